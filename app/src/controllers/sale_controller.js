@@ -11,7 +11,7 @@ export default class extends Controller {
     return [
       'barcodeInput', 'productSelect', 'quantityInput', 'addToListBtn', 'cartItemDiv', 'listTbl', 'itemTemplate',
       'cartTotal', 'customerName', 'phoneNumber', 'amountTender', 'paymentMethod', 'accountNumber',
-      'accountNumberDiv', 'amountTenderDiv'
+      'accountNumberDiv', 'amountTenderDiv', 'creditTermDays', 'creditTermDiv', 'creditAccountNumber', 'creditAccountDiv'
     ]
   }
 
@@ -132,18 +132,26 @@ export default class extends Controller {
   }
 
   paymentMethodChanged (evt) {
-    if (this.paymentMethodTarget.value === 'wallet') {
+    const method = this.paymentMethodTarget.value
+    if (method === 'cash') {
+      hide(this.accountNumberDivTarget)
+      show(this.amountTenderDivTarget)
+    } else {
       show(this.accountNumberDivTarget)
       hide(this.amountTenderDivTarget)
+    }
+    if (method === 'credit') {
+      show(this.creditTermDivTarget)
+      show(this.creditAccountDivTarget)
     } else {
-      hide(this.accountNumberDivTarget)
-      show(this.amountTenderDivTarget)
+      hide(this.creditTermDivTarget)
+      hide(this.creditAccountDivTarget)
     }
   }
 
   sell () {
     const amountTender = parseFloat(this.amountTenderTarget.value)
-    if (amountTender < this.cartTotal) {
+    if (this.paymentMethodTarget.value === 'cash' && amountTender < this.cartTotal) {
       window.alert('The amount tender cannot be less than the cart total')
       return
     }
@@ -151,6 +159,8 @@ export default class extends Controller {
       payment_method: this.paymentMethodTarget.value,
       account_number: this.accountNumberTarget.value,
       amount_tender: amountTender,
+      credit_term_days: parseInt(this.creditTermDaysTarget.value) || 0,
+      credit_account_number: this.creditAccountNumberTarget.value,
       customer_name: this.customerNameTarget.value,
       phone_number: this.phoneNumberTarget.value,
       items: []
@@ -184,7 +194,11 @@ export default class extends Controller {
     this.quantityInputTarget.value = 1
     this.paymentMethodTarget.value = 'cash'
     this.accountNumberTarget.value = ''
+    this.creditTermDaysTarget.value = ''
+    this.creditAccountNumberTarget.value = ''
     hide(this.accountNumberDivTarget)
+    hide(this.creditTermDivTarget)
+    hide(this.creditAccountDivTarget)
     show(this.amountTenderDivTarget)
     this.displayList()
   }
@@ -906,7 +906,7 @@ var doc = `{
                         "OAuth2Password": []
                     }
                 ],
-                "description": "Update updates the specified transaction in the system.",
+                "description": "Update corrects the specified transaction by posting a reversal and a replacement linked to it.",
                 "consumes": [
                     "application/json"
                 ],
@@ -959,7 +959,7 @@ var doc = `{
                         "OAuth2Password": []
                     }
                 ],
-                "description": "Archive soft-deletes the specified transaction from the system.",
+                "description": "Archive reverses the specified transaction by posting a reversal linked to it.",
                 "consumes": [
                     "application/json"
                 ],
@@ -1005,6 +1005,82 @@ var doc = `{
                 }
             }
         },
+        "/transactions/withdraw": {
+            "post": {
+                "security": [
+                    {
+                        "OAuth2Password": []
+                    }
+                ],
+                "description": "Withdraw takes money out of an account. Withdrawals at or above the approval threshold are held until a branch admin approves them.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "transaction"
+                ],
+                "summary": "Withdraw from an account.",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Unique key of the request, a retry with the same key returns the original withdrawal",
+                        "name": "Idempotency-Key",
+                        "in": "header"
+                    },
+                    {
+                        "description": "Withdrawal details",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/transaction.WithdrawRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/transaction.Response"
+                        }
+                    },
+                    "202": {
+                        "description": "Accepted",
+                        "schema": {
+                            "$ref": "#/definitions/transaction.ApprovalResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/weberror.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/weberror.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/weberror.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/weberror.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
         "/transactions/{id}": {
             "get": {
                 "security": [
@@ -1060,6 +1136,137 @@ var doc = `{
                 }
             }
         },
+        "/transfers": {
+            "post": {
+                "security": [
+                    {
+                        "OAuth2Password": []
+                    }
+                ],
+                "description": "Transfer moves money from one account of a customer to another as a linked withdrawal and deposit. Transfers at or above the approval threshold are held until a branch admin approves them.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "transaction"
+                ],
+                "summary": "Transfer between accounts of a customer.",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Unique key of the request, a retry with the same key returns the original transfer",
+                        "name": "Idempotency-Key",
+                        "in": "header"
+                    },
+                    {
+                        "description": "Transfer details",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/transaction.TransferRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/transaction.TransferResponse"
+                        }
+                    },
+                    "202": {
+                        "description": "Accepted",
+                        "schema": {
+                            "$ref": "#/definitions/transaction.ApprovalResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/weberror.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/weberror.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/weberror.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/weberror.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/transfers/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "OAuth2Password": []
+                    }
+                ],
+                "description": "ReadTransfer returns the specified transfer with both of its transactions.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "transaction"
+                ],
+                "summary": "Get transfer by ID.",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Transfer ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/transaction.TransferResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/weberror.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/weberror.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/weberror.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
         "/user_accounts": {
             "get": {
                 "security": [
@@ -2331,15 +2538,102 @@ var doc = `{
                 }
             }
         },
+        "transaction.ApprovalResponse": {
+            "type": "object",
+            "properties": {
+                "account_id": {
+                    "type": "string",
+                    "example": "985f1746-1d9f-459f-a2d9-fc53ece5ae86"
+                },
+                "account_number": {
+                    "type": "string",
+                    "example": "SB10003001"
+                },
+                "amount": {
+                    "type": "number"
+                },
+                "branch": {
+                    "type": "string"
+                },
+                "branch_id": {
+                    "type": "string",
+                    "example": "985f1746-1d9f-459f-a2d9-fc53ece5ae86"
+                },
+                "created_at": {
+                    "type": "object",
+                    "$ref": "#/definitions/web.TimeResponse"
+                },
+                "customer_id": {
+                    "type": "string"
+                },
+                "decided_at": {
+                    "type": "object",
+                    "$ref": "#/definitions/web.TimeResponse"
+                },
+                "decided_by": {
+                    "type": "string"
+                },
+                "decided_by_id": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "985f1746-1d9f-459f-a2d9-fc53ece5ae86"
+                },
+                "kind": {
+                    "type": "string",
+                    "example": "withdrawal"
+                },
+                "narration": {
+                    "type": "string"
+                },
+                "reason": {
+                    "type": "string"
+                },
+                "requested_by": {
+                    "type": "string"
+                },
+                "requested_by_id": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string",
+                    "example": "pending"
+                },
+                "to_account_id": {
+                    "type": "string",
+                    "example": "985f1746-1d9f-459f-a2d9-fc53ece5ae86"
+                },
+                "to_account_number": {
+                    "type": "string",
+                    "example": "DS10003002"
+                },
+                "transaction_id": {
+                    "type": "string"
+                },
+                "transfer_id": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "object",
+                    "$ref": "#/definitions/web.TimeResponse"
+                }
+            }
+        },
         "transaction.ArchiveRequest": {
             "type": "object",
             "required": [
-                "id"
+                "id",
+                "reason"
             ],
             "properties": {
                 "id": {
                     "type": "string",
                     "example": "985f1746-1d9f-459f-a2d9-fc53ece5ae86"
+                },
+                "reason": {
+                    "type": "string",
+                    "example": "Posted to the wrong account"
                 }
             }
         },
@@ -2419,16 +2713,92 @@ var doc = `{
                 "sales_rep_id": {
                     "type": "string"
                 },
+                "transfer_id": {
+                    "type": "string"
+                },
                 "updated_at": {
                     "type": "object",
                     "$ref": "#/definitions/web.TimeResponse"
                 }
             }
         },
+        "transaction.TransferRequest": {
+            "type": "object",
+            "required": [
+                "amount",
+                "from_account_number",
+                "to_account_number"
+            ],
+            "properties": {
+                "amount": {
+                    "type": "number"
+                },
+                "from_account_number": {
+                    "type": "string",
+                    "example": "SB10003001"
+                },
+                "narration": {
+                    "type": "string"
+                },
+                "to_account_number": {
+                    "type": "string",
+                    "example": "DS10003002"
+                }
+            }
+        },
+        "transaction.TransferResponse": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "type": "number"
+                },
+                "created_at": {
+                    "type": "object",
+                    "$ref": "#/definitions/web.TimeResponse"
+                },
+                "from_account_id": {
+                    "type": "string",
+                    "example": "985f1746-1d9f-459f-a2d9-fc53ece5ae86"
+                },
+                "from_account_number": {
+                    "type": "string",
+                    "example": "SB10003001"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "985f1746-1d9f-459f-a2d9-fc53ece5ae86"
+                },
+                "narration": {
+                    "type": "string"
+                },
+                "reference": {
+                    "type": "string",
+                    "example": "TF123456"
+                },
+                "sales_rep_id": {
+                    "type": "string"
+                },
+                "to_account_id": {
+                    "type": "string",
+                    "example": "985f1746-1d9f-459f-a2d9-fc53ece5ae86"
+                },
+                "to_account_number": {
+                    "type": "string",
+                    "example": "DS10003002"
+                },
+                "transactions": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/transaction.Response"
+                    }
+                }
+            }
+        },
         "transaction.UpdateRequest": {
             "type": "object",
             "required": [
-                "id"
+                "id",
+                "reason"
             ],
             "properties": {
                 "amount": {
@@ -2440,6 +2810,42 @@ var doc = `{
                 },
                 "narration": {
                     "type": "string"
+                },
+                "reason": {
+                    "type": "string",
+                    "example": "Amount entered wrongly"
+                }
+            }
+        },
+        "transaction.WithdrawRequest": {
+            "type": "object",
+            "required": [
+                "account_number",
+                "amount",
+                "payment_method",
+                "type"
+            ],
+            "properties": {
+                "account_number": {
+                    "type": "string"
+                },
+                "amount": {
+                    "type": "number"
+                },
+                "bank": {
+                    "type": "string"
+                },
+                "bank_account_number": {
+                    "type": "string"
+                },
+                "narration": {
+                    "type": "string"
+                },
+                "payment_method": {
+                    "type": "string"
+                },
+                "type": {
+                    "type": "string"
                 }
             }
         },
@@ -39,6 +39,7 @@ type Accounts struct {
 // @Param include-customer query boolean 	false 	"Included Customer info, example: false"
 // @Param include-branch query boolean 	false 	"Included Branch info, example: false"
 // @Param include-sales-rep query boolean 	false 	"Included Sale rep info, example: false"
+// @Param status			query string 	false 	"Account status, example: dormant"
 // @Success 200 {object} account.PagedResponseList
 // @Failure 400 {object} weberror.ErrorResponse
 // @Failure 403 {object} weberror.ErrorResponse
@@ -134,6 +135,9 @@ func (h *Accounts) Find(ctx context.Context, w http.ResponseWriter, r *http.Requ
 		req.IncludeSalesRep = b
 	}
 
+	// Handle status query value if set.
+	req.Status = r.URL.Query().Get("status")
+
 	res, err := h.Repository.Find(ctx, claims, req)
 	if err != nil {
 		return err
@@ -172,6 +176,14 @@ func (h *Accounts) Read(ctx context.Context, w http.ResponseWriter, r *http.Requ
 		}
 	}
 
+	v, err := webcontext.ContextValues(ctx)
+	if err != nil {
+		return err
+	}
+	if err := h.Repository.LoadGoal(ctx, res, v.Now); err != nil {
+		return err
+	}
+
 	return web.RespondJson(ctx, w, res.Response(ctx), http.StatusOK)
 }
 
@@ -173,6 +173,7 @@ func (h *Customers) Read(ctx context.Context, w http.ResponseWriter, r *http.Req
 // @Failure 400 {object} weberror.ErrorResponse
 // @Failure 403 {object} weberror.ErrorResponse
 // @Failure 404 {object} weberror.ErrorResponse
+// @Failure 409 {object} weberror.ErrorResponse
 // @Failure 500 {object} weberror.ErrorResponse
 // @Router /customers [post]
 func (h *Customers) Create(ctx context.Context, w http.ResponseWriter, r *http.Request, _ map[string]string) error {
@@ -195,6 +196,9 @@ func (h *Customers) Create(ctx context.Context, w http.ResponseWriter, r *http.R
 	}
 
 	res, err := h.Repository.Create(ctx, claims, req, v.Now)
+	if _, ok := customer.IsDuplicate(err); ok {
+		return web.RespondJsonError(ctx, w, weberror.NewError(ctx, err, http.StatusConflict))
+	}
 	if err != nil {
 		cause := errors.Cause(err)
 		switch cause {
@@ -17,6 +17,7 @@ import (
 	"merryworld/surebank/internal/platform/web/webcontext"
 	_ "merryworld/surebank/internal/platform/web/weberror"
 	"merryworld/surebank/internal/signup"
+	"merryworld/surebank/internal/sms_command"
 	"merryworld/surebank/internal/tenant"
 	"merryworld/surebank/internal/tenant/account_preference"
 	"merryworld/surebank/internal/transaction"
@@ -50,6 +51,8 @@ type AppContext struct {
 	PreAppMiddleware  []web.Middleware
 	PostAppMiddleware []web.Middleware
 	NotifySMS		  notify.SMS
+	SMSCommandRepo    *sms_command.Repository
+	SMSInboundToken   string
 }
 
 // API returns a handler for a set of routes.
@@ -152,9 +155,22 @@ func API(shutdown chan os.Signal, appCtx *AppContext) http.Handler {
 	}
 	app.Handle("GET", "/v1/transactions", dep.Find, mid.AuthenticateHeader(appCtx.Authenticator))
 	app.Handle("POST", "/v1/transactions", dep.Create, mid.AuthenticateHeader(appCtx.Authenticator))
+	app.Handle("POST", "/v1/transactions/withdraw", dep.Withdraw, mid.AuthenticateHeader(appCtx.Authenticator))
 	app.Handle("GET", "/v1/transactions/:id", dep.Read, mid.AuthenticateHeader(appCtx.Authenticator))
 	app.Handle("PATCH", "/v1/transactions", dep.Update, mid.AuthenticateHeader(appCtx.Authenticator), mid.HasRole(auth.RoleAdmin))
 	app.Handle("PATCH", "/v1/transactions/archive", dep.Archive, mid.AuthenticateHeader(appCtx.Authenticator), mid.HasRole(auth.RoleAdmin))
+	app.Handle("POST", "/v1/transfers", dep.Transfer, mid.AuthenticateHeader(appCtx.Authenticator))
+	app.Handle("GET", "/v1/transfers/:id", dep.ReadTransfer, mid.AuthenticateHeader(appCtx.Authenticator))
+
+	// Register the inbound SMS webhook, SMS providers that can forward messages implement
+	// notify.InboundSMSParser.
+	parser, _ := appCtx.NotifySMS.(notify.InboundSMSParser)
+	inbound := InboundSMS{
+		Repository: appCtx.SMSCommandRepo,
+		Parser:     parser,
+		Token:      appCtx.SMSInboundToken,
+	}
+	app.Handle("POST", "/v1/sms/inbound", inbound.Receive)
 
 	// Register swagger documentation.
 	// TODO: Add authentication. Current authenticator requires an Authorization header
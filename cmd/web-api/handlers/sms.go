@@ -0,0 +1,61 @@
+package handlers
+
+import (
+	"context"
+	"crypto/subtle"
+	"net/http"
+
+	"merryworld/surebank/internal/platform/notify"
+	"merryworld/surebank/internal/platform/web"
+	"merryworld/surebank/internal/platform/web/webcontext"
+	"merryworld/surebank/internal/platform/web/weberror"
+	"merryworld/surebank/internal/sms_command"
+
+	"github.com/pkg/errors"
+)
+
+// InboundSMS represents the webhook SMS providers forward the messages customers send to.
+type InboundSMS struct {
+	Repository *sms_command.Repository
+	// Parser reads the payload of the SMS provider, the webhook is disabled without one.
+	Parser notify.InboundSMSParser
+	// Token is shared with the SMS provider which adds it to the URL of the webhook.
+	Token string
+}
+
+// Receive godoc
+// @Summary Receive an SMS.
+// @Description Receive replies to the balance and mini statement commands customers send by SMS.
+// @Tags sms
+// @Accept  json
+// @Produce  json
+// @Param token query string true "Webhook token"
+// @Param data body notify.InboundSMS true "Message details, the payload depends on the SMS provider"
+// @Success 204
+// @Failure 400 {object} weberror.ErrorResponse
+// @Failure 403 {object} weberror.ErrorResponse
+// @Failure 500 {object} weberror.ErrorResponse
+// @Router /sms/inbound [post]
+func (h *InboundSMS) Receive(ctx context.Context, w http.ResponseWriter, r *http.Request, _ map[string]string) error {
+	v, err := webcontext.ContextValues(ctx)
+	if err != nil {
+		return err
+	}
+
+	token := r.URL.Query().Get("token")
+	if h.Parser == nil || h.Token == "" || subtle.ConstantTimeCompare([]byte(token), []byte(h.Token)) != 1 {
+		err := errors.New("Inbound SMS token is not valid")
+		return web.RespondJsonError(ctx, w, weberror.NewError(ctx, err, http.StatusForbidden))
+	}
+
+	sms, err := h.Parser.ParseInbound(r)
+	if err != nil {
+		return web.RespondJsonError(ctx, w, weberror.NewError(ctx, err, http.StatusBadRequest))
+	}
+
+	if err := h.Repository.Handle(ctx, *sms, v.Now); err != nil {
+		return errors.Wrapf(err, "From: %s", sms.From)
+	}
+
+	return web.RespondJson(ctx, w, nil, http.StatusNoContent)
+}
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"merryworld/surebank/internal/checklist"
+	"merryworld/surebank/internal/idempotency"
 	"merryworld/surebank/internal/platform/auth"
 	"merryworld/surebank/internal/platform/web"
 	"merryworld/surebank/internal/platform/web/webcontext"
@@ -156,11 +157,13 @@ func (h *Transactions) Read(ctx context.Context, w http.ResponseWriter, r *http.
 // @Accept  json
 // @Produce  json
 // @Security OAuth2Password
+// @Param Idempotency-Key header string false "Unique key of the request, a retry with the same key returns the original deposit"
 // @Param data body transaction.CreateDepositRequest true "Deposit details"
 // @Success 201 {object} transaction.Response
 // @Failure 400 {object} weberror.ErrorResponse
 // @Failure 403 {object} weberror.ErrorResponse
 // @Failure 404 {object} weberror.ErrorResponse
+// @Failure 422 {object} weberror.ErrorResponse
 // @Failure 500 {object} weberror.ErrorResponse
 // @Router /transactions [post]
 func (h *Transactions) Create(ctx context.Context, w http.ResponseWriter, r *http.Request, _ map[string]string) error {
@@ -186,17 +189,20 @@ func (h *Transactions) Create(ctx context.Context, w http.ResponseWriter, r *htt
 		req.PaymentMethod = "bank_deposit"
 	}
 	res, err := h.Repository.Deposit(ctx, claims, transaction.CreateRequest{
-		Type:          transaction.TransactionType_Deposit,
-		AccountNumber: req.AccountNumber,
-		Amount:        req.Amount,
-		Narration:     req.Narration,
-		PaymentMethod: strings.ToLower(req.PaymentMethod),
+		Type:           transaction.TransactionType_Deposit,
+		AccountNumber:  req.AccountNumber,
+		Amount:         req.Amount,
+		Narration:      req.Narration,
+		PaymentMethod:  strings.ToLower(req.PaymentMethod),
+		IdempotencyKey: r.Header.Get(web.HeaderIdempotencyKey),
 	}, v.Now)
 	if err != nil {
 		cause := errors.Cause(err)
 		switch cause {
 		case checklist.ErrForbidden:
 			return web.RespondJsonError(ctx, w, weberror.NewError(ctx, err, http.StatusForbidden))
+		case idempotency.ErrKeyReused, idempotency.ErrInvalidKey:
+			return web.RespondJsonError(ctx, w, weberror.NewError(ctx, err, http.StatusUnprocessableEntity))
 		default:
 			_, ok := cause.(validator.ValidationErrors)
 			if ok {
@@ -209,9 +215,70 @@ func (h *Transactions) Create(ctx context.Context, w http.ResponseWriter, r *htt
 	return web.RespondJson(ctx, w, res.Response(ctx), http.StatusCreated)
 }
 
+// Withdraw godoc
+// @Summary Withdraw from an account.
+// @Description Withdraw takes money out of an account. Withdrawals at or above the approval threshold are held until a branch admin approves them.
+// @Tags transaction
+// @Accept  json
+// @Produce  json
+// @Security OAuth2Password
+// @Param Idempotency-Key header string false "Unique key of the request, a retry with the same key returns the original withdrawal"
+// @Param data body transaction.WithdrawRequest true "Withdrawal details"
+// @Success 201 {object} transaction.Response
+// @Success 202 {object} transaction.ApprovalResponse
+// @Failure 400 {object} weberror.ErrorResponse
+// @Failure 403 {object} weberror.ErrorResponse
+// @Failure 422 {object} weberror.ErrorResponse
+// @Failure 500 {object} weberror.ErrorResponse
+// @Router /transactions/withdraw [post]
+func (h *Transactions) Withdraw(ctx context.Context, w http.ResponseWriter, r *http.Request, _ map[string]string) error {
+	v, err := webcontext.ContextValues(ctx)
+	if err != nil {
+		return err
+	}
+
+	claims, err := auth.ClaimsFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	var req transaction.WithdrawRequest
+	if err := web.Decode(ctx, r, &req); err != nil {
+		if _, ok := errors.Cause(err).(*weberror.Error); !ok {
+			err = weberror.NewError(ctx, err, http.StatusBadRequest)
+		}
+		return web.RespondJsonError(ctx, w, err)
+	}
+	req.Type = transaction.TransactionType_Withdrawal
+	req.PaymentMethod = strings.ToLower(req.PaymentMethod)
+	req.IdempotencyKey = r.Header.Get(web.HeaderIdempotencyKey)
+
+	res, err := h.Repository.Withdraw(ctx, claims, req, v.Now)
+	if approval, ok := transaction.IsPendingApproval(err); ok {
+		return web.RespondJson(ctx, w, approval.Response(ctx), http.StatusAccepted)
+	}
+	if err != nil {
+		cause := errors.Cause(err)
+		switch cause {
+		case transaction.ErrForbidden:
+			return web.RespondJsonError(ctx, w, weberror.NewError(ctx, err, http.StatusForbidden))
+		case idempotency.ErrKeyReused, idempotency.ErrInvalidKey:
+			return web.RespondJsonError(ctx, w, weberror.NewError(ctx, err, http.StatusUnprocessableEntity))
+		default:
+			_, ok := cause.(validator.ValidationErrors)
+			if ok {
+				return web.RespondJsonError(ctx, w, weberror.NewError(ctx, err, http.StatusBadRequest))
+			}
+			return errors.Wrapf(err, "Withdrawal: %+v", &req)
+		}
+	}
+
+	return web.RespondJson(ctx, w, res.Response(ctx), http.StatusCreated)
+}
+
 // Read godoc
 // @Summary Update transaction by ID
-// @Description Update updates the specified transaction in the system.
+// @Description Update corrects the specified transaction by posting a reversal and a replacement linked to it.
 // @Tags transaction
 // @Accept  json
 // @Produce  json
@@ -262,7 +329,7 @@ func (h *Transactions) Update(ctx context.Context, w http.ResponseWriter, r *htt
 
 // Read godoc
 // @Summary Archive transaction by ID
-// @Description Archive soft-deletes the specified transaction from the system.
+// @Description Archive reverses the specified transaction by posting a reversal linked to it.
 // @Tags transaction
 // @Accept  json
 // @Produce  json
@@ -310,3 +377,95 @@ func (h *Transactions) Archive(ctx context.Context, w http.ResponseWriter, r *ht
 
 	return web.RespondJson(ctx, w, nil, http.StatusNoContent)
 }
+
+// Transfer godoc
+// @Summary Transfer between accounts of a customer.
+// @Description Transfer moves money from one account of a customer to another as a linked withdrawal and deposit. Transfers at or above the approval threshold are held until a branch admin approves them.
+// @Tags transaction
+// @Accept  json
+// @Produce  json
+// @Security OAuth2Password
+// @Param Idempotency-Key header string false "Unique key of the request, a retry with the same key returns the original transfer"
+// @Param data body transaction.TransferRequest true "Transfer details"
+// @Success 201 {object} transaction.TransferResponse
+// @Success 202 {object} transaction.ApprovalResponse
+// @Failure 400 {object} weberror.ErrorResponse
+// @Failure 403 {object} weberror.ErrorResponse
+// @Failure 422 {object} weberror.ErrorResponse
+// @Failure 500 {object} weberror.ErrorResponse
+// @Router /transfers [post]
+func (h *Transactions) Transfer(ctx context.Context, w http.ResponseWriter, r *http.Request, _ map[string]string) error {
+	v, err := webcontext.ContextValues(ctx)
+	if err != nil {
+		return err
+	}
+
+	claims, err := auth.ClaimsFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	var req transaction.TransferRequest
+	if err := web.Decode(ctx, r, &req); err != nil {
+		if _, ok := errors.Cause(err).(*weberror.Error); !ok {
+			err = weberror.NewError(ctx, err, http.StatusBadRequest)
+		}
+		return web.RespondJsonError(ctx, w, err)
+	}
+	req.IdempotencyKey = r.Header.Get(web.HeaderIdempotencyKey)
+
+	res, err := h.Repository.Transfer(ctx, claims, req, v.Now)
+	if approval, ok := transaction.IsPendingApproval(err); ok {
+		return web.RespondJson(ctx, w, approval.Response(ctx), http.StatusAccepted)
+	}
+	if err != nil {
+		cause := errors.Cause(err)
+		switch cause {
+		case transaction.ErrForbidden:
+			return web.RespondJsonError(ctx, w, weberror.NewError(ctx, err, http.StatusForbidden))
+		case idempotency.ErrKeyReused, idempotency.ErrInvalidKey:
+			return web.RespondJsonError(ctx, w, weberror.NewError(ctx, err, http.StatusUnprocessableEntity))
+		default:
+			_, ok := cause.(validator.ValidationErrors)
+			if ok {
+				return web.RespondJsonError(ctx, w, weberror.NewError(ctx, err, http.StatusBadRequest))
+			}
+			return errors.Wrapf(err, "Transfer: %+v", &req)
+		}
+	}
+
+	return web.RespondJson(ctx, w, res.Response(ctx), http.StatusCreated)
+}
+
+// ReadTransfer godoc
+// @Summary Get transfer by ID.
+// @Description ReadTransfer returns the specified transfer with both of its transactions.
+// @Tags transaction
+// @Accept  json
+// @Produce  json
+// @Security OAuth2Password
+// @Param id path string true "Transfer ID"
+// @Success 200 {object} transaction.TransferResponse
+// @Failure 400 {object} weberror.ErrorResponse
+// @Failure 404 {object} weberror.ErrorResponse
+// @Failure 500 {object} weberror.ErrorResponse
+// @Router /transfers/{id} [get]
+func (h *Transactions) ReadTransfer(ctx context.Context, w http.ResponseWriter, r *http.Request, params map[string]string) error {
+	claims, err := auth.ClaimsFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	res, err := h.Repository.ReadTransfer(ctx, claims, params["id"])
+	if err != nil {
+		cause := errors.Cause(err)
+		switch cause {
+		case transaction.ErrNotFound:
+			return web.RespondJsonError(ctx, w, weberror.NewError(ctx, err, http.StatusNotFound))
+		default:
+			return errors.Wrapf(err, "ID: %s", params["id"])
+		}
+	}
+
+	return web.RespondJson(ctx, w, res.Response(ctx), http.StatusOK)
+}
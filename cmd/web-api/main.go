@@ -24,12 +24,16 @@ import (
 	"merryworld/surebank/internal/checklist"
 	"merryworld/surebank/internal/customer"
 	"merryworld/surebank/internal/dscommission"
+	"merryworld/surebank/internal/ledger"
 	"merryworld/surebank/internal/mid"
 	"merryworld/surebank/internal/platform/auth"
+	"merryworld/surebank/internal/platform/blob"
 	"merryworld/surebank/internal/platform/flag"
 	"merryworld/surebank/internal/platform/notify"
 	"merryworld/surebank/internal/platform/web/webcontext"
+	"merryworld/surebank/internal/profit"
 	"merryworld/surebank/internal/signup"
+	"merryworld/surebank/internal/sms_command"
 	"merryworld/surebank/internal/tenant"
 	"merryworld/surebank/internal/tenant/account_preference"
 	"merryworld/surebank/internal/transaction"
@@ -125,6 +129,7 @@ func main() {
 			SMSAuthToken      string `default:"nHIts34gQLQeqN6o92PgFZTqWj01MCNNgPxn0Z5lqTfArc3mbE1SZkt3vil0" envconfig:"SMS_Auth_TOKEN"`
 			SMSUsername       string `default:"surebank" envconfig:"SMS_Auth_User"`
 			SMSPassword       string `default:"surebank123" envconfig:"SMS_Auth_Pass"`
+			SMSInboundToken   string `default:"" envconfig:"SMS_INBOUND_TOKEN"`
 			WebAppBaseUrl     string `default:"http://127.0.0.1:3000" envconfig:"WEB_APP_BASE_URL" example:"www.example.saasstartupkit.com"`
 		}
 		Redis struct {
@@ -142,6 +147,9 @@ func main() {
 			Timezone   string `default:"utc" envconfig:"TIMEZONE"`
 			DisableTLS bool   `default:"true" envconfig:"DISABLE_TLS"`
 		}
+		Blob struct {
+			Dir string `default:"../../uploads" envconfig:"DIR"`
+		}
 		Trace struct {
 			Host          string  `default:"127.0.0.1" envconfig:"DD_TRACE_AGENT_HOSTNAME"`
 			Port          int     `default:"8126" envconfig:"DD_TRACE_AGENT_PORT"`
@@ -464,6 +472,9 @@ func main() {
 		if err != nil {
 			log.Fatalf("main : Notify SMS : %+v", err)
 		}
+	} else if cfg.Project.SMSProvider == "local" {
+		// keep messages in memory for local development
+		notifySMS = notify.NewSMSLocal(cfg.Project.SharedTemplateDir)
 	} else {
 		notifySMS = notify.NewSMSDisabled()
 	}
@@ -497,10 +508,18 @@ func main() {
 	signupRepo := signup.NewRepository(masterDb, usrRepo, usrAccRepo, accRepo)
 	inviteRepo := invite.NewRepository(masterDb, usrRepo, usrAccRepo, accRepo, webRoute.UserInviteAccept, notifyEmail, cfg.Project.SharedSecretKey)
 	chklstRepo := checklist.NewRepository(masterDb)
-	customerRepo := customer.NewRepository(masterDb)
+	// Customer documents are kept on the local disk.
+	blobStore, err := blob.NewStoreLocal(cfg.Blob.Dir)
+	if err != nil {
+		log.Fatalf("main : Blob store : %s : %+v", cfg.Blob.Dir, err)
+	}
+	customerRepo := customer.NewRepository(masterDb, blobStore)
 	accountRepo := account.NewRepository(masterDb)
 	commissionRepo := dscommission.NewRepository(masterDb)
-	depositRepo := transaction.NewRepository(masterDb, commissionRepo, notifySMS, createDB)
+	profitRepo := profit.NewRepository(masterDb)
+	ledgerRepo := ledger.NewRepository(masterDb)
+	depositRepo := transaction.NewRepository(masterDb, commissionRepo, profitRepo, ledgerRepo, notifySMS, notifyEmail, createDB)
+	smsCommandRepo := sms_command.NewRepository(masterDb, customerRepo, notifySMS)
 
 	appCtx := &handlers.AppContext{
 		Log:             log,
@@ -521,6 +540,8 @@ func main() {
 		DepositRepo:     depositRepo,
 		Authenticator:   authenticator,
 		NotifySMS:       notifySMS,
+		SMSCommandRepo:  smsCommandRepo,
+		SMSInboundToken: cfg.Project.SMSInboundToken,
 	}
 
 	// =========================================================================
@@ -623,11 +644,11 @@ func main() {
 	serverErrors := make(chan error, 1)
 
 	// Make an list of HTTP servers for both HTTP and HTTPS requests.
-	var httpServers []http.Server
+	var httpServers []*http.Server
 
 	// Start the HTTP service listening for requests.
 	if cfg.HTTP.Host != "" {
-		api := http.Server{
+		api := &http.Server{
 			Addr:           cfg.HTTP.Host,
 			Handler:        handlers.API(shutdown, appCtx),
 			ReadTimeout:    cfg.HTTP.ReadTimeout,
@@ -644,7 +665,7 @@ func main() {
 
 	// Start the HTTPS service listening for requests with an SSL Cert auto generated with Let's Encrypt.
 	if cfg.HTTPS.Host != "" {
-		api := http.Server{
+		api := &http.Server{
 			Addr:           cfg.HTTPS.Host,
 			Handler:        handlers.API(shutdown, appCtx),
 			ReadTimeout:    cfg.HTTPS.ReadTimeout,
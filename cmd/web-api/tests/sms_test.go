@@ -0,0 +1,278 @@
+package tests
+
+import (
+	"encoding/binary"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"merryworld/surebank/internal/customer"
+	"merryworld/surebank/internal/platform/auth"
+	"merryworld/surebank/internal/platform/money"
+	"merryworld/surebank/internal/platform/notify"
+	"merryworld/surebank/internal/platform/tests"
+	"merryworld/surebank/internal/postgres/models"
+	"merryworld/surebank/internal/sms_command"
+	"merryworld/surebank/internal/transaction"
+	"merryworld/surebank/internal/user_auth"
+
+	"github.com/pborman/uuid"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+// mockPhoneNumber returns a phone number no customer or other test uses.
+func mockPhoneNumber() string {
+	return fmt.Sprintf("+23480%08d", binary.BigEndian.Uint32(uuid.NewRandom())%100000000)
+}
+
+// TestInboundSMS tests the inbound SMS webhook with the local SMS provider.
+func TestInboundSMS(t *testing.T) {
+	defer tests.Recover(t)
+
+	ctx := tests.Context()
+	url := "/v1/sms/inbound?token=" + smsInboundToken
+
+	t.Log("Given the need to reply to the commands customers send by SMS.")
+	{
+		// Test the webhook cannot be called without the token.
+		{
+			expectedStatus := http.StatusForbidden
+
+			rt := requestTest{
+				fmt.Sprintf("Receive %d w/o token", expectedStatus),
+				http.MethodPost,
+				"/v1/sms/inbound",
+				notify.InboundSMS{From: mockPhoneNumber(), Message: "BAL SB10003001"},
+				user_auth.Token{},
+				auth.Claims{},
+				expectedStatus,
+				nil,
+			}
+			t.Logf("\tTest: %s - %s %s", rt.name, rt.method, rt.url)
+
+			if _, ok := executeRequestTest(t, rt, ctx); !ok {
+				t.Fatalf("\t%s\tExecute request failed.", tests.Failed)
+			}
+			t.Logf("\t%s\tReceived valid status code of %d.", tests.Success, expectedStatus)
+		}
+
+		// Test messages are replied to.
+		var replyTests = []struct {
+			name    string
+			message string
+			reply   string
+		}{
+			{"unknown command", "HELLO", "Send BAL <account number>"},
+			{"account of another phone", "bal " + uuid.NewRandom().String()[:8], "is not registered to this phone number"},
+		}
+		for _, tt := range replyTests {
+			expectedStatus := http.StatusNoContent
+
+			phone := mockPhoneNumber()
+			rt := requestTest{
+				fmt.Sprintf("Receive %d %s", expectedStatus, tt.name),
+				http.MethodPost,
+				url,
+				notify.InboundSMS{From: phone, Message: tt.message},
+				user_auth.Token{},
+				auth.Claims{},
+				expectedStatus,
+				nil,
+			}
+			t.Logf("\tTest: %s - %s %s", rt.name, rt.method, rt.url)
+
+			if _, ok := executeRequestTest(t, rt, ctx); !ok {
+				t.Fatalf("\t%s\tExecute request failed.", tests.Failed)
+			}
+			t.Logf("\t%s\tReceived valid status code of %d.", tests.Success, expectedStatus)
+
+			replies := smsSentTo(phone)
+			if len(replies) != 1 || !strings.Contains(replies[0], tt.reply) {
+				t.Logf("\t\tGot : %v", replies)
+				t.Logf("\t\tWant: %s", tt.reply)
+				t.Fatalf("\t%s\tShould reply to the message.", tests.Failed)
+			}
+			t.Logf("\t%s\tShould reply to the message.", tests.Success)
+		}
+
+		// Test a phone is not replied to after it sent too many messages, with the provider sending
+		// its number with and without the country code.
+		{
+			expectedStatus := http.StatusNoContent
+
+			phone := mockPhoneNumber()
+			localPhone := "0" + strings.TrimPrefix(phone, "+234")
+			for i := 0; i <= sms_command.MaxMessagesPerHour; i++ {
+				from := phone
+				if i%2 == 1 {
+					from = localPhone
+				}
+
+				rt := requestTest{
+					fmt.Sprintf("Receive %d message %d", expectedStatus, i),
+					http.MethodPost,
+					url,
+					notify.InboundSMS{From: from, Message: "STMT SB10003001"},
+					user_auth.Token{},
+					auth.Claims{},
+					expectedStatus,
+					nil,
+				}
+				if _, ok := executeRequestTest(t, rt, ctx); !ok {
+					t.Fatalf("\t%s\tExecute request failed.", tests.Failed)
+				}
+			}
+
+			if got := len(smsSentTo(phone)) + len(smsSentTo(localPhone)); got != sms_command.MaxMessagesPerHour {
+				t.Logf("\t\tGot : %d replies", got)
+				t.Logf("\t\tWant: %d replies", sms_command.MaxMessagesPerHour)
+				t.Fatalf("\t%s\tShould stop replying to the phone.", tests.Failed)
+			}
+			t.Logf("\t%s\tShould stop replying to the phone.", tests.Success)
+		}
+	}
+}
+
+// TestInboundSMSStatement tests the mini statement sent by SMS leaves out the deposits that were
+// corrected and their reversals.
+func TestInboundSMSStatement(t *testing.T) {
+	defer tests.Recover(t)
+
+	ctx := tests.Context()
+	now := time.Date(2026, time.October, 18, 9, 0, 0, 0, time.UTC)
+	phone := mockPhoneNumber()
+
+	branch := models.Branch{
+		ID:        uuid.NewRandom().String(),
+		Name:      "Branch " + uuid.NewRandom().String(),
+		CreatedAt: now.Unix(),
+		UpdatedAt: now.Unix(),
+	}
+	if err := branch.Insert(ctx, test.MasterDB, boil.Infer()); err != nil {
+		t.Fatalf("\t%s\tInsert branch failed: %v", tests.Failed, err)
+	}
+
+	salesRep := models.User{
+		ID:          uuid.NewRandom().String(),
+		BranchID:    branch.ID,
+		Email:       uuid.NewRandom().String() + "@example.com",
+		FirstName:   "Sales",
+		LastName:    "Rep",
+		PhoneNumber: "08000000000",
+		CreatedAt:   now,
+	}
+	if err := salesRep.Insert(ctx, test.MasterDB, boil.Infer()); err != nil {
+		t.Fatalf("\t%s\tInsert sales rep failed: %v", tests.Failed, err)
+	}
+
+	cust := models.Customer{
+		ID:          uuid.NewRandom().String(),
+		BranchID:    branch.ID,
+		Email:       uuid.NewRandom().String() + "@example.com",
+		Name:        "Test Customer",
+		PhoneNumber: phone,
+		SalesRepID:  salesRep.ID,
+		CreatedAt:   now.Unix(),
+		UpdatedAt:   now.Unix(),
+	}
+	if err := cust.Insert(ctx, test.MasterDB, boil.Infer()); err != nil {
+		t.Fatalf("\t%s\tInsert customer failed: %v", tests.Failed, err)
+	}
+
+	product, err := models.AccountProducts(models.AccountProductWhere.Code.EQ(customer.AccountTypeSB)).One(ctx, test.MasterDB)
+	if err != nil {
+		t.Fatalf("\t%s\tRead account product failed: %v", tests.Failed, err)
+	}
+
+	acc := models.Account{
+		ID:          uuid.NewRandom().String(),
+		BranchID:    branch.ID,
+		Number:      uuid.NewRandom().String()[:8],
+		CustomerID:  cust.ID,
+		AccountType: product.Code,
+		ProductID:   product.ID,
+		SalesRepID:  salesRep.ID,
+		Balance:     money.Naira(500).Kobo(),
+		CreatedAt:   now.Unix(),
+		UpdatedAt:   now.Unix(),
+	}
+	if err := acc.Insert(ctx, test.MasterDB, boil.Infer()); err != nil {
+		t.Fatalf("\t%s\tInsert account failed: %v", tests.Failed, err)
+	}
+
+	// A deposit of 700 corrected to 300 followed by a deposit of 200.
+	original := uuid.NewRandom().String()
+	for i, tx := range []models.Transaction{
+		{ID: original, TXType: transaction.TransactionType_Deposit.String(), Amount: money.Naira(700).Kobo()},
+		{TXType: transaction.TransactionType_Withdrawal.String(), Amount: money.Naira(700).Kobo(),
+			ReversalOfID: null.StringFrom(original)},
+		{TXType: transaction.TransactionType_Deposit.String(), Amount: money.Naira(300).Kobo(),
+			CorrectionOfID: null.StringFrom(original)},
+		{TXType: transaction.TransactionType_Deposit.String(), Amount: money.Naira(200).Kobo()},
+	} {
+		createdAt := now.Add(time.Duration(i) * time.Minute).Unix()
+		if tx.ID == "" {
+			tx.ID = uuid.NewRandom().String()
+		}
+		tx.AccountID = acc.ID
+		tx.Narration = "Cash deposit"
+		tx.SalesRepID = salesRep.ID
+		tx.ReceiptNo = uuid.NewRandom().String()[:8]
+		tx.PaymentMethod = transaction.PaymentMethod_Cash
+		tx.CreatedAt = createdAt
+		tx.UpdatedAt = createdAt
+		tx.EffectiveDate = createdAt
+		if err := tx.Insert(ctx, test.MasterDB, boil.Infer()); err != nil {
+			t.Fatalf("\t%s\tInsert transaction failed: %v", tests.Failed, err)
+		}
+	}
+
+	t.Log("Given the need to send customers the last transactions of their accounts by SMS.")
+	{
+		expectedStatus := http.StatusNoContent
+
+		rt := requestTest{
+			fmt.Sprintf("Receive %d statement with a corrected deposit", expectedStatus),
+			http.MethodPost,
+			"/v1/sms/inbound?token=" + smsInboundToken,
+			notify.InboundSMS{From: phone, Message: "STMT " + acc.Number},
+			user_auth.Token{},
+			auth.Claims{},
+			expectedStatus,
+			nil,
+		}
+		t.Logf("\tTest: %s - %s %s", rt.name, rt.method, rt.url)
+
+		if _, ok := executeRequestTest(t, rt, ctx); !ok {
+			t.Fatalf("\t%s\tExecute request failed.", tests.Failed)
+		}
+		t.Logf("\t%s\tReceived valid status code of %d.", tests.Success, expectedStatus)
+
+		replies := smsSentTo(phone)
+		if len(replies) != 1 {
+			t.Logf("\t\tGot : %v", replies)
+			t.Fatalf("\t%s\tShould reply with the statement.", tests.Failed)
+		}
+		reply := replies[0]
+		if strings.Contains(reply, money.Naira(700).String()) || strings.Contains(reply, " DR ") ||
+			!strings.Contains(reply, "CR "+money.Naira(300).String()) || !strings.Contains(reply, "CR "+money.Naira(200).String()) {
+			t.Logf("\t\tGot : %s", reply)
+			t.Fatalf("\t%s\tShould leave out the corrected deposit and its reversal.", tests.Failed)
+		}
+		t.Logf("\t%s\tShould leave out the corrected deposit and its reversal.", tests.Success)
+	}
+}
+
+// smsSentTo returns the messages the local SMS provider sent to the phone number.
+func smsSentTo(phoneNumber string) []string {
+	var res []string
+	for _, m := range smsLocal.Sent() {
+		if m.PhoneNumber == phoneNumber {
+			res = append(res, m.Message)
+		}
+	}
+	return res
+}
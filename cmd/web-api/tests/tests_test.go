@@ -15,6 +15,7 @@ import (
 	"time"
 
 	"merryworld/surebank/cmd/web-api/handlers"
+	"merryworld/surebank/internal/customer"
 	"merryworld/surebank/internal/tenant"
 	"merryworld/surebank/internal/tenant/account_preference"
 	"merryworld/surebank/internal/platform/auth"
@@ -24,6 +25,7 @@ import (
 	"merryworld/surebank/internal/platform/web/webcontext"
 	"merryworld/surebank/internal/platform/web/weberror"
 	"merryworld/surebank/internal/signup"
+	"merryworld/surebank/internal/sms_command"
 	"merryworld/surebank/internal/user"
 	"merryworld/surebank/internal/user_account"
 	"merryworld/surebank/internal/user_account/invite"
@@ -40,8 +42,12 @@ var (
 	test          *tests.Test
 	authenticator *auth.Authenticator
 	appCtx        *handlers.AppContext
+	smsLocal      *notify.SMSLocal
 )
 
+// smsInboundToken is the token the inbound SMS webhook is called with in the tests.
+const smsInboundToken = "6368616e676520746869732070613434"
+
 // Information about the users we have created for testing.
 type roleTest struct {
 	Role             string
@@ -106,6 +112,9 @@ func testMain(m *testing.M) int {
 	authRepo := user_auth.NewRepository(test.MasterDB, authenticator, usrRepo, usrAccRepo, accPrefRepo)
 	signupRepo := signup.NewRepository(test.MasterDB, usrRepo, usrAccRepo, accRepo)
 	inviteRepo := invite.NewRepository(test.MasterDB, usrRepo, usrAccRepo, accRepo, projectRoute.UserInviteAccept, notifyEmail, "6368616e676520746869732070613434")
+	smsLocal = notify.NewSMSLocal("")
+	customerRepo := customer.NewRepository(test.MasterDB, nil)
+	smsCommandRepo := sms_command.NewRepository(test.MasterDB, customerRepo, smsLocal)
 
 	appCtx = &handlers.AppContext{
 		Log:             log,
@@ -120,6 +129,9 @@ func testMain(m *testing.M) int {
 		SignupRepo:      signupRepo,
 		InviteRepo:      inviteRepo,
 		Authenticator:   authenticator,
+		NotifySMS:       smsLocal,
+		SMSCommandRepo:  smsCommandRepo,
+		SMSInboundToken: smsInboundToken,
 	}
 
 	a = handlers.API(shutdown, appCtx)
@@ -0,0 +1,413 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"merryworld/surebank/internal/account_product"
+	"merryworld/surebank/internal/dscommission"
+	"merryworld/surebank/internal/platform/auth"
+	"merryworld/surebank/internal/platform/datatable"
+	"merryworld/surebank/internal/platform/web"
+	"merryworld/surebank/internal/platform/web/webcontext"
+	"merryworld/surebank/internal/platform/web/weberror"
+
+	"github.com/gorilla/schema"
+	"github.com/pkg/errors"
+	"gopkg.in/DataDog/dd-trace-go.v1/contrib/go-redis/redis"
+)
+
+// AccountProducts represents the AccountProducts API method handler set.
+type AccountProducts struct {
+	Repo     *account_product.Repository
+	Redis    *redis.Client
+	Renderer web.Renderer
+}
+
+func urlAccountProductsIndex() string {
+	return fmt.Sprintf("/account-products")
+}
+
+func urlAccountProductsCreate() string {
+	return fmt.Sprintf("/account-products/create")
+}
+
+func urlAccountProductsView(productID string) string {
+	return fmt.Sprintf("/account-products/%s", productID)
+}
+
+func urlAccountProductsUpdate(productID string) string {
+	return fmt.Sprintf("/account-products/%s/update", productID)
+}
+
+// Index handles listing all the account products.
+func (h *AccountProducts) Index(ctx context.Context, w http.ResponseWriter, r *http.Request, params map[string]string) error {
+
+	claims, err := auth.ClaimsFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	fields := []datatable.DisplayField{
+		{Field: "id", Title: "ID", Visible: false, Searchable: true, Orderable: true, Filterable: false},
+		{Field: "code", Title: "Code", Visible: true, Searchable: true, Orderable: true, Filterable: true, FilterPlaceholder: "filter Code"},
+		{Field: "name", Title: "Product", Visible: true, Searchable: true, Orderable: true, Filterable: true, FilterPlaceholder: "filter Name"},
+		{Field: "daily_contribution", Title: "Daily Contribution", Visible: true, Searchable: false, Orderable: true, Filterable: false},
+		{Field: "withdrawals_allowed", Title: "Withdrawals", Visible: true, Searchable: false, Orderable: true, Filterable: false},
+		{Field: "interest_rate_bps", Title: "Interest Rate", Visible: true, Searchable: false, Orderable: true, Filterable: false},
+		{Field: "updated_at", Title: "Last Updated", Visible: true, Searchable: true, Orderable: true, Filterable: false},
+	}
+
+	yesNo := func(b bool) string {
+		if b {
+			return "Yes"
+		}
+		return "No"
+	}
+
+	mapFunc := func(q *account_product.Product, cols []datatable.DisplayField) (resp []datatable.ColumnValue, err error) {
+		res := q.Response(ctx)
+		for i := 0; i < len(cols); i++ {
+			col := cols[i]
+			var v datatable.ColumnValue
+			switch col.Field {
+			case "id":
+				v.Value = fmt.Sprintf("%s", q.ID)
+			case "code":
+				v.Value = q.Code
+				v.Formatted = fmt.Sprintf("<a href='%s'>%s</a>", urlAccountProductsView(q.ID), v.Value)
+			case "name":
+				v.Value = q.Name
+				v.Formatted = fmt.Sprintf("<a href='%s'>%s</a>", urlAccountProductsView(q.ID), v.Value)
+			case "daily_contribution":
+				v.Value = yesNo(q.DailyContribution)
+				v.Formatted = v.Value
+			case "withdrawals_allowed":
+				v.Value = yesNo(q.WithdrawalsAllowed)
+				v.Formatted = v.Value
+			case "interest_rate_bps":
+				v.Value = res.InterestRate
+				v.Formatted = v.Value
+			case "updated_at":
+				v.Value = res.UpdatedAt.Local
+				v.Formatted = fmt.Sprintf("<span class='cell-font-date'>%s</span>", v.Value)
+			default:
+				return resp, errors.Errorf("Failed to map value for %s.", col.Field)
+			}
+			resp = append(resp, v)
+		}
+
+		return resp, nil
+	}
+
+	loadFunc := func(ctx context.Context, sorting string, fields []datatable.DisplayField) (resp [][]datatable.ColumnValue, err error) {
+
+		var order []string
+		if len(sorting) > 0 {
+			order = strings.Split(sorting, ",")
+		}
+
+		res, err := h.Repo.Find(ctx, claims, account_product.FindRequest{
+			Order: order,
+		})
+		if err != nil {
+			return resp, err
+		}
+
+		for _, a := range res {
+			l, err := mapFunc(a, fields)
+			if err != nil {
+				return resp, errors.Wrapf(err, "Failed to map account product for display.")
+			}
+
+			resp = append(resp, l)
+		}
+
+		return resp, nil
+	}
+
+	dt, err := datatable.New(ctx, w, r, h.Redis, fields, loadFunc)
+	if err != nil {
+		return err
+	}
+
+	if dt.HasCache() {
+		return nil
+	}
+
+	if ok, err := dt.Render(); ok {
+		if err != nil {
+			return err
+		}
+		return nil
+	}
+
+	data := map[string]interface{}{
+		"datatable":                dt.Response(),
+		"urlAccountProductsCreate": urlAccountProductsCreate(),
+		"urlAccountProductsIndex":  urlAccountProductsIndex(),
+	}
+
+	return h.Renderer.Render(ctx, w, r, TmplLayoutBase, "account-products-index.gohtml", web.MIMETextHTMLCharsetUTF8, http.StatusOK, data)
+}
+
+// Create handles creating a new account product.
+func (h *AccountProducts) Create(ctx context.Context, w http.ResponseWriter, r *http.Request, params map[string]string) error {
+
+	ctxValues, err := webcontext.ContextValues(ctx)
+	if err != nil {
+		return err
+	}
+
+	claims, err := auth.ClaimsFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	//
+	req := new(account_product.CreateRequest)
+	data := make(map[string]interface{})
+	f := func() (bool, error) {
+		if r.Method == http.MethodPost {
+			err := r.ParseForm()
+			if err != nil {
+				return false, err
+			}
+
+			decoder := schema.NewDecoder()
+			decoder.IgnoreUnknownKeys(true)
+
+			if err := decoder.Decode(req, r.PostForm); err != nil {
+				return false, err
+			}
+			req.Code = strings.ToUpper(strings.TrimSpace(req.Code))
+
+			if !checkCommissionPolicy(ctx, req.CommissionPolicy) {
+				return false, nil
+			}
+
+			res, err := h.Repo.Create(ctx, claims, *req, ctxValues.Now)
+			if err != nil {
+				switch errors.Cause(err) {
+				default:
+					if verr, ok := weberror.NewValidationError(ctx, err); ok {
+						data["validationErrors"] = verr.(*weberror.Error)
+						return false, nil
+					} else {
+						return false, err
+					}
+				}
+			}
+
+			// Display a success message to the user.
+			webcontext.SessionFlashSuccess(ctx,
+				"Account Product Created",
+				"Account product successfully created.")
+
+			return true, web.Redirect(ctx, w, r, urlAccountProductsView(res.ID), http.StatusFound)
+		}
+
+		return false, nil
+	}
+
+	end, err := f()
+	if err != nil {
+		return web.RenderError(ctx, w, r, err, h.Renderer, TmplLayoutBase, TmplContentErrorGeneric, web.MIMETextHTMLCharsetUTF8)
+	} else if end {
+		return nil
+	}
+
+	// New products take deposits and withdrawals like savings accounts unless told otherwise.
+	if r.Method != http.MethodPost {
+		req.WithdrawalsAllowed = true
+		req.DepositSMS = true
+	}
+
+	data["form"] = req
+	data["urlAccountProductsIndex"] = urlAccountProductsIndex()
+
+	if verr, ok := weberror.NewValidationError(ctx, webcontext.Validator().Struct(account_product.CreateRequest{})); ok {
+		data["validationDefaults"] = verr.(*weberror.Error)
+	}
+
+	return h.Renderer.Render(ctx, w, r, TmplLayoutBase, "account-products-create.gohtml", web.MIMETextHTMLCharsetUTF8, http.StatusOK, data)
+}
+
+// View handles displaying an account product.
+func (h *AccountProducts) View(ctx context.Context, w http.ResponseWriter, r *http.Request, params map[string]string) error {
+
+	productID := params["product_id"]
+
+	ctxValues, err := webcontext.ContextValues(ctx)
+	if err != nil {
+		return err
+	}
+
+	claims, err := auth.ClaimsFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	data := make(map[string]interface{})
+	f := func() (bool, error) {
+		if r.Method == http.MethodPost {
+			err := r.ParseForm()
+			if err != nil {
+				return false, err
+			}
+
+			switch r.PostForm.Get("action") {
+			case "archive":
+				err = h.Repo.Archive(ctx, claims, account_product.ArchiveRequest{
+					ID: productID,
+				}, ctxValues.Now)
+				if err != nil {
+					return false, err
+				}
+
+				webcontext.SessionFlashSuccess(ctx,
+					"Account Product Archive",
+					"Account product successfully archived.")
+
+				return true, web.Redirect(ctx, w, r, urlAccountProductsIndex(), http.StatusFound)
+			}
+		}
+
+		return false, nil
+	}
+
+	end, err := f()
+	if err != nil {
+		return web.RenderError(ctx, w, r, err, h.Renderer, TmplLayoutBase, TmplContentErrorGeneric, web.MIMETextHTMLCharsetUTF8)
+	} else if end {
+		return nil
+	}
+
+	prj, err := h.Repo.ReadByID(ctx, claims, productID)
+	if err != nil {
+		return err
+	}
+	data["product"] = prj.Response(ctx)
+	data["urlAccountProductsCreate"] = urlAccountProductsCreate()
+	data["urlAccountProductsIndex"] = urlAccountProductsIndex()
+	data["urlAccountProductsView"] = urlAccountProductsView(productID)
+	data["urlAccountProductsUpdate"] = urlAccountProductsUpdate(productID)
+
+	return h.Renderer.Render(ctx, w, r, TmplLayoutBase, "account-products-view.gohtml", web.MIMETextHTMLCharsetUTF8, http.StatusOK, data)
+}
+
+// Update handles updating an account product.
+func (h *AccountProducts) Update(ctx context.Context, w http.ResponseWriter, r *http.Request, params map[string]string) error {
+
+	productID := params["product_id"]
+
+	ctxValues, err := webcontext.ContextValues(ctx)
+	if err != nil {
+		return err
+	}
+
+	claims, err := auth.ClaimsFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	//
+	req := new(account_product.UpdateRequest)
+	data := make(map[string]interface{})
+	f := func() (bool, error) {
+		if r.Method == http.MethodPost {
+			err := r.ParseForm()
+			if err != nil {
+				return false, err
+			}
+
+			decoder := schema.NewDecoder()
+			decoder.IgnoreUnknownKeys(true)
+
+			if err := decoder.Decode(req, r.PostForm); err != nil {
+				return false, err
+			}
+			req.ID = productID
+
+			if req.CommissionPolicy != nil && !checkCommissionPolicy(ctx, *req.CommissionPolicy) {
+				return false, nil
+			}
+
+			err = h.Repo.Update(ctx, claims, *req, ctxValues.Now)
+			if err != nil {
+				switch errors.Cause(err) {
+				default:
+					if verr, ok := weberror.NewValidationError(ctx, err); ok {
+						data["validationErrors"] = verr.(*weberror.Error)
+						return false, nil
+					} else {
+						return false, err
+					}
+				}
+			}
+
+			// Display a success message to the user.
+			webcontext.SessionFlashSuccess(ctx,
+				"Account Product Updated",
+				"Account product successfully updated.")
+
+			return true, web.Redirect(ctx, w, r, urlAccountProductsView(req.ID), http.StatusFound)
+		}
+
+		return false, nil
+	}
+
+	end, err := f()
+	if err != nil {
+		return web.RenderError(ctx, w, r, err, h.Renderer, TmplLayoutBase, TmplContentErrorGeneric, web.MIMETextHTMLCharsetUTF8)
+	} else if end {
+		return nil
+	}
+
+	prj, err := h.Repo.ReadByID(ctx, claims, productID)
+	if err != nil {
+		return err
+	}
+	data["product"] = prj.Response(ctx)
+
+	data["urlAccountProductsIndex"] = urlAccountProductsIndex()
+	data["urlAccountProductsView"] = urlAccountProductsView(productID)
+
+	if req.ID == "" {
+		req.Name = &prj.Name
+		req.Description = &prj.Description
+		req.TargetRequired = &prj.TargetRequired
+		req.DailyContribution = &prj.DailyContribution
+		req.MaxDaysPerPayment = &prj.MaxDaysPerPayment
+		req.MinDeposit = &prj.MinDeposit
+		req.CycleDays = &prj.CycleDays
+		req.FirstContributionFee = &prj.FirstContributionFee
+		req.DepositSMS = &prj.DepositSMS
+		req.WithdrawalsAllowed = &prj.WithdrawalsAllowed
+		req.MinBalance = &prj.MinBalance
+		req.ApprovalThreshold = &prj.ApprovalThreshold
+		req.CommissionPolicy = &prj.CommissionPolicy
+		req.InterestRateBPS = &prj.InterestRateBPS
+		req.TenorDays = &prj.TenorDays
+		req.EarlyWithdrawalPenaltyBPS = &prj.EarlyWithdrawalPenaltyBPS
+	}
+	data["form"] = req
+
+	if verr, ok := weberror.NewValidationError(ctx, webcontext.Validator().Struct(account_product.UpdateRequest{})); ok {
+		data["validationDefaults"] = verr.(*weberror.Error)
+	}
+
+	return h.Renderer.Render(ctx, w, r, TmplLayoutBase, "account-products-update.gohtml", web.MIMETextHTMLCharsetUTF8, http.StatusOK, data)
+}
+
+// checkCommissionPolicy flashes an error when the DS commission policy entered on a product or
+// branch form cannot be read.
+func checkCommissionPolicy(ctx context.Context, policy string) bool {
+	if _, err := dscommission.ParsePolicy(policy); err != nil {
+		webcontext.SessionFlashError(ctx, "Invalid Commission Policy", err.Error())
+		return false
+	}
+	return true
+}
@@ -10,8 +10,11 @@ import (
 	"time"
 
 	"merryworld/surebank/internal/accounting"
+	"merryworld/surebank/internal/integrity"
+	"merryworld/surebank/internal/ledger"
 	"merryworld/surebank/internal/platform/auth"
 	"merryworld/surebank/internal/platform/datatable"
+	"merryworld/surebank/internal/platform/money"
 	"merryworld/surebank/internal/platform/web"
 	"merryworld/surebank/internal/platform/web/webcontext"
 	"merryworld/surebank/internal/platform/web/weberror"
@@ -31,10 +34,12 @@ import (
 
 // Accounting represents the Accounting API method handler set.
 type Accounting struct {
-	Redis    *redis.Client
-	Renderer web.Renderer
-	DbConn   *sql.DB
-	UserRepos *user.Repository
+	Redis         *redis.Client
+	Renderer      web.Renderer
+	DbConn        *sql.DB
+	UserRepos     *user.Repository
+	LedgerRepo    *ledger.Repository
+	IntegrityRepo *integrity.Repository
 }
 
 // DailySummaries handles listing all the daily summaries.
@@ -59,16 +64,16 @@ func (h *Accounting) DailySummaries(ctx context.Context, w http.ResponseWriter,
 				v.Value = dt.LocalDate
 				v.Formatted = v.Value
 			case "income":
-				v.Value = fmt.Sprintf("%.2f", q.Income)
+				v.Value = money.Amount(q.Income).String()
 				v.Formatted = v.Value
 			case "bank_deposit":
-				v.Value = fmt.Sprintf("%.2f", q.BankDeposit)
+				v.Value = money.Amount(q.BankDeposit).String()
 				v.Formatted = fmt.Sprintf("<a href='/accounting/deposits?date=%d'>%s</a>", q.Date, v.Value)
 			case "expenditure":
-				v.Value = fmt.Sprintf("%.2f", q.Expenditure)
+				v.Value = money.Amount(q.Expenditure).String()
 				v.Formatted = fmt.Sprintf("<a href='/accounting/expenditures?date=%d'>%s</a>", q.Date, v.Value)
 			case "balance":
-				v.Value = fmt.Sprintf("%.2f", q.Income-(q.BankDeposit+q.Expenditure))
+				v.Value = money.Amount(q.Income - (q.BankDeposit + q.Expenditure)).String()
 				v.Formatted = v.Value
 			default:
 				return resp, errors.Errorf("Failed to map value for %s.", col.Field)
@@ -183,28 +188,22 @@ func (h *Accounting) RepsSummaries(ctx context.Context, w http.ResponseWriter, r
 				v.Value = q.SalesRep
 				v.Formatted = fmt.Sprintf("<a href='%s'>%s</a>", urlUsersView(q.SalesRepID), v.Value)
 			case "income":
-				var amount float64
-				if q.Income.Valid {
-					amount = q.Income.Float64
-				}
-				v.Value = fmt.Sprintf("%f", amount)
+				amount := money.Amount(q.Income.Int64)
+				v.Value = amount.String()
 				p := message.NewPrinter(language.English)
 				url := fmt.Sprintf(incomeUrlTemplate, q.SalesRepID, startDate, endDate)
-				v.Formatted = fmt.Sprintf("<a href='%s'>%s</a>", url, p.Sprintf("%.2f", amount))
+				v.Formatted = fmt.Sprintf("<a href='%s'>%s</a>", url, p.Sprintf("%.2f", amount.Float()))
 			case "expenditure":
-				var amount float64
-				if q.Expenditure.Valid {
-					amount = q.Expenditure.Float64
-				}
-				v.Value = fmt.Sprintf("%f", amount)
+				amount := money.Amount(q.Expenditure.Int64)
+				v.Value = amount.String()
 				p := message.NewPrinter(language.English)
 				url := fmt.Sprintf(expenditureUrlTemplate, q.SalesRepID, startDate, endDate)
-				v.Formatted = fmt.Sprintf("<a href='%s'>%s</a>", url, p.Sprintf("%.2f", amount))
+				v.Formatted = fmt.Sprintf("<a href='%s'>%s</a>", url, p.Sprintf("%.2f", amount.Float()))
 			case "balance":
-				balance := q.Income.Float64 - q.Expenditure.Float64
-				v.Value = fmt.Sprintf("%f", balance)
+				balance := money.Amount(q.Income.Int64 - q.Expenditure.Int64)
+				v.Value = balance.String()
 				p := message.NewPrinter(language.English)
-				v.Formatted = p.Sprintf("%.2f", balance)
+				v.Formatted = p.Sprintf("%.2f", balance.Float())
 			default:
 				return resp, errors.Errorf("Failed to map value for %s.", col.Field)
 			}
@@ -437,10 +436,10 @@ func (h *Accounting) BankDeposits(ctx context.Context, w http.ResponseWriter,
 				v.Value = bankInfo
 				v.Formatted = v.Value
 			case "amount":
-				v.Value = fmt.Sprintf("%.2f", q.Amount)
+				v.Value = money.Amount(q.Amount).String()
 				v.Formatted = v.Value
 			case "info":
-				v.Value = fmt.Sprintf("%.2f", q.Amount)
+				v.Value = money.Amount(q.Amount).String()
 				v.Formatted = v.Value
 			case "date":
 				dt := web.NewTimeResponse(ctx, time.Unix(q.Date, 0))
@@ -529,6 +528,11 @@ func (h *Accounting) CreateBankDeposit(ctx context.Context, w http.ResponseWrite
 		return err
 	}
 
+	claims, err := auth.ClaimsFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
 	//
 	var req accounting.CreateBankDeposit
 	if err := web.Decode(ctx, r, &req); err != nil {
@@ -542,7 +546,7 @@ func (h *Accounting) CreateBankDeposit(ctx context.Context, w http.ResponseWrite
 	id, _ := uuid.NewV4()
 	model := models.BankDeposit{
 		ID:            id.String(),
-		Amount:        req.Amount,
+		Amount:        req.Amount.Kobo(),
 		BankAccountID: req.BankID,
 		Date:          today.Unix(),
 	}
@@ -557,6 +561,19 @@ func (h *Accounting) CreateBankDeposit(ctx context.Context, w http.ResponseWrite
 		return web.RespondJsonError(ctx, w, weberror.NewError(ctx, err, http.StatusBadRequest))
 	}
 
+	if _, err = h.LedgerRepo.PostTx(ctx, claims, ledger.PostRequest{
+		Narration:  "Bank lodgement",
+		SourceType: ledger.SourceBankDeposit,
+		SourceID:   model.ID,
+		Lines: []ledger.Line{
+			ledger.Debit(ledger.AccountBank, req.Amount),
+			ledger.Credit(ledger.AccountCash, req.Amount),
+		},
+	}, ctxValues.Now, tx); err != nil {
+		tx.Rollback()
+		return web.RespondJsonError(ctx, w, weberror.NewError(ctx, err, http.StatusBadRequest))
+	}
+
 	if err = transaction.SaveDailySummary(ctx, 0, 0, req.Amount, ctxValues.Now, tx); err != nil {
 		tx.Rollback()
 		return err
@@ -588,7 +605,7 @@ func (h *Accounting) Expenditures(ctx context.Context, w http.ResponseWriter,
 			case "id":
 				v.Value = fmt.Sprintf("%s", q.ID)
 			case "amount":
-				v.Value = fmt.Sprintf("%.2f", q.Amount)
+				v.Value = money.Amount(q.Amount).String()
 				v.Formatted = v.Value
 			case "memo":
 				v.Value = q.Reason
@@ -672,10 +689,10 @@ func (h *Accounting) CreateExpenditure(ctx context.Context, w http.ResponseWrite
 		return err
 	}
 
-	// claims, err := auth.ClaimsFromContext(ctx)
-	// if err != nil {
-	// 	return err
-	// }
+	claims, err := auth.ClaimsFromContext(ctx)
+	if err != nil {
+		return err
+	}
 
 	//
 	var req accounting.CreateExpenditure
@@ -693,7 +710,7 @@ func (h *Accounting) CreateExpenditure(ctx context.Context, w http.ResponseWrite
 	id, _ := uuid.NewV4()
 	model := models.Expenditure{
 		ID:     id.String(),
-		Amount: req.Amount,
+		Amount: req.Amount.Kobo(),
 		Reason: req.Memo,
 		Date:   ctxValues.Now.Unix(),
 	}
@@ -703,6 +720,19 @@ func (h *Accounting) CreateExpenditure(ctx context.Context, w http.ResponseWrite
 		return web.RespondJsonError(ctx, w, weberror.NewError(ctx, err, http.StatusBadRequest))
 	}
 
+	if _, err = h.LedgerRepo.PostTx(ctx, claims, ledger.PostRequest{
+		Narration:  req.Memo,
+		SourceType: ledger.SourceExpenditure,
+		SourceID:   model.ID,
+		Lines: []ledger.Line{
+			ledger.Debit(ledger.AccountOperatingExpenses, req.Amount),
+			ledger.Credit(ledger.AccountCash, req.Amount),
+		},
+	}, ctxValues.Now, tx); err != nil {
+		tx.Rollback()
+		return web.RespondJsonError(ctx, w, weberror.NewError(ctx, err, http.StatusBadRequest))
+	}
+
 	if err = transaction.SaveDailySummary(ctx, 0, req.Amount, 0, ctxValues.Now, tx); err != nil {
 		tx.Rollback()
 		return err
@@ -714,3 +744,50 @@ func (h *Accounting) CreateExpenditure(ctx context.Context, w http.ResponseWrite
 
 	return web.RespondJson(ctx, w, model, http.StatusCreated)
 }
+
+// TrialBalance handles displaying the trial balance of the general ledger.
+func (h *Accounting) TrialBalance(ctx context.Context, w http.ResponseWriter, r *http.Request, params map[string]string) error {
+
+	claims, err := auth.ClaimsFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	data := map[string]interface{}{}
+
+	asOf := time.Now()
+	if v := r.URL.Query().Get("date"); v != "" {
+		date, err := time.Parse("01/02/2006", v)
+		if err != nil {
+			return weberror.NewError(ctx, err, http.StatusBadRequest)
+		}
+		asOf = now.New(date).EndOfDay()
+		data["date"] = v
+	} else {
+		data["date"] = asOf.Format("01/02/2006")
+	}
+
+	tb, err := h.LedgerRepo.TrialBalance(ctx, claims, asOf)
+	if err != nil {
+		return err
+	}
+	data["trialBalance"] = tb.Response(ctx)
+
+	return h.Renderer.Render(ctx, w, r, TmplLayoutBase, "accounting-trial-balance.gohtml", web.MIMETextHTMLCharsetUTF8, http.StatusOK, data)
+}
+
+// Integrity handles displaying the records whose cached balances drifted from the transactions and
+// stock movements they are built from. Repairs are left to the schema tool.
+func (h *Accounting) Integrity(ctx context.Context, w http.ResponseWriter, r *http.Request, params map[string]string) error {
+
+	report, err := h.IntegrityRepo.Check(ctx, integrity.CheckRequest{}, time.Now())
+	if err != nil {
+		return err
+	}
+
+	data := map[string]interface{}{
+		"report": report.Response(ctx),
+	}
+
+	return h.Renderer.Render(ctx, w, r, TmplLayoutBase, "accounting-integrity.gohtml", web.MIMETextHTMLCharsetUTF8, http.StatusOK, data)
+}
@@ -0,0 +1,172 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"merryworld/surebank/internal/platform/auth"
+	"merryworld/surebank/internal/platform/web"
+	"merryworld/surebank/internal/platform/web/webcontext"
+	"merryworld/surebank/internal/platform/web/weberror"
+	"merryworld/surebank/internal/transaction"
+
+	"github.com/gorilla/schema"
+	"gopkg.in/DataDog/dd-trace-go.v1/contrib/go-redis/redis"
+)
+
+// Approvals represents the approvals queue handler set.
+type Approvals struct {
+	TransactionRepo *transaction.Repository
+	Redis           *redis.Client
+	Renderer        web.Renderer
+}
+
+func urlApprovalsIndex() string {
+	return "/approvals"
+}
+
+func urlApprovalsApprove(approvalID string) string {
+	return fmt.Sprintf("/approvals/%s/approve", approvalID)
+}
+
+func urlApprovalsReject(approvalID string) string {
+	return fmt.Sprintf("/approvals/%s/reject", approvalID)
+}
+
+// Index lists the withdrawals and transfers waiting for approval at the branch of the user,
+// followed by the ones decided most recently.
+func (h *Approvals) Index(ctx context.Context, w http.ResponseWriter, r *http.Request, params map[string]string) error {
+
+	claims, err := auth.ClaimsFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	branchWhere := "approval.branch_id = (select branch_id from users where id = ?)"
+
+	pending, err := h.TransactionRepo.FindApprovals(ctx, claims, transaction.ApprovalFindRequest{
+		Where: branchWhere + " and approval.status = ?",
+		Args:  []interface{}{claims.Subject, transaction.ApprovalStatus_Pending},
+		Order: []string{"approval.created_at"},
+	})
+	if err != nil {
+		return err
+	}
+
+	limit := uint(20)
+	decided, err := h.TransactionRepo.FindApprovals(ctx, claims, transaction.ApprovalFindRequest{
+		Where: branchWhere + " and approval.status != ?",
+		Args:  []interface{}{claims.Subject, transaction.ApprovalStatus_Pending},
+		Order: []string{"approval.decided_at desc"},
+		Limit: &limit,
+	})
+	if err != nil {
+		return err
+	}
+
+	type row struct {
+		*transaction.ApprovalResponse
+		URLApprove string
+		URLReject  string
+		CanDecide  bool
+	}
+
+	var pendingRows []row
+	for _, a := range pending.Response(ctx) {
+		pendingRows = append(pendingRows, row{
+			ApprovalResponse: a,
+			URLApprove:       urlApprovalsApprove(a.ID),
+			URLReject:        urlApprovalsReject(a.ID),
+			CanDecide:        a.RequestedByID != claims.Subject,
+		})
+	}
+
+	data := map[string]interface{}{
+		"pending": pendingRows,
+		"decided": decided.Response(ctx),
+	}
+
+	return h.Renderer.Render(ctx, w, r, TmplLayoutBase, "approvals-index.gohtml", web.MIMETextHTMLCharsetUTF8, http.StatusOK, data)
+}
+
+// Approve posts the withdrawal or transfer held by the approval.
+func (h *Approvals) Approve(ctx context.Context, w http.ResponseWriter, r *http.Request, params map[string]string) error {
+
+	ctxValues, err := webcontext.ContextValues(ctx)
+	if err != nil {
+		return err
+	}
+
+	claims, err := auth.ClaimsFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	approval, err := h.TransactionRepo.Approve(ctx, claims, transaction.ApproveRequest{ID: params["approval_id"]}, ctxValues.Now)
+	if err != nil {
+		return h.decisionFailed(ctx, w, r, err)
+	}
+
+	webcontext.SessionFlashSuccess(ctx,
+		"Request Approved",
+		fmt.Sprintf("The %s of %s has been posted.", approval.Kind, approval.Amount))
+
+	return web.Redirect(ctx, w, r, urlApprovalsIndex(), http.StatusFound)
+}
+
+// Reject declines the withdrawal or transfer held by the approval with the reason entered.
+func (h *Approvals) Reject(ctx context.Context, w http.ResponseWriter, r *http.Request, params map[string]string) error {
+
+	ctxValues, err := webcontext.ContextValues(ctx)
+	if err != nil {
+		return err
+	}
+
+	claims, err := auth.ClaimsFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err := r.ParseForm(); err != nil {
+		return err
+	}
+
+	req := new(transaction.RejectRequest)
+	decoder := schema.NewDecoder()
+	decoder.IgnoreUnknownKeys(true)
+	if err := decoder.Decode(req, r.PostForm); err != nil {
+		return err
+	}
+	req.ID = params["approval_id"]
+
+	if err = h.TransactionRepo.Reject(ctx, claims, *req, ctxValues.Now); err != nil {
+		return h.decisionFailed(ctx, w, r, err)
+	}
+
+	webcontext.SessionFlashSuccess(ctx,
+		"Request Rejected",
+		"The request has been rejected and nothing was posted.")
+
+	return web.Redirect(ctx, w, r, urlApprovalsIndex(), http.StatusFound)
+}
+
+// decisionFailed shows why an approval could not be decided on the queue page.
+func (h *Approvals) decisionFailed(ctx context.Context, w http.ResponseWriter, r *http.Request, err error) error {
+	werr, ok := weberror.NewError(ctx, err, 0).(*weberror.Error)
+	if !ok || werr.Status >= http.StatusInternalServerError {
+		return err
+	}
+
+	msg := werr.Message
+	if msg == "" {
+		msg = werr.Error()
+	}
+	var items []string
+	for _, f := range werr.Fields {
+		items = append(items, f.Display)
+	}
+
+	webcontext.SessionFlashError(ctx, "Request Not Decided", msg, items...)
+	return web.Redirect(ctx, w, r, urlApprovalsIndex(), http.StatusFound)
+}
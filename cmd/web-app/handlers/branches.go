@@ -163,6 +163,10 @@ func (h *Branches) Create(ctx context.Context, w http.ResponseWriter, r *http.Re
 				return false, err
 			}
 
+			if !checkCommissionPolicy(ctx, req.CommissionPolicy) {
+				return false, nil
+			}
+
 			usr, err := h.Repo.Create(ctx, claims, *req, ctxValues.Now)
 			if err != nil {
 				switch errors.Cause(err) {
@@ -337,6 +341,10 @@ func (h *Branches) Update(ctx context.Context, w http.ResponseWriter, r *http.Re
 			}
 			req.ID = branchID
 
+			if req.CommissionPolicy != nil && !checkCommissionPolicy(ctx, *req.CommissionPolicy) {
+				return false, nil
+			}
+
 			err = h.Repo.Update(ctx, claims, *req, ctxValues.Now)
 			if err != nil {
 				switch errors.Cause(err) {
@@ -378,6 +386,8 @@ func (h *Branches) Update(ctx context.Context, w http.ResponseWriter, r *http.Re
 
 	if req.ID == "" {
 		req.Name = &prj.Name
+		req.ApprovalThreshold = &prj.ApprovalThreshold
+		req.CommissionPolicy = &prj.CommissionPolicy
 	}
 	data["form"] = req
 
@@ -0,0 +1,319 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+
+	"merryworld/surebank/internal/account_product"
+	"merryworld/surebank/internal/customer_import"
+	"merryworld/surebank/internal/platform/auth"
+	"merryworld/surebank/internal/platform/web"
+	"merryworld/surebank/internal/platform/web/webcontext"
+	"merryworld/surebank/internal/platform/web/weberror"
+	"merryworld/surebank/internal/user"
+)
+
+// importMaxUploadSize is the largest spreadsheet that can be uploaded to import customers.
+const importMaxUploadSize = 10 << 20
+
+// importPreviewRows is the number of rows shown to help map the columns of a spreadsheet.
+const importPreviewRows = 5
+
+// CustomerImports represents the bulk customer import handler set.
+type CustomerImports struct {
+	ImportRepo         *customer_import.Repository
+	UserRepo           *user.Repository
+	AccountProductRepo *account_product.Repository
+	Renderer           web.Renderer
+}
+
+func urlCustomerImportsIndex() string {
+	return "/customers/import"
+}
+
+func urlCustomerImportsView(jobID string) string {
+	return fmt.Sprintf("/customers/import/%s", jobID)
+}
+
+func urlCustomerImportsValidate(jobID string) string {
+	return fmt.Sprintf("/customers/import/%s/validate", jobID)
+}
+
+func urlCustomerImportsCommit(jobID string) string {
+	return fmt.Sprintf("/customers/import/%s/commit", jobID)
+}
+
+// Index lists the recent imports and handles uploading a spreadsheet of customers to import.
+func (h *CustomerImports) Index(ctx context.Context, w http.ResponseWriter, r *http.Request, params map[string]string) error {
+
+	ctxValues, err := webcontext.ContextValues(ctx)
+	if err != nil {
+		return err
+	}
+
+	claims, err := auth.ClaimsFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	req := new(customer_import.UploadRequest)
+	data := make(map[string]interface{})
+	f := func() (bool, error) {
+		if r.Method == http.MethodPost {
+			if err := r.ParseMultipartForm(importMaxUploadSize); err != nil {
+				return false, weberror.NewErrorMessage(ctx, err, http.StatusBadRequest, "The spreadsheet is too large")
+			}
+
+			req.SalesRepID = r.PostForm.Get("SalesRepID")
+
+			file, header, err := r.FormFile("File")
+			if err != nil && err != http.ErrMissingFile {
+				return false, err
+			} else if err == nil {
+				req.FileName = header.Filename
+				req.Data, err = ioutil.ReadAll(file)
+				file.Close()
+				if err != nil {
+					return false, err
+				}
+			}
+
+			job, err := h.ImportRepo.Upload(ctx, claims, *req, ctxValues.Now)
+			if err != nil {
+				if verr, ok := weberror.NewValidationError(ctx, err); ok {
+					data["validationErrors"] = verr.(*weberror.Error)
+					return false, nil
+				}
+
+				werr, ok := weberror.NewError(ctx, err, 0).(*weberror.Error)
+				if !ok || werr.Status >= http.StatusInternalServerError {
+					return false, err
+				}
+
+				webcontext.SessionFlashError(ctx, "Spreadsheet Not Uploaded", werr.Error())
+				return true, web.Redirect(ctx, w, r, urlCustomerImportsIndex(), http.StatusFound)
+			}
+
+			webcontext.SessionFlashSuccess(ctx,
+				"Spreadsheet Uploaded",
+				fmt.Sprintf("%d rows were read from %s. Check the columns they are mapped to and run a dry run.",
+					job.TotalRows, job.FileName))
+
+			return true, web.Redirect(ctx, w, r, urlCustomerImportsView(job.ID), http.StatusFound)
+		}
+
+		return false, nil
+	}
+
+	end, err := f()
+	if err != nil {
+		return web.RenderError(ctx, w, r, err, h.Renderer, TmplLayoutBase, TmplContentErrorGeneric, web.MIMETextHTMLCharsetUTF8)
+	} else if end {
+		return nil
+	}
+
+	jobs, err := h.ImportRepo.Find(ctx, claims, 50)
+	if err != nil {
+		return err
+	}
+
+	users, err := h.UserRepo.Find(ctx, claims, user.UserFindRequest{
+		Order: []string{"first_name", "last_name"},
+	})
+	if err != nil {
+		return err
+	}
+
+	type jobRow struct {
+		*customer_import.JobResponse
+		URLView string
+	}
+	var jobRows []jobRow
+	for _, j := range jobs.Response(ctx) {
+		jobRows = append(jobRows, jobRow{JobResponse: j, URLView: urlCustomerImportsView(j.ID)})
+	}
+
+	data["jobs"] = jobRows
+	data["users"] = users
+	data["form"] = req
+	data["maxRows"] = customer_import.MaxRows
+	data["urlCustomerImportsIndex"] = urlCustomerImportsIndex()
+	data["urlCustomersIndex"] = urlCustomersIndex()
+
+	if verr, ok := weberror.NewValidationError(ctx, webcontext.Validator().Struct(customer_import.UploadRequest{})); ok {
+		data["validationDefaults"] = verr.(*weberror.Error)
+	}
+
+	return h.Renderer.Render(ctx, w, r, TmplLayoutBase, "customers-import-index.gohtml", web.MIMETextHTMLCharsetUTF8, http.StatusOK, data)
+}
+
+// View shows an import with the mapping of its columns and the report of its last dry run.
+func (h *CustomerImports) View(ctx context.Context, w http.ResponseWriter, r *http.Request, params map[string]string) error {
+
+	claims, err := auth.ClaimsFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	job, err := h.ImportRepo.Read(ctx, claims, params["job_id"])
+	if err != nil {
+		return err
+	}
+
+	rows, err := h.ImportRepo.FindRows(ctx, claims, job.ID)
+	if err != nil {
+		return err
+	}
+
+	var preview, problems customer_import.Rows
+	for _, row := range rows {
+		if len(preview) < importPreviewRows {
+			preview = append(preview, row)
+		}
+		if row.Status == customer_import.RowStatus_Invalid || row.Status == customer_import.RowStatus_Failed {
+			problems = append(problems, row)
+		}
+	}
+
+	type fieldRow struct {
+		Field  string
+		Name   string
+		Column int
+	}
+	var fields []fieldRow
+	for _, f := range customer_import.Fields {
+		col, ok := job.Mapping[f]
+		if !ok {
+			col = -1
+		}
+		fields = append(fields, fieldRow{Field: f, Name: customer_import.FieldNames[f], Column: col})
+	}
+
+	products, err := h.AccountProductRepo.Find(ctx, claims, account_product.FindRequest{})
+	if err != nil {
+		return err
+	}
+
+	data := map[string]interface{}{
+		"job":                        job.Response(ctx),
+		"fields":                     fields,
+		"preview":                    preview,
+		"problems":                   problems,
+		"accountProducts":            products.Response(ctx),
+		"canValidate":                job.Status != customer_import.Status_Committing,
+		"canCommit":                  job.Status == customer_import.Status_Validated || job.Status == customer_import.Status_Committing,
+		"resume":                     job.Status == customer_import.Status_Committing,
+		"urlCustomerImportsIndex":    urlCustomerImportsIndex(),
+		"urlCustomerImportsValidate": urlCustomerImportsValidate(job.ID),
+		"urlCustomerImportsCommit":   urlCustomerImportsCommit(job.ID),
+	}
+
+	return h.Renderer.Render(ctx, w, r, TmplLayoutBase, "customers-import-view.gohtml", web.MIMETextHTMLCharsetUTF8, http.StatusOK, data)
+}
+
+// Validate runs a dry run of an import with the mapping of its columns.
+func (h *CustomerImports) Validate(ctx context.Context, w http.ResponseWriter, r *http.Request, params map[string]string) error {
+
+	ctxValues, err := webcontext.ContextValues(ctx)
+	if err != nil {
+		return err
+	}
+
+	claims, err := auth.ClaimsFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	jobID := params["job_id"]
+
+	if err := r.ParseForm(); err != nil {
+		return err
+	}
+
+	req := customer_import.ValidateRequest{
+		ID:               jobID,
+		Mapping:          make(customer_import.Mapping),
+		AccountType:      r.PostForm.Get("AccountType"),
+		IgnoreDuplicates: r.PostForm.Get("IgnoreDuplicates") == "true",
+	}
+	for _, f := range customer_import.Fields {
+		v := r.PostForm.Get("Mapping." + f)
+		if v == "" {
+			continue
+		}
+		col, err := strconv.Atoi(v)
+		if err != nil {
+			return weberror.NewErrorMessage(ctx, err, http.StatusBadRequest, "Invalid column")
+		}
+		req.Mapping[f] = col
+	}
+
+	redirect := urlCustomerImportsView(jobID)
+
+	job, err := h.ImportRepo.Validate(ctx, claims, req, ctxValues.Now)
+	if err != nil {
+		if verr, ok := weberror.NewValidationError(ctx, err); ok {
+			webcontext.SessionFlashError(ctx, "Dry Run Failed", verr.Error())
+			return web.Redirect(ctx, w, r, redirect, http.StatusFound)
+		}
+
+		werr, ok := weberror.NewError(ctx, err, 0).(*weberror.Error)
+		if !ok || werr.Status >= http.StatusInternalServerError {
+			return err
+		}
+
+		webcontext.SessionFlashError(ctx, "Dry Run Failed", werr.Error())
+		return web.Redirect(ctx, w, r, redirect, http.StatusFound)
+	}
+
+	webcontext.SessionFlashSuccess(ctx,
+		"Dry Run Complete",
+		fmt.Sprintf("%d rows can be imported and %d rows have problems. Nothing has been imported yet.",
+			job.ValidRows, job.InvalidRows+job.FailedRows))
+
+	return web.Redirect(ctx, w, r, redirect+"#report", http.StatusFound)
+}
+
+// Commit imports the valid rows of an import, or resumes an import that was interrupted.
+func (h *CustomerImports) Commit(ctx context.Context, w http.ResponseWriter, r *http.Request, params map[string]string) error {
+
+	ctxValues, err := webcontext.ContextValues(ctx)
+	if err != nil {
+		return err
+	}
+
+	claims, err := auth.ClaimsFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	jobID := params["job_id"]
+	redirect := urlCustomerImportsView(jobID)
+
+	job, err := h.ImportRepo.Commit(ctx, claims, customer_import.CommitRequest{ID: jobID}, ctxValues.Now)
+	if err != nil {
+		werr, ok := weberror.NewError(ctx, err, 0).(*weberror.Error)
+		if !ok || werr.Status >= http.StatusInternalServerError {
+			return err
+		}
+
+		webcontext.SessionFlashError(ctx, "Import Failed", werr.Error())
+		return web.Redirect(ctx, w, r, redirect, http.StatusFound)
+	}
+
+	if job.FailedRows > 0 {
+		webcontext.SessionFlashWarning(ctx,
+			"Import Complete",
+			fmt.Sprintf("%d rows were imported and %d rows failed. Fix what they failed on and run a dry run to import them.",
+				job.ImportedRows, job.FailedRows))
+	} else {
+		webcontext.SessionFlashSuccess(ctx,
+			"Import Complete",
+			fmt.Sprintf("%d rows were imported.", job.ImportedRows))
+	}
+
+	return web.Redirect(ctx, w, r, redirect+"#report", http.StatusFound)
+}
@@ -0,0 +1,247 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"merryworld/surebank/internal/customer"
+	"merryworld/surebank/internal/platform/auth"
+	"merryworld/surebank/internal/platform/web"
+	"merryworld/surebank/internal/platform/web/webcontext"
+	"merryworld/surebank/internal/platform/web/weberror"
+
+	"github.com/gorilla/schema"
+)
+
+// kycMaxUploadSize is the most the documents uploaded with the KYC form can add up to.
+const kycMaxUploadSize = 20 << 20
+
+func urlCustomersKYC(customerID string) string {
+	return fmt.Sprintf("/customers/%s/kyc", customerID)
+}
+
+// KYC handles capturing the KYC details and documents of a customer.
+func (h *Customers) KYC(ctx context.Context, w http.ResponseWriter, r *http.Request, params map[string]string) error {
+
+	ctxValues, err := webcontext.ContextValues(ctx)
+	if err != nil {
+		return err
+	}
+
+	customerID := params["customer_id"]
+
+	claims, err := auth.ClaimsFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	req := new(customer.KYCRequest)
+	data := make(map[string]interface{})
+	f := func() (bool, error) {
+		if r.Method == http.MethodPost {
+			if err := r.ParseMultipartForm(kycMaxUploadSize); err != nil {
+				return false, weberror.NewErrorMessage(ctx, err, http.StatusBadRequest, "The documents are too large")
+			}
+
+			// The date is not decoded into the request as it is posted in the format of the date picker.
+			dob := r.PostForm.Get("DateOfBirth")
+			r.PostForm.Del("DateOfBirth")
+			data["dateOfBirth"] = dob
+
+			decoder := schema.NewDecoder()
+			decoder.IgnoreUnknownKeys(true)
+
+			if err := decoder.Decode(req, r.PostForm); err != nil {
+				return false, err
+			}
+			req.ID = customerID
+
+			if dob != "" {
+				t, err := time.Parse("01/02/2006", dob)
+				if err != nil {
+					return false, weberror.NewErrorMessage(ctx, err, http.StatusBadRequest, "Invalid date of birth")
+				}
+				req.DateOfBirth = &t
+			}
+
+			err = h.CustomerRepo.UpdateKYC(ctx, claims, *req, ctxValues.Now)
+			if err != nil {
+				if verr, ok := weberror.NewValidationError(ctx, err); ok {
+					data["validationErrors"] = verr.(*weberror.Error)
+					return false, nil
+				}
+				return false, err
+			}
+
+			for _, kind := range customer.DocumentKinds {
+				file, _, err := r.FormFile(kind)
+				if err == http.ErrMissingFile {
+					continue
+				} else if err != nil {
+					return false, err
+				}
+				dat, err := ioutil.ReadAll(file)
+				file.Close()
+				if err != nil {
+					return false, err
+				}
+
+				_, err = h.CustomerRepo.UploadDocument(ctx, claims, customer.DocumentUploadRequest{
+					CustomerID: customerID,
+					Kind:       kind,
+					Data:       dat,
+				}, ctxValues.Now)
+				if err != nil {
+					werr, ok := weberror.NewError(ctx, err, 0).(*weberror.Error)
+					if !ok || werr.Status >= http.StatusInternalServerError {
+						return false, err
+					}
+
+					webcontext.SessionFlashError(ctx,
+						"Document Not Uploaded",
+						fmt.Sprintf("%s: %s", customer.DocumentKindNames[kind], werr.Error()))
+					return true, web.Redirect(ctx, w, r, urlCustomersKYC(customerID), http.StatusFound)
+				}
+			}
+
+			webcontext.SessionFlashSuccess(ctx,
+				"KYC Updated",
+				"The customer's KYC records are awaiting verification.")
+
+			return true, web.Redirect(ctx, w, r, urlCustomersView(customerID)+"#kyc", http.StatusFound)
+		}
+
+		return false, nil
+	}
+
+	end, err := f()
+	if err != nil {
+		return web.RenderError(ctx, w, r, err, h.Renderer, TmplLayoutBase, TmplContentErrorGeneric, web.MIMETextHTMLCharsetUTF8)
+	} else if end {
+		return nil
+	}
+
+	cust, err := h.CustomerRepo.ReadByID(ctx, claims, customerID)
+	if err != nil {
+		return err
+	}
+
+	docs, err := h.CustomerRepo.FindDocuments(ctx, claims, customerID)
+	if err != nil {
+		return err
+	}
+
+	if req.ID == "" {
+		req.Gender = cust.Gender
+		req.IDType = cust.IDType
+		req.IDNumber = cust.IDNumber
+		req.BVN = cust.BVN
+		req.NIN = cust.NIN
+		req.Occupation = cust.Occupation
+		req.NextOfKinName = cust.NextOfKinName
+		req.NextOfKinPhone = cust.NextOfKinPhone
+		req.NextOfKinRelationship = cust.NextOfKinRelationship
+		if cust.DateOfBirth != nil {
+			data["dateOfBirth"] = cust.DateOfBirth.UTC().Format("01/02/2006")
+		}
+	}
+
+	data["customer"] = cust.Response(ctx)
+	data["documents"] = docs.Response(ctx)
+	data["form"] = req
+	data["genders"] = customer.Genders
+	data["idTypes"] = customer.IDTypes
+	data["idTypeNames"] = customer.IDTypeNames
+	data["documentKinds"] = customer.DocumentKinds
+	data["documentKindNames"] = customer.DocumentKindNames
+	data["urlCustomersIndex"] = urlCustomersIndex()
+	data["urlCustomersView"] = urlCustomersView(customerID)
+
+	if verr, ok := weberror.NewValidationError(ctx, webcontext.Validator().Struct(customer.KYCRequest{})); ok {
+		data["validationDefaults"] = verr.(*weberror.Error)
+	}
+
+	return h.Renderer.Render(ctx, w, r, TmplLayoutBase, "customers-kyc.gohtml", web.MIMETextHTMLCharsetUTF8, http.StatusOK, data)
+}
+
+// KYCVerify verifies the KYC records of a customer for a tier or rejects them.
+func (h *Customers) KYCVerify(ctx context.Context, w http.ResponseWriter, r *http.Request, params map[string]string) error {
+
+	ctxValues, err := webcontext.ContextValues(ctx)
+	if err != nil {
+		return err
+	}
+
+	claims, err := auth.ClaimsFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	customerID := params["customer_id"]
+
+	if err := r.ParseForm(); err != nil {
+		return err
+	}
+
+	redirect := urlCustomersView(customerID) + "#kyc"
+
+	req := customer.VerifyKYCRequest{
+		ID:     customerID,
+		Status: r.PostForm.Get("Status"),
+		Note:   strings.TrimSpace(r.PostForm.Get("Note")),
+	}
+	if v := r.PostForm.Get("Tier"); v != "" && req.Status == customer.KYCStatus_Verified {
+		if req.Tier, err = strconv.Atoi(v); err != nil {
+			return weberror.NewErrorMessage(ctx, err, http.StatusBadRequest, "Invalid tier")
+		}
+	}
+
+	if err = h.CustomerRepo.VerifyKYC(ctx, claims, req, ctxValues.Now); err != nil {
+		if verr, ok := weberror.NewValidationError(ctx, err); ok {
+			webcontext.SessionFlashError(ctx, "KYC Not Updated", verr.Error())
+			return web.Redirect(ctx, w, r, redirect, http.StatusFound)
+		}
+
+		werr, ok := weberror.NewError(ctx, err, 0).(*weberror.Error)
+		if !ok || werr.Status >= http.StatusInternalServerError {
+			return err
+		}
+
+		webcontext.SessionFlashError(ctx, "KYC Not Updated", werr.Error())
+		return web.Redirect(ctx, w, r, redirect, http.StatusFound)
+	}
+
+	if req.Status == customer.KYCStatus_Verified {
+		webcontext.SessionFlashSuccess(ctx,
+			"KYC Verified",
+			fmt.Sprintf("The customer is now on tier %d.", req.Tier))
+	} else {
+		webcontext.SessionFlashSuccess(ctx,
+			"KYC Rejected",
+			"The customer stays on tier 1 until new records are verified.")
+	}
+
+	return web.Redirect(ctx, w, r, redirect, http.StatusFound)
+}
+
+// Document serves a document uploaded for a customer.
+func (h *Customers) Document(ctx context.Context, w http.ResponseWriter, r *http.Request, params map[string]string) error {
+
+	claims, err := auth.ClaimsFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	doc, dat, err := h.CustomerRepo.ReadDocument(ctx, claims, params["customer_id"], params["kind"])
+	if err != nil {
+		return err
+	}
+
+	w.Header().Set("Cache-Control", "private, max-age=300")
+	return web.Respond(ctx, w, dat, http.StatusOK, doc.ContentType)
+}
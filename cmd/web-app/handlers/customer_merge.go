@@ -0,0 +1,69 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"merryworld/surebank/internal/customer"
+	"merryworld/surebank/internal/platform/auth"
+	"merryworld/surebank/internal/platform/web"
+	"merryworld/surebank/internal/platform/web/webcontext"
+	"merryworld/surebank/internal/platform/web/weberror"
+)
+
+func urlCustomersMerge(customerID string) string {
+	return fmt.Sprintf("/customers/%s/merge", customerID)
+}
+
+// Merge merges a duplicate customer into the customer.
+func (h *Customers) Merge(ctx context.Context, w http.ResponseWriter, r *http.Request, params map[string]string) error {
+
+	ctxValues, err := webcontext.ContextValues(ctx)
+	if err != nil {
+		return err
+	}
+
+	claims, err := auth.ClaimsFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	customerID := params["customer_id"]
+
+	if err := r.ParseForm(); err != nil {
+		return err
+	}
+
+	redirect := urlCustomersView(customerID) + "#duplicates"
+
+	req := customer.MergeRequest{
+		SurvivorID:  customerID,
+		DuplicateID: r.PostForm.Get("DuplicateID"),
+		Reason:      strings.TrimSpace(r.PostForm.Get("Reason")),
+	}
+
+	res, err := h.CustomerRepo.Merge(ctx, claims, req, ctxValues.Now)
+	if err != nil {
+		if verr, ok := weberror.NewValidationError(ctx, err); ok {
+			webcontext.SessionFlashError(ctx, "Customers Not Merged", verr.Error())
+			return web.Redirect(ctx, w, r, redirect, http.StatusFound)
+		}
+
+		werr, ok := weberror.NewError(ctx, err, 0).(*weberror.Error)
+		if !ok || werr.Status >= http.StatusInternalServerError {
+			return err
+		}
+
+		webcontext.SessionFlashError(ctx, "Customers Not Merged", werr.Error())
+		return web.Redirect(ctx, w, r, redirect, http.StatusFound)
+	}
+
+	webcontext.SessionFlashSuccess(ctx,
+		"Customers Merged",
+		fmt.Sprintf("%d account(s), %d loan(s), %d document(s) and %d sale(s) were moved to this customer.",
+			res.AccountsMoved, res.LoansMoved, res.DocumentsMoved, res.SalesMoved))
+
+	return web.Redirect(ctx, w, r, redirect, http.StatusFound)
+}
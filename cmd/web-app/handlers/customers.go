@@ -1,6 +1,7 @@
 package handlers
 
 import (
+	"bytes"
 	"context"
 	"database/sql"
 	"fmt"
@@ -10,9 +11,12 @@ import (
 	"time"
 
 	"merryworld/surebank/internal/account"
+	"merryworld/surebank/internal/account_product"
 	"merryworld/surebank/internal/customer"
+	"merryworld/surebank/internal/customer_portal"
 	"merryworld/surebank/internal/platform/auth"
 	"merryworld/surebank/internal/platform/datatable"
+	"merryworld/surebank/internal/platform/money"
 	"merryworld/surebank/internal/platform/notify"
 	"merryworld/surebank/internal/platform/web"
 	"merryworld/surebank/internal/platform/web/webcontext"
@@ -31,12 +35,14 @@ import (
 
 // Customers represents the Customers API method handler set.
 type Customers struct {
-	CustomerRepo    *customer.Repository
-	AccountRepo     *account.Repository
-	TransactionRepo *transaction.Repository
-	NotifySMS       notify.SMS
-	Renderer        web.Renderer
-	Redis           *redis.Client
+	CustomerRepo       *customer.Repository
+	AccountRepo        *account.Repository
+	AccountProductRepo *account_product.Repository
+	TransactionRepo    *transaction.Repository
+	PortalRepo         *customer_portal.Repository
+	NotifySMS          notify.SMS
+	Renderer           web.Renderer
+	Redis              *redis.Client
 }
 
 func urlCustomersIndex() string {
@@ -75,6 +81,30 @@ func urlCustomersAccountTransactions(customerID, accountID string) string {
 	return fmt.Sprintf("/customers/%s/accounts/%s/transactions", customerID, accountID)
 }
 
+func urlCustomersAccountStatement(customerID, accountID string) string {
+	return fmt.Sprintf("/customers/%s/accounts/%s/statement", customerID, accountID)
+}
+
+func urlCustomersAccountCycle(customerID, accountID, cycleID string) string {
+	return fmt.Sprintf("/customers/%s/accounts/%s/cycles/%s", customerID, accountID, cycleID)
+}
+
+func urlCustomersAccountFollowUps(customerID, accountID string) string {
+	return fmt.Sprintf("/customers/%s/accounts/%s/follow-ups", customerID, accountID)
+}
+
+func urlCustomersAccountStatus(customerID, accountID string) string {
+	return fmt.Sprintf("/customers/%s/accounts/%s/status", customerID, accountID)
+}
+
+func urlCustomersAccountClose(customerID, accountID string) string {
+	return fmt.Sprintf("/customers/%s/accounts/%s/close", customerID, accountID)
+}
+
+func urlCustomersAccountHolds(customerID, accountID string) string {
+	return fmt.Sprintf("/customers/%s/accounts/%s/holds", customerID, accountID)
+}
+
 func urlCustomersAccountTransactionsReverse(customerID, accountID, transactionID string) string {
 	return fmt.Sprintf("/customers/%s/accounts/%s/transactions/%s/reverse", customerID, accountID, transactionID)
 }
@@ -87,6 +117,10 @@ func urlCustomersTransactionsWithdraw(customerID, accountID string) string {
 	return fmt.Sprintf("/customers/%s/accounts/%s/transactions/withdraw", customerID, accountID)
 }
 
+func urlCustomersTransactionsTransfer(customerID, accountID string) string {
+	return fmt.Sprintf("/customers/%s/accounts/%s/transactions/transfer", customerID, accountID)
+}
+
 func urlCustomersTransactionsView(customerID, accountID, tranxID string) string {
 	return fmt.Sprintf("/customers/%s/accounts/%s/transactions/%s", customerID, accountID, tranxID)
 }
@@ -174,6 +208,10 @@ func (h *Customers) Create(ctx context.Context, w http.ResponseWriter, r *http.R
 			}
 
 			res, err := h.CustomerRepo.Create(ctx, claims, *req, ctxValues.Now)
+			if dups, ok := customer.IsDuplicate(err); ok {
+				data["duplicates"] = dups.Response(ctx)
+				return false, nil
+			}
 			if err != nil {
 				switch errors.Cause(err) {
 				default:
@@ -238,7 +276,11 @@ func (h *Customers) Create(ctx context.Context, w http.ResponseWriter, r *http.R
 		return nil
 	}
 
-	data["accountTypes"] = customer.AccountTypes
+	products, err := h.AccountProductRepo.Find(ctx, claims, account_product.FindRequest{})
+	if err != nil {
+		return err
+	}
+	data["accountProducts"] = products.Response(ctx)
 	data["form"] = req
 	data["urlCustomersIndex"] = urlCustomersIndex()
 
@@ -300,6 +342,29 @@ func (h *Customers) View(ctx context.Context, w http.ResponseWriter, r *http.Req
 	}
 	data["customer"] = cust.Response(ctx)
 
+	docs, err := h.CustomerRepo.FindDocuments(ctx, claims, customerID)
+	if err != nil {
+		return err
+	}
+	data["documents"] = docs.Response(ctx)
+	data["limits"] = customer.Limits(cust.EffectiveTier())
+	data["idTypeNames"] = customer.IDTypeNames
+	data["tiers"] = customer.Tiers
+
+	if claims.HasRole(auth.RoleAdmin, auth.RoleSuperAdmin) {
+		dups, err := h.CustomerRepo.FindDuplicatesOf(ctx, claims, cust)
+		if err != nil {
+			return err
+		}
+		data["duplicates"] = dups.Response(ctx)
+	}
+
+	merges, err := h.CustomerRepo.FindMerges(ctx, claims, customerID)
+	if err != nil {
+		return err
+	}
+	data["merges"] = merges.Response(ctx)
+
 	accountsResp, err := h.AccountRepo.Find(ctx, claims, account.FindRequest{
 		Where: "customer_id = ?", Args: []interface{}{customerID}, IncludeSalesRep: true, IncludeBranch: true,
 	})
@@ -307,7 +372,7 @@ func (h *Customers) View(ctx context.Context, w http.ResponseWriter, r *http.Req
 		return err
 	}
 
-	var accountBalance float64
+	var accountBalance money.Amount
 	var txWhere []string
 	var txArgs []interface{}
 	for _, acc := range accountsResp.Accounts {
@@ -351,6 +416,8 @@ func (h *Customers) View(ctx context.Context, w http.ResponseWriter, r *http.Req
 	data["urlCustomersView"] = urlCustomersView(customerID)
 	data["urlCustomersAddAccount"] = urlCustomersAddAccount(customerID)
 	data["urlCustomersTransactions"] = urlCustomersTransactions(customerID)
+	data["urlCustomersKYC"] = urlCustomersKYC(customerID)
+	data["urlCustomersMerge"] = urlCustomersMerge(customerID)
 	var accountID string
 	if len(accountsResp.Accounts) > 0 {
 		accountID = accountsResp.Accounts[0].ID
@@ -479,13 +546,13 @@ func (h *Customers) Transactions(ctx context.Context, w http.ResponseWriter, r *
 			case "id":
 				v.Value = fmt.Sprintf("%s", q.ID)
 			case "amount":
-				v.Value = fmt.Sprintf("%f", q.Amount)
+				v.Value = q.Amount.String()
 				p := message.NewPrinter(language.English)
 				var sign string
 				if q.Type == transaction.TransactionType_Withdrawal {
 					sign = "-"
 				}
-				v.Formatted = p.Sprintf("<a href='%s'>%s%.2f</a>", urlCustomersTransactionsView(customerID, q.AccountID, q.ID), sign, q.Amount)
+				v.Formatted = p.Sprintf("<a href='%s'>%s%.2f</a>", urlCustomersTransactionsView(customerID, q.AccountID, q.ID), sign, q.Amount.Float())
 			case "created_at":
 				v.Value = q.CreatedAt.Local
 				v.Formatted = q.CreatedAt.Local
@@ -677,8 +744,13 @@ func (h *Customers) AddAccount(ctx context.Context, w http.ResponseWriter, r *ht
 		return err
 	}
 
+	products, err := h.AccountProductRepo.Find(ctx, claims, account_product.FindRequest{})
+	if err != nil {
+		return err
+	}
+
 	data["form"] = req
-	data["accountTypes"] = customer.AccountTypes
+	data["accountProducts"] = products.Response(ctx)
 	data["customer"] = customerRes
 	data["urlCustomersIndex"] = urlCustomersIndex()
 	data["urlCustomersView"] = urlCustomersView(customerID)
@@ -746,6 +818,9 @@ func (h *Customers) Account(ctx context.Context, w http.ResponseWriter, r *http.
 	if err != nil {
 		return weberror.NewError(ctx, err, 404)
 	}
+	if err := h.AccountRepo.LoadGoal(ctx, acc, time.Now()); err != nil {
+		return err
+	}
 	data["account"] = acc.Response(ctx)
 
 	var limit uint = 5
@@ -765,12 +840,63 @@ func (h *Customers) Account(ctx context.Context, w http.ResponseWriter, r *http.
 
 	data["transactions"] = tranxListResp.Transactions
 
+	if acc.Product != nil && acc.Product.CycleDays > 0 {
+		cycles, err := h.TransactionRepo.FindCycles(ctx, claims, transaction.CycleFindRequest{
+			Where: "account_id = ?",
+			Args:  []interface{}{accountID},
+		})
+		if err != nil {
+			return err
+		}
+
+		data["cycles"] = cycles.Response(ctx)
+		data["hasCycles"] = true
+	}
+
+	if acc.Product != nil && acc.Product.DailyContribution {
+		followUps, err := h.AccountRepo.FindFollowUps(ctx, claims, accountID)
+		if err != nil {
+			return err
+		}
+
+		data["followUps"] = followUps.Response(ctx)
+		data["followUpOutcomes"] = account.FollowUpOutcomes
+		data["outcomeNames"] = account.FollowUpOutcomeNames
+		data["hasFollowUps"] = true
+		data["urlCustomersAccountFollowUps"] = urlCustomersAccountFollowUps(customerID, accountID)
+	}
+
+	holds, err := h.AccountRepo.FindHolds(ctx, claims, accountID)
+	if err != nil {
+		return err
+	}
+	held := holds.Held(time.Now())
+	data["holds"] = holds.Response(ctx)
+	data["held"] = held
+	data["availableBalance"] = account.AvailableBalance(acc.Balance, held)
+
+	statusChanges, err := h.AccountRepo.FindStatusChanges(ctx, claims, accountID)
+	if err != nil {
+		return err
+	}
+	data["statusChanges"] = statusChanges.Response(ctx)
+
 	data["urlCustomersIndex"] = urlCustomersIndex()
 	data["urlCustomersAccountsUpdate"] = urlCustomersAccountsUpdate(customerID, accountID)
+	data["urlCustomersAccountStatus"] = urlCustomersAccountStatus(customerID, accountID)
+	data["urlCustomersAccountClose"] = urlCustomersAccountClose(customerID, accountID)
+	data["urlCustomersAccountHolds"] = urlCustomersAccountHolds(customerID, accountID)
 	data["urlCustomersAccountUpdate"] = urlCustomersAddAccount(customerID)
 	data["urlCustomersAccountTransactions"] = urlCustomersAccountTransactions(customerID, accountID)
+	data["urlCustomersAccountStatement"] = urlCustomersAccountStatement(customerID, accountID)
+	data["statementStartDate"] = now.BeginningOfMonth().Format("01/02/2006")
+	data["statementEndDate"] = time.Now().Format("01/02/2006")
 	data["urlCustomersTransactionsWithdraw"] = urlCustomersTransactionsWithdraw(cust.ID, accountID)
+	data["urlCustomersTransactionsTransfer"] = urlCustomersTransactionsTransfer(cust.ID, accountID)
 	data["urlCustomersTransactionsCreate"] = urlCustomersTransactionsCreate(customerID, accountID)
+	data["urlLoansApply"] = urlLoansApply(customerID, accountID)
+	data["urlLoans"] = urlLoansIndex() + "?account_id=" + accountID
+	data["urlSalesRedeem"] = urlSalesRedeem(customerID, accountID)
 
 	return h.Renderer.Render(ctx, w, r, TmplLayoutBase, "customers-account.gohtml", web.MIMETextHTMLCharsetUTF8, http.StatusOK, data)
 }
@@ -860,8 +986,13 @@ func (h *Customers) UpdateAccount(ctx context.Context, w http.ResponseWriter, r
 		req.Type = &account.Type
 	}
 
+	products, err := h.AccountProductRepo.Find(ctx, claims, account_product.FindRequest{})
+	if err != nil {
+		return err
+	}
+
 	data["form"] = req
-	data["accountTypes"] = customer.AccountTypes
+	data["accountProducts"] = products.Response(ctx)
 
 	if verr, ok := weberror.NewValidationError(ctx, webcontext.Validator().Struct(customer.UpdateRequest{})); ok {
 		data["validationDefaults"] = verr.(*weberror.Error)
@@ -910,13 +1041,13 @@ func (h *Customers) AccountTransactions(ctx context.Context, w http.ResponseWrit
 			case "id":
 				v.Value = fmt.Sprintf("%s", q.ID)
 			case "amount":
-				v.Value = fmt.Sprintf("%f", q.Amount)
+				v.Value = q.Amount.String()
 				p := message.NewPrinter(language.English)
 				var sign string
 				if q.Type == transaction.TransactionType_Withdrawal {
 					sign = "-"
 				}
-				v.Formatted = p.Sprintf("<a href='%s'>%s%.2f</a>", urlCustomersTransactionsView(cust.ID, acc.ID, q.ID), sign, q.Amount)
+				v.Formatted = p.Sprintf("<a href='%s'>%s%.2f</a>", urlCustomersTransactionsView(cust.ID, acc.ID, q.ID), sign, q.Amount.Float())
 			case "created_at":
 				v.Value = q.CreatedAt.Local
 				v.Formatted = q.CreatedAt.Local
@@ -1164,6 +1295,19 @@ func (h *Customers) Withraw(ctx context.Context, w http.ResponseWriter, r *http.
 
 	req := new(transaction.WithdrawRequest)
 	data := make(map[string]interface{})
+
+	// A withdrawal request from the customer portal being paid out fills in the form.
+	withdrawalID := r.URL.Query().Get("request")
+	if withdrawalID != "" {
+		withdrawal, err := h.PortalRepo.ReadWithdrawal(ctx, claims, withdrawalID)
+		if err != nil {
+			return err
+		}
+		req.Amount = withdrawal.Amount
+		req.Narration = withdrawal.Note
+		data["withdrawalRequest"] = withdrawal.Response(ctx)
+	}
+
 	f := func() (bool, error) {
 		if r.Method == http.MethodPost {
 			err := r.ParseForm()
@@ -1179,8 +1323,23 @@ func (h *Customers) Withraw(ctx context.Context, w http.ResponseWriter, r *http.
 			}
 			req.AccountNumber = acc.Number
 			req.Type = transaction.TransactionType_Deposit
+			req.IdempotencyKey = r.Header.Get(web.HeaderIdempotencyKey)
+
+			tx, err := h.TransactionRepo.Withdraw(ctx, claims, *req, ctxValues.Now)
+			if approval, ok := transaction.IsPendingApproval(err); ok {
+				if withdrawalID != "" {
+					if err := h.PortalRepo.ReferWithdrawal(ctx, claims, withdrawalID, ctxValues.Now); err != nil {
+						return false, err
+					}
+				}
+
+				webcontext.SessionFlashInfo(ctx,
+					"Withdrawal Sent for Approval",
+					fmt.Sprintf("The %s of %s is above the approval threshold and will be posted once a branch admin approves it.",
+						approval.Kind, approval.Amount))
 
-			_, err = h.TransactionRepo.Withdraw(ctx, claims, *req, ctxValues.Now)
+				return true, web.Redirect(ctx, w, r, urlCustomersAccountsView(customerID, accountID), http.StatusFound)
+			}
 			if err != nil {
 				switch errors.Cause(err) {
 				default:
@@ -1193,6 +1352,22 @@ func (h *Customers) Withraw(ctx context.Context, w http.ResponseWriter, r *http.
 				}
 			}
 
+			if withdrawalID != "" {
+				if err := h.PortalRepo.ProcessWithdrawal(ctx, claims, customer_portal.WithdrawalProcessRequest{
+					ID:            withdrawalID,
+					AccountID:     accountID,
+					TransactionID: tx.ID,
+				}, ctxValues.Now); err != nil {
+					// The money has been paid out so the withdrawal stands, most likely the
+					// customer cancelled the request while it was being paid.
+					webcontext.SessionFlashWarning(ctx,
+						"Withdrawal Added",
+						"The withdrawal was added but the withdrawal request could not be marked as paid out: "+err.Error())
+
+					return true, web.Redirect(ctx, w, r, urlCustomersAccountsView(customerID, accountID), http.StatusFound)
+				}
+			}
+
 			// Display a success message to the checklist.
 			webcontext.SessionFlashSuccess(ctx,
 				"Withdrawal Added",
@@ -1218,6 +1393,7 @@ func (h *Customers) Withraw(ctx context.Context, w http.ResponseWriter, r *http.
 
 	data["form"] = req
 	data["account"] = acc
+	data["paymentMethods"] = transaction.PaymentMethods
 	data["customer"] = customerRes
 	data["urlCustomersIndex"] = urlCustomersIndex()
 	data["urlCustomersView"] = urlCustomersView(customerID)
@@ -1230,6 +1406,113 @@ func (h *Customers) Withraw(ctx context.Context, w http.ResponseWriter, r *http.
 	return h.Renderer.Render(ctx, w, r, TmplLayoutBase, "customers-account-withdrawal.gohtml", web.MIMETextHTMLCharsetUTF8, http.StatusOK, data)
 }
 
+// Transfer handles moving money from the account to another account of the same customer.
+func (h *Customers) Transfer(ctx context.Context, w http.ResponseWriter, r *http.Request, params map[string]string) error {
+
+	ctxValues, err := webcontext.ContextValues(ctx)
+	if err != nil {
+		return err
+	}
+
+	customerID := params["customer_id"]
+	accountID := params["account_id"]
+
+	claims, err := auth.ClaimsFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	acc, err := h.AccountRepo.ReadByID(ctx, claims, accountID)
+	if err != nil {
+		return weberror.NewErrorMessage(ctx, err, 400, "accountID"+accountID)
+	}
+
+	req := new(transaction.TransferRequest)
+	data := make(map[string]interface{})
+	f := func() (bool, error) {
+		if r.Method == http.MethodPost {
+			err := r.ParseForm()
+			if err != nil {
+				return false, err
+			}
+
+			decoder := schema.NewDecoder()
+			decoder.IgnoreUnknownKeys(true)
+
+			if err := decoder.Decode(req, r.PostForm); err != nil {
+				return false, err
+			}
+			req.FromAccountNumber = acc.Number
+
+			_, err = h.TransactionRepo.Transfer(ctx, claims, *req, ctxValues.Now)
+			if approval, ok := transaction.IsPendingApproval(err); ok {
+				webcontext.SessionFlashInfo(ctx,
+					"Transfer Sent for Approval",
+					fmt.Sprintf("The %s of %s is above the approval threshold and will be posted once a branch admin approves it.",
+						approval.Kind, approval.Amount))
+
+				return true, web.Redirect(ctx, w, r, urlCustomersAccountsView(customerID, accountID), http.StatusFound)
+			}
+			if err != nil {
+				switch errors.Cause(err) {
+				default:
+					if verr, ok := weberror.NewValidationError(ctx, err); ok {
+						data["validationErrors"] = verr.(*weberror.Error)
+						return false, nil
+					} else {
+						return false, err
+					}
+				}
+			}
+
+			// Display a success message to the checklist.
+			webcontext.SessionFlashSuccess(ctx,
+				"Transfer Completed",
+				"Transfer successfully completed.")
+
+			return true, web.Redirect(ctx, w, r, urlCustomersAccountsView(customerID, accountID), http.StatusFound)
+		}
+
+		return false, nil
+	}
+
+	end, err := f()
+	if err != nil {
+		return web.RenderError(ctx, w, r, err, h.Renderer, TmplLayoutBase, TmplContentErrorGeneric, web.MIMETextHTMLCharsetUTF8)
+	} else if end {
+		return nil
+	}
+
+	customerRes, err := h.CustomerRepo.ReadByID(ctx, claims, customerID)
+	if err != nil {
+		return weberror.NewErrorMessage(ctx, err, 400, "customerID"+customerID)
+	}
+
+	// Money can only move to the other accounts of the customer.
+	accountsResp, err := h.AccountRepo.Find(ctx, claims, account.FindRequest{
+		Where: "customer_id = ? AND id != ?",
+		Args:  []interface{}{customerID, accountID},
+		Order: []string{"number"},
+	})
+	if err != nil {
+		return err
+	}
+
+	data["form"] = req
+	data["account"] = acc
+	data["accounts"] = accountsResp.Accounts
+	data["customer"] = customerRes
+	data["urlCustomersIndex"] = urlCustomersIndex()
+	data["urlCustomersView"] = urlCustomersView(customerID)
+	data["urlCustomersAccountsView"] = urlCustomersAccountsView(customerID, accountID)
+
+	if verr, ok := weberror.NewValidationError(ctx, webcontext.Validator().Struct(transaction.TransferRequest{})); ok {
+		data["validationDefaults"] = verr.(*weberror.Error)
+	}
+
+	return h.Renderer.Render(ctx, w, r, TmplLayoutBase, "customers-account-transfer.gohtml", web.MIMETextHTMLCharsetUTF8, http.StatusOK, data)
+}
+
 // Deposit handles add a new transaction to account.
 func (h *Customers) DirectDeposit(ctx context.Context, w http.ResponseWriter, r *http.Request, params map[string]string) error {
 
@@ -1360,15 +1643,16 @@ func (h *Customers) Transaction(ctx context.Context, w http.ResponseWriter, r *h
 			switch r.PostForm.Get("action") {
 			case "archive":
 				err = h.TransactionRepo.Archive(ctx, claims, transaction.ArchiveRequest{
-					ID: transactionID,
+					ID:     transactionID,
+					Reason: r.PostForm.Get("reason"),
 				}, ctxValue.Now)
 				if err != nil {
 					return false, err
 				}
 
 				webcontext.SessionFlashSuccess(ctx,
-					"Transaction Archived",
-					"Transaction successfully archived.")
+					"Transaction Reversed",
+					"Transaction successfully reversed.")
 
 				return true, web.Redirect(ctx, w, r, urlCustomersAccountTransactions(customerID, accountID), http.StatusFound)
 			}
@@ -1410,3 +1694,428 @@ func (h *Customers) Transaction(ctx context.Context, w http.ResponseWriter, r *h
 
 	return h.Renderer.Render(ctx, w, r, TmplLayoutBase, "customers-account-transactions-view.gohtml", web.MIMETextHTMLCharsetUTF8, http.StatusOK, data)
 }
+
+// AccountHold places a hold on part of the balance of an account.
+func (h *Customers) AccountHold(ctx context.Context, w http.ResponseWriter, r *http.Request, params map[string]string) error {
+
+	ctxValue, err := webcontext.ContextValues(ctx)
+	if err != nil {
+		return err
+	}
+
+	claims, err := auth.ClaimsFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	customerID := params["customer_id"]
+	accountID := params["account_id"]
+
+	if err := r.ParseForm(); err != nil {
+		return err
+	}
+
+	redirect := urlCustomersAccountsView(customerID, accountID) + "#holds"
+
+	amount, err := money.Parse(r.PostForm.Get("Amount"))
+	if err != nil {
+		webcontext.SessionFlashError(ctx, "Hold Not Placed", err.Error())
+		return web.Redirect(ctx, w, r, redirect, http.StatusFound)
+	}
+
+	req := account.HoldCreateRequest{
+		AccountID: accountID,
+		Amount:    amount,
+		Reason:    strings.TrimSpace(r.PostForm.Get("Reason")),
+	}
+	if v := r.PostForm.Get("ExpiresAt"); v != "" {
+		expiresAt, err := time.Parse("01/02/2006", v)
+		if err != nil {
+			return weberror.NewErrorMessage(ctx, err, http.StatusBadRequest, "Invalid expiry date")
+		}
+		req.ExpiresAt = &expiresAt
+	}
+
+	if _, err = h.AccountRepo.CreateHold(ctx, claims, req, ctxValue.Now); err != nil {
+		if verr, ok := weberror.NewValidationError(ctx, err); ok {
+			webcontext.SessionFlashError(ctx, "Hold Not Placed", verr.Error())
+			return web.Redirect(ctx, w, r, redirect, http.StatusFound)
+		}
+
+		werr, ok := weberror.NewError(ctx, err, 0).(*weberror.Error)
+		if !ok || werr.Status >= http.StatusInternalServerError {
+			return err
+		}
+
+		webcontext.SessionFlashError(ctx, "Hold Not Placed", werr.Error())
+		return web.Redirect(ctx, w, r, redirect, http.StatusFound)
+	}
+
+	webcontext.SessionFlashSuccess(ctx,
+		"Hold Placed",
+		fmt.Sprintf("%s of the balance is on hold.", req.Amount))
+
+	return web.Redirect(ctx, w, r, redirect, http.StatusFound)
+}
+
+// AccountHoldRelease releases a hold on an account.
+func (h *Customers) AccountHoldRelease(ctx context.Context, w http.ResponseWriter, r *http.Request, params map[string]string) error {
+
+	ctxValue, err := webcontext.ContextValues(ctx)
+	if err != nil {
+		return err
+	}
+
+	claims, err := auth.ClaimsFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	customerID := params["customer_id"]
+	accountID := params["account_id"]
+
+	redirect := urlCustomersAccountsView(customerID, accountID) + "#holds"
+
+	err = h.AccountRepo.ReleaseHold(ctx, claims, account.HoldReleaseRequest{ID: params["hold_id"]}, ctxValue.Now)
+	if err != nil {
+		werr, ok := weberror.NewError(ctx, err, 0).(*weberror.Error)
+		if !ok || werr.Status >= http.StatusInternalServerError {
+			return err
+		}
+
+		webcontext.SessionFlashError(ctx, "Hold Not Released", werr.Error())
+		return web.Redirect(ctx, w, r, redirect, http.StatusFound)
+	}
+
+	webcontext.SessionFlashSuccess(ctx,
+		"Hold Released",
+		"The amount held is available to the customer again.")
+
+	return web.Redirect(ctx, w, r, redirect, http.StatusFound)
+}
+
+// AccountStatus freezes, unfreezes or reactivates an account.
+func (h *Customers) AccountStatus(ctx context.Context, w http.ResponseWriter, r *http.Request, params map[string]string) error {
+
+	ctxValue, err := webcontext.ContextValues(ctx)
+	if err != nil {
+		return err
+	}
+
+	claims, err := auth.ClaimsFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	customerID := params["customer_id"]
+	accountID := params["account_id"]
+
+	if err := r.ParseForm(); err != nil {
+		return err
+	}
+
+	req := account.StatusRequest{
+		ID:     accountID,
+		Status: r.PostForm.Get("Status"),
+		Reason: strings.TrimSpace(r.PostForm.Get("Reason")),
+	}
+
+	redirect := urlCustomersAccountsView(customerID, accountID) + "#status"
+
+	if err = h.AccountRepo.ChangeStatus(ctx, claims, req, ctxValue.Now); err != nil {
+		if verr, ok := weberror.NewValidationError(ctx, err); ok {
+			webcontext.SessionFlashError(ctx, "Status Not Changed", verr.Error())
+			return web.Redirect(ctx, w, r, redirect, http.StatusFound)
+		}
+
+		werr, ok := weberror.NewError(ctx, err, 0).(*weberror.Error)
+		if !ok || werr.Status >= http.StatusInternalServerError {
+			return err
+		}
+
+		webcontext.SessionFlashError(ctx, "Status Not Changed", werr.Error())
+		return web.Redirect(ctx, w, r, redirect, http.StatusFound)
+	}
+
+	webcontext.SessionFlashSuccess(ctx,
+		"Status Changed",
+		fmt.Sprintf("The account is now %s.", req.Status))
+
+	return web.Redirect(ctx, w, r, redirect, http.StatusFound)
+}
+
+// AccountClose settles the balance of an account and closes it.
+func (h *Customers) AccountClose(ctx context.Context, w http.ResponseWriter, r *http.Request, params map[string]string) error {
+
+	ctxValue, err := webcontext.ContextValues(ctx)
+	if err != nil {
+		return err
+	}
+
+	claims, err := auth.ClaimsFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	customerID := params["customer_id"]
+	accountID := params["account_id"]
+
+	if err := r.ParseForm(); err != nil {
+		return err
+	}
+
+	req := account.CloseRequest{
+		ID:     accountID,
+		Reason: strings.TrimSpace(r.PostForm.Get("Reason")),
+	}
+
+	redirect := urlCustomersAccountsView(customerID, accountID) + "#status"
+
+	settlement, err := h.TransactionRepo.CloseAccount(ctx, claims, req, ctxValue.Now)
+	if err != nil {
+		if verr, ok := weberror.NewValidationError(ctx, err); ok {
+			webcontext.SessionFlashError(ctx, "Account Not Closed", verr.Error())
+			return web.Redirect(ctx, w, r, redirect, http.StatusFound)
+		}
+
+		werr, ok := weberror.NewError(ctx, err, 0).(*weberror.Error)
+		if !ok || werr.Status >= http.StatusInternalServerError {
+			return err
+		}
+
+		webcontext.SessionFlashError(ctx, "Account Not Closed", werr.Error())
+		return web.Redirect(ctx, w, r, redirect, http.StatusFound)
+	}
+
+	if settlement == nil {
+		webcontext.SessionFlashSuccess(ctx,
+			"Account Closed",
+			"The account has been closed, there was no balance to pay out.")
+	} else {
+		webcontext.SessionFlashSuccess(ctx,
+			"Account Closed",
+			fmt.Sprintf("The account has been closed and %s paid out to the customer.", settlement.Amount))
+	}
+
+	return web.Redirect(ctx, w, r, redirect, http.StatusFound)
+}
+
+// AccountCycle handles displaying the statement of a DS cycle of an account and settling the
+
+// AccountFollowUp records a call made to the customer of a DS account that is behind on its
+// contributions.
+func (h *Customers) AccountFollowUp(ctx context.Context, w http.ResponseWriter, r *http.Request, params map[string]string) error {
+
+	ctxValue, err := webcontext.ContextValues(ctx)
+	if err != nil {
+		return err
+	}
+
+	claims, err := auth.ClaimsFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	customerID := params["customer_id"]
+	accountID := params["account_id"]
+
+	if err := r.ParseForm(); err != nil {
+		return err
+	}
+
+	req := account.FollowUpCreateRequest{
+		AccountID: accountID,
+		Outcome:   r.PostForm.Get("Outcome"),
+		Note:      strings.TrimSpace(r.PostForm.Get("Note")),
+	}
+	if v := r.PostForm.Get("PromisedDate"); v != "" {
+		promisedDate, err := time.Parse("01/02/2006", v)
+		if err != nil {
+			return weberror.NewErrorMessage(ctx, err, http.StatusBadRequest, "Invalid promised date")
+		}
+		req.PromisedDate = &promisedDate
+	}
+
+	redirect := urlCustomersAccountsView(customerID, accountID) + "#follow-ups"
+
+	if _, err = h.AccountRepo.CreateFollowUp(ctx, claims, req, ctxValue.Now); err != nil {
+		if verr, ok := weberror.NewValidationError(ctx, err); ok {
+			webcontext.SessionFlashError(ctx, "Follow Up Not Recorded", verr.Error())
+			return web.Redirect(ctx, w, r, redirect, http.StatusFound)
+		}
+
+		werr, ok := weberror.NewError(ctx, err, 0).(*weberror.Error)
+		if !ok || werr.Status >= http.StatusInternalServerError {
+			return err
+		}
+
+		webcontext.SessionFlashError(ctx, "Follow Up Not Recorded", werr.Error())
+		return web.Redirect(ctx, w, r, redirect, http.StatusFound)
+	}
+
+	webcontext.SessionFlashSuccess(ctx,
+		"Follow Up Recorded",
+		"The call to the customer has been recorded.")
+
+	return web.Redirect(ctx, w, r, redirect, http.StatusFound)
+}
+
+// cycle once it is due.
+func (h *Customers) AccountCycle(ctx context.Context, w http.ResponseWriter, r *http.Request, params map[string]string) error {
+
+	ctxValue, err := webcontext.ContextValues(ctx)
+	if err != nil {
+		return err
+	}
+
+	customerID := params["customer_id"]
+	accountID := params["account_id"]
+	cycleID := params["cycle_id"]
+
+	claims, err := auth.ClaimsFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	data := make(map[string]interface{})
+	f := func() (bool, error) {
+		if r.Method == http.MethodPost {
+			err := r.ParseForm()
+			if err != nil {
+				return false, err
+			}
+
+			req := new(transaction.SettleCycleRequest)
+			decoder := schema.NewDecoder()
+			decoder.IgnoreUnknownKeys(true)
+
+			if err := decoder.Decode(req, r.PostForm); err != nil {
+				return false, err
+			}
+			req.ID = cycleID
+
+			cycle, err := h.TransactionRepo.SettleCycle(ctx, claims, *req, ctxValue.Now)
+			if err != nil {
+				return false, err
+			}
+
+			if cycle.Settlement == transaction.CycleSettlement_Payout {
+				webcontext.SessionFlashSuccess(ctx,
+					"Cycle Paid Out",
+					fmt.Sprintf("%s has been paid out for cycle %d.", cycle.Payout, cycle.Number))
+			} else {
+				webcontext.SessionFlashSuccess(ctx,
+					"Cycle Rolled Over",
+					fmt.Sprintf("%s has been rolled over from cycle %d into the next cycle.", cycle.CarriedForward, cycle.Number))
+			}
+
+			return true, web.Redirect(ctx, w, r, urlCustomersAccountCycle(customerID, accountID, cycleID), http.StatusFound)
+		}
+
+		return false, nil
+	}
+
+	end, err := f()
+	if err != nil {
+		return web.RenderError(ctx, w, r, err, h.Renderer, TmplLayoutBase, TmplContentErrorGeneric, web.MIMETextHTMLCharsetUTF8)
+	} else if end {
+		return nil
+	}
+
+	statement, err := h.TransactionRepo.CycleStatement(ctx, claims, cycleID)
+	if err != nil {
+		return err
+	}
+	if statement.Cycle.AccountID != accountID {
+		return weberror.NewError(ctx, transaction.ErrNotFound, http.StatusNotFound)
+	}
+
+	acc, err := h.AccountRepo.ReadByID(ctx, claims, accountID)
+	if err != nil {
+		return err
+	}
+
+	cust, err := h.CustomerRepo.ReadByID(ctx, claims, acc.CustomerID)
+	if err != nil {
+		return err
+	}
+
+	data["cycle"] = statement.Cycle.Response(ctx)
+	data["transactions"] = statement.Transactions.Response(ctx)
+	data["account"] = acc.Response(ctx)
+	data["customer"] = cust.Response(ctx)
+	data["paymentMethods"] = transaction.PaymentMethods
+	data["urlCustomersIndex"] = urlCustomersIndex()
+	data["urlCustomersView"] = urlCustomersView(customerID)
+	data["urlCustomerAccountsView"] = urlCustomersAccountsView(customerID, accountID)
+
+	return h.Renderer.Render(ctx, w, r, TmplLayoutBase, "customers-account-cycle.gohtml", web.MIMETextHTMLCharsetUTF8, http.StatusOK, data)
+}
+
+// AccountStatement handles downloading the statement of an account as a PDF and emailing it to
+// the customer. The period is given as start and end dates in the timezone of the user.
+func (h *Customers) AccountStatement(ctx context.Context, w http.ResponseWriter, r *http.Request, params map[string]string) error {
+
+	ctxValue, err := webcontext.ContextValues(ctx)
+	if err != nil {
+		return err
+	}
+
+	customerID := params["customer_id"]
+	accountID := params["account_id"]
+
+	claims, err := auth.ClaimsFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	loc := claims.TimeLocation()
+	if loc == nil {
+		loc = time.Local
+	}
+
+	if err := r.ParseForm(); err != nil {
+		return err
+	}
+
+	req := transaction.StatementRequest{AccountID: accountID}
+	start, err := time.ParseInLocation("01/02/2006", r.Form.Get("start_date"), loc)
+	if err != nil {
+		return weberror.NewErrorMessage(ctx, err, http.StatusBadRequest, "Invalid start date")
+	}
+	req.StartDate = now.New(start).BeginningOfDay()
+	end, err := time.ParseInLocation("01/02/2006", r.Form.Get("end_date"), loc)
+	if err != nil {
+		return weberror.NewErrorMessage(ctx, err, http.StatusBadRequest, "Invalid end date")
+	}
+	req.EndDate = now.New(end).EndOfDay()
+
+	if r.Method == http.MethodPost && r.PostForm.Get("action") == "email" {
+		err = h.TransactionRepo.EmailStatement(ctx, claims, transaction.EmailStatementRequest{
+			StatementRequest: req,
+			Email:            r.PostForm.Get("email"),
+		}, ctxValue.Now)
+		if err != nil {
+			return web.RenderError(ctx, w, r, err, h.Renderer, TmplLayoutBase, TmplContentErrorGeneric, web.MIMETextHTMLCharsetUTF8)
+		}
+
+		webcontext.SessionFlashSuccess(ctx,
+			"Statement Sent",
+			"The statement has been sent by email.")
+
+		return web.Redirect(ctx, w, r, urlCustomersAccountsView(customerID, accountID), http.StatusFound)
+	}
+
+	st, err := h.TransactionRepo.Statement(ctx, claims, req, ctxValue.Now)
+	if err != nil {
+		return err
+	}
+
+	var pdf bytes.Buffer
+	if err = st.WritePDF(&pdf); err != nil {
+		return err
+	}
+
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", st.Filename()))
+	return web.Respond(ctx, w, pdf.Bytes(), http.StatusOK, web.MIMEApplicationPDF)
+}
@@ -103,9 +103,9 @@ func (h *Expenditures) Index(ctx context.Context, w http.ResponseWriter, r *http
 				v.Value = q.SalesRep
 				v.Formatted = fmt.Sprintf("<a href='%s'>%s</a>", urlUsersView(q.SalesRepID), v.Value)
 			case "amount":
-				v.Value = fmt.Sprintf("%f", q.Amount)
+				v.Value = q.Amount.String()
 				p := message.NewPrinter(language.English)
-				v.Formatted = p.Sprintf("%.2f", q.Amount)
+				v.Formatted = p.Sprintf("%.2f", q.Amount.Float())
 			case "date":
 				v.Value = q.Date.Local
 				v.Formatted = v.Value
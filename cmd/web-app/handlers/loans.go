@@ -0,0 +1,404 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"merryworld/surebank/internal/account"
+	"merryworld/surebank/internal/loan"
+	"merryworld/surebank/internal/platform/auth"
+	"merryworld/surebank/internal/platform/web"
+	"merryworld/surebank/internal/platform/web/webcontext"
+	"merryworld/surebank/internal/platform/web/weberror"
+
+	"github.com/gorilla/schema"
+	"gopkg.in/DataDog/dd-trace-go.v1/contrib/go-redis/redis"
+)
+
+// Loans represents the customer loans handler set.
+type Loans struct {
+	LoanRepo    *loan.Repository
+	AccountRepo *account.Repository
+	Redis       *redis.Client
+	Renderer    web.Renderer
+}
+
+func urlLoansIndex() string {
+	return "/loans"
+}
+
+func urlLoansView(loanID string) string {
+	return fmt.Sprintf("/loans/%s", loanID)
+}
+
+func urlLoansApprove(loanID string) string {
+	return fmt.Sprintf("/loans/%s/approve", loanID)
+}
+
+func urlLoansReject(loanID string) string {
+	return fmt.Sprintf("/loans/%s/reject", loanID)
+}
+
+func urlLoansDisburse(loanID string) string {
+	return fmt.Sprintf("/loans/%s/disburse", loanID)
+}
+
+func urlLoansRepay(loanID string) string {
+	return fmt.Sprintf("/loans/%s/repay", loanID)
+}
+
+func urlLoansApply(customerID, accountID string) string {
+	return fmt.Sprintf("/customers/%s/accounts/%s/loans/apply", customerID, accountID)
+}
+
+// Index lists the loans of the branch of the user, all loans for super admins. The list can be
+// narrowed down to a status or an account.
+func (h *Loans) Index(ctx context.Context, w http.ResponseWriter, r *http.Request, params map[string]string) error {
+
+	claims, err := auth.ClaimsFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	var (
+		where []string
+		args  []interface{}
+	)
+	if !claims.HasRole(auth.RoleSuperAdmin) {
+		where = append(where, "loan.branch_id = (select branch_id from users where id = ?)")
+		args = append(args, claims.Subject)
+	}
+
+	status := r.URL.Query().Get("status")
+	if status != "" {
+		where = append(where, "loan.status = ?")
+		args = append(args, status)
+	}
+	if accountID := r.URL.Query().Get("account_id"); accountID != "" {
+		where = append(where, "loan.account_id = ?")
+		args = append(args, accountID)
+	}
+
+	limit := uint(100)
+	req := loan.FindRequest{
+		Args:  args,
+		Order: []string{"loan.created_at desc"},
+		Limit: &limit,
+	}
+	for i, s := range where {
+		if i > 0 {
+			req.Where += " and "
+		}
+		req.Where += s
+	}
+
+	loans, err := h.LoanRepo.Find(ctx, claims, req)
+	if err != nil {
+		return err
+	}
+
+	type row struct {
+		*loan.Response
+		URLView string
+	}
+
+	var rows []row
+	for _, l := range loans.Response(ctx) {
+		rows = append(rows, row{Response: l, URLView: urlLoansView(l.ID)})
+	}
+
+	data := map[string]interface{}{
+		"loans":    rows,
+		"status":   status,
+		"statuses": loan.Statuses,
+	}
+
+	return h.Renderer.Render(ctx, w, r, TmplLayoutBase, "loans-index.gohtml", web.MIMETextHTMLCharsetUTF8, http.StatusOK, data)
+}
+
+// Apply records a loan application against a savings account of a customer.
+func (h *Loans) Apply(ctx context.Context, w http.ResponseWriter, r *http.Request, params map[string]string) error {
+
+	customerID := params["customer_id"]
+	accountID := params["account_id"]
+
+	ctxValues, err := webcontext.ContextValues(ctx)
+	if err != nil {
+		return err
+	}
+
+	claims, err := auth.ClaimsFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	req := &loan.ApplyRequest{
+		Method:    loan.Method_Flat,
+		Frequency: loan.Frequency_Weekly,
+	}
+	data := make(map[string]interface{})
+	f := func() (bool, error) {
+		if r.Method == http.MethodPost {
+			err := r.ParseForm()
+			if err != nil {
+				return false, err
+			}
+
+			decoder := schema.NewDecoder()
+			decoder.IgnoreUnknownKeys(true)
+
+			if err := decoder.Decode(req, r.PostForm); err != nil {
+				return false, err
+			}
+			req.AccountID = accountID
+
+			l, err := h.LoanRepo.Apply(ctx, claims, *req, ctxValues.Now)
+			if err != nil {
+				if verr, ok := weberror.NewValidationError(ctx, err); ok {
+					data["validationErrors"] = verr.(*weberror.Error)
+					return false, nil
+				}
+
+				// Applications the savings history does not support are shown with the reasons.
+				werr, ok := weberror.NewError(ctx, err, 0).(*weberror.Error)
+				if !ok || werr.Status >= http.StatusInternalServerError {
+					return false, err
+				}
+				data["error"] = werr.Message
+				if werr.Message == "" {
+					data["error"] = werr.Error()
+				}
+				return false, nil
+			}
+
+			webcontext.SessionFlashSuccess(ctx,
+				"Loan Application Recorded",
+				fmt.Sprintf("The application for %s is waiting for approval.", l.Principal))
+
+			return true, web.Redirect(ctx, w, r, urlLoansView(l.ID), http.StatusFound)
+		}
+
+		return false, nil
+	}
+
+	end, err := f()
+	if err != nil {
+		return web.RenderError(ctx, w, r, err, h.Renderer, TmplLayoutBase, TmplContentErrorGeneric, web.MIMETextHTMLCharsetUTF8)
+	} else if end {
+		return nil
+	}
+
+	acc, err := h.AccountRepo.ReadByID(ctx, claims, accountID)
+	if err != nil {
+		return weberror.NewError(ctx, err, 404)
+	}
+	data["account"] = acc.Response(ctx)
+
+	eligibility, err := h.LoanRepo.CheckEligibility(ctx, claims, accountID, req.Principal, ctxValues.Now)
+	if err != nil {
+		return err
+	}
+	data["maxPrincipal"] = eligibility.MaxPrincipal
+
+	data["form"] = req
+	data["urlCustomersIndex"] = urlCustomersIndex()
+	data["urlCustomersView"] = urlCustomersView(customerID)
+	data["urlCustomersAccountsView"] = urlCustomersAccountsView(customerID, accountID)
+
+	if verr, ok := weberror.NewValidationError(ctx, webcontext.Validator().Struct(loan.ApplyRequest{})); ok {
+		data["validationDefaults"] = verr.(*weberror.Error)
+	}
+
+	return h.Renderer.Render(ctx, w, r, TmplLayoutBase, "loans-apply.gohtml", web.MIMETextHTMLCharsetUTF8, http.StatusOK, data)
+}
+
+// View shows a loan with its repayment schedule and repayments, and the actions that can be
+// taken on it in its current status.
+func (h *Loans) View(ctx context.Context, w http.ResponseWriter, r *http.Request, params map[string]string) error {
+
+	claims, err := auth.ClaimsFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	l, err := h.LoanRepo.Read(ctx, claims, loan.ReadRequest{ID: params["loan_id"]})
+	if err != nil {
+		return err
+	}
+
+	isAdmin := claims.HasRole(auth.RoleAdmin, auth.RoleSuperAdmin)
+
+	data := map[string]interface{}{
+		"loan":                     l.Response(ctx),
+		"isAdmin":                  isAdmin,
+		"canApprove":               isAdmin && l.AppliedByID != claims.Subject,
+		"urlLoansIndex":            urlLoansIndex(),
+		"urlLoansApprove":          urlLoansApprove(l.ID),
+		"urlLoansReject":           urlLoansReject(l.ID),
+		"urlLoansDisburse":         urlLoansDisburse(l.ID),
+		"urlLoansRepay":            urlLoansRepay(l.ID),
+		"urlCustomersView":         urlCustomersView(l.CustomerID),
+		"urlCustomersAccountsView": urlCustomersAccountsView(l.CustomerID, l.AccountID),
+	}
+
+	return h.Renderer.Render(ctx, w, r, TmplLayoutBase, "loans-view.gohtml", web.MIMETextHTMLCharsetUTF8, http.StatusOK, data)
+}
+
+// Approve approves a pending loan application.
+func (h *Loans) Approve(ctx context.Context, w http.ResponseWriter, r *http.Request, params map[string]string) error {
+
+	ctxValues, err := webcontext.ContextValues(ctx)
+	if err != nil {
+		return err
+	}
+
+	claims, err := auth.ClaimsFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	loanID := params["loan_id"]
+	if _, err := h.LoanRepo.Approve(ctx, claims, loan.ApproveRequest{ID: loanID}, ctxValues.Now); err != nil {
+		return h.actionFailed(ctx, w, r, loanID, err)
+	}
+
+	webcontext.SessionFlashSuccess(ctx,
+		"Loan Approved",
+		"The loan has been approved and can now be disbursed.")
+
+	return web.Redirect(ctx, w, r, urlLoansView(loanID), http.StatusFound)
+}
+
+// Reject declines a loan application with the reason entered.
+func (h *Loans) Reject(ctx context.Context, w http.ResponseWriter, r *http.Request, params map[string]string) error {
+
+	ctxValues, err := webcontext.ContextValues(ctx)
+	if err != nil {
+		return err
+	}
+
+	claims, err := auth.ClaimsFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err := r.ParseForm(); err != nil {
+		return err
+	}
+
+	req := new(loan.RejectRequest)
+	decoder := schema.NewDecoder()
+	decoder.IgnoreUnknownKeys(true)
+	if err := decoder.Decode(req, r.PostForm); err != nil {
+		return err
+	}
+	req.ID = params["loan_id"]
+
+	if _, err := h.LoanRepo.Reject(ctx, claims, *req, ctxValues.Now); err != nil {
+		return h.actionFailed(ctx, w, r, req.ID, err)
+	}
+
+	webcontext.SessionFlashSuccess(ctx,
+		"Loan Rejected",
+		"The loan application has been rejected.")
+
+	return web.Redirect(ctx, w, r, urlLoansView(req.ID), http.StatusFound)
+}
+
+// Disburse pays out an approved loan to the savings account or in cash.
+func (h *Loans) Disburse(ctx context.Context, w http.ResponseWriter, r *http.Request, params map[string]string) error {
+
+	ctxValues, err := webcontext.ContextValues(ctx)
+	if err != nil {
+		return err
+	}
+
+	claims, err := auth.ClaimsFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err := r.ParseForm(); err != nil {
+		return err
+	}
+
+	req := new(loan.DisburseRequest)
+	decoder := schema.NewDecoder()
+	decoder.IgnoreUnknownKeys(true)
+	if err := decoder.Decode(req, r.PostForm); err != nil {
+		return err
+	}
+	req.ID = params["loan_id"]
+
+	l, err := h.LoanRepo.Disburse(ctx, claims, *req, ctxValues.Now)
+	if err != nil {
+		return h.actionFailed(ctx, w, r, req.ID, err)
+	}
+
+	msg := fmt.Sprintf("%s has been credited to account %s.", l.Principal, l.AccountNumber)
+	if l.DisbursementMethod == loan.Disbursement_Cash {
+		msg = fmt.Sprintf("%s has been paid out in cash.", l.Principal)
+	}
+	webcontext.SessionFlashSuccess(ctx, "Loan Disbursed", msg)
+
+	return web.Redirect(ctx, w, r, urlLoansView(req.ID), http.StatusFound)
+}
+
+// Repay records a repayment on an active loan.
+func (h *Loans) Repay(ctx context.Context, w http.ResponseWriter, r *http.Request, params map[string]string) error {
+
+	ctxValues, err := webcontext.ContextValues(ctx)
+	if err != nil {
+		return err
+	}
+
+	claims, err := auth.ClaimsFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err := r.ParseForm(); err != nil {
+		return err
+	}
+
+	req := new(loan.RepayRequest)
+	decoder := schema.NewDecoder()
+	decoder.IgnoreUnknownKeys(true)
+	if err := decoder.Decode(req, r.PostForm); err != nil {
+		return err
+	}
+	req.ID = params["loan_id"]
+
+	repayment, err := h.LoanRepo.Repay(ctx, claims, *req, ctxValues.Now)
+	if err != nil {
+		return h.actionFailed(ctx, w, r, req.ID, err)
+	}
+
+	webcontext.SessionFlashSuccess(ctx,
+		"Repayment Recorded",
+		fmt.Sprintf("%s received: %s principal, %s interest and %s penalty.",
+			repayment.Amount, repayment.Principal, repayment.Interest, repayment.Penalty))
+
+	return web.Redirect(ctx, w, r, urlLoansView(req.ID), http.StatusFound)
+}
+
+// actionFailed shows why an action could not be taken on the loan page.
+func (h *Loans) actionFailed(ctx context.Context, w http.ResponseWriter, r *http.Request, loanID string, err error) error {
+	werr, ok := weberror.NewError(ctx, err, 0).(*weberror.Error)
+	if !ok || werr.Status >= http.StatusInternalServerError {
+		return err
+	}
+
+	msg := werr.Message
+	if msg == "" {
+		msg = werr.Error()
+	}
+	var items []string
+	for _, f := range werr.Fields {
+		items = append(items, f.Display)
+	}
+
+	webcontext.SessionFlashError(ctx, "Loan Not Updated", msg, items...)
+	return web.Redirect(ctx, w, r, urlLoansView(loanID), http.StatusFound)
+}
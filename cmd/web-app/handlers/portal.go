@@ -0,0 +1,394 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"merryworld/surebank/internal/customer_portal"
+	"merryworld/surebank/internal/platform/auth"
+	"merryworld/surebank/internal/platform/web"
+	"merryworld/surebank/internal/platform/web/webcontext"
+	"merryworld/surebank/internal/platform/web/weberror"
+
+	"github.com/gorilla/schema"
+)
+
+// portalSessionTTL is how long a customer stays logged in to the portal.
+const portalSessionTTL = time.Hour
+
+// portalTransactionsPerPage is the number of transactions shown on each page of an account.
+const portalTransactionsPerPage = 20
+
+// Portal represents the customer portal handler set. Its routes are only reachable with the
+// session of a customer and staff routes are never reachable with one.
+type Portal struct {
+	PortalRepo *customer_portal.Repository
+	Renderer   web.Renderer
+}
+
+func urlPortalIndex() string {
+	return "/portal"
+}
+
+func urlPortalLogin() string {
+	return "/portal/login"
+}
+
+func urlPortalAccount(accountID string) string {
+	return fmt.Sprintf("/portal/accounts/%s", accountID)
+}
+
+func urlPortalAccountWithdraw(accountID string) string {
+	return fmt.Sprintf("/portal/accounts/%s/withdraw", accountID)
+}
+
+func urlPortalWithdrawalCancel(withdrawalID string) string {
+	return fmt.Sprintf("/portal/withdrawals/%s/cancel", withdrawalID)
+}
+
+// PortalLoginRequest holds the phone number a code is sent to and the code once it is entered.
+type PortalLoginRequest struct {
+	PhoneNumber string
+	Code        string
+}
+
+// Login sends a customer a login code for their phone number and logs them in with it.
+func (h *Portal) Login(ctx context.Context, w http.ResponseWriter, r *http.Request, params map[string]string) error {
+
+	ctxValues, err := webcontext.ContextValues(ctx)
+	if err != nil {
+		return err
+	}
+
+	req := new(PortalLoginRequest)
+	data := make(map[string]interface{})
+	f := func() (bool, error) {
+		if r.Method == http.MethodPost {
+			err := r.ParseForm()
+			if err != nil {
+				return false, err
+			}
+
+			decoder := schema.NewDecoder()
+			decoder.IgnoreUnknownKeys(true)
+
+			if err := decoder.Decode(req, r.PostForm); err != nil {
+				return false, err
+			}
+
+			if req.Code == "" || r.PostForm.Get("action") == "resend" {
+				err = h.PortalRepo.RequestCode(ctx, customer_portal.RequestCodeRequest{
+					PhoneNumber: req.PhoneNumber,
+				}, ctxValues.Now)
+				if err != nil {
+					if verr, ok := weberror.NewValidationError(ctx, err); ok {
+						data["validationErrors"] = verr.(*weberror.Error)
+						return false, nil
+					}
+					return false, err
+				}
+
+				req.Code = ""
+				data["codeSent"] = true
+				return false, nil
+			}
+
+			token, err := h.PortalRepo.Login(ctx, customer_portal.LoginRequest{
+				PhoneNumber: req.PhoneNumber,
+				Code:        req.Code,
+			}, portalSessionTTL, ctxValues.Now)
+			if err != nil {
+				data["codeSent"] = true
+
+				if verr, ok := weberror.NewValidationError(ctx, err); ok {
+					data["validationErrors"] = verr.(*weberror.Error)
+					return false, nil
+				}
+
+				werr, ok := weberror.NewError(ctx, err, 0).(*weberror.Error)
+				if !ok || werr.Status >= http.StatusInternalServerError {
+					return false, err
+				}
+				data["error"] = werr
+				return false, nil
+			}
+
+			// Add the token to the session of the customer.
+			if err := handleSessionToken(ctx, w, r, token); err != nil {
+				return false, err
+			}
+
+			return true, web.Redirect(ctx, w, r, urlPortalIndex(), http.StatusFound)
+		}
+
+		return false, nil
+	}
+
+	end, err := f()
+	if err != nil {
+		return web.RenderError(ctx, w, r, err, h.Renderer, TmplLayoutBase, TmplContentErrorGeneric, web.MIMETextHTMLCharsetUTF8)
+	} else if end {
+		return nil
+	}
+
+	data["form"] = req
+	data["codeLength"] = customer_portal.CodeLength
+	data["urlPortalLogin"] = urlPortalLogin()
+
+	if verr, ok := weberror.NewValidationError(ctx, webcontext.Validator().Struct(customer_portal.LoginRequest{})); ok {
+		data["validationDefaults"] = verr.(*weberror.Error)
+	}
+
+	return h.Renderer.Render(ctx, w, r, TmplLayoutBase, "portal-login.gohtml", web.MIMETextHTMLCharsetUTF8, http.StatusOK, data)
+}
+
+// Logout ends the session of the customer.
+func (h *Portal) Logout(ctx context.Context, w http.ResponseWriter, r *http.Request, params map[string]string) error {
+
+	sess := webcontext.ContextSession(ctx)
+
+	// Set the access token to empty to logout the customer.
+	sess = webcontext.SessionDestroy(sess)
+
+	if err := sess.Save(r, w); err != nil {
+		return err
+	}
+
+	return web.Redirect(ctx, w, r, urlPortalLogin(), http.StatusFound)
+}
+
+// Index shows the accounts of the customer with their balances and their withdrawal requests.
+func (h *Portal) Index(ctx context.Context, w http.ResponseWriter, r *http.Request, params map[string]string) error {
+
+	ctxValues, err := webcontext.ContextValues(ctx)
+	if err != nil {
+		return err
+	}
+
+	claims, err := auth.ClaimsFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	cust, err := h.PortalRepo.Customer(ctx, claims)
+	if err != nil {
+		return err
+	}
+
+	accounts, err := h.PortalRepo.Accounts(ctx, claims, ctxValues.Now)
+	if err != nil {
+		return err
+	}
+
+	limit := uint(20)
+	withdrawals, err := h.PortalRepo.FindWithdrawals(ctx, claims, customer_portal.WithdrawalFindRequest{
+		Limit: &limit,
+	})
+	if err != nil {
+		return err
+	}
+
+	type accountRow struct {
+		*customer_portal.AccountResponse
+		URLView     string
+		URLWithdraw string
+	}
+	var accountRows []accountRow
+	for _, a := range accounts {
+		accountRows = append(accountRows, accountRow{
+			AccountResponse: a,
+			URLView:         urlPortalAccount(a.ID),
+			URLWithdraw:     urlPortalAccountWithdraw(a.ID),
+		})
+	}
+
+	type withdrawalRow struct {
+		*customer_portal.WithdrawalResponse
+		URLCancel string
+	}
+	var withdrawalRows []withdrawalRow
+	for _, wr := range withdrawals.Response(ctx) {
+		row := withdrawalRow{WithdrawalResponse: wr}
+		if wr.Status == customer_portal.WithdrawalStatus_Pending {
+			row.URLCancel = urlPortalWithdrawalCancel(wr.ID)
+		}
+		withdrawalRows = append(withdrawalRows, row)
+	}
+
+	data := map[string]interface{}{
+		"customer":    cust.Response(ctx),
+		"accounts":    accountRows,
+		"withdrawals": withdrawalRows,
+	}
+
+	return h.Renderer.Render(ctx, w, r, TmplLayoutBase, "portal-index.gohtml", web.MIMETextHTMLCharsetUTF8, http.StatusOK, data)
+}
+
+// Account shows an account of the customer with its transactions and, for daily savings
+// accounts, the progress of its cycles.
+func (h *Portal) Account(ctx context.Context, w http.ResponseWriter, r *http.Request, params map[string]string) error {
+
+	ctxValues, err := webcontext.ContextValues(ctx)
+	if err != nil {
+		return err
+	}
+
+	claims, err := auth.ClaimsFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	accountID := params["account_id"]
+
+	acc, err := h.PortalRepo.Account(ctx, claims, accountID, ctxValues.Now)
+	if err != nil {
+		return err
+	}
+
+	page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+	if page < 1 {
+		page = 1
+	}
+
+	txs, total, err := h.PortalRepo.Transactions(ctx, claims, accountID, portalTransactionsPerPage, (page-1)*portalTransactionsPerPage)
+	if err != nil {
+		return err
+	}
+
+	cycles, err := h.PortalRepo.Cycles(ctx, claims, accountID)
+	if err != nil {
+		return err
+	}
+
+	data := map[string]interface{}{
+		"account":                  acc,
+		"transactions":             txs.Response(ctx),
+		"cycles":                   cycles.Response(ctx),
+		"urlPortalIndex":           urlPortalIndex(),
+		"urlPortalAccountWithdraw": urlPortalAccountWithdraw(accountID),
+	}
+
+	if page > 1 {
+		data["urlPrevPage"] = fmt.Sprintf("%s?page=%d", urlPortalAccount(accountID), page-1)
+	}
+	if int64(page*portalTransactionsPerPage) < total {
+		data["urlNextPage"] = fmt.Sprintf("%s?page=%d", urlPortalAccount(accountID), page+1)
+	}
+
+	return h.Renderer.Render(ctx, w, r, TmplLayoutBase, "portal-account.gohtml", web.MIMETextHTMLCharsetUTF8, http.StatusOK, data)
+}
+
+// Withdraw handles the customer requesting a withdrawal from one of their accounts.
+func (h *Portal) Withdraw(ctx context.Context, w http.ResponseWriter, r *http.Request, params map[string]string) error {
+
+	ctxValues, err := webcontext.ContextValues(ctx)
+	if err != nil {
+		return err
+	}
+
+	claims, err := auth.ClaimsFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	accountID := params["account_id"]
+
+	acc, err := h.PortalRepo.Account(ctx, claims, accountID, ctxValues.Now)
+	if err != nil {
+		return err
+	}
+
+	req := new(customer_portal.WithdrawalCreateRequest)
+	data := make(map[string]interface{})
+	f := func() (bool, error) {
+		if r.Method == http.MethodPost {
+			err := r.ParseForm()
+			if err != nil {
+				return false, err
+			}
+
+			decoder := schema.NewDecoder()
+			decoder.IgnoreUnknownKeys(true)
+
+			if err := decoder.Decode(req, r.PostForm); err != nil {
+				return false, weberror.NewErrorMessage(ctx, err, http.StatusBadRequest, "Enter the amount as a number")
+			}
+			req.AccountID = accountID
+
+			withdrawal, err := h.PortalRepo.RequestWithdrawal(ctx, claims, *req, ctxValues.Now)
+			if err != nil {
+				if verr, ok := weberror.NewValidationError(ctx, err); ok {
+					data["validationErrors"] = verr.(*weberror.Error)
+					return false, nil
+				}
+
+				werr, ok := weberror.NewError(ctx, err, 0).(*weberror.Error)
+				if !ok || werr.Status >= http.StatusInternalServerError {
+					return false, err
+				}
+				data["error"] = werr
+				return false, nil
+			}
+
+			webcontext.SessionFlashSuccess(ctx,
+				"Withdrawal Requested",
+				fmt.Sprintf("Your request to withdraw %s from %s has been sent to the branch. You will get an SMS once it is paid out.",
+					withdrawal.Amount, acc.Number))
+
+			return true, web.Redirect(ctx, w, r, urlPortalIndex(), http.StatusFound)
+		}
+
+		return false, nil
+	}
+
+	end, err := f()
+	if err != nil {
+		return web.RenderError(ctx, w, r, err, h.Renderer, TmplLayoutBase, TmplContentErrorGeneric, web.MIMETextHTMLCharsetUTF8)
+	} else if end {
+		return nil
+	}
+
+	data["form"] = req
+	data["account"] = acc
+	data["urlPortalIndex"] = urlPortalIndex()
+	data["urlPortalAccount"] = urlPortalAccount(accountID)
+
+	if verr, ok := weberror.NewValidationError(ctx, webcontext.Validator().Struct(customer_portal.WithdrawalCreateRequest{})); ok {
+		data["validationDefaults"] = verr.(*weberror.Error)
+	}
+
+	return h.Renderer.Render(ctx, w, r, TmplLayoutBase, "portal-withdraw.gohtml", web.MIMETextHTMLCharsetUTF8, http.StatusOK, data)
+}
+
+// Cancel cancels a pending withdrawal request of the customer.
+func (h *Portal) Cancel(ctx context.Context, w http.ResponseWriter, r *http.Request, params map[string]string) error {
+
+	ctxValues, err := webcontext.ContextValues(ctx)
+	if err != nil {
+		return err
+	}
+
+	claims, err := auth.ClaimsFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err := h.PortalRepo.CancelWithdrawal(ctx, claims, params["withdrawal_id"], ctxValues.Now); err != nil {
+		werr, ok := weberror.NewError(ctx, err, 0).(*weberror.Error)
+		if !ok || werr.Status >= http.StatusInternalServerError {
+			return err
+		}
+
+		webcontext.SessionFlashError(ctx, "Request Not Cancelled", werr.Error())
+		return web.Redirect(ctx, w, r, urlPortalIndex(), http.StatusFound)
+	}
+
+	webcontext.SessionFlashSuccess(ctx,
+		"Request Cancelled",
+		"Your withdrawal request has been cancelled.")
+
+	return web.Redirect(ctx, w, r, urlPortalIndex(), http.StatusFound)
+}
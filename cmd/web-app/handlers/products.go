@@ -93,13 +93,13 @@ func productDatatable(ctx context.Context, shopRepo *shop.Repository, redisClien
 				v.Value = q.Sku
 				v.Formatted = q.Sku
 			case "cost_price":
-				v.Value = fmt.Sprintf("%f", q.Price)
+				v.Value = q.Price.String()
 				p := message.NewPrinter(language.English)
-				v.Formatted = p.Sprintf("%.2f", q.CostPrice)
+				v.Formatted = p.Sprintf("%.2f", q.CostPrice.Float())
 			case "price":
-				v.Value = fmt.Sprintf("%f", q.Price)
+				v.Value = q.Price.String()
 				p := message.NewPrinter(language.English)
-				v.Formatted = p.Sprintf("%.2f", q.Price)
+				v.Formatted = p.Sprintf("%.2f", q.Price.Float())
 			default:
 				return resp, errors.Errorf("Failed to map value for %s.", col.Field)
 			}
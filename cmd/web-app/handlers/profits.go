@@ -88,9 +88,9 @@ func profitDatatable(ctx context.Context, repo *profit.Repository, redisClient *
 				v.Value = q.Narration
 				v.Formatted = fmt.Sprintf("<a href='%s'>%s</a>", urlProfitsView(q.ID), v.Value)
 			case "amount":
-				v.Value = fmt.Sprintf("%f", q.Amount)
+				v.Value = q.Amount.String()
 				p := message.NewPrinter(language.English)
-				v.Formatted = p.Sprintf("%.2f", q.Amount)
+				v.Formatted = p.Sprintf("%.2f", q.Amount.Float())
 			default:
 				return resp, errors.Errorf("Failed to map value for %s.", col.Field)
 			}
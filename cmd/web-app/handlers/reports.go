@@ -4,12 +4,15 @@ import (
 	"context"
 	"fmt"
 	"merryworld/surebank/internal/account"
+	"merryworld/surebank/internal/branch"
 	"merryworld/surebank/internal/customer"
 	"merryworld/surebank/internal/dscommission"
 	"merryworld/surebank/internal/platform/auth"
 	"merryworld/surebank/internal/platform/datatable"
+	"merryworld/surebank/internal/platform/money"
 	"merryworld/surebank/internal/platform/web"
 	"merryworld/surebank/internal/platform/web/webcontext"
+	"merryworld/surebank/internal/platform/web/weberror"
 	"merryworld/surebank/internal/shop"
 	"merryworld/surebank/internal/transaction"
 	"merryworld/surebank/internal/user"
@@ -32,6 +35,7 @@ type Reports struct {
 	ShopRepo        *shop.Repository
 	UserRepos       *user.Repository
 	CommissionRepo  *dscommission.Repository
+	BranchRepo      *branch.Repository
 	Renderer        web.Renderer
 	Redis           *redis.Client
 }
@@ -40,7 +44,7 @@ type Reports struct {
 func (h *Reports) Transactions(ctx context.Context, w http.ResponseWriter, r *http.Request, params map[string]string) error {
 
 	var data = make(map[string]interface{})
-	var total float64
+	var total money.Amount
 
 	claims, err := auth.ClaimsFromContext(ctx)
 	if err != nil {
@@ -64,9 +68,9 @@ func (h *Reports) Transactions(ctx context.Context, w http.ResponseWriter, r *ht
 			case "id":
 				v.Value = fmt.Sprintf("%s", q.ID)
 			case "amount":
-				v.Value = fmt.Sprintf("%f", q.Amount)
+				v.Value = q.Amount.String()
 				p := message.NewPrinter(language.English)
-				v.Formatted = p.Sprintf("<a href='%s'>%.2f</a>", urlCustomersTransactionsView(q.CustomerID, q.AccountID, q.ID), q.Amount)
+				v.Formatted = p.Sprintf("<a href='%s'>%.2f</a>", urlCustomersTransactionsView(q.CustomerID, q.AccountID, q.ID), q.Amount.Float())
 			case "created_at":
 				date := web.NewTimeResponse(ctx, time.Unix(q.CreatedAt, 0))
 				v.Value = date.LocalDate
@@ -103,7 +107,7 @@ func (h *Reports) Transactions(ctx context.Context, w http.ResponseWriter, r *ht
 		return resp, nil
 	}
 
-	var txWhere = []string{"tx_type = 'deposit'"}
+	var txWhere = []string{"tx_type = 'deposit'", "transfer_id is null"}
 	var txArgs []interface{}
 	// todo sales rep filtering
 	if v := r.URL.Query().Get("sales_rep_id"); v != "" {
@@ -240,9 +244,9 @@ func (h *Reports) Withdrawals(ctx context.Context, w http.ResponseWriter, r *htt
 			case "id":
 				v.Value = fmt.Sprintf("%s", q.ID)
 			case "amount":
-				v.Value = fmt.Sprintf("%f", q.Amount)
+				v.Value = q.Amount.String()
 				p := message.NewPrinter(language.English)
-				v.Formatted = p.Sprintf("<a href='%s'>%.2f</a>", urlCustomersTransactionsView(q.CustomerID, q.AccountID, q.ID), q.Amount)
+				v.Formatted = p.Sprintf("<a href='%s'>%.2f</a>", urlCustomersTransactionsView(q.CustomerID, q.AccountID, q.ID), q.Amount.Float())
 			case "created_at":
 				date := web.NewTimeResponse(ctx, time.Unix(q.CreatedAt, 0))
 				v.Value = date.LocalDate
@@ -377,11 +381,12 @@ func (h *Reports) Ds(ctx context.Context, w http.ResponseWriter, r *http.Request
 		{Field: "number", Title: "Account Number", Visible: true, Searchable: true, Orderable: true, Filterable: true, FilterPlaceholder: "filter Date"},
 		{Field: "target", Title: "Daily Contribution", Visible: true, Searchable: true, Orderable: true, Filterable: true, FilterPlaceholder: "filter Narration"},
 		{Field: "balance", Title: "Account Balance", Visible: true, Searchable: false, Orderable: true, Filterable: false},
+		{Field: "cycle", Title: "Current Cycle", Visible: true, Searchable: false, Orderable: false, Filterable: false},
 		{Field: "sales_rep_id", Title: "Account Manager", Visible: true, Searchable: true, Orderable: false, Filterable: true, FilterPlaceholder: "filter Recorder"},
 		{Field: "created_at", Title: "Registration Date", Visible: true, Searchable: false, Orderable: true, Filterable: false},
 	}
 
-	mapFunc := func(q *account.Response, cols []datatable.DisplayField) (resp []datatable.ColumnValue, err error) {
+	mapFunc := func(q *account.Response, cycle *transaction.CycleResponse, cols []datatable.DisplayField) (resp []datatable.ColumnValue, err error) {
 		for i := 0; i < len(cols); i++ {
 			col := cols[i]
 			var v datatable.ColumnValue
@@ -395,13 +400,23 @@ func (h *Reports) Ds(ctx context.Context, w http.ResponseWriter, r *http.Request
 				v.Value = q.Number
 				v.Formatted = fmt.Sprintf("<a href='%s'>%s</a>", urlCustomersAccountsView(q.CustomerID, q.ID), q.Number)
 			case "target":
-				v.Value = fmt.Sprintf("%f", q.Balance)
+				v.Value = q.Balance.String()
 				p := message.NewPrinter(language.English)
-				v.Formatted = p.Sprintf("%.2f", q.Balance)
+				v.Formatted = p.Sprintf("%.2f", q.Balance.Float())
 			case "balance":
-				v.Value = fmt.Sprintf("%f", q.Balance)
+				v.Value = q.Balance.String()
 				p := message.NewPrinter(language.English)
-				v.Formatted = p.Sprintf("%.2f", q.Balance)
+				v.Formatted = p.Sprintf("%.2f", q.Balance.Float())
+			case "cycle":
+				if cycle == nil {
+					break
+				}
+				v.Value = fmt.Sprintf("%d", cycle.Number)
+				v.Formatted = fmt.Sprintf("<a href='%s'>%d</a> - %d paid, %d missed",
+					urlCustomersAccountCycle(q.CustomerID, q.ID, cycle.ID), cycle.Number, cycle.DaysPaid, cycle.DaysMissed)
+				if cycle.Due {
+					v.Formatted += " <span class='badge badge-warning'>Due</span>"
+				}
 			case "sales_rep_id":
 				v.Value = q.SalesRepID
 				v.Formatted = fmt.Sprintf("<a href='%s'>%s</a>", urlUsersView(q.SalesRepID), q.SalesRep)
@@ -434,8 +449,20 @@ func (h *Reports) Ds(ctx context.Context, w http.ResponseWriter, r *http.Request
 			return resp, err
 		}
 
+		cycles, err := h.TransactionRepo.FindCycles(ctx, claims, transaction.CycleFindRequest{
+			Where: "status = ?",
+			Args:  []interface{}{transaction.CycleStatus_Open},
+		})
+		if err != nil {
+			return resp, err
+		}
+		openCycles := make(map[string]*transaction.CycleResponse)
+		for _, c := range cycles {
+			openCycles[c.AccountID] = c.Response(ctx)
+		}
+
 		for _, a := range res.Accounts {
-			l, err := mapFunc(a, fields)
+			l, err := mapFunc(a, openCycles[a.ID], fields)
 			if err != nil {
 				return resp, errors.Wrapf(err, "Failed to map DS accounts for display.")
 			}
@@ -467,7 +494,8 @@ func (h *Reports) Ds(ctx context.Context, w http.ResponseWriter, r *http.Request
 	return h.Renderer.Render(ctx, w, r, TmplLayoutBase, "report-ds.gohtml", web.MIMETextHTMLCharsetUTF8, http.StatusOK, data)
 }
 
-// Ds handles listing of all the Ds account types
+// Debtors shows the aging of the DS accounts that are behind on their contributions by branch and
+// sales rep, with the debtors that match the filters and the last call made to each.
 func (h *Reports) Debtors(ctx context.Context, w http.ResponseWriter, r *http.Request, params map[string]string) error {
 
 	ctxValue, err := webcontext.ContextValues(ctx)
@@ -475,115 +503,128 @@ func (h *Reports) Debtors(ctx context.Context, w http.ResponseWriter, r *http.Re
 		return err
 	}
 
-	var data = make(map[string]interface{})
-
 	claims, err := auth.ClaimsFromContext(ctx)
 	if err != nil {
 		return err
 	}
 
-	fields := []datatable.DisplayField{
-		{Field: "id", Title: "ID", Visible: false, Searchable: true, Orderable: true, Filterable: false},
-		{Field: "customer", Title: "Name", Visible: true, Searchable: false, Orderable: true, Filterable: true, FilterPlaceholder: "filter Quantity"},
-		{Field: "phone_number", Title: "Phone Number", Visible: true, Searchable: false, Orderable: true, Filterable: true, FilterPlaceholder: "filter Quantity"},
-		{Field: "number", Title: "Account Number", Visible: true, Searchable: true, Orderable: true, Filterable: true, FilterPlaceholder: "filter Date"},
-		{Field: "last_payment_date", Title: "Last Payment Date", Visible: true, Searchable: true, Orderable: true, Filterable: true, FilterPlaceholder: "filter Narration"},
-		{Field: "target", Title: "Daily Contribution", Visible: true, Searchable: true, Orderable: true, Filterable: true, FilterPlaceholder: "filter Narration"},
-		{Field: "balance", Title: "Account Balance", Visible: true, Searchable: false, Orderable: true, Filterable: false},
-		{Field: "sales_rep_id", Title: "Account Manager", Visible: true, Searchable: true, Orderable: false, Filterable: true, FilterPlaceholder: "filter Recorder"},
-		{Field: "created_at", Title: "Registration Date", Visible: true, Searchable: false, Orderable: true, Filterable: false},
+	q := r.URL.Query()
+	req := account.DebtorsRequest{
+		BranchID:   q.Get("branch_id"),
+		SalesRepID: q.Get("sales_rep_id"),
+		Bucket:     q.Get("bucket"),
+		FollowUp:   q.Get("follow_up"),
 	}
 
-	mapFunc := func(q *account.Response, cols []datatable.DisplayField) (resp []datatable.ColumnValue, err error) {
-		for i := 0; i < len(cols); i++ {
-			col := cols[i]
-			var v datatable.ColumnValue
-			switch col.Field {
-			case "id":
-				v.Value = fmt.Sprintf("%s", q.ID)
-			case "customer":
-				v.Value = q.Customer.ShortName
-				v.Formatted = fmt.Sprintf("<a href='%s'>%s</a>", urlCustomersView(q.CustomerID), q.Customer.ShortName)
-			case "phone_number":
-				v.Value = q.Customer.PhoneNumber
-				v.Formatted = v.Value
-			case "number":
-				v.Value = q.Number
-				v.Formatted = fmt.Sprintf("<a href='%s'>%s</a>", urlCustomersAccountsView(q.CustomerID, q.ID), q.Number)
-			case "last_payment_date":
-				v.Value = q.LastPaymentDate.LocalDate
-				v.Formatted = v.Value
-			case "target":
-				v.Value = fmt.Sprintf("%f", q.Balance)
-				p := message.NewPrinter(language.English)
-				v.Formatted = p.Sprintf("%.2f", q.Balance)
-			case "balance":
-				v.Value = fmt.Sprintf("%f", q.Balance)
-				p := message.NewPrinter(language.English)
-				v.Formatted = p.Sprintf("%.2f", q.Balance)
-			case "sales_rep_id":
-				v.Value = q.SalesRepID
-				v.Formatted = fmt.Sprintf("<a href='%s'>%s</a>", urlUsersView(q.SalesRepID), q.SalesRep)
-			case "created_at":
-				v.Value = q.CreatedAt.LocalDate
-				v.Formatted = v.Value
-			default:
-				return resp, errors.Errorf("Failed to map value for %s.", col.Field)
-			}
-			resp = append(resp, v)
+	// Admins see the debtors of their branch and sales reps their own.
+	if !claims.HasRole(auth.RoleSuperAdmin) {
+		u, err := h.UserRepos.ReadByID(ctx, claims, claims.Subject)
+		if err != nil {
+			return err
 		}
+		req.BranchID = u.BranchID
 
-		return resp, nil
+		if !claims.HasRole(auth.RoleAdmin) {
+			req.SalesRepID = claims.Subject
+		}
 	}
 
-	loadFunc := func(ctx context.Context, sorting string, fields []datatable.DisplayField) (resp [][]datatable.ColumnValue, err error) {
-
-		var order []string
-		if len(sorting) > 0 {
-			order = strings.Split(sorting, ",")
+	res, err := h.AccountRepo.Debtors(ctx, claims, req, ctxValue.Now)
+	if err != nil {
+		if _, ok := weberror.NewValidationError(ctx, err); ok {
+			return weberror.NewErrorMessage(ctx, err, http.StatusBadRequest, "Invalid debtors filter")
 		}
+		return err
+	}
 
-		res, err := h.AccountRepo.Debtors(ctx, claims, account.FindRequest{
-			Order:           order,
-			IncludeBranch:   true,
-			IncludeCustomer: true,
-			IncludeSalesRep: true,
-		}, ctxValue.Now)
+	data := map[string]interface{}{
+		"debtors":         res.Debtors,
+		"aging":           res.Aging,
+		"total":           res.Total,
+		"buckets":         account.AgingBuckets,
+		"branchID":        req.BranchID,
+		"salesRepID":      req.SalesRepID,
+		"bucket":          req.Bucket,
+		"followUp":        req.FollowUp,
+		"outcomeNames":    account.FollowUpOutcomeNames,
+		"followUpFilters": []string{account.DebtorFollowUp_None, account.DebtorFollowUp_Promised, account.DebtorFollowUp_Broken},
+	}
+
+	if claims.HasRole(auth.RoleSuperAdmin) {
+		data["branches"], err = h.BranchRepo.Find(ctx, claims, branch.FindRequest{
+			Order: []string{"name"},
+		})
 		if err != nil {
-			return resp, err
+			return err
 		}
+	}
 
-		for _, a := range res.Accounts {
-			l, err := mapFunc(a, fields)
-			if err != nil {
-				return resp, errors.Wrapf(err, "Failed to map DS accounts for display.")
-			}
-
-			resp = append(resp, l)
+	if claims.HasRole(auth.RoleAdmin, auth.RoleSuperAdmin) {
+		where := "branch_id = ?"
+		args := []interface{}{req.BranchID}
+		if req.BranchID == "" {
+			where, args = "", nil
+		}
+		data["users"], err = h.UserRepos.Find(ctx, claims, user.UserFindRequest{
+			Where: where,
+			Args:  args,
+			Order: []string{"first_name", "last_name"},
+		})
+		if err != nil {
+			return err
 		}
-
-		return resp, nil
 	}
 
-	dt, err := datatable.New(ctx, w, r, h.Redis, fields, loadFunc)
+	return h.Renderer.Render(ctx, w, r, TmplLayoutBase, "report-debtors.gohtml", web.MIMETextHTMLCharsetUTF8, http.StatusOK, data)
+}
+
+// AccountStatuses handles listing the accounts with a status, the dormant ones unless another
+// status is requested.
+func (h *Reports) AccountStatuses(ctx context.Context, w http.ResponseWriter, r *http.Request, params map[string]string) error {
+
+	claims, err := auth.ClaimsFromContext(ctx)
 	if err != nil {
 		return err
 	}
 
-	if dt.HasCache() {
-		return nil
+	req := account.FindRequest{
+		Status:          r.URL.Query().Get("status"),
+		Order:           []string{"last_payment_date"},
+		IncludeCustomer: true,
+		IncludeSalesRep: true,
+		IncludeBranch:   true,
+	}
+	if req.Status == "" {
+		req.Status = account.Status_Dormant
 	}
 
-	if ok, err := dt.Render(); ok {
+	// Admins see the accounts of their branch.
+	if !claims.HasRole(auth.RoleSuperAdmin) {
+		u, err := h.UserRepos.ReadByID(ctx, claims, claims.Subject)
 		if err != nil {
 			return err
 		}
-		return nil
+		req.Where = "branch_id = ?"
+		req.Args = []interface{}{u.BranchID}
 	}
 
-	data["datatable"] = dt.Response()
+	if err := webcontext.Validator().Struct(req); err != nil {
+		return weberror.NewErrorMessage(ctx, err, http.StatusBadRequest, "Invalid account status")
+	}
 
-	return h.Renderer.Render(ctx, w, r, TmplLayoutBase, "report-debtors.gohtml", web.MIMETextHTMLCharsetUTF8, http.StatusOK, data)
+	res, err := h.AccountRepo.Find(ctx, claims, req)
+	if err != nil {
+		return err
+	}
+
+	data := map[string]interface{}{
+		"accounts": res.Accounts,
+		"total":    res.TotalCount,
+		"status":   req.Status,
+		"statuses": account.Statuses,
+	}
+
+	return h.Renderer.Render(ctx, w, r, TmplLayoutBase, "report-accounts.gohtml", web.MIMETextHTMLCharsetUTF8, http.StatusOK, data)
 }
 
 // DsCommissions handles listing of all the Ds Commissions
@@ -619,9 +660,9 @@ func (h *Reports) DsCommissions(ctx context.Context, w http.ResponseWriter, r *h
 				v.Value = q.AccountNumber
 				v.Formatted = fmt.Sprintf("<a href='%s'>%s</a>", urlCustomersAccountsView(q.CustomerID, q.AccountID), q.AccountNumber)
 			case "amount":
-				v.Value = fmt.Sprintf("%f", q.Amount)
+				v.Value = q.Amount.String()
 				p := message.NewPrinter(language.English)
-				v.Formatted = p.Sprintf("%.2f", q.Amount)
+				v.Formatted = p.Sprintf("%.2f", q.Amount.Float())
 			case "effective_date":
 				v.Value = q.EffectiveDate.Local
 				v.Formatted = v.Value
@@ -10,6 +10,7 @@ import (
 	"merryworld/surebank/internal/account"
 	"merryworld/surebank/internal/customer"
 	"merryworld/surebank/internal/platform/auth"
+	"merryworld/surebank/internal/platform/money"
 	"merryworld/surebank/internal/platform/web"
 	"merryworld/surebank/internal/platform/web/webcontext"
 	"merryworld/surebank/internal/platform/web/weberror"
@@ -71,7 +72,7 @@ func (h *Root) indexDashboard(ctx context.Context, w http.ResponseWriter, r *htt
 
 	
 	statement := "select SUM(balance) total from account WHERE account_type = 'DS'"
-	var dsBalance null.Float64
+	var dsBalance null.Int64
 	rows := h.CustomerRepo.DbConn.QueryRow(statement)
 	err = rows.Scan(&dsBalance)
 	if err != nil {
@@ -79,22 +80,22 @@ func (h *Root) indexDashboard(ctx context.Context, w http.ResponseWriter, r *htt
 	}
 
 	statement = "select SUM(balance) total from account WHERE account_type = 'SB'"
-	var sbBalance null.Float64
+	var sbBalance null.Int64
 	rows = h.CustomerRepo.DbConn.QueryRow(statement)
 	err = rows.Scan(&sbBalance)
 	if err != nil {
 		return weberror.WithMessage(ctx, err, "Cannot get total DS balance")
 	}
 
-	data := map[string]interface{} {
-		"customerCount": customerCount,
-		"accountCount": accountCount,
-		"todayDeposit": todayDeposit,
+	data := map[string]interface{}{
+		"customerCount":   customerCount,
+		"accountCount":    accountCount,
+		"todayDeposit":    todayDeposit,
 		"thisWeekDeposit": thisWeekDeposit,
-		"dsBalance": dsBalance.Float64,
-		"sbBalance": sbBalance.Float64,
+		"dsBalance":       money.Amount(dsBalance.Int64),
+		"sbBalance":       money.Amount(sbBalance.Int64),
 	}
-	
+
 	return h.Renderer.Render(ctx, w, r, TmplLayoutBase, "root-dashboard.gohtml",
 		web.MIMETextHTMLCharsetUTF8, http.StatusOK, data)
 }
@@ -5,10 +5,16 @@ import (
 	"fmt"
 	"log"
 	"merryworld/surebank/internal/account"
+	"merryworld/surebank/internal/account_product"
 	"merryworld/surebank/internal/customer"
+	"merryworld/surebank/internal/customer_import"
+	"merryworld/surebank/internal/customer_portal"
 	"merryworld/surebank/internal/dscommission"
 	"merryworld/surebank/internal/expenditure"
+	"merryworld/surebank/internal/integrity"
 	"merryworld/surebank/internal/inventory"
+	"merryworld/surebank/internal/ledger"
+	"merryworld/surebank/internal/loan"
 	"merryworld/surebank/internal/profit"
 	"merryworld/surebank/internal/sale"
 	"merryworld/surebank/internal/transaction"
@@ -30,6 +36,7 @@ import (
 	"merryworld/surebank/internal/signup"
 	"merryworld/surebank/internal/tenant"
 	"merryworld/surebank/internal/tenant/account_preference"
+	"merryworld/surebank/internal/till"
 	"merryworld/surebank/internal/user"
 	"merryworld/surebank/internal/user_account"
 	"merryworld/surebank/internal/user_account/invite"
@@ -49,39 +56,46 @@ const (
 )
 
 type AppContext struct {
-	Log               *log.Logger
-	Env               webcontext.Env
-	MasterDB          *sqlx.DB
-	MasterDbHost      string
-	Redis             *redis.Client
-	UserRepo          *user.Repository
-	UserAccountRepo   *user_account.Repository
-	TenantRepo        *tenant.Repository
-	AccountPrefRepo   *account_preference.Repository
-	AuthRepo          *user_auth.Repository
-	SignupRepo        *signup.Repository
-	InviteRepo        *invite.Repository
-	ChecklistRepo     *checklist.Repository
-	GeoRepo           *geonames.Repository
-	ProfitRepo        *profit.Repository
-	ShopRepo          *shop.Repository
-	InventoryRepo     *inventory.Repository
-	BranchRepo        *branch.Repository
-	CustomerRepo      *customer.Repository
-	AccountRepo       *account.Repository
-	CommissionRepo    *dscommission.Repository
-	TransactionRepo   *transaction.Repository
-	SaleRepo          *sale.Repository
-	ExpendituresRepo  *expenditure.Repository
-	NotifySMS         notify.SMS
-	Authenticator     *auth.Authenticator
-	StaticDir         string
-	TemplateDir       string
-	Renderer          web.Renderer
-	WebRoute          webroute.WebRoute
-	PreAppMiddleware  []web.Middleware
-	PostAppMiddleware []web.Middleware
-	AwsSession        *session.Session
+	Log                *log.Logger
+	Env                webcontext.Env
+	MasterDB           *sqlx.DB
+	MasterDbHost       string
+	Redis              *redis.Client
+	UserRepo           *user.Repository
+	UserAccountRepo    *user_account.Repository
+	TenantRepo         *tenant.Repository
+	AccountPrefRepo    *account_preference.Repository
+	AuthRepo           *user_auth.Repository
+	SignupRepo         *signup.Repository
+	InviteRepo         *invite.Repository
+	ChecklistRepo      *checklist.Repository
+	GeoRepo            *geonames.Repository
+	ProfitRepo         *profit.Repository
+	LedgerRepo         *ledger.Repository
+	IntegrityRepo      *integrity.Repository
+	ShopRepo           *shop.Repository
+	InventoryRepo      *inventory.Repository
+	BranchRepo         *branch.Repository
+	CustomerRepo       *customer.Repository
+	AccountRepo        *account.Repository
+	AccountProductRepo *account_product.Repository
+	CommissionRepo     *dscommission.Repository
+	TransactionRepo    *transaction.Repository
+	SaleRepo           *sale.Repository
+	ExpendituresRepo   *expenditure.Repository
+	TillRepo           *till.Repository
+	LoanRepo           *loan.Repository
+	ImportRepo         *customer_import.Repository
+	PortalRepo         *customer_portal.Repository
+	NotifySMS          notify.SMS
+	Authenticator      *auth.Authenticator
+	StaticDir          string
+	TemplateDir        string
+	Renderer           web.Renderer
+	WebRoute           webroute.WebRoute
+	PreAppMiddleware   []web.Middleware
+	PostAppMiddleware  []web.Middleware
+	AwsSession         *session.Session
 }
 
 // API returns a handler for a set of routes.
@@ -173,12 +187,28 @@ func APP(shutdown chan os.Signal, appCtx *AppContext, reopenDBFunc func() error)
 	app.Handle("GET", "/branches", branches.Index, mid.AuthenticateSessionRequired(appCtx.Authenticator), mid.HasAuth(), mid.HasRole(auth.RoleSuperAdmin))
 	app.Handle("POST", "/api/v1/branches", branches.APICreate, mid.AuthenticateSessionRequired(appCtx.Authenticator), mid.HasRole(auth.RoleSuperAdmin))
 
+	// Account products
+	accountProducts := AccountProducts{
+		Repo:     appCtx.AccountProductRepo,
+		Redis:    appCtx.Redis,
+		Renderer: appCtx.Renderer,
+	}
+	app.Handle("POST", "/account-products/:product_id/update", accountProducts.Update, mid.AuthenticateSessionRequired(appCtx.Authenticator), mid.HasRole(auth.RoleSuperAdmin))
+	app.Handle("GET", "/account-products/:product_id/update", accountProducts.Update, mid.AuthenticateSessionRequired(appCtx.Authenticator), mid.HasRole(auth.RoleSuperAdmin))
+	app.Handle("POST", "/account-products/:product_id", accountProducts.View, mid.AuthenticateSessionRequired(appCtx.Authenticator), mid.HasAuth(), mid.HasRole(auth.RoleSuperAdmin))
+	app.Handle("GET", "/account-products/:product_id", accountProducts.View, mid.AuthenticateSessionRequired(appCtx.Authenticator), mid.HasAuth(), mid.HasRole(auth.RoleSuperAdmin))
+	app.Handle("POST", "/account-products/create", accountProducts.Create, mid.AuthenticateSessionRequired(appCtx.Authenticator), mid.HasRole(auth.RoleSuperAdmin))
+	app.Handle("GET", "/account-products/create", accountProducts.Create, mid.AuthenticateSessionRequired(appCtx.Authenticator), mid.HasRole(auth.RoleSuperAdmin))
+	app.Handle("GET", "/account-products", accountProducts.Index, mid.AuthenticateSessionRequired(appCtx.Authenticator), mid.HasAuth(), mid.HasRole(auth.RoleSuperAdmin))
+
 	// Accounting
 	accounting := Accounting{
-		DbConn:    appCtx.MasterDB.DB,
-		UserRepos: appCtx.UserRepo,
-		Redis:     appCtx.Redis,
-		Renderer:  appCtx.Renderer,
+		DbConn:        appCtx.MasterDB.DB,
+		UserRepos:     appCtx.UserRepo,
+		LedgerRepo:    appCtx.LedgerRepo,
+		IntegrityRepo: appCtx.IntegrityRepo,
+		Redis:         appCtx.Redis,
+		Renderer:      appCtx.Renderer,
 	}
 	app.Handle("GET", "/accounting/banks", accounting.BankAccounts, mid.AuthenticateSessionRequired(appCtx.Authenticator), mid.HasAuth())
 	app.Handle("POST", "/api/v1/accounting/banks", accounting.CreateBankAccount, mid.AuthenticateSessionRequired(appCtx.Authenticator), mid.HasRole(auth.RoleAdmin))
@@ -187,6 +217,8 @@ func APP(shutdown chan os.Signal, appCtx *AppContext, reopenDBFunc func() error)
 	app.Handle("GET", "/accounting/expenditures", accounting.Expenditures, mid.AuthenticateSessionRequired(appCtx.Authenticator), mid.HasAuth())
 	app.Handle("POST", "/api/v1/accounting/expenditures", accounting.CreateExpenditure, mid.AuthenticateSessionRequired(appCtx.Authenticator), mid.HasRole(auth.RoleAdmin))
 	app.Handle("GET", "/accounting/resp-summaries", accounting.RepsSummaries, mid.AuthenticateSessionRequired(appCtx.Authenticator), mid.HasAuth())
+	app.Handle("GET", "/accounting/trial-balance", accounting.TrialBalance, mid.AuthenticateSessionRequired(appCtx.Authenticator), mid.HasAuth(), mid.HasRole(auth.RoleSuperAdmin))
+	app.Handle("GET", "/accounting/integrity", accounting.Integrity, mid.AuthenticateSessionRequired(appCtx.Authenticator), mid.HasAuth(), mid.HasRole(auth.RoleSuperAdmin))
 	app.Handle("GET", "/accounting", accounting.DailySummaries, mid.AuthenticateSessionRequired(appCtx.Authenticator), mid.HasAuth(), mid.HasRole(auth.RoleSuperAdmin))
 
 	// /accounting/reps-expenditure
@@ -278,12 +310,14 @@ func APP(shutdown chan os.Signal, appCtx *AppContext, reopenDBFunc func() error)
 
 	// Customers
 	custs := Customers{
-		CustomerRepo:    appCtx.CustomerRepo,
-		AccountRepo:     appCtx.AccountRepo,
-		NotifySMS:       appCtx.NotifySMS,
-		TransactionRepo: appCtx.TransactionRepo,
-		Redis:           appCtx.Redis,
-		Renderer:        appCtx.Renderer,
+		CustomerRepo:       appCtx.CustomerRepo,
+		AccountRepo:        appCtx.AccountRepo,
+		AccountProductRepo: appCtx.AccountProductRepo,
+		NotifySMS:          appCtx.NotifySMS,
+		TransactionRepo:    appCtx.TransactionRepo,
+		PortalRepo:         appCtx.PortalRepo,
+		Redis:              appCtx.Redis,
+		Renderer:           appCtx.Renderer,
 	}
 	app.Handle("POST", "/customers/:customer_id/update", custs.Update, mid.AuthenticateSessionRequired(appCtx.Authenticator), mid.HasRole(auth.RoleAdmin))
 	app.Handle("GET", "/customers/:customer_id/update", custs.Update, mid.AuthenticateSessionRequired(appCtx.Authenticator), mid.HasRole(auth.RoleAdmin))
@@ -300,12 +334,30 @@ func APP(shutdown chan os.Signal, appCtx *AppContext, reopenDBFunc func() error)
 		custs.Withraw, mid.AuthenticateSessionRequired(appCtx.Authenticator), mid.HasAuth())
 	app.Handle("POST", "/customers/:customer_id/accounts/:account_id/transactions/withdraw",
 		custs.Withraw, mid.AuthenticateSessionRequired(appCtx.Authenticator), mid.HasAuth())
+	app.Handle("GET", "/customers/:customer_id/accounts/:account_id/transactions/transfer",
+		custs.Transfer, mid.AuthenticateSessionRequired(appCtx.Authenticator), mid.HasAuth())
+	app.Handle("POST", "/customers/:customer_id/accounts/:account_id/transactions/transfer",
+		custs.Transfer, mid.AuthenticateSessionRequired(appCtx.Authenticator), mid.HasAuth())
 	app.Handle("GET", "/customers/:customer_id/accounts/:account_id/transactions", custs.AccountTransactions, mid.AuthenticateSessionRequired(appCtx.Authenticator), mid.HasAuth())
 	app.Handle("POST", "/customers/:customer_id/accounts/:account_id/transactions/:transaction_id", custs.Transaction, mid.AuthenticateSessionRequired(appCtx.Authenticator), mid.HasAuth())
 	app.Handle("GET", "/customers/:customer_id/accounts/:account_id/transactions/:transaction_id", custs.Transaction, mid.AuthenticateSessionRequired(appCtx.Authenticator), mid.HasAuth())
+	app.Handle("POST", "/customers/:customer_id/accounts/:account_id/follow-ups", custs.AccountFollowUp, mid.AuthenticateSessionRequired(appCtx.Authenticator), mid.HasAuth())
+	app.Handle("POST", "/customers/:customer_id/accounts/:account_id/holds/:hold_id/release", custs.AccountHoldRelease, mid.AuthenticateSessionRequired(appCtx.Authenticator), mid.HasRole(auth.RoleAdmin, auth.RoleSuperAdmin))
+	app.Handle("POST", "/customers/:customer_id/accounts/:account_id/holds", custs.AccountHold, mid.AuthenticateSessionRequired(appCtx.Authenticator), mid.HasRole(auth.RoleAdmin, auth.RoleSuperAdmin))
+	app.Handle("POST", "/customers/:customer_id/accounts/:account_id/status", custs.AccountStatus, mid.AuthenticateSessionRequired(appCtx.Authenticator), mid.HasRole(auth.RoleAdmin, auth.RoleSuperAdmin))
+	app.Handle("POST", "/customers/:customer_id/accounts/:account_id/close", custs.AccountClose, mid.AuthenticateSessionRequired(appCtx.Authenticator), mid.HasRole(auth.RoleAdmin, auth.RoleSuperAdmin))
+	app.Handle("GET", "/customers/:customer_id/accounts/:account_id/cycles/:cycle_id", custs.AccountCycle, mid.AuthenticateSessionRequired(appCtx.Authenticator), mid.HasAuth())
+	app.Handle("POST", "/customers/:customer_id/accounts/:account_id/cycles/:cycle_id", custs.AccountCycle, mid.AuthenticateSessionRequired(appCtx.Authenticator), mid.HasRole(auth.RoleAdmin, auth.RoleSuperAdmin))
+	app.Handle("GET", "/customers/:customer_id/accounts/:account_id/statement", custs.AccountStatement, mid.AuthenticateSessionRequired(appCtx.Authenticator), mid.HasAuth())
+	app.Handle("POST", "/customers/:customer_id/accounts/:account_id/statement", custs.AccountStatement, mid.AuthenticateSessionRequired(appCtx.Authenticator), mid.HasAuth())
 	app.Handle("GET", "/customers/:customer_id/accounts/:account_id", custs.Account, mid.AuthenticateSessionRequired(appCtx.Authenticator), mid.HasAuth())
 	app.Handle("POST", "/customers/:customer_id/accounts/:account_id/update", custs.UpdateAccount, mid.AuthenticateSessionRequired(appCtx.Authenticator), mid.HasAuth())
 	app.Handle("GET", "/customers/:customer_id/accounts/:account_id/update", custs.UpdateAccount, mid.AuthenticateSessionRequired(appCtx.Authenticator), mid.HasAuth())
+	app.Handle("POST", "/customers/:customer_id/merge", custs.Merge, mid.AuthenticateSessionRequired(appCtx.Authenticator), mid.HasRole(auth.RoleAdmin, auth.RoleSuperAdmin))
+	app.Handle("POST", "/customers/:customer_id/kyc/verify", custs.KYCVerify, mid.AuthenticateSessionRequired(appCtx.Authenticator), mid.HasRole(auth.RoleAdmin, auth.RoleSuperAdmin))
+	app.Handle("POST", "/customers/:customer_id/kyc", custs.KYC, mid.AuthenticateSessionRequired(appCtx.Authenticator), mid.HasAuth())
+	app.Handle("GET", "/customers/:customer_id/kyc", custs.KYC, mid.AuthenticateSessionRequired(appCtx.Authenticator), mid.HasAuth())
+	app.Handle("GET", "/customers/:customer_id/documents/:kind", custs.Document, mid.AuthenticateSessionRequired(appCtx.Authenticator), mid.HasAuth())
 	app.Handle("GET", "/customers/:customer_id/transactions", custs.Transactions, mid.AuthenticateSessionRequired(appCtx.Authenticator), mid.HasAuth())
 	app.Handle("POST", "/customers/:customer_id", custs.View, mid.AuthenticateSessionRequired(appCtx.Authenticator), mid.HasAuth())
 	app.Handle("GET", "/customers/:customer_id", custs.View, mid.AuthenticateSessionRequired(appCtx.Authenticator), mid.HasAuth())
@@ -313,14 +365,90 @@ func APP(shutdown chan os.Signal, appCtx *AppContext, reopenDBFunc func() error)
 	app.Handle("GET", "/customers/create", custs.Create, mid.AuthenticateSessionRequired(appCtx.Authenticator), mid.HasAuth())
 	app.Handle("GET", "/customers", custs.Index, mid.AuthenticateSessionRequired(appCtx.Authenticator), mid.HasAuth())
 
+	// Customer imports
+	imports := CustomerImports{
+		ImportRepo:         appCtx.ImportRepo,
+		UserRepo:           appCtx.UserRepo,
+		AccountProductRepo: appCtx.AccountProductRepo,
+		Renderer:           appCtx.Renderer,
+	}
+	app.Handle("POST", "/customers/import/:job_id/validate", imports.Validate, mid.AuthenticateSessionRequired(appCtx.Authenticator), mid.HasRole(auth.RoleAdmin, auth.RoleSuperAdmin))
+	app.Handle("POST", "/customers/import/:job_id/commit", imports.Commit, mid.AuthenticateSessionRequired(appCtx.Authenticator), mid.HasRole(auth.RoleAdmin, auth.RoleSuperAdmin))
+	app.Handle("GET", "/customers/import/:job_id", imports.View, mid.AuthenticateSessionRequired(appCtx.Authenticator), mid.HasRole(auth.RoleAdmin, auth.RoleSuperAdmin))
+	app.Handle("POST", "/customers/import", imports.Index, mid.AuthenticateSessionRequired(appCtx.Authenticator), mid.HasRole(auth.RoleAdmin, auth.RoleSuperAdmin))
+	app.Handle("GET", "/customers/import", imports.Index, mid.AuthenticateSessionRequired(appCtx.Authenticator), mid.HasRole(auth.RoleAdmin, auth.RoleSuperAdmin))
+
+	// Approvals
+	approvals := Approvals{
+		TransactionRepo: appCtx.TransactionRepo,
+		Redis:           appCtx.Redis,
+		Renderer:        appCtx.Renderer,
+	}
+	app.Handle("POST", "/approvals/:approval_id/approve", approvals.Approve, mid.AuthenticateSessionRequired(appCtx.Authenticator), mid.HasRole(auth.RoleAdmin, auth.RoleSuperAdmin))
+	app.Handle("POST", "/approvals/:approval_id/reject", approvals.Reject, mid.AuthenticateSessionRequired(appCtx.Authenticator), mid.HasRole(auth.RoleAdmin, auth.RoleSuperAdmin))
+	app.Handle("GET", "/approvals", approvals.Index, mid.AuthenticateSessionRequired(appCtx.Authenticator), mid.HasRole(auth.RoleAdmin, auth.RoleSuperAdmin))
+
+	// Withdrawal requests from the customer portal
+	withdrawalRequests := WithdrawalRequests{
+		PortalRepo: appCtx.PortalRepo,
+		Renderer:   appCtx.Renderer,
+	}
+	app.Handle("POST", "/withdrawal-requests/:withdrawal_id/decline", withdrawalRequests.Decline, mid.AuthenticateSessionRequired(appCtx.Authenticator), mid.HasAuth())
+	app.Handle("GET", "/withdrawal-requests", withdrawalRequests.Index, mid.AuthenticateSessionRequired(appCtx.Authenticator), mid.HasAuth())
+
+	// Customer portal
+	portal := Portal{
+		PortalRepo: appCtx.PortalRepo,
+		Renderer:   appCtx.Renderer,
+	}
+	app.Handle("POST", "/portal/login", portal.Login)
+	app.Handle("GET", "/portal/login", portal.Login, waitDbMid)
+	app.Handle("GET", "/portal/logout", portal.Logout)
+	app.Handle("POST", "/portal/withdrawals/:withdrawal_id/cancel", portal.Cancel, mid.AuthenticateCustomerSessionRequired(appCtx.Authenticator))
+	app.Handle("POST", "/portal/accounts/:account_id/withdraw", portal.Withdraw, mid.AuthenticateCustomerSessionRequired(appCtx.Authenticator))
+	app.Handle("GET", "/portal/accounts/:account_id/withdraw", portal.Withdraw, mid.AuthenticateCustomerSessionRequired(appCtx.Authenticator))
+	app.Handle("GET", "/portal/accounts/:account_id", portal.Account, mid.AuthenticateCustomerSessionRequired(appCtx.Authenticator))
+	app.Handle("GET", "/portal", portal.Index, mid.AuthenticateCustomerSessionRequired(appCtx.Authenticator))
+
+	// Till cash-ups
+	tills := Tills{
+		TillRepo:  appCtx.TillRepo,
+		UserRepos: appCtx.UserRepo,
+		Redis:     appCtx.Redis,
+		Renderer:  appCtx.Renderer,
+	}
+	app.Handle("GET", "/till/shortages", tills.Shortages, mid.AuthenticateSessionRequired(appCtx.Authenticator), mid.HasRole(auth.RoleAdmin, auth.RoleSuperAdmin))
+	app.Handle("POST", "/till/cash-up", tills.CashUp, mid.AuthenticateSessionRequired(appCtx.Authenticator), mid.HasAuth())
+	app.Handle("GET", "/till/cash-up", tills.CashUp, mid.AuthenticateSessionRequired(appCtx.Authenticator), mid.HasAuth())
+	app.Handle("POST", "/till/:session_id/sign-off", tills.SignOff, mid.AuthenticateSessionRequired(appCtx.Authenticator), mid.HasRole(auth.RoleAdmin, auth.RoleSuperAdmin))
+	app.Handle("GET", "/till/:session_id", tills.View, mid.AuthenticateSessionRequired(appCtx.Authenticator), mid.HasAuth())
+	app.Handle("GET", "/till", tills.Index, mid.AuthenticateSessionRequired(appCtx.Authenticator), mid.HasAuth())
+
+	// Loans
+	loans := Loans{
+		LoanRepo:    appCtx.LoanRepo,
+		AccountRepo: appCtx.AccountRepo,
+		Redis:       appCtx.Redis,
+		Renderer:    appCtx.Renderer,
+	}
+	app.Handle("POST", "/customers/:customer_id/accounts/:account_id/loans/apply", loans.Apply, mid.AuthenticateSessionRequired(appCtx.Authenticator), mid.HasAuth())
+	app.Handle("GET", "/customers/:customer_id/accounts/:account_id/loans/apply", loans.Apply, mid.AuthenticateSessionRequired(appCtx.Authenticator), mid.HasAuth())
+	app.Handle("POST", "/loans/:loan_id/approve", loans.Approve, mid.AuthenticateSessionRequired(appCtx.Authenticator), mid.HasRole(auth.RoleAdmin, auth.RoleSuperAdmin))
+	app.Handle("POST", "/loans/:loan_id/reject", loans.Reject, mid.AuthenticateSessionRequired(appCtx.Authenticator), mid.HasRole(auth.RoleAdmin, auth.RoleSuperAdmin))
+	app.Handle("POST", "/loans/:loan_id/disburse", loans.Disburse, mid.AuthenticateSessionRequired(appCtx.Authenticator), mid.HasRole(auth.RoleAdmin, auth.RoleSuperAdmin))
+	app.Handle("POST", "/loans/:loan_id/repay", loans.Repay, mid.AuthenticateSessionRequired(appCtx.Authenticator), mid.HasAuth())
+	app.Handle("GET", "/loans/:loan_id", loans.View, mid.AuthenticateSessionRequired(appCtx.Authenticator), mid.HasAuth())
+	app.Handle("GET", "/loans", loans.Index, mid.AuthenticateSessionRequired(appCtx.Authenticator), mid.HasAuth())
+
 	// Customers
 	sms := BulkSMS{
-		CustomerRepo: appCtx.CustomerRepo,
-		AccountRepo:  appCtx.AccountRepo,
-		NotifySMS:    appCtx.NotifySMS,
-		Redis:        appCtx.Redis,
-		Renderer:     appCtx.Renderer,
-		DbConn:       appCtx.MasterDB.DB,
+		CustomerRepo:       appCtx.CustomerRepo,
+		AccountRepo:        appCtx.AccountRepo,
+		AccountProductRepo: appCtx.AccountProductRepo,
+		NotifySMS:          appCtx.NotifySMS,
+		Redis:              appCtx.Redis,
+		Renderer:           appCtx.Renderer,
+		DbConn:             appCtx.MasterDB.DB,
 	}
 	app.Handle("POST", "/sms", sms.Index, mid.AuthenticateSessionRequired(appCtx.Authenticator), mid.HasRole(auth.RoleAdmin))
 	app.Handle("GET", "/sms", sms.Index, mid.AuthenticateSessionRequired(appCtx.Authenticator), mid.HasRole(auth.RoleAdmin))
@@ -332,6 +460,7 @@ func APP(shutdown chan os.Signal, appCtx *AppContext, reopenDBFunc func() error)
 		TransactionRepo: appCtx.TransactionRepo,
 		ShopRepo:        appCtx.ShopRepo,
 		UserRepos:       appCtx.UserRepo,
+		BranchRepo:      appCtx.BranchRepo,
 		Renderer:        appCtx.Renderer,
 		Redis:           appCtx.Redis,
 	}
@@ -340,15 +469,22 @@ func APP(shutdown chan os.Signal, appCtx *AppContext, reopenDBFunc func() error)
 	app.Handle("GET", "/reports/ds/commissions", reports.DsCommissions, mid.AuthenticateSessionRequired(appCtx.Authenticator), mid.HasAuth())
 	app.Handle("GET", "/reports/ds", reports.Ds, mid.AuthenticateSessionRequired(appCtx.Authenticator), mid.HasAuth())
 	app.Handle("GET", "/reports/debtors", reports.Debtors, mid.AuthenticateSessionRequired(appCtx.Authenticator), mid.HasAuth())
+	app.Handle("GET", "/reports/accounts", reports.AccountStatuses, mid.AuthenticateSessionRequired(appCtx.Authenticator), mid.HasRole(auth.RoleAdmin, auth.RoleSuperAdmin))
 
 	// Register sales endpoint
 	sales := Sales{
-		Repository: appCtx.SaleRepo,
-		ShopRepo:   appCtx.ShopRepo,
-		Redis:      appCtx.Redis,
-		Renderer:   appCtx.Renderer,
+		Repository:  appCtx.SaleRepo,
+		ShopRepo:    appCtx.ShopRepo,
+		AccountRepo: appCtx.AccountRepo,
+		UserRepos:   appCtx.UserRepo,
+		Redis:       appCtx.Redis,
+		Renderer:    appCtx.Renderer,
 	}
 	app.Handle("POST", "/api/v1/sales/sell", sales.Sell, mid.AuthenticateSessionRequired(appCtx.Authenticator))
+	app.Handle("POST", "/customers/:customer_id/accounts/:account_id/redeem", sales.Redeem, mid.AuthenticateSessionRequired(appCtx.Authenticator), mid.HasAuth())
+	app.Handle("GET", "/customers/:customer_id/accounts/:account_id/redeem", sales.Redeem, mid.AuthenticateSessionRequired(appCtx.Authenticator), mid.HasAuth())
+	app.Handle("GET", "/sales/receivables", sales.Receivables, mid.AuthenticateSessionRequired(appCtx.Authenticator), mid.HasRole(auth.RoleAdmin, auth.RoleSuperAdmin))
+	app.Handle("POST", "/sales/:sale_id/collect", sales.Collect, mid.AuthenticateSessionRequired(appCtx.Authenticator), mid.HasAuth())
 	app.Handle("GET", "/sales/:sale_id", sales.View, mid.AuthenticateSessionRequired(appCtx.Authenticator))
 	app.Handle("GET", "/sales", sales.Index, mid.AuthenticateSessionRequired(appCtx.Authenticator))
 
@@ -5,11 +5,14 @@ import (
 	"fmt"
 	"golang.org/x/text/language"
 	"golang.org/x/text/message"
+	"merryworld/surebank/internal/account"
+	"merryworld/surebank/internal/idempotency"
 	"merryworld/surebank/internal/platform/datatable"
 	"merryworld/surebank/internal/shop"
 	"net/http"
 	"strings"
 
+	"github.com/gorilla/schema"
 	"github.com/pkg/errors"
 	"gopkg.in/DataDog/dd-trace-go.v1/contrib/go-redis/redis"
 
@@ -18,14 +21,17 @@ import (
 	"merryworld/surebank/internal/platform/web/webcontext"
 	"merryworld/surebank/internal/platform/web/weberror"
 	"merryworld/surebank/internal/sale"
+	"merryworld/surebank/internal/user"
 )
 
 // Sales represents the sales API method handler set.
 type Sales struct {
-	Repository *sale.Repository
-	ShopRepo   *shop.Repository
-	Redis      *redis.Client
-	Renderer   web.Renderer 
+	Repository  *sale.Repository
+	ShopRepo    *shop.Repository
+	AccountRepo *account.Repository
+	UserRepos   *user.Repository
+	Redis       *redis.Client
+	Renderer    web.Renderer
 }
 
 func urlSalesIndex() string {
@@ -36,6 +42,18 @@ func urlSalesView(saleID string) string {
 	return fmt.Sprintf("/sales/%s", saleID)
 }
 
+func urlSalesReceivables() string {
+	return "/sales/receivables"
+}
+
+func urlSalesCollect(saleID string) string {
+	return fmt.Sprintf("/sales/%s/collect", saleID)
+}
+
+func urlSalesRedeem(customerID, accountID string) string {
+	return fmt.Sprintf("/customers/%s/accounts/%s/redeem", customerID, accountID)
+}
+
 // Index handles listing all the customers.
 func (h *Sales) Index(ctx context.Context, w http.ResponseWriter, r *http.Request, _ map[string]string) error {
 
@@ -67,7 +85,7 @@ func (h *Sales) Index(ctx context.Context, w http.ResponseWriter, r *http.Reques
 				v.Formatted = fmt.Sprintf("<a href='%s'>%s</a>", urlSalesView(q.ID), v.Value)
 			case "amount":
 				p := message.NewPrinter(language.English)
-				v.Value = p.Sprintf("%.2f", q.Amount)
+				v.Value = p.Sprintf("%.2f", q.Amount.Float())
 				v.Formatted = v.Value
 			case "customer_name":
 				v.Value = q.CustomerName
@@ -174,12 +192,16 @@ func (h *Sales) Sell(ctx context.Context, w http.ResponseWriter, r *http.Request
 		return web.RespondJsonError(ctx, w, err)
 	}
 
+	req.IdempotencyKey = r.Header.Get(web.HeaderIdempotencyKey)
+
 	res, err := h.Repository.MakeSale(ctx, claims, req, v.Now)
 	if err != nil {
 		cause := errors.Cause(err)
 		switch cause {
 		case sale.ErrForbidden:
 			return web.RespondJsonError(ctx, w, weberror.NewError(ctx, err, http.StatusForbidden))
+		case idempotency.ErrKeyReused, idempotency.ErrInvalidKey:
+			return web.RespondJsonError(ctx, w, weberror.NewError(ctx, err, http.StatusUnprocessableEntity))
 		default:
 			return web.RespondJsonError(ctx, w, weberror.NewError(ctx, err, http.StatusBadRequest))
 			/*_, ok := cause.(validator.ValidationErrors)
@@ -251,6 +273,191 @@ func (h *Sales) View(ctx context.Context, w http.ResponseWriter, r *http.Request
 	data["sale"] = salesDetail.Response(ctx)
 
 	data["urlSalesIndex"] = urlSalesIndex()
+	data["urlSalesCollect"] = urlSalesCollect(saleID)
 
 	return h.Renderer.Render(ctx, w, r, TmplLayoutBase, "sales-view.gohtml", web.MIMETextHTMLCharsetUTF8, http.StatusOK, data)
 }
+
+// Receivables lists the credit sales of the branch of the user that have not been paid in full,
+// all branches for super admins.
+func (h *Sales) Receivables(ctx context.Context, w http.ResponseWriter, r *http.Request, _ map[string]string) error {
+
+	ctxValues, err := webcontext.ContextValues(ctx)
+	if err != nil {
+		return err
+	}
+
+	claims, err := auth.ClaimsFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	var req sale.ReceivablesRequest
+	if !claims.HasRole(auth.RoleSuperAdmin) {
+		u, err := h.UserRepos.ReadByID(ctx, claims, claims.Subject)
+		if err != nil {
+			return err
+		}
+		req.BranchID = u.BranchID
+	}
+
+	receivables, err := h.Repository.Receivables(ctx, claims, req, ctxValues.Now)
+	if err != nil {
+		return err
+	}
+
+	type row struct {
+		*sale.Receivable
+		URLView    string
+		URLCollect string
+	}
+
+	var rows []row
+	for _, s := range receivables.Sales {
+		rows = append(rows, row{Receivable: s, URLView: urlSalesView(s.ID), URLCollect: urlSalesCollect(s.ID)})
+	}
+
+	data := map[string]interface{}{
+		"sales":       rows,
+		"receivables": receivables,
+	}
+
+	return h.Renderer.Render(ctx, w, r, TmplLayoutBase, "sales-receivables.gohtml", web.MIMETextHTMLCharsetUTF8, http.StatusOK, data)
+}
+
+// Collect takes what the credit account of a sale holds towards the balance of the sale.
+func (h *Sales) Collect(ctx context.Context, w http.ResponseWriter, r *http.Request, params map[string]string) error {
+
+	ctxValues, err := webcontext.ContextValues(ctx)
+	if err != nil {
+		return err
+	}
+
+	claims, err := auth.ClaimsFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	saleID := params["sale_id"]
+	s, err := h.Repository.Collect(ctx, claims, sale.CollectRequest{ID: saleID}, ctxValues.Now)
+	if err != nil {
+		werr, ok := weberror.NewError(ctx, err, 0).(*weberror.Error)
+		if !ok || werr.Status >= http.StatusInternalServerError {
+			return err
+		}
+		msg := werr.Message
+		if msg == "" {
+			msg = werr.Error()
+		}
+		webcontext.SessionFlashError(ctx, "Nothing Collected", msg)
+		return web.Redirect(ctx, w, r, urlSalesView(saleID), http.StatusFound)
+	}
+
+	if s.Status == sale.Status_Paid {
+		webcontext.SessionFlashSuccess(ctx,
+			"Sale Paid",
+			"The balance of the sale has been collected in full.")
+	} else {
+		webcontext.SessionFlashSuccess(ctx,
+			"Payment Collected",
+			fmt.Sprintf("%s is left to collect on the sale.", s.Outstanding()))
+	}
+
+	return web.Redirect(ctx, w, r, urlSalesView(saleID), http.StatusFound)
+}
+
+// Redeem sells a product to the customer of an SB account that reached its savings goal, paid for
+// from the account.
+func (h *Sales) Redeem(ctx context.Context, w http.ResponseWriter, r *http.Request, params map[string]string) error {
+
+	customerID := params["customer_id"]
+	accountID := params["account_id"]
+
+	ctxValues, err := webcontext.ContextValues(ctx)
+	if err != nil {
+		return err
+	}
+
+	claims, err := auth.ClaimsFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	req := &sale.RedeemGoalRequest{Quantity: 1}
+	data := make(map[string]interface{})
+	f := func() (bool, error) {
+		if r.Method == http.MethodPost {
+			err := r.ParseForm()
+			if err != nil {
+				return false, err
+			}
+
+			decoder := schema.NewDecoder()
+			decoder.IgnoreUnknownKeys(true)
+
+			if err := decoder.Decode(req, r.PostForm); err != nil {
+				return false, err
+			}
+			req.AccountID = accountID
+
+			s, err := h.Repository.RedeemGoal(ctx, claims, *req, ctxValues.Now)
+			if err != nil {
+				if verr, ok := weberror.NewValidationError(ctx, err); ok {
+					data["validationErrors"] = verr.(*weberror.Error)
+					return false, nil
+				}
+
+				werr, ok := weberror.NewError(ctx, err, 0).(*weberror.Error)
+				if !ok || werr.Status >= http.StatusInternalServerError {
+					return false, err
+				}
+				data["error"] = werr.Message
+				if werr.Message == "" {
+					data["error"] = werr.Error()
+				}
+				return false, nil
+			}
+
+			webcontext.SessionFlashSuccess(ctx,
+				"Goal Redeemed",
+				fmt.Sprintf("%s has been paid from the account for the sale.", s.Amount))
+
+			return true, web.Redirect(ctx, w, r, urlSalesView(s.ID), http.StatusFound)
+		}
+
+		return false, nil
+	}
+
+	end, err := f()
+	if err != nil {
+		return web.RenderError(ctx, w, r, err, h.Renderer, TmplLayoutBase, TmplContentErrorGeneric, web.MIMETextHTMLCharsetUTF8)
+	} else if end {
+		return nil
+	}
+
+	acc, err := h.AccountRepo.ReadByID(ctx, claims, accountID)
+	if err != nil {
+		return weberror.NewError(ctx, err, 404)
+	}
+	if err := h.AccountRepo.LoadGoal(ctx, acc, ctxValues.Now); err != nil {
+		return err
+	}
+	data["account"] = acc.Response(ctx)
+
+	products, err := h.ShopRepo.FindProduct(ctx, shop.ProductFindRequest{Order: []string{"name"}})
+	if err != nil {
+		return err
+	}
+	data["products"] = products
+
+	data["form"] = req
+	data["urlCustomersIndex"] = urlCustomersIndex()
+	data["urlCustomersView"] = urlCustomersView(customerID)
+	data["urlCustomersAccountsView"] = urlCustomersAccountsView(customerID, accountID)
+
+	if verr, ok := weberror.NewValidationError(ctx, webcontext.Validator().Struct(sale.RedeemGoalRequest{})); ok {
+		data["validationDefaults"] = verr.(*weberror.Error)
+	}
+
+	return h.Renderer.Render(ctx, w, r, TmplLayoutBase, "sales-redeem.gohtml", web.MIMETextHTMLCharsetUTF8, http.StatusOK, data)
+}
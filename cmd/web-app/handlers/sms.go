@@ -8,7 +8,9 @@ import (
 	"strings"
 
 	"merryworld/surebank/internal/account"
+	"merryworld/surebank/internal/account_product"
 	"merryworld/surebank/internal/customer"
+	"merryworld/surebank/internal/platform/auth"
 	"merryworld/surebank/internal/platform/notify"
 	"merryworld/surebank/internal/platform/web"
 	"merryworld/surebank/internal/postgres/models"
@@ -20,12 +22,13 @@ import (
 
 // BulkSMS represents the endpoint for sending SMS notification to customers
 type BulkSMS struct {
-	CustomerRepo *customer.Repository
-	AccountRepo  *account.Repository
-	NotifySMS    notify.SMS
-	Renderer     web.Renderer
-	Redis        *redis.Client
-	DbConn       *sql.DB
+	CustomerRepo       *customer.Repository
+	AccountRepo        *account.Repository
+	AccountProductRepo *account_product.Repository
+	NotifySMS          notify.SMS
+	Renderer           web.Renderer
+	Redis              *redis.Client
+	DbConn             *sql.DB
 }
 
 type sendSMSRequest struct {
@@ -116,7 +119,16 @@ func (h BulkSMS) Index(ctx context.Context, w http.ResponseWriter, r *http.Reque
 		data["message"] = "Messages sent to server successfully"
 	}
 
-	data["accountTypes"] = customer.AccountTypes
+	claims, err := auth.ClaimsFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	products, err := h.AccountProductRepo.Find(ctx, claims, account_product.FindRequest{})
+	if err != nil {
+		return err
+	}
+	data["accountProducts"] = products.Response(ctx)
 
 	return h.Renderer.Render(ctx, w, r, TmplLayoutBase, "sms-send.gohtml", web.MIMETextHTMLCharsetUTF8, http.StatusOK, data)
 }
@@ -437,9 +437,7 @@ func (h *Stocks) Report(ctx context.Context, w http.ResponseWriter, r *http.Requ
 	loadFunc := func(ctx context.Context, sorting string, fields []datatable.DisplayField) (resp [][]datatable.ColumnValue, err error) {
 
 		var order []string
-		if len(sorting) > 0 {
-			order = order
-		} else {
+		if len(sorting) == 0 {
 			order = append(order, "p.name")
 		}
 
@@ -0,0 +1,401 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"merryworld/surebank/internal/platform/auth"
+	"merryworld/surebank/internal/platform/money"
+	"merryworld/surebank/internal/platform/web"
+	"merryworld/surebank/internal/platform/web/webcontext"
+	"merryworld/surebank/internal/platform/web/weberror"
+	"merryworld/surebank/internal/till"
+	"merryworld/surebank/internal/user"
+
+	"github.com/gorilla/schema"
+	"github.com/pkg/errors"
+	"gopkg.in/DataDog/dd-trace-go.v1/contrib/go-redis/redis"
+)
+
+// Tills represents the end of day cash-up handler set.
+type Tills struct {
+	TillRepo  *till.Repository
+	UserRepos *user.Repository
+	Redis     *redis.Client
+	Renderer  web.Renderer
+}
+
+// tillDateFormat is the layout of the dates picked on the cash-up pages.
+const tillDateFormat = "01/02/2006"
+
+func urlTillIndex() string {
+	return "/till"
+}
+
+func urlTillCashUp(salesRepID string, date time.Time) string {
+	return fmt.Sprintf("/till/cash-up?sales_rep_id=%s&date=%s", salesRepID, date.Format(tillDateFormat))
+}
+
+func urlTillView(sessionID string) string {
+	return fmt.Sprintf("/till/%s", sessionID)
+}
+
+func urlTillSignOff(sessionID string) string {
+	return fmt.Sprintf("/till/%s/sign-off", sessionID)
+}
+
+// tillDate reads a date picked on the cash-up pages, the current business day when it is empty.
+func tillDate(v string, now time.Time) time.Time {
+	if v != "" {
+		if date, err := time.Parse(tillDateFormat, v); err == nil {
+			return date
+		}
+	}
+	return till.BusinessDate(now)
+}
+
+// branchUsers lists the users of the branch of the signed in user, all users for super admins.
+func (h *Tills) branchUsers(ctx context.Context, claims auth.Claims) (user.Users, error) {
+	req := user.UserFindRequest{
+		Order: []string{"first_name", "last_name"},
+	}
+	if !claims.HasRole(auth.RoleSuperAdmin) {
+		req.Where = "branch_id = (select branch_id from users where id = ?)"
+		req.Args = []interface{}{claims.Subject}
+	}
+	return h.UserRepos.Find(ctx, claims, req)
+}
+
+// Index lists the cash-ups of a day. Admins see every rep of their branch, with the reps that
+// have not counted their till yet.
+func (h *Tills) Index(ctx context.Context, w http.ResponseWriter, r *http.Request, params map[string]string) error {
+
+	ctxValues, err := webcontext.ContextValues(ctx)
+	if err != nil {
+		return err
+	}
+
+	claims, err := auth.ClaimsFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	date := tillDate(r.URL.Query().Get("date"), ctxValues.Now)
+	start, _ := till.DayRange(date)
+
+	req := till.FindRequest{
+		Where: "till_session.date = ?",
+		Args:  []interface{}{start.Unix()},
+		Order: []string{"till_session.variance"},
+	}
+	if !claims.HasRole(auth.RoleSuperAdmin) {
+		req.Where += " and till_session.branch_id = (select branch_id from users where id = ?)"
+		req.Args = append(req.Args, claims.Subject)
+	}
+
+	sessions, err := h.TillRepo.Find(ctx, claims, req)
+	if err != nil {
+		return err
+	}
+
+	type row struct {
+		*till.Response
+		URLView string
+	}
+
+	counted := make(map[string]bool)
+	var rows []row
+	for _, s := range sessions.Response(ctx) {
+		counted[s.SalesRepID] = true
+		rows = append(rows, row{Response: s, URLView: urlTillView(s.ID)})
+	}
+
+	data := map[string]interface{}{
+		"date":          date.Format(tillDateFormat),
+		"sessions":      rows,
+		"urlTillCashUp": urlTillCashUp(claims.Subject, date),
+	}
+
+	if claims.HasRole(auth.RoleAdmin, auth.RoleSuperAdmin) {
+		users, err := h.branchUsers(ctx, claims)
+		if err != nil {
+			return err
+		}
+
+		type pending struct {
+			Name      string
+			URLCashUp string
+		}
+		var notCounted []pending
+		for _, u := range users {
+			if !counted[u.ID] {
+				notCounted = append(notCounted, pending{Name: u.FullName(), URLCashUp: urlTillCashUp(u.ID, date)})
+			}
+		}
+		data["notCounted"] = notCounted
+	}
+
+	return h.Renderer.Render(ctx, w, r, TmplLayoutBase, "till-index.gohtml", web.MIMETextHTMLCharsetUTF8, http.StatusOK, data)
+}
+
+// tillCashUpForm is the cash counted by denomination as entered on the cash-up page.
+type tillCashUpForm struct {
+	SalesRepID string
+	Date       string
+	Counts     []till.Count
+	Notes      string
+}
+
+// CashUp shows the cash expected from a rep for the day and records the cash counted.
+func (h *Tills) CashUp(ctx context.Context, w http.ResponseWriter, r *http.Request, params map[string]string) error {
+
+	ctxValues, err := webcontext.ContextValues(ctx)
+	if err != nil {
+		return err
+	}
+
+	claims, err := auth.ClaimsFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	isAdmin := claims.HasRole(auth.RoleAdmin, auth.RoleSuperAdmin)
+
+	form := &tillCashUpForm{
+		SalesRepID: r.URL.Query().Get("sales_rep_id"),
+		Date:       r.URL.Query().Get("date"),
+	}
+
+	data := make(map[string]interface{})
+	f := func() (bool, error) {
+		if r.Method == http.MethodPost {
+			err := r.ParseForm()
+			if err != nil {
+				return false, err
+			}
+
+			decoder := schema.NewDecoder()
+			decoder.IgnoreUnknownKeys(true)
+
+			if err := decoder.Decode(form, r.PostForm); err != nil {
+				return false, err
+			}
+			if !isAdmin {
+				form.SalesRepID = claims.Subject
+			}
+
+			session, err := h.TillRepo.Submit(ctx, claims, till.SubmitRequest{
+				SalesRepID: form.SalesRepID,
+				Date:       tillDate(form.Date, ctxValues.Now),
+				Counts:     form.Counts,
+				Notes:      form.Notes,
+			}, ctxValues.Now)
+			if err != nil {
+				switch errors.Cause(err) {
+				default:
+					if verr, ok := weberror.NewValidationError(ctx, err); ok {
+						data["validationErrors"] = verr.(*weberror.Error)
+						return false, nil
+					} else {
+						return false, err
+					}
+				}
+			}
+
+			msg := fmt.Sprintf("%s counted against %s expected, the till balances.", session.Counted, session.Expected.Total())
+			if session.Variance != 0 {
+				msg = fmt.Sprintf("%s counted against %s expected, a variance of %s.", session.Counted, session.Expected.Total(), session.Variance)
+			}
+			webcontext.SessionFlashSuccess(ctx, "Cash-Up Submitted", msg)
+
+			return true, web.Redirect(ctx, w, r, urlTillView(session.ID), http.StatusFound)
+		}
+
+		return false, nil
+	}
+
+	end, err := f()
+	if err != nil {
+		return web.RenderError(ctx, w, r, err, h.Renderer, TmplLayoutBase, TmplContentErrorGeneric, web.MIMETextHTMLCharsetUTF8)
+	} else if end {
+		return nil
+	}
+
+	if form.SalesRepID == "" || !isAdmin {
+		form.SalesRepID = claims.Subject
+	}
+	date := tillDate(form.Date, ctxValues.Now)
+	form.Date = date.Format(tillDateFormat)
+
+	// Counting the till again starts from the previous count until a supervisor signs it off.
+	start, _ := till.DayRange(date)
+	existing, err := h.TillRepo.Find(ctx, claims, till.FindRequest{
+		Where: "till_session.sales_rep_id = ? and till_session.date = ?",
+		Args:  []interface{}{form.SalesRepID, start.Unix()},
+	})
+	if err != nil {
+		return err
+	}
+	if len(existing) > 0 {
+		session, err := h.TillRepo.Read(ctx, claims, till.ReadRequest{ID: existing[0].ID})
+		if err != nil {
+			return err
+		}
+		if session.Status != till.Status_Submitted {
+			webcontext.SessionFlashInfo(ctx, "Cash-Up Signed Off", till.ErrLocked.Error())
+			return web.Redirect(ctx, w, r, urlTillView(session.ID), http.StatusFound)
+		}
+		if r.Method != http.MethodPost {
+			form.Counts = session.Counts
+			form.Notes = session.Notes
+		}
+		data["session"] = session.Response(ctx)
+	}
+
+	expected, err := h.TillRepo.ExpectedCash(ctx, claims, form.SalesRepID, date)
+	if err != nil {
+		return err
+	}
+
+	counts := make(map[money.Amount]int64)
+	for _, c := range form.Counts {
+		counts[c.Denomination] = c.Count
+	}
+	type denomination struct {
+		Value money.Amount
+		Count int64
+	}
+	var denominations []denomination
+	for _, d := range till.Denominations {
+		denominations = append(denominations, denomination{Value: d, Count: counts[d]})
+	}
+
+	if isAdmin {
+		users, err := h.branchUsers(ctx, claims)
+		if err != nil {
+			return err
+		}
+		data["users"] = users
+	}
+
+	data["form"] = form
+	data["expected"] = expected
+	data["expectedTotal"] = expected.Total()
+	data["denominations"] = denominations
+	data["urlTillIndex"] = urlTillIndex()
+
+	if verr, ok := weberror.NewValidationError(ctx, webcontext.Validator().Struct(till.SubmitRequest{})); ok {
+		data["validationDefaults"] = verr.(*weberror.Error)
+	}
+
+	return h.Renderer.Render(ctx, w, r, TmplLayoutBase, "till-cash-up.gohtml", web.MIMETextHTMLCharsetUTF8, http.StatusOK, data)
+}
+
+// View shows a cash-up with the counts by denomination and lets a supervisor sign it off.
+func (h *Tills) View(ctx context.Context, w http.ResponseWriter, r *http.Request, params map[string]string) error {
+
+	claims, err := auth.ClaimsFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	session, err := h.TillRepo.Read(ctx, claims, till.ReadRequest{ID: params["session_id"]})
+	if err != nil {
+		return err
+	}
+
+	data := map[string]interface{}{
+		"session":      session.Response(ctx),
+		"urlTillIndex": urlTillIndex(),
+	}
+
+	if session.Status == till.Status_Submitted {
+		data["urlTillCashUp"] = urlTillCashUp(session.SalesRepID, session.Date)
+		data["canSignOff"] = claims.HasRole(auth.RoleAdmin, auth.RoleSuperAdmin) &&
+			session.SalesRepID != claims.Subject && session.SubmittedByID != claims.Subject
+		data["urlTillSignOff"] = urlTillSignOff(session.ID)
+	}
+
+	return h.Renderer.Render(ctx, w, r, TmplLayoutBase, "till-view.gohtml", web.MIMETextHTMLCharsetUTF8, http.StatusOK, data)
+}
+
+// SignOff locks the cash-up once a supervisor has checked the cash handed over.
+func (h *Tills) SignOff(ctx context.Context, w http.ResponseWriter, r *http.Request, params map[string]string) error {
+
+	ctxValues, err := webcontext.ContextValues(ctx)
+	if err != nil {
+		return err
+	}
+
+	claims, err := auth.ClaimsFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	sessionID := params["session_id"]
+
+	if _, err = h.TillRepo.SignOff(ctx, claims, till.SignOffRequest{ID: sessionID}, ctxValues.Now); err != nil {
+		werr, ok := weberror.NewError(ctx, err, 0).(*weberror.Error)
+		if !ok || werr.Status >= http.StatusInternalServerError {
+			return err
+		}
+
+		webcontext.SessionFlashError(ctx, "Cash-Up Not Signed Off", werr.Error())
+		return web.Redirect(ctx, w, r, urlTillView(sessionID), http.StatusFound)
+	}
+
+	webcontext.SessionFlashSuccess(ctx,
+		"Cash-Up Signed Off",
+		"The cash-up has been signed off and locked.")
+
+	return web.Redirect(ctx, w, r, urlTillView(sessionID), http.StatusFound)
+}
+
+// Shortages lists the reps that were short at the cash-ups of a period, highlighting the ones
+// that were short repeatedly.
+func (h *Tills) Shortages(ctx context.Context, w http.ResponseWriter, r *http.Request, params map[string]string) error {
+
+	ctxValues, err := webcontext.ContextValues(ctx)
+	if err != nil {
+		return err
+	}
+
+	claims, err := auth.ClaimsFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	endDate := tillDate(r.URL.Query().Get("end_date"), ctxValues.Now)
+	startDate := time.Date(endDate.Year(), endDate.Month(), 1, 0, 0, 0, 0, time.UTC)
+	if v := r.URL.Query().Get("start_date"); v != "" {
+		startDate = tillDate(v, ctxValues.Now)
+	}
+
+	req := till.ShortagesRequest{
+		StartDate: startDate,
+		EndDate:   endDate,
+	}
+	if !claims.HasRole(auth.RoleSuperAdmin) {
+		u, err := h.UserRepos.ReadByID(ctx, claims, claims.Subject)
+		if err != nil {
+			return err
+		}
+		req.BranchID = u.BranchID
+	}
+
+	shortages, err := h.TillRepo.Shortages(ctx, claims, req)
+	if err != nil {
+		return err
+	}
+
+	data := map[string]interface{}{
+		"startDate":         startDate.Format(tillDateFormat),
+		"endDate":           endDate.Format(tillDateFormat),
+		"shortages":         shortages,
+		"repeatedShortages": till.RepeatedShortages,
+	}
+
+	return h.Renderer.Render(ctx, w, r, TmplLayoutBase, "till-shortages.gohtml", web.MIMETextHTMLCharsetUTF8, http.StatusOK, data)
+}
@@ -0,0 +1,131 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"merryworld/surebank/internal/customer_portal"
+	"merryworld/surebank/internal/platform/auth"
+	"merryworld/surebank/internal/platform/web"
+	"merryworld/surebank/internal/platform/web/webcontext"
+	"merryworld/surebank/internal/platform/web/weberror"
+
+	"github.com/gorilla/schema"
+)
+
+// WithdrawalRequests represents the handler set for the withdrawals customers request from the portal.
+type WithdrawalRequests struct {
+	PortalRepo *customer_portal.Repository
+	Renderer   web.Renderer
+}
+
+func urlWithdrawalRequestsIndex() string {
+	return "/withdrawal-requests"
+}
+
+func urlWithdrawalRequestsDecline(withdrawalID string) string {
+	return fmt.Sprintf("/withdrawal-requests/%s/decline", withdrawalID)
+}
+
+// urlWithdrawalRequestsPay opens the withdrawal form of the account filled in from the request.
+func urlWithdrawalRequestsPay(customerID, accountID, withdrawalID string) string {
+	return fmt.Sprintf("/customers/%s/accounts/%s/transactions/withdraw?request=%s", customerID, accountID, withdrawalID)
+}
+
+// Index lists the pending withdrawal requests of the accounts the user manages, followed by the
+// ones decided most recently.
+func (h *WithdrawalRequests) Index(ctx context.Context, w http.ResponseWriter, r *http.Request, params map[string]string) error {
+
+	claims, err := auth.ClaimsFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	pending, err := h.PortalRepo.FindWithdrawals(ctx, claims, customer_portal.WithdrawalFindRequest{
+		Statuses: []string{customer_portal.WithdrawalStatus_Pending},
+	})
+	if err != nil {
+		return err
+	}
+
+	limit := uint(20)
+	decided, err := h.PortalRepo.FindWithdrawals(ctx, claims, customer_portal.WithdrawalFindRequest{
+		Statuses: []string{
+			customer_portal.WithdrawalStatus_Referred,
+			customer_portal.WithdrawalStatus_Processed,
+			customer_portal.WithdrawalStatus_Declined,
+			customer_portal.WithdrawalStatus_Cancelled,
+		},
+		Limit: &limit,
+	})
+	if err != nil {
+		return err
+	}
+
+	type row struct {
+		*customer_portal.WithdrawalResponse
+		URLAccount string
+		URLPay     string
+		URLDecline string
+	}
+
+	var pendingRows []row
+	for _, wr := range pending.Response(ctx) {
+		pendingRows = append(pendingRows, row{
+			WithdrawalResponse: wr,
+			URLAccount:         urlCustomersAccountsView(wr.CustomerID, wr.AccountID),
+			URLPay:             urlWithdrawalRequestsPay(wr.CustomerID, wr.AccountID, wr.ID),
+			URLDecline:         urlWithdrawalRequestsDecline(wr.ID),
+		})
+	}
+
+	data := map[string]interface{}{
+		"pending": pendingRows,
+		"decided": decided.Response(ctx),
+	}
+
+	return h.Renderer.Render(ctx, w, r, TmplLayoutBase, "withdrawal-requests-index.gohtml", web.MIMETextHTMLCharsetUTF8, http.StatusOK, data)
+}
+
+// Decline declines a withdrawal request with the reason entered, the customer is sent the reason.
+func (h *WithdrawalRequests) Decline(ctx context.Context, w http.ResponseWriter, r *http.Request, params map[string]string) error {
+
+	ctxValues, err := webcontext.ContextValues(ctx)
+	if err != nil {
+		return err
+	}
+
+	claims, err := auth.ClaimsFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err := r.ParseForm(); err != nil {
+		return err
+	}
+
+	req := new(customer_portal.WithdrawalDeclineRequest)
+	decoder := schema.NewDecoder()
+	decoder.IgnoreUnknownKeys(true)
+	if err := decoder.Decode(req, r.PostForm); err != nil {
+		return err
+	}
+	req.ID = params["withdrawal_id"]
+
+	if err = h.PortalRepo.DeclineWithdrawal(ctx, claims, *req, ctxValues.Now); err != nil {
+		werr, ok := weberror.NewError(ctx, err, 0).(*weberror.Error)
+		if !ok || werr.Status >= http.StatusInternalServerError {
+			return err
+		}
+
+		webcontext.SessionFlashError(ctx, "Request Not Declined", werr.Error())
+		return web.Redirect(ctx, w, r, urlWithdrawalRequestsIndex(), http.StatusFound)
+	}
+
+	webcontext.SessionFlashSuccess(ctx,
+		"Request Declined",
+		"The customer has been sent the reason by SMS.")
+
+	return web.Redirect(ctx, w, r, urlWithdrawalRequestsIndex(), http.StatusFound)
+}
@@ -10,7 +10,11 @@ import (
 	"log"
 	"merryworld/surebank/internal/dscommission"
 	"merryworld/surebank/internal/expenditure"
+	"merryworld/surebank/internal/integrity"
 	"merryworld/surebank/internal/inventory"
+	"merryworld/surebank/internal/ledger"
+	"merryworld/surebank/internal/loan"
+	"merryworld/surebank/internal/platform/money"
 	"merryworld/surebank/internal/profit"
 	"merryworld/surebank/internal/sale"
 	"net"
@@ -27,12 +31,16 @@ import (
 
 	"merryworld/surebank/cmd/web-app/handlers"
 	"merryworld/surebank/internal/account"
+	"merryworld/surebank/internal/account_product"
 	"merryworld/surebank/internal/branch"
 	"merryworld/surebank/internal/checklist"
 	"merryworld/surebank/internal/customer"
+	"merryworld/surebank/internal/customer_import"
+	"merryworld/surebank/internal/customer_portal"
 	"merryworld/surebank/internal/geonames"
 	"merryworld/surebank/internal/mid"
 	"merryworld/surebank/internal/platform/auth"
+	"merryworld/surebank/internal/platform/blob"
 	"merryworld/surebank/internal/platform/flag"
 	img_resize "merryworld/surebank/internal/platform/img-resize"
 	"merryworld/surebank/internal/platform/notify"
@@ -44,6 +52,7 @@ import (
 	"merryworld/surebank/internal/signup"
 	"merryworld/surebank/internal/tenant"
 	"merryworld/surebank/internal/tenant/account_preference"
+	"merryworld/surebank/internal/till"
 	"merryworld/surebank/internal/transaction"
 	"merryworld/surebank/internal/user"
 	"merryworld/surebank/internal/user_account"
@@ -158,6 +167,9 @@ func main() {
 			Timezone   string `default:"utc" envconfig:"TIMEZONE"`
 			DisableTLS bool   `default:"true" envconfig:"DISABLE_TLS"`
 		}
+		Blob struct {
+			Dir string `default:"../../uploads" envconfig:"DIR"`
+		}
 		Trace struct {
 			Host          string  `default:"127.0.0.1" envconfig:"DD_TRACE_AGENT_HOSTNAME"`
 			Port          int     `default:"8126" envconfig:"DD_TRACE_AGENT_PORT"`
@@ -537,6 +549,9 @@ func main() {
 		if err != nil {
 			log.Fatalf("main : Notify SMS : %+v", err)
 		}
+	} else if cfg.Project.SMSProvider == "local" {
+		// keep messages in memory for local development
+		notifySMS = notify.NewSMSLocal(cfg.Project.SharedTemplateDir)
 	} else {
 		notifySMS = notify.NewSMSDisabled()
 	}
@@ -559,7 +574,7 @@ func main() {
 
 	webRoute, err := webroute.New(cfg.Project.WebApiBaseUrl, cfg.Service.BaseUrl)
 	if err != nil {
-		log.Fatalf("main : surebank routes : %+v", cfg.Service.BaseUrl, err)
+		log.Fatalf("main : surebank routes : %s: %+v", cfg.Service.BaseUrl, err)
 	}
 
 	usrRepo := user.NewRepository(masterDb, webRoute.UserResetPassword, notifyEmail, cfg.Project.SharedSecretKey)
@@ -573,46 +588,65 @@ func main() {
 	chklstRepo := checklist.NewRepository(masterDb)
 	shopRepo := shop.NewRepository(masterDb)
 	branchRepo := branch.NewRepository(masterDb)
-	customerRepo := customer.NewRepository(masterDb)
+	// Customer documents are kept on the local disk.
+	blobStore, err := blob.NewStoreLocal(cfg.Blob.Dir)
+	if err != nil {
+		log.Fatalf("main : Blob store : %s : %+v", cfg.Blob.Dir, err)
+	}
+	customerRepo := customer.NewRepository(masterDb, blobStore)
 	accountRepo := account.NewRepository(masterDb)
+	accountProductRepo := account_product.NewRepository(masterDb)
 	commissionRepo := dscommission.NewRepository(masterDb)
 	profitRepo := profit.NewRepository(masterDb)
-	transactionRepo := transaction.NewRepository(masterDb, commissionRepo, profitRepo, notifySMS, createDB)
+	ledgerRepo := ledger.NewRepository(masterDb)
+	integrityRepo := integrity.NewRepository(masterDb)
+	transactionRepo := transaction.NewRepository(masterDb, commissionRepo, profitRepo, ledgerRepo, notifySMS, notifyEmail, createDB)
 	inventoryRepo := inventory.NewRepository(masterDb)
-	saleRepo := sale.NewRepository(masterDb, shopRepo, inventoryRepo, transactionRepo, profitRepo)
-	expendituresRepo := expenditure.NewRepository(masterDb)
+	saleRepo := sale.NewRepository(masterDb, shopRepo, inventoryRepo, transactionRepo, profitRepo, ledgerRepo, accountRepo)
+	expendituresRepo := expenditure.NewRepository(masterDb, ledgerRepo)
+	tillRepo := till.NewRepository(masterDb)
+	loanRepo := loan.NewRepository(masterDb, transactionRepo, ledgerRepo, profitRepo)
+	importRepo := customer_import.NewRepository(masterDb, customerRepo, accountRepo, transactionRepo)
+	portalRepo := customer_portal.NewRepository(masterDb, authenticator, customerRepo, accountRepo, accPrefRepo, notifySMS)
 
 	appCtx := &handlers.AppContext{
-		Log:              log,
-		Env:              cfg.Env,
-		MasterDB:         masterDb,
-		MasterDbHost:     cfg.DB.Host,
-		Redis:            redisClient,
-		TemplateDir:      cfg.Service.TemplateDir,
-		StaticDir:        cfg.Service.StaticFiles.Dir,
-		WebRoute:         webRoute,
-		UserRepo:         usrRepo,
-		UserAccountRepo:  usrAccRepo,
-		TenantRepo:       accRepo,
-		AccountPrefRepo:  accPrefRepo,
-		AuthRepo:         authRepo,
-		GeoRepo:          geoRepo,
-		SignupRepo:       signupRepo,
-		InviteRepo:       inviteRepo,
-		ChecklistRepo:    chklstRepo,
-		CustomerRepo:     customerRepo,
-		AccountRepo:      accountRepo,
-		CommissionRepo:   commissionRepo,
-		TransactionRepo:  transactionRepo,
-		Authenticator:    authenticator,
-		AwsSession:       awsSession,
-		ProfitRepo:       profitRepo,
-		ShopRepo:         shopRepo,
-		BranchRepo:       branchRepo,
-		InventoryRepo:    inventoryRepo,
-		SaleRepo:         saleRepo,
-		ExpendituresRepo: expendituresRepo,
-		NotifySMS:        notifySMS,
+		Log:                log,
+		Env:                cfg.Env,
+		MasterDB:           masterDb,
+		MasterDbHost:       cfg.DB.Host,
+		Redis:              redisClient,
+		TemplateDir:        cfg.Service.TemplateDir,
+		StaticDir:          cfg.Service.StaticFiles.Dir,
+		WebRoute:           webRoute,
+		UserRepo:           usrRepo,
+		UserAccountRepo:    usrAccRepo,
+		TenantRepo:         accRepo,
+		AccountPrefRepo:    accPrefRepo,
+		AuthRepo:           authRepo,
+		GeoRepo:            geoRepo,
+		SignupRepo:         signupRepo,
+		InviteRepo:         inviteRepo,
+		ChecklistRepo:      chklstRepo,
+		CustomerRepo:       customerRepo,
+		AccountRepo:        accountRepo,
+		AccountProductRepo: accountProductRepo,
+		CommissionRepo:     commissionRepo,
+		TransactionRepo:    transactionRepo,
+		Authenticator:      authenticator,
+		AwsSession:         awsSession,
+		ProfitRepo:         profitRepo,
+		LedgerRepo:         ledgerRepo,
+		IntegrityRepo:      integrityRepo,
+		ShopRepo:           shopRepo,
+		BranchRepo:         branchRepo,
+		InventoryRepo:      inventoryRepo,
+		SaleRepo:           saleRepo,
+		ExpendituresRepo:   expendituresRepo,
+		TillRepo:           tillRepo,
+		LoanRepo:           loanRepo,
+		ImportRepo:         importRepo,
+		PortalRepo:         portalRepo,
+		NotifySMS:          notifySMS,
 	}
 
 	// =========================================================================
@@ -1031,9 +1065,9 @@ func main() {
 		"CompStringInt": func(s1 interface{}, s2 interface{}) bool {
 			return fmt.Sprintf("%v", s1) == fmt.Sprintf("%v", s2)
 		},
-		"normalize": func(f float64) string {
+		"normalize": func(a money.Amount) string {
 			p := message.NewPrinter(language.English)
-			return p.Sprintf("%.2f", f)
+			return p.Sprintf("%.2f", a.Float())
 		},
 	}
 
@@ -1129,6 +1163,11 @@ func main() {
 
 		switch statusCode {
 		case http.StatusUnauthorized:
+			// Customers log in to the portal with their phone number.
+			if strings.HasPrefix(r.URL.Path, "/portal") {
+				http.Redirect(w, r, "/portal/login", http.StatusFound)
+				return nil
+			}
 			http.Redirect(w, r, "/user/login?redirect="+url.QueryEscape(r.RequestURI), http.StatusFound)
 			return nil
 		}
@@ -1207,11 +1246,11 @@ func main() {
 	serverErrors := make(chan error, 1)
 
 	// Make an list of HTTP servers for both HTTP and HTTPS requests.
-	var httpServers []http.Server
+	var httpServers []*http.Server
 
 	// Start the HTTP service listening for requests.
 	if cfg.HTTP.Host != "" {
-		api := http.Server{
+		api := &http.Server{
 			Addr:           cfg.HTTP.Host,
 			Handler:        handlers.APP(shutdown, appCtx, openDbFunc),
 			ReadTimeout:    cfg.HTTP.ReadTimeout,
@@ -1228,7 +1267,7 @@ func main() {
 
 	// Start the HTTPS service listening for requests with an SSL Cert auto generated with Let's Encrypt.
 	if cfg.HTTPS.Host != "" {
-		api := http.Server{
+		api := &http.Server{
 			Addr:           cfg.HTTPS.Host,
 			Handler:        handlers.APP(shutdown, appCtx, openDbFunc),
 			ReadTimeout:    cfg.HTTPS.ReadTimeout,
@@ -9,13 +9,15 @@ import (
 
 	"github.com/pborman/uuid"
 	"github.com/pkg/errors"
+	"github.com/volatiletech/null/v8"
 	"github.com/volatiletech/sqlboiler/v4/boil"
 	"github.com/volatiletech/sqlboiler/v4/queries/qm"
 	. "github.com/volatiletech/sqlboiler/v4/queries/qm"
 	"gopkg.in/DataDog/dd-trace-go.v1/ddtrace/tracer"
 
-	"merryworld/surebank/internal/customer"
+	"merryworld/surebank/internal/account_product"
 	"merryworld/surebank/internal/platform/auth"
+	"merryworld/surebank/internal/platform/money"
 	"merryworld/surebank/internal/platform/web/webcontext"
 	"merryworld/surebank/internal/platform/web/weberror"
 	"merryworld/surebank/internal/postgres/models"
@@ -44,6 +46,10 @@ func (repo *Repository) Find(ctx context.Context, _ auth.Claims, req FindRequest
 		queries = append(queries, And("archived_at is null"))
 	}
 
+	if req.Status != "" {
+		queries = append(queries, models.AccountWhere.Status.EQ(req.Status))
+	}
+
 	totalCount, err := models.Accounts(queries...).Count(ctx, repo.DbConn)
 	if err != nil {
 		return nil, weberror.WithMessage(ctx, err, "Cannot get account total count")
@@ -61,6 +67,10 @@ func (repo *Repository) Find(ctx context.Context, _ auth.Claims, req FindRequest
 		queries = append(queries, Load(models.AccountRels.SalesRep))
 	}
 
+	if req.IncludeProduct {
+		queries = append(queries, Load(models.AccountRels.Product))
+	}
+
 	if len(req.Order) > 0 {
 		for _, s := range req.Order {
 			queries = append(queries, OrderBy(s))
@@ -121,7 +131,7 @@ func (repo *Repository) Find(ctx context.Context, _ auth.Claims, req FindRequest
 // 	}
 // }
 
-// FindDs gets all the accounts from the database that are of DS type and have > 0 balance.
+// FindDs gets all the accounts from the database that are on a daily contribution product and have > 0 balance.
 func (repo *Repository) FindDs(ctx context.Context, _ auth.Claims, req FindRequest) (*PagedResponseList, error) {
 	span, ctx := tracer.StartSpanFromContext(ctx, "internal.account.FindDs")
 	defer span.Finish()
@@ -133,7 +143,7 @@ func (repo *Repository) FindDs(ctx context.Context, _ auth.Claims, req FindReque
 	}
 
 	queries = append(queries,
-		models.AccountWhere.AccountType.EQ(customer.AccountTypeDS),
+		Where("product_id in (select id from account_product where daily_contribution)"),
 		models.AccountWhere.Balance.GT(0),
 	)
 
@@ -141,81 +151,8 @@ func (repo *Repository) FindDs(ctx context.Context, _ auth.Claims, req FindReque
 		queries = append(queries, And("archived_at is null"))
 	}
 
-	totalCount, err := models.Accounts(queries...).Count(ctx, repo.DbConn)
-	if err != nil {
-		return nil, weberror.WithMessage(ctx, err, "Cannot get account total count")
-	}
-
-	if req.IncludeBranch {
-		queries = append(queries, Load(models.AccountRels.Branch))
-	}
-
-	if req.IncludeCustomer {
-		queries = append(queries, Load(models.AccountRels.Customer))
-	}
-
-	if req.IncludeSalesRep {
-		queries = append(queries, Load(models.AccountRels.SalesRep))
-	}
-
-	if len(req.Order) > 0 {
-		for _, s := range req.Order {
-			queries = append(queries, OrderBy(s))
-		}
-	}
-
-	if req.Limit != nil {
-		queries = append(queries, Limit(int(*req.Limit)))
-	}
-
-	if req.Offset != nil {
-		queries = append(queries, Offset(int(*req.Offset)))
-	}
-
-	accountSlice, err := models.Accounts(queries...).All(ctx, repo.DbConn)
-	if err != nil {
-		if err.Error() == sql.ErrNoRows.Error() {
-			return &PagedResponseList{}, nil
-		}
-		return nil, weberror.NewError(ctx, err, 500)
-	}
-
-	var result Accounts
-	for _, rec := range accountSlice {
-		result = append(result, FromModel(rec))
-	}
-
-	if len(result) == 0 {
-		return &PagedResponseList{}, nil
-	}
-
-	return &PagedResponseList{
-		Accounts:   result.Response(ctx),
-		TotalCount: totalCount,
-	}, nil
-}
-
-// FindDs gets all the accounts from the database that are of DS type and have > 0 balance.
-func (repo *Repository) Debtors(ctx context.Context, _ auth.Claims, req FindRequest, currentDate time.Time) (*PagedResponseList, error) {
-	span, ctx := tracer.StartSpanFromContext(ctx, "internal.account.Debtors")
-	defer span.Finish()
-
-	var queries []QueryMod
-
-	if req.Where != "" {
-		queries = append(queries, Where(req.Where, req.Args...))
-	}
-
-	threeDaysAgo := currentDate.Add(-3 * 24 * time.Hour).Unix()
-	thirtyDaysAgo := currentDate.Add(-30 * 24 * time.Hour).Unix()
-
-	queries = append(queries,
-		models.AccountWhere.LastPaymentDate.GTE(thirtyDaysAgo),
-		models.AccountWhere.LastPaymentDate.LTE(threeDaysAgo),
-	)
-
-	if !req.IncludeArchived {
-		queries = append(queries, And("archived_at is null"))
+	if req.Status != "" {
+		queries = append(queries, models.AccountWhere.Status.EQ(req.Status))
 	}
 
 	totalCount, err := models.Accounts(queries...).Count(ctx, repo.DbConn)
@@ -235,7 +172,10 @@ func (repo *Repository) Debtors(ctx context.Context, _ auth.Claims, req FindRequ
 		queries = append(queries, Load(models.AccountRels.SalesRep))
 	}
 
-	queries = append(queries, qm.OrderBy(models.AccountColumns.LastPaymentDate))
+	if req.IncludeProduct {
+		queries = append(queries, Load(models.AccountRels.Product))
+	}
+
 	if len(req.Order) > 0 {
 		for _, s := range req.Order {
 			queries = append(queries, OrderBy(s))
@@ -283,6 +223,7 @@ func (repo *Repository) ReadByID(ctx context.Context, claims auth.Claims, id str
 		Load(models.AccountRels.Branch),
 		Load(models.AccountRels.Customer),
 		Load(models.AccountRels.SalesRep),
+		Load(models.AccountRels.Product),
 	}
 	branchModel, err := models.Accounts(queries...).One(ctx, repo.DbConn)
 	if err != nil {
@@ -311,11 +252,26 @@ func (repo *Repository) AccountsCount(ctx context.Context, claims auth.Claims) (
 func (repo *Repository) Create(ctx context.Context, claims auth.Claims, req CreateRequest, now time.Time) (*Account, error) {
 	span, ctx := tracer.StartSpanFromContext(ctx, "internal.account.Create")
 	defer span.Finish()
+
+	return repo.create(ctx, claims, req, now, repo.DbConn)
+}
+
+// CreateTx inserts a new account within the db transaction so it is only opened when the rest
+// of the work it is opened for succeeds.
+func (repo *Repository) CreateTx(ctx context.Context, claims auth.Claims, req CreateRequest, now time.Time, tx *sql.Tx) (*Account, error) {
+	span, ctx := tracer.StartSpanFromContext(ctx, "internal.account.CreateTx")
+	defer span.Finish()
+
+	return repo.create(ctx, claims, req, now, tx)
+}
+
+// create inserts a new account with the executor.
+func (repo *Repository) create(ctx context.Context, claims auth.Claims, req CreateRequest, now time.Time, exec boil.ContextExecutor) (*Account, error) {
 	if claims.Audience == "" {
 		return nil, errors.WithStack(ErrForbidden)
 	}
 
-	salesRep, err := models.Users(models.UserWhere.ID.EQ(claims.Subject)).One(ctx, repo.DbConn)
+	salesRep, err := models.Users(models.UserWhere.ID.EQ(claims.Subject)).One(ctx, exec)
 	if err != nil {
 		return nil, weberror.NewErrorMessage(ctx, err, 400, "Something went wrong. Are you logged in?")
 	}
@@ -328,6 +284,15 @@ func (repo *Repository) Create(ctx context.Context, claims auth.Claims, req Crea
 		return nil, err
 	}
 
+	product, err := repo.readProduct(ctx, req.Type)
+	if err != nil {
+		return nil, err
+	}
+	if product.TargetRequired && req.Target <= 0 {
+		return nil, weberror.NewErrorMessage(ctx, errors.New("target required"), 400,
+			product.Name+" accounts must have a target amount")
+	}
+
 	// If now empty set it to the current time.
 	if now.IsZero() {
 		now = time.Now()
@@ -343,34 +308,74 @@ func (repo *Repository) Create(ctx context.Context, claims auth.Claims, req Crea
 	defer repo.accNumMtx.Unlock()
 
 	m := models.Account{
-		ID:          uuid.NewRandom().String(),
-		Number:      repo.generateAccountNumber(ctx, req.Type),
-		CustomerID:  req.CustomerID,
-		AccountType: req.Type,
-		Target:      req.Target,
-		TargetInfo:  req.TargetInfo,
-		SalesRepID:  claims.Subject,
-		CreatedAt:   now.Unix(),
-		BranchID:    req.BranchID,
-		UpdatedAt:   now.Unix(),
-	}
-
-	if err := m.Insert(ctx, repo.DbConn, boil.Infer()); err != nil {
+		ID:              uuid.NewRandom().String(),
+		Number:          repo.generateAccountNumber(ctx, product.Code),
+		CustomerID:      req.CustomerID,
+		AccountType:     product.Code,
+		ProductID:       product.ID,
+		Target:          req.Target.Kobo(),
+		TargetInfo:      req.TargetInfo,
+		SalesRepID:      claims.Subject,
+		CreatedAt:       now.Unix(),
+		BranchID:        req.BranchID,
+		UpdatedAt:       now.Unix(),
+		TenorDays:       product.TenorDays,
+		InterestRateBPS: product.InterestRateBPS,
+		RollOver:        req.RollOver,
+	}
+	if product.TenorDays > 0 {
+		m.MaturityDate = null.Int64From(maturityDate(now, product.TenorDays).Unix())
+	}
+
+	if err := m.Insert(ctx, exec, boil.Infer()); err != nil {
 		return nil, weberror.WithMessage(ctx, err, "Insert account failed")
 	}
 
-	return &Account{
+	a := &Account{
 		ID:         m.ID,
 		CustomerID: m.CustomerID,
 		Number:     m.Number,
 		Type:       m.AccountType,
-		Target:     m.Target,
+		ProductID:  m.ProductID,
+		Target:     money.Amount(m.Target),
 		TargetInfo: m.TargetInfo,
 		SalesRepID: m.SalesRepID,
 		BranchID:   m.BranchID,
 		CreatedAt:  time.Unix(m.CreatedAt, 0),
 		UpdatedAt:  time.Unix(m.UpdatedAt, 0),
-	}, nil
+		Product:    product,
+
+		TenorDays:       m.TenorDays,
+		InterestRateBPS: m.InterestRateBPS,
+		RollOver:        m.RollOver,
+	}
+	if m.MaturityDate.Valid {
+		maturity := time.Unix(m.MaturityDate.Int64, 0)
+		a.MaturityDate = &maturity
+	}
+
+	return a, nil
+}
+
+// maturityDate returns the end of a tenor of the number of days that starts at the time.
+func maturityDate(start time.Time, tenorDays int) time.Time {
+	return start.AddDate(0, 0, tenorDays)
+}
+
+// readProduct gets the product accounts of the specified type are opened on. Archived products
+// are no longer offered.
+func (repo *Repository) readProduct(ctx context.Context, code string) (*account_product.Product, error) {
+	rec, err := models.AccountProducts(
+		models.AccountProductWhere.Code.EQ(code),
+		models.AccountProductWhere.ArchivedAt.IsNull(),
+	).One(ctx, repo.DbConn)
+	if err != nil {
+		if err.Error() == sql.ErrNoRows.Error() {
+			return nil, weberror.NewErrorMessage(ctx, err, 400, "Invalid account type "+code)
+		}
+		return nil, weberror.NewError(ctx, err, 500)
+	}
+	return account_product.FromModel(rec), nil
 }
 
 func (repo *Repository) generateAccountNumber(ctx context.Context, accountType string) string {
@@ -410,17 +415,53 @@ func (repo *Repository) Update(ctx context.Context, claims auth.Claims, req Upda
 		return err
 	}
 
+	// Only super admins can change the interest terms of an account.
+	if (req.TenorDays != nil || req.InterestRateBPS != nil) && !claims.HasRole(auth.RoleSuperAdmin) {
+		return errors.WithStack(ErrForbidden)
+	}
+
 	cols := models.M{}
 	if req.TargetInfo != nil {
 		cols[models.AccountColumns.TargetInfo] = *req.TargetInfo
 	}
 
+	if req.RollOver != nil {
+		cols[models.AccountColumns.RollOver] = *req.RollOver
+	}
+
+	if req.InterestRateBPS != nil {
+		cols[models.AccountColumns.InterestRateBPS] = *req.InterestRateBPS
+	}
+
+	if req.TenorDays != nil {
+		acc, err := models.FindAccount(ctx, repo.DbConn, req.ID)
+		if err != nil {
+			return weberror.NewError(ctx, err, 400)
+		}
+		cols[models.AccountColumns.TenorDays] = *req.TenorDays
+		cols[models.AccountColumns.MaturityDate] = null.Int64{}
+		if *req.TenorDays > 0 {
+			cols[models.AccountColumns.MaturityDate] = null.Int64From(maturityDate(time.Unix(acc.CreatedAt, 0), *req.TenorDays).Unix())
+		}
+	}
+
 	if req.Target != nil {
-		cols[models.AccountColumns.Target] = *req.Target
+		acc, err := models.FindAccount(ctx, repo.DbConn, req.ID)
+		if err != nil {
+			return weberror.NewError(ctx, err, 400)
+		}
+		cols[models.AccountColumns.Target] = req.Target.Kobo()
+		// Milestones of the new target that the balance has already passed are not notified.
+		cols[models.AccountColumns.GoalMilestone] = GoalMilestone(money.Amount(acc.Balance), *req.Target)
 	}
 
 	if req.Type != nil {
-		cols[models.AccountColumns.AccountType] = *req.Type
+		product, err := repo.readProduct(ctx, *req.Type)
+		if err != nil {
+			return err
+		}
+		cols[models.AccountColumns.AccountType] = product.Code
+		cols[models.AccountColumns.ProductID] = product.ID
 	}
 
 	if len(cols) == 0 {
@@ -0,0 +1,307 @@
+package account
+
+import (
+	"context"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/jinzhu/now"
+	"github.com/pborman/uuid"
+	"github.com/pkg/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	. "github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"gopkg.in/DataDog/dd-trace-go.v1/ddtrace/tracer"
+
+	"merryworld/surebank/internal/platform/auth"
+	"merryworld/surebank/internal/platform/web/webcontext"
+	"merryworld/surebank/internal/platform/web/weberror"
+	"merryworld/surebank/internal/postgres/models"
+)
+
+var (
+	// ErrPromisedDateRequired occurs when a promise to pay is recorded without the day it is due.
+	ErrPromisedDateRequired = errors.New("The day the customer promised to pay by is required")
+
+	// ErrPromisedDatePassed occurs when a promise to pay is recorded for a day that has passed.
+	ErrPromisedDatePassed = errors.New("The promised date cannot be in the past")
+)
+
+// OutstandingDays returns the number of days before the day of currentDate that a DS account has
+// not paid for. lastPaid is the effective date of the last day paid for, accounts that have not
+// paid anything owe from the day they were opened.
+func OutstandingDays(lastPaid, opened, currentDate time.Time) int {
+	today := now.New(currentDate).BeginningOfDay()
+
+	last := now.New(opened).BeginningOfDay().Add(-24 * time.Hour)
+	if lastPaid.Unix() > 0 {
+		last = now.New(lastPaid).BeginningOfDay()
+	}
+
+	days := int(math.Round(today.Sub(last).Hours()/24)) - 1
+	if days < 0 {
+		return 0
+	}
+	return days
+}
+
+// BucketFor returns the name of the aging bucket of the outstanding days, empty when nothing is
+// outstanding.
+func BucketFor(days int) string {
+	if days < 1 {
+		return ""
+	}
+	for _, b := range AgingBuckets {
+		if b.MaxDays == 0 || days <= b.MaxDays {
+			return b.Name
+		}
+	}
+	return ""
+}
+
+// Debtors gets the DS accounts that have not been paid for up to the day before currentDate, with
+// the days outstanding, the amount owed and the last follow up call of each. The aging is worked
+// out for every debtor of the branch and sales rep filters, the bucket and follow up filters only
+// narrow the list of debtors.
+func (repo *Repository) Debtors(ctx context.Context, claims auth.Claims, req DebtorsRequest, currentDate time.Time) (*DebtorsResponse, error) {
+	span, ctx := tracer.StartSpanFromContext(ctx, "internal.account.Debtors")
+	defer span.Finish()
+
+	if claims.Audience == "" {
+		return nil, errors.WithStack(ErrForbidden)
+	}
+
+	v := webcontext.Validator()
+	if err := v.Struct(req); err != nil {
+		return nil, err
+	}
+
+	today := now.New(currentDate).BeginningOfDay()
+
+	queries := []QueryMod{
+		Where("product_id in (select id from account_product where daily_contribution)"),
+		// Accounts paid up to yesterday owe nothing yet.
+		models.AccountWhere.LastPaymentDate.LT(today.Add(-24 * time.Hour).Unix()),
+		models.AccountWhere.ArchivedAt.IsNull(),
+	}
+	if req.BranchID != "" {
+		queries = append(queries, models.AccountWhere.BranchID.EQ(req.BranchID))
+	}
+	if req.SalesRepID != "" {
+		queries = append(queries, models.AccountWhere.SalesRepID.EQ(req.SalesRepID))
+	}
+	queries = append(queries,
+		Load(models.AccountRels.Branch),
+		Load(models.AccountRels.Customer),
+		Load(models.AccountRels.SalesRep),
+		OrderBy(models.AccountColumns.LastPaymentDate),
+	)
+
+	accountSlice, err := models.Accounts(queries...).All(ctx, repo.DbConn)
+	if err != nil {
+		return nil, weberror.NewError(ctx, err, 500)
+	}
+
+	followUps, err := repo.lastFollowUps(ctx, accountSlice)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &DebtorsResponse{
+		Debtors: []*Debtor{},
+		Aging:   []*AgingSummary{},
+		Total:   AgingSummary{Buckets: make([]AgingTotal, len(AgingBuckets))},
+	}
+	aging := make(map[string]*AgingSummary)
+
+	for _, rec := range accountSlice {
+		a := FromModel(rec)
+
+		days := OutstandingDays(a.LastPaymentDate, a.CreatedAt, currentDate)
+		bucket := BucketFor(days)
+		if bucket == "" {
+			continue
+		}
+		d := &Debtor{
+			Account:         a.Response(ctx),
+			DaysOutstanding: days,
+			AmountOwed:      a.Target.Mul(int64(days)),
+			Bucket:          bucket,
+		}
+
+		// A follow up made before the account fell behind belongs to earlier arrears.
+		if f, ok := followUps[a.ID]; ok && !f.CalledAt.Before(today.AddDate(0, 0, -days)) {
+			d.LastFollowUp = f.Response(ctx)
+			d.PromiseBroken = f.Outcome == FollowUpOutcome_Promised && f.PromisedDate != nil &&
+				f.PromisedDate.Before(today)
+		}
+
+		key := a.BranchID + "/" + a.SalesRepID
+		s, ok := aging[key]
+		if !ok {
+			s = &AgingSummary{
+				BranchID:   a.BranchID,
+				Branch:     d.Account.Branch,
+				SalesRepID: a.SalesRepID,
+				SalesRep:   d.Account.SalesRep,
+				Buckets:    make([]AgingTotal, len(AgingBuckets)),
+			}
+			aging[key] = s
+			resp.Aging = append(resp.Aging, s)
+		}
+		for i, b := range AgingBuckets {
+			if b.Name == bucket {
+				s.Buckets[i].Add(d.AmountOwed)
+				resp.Total.Buckets[i].Add(d.AmountOwed)
+			}
+		}
+		s.Total.Add(d.AmountOwed)
+		resp.Total.Total.Add(d.AmountOwed)
+
+		if req.Bucket != "" && req.Bucket != bucket {
+			continue
+		}
+		switch req.FollowUp {
+		case DebtorFollowUp_None:
+			if d.LastFollowUp != nil {
+				continue
+			}
+		case DebtorFollowUp_Promised:
+			if d.LastFollowUp == nil || d.LastFollowUp.Outcome != FollowUpOutcome_Promised || d.PromiseBroken {
+				continue
+			}
+		case DebtorFollowUp_Broken:
+			if !d.PromiseBroken {
+				continue
+			}
+		}
+		resp.Debtors = append(resp.Debtors, d)
+	}
+
+	sort.SliceStable(resp.Aging, func(i, j int) bool {
+		if resp.Aging[i].Branch != resp.Aging[j].Branch {
+			return resp.Aging[i].Branch < resp.Aging[j].Branch
+		}
+		return resp.Aging[i].SalesRep < resp.Aging[j].SalesRep
+	})
+
+	return resp, nil
+}
+
+// lastFollowUps returns the last follow up call made to each of the accounts by account ID.
+func (repo *Repository) lastFollowUps(ctx context.Context, accounts models.AccountSlice) (map[string]*FollowUp, error) {
+	res := make(map[string]*FollowUp)
+	if len(accounts) == 0 {
+		return res, nil
+	}
+
+	var ids []string
+	for _, a := range accounts {
+		ids = append(ids, a.ID)
+	}
+
+	followUpSlice, err := models.AccountFollowUps(
+		models.AccountFollowUpWhere.AccountID.IN(ids),
+		Load(models.AccountFollowUpRels.SalesRep),
+		OrderBy("called_at desc, created_at desc"),
+	).All(ctx, repo.DbConn)
+	if err != nil {
+		return nil, weberror.NewError(ctx, err, 500)
+	}
+
+	for _, rec := range followUpSlice {
+		if _, ok := res[rec.AccountID]; !ok {
+			res[rec.AccountID] = FollowUpFromModel(rec)
+		}
+	}
+
+	return res, nil
+}
+
+// FindFollowUps gets the follow up calls made to the customer of an account, the latest first.
+func (repo *Repository) FindFollowUps(ctx context.Context, claims auth.Claims, accountID string) (FollowUps, error) {
+	span, ctx := tracer.StartSpanFromContext(ctx, "internal.account.FindFollowUps")
+	defer span.Finish()
+
+	if claims.Audience == "" {
+		return nil, errors.WithStack(ErrForbidden)
+	}
+
+	followUpSlice, err := models.AccountFollowUps(
+		models.AccountFollowUpWhere.AccountID.EQ(accountID),
+		Load(models.AccountFollowUpRels.SalesRep),
+		OrderBy("called_at desc, created_at desc"),
+	).All(ctx, repo.DbConn)
+	if err != nil {
+		return nil, weberror.NewError(ctx, err, 500)
+	}
+
+	var result FollowUps
+	for _, rec := range followUpSlice {
+		result = append(result, FollowUpFromModel(rec))
+	}
+
+	return result, nil
+}
+
+// CreateFollowUp records a call made by the current user to the customer of a DS account.
+func (repo *Repository) CreateFollowUp(ctx context.Context, claims auth.Claims, req FollowUpCreateRequest, currentDate time.Time) (*FollowUp, error) {
+	span, ctx := tracer.StartSpanFromContext(ctx, "internal.account.CreateFollowUp")
+	defer span.Finish()
+
+	if claims.Audience == "" {
+		return nil, errors.WithStack(ErrForbidden)
+	}
+
+	v := webcontext.Validator()
+	if err := v.Struct(req); err != nil {
+		return nil, err
+	}
+
+	// If now empty set it to the current time.
+	if currentDate.IsZero() {
+		currentDate = time.Now()
+	}
+
+	// Always store the time as UTC.
+	currentDate = currentDate.UTC()
+
+	// Postgres truncates times to milliseconds when storing. We and do the same
+	// here so the value we return is consistent with what we store.
+	currentDate = currentDate.Truncate(time.Millisecond)
+
+	m := models.AccountFollowUp{
+		ID:         uuid.NewRandom().String(),
+		AccountID:  req.AccountID,
+		SalesRepID: claims.Subject,
+		CalledAt:   currentDate.Unix(),
+		Outcome:    req.Outcome,
+		Note:       req.Note,
+		CreatedAt:  currentDate.Unix(),
+	}
+
+	if req.Outcome == FollowUpOutcome_Promised {
+		if req.PromisedDate == nil {
+			return nil, weberror.NewError(ctx, ErrPromisedDateRequired, 400)
+		}
+		promisedDate := now.New(req.PromisedDate.UTC()).BeginningOfDay()
+		if promisedDate.Before(now.New(currentDate).BeginningOfDay()) {
+			return nil, weberror.NewError(ctx, ErrPromisedDatePassed, 400)
+		}
+		m.PromisedDate = null.Int64From(promisedDate.Unix())
+	}
+
+	exists, err := models.AccountExists(ctx, repo.DbConn, req.AccountID)
+	if err != nil {
+		return nil, err
+	} else if !exists {
+		return nil, weberror.NewError(ctx, ErrNotFound, 404)
+	}
+
+	if err := m.Insert(ctx, repo.DbConn, boil.Infer()); err != nil {
+		return nil, errors.WithMessage(err, "Insert follow up failed")
+	}
+
+	return FollowUpFromModel(&m), nil
+}
@@ -0,0 +1,80 @@
+package account
+
+import (
+	"testing"
+	"time"
+
+	"merryworld/surebank/internal/platform/tests"
+)
+
+// TestOutstandingDays validates the days a DS account has not paid for.
+func TestOutstandingDays(t *testing.T) {
+
+	day := func(d int) time.Time {
+		return time.Date(2026, time.March, d, 0, 0, 0, 0, time.UTC)
+	}
+	currentDate := time.Date(2026, time.March, 20, 15, 30, 0, 0, time.UTC)
+
+	var outstandingTests = []struct {
+		name     string
+		lastPaid time.Time
+		opened   time.Time
+		want     int
+	}{
+		{"paid up to today", day(20), day(1), 0},
+		{"paid up to yesterday", day(19), day(1), 0},
+		{"paid in advance", day(25), day(1), 0},
+		{"missed yesterday", day(18), day(1), 1},
+		{"missed ten days", day(9), day(1), 10},
+		{"never paid, opened today", time.Unix(0, 0), time.Date(2026, time.March, 20, 9, 0, 0, 0, time.UTC), 0},
+		{"never paid, opened yesterday", time.Unix(0, 0), time.Date(2026, time.March, 19, 9, 0, 0, 0, time.UTC), 1},
+		{"never paid, opened a week ago", time.Unix(0, 0), day(13), 7},
+	}
+
+	t.Log("Given the need to work out how far behind DS accounts are.")
+	{
+		for i, tt := range outstandingTests {
+			t.Logf("\tTest: %d\tWhen the account %s.", i, tt.name)
+			{
+				if got := OutstandingDays(tt.lastPaid, tt.opened, currentDate); got != tt.want {
+					t.Fatalf("\t%s\tExpected %d days outstanding, got %d.", tests.Failed, tt.want, got)
+				}
+				t.Logf("\t%s\tOutstandingDays ok.", tests.Success)
+			}
+		}
+	}
+}
+
+// TestBucketFor validates the aging bucket of the days outstanding.
+func TestBucketFor(t *testing.T) {
+
+	var bucketTests = []struct {
+		days int
+		want string
+	}{
+		{0, ""},
+		{1, "1-3"},
+		{3, "1-3"},
+		{4, "4-7"},
+		{7, "4-7"},
+		{8, "8-14"},
+		{14, "8-14"},
+		{15, "15-30"},
+		{30, "15-30"},
+		{31, "30+"},
+		{400, "30+"},
+	}
+
+	t.Log("Given the need to group DS debtors by the days outstanding.")
+	{
+		for i, tt := range bucketTests {
+			t.Logf("\tTest: %d\tWhen %d days are outstanding.", i, tt.days)
+			{
+				if got := BucketFor(tt.days); got != tt.want {
+					t.Fatalf("\t%s\tExpected bucket %q, got %q.", tests.Failed, tt.want, got)
+				}
+				t.Logf("\t%s\tBucketFor ok.", tests.Success)
+			}
+		}
+	}
+}
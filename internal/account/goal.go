@@ -0,0 +1,157 @@
+package account
+
+import (
+	"context"
+	"time"
+
+	"github.com/jinzhu/now"
+	"github.com/pkg/errors"
+	"gopkg.in/DataDog/dd-trace-go.v1/ddtrace/tracer"
+
+	"merryworld/surebank/internal/customer"
+	"merryworld/surebank/internal/platform/money"
+	"merryworld/surebank/internal/platform/web"
+)
+
+// GoalMilestones are the percentages of the target of an SB account the customer is sent an SMS
+// for, in increasing order.
+var GoalMilestones = []int{50, 90, 100}
+
+// GoalRateDays is the number of days the deposits into an SB account are averaged over to project
+// when its target will be reached.
+const GoalRateDays = 30
+
+// Goal is the progress of an SB account towards its target.
+type Goal struct {
+	Target    money.Amount `json:"target"`
+	Info      string       `json:"info"`
+	Balance   money.Amount `json:"balance"`
+	Remaining money.Amount `json:"remaining"`
+	Percent   int          `json:"percent"`
+	Reached   bool         `json:"reached"`
+	// DailyRate is the average deposited a day over the last GoalRateDays days.
+	DailyRate money.Amount `json:"daily_rate"`
+	// ProjectedDate is when the target is reached if deposits keep up the daily rate. It is not
+	// set once the target is reached or when nothing was deposited.
+	ProjectedDate *time.Time `json:"projected_date,omitempty"`
+}
+
+// HasGoal reports whether the account is an SB account saving towards a target.
+func HasGoal(accountType string, target money.Amount) bool {
+	return accountType == customer.AccountTypeSB && target > 0
+}
+
+// GoalPercent returns the balance as a whole percentage of the target, rounded down.
+func GoalPercent(balance, target money.Amount) int {
+	if target <= 0 || balance <= 0 {
+		return 0
+	}
+	return int(balance.Kobo() * 100 / target.Kobo())
+}
+
+// GoalMilestone returns the highest of the GoalMilestones the balance has reached, 0 for none.
+func GoalMilestone(balance, target money.Amount) int {
+	percent := GoalPercent(balance, target)
+	var res int
+	for _, m := range GoalMilestones {
+		if percent >= m {
+			res = m
+		}
+	}
+	return res
+}
+
+// NewGoal works out the progress towards the target from the amount deposited over the last days.
+func NewGoal(balance, target money.Amount, deposited money.Amount, days int, currentDate time.Time) *Goal {
+	g := &Goal{
+		Target:  target,
+		Balance: balance,
+		Percent: GoalPercent(balance, target),
+		Reached: target > 0 && balance >= target,
+	}
+	if !g.Reached {
+		g.Remaining = target - balance
+	}
+	if days <= 0 {
+		days = 1
+	}
+	g.DailyRate = money.Amount(deposited.Kobo() / int64(days))
+
+	if !g.Reached && g.DailyRate > 0 {
+		daysLeft := (g.Remaining.Kobo() + g.DailyRate.Kobo() - 1) / g.DailyRate.Kobo()
+		projected := now.New(currentDate).BeginningOfDay().AddDate(0, 0, int(daysLeft))
+		g.ProjectedDate = &projected
+	}
+
+	return g
+}
+
+// GoalResponse represents the progress of an SB account towards its target for display.
+type GoalResponse struct {
+	Target        money.Amount      `json:"target"`
+	Info          string            `json:"info"`
+	Balance       money.Amount      `json:"balance"`
+	Remaining     money.Amount      `json:"remaining"`
+	Percent       int               `json:"percent"`
+	Reached       bool              `json:"reached"`
+	DailyRate     money.Amount      `json:"daily_rate"`
+	ProjectedDate *web.TimeResponse `json:"projected_date,omitempty"`
+}
+
+// Response transforms Goal to the GoalResponse that is used for display.
+func (g *Goal) Response(ctx context.Context) *GoalResponse {
+	if g == nil {
+		return nil
+	}
+
+	r := &GoalResponse{
+		Target:    g.Target,
+		Info:      g.Info,
+		Balance:   g.Balance,
+		Remaining: g.Remaining,
+		Percent:   g.Percent,
+		Reached:   g.Reached,
+		DailyRate: g.DailyRate,
+	}
+
+	if g.ProjectedDate != nil {
+		pd := web.NewTimeResponse(ctx, *g.ProjectedDate)
+		r.ProjectedDate = &pd
+	}
+
+	return r
+}
+
+const goalDepositsStatement = `SELECT COALESCE(SUM(tx.amount), 0)
+	FROM transaction tx
+	WHERE tx.account_id = $1 AND tx.tx_type = 'deposit' AND tx.archived_at IS NULL AND tx.created_at >= $2`
+
+// LoadGoal works out the progress of an SB account towards its target from its deposits over the
+// last GoalRateDays days, or since it was opened when that is more recent. Accounts without a
+// goal are left as they are.
+func (repo *Repository) LoadGoal(ctx context.Context, a *Account, currentDate time.Time) error {
+	span, ctx := tracer.StartSpanFromContext(ctx, "internal.account.LoadGoal")
+	defer span.Finish()
+
+	if a == nil || !HasGoal(a.Type, a.Target) {
+		return nil
+	}
+
+	today := now.New(currentDate).BeginningOfDay()
+	since := today.AddDate(0, 0, -GoalRateDays)
+	days := GoalRateDays
+	if opened := now.New(a.CreatedAt).BeginningOfDay(); opened.After(since) {
+		since = opened
+		days = int(today.Sub(opened).Hours()/24) + 1
+	}
+
+	var deposited int64
+	if err := repo.DbConn.QueryRowContext(ctx, goalDepositsStatement, a.ID, since.Unix()).Scan(&deposited); err != nil {
+		return errors.WithMessage(err, "Cannot read goal deposits")
+	}
+
+	a.Goal = NewGoal(a.Balance, a.Target, money.Amount(deposited), days, currentDate)
+	a.Goal.Info = a.TargetInfo
+
+	return nil
+}
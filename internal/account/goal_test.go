@@ -0,0 +1,83 @@
+package account
+
+import (
+	"testing"
+	"time"
+
+	"merryworld/surebank/internal/platform/money"
+	"merryworld/surebank/internal/platform/tests"
+)
+
+// TestGoalMilestone validates the milestones of savings goals customers are notified of.
+func TestGoalMilestone(t *testing.T) {
+
+	var milestoneTests = []struct {
+		balance, target money.Amount
+		want            int
+	}{
+		{money.Naira(4999), money.Naira(10000), 0},
+		{money.Naira(5000), money.Naira(10000), 50},
+		{money.Naira(8999), money.Naira(10000), 50},
+		{money.Naira(9000), money.Naira(10000), 90},
+		{money.Naira(10000), money.Naira(10000), 100},
+		{money.Naira(12000), money.Naira(10000), 100},
+		{money.Naira(5000), 0, 0},
+	}
+
+	t.Log("Given the need to tell customers how close they are to their savings goal.")
+	{
+		for i, tt := range milestoneTests {
+			t.Logf("\tTest: %d\tWhen %s is saved of a %s target.", i, tt.balance, tt.target)
+			{
+				if got := GoalMilestone(tt.balance, tt.target); got != tt.want {
+					t.Fatalf("\t%s\tExpected milestone %d, got %d.", tests.Failed, tt.want, got)
+				}
+				t.Logf("\t%s\tGoalMilestone ok.", tests.Success)
+			}
+		}
+	}
+}
+
+// TestNewGoal validates the progress and projected completion of savings goals.
+func TestNewGoal(t *testing.T) {
+
+	currentDate := time.Date(2026, time.June, 30, 15, 0, 0, 0, time.UTC)
+	day := func(m time.Month, d int) *time.Time {
+		t := time.Date(2026, m, d, 0, 0, 0, 0, time.UTC)
+		return &t
+	}
+
+	var goalTests = []struct {
+		name               string
+		balance, deposited money.Amount
+		days               int
+		wantPercent        int
+		wantRate           money.Amount
+		wantDate           *time.Time
+	}{
+		{"a steady saver", money.Naira(4000), money.Naira(3000), 30, 40, money.Naira(100), day(time.August, 29)},
+		{"a saver with a part day left", money.Naira(9950), money.Naira(3000), 30, 99, money.Naira(100), day(time.July, 1)},
+		{"no recent deposits", money.Naira(4000), 0, 30, 40, 0, nil},
+		{"a reached target", money.Naira(10500), money.Naira(3000), 30, 105, money.Naira(100), nil},
+	}
+
+	t.Log("Given the need to project when savings goals will be reached.")
+	{
+		for i, tt := range goalTests {
+			t.Logf("\tTest: %d\tWhen the account is %s.", i, tt.name)
+			{
+				g := NewGoal(tt.balance, money.Naira(10000), tt.deposited, tt.days, currentDate)
+				if g.Percent != tt.wantPercent || g.DailyRate != tt.wantRate {
+					t.Fatalf("\t%s\tExpected %d%% at %s a day, got %d%% at %s.", tests.Failed, tt.wantPercent, tt.wantRate, g.Percent, g.DailyRate)
+				}
+				if (g.ProjectedDate == nil) != (tt.wantDate == nil) || (g.ProjectedDate != nil && !g.ProjectedDate.Equal(*tt.wantDate)) {
+					t.Fatalf("\t%s\tExpected to complete on %v, got %v.", tests.Failed, tt.wantDate, g.ProjectedDate)
+				}
+				if g.Reached != (tt.balance >= money.Naira(10000)) {
+					t.Fatalf("\t%s\tExpected reached to be %v.", tests.Failed, !g.Reached)
+				}
+				t.Logf("\t%s\tNewGoal ok.", tests.Success)
+			}
+		}
+	}
+}
@@ -0,0 +1,224 @@
+package account
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/jinzhu/now"
+	"github.com/pborman/uuid"
+	"github.com/pkg/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	. "github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"gopkg.in/DataDog/dd-trace-go.v1/ddtrace/tracer"
+
+	"merryworld/surebank/internal/platform/auth"
+	"merryworld/surebank/internal/platform/money"
+	"merryworld/surebank/internal/platform/web/webcontext"
+	"merryworld/surebank/internal/platform/web/weberror"
+	"merryworld/surebank/internal/postgres/models"
+)
+
+var (
+	// ErrHoldExpiry occurs when a hold is placed with an expiry date that has passed.
+	ErrHoldExpiry = errors.New("The hold must expire after today")
+
+	// ErrHoldReleased occurs when a hold that was released or has expired is released.
+	ErrHoldReleased = errors.New("The hold is no longer active")
+)
+
+// Active reports whether the hold blocks part of the balance at the time.
+func (m *Hold) Active(t time.Time) bool {
+	if m.ReleasedAt != nil {
+		return false
+	}
+	return m.ExpiresAt == nil || t.Before(*m.ExpiresAt)
+}
+
+// Held returns the total amount of the holds that are active at the time.
+func (m Holds) Held(t time.Time) money.Amount {
+	var held money.Amount
+	for _, h := range m {
+		if h.Active(t) {
+			held += h.Amount
+		}
+	}
+	return held
+}
+
+// AvailableBalance returns the part of the balance that is not on hold, never less than zero.
+func AvailableBalance(balance, held money.Amount) money.Amount {
+	if available := balance - held; available > 0 {
+		return available
+	}
+	return 0
+}
+
+// CheckAvailable validates taking amount out of a balance with the amount held. The error says how
+// much is on hold so the teller can explain why the balance is not enough.
+func CheckAvailable(balance, held, amount money.Amount) error {
+	if held <= 0 || amount <= balance-held {
+		return nil
+	}
+	return fmt.Errorf("insufficient available balance, %s of the balance is on hold", held)
+}
+
+const heldAmountStatement = `SELECT COALESCE(SUM(amount), 0) FROM account_hold
+	WHERE account_id = $1 AND released_at IS NULL AND (expires_at IS NULL OR expires_at > $2)`
+
+// HeldAmount returns the total amount of the active holds on the account at the time. It takes
+// the executor so withdrawals can read it within their db transaction.
+func HeldAmount(ctx context.Context, exec boil.ContextExecutor, accountID string, t time.Time) (money.Amount, error) {
+	var held int64
+	if err := exec.QueryRowContext(ctx, heldAmountStatement, accountID, t.Unix()).Scan(&held); err != nil {
+		return 0, errors.WithMessage(err, "Cannot read held amount")
+	}
+	return money.Amount(held), nil
+}
+
+// CreateHold places a hold on part of the balance of an account. The hold expires at the end of its
+// expiry date. Only supervisors can place holds.
+func (repo *Repository) CreateHold(ctx context.Context, claims auth.Claims, req HoldCreateRequest, currentDate time.Time) (*Hold, error) {
+	span, ctx := tracer.StartSpanFromContext(ctx, "internal.account.CreateHold")
+	defer span.Finish()
+
+	if claims.Audience == "" || !claims.HasRole(auth.RoleAdmin, auth.RoleSuperAdmin) {
+		return nil, errors.WithStack(ErrForbidden)
+	}
+
+	v := webcontext.Validator()
+	if err := v.Struct(req); err != nil {
+		return nil, err
+	}
+
+	// If now empty set it to the current time.
+	if currentDate.IsZero() {
+		currentDate = time.Now()
+	}
+
+	// Always store the time as UTC.
+	currentDate = currentDate.UTC()
+
+	// Postgres truncates times to milliseconds when storing. We and do the same
+	// here so the value we return is consistent with what we store.
+	currentDate = currentDate.Truncate(time.Millisecond)
+
+	m := models.AccountHold{
+		ID:          uuid.NewRandom().String(),
+		AccountID:   req.AccountID,
+		Amount:      req.Amount.Kobo(),
+		Reason:      req.Reason,
+		CreatedByID: claims.Subject,
+		CreatedAt:   currentDate.Unix(),
+	}
+
+	if req.ExpiresAt != nil {
+		expiresAt := now.New(*req.ExpiresAt).EndOfDay()
+		if !expiresAt.After(currentDate) {
+			return nil, weberror.NewError(ctx, ErrHoldExpiry, http.StatusBadRequest)
+		}
+		m.ExpiresAt = null.Int64From(expiresAt.Unix())
+	}
+
+	exists, err := models.AccountExists(ctx, repo.DbConn, req.AccountID)
+	if err != nil {
+		return nil, err
+	} else if !exists {
+		return nil, weberror.NewError(ctx, ErrNotFound, http.StatusNotFound)
+	}
+
+	if err := m.Insert(ctx, repo.DbConn, boil.Infer()); err != nil {
+		return nil, errors.WithMessage(err, "Insert hold failed")
+	}
+
+	return HoldFromModel(&m), nil
+}
+
+// ReleaseHold releases an active hold so its amount can be taken out again. Only supervisors can
+// release holds.
+func (repo *Repository) ReleaseHold(ctx context.Context, claims auth.Claims, req HoldReleaseRequest, currentDate time.Time) error {
+	span, ctx := tracer.StartSpanFromContext(ctx, "internal.account.ReleaseHold")
+	defer span.Finish()
+
+	if claims.Audience == "" || !claims.HasRole(auth.RoleAdmin, auth.RoleSuperAdmin) {
+		return errors.WithStack(ErrForbidden)
+	}
+
+	v := webcontext.Validator()
+	if err := v.Struct(req); err != nil {
+		return err
+	}
+
+	// If now empty set it to the current time.
+	if currentDate.IsZero() {
+		currentDate = time.Now()
+	}
+
+	// Always store the time as UTC.
+	currentDate = currentDate.UTC()
+
+	// Postgres truncates times to milliseconds when storing. We and do the same
+	// here so the value we return is consistent with what we store.
+	currentDate = currentDate.Truncate(time.Millisecond)
+
+	tx, err := repo.DbConn.Begin()
+	if err != nil {
+		return err
+	}
+
+	rec, err := models.AccountHolds(models.AccountHoldWhere.ID.EQ(req.ID), For("UPDATE")).One(ctx, tx)
+	if err != nil {
+		_ = tx.Rollback()
+		if errors.Cause(err) == sql.ErrNoRows {
+			return weberror.NewError(ctx, ErrNotFound, http.StatusNotFound)
+		}
+		return err
+	}
+
+	if !HoldFromModel(rec).Active(currentDate) {
+		_ = tx.Rollback()
+		return weberror.NewError(ctx, ErrHoldReleased, http.StatusBadRequest)
+	}
+
+	rec.ReleasedAt = null.Int64From(currentDate.Unix())
+	rec.ReleasedByID = null.StringFrom(claims.Subject)
+	if _, err = rec.Update(ctx, tx, boil.Whitelist(
+		models.AccountHoldColumns.ReleasedAt,
+		models.AccountHoldColumns.ReleasedByID,
+	)); err != nil {
+		_ = tx.Rollback()
+		return errors.WithMessage(err, "Release hold failed")
+	}
+
+	return tx.Commit()
+}
+
+// FindHolds gets the holds placed on an account, most recent first.
+func (repo *Repository) FindHolds(ctx context.Context, claims auth.Claims, accountID string) (Holds, error) {
+	span, ctx := tracer.StartSpanFromContext(ctx, "internal.account.FindHolds")
+	defer span.Finish()
+
+	if claims.Audience == "" {
+		return nil, errors.WithStack(ErrForbidden)
+	}
+
+	recs, err := models.AccountHolds(
+		models.AccountHoldWhere.AccountID.EQ(accountID),
+		Load(models.AccountHoldRels.CreatedBy),
+		Load(models.AccountHoldRels.ReleasedBy),
+		OrderBy(models.AccountHoldColumns.CreatedAt+" desc"),
+	).All(ctx, repo.DbConn)
+	if err != nil {
+		return nil, weberror.NewError(ctx, err, http.StatusInternalServerError)
+	}
+
+	var res Holds
+	for _, rec := range recs {
+		res = append(res, HoldFromModel(rec))
+	}
+
+	return res, nil
+}
@@ -0,0 +1,88 @@
+package account
+
+import (
+	"testing"
+	"time"
+
+	"merryworld/surebank/internal/platform/money"
+	"merryworld/surebank/internal/platform/tests"
+)
+
+// TestHoldsHeld validates the amount held is the sum of the holds that are active.
+func TestHoldsHeld(t *testing.T) {
+	now := time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+	yesterday := now.AddDate(0, 0, -1)
+	tomorrow := now.AddDate(0, 0, 1)
+
+	holds := Holds{
+		{Amount: money.Naira(1000)},
+		{Amount: money.Naira(2000), ExpiresAt: &tomorrow},
+		{Amount: money.Naira(4000), ExpiresAt: &yesterday},
+		{Amount: money.Naira(8000), ReleasedAt: &yesterday},
+		{Amount: money.Naira(16000), ExpiresAt: &now},
+	}
+
+	t.Log("Given the need to know how much of a balance is on hold.")
+	{
+		t.Log("\tTest: 0\tWhen some holds were released or have expired.")
+		{
+			if got, want := holds.Held(now), money.Naira(3000); got != want {
+				t.Logf("\t\tGot : %s", got)
+				t.Logf("\t\tWant: %s", want)
+				t.Fatalf("\t%s\tShould only count the active holds.", tests.Failed)
+			}
+			t.Logf("\t%s\tShould only count the active holds.", tests.Success)
+		}
+
+		t.Log("\tTest: 1\tWhen there are no holds.")
+		{
+			if got := (Holds{}).Held(now); got != 0 {
+				t.Logf("\t\tGot : %s", got)
+				t.Fatalf("\t%s\tShould hold nothing.", tests.Failed)
+			}
+			t.Logf("\t%s\tShould hold nothing.", tests.Success)
+		}
+	}
+}
+
+// TestCheckAvailable validates withdrawals are limited to the balance that is not on hold.
+func TestCheckAvailable(t *testing.T) {
+	type availableTest struct {
+		Name      string
+		Balance   money.Amount
+		Held      money.Amount
+		Amount    money.Amount
+		Available money.Amount
+		Allowed   bool
+	}
+
+	var availableTests = []availableTest{
+		{"no hold", money.Naira(5000), 0, money.Naira(5000), money.Naira(5000), true},
+		{"within available", money.Naira(5000), money.Naira(2000), money.Naira(3000), money.Naira(3000), true},
+		{"into the hold", money.Naira(5000), money.Naira(2000), money.Naira(3001), money.Naira(3000), false},
+		{"hold above balance", money.Naira(1000), money.Naira(2000), money.Kobo(1), 0, false},
+	}
+
+	t.Log("Given the need to keep the amount on hold in the account.")
+	{
+		for i, tt := range availableTests {
+			t.Logf("\tTest: %d\tWhen taking out %s with %s of %s on hold.", i, tt.Amount, tt.Held, tt.Balance)
+			{
+				if got := AvailableBalance(tt.Balance, tt.Held); got != tt.Available {
+					t.Logf("\t\tGot : %s", got)
+					t.Logf("\t\tWant: %s", tt.Available)
+					t.Fatalf("\t%s\tShould get the expected available balance.", tests.Failed)
+				}
+				t.Logf("\t%s\tShould get the expected available balance.", tests.Success)
+
+				err := CheckAvailable(tt.Balance, tt.Held, tt.Amount)
+				if (err == nil) != tt.Allowed {
+					t.Logf("\t\tGot : %v", err)
+					t.Logf("\t\tWant allowed: %v", tt.Allowed)
+					t.Fatalf("\t%s\tShould allow the withdrawal only within the available balance.", tests.Failed)
+				}
+				t.Logf("\t%s\tShould allow the withdrawal only within the available balance.", tests.Success)
+			}
+		}
+	}
+}
@@ -5,10 +5,12 @@ import (
 	"sync"
 	"time"
 
+	"merryworld/surebank/internal/account_product"
 	"merryworld/surebank/internal/branch"
 	"merryworld/surebank/internal/customer"
 	"merryworld/surebank/internal/user"
 
+	"merryworld/surebank/internal/platform/money"
 	"merryworld/surebank/internal/platform/web"
 	"merryworld/surebank/internal/postgres/models"
 
@@ -28,25 +30,61 @@ func NewRepository(db *sqlx.DB) *Repository {
 	}
 }
 
+// Statuses of an account.
+const (
+	Status_Active = "active"
+	// Status_Dormant is an account without transactions for too long, it takes deposits but
+	// withdrawals need a supervisor to reactivate it first.
+	Status_Dormant = "dormant"
+	// Status_Frozen is an account that takes no deposits or withdrawals until it is unfrozen.
+	Status_Frozen = "frozen"
+	// Status_Closed is an account that was settled and closed, it cannot be used again.
+	Status_Closed = "closed"
+)
+
+// Statuses lists the statuses of an account in the order they are offered.
+var Statuses = []string{
+	Status_Active,
+	Status_Dormant,
+	Status_Frozen,
+	Status_Closed,
+}
+
 // Account represents a customer account.
 type Account struct {
-	ID              string     `json:"id" validate:"required,uuid" example:"985f1746-1d9f-459f-a2d9-fc53ece5ae86"`
-	CustomerID      string     `json:"customer_id" validate:"required,uuid" example:"985f1746-1d9f-459f-a2d9-fc53ece5ae86"`
-	Number          string     `json:"number"  validate:"required" example:"Rocket Launch"`
-	Type            string     `json:"type" truss:"api-read"`
-	Balance         float64    `json:"balance" truss:"api-read"`
-	Target          float64    `json:"target" truss:"api-read"`
-	TargetInfo      string     `json:"target_info" truss:"api-read"`
-	SalesRepID      string     `json:"sales_rep_id" truss:"api-read"`
-	BranchID        string     `json:"branch_id" truss:"api-read"`
-	LastPaymentDate time.Time  `json:"last_payment_date"`
-	CreatedAt       time.Time  `json:"created_at" truss:"api-read"`
-	UpdatedAt       time.Time  `json:"updated_at" truss:"api-read"`
-	ArchivedAt      *time.Time `json:"archived_at,omitempty" truss:"api-hide"`
-
-	Customer *customer.Customer `json:"customer"`
-	SalesRep *user.User         `json:"sales_rep" truss:"api-read"`
-	Branch   *branch.Branch     `json:"branch" truss:"api-read"`
+	ID              string       `json:"id" validate:"required,uuid" example:"985f1746-1d9f-459f-a2d9-fc53ece5ae86"`
+	CustomerID      string       `json:"customer_id" validate:"required,uuid" example:"985f1746-1d9f-459f-a2d9-fc53ece5ae86"`
+	Number          string       `json:"number"  validate:"required" example:"Rocket Launch"`
+	Type            string       `json:"type" truss:"api-read"`
+	ProductID       string       `json:"product_id" truss:"api-read"`
+	Balance         money.Amount `json:"balance" truss:"api-read"`
+	Status          string       `json:"status" truss:"api-read"`
+	Target          money.Amount `json:"target" truss:"api-read"`
+	TargetInfo      string       `json:"target_info" truss:"api-read"`
+	SalesRepID      string       `json:"sales_rep_id" truss:"api-read"`
+	BranchID        string       `json:"branch_id" truss:"api-read"`
+	LastPaymentDate time.Time    `json:"last_payment_date"`
+	CreatedAt       time.Time    `json:"created_at" truss:"api-read"`
+	UpdatedAt       time.Time    `json:"updated_at" truss:"api-read"`
+	ArchivedAt      *time.Time   `json:"archived_at,omitempty" truss:"api-hide"`
+
+	// The interest terms are copied from the product when the account is opened.
+	TenorDays       int          `json:"tenor_days" truss:"api-read"`
+	InterestRateBPS int          `json:"interest_rate_bps" truss:"api-read"`
+	MaturityDate    *time.Time   `json:"maturity_date,omitempty" truss:"api-read"`
+	RollOver        bool         `json:"roll_over" truss:"api-read"`
+	AccruedInterest money.Amount `json:"accrued_interest" truss:"api-read"`
+	MaturedAt       *time.Time   `json:"matured_at,omitempty" truss:"api-read"`
+
+	// GoalMilestone is the highest of the GoalMilestones of an SB account the customer was sent an
+	// SMS for. Goal is only set when loaded with LoadGoal.
+	GoalMilestone int   `json:"goal_milestone" truss:"api-read"`
+	Goal          *Goal `json:"goal,omitempty" truss:"api-read"`
+
+	Customer *customer.Customer       `json:"customer"`
+	SalesRep *user.User               `json:"sales_rep" truss:"api-read"`
+	Branch   *branch.Branch           `json:"branch" truss:"api-read"`
+	Product  *account_product.Product `json:"product" truss:"api-read"`
 }
 
 func FromModel(rec *models.Account) *Account {
@@ -55,14 +93,31 @@ func FromModel(rec *models.Account) *Account {
 		CustomerID:      rec.CustomerID,
 		Number:          rec.Number,
 		Type:            rec.AccountType,
-		Balance:         rec.Balance,
-		Target:          rec.Target,
+		ProductID:       rec.ProductID,
+		Balance:         money.Amount(rec.Balance),
+		Status:          rec.Status,
+		Target:          money.Amount(rec.Target),
 		TargetInfo:      rec.TargetInfo,
 		SalesRepID:      rec.SalesRepID,
 		BranchID:        rec.BranchID,
 		LastPaymentDate: time.Unix(rec.LastPaymentDate, 0),
 		CreatedAt:       time.Unix(rec.CreatedAt, 0),
 		UpdatedAt:       time.Unix(rec.UpdatedAt, 0),
+		TenorDays:       rec.TenorDays,
+		InterestRateBPS: rec.InterestRateBPS,
+		RollOver:        rec.RollOver,
+		AccruedInterest: money.Amount(rec.AccruedInterest),
+		GoalMilestone:   rec.GoalMilestone,
+	}
+
+	if rec.MaturityDate.Valid {
+		maturityDate := time.Unix(rec.MaturityDate.Int64, 0)
+		a.MaturityDate = &maturityDate
+	}
+
+	if rec.MaturedAt.Valid {
+		maturedAt := time.Unix(rec.MaturedAt.Int64, 0)
+		a.MaturedAt = &maturedAt
 	}
 
 	if rec.R != nil {
@@ -77,6 +132,10 @@ func FromModel(rec *models.Account) *Account {
 		if rec.R.SalesRep != nil {
 			a.SalesRep = user.FromModel(rec.R.SalesRep)
 		}
+
+		if rec.R.Product != nil {
+			a.Product = account_product.FromModel(rec.R.Product)
+		}
 	}
 
 	if rec.ArchivedAt.Valid {
@@ -94,8 +153,11 @@ type Response struct {
 	Customer        *customer.Response `json:"customer,omitempty" truss:"api-read"`
 	Number          string             `json:"number" example:"Rocket Launch" truss:"api-read"`
 	Type            string             `json:"type" truss:"api-read"`
-	Balance         float64            `json:"balance" truss:"api-read"`
-	Target          float64            `json:"target" truss:"api-read"`
+	ProductID       string             `json:"product_id" truss:"api-read"`
+	Product         string             `json:"product,omitempty" truss:"api-read"`
+	Balance         money.Amount       `json:"balance" truss:"api-read"`
+	Status          string             `json:"status" example:"active" truss:"api-read"`
+	Target          money.Amount       `json:"target" truss:"api-read"`
 	TargetInfo      string             `json:"target_info" truss:"api-read"`
 	SalesRepID      string             `json:"sales_rep_id" truss:"api-read"`
 	BranchID        string             `json:"branch_id" truss:"api-read"`
@@ -105,6 +167,14 @@ type Response struct {
 	CreatedAt       web.TimeResponse   `json:"created_at"`            // CreatedAt contains multiple format options for display.
 	UpdatedAt       web.TimeResponse   `json:"updated_at"`            // UpdatedAt contains multiple format options for display.
 	ArchivedAt      *web.TimeResponse  `json:"archived_at,omitempty"` // ArchivedAt contains multiple format options for display.
+	TenorDays       int                `json:"tenor_days" truss:"api-read"`
+	InterestRateBPS int                `json:"interest_rate_bps" truss:"api-read"`
+	InterestRate    string             `json:"interest_rate" example:"12.50%" truss:"api-read"`
+	MaturityDate    *web.TimeResponse  `json:"maturity_date,omitempty" truss:"api-read"`
+	RollOver        bool               `json:"roll_over" truss:"api-read"`
+	AccruedInterest money.Amount       `json:"accrued_interest" truss:"api-read"`
+	MaturedAt       *web.TimeResponse  `json:"matured_at,omitempty" truss:"api-read"`
+	Goal            *GoalResponse      `json:"goal,omitempty" truss:"api-read"`
 }
 
 type AccountList struct {
@@ -143,7 +213,9 @@ func (m *Account) Response(ctx context.Context) *Response {
 		Customer:        m.Customer.Response(ctx),
 		Number:          m.Number,
 		Type:            m.Type,
+		ProductID:       m.ProductID,
 		Balance:         m.Balance,
+		Status:          m.Status,
 		Target:          m.Target,
 		TargetInfo:      m.TargetInfo,
 		SalesRepID:      m.SalesRepID,
@@ -151,6 +223,12 @@ func (m *Account) Response(ctx context.Context) *Response {
 		LastPaymentDate: web.NewTimeResponse(ctx, m.LastPaymentDate),
 		CreatedAt:       web.NewTimeResponse(ctx, m.CreatedAt),
 		UpdatedAt:       web.NewTimeResponse(ctx, m.UpdatedAt),
+		TenorDays:       m.TenorDays,
+		InterestRateBPS: m.InterestRateBPS,
+		InterestRate:    account_product.FormatBPS(m.InterestRateBPS),
+		RollOver:        m.RollOver,
+		AccruedInterest: m.AccruedInterest,
+		Goal:            m.Goal.Response(ctx),
 	}
 
 	if m.ArchivedAt != nil && !m.ArchivedAt.IsZero() {
@@ -158,6 +236,16 @@ func (m *Account) Response(ctx context.Context) *Response {
 		r.ArchivedAt = &at
 	}
 
+	if m.MaturityDate != nil {
+		at := web.NewTimeResponse(ctx, *m.MaturityDate)
+		r.MaturityDate = &at
+	}
+
+	if m.MaturedAt != nil {
+		at := web.NewTimeResponse(ctx, *m.MaturedAt)
+		r.MaturedAt = &at
+	}
+
 	if m.SalesRep != nil {
 		r.SalesRep = m.SalesRep.FullName()
 	}
@@ -166,6 +254,10 @@ func (m *Account) Response(ctx context.Context) *Response {
 		r.Branch = m.Branch.Name
 	}
 
+	if m.Product != nil {
+		r.Product = m.Product.Name
+	}
+
 	return r
 }
 
@@ -192,11 +284,12 @@ type PagedResponseList struct {
 
 // CreateRequest contains information needed to create a new Account.
 type CreateRequest struct {
-	CustomerID string  `json:"customer_id" validate:"required,uuid" example:"985f1746-1d9f-459f-a2d9-fc53ece5ae86"`
-	Type       string  `json:"type" validate:"required"`
-	Target     float64 `json:"target"`
-	TargetInfo string  `json:"target_info"`
-	BranchID   string  `json:"branch_id"`
+	CustomerID string       `json:"customer_id" validate:"required,uuid" example:"985f1746-1d9f-459f-a2d9-fc53ece5ae86"`
+	Type       string       `json:"type" validate:"required" example:"SB"` // Type is the code of the account product.
+	Target     money.Amount `json:"target"`
+	TargetInfo string       `json:"target_info"`
+	BranchID   string       `json:"branch_id"`
+	RollOver   bool         `json:"roll_over"` // RollOver starts a new tenor when the account matures.
 }
 
 // ReadRequest defines the information needed to read a customer account.
@@ -210,10 +303,16 @@ type ReadRequest struct {
 // changed. It uses pointer fields so we can differentiate between a field that
 // was not provided and a field that was provided as explicitly blank.
 type UpdateRequest struct {
-	ID         string   `json:"id" validate:"required,uuid" example:"985f1746-1d9f-459f-a2d9-fc53ece5ae86"`
-	Type       *string  `json:"type" validate:"required"`
-	Target     *float64 `json:"target"`
-	TargetInfo *string  `json:"target_info"`
+	ID         string        `json:"id" validate:"required,uuid" example:"985f1746-1d9f-459f-a2d9-fc53ece5ae86"`
+	Type       *string       `json:"type" validate:"required"`
+	Target     *money.Amount `json:"target"`
+	TargetInfo *string       `json:"target_info"`
+	RollOver   *bool         `json:"roll_over"`
+
+	// The interest terms can only be changed by super admins. A new tenor moves the maturity date
+	// relative to the opening date of the account.
+	TenorDays       *int `json:"tenor_days" validate:"omitempty,gte=0"`
+	InterestRateBPS *int `json:"interest_rate_bps" validate:"omitempty,gte=0,lte=10000"`
 }
 
 // ArchiveRequest defines the information needed to archive a customer account. This will archive (soft-delete) the
@@ -239,4 +338,455 @@ type FindRequest struct {
 	IncludeCustomer bool          `json:"include_customer" example:"false"`
 	IncludeBranch   bool          `json:"include_branch" example:"false"`
 	IncludeSalesRep bool          `json:"include_sales_rep" example:"false"`
+	IncludeProduct  bool          `json:"include_product" example:"false"`
+	// Status only matches accounts with the status when set.
+	Status string `json:"status" validate:"omitempty,oneof=active dormant frozen closed" example:"dormant"`
+}
+
+// StatusRequest defines the information needed to freeze, unfreeze or reactivate an account.
+type StatusRequest struct {
+	ID     string `json:"id" validate:"required,uuid" example:"985f1746-1d9f-459f-a2d9-fc53ece5ae86"`
+	Status string `json:"status" validate:"required,oneof=active frozen" example:"frozen"`
+	Reason string `json:"reason" validate:"required,max=500" example:"Reported stolen passbook"`
+}
+
+// CloseRequest defines the information needed to settle and close an account.
+type CloseRequest struct {
+	ID     string `json:"id" validate:"required,uuid" example:"985f1746-1d9f-459f-a2d9-fc53ece5ae86"`
+	Reason string `json:"reason" validate:"required,max=500" example:"Customer relocated"`
+}
+
+// DormantRequest defines the day the dormancy job runs for and the number of days without
+// transactions that make an account dormant.
+type DormantRequest struct {
+	Date time.Time `json:"date" validate:"required"`
+	Days int       `json:"days" validate:"required,gt=0" example:"180"`
+}
+
+// DormantReport is what the dormancy job did.
+type DormantReport struct {
+	Date   time.Time `json:"date"`
+	Days   int       `json:"days"`
+	Marked int       `json:"marked"`
+	Failed int       `json:"failed"`
+}
+
+// StatusChange is a change of the status of an account.
+type StatusChange struct {
+	ID          string    `json:"id" example:"985f1746-1d9f-459f-a2d9-fc53ece5ae86"`
+	AccountID   string    `json:"account_id" example:"985f1746-1d9f-459f-a2d9-fc53ece5ae86"`
+	FromStatus  string    `json:"from_status" example:"active"`
+	ToStatus    string    `json:"to_status" example:"dormant"`
+	Reason      string    `json:"reason"`
+	ChangedByID *string   `json:"changed_by_id,omitempty"`
+	CreatedAt   time.Time `json:"created_at"`
+
+	ChangedBy *user.User `json:"changed_by,omitempty"`
+}
+
+// StatusChangeFromModel converts the status change model and its loaded user to a StatusChange.
+func StatusChangeFromModel(rec *models.AccountStatusChange) *StatusChange {
+	c := &StatusChange{
+		ID:          rec.ID,
+		AccountID:   rec.AccountID,
+		FromStatus:  rec.FromStatus,
+		ToStatus:    rec.ToStatus,
+		Reason:      rec.Reason,
+		ChangedByID: rec.ChangedByID.Ptr(),
+		CreatedAt:   time.Unix(rec.CreatedAt, 0),
+	}
+
+	if rec.R != nil && rec.R.ChangedBy != nil {
+		c.ChangedBy = user.FromModel(rec.R.ChangedBy)
+	}
+
+	return c
+}
+
+// StatusChangeResponse represents a change of the status of an account for display.
+type StatusChangeResponse struct {
+	ID         string           `json:"id" example:"985f1746-1d9f-459f-a2d9-fc53ece5ae86"`
+	AccountID  string           `json:"account_id" example:"985f1746-1d9f-459f-a2d9-fc53ece5ae86"`
+	FromStatus string           `json:"from_status" example:"active"`
+	ToStatus   string           `json:"to_status" example:"dormant"`
+	Reason     string           `json:"reason"`
+	ChangedBy  string           `json:"changed_by" example:"System"`
+	CreatedAt  web.TimeResponse `json:"created_at"`
+}
+
+// Response transforms StatusChange to the StatusChangeResponse that is used for display. Changes
+// made by the dormancy job are shown as made by the system.
+func (m *StatusChange) Response(ctx context.Context) *StatusChangeResponse {
+	if m == nil {
+		return nil
+	}
+
+	r := &StatusChangeResponse{
+		ID:         m.ID,
+		AccountID:  m.AccountID,
+		FromStatus: m.FromStatus,
+		ToStatus:   m.ToStatus,
+		Reason:     m.Reason,
+		ChangedBy:  "System",
+		CreatedAt:  web.NewTimeResponse(ctx, m.CreatedAt),
+	}
+
+	if m.ChangedBy != nil {
+		r.ChangedBy = m.ChangedBy.FullName()
+	}
+
+	return r
+}
+
+// StatusChanges a list of StatusChanges.
+type StatusChanges []*StatusChange
+
+// Response transforms a list of StatusChanges to a list of StatusChangeResponses.
+func (m *StatusChanges) Response(ctx context.Context) []*StatusChangeResponse {
+	var l = make([]*StatusChangeResponse, 0)
+	if m != nil && len(*m) > 0 {
+		for _, n := range *m {
+			l = append(l, n.Response(ctx))
+		}
+	}
+
+	return l
+}
+
+// Outcomes of a call made to a DS debtor.
+const (
+	FollowUpOutcome_Promised    = "promised"
+	FollowUpOutcome_NoAnswer    = "no_answer"
+	FollowUpOutcome_Unreachable = "unreachable"
+	FollowUpOutcome_Refused     = "refused"
+	FollowUpOutcome_Other       = "other"
+)
+
+// FollowUpOutcomes lists the outcomes of a call in the order they are offered.
+var FollowUpOutcomes = []string{
+	FollowUpOutcome_Promised,
+	FollowUpOutcome_NoAnswer,
+	FollowUpOutcome_Unreachable,
+	FollowUpOutcome_Refused,
+	FollowUpOutcome_Other,
+}
+
+// FollowUpOutcomeNames are the names the outcomes of a call are displayed with.
+var FollowUpOutcomeNames = map[string]string{
+	FollowUpOutcome_Promised:    "Promised to pay",
+	FollowUpOutcome_NoAnswer:    "No answer",
+	FollowUpOutcome_Unreachable: "Unreachable",
+	FollowUpOutcome_Refused:     "Refused to pay",
+	FollowUpOutcome_Other:       "Other",
+}
+
+// FollowUp is a call made to the customer of a DS account that is behind on its contributions.
+type FollowUp struct {
+	ID         string    `json:"id" example:"985f1746-1d9f-459f-a2d9-fc53ece5ae86"`
+	AccountID  string    `json:"account_id" example:"985f1746-1d9f-459f-a2d9-fc53ece5ae86"`
+	SalesRepID string    `json:"sales_rep_id" example:"985f1746-1d9f-459f-a2d9-fc53ece5ae86"`
+	CalledAt   time.Time `json:"called_at"`
+	Outcome    string    `json:"outcome" example:"promised"`
+	// PromisedDate is the day the customer promised to pay by, as picked in UTC.
+	PromisedDate *time.Time `json:"promised_date,omitempty"`
+	Note         string     `json:"note"`
+	CreatedAt    time.Time  `json:"created_at"`
+
+	SalesRep *user.User `json:"sales_rep,omitempty"`
+}
+
+// FollowUpFromModel converts the follow up model and its loaded sales rep to a FollowUp.
+func FollowUpFromModel(rec *models.AccountFollowUp) *FollowUp {
+	f := &FollowUp{
+		ID:         rec.ID,
+		AccountID:  rec.AccountID,
+		SalesRepID: rec.SalesRepID,
+		CalledAt:   time.Unix(rec.CalledAt, 0),
+		Outcome:    rec.Outcome,
+		Note:       rec.Note,
+		CreatedAt:  time.Unix(rec.CreatedAt, 0),
+	}
+
+	if rec.PromisedDate.Valid {
+		promisedDate := time.Unix(rec.PromisedDate.Int64, 0).UTC()
+		f.PromisedDate = &promisedDate
+	}
+
+	if rec.R != nil && rec.R.SalesRep != nil {
+		f.SalesRep = user.FromModel(rec.R.SalesRep)
+	}
+
+	return f
+}
+
+// FollowUpResponse represents a follow up call that is returned for display.
+type FollowUpResponse struct {
+	ID           string            `json:"id" example:"985f1746-1d9f-459f-a2d9-fc53ece5ae86"`
+	AccountID    string            `json:"account_id" example:"985f1746-1d9f-459f-a2d9-fc53ece5ae86"`
+	SalesRepID   string            `json:"sales_rep_id" example:"985f1746-1d9f-459f-a2d9-fc53ece5ae86"`
+	SalesRep     string            `json:"sales_rep,omitempty"`
+	CalledAt     web.TimeResponse  `json:"called_at"`
+	Outcome      string            `json:"outcome" example:"promised"`
+	PromisedDate *web.TimeResponse `json:"promised_date,omitempty"`
+	Note         string            `json:"note"`
+	CreatedAt    web.TimeResponse  `json:"created_at"`
+}
+
+// Response transforms FollowUp to the FollowUpResponse that is used for display.
+func (m *FollowUp) Response(ctx context.Context) *FollowUpResponse {
+	if m == nil {
+		return nil
+	}
+
+	r := &FollowUpResponse{
+		ID:         m.ID,
+		AccountID:  m.AccountID,
+		SalesRepID: m.SalesRepID,
+		CalledAt:   web.NewTimeResponse(ctx, m.CalledAt),
+		Outcome:    m.Outcome,
+		Note:       m.Note,
+		CreatedAt:  web.NewTimeResponse(ctx, m.CreatedAt),
+	}
+
+	if m.PromisedDate != nil {
+		pd := web.NewTimeResponse(ctx, *m.PromisedDate)
+		r.PromisedDate = &pd
+	}
+
+	if m.SalesRep != nil {
+		r.SalesRep = m.SalesRep.FullName()
+	}
+
+	return r
+}
+
+// FollowUps a list of FollowUps.
+type FollowUps []*FollowUp
+
+// Response transforms a list of FollowUps to a list of FollowUpResponses.
+func (m *FollowUps) Response(ctx context.Context) []*FollowUpResponse {
+	var l = make([]*FollowUpResponse, 0)
+	if m != nil && len(*m) > 0 {
+		for _, n := range *m {
+			l = append(l, n.Response(ctx))
+		}
+	}
+
+	return l
+}
+
+// FollowUpCreateRequest contains the information needed to record a call made to a DS debtor. A
+// promised date is required when the customer promised to pay.
+type FollowUpCreateRequest struct {
+	AccountID    string     `json:"account_id" validate:"required,uuid" example:"985f1746-1d9f-459f-a2d9-fc53ece5ae86"`
+	Outcome      string     `json:"outcome" validate:"required,oneof=promised no_answer unreachable refused other" example:"promised"`
+	PromisedDate *time.Time `json:"promised_date,omitempty"`
+	Note         string     `json:"note" validate:"max=500"`
+}
+
+// AgingBucket is a range of days a DS debtor has not paid for. MaxDays is 0 for the last bucket,
+// which has no upper bound.
+type AgingBucket struct {
+	Name    string `json:"name" example:"4-7"`
+	MinDays int    `json:"min_days"`
+	MaxDays int    `json:"max_days"`
+}
+
+// AgingBuckets are the ranges DS debtors are grouped in, in increasing order of days.
+var AgingBuckets = []AgingBucket{
+	{Name: "1-3", MinDays: 1, MaxDays: 3},
+	{Name: "4-7", MinDays: 4, MaxDays: 7},
+	{Name: "8-14", MinDays: 8, MaxDays: 14},
+	{Name: "15-30", MinDays: 15, MaxDays: 30},
+	{Name: "30+", MinDays: 31},
+}
+
+// Follow up filters of the debtors report.
+const (
+	DebtorFollowUp_None     = "none"
+	DebtorFollowUp_Promised = "promised"
+	DebtorFollowUp_Broken   = "broken"
+)
+
+// DebtorsRequest defines the filters of the DS debtors report. Empty filters match every debtor.
+type DebtorsRequest struct {
+	BranchID   string `json:"branch_id" validate:"omitempty,uuid"`
+	SalesRepID string `json:"sales_rep_id" validate:"omitempty,uuid"`
+	// Bucket is the name of one of the AgingBuckets.
+	Bucket string `json:"bucket" validate:"omitempty,oneof=1-3 4-7 8-14 15-30 30+" example:"4-7"`
+	// FollowUp matches debtors never followed up, with a pending promise or a broken promise.
+	FollowUp string `json:"follow_up" validate:"omitempty,oneof=none promised broken" example:"broken"`
+}
+
+// Debtor is a DS account that has not been paid for up to the day before the report.
+type Debtor struct {
+	Account         *Response         `json:"account"`
+	DaysOutstanding int               `json:"days_outstanding"`
+	AmountOwed      money.Amount      `json:"amount_owed"`
+	Bucket          string            `json:"bucket" example:"4-7"`
+	LastFollowUp    *FollowUpResponse `json:"last_follow_up,omitempty"`
+	// PromiseBroken is set when the last follow up is a promise to pay by a day that has passed.
+	PromiseBroken bool `json:"promise_broken"`
+}
+
+// AgingTotal is the number of debtors and the amount they owe.
+type AgingTotal struct {
+	Accounts int          `json:"accounts"`
+	Owed     money.Amount `json:"owed"`
+}
+
+// Add counts a debtor in the total.
+func (t *AgingTotal) Add(owed money.Amount) {
+	t.Accounts++
+	t.Owed += owed
+}
+
+// AgingSummary is the aging of the debtors of a sales rep at a branch. Buckets holds the total of
+// each of the AgingBuckets.
+type AgingSummary struct {
+	BranchID   string       `json:"branch_id"`
+	Branch     string       `json:"branch"`
+	SalesRepID string       `json:"sales_rep_id"`
+	SalesRep   string       `json:"sales_rep"`
+	Buckets    []AgingTotal `json:"buckets"`
+	Total      AgingTotal   `json:"total"`
+}
+
+// DebtorsResponse is the DS debtors report, the debtors that match the filters and their aging by
+// branch and sales rep.
+type DebtorsResponse struct {
+	Debtors []*Debtor       `json:"debtors"`
+	Aging   []*AgingSummary `json:"aging"`
+	Total   AgingSummary    `json:"total"`
+}
+
+// Hold blocks part of the balance of an account from being taken out, while a dispute is
+// investigated or as collateral for a loan. It is active until it is released or expires.
+type Hold struct {
+	ID           string       `json:"id" example:"985f1746-1d9f-459f-a2d9-fc53ece5ae86"`
+	AccountID    string       `json:"account_id" example:"985f1746-1d9f-459f-a2d9-fc53ece5ae86"`
+	Amount       money.Amount `json:"amount"`
+	Reason       string       `json:"reason" example:"Disputed deposit"`
+	ExpiresAt    *time.Time   `json:"expires_at,omitempty"`
+	CreatedByID  string       `json:"created_by_id" example:"985f1746-1d9f-459f-a2d9-fc53ece5ae86"`
+	CreatedAt    time.Time    `json:"created_at"`
+	ReleasedAt   *time.Time   `json:"released_at,omitempty"`
+	ReleasedByID *string      `json:"released_by_id,omitempty"`
+
+	CreatedBy  *user.User `json:"created_by,omitempty"`
+	ReleasedBy *user.User `json:"released_by,omitempty"`
+}
+
+// HoldFromModel converts the hold model and its loaded users to a Hold.
+func HoldFromModel(rec *models.AccountHold) *Hold {
+	h := &Hold{
+		ID:           rec.ID,
+		AccountID:    rec.AccountID,
+		Amount:       money.Amount(rec.Amount),
+		Reason:       rec.Reason,
+		CreatedByID:  rec.CreatedByID,
+		CreatedAt:    time.Unix(rec.CreatedAt, 0),
+		ReleasedByID: rec.ReleasedByID.Ptr(),
+	}
+
+	if rec.ExpiresAt.Valid {
+		expiresAt := time.Unix(rec.ExpiresAt.Int64, 0)
+		h.ExpiresAt = &expiresAt
+	}
+
+	if rec.ReleasedAt.Valid {
+		releasedAt := time.Unix(rec.ReleasedAt.Int64, 0)
+		h.ReleasedAt = &releasedAt
+	}
+
+	if rec.R != nil {
+		if rec.R.CreatedBy != nil {
+			h.CreatedBy = user.FromModel(rec.R.CreatedBy)
+		}
+		if rec.R.ReleasedBy != nil {
+			h.ReleasedBy = user.FromModel(rec.R.ReleasedBy)
+		}
+	}
+
+	return h
+}
+
+// HoldResponse represents a hold on an account for display.
+type HoldResponse struct {
+	ID         string            `json:"id" example:"985f1746-1d9f-459f-a2d9-fc53ece5ae86"`
+	AccountID  string            `json:"account_id" example:"985f1746-1d9f-459f-a2d9-fc53ece5ae86"`
+	Amount     money.Amount      `json:"amount"`
+	Reason     string            `json:"reason" example:"Disputed deposit"`
+	Active     bool              `json:"active"`
+	ExpiresAt  *web.TimeResponse `json:"expires_at,omitempty"`
+	CreatedBy  string            `json:"created_by,omitempty"`
+	CreatedAt  web.TimeResponse  `json:"created_at"`
+	ReleasedAt *web.TimeResponse `json:"released_at,omitempty"`
+	ReleasedBy string            `json:"released_by,omitempty"`
+}
+
+// Response transforms Hold to the HoldResponse that is used for display. Whether the hold is
+// active is worked out for the current time.
+func (m *Hold) Response(ctx context.Context) *HoldResponse {
+	if m == nil {
+		return nil
+	}
+
+	r := &HoldResponse{
+		ID:        m.ID,
+		AccountID: m.AccountID,
+		Amount:    m.Amount,
+		Reason:    m.Reason,
+		Active:    m.Active(time.Now()),
+		CreatedAt: web.NewTimeResponse(ctx, m.CreatedAt),
+	}
+
+	if m.ExpiresAt != nil {
+		ea := web.NewTimeResponse(ctx, *m.ExpiresAt)
+		r.ExpiresAt = &ea
+	}
+
+	if m.ReleasedAt != nil {
+		ra := web.NewTimeResponse(ctx, *m.ReleasedAt)
+		r.ReleasedAt = &ra
+	}
+
+	if m.CreatedBy != nil {
+		r.CreatedBy = m.CreatedBy.FullName()
+	}
+
+	if m.ReleasedBy != nil {
+		r.ReleasedBy = m.ReleasedBy.FullName()
+	}
+
+	return r
+}
+
+// Holds a list of Holds.
+type Holds []*Hold
+
+// Response transforms a list of Holds to a list of HoldResponses.
+func (m *Holds) Response(ctx context.Context) []*HoldResponse {
+	var l = make([]*HoldResponse, 0)
+	if m != nil && len(*m) > 0 {
+		for _, n := range *m {
+			l = append(l, n.Response(ctx))
+		}
+	}
+
+	return l
+}
+
+// HoldCreateRequest contains the information needed to place a hold on an account. A hold without
+// an expiry date stays until it is released.
+type HoldCreateRequest struct {
+	AccountID string       `json:"account_id" validate:"required,uuid" example:"985f1746-1d9f-459f-a2d9-fc53ece5ae86"`
+	Amount    money.Amount `json:"amount" validate:"required,gt=0" example:"5000"`
+	Reason    string       `json:"reason" validate:"required,max=500" example:"Disputed deposit"`
+	ExpiresAt *time.Time   `json:"expires_at,omitempty"`
+}
+
+// HoldReleaseRequest defines the hold to release.
+type HoldReleaseRequest struct {
+	ID string `json:"id" validate:"required,uuid" example:"985f1746-1d9f-459f-a2d9-fc53ece5ae86"`
 }
@@ -0,0 +1,257 @@
+package account
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/jinzhu/now"
+	"github.com/pborman/uuid"
+	"github.com/pkg/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	. "github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"gopkg.in/DataDog/dd-trace-go.v1/ddtrace/tracer"
+
+	"merryworld/surebank/internal/platform/auth"
+	"merryworld/surebank/internal/platform/web/webcontext"
+	"merryworld/surebank/internal/platform/web/weberror"
+	"merryworld/surebank/internal/postgres/models"
+)
+
+var (
+	// ErrStatusChange occurs when an account cannot be moved from its status to the one requested.
+	ErrStatusChange = errors.New("The account cannot be moved to the requested status")
+
+	// ErrAccountDormant occurs when money is taken out of a dormant account before it is reactivated.
+	ErrAccountDormant = errors.New("The account is dormant and must be reactivated by a supervisor before withdrawals")
+
+	// ErrAccountFrozen occurs when money is paid into or taken out of a frozen account.
+	ErrAccountFrozen = errors.New("The account is frozen")
+
+	// ErrAccountClosed occurs when money is paid into or taken out of a closed account.
+	ErrAccountClosed = errors.New("The account is closed")
+)
+
+// DormantDays is the number of days without transactions after which accounts are marked dormant
+// when the job is not told otherwise.
+const DormantDays = 180
+
+// CheckStatus returns the error that stops money being moved on an account with the status. Dormant
+// accounts take deposits but no withdrawals, frozen and closed accounts take neither.
+func CheckStatus(status string, withdrawal bool) error {
+	switch status {
+	case Status_Dormant:
+		if withdrawal {
+			return ErrAccountDormant
+		}
+	case Status_Frozen:
+		return ErrAccountFrozen
+	case Status_Closed:
+		return ErrAccountClosed
+	}
+	return nil
+}
+
+// CanChangeStatus reports whether an account can be moved between the statuses. Closed accounts
+// stay closed and dormant accounts are only marked by the dormancy job.
+func CanChangeStatus(from, to string) bool {
+	if from == to || from == Status_Closed {
+		return false
+	}
+	switch to {
+	case Status_Active, Status_Frozen, Status_Closed:
+		return true
+	case Status_Dormant:
+		return from == Status_Active
+	}
+	return false
+}
+
+// RecordStatusChange moves the account to the status and logs the change with who made it and
+// why. An empty changedByID records a change made by a scheduled job.
+func RecordStatusChange(ctx context.Context, exec boil.ContextExecutor, acc *models.Account, to, reason, changedByID string, now time.Time) error {
+	if !CanChangeStatus(acc.Status, to) {
+		return errors.WithMessagef(ErrStatusChange, "%s to %s", acc.Status, to)
+	}
+
+	m := models.AccountStatusChange{
+		ID:         uuid.NewRandom().String(),
+		AccountID:  acc.ID,
+		FromStatus: acc.Status,
+		ToStatus:   to,
+		Reason:     reason,
+		CreatedAt:  now.Unix(),
+	}
+	if changedByID != "" {
+		m.ChangedByID = null.StringFrom(changedByID)
+	}
+	if err := m.Insert(ctx, exec, boil.Infer()); err != nil {
+		return errors.WithMessage(err, "Insert status change failed")
+	}
+
+	if _, err := models.Accounts(models.AccountWhere.ID.EQ(acc.ID)).UpdateAll(ctx, exec, models.M{
+		models.AccountColumns.Status:    to,
+		models.AccountColumns.UpdatedAt: now.Unix(),
+	}); err != nil {
+		return errors.WithMessage(err, "Update account status failed")
+	}
+	acc.Status = to
+
+	return nil
+}
+
+// ChangeStatus freezes, unfreezes or reactivates an account. Only supervisors can change the status
+// of an account, closing one is done with transaction.Repository.CloseAccount so the balance is
+// settled.
+func (repo *Repository) ChangeStatus(ctx context.Context, claims auth.Claims, req StatusRequest, now time.Time) error {
+	span, ctx := tracer.StartSpanFromContext(ctx, "internal.account.ChangeStatus")
+	defer span.Finish()
+
+	if claims.Audience == "" || !claims.HasRole(auth.RoleAdmin, auth.RoleSuperAdmin) {
+		return errors.WithStack(ErrForbidden)
+	}
+
+	// Validate the request.
+	v := webcontext.Validator()
+	if err := v.Struct(req); err != nil {
+		return err
+	}
+
+	// If now empty set it to the current time.
+	if now.IsZero() {
+		now = time.Now()
+	}
+
+	// Always store the time as UTC.
+	now = now.UTC()
+	// Postgres truncates times to milliseconds when storing. We and do the same
+	// here so the value we return is consistent with what we store.
+	now = now.Truncate(time.Millisecond)
+
+	tx, err := repo.DbConn.Begin()
+	if err != nil {
+		return err
+	}
+
+	acc, err := models.Accounts(models.AccountWhere.ID.EQ(req.ID), For("UPDATE")).One(ctx, tx)
+	if err != nil {
+		_ = tx.Rollback()
+		if errors.Cause(err) == sql.ErrNoRows {
+			return weberror.NewErrorMessage(ctx, err, http.StatusNotFound, "Invalid account id")
+		}
+		return err
+	}
+
+	if err = RecordStatusChange(ctx, tx, acc, req.Status, req.Reason, claims.Subject, now); err != nil {
+		_ = tx.Rollback()
+		return weberror.NewError(ctx, err, http.StatusBadRequest)
+	}
+
+	return tx.Commit()
+}
+
+const dormantAccountsStatement = `SELECT a.id FROM account a
+	WHERE a.status = 'active' AND a.archived_at IS NULL
+	AND GREATEST(a.last_payment_date, a.created_at) < $1
+	AND NOT EXISTS (SELECT 1 FROM transaction tx WHERE tx.account_id = a.id AND tx.created_at >= $1)`
+
+// MarkDormant is the daily dormancy job. Active accounts without transactions for the number of
+// days before the date are marked dormant, each in its own db transaction.
+func (repo *Repository) MarkDormant(ctx context.Context, req DormantRequest, currentDate time.Time) (*DormantReport, error) {
+	span, ctx := tracer.StartSpanFromContext(ctx, "internal.account.MarkDormant")
+	defer span.Finish()
+
+	// Validate the request.
+	v := webcontext.Validator()
+	if err := v.Struct(req); err != nil {
+		return nil, err
+	}
+
+	// If now empty set it to the current time.
+	if currentDate.IsZero() {
+		currentDate = time.Now()
+	}
+
+	// Always store the time as UTC.
+	currentDate = currentDate.UTC()
+	// Postgres truncates times to milliseconds when storing. We and do the same
+	// here so the value we return is consistent with what we store.
+	currentDate = currentDate.Truncate(time.Millisecond)
+
+	report := &DormantReport{Date: now.New(req.Date).BeginningOfDay(), Days: req.Days}
+	cutoff := report.Date.AddDate(0, 0, -req.Days)
+
+	rows, err := repo.DbConn.QueryContext(ctx, dormantAccountsStatement, cutoff.Unix())
+	if err != nil {
+		return nil, errors.WithMessage(err, "Cannot list dormant accounts")
+	}
+	var ids []string
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			rows.Close()
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+	rows.Close()
+
+	reason := fmt.Sprintf("No transactions for %d days", req.Days)
+	for _, id := range ids {
+		tx, err := repo.DbConn.Begin()
+		if err != nil {
+			return report, err
+		}
+
+		acc, err := models.Accounts(models.AccountWhere.ID.EQ(id), For("UPDATE")).One(ctx, tx)
+		if err == nil && acc.Status != Status_Active {
+			// Changed since it was listed.
+			_ = tx.Rollback()
+			continue
+		}
+		if err == nil {
+			err = RecordStatusChange(ctx, tx, acc, Status_Dormant, reason, "", currentDate)
+		}
+		if err != nil {
+			_ = tx.Rollback()
+			report.Failed++
+			continue
+		}
+		if err = tx.Commit(); err != nil {
+			report.Failed++
+			continue
+		}
+		report.Marked++
+	}
+
+	return report, nil
+}
+
+// FindStatusChanges gets the status changes of an account, most recent first.
+func (repo *Repository) FindStatusChanges(ctx context.Context, claims auth.Claims, accountID string) (StatusChanges, error) {
+	span, ctx := tracer.StartSpanFromContext(ctx, "internal.account.FindStatusChanges")
+	defer span.Finish()
+
+	if claims.Audience == "" {
+		return nil, errors.WithStack(ErrForbidden)
+	}
+
+	recs, err := models.AccountStatusChanges(
+		models.AccountStatusChangeWhere.AccountID.EQ(accountID),
+		Load(models.AccountStatusChangeRels.ChangedBy),
+		OrderBy(models.AccountStatusChangeColumns.CreatedAt+" desc"),
+	).All(ctx, repo.DbConn)
+	if err != nil {
+		return nil, weberror.NewError(ctx, err, http.StatusInternalServerError)
+	}
+
+	var res StatusChanges
+	for _, rec := range recs {
+		res = append(res, StatusChangeFromModel(rec))
+	}
+
+	return res, nil
+}
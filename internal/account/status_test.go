@@ -0,0 +1,80 @@
+package account
+
+import (
+	"testing"
+
+	"merryworld/surebank/internal/platform/tests"
+)
+
+// TestCheckStatus validates which statuses stop deposits and withdrawals.
+func TestCheckStatus(t *testing.T) {
+	type statusTest struct {
+		Status     string
+		Withdrawal bool
+		Expected   error
+	}
+
+	var statusTests = []statusTest{
+		{Status_Active, false, nil},
+		{Status_Active, true, nil},
+		{Status_Dormant, false, nil},
+		{Status_Dormant, true, ErrAccountDormant},
+		{Status_Frozen, false, ErrAccountFrozen},
+		{Status_Frozen, true, ErrAccountFrozen},
+		{Status_Closed, false, ErrAccountClosed},
+		{Status_Closed, true, ErrAccountClosed},
+	}
+
+	t.Log("Given the need to stop money moving on accounts that are not active.")
+	{
+		for i, tt := range statusTests {
+			t.Logf("\tTest: %d\tWhen the account is %s and withdrawal is %v.", i, tt.Status, tt.Withdrawal)
+			{
+				if err := CheckStatus(tt.Status, tt.Withdrawal); err != tt.Expected {
+					t.Logf("\t\tGot : %v", err)
+					t.Logf("\t\tWant: %v", tt.Expected)
+					t.Fatalf("\t%s\tShould get the expected error.", tests.Failed)
+				}
+				t.Logf("\t%s\tShould get the expected error.", tests.Success)
+			}
+		}
+	}
+}
+
+// TestCanChangeStatus validates the moves allowed between the statuses of an account.
+func TestCanChangeStatus(t *testing.T) {
+	type changeTest struct {
+		From     string
+		To       string
+		Expected bool
+	}
+
+	var changeTests = []changeTest{
+		{Status_Active, Status_Dormant, true},
+		{Status_Active, Status_Frozen, true},
+		{Status_Active, Status_Closed, true},
+		{Status_Active, Status_Active, false},
+		{Status_Dormant, Status_Active, true},
+		{Status_Dormant, Status_Frozen, true},
+		{Status_Frozen, Status_Dormant, false},
+		{Status_Frozen, Status_Active, true},
+		{Status_Frozen, Status_Closed, true},
+		{Status_Closed, Status_Active, false},
+		{Status_Active, "suspended", false},
+	}
+
+	t.Log("Given the need to move accounts between statuses.")
+	{
+		for i, tt := range changeTests {
+			t.Logf("\tTest: %d\tWhen moving from %s to %s.", i, tt.From, tt.To)
+			{
+				if got := CanChangeStatus(tt.From, tt.To); got != tt.Expected {
+					t.Logf("\t\tGot : %v", got)
+					t.Logf("\t\tWant: %v", tt.Expected)
+					t.Fatalf("\t%s\tShould get the expected answer.", tests.Failed)
+				}
+				t.Logf("\t%s\tShould get the expected answer.", tests.Success)
+			}
+		}
+	}
+}
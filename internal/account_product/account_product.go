@@ -0,0 +1,311 @@
+package account_product
+
+import (
+	"context"
+	"database/sql"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/pborman/uuid"
+	"github.com/pkg/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	. "github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"gopkg.in/DataDog/dd-trace-go.v1/ddtrace/tracer"
+
+	"merryworld/surebank/internal/platform/auth"
+	"merryworld/surebank/internal/platform/web/webcontext"
+	"merryworld/surebank/internal/platform/web/weberror"
+	"merryworld/surebank/internal/postgres/models"
+)
+
+var (
+	// ErrNotFound abstracts the postgres not found error.
+	ErrNotFound = errors.New("Entity not found")
+
+	// ErrForbidden occurs when a user tries to do something that is forbidden to them according to our access control policies.
+	ErrForbidden = errors.New("Attempted action is not allowed")
+)
+
+// Find gets all the products from the database based on the request params.
+func (repo *Repository) Find(ctx context.Context, _ auth.Claims, req FindRequest) (Products, error) {
+	span, ctx := tracer.StartSpanFromContext(ctx, "internal.account_product.Find")
+	defer span.Finish()
+
+	var queries []QueryMod
+
+	if req.Where != "" {
+		queries = append(queries, Where(req.Where, req.Args...))
+	}
+
+	if !req.IncludeArchived {
+		queries = append(queries, And("archived_at is null"))
+	}
+
+	if len(req.Order) > 0 {
+		for _, s := range req.Order {
+			queries = append(queries, OrderBy(s))
+		}
+	} else {
+		queries = append(queries, OrderBy(models.AccountProductColumns.Code))
+	}
+
+	if req.Limit != nil {
+		queries = append(queries, Limit(int(*req.Limit)))
+	}
+
+	if req.Offset != nil {
+		queries = append(queries, Offset(int(*req.Offset)))
+	}
+
+	productSlice, err := models.AccountProducts(queries...).All(ctx, repo.DbConn)
+	if err != nil {
+		return nil, err
+	}
+
+	var result Products
+	for _, rec := range productSlice {
+		result = append(result, FromModel(rec))
+	}
+
+	return result, nil
+}
+
+// ReadByID gets the specified product by ID from the database.
+func (repo *Repository) ReadByID(ctx context.Context, _ auth.Claims, id string) (*Product, error) {
+	span, ctx := tracer.StartSpanFromContext(ctx, "internal.account_product.ReadByID")
+	defer span.Finish()
+
+	rec, err := models.FindAccountProduct(ctx, repo.DbConn, id)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, weberror.NewError(ctx, ErrNotFound, http.StatusNotFound)
+		}
+		return nil, err
+	}
+
+	return FromModel(rec), nil
+}
+
+// ReadByCode gets the product with the code that accounts can be opened on.
+func (repo *Repository) ReadByCode(ctx context.Context, _ auth.Claims, code string) (*Product, error) {
+	span, ctx := tracer.StartSpanFromContext(ctx, "internal.account_product.ReadByCode")
+	defer span.Finish()
+
+	rec, err := models.AccountProducts(
+		models.AccountProductWhere.Code.EQ(code),
+		models.AccountProductWhere.ArchivedAt.IsNull(),
+	).One(ctx, repo.DbConn)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, weberror.NewErrorMessage(ctx, ErrNotFound, http.StatusBadRequest, "Unknown account type "+code)
+		}
+		return nil, err
+	}
+
+	return FromModel(rec), nil
+}
+
+// Create inserts a new product into the database.
+func (repo *Repository) Create(ctx context.Context, claims auth.Claims, req CreateRequest, now time.Time) (*Product, error) {
+	span, ctx := tracer.StartSpanFromContext(ctx, "internal.account_product.Create")
+	defer span.Finish()
+	if claims.Audience == "" {
+		return nil, errors.WithStack(ErrForbidden)
+	}
+
+	// Only super admins can change the products the business offers.
+	if !claims.HasRole(auth.RoleSuperAdmin) {
+		return nil, errors.WithStack(ErrForbidden)
+	}
+
+	exists, err := models.AccountProducts(models.AccountProductWhere.Code.EQ(req.Code)).Exists(ctx, repo.DbConn)
+	if err != nil {
+		return nil, err
+	}
+	ctx = webcontext.ContextAddUniqueValue(ctx, req, "Code", !exists)
+
+	// Validate the request.
+	v := webcontext.Validator()
+	err = v.StructCtx(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	// If now empty set it to the current time.
+	if now.IsZero() {
+		now = time.Now()
+	}
+
+	// Always store the time as UTC.
+	now = now.UTC()
+	// Postgres truncates times to milliseconds when storing. We and do the same
+	// here so the value we return is consistent with what we store.
+	now = now.Truncate(time.Millisecond)
+
+	m := models.AccountProduct{
+		ID:                        uuid.NewRandom().String(),
+		Code:                      req.Code,
+		Name:                      req.Name,
+		Description:               req.Description,
+		TargetRequired:            req.TargetRequired,
+		DailyContribution:         req.DailyContribution,
+		MaxDaysPerPayment:         req.MaxDaysPerPayment,
+		MinDeposit:                req.MinDeposit.Kobo(),
+		CycleDays:                 req.CycleDays,
+		FirstContributionFee:      req.FirstContributionFee,
+		CommissionPolicy:          strings.TrimSpace(req.CommissionPolicy),
+		DepositSMS:                req.DepositSMS,
+		WithdrawalsAllowed:        req.WithdrawalsAllowed,
+		MinBalance:                req.MinBalance.Kobo(),
+		ApprovalThreshold:         req.ApprovalThreshold.Kobo(),
+		InterestRateBPS:           req.InterestRateBPS,
+		TenorDays:                 req.TenorDays,
+		EarlyWithdrawalPenaltyBPS: req.EarlyWithdrawalPenaltyBPS,
+		CreatedAt:                 now.Unix(),
+		UpdatedAt:                 now.Unix(),
+	}
+
+	if err := m.Insert(ctx, repo.DbConn, boil.Infer()); err != nil {
+		return nil, errors.WithMessage(err, "Insert account product failed")
+	}
+
+	return FromModel(&m), nil
+}
+
+// Update replaces a product in the database. The new rules apply to the next transactions of
+// every account on the product.
+func (repo *Repository) Update(ctx context.Context, claims auth.Claims, req UpdateRequest, now time.Time) error {
+	span, ctx := tracer.StartSpanFromContext(ctx, "internal.account_product.Update")
+	defer span.Finish()
+
+	if claims.Audience == "" {
+		return errors.WithStack(ErrForbidden)
+	}
+	// Only super admins can change the products the business offers.
+	if !claims.HasRole(auth.RoleSuperAdmin) {
+		return errors.WithStack(ErrForbidden)
+	}
+
+	// Validate the request.
+	v := webcontext.Validator()
+	err := v.Struct(req)
+	if err != nil {
+		return err
+	}
+
+	cols := models.M{}
+	if req.Name != nil {
+		cols[models.AccountProductColumns.Name] = *req.Name
+	}
+	if req.Description != nil {
+		cols[models.AccountProductColumns.Description] = *req.Description
+	}
+	if req.TargetRequired != nil {
+		cols[models.AccountProductColumns.TargetRequired] = *req.TargetRequired
+	}
+	if req.DailyContribution != nil {
+		cols[models.AccountProductColumns.DailyContribution] = *req.DailyContribution
+	}
+	if req.MaxDaysPerPayment != nil {
+		cols[models.AccountProductColumns.MaxDaysPerPayment] = *req.MaxDaysPerPayment
+	}
+	if req.MinDeposit != nil {
+		cols[models.AccountProductColumns.MinDeposit] = req.MinDeposit.Kobo()
+	}
+	if req.CycleDays != nil {
+		cols[models.AccountProductColumns.CycleDays] = *req.CycleDays
+	}
+	if req.FirstContributionFee != nil {
+		cols[models.AccountProductColumns.FirstContributionFee] = *req.FirstContributionFee
+	}
+	if req.CommissionPolicy != nil {
+		cols[models.AccountProductColumns.CommissionPolicy] = strings.TrimSpace(*req.CommissionPolicy)
+	}
+	if req.DepositSMS != nil {
+		cols[models.AccountProductColumns.DepositSMS] = *req.DepositSMS
+	}
+	if req.WithdrawalsAllowed != nil {
+		cols[models.AccountProductColumns.WithdrawalsAllowed] = *req.WithdrawalsAllowed
+	}
+	if req.MinBalance != nil {
+		cols[models.AccountProductColumns.MinBalance] = req.MinBalance.Kobo()
+	}
+	if req.ApprovalThreshold != nil {
+		cols[models.AccountProductColumns.ApprovalThreshold] = req.ApprovalThreshold.Kobo()
+	}
+	if req.InterestRateBPS != nil {
+		cols[models.AccountProductColumns.InterestRateBPS] = *req.InterestRateBPS
+	}
+	if req.TenorDays != nil {
+		cols[models.AccountProductColumns.TenorDays] = *req.TenorDays
+	}
+	if req.EarlyWithdrawalPenaltyBPS != nil {
+		cols[models.AccountProductColumns.EarlyWithdrawalPenaltyBPS] = *req.EarlyWithdrawalPenaltyBPS
+	}
+
+	if len(cols) == 0 {
+		return nil
+	}
+
+	// If now empty set it to the current time.
+	if now.IsZero() {
+		now = time.Now()
+	}
+
+	// Always store the time as UTC.
+	now = now.UTC()
+	// Postgres truncates times to milliseconds when storing. We and do the same
+	// here so the value we return is consistent with what we store.
+	now = now.Truncate(time.Millisecond)
+
+	cols[models.AccountProductColumns.UpdatedAt] = now.Unix()
+
+	_, err = models.AccountProducts(models.AccountProductWhere.ID.EQ(req.ID)).UpdateAll(ctx, repo.DbConn, cols)
+	if err != nil {
+		return errors.WithMessage(err, "Update account product failed")
+	}
+
+	return nil
+}
+
+// Archive soft deletes the product from the database so no new account can be opened on it.
+func (repo *Repository) Archive(ctx context.Context, claims auth.Claims, req ArchiveRequest, now time.Time) error {
+	span, ctx := tracer.StartSpanFromContext(ctx, "internal.account_product.Archive")
+	defer span.Finish()
+
+	if claims.Audience == "" {
+		return errors.WithStack(ErrForbidden)
+	}
+	// Only super admins can change the products the business offers.
+	if !claims.HasRole(auth.RoleSuperAdmin) {
+		return errors.WithStack(ErrForbidden)
+	}
+
+	// Validate the request.
+	v := webcontext.Validator()
+	err := v.Struct(req)
+	if err != nil {
+		return err
+	}
+
+	// If now empty set it to the current time.
+	if now.IsZero() {
+		now = time.Now()
+	}
+
+	// Always store the time as UTC.
+	now = now.UTC()
+	// Postgres truncates times to milliseconds when storing. We and do the same
+	// here so the value we return is consistent with what we store.
+	now = now.Truncate(time.Millisecond)
+
+	_, err = models.AccountProducts(models.AccountProductWhere.ID.EQ(req.ID)).UpdateAll(ctx, repo.DbConn, models.M{
+		models.AccountProductColumns.ArchivedAt: now.Unix(),
+	})
+	if err != nil {
+		return errors.WithMessage(err, "Archive account product failed")
+	}
+
+	return nil
+}
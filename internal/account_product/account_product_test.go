@@ -0,0 +1,124 @@
+package account_product
+
+import (
+	"testing"
+
+	"merryworld/surebank/internal/platform/money"
+	"merryworld/surebank/internal/platform/tests"
+)
+
+// TestCheckDeposit validates the deposit rules of a product.
+func TestCheckDeposit(t *testing.T) {
+
+	sb := &Product{Name: "Savings", MinDeposit: money.Naira(100)}
+	ds := &Product{Name: "Daily Savings", DailyContribution: true, MaxDaysPerPayment: 50}
+
+	var depositTests = []struct {
+		name    string
+		product *Product
+		amount  money.Amount
+		target  money.Amount
+		days    int64
+		wantErr bool
+	}{
+		{"savings deposit", sb, money.Naira(150), 0, 1, false},
+		{"savings deposit below minimum", sb, money.Naira(50), 0, 0, true},
+		{"one day", ds, money.Naira(200), money.Naira(200), 1, false},
+		{"many days", ds, money.Naira(1000), money.Naira(200), 5, false},
+		{"not a multiple of the target", ds, money.Naira(300), money.Naira(200), 0, true},
+		{"more than the max days", ds, money.Naira(200).Mul(51), money.Naira(200), 0, true},
+		{"no target", ds, money.Naira(200), 0, 0, true},
+	}
+
+	t.Log("Given the need to validate deposits against the rules of a product.")
+	{
+		for i, tt := range depositTests {
+			t.Logf("\tTest: %d\tWhen checking a %s.", i, tt.name)
+			{
+				days, err := tt.product.CheckDeposit(tt.amount, tt.target)
+				if (err != nil) != tt.wantErr {
+					t.Fatalf("\t%s\tExpected error %v, got %v.", tests.Failed, tt.wantErr, err)
+				}
+				if days != tt.days {
+					t.Fatalf("\t%s\tExpected %d days, got %d.", tests.Failed, tt.days, days)
+				}
+				t.Logf("\t%s\tCheckDeposit ok.", tests.Success)
+			}
+		}
+	}
+}
+
+// TestCheckWithdrawal validates the withdrawal rules of a product.
+func TestCheckWithdrawal(t *testing.T) {
+
+	var withdrawalTests = []struct {
+		name    string
+		product *Product
+		balance money.Amount
+		amount  money.Amount
+		wantErr bool
+	}{
+		{"withdrawal", &Product{WithdrawalsAllowed: true}, money.Naira(500), money.Naira(500), false},
+		{"withdrawal above the balance", &Product{WithdrawalsAllowed: true}, money.Naira(500), money.Naira(501), true},
+		{"withdrawal into the minimum balance", &Product{WithdrawalsAllowed: true, MinBalance: money.Naira(100)},
+			money.Naira(500), money.Naira(450), true},
+		{"withdrawal down to the minimum balance", &Product{WithdrawalsAllowed: true, MinBalance: money.Naira(100)},
+			money.Naira(500), money.Naira(400), false},
+		{"withdrawal not allowed", &Product{}, money.Naira(500), money.Naira(100), true},
+	}
+
+	t.Log("Given the need to validate withdrawals against the rules of a product.")
+	{
+		for i, tt := range withdrawalTests {
+			t.Logf("\tTest: %d\tWhen checking a %s.", i, tt.name)
+			{
+				err := tt.product.CheckWithdrawal(tt.balance, tt.amount)
+				if (err != nil) != tt.wantErr {
+					t.Fatalf("\t%s\tExpected error %v, got %v.", tests.Failed, tt.wantErr, err)
+				}
+				t.Logf("\t%s\tCheckWithdrawal ok.", tests.Success)
+			}
+		}
+	}
+}
+
+// TestInterest validates the daily interest and the early withdrawal penalty.
+func TestInterest(t *testing.T) {
+
+	t.Log("Given the need to compute the interest and penalties of fixed savings.")
+	{
+		var interestTests = []struct {
+			name    string
+			balance money.Amount
+			rateBPS int
+			want    money.Amount
+		}{
+			{"365,000 at 10%", money.Naira(365000), 1000, money.Naira(100)},
+			{"100,000 at 12.5%", money.Naira(100000), 1250, money.Amount(3425)},
+			{"no rate", money.Naira(100000), 0, 0},
+			{"no balance", 0, 1000, 0},
+		}
+
+		for i, tt := range interestTests {
+			t.Logf("\tTest: %d\tWhen accruing a day of interest on %s.", i, tt.name)
+			{
+				if got := DailyInterest(tt.balance, tt.rateBPS); got != tt.want {
+					t.Fatalf("\t%s\tExpected %s, got %s.", tests.Failed, tt.want, got)
+				}
+				t.Logf("\t%s\tDailyInterest ok.", tests.Success)
+			}
+		}
+
+		t.Logf("\tTest: %d\tWhen withdrawing before maturity.", len(interestTests))
+		{
+			p := &Product{EarlyWithdrawalPenaltyBPS: 250}
+			if got, want := p.EarlyWithdrawalPenalty(money.Naira(10000)), money.Naira(250); got != want {
+				t.Fatalf("\t%s\tExpected %s, got %s.", tests.Failed, want, got)
+			}
+			if got := (&Product{}).EarlyWithdrawalPenalty(money.Naira(10000)); got != 0 {
+				t.Fatalf("\t%s\tExpected no penalty, got %s.", tests.Failed, got)
+			}
+			t.Logf("\t%s\tEarlyWithdrawalPenalty ok.", tests.Success)
+		}
+	}
+}
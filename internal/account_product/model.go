@@ -0,0 +1,329 @@
+package account_product
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+
+	"merryworld/surebank/internal/platform/money"
+	"merryworld/surebank/internal/platform/web"
+	"merryworld/surebank/internal/postgres/models"
+)
+
+// Repository defines the required dependencies for AccountProduct.
+type Repository struct {
+	DbConn *sqlx.DB
+}
+
+// NewRepository creates a new Repository that defines dependencies for AccountProduct.
+func NewRepository(db *sqlx.DB) *Repository {
+	return &Repository{
+		DbConn: db,
+	}
+}
+
+// Product represents a savings product that customer accounts are opened on. It holds the rules
+// the transaction engine applies to the accounts of the product.
+type Product struct {
+	ID          string `json:"id" validate:"required,uuid" example:"985f1746-1d9f-459f-a2d9-fc53ece5ae86"`
+	Code        string `json:"code" example:"DS"`
+	Name        string `json:"name" example:"Daily Savings"`
+	Description string `json:"description"`
+
+	// TargetRequired requires a target amount when an account is opened.
+	TargetRequired bool `json:"target_required"`
+	// DailyContribution makes each deposit a number of daily contributions of the account target.
+	// Deposits must be a multiple of the target and are dated from the day after the last one.
+	DailyContribution bool `json:"daily_contribution"`
+	// MaxDaysPerPayment limits how many daily contributions one deposit can pay for, 0 for no limit.
+	MaxDaysPerPayment int `json:"max_days_per_payment"`
+	// MinDeposit is the smallest amount accepted for a deposit.
+	MinDeposit money.Amount `json:"min_deposit"`
+
+	// CycleDays is the length of a contribution cycle, 0 for products without cycles.
+	CycleDays int `json:"cycle_days"`
+	// FirstContributionFee charges the DS commission on the contributions of the accounts, by
+	// keeping the first contribution of each cycle unless the branch sets another policy.
+	FirstContributionFee bool `json:"first_contribution_fee"`
+	// CommissionPolicy is how the DS commission is charged on the accounts of the product whatever
+	// their branch, empty to follow FirstContributionFee. See dscommission.ParsePolicy.
+	CommissionPolicy string `json:"commission_policy"`
+	// DepositSMS sends the customer an SMS for every deposit.
+	DepositSMS bool `json:"deposit_sms"`
+
+	// WithdrawalsAllowed allows money to be taken out of the accounts.
+	WithdrawalsAllowed bool `json:"withdrawals_allowed"`
+	// MinBalance is the balance that must be left after a withdrawal.
+	MinBalance money.Amount `json:"min_balance"`
+	// ApprovalThreshold is the amount from which withdrawals and transfers must be approved by a
+	// second user, 0 for no approval.
+	ApprovalThreshold money.Amount `json:"approval_threshold"`
+
+	// InterestRateBPS is the yearly interest rate in basis points.
+	InterestRateBPS int `json:"interest_rate_bps"`
+	// TenorDays is how long money is kept before it matures, 0 for no fixed period.
+	TenorDays int `json:"tenor_days"`
+	// EarlyWithdrawalPenaltyBPS is charged in basis points of a withdrawal made before maturity.
+	EarlyWithdrawalPenaltyBPS int `json:"early_withdrawal_penalty_bps"`
+
+	CreatedAt  time.Time  `json:"created_at" truss:"api-read"`
+	UpdatedAt  time.Time  `json:"updated_at" truss:"api-read"`
+	ArchivedAt *time.Time `json:"archived_at,omitempty" truss:"api-hide"`
+}
+
+func FromModel(rec *models.AccountProduct) *Product {
+	p := &Product{
+		ID:                        rec.ID,
+		Code:                      rec.Code,
+		Name:                      rec.Name,
+		Description:               rec.Description,
+		TargetRequired:            rec.TargetRequired,
+		DailyContribution:         rec.DailyContribution,
+		MaxDaysPerPayment:         rec.MaxDaysPerPayment,
+		MinDeposit:                money.Amount(rec.MinDeposit),
+		CycleDays:                 rec.CycleDays,
+		FirstContributionFee:      rec.FirstContributionFee,
+		CommissionPolicy:          rec.CommissionPolicy,
+		DepositSMS:                rec.DepositSMS,
+		WithdrawalsAllowed:        rec.WithdrawalsAllowed,
+		MinBalance:                money.Amount(rec.MinBalance),
+		ApprovalThreshold:         money.Amount(rec.ApprovalThreshold),
+		InterestRateBPS:           rec.InterestRateBPS,
+		TenorDays:                 rec.TenorDays,
+		EarlyWithdrawalPenaltyBPS: rec.EarlyWithdrawalPenaltyBPS,
+		CreatedAt:                 time.Unix(rec.CreatedAt, 0),
+		UpdatedAt:                 time.Unix(rec.UpdatedAt, 0),
+	}
+
+	if rec.ArchivedAt.Valid {
+		archivedAt := time.Unix(rec.ArchivedAt.Int64, 0)
+		p.ArchivedAt = &archivedAt
+	}
+
+	return p
+}
+
+// CheckDeposit validates a deposit of amount into an account of the product with the target
+// and returns the number of daily contributions it pays for. Products without daily
+// contributions take a deposit as a single payment.
+func (m *Product) CheckDeposit(amount, target money.Amount) (int64, error) {
+	if amount < m.MinDeposit {
+		return 0, fmt.Errorf("The minimum deposit for %s is %s", m.Name, m.MinDeposit)
+	}
+
+	if !m.DailyContribution {
+		return 1, nil
+	}
+
+	if target <= 0 {
+		return 0, fmt.Errorf("The account has no daily amount set, update the target of the account")
+	}
+
+	if !amount.IsMultipleOf(target) {
+		return 0, fmt.Errorf("Amount must be a multiple of %s", target)
+	}
+
+	days := int64(amount / target)
+	if m.MaxDaysPerPayment > 0 && days > int64(m.MaxDaysPerPayment) {
+		return 0, fmt.Errorf("Please pay for max of %d days at a time, one day is %s", m.MaxDaysPerPayment, target)
+	}
+
+	return days, nil
+}
+
+// CheckWithdrawal validates taking amount out of an account of the product with the balance.
+func (m *Product) CheckWithdrawal(balance, amount money.Amount) error {
+	if !m.WithdrawalsAllowed {
+		return fmt.Errorf("Withdrawals are not allowed on %s accounts", m.Name)
+	}
+
+	if balance-amount < m.MinBalance {
+		if m.MinBalance > 0 {
+			return fmt.Errorf("insufficient fund, %s must be left in the account", m.MinBalance)
+		}
+		return fmt.Errorf("insufficient fund")
+	}
+
+	return nil
+}
+
+// DaysInYear is the number of days yearly interest rates are spread over.
+const DaysInYear = 365
+
+// DailyInterest returns the interest a balance earns in a day at the yearly rate in basis points,
+// rounded to the nearest kobo.
+func DailyInterest(balance money.Amount, rateBPS int) money.Amount {
+	if balance <= 0 || rateBPS <= 0 {
+		return 0
+	}
+	d := int64(10000 * DaysInYear)
+	return money.Amount((balance.Kobo()*int64(rateBPS) + d/2) / d)
+}
+
+// EarlyWithdrawalPenalty returns the penalty charged for taking amount out of an account of the
+// product before it matures, rounded to the nearest kobo.
+func (m *Product) EarlyWithdrawalPenalty(amount money.Amount) money.Amount {
+	if amount <= 0 || m.EarlyWithdrawalPenaltyBPS <= 0 {
+		return 0
+	}
+	return money.Amount((amount.Kobo()*int64(m.EarlyWithdrawalPenaltyBPS) + 5000) / 10000)
+}
+
+// Response represents a product that is returned for display.
+type Response struct {
+	ID                        string            `json:"id" example:"985f1746-1d9f-459f-a2d9-fc53ece5ae86"`
+	Code                      string            `json:"code" example:"DS"`
+	Name                      string            `json:"name" example:"Daily Savings"`
+	Description               string            `json:"description"`
+	TargetRequired            bool              `json:"target_required"`
+	DailyContribution         bool              `json:"daily_contribution"`
+	MaxDaysPerPayment         int               `json:"max_days_per_payment"`
+	MinDeposit                money.Amount      `json:"min_deposit"`
+	CycleDays                 int               `json:"cycle_days"`
+	FirstContributionFee      bool              `json:"first_contribution_fee"`
+	CommissionPolicy          string            `json:"commission_policy"`
+	DepositSMS                bool              `json:"deposit_sms"`
+	WithdrawalsAllowed        bool              `json:"withdrawals_allowed"`
+	MinBalance                money.Amount      `json:"min_balance"`
+	ApprovalThreshold         money.Amount      `json:"approval_threshold"`
+	InterestRateBPS           int               `json:"interest_rate_bps"`
+	InterestRate              string            `json:"interest_rate" example:"12.50%"`
+	TenorDays                 int               `json:"tenor_days"`
+	EarlyWithdrawalPenaltyBPS int               `json:"early_withdrawal_penalty_bps"`
+	EarlyWithdrawalPenalty    string            `json:"early_withdrawal_penalty" example:"2.00%"`
+	CreatedAt                 web.TimeResponse  `json:"created_at"`            // CreatedAt contains multiple format options for display.
+	UpdatedAt                 web.TimeResponse  `json:"updated_at"`            // UpdatedAt contains multiple format options for display.
+	ArchivedAt                *web.TimeResponse `json:"archived_at,omitempty"` // ArchivedAt contains multiple format options for display.
+}
+
+// Response transforms Product to the Response that is used for display.
+// Additional filtering by context values or translations could be applied.
+func (m *Product) Response(ctx context.Context) *Response {
+	if m == nil {
+		return nil
+	}
+
+	r := &Response{
+		ID:                        m.ID,
+		Code:                      m.Code,
+		Name:                      m.Name,
+		Description:               m.Description,
+		TargetRequired:            m.TargetRequired,
+		DailyContribution:         m.DailyContribution,
+		MaxDaysPerPayment:         m.MaxDaysPerPayment,
+		MinDeposit:                m.MinDeposit,
+		CycleDays:                 m.CycleDays,
+		FirstContributionFee:      m.FirstContributionFee,
+		CommissionPolicy:          m.CommissionPolicy,
+		DepositSMS:                m.DepositSMS,
+		WithdrawalsAllowed:        m.WithdrawalsAllowed,
+		MinBalance:                m.MinBalance,
+		ApprovalThreshold:         m.ApprovalThreshold,
+		InterestRateBPS:           m.InterestRateBPS,
+		InterestRate:              FormatBPS(m.InterestRateBPS),
+		TenorDays:                 m.TenorDays,
+		EarlyWithdrawalPenaltyBPS: m.EarlyWithdrawalPenaltyBPS,
+		EarlyWithdrawalPenalty:    FormatBPS(m.EarlyWithdrawalPenaltyBPS),
+		CreatedAt:                 web.NewTimeResponse(ctx, m.CreatedAt),
+		UpdatedAt:                 web.NewTimeResponse(ctx, m.UpdatedAt),
+	}
+
+	if m.ArchivedAt != nil && !m.ArchivedAt.IsZero() {
+		at := web.NewTimeResponse(ctx, *m.ArchivedAt)
+		r.ArchivedAt = &at
+	}
+
+	return r
+}
+
+// FormatBPS formats basis points as a percentage.
+func FormatBPS(bps int) string {
+	return fmt.Sprintf("%d.%02d%%", bps/100, bps%100)
+}
+
+// Products a list of Products.
+type Products []*Product
+
+// Response transforms a list of Products to a list of Responses.
+func (m *Products) Response(ctx context.Context) []*Response {
+	var l = make([]*Response, 0)
+	if m != nil && len(*m) > 0 {
+		for _, n := range *m {
+			l = append(l, n.Response(ctx))
+		}
+	}
+
+	return l
+}
+
+// CreateRequest contains information needed to create a new Product. The code is the prefix of
+// the numbers of accounts opened on the product.
+type CreateRequest struct {
+	Code                      string       `json:"code" validate:"required,alphanum,uppercase,max=5,unique" example:"DS"`
+	Name                      string       `json:"name" validate:"required,max=100" example:"Daily Savings"`
+	Description               string       `json:"description" validate:"max=500"`
+	TargetRequired            bool         `json:"target_required"`
+	DailyContribution         bool         `json:"daily_contribution"`
+	MaxDaysPerPayment         int          `json:"max_days_per_payment" validate:"gte=0"`
+	MinDeposit                money.Amount `json:"min_deposit" validate:"gte=0"`
+	CycleDays                 int          `json:"cycle_days" validate:"required_with=FirstContributionFee,gte=0"`
+	FirstContributionFee      bool         `json:"first_contribution_fee"`
+	CommissionPolicy          string       `json:"commission_policy" validate:"max=200"`
+	DepositSMS                bool         `json:"deposit_sms"`
+	WithdrawalsAllowed        bool         `json:"withdrawals_allowed"`
+	MinBalance                money.Amount `json:"min_balance" validate:"gte=0"`
+	ApprovalThreshold         money.Amount `json:"approval_threshold" validate:"gte=0"`
+	InterestRateBPS           int          `json:"interest_rate_bps" validate:"gte=0,lte=10000"`
+	TenorDays                 int          `json:"tenor_days" validate:"gte=0"`
+	EarlyWithdrawalPenaltyBPS int          `json:"early_withdrawal_penalty_bps" validate:"gte=0,lte=10000"`
+}
+
+// ReadRequest defines the information needed to read a product.
+type ReadRequest struct {
+	ID              string `json:"id" validate:"required,uuid" example:"985f1746-1d9f-459f-a2d9-fc53ece5ae86"`
+	IncludeArchived bool   `json:"include-archived" example:"false"`
+}
+
+// UpdateRequest defines what information may be provided to modify an existing
+// Product. All fields are optional so clients can send just the fields they want
+// changed. It uses pointer fields so we can differentiate between a field that
+// was not provided and a field that was provided as explicitly blank. The code cannot be
+// changed as it is part of the numbers of existing accounts.
+type UpdateRequest struct {
+	ID                        string        `json:"id" validate:"required,uuid" example:"985f1746-1d9f-459f-a2d9-fc53ece5ae86"`
+	Name                      *string       `json:"name,omitempty" validate:"omitempty,max=100" example:"Daily Savings"`
+	Description               *string       `json:"description,omitempty" validate:"omitempty,max=500"`
+	TargetRequired            *bool         `json:"target_required,omitempty"`
+	DailyContribution         *bool         `json:"daily_contribution,omitempty"`
+	MaxDaysPerPayment         *int          `json:"max_days_per_payment,omitempty" validate:"omitempty,gte=0"`
+	MinDeposit                *money.Amount `json:"min_deposit,omitempty" validate:"omitempty,gte=0"`
+	CycleDays                 *int          `json:"cycle_days,omitempty" validate:"omitempty,gte=0"`
+	FirstContributionFee      *bool         `json:"first_contribution_fee,omitempty"`
+	CommissionPolicy          *string       `json:"commission_policy,omitempty" validate:"omitempty,max=200"`
+	DepositSMS                *bool         `json:"deposit_sms,omitempty"`
+	WithdrawalsAllowed        *bool         `json:"withdrawals_allowed,omitempty"`
+	MinBalance                *money.Amount `json:"min_balance,omitempty" validate:"omitempty,gte=0"`
+	ApprovalThreshold         *money.Amount `json:"approval_threshold,omitempty" validate:"omitempty,gte=0"`
+	InterestRateBPS           *int          `json:"interest_rate_bps,omitempty" validate:"omitempty,gte=0,lte=10000"`
+	TenorDays                 *int          `json:"tenor_days,omitempty" validate:"omitempty,gte=0"`
+	EarlyWithdrawalPenaltyBPS *int          `json:"early_withdrawal_penalty_bps,omitempty" validate:"omitempty,gte=0,lte=10000"`
+}
+
+// ArchiveRequest defines the information needed to archive a product. Accounts already opened on
+// it keep working but no new account can be opened on it.
+type ArchiveRequest struct {
+	ID string `json:"id" validate:"required,uuid" example:"985f1746-1d9f-459f-a2d9-fc53ece5ae86"`
+}
+
+// FindRequest defines the possible options to search for products. By default
+// archived products will be excluded from response.
+type FindRequest struct {
+	Where           string        `json:"where" example:"code = ?"`
+	Args            []interface{} `json:"args" swaggertype:"array,string" example:"DS"`
+	Order           []string      `json:"order" example:"code"`
+	Limit           *uint         `json:"limit" example:"10"`
+	Offset          *uint         `json:"offset" example:"20"`
+	IncludeArchived bool          `json:"include-archived" example:"false"`
+}
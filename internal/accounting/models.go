@@ -2,6 +2,8 @@ package accounting
 
 import (
 	"github.com/volatiletech/null"
+
+	"merryworld/surebank/internal/platform/money"
 )
 
 // CreateBankAccount holds req for creating a new bank account
@@ -13,19 +15,19 @@ type CreateBankAccount struct {
 
 // CreateBankDeposit holds req for creating a new bank deposit
 type CreateBankDeposit struct {
-	BankID string  `json:"bank_id" validate:"required"`
-	Amount float64 `json:"amount" validate:"required"`
+	BankID string       `json:"bank_id" validate:"required"`
+	Amount money.Amount `json:"amount" validate:"required"`
 }
 
 // CreateExpenditure holds req for creating a new bank expenditure
 type CreateExpenditure struct {
-	Amount float64 `json:"amount" validate:"required"`
-	Memo   string  `json:"memo"`
+	Amount money.Amount `json:"amount" validate:"required"`
+	Memo   string       `json:"memo"`
 }
 
 type RepsSummary struct {
-	SalesRepID  string           `json:"sales_rep_id"`
-	SalesRep    string           `json:"sales_rep"`
-	Income      null.Float64     `json:"income"`
-	Expenditure null.Float64     `json:"expenditure"`
+	SalesRepID  string     `json:"sales_rep_id"`
+	SalesRep    string     `json:"sales_rep"`
+	Income      null.Int64 `json:"income"`
+	Expenditure null.Int64 `json:"expenditure"`
 }
@@ -2,6 +2,7 @@ package branch
 
 import (
 	"context"
+	"strings"
 	"time"
 
 	"merryworld/surebank/internal/platform/auth"
@@ -116,22 +117,19 @@ func (repo *Repository) Create(ctx context.Context, claims auth.Claims, req Crea
 	// here so the value we return is consistent with what we store.
 	now = now.Truncate(time.Millisecond)
 	m := models.Branch{
-		ID:        uuid.NewRandom().String(),
-		Name:      req.Name,
-		CreatedAt: now.Unix(),
-		UpdatedAt: now.Unix(),
+		ID:                uuid.NewRandom().String(),
+		Name:              req.Name,
+		ApprovalThreshold: req.ApprovalThreshold.Kobo(),
+		CommissionPolicy:  strings.TrimSpace(req.CommissionPolicy),
+		CreatedAt:         now.Unix(),
+		UpdatedAt:         now.Unix(),
 	}
 
 	if err := m.Insert(ctx, repo.DbConn, boil.Infer()); err != nil {
 		return nil, errors.WithMessage(err, "Insert branch failed")
 	}
 
-	return &Branch{
-		ID:        m.ID,
-		Name:      m.Name,
-		CreatedAt: time.Unix(m.CreatedAt, 0),
-		UpdatedAt: time.Unix(m.UpdatedAt, 0),
-	}, nil
+	return FromModel(&m), nil
 }
 
 // Update replaces an branch in the database.
@@ -169,6 +167,12 @@ func (repo *Repository) Update(ctx context.Context, claims auth.Claims, req Upda
 	if req.Name != nil {
 		cols[models.BrandColumns.Name] = *req.Name
 	}
+	if req.ApprovalThreshold != nil {
+		cols[models.BranchColumns.ApprovalThreshold] = req.ApprovalThreshold.Kobo()
+	}
+	if req.CommissionPolicy != nil {
+		cols[models.BranchColumns.CommissionPolicy] = strings.TrimSpace(*req.CommissionPolicy)
+	}
 
 	if len(cols) == 0 {
 		return nil
@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/jmoiron/sqlx"
+	"merryworld/surebank/internal/platform/money"
 	"merryworld/surebank/internal/platform/web"
 	"merryworld/surebank/internal/postgres/models"
 )
@@ -23,19 +24,27 @@ func NewRepository(db *sqlx.DB) *Repository {
 
 // Branch represents a workflow.
 type Branch struct {
-	ID         string          `json:"id" validate:"required,uuid" example:"985f1746-1d9f-459f-a2d9-fc53ece5ae86"`
-	Name       string          `json:"name"  validate:"required" example:"Rocket Launch"`
-	CreatedAt  time.Time       `json:"created_at" truss:"api-read"`
-	UpdatedAt  time.Time       `json:"updated_at" truss:"api-read"`
-	ArchivedAt *time.Time    `json:"archived_at,omitempty" truss:"api-hide"`
+	ID   string `json:"id" validate:"required,uuid" example:"985f1746-1d9f-459f-a2d9-fc53ece5ae86"`
+	Name string `json:"name"  validate:"required" example:"Rocket Launch"`
+	// ApprovalThreshold is the amount from which withdrawals and transfers made at the branch must
+	// be approved by a second user, 0 for no approval.
+	ApprovalThreshold money.Amount `json:"approval_threshold"`
+	// CommissionPolicy is how the DS commission is charged on the accounts of the branch whose
+	// product does not set one, empty for the default. See dscommission.ParsePolicy.
+	CommissionPolicy string     `json:"commission_policy"`
+	CreatedAt        time.Time  `json:"created_at" truss:"api-read"`
+	UpdatedAt        time.Time  `json:"updated_at" truss:"api-read"`
+	ArchivedAt       *time.Time `json:"archived_at,omitempty" truss:"api-hide"`
 }
 
 func FromModel(rec *models.Branch) *Branch {
 	b := &Branch{
-		ID:         rec.ID,
-		Name:       rec.Name,
-		CreatedAt:  time.Unix(rec.CreatedAt, 0),
-		UpdatedAt:  time.Unix(rec.UpdatedAt, 0),
+		ID:                rec.ID,
+		Name:              rec.Name,
+		ApprovalThreshold: money.Amount(rec.ApprovalThreshold),
+		CommissionPolicy:  rec.CommissionPolicy,
+		CreatedAt:         time.Unix(rec.CreatedAt, 0),
+		UpdatedAt:         time.Unix(rec.UpdatedAt, 0),
 	}
 	if rec.ArchivedAt.Valid {
 		archivedAt := time.Unix(rec.ArchivedAt.Int64, 0)
@@ -47,11 +56,13 @@ func FromModel(rec *models.Branch) *Branch {
 
 // Response represents a workflow that is returned for display.
 type Response struct {
-	ID         string            `json:"id" validate:"required,uuid" example:"985f1746-1d9f-459f-a2d9-fc53ece5ae86"`
-	Name       string            `json:"name"  validate:"required" example:"Rocket Launch"`
-	CreatedAt  web.TimeResponse  `json:"created_at"`            // CreatedAt contains multiple format options for display.
-	UpdatedAt  web.TimeResponse  `json:"updated_at"`            // UpdatedAt contains multiple format options for display.
-	ArchivedAt *web.TimeResponse `json:"archived_at,omitempty"` // ArchivedAt contains multiple format options for display.
+	ID                string            `json:"id" validate:"required,uuid" example:"985f1746-1d9f-459f-a2d9-fc53ece5ae86"`
+	Name              string            `json:"name"  validate:"required" example:"Rocket Launch"`
+	ApprovalThreshold money.Amount      `json:"approval_threshold"`
+	CommissionPolicy  string            `json:"commission_policy"`
+	CreatedAt         web.TimeResponse  `json:"created_at"`            // CreatedAt contains multiple format options for display.
+	UpdatedAt         web.TimeResponse  `json:"updated_at"`            // UpdatedAt contains multiple format options for display.
+	ArchivedAt        *web.TimeResponse `json:"archived_at,omitempty"` // ArchivedAt contains multiple format options for display.
 }
 
 // Response transforms Branch to the Response that is used for display.
@@ -62,10 +73,12 @@ func (m *Branch) Response(ctx context.Context) *Response {
 	}
 
 	r := &Response{
-		ID:        m.ID,
-		Name:      m.Name,
-		CreatedAt: web.NewTimeResponse(ctx, m.CreatedAt),
-		UpdatedAt: web.NewTimeResponse(ctx, m.UpdatedAt),
+		ID:                m.ID,
+		Name:              m.Name,
+		ApprovalThreshold: m.ApprovalThreshold,
+		CommissionPolicy:  m.CommissionPolicy,
+		CreatedAt:         web.NewTimeResponse(ctx, m.CreatedAt),
+		UpdatedAt:         web.NewTimeResponse(ctx, m.UpdatedAt),
 	}
 
 	if m.ArchivedAt != nil && !m.ArchivedAt.IsZero() {
@@ -93,7 +106,9 @@ func (m *Branches) Response(ctx context.Context) []*Response {
 
 // CreateRequest contains information needed to create a new Branch.
 type CreateRequest struct {
-	Name      string           `json:"name" validate:"required"  example:"Rocket Launch"`
+	Name              string       `json:"name" validate:"required"  example:"Rocket Launch"`
+	ApprovalThreshold money.Amount `json:"approval_threshold" validate:"gte=0"`
+	CommissionPolicy  string       `json:"commission_policy" validate:"max=200"`
 }
 
 // ReadRequest defines the information needed to read a checklist.
@@ -107,8 +122,10 @@ type ReadRequest struct {
 // changed. It uses pointer fields so we can differentiate between a field that
 // was not provided and a field that was provided as explicitly blank.
 type UpdateRequest struct {
-	ID     string           `json:"id" validate:"required,uuid" example:"985f1746-1d9f-459f-a2d9-fc53ece5ae86"`
-	Name   *string          `json:"name,omitempty" validate:"omitempty,unique" example:"Rocket Launch to Moon"`
+	ID                string        `json:"id" validate:"required,uuid" example:"985f1746-1d9f-459f-a2d9-fc53ece5ae86"`
+	Name              *string       `json:"name,omitempty" validate:"omitempty,unique" example:"Rocket Launch to Moon"`
+	ApprovalThreshold *money.Amount `json:"approval_threshold,omitempty" validate:"omitempty,gte=0"`
+	CommissionPolicy  *string       `json:"commission_policy,omitempty" validate:"omitempty,max=200"`
 }
 
 // ArchiveRequest defines the information needed to archive a checklist. This will archive (soft-delete) the
@@ -132,6 +132,34 @@ func (repo *Repository) ReadByID(ctx context.Context, claims auth.Claims, id str
 	return FromModel(customerModel), nil
 }
 
+// FindByPhone gets the customers that are not archived with the phone number. Numbers are matched
+// on their last 10 digits so the ones captured with a country code or spaces are found.
+func (repo *Repository) FindByPhone(ctx context.Context, _ auth.Claims, phone string) (Customers, error) {
+	span, ctx := tracer.StartSpanFromContext(ctx, "internal.customer.FindByPhone")
+	defer span.Finish()
+
+	key := phoneKey(phone)
+	if key == "" {
+		return nil, nil
+	}
+
+	slice, err := models.Customers(
+		Where("RIGHT(regexp_replace(phone_number, '[^0-9]', '', 'g'), 10) = ?", key),
+		models.CustomerWhere.ArchivedAt.IsNull(),
+		OrderBy(models.CustomerColumns.CreatedAt),
+	).All(ctx, repo.DbConn)
+	if err != nil {
+		return nil, err
+	}
+
+	var result Customers
+	for _, rec := range slice {
+		result = append(result, FromModel(rec))
+	}
+
+	return result, nil
+}
+
 func (repo *Repository) CustomersCount(ctx context.Context, claims auth.Claims) (int64, error) {
 	span, ctx := tracer.StartSpanFromContext(ctx, "internal.customer.CustomersCount")
 	defer span.Finish()
@@ -144,15 +172,31 @@ func (repo *Repository) CustomersCount(ctx context.Context, claims auth.Claims)
 	return models.Customers(queries...).Count(ctx, repo.DbConn)
 }
 
-// Create inserts a new customer into the database.
+// Create inserts a new customer into the database. A DuplicateError is returned instead when the
+// customer looks like customers that are already registered and duplicates are not ignored.
 func (repo *Repository) Create(ctx context.Context, claims auth.Claims, req CreateRequest, now time.Time) (*Customer, error) {
 	span, ctx := tracer.StartSpanFromContext(ctx, "internal.customer.Create")
 	defer span.Finish()
+
+	return repo.create(ctx, claims, req, now, repo.DbConn)
+}
+
+// CreateTx inserts a new customer within the db transaction so it is only registered when the
+// rest of the work it is registered for succeeds.
+func (repo *Repository) CreateTx(ctx context.Context, claims auth.Claims, req CreateRequest, now time.Time, tx *sql.Tx) (*Customer, error) {
+	span, ctx := tracer.StartSpanFromContext(ctx, "internal.customer.CreateTx")
+	defer span.Finish()
+
+	return repo.create(ctx, claims, req, now, tx)
+}
+
+// create inserts a new customer with the executor.
+func (repo *Repository) create(ctx context.Context, claims auth.Claims, req CreateRequest, now time.Time, exec boil.ContextExecutor) (*Customer, error) {
 	if claims.Audience == "" {
 		return nil, errors.WithStack(ErrForbidden)
 	}
 
-	salesRep, err := models.Users(models.UserWhere.ID.EQ(claims.Subject)).One(ctx, repo.DbConn)
+	salesRep, err := models.Users(models.UserWhere.ID.EQ(claims.Subject)).One(ctx, exec)
 	if err != nil {
 		return nil, weberror.NewErrorMessage(ctx, err, 400, "Something went wrong. Are you logged in?")
 	}
@@ -166,6 +210,17 @@ func (repo *Repository) Create(ctx context.Context, claims auth.Claims, req Crea
 		return nil, err
 	}
 
+	// Returning customers are often registered again, warn about them unless told not to.
+	if !req.IgnoreDuplicates {
+		dups, err := repo.FindDuplicates(ctx, claims, req.Name, req.PhoneNumber, req.BranchID, "")
+		if err != nil {
+			return nil, err
+		}
+		if len(dups) > 0 {
+			return nil, &DuplicateError{Duplicates: dups}
+		}
+	}
+
 	// If now empty set it to the current time.
 	if now.IsZero() {
 		now = time.Now()
@@ -188,7 +243,7 @@ func (repo *Repository) Create(ctx context.Context, claims auth.Claims, req Crea
 		UpdatedAt:   now.Unix(),
 	}
 
-	if err := m.Insert(ctx, repo.DbConn, boil.Infer()); err != nil {
+	if err := m.Insert(ctx, exec, boil.Infer()); err != nil {
 		return nil, weberror.WithMessage(ctx, err, "Insert customer failed")
 	}
 
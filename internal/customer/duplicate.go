@@ -0,0 +1,451 @@
+package customer
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/pborman/uuid"
+	"github.com/pkg/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	. "github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"gopkg.in/DataDog/dd-trace-go.v1/ddtrace/tracer"
+
+	"merryworld/surebank/internal/platform/auth"
+	"merryworld/surebank/internal/platform/web/webcontext"
+	"merryworld/surebank/internal/platform/web/weberror"
+	"merryworld/surebank/internal/postgres/models"
+)
+
+var (
+	// ErrMergeArchived occurs when a customer that is archived or was already merged is merged.
+	ErrMergeArchived = errors.New("Archived customers cannot be merged")
+)
+
+// DuplicateNameSimilarity is how alike the names of two customers of the same branch must be for
+// them to be taken as the same person.
+const DuplicateNameSimilarity = 0.85
+
+// The reasons a customer is taken as a duplicate.
+const (
+	DuplicateReason_Phone = "Same phone number"
+	DuplicateReason_Name  = "Similar name in the same branch"
+)
+
+// DuplicateError is returned by Create when the customer looks like customers that are already
+// registered. The request can be sent again with IgnoreDuplicates set to create it anyway.
+type DuplicateError struct {
+	Duplicates Duplicates
+}
+
+// Error implements the error interface.
+func (e *DuplicateError) Error() string {
+	var names []string
+	for _, d := range e.Duplicates {
+		names = append(names, d.Customer.Name)
+	}
+	return fmt.Sprintf("The customer may already be registered as %s", strings.Join(names, ", "))
+}
+
+// IsDuplicate returns the customers a new customer looks like when err is a DuplicateError.
+func IsDuplicate(err error) (Duplicates, bool) {
+	if e, ok := errors.Cause(err).(*DuplicateError); ok {
+		return e.Duplicates, true
+	}
+	return nil, false
+}
+
+// NormalizePhone returns the phone number in the local 11 digit format so numbers captured with
+// the country code, spaces or dashes compare equal.
+func NormalizePhone(phone string) string {
+	var b strings.Builder
+	for _, r := range phone {
+		if r >= '0' && r <= '9' {
+			b.WriteRune(r)
+		}
+	}
+	digits := b.String()
+
+	switch {
+	case len(digits) == 13 && strings.HasPrefix(digits, "234"):
+		return "0" + digits[3:]
+	case len(digits) == 10 && !strings.HasPrefix(digits, "0"):
+		return "0" + digits
+	}
+	return digits
+}
+
+// phoneKey returns the last 10 digits of the phone number that customers are matched on, empty
+// for numbers that are too short to match.
+func phoneKey(phone string) string {
+	digits := NormalizePhone(phone)
+	if len(digits) < 10 {
+		return ""
+	}
+	return digits[len(digits)-10:]
+}
+
+// nameTokens splits the name into lower case words, ignoring punctuation.
+func nameTokens(name string) []string {
+	return strings.FieldsFunc(strings.ToLower(name), func(r rune) bool {
+		return !unicode.IsLetter(r)
+	})
+}
+
+// levenshtein returns the number of single character edits that turn a into b.
+func levenshtein(a, b []rune) int {
+	prev := make([]int, len(b)+1)
+	cur := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		cur[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			cur[j] = min3(prev[j]+1, cur[j-1]+1, prev[j-1]+cost)
+		}
+		prev, cur = cur, prev
+	}
+	return prev[len(b)]
+}
+
+func min3(a, b, c int) int {
+	if b < a {
+		a = b
+	}
+	if c < a {
+		a = c
+	}
+	return a
+}
+
+// ratio returns how alike the strings are from the edit distance, from 0 to 1.
+func ratio(a, b string) float64 {
+	ra, rb := []rune(a), []rune(b)
+	n := len(ra)
+	if len(rb) > n {
+		n = len(rb)
+	}
+	if n == 0 {
+		return 0
+	}
+	return 1 - float64(levenshtein(ra, rb))/float64(n)
+}
+
+// NameSimilarity returns how alike two names are, from 0 to 1. The order of the names does not
+// matter and a name of two or more words that is contained in the other, such as one without the
+// middle name, is as alike as the words are.
+func NameSimilarity(a, b string) float64 {
+	ta, tb := nameTokens(a), nameTokens(b)
+	if len(ta) == 0 || len(tb) == 0 {
+		return 0
+	}
+	sort.Strings(ta)
+	sort.Strings(tb)
+
+	best := ratio(strings.Join(ta, " "), strings.Join(tb, " "))
+
+	short, long := ta, tb
+	if len(short) > len(long) {
+		short, long = long, short
+	}
+	if len(short) >= 2 {
+		var total float64
+		for _, s := range short {
+			var tokenBest float64
+			for _, l := range long {
+				if r := ratio(s, l); r > tokenBest {
+					tokenBest = r
+				}
+			}
+			total += tokenBest
+		}
+		if contained := total / float64(len(short)); contained > best {
+			best = contained
+		}
+	}
+
+	return best
+}
+
+// MatchDuplicate checks whether the registered customer looks like the same person as a customer
+// with the name and phone number registered in the branch. It returns nil when it does not.
+func MatchDuplicate(c *Customer, name, phone, branchID string) *Duplicate {
+	d := &Duplicate{Customer: c, Similarity: NameSimilarity(c.Name, name)}
+
+	if key := phoneKey(phone); key != "" && key == phoneKey(c.PhoneNumber) {
+		d.Reasons = append(d.Reasons, DuplicateReason_Phone)
+	}
+	if branchID != "" && c.BranchID == branchID && d.Similarity >= DuplicateNameSimilarity {
+		d.Reasons = append(d.Reasons, DuplicateReason_Name)
+	}
+
+	if len(d.Reasons) == 0 {
+		return nil
+	}
+	return d
+}
+
+// FindDuplicates gets the registered customers that look like the same person as a customer with
+// the name and phone number registered in the branch, most alike first. The customer with the
+// excluded ID is left out so a customer is not its own duplicate.
+func (repo *Repository) FindDuplicates(ctx context.Context, claims auth.Claims, name, phone, branchID, excludeID string) (Duplicates, error) {
+	span, ctx := tracer.StartSpanFromContext(ctx, "internal.customer.FindDuplicates")
+	defer span.Finish()
+
+	if claims.Audience == "" {
+		return nil, errors.WithStack(ErrForbidden)
+	}
+
+	queries := []QueryMod{
+		Load(models.CustomerRels.Branch),
+		Load(models.CustomerRels.SalesRep),
+		Where("archived_at IS NULL"),
+		Where("(branch_id = ? OR RIGHT(regexp_replace(phone_number, '[^0-9]', '', 'g'), 10) = ?)", branchID, phoneKey(phone)),
+	}
+	if excludeID != "" {
+		queries = append(queries, models.CustomerWhere.ID.NEQ(excludeID))
+	}
+
+	recs, err := models.Customers(queries...).All(ctx, repo.DbConn)
+	if err != nil {
+		return nil, weberror.NewError(ctx, err, http.StatusInternalServerError)
+	}
+
+	var res Duplicates
+	for _, rec := range recs {
+		if d := MatchDuplicate(FromModel(rec), name, phone, branchID); d != nil {
+			res = append(res, d)
+		}
+	}
+	sort.SliceStable(res, func(i, j int) bool {
+		if len(res[i].Reasons) != len(res[j].Reasons) {
+			return len(res[i].Reasons) > len(res[j].Reasons)
+		}
+		return res[i].Similarity > res[j].Similarity
+	})
+
+	return res, nil
+}
+
+// FindDuplicatesOf gets the registered customers that look like the same person as the customer.
+func (repo *Repository) FindDuplicatesOf(ctx context.Context, claims auth.Claims, c *Customer) (Duplicates, error) {
+	if c.ArchivedAt != nil {
+		return nil, nil
+	}
+	return repo.FindDuplicates(ctx, claims, c.Name, c.PhoneNumber, c.BranchID, c.ID)
+}
+
+// Merge merges a duplicate customer into the surviving one in one db transaction. The accounts,
+// with their transactions and history, loans, commissions and documents the survivor does not have
+// are moved to the survivor, sales recorded with the phone number of the duplicate get the name
+// and phone number of the survivor and the duplicate is archived. The merge is recorded with a
+// snapshot of the duplicate. Only supervisors can merge customers.
+func (repo *Repository) Merge(ctx context.Context, claims auth.Claims, req MergeRequest, now time.Time) (*Merge, error) {
+	span, ctx := tracer.StartSpanFromContext(ctx, "internal.customer.Merge")
+	defer span.Finish()
+
+	if claims.Audience == "" || !claims.HasRole(auth.RoleAdmin, auth.RoleSuperAdmin) {
+		return nil, errors.WithStack(ErrForbidden)
+	}
+
+	// Validate the request.
+	v := webcontext.Validator()
+	if err := v.Struct(req); err != nil {
+		return nil, err
+	}
+
+	// If now empty set it to the current time.
+	if now.IsZero() {
+		now = time.Now()
+	}
+
+	// Always store the time as UTC.
+	now = now.UTC()
+	// Postgres truncates times to milliseconds when storing. We and do the same
+	// here so the value we return is consistent with what we store.
+	now = now.Truncate(time.Millisecond)
+
+	tx, err := repo.DbConn.Begin()
+	if err != nil {
+		return nil, err
+	}
+
+	m, err := repo.merge(ctx, claims, req, now, tx)
+	if err != nil {
+		_ = tx.Rollback()
+		return nil, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return MergeFromModel(m), nil
+}
+
+func (repo *Repository) merge(ctx context.Context, claims auth.Claims, req MergeRequest, now time.Time, tx *sql.Tx) (*models.CustomerMerge, error) {
+	// Both customers are locked in a fixed order so merges that involve the same customers do not
+	// deadlock.
+	custs, err := models.Customers(
+		models.CustomerWhere.ID.IN([]string{req.SurvivorID, req.DuplicateID}),
+		OrderBy(models.CustomerColumns.ID),
+		For("UPDATE"),
+	).All(ctx, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	var survivor, duplicate *models.Customer
+	for _, c := range custs {
+		if c.ID == req.SurvivorID {
+			survivor = c
+		} else {
+			duplicate = c
+		}
+	}
+	if survivor == nil || duplicate == nil {
+		return nil, weberror.NewErrorMessage(ctx, errors.WithStack(ErrNotFound), http.StatusNotFound, "Invalid customer ID")
+	}
+	if survivor.ArchivedAt.Valid || duplicate.ArchivedAt.Valid {
+		return nil, weberror.NewError(ctx, errors.WithStack(ErrMergeArchived), http.StatusBadRequest)
+	}
+
+	snapshot, err := json.Marshal(duplicate)
+	if err != nil {
+		return nil, err
+	}
+
+	m := &models.CustomerMerge{
+		ID:                uuid.NewRandom().String(),
+		SurvivorID:        survivor.ID,
+		DuplicateID:       duplicate.ID,
+		Reason:            req.Reason,
+		DuplicateSnapshot: string(snapshot),
+		MergedByID:        claims.Subject,
+		CreatedAt:         now.Unix(),
+	}
+
+	n, err := models.Accounts(models.AccountWhere.CustomerID.EQ(duplicate.ID)).UpdateAll(ctx, tx, models.M{
+		models.AccountColumns.CustomerID: survivor.ID,
+		models.AccountColumns.UpdatedAt:  now.Unix(),
+	})
+	if err != nil {
+		return nil, errors.WithMessage(err, "Move accounts failed")
+	}
+	m.AccountsMoved = int(n)
+
+	n, err = models.Loans(models.LoanWhere.CustomerID.EQ(duplicate.ID)).UpdateAll(ctx, tx, models.M{
+		models.LoanColumns.CustomerID: survivor.ID,
+		models.LoanColumns.UpdatedAt:  now.Unix(),
+	})
+	if err != nil {
+		return nil, errors.WithMessage(err, "Move loans failed")
+	}
+	m.LoansMoved = int(n)
+
+	if _, err = models.DSCommissions(models.DSCommissionWhere.CustomerID.EQ(duplicate.ID)).UpdateAll(ctx, tx, models.M{
+		models.DSCommissionColumns.CustomerID: survivor.ID,
+	}); err != nil {
+		return nil, errors.WithMessage(err, "Move commissions failed")
+	}
+
+	// The documents of the survivor are kept, the duplicate's replaced ones stay with it.
+	survivorDocs, err := models.CustomerDocuments(models.CustomerDocumentWhere.CustomerID.EQ(survivor.ID)).All(ctx, tx)
+	if err != nil {
+		return nil, err
+	}
+	var kinds []string
+	for _, d := range survivorDocs {
+		kinds = append(kinds, d.Kind)
+	}
+	docQueries := []QueryMod{models.CustomerDocumentWhere.CustomerID.EQ(duplicate.ID)}
+	if len(kinds) > 0 {
+		docQueries = append(docQueries, models.CustomerDocumentWhere.Kind.NIN(kinds))
+	}
+	n, err = models.CustomerDocuments(docQueries...).UpdateAll(ctx, tx, models.M{
+		models.CustomerDocumentColumns.CustomerID: survivor.ID,
+	})
+	if err != nil {
+		return nil, errors.WithMessage(err, "Move documents failed")
+	}
+	m.DocumentsMoved = int(n)
+
+	if key := phoneKey(duplicate.PhoneNumber); key != "" {
+		n, err = models.Sales(
+			Where("RIGHT(regexp_replace(phone_number, '[^0-9]', '', 'g'), 10) = ?", key),
+		).UpdateAll(ctx, tx, models.M{
+			models.SaleColumns.CustomerName: null.StringFrom(survivor.Name),
+			models.SaleColumns.PhoneNumber:  null.StringFrom(survivor.PhoneNumber),
+			models.SaleColumns.UpdatedAt:    now.Unix(),
+		})
+		if err != nil {
+			return nil, errors.WithMessage(err, "Move sales failed")
+		}
+		m.SalesMoved = int(n)
+	}
+
+	// Contact details only the duplicate has are kept on the survivor.
+	survivorCols := models.M{models.CustomerColumns.UpdatedAt: now.Unix()}
+	if survivor.Email == "" && duplicate.Email != "" {
+		survivorCols[models.CustomerColumns.Email] = duplicate.Email
+	}
+	if survivor.Address == "" && duplicate.Address != "" {
+		survivorCols[models.CustomerColumns.Address] = duplicate.Address
+	}
+	if _, err = models.Customers(models.CustomerWhere.ID.EQ(survivor.ID)).UpdateAll(ctx, tx, survivorCols); err != nil {
+		return nil, errors.WithMessage(err, "Update survivor failed")
+	}
+
+	if _, err = models.Customers(models.CustomerWhere.ID.EQ(duplicate.ID)).UpdateAll(ctx, tx, models.M{
+		models.CustomerColumns.MergedIntoID: null.StringFrom(survivor.ID),
+		models.CustomerColumns.ArchivedAt:   null.Int64From(now.Unix()),
+		models.CustomerColumns.UpdatedAt:    now.Unix(),
+	}); err != nil {
+		return nil, errors.WithMessage(err, "Archive duplicate failed")
+	}
+
+	if err = m.Insert(ctx, tx, boil.Infer()); err != nil {
+		return nil, errors.WithMessage(err, "Insert merge failed")
+	}
+
+	return m, nil
+}
+
+// FindMerges gets the duplicates merged into a customer, most recent first.
+func (repo *Repository) FindMerges(ctx context.Context, claims auth.Claims, customerID string) (Merges, error) {
+	span, ctx := tracer.StartSpanFromContext(ctx, "internal.customer.FindMerges")
+	defer span.Finish()
+
+	if claims.Audience == "" {
+		return nil, errors.WithStack(ErrForbidden)
+	}
+
+	recs, err := models.CustomerMerges(
+		models.CustomerMergeWhere.SurvivorID.EQ(customerID),
+		Load(models.CustomerMergeRels.Duplicate),
+		Load(models.CustomerMergeRels.MergedBy),
+		OrderBy(models.CustomerMergeColumns.CreatedAt+" desc"),
+	).All(ctx, repo.DbConn)
+	if err != nil {
+		return nil, weberror.NewError(ctx, err, http.StatusInternalServerError)
+	}
+
+	var res Merges
+	for _, rec := range recs {
+		res = append(res, MergeFromModel(rec))
+	}
+
+	return res, nil
+}
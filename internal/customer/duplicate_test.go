@@ -0,0 +1,108 @@
+package customer
+
+import (
+	"strings"
+	"testing"
+
+	"merryworld/surebank/internal/platform/tests"
+)
+
+// TestNormalizePhone validates phone numbers captured in different formats compare equal.
+func TestNormalizePhone(t *testing.T) {
+	var phoneTests = []struct {
+		Phone string
+		Want  string
+	}{
+		{"08031234567", "08031234567"},
+		{"+234 803 123 4567", "08031234567"},
+		{"2348031234567", "08031234567"},
+		{"803-123-4567", "08031234567"},
+		{"0803 123 4567", "08031234567"},
+		{"12345", "12345"},
+		{"", ""},
+	}
+
+	t.Log("Given the need to match customers by phone number.")
+	{
+		for i, tt := range phoneTests {
+			t.Logf("\tTest: %d\tWhen the phone number is %q.", i, tt.Phone)
+			{
+				if got := NormalizePhone(tt.Phone); got != tt.Want {
+					t.Logf("\t\tGot : %s", got)
+					t.Logf("\t\tWant: %s", tt.Want)
+					t.Fatalf("\t%s\tShould get the local format.", tests.Failed)
+				}
+				t.Logf("\t%s\tShould get the local format.", tests.Success)
+			}
+		}
+	}
+}
+
+// TestNameSimilarity validates names of the same person written differently are alike.
+func TestNameSimilarity(t *testing.T) {
+	var nameTests = []struct {
+		A, B    string
+		Similar bool
+	}{
+		{"Oluwafe Dami", "Oluwafe Dami", true},
+		{"Oluwafe Dami", "dami, oluwafe", true},
+		{"Oluwafe Dami", "Oluwafe Damilola Dami", true},
+		{"Oluwafemi Dami", "Oluwafe Dami", true},
+		{"Oluwafe Dami", "Chinedu Okafor", false},
+		{"Oluwafe Dami", "Oluwafe Bello", false},
+		{"Dami", "Oluwafe Dami", false},
+		{"", "Oluwafe Dami", false},
+	}
+
+	t.Log("Given the need to match customers by name.")
+	{
+		for i, tt := range nameTests {
+			t.Logf("\tTest: %d\tWhen comparing %q and %q.", i, tt.A, tt.B)
+			{
+				got := NameSimilarity(tt.A, tt.B)
+				if (got >= DuplicateNameSimilarity) != tt.Similar {
+					t.Logf("\t\tGot : %.2f", got)
+					t.Logf("\t\tWant similar: %v", tt.Similar)
+					t.Fatalf("\t%s\tShould get the expected similarity.", tests.Failed)
+				}
+				t.Logf("\t%s\tShould get the expected similarity.", tests.Success)
+			}
+		}
+	}
+}
+
+// TestMatchDuplicate validates customers are taken as duplicates on the phone number or on a
+// similar name in the same branch.
+func TestMatchDuplicate(t *testing.T) {
+	registered := &Customer{ID: "c1", Name: "Oluwafe Dami", PhoneNumber: "08031234567", BranchID: "b1"}
+
+	var matchTests = []struct {
+		Name, Phone, BranchID string
+		Reasons               []string
+	}{
+		{"Chinedu Okafor", "+2348031234567", "b2", []string{DuplicateReason_Phone}},
+		{"Dami Oluwafe", "08099999999", "b1", []string{DuplicateReason_Name}},
+		{"Oluwafe Dami", "0803 123 4567", "b1", []string{DuplicateReason_Phone, DuplicateReason_Name}},
+		{"Oluwafe Dami", "08099999999", "b2", nil},
+		{"Chinedu Okafor", "08099999999", "b1", nil},
+	}
+
+	t.Log("Given the need to warn about customers that are registered again.")
+	{
+		for i, tt := range matchTests {
+			t.Logf("\tTest: %d\tWhen registering %s %s in %s.", i, tt.Name, tt.Phone, tt.BranchID)
+			{
+				var got string
+				if d := MatchDuplicate(registered, tt.Name, tt.Phone, tt.BranchID); d != nil {
+					got = strings.Join(d.Reasons, ", ")
+				}
+				if want := strings.Join(tt.Reasons, ", "); got != want {
+					t.Logf("\t\tGot : %s", got)
+					t.Logf("\t\tWant: %s", want)
+					t.Fatalf("\t%s\tShould get the expected reasons.", tests.Failed)
+				}
+				t.Logf("\t%s\tShould get the expected reasons.", tests.Success)
+			}
+		}
+	}
+}
@@ -0,0 +1,446 @@
+package customer
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/jinzhu/now"
+	"github.com/pborman/uuid"
+	"github.com/pkg/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	. "github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"gopkg.in/DataDog/dd-trace-go.v1/ddtrace/tracer"
+
+	"merryworld/surebank/internal/platform/auth"
+	imgresize "merryworld/surebank/internal/platform/img-resize"
+	"merryworld/surebank/internal/platform/money"
+	"merryworld/surebank/internal/platform/web/webcontext"
+	"merryworld/surebank/internal/platform/web/weberror"
+	"merryworld/surebank/internal/postgres/models"
+)
+
+var (
+	// ErrDepositLimit occurs when a deposit takes a customer over the daily deposit limit of their KYC tier.
+	ErrDepositLimit = errors.New("The deposit is over the daily limit of the customer's KYC tier")
+
+	// ErrWithdrawalLimit occurs when a withdrawal takes a customer over the daily withdrawal limit of their KYC tier.
+	ErrWithdrawalLimit = errors.New("The withdrawal is over the daily limit of the customer's KYC tier")
+
+	// ErrKYCIncomplete occurs when a customer is verified for a tier their KYC records do not meet.
+	ErrKYCIncomplete = errors.New("The customer's KYC records are incomplete for the tier")
+)
+
+// DocumentMaxWidth is the width in pixels uploaded documents are scaled down to.
+const DocumentMaxWidth = 1200
+
+// Tiers are the limits of the KYC tiers, customers start on the first tier until their records
+// are verified for a higher one.
+var Tiers = []TierLimits{
+	{Tier: 1, DailyDeposit: money.Naira(50000), DailyWithdrawal: money.Naira(20000)},
+	{Tier: 2, DailyDeposit: money.Naira(200000), DailyWithdrawal: money.Naira(100000)},
+	{Tier: 3},
+}
+
+// Limits returns the limits of the KYC tier, those of the first tier for unknown tiers.
+func Limits(tier int) TierLimits {
+	for _, l := range Tiers {
+		if l.Tier == tier {
+			return l
+		}
+	}
+	return Tiers[0]
+}
+
+// CheckDeposit returns ErrDepositLimit when the amount on top of what was deposited today goes
+// over the daily limit.
+func (l TierLimits) CheckDeposit(used, amount money.Amount) error {
+	if l.DailyDeposit > 0 && used+amount > l.DailyDeposit {
+		return errors.WithMessagef(ErrDepositLimit, "%s of the %s tier %d limit is left today",
+			remaining(l.DailyDeposit, used), l.DailyDeposit, l.Tier)
+	}
+	return nil
+}
+
+// CheckWithdrawal returns ErrWithdrawalLimit when the amount on top of what was withdrawn today
+// goes over the daily limit.
+func (l TierLimits) CheckWithdrawal(used, amount money.Amount) error {
+	if l.DailyWithdrawal > 0 && used+amount > l.DailyWithdrawal {
+		return errors.WithMessagef(ErrWithdrawalLimit, "%s of the %s tier %d limit is left today",
+			remaining(l.DailyWithdrawal, used), l.DailyWithdrawal, l.Tier)
+	}
+	return nil
+}
+
+func remaining(limit, used money.Amount) money.Amount {
+	if used >= limit {
+		return 0
+	}
+	return limit - used
+}
+
+// EffectiveTier is the KYC tier whose limits apply to the customer. Only verified records lift a
+// customer off the first tier.
+func (m *Customer) EffectiveTier() int {
+	if m.KYCStatus != KYCStatus_Verified || m.KYCTier < 1 {
+		return 1
+	}
+	return m.KYCTier
+}
+
+// MissingForTier lists what the customer's records lack to be verified for the tier. The second
+// tier needs a BVN or NIN, a means of identification with its scanned copy and a passport photo,
+// the third tier also needs the date of birth, address, next of kin and a signature.
+func MissingForTier(c *Customer, docKinds []string, tier int) []string {
+	hasDoc := func(kind string) bool {
+		for _, k := range docKinds {
+			if k == kind {
+				return true
+			}
+		}
+		return false
+	}
+
+	var missing []string
+	if tier >= 2 {
+		if c.BVN == "" && c.NIN == "" {
+			missing = append(missing, "BVN or NIN")
+		}
+		if c.IDType == "" || c.IDNumber == "" {
+			missing = append(missing, "means of identification")
+		}
+		if !hasDoc(Document_IDCard) {
+			missing = append(missing, DocumentKindNames[Document_IDCard])
+		}
+		if !hasDoc(Document_PassportPhoto) {
+			missing = append(missing, DocumentKindNames[Document_PassportPhoto])
+		}
+	}
+	if tier >= 3 {
+		if c.DateOfBirth == nil {
+			missing = append(missing, "date of birth")
+		}
+		if c.Address == "" {
+			missing = append(missing, "address")
+		}
+		if c.NextOfKinName == "" || c.NextOfKinPhone == "" {
+			missing = append(missing, "next of kin")
+		}
+		if !hasDoc(Document_Signature) {
+			missing = append(missing, DocumentKindNames[Document_Signature])
+		}
+	}
+
+	return missing
+}
+
+// UpdateKYC saves the KYC details of a customer and puts the records up for verification.
+func (repo *Repository) UpdateKYC(ctx context.Context, claims auth.Claims, req KYCRequest, now time.Time) error {
+	span, ctx := tracer.StartSpanFromContext(ctx, "internal.customer.UpdateKYC")
+	defer span.Finish()
+
+	if claims.Audience == "" {
+		return errors.WithStack(ErrForbidden)
+	}
+
+	// Validate the request.
+	v := webcontext.Validator()
+	if err := v.Struct(req); err != nil {
+		return err
+	}
+
+	// If now empty set it to the current time.
+	if now.IsZero() {
+		now = time.Now()
+	}
+
+	// Always store the time as UTC.
+	now = now.UTC()
+	// Postgres truncates times to milliseconds when storing. We and do the same
+	// here so the value we return is consistent with what we store.
+	now = now.Truncate(time.Millisecond)
+
+	cols := models.M{
+		models.CustomerColumns.Gender:                req.Gender,
+		models.CustomerColumns.IDType:                req.IDType,
+		models.CustomerColumns.IDNumber:              strings.TrimSpace(req.IDNumber),
+		models.CustomerColumns.BVN:                   req.BVN,
+		models.CustomerColumns.Nin:                   req.NIN,
+		models.CustomerColumns.Occupation:            req.Occupation,
+		models.CustomerColumns.NextOfKinName:         req.NextOfKinName,
+		models.CustomerColumns.NextOfKinPhone:        req.NextOfKinPhone,
+		models.CustomerColumns.NextOfKinRelationship: req.NextOfKinRelationship,
+		models.CustomerColumns.KycStatus:             KYCStatus_Pending,
+		models.CustomerColumns.UpdatedAt:             now.Unix(),
+	}
+	if req.DateOfBirth != nil && !req.DateOfBirth.IsZero() {
+		cols[models.CustomerColumns.DateOfBirth] = null.Int64From(dateOfBirth(*req.DateOfBirth).Unix())
+	} else {
+		cols[models.CustomerColumns.DateOfBirth] = null.Int64{}
+	}
+
+	n, err := models.Customers(models.CustomerWhere.ID.EQ(req.ID)).UpdateAll(ctx, repo.DbConn, cols)
+	if err != nil {
+		return weberror.NewError(ctx, err, http.StatusInternalServerError)
+	}
+	if n == 0 {
+		return weberror.NewErrorMessage(ctx, errors.WithStack(ErrNotFound), http.StatusNotFound, "Invalid customer ID")
+	}
+
+	return nil
+}
+
+// dateOfBirth returns the day of the date in UTC so it reads the same in every timezone.
+func dateOfBirth(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+// UploadDocument scales down and stores a scanned document or photo of a customer. A new upload
+// replaces the document of the same kind and puts the records up for verification.
+func (repo *Repository) UploadDocument(ctx context.Context, claims auth.Claims, req DocumentUploadRequest, now time.Time) (*Document, error) {
+	span, ctx := tracer.StartSpanFromContext(ctx, "internal.customer.UploadDocument")
+	defer span.Finish()
+
+	if claims.Audience == "" {
+		return nil, errors.WithStack(ErrForbidden)
+	}
+
+	// Validate the request.
+	v := webcontext.Validator()
+	if err := v.Struct(req); err != nil {
+		return nil, err
+	}
+
+	// If now empty set it to the current time.
+	if now.IsZero() {
+		now = time.Now()
+	}
+
+	// Always store the time as UTC.
+	now = now.UTC()
+	// Postgres truncates times to milliseconds when storing. We and do the same
+	// here so the value we return is consistent with what we store.
+	now = now.Truncate(time.Millisecond)
+
+	exists, err := models.CustomerExists(ctx, repo.DbConn, req.CustomerID)
+	if err != nil {
+		return nil, weberror.NewError(ctx, err, http.StatusInternalServerError)
+	}
+	if !exists {
+		return nil, weberror.NewErrorMessage(ctx, errors.WithStack(ErrNotFound), http.StatusNotFound, "Invalid customer ID")
+	}
+
+	dat, contentType, err := imgresize.ResizeImage(req.Data, DocumentMaxWidth)
+	if err != nil {
+		return nil, weberror.NewError(ctx, err, http.StatusBadRequest)
+	}
+
+	ext := strings.TrimPrefix(contentType, "image/")
+	if ext == "jpeg" {
+		ext = "jpg"
+	}
+	key := fmt.Sprintf("customers/%s/%s-%s.%s", req.CustomerID, req.Kind, uuid.NewRandom().String(), ext)
+	if err := repo.Blobs.Put(ctx, key, contentType, dat); err != nil {
+		return nil, errors.WithMessage(err, "Cannot store document")
+	}
+
+	tx, err := repo.DbConn.Begin()
+	if err != nil {
+		_ = repo.Blobs.Delete(ctx, key)
+		return nil, err
+	}
+
+	m, err := models.CustomerDocuments(
+		models.CustomerDocumentWhere.CustomerID.EQ(req.CustomerID),
+		models.CustomerDocumentWhere.Kind.EQ(req.Kind),
+		For("UPDATE"),
+	).One(ctx, tx)
+	var oldKey string
+	switch {
+	case err == nil:
+		oldKey = m.BlobKey
+		m.BlobKey = key
+		m.ContentType = contentType
+		m.UploadedByID = claims.Subject
+		m.CreatedAt = now.Unix()
+		_, err = m.Update(ctx, tx, boil.Infer())
+	case errors.Cause(err) == sql.ErrNoRows:
+		m = &models.CustomerDocument{
+			ID:           uuid.NewRandom().String(),
+			CustomerID:   req.CustomerID,
+			Kind:         req.Kind,
+			BlobKey:      key,
+			ContentType:  contentType,
+			UploadedByID: claims.Subject,
+			CreatedAt:    now.Unix(),
+		}
+		err = m.Insert(ctx, tx, boil.Infer())
+	}
+	if err == nil {
+		_, err = models.Customers(models.CustomerWhere.ID.EQ(req.CustomerID)).UpdateAll(ctx, tx, models.M{
+			models.CustomerColumns.KycStatus: KYCStatus_Pending,
+			models.CustomerColumns.UpdatedAt: now.Unix(),
+		})
+	}
+	if err != nil {
+		_ = tx.Rollback()
+		_ = repo.Blobs.Delete(ctx, key)
+		return nil, errors.WithMessage(err, "Save document failed")
+	}
+	if err = tx.Commit(); err != nil {
+		_ = repo.Blobs.Delete(ctx, key)
+		return nil, err
+	}
+
+	if oldKey != "" {
+		// The replaced file is no longer referenced, failing to remove it only wastes space.
+		_ = repo.Blobs.Delete(ctx, oldKey)
+	}
+
+	return DocumentFromModel(m), nil
+}
+
+// FindDocuments gets the documents uploaded for a customer.
+func (repo *Repository) FindDocuments(ctx context.Context, claims auth.Claims, customerID string) (Documents, error) {
+	span, ctx := tracer.StartSpanFromContext(ctx, "internal.customer.FindDocuments")
+	defer span.Finish()
+
+	if claims.Audience == "" {
+		return nil, errors.WithStack(ErrForbidden)
+	}
+
+	recs, err := models.CustomerDocuments(
+		models.CustomerDocumentWhere.CustomerID.EQ(customerID),
+		Load(models.CustomerDocumentRels.UploadedBy),
+		OrderBy(models.CustomerDocumentColumns.Kind),
+	).All(ctx, repo.DbConn)
+	if err != nil {
+		return nil, weberror.NewError(ctx, err, http.StatusInternalServerError)
+	}
+
+	var res Documents
+	for _, rec := range recs {
+		res = append(res, DocumentFromModel(rec))
+	}
+
+	return res, nil
+}
+
+// ReadDocument gets the document of the kind uploaded for a customer together with the file.
+func (repo *Repository) ReadDocument(ctx context.Context, claims auth.Claims, customerID, kind string) (*Document, []byte, error) {
+	span, ctx := tracer.StartSpanFromContext(ctx, "internal.customer.ReadDocument")
+	defer span.Finish()
+
+	if claims.Audience == "" {
+		return nil, nil, errors.WithStack(ErrForbidden)
+	}
+
+	rec, err := models.CustomerDocuments(
+		models.CustomerDocumentWhere.CustomerID.EQ(customerID),
+		models.CustomerDocumentWhere.Kind.EQ(kind),
+	).One(ctx, repo.DbConn)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, nil, weberror.NewErrorMessage(ctx, err, http.StatusNotFound, "Document not found")
+		}
+		return nil, nil, weberror.NewError(ctx, err, http.StatusInternalServerError)
+	}
+
+	dat, err := repo.Blobs.Get(ctx, rec.BlobKey)
+	if err != nil {
+		return nil, nil, weberror.NewErrorMessage(ctx, err, http.StatusNotFound, "Document not found")
+	}
+
+	return DocumentFromModel(rec), dat, nil
+}
+
+// VerifyKYC verifies the KYC records of a customer for a tier or rejects them. Only supervisors can
+// verify records and only for a tier the records are complete for.
+func (repo *Repository) VerifyKYC(ctx context.Context, claims auth.Claims, req VerifyKYCRequest, now time.Time) error {
+	span, ctx := tracer.StartSpanFromContext(ctx, "internal.customer.VerifyKYC")
+	defer span.Finish()
+
+	if claims.Audience == "" || !claims.HasRole(auth.RoleAdmin, auth.RoleSuperAdmin) {
+		return errors.WithStack(ErrForbidden)
+	}
+
+	// Validate the request.
+	v := webcontext.Validator()
+	if err := v.Struct(req); err != nil {
+		return err
+	}
+	if req.Status == KYCStatus_Verified && req.Tier == 0 {
+		return weberror.NewErrorMessage(ctx, errors.New("tier required"), http.StatusBadRequest, "Select the tier to verify the customer for")
+	}
+
+	// If now empty set it to the current time.
+	if now.IsZero() {
+		now = time.Now()
+	}
+
+	// Always store the time as UTC.
+	now = now.UTC()
+	// Postgres truncates times to milliseconds when storing. We and do the same
+	// here so the value we return is consistent with what we store.
+	now = now.Truncate(time.Millisecond)
+
+	rec, err := models.FindCustomer(ctx, repo.DbConn, req.ID)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return weberror.NewErrorMessage(ctx, err, http.StatusNotFound, "Invalid customer ID")
+		}
+		return weberror.NewError(ctx, err, http.StatusInternalServerError)
+	}
+
+	cols := models.M{
+		models.CustomerColumns.KycStatus:       req.Status,
+		models.CustomerColumns.KycNote:         req.Note,
+		models.CustomerColumns.KycVerifiedAt:   null.Int64From(now.Unix()),
+		models.CustomerColumns.KycVerifiedByID: null.StringFrom(claims.Subject),
+		models.CustomerColumns.UpdatedAt:       now.Unix(),
+	}
+
+	if req.Status == KYCStatus_Verified {
+		docs, err := repo.FindDocuments(ctx, claims, req.ID)
+		if err != nil {
+			return err
+		}
+		if missing := MissingForTier(FromModel(rec), docs.Kinds(), req.Tier); len(missing) > 0 {
+			err = errors.WithMessagef(ErrKYCIncomplete, "missing %s", strings.Join(missing, ", "))
+			return weberror.NewErrorMessage(ctx, err, http.StatusBadRequest,
+				fmt.Sprintf("The customer cannot be verified for tier %d without the %s", req.Tier, strings.Join(missing, ", ")))
+		}
+		cols[models.CustomerColumns.KycTier] = req.Tier
+	} else {
+		cols[models.CustomerColumns.KycTier] = 1
+	}
+
+	if _, err = models.Customers(models.CustomerWhere.ID.EQ(req.ID)).UpdateAll(ctx, repo.DbConn, cols); err != nil {
+		return weberror.NewError(ctx, err, http.StatusInternalServerError)
+	}
+
+	return nil
+}
+
+const kycUsedStatement = `SELECT COALESCE(SUM(tx.amount), 0)
+	FROM transaction tx
+	INNER JOIN account a ON a.id = tx.account_id
+	WHERE a.customer_id = $1 AND tx.tx_type = $2 AND tx.archived_at IS NULL AND tx.created_at >= $3
+	AND tx.transfer_id IS NULL AND tx.reversal_of_id IS NULL AND tx.payment_method <> 'opening_balance'
+	AND NOT EXISTS (SELECT 1 FROM transaction r WHERE r.reversal_of_id = tx.id)`
+
+// DailyUsed returns how much of the transaction type the customer has moved across all their
+// accounts on the day of the date. Transfers between accounts, opening balances and reversed
+// transactions along with their reversals are not counted.
+func DailyUsed(ctx context.Context, exec boil.ContextExecutor, customerID, txType string, date time.Time) (money.Amount, error) {
+	var used int64
+	start := now.New(date).BeginningOfDay().Unix()
+	if err := exec.QueryRowContext(ctx, kycUsedStatement, customerID, txType, start).Scan(&used); err != nil {
+		return 0, errors.WithMessage(err, "Cannot read the customer's transactions today")
+	}
+	return money.Amount(used), nil
+}
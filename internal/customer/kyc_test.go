@@ -0,0 +1,134 @@
+package customer
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"merryworld/surebank/internal/platform/money"
+	"merryworld/surebank/internal/platform/tests"
+)
+
+// TestEffectiveTier validates only verified records lift a customer off the first tier.
+func TestEffectiveTier(t *testing.T) {
+	type tierTest struct {
+		Status string
+		Tier   int
+		Want   int
+	}
+
+	var tierTests = []tierTest{
+		{KYCStatus_Unverified, 1, 1},
+		{KYCStatus_Pending, 3, 1},
+		{KYCStatus_Rejected, 2, 1},
+		{KYCStatus_Verified, 2, 2},
+		{KYCStatus_Verified, 3, 3},
+		{KYCStatus_Verified, 0, 1},
+	}
+
+	t.Log("Given the need to know which KYC tier limits apply to a customer.")
+	{
+		for i, tt := range tierTests {
+			t.Logf("\tTest: %d\tWhen the records are %s for tier %d.", i, tt.Status, tt.Tier)
+			{
+				c := &Customer{KYCStatus: tt.Status, KYCTier: tt.Tier}
+				if got := c.EffectiveTier(); got != tt.Want {
+					t.Logf("\t\tGot : %d", got)
+					t.Logf("\t\tWant: %d", tt.Want)
+					t.Fatalf("\t%s\tShould get the expected tier.", tests.Failed)
+				}
+				t.Logf("\t%s\tShould get the expected tier.", tests.Success)
+			}
+		}
+	}
+}
+
+// TestMissingForTier validates the records a customer needs for each tier.
+func TestMissingForTier(t *testing.T) {
+	dob := time.Date(1980, 5, 12, 0, 0, 0, 0, time.UTC)
+	tier2 := &Customer{NIN: "12345678901", IDType: IDType_NationalID, IDNumber: "A123"}
+	tier3 := &Customer{BVN: "22123456789", IDType: IDType_Passport, IDNumber: "B456", DateOfBirth: &dob,
+		Address: "No 3 Ab Rd, Agege, Lagos", NextOfKinName: "Ade Dami", NextOfKinPhone: "0809000000"}
+
+	type missingTest struct {
+		Name     string
+		Customer *Customer
+		Docs     []string
+		Tier     int
+		Missing  []string
+	}
+
+	var missingTests = []missingTest{
+		{"no records for tier 1", &Customer{}, nil, 1, nil},
+		{"no records for tier 2", &Customer{}, nil, 2,
+			[]string{"BVN or NIN", "means of identification", "ID card", "Passport photo"}},
+		{"complete tier 2", tier2, []string{Document_IDCard, Document_PassportPhoto}, 2, nil},
+		{"tier 2 records for tier 3", tier2, []string{Document_IDCard, Document_PassportPhoto}, 3,
+			[]string{"date of birth", "address", "next of kin", "Signature"}},
+		{"complete tier 3", tier3, DocumentKinds, 3, nil},
+	}
+
+	t.Log("Given the need to verify a customer only with complete KYC records.")
+	{
+		for i, tt := range missingTests {
+			t.Logf("\tTest: %d\tWhen checking %s.", i, tt.Name)
+			{
+				got := strings.Join(MissingForTier(tt.Customer, tt.Docs, tt.Tier), ", ")
+				want := strings.Join(tt.Missing, ", ")
+				if got != want {
+					t.Logf("\t\tGot : %s", got)
+					t.Logf("\t\tWant: %s", want)
+					t.Fatalf("\t%s\tShould list what is missing.", tests.Failed)
+				}
+				t.Logf("\t%s\tShould list what is missing.", tests.Success)
+			}
+		}
+	}
+}
+
+// TestTierLimits validates deposits and withdrawals are capped by the daily limits of the tier.
+func TestTierLimits(t *testing.T) {
+	type limitTest struct {
+		Name       string
+		Tier       int
+		Withdrawal bool
+		Used       money.Amount
+		Amount     money.Amount
+		Err        error
+	}
+
+	var limitTests = []limitTest{
+		{"deposit within tier 1", 1, false, money.Naira(40000), money.Naira(10000), nil},
+		{"deposit over tier 1", 1, false, money.Naira(40000), money.Naira(10001), ErrDepositLimit},
+		{"withdrawal within tier 1", 1, true, 0, money.Naira(20000), nil},
+		{"withdrawal over tier 1", 1, true, money.Naira(15000), money.Naira(5001), ErrWithdrawalLimit},
+		{"deposit over tier 1 on tier 2", 2, false, 0, money.Naira(60000), nil},
+		{"withdrawal over tier 2", 2, true, money.Naira(100000), money.Kobo(1), ErrWithdrawalLimit},
+		{"anything on tier 3", 3, true, money.Naira(1000000), money.Naira(1000000), nil},
+		{"unknown tier", 9, false, 0, money.Naira(50001), ErrDepositLimit},
+	}
+
+	t.Log("Given the need to cap how much a customer moves a day by their KYC tier.")
+	{
+		for i, tt := range limitTests {
+			t.Logf("\tTest: %d\tWhen checking a %s.", i, tt.Name)
+			{
+				l := Limits(tt.Tier)
+				var err error
+				if tt.Withdrawal {
+					err = l.CheckWithdrawal(tt.Used, tt.Amount)
+				} else {
+					err = l.CheckDeposit(tt.Used, tt.Amount)
+				}
+				if errors.Cause(err) != tt.Err {
+					t.Logf("\t\tGot : %v", err)
+					t.Logf("\t\tWant: %v", tt.Err)
+					t.Fatalf("\t%s\tShould get the expected error.", tests.Failed)
+				}
+				t.Logf("\t%s\tShould get the expected error.", tests.Success)
+			}
+		}
+	}
+}
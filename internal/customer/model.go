@@ -6,6 +6,8 @@ import (
 	"strings"
 	"time"
 
+	"merryworld/surebank/internal/platform/blob"
+	"merryworld/surebank/internal/platform/money"
 	"merryworld/surebank/internal/platform/web"
 	"merryworld/surebank/internal/postgres/models"
 
@@ -15,24 +17,23 @@ import (
 // Repository defines the required dependencies for Customer.
 type Repository struct {
 	DbConn *sqlx.DB
+	Blobs  blob.Store
 }
 
+// The codes of the account products seeded by the schema migrations. Other products are managed
+// from the account_product package.
 var (
 	AccountTypeSB = "SB"
 	AccountTypeDS = "DS"
 	AccountTypeSF = "SF"
-	
-	AccountTypes = []string{
-		AccountTypeSB,
-		AccountTypeDS,
-		AccountTypeSF,
-	}
 )
 
-// NewRepository creates a new Repository that defines dependencies for Customer.
-func NewRepository(db *sqlx.DB) *Repository {
+// NewRepository creates a new Repository that defines dependencies for Customer. KYC documents
+// are kept in the blob store.
+func NewRepository(db *sqlx.DB, blobs blob.Store) *Repository {
 	return &Repository{
 		DbConn: db,
+		Blobs:  blobs,
 	}
 }
 
@@ -51,6 +52,26 @@ type Customer struct {
 	CreatedAt   time.Time  `json:"created_at" truss:"api-read"`
 	UpdatedAt   time.Time  `json:"updated_at" truss:"api-read"`
 	ArchivedAt  *time.Time `json:"archived_at,omitempty" truss:"api-hide"`
+
+	// DateOfBirth is the day the customer was born, as picked in UTC.
+	DateOfBirth           *time.Time `json:"date_of_birth,omitempty"`
+	Gender                string     `json:"gender"`
+	IDType                string     `json:"id_type"`
+	IDNumber              string     `json:"id_number"`
+	BVN                   string     `json:"bvn"`
+	NIN                   string     `json:"nin"`
+	Occupation            string     `json:"occupation"`
+	NextOfKinName         string     `json:"next_of_kin_name"`
+	NextOfKinPhone        string     `json:"next_of_kin_phone"`
+	NextOfKinRelationship string     `json:"next_of_kin_relationship"`
+	KYCTier               int        `json:"kyc_tier"`
+	KYCStatus             string     `json:"kyc_status"`
+	KYCNote               string     `json:"kyc_note"`
+	KYCVerifiedAt         *time.Time `json:"kyc_verified_at,omitempty"`
+	KYCVerifiedByID       *string    `json:"kyc_verified_by_id,omitempty"`
+
+	// MergedIntoID is the customer this one was merged into as a duplicate.
+	MergedIntoID *string `json:"merged_into_id,omitempty"`
 }
 
 func FromModel(rec *models.Customer) *Customer {
@@ -65,6 +86,31 @@ func FromModel(rec *models.Customer) *Customer {
 		SalesRepID:  rec.SalesRepID,
 		CreatedAt:   time.Unix(rec.CreatedAt, 0),
 		UpdatedAt:   time.Unix(rec.UpdatedAt, 0),
+
+		Gender:                rec.Gender,
+		IDType:                rec.IDType,
+		IDNumber:              rec.IDNumber,
+		BVN:                   rec.BVN,
+		NIN:                   rec.Nin,
+		Occupation:            rec.Occupation,
+		NextOfKinName:         rec.NextOfKinName,
+		NextOfKinPhone:        rec.NextOfKinPhone,
+		NextOfKinRelationship: rec.NextOfKinRelationship,
+		KYCTier:               rec.KycTier,
+		KYCStatus:             rec.KycStatus,
+		KYCNote:               rec.KycNote,
+		KYCVerifiedByID:       rec.KycVerifiedByID.Ptr(),
+		MergedIntoID:          rec.MergedIntoID.Ptr(),
+	}
+
+	if rec.DateOfBirth.Valid {
+		dateOfBirth := time.Unix(rec.DateOfBirth.Int64, 0).UTC()
+		c.DateOfBirth = &dateOfBirth
+	}
+
+	if rec.KycVerifiedAt.Valid {
+		verifiedAt := time.Unix(rec.KycVerifiedAt.Int64, 0)
+		c.KYCVerifiedAt = &verifiedAt
 	}
 
 	if rec.R != nil {
@@ -109,6 +155,26 @@ type Response struct {
 	CreatedAt   web.TimeResponse  `json:"created_at"`            // CreatedAt contains multiple format options for display.
 	UpdatedAt   web.TimeResponse  `json:"updated_at"`            // UpdatedAt contains multiple format options for display.
 	ArchivedAt  *web.TimeResponse `json:"archived_at,omitempty"` // ArchivedAt contains multiple format options for display.
+
+	// DateOfBirth is formatted as a date, web.TimeResponse leaves dates before 1987 empty.
+	DateOfBirth           string            `json:"date_of_birth,omitempty" example:"1990-06-25"`
+	Gender                string            `json:"gender"`
+	IDType                string            `json:"id_type"`
+	IDNumber              string            `json:"id_number"`
+	BVN                   string            `json:"bvn"`
+	NIN                   string            `json:"nin"`
+	Occupation            string            `json:"occupation"`
+	NextOfKinName         string            `json:"next_of_kin_name"`
+	NextOfKinPhone        string            `json:"next_of_kin_phone"`
+	NextOfKinRelationship string            `json:"next_of_kin_relationship"`
+	KYCTier               int               `json:"kyc_tier"`
+	KYCStatus             string            `json:"kyc_status"`
+	KYCNote               string            `json:"kyc_note"`
+	KYCVerifiedAt         *web.TimeResponse `json:"kyc_verified_at,omitempty"`
+	// EffectiveTier is the tier whose limits apply to the customer.
+	EffectiveTier int `json:"effective_tier"`
+
+	MergedIntoID string `json:"merged_into_id,omitempty"`
 }
 
 // Response transforms Customer to the Response that is used for display.
@@ -131,6 +197,33 @@ func (m *Customer) Response(ctx context.Context) *Response {
 		Branch:      m.Branch,
 		CreatedAt:   web.NewTimeResponse(ctx, m.CreatedAt),
 		UpdatedAt:   web.NewTimeResponse(ctx, m.UpdatedAt),
+
+		Gender:                m.Gender,
+		IDType:                m.IDType,
+		IDNumber:              m.IDNumber,
+		BVN:                   m.BVN,
+		NIN:                   m.NIN,
+		Occupation:            m.Occupation,
+		NextOfKinName:         m.NextOfKinName,
+		NextOfKinPhone:        m.NextOfKinPhone,
+		NextOfKinRelationship: m.NextOfKinRelationship,
+		KYCTier:               m.KYCTier,
+		KYCStatus:             m.KYCStatus,
+		KYCNote:               m.KYCNote,
+		EffectiveTier:         m.EffectiveTier(),
+	}
+
+	if m.MergedIntoID != nil {
+		r.MergedIntoID = *m.MergedIntoID
+	}
+
+	if m.DateOfBirth != nil {
+		r.DateOfBirth = m.DateOfBirth.UTC().Format("2006-01-02")
+	}
+
+	if m.KYCVerifiedAt != nil {
+		va := web.NewTimeResponse(ctx, *m.KYCVerifiedAt)
+		r.KYCVerifiedAt = &va
 	}
 
 	if m.ArchivedAt != nil && !m.ArchivedAt.IsZero() {
@@ -171,9 +264,13 @@ type CreateRequest struct {
 	SalesRepID  string `json:"sales_rep_id" validate:"required,uuid" example:"985f1746-1d9f-459f-a2d9-fc53ece5ae86"`
 	BranchID    string `json:"branch_id" validate:"required,uuid" example:"985f1746-1d9f-459f-a2d9-fc53ece5ae86"`
 
-	Type       string  `json:"type" validate:"required"`
-	Target     float64 `json:"target"`
-	TargetInfo string  `json:"target_info"`
+	Type       string       `json:"type" validate:"required"`
+	Target     money.Amount `json:"target"`
+	TargetInfo string       `json:"target_info"`
+
+	// IgnoreDuplicates creates the customer even when it looks like a customer that is already
+	// registered.
+	IgnoreDuplicates bool `json:"ignore_duplicates" example:"false"`
 }
 
 // ReadRequest defines the information needed to read a customer.
@@ -221,3 +318,322 @@ type FindRequest struct {
 	IncludeAccountNo bool          `json:"include-account-no" example:"false"`
 	IncludeBranch    bool          `json:"include-branch" example:"false"`
 }
+
+// The genders a customer can be recorded with.
+const (
+	Gender_Female = "female"
+	Gender_Male   = "male"
+)
+
+// Genders is the list of genders a customer can be recorded with.
+var Genders = []string{Gender_Female, Gender_Male}
+
+// The means of identification accepted for KYC.
+const (
+	IDType_NationalID     = "national_id"
+	IDType_DriversLicence = "drivers_licence"
+	IDType_Passport       = "passport"
+	IDType_VotersCard     = "voters_card"
+)
+
+// IDTypes is the list of means of identification accepted for KYC.
+var IDTypes = []string{IDType_NationalID, IDType_DriversLicence, IDType_Passport, IDType_VotersCard}
+
+// IDTypeNames are the display names of the IDTypes.
+var IDTypeNames = map[string]string{
+	IDType_NationalID:     "National ID card",
+	IDType_DriversLicence: "Driver's licence",
+	IDType_Passport:       "International passport",
+	IDType_VotersCard:     "Voter's card",
+}
+
+// The verification statuses of the KYC records of a customer.
+const (
+	KYCStatus_Unverified = "unverified"
+	KYCStatus_Pending    = "pending"
+	KYCStatus_Verified   = "verified"
+	KYCStatus_Rejected   = "rejected"
+)
+
+// The kinds of documents that can be uploaded for a customer.
+const (
+	Document_IDCard        = "id_card"
+	Document_PassportPhoto = "passport_photo"
+	Document_Signature     = "signature"
+)
+
+// DocumentKinds is the list of the kinds of documents that can be uploaded for a customer.
+var DocumentKinds = []string{Document_IDCard, Document_PassportPhoto, Document_Signature}
+
+// DocumentKindNames are the display names of the DocumentKinds.
+var DocumentKindNames = map[string]string{
+	Document_IDCard:        "ID card",
+	Document_PassportPhoto: "Passport photo",
+	Document_Signature:     "Signature",
+}
+
+// TierLimits are the most a customer on the KYC tier can deposit and withdraw a day across all
+// their accounts. A zero limit means there is no limit.
+type TierLimits struct {
+	Tier            int          `json:"tier"`
+	DailyDeposit    money.Amount `json:"daily_deposit"`
+	DailyWithdrawal money.Amount `json:"daily_withdrawal"`
+}
+
+// Document is a file such as a scanned ID card kept for a customer.
+type Document struct {
+	ID           string    `json:"id"`
+	CustomerID   string    `json:"customer_id"`
+	Kind         string    `json:"kind"`
+	BlobKey      string    `json:"blob_key"`
+	ContentType  string    `json:"content_type"`
+	UploadedByID string    `json:"uploaded_by_id"`
+	UploadedBy   string    `json:"uploaded_by,omitempty"`
+	CreatedAt    time.Time `json:"created_at"`
+}
+
+// DocumentFromModel transforms the customer document model to Document.
+func DocumentFromModel(rec *models.CustomerDocument) *Document {
+	d := &Document{
+		ID:           rec.ID,
+		CustomerID:   rec.CustomerID,
+		Kind:         rec.Kind,
+		BlobKey:      rec.BlobKey,
+		ContentType:  rec.ContentType,
+		UploadedByID: rec.UploadedByID,
+		CreatedAt:    time.Unix(rec.CreatedAt, 0),
+	}
+
+	if rec.R != nil && rec.R.UploadedBy != nil {
+		d.UploadedBy = fmt.Sprintf("%s %s", rec.R.UploadedBy.FirstName, rec.R.UploadedBy.LastName)
+	}
+
+	return d
+}
+
+// DocumentResponse represents a customer document that is returned for display.
+type DocumentResponse struct {
+	ID         string           `json:"id"`
+	CustomerID string           `json:"customer_id"`
+	Kind       string           `json:"kind"`
+	KindName   string           `json:"kind_name"`
+	UploadedBy string           `json:"uploaded_by"`
+	CreatedAt  web.TimeResponse `json:"created_at"`
+}
+
+// Response transforms Document to the DocumentResponse that is used for display.
+func (m *Document) Response(ctx context.Context) *DocumentResponse {
+	if m == nil {
+		return nil
+	}
+
+	return &DocumentResponse{
+		ID:         m.ID,
+		CustomerID: m.CustomerID,
+		Kind:       m.Kind,
+		KindName:   DocumentKindNames[m.Kind],
+		UploadedBy: m.UploadedBy,
+		CreatedAt:  web.NewTimeResponse(ctx, m.CreatedAt),
+	}
+}
+
+// Documents a list of Documents.
+type Documents []*Document
+
+// Response transforms a list of Documents to a list of DocumentResponses.
+func (m *Documents) Response(ctx context.Context) []*DocumentResponse {
+	var l = make([]*DocumentResponse, 0)
+	if m != nil && len(*m) > 0 {
+		for _, n := range *m {
+			l = append(l, n.Response(ctx))
+		}
+	}
+
+	return l
+}
+
+// Kinds returns the kinds of the documents.
+func (m Documents) Kinds() []string {
+	var kinds []string
+	for _, d := range m {
+		kinds = append(kinds, d.Kind)
+	}
+	return kinds
+}
+
+// KYCRequest defines the KYC details captured for a customer. Saving them puts the customer's
+// records up for verification.
+type KYCRequest struct {
+	ID                    string     `json:"id" validate:"required,uuid" example:"985f1746-1d9f-459f-a2d9-fc53ece5ae86"`
+	DateOfBirth           *time.Time `json:"date_of_birth"`
+	Gender                string     `json:"gender" validate:"omitempty,oneof=female male"`
+	IDType                string     `json:"id_type" validate:"omitempty,oneof=national_id drivers_licence passport voters_card"`
+	IDNumber              string     `json:"id_number" validate:"required_with=IDType"`
+	BVN                   string     `json:"bvn" validate:"omitempty,numeric,len=11" example:"22123456789"`
+	NIN                   string     `json:"nin" validate:"omitempty,numeric,len=11" example:"12345678901"`
+	Occupation            string     `json:"occupation" example:"Trader"`
+	NextOfKinName         string     `json:"next_of_kin_name" example:"Ade Dami"`
+	NextOfKinPhone        string     `json:"next_of_kin_phone" example:"0809000000"`
+	NextOfKinRelationship string     `json:"next_of_kin_relationship" example:"Brother"`
+}
+
+// VerifyKYCRequest defines the information needed to verify or reject the KYC records of a
+// customer. Verified customers are moved to the tier, which is required when verifying.
+type VerifyKYCRequest struct {
+	ID     string `json:"id" validate:"required,uuid" example:"985f1746-1d9f-459f-a2d9-fc53ece5ae86"`
+	Status string `json:"status" validate:"required,oneof=verified rejected" example:"verified"`
+	Tier   int    `json:"tier" validate:"omitempty,min=1,max=3" example:"2"`
+	Note   string `json:"note" example:"BVN matches the ID card"`
+}
+
+// DocumentUploadRequest defines the information needed to upload a document for a customer.
+type DocumentUploadRequest struct {
+	CustomerID string `json:"customer_id" validate:"required,uuid" example:"985f1746-1d9f-459f-a2d9-fc53ece5ae86"`
+	Kind       string `json:"kind" validate:"required,oneof=id_card passport_photo signature" example:"passport_photo"`
+	Data       []byte `json:"-" validate:"required"`
+}
+
+// Duplicate is a registered customer that looks like the same person as another.
+type Duplicate struct {
+	Customer *Customer `json:"customer"`
+	// Reasons are why the customer looks like the same person.
+	Reasons []string `json:"reasons"`
+	// Similarity is how alike the names are, from 0 to 1.
+	Similarity float64 `json:"similarity"`
+}
+
+// DuplicateResponse represents a duplicate customer that is returned for display.
+type DuplicateResponse struct {
+	Customer   *Response `json:"customer"`
+	Reasons    []string  `json:"reasons"`
+	Similarity int       `json:"similarity" example:"92"` // Similarity of the names as a percentage.
+}
+
+// Response transforms Duplicate to the DuplicateResponse that is used for display.
+func (m *Duplicate) Response(ctx context.Context) *DuplicateResponse {
+	if m == nil {
+		return nil
+	}
+
+	return &DuplicateResponse{
+		Customer:   m.Customer.Response(ctx),
+		Reasons:    m.Reasons,
+		Similarity: int(m.Similarity * 100),
+	}
+}
+
+// Duplicates a list of Duplicates.
+type Duplicates []*Duplicate
+
+// Response transforms a list of Duplicates to a list of DuplicateResponses.
+func (m *Duplicates) Response(ctx context.Context) []*DuplicateResponse {
+	var l = make([]*DuplicateResponse, 0)
+	if m != nil && len(*m) > 0 {
+		for _, n := range *m {
+			l = append(l, n.Response(ctx))
+		}
+	}
+
+	return l
+}
+
+// Merge is the audit record of a duplicate customer merged into the surviving one.
+type Merge struct {
+	ID             string    `json:"id"`
+	SurvivorID     string    `json:"survivor_id"`
+	DuplicateID    string    `json:"duplicate_id"`
+	Duplicate      string    `json:"duplicate,omitempty"`
+	Reason         string    `json:"reason"`
+	AccountsMoved  int       `json:"accounts_moved"`
+	LoansMoved     int       `json:"loans_moved"`
+	DocumentsMoved int       `json:"documents_moved"`
+	SalesMoved     int       `json:"sales_moved"`
+	MergedByID     string    `json:"merged_by_id"`
+	MergedBy       string    `json:"merged_by,omitempty"`
+	CreatedAt      time.Time `json:"created_at"`
+}
+
+// MergeFromModel transforms the customer merge model to Merge.
+func MergeFromModel(rec *models.CustomerMerge) *Merge {
+	m := &Merge{
+		ID:             rec.ID,
+		SurvivorID:     rec.SurvivorID,
+		DuplicateID:    rec.DuplicateID,
+		Reason:         rec.Reason,
+		AccountsMoved:  rec.AccountsMoved,
+		LoansMoved:     rec.LoansMoved,
+		DocumentsMoved: rec.DocumentsMoved,
+		SalesMoved:     rec.SalesMoved,
+		MergedByID:     rec.MergedByID,
+		CreatedAt:      time.Unix(rec.CreatedAt, 0),
+	}
+
+	if rec.R != nil {
+		if rec.R.Duplicate != nil {
+			m.Duplicate = rec.R.Duplicate.Name
+		}
+		if rec.R.MergedBy != nil {
+			m.MergedBy = fmt.Sprintf("%s %s", rec.R.MergedBy.FirstName, rec.R.MergedBy.LastName)
+		}
+	}
+
+	return m
+}
+
+// MergeResponse represents a customer merge that is returned for display.
+type MergeResponse struct {
+	ID             string           `json:"id"`
+	SurvivorID     string           `json:"survivor_id"`
+	DuplicateID    string           `json:"duplicate_id"`
+	Duplicate      string           `json:"duplicate"`
+	Reason         string           `json:"reason"`
+	AccountsMoved  int              `json:"accounts_moved"`
+	LoansMoved     int              `json:"loans_moved"`
+	DocumentsMoved int              `json:"documents_moved"`
+	SalesMoved     int              `json:"sales_moved"`
+	MergedBy       string           `json:"merged_by"`
+	CreatedAt      web.TimeResponse `json:"created_at"`
+}
+
+// Response transforms Merge to the MergeResponse that is used for display.
+func (m *Merge) Response(ctx context.Context) *MergeResponse {
+	if m == nil {
+		return nil
+	}
+
+	return &MergeResponse{
+		ID:             m.ID,
+		SurvivorID:     m.SurvivorID,
+		DuplicateID:    m.DuplicateID,
+		Duplicate:      m.Duplicate,
+		Reason:         m.Reason,
+		AccountsMoved:  m.AccountsMoved,
+		LoansMoved:     m.LoansMoved,
+		DocumentsMoved: m.DocumentsMoved,
+		SalesMoved:     m.SalesMoved,
+		MergedBy:       m.MergedBy,
+		CreatedAt:      web.NewTimeResponse(ctx, m.CreatedAt),
+	}
+}
+
+// Merges a list of Merges.
+type Merges []*Merge
+
+// Response transforms a list of Merges to a list of MergeResponses.
+func (m *Merges) Response(ctx context.Context) []*MergeResponse {
+	var l = make([]*MergeResponse, 0)
+	if m != nil && len(*m) > 0 {
+		for _, n := range *m {
+			l = append(l, n.Response(ctx))
+		}
+	}
+
+	return l
+}
+
+// MergeRequest defines the information needed to merge a duplicate customer into the surviving one.
+type MergeRequest struct {
+	SurvivorID  string `json:"survivor_id" validate:"required,uuid" example:"985f1746-1d9f-459f-a2d9-fc53ece5ae86"`
+	DuplicateID string `json:"duplicate_id" validate:"required,uuid,nefield=SurvivorID" example:"7a0c9b1e-3f6d-4a44-a7f5-0fbd2a1e9c10"`
+	Reason      string `json:"reason" validate:"required,max=500" example:"Re-registered by another rep"`
+}
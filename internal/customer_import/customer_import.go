@@ -0,0 +1,742 @@
+package customer_import
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/pborman/uuid"
+	"github.com/pkg/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	. "github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"gopkg.in/DataDog/dd-trace-go.v1/ddtrace/tracer"
+
+	"merryworld/surebank/internal/platform/auth"
+	"merryworld/surebank/internal/platform/spreadsheet"
+	"merryworld/surebank/internal/platform/web/webcontext"
+	"merryworld/surebank/internal/platform/web/weberror"
+	"merryworld/surebank/internal/postgres/models"
+	"merryworld/surebank/internal/transaction"
+)
+
+var (
+	// ErrNotFound abstracts the postgres not found error.
+	ErrNotFound = errors.New("Entity not found")
+
+	// ErrForbidden occurs when a user tries to do something that is forbidden to them according to our access control policies.
+	ErrForbidden = errors.New("Attempted action is not allowed")
+
+	// ErrStatus occurs when an import job is validated or committed in a status that does not allow it.
+	ErrStatus = errors.New("The import cannot be changed in its current status")
+
+	// ErrNoRows occurs when the spreadsheet uploaded has no rows below its headers.
+	ErrNoRows = errors.New("The spreadsheet has no rows to import")
+
+	// ErrTooManyRows occurs when the spreadsheet uploaded has more than MaxRows rows.
+	ErrTooManyRows = errors.Errorf("The spreadsheet has more than %d rows, split it into smaller files", MaxRows)
+)
+
+// jobQueries loads the relations of a job shown with it.
+func jobQueries() []QueryMod {
+	return []QueryMod{
+		Load(models.ImportJobRels.Branch),
+		Load(models.ImportJobRels.SalesRep),
+		Load(models.ImportJobRels.CreatedBy),
+	}
+}
+
+// Find gets the most recent import jobs.
+func (repo *Repository) Find(ctx context.Context, claims auth.Claims, limit int) (Jobs, error) {
+	span, ctx := tracer.StartSpanFromContext(ctx, "internal.customer_import.Find")
+	defer span.Finish()
+
+	if claims.Audience == "" || !claims.HasRole(auth.RoleAdmin, auth.RoleSuperAdmin) {
+		return nil, errors.WithStack(ErrForbidden)
+	}
+
+	queries := append(jobQueries(), OrderBy(models.ImportJobColumns.CreatedAt+" desc"), Limit(limit))
+	jobSlice, err := models.ImportJobs(queries...).All(ctx, repo.DbConn)
+	if err != nil {
+		return nil, weberror.NewError(ctx, err, http.StatusInternalServerError)
+	}
+
+	var result Jobs
+	for _, rec := range jobSlice {
+		result = append(result, JobFromModel(rec))
+	}
+
+	return result, nil
+}
+
+// Read gets the specified import job from the database.
+func (repo *Repository) Read(ctx context.Context, claims auth.Claims, id string) (*Job, error) {
+	span, ctx := tracer.StartSpanFromContext(ctx, "internal.customer_import.Read")
+	defer span.Finish()
+
+	if claims.Audience == "" || !claims.HasRole(auth.RoleAdmin, auth.RoleSuperAdmin) {
+		return nil, errors.WithStack(ErrForbidden)
+	}
+
+	rec, err := models.ImportJobs(append(jobQueries(), models.ImportJobWhere.ID.EQ(id))...).One(ctx, repo.DbConn)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, weberror.NewError(ctx, ErrNotFound, http.StatusNotFound)
+		}
+		return nil, err
+	}
+
+	return JobFromModel(rec), nil
+}
+
+// FindRows gets the rows of the import job in the order they are in the spreadsheet. Only rows
+// in the statuses are returned when any are provided.
+func (repo *Repository) FindRows(ctx context.Context, claims auth.Claims, jobID string, statuses ...string) (Rows, error) {
+	span, ctx := tracer.StartSpanFromContext(ctx, "internal.customer_import.FindRows")
+	defer span.Finish()
+
+	if claims.Audience == "" || !claims.HasRole(auth.RoleAdmin, auth.RoleSuperAdmin) {
+		return nil, errors.WithStack(ErrForbidden)
+	}
+
+	queries := []QueryMod{
+		models.ImportRowWhere.JobID.EQ(jobID),
+		OrderBy(models.ImportRowColumns.RowNumber),
+	}
+	if len(statuses) > 0 {
+		queries = append(queries, models.ImportRowWhere.Status.IN(statuses))
+	}
+
+	rowSlice, err := models.ImportRows(queries...).All(ctx, repo.DbConn)
+	if err != nil {
+		return nil, weberror.NewError(ctx, err, http.StatusInternalServerError)
+	}
+
+	var result Rows
+	for _, rec := range rowSlice {
+		result = append(result, RowFromModel(rec))
+	}
+
+	return result, nil
+}
+
+// Upload reads the spreadsheet and saves its rows in a new import job for the branch of the
+// sales rep. The first row that is not blank holds the headers the columns are mapped by, a
+// mapping is guessed from them.
+func (repo *Repository) Upload(ctx context.Context, claims auth.Claims, req UploadRequest, now time.Time) (*Job, error) {
+	span, ctx := tracer.StartSpanFromContext(ctx, "internal.customer_import.Upload")
+	defer span.Finish()
+
+	if claims.Audience == "" || !claims.HasRole(auth.RoleAdmin, auth.RoleSuperAdmin) {
+		return nil, errors.WithStack(ErrForbidden)
+	}
+
+	// Validate the request.
+	v := webcontext.Validator()
+	err := v.Struct(req)
+	if err != nil {
+		return nil, err
+	}
+
+	salesRep, err := models.FindUser(ctx, repo.DbConn, req.SalesRepID)
+	if err != nil {
+		return nil, weberror.NewErrorMessage(ctx, err, http.StatusBadRequest, "invalid sales rep")
+	}
+
+	cells, err := spreadsheet.Read(req.FileName, req.Data)
+	if err != nil {
+		return nil, weberror.NewError(ctx, err, http.StatusBadRequest)
+	}
+
+	headerIdx := -1
+	var rows []*models.ImportRow
+	for i, c := range cells {
+		if blank(c) {
+			continue
+		}
+		if headerIdx < 0 {
+			headerIdx = i
+			continue
+		}
+		dat, err := json.Marshal(c)
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+		rows = append(rows, &models.ImportRow{
+			ID:        uuid.NewRandom().String(),
+			RowNumber: i + 1,
+			Cells:     string(dat),
+			Status:    RowStatus_Pending,
+		})
+	}
+	if len(rows) == 0 {
+		return nil, weberror.NewError(ctx, ErrNoRows, http.StatusBadRequest)
+	}
+	if len(rows) > MaxRows {
+		return nil, weberror.NewError(ctx, ErrTooManyRows, http.StatusBadRequest)
+	}
+
+	headers := cells[headerIdx]
+	headersDat, err := json.Marshal(headers)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	mappingDat, err := json.Marshal(GuessMapping(headers))
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	// If now empty set it to the current time.
+	if now.IsZero() {
+		now = time.Now()
+	}
+
+	// Always store the time as UTC.
+	now = now.UTC()
+	// Postgres truncates times to milliseconds when storing. We and do the same
+	// here so the value we return is consistent with what we store.
+	now = now.Truncate(time.Millisecond)
+
+	m := models.ImportJob{
+		ID:          uuid.NewRandom().String(),
+		BranchID:    salesRep.BranchID,
+		SalesRepID:  salesRep.ID,
+		FileName:    req.FileName,
+		Headers:     string(headersDat),
+		Mapping:     string(mappingDat),
+		Status:      Status_Uploaded,
+		TotalRows:   len(rows),
+		CreatedByID: claims.Subject,
+		CreatedAt:   now.Unix(),
+		UpdatedAt:   now.Unix(),
+	}
+
+	tx, err := repo.DbConn.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := m.Insert(ctx, tx, boil.Infer()); err != nil {
+		_ = tx.Rollback()
+		return nil, weberror.WithMessage(ctx, err, "Insert import job failed")
+	}
+
+	for _, r := range rows {
+		r.JobID = m.ID
+		if err := r.Insert(ctx, tx, boil.Infer()); err != nil {
+			_ = tx.Rollback()
+			return nil, weberror.WithMessage(ctx, err, "Insert import row failed")
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return repo.Read(ctx, claims, m.ID)
+}
+
+// blank reports whether every cell of the row is empty.
+func blank(cells []string) bool {
+	for _, c := range cells {
+		if strings.TrimSpace(c) != "" {
+			return false
+		}
+	}
+	return true
+}
+
+// lockJob selects the import job for update within the db transaction.
+func (repo *Repository) lockJob(ctx context.Context, id string, tx *sql.Tx) (*models.ImportJob, error) {
+	m, err := models.ImportJobs(models.ImportJobWhere.ID.EQ(id), For("UPDATE")).One(ctx, tx)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, weberror.NewError(ctx, ErrNotFound, http.StatusNotFound)
+		}
+		return nil, err
+	}
+	return m, nil
+}
+
+// importContext holds what the rows of a job are checked and imported with.
+type importContext struct {
+	fileName    string
+	mapping     Mapping
+	accountType string
+	branchID    string
+	defaultRep  *models.User
+	reps        salesReps
+	products    map[string]*models.AccountProduct
+}
+
+// loadImportContext loads the sales reps and account products the rows of the job refer to.
+func (repo *Repository) loadImportContext(ctx context.Context, m *models.ImportJob, exec boil.ContextExecutor) (*importContext, error) {
+	ic := &importContext{
+		fileName:    m.FileName,
+		accountType: m.AccountType,
+		branchID:    m.BranchID,
+		products:    make(map[string]*models.AccountProduct),
+	}
+	if err := json.Unmarshal([]byte(m.Mapping), &ic.mapping); err != nil {
+		return nil, errors.WithMessage(err, "Cannot read the mapping of the import")
+	}
+
+	users, err := models.Users(models.UserWhere.ArchivedAt.IsNull()).All(ctx, exec)
+	if err != nil {
+		return nil, err
+	}
+	ic.reps = newSalesReps(users)
+	ic.defaultRep = ic.reps[m.SalesRepID]
+	if ic.defaultRep == nil {
+		return nil, errors.New("The sales rep of the import is no longer active")
+	}
+
+	products, err := models.AccountProducts(models.AccountProductWhere.ArchivedAt.IsNull()).All(ctx, exec)
+	if err != nil {
+		return nil, err
+	}
+	for _, p := range products {
+		ic.products[strings.ToUpper(p.Code)] = p
+	}
+
+	return ic, nil
+}
+
+// entry reads the cells of the row and returns it with its sales rep and the problems that stop
+// it from being imported.
+func (ic *importContext) entry(ctx context.Context, rec *models.ImportRow) (*Entry, *models.User, []string) {
+	var cells []string
+	if err := json.Unmarshal([]byte(rec.Cells), &cells); err != nil {
+		return nil, nil, []string{"The cells of the row could not be read"}
+	}
+
+	e, problems := ic.mapping.Entry(cells, ic.accountType)
+	rep := ic.reps.find(e.SalesRep, ic.defaultRep)
+	problems = append(problems, e.check(ctx, rep, ic.branchID, ic.products)...)
+
+	return e, rep, problems
+}
+
+// Validate checks every row of the job that is not imported yet with the mapping in a dry run.
+// Nothing is imported, each row is marked valid or invalid with the problems found with it.
+// Rows are checked the same way customers registered one at a time are, rows that look like
+// registered customers are reported unless duplicates are ignored and rows with the phone
+// number of an earlier row are only allowed when they are another account of its customer.
+func (repo *Repository) Validate(ctx context.Context, claims auth.Claims, req ValidateRequest, now time.Time) (*Job, error) {
+	span, ctx := tracer.StartSpanFromContext(ctx, "internal.customer_import.Validate")
+	defer span.Finish()
+
+	if claims.Audience == "" || !claims.HasRole(auth.RoleAdmin, auth.RoleSuperAdmin) {
+		return nil, errors.WithStack(ErrForbidden)
+	}
+
+	// Validate the request.
+	v := webcontext.Validator()
+	err := v.Struct(req)
+	if err != nil {
+		return nil, err
+	}
+
+	// If now empty set it to the current time.
+	if now.IsZero() {
+		now = time.Now()
+	}
+
+	// Always store the time as UTC.
+	now = now.UTC()
+	// Postgres truncates times to milliseconds when storing. We and do the same
+	// here so the value we return is consistent with what we store.
+	now = now.Truncate(time.Millisecond)
+
+	tx, err := repo.DbConn.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	m, err := repo.lockJob(ctx, req.ID, tx)
+	if err != nil {
+		_ = tx.Rollback()
+		return nil, err
+	}
+
+	// Rows are validated again after a commit so the rows that failed can be imported once what
+	// they failed on is fixed, but not while a commit is importing them.
+	if m.Status == Status_Committing {
+		_ = tx.Rollback()
+		return nil, weberror.NewError(ctx, ErrStatus, http.StatusBadRequest)
+	}
+
+	var headers []string
+	_ = json.Unmarshal([]byte(m.Headers), &headers)
+	req.AccountType = strings.ToUpper(strings.TrimSpace(req.AccountType))
+	if err := req.Mapping.Check(len(headers), req.AccountType); err != nil {
+		_ = tx.Rollback()
+		return nil, weberror.NewErrorMessage(ctx, err, http.StatusBadRequest, err.Error())
+	}
+
+	mappingDat, err := json.Marshal(req.Mapping)
+	if err != nil {
+		_ = tx.Rollback()
+		return nil, errors.WithStack(err)
+	}
+	m.Mapping = string(mappingDat)
+	m.AccountType = req.AccountType
+	m.IgnoreDuplicates = req.IgnoreDuplicates
+
+	ic, err := repo.loadImportContext(ctx, m, tx)
+	if err != nil {
+		_ = tx.Rollback()
+		return nil, weberror.NewErrorMessage(ctx, err, http.StatusBadRequest, err.Error())
+	}
+	if _, ok := ic.products[req.AccountType]; req.AccountType != "" && !ok {
+		_ = tx.Rollback()
+		err = errors.Errorf("Account type %q does not exist", req.AccountType)
+		return nil, weberror.NewErrorMessage(ctx, err, http.StatusBadRequest, err.Error())
+	}
+
+	rows, err := models.ImportRows(
+		models.ImportRowWhere.JobID.EQ(m.ID),
+		models.ImportRowWhere.Status.NEQ(RowStatus_Imported),
+		OrderBy(models.ImportRowColumns.RowNumber),
+	).All(ctx, tx)
+	if err != nil {
+		_ = tx.Rollback()
+		return nil, err
+	}
+
+	type seenRow struct {
+		*Entry
+		rowNumber int
+	}
+	seen := make(map[string]seenRow)
+	for _, rec := range rows {
+		e, rep, problems := ic.entry(ctx, rec)
+
+		if e != nil && e.PhoneNumber != "" {
+			if prev, ok := seen[e.PhoneNumber]; ok {
+				if !sameCustomer(prev.Entry, e) {
+					problems = append(problems, fmt.Sprintf("Row %d has the same phone number for another customer", prev.rowNumber))
+				}
+			} else {
+				seen[e.PhoneNumber] = seenRow{Entry: e, rowNumber: rec.RowNumber}
+
+				if len(problems) == 0 && !req.IgnoreDuplicates {
+					dups, err := repo.CustomerRepo.FindDuplicates(ctx, claims, e.Name, e.PhoneNumber, rep.BranchID, "")
+					if err != nil {
+						_ = tx.Rollback()
+						return nil, err
+					}
+					for _, d := range dups {
+						problems = append(problems, fmt.Sprintf("Looks like %s (%s) who is already registered: %s",
+							d.Customer.Name, d.Customer.PhoneNumber, strings.Join(d.Reasons, ", ")))
+					}
+				}
+			}
+		}
+
+		rec.Status = RowStatus_Valid
+		rec.Error = strings.Join(problems, "; ")
+		if len(problems) > 0 {
+			rec.Status = RowStatus_Invalid
+		}
+		if _, err := rec.Update(ctx, tx, boil.Whitelist(models.ImportRowColumns.Status, models.ImportRowColumns.Error)); err != nil {
+			_ = tx.Rollback()
+			return nil, weberror.WithMessage(ctx, err, "Update import row failed")
+		}
+	}
+
+	m.Status = Status_Validated
+	m.CompletedAt = null.Int64{}
+	m.UpdatedAt = now.Unix()
+	if err := repo.countRows(ctx, m, tx); err != nil {
+		_ = tx.Rollback()
+		return nil, err
+	}
+	if _, err := m.Update(ctx, tx, boil.Infer()); err != nil {
+		_ = tx.Rollback()
+		return nil, weberror.WithMessage(ctx, err, "Update import job failed")
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return repo.Read(ctx, claims, m.ID)
+}
+
+const countRowsStatement = `SELECT status, COUNT(*) FROM import_row WHERE job_id = $1 GROUP BY status`
+
+// countRows sets the number of rows of the job in each status.
+func (repo *Repository) countRows(ctx context.Context, m *models.ImportJob, tx *sql.Tx) error {
+	rows, err := tx.QueryContext(ctx, countRowsStatement, m.ID)
+	if err != nil {
+		return errors.WithMessage(err, "Cannot count the rows of the import")
+	}
+	defer rows.Close()
+
+	m.ValidRows, m.InvalidRows, m.ImportedRows, m.FailedRows = 0, 0, 0, 0
+	for rows.Next() {
+		var status string
+		var n int
+		if err := rows.Scan(&status, &n); err != nil {
+			return errors.WithStack(err)
+		}
+		switch status {
+		case RowStatus_Valid:
+			m.ValidRows = n
+		case RowStatus_Invalid:
+			m.InvalidRows = n
+		case RowStatus_Imported:
+			m.ImportedRows = n
+		case RowStatus_Failed:
+			m.FailedRows = n
+		}
+	}
+
+	return errors.WithStack(rows.Err())
+}
+
+// Commit imports the valid rows of the job in batches of BatchSize, each in its own db
+// transaction. A row that fails is marked failed with the error and the rest of its batch is
+// still imported. Each row registers its customer, unless an earlier row of the job already
+// registered them, and opens its account with the sales rep of the row so the customer and the
+// account are assigned to the rep and their branch. The opening balance of the row is posted to
+// the account as a transaction. Rows are marked imported with their batch so a commit that is
+// interrupted is resumed from the first row that was not imported by committing the job again.
+func (repo *Repository) Commit(ctx context.Context, claims auth.Claims, req CommitRequest, now time.Time) (*Job, error) {
+	span, ctx := tracer.StartSpanFromContext(ctx, "internal.customer_import.Commit")
+	defer span.Finish()
+
+	if claims.Audience == "" || !claims.HasRole(auth.RoleAdmin, auth.RoleSuperAdmin) {
+		return nil, errors.WithStack(ErrForbidden)
+	}
+
+	// Validate the request.
+	v := webcontext.Validator()
+	err := v.Struct(req)
+	if err != nil {
+		return nil, err
+	}
+
+	// If now empty set it to the current time.
+	if now.IsZero() {
+		now = time.Now()
+	}
+
+	// Always store the time as UTC.
+	now = now.UTC()
+	// Postgres truncates times to milliseconds when storing. We and do the same
+	// here so the value we return is consistent with what we store.
+	now = now.Truncate(time.Millisecond)
+
+	m, err := models.FindImportJob(ctx, repo.DbConn, req.ID)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, weberror.NewError(ctx, ErrNotFound, http.StatusNotFound)
+		}
+		return nil, err
+	}
+	if m.Status != Status_Validated && m.Status != Status_Committing {
+		return nil, weberror.NewError(ctx, ErrStatus, http.StatusBadRequest)
+	}
+
+	ic, err := repo.loadImportContext(ctx, m, repo.DbConn)
+	if err != nil {
+		return nil, weberror.NewErrorMessage(ctx, err, http.StatusBadRequest, err.Error())
+	}
+
+	for {
+		done, err := repo.commitBatch(ctx, claims, m.ID, ic, now)
+		if err != nil {
+			return nil, err
+		}
+		if done {
+			break
+		}
+	}
+
+	return repo.Read(ctx, claims, m.ID)
+}
+
+// commitBatch imports the next batch of valid rows of the job and reports whether there were
+// none left to import.
+func (repo *Repository) commitBatch(ctx context.Context, claims auth.Claims, jobID string, ic *importContext,
+	now time.Time) (bool, error) {
+
+	tx, err := repo.DbConn.BeginTx(ctx, nil)
+	if err != nil {
+		return false, err
+	}
+
+	// Locking the job keeps two commits of it from importing the same rows.
+	m, err := repo.lockJob(ctx, jobID, tx)
+	if err != nil {
+		_ = tx.Rollback()
+		return false, err
+	}
+	if m.Status != Status_Validated && m.Status != Status_Committing {
+		_ = tx.Rollback()
+		return false, weberror.NewError(ctx, ErrStatus, http.StatusBadRequest)
+	}
+
+	rows, err := models.ImportRows(
+		models.ImportRowWhere.JobID.EQ(m.ID),
+		models.ImportRowWhere.Status.EQ(RowStatus_Valid),
+		OrderBy(models.ImportRowColumns.RowNumber),
+		Limit(BatchSize),
+	).All(ctx, tx)
+	if err != nil {
+		_ = tx.Rollback()
+		return false, err
+	}
+
+	// Customers registered by earlier rows, rows of the same customer open accounts for them.
+	imported, err := models.ImportRows(
+		models.ImportRowWhere.JobID.EQ(m.ID),
+		models.ImportRowWhere.Status.EQ(RowStatus_Imported),
+		models.ImportRowWhere.CustomerID.IsNotNull(),
+	).All(ctx, tx)
+	if err != nil {
+		_ = tx.Rollback()
+		return false, err
+	}
+	type registered struct {
+		*Entry
+		customerID string
+	}
+	customers := make(map[string]registered)
+	for _, rec := range imported {
+		if e, _, _ := ic.entry(ctx, rec); e != nil {
+			customers[e.PhoneNumber] = registered{Entry: e, customerID: rec.CustomerID.String}
+		}
+	}
+
+	for _, rec := range rows {
+		if _, err := tx.ExecContext(ctx, "SAVEPOINT import_row"); err != nil {
+			_ = tx.Rollback()
+			return false, errors.WithStack(err)
+		}
+
+		e, err := repo.importRow(ctx, claims, rec, ic, func(e *Entry) string {
+			if c, ok := customers[e.PhoneNumber]; ok && sameCustomer(c.Entry, e) {
+				return c.customerID
+			}
+			return ""
+		}, now, tx)
+		if err != nil {
+			if _, rerr := tx.ExecContext(ctx, "ROLLBACK TO SAVEPOINT import_row"); rerr != nil {
+				_ = tx.Rollback()
+				return false, errors.WithStack(rerr)
+			}
+			rec.Status = RowStatus_Failed
+			rec.Error = rowError(ctx, err)
+			rec.CustomerID, rec.AccountID, rec.TransactionID = null.String{}, null.String{}, null.String{}
+		} else if _, ok := customers[e.PhoneNumber]; !ok {
+			customers[e.PhoneNumber] = registered{Entry: e, customerID: rec.CustomerID.String}
+		}
+
+		if _, err := rec.Update(ctx, tx, boil.Infer()); err != nil {
+			_ = tx.Rollback()
+			return false, weberror.WithMessage(ctx, err, "Update import row failed")
+		}
+		if _, err := tx.ExecContext(ctx, "RELEASE SAVEPOINT import_row"); err != nil {
+			_ = tx.Rollback()
+			return false, errors.WithStack(err)
+		}
+	}
+
+	m.Status = Status_Committing
+	if len(rows) == 0 {
+		m.Status = Status_Completed
+		m.CompletedAt = null.Int64From(now.Unix())
+	}
+	m.UpdatedAt = now.Unix()
+	if err := repo.countRows(ctx, m, tx); err != nil {
+		_ = tx.Rollback()
+		return false, err
+	}
+	if _, err := m.Update(ctx, tx, boil.Infer()); err != nil {
+		_ = tx.Rollback()
+		return false, weberror.WithMessage(ctx, err, "Update import job failed")
+	}
+
+	if err := tx.Commit(); err != nil {
+		return false, err
+	}
+
+	return len(rows) == 0, nil
+}
+
+// importRow registers the customer of the row, or opens another account for the customer
+// registered by an earlier row, and posts the opening balance. The row is marked imported with
+// what was created for it.
+func (repo *Repository) importRow(ctx context.Context, claims auth.Claims, rec *models.ImportRow, ic *importContext,
+	registeredCustomer func(e *Entry) string, now time.Time, tx *sql.Tx) (*Entry, error) {
+
+	e, rep, problems := ic.entry(ctx, rec)
+	if len(problems) > 0 {
+		return nil, errors.New(strings.Join(problems, "; "))
+	}
+
+	// Customers and accounts are assigned to the sales rep that creates them.
+	repClaims := claims
+	repClaims.Subject = rep.ID
+
+	customerID := registeredCustomer(e)
+	if customerID == "" {
+		c, err := repo.CustomerRepo.CreateTx(ctx, repClaims, e.CustomerRequest(rep), now, tx)
+		if err != nil {
+			return nil, err
+		}
+		customerID = c.ID
+	}
+
+	acc, err := repo.AccountRepo.CreateTx(ctx, repClaims, e.AccountRequest(customerID), now, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	rec.CustomerID = null.StringFrom(customerID)
+	rec.AccountID = null.StringFrom(acc.ID)
+
+	if e.OpeningBalance > 0 {
+		t, err := repo.TransactionRepo.OpeningBalance(ctx, claims, transaction.OpeningBalanceRequest{
+			AccountID: acc.ID,
+			Amount:    e.OpeningBalance,
+			Narration: fmt.Sprintf("Opening balance imported from row %d of %s", rec.RowNumber, ic.fileName),
+		}, now, tx)
+		if err != nil {
+			return nil, err
+		}
+		rec.TransactionID = null.StringFrom(t.ID)
+	}
+
+	rec.Status = RowStatus_Imported
+	rec.Error = ""
+
+	return e, nil
+}
+
+// rowError returns the message the row failed with.
+func rowError(ctx context.Context, err error) string {
+	werr, ok := weberror.NewError(ctx, err, 0).(*weberror.Error)
+	if !ok {
+		return err.Error()
+	}
+
+	if len(werr.Fields) > 0 {
+		var msgs []string
+		for _, f := range werr.Fields {
+			msgs = append(msgs, f.Display)
+		}
+		return strings.Join(msgs, "; ")
+	}
+	if werr.Message != "" {
+		return werr.Message
+	}
+	return werr.Error()
+}
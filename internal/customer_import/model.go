@@ -0,0 +1,297 @@
+package customer_import
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+
+	"merryworld/surebank/internal/account"
+	"merryworld/surebank/internal/customer"
+	"merryworld/surebank/internal/platform/web"
+	"merryworld/surebank/internal/postgres/models"
+	"merryworld/surebank/internal/transaction"
+)
+
+// Repository defines the required dependencies for importing customers.
+type Repository struct {
+	DbConn          *sqlx.DB
+	CustomerRepo    *customer.Repository
+	AccountRepo     *account.Repository
+	TransactionRepo *transaction.Repository
+}
+
+// NewRepository creates a new Repository that defines dependencies for importing customers.
+func NewRepository(db *sqlx.DB, customerRepo *customer.Repository, accountRepo *account.Repository,
+	transactionRepo *transaction.Repository) *Repository {
+	return &Repository{
+		DbConn:          db,
+		CustomerRepo:    customerRepo,
+		AccountRepo:     accountRepo,
+		TransactionRepo: transactionRepo,
+	}
+}
+
+// Job statuses. A job is uploaded, validated in a dry run as many times as needed to get the
+// mapping of its columns right and then committed. A job stays committing until every valid row
+// has been imported so an import that is interrupted is resumed by committing it again. A
+// completed job can be validated and committed again to import the rows that failed.
+const (
+	Status_Uploaded   = "uploaded"
+	Status_Validated  = "validated"
+	Status_Committing = "committing"
+	Status_Completed  = "completed"
+)
+
+// Row statuses. Rows are valid or invalid once the job is validated, valid rows are then
+// imported or failed when they are committed.
+const (
+	RowStatus_Pending  = "pending"
+	RowStatus_Valid    = "valid"
+	RowStatus_Invalid  = "invalid"
+	RowStatus_Imported = "imported"
+	RowStatus_Failed   = "failed"
+)
+
+// BatchSize is the number of rows imported in each db transaction when a job is committed.
+const BatchSize = 50
+
+// MaxRows is the most rows a spreadsheet can have to be imported in one job.
+const MaxRows = 5000
+
+// Fields of a row that the columns of the spreadsheet are mapped to.
+const (
+	Field_Name           = "name"
+	Field_PhoneNumber    = "phone_number"
+	Field_Email          = "email"
+	Field_Address        = "address"
+	Field_AccountType    = "account_type"
+	Field_Target         = "target"
+	Field_TargetInfo     = "target_info"
+	Field_OpeningBalance = "opening_balance"
+	Field_SalesRep       = "sales_rep"
+)
+
+// Fields lists the fields of a row in the order they are mapped.
+var Fields = []string{
+	Field_Name, Field_PhoneNumber, Field_Email, Field_Address, Field_AccountType,
+	Field_Target, Field_TargetInfo, Field_OpeningBalance, Field_SalesRep,
+}
+
+// FieldNames are the names of the fields for display.
+var FieldNames = map[string]string{
+	Field_Name:           "Name",
+	Field_PhoneNumber:    "Phone Number",
+	Field_Email:          "Email",
+	Field_Address:        "Address",
+	Field_AccountType:    "Account Type",
+	Field_Target:         "Target",
+	Field_TargetInfo:     "Target Info",
+	Field_OpeningBalance: "Opening Balance",
+	Field_SalesRep:       "Sales Rep",
+}
+
+// Mapping maps the fields of a row to the zero based index of the column they are read from.
+// Fields that are not in the mapping are left blank.
+type Mapping map[string]int
+
+// Job is a spreadsheet of customers and their accounts being imported into a branch.
+type Job struct {
+	ID               string     `json:"id" example:"985f1746-1d9f-459f-a2d9-fc53ece5ae86"`
+	BranchID         string     `json:"branch_id" example:"985f1746-1d9f-459f-a2d9-fc53ece5ae86"`
+	SalesRepID       string     `json:"sales_rep_id" example:"985f1746-1d9f-459f-a2d9-fc53ece5ae86"`
+	FileName         string     `json:"file_name" example:"agege.xlsx"`
+	Headers          []string   `json:"headers"`
+	Mapping          Mapping    `json:"mapping"`
+	AccountType      string     `json:"account_type" example:"SB"`
+	IgnoreDuplicates bool       `json:"ignore_duplicates"`
+	Status           string     `json:"status" example:"validated"`
+	TotalRows        int        `json:"total_rows"`
+	ValidRows        int        `json:"valid_rows"`
+	InvalidRows      int        `json:"invalid_rows"`
+	ImportedRows     int        `json:"imported_rows"`
+	FailedRows       int        `json:"failed_rows"`
+	CreatedByID      string     `json:"created_by_id"`
+	CreatedAt        time.Time  `json:"created_at"`
+	UpdatedAt        time.Time  `json:"updated_at"`
+	CompletedAt      *time.Time `json:"completed_at,omitempty"`
+
+	Branch    string `json:"branch,omitempty"`
+	SalesRep  string `json:"sales_rep,omitempty"`
+	CreatedBy string `json:"created_by,omitempty"`
+}
+
+// JobFromModel converts the import job model and its loaded relations to a Job.
+func JobFromModel(rec *models.ImportJob) *Job {
+	j := &Job{
+		ID:               rec.ID,
+		BranchID:         rec.BranchID,
+		SalesRepID:       rec.SalesRepID,
+		FileName:         rec.FileName,
+		AccountType:      rec.AccountType,
+		IgnoreDuplicates: rec.IgnoreDuplicates,
+		Status:           rec.Status,
+		TotalRows:        rec.TotalRows,
+		ValidRows:        rec.ValidRows,
+		InvalidRows:      rec.InvalidRows,
+		ImportedRows:     rec.ImportedRows,
+		FailedRows:       rec.FailedRows,
+		CreatedByID:      rec.CreatedByID,
+		CreatedAt:        time.Unix(rec.CreatedAt, 0).UTC(),
+		UpdatedAt:        time.Unix(rec.UpdatedAt, 0).UTC(),
+	}
+	_ = json.Unmarshal([]byte(rec.Headers), &j.Headers)
+	_ = json.Unmarshal([]byte(rec.Mapping), &j.Mapping)
+
+	if rec.CompletedAt.Valid {
+		completedAt := time.Unix(rec.CompletedAt.Int64, 0).UTC()
+		j.CompletedAt = &completedAt
+	}
+
+	if rec.R != nil {
+		if rec.R.Branch != nil {
+			j.Branch = rec.R.Branch.Name
+		}
+		if rec.R.SalesRep != nil {
+			j.SalesRep = rec.R.SalesRep.LastName + " " + rec.R.SalesRep.FirstName
+		}
+		if rec.R.CreatedBy != nil {
+			j.CreatedBy = rec.R.CreatedBy.LastName + " " + rec.R.CreatedBy.FirstName
+		}
+	}
+
+	return j
+}
+
+// JobResponse represents an import job that is returned for display.
+type JobResponse struct {
+	ID               string            `json:"id" example:"985f1746-1d9f-459f-a2d9-fc53ece5ae86"`
+	BranchID         string            `json:"branch_id" example:"985f1746-1d9f-459f-a2d9-fc53ece5ae86"`
+	Branch           string            `json:"branch,omitempty"`
+	SalesRepID       string            `json:"sales_rep_id" example:"985f1746-1d9f-459f-a2d9-fc53ece5ae86"`
+	SalesRep         string            `json:"sales_rep,omitempty"`
+	FileName         string            `json:"file_name" example:"agege.xlsx"`
+	Headers          []string          `json:"headers"`
+	Mapping          Mapping           `json:"mapping"`
+	AccountType      string            `json:"account_type" example:"SB"`
+	IgnoreDuplicates bool              `json:"ignore_duplicates"`
+	Status           string            `json:"status" example:"validated"`
+	TotalRows        int               `json:"total_rows"`
+	ValidRows        int               `json:"valid_rows"`
+	InvalidRows      int               `json:"invalid_rows"`
+	ImportedRows     int               `json:"imported_rows"`
+	FailedRows       int               `json:"failed_rows"`
+	CreatedBy        string            `json:"created_by,omitempty"`
+	CreatedAt        web.TimeResponse  `json:"created_at"`
+	UpdatedAt        web.TimeResponse  `json:"updated_at"`
+	CompletedAt      *web.TimeResponse `json:"completed_at,omitempty"`
+}
+
+// Response transforms Job to the JobResponse that is used for display.
+func (m *Job) Response(ctx context.Context) *JobResponse {
+	if m == nil {
+		return nil
+	}
+
+	r := &JobResponse{
+		ID:               m.ID,
+		BranchID:         m.BranchID,
+		Branch:           m.Branch,
+		SalesRepID:       m.SalesRepID,
+		SalesRep:         m.SalesRep,
+		FileName:         m.FileName,
+		Headers:          m.Headers,
+		Mapping:          m.Mapping,
+		AccountType:      m.AccountType,
+		IgnoreDuplicates: m.IgnoreDuplicates,
+		Status:           m.Status,
+		TotalRows:        m.TotalRows,
+		ValidRows:        m.ValidRows,
+		InvalidRows:      m.InvalidRows,
+		ImportedRows:     m.ImportedRows,
+		FailedRows:       m.FailedRows,
+		CreatedBy:        m.CreatedBy,
+		CreatedAt:        web.NewTimeResponse(ctx, m.CreatedAt),
+		UpdatedAt:        web.NewTimeResponse(ctx, m.UpdatedAt),
+	}
+
+	if m.CompletedAt != nil {
+		completedAt := web.NewTimeResponse(ctx, *m.CompletedAt)
+		r.CompletedAt = &completedAt
+	}
+
+	return r
+}
+
+// Jobs a list of import jobs.
+type Jobs []*Job
+
+// Response transforms a list of Jobs to a list of JobResponses.
+func (m *Jobs) Response(ctx context.Context) []*JobResponse {
+	var l = make([]*JobResponse, 0)
+	if m != nil && len(*m) > 0 {
+		for _, n := range *m {
+			l = append(l, n.Response(ctx))
+		}
+	}
+
+	return l
+}
+
+// Row is a row of the spreadsheet of an import job.
+type Row struct {
+	ID            string   `json:"id" example:"985f1746-1d9f-459f-a2d9-fc53ece5ae86"`
+	JobID         string   `json:"job_id" example:"985f1746-1d9f-459f-a2d9-fc53ece5ae86"`
+	RowNumber     int      `json:"row_number" example:"2"`
+	Cells         []string `json:"cells"`
+	Status        string   `json:"status" example:"valid"`
+	Error         string   `json:"error"`
+	CustomerID    *string  `json:"customer_id,omitempty"`
+	AccountID     *string  `json:"account_id,omitempty"`
+	TransactionID *string  `json:"transaction_id,omitempty"`
+}
+
+// RowFromModel converts the import row model to a Row.
+func RowFromModel(rec *models.ImportRow) *Row {
+	r := &Row{
+		ID:            rec.ID,
+		JobID:         rec.JobID,
+		RowNumber:     rec.RowNumber,
+		Status:        rec.Status,
+		Error:         rec.Error,
+		CustomerID:    rec.CustomerID.Ptr(),
+		AccountID:     rec.AccountID.Ptr(),
+		TransactionID: rec.TransactionID.Ptr(),
+	}
+	_ = json.Unmarshal([]byte(rec.Cells), &r.Cells)
+
+	return r
+}
+
+// Rows a list of import rows.
+type Rows []*Row
+
+// UploadRequest contains the spreadsheet of customers to import and the sales rep they are
+// assigned to when their rows do not name one.
+type UploadRequest struct {
+	FileName   string `json:"file_name" validate:"required"`
+	Data       []byte `json:"data" validate:"required"`
+	SalesRepID string `json:"sales_rep_id" validate:"required,uuid" example:"985f1746-1d9f-459f-a2d9-fc53ece5ae86"`
+}
+
+// ValidateRequest contains the mapping of the columns of the spreadsheet to validate the rows of
+// the job with in a dry run.
+type ValidateRequest struct {
+	ID      string  `json:"id" validate:"required,uuid" example:"985f1746-1d9f-459f-a2d9-fc53ece5ae86"`
+	Mapping Mapping `json:"mapping" validate:"required"`
+	// AccountType is the code of the account product opened for rows without an account type.
+	AccountType string `json:"account_type" example:"SB"`
+	// IgnoreDuplicates imports rows that look like customers that are already registered.
+	IgnoreDuplicates bool `json:"ignore_duplicates"`
+}
+
+// CommitRequest defines the import job to commit.
+type CommitRequest struct {
+	ID string `json:"id" validate:"required,uuid" example:"985f1746-1d9f-459f-a2d9-fc53ece5ae86"`
+}
@@ -0,0 +1,253 @@
+package customer_import
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"merryworld/surebank/internal/account"
+	"merryworld/surebank/internal/customer"
+	"merryworld/surebank/internal/platform/money"
+	"merryworld/surebank/internal/platform/web/webcontext"
+	"merryworld/surebank/internal/platform/web/weberror"
+	"merryworld/surebank/internal/postgres/models"
+)
+
+// headerAliases are the headers, reduced to lower case letters and digits, that name each field
+// in the spreadsheets branches keep.
+var headerAliases = map[string][]string{
+	Field_Name:           {"name", "customer", "customername", "fullname"},
+	Field_PhoneNumber:    {"phone", "phonenumber", "phoneno", "mobile", "mobilenumber", "telephone", "tel", "gsm"},
+	Field_Email:          {"email", "emailaddress"},
+	Field_Address:        {"address", "homeaddress", "residentialaddress"},
+	Field_AccountType:    {"accounttype", "type", "product", "accountproduct"},
+	Field_Target:         {"target", "targetamount"},
+	Field_TargetInfo:     {"targetinfo", "purpose"},
+	Field_OpeningBalance: {"openingbalance", "balance", "currentbalance"},
+	Field_SalesRep:       {"salesrep", "rep", "accountofficer", "officer", "agent"},
+}
+
+// headerKey reduces a header to its lower case letters and digits.
+func headerKey(header string) string {
+	var b strings.Builder
+	for _, r := range strings.ToLower(header) {
+		if (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9') {
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
+
+// GuessMapping maps the fields to the columns with headers that name them. The first column is
+// taken when more than one names a field.
+func GuessMapping(headers []string) Mapping {
+	m := make(Mapping)
+	for col, h := range headers {
+		key := headerKey(h)
+		for field, aliases := range headerAliases {
+			if _, ok := m[field]; ok {
+				continue
+			}
+			for _, alias := range aliases {
+				if key == alias {
+					m[field] = col
+					break
+				}
+			}
+		}
+	}
+	return m
+}
+
+// Check returns an error when a field is mapped to a column the spreadsheet does not have or
+// a field every row needs is not mapped. The account type does not need to be mapped when a
+// default is set.
+func (m Mapping) Check(columns int, accountType string) error {
+	for field, col := range m {
+		if _, ok := FieldNames[field]; !ok {
+			return errors.Errorf("%s is not a field that can be imported", field)
+		}
+		if col < 0 || col >= columns {
+			return errors.Errorf("%s is mapped to a column the spreadsheet does not have", FieldNames[field])
+		}
+	}
+
+	required := []string{Field_Name, Field_PhoneNumber}
+	if accountType == "" {
+		required = append(required, Field_AccountType)
+	}
+	for _, field := range required {
+		if _, ok := m[field]; !ok {
+			return errors.Errorf("%s must be mapped to a column", FieldNames[field])
+		}
+	}
+
+	return nil
+}
+
+// Entry is a row of the spreadsheet read with the mapping of the columns of its job.
+type Entry struct {
+	Name           string
+	PhoneNumber    string
+	Email          string
+	Address        string
+	AccountType    string
+	Target         money.Amount
+	TargetInfo     string
+	OpeningBalance money.Amount
+	SalesRep       string
+}
+
+// Entry reads the cells of a row with the mapping. The account type is used for rows that do
+// not have one. Problems with the cells are returned along with what could be read.
+func (m Mapping) Entry(cells []string, accountType string) (*Entry, []string) {
+	cell := func(field string) string {
+		col, ok := m[field]
+		if !ok || col < 0 || col >= len(cells) {
+			return ""
+		}
+		return strings.TrimSpace(cells[col])
+	}
+
+	var problems []string
+	amount := func(field string) money.Amount {
+		v := cell(field)
+		v = strings.TrimPrefix(strings.TrimPrefix(v, "₦"), "NGN")
+		if strings.TrimSpace(v) == "" {
+			return 0
+		}
+		a, err := money.Parse(v)
+		if err != nil || a < 0 {
+			problems = append(problems, fmt.Sprintf("%s %q is not an amount", FieldNames[field], cell(field)))
+			return 0
+		}
+		return a
+	}
+
+	e := &Entry{
+		Name:           strings.Join(strings.Fields(cell(Field_Name)), " "),
+		PhoneNumber:    cell(Field_PhoneNumber),
+		Email:          strings.ToLower(cell(Field_Email)),
+		Address:        cell(Field_Address),
+		AccountType:    strings.ToUpper(cell(Field_AccountType)),
+		Target:         amount(Field_Target),
+		TargetInfo:     cell(Field_TargetInfo),
+		OpeningBalance: amount(Field_OpeningBalance),
+		SalesRep:       cell(Field_SalesRep),
+	}
+	if e.PhoneNumber != "" {
+		e.PhoneNumber = customer.NormalizePhone(e.PhoneNumber)
+		// Spreadsheets store phone numbers typed as numbers without their leading zero.
+		if len(e.PhoneNumber) == 10 && e.PhoneNumber[0] != '0' {
+			e.PhoneNumber = "0" + e.PhoneNumber
+		}
+	}
+	if e.AccountType == "" {
+		e.AccountType = strings.ToUpper(accountType)
+	}
+
+	return e, problems
+}
+
+// CustomerRequest returns the request that registers the customer of the entry with the sales
+// rep. Duplicates are reported by the dry run so they are ignored when the row is imported.
+func (e *Entry) CustomerRequest(salesRep *models.User) customer.CreateRequest {
+	return customer.CreateRequest{
+		Name:             e.Name,
+		Email:            e.Email,
+		PhoneNumber:      e.PhoneNumber,
+		Address:          e.Address,
+		SalesRepID:       salesRep.ID,
+		BranchID:         salesRep.BranchID,
+		Type:             e.AccountType,
+		Target:           e.Target,
+		TargetInfo:       e.TargetInfo,
+		IgnoreDuplicates: true,
+	}
+}
+
+// AccountRequest returns the request that opens the account of the entry for the customer.
+func (e *Entry) AccountRequest(customerID string) account.CreateRequest {
+	return account.CreateRequest{
+		CustomerID: customerID,
+		Type:       e.AccountType,
+		Target:     e.Target,
+		TargetInfo: e.TargetInfo,
+	}
+}
+
+// check returns the problems with the entry that stop it from being imported into the branch
+// by the sales rep, who is nil when the row names a rep that could not be found. The customer
+// is validated the same way as customers registered one at a time.
+func (e *Entry) check(ctx context.Context, salesRep *models.User, branchID string,
+	products map[string]*models.AccountProduct) []string {
+
+	var problems []string
+	if salesRep == nil {
+		return append(problems, fmt.Sprintf("Sales rep %q was not found", e.SalesRep))
+	}
+	if salesRep.BranchID != branchID {
+		problems = append(problems, fmt.Sprintf("Sales rep %s %s is not at the branch being imported",
+			salesRep.FirstName, salesRep.LastName))
+	}
+
+	if err := webcontext.Validator().Struct(e.CustomerRequest(salesRep)); err != nil {
+		verr, ok := weberror.NewValidationError(ctx, err)
+		if !ok {
+			return append(problems, err.Error())
+		}
+		for _, f := range verr.(*weberror.Error).Fields {
+			problems = append(problems, f.Display)
+		}
+	}
+
+	if e.AccountType != "" {
+		product, ok := products[e.AccountType]
+		if !ok {
+			problems = append(problems, fmt.Sprintf("Account type %q does not exist", e.AccountType))
+		} else if product.TargetRequired && e.Target <= 0 {
+			problems = append(problems, product.Name+" accounts must have a target amount")
+		}
+	}
+
+	return problems
+}
+
+// salesReps finds the users rows are assigned to by their email, their name or their ID.
+type salesReps map[string]*models.User
+
+// nameKey reduces a name to lower case words separated by single spaces.
+func nameKey(name string) string {
+	return strings.Join(strings.Fields(strings.ToLower(name)), " ")
+}
+
+func newSalesReps(users models.UserSlice) salesReps {
+	reps := make(salesReps)
+	for _, u := range users {
+		reps[u.ID] = u
+		reps[strings.ToLower(u.Email)] = u
+		reps[nameKey(u.FirstName+" "+u.LastName)] = u
+		reps[nameKey(u.LastName+" "+u.FirstName)] = u
+	}
+	return reps
+}
+
+// find returns the sales rep named or the default rep when none is named.
+func (r salesReps) find(name string, defaultRep *models.User) *models.User {
+	if strings.TrimSpace(name) == "" {
+		return defaultRep
+	}
+	if u, ok := r[strings.TrimSpace(name)]; ok {
+		return u
+	}
+	return r[nameKey(name)]
+}
+
+// sameCustomer reports whether the rows are accounts of the same customer, they have the same
+// phone number and similar names.
+func sameCustomer(a, b *Entry) bool {
+	return a.PhoneNumber == b.PhoneNumber &&
+		customer.NameSimilarity(a.Name, b.Name) >= customer.DuplicateNameSimilarity
+}
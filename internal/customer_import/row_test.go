@@ -0,0 +1,194 @@
+package customer_import
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"merryworld/surebank/internal/platform/money"
+	"merryworld/surebank/internal/platform/tests"
+	"merryworld/surebank/internal/postgres/models"
+)
+
+// TestGuessMapping validates the columns of a spreadsheet are mapped by their headers.
+func TestGuessMapping(t *testing.T) {
+	headers := []string{"S/N", "Customer Name", "Phone No.", "Type", "Balance (₦)", "Account Officer", "Mobile"}
+	want := Mapping{Field_Name: 1, Field_PhoneNumber: 2, Field_AccountType: 3, Field_OpeningBalance: 4, Field_SalesRep: 5}
+
+	t.Log("Given the need to map the columns of a spreadsheet to the fields of a customer.")
+	{
+		t.Logf("\tTest: 0\tWhen the headers are %q.", headers)
+		{
+			got := GuessMapping(headers)
+			if len(got) != len(want) {
+				t.Logf("\t\tGot : %v", got)
+				t.Logf("\t\tWant: %v", want)
+				t.Fatalf("\t%s\tShould map the fields named by the headers.", tests.Failed)
+			}
+			for field, col := range want {
+				if got[field] != col {
+					t.Logf("\t\tGot : %v", got)
+					t.Logf("\t\tWant: %v", want)
+					t.Fatalf("\t%s\tShould map the fields named by the headers.", tests.Failed)
+				}
+			}
+			t.Logf("\t%s\tShould map the fields named by the headers.", tests.Success)
+		}
+	}
+}
+
+// TestMappingCheck validates a mapping reads every field a row needs from the spreadsheet.
+func TestMappingCheck(t *testing.T) {
+	type checkTest struct {
+		Name        string
+		Mapping     Mapping
+		AccountType string
+		Err         string
+	}
+
+	var checkTests = []checkTest{
+		{"every field needed", Mapping{Field_Name: 0, Field_PhoneNumber: 1, Field_AccountType: 2}, "", ""},
+		{"a default account type", Mapping{Field_Name: 0, Field_PhoneNumber: 1}, "SB", ""},
+		{"no account type", Mapping{Field_Name: 0, Field_PhoneNumber: 1}, "", "Account Type must be mapped to a column"},
+		{"no phone number", Mapping{Field_Name: 0}, "SB", "Phone Number must be mapped to a column"},
+		{"a missing column", Mapping{Field_Name: 0, Field_PhoneNumber: 3}, "SB",
+			"Phone Number is mapped to a column the spreadsheet does not have"},
+		{"an unknown field", Mapping{Field_Name: 0, Field_PhoneNumber: 1, "bvn": 2}, "SB",
+			"bvn is not a field that can be imported"},
+	}
+
+	t.Log("Given the need to validate the rows of a spreadsheet with a mapping of its three columns.")
+	{
+		for i, tt := range checkTests {
+			t.Logf("\tTest: %d\tWhen the mapping has %s.", i, tt.Name)
+			{
+				var got string
+				if err := tt.Mapping.Check(3, tt.AccountType); err != nil {
+					got = err.Error()
+				}
+				if got != tt.Err {
+					t.Logf("\t\tGot : %s", got)
+					t.Logf("\t\tWant: %s", tt.Err)
+					t.Fatalf("\t%s\tShould get the expected error.", tests.Failed)
+				}
+				t.Logf("\t%s\tShould get the expected error.", tests.Success)
+			}
+		}
+	}
+}
+
+// TestEntry validates the rows of a spreadsheet are read into customers and checked the same
+// way customers registered one at a time are.
+func TestEntry(t *testing.T) {
+	const branchID = "0c1e2a7d-3f4b-4c5d-8e9f-a0b1c2d3e4f5"
+	mapping := Mapping{Field_Name: 0, Field_PhoneNumber: 1, Field_AccountType: 2, Field_Target: 3,
+		Field_OpeningBalance: 4, Field_SalesRep: 5}
+
+	defaultRep := &models.User{ID: "4ba8b3b5-6f2c-4a2f-9b46-7e1d3c0f1a01", BranchID: branchID, Email: "ade@surebank.ng",
+		FirstName: "Ade", LastName: "Bello"}
+	otherRep := &models.User{ID: "4ba8b3b5-6f2c-4a2f-9b46-7e1d3c0f1a02", BranchID: branchID, Email: "ngozi@surebank.ng",
+		FirstName: "Ngozi", LastName: "Eze"}
+	farRep := &models.User{ID: "4ba8b3b5-6f2c-4a2f-9b46-7e1d3c0f1a03", BranchID: "0c1e2a7d-3f4b-4c5d-8e9f-a0b1c2d3e4f6", Email: "musa@surebank.ng",
+		FirstName: "Musa", LastName: "Ali"}
+	reps := newSalesReps(models.UserSlice{defaultRep, otherRep, farRep})
+
+	products := map[string]*models.AccountProduct{
+		"SB": {Code: "SB", Name: "Savings"},
+		"TL": {Code: "TL", Name: "Target Loan", TargetRequired: true},
+	}
+
+	type entryTest struct {
+		Name     string
+		Cells    []string
+		Phone    string
+		Balance  money.Amount
+		RepID    string
+		Problems []string
+	}
+
+	var entryTests = []entryTest{
+		{"a complete row", []string{" Oluwafe  Dami ", "8031234567", "sb", "", "₦2,500.50", ""},
+			"08031234567", money.Kobo(250050), defaultRep.ID, nil},
+		{"a row for another rep", []string{"Chinedu Okafor", "+234 803 999 0000", "", "", "", "eze ngozi"},
+			"08039990000", 0, otherRep.ID, nil},
+		{"a row without a name", []string{"", "08031234567", "SB"},
+			"08031234567", 0, defaultRep.ID, []string{"name is a required field"}},
+		{"a row with a bad balance", []string{"Oluwafe Dami", "08031234567", "SB", "", "two thousand"},
+			"08031234567", 0, defaultRep.ID, []string{`Opening Balance "two thousand" is not an amount`}},
+		{"a row without a target", []string{"Oluwafe Dami", "08031234567", "TL"},
+			"08031234567", 0, defaultRep.ID, []string{"Target Loan accounts must have a target amount"}},
+		{"a row with an unknown type", []string{"Oluwafe Dami", "08031234567", "FD"},
+			"08031234567", 0, defaultRep.ID, []string{`Account type "FD" does not exist`}},
+		{"a row for a rep at another branch", []string{"Oluwafe Dami", "08031234567", "SB", "", "", "MUSA@surebank.ng"},
+			"08031234567", 0, farRep.ID, []string{"Sales rep Musa Ali is not at the branch being imported"}},
+		{"a row for an unknown rep", []string{"Oluwafe Dami", "08031234567", "SB", "", "", "Tunde"},
+			"08031234567", 0, "", []string{`Sales rep "Tunde" was not found`}},
+	}
+
+	t.Log("Given the need to import the rows of a spreadsheet into a branch.")
+	{
+		for i, tt := range entryTests {
+			t.Logf("\tTest: %d\tWhen reading %s.", i, tt.Name)
+			{
+				e, problems := mapping.Entry(tt.Cells, "SB")
+				rep := reps.find(e.SalesRep, defaultRep)
+				problems = append(problems, e.check(context.Background(), rep, branchID, products)...)
+
+				if e.PhoneNumber != tt.Phone || e.OpeningBalance != tt.Balance {
+					t.Logf("\t\tGot : %s %s", e.PhoneNumber, e.OpeningBalance)
+					t.Logf("\t\tWant: %s %s", tt.Phone, tt.Balance)
+					t.Fatalf("\t%s\tShould read the cells of the row.", tests.Failed)
+				}
+				t.Logf("\t%s\tShould read the cells of the row.", tests.Success)
+
+				var repID string
+				if rep != nil {
+					repID = rep.ID
+				}
+				if repID != tt.RepID {
+					t.Logf("\t\tGot : %s", repID)
+					t.Logf("\t\tWant: %s", tt.RepID)
+					t.Fatalf("\t%s\tShould assign the row to its sales rep.", tests.Failed)
+				}
+				t.Logf("\t%s\tShould assign the row to its sales rep.", tests.Success)
+
+				if got, want := strings.Join(problems, "; "), strings.Join(tt.Problems, "; "); got != want {
+					t.Logf("\t\tGot : %s", got)
+					t.Logf("\t\tWant: %s", want)
+					t.Fatalf("\t%s\tShould get the problems with the row.", tests.Failed)
+				}
+				t.Logf("\t%s\tShould get the problems with the row.", tests.Success)
+			}
+		}
+	}
+}
+
+// TestSameCustomer validates rows are only taken as accounts of the same customer when both the
+// phone number and the name match.
+func TestSameCustomer(t *testing.T) {
+	first := &Entry{Name: "Oluwafe Dami", PhoneNumber: "08031234567"}
+
+	var sameTests = []struct {
+		Entry *Entry
+		Same  bool
+	}{
+		{&Entry{Name: "Dami Oluwafe", PhoneNumber: "08031234567"}, true},
+		{&Entry{Name: "Chinedu Okafor", PhoneNumber: "08031234567"}, false},
+		{&Entry{Name: "Oluwafe Dami", PhoneNumber: "08099999999"}, false},
+	}
+
+	t.Log("Given the need to import customers with more than one account.")
+	{
+		for i, tt := range sameTests {
+			t.Logf("\tTest: %d\tWhen a later row is %s %s.", i, tt.Entry.Name, tt.Entry.PhoneNumber)
+			{
+				if got := sameCustomer(first, tt.Entry); got != tt.Same {
+					t.Logf("\t\tGot : %v", got)
+					t.Logf("\t\tWant: %v", tt.Same)
+					t.Fatalf("\t%s\tShould match the customer of the first row.", tests.Failed)
+				}
+				t.Logf("\t%s\tShould match the customer of the first row.", tests.Success)
+			}
+		}
+	}
+}
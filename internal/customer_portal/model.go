@@ -0,0 +1,239 @@
+package customer_portal
+
+import (
+	"context"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+
+	"merryworld/surebank/internal/account"
+	"merryworld/surebank/internal/customer"
+	"merryworld/surebank/internal/platform/money"
+	"merryworld/surebank/internal/platform/notify"
+	"merryworld/surebank/internal/platform/web"
+	"merryworld/surebank/internal/postgres/models"
+	"merryworld/surebank/internal/tenant/account_preference"
+	"merryworld/surebank/internal/user"
+	"merryworld/surebank/internal/user_auth"
+)
+
+// Repository defines the required dependencies for the customer portal.
+type Repository struct {
+	DbConn            *sqlx.DB
+	TknGen            user_auth.TokenGenerator
+	CustomerRepo      *customer.Repository
+	AccountRepo       *account.Repository
+	AccountPreference *account_preference.Repository
+	notifySMS         notify.SMS
+}
+
+// NewRepository creates a new Repository that defines dependencies for the customer portal.
+func NewRepository(db *sqlx.DB, tknGen user_auth.TokenGenerator, customerRepo *customer.Repository,
+	accountRepo *account.Repository, accPref *account_preference.Repository, notifySMS notify.SMS) *Repository {
+	return &Repository{
+		DbConn:            db,
+		TknGen:            tknGen,
+		CustomerRepo:      customerRepo,
+		AccountRepo:       accountRepo,
+		AccountPreference: accPref,
+		notifySMS:         notifySMS,
+	}
+}
+
+// Login codes are sent by SMS to the phone number of the customer. A code can only be used once,
+// expires after CodeTTL and stops working after MaxCodeAttempts wrong guesses.
+const (
+	CodeLength      = 6
+	CodeTTL         = 10 * time.Minute
+	MaxCodeAttempts = 5
+	// CodeResendWait is how long a customer waits for a code before another one is sent.
+	CodeResendWait = time.Minute
+	// MaxCodesPerHour is the most codes sent to a phone number in an hour.
+	MaxCodesPerHour = 5
+)
+
+// Withdrawal request statuses. A pending request is paid out by a teller which makes it
+// processed, or referred when the withdrawal needs the approval of a supervisor. Staff decline
+// requests they cannot pay and customers can cancel their pending requests.
+const (
+	WithdrawalStatus_Pending   = "pending"
+	WithdrawalStatus_Referred  = "referred"
+	WithdrawalStatus_Processed = "processed"
+	WithdrawalStatus_Declined  = "declined"
+	WithdrawalStatus_Cancelled = "cancelled"
+)
+
+// RequestCodeRequest defines the phone number a login code is sent to.
+type RequestCodeRequest struct {
+	PhoneNumber string `json:"phone_number" validate:"required,max=20" example:"08012345678"`
+}
+
+// LoginRequest defines the phone number and the code sent to it that logs a customer in.
+type LoginRequest struct {
+	PhoneNumber string `json:"phone_number" validate:"required,max=20" example:"08012345678"`
+	Code        string `json:"code" validate:"required,len=6,numeric" example:"123456"`
+}
+
+// AccountResponse is an account of the customer with the part of its balance that can be
+// withdrawn once the holds on it are taken off.
+type AccountResponse struct {
+	*account.Response
+	Held      money.Amount `json:"held"`
+	Available money.Amount `json:"available"`
+}
+
+// Withdrawal is a withdrawal a customer requested from the portal to be paid out by a teller.
+type Withdrawal struct {
+	ID            string       `json:"id" example:"985f1746-1d9f-459f-a2d9-fc53ece5ae86"`
+	CustomerID    string       `json:"customer_id" example:"985f1746-1d9f-459f-a2d9-fc53ece5ae86"`
+	AccountID     string       `json:"account_id" example:"985f1746-1d9f-459f-a2d9-fc53ece5ae86"`
+	Amount        money.Amount `json:"amount"`
+	Note          string       `json:"note"`
+	Status        string       `json:"status" example:"pending"`
+	Reason        string       `json:"reason,omitempty"`
+	DecidedByID   *string      `json:"decided_by_id,omitempty"`
+	DecidedAt     *time.Time   `json:"decided_at,omitempty"`
+	TransactionID *string      `json:"transaction_id,omitempty"`
+	CreatedAt     time.Time    `json:"created_at"`
+	UpdatedAt     time.Time    `json:"updated_at"`
+
+	Account   *account.Account   `json:"account,omitempty"`
+	Customer  *customer.Customer `json:"customer,omitempty"`
+	DecidedBy *user.User         `json:"decided_by,omitempty"`
+}
+
+// WithdrawalFromModel converts the withdrawal request model and its loaded relations to a Withdrawal.
+func WithdrawalFromModel(rec *models.WithdrawalRequest) *Withdrawal {
+	m := &Withdrawal{
+		ID:            rec.ID,
+		CustomerID:    rec.CustomerID,
+		AccountID:     rec.AccountID,
+		Amount:        money.Amount(rec.Amount),
+		Note:          rec.Note,
+		Status:        rec.Status,
+		Reason:        rec.Reason,
+		DecidedByID:   rec.DecidedByID.Ptr(),
+		TransactionID: rec.TransactionID.Ptr(),
+		CreatedAt:     time.Unix(rec.CreatedAt, 0).UTC(),
+		UpdatedAt:     time.Unix(rec.UpdatedAt, 0).UTC(),
+	}
+
+	if rec.DecidedAt.Valid {
+		decidedAt := time.Unix(rec.DecidedAt.Int64, 0).UTC()
+		m.DecidedAt = &decidedAt
+	}
+
+	if rec.R != nil {
+		if rec.R.Account != nil {
+			m.Account = account.FromModel(rec.R.Account)
+		}
+		if rec.R.Customer != nil {
+			m.Customer = customer.FromModel(rec.R.Customer)
+		}
+		if rec.R.DecidedBy != nil {
+			m.DecidedBy = user.FromModel(rec.R.DecidedBy)
+		}
+	}
+
+	return m
+}
+
+// WithdrawalResponse represents a withdrawal request that is returned for display.
+type WithdrawalResponse struct {
+	ID            string            `json:"id" example:"985f1746-1d9f-459f-a2d9-fc53ece5ae86"`
+	CustomerID    string            `json:"customer_id" example:"985f1746-1d9f-459f-a2d9-fc53ece5ae86"`
+	Customer      string            `json:"customer,omitempty"`
+	PhoneNumber   string            `json:"phone_number,omitempty"`
+	AccountID     string            `json:"account_id" example:"985f1746-1d9f-459f-a2d9-fc53ece5ae86"`
+	AccountNumber string            `json:"account_number,omitempty" example:"SB10003001"`
+	Amount        money.Amount      `json:"amount"`
+	Note          string            `json:"note"`
+	Status        string            `json:"status" example:"pending"`
+	Reason        string            `json:"reason,omitempty"`
+	DecidedByID   *string           `json:"decided_by_id,omitempty"`
+	DecidedBy     string            `json:"decided_by,omitempty"`
+	DecidedAt     *web.TimeResponse `json:"decided_at,omitempty"`
+	TransactionID *string           `json:"transaction_id,omitempty"`
+	CreatedAt     web.TimeResponse  `json:"created_at"`
+	UpdatedAt     web.TimeResponse  `json:"updated_at"`
+}
+
+// Response transforms Withdrawal to the WithdrawalResponse that is used for display.
+func (m *Withdrawal) Response(ctx context.Context) *WithdrawalResponse {
+	if m == nil {
+		return nil
+	}
+
+	r := &WithdrawalResponse{
+		ID:            m.ID,
+		CustomerID:    m.CustomerID,
+		AccountID:     m.AccountID,
+		Amount:        m.Amount,
+		Note:          m.Note,
+		Status:        m.Status,
+		Reason:        m.Reason,
+		DecidedByID:   m.DecidedByID,
+		TransactionID: m.TransactionID,
+		CreatedAt:     web.NewTimeResponse(ctx, m.CreatedAt),
+		UpdatedAt:     web.NewTimeResponse(ctx, m.UpdatedAt),
+	}
+
+	if m.DecidedAt != nil {
+		at := web.NewTimeResponse(ctx, *m.DecidedAt)
+		r.DecidedAt = &at
+	}
+
+	if m.Account != nil {
+		r.AccountNumber = m.Account.Number
+	}
+	if m.Customer != nil {
+		r.Customer = m.Customer.Name
+		r.PhoneNumber = m.Customer.PhoneNumber
+	}
+	if m.DecidedBy != nil {
+		r.DecidedBy = m.DecidedBy.LastName + " " + m.DecidedBy.FirstName
+	}
+
+	return r
+}
+
+// Withdrawals a list of Withdrawals.
+type Withdrawals []*Withdrawal
+
+// Response transforms a list of Withdrawals to a list of WithdrawalResponses.
+func (m *Withdrawals) Response(ctx context.Context) []*WithdrawalResponse {
+	var l = make([]*WithdrawalResponse, 0)
+	if m != nil && len(*m) > 0 {
+		for _, n := range *m {
+			l = append(l, n.Response(ctx))
+		}
+	}
+
+	return l
+}
+
+// WithdrawalCreateRequest contains the withdrawal a customer requests from one of their accounts.
+type WithdrawalCreateRequest struct {
+	AccountID string       `json:"account_id" validate:"required,uuid" example:"985f1746-1d9f-459f-a2d9-fc53ece5ae86"`
+	Amount    money.Amount `json:"amount" validate:"required,gt=0"`
+	Note      string       `json:"note" validate:"omitempty,max=500" example:"Send it to my GTBank account 0123456789"`
+}
+
+// WithdrawalFindRequest defines the withdrawal requests to find, most recent first.
+type WithdrawalFindRequest struct {
+	Statuses []string `json:"statuses"`
+	Limit    *uint    `json:"limit" example:"50"`
+}
+
+// WithdrawalProcessRequest records the transaction a teller paid a withdrawal request out with.
+type WithdrawalProcessRequest struct {
+	ID            string `json:"id" validate:"required,uuid" example:"985f1746-1d9f-459f-a2d9-fc53ece5ae86"`
+	AccountID     string `json:"account_id" validate:"required,uuid" example:"985f1746-1d9f-459f-a2d9-fc53ece5ae86"`
+	TransactionID string `json:"transaction_id" validate:"required,uuid" example:"985f1746-1d9f-459f-a2d9-fc53ece5ae86"`
+}
+
+// WithdrawalDeclineRequest defines the withdrawal request to decline and why.
+type WithdrawalDeclineRequest struct {
+	ID     string `json:"id" validate:"required,uuid" example:"985f1746-1d9f-459f-a2d9-fc53ece5ae86"`
+	Reason string `json:"reason" validate:"required,max=200" example:"Visit the branch with your ID to withdraw"`
+}
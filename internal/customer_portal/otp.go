@@ -0,0 +1,341 @@
+package customer_portal
+
+import (
+	"context"
+	"crypto/rand"
+	"database/sql"
+	"fmt"
+	"math/big"
+	"net/http"
+	"time"
+
+	"github.com/pborman/uuid"
+	"github.com/pkg/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	. "github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"golang.org/x/crypto/bcrypt"
+	"gopkg.in/DataDog/dd-trace-go.v1/ddtrace/tracer"
+
+	"merryworld/surebank/internal/platform/auth"
+	"merryworld/surebank/internal/platform/web/webcontext"
+	"merryworld/surebank/internal/platform/web/weberror"
+	"merryworld/surebank/internal/postgres/models"
+	"merryworld/surebank/internal/tenant/account_preference"
+	"merryworld/surebank/internal/user_auth"
+)
+
+var (
+	// ErrNotFound abstracts the postgres not found error.
+	ErrNotFound = errors.New("Entity not found")
+
+	// ErrForbidden occurs when a user tries to do something that is forbidden to them according to our access control policies.
+	ErrForbidden = errors.New("Attempted action is not allowed")
+
+	// ErrInvalidCode occurs when a customer logs in with a code that is wrong, used, expired or
+	// was guessed at too many times. The error is the same for each so codes cannot be probed.
+	ErrInvalidCode = errors.New("The code is not valid, request a new code and try again")
+)
+
+// CanSendCode reports whether another login code can be sent to a customer who was last sent
+// one at lastSent and was sent sentLastHour codes in the hour before now.
+func CanSendCode(lastSent time.Time, sentLastHour int, now time.Time) bool {
+	if sentLastHour >= MaxCodesPerHour {
+		return false
+	}
+	return lastSent.IsZero() || !now.Before(lastSent.Add(CodeResendWait))
+}
+
+// generateCode returns a random code of CodeLength digits.
+func generateCode() (string, error) {
+	max := big.NewInt(1)
+	for i := 0; i < CodeLength; i++ {
+		max.Mul(max, big.NewInt(10))
+	}
+
+	n, err := rand.Int(rand.Reader, max)
+	if err != nil {
+		return "", errors.WithStack(err)
+	}
+
+	return fmt.Sprintf("%0*d", CodeLength, n), nil
+}
+
+// RequestCode sends a login code to the phone number when it belongs to a single customer, the
+// customers that share a number are sent a notice to visit their branch instead. The result is the
+// same when the number is not registered or too many messages were sent to it, so the portal
+// cannot be used to find out who banks with us or to flood a phone with messages.
+func (repo *Repository) RequestCode(ctx context.Context, req RequestCodeRequest, now time.Time) error {
+	span, ctx := tracer.StartSpanFromContext(ctx, "internal.customer_portal.RequestCode")
+	defer span.Finish()
+
+	v := webcontext.Validator()
+	if err := v.Struct(req); err != nil {
+		return err
+	}
+
+	// If now empty set it to the current time.
+	if now.IsZero() {
+		now = time.Now()
+	}
+
+	// Always store the time as UTC.
+	now = now.UTC()
+
+	// Postgres truncates times to milliseconds when storing. We and do the same
+	// here so the value we return is consistent with what we store.
+	now = now.Truncate(time.Millisecond)
+
+	customers, err := repo.CustomerRepo.FindByPhone(ctx, auth.Claims{}, req.PhoneNumber)
+	if err != nil {
+		return err
+	} else if len(customers) == 0 {
+		return nil
+	}
+
+	var customerIDs []string
+	for _, c := range customers {
+		customerIDs = append(customerIDs, c.ID)
+	}
+
+	tx, err := repo.DbConn.Begin()
+	if err != nil {
+		return err
+	}
+
+	// Lock the customers on the phone so concurrent requests are counted one after the other.
+	if _, err := models.Customers(
+		models.CustomerWhere.ID.IN(customerIDs),
+		For("UPDATE"),
+	).All(ctx, tx); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+
+	// Codes are limited per phone, across all the customers that share it.
+	recent, err := models.CustomerLoginCodes(
+		models.CustomerLoginCodeWhere.CustomerID.IN(customerIDs),
+		models.CustomerLoginCodeWhere.CreatedAt.GT(now.Add(-time.Hour).Unix()),
+		OrderBy(models.CustomerLoginCodeColumns.CreatedAt+" desc"),
+	).All(ctx, tx)
+	if err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+
+	var lastSent time.Time
+	if len(recent) > 0 {
+		lastSent = time.Unix(recent[0].CreatedAt, 0).UTC()
+	}
+	if !CanSendCode(lastSent, len(recent), now) {
+		_ = tx.Rollback()
+		return nil
+	}
+
+	cust := customers[0]
+
+	m := models.CustomerLoginCode{
+		ID:          uuid.NewRandom().String(),
+		CustomerID:  cust.ID,
+		PhoneNumber: req.PhoneNumber,
+		ExpiresAt:   now.Add(CodeTTL).Unix(),
+		CreatedAt:   now.Unix(),
+	}
+
+	to := cust.PhoneNumber
+	var data map[string]interface{}
+	if len(customers) > 1 {
+		// Codes cannot tell customers who share a phone number apart, they are sent a notice
+		// instead. The notice is kept as a used code without a hash so it counts towards the
+		// limit and can never be logged in with.
+		m.ExpiresAt = now.Unix()
+		m.UsedAt = null.Int64From(now.Unix())
+		to = req.PhoneNumber
+		data = map[string]interface{}{}
+	} else {
+		code, err := generateCode()
+		if err != nil {
+			_ = tx.Rollback()
+			return err
+		}
+
+		codeHash, err := bcrypt.GenerateFromPassword([]byte(code), bcrypt.DefaultCost)
+		if err != nil {
+			_ = tx.Rollback()
+			return errors.Wrap(err, "generating code hash")
+		}
+		m.CodeHash = string(codeHash)
+
+		data = map[string]interface{}{
+			"Name":    cust.Name,
+			"Code":    code,
+			"Minutes": int(CodeTTL.Minutes()),
+		}
+	}
+
+	if err := m.Insert(ctx, tx, boil.Infer()); err != nil {
+		_ = tx.Rollback()
+		return errors.WithMessage(err, "Insert login code failed")
+	}
+
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+
+	if err := repo.notifySMS.Send(ctx, to, "sms/login_code", data); err != nil {
+		return errors.WithMessage(err, "Send login code failed")
+	}
+
+	return nil
+}
+
+// Login checks the code sent to the phone number of the customer and returns a token with the
+// customer role for the portal. The token is for the company account the sales rep of the
+// customer works in.
+func (repo *Repository) Login(ctx context.Context, req LoginRequest, expires time.Duration, now time.Time) (user_auth.Token, error) {
+	span, ctx := tracer.StartSpanFromContext(ctx, "internal.customer_portal.Login")
+	defer span.Finish()
+
+	v := webcontext.Validator()
+	if err := v.Struct(req); err != nil {
+		return user_auth.Token{}, err
+	}
+
+	// If now empty set it to the current time.
+	if now.IsZero() {
+		now = time.Now()
+	}
+
+	// Always store the time as UTC.
+	now = now.UTC()
+
+	// Postgres truncates times to milliseconds when storing. We and do the same
+	// here so the value we return is consistent with what we store.
+	now = now.Truncate(time.Millisecond)
+
+	invalidCode := weberror.NewError(ctx, ErrInvalidCode, http.StatusBadRequest)
+
+	customers, err := repo.CustomerRepo.FindByPhone(ctx, auth.Claims{}, req.PhoneNumber)
+	if err != nil {
+		return user_auth.Token{}, err
+	} else if len(customers) != 1 {
+		return user_auth.Token{}, invalidCode
+	}
+	cust := customers[0]
+
+	tx, err := repo.DbConn.Begin()
+	if err != nil {
+		return user_auth.Token{}, err
+	}
+
+	rec, err := models.CustomerLoginCodes(
+		models.CustomerLoginCodeWhere.CustomerID.EQ(cust.ID),
+		models.CustomerLoginCodeWhere.UsedAt.IsNull(),
+		models.CustomerLoginCodeWhere.ExpiresAt.GT(now.Unix()),
+		OrderBy(models.CustomerLoginCodeColumns.CreatedAt+" desc"),
+		For("UPDATE"),
+	).One(ctx, tx)
+	if err != nil {
+		_ = tx.Rollback()
+		if errors.Cause(err) == sql.ErrNoRows {
+			return user_auth.Token{}, invalidCode
+		}
+		return user_auth.Token{}, err
+	}
+
+	if rec.Attempts >= MaxCodeAttempts {
+		_ = tx.Rollback()
+		return user_auth.Token{}, invalidCode
+	}
+
+	if bcrypt.CompareHashAndPassword([]byte(rec.CodeHash), []byte(req.Code)) != nil {
+		rec.Attempts++
+		if _, err := rec.Update(ctx, tx, boil.Whitelist(models.CustomerLoginCodeColumns.Attempts)); err != nil {
+			_ = tx.Rollback()
+			return user_auth.Token{}, errors.WithMessage(err, "Update login code failed")
+		}
+		if err := tx.Commit(); err != nil {
+			return user_auth.Token{}, err
+		}
+		return user_auth.Token{}, invalidCode
+	}
+
+	rec.UsedAt = null.Int64From(now.Unix())
+	if _, err := rec.Update(ctx, tx, boil.Whitelist(models.CustomerLoginCodeColumns.UsedAt)); err != nil {
+		_ = tx.Rollback()
+		return user_auth.Token{}, errors.WithMessage(err, "Update login code failed")
+	}
+
+	if err := tx.Commit(); err != nil {
+		return user_auth.Token{}, err
+	}
+
+	return repo.generateToken(ctx, cust.ID, cust.SalesRepID, expires, now)
+}
+
+const salesRepAccountStatement = `SELECT ua.account_id, a.timezone FROM users_accounts ua
+	JOIN accounts a ON a.id = ua.account_id
+	WHERE ua.user_id = $1 AND ua.archived_at IS NULL AND a.archived_at IS NULL
+	ORDER BY ua.created_at LIMIT 1`
+
+// generateToken returns the token for the claims of the customer. The claims are for the
+// account of the sales rep and use its preferences to display dates.
+func (repo *Repository) generateToken(ctx context.Context, customerID, salesRepID string, expires time.Duration, now time.Time) (user_auth.Token, error) {
+	var (
+		accountID string
+		timezone  sql.NullString
+	)
+	if err := repo.DbConn.QueryRowContext(ctx, salesRepAccountStatement, salesRepID).Scan(&accountID, &timezone); err != nil {
+		return user_auth.Token{}, errors.WithMessage(user_auth.ErrAuthenticationFailure, "no account for the sales rep of the customer")
+	}
+
+	var tz *time.Location
+	if timezone.Valid && timezone.String != "" {
+		tz, _ = time.LoadLocation(timezone.String)
+	}
+
+	prefs, err := repo.AccountPreference.FindByAccountID(ctx, auth.Claims{}, account_preference.AccountPreferenceFindByAccountIDRequest{
+		AccountID: accountID,
+	})
+	if err != nil {
+		return user_auth.Token{}, err
+	}
+
+	datetimeFormat := account_preference.AccountPreference_Datetime_Format_Default
+	dateFormat := account_preference.AccountPreference_Date_Format_Default
+	timeFormat := account_preference.AccountPreference_Time_Format_Default
+	for _, pref := range prefs {
+		switch pref.Name {
+		case account_preference.AccountPreference_Datetime_Format:
+			datetimeFormat = pref.Value
+		case account_preference.AccountPreference_Date_Format:
+			dateFormat = pref.Value
+		case account_preference.AccountPreference_Time_Format:
+			timeFormat = pref.Value
+		}
+	}
+	claimPref := auth.NewClaimPreferences(tz, datetimeFormat, dateFormat, timeFormat)
+
+	// Subject: The ID of the customer authenticated.
+	// Audience: The ID of the account of the sales rep, the customer cannot switch accounts.
+	claims := auth.NewClaims(customerID, accountID, []string{accountID}, []string{auth.RoleCustomer}, claimPref, now, expires)
+
+	tknStr, err := repo.TknGen.GenerateToken(claims)
+	if err != nil {
+		return user_auth.Token{}, errors.Wrap(err, "generating token")
+	}
+
+	tkn := user_auth.Token{
+		AccessToken: tknStr,
+		TokenType:   "Bearer",
+		UserID:      claims.Subject,
+		AccountID:   claims.Audience,
+	}
+
+	if expires.Seconds() > 0 {
+		tkn.Expiry = now.Add(expires)
+		tkn.TTL = expires
+	}
+
+	return tkn, nil
+}
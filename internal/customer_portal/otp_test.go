@@ -0,0 +1,219 @@
+package customer_portal
+
+import (
+	"encoding/binary"
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+	"time"
+	"unicode"
+
+	"github.com/pborman/uuid"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+
+	"merryworld/surebank/internal/account"
+	"merryworld/surebank/internal/customer"
+	"merryworld/surebank/internal/platform/notify"
+	"merryworld/surebank/internal/platform/tests"
+	"merryworld/surebank/internal/postgres/models"
+	"merryworld/surebank/internal/tenant/account_preference"
+)
+
+var (
+	test     *tests.Test
+	repo     *Repository
+	smsLocal *notify.SMSLocal
+)
+
+// TestMain is the entry point for testing.
+func TestMain(m *testing.M) {
+	os.Exit(testMain(m))
+}
+
+func testMain(m *testing.M) int {
+	test = tests.New()
+	defer test.TearDown()
+
+	smsLocal = notify.NewSMSLocal("../../resources/templates/shared")
+
+	repo = NewRepository(test.MasterDB, nil, customer.NewRepository(test.MasterDB, nil),
+		account.NewRepository(test.MasterDB), account_preference.NewRepository(test.MasterDB), smsLocal)
+
+	return m.Run()
+}
+
+// mockPhoneNumber returns a phone number no other test uses.
+func mockPhoneNumber() string {
+	return fmt.Sprintf("080%08d", binary.BigEndian.Uint32(uuid.NewRandom())%100000000)
+}
+
+// newTestCustomer creates a branch, sales rep and a customer with the phone number.
+func newTestCustomer(t *testing.T, phoneNumber string, now time.Time) *models.Customer {
+	ctx := tests.Context()
+
+	branch := models.Branch{
+		ID:        uuid.NewRandom().String(),
+		Name:      "Branch " + uuid.NewRandom().String(),
+		CreatedAt: now.Unix(),
+		UpdatedAt: now.Unix(),
+	}
+	if err := branch.Insert(ctx, test.MasterDB, boil.Infer()); err != nil {
+		t.Fatalf("\t%s\tInsert branch failed: %v", tests.Failed, err)
+	}
+
+	salesRep := models.User{
+		ID:          uuid.NewRandom().String(),
+		BranchID:    branch.ID,
+		Email:       uuid.NewRandom().String() + "@example.com",
+		FirstName:   "Sales",
+		LastName:    "Rep",
+		PhoneNumber: "08000000000",
+		CreatedAt:   now,
+	}
+	if err := salesRep.Insert(ctx, test.MasterDB, boil.Infer()); err != nil {
+		t.Fatalf("\t%s\tInsert sales rep failed: %v", tests.Failed, err)
+	}
+
+	cust := models.Customer{
+		ID:          uuid.NewRandom().String(),
+		BranchID:    branch.ID,
+		Email:       uuid.NewRandom().String() + "@example.com",
+		Name:        "Test Customer",
+		PhoneNumber: phoneNumber,
+		SalesRepID:  salesRep.ID,
+		CreatedAt:   now.Unix(),
+		UpdatedAt:   now.Unix(),
+	}
+	if err := cust.Insert(ctx, test.MasterDB, boil.Infer()); err != nil {
+		t.Fatalf("\t%s\tInsert customer failed: %v", tests.Failed, err)
+	}
+
+	return &cust
+}
+
+// smsSentTo returns the messages the local SMS provider sent to the phone number.
+func smsSentTo(phoneNumber string) []string {
+	var res []string
+	for _, m := range smsLocal.Sent() {
+		if m.PhoneNumber == phoneNumber {
+			res = append(res, m.Message)
+		}
+	}
+	return res
+}
+
+// TestCanSendCode validates login codes are not sent too often to the same customer.
+func TestCanSendCode(t *testing.T) {
+	now := time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+
+	type sendTest struct {
+		Name         string
+		LastSent     time.Time
+		SentLastHour int
+		Allowed      bool
+	}
+
+	var sendTests = []sendTest{
+		{"never sent", time.Time{}, 0, true},
+		{"sent a minute ago", now.Add(-CodeResendWait), 1, true},
+		{"sent seconds ago", now.Add(-10 * time.Second), 1, false},
+		{"hourly limit reached", now.Add(-10 * time.Minute), MaxCodesPerHour, false},
+		{"below hourly limit", now.Add(-10 * time.Minute), MaxCodesPerHour - 1, true},
+	}
+
+	t.Log("Given the need to limit the login codes sent to a phone.")
+	{
+		for i, tt := range sendTests {
+			t.Logf("\tTest: %d\tWhen the code was %s.", i, tt.Name)
+			{
+				if got := CanSendCode(tt.LastSent, tt.SentLastHour, now); got != tt.Allowed {
+					t.Logf("\t\tGot : %v", got)
+					t.Logf("\t\tWant: %v", tt.Allowed)
+					t.Fatalf("\t%s\tShould decide if another code is sent.", tests.Failed)
+				}
+				t.Logf("\t%s\tShould decide if another code is sent.", tests.Success)
+			}
+		}
+	}
+}
+
+// TestGenerateCode validates login codes are made of CodeLength digits.
+func TestGenerateCode(t *testing.T) {
+	t.Log("Given the need to send customers a code to log in with.")
+	{
+		t.Log("\tTest: 0\tWhen generating codes.")
+		{
+			seen := make(map[string]bool)
+			for i := 0; i < 100; i++ {
+				code, err := generateCode()
+				if err != nil {
+					t.Log("\t\tGot :", err)
+					t.Fatalf("\t%s\tShould generate a code.", tests.Failed)
+				}
+				if len(code) != CodeLength {
+					t.Logf("\t\tGot : %q", code)
+					t.Fatalf("\t%s\tShould have %d digits.", tests.Failed, CodeLength)
+				}
+				for _, r := range code {
+					if !unicode.IsDigit(r) {
+						t.Logf("\t\tGot : %q", code)
+						t.Fatalf("\t%s\tShould only have digits.", tests.Failed)
+					}
+				}
+				seen[code] = true
+			}
+			t.Logf("\t%s\tShould have %d digits.", tests.Success, CodeLength)
+
+			if len(seen) < 90 {
+				t.Logf("\t\tGot : %d different codes", len(seen))
+				t.Fatalf("\t%s\tShould not repeat codes.", tests.Failed)
+			}
+			t.Logf("\t%s\tShould not repeat codes.", tests.Success)
+		}
+	}
+}
+
+// TestRequestCodeSharedPhone validates a phone number several customers share is not sent more
+// than MaxCodesPerHour messages in an hour.
+func TestRequestCodeSharedPhone(t *testing.T) {
+	defer tests.Recover(t)
+
+	now := time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+
+	phone := mockPhoneNumber()
+	newTestCustomer(t, phone, now)
+	newTestCustomer(t, phone, now)
+
+	t.Log("Given the need to limit the messages sent to a phone number customers share.")
+	{
+		t.Log("\tTest: 0\tWhen codes are requested more often than allowed.")
+		{
+			ctx := tests.Context()
+
+			for i := 0; i < MaxCodesPerHour*2; i++ {
+				err := repo.RequestCode(ctx, RequestCodeRequest{PhoneNumber: phone}, now.Add(time.Duration(i)*CodeResendWait))
+				if err != nil {
+					t.Log("\t\tGot :", err)
+					t.Fatalf("\t%s\tRequest code failed.", tests.Failed)
+				}
+			}
+
+			sent := smsSentTo(phone)
+			if len(sent) != MaxCodesPerHour {
+				t.Logf("\t\tGot : %d messages", len(sent))
+				t.Logf("\t\tWant: %d messages", MaxCodesPerHour)
+				t.Fatalf("\t%s\tShould stop sending messages to the phone.", tests.Failed)
+			}
+			t.Logf("\t%s\tShould stop sending messages to the phone.", tests.Success)
+
+			for _, msg := range sent {
+				if strings.Contains(msg, "login code is") {
+					t.Logf("\t\tGot : %q", msg)
+					t.Fatalf("\t%s\tShould not send a code to a shared phone.", tests.Failed)
+				}
+			}
+			t.Logf("\t%s\tShould not send a code to a shared phone.", tests.Success)
+		}
+	}
+}
@@ -0,0 +1,199 @@
+package customer_portal
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/pkg/errors"
+	. "github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"gopkg.in/DataDog/dd-trace-go.v1/ddtrace/tracer"
+
+	"merryworld/surebank/internal/account"
+	"merryworld/surebank/internal/customer"
+	"merryworld/surebank/internal/platform/auth"
+	"merryworld/surebank/internal/platform/web/weberror"
+	"merryworld/surebank/internal/postgres/models"
+	"merryworld/surebank/internal/transaction"
+)
+
+// CyclesShown is the number of the most recent DS cycles of an account shown in the portal.
+const CyclesShown = 12
+
+// Customer gets the customer logged in to the portal.
+func (repo *Repository) Customer(ctx context.Context, claims auth.Claims) (*customer.Customer, error) {
+	span, ctx := tracer.StartSpanFromContext(ctx, "internal.customer_portal.Customer")
+	defer span.Finish()
+
+	if claims.Audience == "" || !claims.IsCustomer() {
+		return nil, errors.WithStack(ErrForbidden)
+	}
+
+	return repo.CustomerRepo.ReadByID(ctx, claims, claims.Subject)
+}
+
+// Accounts gets the open accounts of the customer logged in to the portal with what can be
+// withdrawn from each.
+func (repo *Repository) Accounts(ctx context.Context, claims auth.Claims, now time.Time) ([]*AccountResponse, error) {
+	span, ctx := tracer.StartSpanFromContext(ctx, "internal.customer_portal.Accounts")
+	defer span.Finish()
+
+	if claims.Audience == "" || !claims.IsCustomer() {
+		return nil, errors.WithStack(ErrForbidden)
+	}
+
+	slice, err := models.Accounts(
+		models.AccountWhere.CustomerID.EQ(claims.Subject),
+		models.AccountWhere.ArchivedAt.IsNull(),
+		Load(models.AccountRels.Product),
+		OrderBy(models.AccountColumns.CreatedAt),
+	).All(ctx, repo.DbConn)
+	if err != nil {
+		return nil, err
+	}
+
+	var result []*AccountResponse
+	for _, rec := range slice {
+		res, err := repo.accountResponse(ctx, account.FromModel(rec), now)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, res)
+	}
+
+	return result, nil
+}
+
+// Account gets an account of the customer logged in to the portal with the progress towards its
+// goal. Accounts of other customers are not found.
+func (repo *Repository) Account(ctx context.Context, claims auth.Claims, accountID string, now time.Time) (*AccountResponse, error) {
+	span, ctx := tracer.StartSpanFromContext(ctx, "internal.customer_portal.Account")
+	defer span.Finish()
+
+	if claims.Audience == "" || !claims.IsCustomer() {
+		return nil, errors.WithStack(ErrForbidden)
+	}
+
+	rec, err := repo.readAccount(ctx, claims, accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	a := account.FromModel(rec)
+	if err := repo.AccountRepo.LoadGoal(ctx, a, now); err != nil {
+		return nil, err
+	}
+
+	return repo.accountResponse(ctx, a, now)
+}
+
+// Transactions gets a page of the transactions of an account of the customer logged in to the
+// portal, most recent first, with the total number of transactions. Reversed transactions are
+// left out.
+func (repo *Repository) Transactions(ctx context.Context, claims auth.Claims, accountID string, limit, offset int) (transaction.Transactions, int64, error) {
+	span, ctx := tracer.StartSpanFromContext(ctx, "internal.customer_portal.Transactions")
+	defer span.Finish()
+
+	if claims.Audience == "" || !claims.IsCustomer() {
+		return nil, 0, errors.WithStack(ErrForbidden)
+	}
+
+	if _, err := repo.readAccount(ctx, claims, accountID); err != nil {
+		return nil, 0, err
+	}
+
+	where := []QueryMod{
+		models.TransactionWhere.AccountID.EQ(accountID),
+		models.TransactionWhere.ArchivedAt.IsNull(),
+		models.TransactionWhere.ReversalOfID.IsNull(),
+		Where(fmt.Sprintf("NOT EXISTS (SELECT 1 FROM %s r WHERE r.%s = %s.%s)",
+			models.TableNames.Transaction, models.TransactionColumns.ReversalOfID,
+			models.TableNames.Transaction, models.TransactionColumns.ID)),
+	}
+
+	total, err := models.Transactions(where...).Count(ctx, repo.DbConn)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	queries := append(where,
+		OrderBy(models.TransactionColumns.CreatedAt+" desc"),
+		Limit(limit),
+		Offset(offset),
+	)
+	slice, err := models.Transactions(queries...).All(ctx, repo.DbConn)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	var result transaction.Transactions
+	for _, rec := range slice {
+		result = append(result, transaction.FromModel(rec))
+	}
+
+	return result, total, nil
+}
+
+// Cycles gets the most recent DS cycles of an account of the customer logged in to the portal.
+func (repo *Repository) Cycles(ctx context.Context, claims auth.Claims, accountID string) (transaction.Cycles, error) {
+	span, ctx := tracer.StartSpanFromContext(ctx, "internal.customer_portal.Cycles")
+	defer span.Finish()
+
+	if claims.Audience == "" || !claims.IsCustomer() {
+		return nil, errors.WithStack(ErrForbidden)
+	}
+
+	if _, err := repo.readAccount(ctx, claims, accountID); err != nil {
+		return nil, err
+	}
+
+	slice, err := models.DSCycles(
+		models.DSCycleWhere.AccountID.EQ(accountID),
+		OrderBy(models.DSCycleColumns.Number+" desc"),
+		Limit(CyclesShown),
+	).All(ctx, repo.DbConn)
+	if err != nil {
+		return nil, err
+	}
+
+	var result transaction.Cycles
+	for _, rec := range slice {
+		result = append(result, transaction.CycleFromModel(rec))
+	}
+
+	return result, nil
+}
+
+// readAccount gets an open account of the customer logged in to the portal.
+func (repo *Repository) readAccount(ctx context.Context, claims auth.Claims, accountID string) (*models.Account, error) {
+	rec, err := models.Accounts(
+		models.AccountWhere.ID.EQ(accountID),
+		models.AccountWhere.CustomerID.EQ(claims.Subject),
+		models.AccountWhere.ArchivedAt.IsNull(),
+		Load(models.AccountRels.Product),
+	).One(ctx, repo.DbConn)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, weberror.NewError(ctx, ErrNotFound, http.StatusNotFound)
+		}
+		return nil, err
+	}
+
+	return rec, nil
+}
+
+// accountResponse returns the account for display with the amount held on it now.
+func (repo *Repository) accountResponse(ctx context.Context, a *account.Account, now time.Time) (*AccountResponse, error) {
+	held, err := account.HeldAmount(ctx, repo.DbConn, a.ID, now)
+	if err != nil {
+		return nil, err
+	}
+
+	return &AccountResponse{
+		Response:  a.Response(ctx),
+		Held:      held,
+		Available: account.AvailableBalance(a.Balance, held),
+	}, nil
+}
@@ -0,0 +1,113 @@
+package customer_portal
+
+import (
+	"testing"
+	"time"
+
+	"github.com/dgrijalva/jwt-go"
+	"github.com/pborman/uuid"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+
+	"merryworld/surebank/internal/customer"
+	"merryworld/surebank/internal/dscommission"
+	"merryworld/surebank/internal/ledger"
+	"merryworld/surebank/internal/platform/auth"
+	"merryworld/surebank/internal/platform/money"
+	"merryworld/surebank/internal/platform/notify"
+	"merryworld/surebank/internal/platform/tests"
+	"merryworld/surebank/internal/postgres/models"
+	"merryworld/surebank/internal/profit"
+	"merryworld/surebank/internal/transaction"
+)
+
+// TestTransactions validates the portal lists the transactions of an account without the
+// deposits that were corrected or their reversals.
+func TestTransactions(t *testing.T) {
+	defer tests.Recover(t)
+
+	now := time.Date(2026, 10, 18, 9, 0, 0, 0, time.UTC)
+
+	cust := newTestCustomer(t, mockPhoneNumber(), now)
+
+	t.Log("Given the need to show customers the transactions of their accounts.")
+	{
+		ctx := tests.Context()
+
+		product, err := models.AccountProducts(models.AccountProductWhere.Code.EQ(customer.AccountTypeSB)).One(ctx, test.MasterDB)
+		if err != nil {
+			t.Log("\t\tGot :", err)
+			t.Fatalf("\t%s\tRead account product failed.", tests.Failed)
+		}
+
+		acc := models.Account{
+			ID:          uuid.NewRandom().String(),
+			BranchID:    cust.BranchID,
+			Number:      uuid.NewRandom().String()[:8],
+			CustomerID:  cust.ID,
+			AccountType: product.Code,
+			ProductID:   product.ID,
+			SalesRepID:  cust.SalesRepID,
+			CreatedAt:   now.Unix(),
+			UpdatedAt:   now.Unix(),
+		}
+		if err := acc.Insert(ctx, test.MasterDB, boil.Infer()); err != nil {
+			t.Log("\t\tGot :", err)
+			t.Fatalf("\t%s\tInsert account failed.", tests.Failed)
+		}
+
+		audience := uuid.NewRandom().String()
+		staffClaims := auth.Claims{
+			Roles: []string{auth.RoleAdmin},
+			StandardClaims: jwt.StandardClaims{
+				Subject:  cust.SalesRepID,
+				Audience: audience,
+			},
+		}
+		customerClaims := auth.Claims{
+			Roles: []string{auth.RoleCustomer},
+			StandardClaims: jwt.StandardClaims{
+				Subject:  cust.ID,
+				Audience: audience,
+			},
+		}
+
+		txRepo := transaction.NewRepository(test.MasterDB, dscommission.NewRepository(test.MasterDB), profit.NewRepository(test.MasterDB),
+			ledger.NewRepository(test.MasterDB), notify.NewSMSDisabled(), notify.NewEmailDisabled(), nil)
+
+		deposit, err := txRepo.Deposit(ctx, staffClaims, transaction.CreateRequest{
+			Type:          transaction.TransactionType_Deposit,
+			AccountNumber: acc.Number,
+			Amount:        money.Naira(500),
+			PaymentMethod: transaction.PaymentMethod_Cash,
+		}, now)
+		if err != nil {
+			t.Log("\t\tGot :", err)
+			t.Fatalf("\t%s\tDeposit failed.", tests.Failed)
+		}
+
+		amount := money.Naira(300)
+		if err := txRepo.Update(ctx, staffClaims, transaction.UpdateRequest{
+			ID:     deposit.ID,
+			Amount: &amount,
+			Reason: "Amount entered wrongly",
+		}, now.Add(time.Minute)); err != nil {
+			t.Log("\t\tGot :", err)
+			t.Fatalf("\t%s\tUpdate failed.", tests.Failed)
+		}
+
+		t.Log("\tTest: 0\tWhen a deposit was corrected.")
+		{
+			txs, total, err := repo.Transactions(ctx, customerClaims, acc.ID, 20, 0)
+			if err != nil {
+				t.Log("\t\tGot :", err)
+				t.Fatalf("\t%s\tShould list the transactions.", tests.Failed)
+			}
+			if total != 1 || len(txs) != 1 || txs[0].Amount != amount {
+				t.Logf("\t\tGot : %d of %d transactions %+v", len(txs), total, txs)
+				t.Logf("\t\tWant: the deposit of %s", amount)
+				t.Fatalf("\t%s\tShould only list the corrected deposit.", tests.Failed)
+			}
+			t.Logf("\t%s\tShould only list the corrected deposit.", tests.Success)
+		}
+	}
+}
@@ -0,0 +1,385 @@
+package customer_portal
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/pborman/uuid"
+	"github.com/pkg/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	. "github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"gopkg.in/DataDog/dd-trace-go.v1/ddtrace/tracer"
+
+	"merryworld/surebank/internal/account"
+	"merryworld/surebank/internal/platform/auth"
+	"merryworld/surebank/internal/platform/money"
+	"merryworld/surebank/internal/platform/web/webcontext"
+	"merryworld/surebank/internal/platform/web/weberror"
+	"merryworld/surebank/internal/postgres/models"
+)
+
+var (
+	// ErrWithdrawalDecided occurs when a withdrawal request that is no longer pending is changed.
+	ErrWithdrawalDecided = errors.New("The withdrawal request is no longer pending")
+
+	// ErrWithdrawalPending occurs when a withdrawal is requested from an account that already has
+	// a pending withdrawal request.
+	ErrWithdrawalPending = errors.New("There is already a pending withdrawal request for the account")
+
+	// ErrWithdrawalAccount occurs when a withdrawal request is paid out of another account.
+	ErrWithdrawalAccount = errors.New("The withdrawal request is for another account")
+
+	// ErrInsufficientBalance occurs when more is requested than the available balance of the account.
+	ErrInsufficientBalance = errors.New("The amount is more than the available balance of the account")
+)
+
+// withdrawalQueries loads the relations of a withdrawal request shown with it.
+func withdrawalQueries() []QueryMod {
+	return []QueryMod{
+		Load(models.WithdrawalRequestRels.Account),
+		Load(models.WithdrawalRequestRels.Customer),
+		Load(models.WithdrawalRequestRels.DecidedBy),
+	}
+}
+
+// FindWithdrawals gets the withdrawal requests, most recent first. Customers only get their own
+// requests and sales reps the requests for the accounts they manage.
+func (repo *Repository) FindWithdrawals(ctx context.Context, claims auth.Claims, req WithdrawalFindRequest) (Withdrawals, error) {
+	span, ctx := tracer.StartSpanFromContext(ctx, "internal.customer_portal.FindWithdrawals")
+	defer span.Finish()
+
+	if claims.Audience == "" {
+		return nil, errors.WithStack(ErrForbidden)
+	}
+
+	queries := withdrawalQueries()
+	if claims.IsCustomer() {
+		queries = append(queries, models.WithdrawalRequestWhere.CustomerID.EQ(claims.Subject))
+	} else if !claims.HasRole(auth.RoleAdmin, auth.RoleSuperAdmin) {
+		queries = append(queries, Where(
+			fmt.Sprintf("%s IN (SELECT id FROM account WHERE sales_rep_id = ?)", models.WithdrawalRequestColumns.AccountID),
+			claims.Subject))
+	}
+
+	if len(req.Statuses) > 0 {
+		queries = append(queries, models.WithdrawalRequestWhere.Status.IN(req.Statuses))
+	}
+
+	queries = append(queries, OrderBy(models.WithdrawalRequestColumns.CreatedAt+" desc"))
+
+	if req.Limit != nil {
+		queries = append(queries, Limit(int(*req.Limit)))
+	}
+
+	slice, err := models.WithdrawalRequests(queries...).All(ctx, repo.DbConn)
+	if err != nil {
+		return nil, err
+	}
+
+	var result Withdrawals
+	for _, rec := range slice {
+		result = append(result, WithdrawalFromModel(rec))
+	}
+
+	return result, nil
+}
+
+// ReadWithdrawal gets the specified withdrawal request for staff to pay out or decline.
+func (repo *Repository) ReadWithdrawal(ctx context.Context, claims auth.Claims, id string) (*Withdrawal, error) {
+	span, ctx := tracer.StartSpanFromContext(ctx, "internal.customer_portal.ReadWithdrawal")
+	defer span.Finish()
+
+	if claims.Audience == "" || claims.IsCustomer() {
+		return nil, errors.WithStack(ErrForbidden)
+	}
+
+	rec, err := models.WithdrawalRequests(append(withdrawalQueries(), models.WithdrawalRequestWhere.ID.EQ(id))...).One(ctx, repo.DbConn)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, weberror.NewError(ctx, ErrNotFound, http.StatusNotFound)
+		}
+		return nil, err
+	}
+
+	return WithdrawalFromModel(rec), nil
+}
+
+// RequestWithdrawal records a withdrawal the customer logged in to the portal requests from one
+// of their accounts. The amount cannot be more than the available balance and an account can
+// only have one pending request. Money is not moved until a teller pays the request out.
+func (repo *Repository) RequestWithdrawal(ctx context.Context, claims auth.Claims, req WithdrawalCreateRequest, now time.Time) (*Withdrawal, error) {
+	span, ctx := tracer.StartSpanFromContext(ctx, "internal.customer_portal.RequestWithdrawal")
+	defer span.Finish()
+
+	if claims.Audience == "" || !claims.IsCustomer() {
+		return nil, errors.WithStack(ErrForbidden)
+	}
+
+	v := webcontext.Validator()
+	if err := v.Struct(req); err != nil {
+		return nil, err
+	}
+
+	// If now empty set it to the current time.
+	if now.IsZero() {
+		now = time.Now()
+	}
+
+	// Always store the time as UTC.
+	now = now.UTC()
+
+	// Postgres truncates times to milliseconds when storing. We and do the same
+	// here so the value we return is consistent with what we store.
+	now = now.Truncate(time.Millisecond)
+
+	tx, err := repo.DbConn.Begin()
+	if err != nil {
+		return nil, err
+	}
+
+	acc, err := models.Accounts(
+		models.AccountWhere.ID.EQ(req.AccountID),
+		models.AccountWhere.CustomerID.EQ(claims.Subject),
+		models.AccountWhere.ArchivedAt.IsNull(),
+		For("UPDATE"),
+	).One(ctx, tx)
+	if err != nil {
+		_ = tx.Rollback()
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, weberror.NewError(ctx, ErrNotFound, http.StatusNotFound)
+		}
+		return nil, err
+	}
+
+	if err := account.CheckStatus(acc.Status, true); err != nil {
+		_ = tx.Rollback()
+		return nil, weberror.NewError(ctx, err, http.StatusBadRequest)
+	}
+
+	held, err := account.HeldAmount(ctx, tx, acc.ID, now)
+	if err != nil {
+		_ = tx.Rollback()
+		return nil, err
+	}
+	if req.Amount > account.AvailableBalance(money.Amount(acc.Balance), held) {
+		_ = tx.Rollback()
+		return nil, weberror.NewError(ctx, ErrInsufficientBalance, http.StatusBadRequest)
+	}
+
+	pending, err := models.WithdrawalRequests(
+		models.WithdrawalRequestWhere.AccountID.EQ(acc.ID),
+		models.WithdrawalRequestWhere.Status.EQ(WithdrawalStatus_Pending),
+	).Exists(ctx, tx)
+	if err != nil {
+		_ = tx.Rollback()
+		return nil, err
+	} else if pending {
+		_ = tx.Rollback()
+		return nil, weberror.NewError(ctx, ErrWithdrawalPending, http.StatusBadRequest)
+	}
+
+	m := models.WithdrawalRequest{
+		ID:         uuid.NewRandom().String(),
+		CustomerID: claims.Subject,
+		AccountID:  acc.ID,
+		Amount:     req.Amount.Kobo(),
+		Note:       req.Note,
+		Status:     WithdrawalStatus_Pending,
+		CreatedAt:  now.Unix(),
+		UpdatedAt:  now.Unix(),
+	}
+	if err := m.Insert(ctx, tx, boil.Infer()); err != nil {
+		_ = tx.Rollback()
+		return nil, errors.WithMessage(err, "Insert withdrawal request failed")
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return WithdrawalFromModel(&m), nil
+}
+
+// CancelWithdrawal cancels a pending withdrawal request of the customer logged in to the portal.
+func (repo *Repository) CancelWithdrawal(ctx context.Context, claims auth.Claims, id string, now time.Time) error {
+	span, ctx := tracer.StartSpanFromContext(ctx, "internal.customer_portal.CancelWithdrawal")
+	defer span.Finish()
+
+	if claims.Audience == "" || !claims.IsCustomer() {
+		return errors.WithStack(ErrForbidden)
+	}
+
+	return repo.decideWithdrawal(ctx, id, now, func(rec *models.WithdrawalRequest) error {
+		if rec.CustomerID != claims.Subject {
+			return weberror.NewError(ctx, ErrNotFound, http.StatusNotFound)
+		}
+		rec.Status = WithdrawalStatus_Cancelled
+		return nil
+	})
+}
+
+// ProcessWithdrawal records the transaction a teller paid a pending withdrawal request out with.
+// The customer is sent the usual SMS for the withdrawal by the transaction.
+func (repo *Repository) ProcessWithdrawal(ctx context.Context, claims auth.Claims, req WithdrawalProcessRequest, now time.Time) error {
+	span, ctx := tracer.StartSpanFromContext(ctx, "internal.customer_portal.ProcessWithdrawal")
+	defer span.Finish()
+
+	if claims.Audience == "" || claims.IsCustomer() {
+		return errors.WithStack(ErrForbidden)
+	}
+
+	v := webcontext.Validator()
+	if err := v.Struct(req); err != nil {
+		return err
+	}
+
+	return repo.decideWithdrawal(ctx, req.ID, now, func(rec *models.WithdrawalRequest) error {
+		if rec.AccountID != req.AccountID {
+			return weberror.NewError(ctx, ErrWithdrawalAccount, http.StatusBadRequest)
+		}
+		rec.Status = WithdrawalStatus_Processed
+		rec.TransactionID = null.StringFrom(req.TransactionID)
+		rec.DecidedByID = null.StringFrom(claims.Subject)
+		return nil
+	})
+}
+
+// ReferWithdrawal marks a pending withdrawal request as referred when the withdrawal a teller
+// made for it is waiting for the approval of a supervisor, and lets the customer know.
+func (repo *Repository) ReferWithdrawal(ctx context.Context, claims auth.Claims, id string, now time.Time) error {
+	span, ctx := tracer.StartSpanFromContext(ctx, "internal.customer_portal.ReferWithdrawal")
+	defer span.Finish()
+
+	if claims.Audience == "" || claims.IsCustomer() {
+		return errors.WithStack(ErrForbidden)
+	}
+
+	err := repo.decideWithdrawal(ctx, id, now, func(rec *models.WithdrawalRequest) error {
+		rec.Status = WithdrawalStatus_Referred
+		rec.DecidedByID = null.StringFrom(claims.Subject)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	repo.notifyWithdrawal(ctx, id)
+
+	return nil
+}
+
+// DeclineWithdrawal declines a pending withdrawal request and sends the reason to the customer.
+func (repo *Repository) DeclineWithdrawal(ctx context.Context, claims auth.Claims, req WithdrawalDeclineRequest, now time.Time) error {
+	span, ctx := tracer.StartSpanFromContext(ctx, "internal.customer_portal.DeclineWithdrawal")
+	defer span.Finish()
+
+	if claims.Audience == "" || claims.IsCustomer() {
+		return errors.WithStack(ErrForbidden)
+	}
+
+	v := webcontext.Validator()
+	if err := v.Struct(req); err != nil {
+		return err
+	}
+
+	err := repo.decideWithdrawal(ctx, req.ID, now, func(rec *models.WithdrawalRequest) error {
+		rec.Status = WithdrawalStatus_Declined
+		rec.Reason = req.Reason
+		rec.DecidedByID = null.StringFrom(claims.Subject)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	repo.notifyWithdrawal(ctx, req.ID)
+
+	return nil
+}
+
+// decideWithdrawal locks a pending withdrawal request, applies the decision to it and saves it.
+func (repo *Repository) decideWithdrawal(ctx context.Context, id string, now time.Time, decide func(rec *models.WithdrawalRequest) error) error {
+
+	// If now empty set it to the current time.
+	if now.IsZero() {
+		now = time.Now()
+	}
+
+	// Always store the time as UTC.
+	now = now.UTC()
+
+	// Postgres truncates times to milliseconds when storing. We and do the same
+	// here so the value we return is consistent with what we store.
+	now = now.Truncate(time.Millisecond)
+
+	tx, err := repo.DbConn.Begin()
+	if err != nil {
+		return err
+	}
+
+	rec, err := models.WithdrawalRequests(models.WithdrawalRequestWhere.ID.EQ(id), For("UPDATE")).One(ctx, tx)
+	if err != nil {
+		_ = tx.Rollback()
+		if errors.Cause(err) == sql.ErrNoRows {
+			return weberror.NewError(ctx, ErrNotFound, http.StatusNotFound)
+		}
+		return err
+	}
+
+	if rec.Status != WithdrawalStatus_Pending {
+		_ = tx.Rollback()
+		return weberror.NewError(ctx, ErrWithdrawalDecided, http.StatusBadRequest)
+	}
+
+	if err := decide(rec); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+
+	rec.DecidedAt = null.Int64From(now.Unix())
+	rec.UpdatedAt = now.Unix()
+	if _, err := rec.Update(ctx, tx, boil.Whitelist(
+		models.WithdrawalRequestColumns.Status,
+		models.WithdrawalRequestColumns.Reason,
+		models.WithdrawalRequestColumns.DecidedByID,
+		models.WithdrawalRequestColumns.DecidedAt,
+		models.WithdrawalRequestColumns.TransactionID,
+		models.WithdrawalRequestColumns.UpdatedAt,
+	)); err != nil {
+		_ = tx.Rollback()
+		return errors.WithMessage(err, "Update withdrawal request failed")
+	}
+
+	return tx.Commit()
+}
+
+// notifyWithdrawal sends the customer an SMS with the decision on their withdrawal request.
+// Failing to send it does not undo the decision.
+func (repo *Repository) notifyWithdrawal(ctx context.Context, id string) {
+	rec, err := models.WithdrawalRequests(
+		models.WithdrawalRequestWhere.ID.EQ(id),
+		Load(models.WithdrawalRequestRels.Account),
+		Load(models.WithdrawalRequestRels.Customer),
+	).One(ctx, repo.DbConn)
+	if err != nil {
+		// TODO: log critical error. Send message to monitoring account
+		fmt.Println(err)
+		return
+	}
+
+	if err := repo.notifySMS.Send(ctx, rec.R.Customer.PhoneNumber, "sms/withdrawal_request",
+		map[string]interface{}{
+			"Name":          rec.R.Customer.Name,
+			"Amount":        money.Amount(rec.Amount),
+			"AccountNumber": rec.R.Account.Number,
+			"Status":        rec.Status,
+			"Reason":        rec.Reason,
+		}); err != nil {
+		// TODO: log critical error. Send message to monitoring account
+		fmt.Println(err)
+	}
+}
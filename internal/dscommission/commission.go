@@ -4,51 +4,16 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
-	"time"
 
 	"merryworld/surebank/internal/platform/auth"
+	"merryworld/surebank/internal/platform/money"
 	"merryworld/surebank/internal/platform/web/weberror"
 	"merryworld/surebank/internal/postgres/models"
 
-	"github.com/jinzhu/now"
 	. "github.com/volatiletech/sqlboiler/v4/queries/qm"
 	"gopkg.in/DataDog/dd-trace-go.v1/ddtrace/tracer"
 )
 
-func (repo *Repository) StartingNewCircle(ctx context.Context, accountID string, effectiveDate time.Time, dbTx *sql.Tx) (bool, error) {
-	span, ctx := tracer.StartSpanFromContext(ctx, "internal.commission.StartingNewCircle")
-	defer span.Finish()
-
-	lastCommission, err := repo.LattestCommission(ctx, accountID, dbTx)
-	if err != nil {
-		if err.Error() == sql.ErrNoRows.Error() {
-			return true, nil
-		}
-		return false, err
-	}
-	lastDate := now.New(time.Unix(lastCommission.EffectiveDate, 0)).BeginningOfDay()
-	effectiveDate = now.New(effectiveDate).BeginningOfDay()
-	duration := effectiveDate.Sub(lastDate)
-	r := duration.Hours() >= float64(31*24)
-	return r, nil
-}
-
-func (repo *Repository) LattestCommission(ctx context.Context, accountID string, dbTx *sql.Tx) (*DsCommission, error) {
-	span, ctx := tracer.StartSpanFromContext(ctx, "internal.commission.LattestCommission")
-	defer span.Finish()
-
-	c, err := models.DSCommissions(
-		models.DSCommissionWhere.AccountID.EQ(accountID),
-		OrderBy(fmt.Sprintf("%s desc", models.DSCommissionColumns.EffectiveDate)),
-		Limit(1),
-	).One(ctx, dbTx)
-
-	if err != nil {
-		return nil, err
-	}
-	return FromModel(c), nil
-}
-
 // Find gets all the commissions from the database based on the request params.
 func (repo *Repository) Find(ctx context.Context, claims auth.Claims, req FindRequest) (*PagedResponseList, error) {
 	span, ctx := tracer.StartSpanFromContext(ctx, "internal.commission.Find")
@@ -128,7 +93,7 @@ func (repo *Repository) ReadByID(ctx context.Context, _ auth.Claims, id string)
 	return FromModel(model), nil
 }
 
-func (repo *Repository) TotalAmountByWhere(ctx context.Context, where string, args []interface{}) (float64, error) {
+func (repo *Repository) TotalAmountByWhere(ctx context.Context, where string, args []interface{}) (money.Amount, error) {
 	span, ctx := tracer.StartSpanFromContext(ctx, "internal.commission.TotalAmountByWhere")
 	defer span.Finish()
 
@@ -137,8 +102,8 @@ func (repo *Repository) TotalAmountByWhere(ctx context.Context, where string, ar
 		statement += fmt.Sprintf(" where %s ", where)
 	}
 	var result struct {
-		Total sql.NullFloat64
+		Total sql.NullInt64
 	}
 	err := models.NewQuery(SQL(statement, args...)).Bind(ctx, repo.DbConn, &result)
-	return result.Total.Float64, err
+	return money.Amount(result.Total.Int64), err
 }
@@ -8,6 +8,7 @@ import (
 
 	"merryworld/surebank/internal/account"
 	"merryworld/surebank/internal/customer"
+	"merryworld/surebank/internal/platform/money"
 	"merryworld/surebank/internal/platform/web"
 	"merryworld/surebank/internal/postgres/models"
 )
@@ -25,12 +26,12 @@ func NewRepository(db *sqlx.DB) *Repository {
 }
 
 type DsCommission struct {
-	ID            string  `boil:"id" json:"id" toml:"id" yaml:"id"`
-	AccountID     string  `boil:"account_id" json:"account_id" toml:"account_id" yaml:"account_id"`
-	CustomerID    string  `boil:"customer_id" json:"customer_id" toml:"customer_id" yaml:"customer_id"`
-	Amount        float64 `boil:"amount" json:"amount" toml:"amount" yaml:"amount"`
-	Date          int64   `boil:"date" json:"date" toml:"date" yaml:"date"`
-	EffectiveDate int64   `boil:"effective_date" json:"effective_date" toml:"effective_date" yaml:"effective_date"`
+	ID            string       `boil:"id" json:"id" toml:"id" yaml:"id"`
+	AccountID     string       `boil:"account_id" json:"account_id" toml:"account_id" yaml:"account_id"`
+	CustomerID    string       `boil:"customer_id" json:"customer_id" toml:"customer_id" yaml:"customer_id"`
+	Amount        money.Amount `boil:"amount" json:"amount" toml:"amount" yaml:"amount"`
+	Date          int64        `boil:"date" json:"date" toml:"date" yaml:"date"`
+	EffectiveDate int64        `boil:"effective_date" json:"effective_date" toml:"effective_date" yaml:"effective_date"`
 
 	Customer *customer.Customer
 	Account  *account.Account
@@ -40,7 +41,7 @@ func FromModel(rec *models.DSCommission) *DsCommission {
 	a := &DsCommission{
 		ID:            rec.ID,
 		AccountID:     rec.AccountID,
-		Amount:        rec.Amount,
+		Amount:        money.Amount(rec.Amount),
 		CustomerID:    rec.CustomerID,
 		Date:          rec.Date,
 		EffectiveDate: rec.EffectiveDate,
@@ -64,8 +65,8 @@ type Response struct {
 	AccountID     string           `json:"account_id" example:"985f1746-1d9f-459f-a2d9-fc53ece5ae86" truss:"api-read"`
 	AccountNumber string           `json:"account_number" example:"SB10003001" truss:"api-read"`
 	CustomerID    string           `json:"customer_id" truss:"api-read"`
-	CustomerName  string           `json:customer_name" truss:"api-read"`
-	Amount        float64          `json:"amount" truss:"api-read"`
+	CustomerName  string           `json:"customer_name" truss:"api-read"`
+	Amount        money.Amount     `json:"amount" truss:"api-read"`
 	Date          web.TimeResponse `json:"date" truss:"api-read"`
 	EffectiveDate web.TimeResponse `json:"effective_date" truss:"api-read"`
 }
@@ -0,0 +1,227 @@
+package dscommission
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"merryworld/surebank/internal/platform/money"
+)
+
+// Commission policies that can be set on an account product or a branch. A policy is stored as
+// its name optionally followed by a colon and its settings, see ParsePolicy.
+const (
+	Policy_FirstContribution = "first_contribution"
+	Policy_MonthlyShare      = "monthly_share"
+	Policy_Flat              = "flat"
+	Policy_Tiered            = "tiered"
+)
+
+// MonthDays is the number of days the monthly total is shared over by default.
+const MonthDays = 31
+
+// ErrInvalidPolicy occurs when a commission policy cannot be read.
+var ErrInvalidPolicy = errors.New("Invalid commission policy")
+
+// Contribution is a daily contribution to a DS account that a commission policy is applied to.
+type Contribution struct {
+	// Amount is the contribution for the day.
+	Amount money.Amount
+	// EffectiveDate is the day the contribution is for.
+	EffectiveDate time.Time
+	// CycleStart is the effective date of the first day of the cycle the contribution is in.
+	CycleStart time.Time
+	// FirstOfCycle is set when the contribution opened its cycle.
+	FirstOfCycle bool
+}
+
+// Policy computes the commission taken from the DS contributions of an account.
+type Policy interface {
+	// Fee returns the fee taken from the contribution and the effective date the commission is
+	// recorded for. A zero fee means the contribution attracts no commission.
+	Fee(c Contribution) (money.Amount, time.Time)
+}
+
+// None takes no commission.
+type None struct{}
+
+// Fee implements Policy.
+func (None) Fee(c Contribution) (money.Amount, time.Time) {
+	return 0, time.Time{}
+}
+
+// FirstContribution keeps the first contribution of each cycle as the fee. It is the default
+// policy of products that charge a fee.
+type FirstContribution struct{}
+
+// Fee implements Policy.
+func (FirstContribution) Fee(c Contribution) (money.Amount, time.Time) {
+	if !c.FirstOfCycle {
+		return 0, time.Time{}
+	}
+	return c.Amount, c.EffectiveDate
+}
+
+// MonthlyShare takes a share of every contribution so that a month of contributions pays one day
+// of the monthly total, 1/31 by default. Customers that miss days pay for the days they saved.
+type MonthlyShare struct {
+	Days int
+}
+
+// Fee implements Policy. The share is rounded to the nearest kobo.
+func (p MonthlyShare) Fee(c Contribution) (money.Amount, time.Time) {
+	days := int64(p.Days)
+	if days <= 0 {
+		days = MonthDays
+	}
+	if c.Amount <= 0 {
+		return 0, time.Time{}
+	}
+	return money.Amount((c.Amount.Kobo() + days/2) / days), c.EffectiveDate
+}
+
+// Flat takes a fixed fee from the first contribution of each cycle. The fee is never more than
+// the contribution it is taken from.
+type Flat struct {
+	Amount money.Amount
+}
+
+// Fee implements Policy.
+func (p Flat) Fee(c Contribution) (money.Amount, time.Time) {
+	if !c.FirstOfCycle || p.Amount <= 0 {
+		return 0, time.Time{}
+	}
+	return capFee(p.Amount, c.Amount), c.CycleStart
+}
+
+// Tier is a band of daily contributions and the fee charged on them.
+type Tier struct {
+	// UpTo is the largest daily contribution in the tier.
+	UpTo money.Amount
+	Fee  money.Amount
+}
+
+// Tiered takes a fee from the first contribution of each cycle that depends on the size of the
+// daily contribution. Tiers are in increasing order of UpTo, contributions above the last tier
+// pay its fee. The fee is never more than the contribution it is taken from.
+type Tiered struct {
+	Tiers []Tier
+}
+
+// Fee implements Policy.
+func (p Tiered) Fee(c Contribution) (money.Amount, time.Time) {
+	if !c.FirstOfCycle || len(p.Tiers) == 0 {
+		return 0, time.Time{}
+	}
+
+	fee := p.Tiers[len(p.Tiers)-1].Fee
+	for _, t := range p.Tiers {
+		if c.Amount <= t.UpTo {
+			fee = t.Fee
+			break
+		}
+	}
+	if fee <= 0 {
+		return 0, time.Time{}
+	}
+
+	return capFee(fee, c.Amount), c.CycleStart
+}
+
+// capFee limits the fee to the contribution it is taken from.
+func capFee(fee, contribution money.Amount) money.Amount {
+	if fee > contribution {
+		return contribution
+	}
+	return fee
+}
+
+// ParsePolicy reads a commission policy, one of:
+//
+//	first_contribution                 the first contribution of each cycle
+//	monthly_share or monthly_share:30  a share of every contribution, 1/31 or 1/30
+//	flat:500                           500.00 from the first contribution of each cycle
+//	tiered:1000=200,5000=500           200.00 for daily contributions up to 1000.00, 500.00 above
+//
+// An empty string returns a nil policy.
+func ParsePolicy(s string) (Policy, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return nil, nil
+	}
+
+	name, settings := s, ""
+	if i := strings.IndexByte(s, ':'); i >= 0 {
+		name, settings = strings.TrimSpace(s[:i]), strings.TrimSpace(s[i+1:])
+	}
+
+	switch name {
+	case Policy_FirstContribution:
+		if settings != "" {
+			return nil, errors.WithMessagef(ErrInvalidPolicy, "%s takes no settings", name)
+		}
+		return FirstContribution{}, nil
+
+	case Policy_MonthlyShare:
+		if settings == "" {
+			return MonthlyShare{Days: MonthDays}, nil
+		}
+		days, err := strconv.Atoi(settings)
+		if err != nil || days <= 0 {
+			return nil, errors.WithMessagef(ErrInvalidPolicy, "%q is not a number of days", settings)
+		}
+		return MonthlyShare{Days: days}, nil
+
+	case Policy_Flat:
+		amount, err := money.Parse(settings)
+		if err != nil || amount <= 0 {
+			return nil, errors.WithMessagef(ErrInvalidPolicy, "%q is not a fee", settings)
+		}
+		return Flat{Amount: amount}, nil
+
+	case Policy_Tiered:
+		var p Tiered
+		for _, band := range strings.Split(settings, ",") {
+			parts := strings.Split(band, "=")
+			if len(parts) != 2 {
+				return nil, errors.WithMessagef(ErrInvalidPolicy, "%q is not a tier, use amount=fee", band)
+			}
+			upTo, err := money.Parse(parts[0])
+			if err != nil || upTo <= 0 {
+				return nil, errors.WithMessagef(ErrInvalidPolicy, "%q is not a daily contribution", parts[0])
+			}
+			fee, err := money.Parse(parts[1])
+			if err != nil || fee < 0 {
+				return nil, errors.WithMessagef(ErrInvalidPolicy, "%q is not a fee", parts[1])
+			}
+			if n := len(p.Tiers); n > 0 && upTo <= p.Tiers[n-1].UpTo {
+				return nil, errors.WithMessage(ErrInvalidPolicy, "tiers must be in increasing order")
+			}
+			p.Tiers = append(p.Tiers, Tier{UpTo: upTo, Fee: fee})
+		}
+		return p, nil
+	}
+
+	return nil, errors.WithMessagef(ErrInvalidPolicy, "unknown policy %q", name)
+}
+
+// SelectPolicy returns the commission policy of a DS account. The policy set on the product of the
+// account applies first. Products that do not charge a fee take no commission, otherwise the
+// policy of the branch applies and then the first contribution of each cycle is kept.
+func SelectPolicy(productPolicy string, firstContributionFee bool, branchPolicy string) (Policy, error) {
+	if p, err := ParsePolicy(productPolicy); err != nil || p != nil {
+		return p, errors.Wrap(err, "product")
+	}
+
+	if !firstContributionFee {
+		return None{}, nil
+	}
+
+	if p, err := ParsePolicy(branchPolicy); err != nil || p != nil {
+		return p, errors.Wrap(err, "branch")
+	}
+
+	return FirstContribution{}, nil
+}
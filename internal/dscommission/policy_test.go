@@ -0,0 +1,171 @@
+package dscommission
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"merryworld/surebank/internal/platform/money"
+	"merryworld/surebank/internal/platform/tests"
+)
+
+var (
+	cycleStart = time.Date(2026, time.March, 1, 0, 0, 0, 0, time.UTC)
+	paidOn     = time.Date(2026, time.March, 4, 0, 0, 0, 0, time.UTC)
+)
+
+// contribution returns a contribution of amount naira paid on the fourth day of a cycle.
+func contribution(naira int64, first bool) Contribution {
+	return Contribution{Amount: money.Naira(naira), EffectiveDate: paidOn, CycleStart: cycleStart, FirstOfCycle: first}
+}
+
+// TestPolicyFee validates the fee each commission policy takes from a contribution.
+func TestPolicyFee(t *testing.T) {
+
+	tiered := Tiered{Tiers: []Tier{
+		{UpTo: money.Naira(1000), Fee: money.Naira(200)},
+		{UpTo: money.Naira(5000), Fee: money.Naira(500)},
+	}}
+
+	var feeTests = []struct {
+		name     string
+		policy   Policy
+		c        Contribution
+		wantFee  money.Amount
+		wantDate time.Time
+	}{
+		{"no commission", None{}, contribution(1000, true), 0, time.Time{}},
+		{"first contribution of a cycle", FirstContribution{}, contribution(1000, true), money.Naira(1000), paidOn},
+		{"first contribution, later day", FirstContribution{}, contribution(1000, false), 0, time.Time{}},
+		{"monthly share of 31 days", MonthlyShare{Days: 31}, contribution(3100, false), money.Naira(100), paidOn},
+		{"monthly share rounds to the kobo", MonthlyShare{Days: 31}, contribution(1000, true), money.Amount(3226), paidOn},
+		{"monthly share defaults to 31 days", MonthlyShare{}, contribution(3100, false), money.Naira(100), paidOn},
+		{"monthly share of 30 days", MonthlyShare{Days: 30}, contribution(3000, false), money.Naira(100), paidOn},
+		{"flat fee", Flat{Amount: money.Naira(500)}, contribution(1000, true), money.Naira(500), cycleStart},
+		{"flat fee, later day", Flat{Amount: money.Naira(500)}, contribution(1000, false), 0, time.Time{}},
+		{"flat fee above the contribution", Flat{Amount: money.Naira(500)}, contribution(200, true), money.Naira(200), cycleStart},
+		{"lowest tier", tiered, contribution(800, true), money.Naira(200), cycleStart},
+		{"upper bound of a tier", tiered, contribution(1000, true), money.Naira(200), cycleStart},
+		{"middle tier", tiered, contribution(2000, true), money.Naira(500), cycleStart},
+		{"above the last tier", tiered, contribution(10000, true), money.Naira(500), cycleStart},
+		{"tiered fee above the contribution", tiered, contribution(150, true), money.Naira(150), cycleStart},
+		{"tiered, later day", tiered, contribution(2000, false), 0, time.Time{}},
+	}
+
+	t.Log("Given the need to take the DS commission from contributions.")
+	{
+		for i, tt := range feeTests {
+			t.Logf("\tTest: %d\tWhen charging %s.", i, tt.name)
+			{
+				fee, date := tt.policy.Fee(tt.c)
+				if fee != tt.wantFee {
+					t.Fatalf("\t%s\tExpected a fee of %s, got %s.", tests.Failed, tt.wantFee, fee)
+				}
+				if !date.Equal(tt.wantDate) {
+					t.Fatalf("\t%s\tExpected the fee on %s, got %s.", tests.Failed, tt.wantDate, date)
+				}
+				t.Logf("\t%s\tFee ok.", tests.Success)
+			}
+		}
+	}
+}
+
+// TestParsePolicy validates reading the commission policy set on a product or branch.
+func TestParsePolicy(t *testing.T) {
+
+	var parseTests = []struct {
+		spec    string
+		want    Policy
+		wantErr bool
+	}{
+		{"", nil, false},
+		{"  ", nil, false},
+		{"first_contribution", FirstContribution{}, false},
+		{"first_contribution:2", nil, true},
+		{"monthly_share", MonthlyShare{Days: 31}, false},
+		{"monthly_share:30", MonthlyShare{Days: 30}, false},
+		{"monthly_share:0", nil, true},
+		{"monthly_share:month", nil, true},
+		{"flat:500", Flat{Amount: money.Naira(500)}, false},
+		{" flat : 250.50 ", Flat{Amount: money.Amount(25050)}, false},
+		{"flat", nil, true},
+		{"flat:-5", nil, true},
+		{"tiered:1000=200,5000=500", Tiered{Tiers: []Tier{
+			{UpTo: money.Naira(1000), Fee: money.Naira(200)},
+			{UpTo: money.Naira(5000), Fee: money.Naira(500)},
+		}}, false},
+		{"tiered:5000=500,1000=200", nil, true},
+		{"tiered:1000", nil, true},
+		{"tiered:", nil, true},
+		{"percentage:5", nil, true},
+	}
+
+	t.Log("Given the need to read commission policies.")
+	{
+		for i, tt := range parseTests {
+			t.Logf("\tTest: %d\tWhen reading %q.", i, tt.spec)
+			{
+				got, err := ParsePolicy(tt.spec)
+				if tt.wantErr {
+					if err == nil {
+						t.Fatalf("\t%s\tExpected an error, got %#v.", tests.Failed, got)
+					}
+					t.Logf("\t%s\tParsePolicy failed as expected.", tests.Success)
+					continue
+				}
+				if err != nil {
+					t.Fatalf("\t%s\tParsePolicy failed : %+v", tests.Failed, err)
+				}
+				if !reflect.DeepEqual(got, tt.want) {
+					t.Fatalf("\t%s\tExpected %#v, got %#v.", tests.Failed, tt.want, got)
+				}
+				t.Logf("\t%s\tParsePolicy ok.", tests.Success)
+			}
+		}
+	}
+}
+
+// TestSelectPolicy validates the policy that applies to the DS accounts of a product and branch.
+func TestSelectPolicy(t *testing.T) {
+
+	var selectTests = []struct {
+		name          string
+		productPolicy string
+		productFee    bool
+		branchPolicy  string
+		want          Policy
+		wantErr       bool
+	}{
+		{"product without a fee", "", false, "flat:500", None{}, false},
+		{"default", "", true, "", FirstContribution{}, false},
+		{"branch policy", "", true, "monthly_share", MonthlyShare{Days: 31}, false},
+		{"product policy over the branch", "flat:300", true, "monthly_share", Flat{Amount: money.Naira(300)}, false},
+		{"product policy without the fee setting", "flat:300", false, "", Flat{Amount: money.Naira(300)}, false},
+		{"invalid product policy", "flat", true, "", nil, true},
+		{"invalid branch policy", "", true, "weekly", nil, true},
+	}
+
+	t.Log("Given the need to select the commission policy of an account.")
+	{
+		for i, tt := range selectTests {
+			t.Logf("\tTest: %d\tWhen selecting the %s.", i, tt.name)
+			{
+				got, err := SelectPolicy(tt.productPolicy, tt.productFee, tt.branchPolicy)
+				if tt.wantErr {
+					if err == nil {
+						t.Fatalf("\t%s\tExpected an error, got %#v.", tests.Failed, got)
+					}
+					t.Logf("\t%s\tSelectPolicy failed as expected.", tests.Success)
+					continue
+				}
+				if err != nil {
+					t.Fatalf("\t%s\tSelectPolicy failed : %+v", tests.Failed, err)
+				}
+				if !reflect.DeepEqual(got, tt.want) {
+					t.Fatalf("\t%s\tExpected %#v, got %#v.", tests.Failed, tt.want, got)
+				}
+				t.Logf("\t%s\tSelectPolicy ok.", tests.Success)
+			}
+		}
+	}
+}
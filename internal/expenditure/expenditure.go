@@ -12,6 +12,7 @@ import (
 	. "github.com/volatiletech/sqlboiler/v4/queries/qm"
 	"gopkg.in/DataDog/dd-trace-go.v1/ddtrace/tracer"
 
+	"merryworld/surebank/internal/ledger"
 	"merryworld/surebank/internal/platform/auth"
 	"merryworld/surebank/internal/platform/web/webcontext"
 	"merryworld/surebank/internal/platform/web/weberror"
@@ -147,14 +148,37 @@ func (repo *Repository) Create(ctx context.Context, claims auth.Claims, req Crea
 		ID:         uuid.NewRandom().String(),
 		SalesRepID: salesRep.ID,
 		Date:       now.Unix(),
-		Amount:     req.Amount,
+		Amount:     req.Amount.Kobo(),
 		Reason:     req.Reason,
 	}
 
-	if err := m.Insert(ctx, repo.DbConn, boil.Infer()); err != nil {
+	tx, err := repo.DbConn.Begin()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := m.Insert(ctx, tx, boil.Infer()); err != nil {
+		_ = tx.Rollback()
 		return nil, errors.WithMessage(err, "Insert expenditure failed")
 	}
 
+	if _, err := repo.LedgerRepo.PostTx(ctx, claims, ledger.PostRequest{
+		Narration:  fmt.Sprintf("%s %s - %s", salesRep.FirstName, salesRep.LastName, req.Reason),
+		SourceType: ledger.SourceRepsExpense,
+		SourceID:   m.ID,
+		Lines: []ledger.Line{
+			ledger.Debit(ledger.AccountRepExpenses, req.Amount),
+			ledger.Credit(ledger.AccountCash, req.Amount),
+		},
+	}, now, tx); err != nil {
+		_ = tx.Rollback()
+		return nil, errors.WithMessage(err, "Cannot post expenditure to the ledger")
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
 	return &Expenditure{
 		ID:         m.ID,
 		SalesRepID: req.SalesRepPhoneNumber,
@@ -186,7 +210,7 @@ func (repo *Repository) Update(ctx context.Context, claims auth.Claims, req Upda
 
 	cols := models.M{}
 	if req.Amount != nil {
-		cols[models.RepsExpenseColumns.Amount] = *req.Amount
+		cols[models.RepsExpenseColumns.Amount] = req.Amount.Kobo()
 	}
 	if req.Reason != nil {
 		cols[models.RepsExpenseColumns.Reason] = *req.Reason
@@ -206,11 +230,29 @@ func (repo *Repository) Update(ctx context.Context, claims auth.Claims, req Upda
 	// here so the value we return is consistent with what we store.
 	now = now.Truncate(time.Millisecond)
 
-	cols[models.BranchColumns.UpdatedAt] = now
+	tx, err := repo.DbConn.Begin()
+	if err != nil {
+		return err
+	}
+
+	if _, err = models.RepsExpenses(models.RepsExpenseWhere.ID.EQ(req.ID)).UpdateAll(ctx, tx, cols); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
 
-	_, err = models.RepsExpenses(models.RepsExpenseWhere.ID.EQ(req.ID)).UpdateAll(ctx, repo.DbConn, cols)
+	if req.Amount != nil {
+		if _, err = repo.LedgerRepo.RestateTx(ctx, claims, ledger.RestateRequest{
+			SourceType: ledger.SourceRepsExpense,
+			SourceID:   req.ID,
+			Amount:     *req.Amount,
+			Narration:  fmt.Sprintf("Amount corrected to %s", *req.Amount),
+		}, now, tx); err != nil {
+			_ = tx.Rollback()
+			return err
+		}
+	}
 
-	return nil
+	return tx.Commit()
 }
 
 // Delete removes an expenditure from the database.
@@ -234,10 +276,25 @@ func (repo *Repository) Delete(ctx context.Context, claims auth.Claims, req Dele
 		return errors.WithStack(ErrForbidden)
 	}
 
-	_, err = models.RepsExpenses(models.RepsExpenseWhere.ID.EQ(req.ID)).DeleteAll(ctx, repo.DbConn)
+	tx, err := repo.DbConn.Begin()
+	if err != nil {
+		return err
+	}
+
+	if _, err = repo.LedgerRepo.ReverseTx(ctx, claims, ledger.ReverseRequest{
+		SourceType: ledger.SourceRepsExpense,
+		SourceID:   req.ID,
+		Narration:  "Deleted expenditure",
+	}, time.Now(), tx); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+
+	_, err = models.RepsExpenses(models.RepsExpenseWhere.ID.EQ(req.ID)).DeleteAll(ctx, tx)
 	if err != nil {
+		_ = tx.Rollback()
 		return errors.WithStack(err)
 	}
 
-	return nil
+	return tx.Commit()
 }
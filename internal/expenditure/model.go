@@ -6,6 +6,8 @@ import (
 
 	"github.com/jmoiron/sqlx"
 
+	"merryworld/surebank/internal/ledger"
+	"merryworld/surebank/internal/platform/money"
 	"merryworld/surebank/internal/platform/web"
 	"merryworld/surebank/internal/postgres/models"
 	"merryworld/surebank/internal/user"
@@ -13,23 +15,25 @@ import (
 
 // Repository defines the required dependencies for Transaction.
 type Repository struct {
-	DbConn *sqlx.DB
+	DbConn     *sqlx.DB
+	LedgerRepo *ledger.Repository
 }
 
 // NewRepository creates a new Repository that defines dependencies for Transaction.
-func NewRepository(db *sqlx.DB) *Repository {
+func NewRepository(db *sqlx.DB, ledgerRepo *ledger.Repository) *Repository {
 	return &Repository{
-		DbConn: db,
+		DbConn:     db,
+		LedgerRepo: ledgerRepo,
 	}
 }
 
 // Expenditure represents a financial expense by a rep.
 type Expenditure struct {
-	ID         string    `boil:"id" json:"id" toml:"id" yaml:"id"`
-	SalesRepID string    `boil:"sales_rep_id" json:"sales_rep_id" toml:"sales_rep_id" yaml:"sales_rep_id"`
-	Amount     float64   `boil:"amount" json:"amount" toml:"amount" yaml:"amount"`
-	Reason     string    `boil:"reason" json:"reason" toml:"reason" yaml:"reason"`
-	Date       time.Time `boil:"date" json:"date" toml:"date" yaml:"date"`
+	ID         string       `boil:"id" json:"id" toml:"id" yaml:"id"`
+	SalesRepID string       `boil:"sales_rep_id" json:"sales_rep_id" toml:"sales_rep_id" yaml:"sales_rep_id"`
+	Amount     money.Amount `boil:"amount" json:"amount" toml:"amount" yaml:"amount"`
+	Reason     string       `boil:"reason" json:"reason" toml:"reason" yaml:"reason"`
+	Date       time.Time    `boil:"date" json:"date" toml:"date" yaml:"date"`
 
 	SalesRep *user.User
 }
@@ -38,7 +42,7 @@ func FromModel(rec *models.RepsExpense) *Expenditure {
 	a := &Expenditure{
 		ID:         rec.ID,
 		SalesRepID: rec.SalesRepID,
-		Amount:     rec.Amount,
+		Amount:     money.Amount(rec.Amount),
 		Reason:     rec.Reason,
 		Date:       time.Unix(rec.Date, 0).UTC(),
 	}
@@ -55,7 +59,7 @@ func FromModel(rec *models.RepsExpense) *Expenditure {
 type Response struct {
 	ID         string           `boil:"id" json:"id" toml:"id" yaml:"id"`
 	SalesRepID string           `boil:"sales_rep_id" json:"sales_rep_id" toml:"sales_rep_id" yaml:"sales_rep_id"`
-	Amount     float64          `boil:"amount" json:"amount" toml:"amount" yaml:"amount"`
+	Amount     money.Amount     `boil:"amount" json:"amount" toml:"amount" yaml:"amount"`
 	Reason     string           `boil:"reason" json:"reason" toml:"reason" yaml:"reason"`
 	Date       web.TimeResponse `json:"effective_date" truss:"api-read"`
 
@@ -107,9 +111,9 @@ type PagedResponseList struct {
 
 // CreateRequest contains information needed to make a new Transaction.
 type CreateRequest struct {
-	SalesRepPhoneNumber string  `validate:"required" json:"sales_rep_phone_number" toml:"sales_rep_phone_number" yaml:"sales_rep_phone_number"`
-	Amount              float64 `validate:"required" json:"amount" toml:"amount" yaml:"amount"`
-	Reason              string  `validate:"required" json:"reason" toml:"reason" yaml:"reason"`
+	SalesRepPhoneNumber string       `validate:"required" json:"sales_rep_phone_number" toml:"sales_rep_phone_number" yaml:"sales_rep_phone_number"`
+	Amount              money.Amount `validate:"required" json:"amount" toml:"amount" yaml:"amount"`
+	Reason              string       `validate:"required" json:"reason" toml:"reason" yaml:"reason"`
 }
 
 // ReadRequest defines the information needed to read a deposit from the system.
@@ -123,9 +127,9 @@ type ReadRequest struct {
 // changed. It uses pointer fields so we can differentiate between a field that
 // was not provided and a field that was provided as explicitly blank.
 type UpdateRequest struct {
-	ID     string   `json:"id" validate:"required,uuid" example:"985f1746-1d9f-459f-a2d9-fc53ece5ae86"`
-	Amount *float64 `boil:"amount" json:"amount" toml:"amount" yaml:"amount"`
-	Reason *string  `boil:"reason" json:"reason" toml:"reason" yaml:"reason"`
+	ID     string        `json:"id" validate:"required,uuid" example:"985f1746-1d9f-459f-a2d9-fc53ece5ae86"`
+	Amount *money.Amount `boil:"amount" json:"amount" toml:"amount" yaml:"amount"`
+	Reason *string       `boil:"reason" json:"reason" toml:"reason" yaml:"reason"`
 }
 
 // ArchiveRequest defines the information needed to archive a deposit. This will archive (soft-delete) the
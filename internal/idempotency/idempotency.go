@@ -0,0 +1,112 @@
+package idempotency
+
+import (
+	"context"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"encoding/json"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/volatiletech/null/v8"
+	"gopkg.in/DataDog/dd-trace-go.v1/ddtrace/tracer"
+
+	"merryworld/surebank/internal/postgres/models"
+)
+
+// Scopes identify the operation an idempotency key was used for.
+const (
+	ScopeDeposit  = "transaction.deposit"
+	ScopeWithdraw = "transaction.withdraw"
+	ScopeSale     = "sale"
+	ScopeTransfer = "transaction.transfer"
+)
+
+var (
+	// ErrKeyReused occurs when an idempotency key is replayed with a different payload or for a
+	// different operation than the request that first used it.
+	ErrKeyReused = errors.New("Idempotency key has already been used for a different request")
+
+	// ErrInvalidKey occurs when the provided idempotency key is too long to be stored.
+	ErrInvalidKey = errors.New("Idempotency key must not be longer than 255 characters")
+)
+
+const claimStatement = `INSERT INTO idempotency_key
+		(tenant_id, idempotency_key, scope, request_hash, created_at)
+	VALUES ($1, $2, $3, $4, $5)
+	ON CONFLICT (tenant_id, idempotency_key) DO NOTHING`
+
+// Claim reserves the idempotency key for the tenant within the provided db transaction. A
+// concurrent request with the same key blocks on the insert until this transaction completes.
+//
+// When the key was already used by a committed request with the same scope and payload, the ID
+// of the resource that request created is returned and the caller should respond with it instead
+// of posting again.
+func Claim(ctx context.Context, tx *sql.Tx, tenantID, scope, key string, payload interface{}, now time.Time) (string, error) {
+	span, ctx := tracer.StartSpanFromContext(ctx, "internal.idempotency.Claim")
+	defer span.Finish()
+
+	if len(key) > 255 {
+		return "", errors.WithStack(ErrInvalidKey)
+	}
+
+	hash, err := requestHash(payload)
+	if err != nil {
+		return "", err
+	}
+
+	// If now empty set it to the current time.
+	if now.IsZero() {
+		now = time.Now()
+	}
+
+	res, err := tx.ExecContext(ctx, claimStatement, tenantID, key, scope, hash, now.UTC().Unix())
+	if err != nil {
+		return "", errors.Wrap(err, "Cannot claim idempotency key")
+	}
+	if n, err := res.RowsAffected(); err != nil {
+		return "", err
+	} else if n == 1 {
+		return "", nil
+	}
+
+	existing, err := models.FindIdempotencyKey(ctx, tx, tenantID, key)
+	if err != nil {
+		return "", errors.Wrap(err, "Cannot read idempotency key")
+	}
+
+	if existing.Scope != scope || existing.RequestHash != hash || !existing.ResourceID.Valid {
+		return "", errors.WithStack(ErrKeyReused)
+	}
+
+	return existing.ResourceID.String, nil
+}
+
+// Complete records the ID of the resource created for the claimed idempotency key so that
+// replays of the request can return it.
+func Complete(ctx context.Context, tx *sql.Tx, tenantID, key, resourceID string) error {
+	span, ctx := tracer.StartSpanFromContext(ctx, "internal.idempotency.Complete")
+	defer span.Finish()
+
+	_, err := models.IdempotencyKeys(
+		models.IdempotencyKeyWhere.TenantID.EQ(tenantID),
+		models.IdempotencyKeyWhere.IdempotencyKey.EQ(key),
+	).UpdateAll(ctx, tx, models.M{models.IdempotencyKeyColumns.ResourceID: null.StringFrom(resourceID)})
+	if err != nil {
+		return errors.Wrap(err, "Cannot complete idempotency key")
+	}
+
+	return nil
+}
+
+// requestHash returns the hex encoded sha256 of the JSON encoded payload.
+func requestHash(payload interface{}) (string, error) {
+	dat, err := json.Marshal(payload)
+	if err != nil {
+		return "", errors.Wrap(err, "Cannot encode request for idempotency check")
+	}
+
+	sum := sha256.Sum256(dat)
+	return hex.EncodeToString(sum[:]), nil
+}
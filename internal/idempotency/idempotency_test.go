@@ -0,0 +1,187 @@
+package idempotency
+
+import (
+	"os"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/pborman/uuid"
+	"github.com/pkg/errors"
+
+	"merryworld/surebank/internal/platform/tests"
+)
+
+var test *tests.Test
+
+// TestMain is the entry point for testing.
+func TestMain(m *testing.M) {
+	os.Exit(testMain(m))
+}
+
+func testMain(m *testing.M) int {
+	test = tests.New()
+	defer test.TearDown()
+
+	return m.Run()
+}
+
+type claimRequest struct {
+	AccountNumber string
+	Amount        int64
+}
+
+// claim claims the key and completes it with resourceID when it was not used before, the db
+// transaction is committed either way.
+func claim(tenantID, scope, key string, payload interface{}, resourceID string, now time.Time) (string, error) {
+	ctx := tests.Context()
+
+	tx, err := test.MasterDB.Begin()
+	if err != nil {
+		return "", err
+	}
+
+	id, err := Claim(ctx, tx, tenantID, scope, key, payload, now)
+	if err != nil {
+		_ = tx.Rollback()
+		return "", err
+	}
+
+	if id == "" {
+		if err := Complete(ctx, tx, tenantID, key, resourceID); err != nil {
+			_ = tx.Rollback()
+			return "", err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return "", err
+	}
+
+	return id, nil
+}
+
+// TestClaim validates idempotency keys are only replayed for the request that first used them.
+func TestClaim(t *testing.T) {
+	defer tests.Recover(t)
+
+	now := time.Date(2026, time.October, 18, 9, 0, 0, 0, time.UTC)
+
+	tenantID := uuid.NewRandom().String()
+	key := uuid.NewRandom().String()
+	payload := claimRequest{AccountNumber: "SB10003001", Amount: 50000}
+	resourceID := uuid.NewRandom().String()
+
+	t.Log("Given the need to make retries of a request safe.")
+	{
+		t.Log("\tTest: 0\tWhen the key is used for the first time.")
+		{
+			id, err := claim(tenantID, ScopeDeposit, key, payload, resourceID, now)
+			if err != nil {
+				t.Log("\t\tGot :", err)
+				t.Fatalf("\t%s\tShould claim the key.", tests.Failed)
+			}
+			if id != "" {
+				t.Logf("\t\tGot : %s", id)
+				t.Fatalf("\t%s\tShould claim the key.", tests.Failed)
+			}
+			t.Logf("\t%s\tShould claim the key.", tests.Success)
+		}
+
+		type replayTest struct {
+			Name     string
+			TenantID string
+			Scope    string
+			Key      string
+			Payload  interface{}
+			Want     string
+			Err      error
+		}
+
+		otherResourceID := uuid.NewRandom().String()
+
+		var replayTests = []replayTest{
+			{"replayed with the same payload", tenantID, ScopeDeposit, key, payload, resourceID, nil},
+			{"replayed with a different payload", tenantID, ScopeDeposit, key,
+				claimRequest{AccountNumber: "SB10003001", Amount: 60000}, "", ErrKeyReused},
+			{"replayed for a different operation", tenantID, ScopeWithdraw, key, payload, "", ErrKeyReused},
+			{"used by a different tenant", uuid.NewRandom().String(), ScopeDeposit, key, payload, "", nil},
+			{"longer than 255 characters", tenantID, ScopeDeposit, strings.Repeat("k", 256), payload, "", ErrInvalidKey},
+		}
+
+		for i, tt := range replayTests {
+			t.Logf("\tTest: %d\tWhen the key is %s.", i+1, tt.Name)
+			{
+				id, err := claim(tt.TenantID, tt.Scope, tt.Key, tt.Payload, otherResourceID, now.Add(time.Minute))
+				if errors.Cause(err) != tt.Err {
+					t.Logf("\t\tGot : %v", err)
+					t.Logf("\t\tWant: %v", tt.Err)
+					t.Fatalf("\t%s\tShould get the expected error.", tests.Failed)
+				}
+				if id != tt.Want {
+					t.Logf("\t\tGot : %q", id)
+					t.Logf("\t\tWant: %q", tt.Want)
+					t.Fatalf("\t%s\tShould return the resource of the first request.", tests.Failed)
+				}
+				t.Logf("\t%s\tShould return the resource of the first request.", tests.Success)
+			}
+		}
+	}
+}
+
+// TestClaimConcurrent validates only one of the requests that use a key at the same time claims it.
+func TestClaimConcurrent(t *testing.T) {
+	defer tests.Recover(t)
+
+	const workers = 2
+
+	now := time.Date(2026, time.October, 18, 10, 0, 0, 0, time.UTC)
+
+	tenantID := uuid.NewRandom().String()
+	key := uuid.NewRandom().String()
+	payload := claimRequest{AccountNumber: "SB10003001", Amount: 50000}
+
+	t.Log("Given the need to make retries sent at the same time safe.")
+	{
+		t.Log("\tTest: 0\tWhen two requests use the same key at the same time.")
+		{
+			var wg sync.WaitGroup
+			ids := make(chan string, workers)
+			errs := make(chan error, workers)
+			for i := 0; i < workers; i++ {
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					id, err := claim(tenantID, ScopeDeposit, key, payload, uuid.NewRandom().String(), now)
+					ids <- id
+					errs <- err
+				}()
+			}
+			wg.Wait()
+			close(ids)
+			close(errs)
+
+			for err := range errs {
+				if err != nil {
+					t.Log("\t\tGot :", err)
+					t.Fatalf("\t%s\tClaim failed.", tests.Failed)
+				}
+			}
+
+			var claimed, replayed int
+			for id := range ids {
+				if id == "" {
+					claimed++
+				} else {
+					replayed++
+				}
+			}
+			if claimed != 1 || replayed != workers-1 {
+				t.Logf("\t\tGot : %d claimed, %d replayed", claimed, replayed)
+				t.Fatalf("\t%s\tShould let exactly one request claim the key.", tests.Failed)
+			}
+			t.Logf("\t%s\tShould let exactly one request claim the key.", tests.Success)
+		}
+	}
+}
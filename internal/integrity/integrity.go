@@ -0,0 +1,301 @@
+package integrity
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/pkg/errors"
+	. "github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"gopkg.in/DataDog/dd-trace-go.v1/ddtrace/tracer"
+
+	"merryworld/surebank/internal/postgres/models"
+	"merryworld/surebank/internal/transaction"
+)
+
+// entry is a movement of a running balance, either a transaction of an account or a stock
+// movement of a product in a branch.
+type entry struct {
+	id        string
+	ref       string
+	credit    bool
+	amount    int64
+	opening   int64
+	createdAt int64
+}
+
+// chain orders the entries of a running balance and returns the opening balance each entry should
+// have along with the closing balance. Entries are applied in the order they were created. Entries
+// created within the same second were serialized by row locks in an order the timestamp cannot
+// tell, so among those the entry whose recorded opening balance continues the chain goes first.
+func chain(entries []entry) ([]entry, []int64, int64) {
+	ordered := make([]entry, len(entries))
+	copy(ordered, entries)
+	sort.SliceStable(ordered, func(i, j int) bool {
+		return ordered[i].createdAt < ordered[j].createdAt
+	})
+
+	expected := make([]int64, len(ordered))
+	var balance int64
+	for i := range ordered {
+		for k := i; k < len(ordered) && ordered[k].createdAt == ordered[i].createdAt; k++ {
+			if ordered[k].opening == balance {
+				ordered[i], ordered[k] = ordered[k], ordered[i]
+				break
+			}
+		}
+
+		expected[i] = balance
+		if ordered[i].credit {
+			balance += ordered[i].amount
+		} else {
+			balance -= ordered[i].amount
+		}
+	}
+
+	return ordered, expected, balance
+}
+
+// Check recomputes the balance of every account from its transactions and the stock balance of
+// every product from its movements, reporting each stored value that drifted. Account balances are
+// summed over all the transactions of the account, the same as postings do: an archived
+// transaction stays on the account and is cancelled by the reversal posted when it was archived.
+// Archived stock movements are left out as archiving them takes them out of the stock balance.
+// With Repair set the drifted values are rewritten in the same db transaction, with every account
+// and product locked so that no posting can land while the balances are rebuilt.
+func (repo *Repository) Check(ctx context.Context, req CheckRequest, now time.Time) (*Report, error) {
+	span, ctx := tracer.StartSpanFromContext(ctx, "internal.integrity.Check")
+	defer span.Finish()
+
+	if now.IsZero() {
+		now = time.Now()
+	}
+
+	// A plain check reads from a single snapshot so postings made while it runs are not
+	// mistaken for drift.
+	opts := &sql.TxOptions{Isolation: sql.LevelRepeatableRead, ReadOnly: true}
+	if req.Repair {
+		opts = nil
+	}
+
+	tx, err := repo.DbConn.BeginTx(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &Report{CheckedAt: now.UTC(), Repaired: req.Repair}
+
+	if req.Repair {
+		// Products are locked before accounts, the same order as a sale paid from a wallet.
+		if _, err = models.Products(Select(models.ProductColumns.ID), OrderBy(models.ProductColumns.ID), For("UPDATE")).All(ctx, tx); err != nil {
+			_ = tx.Rollback()
+			return nil, errors.WithMessage(err, "Cannot lock products")
+		}
+		if _, err = models.Accounts(Select(models.AccountColumns.ID), OrderBy(models.AccountColumns.ID), For("UPDATE")).All(ctx, tx); err != nil {
+			_ = tx.Rollback()
+			return nil, errors.WithMessage(err, "Cannot lock accounts")
+		}
+	}
+
+	if err = repo.checkAccounts(ctx, report, req.Repair, tx); err != nil {
+		_ = tx.Rollback()
+		return nil, err
+	}
+
+	if err = repo.checkStock(ctx, report, req.Repair, tx); err != nil {
+		_ = tx.Rollback()
+		return nil, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return report, nil
+}
+
+// checkAccounts compares the balance and transaction opening balances of every account with the
+// values computed from its transactions.
+func (repo *Repository) checkAccounts(ctx context.Context, report *Report, repair bool, tx *sql.Tx) error {
+	accounts, err := models.Accounts(
+		Select(models.AccountColumns.ID, models.AccountColumns.Number, models.AccountColumns.Balance),
+		OrderBy(models.AccountColumns.Number),
+	).All(ctx, tx)
+	if err != nil {
+		return errors.WithMessage(err, "Cannot read accounts")
+	}
+
+	txns, err := models.Transactions(
+		Select(models.TransactionColumns.ID, models.TransactionColumns.AccountID, models.TransactionColumns.TXType,
+			models.TransactionColumns.Amount, models.TransactionColumns.OpeningBalance,
+			models.TransactionColumns.ReceiptNo, models.TransactionColumns.CreatedAt),
+		OrderBy(fmt.Sprintf("%s, %s", models.TransactionColumns.CreatedAt, models.TransactionColumns.ID)),
+	).All(ctx, tx)
+	if err != nil {
+		return errors.WithMessage(err, "Cannot read transactions")
+	}
+
+	byAccount := make(map[string][]entry)
+	for _, t := range txns {
+		byAccount[t.AccountID] = append(byAccount[t.AccountID], entry{
+			id:        t.ID,
+			ref:       t.ReceiptNo,
+			credit:    t.TXType == transaction.TransactionType_Deposit.String(),
+			amount:    t.Amount,
+			opening:   t.OpeningBalance,
+			createdAt: t.CreatedAt,
+		})
+	}
+
+	report.Accounts = len(accounts)
+	report.Transactions = len(txns)
+
+	for _, acc := range accounts {
+		ordered, expected, balance := chain(byAccount[acc.ID])
+
+		for i, e := range ordered {
+			if e.opening == expected[i] {
+				continue
+			}
+			report.Drifts = append(report.Drifts, Drift{
+				Kind:      DriftKind_OpeningBalance,
+				RecordID:  e.id,
+				Reference: fmt.Sprintf("%s %s", acc.Number, e.ref),
+				Expected:  expected[i],
+				Actual:    e.opening,
+			})
+
+			if repair {
+				if _, err := models.Transactions(models.TransactionWhere.ID.EQ(e.id)).UpdateAll(ctx, tx, models.M{
+					models.TransactionColumns.OpeningBalance: expected[i],
+				}); err != nil {
+					return errors.WithMessagef(err, "Cannot repair opening balance of transaction %s", e.id)
+				}
+			}
+		}
+
+		if acc.Balance == balance {
+			continue
+		}
+		report.Drifts = append(report.Drifts, Drift{
+			Kind:      DriftKind_AccountBalance,
+			RecordID:  acc.ID,
+			Reference: acc.Number,
+			Expected:  balance,
+			Actual:    acc.Balance,
+		})
+
+		if repair {
+			if _, err := models.Accounts(models.AccountWhere.ID.EQ(acc.ID)).UpdateAll(ctx, tx, models.M{
+				models.AccountColumns.Balance: balance,
+			}); err != nil {
+				return errors.WithMessagef(err, "Cannot repair balance of account %s", acc.Number)
+			}
+		}
+	}
+
+	return nil
+}
+
+// checkStock compares the stock balance of every product and the opening balances of its stock
+// movements with the values computed from the movements. Opening balances run per branch while
+// the stock balance of the product covers every branch.
+func (repo *Repository) checkStock(ctx context.Context, report *Report, repair bool, tx *sql.Tx) error {
+	products, err := models.Products(
+		Select(models.ProductColumns.ID, models.ProductColumns.Name, models.ProductColumns.StockBalance),
+		OrderBy(models.ProductColumns.Name),
+	).All(ctx, tx)
+	if err != nil {
+		return errors.WithMessage(err, "Cannot read products")
+	}
+
+	movements, err := models.Inventories(
+		Select(models.InventoryColumns.ID, models.InventoryColumns.ProductID, models.InventoryColumns.BranchID,
+			models.InventoryColumns.TXType, models.InventoryColumns.Quantity, models.InventoryColumns.OpeningBalance,
+			models.InventoryColumns.CreatedAt),
+		models.InventoryWhere.ArchivedAt.IsNull(),
+		OrderBy(fmt.Sprintf("%s, %s", models.InventoryColumns.CreatedAt, models.InventoryColumns.ID)),
+	).All(ctx, tx)
+	if err != nil {
+		return errors.WithMessage(err, "Cannot read stock movements")
+	}
+
+	// Movements of each product grouped by branch.
+	byProduct := make(map[string]map[string][]entry)
+	for _, m := range movements {
+		if byProduct[m.ProductID] == nil {
+			byProduct[m.ProductID] = make(map[string][]entry)
+		}
+		byProduct[m.ProductID][m.BranchID] = append(byProduct[m.ProductID][m.BranchID], entry{
+			id:        m.ID,
+			ref:       m.BranchID,
+			credit:    m.TXType == transaction.TransactionType_Deposit.String(),
+			amount:    int64(math.Round(m.Quantity)),
+			opening:   int64(math.Round(m.OpeningBalance)),
+			createdAt: m.CreatedAt,
+		})
+	}
+
+	report.Products = len(products)
+	report.StockMovements = len(movements)
+
+	for _, prod := range products {
+		// Branches are visited in a fixed order so reports of the same data read the same.
+		branches := make([]string, 0, len(byProduct[prod.ID]))
+		for branchID := range byProduct[prod.ID] {
+			branches = append(branches, branchID)
+		}
+		sort.Strings(branches)
+
+		var stock int64
+		for _, branchID := range branches {
+			ordered, expected, balance := chain(byProduct[prod.ID][branchID])
+			stock += balance
+
+			for i, e := range ordered {
+				if e.opening == expected[i] {
+					continue
+				}
+				report.Drifts = append(report.Drifts, Drift{
+					Kind:      DriftKind_StockOpeningBalance,
+					RecordID:  e.id,
+					Reference: fmt.Sprintf("%s at branch %s", prod.Name, branchID),
+					Expected:  expected[i],
+					Actual:    e.opening,
+				})
+
+				if repair {
+					if _, err := models.Inventories(models.InventoryWhere.ID.EQ(e.id)).UpdateAll(ctx, tx, models.M{
+						models.InventoryColumns.OpeningBalance: float64(expected[i]),
+					}); err != nil {
+						return errors.WithMessagef(err, "Cannot repair opening balance of stock movement %s", e.id)
+					}
+				}
+			}
+		}
+
+		if int64(prod.StockBalance) == stock {
+			continue
+		}
+		report.Drifts = append(report.Drifts, Drift{
+			Kind:      DriftKind_StockBalance,
+			RecordID:  prod.ID,
+			Reference: prod.Name,
+			Expected:  stock,
+			Actual:    int64(prod.StockBalance),
+		})
+
+		if repair {
+			if _, err := models.Products(models.ProductWhere.ID.EQ(prod.ID)).UpdateAll(ctx, tx, models.M{
+				models.ProductColumns.StockBalance: stock,
+			}); err != nil {
+				return errors.WithMessagef(err, "Cannot repair stock balance of %s", prod.Name)
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,258 @@
+package integrity
+
+import (
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/dgrijalva/jwt-go"
+	"github.com/pborman/uuid"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+
+	"merryworld/surebank/internal/customer"
+	"merryworld/surebank/internal/dscommission"
+	"merryworld/surebank/internal/ledger"
+	"merryworld/surebank/internal/platform/auth"
+	"merryworld/surebank/internal/platform/money"
+	"merryworld/surebank/internal/platform/notify"
+	"merryworld/surebank/internal/platform/tests"
+	"merryworld/surebank/internal/postgres/models"
+	"merryworld/surebank/internal/profit"
+	"merryworld/surebank/internal/transaction"
+)
+
+var (
+	test   *tests.Test
+	repo   *Repository
+	txRepo *transaction.Repository
+)
+
+// TestMain is the entry point for testing.
+func TestMain(m *testing.M) {
+	os.Exit(testMain(m))
+}
+
+func testMain(m *testing.M) int {
+	test = tests.New()
+	defer test.TearDown()
+
+	repo = NewRepository(test.MasterDB)
+	txRepo = transaction.NewRepository(test.MasterDB, dscommission.NewRepository(test.MasterDB), profit.NewRepository(test.MasterDB),
+		ledger.NewRepository(test.MasterDB), notify.NewSMSDisabled(), notify.NewEmailDisabled(), nil)
+
+	return m.Run()
+}
+
+// TestChain validates the opening balances computed for a running balance.
+func TestChain(t *testing.T) {
+
+	var chainTests = []struct {
+		name     string
+		entries  []entry
+		order    []string
+		expected []int64
+		balance  int64
+	}{
+		{
+			"no entries",
+			nil,
+			[]string{},
+			[]int64{},
+			0,
+		},
+		{
+			"in order",
+			[]entry{
+				{id: "a", credit: true, amount: 500, opening: 0, createdAt: 1},
+				{id: "b", credit: true, amount: 200, opening: 500, createdAt: 2},
+				{id: "c", credit: false, amount: 300, opening: 700, createdAt: 3},
+			},
+			[]string{"a", "b", "c"},
+			[]int64{0, 500, 700},
+			400,
+		},
+		{
+			"same second follows the recorded chain",
+			[]entry{
+				{id: "a", credit: true, amount: 100, opening: 100, createdAt: 1},
+				{id: "b", credit: true, amount: 100, opening: 0, createdAt: 1},
+				{id: "c", credit: true, amount: 100, opening: 200, createdAt: 1},
+			},
+			[]string{"b", "a", "c"},
+			[]int64{0, 100, 200},
+			300,
+		},
+		{
+			"drifted opening balance",
+			[]entry{
+				{id: "a", credit: true, amount: 100, opening: 0, createdAt: 1},
+				{id: "b", credit: false, amount: 40, opening: 90, createdAt: 2},
+				{id: "c", credit: true, amount: 10, opening: 50, createdAt: 3},
+			},
+			[]string{"a", "b", "c"},
+			[]int64{0, 100, 60},
+			70,
+		},
+	}
+
+	t.Log("Given the need to rebuild running balances from their entries.")
+	{
+		for i, tt := range chainTests {
+			t.Logf("\tTest: %d\tWhen chaining %s.", i, tt.name)
+			{
+				ordered, expected, balance := chain(tt.entries)
+
+				for j, e := range ordered {
+					if e.id != tt.order[j] {
+						t.Fatalf("\t%s\tExpected entry %d to be %s, got %s.", tests.Failed, j, tt.order[j], e.id)
+					}
+					if expected[j] != tt.expected[j] {
+						t.Fatalf("\t%s\tExpected entry %s to open at %d, got %d.", tests.Failed, e.id, tt.expected[j], expected[j])
+					}
+				}
+				if len(ordered) != len(tt.order) {
+					t.Fatalf("\t%s\tExpected %d entries, got %d.", tests.Failed, len(tt.order), len(ordered))
+				}
+				if balance != tt.balance {
+					t.Fatalf("\t%s\tExpected closing balance %d, got %d.", tests.Failed, tt.balance, balance)
+				}
+				t.Logf("\t%s\tChain ok.", tests.Success)
+			}
+		}
+	}
+}
+
+// newTestAccount creates a branch, sales rep, customer and savings account to post transactions to.
+func newTestAccount(t *testing.T, now time.Time) (auth.Claims, *models.Account) {
+	ctx := tests.Context()
+
+	branch := models.Branch{
+		ID:        uuid.NewRandom().String(),
+		Name:      "Branch " + uuid.NewRandom().String(),
+		CreatedAt: now.Unix(),
+		UpdatedAt: now.Unix(),
+	}
+	if err := branch.Insert(ctx, test.MasterDB, boil.Infer()); err != nil {
+		t.Fatalf("\t%s\tInsert branch failed: %v", tests.Failed, err)
+	}
+
+	salesRep := models.User{
+		ID:          uuid.NewRandom().String(),
+		BranchID:    branch.ID,
+		Email:       uuid.NewRandom().String() + "@example.com",
+		FirstName:   "Sales",
+		LastName:    "Rep",
+		PhoneNumber: "08000000000",
+		CreatedAt:   now,
+	}
+	if err := salesRep.Insert(ctx, test.MasterDB, boil.Infer()); err != nil {
+		t.Fatalf("\t%s\tInsert sales rep failed: %v", tests.Failed, err)
+	}
+
+	cust := models.Customer{
+		ID:          uuid.NewRandom().String(),
+		BranchID:    branch.ID,
+		Email:       uuid.NewRandom().String() + "@example.com",
+		Name:        "Test Customer",
+		PhoneNumber: "08000000001",
+		SalesRepID:  salesRep.ID,
+		CreatedAt:   now.Unix(),
+		UpdatedAt:   now.Unix(),
+	}
+	if err := cust.Insert(ctx, test.MasterDB, boil.Infer()); err != nil {
+		t.Fatalf("\t%s\tInsert customer failed: %v", tests.Failed, err)
+	}
+
+	// The SB product is seeded by the schema migrations.
+	product, err := models.AccountProducts(models.AccountProductWhere.Code.EQ(customer.AccountTypeSB)).One(ctx, test.MasterDB)
+	if err != nil {
+		t.Fatalf("\t%s\tRead account product failed: %v", tests.Failed, err)
+	}
+
+	account := models.Account{
+		ID:          uuid.NewRandom().String(),
+		BranchID:    branch.ID,
+		Number:      uuid.NewRandom().String()[:8],
+		CustomerID:  cust.ID,
+		AccountType: product.Code,
+		ProductID:   product.ID,
+		SalesRepID:  salesRep.ID,
+		CreatedAt:   now.Unix(),
+		UpdatedAt:   now.Unix(),
+	}
+	if err := account.Insert(ctx, test.MasterDB, boil.Infer()); err != nil {
+		t.Fatalf("\t%s\tInsert account failed: %v", tests.Failed, err)
+	}
+
+	claims := auth.Claims{
+		Roles: []string{auth.RoleAdmin},
+		StandardClaims: jwt.StandardClaims{
+			Subject:  salesRep.ID,
+			Audience: uuid.NewRandom().String(),
+		},
+	}
+
+	return claims, &account
+}
+
+// TestCheckArchived validates an account with an archived transaction is not reported as drifted,
+// the balance the check computes is the one postings use.
+func TestCheckArchived(t *testing.T) {
+	defer tests.Recover(t)
+
+	now := time.Date(2026, time.October, 18, 9, 0, 0, 0, time.UTC)
+	claims, account := newTestAccount(t, now)
+
+	t.Log("Given the need to find balances that drifted from their transactions.")
+	{
+		ctx := tests.Context()
+
+		var depositIDs []string
+		for i, amount := range []money.Amount{money.Naira(500), money.Naira(200)} {
+			tx, err := txRepo.Deposit(ctx, claims, transaction.CreateRequest{
+				Type:          transaction.TransactionType_Deposit,
+				AccountNumber: account.Number,
+				Amount:        amount,
+				PaymentMethod: transaction.PaymentMethod_Cash,
+			}, now.Add(time.Duration(i)*time.Minute))
+			if err != nil {
+				t.Log("\t\tGot :", err)
+				t.Fatalf("\t%s\tDeposit failed.", tests.Failed)
+			}
+			depositIDs = append(depositIDs, tx.ID)
+		}
+
+		err := txRepo.Archive(ctx, claims, transaction.ArchiveRequest{ID: depositIDs[0], Reason: "Posted twice"}, now.Add(time.Hour))
+		if err != nil {
+			t.Log("\t\tGot :", err)
+			t.Fatalf("\t%s\tArchive failed.", tests.Failed)
+		}
+
+		// Transactions archived before reversals were posted also carry the archived date.
+		if _, err := models.Transactions(models.TransactionWhere.ID.EQ(depositIDs[0])).UpdateAll(ctx, test.MasterDB, models.M{
+			models.TransactionColumns.ArchivedAt: null.Int64From(now.Add(time.Hour).Unix()),
+		}); err != nil {
+			t.Log("\t\tGot :", err)
+			t.Fatalf("\t%s\tUpdate archived date failed.", tests.Failed)
+		}
+
+		t.Log("\tTest: 0\tWhen an account has an archived transaction.")
+		{
+			report, err := repo.Check(ctx, CheckRequest{}, now.Add(2*time.Hour))
+			if err != nil {
+				t.Log("\t\tGot :", err)
+				t.Fatalf("\t%s\tShould check the balances.", tests.Failed)
+			}
+
+			for _, d := range report.Drifts {
+				if strings.HasPrefix(d.Reference, account.Number) {
+					t.Logf("\t\tGot : %+v", d)
+					t.Fatalf("\t%s\tShould not report the account as drifted.", tests.Failed)
+				}
+			}
+			t.Logf("\t%s\tShould not report the account as drifted.", tests.Success)
+		}
+	}
+}
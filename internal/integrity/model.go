@@ -0,0 +1,135 @@
+package integrity
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+
+	"merryworld/surebank/internal/platform/money"
+	"merryworld/surebank/internal/platform/web"
+)
+
+// Repository defines the required dependencies for checking the integrity of cached balances.
+type Repository struct {
+	DbConn *sqlx.DB
+}
+
+// NewRepository creates a new Repository that defines dependencies for checking integrity.
+func NewRepository(db *sqlx.DB) *Repository {
+	return &Repository{
+		DbConn: db,
+	}
+}
+
+// DriftKind identifies the stored value that disagrees with the records it is derived from.
+type DriftKind string
+
+// DriftKind values define the values that are checked.
+const (
+	// DriftKind_OpeningBalance is a transaction whose opening balance does not continue from the
+	// transactions of the account before it.
+	DriftKind_OpeningBalance DriftKind = "opening_balance"
+	// DriftKind_AccountBalance is an account whose balance is not the sum of its transactions.
+	DriftKind_AccountBalance DriftKind = "account_balance"
+	// DriftKind_StockOpeningBalance is a stock movement whose opening balance does not continue
+	// from the movements of the product in the branch before it.
+	DriftKind_StockOpeningBalance DriftKind = "stock_opening_balance"
+	// DriftKind_StockBalance is a product whose stock balance is not the sum of its movements.
+	DriftKind_StockBalance DriftKind = "stock_balance"
+)
+
+func (k DriftKind) String() string {
+	return string(k)
+}
+
+// IsMoney reports whether values of this kind are amounts of money in kobo rather than quantities.
+func (k DriftKind) IsMoney() bool {
+	return k == DriftKind_OpeningBalance || k == DriftKind_AccountBalance
+}
+
+// Drift is a stored value that disagrees with the value computed from the records it summarises.
+type Drift struct {
+	Kind DriftKind `json:"kind"`
+	// RecordID is the ID of the transaction, account, inventory or product row holding the value.
+	RecordID string `json:"record_id"`
+	// Reference identifies the record to a person, such as an account number or product name.
+	Reference string `json:"reference"`
+	Expected  int64  `json:"expected"`
+	Actual    int64  `json:"actual"`
+}
+
+// Report is the outcome of checking every account and product.
+type Report struct {
+	CheckedAt      time.Time `json:"checked_at"`
+	Accounts       int       `json:"accounts"`
+	Transactions   int       `json:"transactions"`
+	Products       int       `json:"products"`
+	StockMovements int       `json:"stock_movements"`
+	Drifts         []Drift   `json:"drifts"`
+	Repaired       bool      `json:"repaired"`
+}
+
+// DriftResponse represents a drift that is returned for display.
+type DriftResponse struct {
+	Kind       DriftKind `json:"kind"`
+	RecordID   string    `json:"record_id"`
+	Reference  string    `json:"reference"`
+	Expected   string    `json:"expected"`
+	Actual     string    `json:"actual"`
+	Difference string    `json:"difference"`
+}
+
+// ReportResponse represents an integrity report that is returned for display.
+type ReportResponse struct {
+	CheckedAt      web.TimeResponse `json:"checked_at"`
+	Accounts       int              `json:"accounts"`
+	Transactions   int              `json:"transactions"`
+	Products       int              `json:"products"`
+	StockMovements int              `json:"stock_movements"`
+	Drifts         []DriftResponse  `json:"drifts"`
+	Repaired       bool             `json:"repaired"`
+}
+
+// Response transforms Report to the ReportResponse that is used for display.
+func (m *Report) Response(ctx context.Context) *ReportResponse {
+	if m == nil {
+		return nil
+	}
+
+	r := &ReportResponse{
+		CheckedAt:      web.NewTimeResponse(ctx, m.CheckedAt),
+		Accounts:       m.Accounts,
+		Transactions:   m.Transactions,
+		Products:       m.Products,
+		StockMovements: m.StockMovements,
+		Repaired:       m.Repaired,
+	}
+
+	format := func(k DriftKind, v int64) string {
+		if k.IsMoney() {
+			return money.Amount(v).String()
+		}
+		return strconv.FormatInt(v, 10)
+	}
+
+	for _, d := range m.Drifts {
+		r.Drifts = append(r.Drifts, DriftResponse{
+			Kind:       d.Kind,
+			RecordID:   d.RecordID,
+			Reference:  d.Reference,
+			Expected:   format(d.Kind, d.Expected),
+			Actual:     format(d.Kind, d.Actual),
+			Difference: format(d.Kind, d.Actual-d.Expected),
+		})
+	}
+
+	return r
+}
+
+// CheckRequest defines the options for an integrity check.
+type CheckRequest struct {
+	// Repair rewrites every drifted value with the value computed from its records.
+	Repair bool `json:"repair"`
+}
@@ -153,14 +153,16 @@ func (repo *Repository) AddStock(ctx context.Context, claims auth.Claims, req Ad
 	// here so the value we return is consistent with what we store.
 	now = now.Truncate(time.Millisecond)
 
-	repo.mutex.Lock()
-	defer repo.mutex.Unlock()
-
 	tx, err := repo.DbConn.Begin()
 	if err != nil {
 		return nil, err
 	}
 
+	if err = repo.LockProducts(ctx, tx, req.ProductID); err != nil {
+		_ = tx.Rollback()
+		return nil, err
+	}
+
 	lastTransaction, err := repo.lastTransaction(ctx, req.ProductID, salesRep.BranchID, tx)
 	if err != nil {
 		if err.Error() != sql.ErrNoRows.Error() {
@@ -186,6 +188,11 @@ func (repo *Repository) AddStock(ctx context.Context, claims auth.Claims, req Ad
 		return nil, errors.WithMessage(err, "Insert deposit failed")
 	}
 
+	if err = repo.adjustStockBalance(ctx, tx, req.ProductID, int64(req.Quantity)); err != nil {
+		_ = tx.Rollback()
+		return nil, err
+	}
+
 	if err = tx.Commit(); err != nil {
 		return nil, err
 	}
@@ -260,8 +267,9 @@ func (repo *Repository) MakeStockDeduction(ctx context.Context, claims auth.Clai
 	// here so the value we return is consistent with what we store.
 	now = now.Truncate(time.Millisecond)
 
-	repo.mutex.Lock()
-	defer repo.mutex.Unlock()
+	if err = repo.LockProducts(ctx, tx, req.ProductID); err != nil {
+		return nil, err
+	}
 
 	lastTransaction, err := repo.lastTransaction(ctx, req.ProductID, salesRep.BranchID, tx)
 	if err != nil {
@@ -292,6 +300,10 @@ func (repo *Repository) MakeStockDeduction(ctx context.Context, claims auth.Clai
 		return nil, errors.WithMessage(err, "Insert deposit failed")
 	}
 
+	if err = repo.adjustStockBalance(ctx, tx, req.ProductID, -req.Quantity); err != nil {
+		return nil, err
+	}
+
 	return &Inventory{
 		ID:             m.ID,
 		ProductID:      m.ProductID,
@@ -305,11 +317,40 @@ func (repo *Repository) MakeStockDeduction(ctx context.Context, claims auth.Clai
 	}, nil
 }
 
+// LockProducts locks the rows of the specified products until the db transaction ends so that
+// stock movements of a product are applied one at a time, including across replicas of the app.
+// The rows are locked in a fixed order to keep transactions that touch several products from
+// deadlocking.
+func (repo *Repository) LockProducts(ctx context.Context, tx *sql.Tx, productIDs ...string) error {
+	_, err := models.Products(
+		models.ProductWhere.ID.IN(productIDs),
+		OrderBy(models.ProductColumns.ID),
+		For("UPDATE"),
+	).All(ctx, tx)
+	if err != nil {
+		return errors.WithMessage(err, "Cannot lock product")
+	}
+	return nil
+}
+
+// adjustStockBalance applies a stock movement to the stock balance cached on the product.
+func (repo *Repository) adjustStockBalance(ctx context.Context, tx *sql.Tx, productID string, quantity int64) error {
+	statement := fmt.Sprintf("UPDATE %s SET %s = %s + $1 WHERE %s = $2",
+		models.TableNames.Product,
+		models.ProductColumns.StockBalance, models.ProductColumns.StockBalance,
+		models.ProductColumns.ID)
+	if _, err := models.NewQuery(SQL(statement, quantity, productID)).ExecContext(ctx, tx); err != nil {
+		return errors.WithMessage(err, "Cannot update stock balance")
+	}
+	return nil
+}
+
 // lastTransaction returns the last transaction for the specified product
 func (repo *Repository) lastTransaction(ctx context.Context, productID string, branchID string, tx *sql.Tx) (*models.Inventory, error) {
 	return models.Inventories(
 		models.InventoryWhere.ProductID.EQ(productID),
 		models.InventoryWhere.BranchID.EQ(branchID),
+		models.InventoryWhere.ArchivedAt.IsNull(),
 		OrderBy(fmt.Sprintf("%s desc", models.InventoryColumns.CreatedAt)),
 		Limit(1),
 	).One(ctx, tx)
@@ -370,36 +411,53 @@ func (repo *Repository) Archive(ctx context.Context, claims auth.Claims, req Arc
 		return err
 	}
 
-	_, err = models.Inventories(models.InventoryWhere.ID.EQ(req.ID)).UpdateAll(ctx, tx, models.M{models.InventoryColumns.ArchivedAt: now})
-	if err != nil {
+	if err = repo.LockProducts(ctx, tx, tranx.ProductID); err != nil {
 		_ = tx.Rollback()
 		return err
 	}
 
-	var txAmount = tranx.Quantity
-	if tranx.TXType == transaction.TransactionType_Withdrawal.String() {
-		txAmount *= -1
+	// Read the stock transaction again now that the product is locked in case it was archived concurrently.
+	if err = tranx.Reload(ctx, tx); err != nil {
+		_ = tx.Rollback()
+		return err
 	}
 
-	// updated all trans after it
-	_, err = models.Inventories(
-		models.InventoryWhere.CreatedAt.GT(tranx.CreatedAt)).
-		UpdateAll(ctx, tx, models.M{models.InventoryColumns.OpeningBalance: fmt.Sprintf("opening_balance + (%f)", txAmount)})
+	if tranx.ArchivedAt.Valid {
+		_ = tx.Rollback()
+		return errors.New("This stock transaction has been archived")
+	}
 
+	_, err = models.Inventories(models.InventoryWhere.ID.EQ(req.ID)).UpdateAll(ctx, tx, models.M{models.InventoryColumns.ArchivedAt: now.Unix()})
 	if err != nil {
 		_ = tx.Rollback()
 		return err
 	}
 
-	// update all product balance
-	_, err = models.Products(models.ProductWhere.ID.EQ(tranx.ProductID)).UpdateAll(ctx, tx,
-		models.M{models.ProductColumns.StockBalance: fmt.Sprintf("stocke_balance + (%f)", txAmount)})
+	var txAmount = tranx.Quantity
+	if tranx.TXType == transaction.TransactionType_Deposit.String() {
+		txAmount *= -1
+	}
 
+	// updated all trans of the product in the branch after it
+	statement := fmt.Sprintf("UPDATE %s SET %s = %s + $1 WHERE %s = $2 AND %s = $3 AND %s > $4",
+		models.TableNames.Inventory,
+		models.InventoryColumns.OpeningBalance, models.InventoryColumns.OpeningBalance,
+		models.InventoryColumns.ProductID, models.InventoryColumns.BranchID, models.InventoryColumns.CreatedAt)
+	_, err = models.NewQuery(SQL(statement, txAmount, tranx.ProductID, tranx.BranchID, tranx.CreatedAt)).ExecContext(ctx, tx)
 	if err != nil {
 		_ = tx.Rollback()
 		return err
 	}
 
+	if err = repo.adjustStockBalance(ctx, tx, tranx.ProductID, int64(txAmount)); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return errors.Wrap(err, "committing stock transaction archive")
+	}
+
 	return nil
 }
 
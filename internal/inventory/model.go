@@ -2,7 +2,6 @@ package inventory
 
 import (
 	"context"
-	"sync"
 	"time"
 
 	"github.com/jmoiron/sqlx"
@@ -17,7 +16,6 @@ import (
 // Repository defines the required dependencies for Inventory.
 type Repository struct {
 	DbConn *sqlx.DB
-	mutex  sync.Mutex
 }
 
 // NewRepository creates a new Repository that defines dependencies for Inventory.
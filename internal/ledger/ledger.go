@@ -0,0 +1,358 @@
+package ledger
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/pborman/uuid"
+	"github.com/pkg/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	. "github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"gopkg.in/DataDog/dd-trace-go.v1/ddtrace/tracer"
+
+	"merryworld/surebank/internal/platform/auth"
+	"merryworld/surebank/internal/platform/money"
+	"merryworld/surebank/internal/platform/web/webcontext"
+	"merryworld/surebank/internal/postgres/models"
+)
+
+var (
+	// ErrNotFound abstracts the postgres not found error.
+	ErrNotFound = errors.New("Entity not found")
+
+	// ErrForbidden occurs when a user tries to do something that is forbidden to them according to our access control policies.
+	ErrForbidden = errors.New("Attempted action is not allowed")
+
+	// ErrUnbalanced occurs when the debits of a journal entry do not equal its credits.
+	ErrUnbalanced = errors.New("Journal entry is not balanced")
+)
+
+// CheckBalanced ensures every line has a single positive side and the total debit equals the
+// total credit.
+func CheckBalanced(lines []Line) error {
+	if len(lines) < 2 {
+		return errors.WithMessage(ErrUnbalanced, "at least two lines are required")
+	}
+
+	var debit, credit money.Amount
+	for i, l := range lines {
+		if l.Debit < 0 || l.Credit < 0 || l.Debit.IsZero() == l.Credit.IsZero() {
+			return errors.WithMessagef(ErrUnbalanced, "line %d must have either a debit or a credit", i+1)
+		}
+		debit += l.Debit
+		credit += l.Credit
+	}
+
+	if debit != credit {
+		return errors.WithMessagef(ErrUnbalanced, "debit %s, credit %s", debit, credit)
+	}
+
+	return nil
+}
+
+// PostTx records a balanced journal entry within the provided db transaction.
+func (repo *Repository) PostTx(ctx context.Context, claims auth.Claims, req PostRequest, now time.Time, tx *sql.Tx) (*JournalEntry, error) {
+	span, ctx := tracer.StartSpanFromContext(ctx, "internal.ledger.PostTx")
+	defer span.Finish()
+
+	// Validate the request.
+	v := webcontext.Validator()
+	err := v.Struct(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := CheckBalanced(req.Lines); err != nil {
+		return nil, err
+	}
+
+	return repo.post(ctx, claims, req, null.String{}, now, tx)
+}
+
+// post inserts the journal entry and its postings.
+func (repo *Repository) post(ctx context.Context, claims auth.Claims, req PostRequest, reversalOfID null.String,
+	now time.Time, tx *sql.Tx) (*JournalEntry, error) {
+
+	// If now empty set it to the current time.
+	if now.IsZero() {
+		now = time.Now()
+	}
+
+	// Always store the time as UTC.
+	now = now.UTC()
+	// Postgres truncates times to milliseconds when storing. We and do the same
+	// here so the value we return is consistent with what we store.
+	now = now.Truncate(time.Millisecond)
+
+	m := models.JournalEntry{
+		ID:           uuid.NewRandom().String(),
+		Reference:    req.Reference,
+		Narration:    req.Narration,
+		SourceType:   req.SourceType,
+		SourceID:     req.SourceID,
+		ReversalOfID: reversalOfID,
+		CreatedAt:    now.Unix(),
+	}
+	if claims.Subject != "" {
+		m.CreatedByID = null.StringFrom(claims.Subject)
+	}
+
+	if err := m.Insert(ctx, tx, boil.Infer()); err != nil {
+		return nil, errors.WithMessage(err, "Insert journal entry failed")
+	}
+
+	entry := JournalEntryFromModel(&m)
+	for _, l := range req.Lines {
+		p := models.Posting{
+			ID:                uuid.NewRandom().String(),
+			JournalEntryID:    m.ID,
+			LedgerAccountCode: l.LedgerAccountCode,
+			Debit:             l.Debit.Kobo(),
+			Credit:            l.Credit.Kobo(),
+			CreatedAt:         now.Unix(),
+		}
+		if l.AccountID != "" {
+			p.AccountID = null.StringFrom(l.AccountID)
+		}
+
+		if err := p.Insert(ctx, tx, boil.Infer()); err != nil {
+			return nil, errors.WithMessagef(err, "Insert posting to %s failed", l.LedgerAccountCode)
+		}
+		entry.Postings = append(entry.Postings, PostingFromModel(&p))
+	}
+
+	return entry, nil
+}
+
+// liveEntries returns the journal entries of a source record that have not been reversed.
+func (repo *Repository) liveEntries(ctx context.Context, sourceType, sourceID string, tx *sql.Tx) (models.JournalEntrySlice, error) {
+	return models.JournalEntries(
+		Load(models.JournalEntryRels.Postings),
+		models.JournalEntryWhere.SourceType.EQ(sourceType),
+		models.JournalEntryWhere.SourceID.EQ(sourceID),
+		models.JournalEntryWhere.ReversalOfID.IsNull(),
+		Where(fmt.Sprintf("NOT EXISTS (SELECT 1 FROM %s r WHERE r.%s = %s.%s)",
+			models.TableNames.JournalEntry, models.JournalEntryColumns.ReversalOfID,
+			models.TableNames.JournalEntry, models.JournalEntryColumns.ID)),
+		OrderBy(models.JournalEntryColumns.CreatedAt),
+	).All(ctx, tx)
+}
+
+// ReverseTx posts a mirror entry for every journal entry of the source record that has not yet
+// been reversed, leaving the original entries untouched.
+func (repo *Repository) ReverseTx(ctx context.Context, claims auth.Claims, req ReverseRequest, now time.Time, tx *sql.Tx) (JournalEntries, error) {
+	span, ctx := tracer.StartSpanFromContext(ctx, "internal.ledger.ReverseTx")
+	defer span.Finish()
+
+	// Validate the request.
+	v := webcontext.Validator()
+	err := v.Struct(req)
+	if err != nil {
+		return nil, err
+	}
+
+	entries, err := repo.liveEntries(ctx, req.SourceType, req.SourceID, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	var result JournalEntries
+	for _, e := range entries {
+		narration := req.Narration
+		if narration == "" {
+			narration = "Reversal of " + e.Narration
+		}
+		rev := PostRequest{
+			Reference:  e.Reference,
+			Narration:  narration,
+			SourceType: e.SourceType,
+			SourceID:   e.SourceID,
+		}
+		for _, p := range e.R.Postings {
+			rev.Lines = append(rev.Lines, Line{
+				LedgerAccountCode: p.LedgerAccountCode,
+				AccountID:         p.AccountID.String,
+				Debit:             money.Amount(p.Credit),
+				Credit:            money.Amount(p.Debit),
+			})
+		}
+
+		r, err := repo.post(ctx, claims, rev, null.StringFrom(e.ID), now, tx)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, r)
+	}
+
+	return result, nil
+}
+
+// RestateTx reverses the journal entries of the source record and posts them again with every
+// line set to the new amount. It is meant for two-sided entries such as a deposit whose amount
+// was corrected.
+func (repo *Repository) RestateTx(ctx context.Context, claims auth.Claims, req RestateRequest, now time.Time, tx *sql.Tx) (JournalEntries, error) {
+	span, ctx := tracer.StartSpanFromContext(ctx, "internal.ledger.RestateTx")
+	defer span.Finish()
+
+	// Validate the request.
+	v := webcontext.Validator()
+	err := v.Struct(req)
+	if err != nil {
+		return nil, err
+	}
+
+	entries, err := repo.liveEntries(ctx, req.SourceType, req.SourceID, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err = repo.ReverseTx(ctx, claims, ReverseRequest{
+		SourceType: req.SourceType,
+		SourceID:   req.SourceID,
+		Narration:  req.Narration,
+	}, now, tx); err != nil {
+		return nil, err
+	}
+
+	var result JournalEntries
+	for _, e := range entries {
+		post := PostRequest{
+			Reference:  e.Reference,
+			Narration:  e.Narration,
+			SourceType: e.SourceType,
+			SourceID:   e.SourceID,
+		}
+		for _, p := range e.R.Postings {
+			l := Line{LedgerAccountCode: p.LedgerAccountCode, AccountID: p.AccountID.String}
+			if p.Debit > 0 {
+				l.Debit = req.Amount
+			} else {
+				l.Credit = req.Amount
+			}
+			post.Lines = append(post.Lines, l)
+		}
+
+		r, err := repo.PostTx(ctx, claims, post, now, tx)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, r)
+	}
+
+	return result, nil
+}
+
+// Find gets the journal entries from the database based on the request params.
+func (repo *Repository) Find(ctx context.Context, _ auth.Claims, req FindRequest) (JournalEntries, error) {
+	span, ctx := tracer.StartSpanFromContext(ctx, "internal.ledger.Find")
+	defer span.Finish()
+
+	var queries = []QueryMod{
+		Load(models.JournalEntryRels.Postings),
+	}
+
+	if req.Where != "" {
+		queries = append(queries, Where(req.Where, req.Args...))
+	}
+
+	if len(req.Order) > 0 {
+		for _, s := range req.Order {
+			queries = append(queries, OrderBy(s))
+		}
+	}
+
+	if req.Limit != nil {
+		queries = append(queries, Limit(int(*req.Limit)))
+	}
+
+	if req.Offset != nil {
+		queries = append(queries, Offset(int(*req.Offset)))
+	}
+
+	slice, err := models.JournalEntries(queries...).All(ctx, repo.DbConn)
+	if err != nil {
+		return nil, err
+	}
+
+	var result JournalEntries
+	for _, rec := range slice {
+		result = append(result, JournalEntryFromModel(rec))
+	}
+
+	return result, nil
+}
+
+// ChartOfAccounts gets all the ledger accounts ordered by code.
+func (repo *Repository) ChartOfAccounts(ctx context.Context) ([]*LedgerAccount, error) {
+	span, ctx := tracer.StartSpanFromContext(ctx, "internal.ledger.ChartOfAccounts")
+	defer span.Finish()
+
+	slice, err := models.LedgerAccounts(OrderBy(models.LedgerAccountColumns.Code)).All(ctx, repo.DbConn)
+	if err != nil {
+		return nil, err
+	}
+
+	var result []*LedgerAccount
+	for _, rec := range slice {
+		result = append(result, LedgerAccountFromModel(rec))
+	}
+
+	return result, nil
+}
+
+const trialBalanceStatement = `SELECT
+		la.code, la.name, la.account_type,
+		COALESCE(SUM(p.debit), 0)::INT8 AS debit,
+		COALESCE(SUM(p.credit), 0)::INT8 AS credit
+	FROM ledger_account la
+	LEFT JOIN posting p ON p.ledger_account_code = la.code AND p.created_at <= $1
+	GROUP BY la.code, la.name, la.account_type
+	ORDER BY la.code`
+
+// TrialBalance sums the postings of every ledger account up to the specified time. Each account
+// is reported on the side of its net balance.
+func (repo *Repository) TrialBalance(ctx context.Context, _ auth.Claims, asOf time.Time) (*TrialBalance, error) {
+	span, ctx := tracer.StartSpanFromContext(ctx, "internal.ledger.TrialBalance")
+	defer span.Finish()
+
+	if asOf.IsZero() {
+		asOf = time.Now()
+	}
+	asOf = asOf.UTC()
+
+	rows, err := repo.DbConn.QueryContext(ctx, trialBalanceStatement, asOf.Unix())
+	if err != nil {
+		return nil, errors.Wrap(err, "Query trial balance failed")
+	}
+	defer rows.Close()
+
+	tb := &TrialBalance{AsOf: asOf}
+	for rows.Next() {
+		var (
+			line          TrialBalanceLine
+			debit, credit money.Amount
+		)
+		if err := rows.Scan(&line.Code, &line.Name, &line.AccountType, &debit, &credit); err != nil {
+			return nil, err
+		}
+
+		if net := debit - credit; net > 0 {
+			line.Debit = net
+			tb.TotalDebit += net
+		} else {
+			line.Credit = -net
+			tb.TotalCredit += -net
+		}
+		tb.Lines = append(tb.Lines, &line)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	tb.Balanced = tb.TotalDebit == tb.TotalCredit
+
+	return tb, nil
+}
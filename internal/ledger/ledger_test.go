@@ -0,0 +1,296 @@
+package ledger
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	"github.com/pborman/uuid"
+	"github.com/pkg/errors"
+
+	"merryworld/surebank/internal/platform/auth"
+	"merryworld/surebank/internal/platform/money"
+	"merryworld/surebank/internal/platform/tests"
+)
+
+var (
+	test *tests.Test
+	repo *Repository
+)
+
+// TestMain is the entry point for testing.
+func TestMain(m *testing.M) {
+	os.Exit(testMain(m))
+}
+
+func testMain(m *testing.M) int {
+	test = tests.New()
+	defer test.TearDown()
+
+	repo = NewRepository(test.MasterDB)
+
+	return m.Run()
+}
+
+// TestCheckBalanced validates journal entries are only posted when their debits equal their credits.
+func TestCheckBalanced(t *testing.T) {
+	type balanceTest struct {
+		Name  string
+		Lines []Line
+		Err   error
+	}
+
+	var balanceTests = []balanceTest{
+		{"balanced", []Line{
+			Debit(AccountCash, money.Naira(500)),
+			Credit(AccountCustomerDeposits, money.Naira(500)),
+		}, nil},
+		{"split over several lines", []Line{
+			Debit(AccountCash, money.Naira(500)),
+			Credit(AccountCustomerDeposits, money.Naira(450)),
+			Credit(AccountDSFeeIncome, money.Naira(50)),
+		}, nil},
+		{"unbalanced", []Line{
+			Debit(AccountCash, money.Naira(500)),
+			Credit(AccountCustomerDeposits, money.Naira(400)),
+		}, ErrUnbalanced},
+		{"without lines", nil, ErrUnbalanced},
+		{"with a single line", []Line{
+			Debit(AccountCash, money.Naira(500)),
+		}, ErrUnbalanced},
+		{"with a zero amount", []Line{
+			Debit(AccountCash, 0),
+			Credit(AccountCustomerDeposits, 0),
+		}, ErrUnbalanced},
+		{"with a negative amount", []Line{
+			Debit(AccountCash, money.Naira(-500)),
+			Credit(AccountCustomerDeposits, money.Naira(-500)),
+		}, ErrUnbalanced},
+		{"with a line that debits and credits", []Line{
+			{LedgerAccountCode: AccountCash, Debit: money.Naira(500), Credit: money.Naira(500)},
+			Credit(AccountCustomerDeposits, money.Naira(500)),
+			Debit(AccountBank, money.Naira(500)),
+		}, ErrUnbalanced},
+	}
+
+	t.Log("Given the need to keep the ledger balanced.")
+	{
+		for i, tt := range balanceTests {
+			t.Logf("\tTest: %d\tWhen the journal entry is %s.", i, tt.Name)
+			{
+				err := CheckBalanced(tt.Lines)
+				if errors.Cause(err) != tt.Err {
+					t.Logf("\t\tGot : %v", err)
+					t.Logf("\t\tWant: %v", tt.Err)
+					t.Fatalf("\t%s\tShould get the expected error.", tests.Failed)
+				}
+				t.Logf("\t%s\tShould get the expected error.", tests.Success)
+			}
+		}
+	}
+}
+
+// postTestEntry posts a deposit of amount to the ledger for a new source record.
+func postTestEntry(t *testing.T, amount money.Amount, now time.Time) *JournalEntry {
+	ctx := tests.Context()
+
+	tx, err := test.MasterDB.Begin()
+	if err != nil {
+		t.Log("\t\tGot :", err)
+		t.Fatalf("\t%s\tBegin failed.", tests.Failed)
+	}
+
+	entry, err := repo.PostTx(ctx, auth.Claims{}, PostRequest{
+		Reference:  "TEST",
+		Narration:  "deposit",
+		SourceType: SourceTransaction,
+		SourceID:   uuid.NewRandom().String(),
+		Lines: []Line{
+			Debit(AccountCash, amount),
+			Credit(AccountCustomerDeposits, amount),
+		},
+	}, now, tx)
+	if err != nil {
+		_ = tx.Rollback()
+		t.Log("\t\tGot :", err)
+		t.Fatalf("\t%s\tPostTx failed.", tests.Failed)
+	}
+
+	if err := tx.Commit(); err != nil {
+		t.Log("\t\tGot :", err)
+		t.Fatalf("\t%s\tCommit failed.", tests.Failed)
+	}
+
+	return entry
+}
+
+// postingsBySide returns the amounts a journal entry debits and credits to each ledger account.
+func postingsBySide(e *JournalEntry) (debits, credits map[string]money.Amount) {
+	debits = make(map[string]money.Amount)
+	credits = make(map[string]money.Amount)
+	for _, p := range e.Postings {
+		debits[p.LedgerAccountCode] += p.Debit
+		credits[p.LedgerAccountCode] += p.Credit
+	}
+	return debits, credits
+}
+
+// TestReverseTx validates a reversal mirrors the journal entries of a source record.
+func TestReverseTx(t *testing.T) {
+	defer tests.Recover(t)
+
+	now := time.Date(2026, time.October, 18, 9, 0, 0, 0, time.UTC)
+
+	t.Log("Given the need to reverse the journal entries of a source record.")
+	{
+		ctx := tests.Context()
+
+		original := postTestEntry(t, money.Naira(500), now)
+
+		tx, err := test.MasterDB.Begin()
+		if err != nil {
+			t.Log("\t\tGot :", err)
+			t.Fatalf("\t%s\tBegin failed.", tests.Failed)
+		}
+		defer tx.Rollback()
+
+		reversals, err := repo.ReverseTx(ctx, auth.Claims{}, ReverseRequest{
+			SourceType: original.SourceType,
+			SourceID:   original.SourceID,
+		}, now.Add(time.Minute), tx)
+		if err != nil {
+			t.Log("\t\tGot :", err)
+			t.Fatalf("\t%s\tReverseTx failed.", tests.Failed)
+		}
+		if len(reversals) != 1 || reversals[0].ReversalOfID == nil || *reversals[0].ReversalOfID != original.ID {
+			t.Logf("\t\tGot : %+v", reversals)
+			t.Fatalf("\t%s\tShould post a reversal linked to the original entry.", tests.Failed)
+		}
+		t.Logf("\t%s\tShould post a reversal linked to the original entry.", tests.Success)
+
+		debits, credits := postingsBySide(original)
+		revDebits, revCredits := postingsBySide(reversals[0])
+		for code := range debits {
+			if revCredits[code] != debits[code] || revDebits[code] != credits[code] {
+				t.Logf("\t\tGot : debit %s, credit %s to %s", revDebits[code], revCredits[code], code)
+				t.Logf("\t\tWant: debit %s, credit %s to %s", credits[code], debits[code], code)
+				t.Fatalf("\t%s\tShould mirror the postings.", tests.Failed)
+			}
+		}
+		t.Logf("\t%s\tShould mirror the postings.", tests.Success)
+
+		reversals, err = repo.ReverseTx(ctx, auth.Claims{}, ReverseRequest{
+			SourceType: original.SourceType,
+			SourceID:   original.SourceID,
+		}, now.Add(2*time.Minute), tx)
+		if err != nil {
+			t.Log("\t\tGot :", err)
+			t.Fatalf("\t%s\tReverseTx failed.", tests.Failed)
+		}
+		if len(reversals) != 0 {
+			t.Logf("\t\tGot : %d reversals", len(reversals))
+			t.Fatalf("\t%s\tShould not reverse an entry twice.", tests.Failed)
+		}
+		t.Logf("\t%s\tShould not reverse an entry twice.", tests.Success)
+	}
+}
+
+// TestRestateTx validates a restatement reverses the journal entries of a source record and
+// posts them again at the new amount.
+func TestRestateTx(t *testing.T) {
+	defer tests.Recover(t)
+
+	now := time.Date(2026, time.October, 18, 10, 0, 0, 0, time.UTC)
+
+	t.Log("Given the need to correct the amount of a source record in the ledger.")
+	{
+		ctx := tests.Context()
+
+		original := postTestEntry(t, money.Naira(500), now)
+
+		tx, err := test.MasterDB.Begin()
+		if err != nil {
+			t.Log("\t\tGot :", err)
+			t.Fatalf("\t%s\tBegin failed.", tests.Failed)
+		}
+		defer tx.Rollback()
+
+		amount := money.Naira(300)
+		restated, err := repo.RestateTx(ctx, auth.Claims{}, RestateRequest{
+			SourceType: original.SourceType,
+			SourceID:   original.SourceID,
+			Amount:     amount,
+		}, now.Add(time.Minute), tx)
+		if err != nil {
+			t.Log("\t\tGot :", err)
+			t.Fatalf("\t%s\tRestateTx failed.", tests.Failed)
+		}
+
+		live, err := repo.liveEntries(ctx, original.SourceType, original.SourceID, tx)
+		if err != nil {
+			t.Log("\t\tGot :", err)
+			t.Fatalf("\t%s\tFind entries failed.", tests.Failed)
+		}
+		if len(live) != 1 || len(restated) != 1 || live[0].ID != restated[0].ID {
+			t.Logf("\t\tGot : %d entries", len(live))
+			t.Fatalf("\t%s\tShould reverse the original entry.", tests.Failed)
+		}
+		t.Logf("\t%s\tShould reverse the original entry.", tests.Success)
+
+		var lines []Line
+		for _, p := range restated[0].Postings {
+			lines = append(lines, Line{LedgerAccountCode: p.LedgerAccountCode, Debit: p.Debit, Credit: p.Credit})
+		}
+		if err := CheckBalanced(lines); err != nil {
+			t.Log("\t\tGot :", err)
+			t.Fatalf("\t%s\tShould post a balanced entry.", tests.Failed)
+		}
+
+		debits, credits := postingsBySide(restated[0])
+		if debits[AccountCash] != amount || credits[AccountCustomerDeposits] != amount {
+			t.Logf("\t\tGot : debit %s, credit %s", debits[AccountCash], credits[AccountCustomerDeposits])
+			t.Logf("\t\tWant: debit %s, credit %s", amount, amount)
+			t.Fatalf("\t%s\tShould post the new amount.", tests.Failed)
+		}
+		t.Logf("\t%s\tShould post a balanced entry at the new amount.", tests.Success)
+	}
+}
+
+// TestTrialBalance validates the trial balance totals of a balanced ledger are equal.
+func TestTrialBalance(t *testing.T) {
+	defer tests.Recover(t)
+
+	now := time.Date(2026, time.October, 18, 11, 0, 0, 0, time.UTC)
+
+	t.Log("Given the need to check the ledger is balanced.")
+	{
+		ctx := tests.Context()
+
+		before, err := repo.TrialBalance(ctx, auth.Claims{}, now)
+		if err != nil {
+			t.Log("\t\tGot :", err)
+			t.Fatalf("\t%s\tTrialBalance failed.", tests.Failed)
+		}
+
+		postTestEntry(t, money.Naira(700), now)
+		postTestEntry(t, money.Naira(250), now)
+
+		tb, err := repo.TrialBalance(ctx, auth.Claims{}, now)
+		if err != nil {
+			t.Log("\t\tGot :", err)
+			t.Fatalf("\t%s\tTrialBalance failed.", tests.Failed)
+		}
+		if !tb.Balanced || tb.TotalDebit != tb.TotalCredit {
+			t.Logf("\t\tGot : debit %s, credit %s", tb.TotalDebit, tb.TotalCredit)
+			t.Fatalf("\t%s\tShould have equal totals.", tests.Failed)
+		}
+		t.Logf("\t%s\tShould have equal totals.", tests.Success)
+
+		if want := before.TotalDebit + money.Naira(950); tb.TotalDebit != want {
+			t.Logf("\t\tGot : %s", tb.TotalDebit)
+			t.Logf("\t\tWant: %s", want)
+			t.Fatalf("\t%s\tShould include the postings.", tests.Failed)
+		}
+		t.Logf("\t%s\tShould include the postings.", tests.Success)
+	}
+}
@@ -0,0 +1,264 @@
+package ledger
+
+import (
+	"context"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+
+	"merryworld/surebank/internal/platform/money"
+	"merryworld/surebank/internal/platform/web"
+	"merryworld/surebank/internal/postgres/models"
+)
+
+// Repository defines the required dependencies for the general ledger.
+type Repository struct {
+	DbConn *sqlx.DB
+}
+
+// NewRepository creates a new Repository that defines dependencies for the general ledger.
+func NewRepository(db *sqlx.DB) *Repository {
+	return &Repository{
+		DbConn: db,
+	}
+}
+
+// AccountType represents the class of a ledger account in the chart of accounts.
+type AccountType string
+
+// AccountType values define the classes of ledger accounts.
+const (
+	AccountType_Asset     AccountType = "asset"
+	AccountType_Liability AccountType = "liability"
+	AccountType_Equity    AccountType = "equity"
+	AccountType_Income    AccountType = "income"
+	AccountType_Expense   AccountType = "expense"
+)
+
+func (t AccountType) String() string {
+	return string(t)
+}
+
+// DebitNormal reports whether accounts of this type increase with a debit.
+func (t AccountType) DebitNormal() bool {
+	return t == AccountType_Asset || t == AccountType_Expense
+}
+
+// Codes of the ledger accounts seeded in the chart of accounts.
+const (
+	AccountCash               = "1000"
+	AccountBank               = "1010"
+	AccountLoans              = "1100"
+	AccountSalesReceivables   = "1200"
+	AccountCustomerDeposits   = "2000"
+	AccountInterestPayable    = "2100"
+	AccountEquity             = "3000"
+	AccountDSFeeIncome        = "4000"
+	AccountSalesRevenue       = "4100"
+	AccountPenaltyIncome      = "4200"
+	AccountLoanInterestIncome = "4300"
+	AccountOperatingExpenses  = "5000"
+	AccountRepExpenses        = "5100"
+	AccountInterestExpense    = "5200"
+)
+
+// Source types identify the business record a journal entry was posted for.
+const (
+	SourceTransaction   = "transaction"
+	SourceSale          = "sale"
+	SourceBankDeposit   = "bank_deposit"
+	SourceExpenditure   = "expenditure"
+	SourceRepsExpense   = "reps_expense"
+	SourceInterest      = "interest_accrual"
+	SourceTransfer      = "transfer"
+	SourceLoan          = "loan"
+	SourceLoanRepayment = "loan_repayment"
+)
+
+// LedgerAccount is an account in the chart of accounts.
+type LedgerAccount struct {
+	Code        string      `json:"code"`
+	Name        string      `json:"name"`
+	AccountType AccountType `json:"account_type"`
+	CreatedAt   time.Time   `json:"created_at"`
+}
+
+// LedgerAccountFromModel converts the models.LedgerAccount to LedgerAccount.
+func LedgerAccountFromModel(rec *models.LedgerAccount) *LedgerAccount {
+	return &LedgerAccount{
+		Code:        rec.Code,
+		Name:        rec.Name,
+		AccountType: AccountType(rec.AccountType),
+		CreatedAt:   time.Unix(rec.CreatedAt, 0),
+	}
+}
+
+// JournalEntry is a balanced set of postings recorded for a single money movement.
+type JournalEntry struct {
+	ID           string     `json:"id"`
+	Reference    string     `json:"reference"`
+	Narration    string     `json:"narration"`
+	SourceType   string     `json:"source_type"`
+	SourceID     string     `json:"source_id"`
+	ReversalOfID *string    `json:"reversal_of_id,omitempty"`
+	CreatedByID  *string    `json:"created_by_id,omitempty"`
+	CreatedAt    time.Time  `json:"created_at"`
+	Postings     []*Posting `json:"postings"`
+}
+
+// Posting is a single debit or credit line of a journal entry.
+type Posting struct {
+	ID                string       `json:"id"`
+	JournalEntryID    string       `json:"journal_entry_id"`
+	LedgerAccountCode string       `json:"ledger_account_code"`
+	AccountID         *string      `json:"account_id,omitempty"`
+	Debit             money.Amount `json:"debit"`
+	Credit            money.Amount `json:"credit"`
+	CreatedAt         time.Time    `json:"created_at"`
+}
+
+// JournalEntryFromModel converts the models.JournalEntry to JournalEntry.
+func JournalEntryFromModel(rec *models.JournalEntry) *JournalEntry {
+	e := &JournalEntry{
+		ID:         rec.ID,
+		Reference:  rec.Reference,
+		Narration:  rec.Narration,
+		SourceType: rec.SourceType,
+		SourceID:   rec.SourceID,
+		CreatedAt:  time.Unix(rec.CreatedAt, 0),
+	}
+	if rec.ReversalOfID.Valid {
+		e.ReversalOfID = &rec.ReversalOfID.String
+	}
+	if rec.CreatedByID.Valid {
+		e.CreatedByID = &rec.CreatedByID.String
+	}
+
+	if rec.R != nil {
+		for _, p := range rec.R.Postings {
+			e.Postings = append(e.Postings, PostingFromModel(p))
+		}
+	}
+
+	return e
+}
+
+// PostingFromModel converts the models.Posting to Posting.
+func PostingFromModel(rec *models.Posting) *Posting {
+	p := &Posting{
+		ID:                rec.ID,
+		JournalEntryID:    rec.JournalEntryID,
+		LedgerAccountCode: rec.LedgerAccountCode,
+		Debit:             money.Amount(rec.Debit),
+		Credit:            money.Amount(rec.Credit),
+		CreatedAt:         time.Unix(rec.CreatedAt, 0),
+	}
+	if rec.AccountID.Valid {
+		p.AccountID = &rec.AccountID.String
+	}
+	return p
+}
+
+// JournalEntries a list of JournalEntries.
+type JournalEntries []*JournalEntry
+
+// Line describes one side of a journal entry to be posted.
+type Line struct {
+	LedgerAccountCode string       `json:"ledger_account_code" validate:"required"`
+	AccountID         string       `json:"account_id"`
+	Debit             money.Amount `json:"debit" validate:"gte=0"`
+	Credit            money.Amount `json:"credit" validate:"gte=0"`
+}
+
+// Debit returns a line that debits the ledger account with the given amount.
+func Debit(code string, amount money.Amount) Line {
+	return Line{LedgerAccountCode: code, Debit: amount}
+}
+
+// Credit returns a line that credits the ledger account with the given amount.
+func Credit(code string, amount money.Amount) Line {
+	return Line{LedgerAccountCode: code, Credit: amount}
+}
+
+// ForAccount ties the line to a customer account so the customer deposit sub-ledger can be
+// reconciled against the account balance.
+func (l Line) ForAccount(accountID string) Line {
+	l.AccountID = accountID
+	return l
+}
+
+// PostRequest contains the information needed to post a journal entry.
+type PostRequest struct {
+	Reference  string `json:"reference"`
+	Narration  string `json:"narration"`
+	SourceType string `json:"source_type" validate:"required"`
+	SourceID   string `json:"source_id" validate:"required"`
+	Lines      []Line `json:"lines" validate:"required,min=2,dive"`
+}
+
+// ReverseRequest contains the information needed to reverse the journal entries of a source record.
+type ReverseRequest struct {
+	SourceType string `json:"source_type" validate:"required"`
+	SourceID   string `json:"source_id" validate:"required"`
+	Narration  string `json:"narration"`
+}
+
+// RestateRequest contains the information needed to replace the journal entries of a source
+// record with a copy posted at a different amount.
+type RestateRequest struct {
+	SourceType string       `json:"source_type" validate:"required"`
+	SourceID   string       `json:"source_id" validate:"required"`
+	Amount     money.Amount `json:"amount" validate:"gt=0"`
+	Narration  string       `json:"narration"`
+}
+
+// FindRequest defines the possible options to search for journal entries.
+type FindRequest struct {
+	Where  string        `json:"where" example:"source_type = ? and source_id = ?"`
+	Args   []interface{} `json:"args" swaggertype:"array,string" example:"transaction,985f1746-1d9f-459f-a2d9-fc53ece5ae86"`
+	Order  []string      `json:"order" example:"created_at desc"`
+	Limit  *uint         `json:"limit" example:"10"`
+	Offset *uint         `json:"offset" example:"20"`
+}
+
+// TrialBalanceLine is the net position of a single ledger account.
+type TrialBalanceLine struct {
+	Code        string       `json:"code"`
+	Name        string       `json:"name"`
+	AccountType AccountType  `json:"account_type"`
+	Debit       money.Amount `json:"debit"`
+	Credit      money.Amount `json:"credit"`
+}
+
+// TrialBalance lists the net debit or credit of every ledger account as at a point in time.
+type TrialBalance struct {
+	AsOf        time.Time           `json:"as_of"`
+	Lines       []*TrialBalanceLine `json:"lines"`
+	TotalDebit  money.Amount        `json:"total_debit"`
+	TotalCredit money.Amount        `json:"total_credit"`
+	Balanced    bool                `json:"balanced"`
+}
+
+// TrialBalanceResponse represents a trial balance that is returned for display.
+type TrialBalanceResponse struct {
+	AsOf        web.TimeResponse    `json:"as_of"`
+	Lines       []*TrialBalanceLine `json:"lines"`
+	TotalDebit  money.Amount        `json:"total_debit"`
+	TotalCredit money.Amount        `json:"total_credit"`
+	Balanced    bool                `json:"balanced"`
+}
+
+// Response transforms TrialBalance to the TrialBalanceResponse that is used for display.
+func (m *TrialBalance) Response(ctx context.Context) *TrialBalanceResponse {
+	if m == nil {
+		return nil
+	}
+
+	return &TrialBalanceResponse{
+		AsOf:        web.NewTimeResponse(ctx, m.AsOf),
+		Lines:       m.Lines,
+		TotalDebit:  m.TotalDebit,
+		TotalCredit: m.TotalCredit,
+		Balanced:    m.Balanced,
+	}
+}
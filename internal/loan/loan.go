@@ -0,0 +1,889 @@
+package loan
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/jinzhu/now"
+	"github.com/pborman/uuid"
+	"github.com/pkg/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	. "github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"gopkg.in/DataDog/dd-trace-go.v1/ddtrace/tracer"
+
+	"merryworld/surebank/internal/ledger"
+	"merryworld/surebank/internal/platform/auth"
+	"merryworld/surebank/internal/platform/money"
+	"merryworld/surebank/internal/platform/web/webcontext"
+	"merryworld/surebank/internal/platform/web/weberror"
+	"merryworld/surebank/internal/postgres/models"
+	"merryworld/surebank/internal/profit"
+	"merryworld/surebank/internal/transaction"
+)
+
+var (
+	// ErrNotFound abstracts the postgres not found error.
+	ErrNotFound = errors.New("Entity not found")
+
+	// ErrForbidden occurs when a user tries to do something that is forbidden to them according to our access control policies.
+	ErrForbidden = errors.New("Attempted action is not allowed")
+
+	// ErrNotEligible occurs when the savings history of the account does not support the loan.
+	ErrNotEligible = errors.New("The customer is not eligible for the loan")
+
+	// ErrOpenLoan occurs when a loan is applied for against an account that has not repaid its last loan.
+	ErrOpenLoan = errors.New("The account already has a loan that has not been repaid")
+
+	// ErrApproveSelf occurs when an admin approves a loan they applied for.
+	ErrApproveSelf = errors.New("A loan must be approved by someone other than the user that applied for it")
+
+	// ErrStatus occurs when an action is taken on a loan that is not in the right status for it.
+	ErrStatus = errors.New("The loan cannot be changed in its current status")
+)
+
+// loanQueries loads the relations of a loan shown with it.
+func loanQueries() []QueryMod {
+	return []QueryMod{
+		Load(models.LoanRels.Customer),
+		Load(models.LoanRels.Account),
+		Load(models.LoanRels.Branch),
+		Load(models.LoanRels.AppliedBy),
+		Load(models.LoanRels.ApprovedBy),
+		Load(models.LoanRels.DisbursedBy),
+	}
+}
+
+// Find gets all the loans from the database based on the request params.
+func (repo *Repository) Find(ctx context.Context, claims auth.Claims, req FindRequest) (Loans, error) {
+	span, ctx := tracer.StartSpanFromContext(ctx, "internal.loan.Find")
+	defer span.Finish()
+
+	if claims.Audience == "" {
+		return nil, errors.WithStack(ErrForbidden)
+	}
+
+	queries := loanQueries()
+
+	if req.Where != "" {
+		queries = append(queries, Where(req.Where, req.Args...))
+	}
+
+	if len(req.Order) > 0 {
+		for _, s := range req.Order {
+			queries = append(queries, OrderBy(s))
+		}
+	} else {
+		queries = append(queries, OrderBy(models.LoanColumns.CreatedAt+" desc"))
+	}
+
+	if req.Limit != nil {
+		queries = append(queries, Limit(int(*req.Limit)))
+	}
+
+	if req.Offset != nil {
+		queries = append(queries, Offset(int(*req.Offset)))
+	}
+
+	loanSlice, err := models.Loans(queries...).All(ctx, repo.DbConn)
+	if err != nil {
+		return nil, weberror.NewError(ctx, err, http.StatusInternalServerError)
+	}
+
+	var result Loans
+	for _, rec := range loanSlice {
+		result = append(result, FromModel(rec))
+	}
+
+	return result, nil
+}
+
+// Read gets the specified loan with its repayment schedule and repayments from the database.
+func (repo *Repository) Read(ctx context.Context, claims auth.Claims, req ReadRequest) (*Loan, error) {
+	span, ctx := tracer.StartSpanFromContext(ctx, "internal.loan.Read")
+	defer span.Finish()
+
+	if claims.Audience == "" {
+		return nil, errors.WithStack(ErrForbidden)
+	}
+
+	// Validate the request.
+	v := webcontext.Validator()
+	err := v.Struct(req)
+	if err != nil {
+		return nil, err
+	}
+
+	queries := append(loanQueries(),
+		models.LoanWhere.ID.EQ(req.ID),
+		Load(models.LoanRels.LoanInstalments, OrderBy(models.LoanInstalmentColumns.Number)),
+		Load(models.LoanRels.LoanRepayments, OrderBy(models.LoanRepaymentColumns.CreatedAt+" desc")),
+		Load(models.LoanRels.LoanRepayments+"."+models.LoanRepaymentRels.SalesRep),
+	)
+
+	rec, err := models.Loans(queries...).One(ctx, repo.DbConn)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, weberror.NewError(ctx, ErrNotFound, http.StatusNotFound)
+		}
+		return nil, err
+	}
+
+	return FromModel(rec), nil
+}
+
+// Apply records an application for a loan against a savings account. The savings history of
+// the account must meet the eligibility rules and the account cannot have another loan that
+// has not been repaid. The schedule is worked out again when the loan is disbursed.
+func (repo *Repository) Apply(ctx context.Context, claims auth.Claims, req ApplyRequest, now time.Time) (*Loan, error) {
+	span, ctx := tracer.StartSpanFromContext(ctx, "internal.loan.Apply")
+	defer span.Finish()
+
+	if claims.Audience == "" {
+		return nil, errors.WithStack(ErrForbidden)
+	}
+
+	// Validate the request.
+	v := webcontext.Validator()
+	err := v.Struct(req)
+	if err != nil {
+		return nil, err
+	}
+
+	// If now empty set it to the current time.
+	if now.IsZero() {
+		now = time.Now()
+	}
+
+	// Always store the time as UTC.
+	now = now.UTC()
+	// Postgres truncates times to milliseconds when storing. We and do the same
+	// here so the value we return is consistent with what we store.
+	now = now.Truncate(time.Millisecond)
+
+	account, err := models.Accounts(
+		models.AccountWhere.ID.EQ(req.AccountID),
+		models.AccountWhere.ArchivedAt.IsNull(),
+	).One(ctx, repo.DbConn)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, weberror.NewError(ctx, ErrNotFound, http.StatusNotFound)
+		}
+		return nil, err
+	}
+
+	open, err := models.Loans(
+		models.LoanWhere.AccountID.EQ(account.ID),
+		models.LoanWhere.Status.IN([]string{Status_Pending, Status_Approved, Status_Active}),
+	).Exists(ctx, repo.DbConn)
+	if err != nil {
+		return nil, err
+	}
+	if open {
+		return nil, weberror.NewError(ctx, ErrOpenLoan, http.StatusBadRequest)
+	}
+
+	e, err := repo.CheckEligibility(ctx, claims, account.ID, req.Principal, now)
+	if err != nil {
+		return nil, err
+	}
+	if !e.Eligible {
+		return nil, weberror.NewErrorMessage(ctx, ErrNotEligible, http.StatusBadRequest, strings.Join(e.Reasons, ". "))
+	}
+
+	m := models.Loan{
+		ID:              uuid.NewRandom().String(),
+		CustomerID:      account.CustomerID,
+		AccountID:       account.ID,
+		BranchID:        account.BranchID,
+		Principal:       req.Principal.Kobo(),
+		InterestRateBPS: req.InterestRateBPS,
+		Method:          req.Method,
+		Frequency:       req.Frequency,
+		Tenor:           req.Tenor,
+		PenaltyRateBPS:  req.PenaltyRateBPS,
+		AutoDeduct:      req.AutoDeduct,
+		Purpose:         req.Purpose,
+		Status:          Status_Pending,
+		AppliedByID:     claims.Subject,
+		CreatedAt:       now.Unix(),
+		UpdatedAt:       now.Unix(),
+	}
+	l := FromModel(&m)
+	m.TotalInterest = TotalInterest(l.Terms().Schedule(now)).Kobo()
+
+	if err := m.Insert(ctx, repo.DbConn, boil.Infer()); err != nil {
+		return nil, errors.WithMessage(err, "Insert loan failed")
+	}
+
+	return repo.Read(ctx, claims, ReadRequest{ID: m.ID})
+}
+
+// CheckEligibility checks the savings history of the account against the eligibility rules for
+// a loan of principal.
+func (repo *Repository) CheckEligibility(ctx context.Context, claims auth.Claims, accountID string, principal money.Amount, now time.Time) (*Eligibility, error) {
+	span, ctx := tracer.StartSpanFromContext(ctx, "internal.loan.CheckEligibility")
+	defer span.Finish()
+
+	if claims.Audience == "" {
+		return nil, errors.WithStack(ErrForbidden)
+	}
+
+	// If now empty set it to the current time.
+	if now.IsZero() {
+		now = time.Now()
+	}
+	now = now.UTC()
+
+	history, err := repo.TransactionRepo.SavingsHistory(ctx, claims, accountID, now.AddDate(0, 0, -repo.Eligibility.HistoryDays))
+	if err != nil {
+		return nil, err
+	}
+
+	e := repo.Eligibility.Check(*history, principal, now)
+	return &e, nil
+}
+
+// lockLoan reads the loan for update within the db transaction.
+func (repo *Repository) lockLoan(ctx context.Context, id string, tx *sql.Tx) (*models.Loan, error) {
+	m, err := models.Loans(
+		models.LoanWhere.ID.EQ(id),
+		Load(models.LoanRels.Account),
+		For("UPDATE"),
+	).One(ctx, tx)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, weberror.NewError(ctx, ErrNotFound, http.StatusNotFound)
+		}
+		return nil, err
+	}
+	return m, nil
+}
+
+// Approve accepts a loan application. Only admins that did not apply for the loan can approve it.
+func (repo *Repository) Approve(ctx context.Context, claims auth.Claims, req ApproveRequest, now time.Time) (*Loan, error) {
+	span, ctx := tracer.StartSpanFromContext(ctx, "internal.loan.Approve")
+	defer span.Finish()
+
+	if claims.Audience == "" || !claims.HasRole(auth.RoleAdmin, auth.RoleSuperAdmin) {
+		return nil, errors.WithStack(ErrForbidden)
+	}
+
+	// Validate the request.
+	v := webcontext.Validator()
+	err := v.Struct(req)
+	if err != nil {
+		return nil, err
+	}
+
+	// If now empty set it to the current time.
+	if now.IsZero() {
+		now = time.Now()
+	}
+
+	// Always store the time as UTC.
+	now = now.UTC()
+	// Postgres truncates times to milliseconds when storing. We and do the same
+	// here so the value we return is consistent with what we store.
+	now = now.Truncate(time.Millisecond)
+
+	tx, err := repo.DbConn.Begin()
+	if err != nil {
+		return nil, err
+	}
+
+	m, err := repo.lockLoan(ctx, req.ID, tx)
+	if err != nil {
+		_ = tx.Rollback()
+		return nil, err
+	}
+
+	if m.Status != Status_Pending {
+		_ = tx.Rollback()
+		return nil, weberror.NewError(ctx, ErrStatus, http.StatusBadRequest)
+	}
+
+	if m.AppliedByID == claims.Subject {
+		_ = tx.Rollback()
+		return nil, weberror.NewError(ctx, ErrApproveSelf, http.StatusForbidden)
+	}
+
+	m.Status = Status_Approved
+	m.ApprovedByID = null.StringFrom(claims.Subject)
+	m.ApprovedAt = null.Int64From(now.Unix())
+	m.UpdatedAt = now.Unix()
+
+	if _, err = m.Update(ctx, tx, boil.Whitelist(
+		models.LoanColumns.Status,
+		models.LoanColumns.ApprovedByID,
+		models.LoanColumns.ApprovedAt,
+		models.LoanColumns.UpdatedAt,
+	)); err != nil {
+		_ = tx.Rollback()
+		return nil, errors.WithMessage(err, "Cannot approve the loan")
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return repo.Read(ctx, claims, ReadRequest{ID: m.ID})
+}
+
+// Reject turns down a loan application that has not been disbursed.
+func (repo *Repository) Reject(ctx context.Context, claims auth.Claims, req RejectRequest, now time.Time) (*Loan, error) {
+	span, ctx := tracer.StartSpanFromContext(ctx, "internal.loan.Reject")
+	defer span.Finish()
+
+	if claims.Audience == "" || !claims.HasRole(auth.RoleAdmin, auth.RoleSuperAdmin) {
+		return nil, errors.WithStack(ErrForbidden)
+	}
+
+	// Validate the request.
+	v := webcontext.Validator()
+	err := v.Struct(req)
+	if err != nil {
+		return nil, err
+	}
+
+	// If now empty set it to the current time.
+	if now.IsZero() {
+		now = time.Now()
+	}
+
+	// Always store the time as UTC.
+	now = now.UTC()
+	// Postgres truncates times to milliseconds when storing. We and do the same
+	// here so the value we return is consistent with what we store.
+	now = now.Truncate(time.Millisecond)
+
+	tx, err := repo.DbConn.Begin()
+	if err != nil {
+		return nil, err
+	}
+
+	m, err := repo.lockLoan(ctx, req.ID, tx)
+	if err != nil {
+		_ = tx.Rollback()
+		return nil, err
+	}
+
+	if m.Status != Status_Pending && m.Status != Status_Approved {
+		_ = tx.Rollback()
+		return nil, weberror.NewError(ctx, ErrStatus, http.StatusBadRequest)
+	}
+
+	m.Status = Status_Rejected
+	m.RejectedReason = req.Reason
+	m.UpdatedAt = now.Unix()
+
+	if _, err = m.Update(ctx, tx, boil.Whitelist(
+		models.LoanColumns.Status,
+		models.LoanColumns.RejectedReason,
+		models.LoanColumns.UpdatedAt,
+	)); err != nil {
+		_ = tx.Rollback()
+		return nil, errors.WithMessage(err, "Cannot reject the loan")
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return repo.Read(ctx, claims, ReadRequest{ID: m.ID})
+}
+
+// Disburse pays out an approved loan and creates its repayment schedule from the day it is paid
+// out. The loan is either credited to the savings account it was applied against or paid out of
+// the cash on hand, in both cases it is recorded as owed to the business in the ledger.
+func (repo *Repository) Disburse(ctx context.Context, claims auth.Claims, req DisburseRequest, now time.Time) (*Loan, error) {
+	span, ctx := tracer.StartSpanFromContext(ctx, "internal.loan.Disburse")
+	defer span.Finish()
+
+	if claims.Audience == "" || !claims.HasRole(auth.RoleAdmin, auth.RoleSuperAdmin) {
+		return nil, errors.WithStack(ErrForbidden)
+	}
+
+	// Validate the request.
+	v := webcontext.Validator()
+	err := v.Struct(req)
+	if err != nil {
+		return nil, err
+	}
+
+	// If now empty set it to the current time.
+	if now.IsZero() {
+		now = time.Now()
+	}
+
+	// Always store the time as UTC.
+	now = now.UTC()
+	// Postgres truncates times to milliseconds when storing. We and do the same
+	// here so the value we return is consistent with what we store.
+	now = now.Truncate(time.Millisecond)
+
+	tx, err := repo.DbConn.Begin()
+	if err != nil {
+		return nil, err
+	}
+
+	m, err := repo.lockLoan(ctx, req.ID, tx)
+	if err != nil {
+		_ = tx.Rollback()
+		return nil, err
+	}
+
+	if m.Status != Status_Approved {
+		_ = tx.Rollback()
+		return nil, weberror.NewError(ctx, ErrStatus, http.StatusBadRequest)
+	}
+
+	l := FromModel(m)
+	schedule := l.Terms().Schedule(time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC))
+	for _, i := range schedule {
+		inst := models.LoanInstalment{
+			ID:        uuid.NewRandom().String(),
+			LoanID:    m.ID,
+			Number:    i.Number,
+			DueDate:   i.DueDate.Unix(),
+			Principal: i.Principal.Kobo(),
+			Interest:  i.Interest.Kobo(),
+			CreatedAt: now.Unix(),
+			UpdatedAt: now.Unix(),
+		}
+		if err = inst.Insert(ctx, tx, boil.Infer()); err != nil {
+			_ = tx.Rollback()
+			return nil, errors.WithMessagef(err, "Cannot save instalment %d", i.Number)
+		}
+	}
+
+	narration := fmt.Sprintf("Loan disbursement to %s", m.R.Account.Number)
+	if req.Method == Disbursement_Account {
+		txn, err := repo.TransactionRepo.MakeCredit(ctx, claims, transaction.MakeCreditRequest{
+			AccountNumber: m.R.Account.Number,
+			Amount:        l.Principal,
+			Narration:     "Loan disbursement",
+			LedgerAccount: ledger.AccountLoans,
+		}, now, tx)
+		if err != nil {
+			_ = tx.Rollback()
+			return nil, err
+		}
+		m.DisbursementTransactionID = null.StringFrom(txn.ID)
+	} else {
+		if _, err = repo.LedgerRepo.PostTx(ctx, claims, ledger.PostRequest{
+			Reference:  m.R.Account.Number,
+			Narration:  narration,
+			SourceType: ledger.SourceLoan,
+			SourceID:   m.ID,
+			Lines: []ledger.Line{
+				ledger.Debit(ledger.AccountLoans, l.Principal),
+				ledger.Credit(ledger.AccountCash, l.Principal),
+			},
+		}, now, tx); err != nil {
+			_ = tx.Rollback()
+			return nil, errors.WithMessage(err, "Cannot post loan disbursement to the ledger")
+		}
+	}
+
+	m.Status = Status_Active
+	m.TotalInterest = TotalInterest(schedule).Kobo()
+	m.DisbursementMethod = req.Method
+	m.DisbursedByID = null.StringFrom(claims.Subject)
+	m.DisbursedAt = null.Int64From(now.Unix())
+	m.UpdatedAt = now.Unix()
+
+	if _, err = m.Update(ctx, tx, boil.Whitelist(
+		models.LoanColumns.Status,
+		models.LoanColumns.TotalInterest,
+		models.LoanColumns.DisbursementMethod,
+		models.LoanColumns.DisbursementTransactionID,
+		models.LoanColumns.DisbursedByID,
+		models.LoanColumns.DisbursedAt,
+		models.LoanColumns.UpdatedAt,
+	)); err != nil {
+		_ = tx.Rollback()
+		return nil, errors.WithMessage(err, "Cannot disburse the loan")
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return repo.Read(ctx, claims, ReadRequest{ID: m.ID})
+}
+
+// Repay records money received towards an active loan. Repayments pay off the oldest
+// instalments first and the loan is closed once every instalment has been paid.
+func (repo *Repository) Repay(ctx context.Context, claims auth.Claims, req RepayRequest, now time.Time) (*Repayment, error) {
+	span, ctx := tracer.StartSpanFromContext(ctx, "internal.loan.Repay")
+	defer span.Finish()
+
+	if claims.Audience == "" {
+		return nil, errors.WithStack(ErrForbidden)
+	}
+
+	// Validate the request.
+	v := webcontext.Validator()
+	err := v.Struct(req)
+	if err != nil {
+		return nil, err
+	}
+
+	// If now empty set it to the current time.
+	if now.IsZero() {
+		now = time.Now()
+	}
+
+	// Always store the time as UTC.
+	now = now.UTC()
+	// Postgres truncates times to milliseconds when storing. We and do the same
+	// here so the value we return is consistent with what we store.
+	now = now.Truncate(time.Millisecond)
+
+	tx, err := repo.DbConn.Begin()
+	if err != nil {
+		return nil, err
+	}
+
+	m, err := repo.lockLoan(ctx, req.ID, tx)
+	if err != nil {
+		_ = tx.Rollback()
+		return nil, err
+	}
+
+	repayment, err := repo.repay(ctx, claims, m, req.Amount, req.Method, now, tx)
+	if err != nil {
+		_ = tx.Rollback()
+		return nil, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return repayment, nil
+}
+
+// RepaymentLines returns the ledger lines of a repayment received into the contra account. The
+// principal reduces the loans owed to the business and the interest and penalty are income.
+func RepaymentLines(contraAccount string, principal, interest, penalty money.Amount) []ledger.Line {
+	lines := []ledger.Line{ledger.Debit(contraAccount, principal+interest+penalty)}
+	if principal > 0 {
+		lines = append(lines, ledger.Credit(ledger.AccountLoans, principal))
+	}
+	if interest > 0 {
+		lines = append(lines, ledger.Credit(ledger.AccountLoanInterestIncome, interest))
+	}
+	if penalty > 0 {
+		lines = append(lines, ledger.Credit(ledger.AccountPenaltyIncome, penalty))
+	}
+	return lines
+}
+
+// repay shares the amount between the instalments of the locked loan, takes it from the savings
+// account when it is a deduction and records it in the ledger and the profit of the business.
+func (repo *Repository) repay(ctx context.Context, claims auth.Claims, m *models.Loan, amount money.Amount,
+	method string, now time.Time, tx *sql.Tx) (*Repayment, error) {
+
+	if m.Status != Status_Active {
+		return nil, weberror.NewError(ctx, ErrStatus, http.StatusBadRequest)
+	}
+
+	instSlice, err := models.LoanInstalments(
+		models.LoanInstalmentWhere.LoanID.EQ(m.ID),
+		OrderBy(models.LoanInstalmentColumns.Number),
+		For("UPDATE"),
+	).All(ctx, tx)
+	if err != nil {
+		return nil, err
+	}
+	var instalments []*Instalment
+	for _, rec := range instSlice {
+		instalments = append(instalments, InstalmentFromModel(rec))
+	}
+
+	allocations, err := Allocate(instalments, amount)
+	if err != nil {
+		return nil, weberror.NewError(ctx, err, http.StatusBadRequest)
+	}
+
+	r := models.LoanRepayment{
+		ID:         uuid.NewRandom().String(),
+		LoanID:     m.ID,
+		Amount:     amount.Kobo(),
+		Method:     method,
+		SalesRepID: claims.Subject,
+		CreatedAt:  now.Unix(),
+	}
+	for _, a := range allocations {
+		r.Principal += a.Principal.Kobo()
+		r.Interest += a.Interest.Kobo()
+		r.Penalty += a.Penalty.Kobo()
+	}
+	principal, interest, penalty := money.Amount(r.Principal), money.Amount(r.Interest), money.Amount(r.Penalty)
+
+	// Deductions move the whole amount from the savings account to the loan, the interest and
+	// penalty are then taken from the loan to income.
+	contraAccount := transaction.LedgerAccountForPaymentMethod(method)
+	if method == Repayment_Deduction {
+		txn, err := repo.TransactionRepo.MakeDeduction(ctx, claims, transaction.MakeDeductionRequest{
+			AccountNumber: m.R.Account.Number,
+			Amount:        amount,
+			Narration:     "Loan repayment",
+			LedgerAccount: ledger.AccountLoans,
+		}, now, tx)
+		if err != nil {
+			return nil, err
+		}
+		r.TransactionID = null.StringFrom(txn.ID)
+		contraAccount = ledger.AccountLoans
+		principal = 0
+	}
+
+	if err = r.Insert(ctx, tx, boil.Infer()); err != nil {
+		return nil, errors.WithMessage(err, "Insert loan repayment failed")
+	}
+
+	if principal+interest+penalty > 0 {
+		if _, err = repo.LedgerRepo.PostTx(ctx, claims, ledger.PostRequest{
+			Reference:  m.R.Account.Number,
+			Narration:  fmt.Sprintf("Loan repayment from %s", m.R.Account.Number),
+			SourceType: ledger.SourceLoanRepayment,
+			SourceID:   r.ID,
+			Lines:      RepaymentLines(contraAccount, principal, interest, penalty),
+		}, now, tx); err != nil {
+			return nil, errors.WithMessage(err, "Cannot post loan repayment to the ledger")
+		}
+	}
+
+	if income := interest + penalty; income > 0 {
+		if _, err = repo.ProfitRepo.CreateProfitTx(ctx, tx, claims, profit.ProfitCreateRequest{
+			Amount:    income,
+			Narration: fmt.Sprintf("Loan interest on %s", m.R.Account.Number),
+		}, now); err != nil {
+			return nil, errors.WithMessage(err, "Cannot record loan interest income")
+		}
+	}
+
+	paid := true
+	for _, rec := range instSlice {
+		for _, a := range allocations {
+			if a.Number != rec.Number {
+				continue
+			}
+			rec.PrincipalPaid += a.Principal.Kobo()
+			rec.InterestPaid += a.Interest.Kobo()
+			rec.PenaltyPaid += a.Penalty.Kobo()
+			if InstalmentFromModel(rec).Outstanding() <= 0 {
+				rec.PaidAt = null.Int64From(now.Unix())
+			}
+			rec.UpdatedAt = now.Unix()
+			if _, err := rec.Update(ctx, tx, boil.Whitelist(
+				models.LoanInstalmentColumns.PrincipalPaid,
+				models.LoanInstalmentColumns.InterestPaid,
+				models.LoanInstalmentColumns.PenaltyPaid,
+				models.LoanInstalmentColumns.PaidAt,
+				models.LoanInstalmentColumns.UpdatedAt,
+			)); err != nil {
+				return nil, errors.WithMessagef(err, "Cannot update instalment %d", rec.Number)
+			}
+		}
+		if !rec.PaidAt.Valid {
+			paid = false
+		}
+	}
+
+	m.AmountRepaid += amount.Kobo()
+	m.UpdatedAt = now.Unix()
+	if paid {
+		m.Status = Status_Closed
+		m.ClosedAt = null.Int64From(now.Unix())
+	}
+	if _, err := m.Update(ctx, tx, boil.Whitelist(
+		models.LoanColumns.AmountRepaid,
+		models.LoanColumns.Status,
+		models.LoanColumns.ClosedAt,
+		models.LoanColumns.UpdatedAt,
+	)); err != nil {
+		return nil, errors.WithMessage(err, "Cannot update the loan")
+	}
+
+	return RepaymentFromModel(&r), nil
+}
+
+// ProcessDue is the daily loan job. Loans set to auto deduct have the instalments due by the
+// requested date taken from their savings account, loans the account cannot cover are left for
+// the next run. Instalments still unpaid GraceDays after their due date are then charged the
+// penalty of the loan once. Each loan is handled in its own db transactions so the job can
+// safely be run again for the same date.
+func (repo *Repository) ProcessDue(ctx context.Context, req ProcessRequest, currentDate time.Time) (*ProcessReport, error) {
+	span, ctx := tracer.StartSpanFromContext(ctx, "internal.loan.ProcessDue")
+	defer span.Finish()
+
+	// Validate the request.
+	v := webcontext.Validator()
+	if err := v.Struct(req); err != nil {
+		return nil, err
+	}
+
+	// If now empty set it to the current time.
+	if currentDate.IsZero() {
+		currentDate = time.Now()
+	}
+
+	// Always store the time as UTC.
+	currentDate = currentDate.UTC()
+	// Postgres truncates times to milliseconds when storing. We and do the same
+	// here so the value we return is consistent with what we store.
+	currentDate = currentDate.Truncate(time.Millisecond)
+
+	report := &ProcessReport{Date: now.New(req.Date).BeginningOfDay()}
+
+	loans, err := models.Loans(
+		Select(models.LoanColumns.ID),
+		models.LoanWhere.Status.EQ(Status_Active),
+		OrderBy(models.LoanColumns.ID),
+	).All(ctx, repo.DbConn)
+	if err != nil {
+		return nil, errors.WithMessage(err, "Cannot list active loans")
+	}
+
+	for _, l := range loans {
+		if err := repo.deductDue(ctx, l.ID, report, currentDate); err != nil {
+			report.Failed++
+		}
+		if err := repo.penalize(ctx, l.ID, report, currentDate); err != nil {
+			return report, errors.WithMessagef(err, "Cannot charge the penalties of loan %s", l.ID)
+		}
+	}
+
+	return report, nil
+}
+
+// deductDue takes the instalments due by the date of the report from the savings account of a
+// loan that is set to auto deduct.
+func (repo *Repository) deductDue(ctx context.Context, loanID string, report *ProcessReport, currentDate time.Time) error {
+	tx, err := repo.DbConn.Begin()
+	if err != nil {
+		return err
+	}
+
+	m, err := repo.lockLoan(ctx, loanID, tx)
+	if err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+	if !m.AutoDeduct || m.Status != Status_Active {
+		return tx.Rollback()
+	}
+
+	instSlice, err := models.LoanInstalments(
+		models.LoanInstalmentWhere.LoanID.EQ(m.ID),
+		models.LoanInstalmentWhere.PaidAt.IsNull(),
+		models.LoanInstalmentWhere.DueDate.LTE(report.Date.Unix()),
+	).All(ctx, tx)
+	if err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+	var due money.Amount
+	for _, rec := range instSlice {
+		due += InstalmentFromModel(rec).Outstanding()
+	}
+	if due <= 0 {
+		return tx.Rollback()
+	}
+
+	// Deductions are made on behalf of the account manager.
+	var claims auth.Claims
+	claims.Subject = m.R.Account.SalesRepID
+	if _, err = repo.repay(ctx, claims, m, due, Repayment_Deduction, currentDate, tx); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return err
+	}
+
+	report.Deducted++
+	report.Collected += due
+	return nil
+}
+
+// penalize charges the penalty of the loan on the instalments that are still unpaid GraceDays
+// after their due date. An instalment is only charged once and the penalty is recorded as income
+// when it is repaid.
+func (repo *Repository) penalize(ctx context.Context, loanID string, report *ProcessReport, currentDate time.Time) error {
+	tx, err := repo.DbConn.Begin()
+	if err != nil {
+		return err
+	}
+
+	m, err := repo.lockLoan(ctx, loanID, tx)
+	if err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+	if m.Status != Status_Active || m.PenaltyRateBPS <= 0 {
+		return tx.Rollback()
+	}
+
+	instSlice, err := models.LoanInstalments(
+		models.LoanInstalmentWhere.LoanID.EQ(m.ID),
+		models.LoanInstalmentWhere.PaidAt.IsNull(),
+		models.LoanInstalmentWhere.PenalizedAt.IsNull(),
+		models.LoanInstalmentWhere.DueDate.LT(report.Date.AddDate(0, 0, -GraceDays).Unix()),
+		For("UPDATE"),
+	).All(ctx, tx)
+	if err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+
+	var charged money.Amount
+	for _, rec := range instSlice {
+		penalty := Penalty(InstalmentFromModel(rec).Outstanding(), m.PenaltyRateBPS)
+		if penalty <= 0 {
+			continue
+		}
+		rec.Penalty += penalty.Kobo()
+		rec.PenalizedAt = null.Int64From(currentDate.Unix())
+		rec.UpdatedAt = currentDate.Unix()
+		if _, err = rec.Update(ctx, tx, boil.Whitelist(
+			models.LoanInstalmentColumns.Penalty,
+			models.LoanInstalmentColumns.PenalizedAt,
+			models.LoanInstalmentColumns.UpdatedAt,
+		)); err != nil {
+			_ = tx.Rollback()
+			return errors.WithMessagef(err, "Cannot charge the penalty of instalment %d", rec.Number)
+		}
+		charged += penalty
+		report.Penalized++
+	}
+	if charged == 0 {
+		return tx.Rollback()
+	}
+
+	m.Penalties += charged.Kobo()
+	m.UpdatedAt = currentDate.Unix()
+	if _, err = m.Update(ctx, tx, boil.Whitelist(
+		models.LoanColumns.Penalties,
+		models.LoanColumns.UpdatedAt,
+	)); err != nil {
+		_ = tx.Rollback()
+		return errors.WithMessage(err, "Cannot update the loan")
+	}
+
+	if err = tx.Commit(); err != nil {
+		return err
+	}
+
+	report.Penalties += charged
+	return nil
+}
@@ -0,0 +1,325 @@
+package loan
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"merryworld/surebank/internal/ledger"
+	"merryworld/surebank/internal/platform/money"
+	"merryworld/surebank/internal/platform/tests"
+	"merryworld/surebank/internal/transaction"
+)
+
+// TestSchedule validates the instalments worked out for flat and reducing balance loans.
+func TestSchedule(t *testing.T) {
+
+	start := time.Date(2026, time.March, 2, 0, 0, 0, 0, time.UTC)
+	type row struct {
+		principal, interest money.Amount
+	}
+
+	var scheduleTests = []struct {
+		name  string
+		terms Terms
+		want  []row
+	}{
+		{"flat, shared evenly",
+			Terms{Principal: money.Naira(1000), InterestRateBPS: 250, Method: Method_Flat, Tenor: 4},
+			[]row{{25000, 2500}, {25000, 2500}, {25000, 2500}, {25000, 2500}}},
+		{"flat, rounding on the last instalment",
+			Terms{Principal: money.Naira(1000), InterestRateBPS: 100, Method: Method_Flat, Tenor: 3},
+			[]row{{33333, 1000}, {33333, 1000}, {33334, 1000}}},
+		{"flat without interest",
+			Terms{Principal: money.Naira(300), Method: Method_Flat, Tenor: 3},
+			[]row{{10000, 0}, {10000, 0}, {10000, 0}}},
+		{"reducing balance",
+			Terms{Principal: money.Naira(1000), InterestRateBPS: 1000, Method: Method_Reducing, Tenor: 2},
+			[]row{{47619, 10000}, {52381, 5238}}},
+		{"reducing balance without interest",
+			Terms{Principal: money.Naira(1000), Method: Method_Reducing, Tenor: 3},
+			[]row{{33333, 0}, {33333, 0}, {33334, 0}}},
+		{"no instalments",
+			Terms{Principal: money.Naira(1000), Method: Method_Flat},
+			nil},
+	}
+
+	t.Log("Given the need to work out the repayment schedule of a loan.")
+	{
+		for i, tt := range scheduleTests {
+			t.Logf("\tTest: %d\tWhen the loan is %s.", i, tt.name)
+			{
+				var got []row
+				var principal money.Amount
+				for _, inst := range tt.terms.Schedule(start) {
+					got = append(got, row{inst.Principal, inst.Interest})
+					principal += inst.Principal
+				}
+				if !reflect.DeepEqual(got, tt.want) {
+					t.Fatalf("\t%s\tExpected %v, got %v.", tests.Failed, tt.want, got)
+				}
+				if tt.want != nil && principal != tt.terms.Principal {
+					t.Fatalf("\t%s\tExpected the schedule to repay %s, got %s.", tests.Failed, tt.terms.Principal, principal)
+				}
+				t.Logf("\t%s\tSchedule ok.", tests.Success)
+			}
+		}
+	}
+}
+
+// TestAnnuity validates the instalments of reducing balance loans are the same in every period
+// and that they repay exactly the principal and the interest charged.
+func TestAnnuity(t *testing.T) {
+
+	start := time.Date(2026, time.March, 2, 0, 0, 0, 0, time.UTC)
+
+	var annuityTests = []struct {
+		name  string
+		terms Terms
+		want  money.Amount
+	}{
+		{"over two months", Terms{Principal: money.Naira(1000), InterestRateBPS: 1000, Tenor: 2}, 57619},
+		{"over a year", Terms{Principal: money.Naira(50000), InterestRateBPS: 500, Tenor: 12}, 564127},
+		{"over a year of weeks", Terms{Principal: money.Naira(100000), InterestRateBPS: 350, Tenor: 52}, 420243},
+		{"at the lowest rate", Terms{Principal: money.Naira(2500), InterestRateBPS: 1, Tenor: 24}, 10430},
+	}
+
+	t.Log("Given the need to repay reducing balance loans in equal instalments.")
+	{
+		for i, tt := range annuityTests {
+			t.Logf("\tTest: %d\tWhen the loan is repaid %s.", i, tt.name)
+			{
+				tt.terms.Method = Method_Reducing
+				tt.terms.Frequency = Frequency_Monthly
+
+				if got := Annuity(tt.terms.Principal, tt.terms.InterestRateBPS, tt.terms.Tenor); got != tt.want {
+					t.Fatalf("\t%s\tExpected an instalment of %s, got %s.", tests.Failed, tt.want, got)
+				}
+
+				schedule := tt.terms.Schedule(start)
+				if len(schedule) != tt.terms.Tenor {
+					t.Fatalf("\t%s\tExpected %d instalments, got %d.", tests.Failed, tt.terms.Tenor, len(schedule))
+				}
+
+				var principal, total money.Amount
+				for _, inst := range schedule {
+					if inst.Number < tt.terms.Tenor && inst.Principal+inst.Interest != tt.want {
+						t.Fatalf("\t%s\tExpected instalment %d to be %s, got %s.", tests.Failed, inst.Number, tt.want,
+							inst.Principal+inst.Interest)
+					}
+					principal += inst.Principal
+					total += inst.Principal + inst.Interest
+				}
+				if principal != tt.terms.Principal {
+					t.Fatalf("\t%s\tExpected the schedule to repay %s, got %s.", tests.Failed, tt.terms.Principal, principal)
+				}
+				if want := tt.terms.Principal + TotalInterest(schedule); total != want {
+					t.Fatalf("\t%s\tExpected the instalments to sum to %s, got %s.", tests.Failed, want, total)
+				}
+
+				// The last instalment only takes the rounding of the others.
+				last := schedule[len(schedule)-1]
+				if diff := (last.Principal + last.Interest - tt.want).Abs(); diff > money.Amount(tt.terms.Tenor) {
+					t.Fatalf("\t%s\tExpected the last instalment to be within %d kobo of %s, got %s.", tests.Failed,
+						tt.terms.Tenor, tt.want, last.Principal+last.Interest)
+				}
+				t.Logf("\t%s\tAnnuity ok.", tests.Success)
+			}
+		}
+	}
+}
+
+// TestDueDate validates the due dates of weekly and monthly instalments.
+func TestDueDate(t *testing.T) {
+
+	day := func(m time.Month, d int) time.Time {
+		return time.Date(2026, m, d, 0, 0, 0, 0, time.UTC)
+	}
+
+	var dueTests = []struct {
+		start     time.Time
+		frequency string
+		number    int
+		want      time.Time
+	}{
+		{day(time.March, 2), Frequency_Weekly, 1, day(time.March, 9)},
+		{day(time.March, 30), Frequency_Weekly, 2, day(time.April, 13)},
+		{day(time.March, 2), Frequency_Monthly, 1, day(time.April, 2)},
+		{day(time.January, 31), Frequency_Monthly, 1, day(time.February, 28)},
+		{day(time.January, 31), Frequency_Monthly, 2, day(time.March, 31)},
+		{day(time.November, 15), Frequency_Monthly, 3, time.Date(2027, time.February, 15, 0, 0, 0, 0, time.UTC)},
+	}
+
+	t.Log("Given the need to work out when instalments are due.")
+	{
+		for i, tt := range dueTests {
+			t.Logf("\tTest: %d\tWhen instalment %d of a %s loan from %s is due.", i, tt.number, tt.frequency, tt.start.Format("2006-01-02"))
+			{
+				if got := DueDate(tt.start, tt.frequency, tt.number); !got.Equal(tt.want) {
+					t.Fatalf("\t%s\tExpected %s, got %s.", tests.Failed, tt.want, got)
+				}
+				t.Logf("\t%s\tDueDate ok.", tests.Success)
+			}
+		}
+	}
+}
+
+// TestAllocate validates how repayments are shared between instalments.
+func TestAllocate(t *testing.T) {
+
+	instalments := func() []*Instalment {
+		return []*Instalment{
+			{Number: 1, Principal: 1000, Interest: 100, Penalty: 50, PrincipalPaid: 1000, InterestPaid: 100, PenaltyPaid: 50},
+			{Number: 2, Principal: 1000, Interest: 100, Penalty: 50},
+			{Number: 3, Principal: 1000, Interest: 100},
+		}
+	}
+
+	var allocateTests = []struct {
+		name    string
+		amount  money.Amount
+		want    []Allocation
+		wantErr bool
+	}{
+		{"penalty first", 30, []Allocation{{Number: 2, Penalty: 30}}, false},
+		{"interest before principal", 400, []Allocation{{Number: 2, Penalty: 50, Interest: 100, Principal: 250}}, false},
+		{"into the next instalment", 1200, []Allocation{
+			{Number: 2, Penalty: 50, Interest: 100, Principal: 1000},
+			{Number: 3, Interest: 50},
+		}, false},
+		{"everything outstanding", 2250, []Allocation{
+			{Number: 2, Penalty: 50, Interest: 100, Principal: 1000},
+			{Number: 3, Interest: 100, Principal: 1000},
+		}, false},
+		{"more than is outstanding", 2251, nil, true},
+	}
+
+	t.Log("Given the need to share loan repayments between instalments.")
+	{
+		for i, tt := range allocateTests {
+			t.Logf("\tTest: %d\tWhen allocating %s.", i, tt.name)
+			{
+				got, err := Allocate(instalments(), tt.amount)
+				if tt.wantErr {
+					if err == nil {
+						t.Fatalf("\t%s\tExpected an error, got %v.", tests.Failed, got)
+					}
+					t.Logf("\t%s\tAllocate failed as expected.", tests.Success)
+					continue
+				}
+				if err != nil {
+					t.Fatalf("\t%s\tAllocate failed : %+v", tests.Failed, err)
+				}
+				if !reflect.DeepEqual(got, tt.want) {
+					t.Fatalf("\t%s\tExpected %v, got %v.", tests.Failed, tt.want, got)
+				}
+				t.Logf("\t%s\tAllocate ok.", tests.Success)
+			}
+		}
+	}
+}
+
+// TestPenalty validates the penalty charged on overdue instalments.
+func TestPenalty(t *testing.T) {
+
+	var penaltyTests = []struct {
+		outstanding money.Amount
+		rateBPS     int
+		want        money.Amount
+	}{
+		{money.Naira(1000), 500, money.Naira(50)},
+		{money.Amount(333), 500, money.Amount(17)},
+		{money.Naira(1000), 0, 0},
+		{0, 500, 0},
+	}
+
+	t.Log("Given the need to charge penalties on overdue instalments.")
+	{
+		for i, tt := range penaltyTests {
+			t.Logf("\tTest: %d\tWhen %s is overdue at %d basis points.", i, tt.outstanding, tt.rateBPS)
+			{
+				if got := Penalty(tt.outstanding, tt.rateBPS); got != tt.want {
+					t.Fatalf("\t%s\tExpected a penalty of %s, got %s.", tests.Failed, tt.want, got)
+				}
+				t.Logf("\t%s\tPenalty ok.", tests.Success)
+			}
+		}
+	}
+}
+
+// TestEligibility validates checking loan applications against the savings history.
+func TestEligibility(t *testing.T) {
+
+	currentDate := time.Date(2026, time.June, 30, 10, 0, 0, 0, time.UTC)
+	history := func(openedDaysAgo, depositDays int) transaction.SavingsHistory {
+		return transaction.SavingsHistory{
+			Balance:     money.Naira(10000),
+			OpenedAt:    currentDate.AddDate(0, 0, -openedDaysAgo),
+			DepositDays: depositDays,
+		}
+	}
+
+	var eligibilityTests = []struct {
+		name      string
+		history   transaction.SavingsHistory
+		principal money.Amount
+		want      bool
+		reasons   int
+	}{
+		{"a regular saver", history(120, 40), money.Naira(25000), true, 0},
+		{"the most that can be lent", history(120, 40), money.Naira(30000), true, 0},
+		{"above the balance multiple", history(120, 40), money.Naira(30001), false, 1},
+		{"a new account", history(30, 20), money.Naira(5000), false, 1},
+		{"an irregular saver", history(120, 5), money.Naira(5000), false, 1},
+		{"a new irregular saver borrowing too much", history(10, 2), money.Naira(50000), false, 3},
+	}
+
+	t.Log("Given the need to lend against the savings history of an account.")
+	{
+		for i, tt := range eligibilityTests {
+			t.Logf("\tTest: %d\tWhen checking %s.", i, tt.name)
+			{
+				got := DefaultEligibility.Check(tt.history, tt.principal, currentDate)
+				if got.Eligible != tt.want || len(got.Reasons) != tt.reasons {
+					t.Fatalf("\t%s\tExpected eligible %v with %d reasons, got %+v.", tests.Failed, tt.want, tt.reasons, got)
+				}
+				if got.MaxPrincipal != money.Naira(30000) {
+					t.Fatalf("\t%s\tExpected to lend up to %s, got %s.", tests.Failed, money.Naira(30000), got.MaxPrincipal)
+				}
+				t.Logf("\t%s\tCheck ok.", tests.Success)
+			}
+		}
+	}
+}
+
+// TestRepaymentLines validates that repayments post balanced journal entries.
+func TestRepaymentLines(t *testing.T) {
+
+	var lineTests = []struct {
+		name                         string
+		contra                       string
+		principal, interest, penalty money.Amount
+		lines                        int
+	}{
+		{"cash with interest and penalty", ledger.AccountCash, 1000, 100, 50, 4},
+		{"bank with principal only", ledger.AccountBank, 1000, 0, 0, 2},
+		{"deduction moving interest to income", ledger.AccountLoans, 0, 100, 0, 2},
+	}
+
+	t.Log("Given the need to record loan repayments in the ledger.")
+	{
+		for i, tt := range lineTests {
+			t.Logf("\tTest: %d\tWhen repaying by %s.", i, tt.name)
+			{
+				lines := RepaymentLines(tt.contra, tt.principal, tt.interest, tt.penalty)
+				if len(lines) != tt.lines {
+					t.Fatalf("\t%s\tExpected %d lines, got %d.", tests.Failed, tt.lines, len(lines))
+				}
+				if err := ledger.CheckBalanced(lines); err != nil {
+					t.Fatalf("\t%s\tExpected balanced lines : %+v", tests.Failed, err)
+				}
+				t.Logf("\t%s\tRepaymentLines ok.", tests.Success)
+			}
+		}
+	}
+}
@@ -0,0 +1,600 @@
+package loan
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jinzhu/now"
+	"github.com/jmoiron/sqlx"
+
+	"merryworld/surebank/internal/account_product"
+	"merryworld/surebank/internal/ledger"
+	"merryworld/surebank/internal/platform/money"
+	"merryworld/surebank/internal/platform/web"
+	"merryworld/surebank/internal/postgres/models"
+	"merryworld/surebank/internal/profit"
+	"merryworld/surebank/internal/transaction"
+)
+
+// Repository defines the required dependencies for Loan.
+type Repository struct {
+	DbConn          *sqlx.DB
+	TransactionRepo *transaction.Repository
+	LedgerRepo      *ledger.Repository
+	ProfitRepo      *profit.Repository
+	Eligibility     EligibilityRules
+}
+
+// NewRepository creates a new Repository that defines dependencies for Loan.
+func NewRepository(db *sqlx.DB, transactionRepo *transaction.Repository, ledgerRepo *ledger.Repository,
+	profitRepo *profit.Repository) *Repository {
+	return &Repository{
+		DbConn:          db,
+		TransactionRepo: transactionRepo,
+		LedgerRepo:      ledgerRepo,
+		ProfitRepo:      profitRepo,
+		Eligibility:     DefaultEligibility,
+	}
+}
+
+// Loan statuses. A loan is applied for, approved or rejected by an admin, disbursed and then
+// closed once every instalment has been repaid.
+const (
+	Status_Pending  = "pending"
+	Status_Approved = "approved"
+	Status_Rejected = "rejected"
+	Status_Active   = "active"
+	Status_Closed   = "closed"
+)
+
+// Statuses lists the loan statuses in the order a loan goes through them.
+var Statuses = []string{Status_Pending, Status_Approved, Status_Rejected, Status_Active, Status_Closed}
+
+// Ways the interest of a loan is worked out, see Terms.Schedule.
+const (
+	Method_Flat     = "flat"
+	Method_Reducing = "reducing"
+)
+
+// How often instalments are due.
+const (
+	Frequency_Weekly  = "weekly"
+	Frequency_Monthly = "monthly"
+)
+
+// Ways a loan is paid out to the customer.
+const (
+	// Disbursement_Account credits the savings account the loan was applied against.
+	Disbursement_Account = "account"
+	// Disbursement_Cash pays the loan out of the cash on hand.
+	Disbursement_Cash = "cash"
+)
+
+// Ways a loan is repaid. Cash and bank deposits are received from the customer, deductions
+// are taken out of the savings account of the loan.
+const (
+	Repayment_Cash      = transaction.PaymentMethod_Cash
+	Repayment_Bank      = transaction.PaymentMethod_Bank
+	Repayment_Deduction = "deduction"
+)
+
+// GraceDays is the number of days after the due date before a penalty is charged on an
+// instalment that has not been paid.
+const GraceDays = 3
+
+// Loan is a small loan given to a customer against the savings history of one of their accounts.
+type Loan struct {
+	ID                        string       `json:"id" example:"985f1746-1d9f-459f-a2d9-fc53ece5ae86"`
+	CustomerID                string       `json:"customer_id" example:"985f1746-1d9f-459f-a2d9-fc53ece5ae86"`
+	AccountID                 string       `json:"account_id" example:"985f1746-1d9f-459f-a2d9-fc53ece5ae86"`
+	BranchID                  string       `json:"branch_id" example:"985f1746-1d9f-459f-a2d9-fc53ece5ae86"`
+	Principal                 money.Amount `json:"principal"`
+	InterestRateBPS           int          `json:"interest_rate_bps"`
+	Method                    string       `json:"method" example:"flat"`
+	Frequency                 string       `json:"frequency" example:"weekly"`
+	Tenor                     int          `json:"tenor" example:"12"`
+	PenaltyRateBPS            int          `json:"penalty_rate_bps"`
+	AutoDeduct                bool         `json:"auto_deduct"`
+	Purpose                   string       `json:"purpose"`
+	Status                    string       `json:"status" example:"pending"`
+	TotalInterest             money.Amount `json:"total_interest"`
+	AmountRepaid              money.Amount `json:"amount_repaid"`
+	Penalties                 money.Amount `json:"penalties"`
+	DisbursementMethod        string       `json:"disbursement_method"`
+	DisbursementTransactionID *string      `json:"disbursement_transaction_id,omitempty"`
+	AppliedByID               string       `json:"applied_by_id"`
+	ApprovedByID              *string      `json:"approved_by_id,omitempty"`
+	ApprovedAt                *time.Time   `json:"approved_at,omitempty"`
+	RejectedReason            string       `json:"rejected_reason"`
+	DisbursedByID             *string      `json:"disbursed_by_id,omitempty"`
+	DisbursedAt               *time.Time   `json:"disbursed_at,omitempty"`
+	ClosedAt                  *time.Time   `json:"closed_at,omitempty"`
+	CreatedAt                 time.Time    `json:"created_at"`
+	UpdatedAt                 time.Time    `json:"updated_at"`
+
+	Customer      string        `json:"customer,omitempty"`
+	AccountNumber string        `json:"account_number,omitempty"`
+	Branch        string        `json:"branch,omitempty"`
+	AppliedBy     string        `json:"applied_by,omitempty"`
+	ApprovedBy    string        `json:"approved_by,omitempty"`
+	DisbursedBy   string        `json:"disbursed_by,omitempty"`
+	Instalments   []*Instalment `json:"instalments,omitempty"`
+	Repayments    []*Repayment  `json:"repayments,omitempty"`
+}
+
+// FromModel converts the loan model and its loaded relations to a Loan.
+func FromModel(rec *models.Loan) *Loan {
+	l := &Loan{
+		ID:                        rec.ID,
+		CustomerID:                rec.CustomerID,
+		AccountID:                 rec.AccountID,
+		BranchID:                  rec.BranchID,
+		Principal:                 money.Amount(rec.Principal),
+		InterestRateBPS:           rec.InterestRateBPS,
+		Method:                    rec.Method,
+		Frequency:                 rec.Frequency,
+		Tenor:                     rec.Tenor,
+		PenaltyRateBPS:            rec.PenaltyRateBPS,
+		AutoDeduct:                rec.AutoDeduct,
+		Purpose:                   rec.Purpose,
+		Status:                    rec.Status,
+		TotalInterest:             money.Amount(rec.TotalInterest),
+		AmountRepaid:              money.Amount(rec.AmountRepaid),
+		Penalties:                 money.Amount(rec.Penalties),
+		DisbursementMethod:        rec.DisbursementMethod,
+		DisbursementTransactionID: rec.DisbursementTransactionID.Ptr(),
+		AppliedByID:               rec.AppliedByID,
+		ApprovedByID:              rec.ApprovedByID.Ptr(),
+		RejectedReason:            rec.RejectedReason,
+		DisbursedByID:             rec.DisbursedByID.Ptr(),
+		CreatedAt:                 time.Unix(rec.CreatedAt, 0).UTC(),
+		UpdatedAt:                 time.Unix(rec.UpdatedAt, 0).UTC(),
+	}
+
+	if rec.ApprovedAt.Valid {
+		approvedAt := time.Unix(rec.ApprovedAt.Int64, 0).UTC()
+		l.ApprovedAt = &approvedAt
+	}
+	if rec.DisbursedAt.Valid {
+		disbursedAt := time.Unix(rec.DisbursedAt.Int64, 0).UTC()
+		l.DisbursedAt = &disbursedAt
+	}
+	if rec.ClosedAt.Valid {
+		closedAt := time.Unix(rec.ClosedAt.Int64, 0).UTC()
+		l.ClosedAt = &closedAt
+	}
+
+	if rec.R != nil {
+		if rec.R.Customer != nil {
+			l.Customer = rec.R.Customer.Name
+		}
+		if rec.R.Account != nil {
+			l.AccountNumber = rec.R.Account.Number
+		}
+		if rec.R.Branch != nil {
+			l.Branch = rec.R.Branch.Name
+		}
+		if rec.R.AppliedBy != nil {
+			l.AppliedBy = rec.R.AppliedBy.LastName + " " + rec.R.AppliedBy.FirstName
+		}
+		if rec.R.ApprovedBy != nil {
+			l.ApprovedBy = rec.R.ApprovedBy.LastName + " " + rec.R.ApprovedBy.FirstName
+		}
+		if rec.R.DisbursedBy != nil {
+			l.DisbursedBy = rec.R.DisbursedBy.LastName + " " + rec.R.DisbursedBy.FirstName
+		}
+		for _, i := range rec.R.LoanInstalments {
+			l.Instalments = append(l.Instalments, InstalmentFromModel(i))
+		}
+		for _, r := range rec.R.LoanRepayments {
+			l.Repayments = append(l.Repayments, RepaymentFromModel(r))
+		}
+	}
+
+	return l
+}
+
+// Terms returns the terms the repayment schedule of the loan is worked out from.
+func (m *Loan) Terms() Terms {
+	return Terms{
+		Principal:       m.Principal,
+		InterestRateBPS: m.InterestRateBPS,
+		Method:          m.Method,
+		Frequency:       m.Frequency,
+		Tenor:           m.Tenor,
+	}
+}
+
+// Outstanding returns the principal, interest and penalties that are left to pay.
+func (m *Loan) Outstanding() money.Amount {
+	return m.Principal + m.TotalInterest + m.Penalties - m.AmountRepaid
+}
+
+// Response represents a loan that is returned for display.
+type Response struct {
+	ID                 string                `json:"id" example:"985f1746-1d9f-459f-a2d9-fc53ece5ae86"`
+	CustomerID         string                `json:"customer_id" example:"985f1746-1d9f-459f-a2d9-fc53ece5ae86"`
+	Customer           string                `json:"customer,omitempty"`
+	AccountID          string                `json:"account_id" example:"985f1746-1d9f-459f-a2d9-fc53ece5ae86"`
+	AccountNumber      string                `json:"account_number,omitempty"`
+	BranchID           string                `json:"branch_id" example:"985f1746-1d9f-459f-a2d9-fc53ece5ae86"`
+	Branch             string                `json:"branch,omitempty"`
+	Principal          money.Amount          `json:"principal"`
+	InterestRate       string                `json:"interest_rate" example:"2.5%"`
+	Method             string                `json:"method" example:"flat"`
+	Frequency          string                `json:"frequency" example:"weekly"`
+	Tenor              int                   `json:"tenor" example:"12"`
+	PenaltyRate        string                `json:"penalty_rate" example:"5%"`
+	AutoDeduct         bool                  `json:"auto_deduct"`
+	Purpose            string                `json:"purpose"`
+	Status             string                `json:"status" example:"pending"`
+	TotalInterest      money.Amount          `json:"total_interest"`
+	AmountRepaid       money.Amount          `json:"amount_repaid"`
+	Penalties          money.Amount          `json:"penalties"`
+	Outstanding        money.Amount          `json:"outstanding"`
+	DisbursementMethod string                `json:"disbursement_method"`
+	AppliedByID        string                `json:"applied_by_id"`
+	AppliedBy          string                `json:"applied_by,omitempty"`
+	ApprovedBy         string                `json:"approved_by,omitempty"`
+	ApprovedAt         *web.TimeResponse     `json:"approved_at,omitempty"`
+	RejectedReason     string                `json:"rejected_reason"`
+	DisbursedBy        string                `json:"disbursed_by,omitempty"`
+	DisbursedAt        *web.TimeResponse     `json:"disbursed_at,omitempty"`
+	ClosedAt           *web.TimeResponse     `json:"closed_at,omitempty"`
+	CreatedAt          web.TimeResponse      `json:"created_at"`
+	UpdatedAt          web.TimeResponse      `json:"updated_at"`
+	Instalments        []*InstalmentResponse `json:"instalments,omitempty"`
+	Repayments         []*RepaymentResponse  `json:"repayments,omitempty"`
+}
+
+// Response transforms Loan to the Response that is used for display.
+func (m *Loan) Response(ctx context.Context) *Response {
+	if m == nil {
+		return nil
+	}
+
+	r := &Response{
+		ID:                 m.ID,
+		CustomerID:         m.CustomerID,
+		Customer:           m.Customer,
+		AccountID:          m.AccountID,
+		AccountNumber:      m.AccountNumber,
+		BranchID:           m.BranchID,
+		Branch:             m.Branch,
+		Principal:          m.Principal,
+		InterestRate:       account_product.FormatBPS(m.InterestRateBPS),
+		Method:             m.Method,
+		Frequency:          m.Frequency,
+		Tenor:              m.Tenor,
+		PenaltyRate:        account_product.FormatBPS(m.PenaltyRateBPS),
+		AutoDeduct:         m.AutoDeduct,
+		Purpose:            m.Purpose,
+		Status:             m.Status,
+		TotalInterest:      m.TotalInterest,
+		AmountRepaid:       m.AmountRepaid,
+		Penalties:          m.Penalties,
+		Outstanding:        m.Outstanding(),
+		DisbursementMethod: m.DisbursementMethod,
+		AppliedByID:        m.AppliedByID,
+		AppliedBy:          m.AppliedBy,
+		ApprovedBy:         m.ApprovedBy,
+		RejectedReason:     m.RejectedReason,
+		DisbursedBy:        m.DisbursedBy,
+		CreatedAt:          web.NewTimeResponse(ctx, m.CreatedAt),
+		UpdatedAt:          web.NewTimeResponse(ctx, m.UpdatedAt),
+	}
+
+	if m.ApprovedAt != nil {
+		at := web.NewTimeResponse(ctx, *m.ApprovedAt)
+		r.ApprovedAt = &at
+	}
+	if m.DisbursedAt != nil {
+		at := web.NewTimeResponse(ctx, *m.DisbursedAt)
+		r.DisbursedAt = &at
+	}
+	if m.ClosedAt != nil {
+		at := web.NewTimeResponse(ctx, *m.ClosedAt)
+		r.ClosedAt = &at
+	}
+
+	for _, i := range m.Instalments {
+		r.Instalments = append(r.Instalments, i.Response(ctx))
+	}
+	for _, p := range m.Repayments {
+		r.Repayments = append(r.Repayments, p.Response(ctx))
+	}
+
+	return r
+}
+
+// Loans a list of Loans.
+type Loans []*Loan
+
+// Response transforms a list of Loans to a list of Responses.
+func (m *Loans) Response(ctx context.Context) []*Response {
+	var l = make([]*Response, 0)
+	if m != nil && len(*m) > 0 {
+		for _, n := range *m {
+			l = append(l, n.Response(ctx))
+		}
+	}
+
+	return l
+}
+
+// Instalment is a repayment due on a loan and what has been paid towards it.
+type Instalment struct {
+	ID            string       `json:"id"`
+	LoanID        string       `json:"loan_id"`
+	Number        int          `json:"number"`
+	DueDate       time.Time    `json:"due_date"`
+	Principal     money.Amount `json:"principal"`
+	Interest      money.Amount `json:"interest"`
+	Penalty       money.Amount `json:"penalty"`
+	PrincipalPaid money.Amount `json:"principal_paid"`
+	InterestPaid  money.Amount `json:"interest_paid"`
+	PenaltyPaid   money.Amount `json:"penalty_paid"`
+	PaidAt        *time.Time   `json:"paid_at,omitempty"`
+	PenalizedAt   *time.Time   `json:"penalized_at,omitempty"`
+}
+
+// InstalmentFromModel converts the loan instalment model to an Instalment.
+func InstalmentFromModel(rec *models.LoanInstalment) *Instalment {
+	i := &Instalment{
+		ID:            rec.ID,
+		LoanID:        rec.LoanID,
+		Number:        rec.Number,
+		DueDate:       time.Unix(rec.DueDate, 0).UTC(),
+		Principal:     money.Amount(rec.Principal),
+		Interest:      money.Amount(rec.Interest),
+		Penalty:       money.Amount(rec.Penalty),
+		PrincipalPaid: money.Amount(rec.PrincipalPaid),
+		InterestPaid:  money.Amount(rec.InterestPaid),
+		PenaltyPaid:   money.Amount(rec.PenaltyPaid),
+	}
+	if rec.PaidAt.Valid {
+		paidAt := time.Unix(rec.PaidAt.Int64, 0).UTC()
+		i.PaidAt = &paidAt
+	}
+	if rec.PenalizedAt.Valid {
+		penalizedAt := time.Unix(rec.PenalizedAt.Int64, 0).UTC()
+		i.PenalizedAt = &penalizedAt
+	}
+	return i
+}
+
+// Due returns the amount due on the instalment including any penalty.
+func (m *Instalment) Due() money.Amount {
+	return m.Principal + m.Interest + m.Penalty
+}
+
+// Paid returns the amount paid towards the instalment.
+func (m *Instalment) Paid() money.Amount {
+	return m.PrincipalPaid + m.InterestPaid + m.PenaltyPaid
+}
+
+// Outstanding returns the amount left to pay on the instalment.
+func (m *Instalment) Outstanding() money.Amount {
+	return m.Due() - m.Paid()
+}
+
+// Overdue reports whether the instalment was not paid by the end of its due date.
+func (m *Instalment) Overdue(currentDate time.Time) bool {
+	return m.Outstanding() > 0 && now.New(m.DueDate).BeginningOfDay().Before(now.New(currentDate).BeginningOfDay())
+}
+
+// InstalmentResponse represents an instalment that is returned for display.
+type InstalmentResponse struct {
+	Number      int               `json:"number"`
+	DueDate     web.TimeResponse  `json:"due_date"`
+	Principal   money.Amount      `json:"principal"`
+	Interest    money.Amount      `json:"interest"`
+	Penalty     money.Amount      `json:"penalty"`
+	Due         money.Amount      `json:"due"`
+	Paid        money.Amount      `json:"paid"`
+	Outstanding money.Amount      `json:"outstanding"`
+	Overdue     bool              `json:"overdue"`
+	PaidAt      *web.TimeResponse `json:"paid_at,omitempty"`
+}
+
+// Response transforms Instalment to the InstalmentResponse that is used for display.
+func (m *Instalment) Response(ctx context.Context) *InstalmentResponse {
+	r := &InstalmentResponse{
+		Number:      m.Number,
+		DueDate:     web.NewTimeResponse(ctx, m.DueDate),
+		Principal:   m.Principal,
+		Interest:    m.Interest,
+		Penalty:     m.Penalty,
+		Due:         m.Due(),
+		Paid:        m.Paid(),
+		Outstanding: m.Outstanding(),
+		Overdue:     m.Overdue(time.Now()),
+	}
+	if m.PaidAt != nil {
+		at := web.NewTimeResponse(ctx, *m.PaidAt)
+		r.PaidAt = &at
+	}
+	return r
+}
+
+// Repayment is money received towards a loan and how it was shared between the principal,
+// interest and penalties.
+type Repayment struct {
+	ID            string       `json:"id"`
+	LoanID        string       `json:"loan_id"`
+	Amount        money.Amount `json:"amount"`
+	Principal     money.Amount `json:"principal"`
+	Interest      money.Amount `json:"interest"`
+	Penalty       money.Amount `json:"penalty"`
+	Method        string       `json:"method"`
+	TransactionID *string      `json:"transaction_id,omitempty"`
+	SalesRepID    string       `json:"sales_rep_id"`
+	SalesRep      string       `json:"sales_rep,omitempty"`
+	CreatedAt     time.Time    `json:"created_at"`
+}
+
+// RepaymentFromModel converts the loan repayment model to a Repayment.
+func RepaymentFromModel(rec *models.LoanRepayment) *Repayment {
+	r := &Repayment{
+		ID:            rec.ID,
+		LoanID:        rec.LoanID,
+		Amount:        money.Amount(rec.Amount),
+		Principal:     money.Amount(rec.Principal),
+		Interest:      money.Amount(rec.Interest),
+		Penalty:       money.Amount(rec.Penalty),
+		Method:        rec.Method,
+		TransactionID: rec.TransactionID.Ptr(),
+		SalesRepID:    rec.SalesRepID,
+		CreatedAt:     time.Unix(rec.CreatedAt, 0).UTC(),
+	}
+	if rec.R != nil && rec.R.SalesRep != nil {
+		r.SalesRep = rec.R.SalesRep.LastName + " " + rec.R.SalesRep.FirstName
+	}
+	return r
+}
+
+// RepaymentResponse represents a repayment that is returned for display.
+type RepaymentResponse struct {
+	ID        string           `json:"id"`
+	Amount    money.Amount     `json:"amount"`
+	Principal money.Amount     `json:"principal"`
+	Interest  money.Amount     `json:"interest"`
+	Penalty   money.Amount     `json:"penalty"`
+	Method    string           `json:"method"`
+	SalesRep  string           `json:"sales_rep,omitempty"`
+	CreatedAt web.TimeResponse `json:"created_at"`
+}
+
+// Response transforms Repayment to the RepaymentResponse that is used for display.
+func (m *Repayment) Response(ctx context.Context) *RepaymentResponse {
+	return &RepaymentResponse{
+		ID:        m.ID,
+		Amount:    m.Amount,
+		Principal: m.Principal,
+		Interest:  m.Interest,
+		Penalty:   m.Penalty,
+		Method:    m.Method,
+		SalesRep:  m.SalesRep,
+		CreatedAt: web.NewTimeResponse(ctx, m.CreatedAt),
+	}
+}
+
+// EligibilityRules decide which customers can borrow and how much from the savings history of
+// the account the loan is applied against.
+type EligibilityRules struct {
+	// MinAccountAgeDays is how long the account must have been open.
+	MinAccountAgeDays int
+	// HistoryDays is the period before the application the savings history is read for.
+	HistoryDays int
+	// MinDepositDays is the number of days in the period deposits must have been made on.
+	MinDepositDays int
+	// MaxBalanceMultiple limits the principal to a multiple of the balance of the account.
+	MaxBalanceMultiple int64
+}
+
+// DefaultEligibility lends up to three times the balance of accounts that have been open for
+// three months and saved on at least twelve days in the last three months.
+var DefaultEligibility = EligibilityRules{
+	MinAccountAgeDays:  90,
+	HistoryDays:        90,
+	MinDepositDays:     12,
+	MaxBalanceMultiple: 3,
+}
+
+// Eligibility is the outcome of checking a loan application against the savings history.
+type Eligibility struct {
+	Eligible     bool         `json:"eligible"`
+	MaxPrincipal money.Amount `json:"max_principal"`
+	Reasons      []string     `json:"reasons,omitempty"`
+}
+
+// Check returns whether the principal can be lent against the savings history and the reasons
+// when it cannot.
+func (rules EligibilityRules) Check(history transaction.SavingsHistory, principal money.Amount, currentDate time.Time) Eligibility {
+	res := Eligibility{MaxPrincipal: history.Balance.Mul(rules.MaxBalanceMultiple)}
+	if res.MaxPrincipal < 0 {
+		res.MaxPrincipal = 0
+	}
+
+	opened := now.New(history.OpenedAt).BeginningOfDay()
+	if opened.AddDate(0, 0, rules.MinAccountAgeDays).After(currentDate) {
+		res.Reasons = append(res.Reasons, fmt.Sprintf("The account has been open for less than %d days", rules.MinAccountAgeDays))
+	}
+	if history.DepositDays < rules.MinDepositDays {
+		res.Reasons = append(res.Reasons, fmt.Sprintf("Deposits were made on %d of the %d days needed in the last %d days",
+			history.DepositDays, rules.MinDepositDays, rules.HistoryDays))
+	}
+	if principal > res.MaxPrincipal {
+		res.Reasons = append(res.Reasons, fmt.Sprintf("The most that can be lent against the balance is %s", res.MaxPrincipal))
+	}
+
+	res.Eligible = len(res.Reasons) == 0
+	return res
+}
+
+// ReadRequest defines the information needed to read a loan.
+type ReadRequest struct {
+	ID string `json:"id" validate:"required,uuid" example:"985f1746-1d9f-459f-a2d9-fc53ece5ae86"`
+}
+
+// FindRequest defines the possible options to search for loans.
+type FindRequest struct {
+	Where  string        `json:"where" example:"status = ? and branch_id = ?"`
+	Args   []interface{} `json:"args" swaggertype:"array,string" example:"active,985f1746-1d9f-459f-a2d9-fc53ece5ae86"`
+	Order  []string      `json:"order" example:"created_at desc"`
+	Limit  *uint         `json:"limit" example:"10"`
+	Offset *uint         `json:"offset" example:"20"`
+}
+
+// ApplyRequest contains the information needed to apply for a loan against a savings account.
+type ApplyRequest struct {
+	AccountID       string       `json:"account_id" validate:"required,uuid" example:"985f1746-1d9f-459f-a2d9-fc53ece5ae86"`
+	Principal       money.Amount `json:"principal" validate:"required,gt=0"`
+	InterestRateBPS int          `json:"interest_rate_bps" validate:"gte=0,lte=10000" example:"250"`
+	Method          string       `json:"method" validate:"required,oneof=flat reducing" example:"flat"`
+	Frequency       string       `json:"frequency" validate:"required,oneof=weekly monthly" example:"weekly"`
+	Tenor           int          `json:"tenor" validate:"required,gt=0,lte=104" example:"12"`
+	PenaltyRateBPS  int          `json:"penalty_rate_bps" validate:"gte=0,lte=10000" example:"500"`
+	AutoDeduct      bool         `json:"auto_deduct"`
+	Purpose         string       `json:"purpose" validate:"max=200"`
+}
+
+// ApproveRequest defines the information needed to approve a loan application.
+type ApproveRequest struct {
+	ID string `json:"id" validate:"required,uuid" example:"985f1746-1d9f-459f-a2d9-fc53ece5ae86"`
+}
+
+// RejectRequest defines the information needed to reject a loan application.
+type RejectRequest struct {
+	ID     string `json:"id" validate:"required,uuid" example:"985f1746-1d9f-459f-a2d9-fc53ece5ae86"`
+	Reason string `json:"reason" validate:"required,max=200" example:"Savings history too short"`
+}
+
+// DisburseRequest defines the information needed to pay out an approved loan.
+type DisburseRequest struct {
+	ID     string `json:"id" validate:"required,uuid" example:"985f1746-1d9f-459f-a2d9-fc53ece5ae86"`
+	Method string `json:"method" validate:"required,oneof=account cash" example:"account"`
+}
+
+// RepayRequest defines the information needed to record a repayment on a loan.
+type RepayRequest struct {
+	ID     string       `json:"id" validate:"required,uuid" example:"985f1746-1d9f-459f-a2d9-fc53ece5ae86"`
+	Amount money.Amount `json:"amount" validate:"required,gt=0"`
+	Method string       `json:"method" validate:"required,oneof=cash bank_deposit deduction" example:"cash"`
+}
+
+// ProcessRequest defines the day the loan job runs for.
+type ProcessRequest struct {
+	Date time.Time `json:"date" validate:"required"`
+}
+
+// ProcessReport summarises a run of the loan job.
+type ProcessReport struct {
+	Date      time.Time    `json:"date"`
+	Deducted  int          `json:"deducted"`
+	Collected money.Amount `json:"collected"`
+	Penalized int          `json:"penalized"`
+	Penalties money.Amount `json:"penalties"`
+	Failed    int          `json:"failed"`
+}
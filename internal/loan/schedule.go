@@ -0,0 +1,195 @@
+package loan
+
+import (
+	"math/big"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"merryworld/surebank/internal/platform/money"
+)
+
+// ErrOverpayment occurs when a repayment is more than is left to pay on the loan.
+var ErrOverpayment = errors.New("The repayment is more than the amount outstanding on the loan")
+
+// Terms are the amount, rate and length of a loan that its repayment schedule is worked out from.
+type Terms struct {
+	Principal money.Amount
+	// InterestRateBPS is the interest charged for each instalment period in basis points.
+	InterestRateBPS int
+	Method          string
+	Frequency       string
+	// Tenor is the number of instalments.
+	Tenor int
+}
+
+// DueDate returns the due date of the numbered instalment of a loan disbursed on start. Monthly
+// instalments fall on the same day of the month, or the last day of shorter months.
+func DueDate(start time.Time, frequency string, number int) time.Time {
+	if frequency == Frequency_Weekly {
+		return start.AddDate(0, 0, 7*number)
+	}
+
+	due := time.Date(start.Year(), start.Month()+time.Month(number), 1,
+		start.Hour(), start.Minute(), start.Second(), start.Nanosecond(), start.Location())
+	if lastDay := due.AddDate(0, 1, -1).Day(); start.Day() > lastDay {
+		return due.AddDate(0, 0, lastDay-1)
+	}
+	return due.AddDate(0, 0, start.Day()-1)
+}
+
+// Schedule returns the instalments that repay the loan when it is disbursed on start.
+//
+// With the flat method interest is charged on the full principal for every period and both the
+// principal and the interest are shared evenly between the instalments. With the reducing
+// balance method every instalment is the same and the interest is charged on the principal that
+// is still owed, so more of each instalment repays the principal as the loan is paid down, see
+// Annuity. Amounts are rounded to the kobo and the last instalment takes the rounding difference.
+func (t Terms) Schedule(start time.Time) []*Instalment {
+	if t.Tenor <= 0 || t.Principal <= 0 {
+		return nil
+	}
+	n := int64(t.Tenor)
+	bps := int64(t.InterestRateBPS)
+	if bps < 0 {
+		bps = 0
+	}
+
+	res := make([]*Instalment, 0, t.Tenor)
+	if t.Method == Method_Reducing {
+		payment := Annuity(t.Principal, t.InterestRateBPS, t.Tenor).Kobo()
+
+		balance := t.Principal.Kobo()
+		for k := int64(1); k <= n; k++ {
+			interest := (balance*bps + 5000) / 10000
+			principal := payment - interest
+			if k == n || principal > balance {
+				principal = balance
+			}
+			balance -= principal
+			res = append(res, &Instalment{
+				Number:    int(k),
+				DueDate:   DueDate(start, t.Frequency, int(k)),
+				Principal: money.Amount(principal),
+				Interest:  money.Amount(interest),
+			})
+		}
+		return res
+	}
+
+	totalInterest := (t.Principal.Kobo()*bps*n + 5000) / 10000
+	principal, interest := t.Principal.Kobo()/n, totalInterest/n
+	for k := int64(1); k <= n; k++ {
+		inst := &Instalment{
+			Number:    int(k),
+			DueDate:   DueDate(start, t.Frequency, int(k)),
+			Principal: money.Amount(principal),
+			Interest:  money.Amount(interest),
+		}
+		if k == n {
+			inst.Principal = money.Amount(t.Principal.Kobo() - principal*(n-1))
+			inst.Interest = money.Amount(totalInterest - interest*(n-1))
+		}
+		res = append(res, inst)
+	}
+	return res
+}
+
+// Annuity returns the instalment that repays the principal with interest at rateBPS per period
+// on the balance still owed over tenor equal instalments, rounded half up to the kobo. It is
+// P·r·(1+r)^n / ((1+r)^n − 1) with r = rateBPS/10000, worked out in whole numbers so that the
+// same terms always give the same instalment.
+func Annuity(principal money.Amount, rateBPS, tenor int) money.Amount {
+	if tenor <= 0 || principal <= 0 {
+		return 0
+	}
+	n := int64(tenor)
+	if rateBPS <= 0 {
+		return money.Amount(principal.Kobo() / n)
+	}
+
+	// With r = bps/10000 the payment is P·bps·(10000+bps)^n / (10000·((10000+bps)^n − 10000^n)).
+	growth := new(big.Int).Exp(big.NewInt(10000+int64(rateBPS)), big.NewInt(n), nil)
+	base := new(big.Int).Exp(big.NewInt(10000), big.NewInt(n), nil)
+
+	num := new(big.Int).Mul(big.NewInt(principal.Kobo()), big.NewInt(int64(rateBPS)))
+	num.Mul(num, growth)
+	den := new(big.Int).Sub(growth, base)
+	den.Mul(den, big.NewInt(10000))
+
+	num.Add(num, new(big.Int).Rsh(den, 1))
+	return money.Amount(num.Quo(num, den).Int64())
+}
+
+// TotalInterest returns the interest charged over the schedule.
+func TotalInterest(schedule []*Instalment) money.Amount {
+	var total money.Amount
+	for _, i := range schedule {
+		total += i.Interest
+	}
+	return total
+}
+
+// Penalty returns the penalty charged on the amount left unpaid on an overdue instalment,
+// rounded to the kobo.
+func Penalty(outstanding money.Amount, rateBPS int) money.Amount {
+	if outstanding <= 0 || rateBPS <= 0 {
+		return 0
+	}
+	return money.Amount((outstanding.Kobo()*int64(rateBPS) + 5000) / 10000)
+}
+
+// Allocation is the part of a repayment that goes to an instalment.
+type Allocation struct {
+	Number    int          `json:"number"`
+	Principal money.Amount `json:"principal"`
+	Interest  money.Amount `json:"interest"`
+	Penalty   money.Amount `json:"penalty"`
+}
+
+// Total returns the amount allocated to the instalment.
+func (a Allocation) Total() money.Amount {
+	return a.Principal + a.Interest + a.Penalty
+}
+
+// Allocate shares a repayment between the instalments, oldest first. Within an instalment the
+// penalty is paid first, then the interest and then the principal. A repayment that is more
+// than the amount outstanding on the instalments is rejected.
+func Allocate(instalments []*Instalment, amount money.Amount) ([]Allocation, error) {
+	var outstanding money.Amount
+	for _, i := range instalments {
+		outstanding += i.Outstanding()
+	}
+	if amount > outstanding {
+		return nil, errors.WithMessagef(ErrOverpayment, "%s is outstanding", outstanding)
+	}
+
+	var res []Allocation
+	for _, i := range instalments {
+		if amount <= 0 {
+			break
+		}
+		if i.Outstanding() <= 0 {
+			continue
+		}
+
+		a := Allocation{Number: i.Number}
+		a.Penalty, amount = take(i.Penalty-i.PenaltyPaid, amount)
+		a.Interest, amount = take(i.Interest-i.InterestPaid, amount)
+		a.Principal, amount = take(i.Principal-i.PrincipalPaid, amount)
+		res = append(res, a)
+	}
+
+	return res, nil
+}
+
+// take returns the part of the amount that pays off what is due and what is left of the amount.
+func take(due, amount money.Amount) (money.Amount, money.Amount) {
+	if due <= 0 {
+		return 0, amount
+	}
+	if amount < due {
+		return amount, 0
+	}
+	return due, amount - due
+}
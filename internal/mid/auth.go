@@ -51,6 +51,11 @@ func AuthenticateHeader(authenticator *auth.Authenticator) web.Middleware {
 					return weberror.NewError(ctx, err, http.StatusUnauthorized)
 				}
 
+				// Customer tokens are only accepted by the customer portal.
+				if claims.IsCustomer() {
+					return ErrorForbidden(ctx)
+				}
+
 				// Add claims to the context so they can be retrieved later.
 				ctx = context.WithValue(ctx, auth.Key, claims)
 
@@ -75,16 +80,24 @@ func AuthenticateHeader(authenticator *auth.Authenticator) web.Middleware {
 
 // AuthenticateSessionRequired requires a JWT access token to be loaded from the session.
 func AuthenticateSessionRequired(authenticator *auth.Authenticator) web.Middleware {
-	return authenticateSession(authenticator, true)
+	return authenticateSession(authenticator, true, false)
 }
 
 // AuthenticateSessionOptional loads a JWT access token from the session if it exists.
 func AuthenticateSessionOptional(authenticator *auth.Authenticator) web.Middleware {
-	return authenticateSession(authenticator, false)
+	return authenticateSession(authenticator, false, false)
 }
 
-// authenticateSession validates a JWT by the loading the access token from the session.
-func authenticateSession(authenticator *auth.Authenticator, required bool) web.Middleware {
+// AuthenticateCustomerSessionRequired requires a JWT access token for a customer logged in to
+// the customer portal to be loaded from the session.
+func AuthenticateCustomerSessionRequired(authenticator *auth.Authenticator) web.Middleware {
+	return authenticateSession(authenticator, true, true)
+}
+
+// authenticateSession validates a JWT by the loading the access token from the session. Tokens
+// for customers are only accepted when customer is set and are the only ones accepted then, so
+// customers can never reach staff routes and staff sessions are not used for the portal.
+func authenticateSession(authenticator *auth.Authenticator, required, customer bool) web.Middleware {
 
 	// This is the actual middleware function to be executed.
 	f := func(after web.Handler) web.Handler {
@@ -114,6 +127,15 @@ func authenticateSession(authenticator *auth.Authenticator, required bool) web.M
 					}
 				}
 
+				if claims.IsCustomer() != customer {
+					if required {
+						err := errors.New("AccessToken from session is not valid for this route")
+						return weberror.NewError(ctx, err, http.StatusUnauthorized)
+					} else {
+						return nil
+					}
+				}
+
 				// Add claims to the context so they can be retrieved later.
 				ctx = context.WithValue(ctx, auth.Key, claims)
 
@@ -12,8 +12,11 @@ import (
 // These are the expected values for Claims.Roles.
 const (
 	RoleSuperAdmin = "super_admin"
-	RoleAdmin = "admin"
-	RoleUser  = "user"
+	RoleAdmin      = "admin"
+	RoleUser       = "user"
+	// RoleCustomer is given to customers logged in to the customer portal. Claims with this role
+	// have the ID of the customer as the Subject and are never given a staff role.
+	RoleCustomer = "customer"
 )
 
 // ctxKey represents the type of value for the context key.
@@ -81,7 +84,7 @@ func NewClaimPreferences(timezone *time.Location, datetimeFormat, dateFormat, ti
 func (c Claims) Valid() error {
 	for _, r := range c.Roles {
 		switch r {
-		case RoleSuperAdmin, RoleAdmin, RoleUser: // Role is valid.
+		case RoleSuperAdmin, RoleAdmin, RoleUser, RoleCustomer: // Role is valid.
 		default:
 			return fmt.Errorf("invalid role %q", r)
 		}
@@ -100,6 +103,12 @@ func (c Claims) HasAuth() bool {
 	return false
 }
 
+// IsCustomer returns true when the claims are for a customer logged in to the customer portal
+// rather than a member of staff.
+func (c Claims) IsCustomer() bool {
+	return c.HasRole(RoleCustomer)
+}
+
 // HasRole returns true if the claims has at least one of the provided roles.
 func (c Claims) HasRole(roles ...string) bool {
 	for _, has := range c.Roles {
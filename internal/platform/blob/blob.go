@@ -0,0 +1,38 @@
+// Package blob stores files such as scanned documents and photos disregarding where they are kept.
+package blob
+
+import (
+	"context"
+	"path"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+var (
+	// ErrNotFound occurs when there is no file stored with the key.
+	ErrNotFound = errors.New("File not found")
+
+	// ErrInvalidKey occurs when a key is empty or would be stored outside of the store.
+	ErrInvalidKey = errors.New("Invalid file key")
+)
+
+// Store defines the methods needed to keep files disregarding the storage backend. Keys are slash
+// separated paths such as "customers/<id>/passport_photo.jpg".
+type Store interface {
+	Put(ctx context.Context, key, contentType string, data []byte) error
+	Get(ctx context.Context, key string) ([]byte, error)
+	Delete(ctx context.Context, key string) error
+}
+
+// CleanKey validates the key and returns it cleaned of redundant separators.
+func CleanKey(key string) (string, error) {
+	if key == "" || strings.HasPrefix(key, "/") || strings.Contains(key, "\\") {
+		return "", errors.WithStack(ErrInvalidKey)
+	}
+	cleaned := path.Clean(key)
+	if cleaned == "." || cleaned == ".." || strings.HasPrefix(cleaned, "../") {
+		return "", errors.WithStack(ErrInvalidKey)
+	}
+	return cleaned, nil
+}
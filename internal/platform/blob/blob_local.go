@@ -0,0 +1,102 @@
+package blob
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/pkg/errors"
+)
+
+// LocalStore defines an implementation of the Store interface that keeps files in a directory of
+// the local filesystem.
+type LocalStore struct {
+	dir string
+}
+
+// NewStoreLocal stores files under the directory, creating it when it does not exist.
+func NewStoreLocal(dir string) (*LocalStore, error) {
+	if dir == "" {
+		return nil, errors.New("Blob directory is required")
+	}
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return nil, errors.WithMessagef(err, "Cannot create blob directory %s", dir)
+	}
+	return &LocalStore{dir: dir}, nil
+}
+
+// path returns the location of the file stored with the key.
+func (s *LocalStore) path(key string) (string, error) {
+	key, err := CleanKey(key)
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(s.dir, filepath.FromSlash(key)), nil
+}
+
+// Put writes the file, replacing any file stored with the key. The file is written next to its
+// destination first so a failed write never leaves a partial file behind.
+func (s *LocalStore) Put(ctx context.Context, key, contentType string, data []byte) error {
+	p, err := s.path(key)
+	if err != nil {
+		return err
+	}
+
+	if err = os.MkdirAll(filepath.Dir(p), 0700); err != nil {
+		return errors.WithStack(err)
+	}
+
+	tmp, err := ioutil.TempFile(filepath.Dir(p), ".upload-")
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	if _, err = tmp.Write(data); err != nil {
+		tmp.Close()
+		os.Remove(tmp.Name())
+		return errors.WithStack(err)
+	}
+	if err = tmp.Close(); err != nil {
+		os.Remove(tmp.Name())
+		return errors.WithStack(err)
+	}
+
+	if err = os.Rename(tmp.Name(), p); err != nil {
+		os.Remove(tmp.Name())
+		return errors.WithStack(err)
+	}
+
+	return nil
+}
+
+// Get reads the file stored with the key.
+func (s *LocalStore) Get(ctx context.Context, key string) ([]byte, error) {
+	p, err := s.path(key)
+	if err != nil {
+		return nil, err
+	}
+
+	dat, err := ioutil.ReadFile(p)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, errors.WithStack(ErrNotFound)
+		}
+		return nil, errors.WithStack(err)
+	}
+
+	return dat, nil
+}
+
+// Delete removes the file stored with the key. Deleting a file that does not exist is not an error.
+func (s *LocalStore) Delete(ctx context.Context, key string) error {
+	p, err := s.path(key)
+	if err != nil {
+		return err
+	}
+
+	if err = os.Remove(p); err != nil && !os.IsNotExist(err) {
+		return errors.WithStack(err)
+	}
+
+	return nil
+}
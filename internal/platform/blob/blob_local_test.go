@@ -0,0 +1,123 @@
+package blob
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/pkg/errors"
+)
+
+func TestCleanKey(t *testing.T) {
+
+	var keyTests = []struct {
+		key  string
+		want string
+		err  bool
+	}{
+		{"customers/1/photo.jpg", "customers/1/photo.jpg", false},
+		{"customers//1/./photo.jpg", "customers/1/photo.jpg", false},
+		{"customers/../photo.jpg", "photo.jpg", false},
+		{"", "", true},
+		{"/etc/passwd", "", true},
+		{"../photo.jpg", "", true},
+		{"customers/../../photo.jpg", "", true},
+		{"customers\\photo.jpg", "", true},
+	}
+
+	t.Log("Given the need to keep files inside the store.")
+	{
+		for i, tt := range keyTests {
+			t.Logf("\tTest: %d\tWhen cleaning %q", i, tt.key)
+			{
+				got, err := CleanKey(tt.key)
+				if tt.err {
+					if err == nil {
+						t.Fatalf("\t\tExpected an error, got %q.", got)
+					}
+					t.Logf("\t\tOk.")
+					continue
+				}
+				if err != nil {
+					t.Log("\t\tGot :", err)
+					t.Fatalf("\t\tCleanKey failed.")
+				}
+				if got != tt.want {
+					t.Logf("\t\tGot : %q", got)
+					t.Logf("\t\tWant: %q", tt.want)
+					t.Fatalf("\t\tCleaned key does not match expected.")
+				}
+				t.Logf("\t\tOk.")
+			}
+		}
+	}
+}
+
+func TestLocalStore(t *testing.T) {
+	dir, err := ioutil.TempDir("", "blob")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	store, err := NewStoreLocal(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx := context.Background()
+	key := "customers/1/signature.png"
+
+	t.Log("Given the need to keep files on the local filesystem.")
+	{
+		t.Log("\tTest: 0\tWhen a file is stored and replaced.")
+		{
+			if err := store.Put(ctx, key, "image/png", []byte("first")); err != nil {
+				t.Log("\t\tGot :", err)
+				t.Fatalf("\t\tPut failed.")
+			}
+			if err := store.Put(ctx, key, "image/png", []byte("second")); err != nil {
+				t.Log("\t\tGot :", err)
+				t.Fatalf("\t\tPut failed.")
+			}
+			got, err := store.Get(ctx, key)
+			if err != nil {
+				t.Log("\t\tGot :", err)
+				t.Fatalf("\t\tGet failed.")
+			}
+			if !bytes.Equal(got, []byte("second")) {
+				t.Logf("\t\tGot : %q", got)
+				t.Fatalf("\t\tShould read the last file stored.")
+			}
+			t.Logf("\t\tOk.")
+		}
+
+		t.Log("\tTest: 1\tWhen the file is deleted.")
+		{
+			if err := store.Delete(ctx, key); err != nil {
+				t.Log("\t\tGot :", err)
+				t.Fatalf("\t\tDelete failed.")
+			}
+			if _, err := store.Get(ctx, key); errors.Cause(err) != ErrNotFound {
+				t.Log("\t\tGot :", err)
+				t.Fatalf("\t\tShould not find the deleted file.")
+			}
+			if err := store.Delete(ctx, key); err != nil {
+				t.Log("\t\tGot :", err)
+				t.Fatalf("\t\tDeleting a missing file should not fail.")
+			}
+			t.Logf("\t\tOk.")
+		}
+
+		t.Log("\tTest: 2\tWhen the key leaves the store.")
+		{
+			if err := store.Put(ctx, "../outside", "text/plain", []byte("x")); errors.Cause(err) != ErrInvalidKey {
+				t.Log("\t\tGot :", err)
+				t.Fatalf("\t\tShould refuse the key.")
+			}
+			t.Logf("\t\tOk.")
+		}
+	}
+}
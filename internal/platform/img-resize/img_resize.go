@@ -382,6 +382,42 @@ func ResizePng(dat []byte, width, height uint) ([]byte, error) {
 	return out.Bytes(), nil
 }
 
+// ErrUnsupportedImage occurs when an image is not a JPG, PNG or GIF file.
+var ErrUnsupportedImage = errors.New("Image must be a JPG, PNG or GIF file")
+
+// ResizeImage scales a JPG, PNG or GIF image down to the max width, preserving the aspect ratio,
+// and returns it with its content type. Images that are not wider are returned as they are.
+func ResizeImage(dat []byte, maxWidth uint) ([]byte, string, error) {
+	cfg, format, err := image.DecodeConfig(bytes.NewReader(dat))
+	if err != nil {
+		return nil, "", errors.WithStack(ErrUnsupportedImage)
+	}
+
+	var resizeFn func([]byte, uint, uint) ([]byte, error)
+	switch format {
+	case "jpeg":
+		resizeFn = ResizeJpg
+	case "png":
+		resizeFn = ResizePng
+	case "gif":
+		resizeFn = ResizeGif
+	default:
+		return nil, "", errors.WithStack(ErrUnsupportedImage)
+	}
+	contentType := "image/" + format
+
+	if maxWidth == 0 || uint(cfg.Width) <= maxWidth {
+		return dat, contentType, nil
+	}
+
+	out, err := resizeFn(dat, maxWidth, 0)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return out, contentType, nil
+}
+
 // getImageDimension returns the width and height for a given local file path
 func getImageDimension(dat []byte) (int, int, error) {
 	image, _, err := image.DecodeConfig(bytes.NewReader(dat))
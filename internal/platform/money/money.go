@@ -0,0 +1,186 @@
+// Package money provides an exact representation of naira amounts.
+//
+// Amounts are held as a whole number of kobo so that sums, comparisons and divisions such as
+// checking a deposit is a multiple of the daily contribution never suffer from floating point
+// rounding. They are stored in the database as INT8 kobo and encoded to clients as naira.
+package money
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// KoboPerNaira is the number of kobo in a naira.
+const KoboPerNaira = 100
+
+// ErrInvalidAmount occurs when a value cannot be read as a naira amount.
+var ErrInvalidAmount = errors.New("Invalid amount")
+
+// Amount is a sum of money in kobo.
+type Amount int64
+
+// Zero is an amount of no value.
+const Zero Amount = 0
+
+// Kobo returns the amount for the provided number of kobo.
+func Kobo(kobo int64) Amount {
+	return Amount(kobo)
+}
+
+// Naira returns the amount for a whole number of naira.
+func Naira(naira int64) Amount {
+	return Amount(naira * KoboPerNaira)
+}
+
+// FromFloat converts a naira value held as a float to the nearest kobo. It is meant for values
+// that were computed as floats outside of the system, such as rates.
+func FromFloat(naira float64) Amount {
+	return Amount(math.Round(naira * KoboPerNaira))
+}
+
+// Parse reads a naira amount such as "1500", "1,500.5" or "-20.05" without going through a float.
+// Values with more than two decimal places are rejected.
+func Parse(s string) (Amount, error) {
+	v := strings.TrimSpace(strings.Replace(s, ",", "", -1))
+	if v == "" {
+		return Zero, errors.WithMessagef(ErrInvalidAmount, "%q is empty", s)
+	}
+
+	var neg bool
+	switch v[0] {
+	case '-':
+		neg = true
+		v = v[1:]
+	case '+':
+		v = v[1:]
+	}
+
+	whole, frac := v, ""
+	if i := strings.IndexByte(v, '.'); i >= 0 {
+		whole, frac = v[:i], v[i+1:]
+	}
+	if whole == "" && frac == "" {
+		return Zero, errors.WithMessagef(ErrInvalidAmount, "%q is not a number", s)
+	}
+	if len(frac) > 2 {
+		return Zero, errors.WithMessagef(ErrInvalidAmount, "%q has more than two decimal places", s)
+	}
+	for _, r := range whole + frac {
+		if r < '0' || r > '9' {
+			return Zero, errors.WithMessagef(ErrInvalidAmount, "%q is not a number", s)
+		}
+	}
+
+	var naira, kobo int64
+	if whole != "" {
+		var err error
+		naira, err = strconv.ParseInt(whole, 10, 64)
+		if err != nil || naira > math.MaxInt64/KoboPerNaira-1 {
+			return Zero, errors.WithMessagef(ErrInvalidAmount, "%q is out of range", s)
+		}
+	}
+	if frac != "" {
+		kobo, _ = strconv.ParseInt((frac + "0")[:2], 10, 64)
+	}
+
+	a := Amount(naira*KoboPerNaira + kobo)
+	if neg {
+		a = -a
+	}
+	return a, nil
+}
+
+// Kobo returns the amount as a number of kobo.
+func (a Amount) Kobo() int64 {
+	return int64(a)
+}
+
+// Float returns the amount in naira as a float. It should only be used for display.
+func (a Amount) Float() float64 {
+	return float64(a) / KoboPerNaira
+}
+
+// IsZero reports whether the amount has no value.
+func (a Amount) IsZero() bool {
+	return a == 0
+}
+
+// Abs returns the absolute value of the amount.
+func (a Amount) Abs() Amount {
+	if a < 0 {
+		return -a
+	}
+	return a
+}
+
+// Mul returns the amount multiplied by a whole number such as a quantity.
+func (a Amount) Mul(n int64) Amount {
+	return a * Amount(n)
+}
+
+// IsMultipleOf reports whether the amount can be split evenly into parts of the provided amount.
+func (a Amount) IsMultipleOf(part Amount) bool {
+	if part == 0 {
+		return false
+	}
+	return a%part == 0
+}
+
+// String returns the amount in naira with two decimal places, e.g. 1500.50.
+func (a Amount) String() string {
+	var sign string
+	if a < 0 {
+		sign = "-"
+	}
+	kobo := uint64(a.Abs())
+	return fmt.Sprintf("%s%d.%02d", sign, kobo/KoboPerNaira, kobo%KoboPerNaira)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (a Amount) MarshalText() ([]byte, error) {
+	return []byte(a.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler so amounts can be decoded from forms and
+// query strings.
+func (a *Amount) UnmarshalText(text []byte) error {
+	v, err := Parse(string(text))
+	if err != nil {
+		return err
+	}
+	*a = v
+	return nil
+}
+
+// MarshalJSON encodes the amount as a JSON number of naira.
+func (a Amount) MarshalJSON() ([]byte, error) {
+	return []byte(a.String()), nil
+}
+
+// UnmarshalJSON decodes the amount from a JSON number or string of naira.
+func (a *Amount) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, []byte("null")) {
+		return nil
+	}
+
+	if len(data) > 0 && data[0] == '"' {
+		var s string
+		if err := json.Unmarshal(data, &s); err != nil {
+			return err
+		}
+		return a.UnmarshalText([]byte(s))
+	}
+
+	// Numbers are parsed from their literal text so no precision is lost to a float.
+	var n json.Number
+	if err := json.Unmarshal(data, &n); err != nil {
+		return errors.WithMessagef(ErrInvalidAmount, "%s is not a number", data)
+	}
+	return a.UnmarshalText([]byte(n.String()))
+}
@@ -0,0 +1,89 @@
+package money
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+
+	var parseTests = []struct {
+		value string
+		want  Amount
+		err   bool
+	}{
+		{"1500", 150000, false},
+		{"1,500.5", 150050, false},
+		{"0.1", 10, false},
+		{".05", 5, false},
+		{"-20.05", -2005, false},
+		{"+3", 300, false},
+		{"1.005", 0, true},
+		{"12a", 0, true},
+		{"", 0, true},
+		{".", 0, true},
+	}
+
+	t.Log("Given the need to read naira amounts without losing kobo.")
+	{
+		for i, tt := range parseTests {
+			t.Logf("\tTest: %d\tWhen parsing %q", i, tt.value)
+			{
+				got, err := Parse(tt.value)
+				if tt.err {
+					if err == nil {
+						t.Fatalf("\t\tExpected an error, got %d.", got)
+					}
+					t.Logf("\t\tOk.")
+					continue
+				}
+				if err != nil {
+					t.Log("\t\tGot :", err)
+					t.Fatalf("\t\tParse failed.")
+				}
+				if got != tt.want {
+					t.Logf("\t\tGot : %d", got)
+					t.Logf("\t\tWant: %d", tt.want)
+					t.Fatalf("\t\tParsed amount does not match expected.")
+				}
+				t.Logf("\t\tOk.")
+			}
+		}
+	}
+}
+
+func TestJSON(t *testing.T) {
+	t.Log("Given the need to exchange amounts with clients as naira.")
+	{
+		var req struct {
+			Amount Amount `json:"amount"`
+			Target Amount `json:"target"`
+		}
+		if err := json.Unmarshal([]byte(`{"amount": 0.3, "target": "1,000.10"}`), &req); err != nil {
+			t.Log("\t\tGot :", err)
+			t.Fatalf("\t\tUnmarshal failed.")
+		}
+		if req.Amount != 30 || req.Target != 100010 {
+			t.Fatalf("\t\tGot %d and %d, want 30 and 100010.", req.Amount, req.Target)
+		}
+
+		// 0.1 + 0.2 is not 0.3 as a float but is in kobo.
+		if Amount(10)+Amount(20) != req.Amount {
+			t.Fatalf("\t\tExpected kobo sums to be exact.")
+		}
+
+		dat, err := json.Marshal(req)
+		if err != nil {
+			t.Log("\t\tGot :", err)
+			t.Fatalf("\t\tMarshal failed.")
+		}
+		if string(dat) != `{"amount":0.30,"target":1000.10}` {
+			t.Fatalf("\t\tGot %s.", dat)
+		}
+
+		if !Naira(1500).IsMultipleOf(Naira(500)) || Naira(1500).IsMultipleOf(Kobo(70)) {
+			t.Fatalf("\t\tIsMultipleOf failed.")
+		}
+		t.Logf("\t\tOk.")
+	}
+}
@@ -4,10 +4,12 @@ import (
 	"bytes"
 	"context"
 	html "html/template"
+	"io"
 	"path/filepath"
 	text "text/template"
 
 	"github.com/pkg/errors"
+	"gopkg.in/gomail.v2"
 )
 
 const (
@@ -16,15 +18,22 @@ const (
 
 // Email defines method need to send an email disregarding the service provider.
 type Email interface {
-	Send(ctx context.Context, toEmail, subject, templateName string, data map[string]interface{}) error
+	Send(ctx context.Context, toEmail, subject, templateName string, data map[string]interface{}, attachments ...EmailAttachment) error
 	Verify() error
 }
 
+// EmailAttachment defines a file that is sent along with an email.
+type EmailAttachment struct {
+	Filename    string
+	ContentType string
+	Data        []byte
+}
+
 // MockEmail defines an implementation of the email interface for testing.
 type MockEmail struct{}
 
 // Send an email the provided email address.
-func (n *MockEmail) Send(ctx context.Context, toEmail, subject, templateName string, data map[string]interface{}) error {
+func (n *MockEmail) Send(ctx context.Context, toEmail, subject, templateName string, data map[string]interface{}, attachments ...EmailAttachment) error {
 	return nil
 }
 
@@ -58,3 +67,16 @@ func parseEmailTemplates(templateDir, templateName string, data map[string]inter
 
 	return htmlDat.Bytes(), txtDat.Bytes(), nil
 }
+
+// attachEmailFiles adds the attachments to the message.
+func attachEmailFiles(m *gomail.Message, attachments []EmailAttachment) {
+	for _, a := range attachments {
+		data := a.Data
+		m.Attach(a.Filename,
+			gomail.SetHeader(map[string][]string{"Content-Type": {a.ContentType}}),
+			gomail.SetCopyFunc(func(w io.Writer) error {
+				_, err := w.Write(data)
+				return err
+			}))
+	}
+}
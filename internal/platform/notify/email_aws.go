@@ -1,6 +1,7 @@
 package notify
 
 import (
+	"bytes"
 	"context"
 	"os"
 	"path/filepath"
@@ -9,6 +10,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/ses"
 	"github.com/pkg/errors"
+	"gopkg.in/gomail.v2"
 )
 
 var (
@@ -81,7 +83,7 @@ func (n *EmailAws) Verify() error {
 }
 
 // Send initials the delivery of an email the provided email address.
-func (n *EmailAws) Send(ctx context.Context, toEmail, subject, templateName string, data map[string]interface{}) error {
+func (n *EmailAws) Send(ctx context.Context, toEmail, subject, templateName string, data map[string]interface{}, attachments ...EmailAttachment) error {
 
 	htmlDat, txtDat, err := parseEmailTemplates(n.templateDir, templateName, data)
 	if err != nil {
@@ -90,6 +92,33 @@ func (n *EmailAws) Send(ctx context.Context, toEmail, subject, templateName stri
 
 	svc := ses.New(n.awsSession)
 
+	// SendEmail only takes a body, attachments have to be sent as a raw MIME message.
+	if len(attachments) > 0 {
+		m := gomail.NewMessage()
+		m.SetHeader("From", n.senderEmailAddress)
+		m.SetHeader("To", toEmail)
+		m.SetHeader("Subject", subject)
+		m.SetBody("text/plain", string(txtDat))
+		m.AddAlternative("text/html", string(htmlDat))
+		attachEmailFiles(m, attachments)
+
+		var raw bytes.Buffer
+		if _, err := m.WriteTo(&raw); err != nil {
+			return errors.WithStack(err)
+		}
+
+		_, err = svc.SendRawEmail(&ses.SendRawEmailInput{
+			Destinations: []*string{aws.String(toEmail)},
+			RawMessage:   &ses.RawMessage{Data: raw.Bytes()},
+			Source:       aws.String(n.senderEmailAddress),
+		})
+		if err != nil {
+			return errors.WithStack(err)
+		}
+
+		return nil
+	}
+
 	// Assemble the email.
 	input := &ses.SendEmailInput{
 		Destination: &ses.Destination{
@@ -11,7 +11,7 @@ func NewEmailDisabled() *DisableEmail {
 }
 
 // Send does nothing.
-func (n *DisableEmail) Send(ctx context.Context, toEmail, subject, templateName string, data map[string]interface{}) error {
+func (n *DisableEmail) Send(ctx context.Context, toEmail, subject, templateName string, data map[string]interface{}, attachments ...EmailAttachment) error {
 	return nil
 }
 
@@ -62,7 +62,7 @@ func (n *EmailSmtp) Verify() error {
 }
 
 // Send initials the delivery of an email the provided email address.
-func (n *EmailSmtp) Send(ctx context.Context, toEmail, subject, templateName string, data map[string]interface{}) error {
+func (n *EmailSmtp) Send(ctx context.Context, toEmail, subject, templateName string, data map[string]interface{}, attachments ...EmailAttachment) error {
 
 	htmlDat, txtDat, err := parseEmailTemplates(n.templateDir, templateName, data)
 	if err != nil {
@@ -73,6 +73,7 @@ func (n *EmailSmtp) Send(ctx context.Context, toEmail, subject, templateName str
 	m.SetHeader("From", n.senderEmailAddress)
 	m.SetHeader("To", toEmail)
 	m.SetHeader("Subject", subject)
+	attachEmailFiles(m, attachments)
 
 	m.SetBody("text/plain", string(txtDat))
 	if err := n.dialer.DialAndSend(m); err != nil {
@@ -7,6 +7,8 @@ import (
 	text "text/template"
 
 	"github.com/pkg/errors"
+
+	"merryworld/surebank/internal/platform/money"
 )
 
 // SMS defines method need to send an SMS disregarding the service provider.
@@ -40,7 +42,7 @@ func parseSMSTemplates(templateDir, templateName string, data map[string]interfa
 }
 
 type DepositSMSPayload struct {
-	Name string
-	Amount float64
-	Balance float64
+	Name    string
+	Amount  money.Amount
+	Balance money.Amount
 }
@@ -0,0 +1,22 @@
+package notify
+
+import (
+	"net/http"
+
+	"github.com/pkg/errors"
+)
+
+// ErrInboundSMSInvalid occurs when the request of an SMS provider does not contain an SMS.
+var ErrInboundSMSInvalid = errors.New("Inbound SMS invalid")
+
+// InboundSMS is a message a customer sent to the number of the SMS provider.
+type InboundSMS struct {
+	From    string `json:"from"`
+	Message string `json:"message"`
+}
+
+// InboundSMSParser defines the method needed to read the SMS an SMS provider forwards to the
+// inbound webhook. Each provider posts its own payload so each one implements its own parser.
+type InboundSMSParser interface {
+	ParseInbound(r *http.Request) (*InboundSMS, error)
+}
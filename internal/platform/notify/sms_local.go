@@ -0,0 +1,86 @@
+package notify
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/pkg/errors"
+)
+
+// LocalSMS is a message sent with the local SMS provider.
+type LocalSMS struct {
+	PhoneNumber string
+	Message     string
+}
+
+// SMSLocal defines an implementation of the SMS and InboundSMSParser interfaces that keeps the
+// messages it sends in memory. It stands in for an SMS provider in tests and local development.
+type SMSLocal struct {
+	templateDir string
+	mtx         sync.Mutex
+	sent        []LocalSMS
+}
+
+// NewSMSLocal returns a local SMS provider that renders templates from the shared template directory.
+func NewSMSLocal(sharedTemplateDir string) *SMSLocal {
+	return &SMSLocal{
+		templateDir: sharedTemplateDir,
+	}
+}
+
+// Send renders the template and keeps the message.
+func (n *SMSLocal) Send(ctx context.Context, phoneNumber, templateName string, data map[string]interface{}) error {
+	body, err := parseSMSTemplates(n.templateDir, templateName, data)
+	if err != nil {
+		return err
+	}
+
+	return n.SendStr(ctx, phoneNumber, body)
+}
+
+// SendStr keeps the message.
+func (n *SMSLocal) SendStr(ctx context.Context, phoneNumber, message string) error {
+	n.mtx.Lock()
+	defer n.mtx.Unlock()
+
+	n.sent = append(n.sent, LocalSMS{
+		PhoneNumber: phoneNumber,
+		Message:     message,
+	})
+
+	return nil
+}
+
+// Sent returns the messages sent so far, oldest first.
+func (n *SMSLocal) Sent() []LocalSMS {
+	n.mtx.Lock()
+	defer n.mtx.Unlock()
+
+	return append([]LocalSMS(nil), n.sent...)
+}
+
+// ParseInbound reads an SMS posted as JSON or as the form values from and message.
+func (n *SMSLocal) ParseInbound(r *http.Request) (*InboundSMS, error) {
+	var req InboundSMS
+	if strings.HasPrefix(r.Header.Get("Content-Type"), "application/json") {
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			return nil, errors.Wrap(ErrInboundSMSInvalid, err.Error())
+		}
+	} else {
+		if err := r.ParseForm(); err != nil {
+			return nil, errors.Wrap(ErrInboundSMSInvalid, err.Error())
+		}
+		req.From = r.PostForm.Get("from")
+		req.Message = r.PostForm.Get("message")
+	}
+
+	req.From = strings.TrimSpace(req.From)
+	if req.From == "" {
+		return nil, errors.WithStack(ErrInboundSMSInvalid)
+	}
+
+	return &req, nil
+}
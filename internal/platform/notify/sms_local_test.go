@@ -0,0 +1,84 @@
+package notify
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/pkg/errors"
+
+	"merryworld/surebank/internal/platform/tests"
+)
+
+// TestSMSLocalParseInbound validates the local SMS provider reads inbound messages posted as
+// JSON and as a form.
+func TestSMSLocalParseInbound(t *testing.T) {
+	form := url.Values{"from": {" +2348012345678 "}, "message": {"BAL SB12345"}}
+
+	type inboundTest struct {
+		Name        string
+		ContentType string
+		Body        string
+		Want        *InboundSMS
+		Err         error
+	}
+
+	var inboundTests = []inboundTest{
+		{"JSON", "application/json", `{"from":"+2348012345678","message":"BAL SB12345"}`,
+			&InboundSMS{From: "+2348012345678", Message: "BAL SB12345"}, nil},
+		{"a form", "application/x-www-form-urlencoded", form.Encode(),
+			&InboundSMS{From: "+2348012345678", Message: "BAL SB12345"}, nil},
+		{"invalid JSON", "application/json", `{"from":`, nil, ErrInboundSMSInvalid},
+		{"missing the sender", "application/json", `{"message":"BAL SB12345"}`, nil, ErrInboundSMSInvalid},
+	}
+
+	n := NewSMSLocal("")
+
+	t.Log("Given the need to read the SMS customers send.")
+	{
+		for i, tt := range inboundTests {
+			t.Logf("\tTest: %d\tWhen the SMS is posted as %s.", i, tt.Name)
+			{
+				r := httptest.NewRequest(http.MethodPost, "/v1/sms/inbound", strings.NewReader(tt.Body))
+				r.Header.Set("Content-Type", tt.ContentType)
+
+				got, err := n.ParseInbound(r)
+				if errors.Cause(err) != tt.Err {
+					t.Logf("\t\tGot : %v", err)
+					t.Logf("\t\tWant: %v", tt.Err)
+					t.Fatalf("\t%s\tShould get the expected error.", tests.Failed)
+				}
+				if tt.Want != nil && (got == nil || *got != *tt.Want) {
+					t.Logf("\t\tGot : %+v", got)
+					t.Logf("\t\tWant: %+v", tt.Want)
+					t.Fatalf("\t%s\tShould read the SMS.", tests.Failed)
+				}
+				t.Logf("\t%s\tShould read the SMS.", tests.Success)
+			}
+		}
+	}
+}
+
+// TestSMSLocalSendStr validates the local SMS provider keeps the messages it sends.
+func TestSMSLocalSendStr(t *testing.T) {
+	n := NewSMSLocal("")
+
+	t.Log("Given the need to check the SMS sent in tests.")
+	{
+		t.Log("\tTest: 0\tWhen messages are sent.")
+		{
+			_ = n.SendStr(context.Background(), "+2348012345678", "first")
+			_ = n.SendStr(context.Background(), "+2348087654321", "second")
+
+			sent := n.Sent()
+			if len(sent) != 2 || sent[0].Message != "first" || sent[1].PhoneNumber != "+2348087654321" {
+				t.Logf("\t\tGot : %+v", sent)
+				t.Fatalf("\t%s\tShould keep the messages in the order sent.", tests.Failed)
+			}
+			t.Logf("\t%s\tShould keep the messages in the order sent.", tests.Success)
+		}
+	}
+}
@@ -0,0 +1,279 @@
+package spreadsheet
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/csv"
+	"encoding/xml"
+	"io"
+	"io/ioutil"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+var (
+	// ErrUnsupportedFormat occurs when the file is neither a CSV nor an XLSX spreadsheet.
+	ErrUnsupportedFormat = errors.New("Only CSV and XLSX spreadsheets are supported")
+
+	// ErrInvalidFile occurs when the contents of the file cannot be read as the spreadsheet
+	// format of its name.
+	ErrInvalidFile = errors.New("The spreadsheet could not be read")
+)
+
+// maxPartSize is the most that is read of any part of an XLSX file once it is uncompressed.
+const maxPartSize = 64 << 20
+
+// Formats of spreadsheets that can be read.
+const (
+	Format_CSV  = "csv"
+	Format_XLSX = "xlsx"
+)
+
+// FormatOf returns the format of the spreadsheet from the extension of its file name.
+func FormatOf(fileName string) (string, error) {
+	switch strings.ToLower(filepath.Ext(fileName)) {
+	case ".csv":
+		return Format_CSV, nil
+	case ".xlsx":
+		return Format_XLSX, nil
+	}
+	return "", errors.WithStack(ErrUnsupportedFormat)
+}
+
+// Read returns the rows of cells of the spreadsheet in the format of its file name. Only the
+// first sheet of an XLSX workbook is read.
+func Read(fileName string, dat []byte) ([][]string, error) {
+	format, err := FormatOf(fileName)
+	if err != nil {
+		return nil, err
+	}
+
+	if format == Format_XLSX {
+		return ReadXLSX(dat)
+	}
+	return ReadCSV(bytes.NewReader(dat))
+}
+
+// ReadCSV returns the rows of the comma separated values. Rows may have different numbers of
+// cells, blank lines are skipped and a leading byte order mark is dropped.
+func ReadCSV(r io.Reader) ([][]string, error) {
+	dat, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	dat = bytes.TrimPrefix(dat, []byte("\xef\xbb\xbf"))
+
+	cr := csv.NewReader(bytes.NewReader(dat))
+	cr.FieldsPerRecord = -1
+	cr.TrimLeadingSpace = true
+
+	var rows [][]string
+	for {
+		rec, err := cr.Read()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return nil, errors.Wrap(ErrInvalidFile, err.Error())
+		}
+
+		rows = append(rows, rec)
+	}
+
+	return rows, nil
+}
+
+type xlsxWorkbook struct {
+	Sheets []struct {
+		Name string `xml:"name,attr"`
+		RID  string `xml:"http://schemas.openxmlformats.org/officeDocument/2006/relationships id,attr"`
+	} `xml:"sheets>sheet"`
+}
+
+type xlsxRelationships struct {
+	Relationships []struct {
+		ID     string `xml:"Id,attr"`
+		Target string `xml:"Target,attr"`
+	} `xml:"Relationship"`
+}
+
+type xlsxSharedStrings struct {
+	Items []xlsxText `xml:"si"`
+}
+
+// xlsxText is text that is either plain or made of runs of differently formatted text.
+type xlsxText struct {
+	T    string `xml:"t"`
+	Runs []struct {
+		T string `xml:"t"`
+	} `xml:"r"`
+}
+
+func (t xlsxText) String() string {
+	if len(t.Runs) == 0 {
+		return t.T
+	}
+	var sb strings.Builder
+	for _, r := range t.Runs {
+		sb.WriteString(r.T)
+	}
+	return sb.String()
+}
+
+type xlsxWorksheet struct {
+	Rows []struct {
+		R     int `xml:"r,attr"`
+		Cells []struct {
+			R      string   `xml:"r,attr"`
+			T      string   `xml:"t,attr"`
+			V      string   `xml:"v"`
+			Inline xlsxText `xml:"is"`
+		} `xml:"c"`
+	} `xml:"sheetData>row"`
+}
+
+// ReadXLSX returns the rows of the first sheet of the XLSX workbook. Rows keep their position
+// in the sheet so the index of a row is one less than its row number and blank rows are
+// returned empty. Cells are returned as they are stored, numbers and dates are not formatted.
+func ReadXLSX(dat []byte) ([][]string, error) {
+	zr, err := zip.NewReader(bytes.NewReader(dat), int64(len(dat)))
+	if err != nil {
+		return nil, errors.Wrap(ErrInvalidFile, err.Error())
+	}
+
+	parts := make(map[string]*zip.File)
+	for _, f := range zr.File {
+		parts[f.Name] = f
+	}
+
+	sheetPath, err := xlsxFirstSheet(parts)
+	if err != nil {
+		return nil, err
+	}
+
+	var shared xlsxSharedStrings
+	if f, ok := parts["xl/sharedStrings.xml"]; ok {
+		if err := decodePart(f, &shared); err != nil {
+			return nil, err
+		}
+	}
+
+	f, ok := parts[sheetPath]
+	if !ok {
+		return nil, errors.Wrap(ErrInvalidFile, "missing worksheet "+sheetPath)
+	}
+	var sheet xlsxWorksheet
+	if err := decodePart(f, &sheet); err != nil {
+		return nil, err
+	}
+
+	var rows [][]string
+	for _, xr := range sheet.Rows {
+		rowNum := xr.R
+		if rowNum == 0 {
+			rowNum = len(rows) + 1
+		}
+		for len(rows) < rowNum-1 {
+			rows = append(rows, nil)
+		}
+
+		var row []string
+		for _, c := range xr.Cells {
+			col := len(row)
+			if c.R != "" {
+				if col, err = columnIndex(c.R); err != nil {
+					return nil, err
+				}
+			}
+
+			var val string
+			switch c.T {
+			case "s":
+				i, err := strconv.Atoi(strings.TrimSpace(c.V))
+				if err != nil || i < 0 || i >= len(shared.Items) {
+					return nil, errors.Wrapf(ErrInvalidFile, "invalid shared string in cell %s", c.R)
+				}
+				val = shared.Items[i].String()
+			case "inlineStr":
+				val = c.Inline.String()
+			default:
+				val = c.V
+			}
+
+			for len(row) < col {
+				row = append(row, "")
+			}
+			row = append(row, val)
+		}
+		rows = append(rows, row)
+	}
+
+	return rows, nil
+}
+
+// xlsxFirstSheet returns the path within the archive of the first sheet of the workbook.
+func xlsxFirstSheet(parts map[string]*zip.File) (string, error) {
+	f, ok := parts["xl/workbook.xml"]
+	if !ok {
+		return "", errors.Wrap(ErrInvalidFile, "missing workbook")
+	}
+	var wb xlsxWorkbook
+	if err := decodePart(f, &wb); err != nil {
+		return "", err
+	}
+	if len(wb.Sheets) == 0 {
+		return "", errors.Wrap(ErrInvalidFile, "the workbook has no sheets")
+	}
+
+	if f, ok = parts["xl/_rels/workbook.xml.rels"]; ok {
+		var rels xlsxRelationships
+		if err := decodePart(f, &rels); err != nil {
+			return "", err
+		}
+		for _, rel := range rels.Relationships {
+			if rel.ID != wb.Sheets[0].RID {
+				continue
+			}
+			if strings.HasPrefix(rel.Target, "/") {
+				return strings.TrimPrefix(rel.Target, "/"), nil
+			}
+			return path.Join("xl", rel.Target), nil
+		}
+	}
+
+	return "xl/worksheets/sheet1.xml", nil
+}
+
+// decodePart decodes the XML part of the archive into v.
+func decodePart(f *zip.File, v interface{}) error {
+	rc, err := f.Open()
+	if err != nil {
+		return errors.Wrap(ErrInvalidFile, err.Error())
+	}
+	defer rc.Close()
+
+	if err := xml.NewDecoder(io.LimitReader(rc, maxPartSize)).Decode(v); err != nil {
+		return errors.Wrapf(ErrInvalidFile, "%s: %s", f.Name, err)
+	}
+	return nil
+}
+
+// columnIndex returns the zero based column of the cell reference, A1 is 0 and AB7 is 27.
+func columnIndex(ref string) (int, error) {
+	col := 0
+	n := 0
+	for _, r := range strings.ToUpper(ref) {
+		if r < 'A' || r > 'Z' {
+			break
+		}
+		col = col*26 + int(r-'A'+1)
+		n++
+	}
+	if n == 0 || n > 3 {
+		return 0, errors.Wrapf(ErrInvalidFile, "invalid cell reference %s", ref)
+	}
+	return col - 1, nil
+}
@@ -0,0 +1,117 @@
+package spreadsheet
+
+import (
+	"archive/zip"
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/pkg/errors"
+
+	"merryworld/surebank/internal/platform/tests"
+)
+
+// xlsxFile builds an XLSX workbook from the contents of its parts.
+func xlsxFile(t *testing.T, parts map[string]string) []byte {
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	for name, content := range parts {
+		w, err := zw.Create(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err = w.Write([]byte(content)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := zw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+const testWorkbook = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<workbook xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main" xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships">
+<sheets><sheet name="Customers" sheetId="1" r:id="rId3"/></sheets>
+</workbook>`
+
+const testRels = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">
+<Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/styles" Target="styles.xml"/>
+<Relationship Id="rId3" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/worksheet" Target="worksheets/customers.xml"/>
+</Relationships>`
+
+const testSharedStrings = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<sst xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main" count="4" uniqueCount="4">
+<si><t>Name</t></si>
+<si><t>Phone</t></si>
+<si><r><t>Oluwafe </t></r><r><rPr><b/></rPr><t>Dami</t></r></si>
+<si><t xml:space="preserve">Balance </t></si>
+</sst>`
+
+const testSheet = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<worksheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main">
+<sheetData>
+<row r="1"><c r="A1" t="s"><v>0</v></c><c r="B1" t="s"><v>1</v></c><c r="D1" t="s"><v>3</v></c></row>
+<row r="2"><c r="A2" t="s"><v>2</v></c><c r="B2"><v>8031234567</v></c><c r="D2"><v>2500.5</v></c></row>
+<row r="4"><c r="A4" t="inlineStr"><is><t>Chinedu Okafor</t></is></c><c r="C4" t="str"><v>SB</v></c></row>
+</sheetData>
+</worksheet>`
+
+// TestRead validates the rows of CSV and XLSX spreadsheets are read with their cells in place.
+func TestRead(t *testing.T) {
+	type readTest struct {
+		Name     string
+		FileName string
+		Data     []byte
+		Rows     []string
+		Err      error
+	}
+
+	var readTests = []readTest{
+		{"a CSV file", "ledger.csv",
+			[]byte("\xef\xbb\xbfName,Phone\n\"Dami, Oluwafe\", 08031234567,SB\n\nChinedu Okafor\n"),
+			[]string{"Name|Phone", "Dami, Oluwafe|08031234567|SB", "Chinedu Okafor"}, nil},
+		{"an XLSX file", "Ledger.XLSX",
+			xlsxFile(t, map[string]string{
+				"xl/workbook.xml":             testWorkbook,
+				"xl/_rels/workbook.xml.rels":  testRels,
+				"xl/sharedStrings.xml":        testSharedStrings,
+				"xl/worksheets/customers.xml": testSheet,
+			}),
+			[]string{"Name|Phone||Balance ", "Oluwafe Dami|8031234567||2500.5", "", "Chinedu Okafor||SB"}, nil},
+		{"an XLSX file without sheets", "ledger.xlsx",
+			xlsxFile(t, map[string]string{"xl/workbook.xml": `<workbook><sheets></sheets></workbook>`}),
+			nil, ErrInvalidFile},
+		{"a CSV file with a broken quote", "ledger.csv", []byte("Name\n\"Oluwafe Dami\n"), nil, ErrInvalidFile},
+		{"a corrupt XLSX file", "ledger.xlsx", []byte("Name,Phone\n"), nil, ErrInvalidFile},
+		{"an XLS file", "ledger.xls", []byte("Name"), nil, ErrUnsupportedFormat},
+	}
+
+	t.Log("Given the need to import customers from spreadsheets.")
+	{
+		for i, tt := range readTests {
+			t.Logf("\tTest: %d\tWhen reading %s.", i, tt.Name)
+			{
+				rows, err := Read(tt.FileName, tt.Data)
+				if errors.Cause(err) != tt.Err {
+					t.Logf("\t\tGot : %v", err)
+					t.Logf("\t\tWant: %v", tt.Err)
+					t.Fatalf("\t%s\tShould get the expected error.", tests.Failed)
+				}
+				t.Logf("\t%s\tShould get the expected error.", tests.Success)
+
+				var got []string
+				for _, row := range rows {
+					got = append(got, strings.Join(row, "|"))
+				}
+				if strings.Join(got, "\n") != strings.Join(tt.Rows, "\n") {
+					t.Logf("\t\tGot : %q", got)
+					t.Logf("\t\tWant: %q", tt.Rows)
+					t.Fatalf("\t%s\tShould get the rows of the spreadsheet.", tests.Failed)
+				}
+				t.Logf("\t%s\tShould get the rows of the spreadsheet.", tests.Success)
+			}
+		}
+	}
+}
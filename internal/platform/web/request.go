@@ -29,6 +29,7 @@ const (
 	HeaderXRequestedWith      = "X-Requested-With"
 	HeaderServer              = "Server"
 	HeaderOrigin              = "Origin"
+	HeaderIdempotencyKey      = "Idempotency-Key"
 )
 
 // Decode reads the body of an HTTP request looking for a JSON document. The
@@ -28,6 +28,7 @@ const (
 	MIMETextPlain                  = "text/plain"
 	MIMETextPlainCharsetUTF8       = MIMETextPlain + "; " + charsetUTF8
 	MIMEOctetStream                = "application/octet-stream"
+	MIMEApplicationPDF             = "application/pdf"
 )
 
 // RespondJsonError sends an error formatted as JSON response back to the client.
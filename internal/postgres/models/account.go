@@ -24,82 +24,132 @@ import (
 
 // Account is an object representing the database table.
 type Account struct {
-	ID              string     `boil:"id" json:"id" toml:"id" yaml:"id"`
-	BranchID        string     `boil:"branch_id" json:"branch_id" toml:"branch_id" yaml:"branch_id"`
-	Number          string     `boil:"number" json:"number" toml:"number" yaml:"number"`
-	CustomerID      string     `boil:"customer_id" json:"customer_id" toml:"customer_id" yaml:"customer_id"`
-	AccountType     string     `boil:"account_type" json:"account_type" toml:"account_type" yaml:"account_type"`
-	Target          float64    `boil:"target" json:"target" toml:"target" yaml:"target"`
-	TargetInfo      string     `boil:"target_info" json:"target_info" toml:"target_info" yaml:"target_info"`
-	SalesRepID      string     `boil:"sales_rep_id" json:"sales_rep_id" toml:"sales_rep_id" yaml:"sales_rep_id"`
-	CreatedAt       int64      `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
-	UpdatedAt       int64      `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
-	ArchivedAt      null.Int64 `boil:"archived_at" json:"archived_at,omitempty" toml:"archived_at" yaml:"archived_at,omitempty"`
-	Balance         float64    `boil:"balance" json:"balance" toml:"balance" yaml:"balance"`
-	LastPaymentDate int64      `boil:"last_payment_date" json:"last_payment_date" toml:"last_payment_date" yaml:"last_payment_date"`
+	ID                string     `boil:"id" json:"id" toml:"id" yaml:"id"`
+	BranchID          string     `boil:"branch_id" json:"branch_id" toml:"branch_id" yaml:"branch_id"`
+	Number            string     `boil:"number" json:"number" toml:"number" yaml:"number"`
+	CustomerID        string     `boil:"customer_id" json:"customer_id" toml:"customer_id" yaml:"customer_id"`
+	AccountType       string     `boil:"account_type" json:"account_type" toml:"account_type" yaml:"account_type"`
+	Target            int64      `boil:"target" json:"target" toml:"target" yaml:"target"`
+	TargetInfo        string     `boil:"target_info" json:"target_info" toml:"target_info" yaml:"target_info"`
+	SalesRepID        string     `boil:"sales_rep_id" json:"sales_rep_id" toml:"sales_rep_id" yaml:"sales_rep_id"`
+	CreatedAt         int64      `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedAt         int64      `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+	ArchivedAt        null.Int64 `boil:"archived_at" json:"archived_at,omitempty" toml:"archived_at" yaml:"archived_at,omitempty"`
+	Balance           int64      `boil:"balance" json:"balance" toml:"balance" yaml:"balance"`
+	LastPaymentDate   int64      `boil:"last_payment_date" json:"last_payment_date" toml:"last_payment_date" yaml:"last_payment_date"`
+	ProductID         string     `boil:"product_id" json:"product_id" toml:"product_id" yaml:"product_id"`
+	TenorDays         int        `boil:"tenor_days" json:"tenor_days" toml:"tenor_days" yaml:"tenor_days"`
+	InterestRateBPS   int        `boil:"interest_rate_bps" json:"interest_rate_bps" toml:"interest_rate_bps" yaml:"interest_rate_bps"`
+	MaturityDate      null.Int64 `boil:"maturity_date" json:"maturity_date,omitempty" toml:"maturity_date" yaml:"maturity_date,omitempty"`
+	RollOver          bool       `boil:"roll_over" json:"roll_over" toml:"roll_over" yaml:"roll_over"`
+	AccruedInterest   int64      `boil:"accrued_interest" json:"accrued_interest" toml:"accrued_interest" yaml:"accrued_interest"`
+	InterestAccruedTo null.Int64 `boil:"interest_accrued_to" json:"interest_accrued_to,omitempty" toml:"interest_accrued_to" yaml:"interest_accrued_to,omitempty"`
+	MaturedAt         null.Int64 `boil:"matured_at" json:"matured_at,omitempty" toml:"matured_at" yaml:"matured_at,omitempty"`
+	GoalMilestone     int        `boil:"goal_milestone" json:"goal_milestone" toml:"goal_milestone" yaml:"goal_milestone"`
+	Status            string     `boil:"status" json:"status" toml:"status" yaml:"status"`
 
 	R *accountR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L accountL  `boil:"-" json:"-" toml:"-" yaml:"-"`
 }
 
 var AccountColumns = struct {
-	ID              string
-	BranchID        string
-	Number          string
-	CustomerID      string
-	AccountType     string
-	Target          string
-	TargetInfo      string
-	SalesRepID      string
-	CreatedAt       string
-	UpdatedAt       string
-	ArchivedAt      string
-	Balance         string
-	LastPaymentDate string
+	ID                string
+	BranchID          string
+	Number            string
+	CustomerID        string
+	AccountType       string
+	Target            string
+	TargetInfo        string
+	SalesRepID        string
+	CreatedAt         string
+	UpdatedAt         string
+	ArchivedAt        string
+	Balance           string
+	LastPaymentDate   string
+	ProductID         string
+	TenorDays         string
+	InterestRateBPS   string
+	MaturityDate      string
+	RollOver          string
+	AccruedInterest   string
+	InterestAccruedTo string
+	MaturedAt         string
+	GoalMilestone     string
+	Status            string
 }{
-	ID:              "id",
-	BranchID:        "branch_id",
-	Number:          "number",
-	CustomerID:      "customer_id",
-	AccountType:     "account_type",
-	Target:          "target",
-	TargetInfo:      "target_info",
-	SalesRepID:      "sales_rep_id",
-	CreatedAt:       "created_at",
-	UpdatedAt:       "updated_at",
-	ArchivedAt:      "archived_at",
-	Balance:         "balance",
-	LastPaymentDate: "last_payment_date",
+	ID:                "id",
+	BranchID:          "branch_id",
+	Number:            "number",
+	CustomerID:        "customer_id",
+	AccountType:       "account_type",
+	Target:            "target",
+	TargetInfo:        "target_info",
+	SalesRepID:        "sales_rep_id",
+	CreatedAt:         "created_at",
+	UpdatedAt:         "updated_at",
+	ArchivedAt:        "archived_at",
+	Balance:           "balance",
+	LastPaymentDate:   "last_payment_date",
+	ProductID:         "product_id",
+	TenorDays:         "tenor_days",
+	InterestRateBPS:   "interest_rate_bps",
+	MaturityDate:      "maturity_date",
+	RollOver:          "roll_over",
+	AccruedInterest:   "accrued_interest",
+	InterestAccruedTo: "interest_accrued_to",
+	MaturedAt:         "matured_at",
+	GoalMilestone:     "goal_milestone",
+	Status:            "status",
 }
 
 var AccountTableColumns = struct {
-	ID              string
-	BranchID        string
-	Number          string
-	CustomerID      string
-	AccountType     string
-	Target          string
-	TargetInfo      string
-	SalesRepID      string
-	CreatedAt       string
-	UpdatedAt       string
-	ArchivedAt      string
-	Balance         string
-	LastPaymentDate string
+	ID                string
+	BranchID          string
+	Number            string
+	CustomerID        string
+	AccountType       string
+	Target            string
+	TargetInfo        string
+	SalesRepID        string
+	CreatedAt         string
+	UpdatedAt         string
+	ArchivedAt        string
+	Balance           string
+	LastPaymentDate   string
+	ProductID         string
+	TenorDays         string
+	InterestRateBPS   string
+	MaturityDate      string
+	RollOver          string
+	AccruedInterest   string
+	InterestAccruedTo string
+	MaturedAt         string
+	GoalMilestone     string
+	Status            string
 }{
-	ID:              "account.id",
-	BranchID:        "account.branch_id",
-	Number:          "account.number",
-	CustomerID:      "account.customer_id",
-	AccountType:     "account.account_type",
-	Target:          "account.target",
-	TargetInfo:      "account.target_info",
-	SalesRepID:      "account.sales_rep_id",
-	CreatedAt:       "account.created_at",
-	UpdatedAt:       "account.updated_at",
-	ArchivedAt:      "account.archived_at",
-	Balance:         "account.balance",
-	LastPaymentDate: "account.last_payment_date",
+	ID:                "account.id",
+	BranchID:          "account.branch_id",
+	Number:            "account.number",
+	CustomerID:        "account.customer_id",
+	AccountType:       "account.account_type",
+	Target:            "account.target",
+	TargetInfo:        "account.target_info",
+	SalesRepID:        "account.sales_rep_id",
+	CreatedAt:         "account.created_at",
+	UpdatedAt:         "account.updated_at",
+	ArchivedAt:        "account.archived_at",
+	Balance:           "account.balance",
+	LastPaymentDate:   "account.last_payment_date",
+	ProductID:         "account.product_id",
+	TenorDays:         "account.tenor_days",
+	InterestRateBPS:   "account.interest_rate_bps",
+	MaturityDate:      "account.maturity_date",
+	RollOver:          "account.roll_over",
+	AccruedInterest:   "account.accrued_interest",
+	InterestAccruedTo: "account.interest_accrued_to",
+	MaturedAt:         "account.matured_at",
+	GoalMilestone:     "account.goal_milestone",
+	Status:            "account.status",
 }
 
 // Generated where
@@ -127,35 +177,6 @@ func (w whereHelperstring) NIN(slice []string) qm.QueryMod {
 	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
 }
 
-type whereHelperfloat64 struct{ field string }
-
-func (w whereHelperfloat64) EQ(x float64) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.EQ, x) }
-func (w whereHelperfloat64) NEQ(x float64) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.NEQ, x)
-}
-func (w whereHelperfloat64) LT(x float64) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.LT, x) }
-func (w whereHelperfloat64) LTE(x float64) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.LTE, x)
-}
-func (w whereHelperfloat64) GT(x float64) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.GT, x) }
-func (w whereHelperfloat64) GTE(x float64) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.GTE, x)
-}
-func (w whereHelperfloat64) IN(slice []float64) qm.QueryMod {
-	values := make([]interface{}, 0, len(slice))
-	for _, value := range slice {
-		values = append(values, value)
-	}
-	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
-}
-func (w whereHelperfloat64) NIN(slice []float64) qm.QueryMod {
-	values := make([]interface{}, 0, len(slice))
-	for _, value := range slice {
-		values = append(values, value)
-	}
-	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
-}
-
 type whereHelperint64 struct{ field string }
 
 func (w whereHelperint64) EQ(x int64) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.EQ, x) }
@@ -202,58 +223,158 @@ func (w whereHelpernull_Int64) GTE(x null.Int64) qm.QueryMod {
 	return qmhelper.Where(w.field, qmhelper.GTE, x)
 }
 
+type whereHelperint struct{ field string }
+
+func (w whereHelperint) EQ(x int) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.EQ, x) }
+func (w whereHelperint) NEQ(x int) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.NEQ, x) }
+func (w whereHelperint) LT(x int) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.LT, x) }
+func (w whereHelperint) LTE(x int) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.LTE, x) }
+func (w whereHelperint) GT(x int) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.GT, x) }
+func (w whereHelperint) GTE(x int) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.GTE, x) }
+func (w whereHelperint) IN(slice []int) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
+}
+func (w whereHelperint) NIN(slice []int) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
+}
+
+type whereHelperbool struct{ field string }
+
+func (w whereHelperbool) EQ(x bool) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.EQ, x) }
+func (w whereHelperbool) NEQ(x bool) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.NEQ, x) }
+func (w whereHelperbool) LT(x bool) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.LT, x) }
+func (w whereHelperbool) LTE(x bool) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.LTE, x) }
+func (w whereHelperbool) GT(x bool) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.GT, x) }
+func (w whereHelperbool) GTE(x bool) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.GTE, x) }
+
 var AccountWhere = struct {
-	ID              whereHelperstring
-	BranchID        whereHelperstring
-	Number          whereHelperstring
-	CustomerID      whereHelperstring
-	AccountType     whereHelperstring
-	Target          whereHelperfloat64
-	TargetInfo      whereHelperstring
-	SalesRepID      whereHelperstring
-	CreatedAt       whereHelperint64
-	UpdatedAt       whereHelperint64
-	ArchivedAt      whereHelpernull_Int64
-	Balance         whereHelperfloat64
-	LastPaymentDate whereHelperint64
+	ID                whereHelperstring
+	BranchID          whereHelperstring
+	Number            whereHelperstring
+	CustomerID        whereHelperstring
+	AccountType       whereHelperstring
+	Target            whereHelperint64
+	TargetInfo        whereHelperstring
+	SalesRepID        whereHelperstring
+	CreatedAt         whereHelperint64
+	UpdatedAt         whereHelperint64
+	ArchivedAt        whereHelpernull_Int64
+	Balance           whereHelperint64
+	LastPaymentDate   whereHelperint64
+	ProductID         whereHelperstring
+	TenorDays         whereHelperint
+	InterestRateBPS   whereHelperint
+	MaturityDate      whereHelpernull_Int64
+	RollOver          whereHelperbool
+	AccruedInterest   whereHelperint64
+	InterestAccruedTo whereHelpernull_Int64
+	MaturedAt         whereHelpernull_Int64
+	GoalMilestone     whereHelperint
+	Status            whereHelperstring
 }{
-	ID:              whereHelperstring{field: "\"account\".\"id\""},
-	BranchID:        whereHelperstring{field: "\"account\".\"branch_id\""},
-	Number:          whereHelperstring{field: "\"account\".\"number\""},
-	CustomerID:      whereHelperstring{field: "\"account\".\"customer_id\""},
-	AccountType:     whereHelperstring{field: "\"account\".\"account_type\""},
-	Target:          whereHelperfloat64{field: "\"account\".\"target\""},
-	TargetInfo:      whereHelperstring{field: "\"account\".\"target_info\""},
-	SalesRepID:      whereHelperstring{field: "\"account\".\"sales_rep_id\""},
-	CreatedAt:       whereHelperint64{field: "\"account\".\"created_at\""},
-	UpdatedAt:       whereHelperint64{field: "\"account\".\"updated_at\""},
-	ArchivedAt:      whereHelpernull_Int64{field: "\"account\".\"archived_at\""},
-	Balance:         whereHelperfloat64{field: "\"account\".\"balance\""},
-	LastPaymentDate: whereHelperint64{field: "\"account\".\"last_payment_date\""},
+	ID:                whereHelperstring{field: "\"account\".\"id\""},
+	BranchID:          whereHelperstring{field: "\"account\".\"branch_id\""},
+	Number:            whereHelperstring{field: "\"account\".\"number\""},
+	CustomerID:        whereHelperstring{field: "\"account\".\"customer_id\""},
+	AccountType:       whereHelperstring{field: "\"account\".\"account_type\""},
+	Target:            whereHelperint64{field: "\"account\".\"target\""},
+	TargetInfo:        whereHelperstring{field: "\"account\".\"target_info\""},
+	SalesRepID:        whereHelperstring{field: "\"account\".\"sales_rep_id\""},
+	CreatedAt:         whereHelperint64{field: "\"account\".\"created_at\""},
+	UpdatedAt:         whereHelperint64{field: "\"account\".\"updated_at\""},
+	ArchivedAt:        whereHelpernull_Int64{field: "\"account\".\"archived_at\""},
+	Balance:           whereHelperint64{field: "\"account\".\"balance\""},
+	LastPaymentDate:   whereHelperint64{field: "\"account\".\"last_payment_date\""},
+	ProductID:         whereHelperstring{field: "\"account\".\"product_id\""},
+	TenorDays:         whereHelperint{field: "\"account\".\"tenor_days\""},
+	InterestRateBPS:   whereHelperint{field: "\"account\".\"interest_rate_bps\""},
+	MaturityDate:      whereHelpernull_Int64{field: "\"account\".\"maturity_date\""},
+	RollOver:          whereHelperbool{field: "\"account\".\"roll_over\""},
+	AccruedInterest:   whereHelperint64{field: "\"account\".\"accrued_interest\""},
+	InterestAccruedTo: whereHelpernull_Int64{field: "\"account\".\"interest_accrued_to\""},
+	MaturedAt:         whereHelpernull_Int64{field: "\"account\".\"matured_at\""},
+	GoalMilestone:     whereHelperint{field: "\"account\".\"goal_milestone\""},
+	Status:            whereHelperstring{field: "\"account\".\"status\""},
 }
 
 // AccountRels is where relationship names are stored.
 var AccountRels = struct {
-	Branch        string
-	Customer      string
-	SalesRep      string
-	DSCommissions string
-	Transactions  string
+	Branch               string
+	Customer             string
+	Product              string
+	SalesRep             string
+	AccountFollowUps     string
+	AccountHolds         string
+	AccountStatusChanges string
+	Approvals            string
+	ToAccountApprovals   string
+	DSCommissions        string
+	DSCycles             string
+	ImportRows           string
+	InboundMessages      string
+	InterestAccruals     string
+	Loans                string
+	Postings             string
+	CreditAccountSales   string
+	Transactions         string
+	FromAccountTransfers string
+	ToAccountTransfers   string
+	WithdrawalRequests   string
 }{
-	Branch:        "Branch",
-	Customer:      "Customer",
-	SalesRep:      "SalesRep",
-	DSCommissions: "DSCommissions",
-	Transactions:  "Transactions",
+	Branch:               "Branch",
+	Customer:             "Customer",
+	Product:              "Product",
+	SalesRep:             "SalesRep",
+	AccountFollowUps:     "AccountFollowUps",
+	AccountHolds:         "AccountHolds",
+	AccountStatusChanges: "AccountStatusChanges",
+	Approvals:            "Approvals",
+	ToAccountApprovals:   "ToAccountApprovals",
+	DSCommissions:        "DSCommissions",
+	DSCycles:             "DSCycles",
+	ImportRows:           "ImportRows",
+	InboundMessages:      "InboundMessages",
+	InterestAccruals:     "InterestAccruals",
+	Loans:                "Loans",
+	Postings:             "Postings",
+	CreditAccountSales:   "CreditAccountSales",
+	Transactions:         "Transactions",
+	FromAccountTransfers: "FromAccountTransfers",
+	ToAccountTransfers:   "ToAccountTransfers",
+	WithdrawalRequests:   "WithdrawalRequests",
 }
 
 // accountR is where relationships are stored.
 type accountR struct {
-	Branch        *Branch           `boil:"Branch" json:"Branch" toml:"Branch" yaml:"Branch"`
-	Customer      *Customer         `boil:"Customer" json:"Customer" toml:"Customer" yaml:"Customer"`
-	SalesRep      *User             `boil:"SalesRep" json:"SalesRep" toml:"SalesRep" yaml:"SalesRep"`
-	DSCommissions DSCommissionSlice `boil:"DSCommissions" json:"DSCommissions" toml:"DSCommissions" yaml:"DSCommissions"`
-	Transactions  TransactionSlice  `boil:"Transactions" json:"Transactions" toml:"Transactions" yaml:"Transactions"`
+	Branch               *Branch                  `boil:"Branch" json:"Branch" toml:"Branch" yaml:"Branch"`
+	Customer             *Customer                `boil:"Customer" json:"Customer" toml:"Customer" yaml:"Customer"`
+	Product              *AccountProduct          `boil:"Product" json:"Product" toml:"Product" yaml:"Product"`
+	SalesRep             *User                    `boil:"SalesRep" json:"SalesRep" toml:"SalesRep" yaml:"SalesRep"`
+	AccountFollowUps     AccountFollowUpSlice     `boil:"AccountFollowUps" json:"AccountFollowUps" toml:"AccountFollowUps" yaml:"AccountFollowUps"`
+	AccountHolds         AccountHoldSlice         `boil:"AccountHolds" json:"AccountHolds" toml:"AccountHolds" yaml:"AccountHolds"`
+	AccountStatusChanges AccountStatusChangeSlice `boil:"AccountStatusChanges" json:"AccountStatusChanges" toml:"AccountStatusChanges" yaml:"AccountStatusChanges"`
+	Approvals            ApprovalSlice            `boil:"Approvals" json:"Approvals" toml:"Approvals" yaml:"Approvals"`
+	ToAccountApprovals   ApprovalSlice            `boil:"ToAccountApprovals" json:"ToAccountApprovals" toml:"ToAccountApprovals" yaml:"ToAccountApprovals"`
+	DSCommissions        DSCommissionSlice        `boil:"DSCommissions" json:"DSCommissions" toml:"DSCommissions" yaml:"DSCommissions"`
+	DSCycles             DSCycleSlice             `boil:"DSCycles" json:"DSCycles" toml:"DSCycles" yaml:"DSCycles"`
+	ImportRows           ImportRowSlice           `boil:"ImportRows" json:"ImportRows" toml:"ImportRows" yaml:"ImportRows"`
+	InboundMessages      InboundMessageSlice      `boil:"InboundMessages" json:"InboundMessages" toml:"InboundMessages" yaml:"InboundMessages"`
+	InterestAccruals     InterestAccrualSlice     `boil:"InterestAccruals" json:"InterestAccruals" toml:"InterestAccruals" yaml:"InterestAccruals"`
+	Loans                LoanSlice                `boil:"Loans" json:"Loans" toml:"Loans" yaml:"Loans"`
+	Postings             PostingSlice             `boil:"Postings" json:"Postings" toml:"Postings" yaml:"Postings"`
+	CreditAccountSales   SaleSlice                `boil:"CreditAccountSales" json:"CreditAccountSales" toml:"CreditAccountSales" yaml:"CreditAccountSales"`
+	Transactions         TransactionSlice         `boil:"Transactions" json:"Transactions" toml:"Transactions" yaml:"Transactions"`
+	FromAccountTransfers TransferSlice            `boil:"FromAccountTransfers" json:"FromAccountTransfers" toml:"FromAccountTransfers" yaml:"FromAccountTransfers"`
+	ToAccountTransfers   TransferSlice            `boil:"ToAccountTransfers" json:"ToAccountTransfers" toml:"ToAccountTransfers" yaml:"ToAccountTransfers"`
+	WithdrawalRequests   WithdrawalRequestSlice   `boil:"WithdrawalRequests" json:"WithdrawalRequests" toml:"WithdrawalRequests" yaml:"WithdrawalRequests"`
 }
 
 // NewStruct creates a new relationship struct
@@ -265,9 +386,9 @@ func (*accountR) NewStruct() *accountR {
 type accountL struct{}
 
 var (
-	accountAllColumns            = []string{"id", "branch_id", "number", "customer_id", "account_type", "target", "target_info", "sales_rep_id", "created_at", "updated_at", "archived_at", "balance", "last_payment_date"}
-	accountColumnsWithoutDefault = []string{"id", "number", "account_type", "sales_rep_id", "created_at", "updated_at", "archived_at"}
-	accountColumnsWithDefault    = []string{"branch_id", "customer_id", "target", "target_info", "balance", "last_payment_date"}
+	accountAllColumns            = []string{"id", "branch_id", "number", "customer_id", "account_type", "target", "target_info", "sales_rep_id", "created_at", "updated_at", "archived_at", "balance", "last_payment_date", "product_id", "tenor_days", "interest_rate_bps", "maturity_date", "roll_over", "accrued_interest", "interest_accrued_to", "matured_at", "goal_milestone", "status"}
+	accountColumnsWithoutDefault = []string{"id", "number", "account_type", "sales_rep_id", "created_at", "updated_at", "archived_at", "product_id"}
+	accountColumnsWithDefault    = []string{"branch_id", "customer_id", "target", "target_info", "balance", "last_payment_date", "tenor_days", "interest_rate_bps", "maturity_date", "roll_over", "accrued_interest", "interest_accrued_to", "matured_at", "goal_milestone", "status"}
 	accountPrimaryKeyColumns     = []string{"id"}
 )
 
@@ -390,6 +511,20 @@ func (o *Account) Customer(mods ...qm.QueryMod) customerQuery {
 	return query
 }
 
+// Product pointed to by the foreign key.
+func (o *Account) Product(mods ...qm.QueryMod) accountProductQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.ProductID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	query := AccountProducts(queryMods...)
+	queries.SetFrom(query.Query, "\"account_product\"")
+
+	return query
+}
+
 // SalesRep pointed to by the foreign key.
 func (o *Account) SalesRep(mods ...qm.QueryMod) userQuery {
 	queryMods := []qm.QueryMod{
@@ -404,243 +539,366 @@ func (o *Account) SalesRep(mods ...qm.QueryMod) userQuery {
 	return query
 }
 
-// DSCommissions retrieves all the ds_commission's DSCommissions with an executor.
-func (o *Account) DSCommissions(mods ...qm.QueryMod) dsCommissionQuery {
+// AccountFollowUps retrieves all the account_follow_up's AccountFollowUps with an executor.
+func (o *Account) AccountFollowUps(mods ...qm.QueryMod) accountFollowUpQuery {
 	var queryMods []qm.QueryMod
 	if len(mods) != 0 {
 		queryMods = append(queryMods, mods...)
 	}
 
 	queryMods = append(queryMods,
-		qm.Where("\"ds_commission\".\"account_id\"=?", o.ID),
+		qm.Where("\"account_follow_up\".\"account_id\"=?", o.ID),
 	)
 
-	query := DSCommissions(queryMods...)
-	queries.SetFrom(query.Query, "\"ds_commission\"")
+	query := AccountFollowUps(queryMods...)
+	queries.SetFrom(query.Query, "\"account_follow_up\"")
 
 	if len(queries.GetSelect(query.Query)) == 0 {
-		queries.SetSelect(query.Query, []string{"\"ds_commission\".*"})
+		queries.SetSelect(query.Query, []string{"\"account_follow_up\".*"})
 	}
 
 	return query
 }
 
-// Transactions retrieves all the transaction's Transactions with an executor.
-func (o *Account) Transactions(mods ...qm.QueryMod) transactionQuery {
+// AccountHolds retrieves all the account_hold's AccountHolds with an executor.
+func (o *Account) AccountHolds(mods ...qm.QueryMod) accountHoldQuery {
 	var queryMods []qm.QueryMod
 	if len(mods) != 0 {
 		queryMods = append(queryMods, mods...)
 	}
 
 	queryMods = append(queryMods,
-		qm.Where("\"transaction\".\"account_id\"=?", o.ID),
+		qm.Where("\"account_hold\".\"account_id\"=?", o.ID),
 	)
 
-	query := Transactions(queryMods...)
-	queries.SetFrom(query.Query, "\"transaction\"")
+	query := AccountHolds(queryMods...)
+	queries.SetFrom(query.Query, "\"account_hold\"")
 
 	if len(queries.GetSelect(query.Query)) == 0 {
-		queries.SetSelect(query.Query, []string{"\"transaction\".*"})
+		queries.SetSelect(query.Query, []string{"\"account_hold\".*"})
 	}
 
 	return query
 }
 
-// LoadBranch allows an eager lookup of values, cached into the
-// loaded structs of the objects. This is for an N-1 relationship.
-func (accountL) LoadBranch(ctx context.Context, e boil.ContextExecutor, singular bool, maybeAccount interface{}, mods queries.Applicator) error {
-	var slice []*Account
-	var object *Account
+// AccountStatusChanges retrieves all the account_status_change's AccountStatusChanges with an executor.
+func (o *Account) AccountStatusChanges(mods ...qm.QueryMod) accountStatusChangeQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
 
-	if singular {
-		object = maybeAccount.(*Account)
-	} else {
-		slice = *maybeAccount.(*[]*Account)
+	queryMods = append(queryMods,
+		qm.Where("\"account_status_change\".\"account_id\"=?", o.ID),
+	)
+
+	query := AccountStatusChanges(queryMods...)
+	queries.SetFrom(query.Query, "\"account_status_change\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"account_status_change\".*"})
 	}
 
-	args := make([]interface{}, 0, 1)
-	if singular {
-		if object.R == nil {
-			object.R = &accountR{}
-		}
-		args = append(args, object.BranchID)
+	return query
+}
 
-	} else {
-	Outer:
-		for _, obj := range slice {
-			if obj.R == nil {
-				obj.R = &accountR{}
-			}
+// Approvals retrieves all the approval's Approvals with an executor.
+func (o *Account) Approvals(mods ...qm.QueryMod) approvalQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
 
-			for _, a := range args {
-				if a == obj.BranchID {
-					continue Outer
-				}
-			}
+	queryMods = append(queryMods,
+		qm.Where("\"approval\".\"account_id\"=?", o.ID),
+	)
 
-			args = append(args, obj.BranchID)
+	query := Approvals(queryMods...)
+	queries.SetFrom(query.Query, "\"approval\"")
 
-		}
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"approval\".*"})
 	}
 
-	if len(args) == 0 {
-		return nil
+	return query
+}
+
+// ToAccountApprovals retrieves all the approval's Approvals with an executor via to_account_id column.
+func (o *Account) ToAccountApprovals(mods ...qm.QueryMod) approvalQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
 	}
 
-	query := NewQuery(
-		qm.From(`branch`),
-		qm.WhereIn(`branch.id in ?`, args...),
+	queryMods = append(queryMods,
+		qm.Where("\"approval\".\"to_account_id\"=?", o.ID),
 	)
-	if mods != nil {
-		mods.Apply(query)
-	}
 
-	results, err := query.QueryContext(ctx, e)
-	if err != nil {
-		return errors.Wrap(err, "failed to eager load Branch")
-	}
+	query := Approvals(queryMods...)
+	queries.SetFrom(query.Query, "\"approval\"")
 
-	var resultSlice []*Branch
-	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice Branch")
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"approval\".*"})
 	}
 
-	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results of eager load for branch")
-	}
-	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for branch")
-	}
+	return query
+}
 
-	if len(resultSlice) == 0 {
-		return nil
+// DSCommissions retrieves all the ds_commission's DSCommissions with an executor.
+func (o *Account) DSCommissions(mods ...qm.QueryMod) dsCommissionQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
 	}
 
-	if singular {
-		foreign := resultSlice[0]
-		object.R.Branch = foreign
-		if foreign.R == nil {
-			foreign.R = &branchR{}
-		}
-		foreign.R.Accounts = append(foreign.R.Accounts, object)
-		return nil
-	}
+	queryMods = append(queryMods,
+		qm.Where("\"ds_commission\".\"account_id\"=?", o.ID),
+	)
 
-	for _, local := range slice {
-		for _, foreign := range resultSlice {
-			if local.BranchID == foreign.ID {
-				local.R.Branch = foreign
-				if foreign.R == nil {
-					foreign.R = &branchR{}
-				}
-				foreign.R.Accounts = append(foreign.R.Accounts, local)
-				break
-			}
-		}
+	query := DSCommissions(queryMods...)
+	queries.SetFrom(query.Query, "\"ds_commission\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"ds_commission\".*"})
 	}
 
-	return nil
+	return query
 }
 
-// LoadCustomer allows an eager lookup of values, cached into the
-// loaded structs of the objects. This is for an N-1 relationship.
-func (accountL) LoadCustomer(ctx context.Context, e boil.ContextExecutor, singular bool, maybeAccount interface{}, mods queries.Applicator) error {
-	var slice []*Account
-	var object *Account
+// DSCycles retrieves all the ds_cycle's DSCycles with an executor.
+func (o *Account) DSCycles(mods ...qm.QueryMod) dsCycleQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
 
-	if singular {
-		object = maybeAccount.(*Account)
-	} else {
-		slice = *maybeAccount.(*[]*Account)
+	queryMods = append(queryMods,
+		qm.Where("\"ds_cycle\".\"account_id\"=?", o.ID),
+	)
+
+	query := DSCycles(queryMods...)
+	queries.SetFrom(query.Query, "\"ds_cycle\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"ds_cycle\".*"})
 	}
 
-	args := make([]interface{}, 0, 1)
-	if singular {
-		if object.R == nil {
-			object.R = &accountR{}
-		}
-		args = append(args, object.CustomerID)
+	return query
+}
 
-	} else {
-	Outer:
-		for _, obj := range slice {
-			if obj.R == nil {
-				obj.R = &accountR{}
-			}
+// ImportRows retrieves all the import_row's ImportRows with an executor.
+func (o *Account) ImportRows(mods ...qm.QueryMod) importRowQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
 
-			for _, a := range args {
-				if a == obj.CustomerID {
-					continue Outer
-				}
-			}
+	queryMods = append(queryMods,
+		qm.Where("\"import_row\".\"account_id\"=?", o.ID),
+	)
 
-			args = append(args, obj.CustomerID)
+	query := ImportRows(queryMods...)
+	queries.SetFrom(query.Query, "\"import_row\"")
 
-		}
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"import_row\".*"})
 	}
 
-	if len(args) == 0 {
-		return nil
+	return query
+}
+
+// InboundMessages retrieves all the inbound_message's InboundMessages with an executor.
+func (o *Account) InboundMessages(mods ...qm.QueryMod) inboundMessageQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
 	}
 
-	query := NewQuery(
-		qm.From(`customer`),
-		qm.WhereIn(`customer.id in ?`, args...),
+	queryMods = append(queryMods,
+		qm.Where("\"inbound_message\".\"account_id\"=?", o.ID),
 	)
-	if mods != nil {
-		mods.Apply(query)
-	}
 
-	results, err := query.QueryContext(ctx, e)
-	if err != nil {
-		return errors.Wrap(err, "failed to eager load Customer")
-	}
+	query := InboundMessages(queryMods...)
+	queries.SetFrom(query.Query, "\"inbound_message\"")
 
-	var resultSlice []*Customer
-	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice Customer")
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"inbound_message\".*"})
 	}
 
-	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results of eager load for customer")
-	}
-	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for customer")
-	}
+	return query
+}
 
-	if len(resultSlice) == 0 {
-		return nil
+// InterestAccruals retrieves all the interest_accrual's InterestAccruals with an executor.
+func (o *Account) InterestAccruals(mods ...qm.QueryMod) interestAccrualQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
 	}
 
-	if singular {
-		foreign := resultSlice[0]
-		object.R.Customer = foreign
-		if foreign.R == nil {
-			foreign.R = &customerR{}
-		}
-		foreign.R.Accounts = append(foreign.R.Accounts, object)
-		return nil
+	queryMods = append(queryMods,
+		qm.Where("\"interest_accrual\".\"account_id\"=?", o.ID),
+	)
+
+	query := InterestAccruals(queryMods...)
+	queries.SetFrom(query.Query, "\"interest_accrual\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"interest_accrual\".*"})
 	}
 
-	for _, local := range slice {
-		for _, foreign := range resultSlice {
-			if local.CustomerID == foreign.ID {
-				local.R.Customer = foreign
-				if foreign.R == nil {
-					foreign.R = &customerR{}
-				}
-				foreign.R.Accounts = append(foreign.R.Accounts, local)
-				break
-			}
-		}
+	return query
+}
+
+// Loans retrieves all the loan's Loans with an executor.
+func (o *Account) Loans(mods ...qm.QueryMod) loanQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
 	}
 
-	return nil
+	queryMods = append(queryMods,
+		qm.Where("\"loan\".\"account_id\"=?", o.ID),
+	)
+
+	query := Loans(queryMods...)
+	queries.SetFrom(query.Query, "\"loan\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"loan\".*"})
+	}
+
+	return query
 }
 
-// LoadSalesRep allows an eager lookup of values, cached into the
+// Postings retrieves all the posting's Postings with an executor.
+func (o *Account) Postings(mods ...qm.QueryMod) postingQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"posting\".\"account_id\"=?", o.ID),
+	)
+
+	query := Postings(queryMods...)
+	queries.SetFrom(query.Query, "\"posting\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"posting\".*"})
+	}
+
+	return query
+}
+
+// CreditAccountSales retrieves all the sale's Sales with an executor via credit_account_id column.
+func (o *Account) CreditAccountSales(mods ...qm.QueryMod) saleQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"sale\".\"credit_account_id\"=?", o.ID),
+	)
+
+	query := Sales(queryMods...)
+	queries.SetFrom(query.Query, "\"sale\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"sale\".*"})
+	}
+
+	return query
+}
+
+// Transactions retrieves all the transaction's Transactions with an executor.
+func (o *Account) Transactions(mods ...qm.QueryMod) transactionQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"transaction\".\"account_id\"=?", o.ID),
+	)
+
+	query := Transactions(queryMods...)
+	queries.SetFrom(query.Query, "\"transaction\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"transaction\".*"})
+	}
+
+	return query
+}
+
+// FromAccountTransfers retrieves all the transfer's Transfers with an executor via from_account_id column.
+func (o *Account) FromAccountTransfers(mods ...qm.QueryMod) transferQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"transfer\".\"from_account_id\"=?", o.ID),
+	)
+
+	query := Transfers(queryMods...)
+	queries.SetFrom(query.Query, "\"transfer\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"transfer\".*"})
+	}
+
+	return query
+}
+
+// ToAccountTransfers retrieves all the transfer's Transfers with an executor via to_account_id column.
+func (o *Account) ToAccountTransfers(mods ...qm.QueryMod) transferQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"transfer\".\"to_account_id\"=?", o.ID),
+	)
+
+	query := Transfers(queryMods...)
+	queries.SetFrom(query.Query, "\"transfer\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"transfer\".*"})
+	}
+
+	return query
+}
+
+// WithdrawalRequests retrieves all the withdrawal_request's WithdrawalRequests with an executor.
+func (o *Account) WithdrawalRequests(mods ...qm.QueryMod) withdrawalRequestQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"withdrawal_request\".\"account_id\"=?", o.ID),
+	)
+
+	query := WithdrawalRequests(queryMods...)
+	queries.SetFrom(query.Query, "\"withdrawal_request\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"withdrawal_request\".*"})
+	}
+
+	return query
+}
+
+// LoadBranch allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for an N-1 relationship.
-func (accountL) LoadSalesRep(ctx context.Context, e boil.ContextExecutor, singular bool, maybeAccount interface{}, mods queries.Applicator) error {
+func (accountL) LoadBranch(ctx context.Context, e boil.ContextExecutor, singular bool, maybeAccount interface{}, mods queries.Applicator) error {
 	var slice []*Account
 	var object *Account
 
@@ -655,7 +913,7 @@ func (accountL) LoadSalesRep(ctx context.Context, e boil.ContextExecutor, singul
 		if object.R == nil {
 			object.R = &accountR{}
 		}
-		args = append(args, object.SalesRepID)
+		args = append(args, object.BranchID)
 
 	} else {
 	Outer:
@@ -665,12 +923,12 @@ func (accountL) LoadSalesRep(ctx context.Context, e boil.ContextExecutor, singul
 			}
 
 			for _, a := range args {
-				if a == obj.SalesRepID {
+				if a == obj.BranchID {
 					continue Outer
 				}
 			}
 
-			args = append(args, obj.SalesRepID)
+			args = append(args, obj.BranchID)
 
 		}
 	}
@@ -680,8 +938,8 @@ func (accountL) LoadSalesRep(ctx context.Context, e boil.ContextExecutor, singul
 	}
 
 	query := NewQuery(
-		qm.From(`users`),
-		qm.WhereIn(`users.id in ?`, args...),
+		qm.From(`branch`),
+		qm.WhereIn(`branch.id in ?`, args...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -689,19 +947,19 @@ func (accountL) LoadSalesRep(ctx context.Context, e boil.ContextExecutor, singul
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load User")
+		return errors.Wrap(err, "failed to eager load Branch")
 	}
 
-	var resultSlice []*User
+	var resultSlice []*Branch
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice User")
+		return errors.Wrap(err, "failed to bind eager loaded slice Branch")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results of eager load for users")
+		return errors.Wrap(err, "failed to close results of eager load for branch")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for users")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for branch")
 	}
 
 	if len(resultSlice) == 0 {
@@ -710,22 +968,22 @@ func (accountL) LoadSalesRep(ctx context.Context, e boil.ContextExecutor, singul
 
 	if singular {
 		foreign := resultSlice[0]
-		object.R.SalesRep = foreign
+		object.R.Branch = foreign
 		if foreign.R == nil {
-			foreign.R = &userR{}
+			foreign.R = &branchR{}
 		}
-		foreign.R.SalesRepAccounts = append(foreign.R.SalesRepAccounts, object)
+		foreign.R.Accounts = append(foreign.R.Accounts, object)
 		return nil
 	}
 
 	for _, local := range slice {
 		for _, foreign := range resultSlice {
-			if local.SalesRepID == foreign.ID {
-				local.R.SalesRep = foreign
+			if local.BranchID == foreign.ID {
+				local.R.Branch = foreign
 				if foreign.R == nil {
-					foreign.R = &userR{}
+					foreign.R = &branchR{}
 				}
-				foreign.R.SalesRepAccounts = append(foreign.R.SalesRepAccounts, local)
+				foreign.R.Accounts = append(foreign.R.Accounts, local)
 				break
 			}
 		}
@@ -734,9 +992,9 @@ func (accountL) LoadSalesRep(ctx context.Context, e boil.ContextExecutor, singul
 	return nil
 }
 
-// LoadDSCommissions allows an eager lookup of values, cached into the
-// loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (accountL) LoadDSCommissions(ctx context.Context, e boil.ContextExecutor, singular bool, maybeAccount interface{}, mods queries.Applicator) error {
+// LoadCustomer allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (accountL) LoadCustomer(ctx context.Context, e boil.ContextExecutor, singular bool, maybeAccount interface{}, mods queries.Applicator) error {
 	var slice []*Account
 	var object *Account
 
@@ -751,7 +1009,8 @@ func (accountL) LoadDSCommissions(ctx context.Context, e boil.ContextExecutor, s
 		if object.R == nil {
 			object.R = &accountR{}
 		}
-		args = append(args, object.ID)
+		args = append(args, object.CustomerID)
+
 	} else {
 	Outer:
 		for _, obj := range slice {
@@ -760,12 +1019,13 @@ func (accountL) LoadDSCommissions(ctx context.Context, e boil.ContextExecutor, s
 			}
 
 			for _, a := range args {
-				if a == obj.ID {
+				if a == obj.CustomerID {
 					continue Outer
 				}
 			}
 
-			args = append(args, obj.ID)
+			args = append(args, obj.CustomerID)
+
 		}
 	}
 
@@ -774,8 +1034,8 @@ func (accountL) LoadDSCommissions(ctx context.Context, e boil.ContextExecutor, s
 	}
 
 	query := NewQuery(
-		qm.From(`ds_commission`),
-		qm.WhereIn(`ds_commission.account_id in ?`, args...),
+		qm.From(`customer`),
+		qm.WhereIn(`customer.id in ?`, args...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -783,40 +1043,43 @@ func (accountL) LoadDSCommissions(ctx context.Context, e boil.ContextExecutor, s
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load ds_commission")
+		return errors.Wrap(err, "failed to eager load Customer")
 	}
 
-	var resultSlice []*DSCommission
+	var resultSlice []*Customer
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice ds_commission")
+		return errors.Wrap(err, "failed to bind eager loaded slice Customer")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on ds_commission")
+		return errors.Wrap(err, "failed to close results of eager load for customer")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for ds_commission")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for customer")
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
 	}
 
 	if singular {
-		object.R.DSCommissions = resultSlice
-		for _, foreign := range resultSlice {
-			if foreign.R == nil {
-				foreign.R = &dsCommissionR{}
-			}
-			foreign.R.Account = object
+		foreign := resultSlice[0]
+		object.R.Customer = foreign
+		if foreign.R == nil {
+			foreign.R = &customerR{}
 		}
+		foreign.R.Accounts = append(foreign.R.Accounts, object)
 		return nil
 	}
 
-	for _, foreign := range resultSlice {
-		for _, local := range slice {
-			if local.ID == foreign.AccountID {
-				local.R.DSCommissions = append(local.R.DSCommissions, foreign)
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.CustomerID == foreign.ID {
+				local.R.Customer = foreign
 				if foreign.R == nil {
-					foreign.R = &dsCommissionR{}
+					foreign.R = &customerR{}
 				}
-				foreign.R.Account = local
+				foreign.R.Accounts = append(foreign.R.Accounts, local)
 				break
 			}
 		}
@@ -825,9 +1088,9 @@ func (accountL) LoadDSCommissions(ctx context.Context, e boil.ContextExecutor, s
 	return nil
 }
 
-// LoadTransactions allows an eager lookup of values, cached into the
-// loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (accountL) LoadTransactions(ctx context.Context, e boil.ContextExecutor, singular bool, maybeAccount interface{}, mods queries.Applicator) error {
+// LoadProduct allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (accountL) LoadProduct(ctx context.Context, e boil.ContextExecutor, singular bool, maybeAccount interface{}, mods queries.Applicator) error {
 	var slice []*Account
 	var object *Account
 
@@ -842,7 +1105,8 @@ func (accountL) LoadTransactions(ctx context.Context, e boil.ContextExecutor, si
 		if object.R == nil {
 			object.R = &accountR{}
 		}
-		args = append(args, object.ID)
+		args = append(args, object.ProductID)
+
 	} else {
 	Outer:
 		for _, obj := range slice {
@@ -851,12 +1115,13 @@ func (accountL) LoadTransactions(ctx context.Context, e boil.ContextExecutor, si
 			}
 
 			for _, a := range args {
-				if a == obj.ID {
+				if a == obj.ProductID {
 					continue Outer
 				}
 			}
 
-			args = append(args, obj.ID)
+			args = append(args, obj.ProductID)
+
 		}
 	}
 
@@ -865,8 +1130,8 @@ func (accountL) LoadTransactions(ctx context.Context, e boil.ContextExecutor, si
 	}
 
 	query := NewQuery(
-		qm.From(`transaction`),
-		qm.WhereIn(`transaction.account_id in ?`, args...),
+		qm.From(`account_product`),
+		qm.WhereIn(`account_product.id in ?`, args...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -874,206 +1139,3064 @@ func (accountL) LoadTransactions(ctx context.Context, e boil.ContextExecutor, si
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load transaction")
+		return errors.Wrap(err, "failed to eager load AccountProduct")
 	}
 
-	var resultSlice []*Transaction
+	var resultSlice []*AccountProduct
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice transaction")
+		return errors.Wrap(err, "failed to bind eager loaded slice AccountProduct")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on transaction")
+		return errors.Wrap(err, "failed to close results of eager load for account_product")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for transaction")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for account_product")
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
 	}
 
 	if singular {
-		object.R.Transactions = resultSlice
-		for _, foreign := range resultSlice {
-			if foreign.R == nil {
-				foreign.R = &transactionR{}
-			}
-			foreign.R.Account = object
+		foreign := resultSlice[0]
+		object.R.Product = foreign
+		if foreign.R == nil {
+			foreign.R = &accountProductR{}
 		}
+		foreign.R.ProductAccounts = append(foreign.R.ProductAccounts, object)
 		return nil
 	}
 
-	for _, foreign := range resultSlice {
-		for _, local := range slice {
-			if local.ID == foreign.AccountID {
-				local.R.Transactions = append(local.R.Transactions, foreign)
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.ProductID == foreign.ID {
+				local.R.Product = foreign
 				if foreign.R == nil {
-					foreign.R = &transactionR{}
+					foreign.R = &accountProductR{}
+				}
+				foreign.R.ProductAccounts = append(foreign.R.ProductAccounts, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadSalesRep allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (accountL) LoadSalesRep(ctx context.Context, e boil.ContextExecutor, singular bool, maybeAccount interface{}, mods queries.Applicator) error {
+	var slice []*Account
+	var object *Account
+
+	if singular {
+		object = maybeAccount.(*Account)
+	} else {
+		slice = *maybeAccount.(*[]*Account)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &accountR{}
+		}
+		args = append(args, object.SalesRepID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &accountR{}
+			}
+
+			for _, a := range args {
+				if a == obj.SalesRepID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.SalesRepID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`users`),
+		qm.WhereIn(`users.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load User")
+	}
+
+	var resultSlice []*User
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice User")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for users")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for users")
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.SalesRep = foreign
+		if foreign.R == nil {
+			foreign.R = &userR{}
+		}
+		foreign.R.SalesRepAccounts = append(foreign.R.SalesRepAccounts, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.SalesRepID == foreign.ID {
+				local.R.SalesRep = foreign
+				if foreign.R == nil {
+					foreign.R = &userR{}
+				}
+				foreign.R.SalesRepAccounts = append(foreign.R.SalesRepAccounts, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadAccountFollowUps allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (accountL) LoadAccountFollowUps(ctx context.Context, e boil.ContextExecutor, singular bool, maybeAccount interface{}, mods queries.Applicator) error {
+	var slice []*Account
+	var object *Account
+
+	if singular {
+		object = maybeAccount.(*Account)
+	} else {
+		slice = *maybeAccount.(*[]*Account)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &accountR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &accountR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`account_follow_up`),
+		qm.WhereIn(`account_follow_up.account_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load account_follow_up")
+	}
+
+	var resultSlice []*AccountFollowUp
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice account_follow_up")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on account_follow_up")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for account_follow_up")
+	}
+
+	if singular {
+		object.R.AccountFollowUps = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &accountFollowUpR{}
+			}
+			foreign.R.Account = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.AccountID {
+				local.R.AccountFollowUps = append(local.R.AccountFollowUps, foreign)
+				if foreign.R == nil {
+					foreign.R = &accountFollowUpR{}
+				}
+				foreign.R.Account = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadAccountHolds allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (accountL) LoadAccountHolds(ctx context.Context, e boil.ContextExecutor, singular bool, maybeAccount interface{}, mods queries.Applicator) error {
+	var slice []*Account
+	var object *Account
+
+	if singular {
+		object = maybeAccount.(*Account)
+	} else {
+		slice = *maybeAccount.(*[]*Account)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &accountR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &accountR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`account_hold`),
+		qm.WhereIn(`account_hold.account_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load account_hold")
+	}
+
+	var resultSlice []*AccountHold
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice account_hold")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on account_hold")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for account_hold")
+	}
+
+	if singular {
+		object.R.AccountHolds = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &accountHoldR{}
+			}
+			foreign.R.Account = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.AccountID {
+				local.R.AccountHolds = append(local.R.AccountHolds, foreign)
+				if foreign.R == nil {
+					foreign.R = &accountHoldR{}
 				}
 				foreign.R.Account = local
 				break
 			}
 		}
-	}
+	}
+
+	return nil
+}
+
+// LoadAccountStatusChanges allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (accountL) LoadAccountStatusChanges(ctx context.Context, e boil.ContextExecutor, singular bool, maybeAccount interface{}, mods queries.Applicator) error {
+	var slice []*Account
+	var object *Account
+
+	if singular {
+		object = maybeAccount.(*Account)
+	} else {
+		slice = *maybeAccount.(*[]*Account)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &accountR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &accountR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`account_status_change`),
+		qm.WhereIn(`account_status_change.account_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load account_status_change")
+	}
+
+	var resultSlice []*AccountStatusChange
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice account_status_change")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on account_status_change")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for account_status_change")
+	}
+
+	if singular {
+		object.R.AccountStatusChanges = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &accountStatusChangeR{}
+			}
+			foreign.R.Account = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.AccountID {
+				local.R.AccountStatusChanges = append(local.R.AccountStatusChanges, foreign)
+				if foreign.R == nil {
+					foreign.R = &accountStatusChangeR{}
+				}
+				foreign.R.Account = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadApprovals allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (accountL) LoadApprovals(ctx context.Context, e boil.ContextExecutor, singular bool, maybeAccount interface{}, mods queries.Applicator) error {
+	var slice []*Account
+	var object *Account
+
+	if singular {
+		object = maybeAccount.(*Account)
+	} else {
+		slice = *maybeAccount.(*[]*Account)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &accountR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &accountR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`approval`),
+		qm.WhereIn(`approval.account_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load approval")
+	}
+
+	var resultSlice []*Approval
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice approval")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on approval")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for approval")
+	}
+
+	if singular {
+		object.R.Approvals = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &approvalR{}
+			}
+			foreign.R.Account = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.AccountID {
+				local.R.Approvals = append(local.R.Approvals, foreign)
+				if foreign.R == nil {
+					foreign.R = &approvalR{}
+				}
+				foreign.R.Account = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadToAccountApprovals allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (accountL) LoadToAccountApprovals(ctx context.Context, e boil.ContextExecutor, singular bool, maybeAccount interface{}, mods queries.Applicator) error {
+	var slice []*Account
+	var object *Account
+
+	if singular {
+		object = maybeAccount.(*Account)
+	} else {
+		slice = *maybeAccount.(*[]*Account)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &accountR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &accountR{}
+			}
+
+			for _, a := range args {
+				if queries.Equal(a, obj.ID) {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`approval`),
+		qm.WhereIn(`approval.to_account_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load approval")
+	}
+
+	var resultSlice []*Approval
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice approval")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on approval")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for approval")
+	}
+
+	if singular {
+		object.R.ToAccountApprovals = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &approvalR{}
+			}
+			foreign.R.ToAccount = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.ToAccountID) {
+				local.R.ToAccountApprovals = append(local.R.ToAccountApprovals, foreign)
+				if foreign.R == nil {
+					foreign.R = &approvalR{}
+				}
+				foreign.R.ToAccount = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadDSCommissions allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (accountL) LoadDSCommissions(ctx context.Context, e boil.ContextExecutor, singular bool, maybeAccount interface{}, mods queries.Applicator) error {
+	var slice []*Account
+	var object *Account
+
+	if singular {
+		object = maybeAccount.(*Account)
+	} else {
+		slice = *maybeAccount.(*[]*Account)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &accountR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &accountR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`ds_commission`),
+		qm.WhereIn(`ds_commission.account_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load ds_commission")
+	}
+
+	var resultSlice []*DSCommission
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice ds_commission")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on ds_commission")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for ds_commission")
+	}
+
+	if singular {
+		object.R.DSCommissions = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &dsCommissionR{}
+			}
+			foreign.R.Account = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.AccountID {
+				local.R.DSCommissions = append(local.R.DSCommissions, foreign)
+				if foreign.R == nil {
+					foreign.R = &dsCommissionR{}
+				}
+				foreign.R.Account = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadDSCycles allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (accountL) LoadDSCycles(ctx context.Context, e boil.ContextExecutor, singular bool, maybeAccount interface{}, mods queries.Applicator) error {
+	var slice []*Account
+	var object *Account
+
+	if singular {
+		object = maybeAccount.(*Account)
+	} else {
+		slice = *maybeAccount.(*[]*Account)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &accountR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &accountR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`ds_cycle`),
+		qm.WhereIn(`ds_cycle.account_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load ds_cycle")
+	}
+
+	var resultSlice []*DSCycle
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice ds_cycle")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on ds_cycle")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for ds_cycle")
+	}
+
+	if singular {
+		object.R.DSCycles = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &dsCycleR{}
+			}
+			foreign.R.Account = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.AccountID {
+				local.R.DSCycles = append(local.R.DSCycles, foreign)
+				if foreign.R == nil {
+					foreign.R = &dsCycleR{}
+				}
+				foreign.R.Account = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadImportRows allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (accountL) LoadImportRows(ctx context.Context, e boil.ContextExecutor, singular bool, maybeAccount interface{}, mods queries.Applicator) error {
+	var slice []*Account
+	var object *Account
+
+	if singular {
+		object = maybeAccount.(*Account)
+	} else {
+		slice = *maybeAccount.(*[]*Account)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &accountR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &accountR{}
+			}
+
+			for _, a := range args {
+				if queries.Equal(a, obj.ID) {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`import_row`),
+		qm.WhereIn(`import_row.account_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load import_row")
+	}
+
+	var resultSlice []*ImportRow
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice import_row")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on import_row")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for import_row")
+	}
+
+	if singular {
+		object.R.ImportRows = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &importRowR{}
+			}
+			foreign.R.Account = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.AccountID) {
+				local.R.ImportRows = append(local.R.ImportRows, foreign)
+				if foreign.R == nil {
+					foreign.R = &importRowR{}
+				}
+				foreign.R.Account = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadInboundMessages allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (accountL) LoadInboundMessages(ctx context.Context, e boil.ContextExecutor, singular bool, maybeAccount interface{}, mods queries.Applicator) error {
+	var slice []*Account
+	var object *Account
+
+	if singular {
+		object = maybeAccount.(*Account)
+	} else {
+		slice = *maybeAccount.(*[]*Account)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &accountR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &accountR{}
+			}
+
+			for _, a := range args {
+				if queries.Equal(a, obj.ID) {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`inbound_message`),
+		qm.WhereIn(`inbound_message.account_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load inbound_message")
+	}
+
+	var resultSlice []*InboundMessage
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice inbound_message")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on inbound_message")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for inbound_message")
+	}
+
+	if singular {
+		object.R.InboundMessages = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &inboundMessageR{}
+			}
+			foreign.R.Account = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.AccountID) {
+				local.R.InboundMessages = append(local.R.InboundMessages, foreign)
+				if foreign.R == nil {
+					foreign.R = &inboundMessageR{}
+				}
+				foreign.R.Account = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadInterestAccruals allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (accountL) LoadInterestAccruals(ctx context.Context, e boil.ContextExecutor, singular bool, maybeAccount interface{}, mods queries.Applicator) error {
+	var slice []*Account
+	var object *Account
+
+	if singular {
+		object = maybeAccount.(*Account)
+	} else {
+		slice = *maybeAccount.(*[]*Account)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &accountR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &accountR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`interest_accrual`),
+		qm.WhereIn(`interest_accrual.account_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load interest_accrual")
+	}
+
+	var resultSlice []*InterestAccrual
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice interest_accrual")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on interest_accrual")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for interest_accrual")
+	}
+
+	if singular {
+		object.R.InterestAccruals = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &interestAccrualR{}
+			}
+			foreign.R.Account = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.AccountID {
+				local.R.InterestAccruals = append(local.R.InterestAccruals, foreign)
+				if foreign.R == nil {
+					foreign.R = &interestAccrualR{}
+				}
+				foreign.R.Account = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadLoans allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (accountL) LoadLoans(ctx context.Context, e boil.ContextExecutor, singular bool, maybeAccount interface{}, mods queries.Applicator) error {
+	var slice []*Account
+	var object *Account
+
+	if singular {
+		object = maybeAccount.(*Account)
+	} else {
+		slice = *maybeAccount.(*[]*Account)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &accountR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &accountR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`loan`),
+		qm.WhereIn(`loan.account_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load loan")
+	}
+
+	var resultSlice []*Loan
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice loan")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on loan")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for loan")
+	}
+
+	if singular {
+		object.R.Loans = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &loanR{}
+			}
+			foreign.R.Account = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.AccountID {
+				local.R.Loans = append(local.R.Loans, foreign)
+				if foreign.R == nil {
+					foreign.R = &loanR{}
+				}
+				foreign.R.Account = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadPostings allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (accountL) LoadPostings(ctx context.Context, e boil.ContextExecutor, singular bool, maybeAccount interface{}, mods queries.Applicator) error {
+	var slice []*Account
+	var object *Account
+
+	if singular {
+		object = maybeAccount.(*Account)
+	} else {
+		slice = *maybeAccount.(*[]*Account)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &accountR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &accountR{}
+			}
+
+			for _, a := range args {
+				if queries.Equal(a, obj.ID) {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`posting`),
+		qm.WhereIn(`posting.account_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load posting")
+	}
+
+	var resultSlice []*Posting
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice posting")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on posting")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for posting")
+	}
+
+	if singular {
+		object.R.Postings = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &postingR{}
+			}
+			foreign.R.Account = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.AccountID) {
+				local.R.Postings = append(local.R.Postings, foreign)
+				if foreign.R == nil {
+					foreign.R = &postingR{}
+				}
+				foreign.R.Account = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadCreditAccountSales allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (accountL) LoadCreditAccountSales(ctx context.Context, e boil.ContextExecutor, singular bool, maybeAccount interface{}, mods queries.Applicator) error {
+	var slice []*Account
+	var object *Account
+
+	if singular {
+		object = maybeAccount.(*Account)
+	} else {
+		slice = *maybeAccount.(*[]*Account)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &accountR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &accountR{}
+			}
+
+			for _, a := range args {
+				if queries.Equal(a, obj.ID) {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`sale`),
+		qm.WhereIn(`sale.credit_account_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load sale")
+	}
+
+	var resultSlice []*Sale
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice sale")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on sale")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for sale")
+	}
+
+	if singular {
+		object.R.CreditAccountSales = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &saleR{}
+			}
+			foreign.R.CreditAccount = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.CreditAccountID) {
+				local.R.CreditAccountSales = append(local.R.CreditAccountSales, foreign)
+				if foreign.R == nil {
+					foreign.R = &saleR{}
+				}
+				foreign.R.CreditAccount = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadTransactions allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (accountL) LoadTransactions(ctx context.Context, e boil.ContextExecutor, singular bool, maybeAccount interface{}, mods queries.Applicator) error {
+	var slice []*Account
+	var object *Account
+
+	if singular {
+		object = maybeAccount.(*Account)
+	} else {
+		slice = *maybeAccount.(*[]*Account)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &accountR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &accountR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`transaction`),
+		qm.WhereIn(`transaction.account_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load transaction")
+	}
+
+	var resultSlice []*Transaction
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice transaction")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on transaction")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for transaction")
+	}
+
+	if singular {
+		object.R.Transactions = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &transactionR{}
+			}
+			foreign.R.Account = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.AccountID {
+				local.R.Transactions = append(local.R.Transactions, foreign)
+				if foreign.R == nil {
+					foreign.R = &transactionR{}
+				}
+				foreign.R.Account = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadFromAccountTransfers allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (accountL) LoadFromAccountTransfers(ctx context.Context, e boil.ContextExecutor, singular bool, maybeAccount interface{}, mods queries.Applicator) error {
+	var slice []*Account
+	var object *Account
+
+	if singular {
+		object = maybeAccount.(*Account)
+	} else {
+		slice = *maybeAccount.(*[]*Account)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &accountR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &accountR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`transfer`),
+		qm.WhereIn(`transfer.from_account_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load transfer")
+	}
+
+	var resultSlice []*Transfer
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice transfer")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on transfer")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for transfer")
+	}
+
+	if singular {
+		object.R.FromAccountTransfers = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &transferR{}
+			}
+			foreign.R.FromAccount = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.FromAccountID {
+				local.R.FromAccountTransfers = append(local.R.FromAccountTransfers, foreign)
+				if foreign.R == nil {
+					foreign.R = &transferR{}
+				}
+				foreign.R.FromAccount = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadToAccountTransfers allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (accountL) LoadToAccountTransfers(ctx context.Context, e boil.ContextExecutor, singular bool, maybeAccount interface{}, mods queries.Applicator) error {
+	var slice []*Account
+	var object *Account
+
+	if singular {
+		object = maybeAccount.(*Account)
+	} else {
+		slice = *maybeAccount.(*[]*Account)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &accountR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &accountR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`transfer`),
+		qm.WhereIn(`transfer.to_account_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load transfer")
+	}
+
+	var resultSlice []*Transfer
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice transfer")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on transfer")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for transfer")
+	}
+
+	if singular {
+		object.R.ToAccountTransfers = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &transferR{}
+			}
+			foreign.R.ToAccount = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.ToAccountID {
+				local.R.ToAccountTransfers = append(local.R.ToAccountTransfers, foreign)
+				if foreign.R == nil {
+					foreign.R = &transferR{}
+				}
+				foreign.R.ToAccount = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadWithdrawalRequests allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (accountL) LoadWithdrawalRequests(ctx context.Context, e boil.ContextExecutor, singular bool, maybeAccount interface{}, mods queries.Applicator) error {
+	var slice []*Account
+	var object *Account
+
+	if singular {
+		object = maybeAccount.(*Account)
+	} else {
+		slice = *maybeAccount.(*[]*Account)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &accountR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &accountR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`withdrawal_request`),
+		qm.WhereIn(`withdrawal_request.account_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load withdrawal_request")
+	}
+
+	var resultSlice []*WithdrawalRequest
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice withdrawal_request")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on withdrawal_request")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for withdrawal_request")
+	}
+
+	if singular {
+		object.R.WithdrawalRequests = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &withdrawalRequestR{}
+			}
+			foreign.R.Account = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.AccountID {
+				local.R.WithdrawalRequests = append(local.R.WithdrawalRequests, foreign)
+				if foreign.R == nil {
+					foreign.R = &withdrawalRequestR{}
+				}
+				foreign.R.Account = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetBranch of the account to the related item.
+// Sets o.R.Branch to related.
+// Adds o to related.R.Accounts.
+func (o *Account) SetBranch(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Branch) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"account\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"branch_id"}),
+		strmangle.WhereClause("\"", "\"", 2, accountPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.BranchID = related.ID
+	if o.R == nil {
+		o.R = &accountR{
+			Branch: related,
+		}
+	} else {
+		o.R.Branch = related
+	}
+
+	if related.R == nil {
+		related.R = &branchR{
+			Accounts: AccountSlice{o},
+		}
+	} else {
+		related.R.Accounts = append(related.R.Accounts, o)
+	}
+
+	return nil
+}
+
+// SetCustomer of the account to the related item.
+// Sets o.R.Customer to related.
+// Adds o to related.R.Accounts.
+func (o *Account) SetCustomer(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Customer) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"account\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"customer_id"}),
+		strmangle.WhereClause("\"", "\"", 2, accountPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.CustomerID = related.ID
+	if o.R == nil {
+		o.R = &accountR{
+			Customer: related,
+		}
+	} else {
+		o.R.Customer = related
+	}
+
+	if related.R == nil {
+		related.R = &customerR{
+			Accounts: AccountSlice{o},
+		}
+	} else {
+		related.R.Accounts = append(related.R.Accounts, o)
+	}
+
+	return nil
+}
+
+// SetProduct of the account to the related item.
+// Sets o.R.Product to related.
+// Adds o to related.R.ProductAccounts.
+func (o *Account) SetProduct(ctx context.Context, exec boil.ContextExecutor, insert bool, related *AccountProduct) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"account\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"product_id"}),
+		strmangle.WhereClause("\"", "\"", 2, accountPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.ProductID = related.ID
+	if o.R == nil {
+		o.R = &accountR{
+			Product: related,
+		}
+	} else {
+		o.R.Product = related
+	}
+
+	if related.R == nil {
+		related.R = &accountProductR{
+			ProductAccounts: AccountSlice{o},
+		}
+	} else {
+		related.R.ProductAccounts = append(related.R.ProductAccounts, o)
+	}
+
+	return nil
+}
+
+// SetSalesRep of the account to the related item.
+// Sets o.R.SalesRep to related.
+// Adds o to related.R.SalesRepAccounts.
+func (o *Account) SetSalesRep(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"account\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"sales_rep_id"}),
+		strmangle.WhereClause("\"", "\"", 2, accountPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.SalesRepID = related.ID
+	if o.R == nil {
+		o.R = &accountR{
+			SalesRep: related,
+		}
+	} else {
+		o.R.SalesRep = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			SalesRepAccounts: AccountSlice{o},
+		}
+	} else {
+		related.R.SalesRepAccounts = append(related.R.SalesRepAccounts, o)
+	}
+
+	return nil
+}
+
+// AddAccountFollowUps adds the given related objects to the existing relationships
+// of the account, optionally inserting them as new records.
+// Appends related to o.R.AccountFollowUps.
+// Sets related.R.Account appropriately.
+func (o *Account) AddAccountFollowUps(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*AccountFollowUp) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.AccountID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"account_follow_up\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"account_id"}),
+				strmangle.WhereClause("\"", "\"", 2, accountFollowUpPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.AccountID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &accountR{
+			AccountFollowUps: related,
+		}
+	} else {
+		o.R.AccountFollowUps = append(o.R.AccountFollowUps, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &accountFollowUpR{
+				Account: o,
+			}
+		} else {
+			rel.R.Account = o
+		}
+	}
+	return nil
+}
+
+// AddAccountHolds adds the given related objects to the existing relationships
+// of the account, optionally inserting them as new records.
+// Appends related to o.R.AccountHolds.
+// Sets related.R.Account appropriately.
+func (o *Account) AddAccountHolds(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*AccountHold) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.AccountID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"account_hold\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"account_id"}),
+				strmangle.WhereClause("\"", "\"", 2, accountHoldPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.AccountID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &accountR{
+			AccountHolds: related,
+		}
+	} else {
+		o.R.AccountHolds = append(o.R.AccountHolds, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &accountHoldR{
+				Account: o,
+			}
+		} else {
+			rel.R.Account = o
+		}
+	}
+	return nil
+}
+
+// AddAccountStatusChanges adds the given related objects to the existing relationships
+// of the account, optionally inserting them as new records.
+// Appends related to o.R.AccountStatusChanges.
+// Sets related.R.Account appropriately.
+func (o *Account) AddAccountStatusChanges(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*AccountStatusChange) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.AccountID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"account_status_change\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"account_id"}),
+				strmangle.WhereClause("\"", "\"", 2, accountStatusChangePrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.AccountID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &accountR{
+			AccountStatusChanges: related,
+		}
+	} else {
+		o.R.AccountStatusChanges = append(o.R.AccountStatusChanges, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &accountStatusChangeR{
+				Account: o,
+			}
+		} else {
+			rel.R.Account = o
+		}
+	}
+	return nil
+}
+
+// AddApprovals adds the given related objects to the existing relationships
+// of the account, optionally inserting them as new records.
+// Appends related to o.R.Approvals.
+// Sets related.R.Account appropriately.
+func (o *Account) AddApprovals(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Approval) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.AccountID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"approval\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"account_id"}),
+				strmangle.WhereClause("\"", "\"", 2, approvalPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.AccountID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &accountR{
+			Approvals: related,
+		}
+	} else {
+		o.R.Approvals = append(o.R.Approvals, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &approvalR{
+				Account: o,
+			}
+		} else {
+			rel.R.Account = o
+		}
+	}
+	return nil
+}
+
+// AddToAccountApprovals adds the given related objects to the existing relationships
+// of the account, optionally inserting them as new records.
+// Appends related to o.R.ToAccountApprovals.
+// Sets related.R.ToAccount appropriately.
+func (o *Account) AddToAccountApprovals(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Approval) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.ToAccountID, o.ID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"approval\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"to_account_id"}),
+				strmangle.WhereClause("\"", "\"", 2, approvalPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.ToAccountID, o.ID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &accountR{
+			ToAccountApprovals: related,
+		}
+	} else {
+		o.R.ToAccountApprovals = append(o.R.ToAccountApprovals, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &approvalR{
+				ToAccount: o,
+			}
+		} else {
+			rel.R.ToAccount = o
+		}
+	}
+	return nil
+}
+
+// SetToAccountApprovals removes all previously related items of the
+// account replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.ToAccount's ToAccountApprovals accordingly.
+// Replaces o.R.ToAccountApprovals with related.
+// Sets related.R.ToAccount's ToAccountApprovals accordingly.
+func (o *Account) SetToAccountApprovals(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Approval) error {
+	query := "update \"approval\" set \"to_account_id\" = null where \"to_account_id\" = $1"
+	values := []interface{}{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.ToAccountApprovals {
+			queries.SetScanner(&rel.ToAccountID, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.ToAccount = nil
+		}
+
+		o.R.ToAccountApprovals = nil
+	}
+	return o.AddToAccountApprovals(ctx, exec, insert, related...)
+}
+
+// RemoveToAccountApprovals relationships from objects passed in.
+// Removes related items from R.ToAccountApprovals (uses pointer comparison, removal does not keep order)
+// Sets related.R.ToAccount.
+func (o *Account) RemoveToAccountApprovals(ctx context.Context, exec boil.ContextExecutor, related ...*Approval) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.ToAccountID, nil)
+		if rel.R != nil {
+			rel.R.ToAccount = nil
+		}
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("to_account_id")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.ToAccountApprovals {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.ToAccountApprovals)
+			if ln > 1 && i < ln-1 {
+				o.R.ToAccountApprovals[i] = o.R.ToAccountApprovals[ln-1]
+			}
+			o.R.ToAccountApprovals = o.R.ToAccountApprovals[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
+// AddDSCommissions adds the given related objects to the existing relationships
+// of the account, optionally inserting them as new records.
+// Appends related to o.R.DSCommissions.
+// Sets related.R.Account appropriately.
+func (o *Account) AddDSCommissions(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*DSCommission) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.AccountID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"ds_commission\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"account_id"}),
+				strmangle.WhereClause("\"", "\"", 2, dsCommissionPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.AccountID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &accountR{
+			DSCommissions: related,
+		}
+	} else {
+		o.R.DSCommissions = append(o.R.DSCommissions, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &dsCommissionR{
+				Account: o,
+			}
+		} else {
+			rel.R.Account = o
+		}
+	}
+	return nil
+}
+
+// AddDSCycles adds the given related objects to the existing relationships
+// of the account, optionally inserting them as new records.
+// Appends related to o.R.DSCycles.
+// Sets related.R.Account appropriately.
+func (o *Account) AddDSCycles(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*DSCycle) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.AccountID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"ds_cycle\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"account_id"}),
+				strmangle.WhereClause("\"", "\"", 2, dsCyclePrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.AccountID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &accountR{
+			DSCycles: related,
+		}
+	} else {
+		o.R.DSCycles = append(o.R.DSCycles, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &dsCycleR{
+				Account: o,
+			}
+		} else {
+			rel.R.Account = o
+		}
+	}
+	return nil
+}
+
+// AddImportRows adds the given related objects to the existing relationships
+// of the account, optionally inserting them as new records.
+// Appends related to o.R.ImportRows.
+// Sets related.R.Account appropriately.
+func (o *Account) AddImportRows(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*ImportRow) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.AccountID, o.ID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"import_row\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"account_id"}),
+				strmangle.WhereClause("\"", "\"", 2, importRowPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.AccountID, o.ID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &accountR{
+			ImportRows: related,
+		}
+	} else {
+		o.R.ImportRows = append(o.R.ImportRows, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &importRowR{
+				Account: o,
+			}
+		} else {
+			rel.R.Account = o
+		}
+	}
+	return nil
+}
+
+// SetImportRows removes all previously related items of the
+// account replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.Account's ImportRows accordingly.
+// Replaces o.R.ImportRows with related.
+// Sets related.R.Account's ImportRows accordingly.
+func (o *Account) SetImportRows(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*ImportRow) error {
+	query := "update \"import_row\" set \"account_id\" = null where \"account_id\" = $1"
+	values := []interface{}{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.ImportRows {
+			queries.SetScanner(&rel.AccountID, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.Account = nil
+		}
+
+		o.R.ImportRows = nil
+	}
+	return o.AddImportRows(ctx, exec, insert, related...)
+}
+
+// RemoveImportRows relationships from objects passed in.
+// Removes related items from R.ImportRows (uses pointer comparison, removal does not keep order)
+// Sets related.R.Account.
+func (o *Account) RemoveImportRows(ctx context.Context, exec boil.ContextExecutor, related ...*ImportRow) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.AccountID, nil)
+		if rel.R != nil {
+			rel.R.Account = nil
+		}
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("account_id")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.ImportRows {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.ImportRows)
+			if ln > 1 && i < ln-1 {
+				o.R.ImportRows[i] = o.R.ImportRows[ln-1]
+			}
+			o.R.ImportRows = o.R.ImportRows[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
+// AddInboundMessages adds the given related objects to the existing relationships
+// of the account, optionally inserting them as new records.
+// Appends related to o.R.InboundMessages.
+// Sets related.R.Account appropriately.
+func (o *Account) AddInboundMessages(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*InboundMessage) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.AccountID, o.ID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"inbound_message\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"account_id"}),
+				strmangle.WhereClause("\"", "\"", 2, inboundMessagePrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.AccountID, o.ID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &accountR{
+			InboundMessages: related,
+		}
+	} else {
+		o.R.InboundMessages = append(o.R.InboundMessages, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &inboundMessageR{
+				Account: o,
+			}
+		} else {
+			rel.R.Account = o
+		}
+	}
+	return nil
+}
+
+// SetInboundMessages removes all previously related items of the
+// account replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.Account's InboundMessages accordingly.
+// Replaces o.R.InboundMessages with related.
+// Sets related.R.Account's InboundMessages accordingly.
+func (o *Account) SetInboundMessages(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*InboundMessage) error {
+	query := "update \"inbound_message\" set \"account_id\" = null where \"account_id\" = $1"
+	values := []interface{}{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.InboundMessages {
+			queries.SetScanner(&rel.AccountID, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.Account = nil
+		}
+
+		o.R.InboundMessages = nil
+	}
+	return o.AddInboundMessages(ctx, exec, insert, related...)
+}
+
+// RemoveInboundMessages relationships from objects passed in.
+// Removes related items from R.InboundMessages (uses pointer comparison, removal does not keep order)
+// Sets related.R.Account.
+func (o *Account) RemoveInboundMessages(ctx context.Context, exec boil.ContextExecutor, related ...*InboundMessage) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.AccountID, nil)
+		if rel.R != nil {
+			rel.R.Account = nil
+		}
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("account_id")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.InboundMessages {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.InboundMessages)
+			if ln > 1 && i < ln-1 {
+				o.R.InboundMessages[i] = o.R.InboundMessages[ln-1]
+			}
+			o.R.InboundMessages = o.R.InboundMessages[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
+// AddInterestAccruals adds the given related objects to the existing relationships
+// of the account, optionally inserting them as new records.
+// Appends related to o.R.InterestAccruals.
+// Sets related.R.Account appropriately.
+func (o *Account) AddInterestAccruals(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*InterestAccrual) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.AccountID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"interest_accrual\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"account_id"}),
+				strmangle.WhereClause("\"", "\"", 2, interestAccrualPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.AccountID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &accountR{
+			InterestAccruals: related,
+		}
+	} else {
+		o.R.InterestAccruals = append(o.R.InterestAccruals, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &interestAccrualR{
+				Account: o,
+			}
+		} else {
+			rel.R.Account = o
+		}
+	}
+	return nil
+}
+
+// AddLoans adds the given related objects to the existing relationships
+// of the account, optionally inserting them as new records.
+// Appends related to o.R.Loans.
+// Sets related.R.Account appropriately.
+func (o *Account) AddLoans(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Loan) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.AccountID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"loan\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"account_id"}),
+				strmangle.WhereClause("\"", "\"", 2, loanPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.AccountID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &accountR{
+			Loans: related,
+		}
+	} else {
+		o.R.Loans = append(o.R.Loans, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &loanR{
+				Account: o,
+			}
+		} else {
+			rel.R.Account = o
+		}
+	}
+	return nil
+}
+
+// AddPostings adds the given related objects to the existing relationships
+// of the account, optionally inserting them as new records.
+// Appends related to o.R.Postings.
+// Sets related.R.Account appropriately.
+func (o *Account) AddPostings(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Posting) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.AccountID, o.ID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"posting\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"account_id"}),
+				strmangle.WhereClause("\"", "\"", 2, postingPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.AccountID, o.ID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &accountR{
+			Postings: related,
+		}
+	} else {
+		o.R.Postings = append(o.R.Postings, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &postingR{
+				Account: o,
+			}
+		} else {
+			rel.R.Account = o
+		}
+	}
+	return nil
+}
+
+// SetPostings removes all previously related items of the
+// account replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.Account's Postings accordingly.
+// Replaces o.R.Postings with related.
+// Sets related.R.Account's Postings accordingly.
+func (o *Account) SetPostings(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Posting) error {
+	query := "update \"posting\" set \"account_id\" = null where \"account_id\" = $1"
+	values := []interface{}{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.Postings {
+			queries.SetScanner(&rel.AccountID, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.Account = nil
+		}
 
-	return nil
+		o.R.Postings = nil
+	}
+	return o.AddPostings(ctx, exec, insert, related...)
 }
 
-// SetBranch of the account to the related item.
-// Sets o.R.Branch to related.
-// Adds o to related.R.Accounts.
-func (o *Account) SetBranch(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Branch) error {
+// RemovePostings relationships from objects passed in.
+// Removes related items from R.Postings (uses pointer comparison, removal does not keep order)
+// Sets related.R.Account.
+func (o *Account) RemovePostings(ctx context.Context, exec boil.ContextExecutor, related ...*Posting) error {
+	if len(related) == 0 {
+		return nil
+	}
+
 	var err error
-	if insert {
-		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
-			return errors.Wrap(err, "failed to insert into foreign table")
+	for _, rel := range related {
+		queries.SetScanner(&rel.AccountID, nil)
+		if rel.R != nil {
+			rel.R.Account = nil
+		}
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("account_id")); err != nil {
+			return err
 		}
 	}
+	if o.R == nil {
+		return nil
+	}
 
-	updateQuery := fmt.Sprintf(
-		"UPDATE \"account\" SET %s WHERE %s",
-		strmangle.SetParamNames("\"", "\"", 1, []string{"branch_id"}),
-		strmangle.WhereClause("\"", "\"", 2, accountPrimaryKeyColumns),
-	)
-	values := []interface{}{related.ID, o.ID}
+	for _, rel := range related {
+		for i, ri := range o.R.Postings {
+			if rel != ri {
+				continue
+			}
 
-	if boil.IsDebug(ctx) {
-		writer := boil.DebugWriterFrom(ctx)
-		fmt.Fprintln(writer, updateQuery)
-		fmt.Fprintln(writer, values)
+			ln := len(o.R.Postings)
+			if ln > 1 && i < ln-1 {
+				o.R.Postings[i] = o.R.Postings[ln-1]
+			}
+			o.R.Postings = o.R.Postings[:ln-1]
+			break
+		}
 	}
-	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
-		return errors.Wrap(err, "failed to update local table")
+
+	return nil
+}
+
+// AddCreditAccountSales adds the given related objects to the existing relationships
+// of the account, optionally inserting them as new records.
+// Appends related to o.R.CreditAccountSales.
+// Sets related.R.CreditAccount appropriately.
+func (o *Account) AddCreditAccountSales(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Sale) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.CreditAccountID, o.ID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"sale\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"credit_account_id"}),
+				strmangle.WhereClause("\"", "\"", 2, salePrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.CreditAccountID, o.ID)
+		}
 	}
 
-	o.BranchID = related.ID
 	if o.R == nil {
 		o.R = &accountR{
-			Branch: related,
+			CreditAccountSales: related,
 		}
 	} else {
-		o.R.Branch = related
+		o.R.CreditAccountSales = append(o.R.CreditAccountSales, related...)
 	}
 
-	if related.R == nil {
-		related.R = &branchR{
-			Accounts: AccountSlice{o},
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &saleR{
+				CreditAccount: o,
+			}
+		} else {
+			rel.R.CreditAccount = o
 		}
-	} else {
-		related.R.Accounts = append(related.R.Accounts, o)
 	}
-
 	return nil
 }
 
-// SetCustomer of the account to the related item.
-// Sets o.R.Customer to related.
-// Adds o to related.R.Accounts.
-func (o *Account) SetCustomer(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Customer) error {
+// SetCreditAccountSales removes all previously related items of the
+// account replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.CreditAccount's CreditAccountSales accordingly.
+// Replaces o.R.CreditAccountSales with related.
+// Sets related.R.CreditAccount's CreditAccountSales accordingly.
+func (o *Account) SetCreditAccountSales(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Sale) error {
+	query := "update \"sale\" set \"credit_account_id\" = null where \"credit_account_id\" = $1"
+	values := []interface{}{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.CreditAccountSales {
+			queries.SetScanner(&rel.CreditAccountID, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.CreditAccount = nil
+		}
+
+		o.R.CreditAccountSales = nil
+	}
+	return o.AddCreditAccountSales(ctx, exec, insert, related...)
+}
+
+// RemoveCreditAccountSales relationships from objects passed in.
+// Removes related items from R.CreditAccountSales (uses pointer comparison, removal does not keep order)
+// Sets related.R.CreditAccount.
+func (o *Account) RemoveCreditAccountSales(ctx context.Context, exec boil.ContextExecutor, related ...*Sale) error {
+	if len(related) == 0 {
+		return nil
+	}
+
 	var err error
-	if insert {
-		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
-			return errors.Wrap(err, "failed to insert into foreign table")
+	for _, rel := range related {
+		queries.SetScanner(&rel.CreditAccountID, nil)
+		if rel.R != nil {
+			rel.R.CreditAccount = nil
+		}
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("credit_account_id")); err != nil {
+			return err
 		}
 	}
+	if o.R == nil {
+		return nil
+	}
 
-	updateQuery := fmt.Sprintf(
-		"UPDATE \"account\" SET %s WHERE %s",
-		strmangle.SetParamNames("\"", "\"", 1, []string{"customer_id"}),
-		strmangle.WhereClause("\"", "\"", 2, accountPrimaryKeyColumns),
-	)
-	values := []interface{}{related.ID, o.ID}
+	for _, rel := range related {
+		for i, ri := range o.R.CreditAccountSales {
+			if rel != ri {
+				continue
+			}
 
-	if boil.IsDebug(ctx) {
-		writer := boil.DebugWriterFrom(ctx)
-		fmt.Fprintln(writer, updateQuery)
-		fmt.Fprintln(writer, values)
+			ln := len(o.R.CreditAccountSales)
+			if ln > 1 && i < ln-1 {
+				o.R.CreditAccountSales[i] = o.R.CreditAccountSales[ln-1]
+			}
+			o.R.CreditAccountSales = o.R.CreditAccountSales[:ln-1]
+			break
+		}
 	}
-	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
-		return errors.Wrap(err, "failed to update local table")
+
+	return nil
+}
+
+// AddTransactions adds the given related objects to the existing relationships
+// of the account, optionally inserting them as new records.
+// Appends related to o.R.Transactions.
+// Sets related.R.Account appropriately.
+func (o *Account) AddTransactions(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Transaction) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.AccountID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"transaction\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"account_id"}),
+				strmangle.WhereClause("\"", "\"", 2, transactionPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.AccountID = o.ID
+		}
 	}
 
-	o.CustomerID = related.ID
 	if o.R == nil {
 		o.R = &accountR{
-			Customer: related,
+			Transactions: related,
 		}
 	} else {
-		o.R.Customer = related
+		o.R.Transactions = append(o.R.Transactions, related...)
 	}
 
-	if related.R == nil {
-		related.R = &customerR{
-			Accounts: AccountSlice{o},
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &transactionR{
+				Account: o,
+			}
+		} else {
+			rel.R.Account = o
 		}
-	} else {
-		related.R.Accounts = append(related.R.Accounts, o)
 	}
-
 	return nil
 }
 
-// SetSalesRep of the account to the related item.
-// Sets o.R.SalesRep to related.
-// Adds o to related.R.SalesRepAccounts.
-func (o *Account) SetSalesRep(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
+// AddFromAccountTransfers adds the given related objects to the existing relationships
+// of the account, optionally inserting them as new records.
+// Appends related to o.R.FromAccountTransfers.
+// Sets related.R.FromAccount appropriately.
+func (o *Account) AddFromAccountTransfers(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Transfer) error {
 	var err error
-	if insert {
-		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
-			return errors.Wrap(err, "failed to insert into foreign table")
-		}
-	}
+	for _, rel := range related {
+		if insert {
+			rel.FromAccountID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"transfer\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"from_account_id"}),
+				strmangle.WhereClause("\"", "\"", 2, transferPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
 
-	updateQuery := fmt.Sprintf(
-		"UPDATE \"account\" SET %s WHERE %s",
-		strmangle.SetParamNames("\"", "\"", 1, []string{"sales_rep_id"}),
-		strmangle.WhereClause("\"", "\"", 2, accountPrimaryKeyColumns),
-	)
-	values := []interface{}{related.ID, o.ID}
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
 
-	if boil.IsDebug(ctx) {
-		writer := boil.DebugWriterFrom(ctx)
-		fmt.Fprintln(writer, updateQuery)
-		fmt.Fprintln(writer, values)
-	}
-	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
-		return errors.Wrap(err, "failed to update local table")
+			rel.FromAccountID = o.ID
+		}
 	}
 
-	o.SalesRepID = related.ID
 	if o.R == nil {
 		o.R = &accountR{
-			SalesRep: related,
+			FromAccountTransfers: related,
 		}
 	} else {
-		o.R.SalesRep = related
+		o.R.FromAccountTransfers = append(o.R.FromAccountTransfers, related...)
 	}
 
-	if related.R == nil {
-		related.R = &userR{
-			SalesRepAccounts: AccountSlice{o},
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &transferR{
+				FromAccount: o,
+			}
+		} else {
+			rel.R.FromAccount = o
 		}
-	} else {
-		related.R.SalesRepAccounts = append(related.R.SalesRepAccounts, o)
 	}
-
 	return nil
 }
 
-// AddDSCommissions adds the given related objects to the existing relationships
+// AddToAccountTransfers adds the given related objects to the existing relationships
 // of the account, optionally inserting them as new records.
-// Appends related to o.R.DSCommissions.
-// Sets related.R.Account appropriately.
-func (o *Account) AddDSCommissions(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*DSCommission) error {
+// Appends related to o.R.ToAccountTransfers.
+// Sets related.R.ToAccount appropriately.
+func (o *Account) AddToAccountTransfers(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Transfer) error {
 	var err error
 	for _, rel := range related {
 		if insert {
-			rel.AccountID = o.ID
+			rel.ToAccountID = o.ID
 			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
 				return errors.Wrap(err, "failed to insert into foreign table")
 			}
 		} else {
 			updateQuery := fmt.Sprintf(
-				"UPDATE \"ds_commission\" SET %s WHERE %s",
-				strmangle.SetParamNames("\"", "\"", 1, []string{"account_id"}),
-				strmangle.WhereClause("\"", "\"", 2, dsCommissionPrimaryKeyColumns),
+				"UPDATE \"transfer\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"to_account_id"}),
+				strmangle.WhereClause("\"", "\"", 2, transferPrimaryKeyColumns),
 			)
 			values := []interface{}{o.ID, rel.ID}
 
@@ -1086,35 +4209,35 @@ func (o *Account) AddDSCommissions(ctx context.Context, exec boil.ContextExecuto
 				return errors.Wrap(err, "failed to update foreign table")
 			}
 
-			rel.AccountID = o.ID
+			rel.ToAccountID = o.ID
 		}
 	}
 
 	if o.R == nil {
 		o.R = &accountR{
-			DSCommissions: related,
+			ToAccountTransfers: related,
 		}
 	} else {
-		o.R.DSCommissions = append(o.R.DSCommissions, related...)
+		o.R.ToAccountTransfers = append(o.R.ToAccountTransfers, related...)
 	}
 
 	for _, rel := range related {
 		if rel.R == nil {
-			rel.R = &dsCommissionR{
-				Account: o,
+			rel.R = &transferR{
+				ToAccount: o,
 			}
 		} else {
-			rel.R.Account = o
+			rel.R.ToAccount = o
 		}
 	}
 	return nil
 }
 
-// AddTransactions adds the given related objects to the existing relationships
+// AddWithdrawalRequests adds the given related objects to the existing relationships
 // of the account, optionally inserting them as new records.
-// Appends related to o.R.Transactions.
+// Appends related to o.R.WithdrawalRequests.
 // Sets related.R.Account appropriately.
-func (o *Account) AddTransactions(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Transaction) error {
+func (o *Account) AddWithdrawalRequests(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*WithdrawalRequest) error {
 	var err error
 	for _, rel := range related {
 		if insert {
@@ -1124,9 +4247,9 @@ func (o *Account) AddTransactions(ctx context.Context, exec boil.ContextExecutor
 			}
 		} else {
 			updateQuery := fmt.Sprintf(
-				"UPDATE \"transaction\" SET %s WHERE %s",
+				"UPDATE \"withdrawal_request\" SET %s WHERE %s",
 				strmangle.SetParamNames("\"", "\"", 1, []string{"account_id"}),
-				strmangle.WhereClause("\"", "\"", 2, transactionPrimaryKeyColumns),
+				strmangle.WhereClause("\"", "\"", 2, withdrawalRequestPrimaryKeyColumns),
 			)
 			values := []interface{}{o.ID, rel.ID}
 
@@ -1145,15 +4268,15 @@ func (o *Account) AddTransactions(ctx context.Context, exec boil.ContextExecutor
 
 	if o.R == nil {
 		o.R = &accountR{
-			Transactions: related,
+			WithdrawalRequests: related,
 		}
 	} else {
-		o.R.Transactions = append(o.R.Transactions, related...)
+		o.R.WithdrawalRequests = append(o.R.WithdrawalRequests, related...)
 	}
 
 	for _, rel := range related {
 		if rel.R == nil {
-			rel.R = &transactionR{
+			rel.R = &withdrawalRequestR{
 				Account: o,
 			}
 		} else {
@@ -23,10 +23,10 @@ import (
 
 // BankDeposit is an object representing the database table.
 type BankDeposit struct {
-	ID            string  `boil:"id" json:"id" toml:"id" yaml:"id"`
-	BankAccountID string  `boil:"bank_account_id" json:"bank_account_id" toml:"bank_account_id" yaml:"bank_account_id"`
-	Amount        float64 `boil:"amount" json:"amount" toml:"amount" yaml:"amount"`
-	Date          int64   `boil:"date" json:"date" toml:"date" yaml:"date"`
+	ID            string `boil:"id" json:"id" toml:"id" yaml:"id"`
+	BankAccountID string `boil:"bank_account_id" json:"bank_account_id" toml:"bank_account_id" yaml:"bank_account_id"`
+	Amount        int64  `boil:"amount" json:"amount" toml:"amount" yaml:"amount"`
+	Date          int64  `boil:"date" json:"date" toml:"date" yaml:"date"`
 
 	R *bankDepositR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L bankDepositL  `boil:"-" json:"-" toml:"-" yaml:"-"`
@@ -61,12 +61,12 @@ var BankDepositTableColumns = struct {
 var BankDepositWhere = struct {
 	ID            whereHelperstring
 	BankAccountID whereHelperstring
-	Amount        whereHelperfloat64
+	Amount        whereHelperint64
 	Date          whereHelperint64
 }{
 	ID:            whereHelperstring{field: "\"bank_deposit\".\"id\""},
 	BankAccountID: whereHelperstring{field: "\"bank_deposit\".\"bank_account_id\""},
-	Amount:        whereHelperfloat64{field: "\"bank_deposit\".\"amount\""},
+	Amount:        whereHelperint64{field: "\"bank_deposit\".\"amount\""},
 	Date:          whereHelperint64{field: "\"bank_deposit\".\"date\""},
 }
 
@@ -536,7 +536,7 @@ func testBankDepositsSelect(t *testing.T) {
 }
 
 var (
-	bankDepositDBTypes = map[string]string{`ID`: `character`, `BankAccountID`: `character`, `Amount`: `double precision`, `Date`: `bigint`}
+	bankDepositDBTypes = map[string]string{`ID`: `character`, `BankAccountID`: `character`, `Amount`: `bigint`, `Date`: `bigint`}
 	_                  = bytes.MinRead
 )
 
@@ -13,237 +13,487 @@ import "testing"
 // Separating the tests thusly grants avoidance of Postgres deadlocks.
 func TestParent(t *testing.T) {
 	t.Run("Accounts", testAccounts)
+	t.Run("AccountFollowUps", testAccountFollowUps)
+	t.Run("AccountHolds", testAccountHolds)
+	t.Run("AccountProducts", testAccountProducts)
+	t.Run("AccountStatusChanges", testAccountStatusChanges)
+	t.Run("Approvals", testApprovals)
 	t.Run("BankAccounts", testBankAccounts)
 	t.Run("BankDeposits", testBankDeposits)
 	t.Run("Branches", testBranches)
 	t.Run("Brands", testBrands)
 	t.Run("Categories", testCategories)
 	t.Run("Customers", testCustomers)
+	t.Run("CustomerDocuments", testCustomerDocuments)
+	t.Run("CustomerLoginCodes", testCustomerLoginCodes)
+	t.Run("CustomerMerges", testCustomerMerges)
 	t.Run("DailySummaries", testDailySummaries)
 	t.Run("DSCommissions", testDSCommissions)
+	t.Run("DSCycles", testDSCycles)
 	t.Run("Expenditures", testExpenditures)
+	t.Run("IdempotencyKeys", testIdempotencyKeys)
+	t.Run("ImportJobs", testImportJobs)
+	t.Run("ImportRows", testImportRows)
+	t.Run("InboundMessages", testInboundMessages)
+	t.Run("InterestAccruals", testInterestAccruals)
 	t.Run("Inventories", testInventories)
+	t.Run("JournalEntries", testJournalEntries)
+	t.Run("LedgerAccounts", testLedgerAccounts)
+	t.Run("Loans", testLoans)
+	t.Run("LoanInstalments", testLoanInstalments)
+	t.Run("LoanRepayments", testLoanRepayments)
 	t.Run("Payments", testPayments)
+	t.Run("Postings", testPostings)
 	t.Run("Products", testProducts)
 	t.Run("ProductCategories", testProductCategories)
 	t.Run("Profits", testProfits)
 	t.Run("RepsExpenses", testRepsExpenses)
 	t.Run("Sales", testSales)
 	t.Run("SaleItems", testSaleItems)
+	t.Run("TillCounts", testTillCounts)
+	t.Run("TillSessions", testTillSessions)
 	t.Run("Transactions", testTransactions)
+	t.Run("Transfers", testTransfers)
 	t.Run("Users", testUsers)
+	t.Run("WithdrawalRequests", testWithdrawalRequests)
 }
 
 func TestDelete(t *testing.T) {
 	t.Run("Accounts", testAccountsDelete)
+	t.Run("AccountFollowUps", testAccountFollowUpsDelete)
+	t.Run("AccountHolds", testAccountHoldsDelete)
+	t.Run("AccountProducts", testAccountProductsDelete)
+	t.Run("AccountStatusChanges", testAccountStatusChangesDelete)
+	t.Run("Approvals", testApprovalsDelete)
 	t.Run("BankAccounts", testBankAccountsDelete)
 	t.Run("BankDeposits", testBankDepositsDelete)
 	t.Run("Branches", testBranchesDelete)
 	t.Run("Brands", testBrandsDelete)
 	t.Run("Categories", testCategoriesDelete)
 	t.Run("Customers", testCustomersDelete)
+	t.Run("CustomerDocuments", testCustomerDocumentsDelete)
+	t.Run("CustomerLoginCodes", testCustomerLoginCodesDelete)
+	t.Run("CustomerMerges", testCustomerMergesDelete)
 	t.Run("DailySummaries", testDailySummariesDelete)
 	t.Run("DSCommissions", testDSCommissionsDelete)
+	t.Run("DSCycles", testDSCyclesDelete)
 	t.Run("Expenditures", testExpendituresDelete)
+	t.Run("IdempotencyKeys", testIdempotencyKeysDelete)
+	t.Run("ImportJobs", testImportJobsDelete)
+	t.Run("ImportRows", testImportRowsDelete)
+	t.Run("InboundMessages", testInboundMessagesDelete)
+	t.Run("InterestAccruals", testInterestAccrualsDelete)
 	t.Run("Inventories", testInventoriesDelete)
+	t.Run("JournalEntries", testJournalEntriesDelete)
+	t.Run("LedgerAccounts", testLedgerAccountsDelete)
+	t.Run("Loans", testLoansDelete)
+	t.Run("LoanInstalments", testLoanInstalmentsDelete)
+	t.Run("LoanRepayments", testLoanRepaymentsDelete)
 	t.Run("Payments", testPaymentsDelete)
+	t.Run("Postings", testPostingsDelete)
 	t.Run("Products", testProductsDelete)
 	t.Run("ProductCategories", testProductCategoriesDelete)
 	t.Run("Profits", testProfitsDelete)
 	t.Run("RepsExpenses", testRepsExpensesDelete)
 	t.Run("Sales", testSalesDelete)
 	t.Run("SaleItems", testSaleItemsDelete)
+	t.Run("TillCounts", testTillCountsDelete)
+	t.Run("TillSessions", testTillSessionsDelete)
 	t.Run("Transactions", testTransactionsDelete)
+	t.Run("Transfers", testTransfersDelete)
 	t.Run("Users", testUsersDelete)
+	t.Run("WithdrawalRequests", testWithdrawalRequestsDelete)
 }
 
 func TestQueryDeleteAll(t *testing.T) {
 	t.Run("Accounts", testAccountsQueryDeleteAll)
+	t.Run("AccountFollowUps", testAccountFollowUpsQueryDeleteAll)
+	t.Run("AccountHolds", testAccountHoldsQueryDeleteAll)
+	t.Run("AccountProducts", testAccountProductsQueryDeleteAll)
+	t.Run("AccountStatusChanges", testAccountStatusChangesQueryDeleteAll)
+	t.Run("Approvals", testApprovalsQueryDeleteAll)
 	t.Run("BankAccounts", testBankAccountsQueryDeleteAll)
 	t.Run("BankDeposits", testBankDepositsQueryDeleteAll)
 	t.Run("Branches", testBranchesQueryDeleteAll)
 	t.Run("Brands", testBrandsQueryDeleteAll)
 	t.Run("Categories", testCategoriesQueryDeleteAll)
 	t.Run("Customers", testCustomersQueryDeleteAll)
+	t.Run("CustomerDocuments", testCustomerDocumentsQueryDeleteAll)
+	t.Run("CustomerLoginCodes", testCustomerLoginCodesQueryDeleteAll)
+	t.Run("CustomerMerges", testCustomerMergesQueryDeleteAll)
 	t.Run("DailySummaries", testDailySummariesQueryDeleteAll)
 	t.Run("DSCommissions", testDSCommissionsQueryDeleteAll)
+	t.Run("DSCycles", testDSCyclesQueryDeleteAll)
 	t.Run("Expenditures", testExpendituresQueryDeleteAll)
+	t.Run("IdempotencyKeys", testIdempotencyKeysQueryDeleteAll)
+	t.Run("ImportJobs", testImportJobsQueryDeleteAll)
+	t.Run("ImportRows", testImportRowsQueryDeleteAll)
+	t.Run("InboundMessages", testInboundMessagesQueryDeleteAll)
+	t.Run("InterestAccruals", testInterestAccrualsQueryDeleteAll)
 	t.Run("Inventories", testInventoriesQueryDeleteAll)
+	t.Run("JournalEntries", testJournalEntriesQueryDeleteAll)
+	t.Run("LedgerAccounts", testLedgerAccountsQueryDeleteAll)
+	t.Run("Loans", testLoansQueryDeleteAll)
+	t.Run("LoanInstalments", testLoanInstalmentsQueryDeleteAll)
+	t.Run("LoanRepayments", testLoanRepaymentsQueryDeleteAll)
 	t.Run("Payments", testPaymentsQueryDeleteAll)
+	t.Run("Postings", testPostingsQueryDeleteAll)
 	t.Run("Products", testProductsQueryDeleteAll)
 	t.Run("ProductCategories", testProductCategoriesQueryDeleteAll)
 	t.Run("Profits", testProfitsQueryDeleteAll)
 	t.Run("RepsExpenses", testRepsExpensesQueryDeleteAll)
 	t.Run("Sales", testSalesQueryDeleteAll)
 	t.Run("SaleItems", testSaleItemsQueryDeleteAll)
+	t.Run("TillCounts", testTillCountsQueryDeleteAll)
+	t.Run("TillSessions", testTillSessionsQueryDeleteAll)
 	t.Run("Transactions", testTransactionsQueryDeleteAll)
+	t.Run("Transfers", testTransfersQueryDeleteAll)
 	t.Run("Users", testUsersQueryDeleteAll)
+	t.Run("WithdrawalRequests", testWithdrawalRequestsQueryDeleteAll)
 }
 
 func TestSliceDeleteAll(t *testing.T) {
 	t.Run("Accounts", testAccountsSliceDeleteAll)
+	t.Run("AccountFollowUps", testAccountFollowUpsSliceDeleteAll)
+	t.Run("AccountHolds", testAccountHoldsSliceDeleteAll)
+	t.Run("AccountProducts", testAccountProductsSliceDeleteAll)
+	t.Run("AccountStatusChanges", testAccountStatusChangesSliceDeleteAll)
+	t.Run("Approvals", testApprovalsSliceDeleteAll)
 	t.Run("BankAccounts", testBankAccountsSliceDeleteAll)
 	t.Run("BankDeposits", testBankDepositsSliceDeleteAll)
 	t.Run("Branches", testBranchesSliceDeleteAll)
 	t.Run("Brands", testBrandsSliceDeleteAll)
 	t.Run("Categories", testCategoriesSliceDeleteAll)
 	t.Run("Customers", testCustomersSliceDeleteAll)
+	t.Run("CustomerDocuments", testCustomerDocumentsSliceDeleteAll)
+	t.Run("CustomerLoginCodes", testCustomerLoginCodesSliceDeleteAll)
+	t.Run("CustomerMerges", testCustomerMergesSliceDeleteAll)
 	t.Run("DailySummaries", testDailySummariesSliceDeleteAll)
 	t.Run("DSCommissions", testDSCommissionsSliceDeleteAll)
+	t.Run("DSCycles", testDSCyclesSliceDeleteAll)
 	t.Run("Expenditures", testExpendituresSliceDeleteAll)
+	t.Run("IdempotencyKeys", testIdempotencyKeysSliceDeleteAll)
+	t.Run("ImportJobs", testImportJobsSliceDeleteAll)
+	t.Run("ImportRows", testImportRowsSliceDeleteAll)
+	t.Run("InboundMessages", testInboundMessagesSliceDeleteAll)
+	t.Run("InterestAccruals", testInterestAccrualsSliceDeleteAll)
 	t.Run("Inventories", testInventoriesSliceDeleteAll)
+	t.Run("JournalEntries", testJournalEntriesSliceDeleteAll)
+	t.Run("LedgerAccounts", testLedgerAccountsSliceDeleteAll)
+	t.Run("Loans", testLoansSliceDeleteAll)
+	t.Run("LoanInstalments", testLoanInstalmentsSliceDeleteAll)
+	t.Run("LoanRepayments", testLoanRepaymentsSliceDeleteAll)
 	t.Run("Payments", testPaymentsSliceDeleteAll)
+	t.Run("Postings", testPostingsSliceDeleteAll)
 	t.Run("Products", testProductsSliceDeleteAll)
 	t.Run("ProductCategories", testProductCategoriesSliceDeleteAll)
 	t.Run("Profits", testProfitsSliceDeleteAll)
 	t.Run("RepsExpenses", testRepsExpensesSliceDeleteAll)
 	t.Run("Sales", testSalesSliceDeleteAll)
 	t.Run("SaleItems", testSaleItemsSliceDeleteAll)
+	t.Run("TillCounts", testTillCountsSliceDeleteAll)
+	t.Run("TillSessions", testTillSessionsSliceDeleteAll)
 	t.Run("Transactions", testTransactionsSliceDeleteAll)
+	t.Run("Transfers", testTransfersSliceDeleteAll)
 	t.Run("Users", testUsersSliceDeleteAll)
+	t.Run("WithdrawalRequests", testWithdrawalRequestsSliceDeleteAll)
 }
 
 func TestExists(t *testing.T) {
 	t.Run("Accounts", testAccountsExists)
+	t.Run("AccountFollowUps", testAccountFollowUpsExists)
+	t.Run("AccountHolds", testAccountHoldsExists)
+	t.Run("AccountProducts", testAccountProductsExists)
+	t.Run("AccountStatusChanges", testAccountStatusChangesExists)
+	t.Run("Approvals", testApprovalsExists)
 	t.Run("BankAccounts", testBankAccountsExists)
 	t.Run("BankDeposits", testBankDepositsExists)
 	t.Run("Branches", testBranchesExists)
 	t.Run("Brands", testBrandsExists)
 	t.Run("Categories", testCategoriesExists)
 	t.Run("Customers", testCustomersExists)
+	t.Run("CustomerDocuments", testCustomerDocumentsExists)
+	t.Run("CustomerLoginCodes", testCustomerLoginCodesExists)
+	t.Run("CustomerMerges", testCustomerMergesExists)
 	t.Run("DailySummaries", testDailySummariesExists)
 	t.Run("DSCommissions", testDSCommissionsExists)
+	t.Run("DSCycles", testDSCyclesExists)
 	t.Run("Expenditures", testExpendituresExists)
+	t.Run("IdempotencyKeys", testIdempotencyKeysExists)
+	t.Run("ImportJobs", testImportJobsExists)
+	t.Run("ImportRows", testImportRowsExists)
+	t.Run("InboundMessages", testInboundMessagesExists)
+	t.Run("InterestAccruals", testInterestAccrualsExists)
 	t.Run("Inventories", testInventoriesExists)
+	t.Run("JournalEntries", testJournalEntriesExists)
+	t.Run("LedgerAccounts", testLedgerAccountsExists)
+	t.Run("Loans", testLoansExists)
+	t.Run("LoanInstalments", testLoanInstalmentsExists)
+	t.Run("LoanRepayments", testLoanRepaymentsExists)
 	t.Run("Payments", testPaymentsExists)
+	t.Run("Postings", testPostingsExists)
 	t.Run("Products", testProductsExists)
 	t.Run("ProductCategories", testProductCategoriesExists)
 	t.Run("Profits", testProfitsExists)
 	t.Run("RepsExpenses", testRepsExpensesExists)
 	t.Run("Sales", testSalesExists)
 	t.Run("SaleItems", testSaleItemsExists)
+	t.Run("TillCounts", testTillCountsExists)
+	t.Run("TillSessions", testTillSessionsExists)
 	t.Run("Transactions", testTransactionsExists)
+	t.Run("Transfers", testTransfersExists)
 	t.Run("Users", testUsersExists)
+	t.Run("WithdrawalRequests", testWithdrawalRequestsExists)
 }
 
 func TestFind(t *testing.T) {
 	t.Run("Accounts", testAccountsFind)
+	t.Run("AccountFollowUps", testAccountFollowUpsFind)
+	t.Run("AccountHolds", testAccountHoldsFind)
+	t.Run("AccountProducts", testAccountProductsFind)
+	t.Run("AccountStatusChanges", testAccountStatusChangesFind)
+	t.Run("Approvals", testApprovalsFind)
 	t.Run("BankAccounts", testBankAccountsFind)
 	t.Run("BankDeposits", testBankDepositsFind)
 	t.Run("Branches", testBranchesFind)
 	t.Run("Brands", testBrandsFind)
 	t.Run("Categories", testCategoriesFind)
 	t.Run("Customers", testCustomersFind)
+	t.Run("CustomerDocuments", testCustomerDocumentsFind)
+	t.Run("CustomerLoginCodes", testCustomerLoginCodesFind)
+	t.Run("CustomerMerges", testCustomerMergesFind)
 	t.Run("DailySummaries", testDailySummariesFind)
 	t.Run("DSCommissions", testDSCommissionsFind)
+	t.Run("DSCycles", testDSCyclesFind)
 	t.Run("Expenditures", testExpendituresFind)
+	t.Run("IdempotencyKeys", testIdempotencyKeysFind)
+	t.Run("ImportJobs", testImportJobsFind)
+	t.Run("ImportRows", testImportRowsFind)
+	t.Run("InboundMessages", testInboundMessagesFind)
+	t.Run("InterestAccruals", testInterestAccrualsFind)
 	t.Run("Inventories", testInventoriesFind)
+	t.Run("JournalEntries", testJournalEntriesFind)
+	t.Run("LedgerAccounts", testLedgerAccountsFind)
+	t.Run("Loans", testLoansFind)
+	t.Run("LoanInstalments", testLoanInstalmentsFind)
+	t.Run("LoanRepayments", testLoanRepaymentsFind)
 	t.Run("Payments", testPaymentsFind)
+	t.Run("Postings", testPostingsFind)
 	t.Run("Products", testProductsFind)
 	t.Run("ProductCategories", testProductCategoriesFind)
 	t.Run("Profits", testProfitsFind)
 	t.Run("RepsExpenses", testRepsExpensesFind)
 	t.Run("Sales", testSalesFind)
 	t.Run("SaleItems", testSaleItemsFind)
+	t.Run("TillCounts", testTillCountsFind)
+	t.Run("TillSessions", testTillSessionsFind)
 	t.Run("Transactions", testTransactionsFind)
+	t.Run("Transfers", testTransfersFind)
 	t.Run("Users", testUsersFind)
+	t.Run("WithdrawalRequests", testWithdrawalRequestsFind)
 }
 
 func TestBind(t *testing.T) {
 	t.Run("Accounts", testAccountsBind)
+	t.Run("AccountFollowUps", testAccountFollowUpsBind)
+	t.Run("AccountHolds", testAccountHoldsBind)
+	t.Run("AccountProducts", testAccountProductsBind)
+	t.Run("AccountStatusChanges", testAccountStatusChangesBind)
+	t.Run("Approvals", testApprovalsBind)
 	t.Run("BankAccounts", testBankAccountsBind)
 	t.Run("BankDeposits", testBankDepositsBind)
 	t.Run("Branches", testBranchesBind)
 	t.Run("Brands", testBrandsBind)
 	t.Run("Categories", testCategoriesBind)
 	t.Run("Customers", testCustomersBind)
+	t.Run("CustomerDocuments", testCustomerDocumentsBind)
+	t.Run("CustomerLoginCodes", testCustomerLoginCodesBind)
+	t.Run("CustomerMerges", testCustomerMergesBind)
 	t.Run("DailySummaries", testDailySummariesBind)
 	t.Run("DSCommissions", testDSCommissionsBind)
+	t.Run("DSCycles", testDSCyclesBind)
 	t.Run("Expenditures", testExpendituresBind)
+	t.Run("IdempotencyKeys", testIdempotencyKeysBind)
+	t.Run("ImportJobs", testImportJobsBind)
+	t.Run("ImportRows", testImportRowsBind)
+	t.Run("InboundMessages", testInboundMessagesBind)
+	t.Run("InterestAccruals", testInterestAccrualsBind)
 	t.Run("Inventories", testInventoriesBind)
+	t.Run("JournalEntries", testJournalEntriesBind)
+	t.Run("LedgerAccounts", testLedgerAccountsBind)
+	t.Run("Loans", testLoansBind)
+	t.Run("LoanInstalments", testLoanInstalmentsBind)
+	t.Run("LoanRepayments", testLoanRepaymentsBind)
 	t.Run("Payments", testPaymentsBind)
+	t.Run("Postings", testPostingsBind)
 	t.Run("Products", testProductsBind)
 	t.Run("ProductCategories", testProductCategoriesBind)
 	t.Run("Profits", testProfitsBind)
 	t.Run("RepsExpenses", testRepsExpensesBind)
 	t.Run("Sales", testSalesBind)
 	t.Run("SaleItems", testSaleItemsBind)
+	t.Run("TillCounts", testTillCountsBind)
+	t.Run("TillSessions", testTillSessionsBind)
 	t.Run("Transactions", testTransactionsBind)
+	t.Run("Transfers", testTransfersBind)
 	t.Run("Users", testUsersBind)
+	t.Run("WithdrawalRequests", testWithdrawalRequestsBind)
 }
 
 func TestOne(t *testing.T) {
 	t.Run("Accounts", testAccountsOne)
+	t.Run("AccountFollowUps", testAccountFollowUpsOne)
+	t.Run("AccountHolds", testAccountHoldsOne)
+	t.Run("AccountProducts", testAccountProductsOne)
+	t.Run("AccountStatusChanges", testAccountStatusChangesOne)
+	t.Run("Approvals", testApprovalsOne)
 	t.Run("BankAccounts", testBankAccountsOne)
 	t.Run("BankDeposits", testBankDepositsOne)
 	t.Run("Branches", testBranchesOne)
 	t.Run("Brands", testBrandsOne)
 	t.Run("Categories", testCategoriesOne)
 	t.Run("Customers", testCustomersOne)
+	t.Run("CustomerDocuments", testCustomerDocumentsOne)
+	t.Run("CustomerLoginCodes", testCustomerLoginCodesOne)
+	t.Run("CustomerMerges", testCustomerMergesOne)
 	t.Run("DailySummaries", testDailySummariesOne)
 	t.Run("DSCommissions", testDSCommissionsOne)
+	t.Run("DSCycles", testDSCyclesOne)
 	t.Run("Expenditures", testExpendituresOne)
+	t.Run("IdempotencyKeys", testIdempotencyKeysOne)
+	t.Run("ImportJobs", testImportJobsOne)
+	t.Run("ImportRows", testImportRowsOne)
+	t.Run("InboundMessages", testInboundMessagesOne)
+	t.Run("InterestAccruals", testInterestAccrualsOne)
 	t.Run("Inventories", testInventoriesOne)
+	t.Run("JournalEntries", testJournalEntriesOne)
+	t.Run("LedgerAccounts", testLedgerAccountsOne)
+	t.Run("Loans", testLoansOne)
+	t.Run("LoanInstalments", testLoanInstalmentsOne)
+	t.Run("LoanRepayments", testLoanRepaymentsOne)
 	t.Run("Payments", testPaymentsOne)
+	t.Run("Postings", testPostingsOne)
 	t.Run("Products", testProductsOne)
 	t.Run("ProductCategories", testProductCategoriesOne)
 	t.Run("Profits", testProfitsOne)
 	t.Run("RepsExpenses", testRepsExpensesOne)
 	t.Run("Sales", testSalesOne)
 	t.Run("SaleItems", testSaleItemsOne)
+	t.Run("TillCounts", testTillCountsOne)
+	t.Run("TillSessions", testTillSessionsOne)
 	t.Run("Transactions", testTransactionsOne)
+	t.Run("Transfers", testTransfersOne)
 	t.Run("Users", testUsersOne)
+	t.Run("WithdrawalRequests", testWithdrawalRequestsOne)
 }
 
 func TestAll(t *testing.T) {
 	t.Run("Accounts", testAccountsAll)
+	t.Run("AccountFollowUps", testAccountFollowUpsAll)
+	t.Run("AccountHolds", testAccountHoldsAll)
+	t.Run("AccountProducts", testAccountProductsAll)
+	t.Run("AccountStatusChanges", testAccountStatusChangesAll)
+	t.Run("Approvals", testApprovalsAll)
 	t.Run("BankAccounts", testBankAccountsAll)
 	t.Run("BankDeposits", testBankDepositsAll)
 	t.Run("Branches", testBranchesAll)
 	t.Run("Brands", testBrandsAll)
 	t.Run("Categories", testCategoriesAll)
 	t.Run("Customers", testCustomersAll)
+	t.Run("CustomerDocuments", testCustomerDocumentsAll)
+	t.Run("CustomerLoginCodes", testCustomerLoginCodesAll)
+	t.Run("CustomerMerges", testCustomerMergesAll)
 	t.Run("DailySummaries", testDailySummariesAll)
 	t.Run("DSCommissions", testDSCommissionsAll)
+	t.Run("DSCycles", testDSCyclesAll)
 	t.Run("Expenditures", testExpendituresAll)
+	t.Run("IdempotencyKeys", testIdempotencyKeysAll)
+	t.Run("ImportJobs", testImportJobsAll)
+	t.Run("ImportRows", testImportRowsAll)
+	t.Run("InboundMessages", testInboundMessagesAll)
+	t.Run("InterestAccruals", testInterestAccrualsAll)
 	t.Run("Inventories", testInventoriesAll)
+	t.Run("JournalEntries", testJournalEntriesAll)
+	t.Run("LedgerAccounts", testLedgerAccountsAll)
+	t.Run("Loans", testLoansAll)
+	t.Run("LoanInstalments", testLoanInstalmentsAll)
+	t.Run("LoanRepayments", testLoanRepaymentsAll)
 	t.Run("Payments", testPaymentsAll)
+	t.Run("Postings", testPostingsAll)
 	t.Run("Products", testProductsAll)
 	t.Run("ProductCategories", testProductCategoriesAll)
 	t.Run("Profits", testProfitsAll)
 	t.Run("RepsExpenses", testRepsExpensesAll)
 	t.Run("Sales", testSalesAll)
 	t.Run("SaleItems", testSaleItemsAll)
+	t.Run("TillCounts", testTillCountsAll)
+	t.Run("TillSessions", testTillSessionsAll)
 	t.Run("Transactions", testTransactionsAll)
+	t.Run("Transfers", testTransfersAll)
 	t.Run("Users", testUsersAll)
+	t.Run("WithdrawalRequests", testWithdrawalRequestsAll)
 }
 
 func TestCount(t *testing.T) {
 	t.Run("Accounts", testAccountsCount)
+	t.Run("AccountFollowUps", testAccountFollowUpsCount)
+	t.Run("AccountHolds", testAccountHoldsCount)
+	t.Run("AccountProducts", testAccountProductsCount)
+	t.Run("AccountStatusChanges", testAccountStatusChangesCount)
+	t.Run("Approvals", testApprovalsCount)
 	t.Run("BankAccounts", testBankAccountsCount)
 	t.Run("BankDeposits", testBankDepositsCount)
 	t.Run("Branches", testBranchesCount)
 	t.Run("Brands", testBrandsCount)
 	t.Run("Categories", testCategoriesCount)
 	t.Run("Customers", testCustomersCount)
+	t.Run("CustomerDocuments", testCustomerDocumentsCount)
+	t.Run("CustomerLoginCodes", testCustomerLoginCodesCount)
+	t.Run("CustomerMerges", testCustomerMergesCount)
 	t.Run("DailySummaries", testDailySummariesCount)
 	t.Run("DSCommissions", testDSCommissionsCount)
+	t.Run("DSCycles", testDSCyclesCount)
 	t.Run("Expenditures", testExpendituresCount)
+	t.Run("IdempotencyKeys", testIdempotencyKeysCount)
+	t.Run("ImportJobs", testImportJobsCount)
+	t.Run("ImportRows", testImportRowsCount)
+	t.Run("InboundMessages", testInboundMessagesCount)
+	t.Run("InterestAccruals", testInterestAccrualsCount)
 	t.Run("Inventories", testInventoriesCount)
+	t.Run("JournalEntries", testJournalEntriesCount)
+	t.Run("LedgerAccounts", testLedgerAccountsCount)
+	t.Run("Loans", testLoansCount)
+	t.Run("LoanInstalments", testLoanInstalmentsCount)
+	t.Run("LoanRepayments", testLoanRepaymentsCount)
 	t.Run("Payments", testPaymentsCount)
+	t.Run("Postings", testPostingsCount)
 	t.Run("Products", testProductsCount)
 	t.Run("ProductCategories", testProductCategoriesCount)
 	t.Run("Profits", testProfitsCount)
 	t.Run("RepsExpenses", testRepsExpensesCount)
 	t.Run("Sales", testSalesCount)
 	t.Run("SaleItems", testSaleItemsCount)
+	t.Run("TillCounts", testTillCountsCount)
+	t.Run("TillSessions", testTillSessionsCount)
 	t.Run("Transactions", testTransactionsCount)
+	t.Run("Transfers", testTransfersCount)
 	t.Run("Users", testUsersCount)
+	t.Run("WithdrawalRequests", testWithdrawalRequestsCount)
 }
 
 func TestInsert(t *testing.T) {
 	t.Run("Accounts", testAccountsInsert)
 	t.Run("Accounts", testAccountsInsertWhitelist)
+	t.Run("AccountFollowUps", testAccountFollowUpsInsert)
+	t.Run("AccountFollowUps", testAccountFollowUpsInsertWhitelist)
+	t.Run("AccountHolds", testAccountHoldsInsert)
+	t.Run("AccountHolds", testAccountHoldsInsertWhitelist)
+	t.Run("AccountProducts", testAccountProductsInsert)
+	t.Run("AccountProducts", testAccountProductsInsertWhitelist)
+	t.Run("AccountStatusChanges", testAccountStatusChangesInsert)
+	t.Run("AccountStatusChanges", testAccountStatusChangesInsertWhitelist)
+	t.Run("Approvals", testApprovalsInsert)
+	t.Run("Approvals", testApprovalsInsertWhitelist)
 	t.Run("BankAccounts", testBankAccountsInsert)
 	t.Run("BankAccounts", testBankAccountsInsertWhitelist)
 	t.Run("BankDeposits", testBankDepositsInsert)
@@ -256,16 +506,46 @@ func TestInsert(t *testing.T) {
 	t.Run("Categories", testCategoriesInsertWhitelist)
 	t.Run("Customers", testCustomersInsert)
 	t.Run("Customers", testCustomersInsertWhitelist)
+	t.Run("CustomerDocuments", testCustomerDocumentsInsert)
+	t.Run("CustomerDocuments", testCustomerDocumentsInsertWhitelist)
+	t.Run("CustomerLoginCodes", testCustomerLoginCodesInsert)
+	t.Run("CustomerLoginCodes", testCustomerLoginCodesInsertWhitelist)
+	t.Run("CustomerMerges", testCustomerMergesInsert)
+	t.Run("CustomerMerges", testCustomerMergesInsertWhitelist)
 	t.Run("DailySummaries", testDailySummariesInsert)
 	t.Run("DailySummaries", testDailySummariesInsertWhitelist)
 	t.Run("DSCommissions", testDSCommissionsInsert)
 	t.Run("DSCommissions", testDSCommissionsInsertWhitelist)
+	t.Run("DSCycles", testDSCyclesInsert)
+	t.Run("DSCycles", testDSCyclesInsertWhitelist)
 	t.Run("Expenditures", testExpendituresInsert)
 	t.Run("Expenditures", testExpendituresInsertWhitelist)
+	t.Run("IdempotencyKeys", testIdempotencyKeysInsert)
+	t.Run("IdempotencyKeys", testIdempotencyKeysInsertWhitelist)
+	t.Run("ImportJobs", testImportJobsInsert)
+	t.Run("ImportJobs", testImportJobsInsertWhitelist)
+	t.Run("ImportRows", testImportRowsInsert)
+	t.Run("ImportRows", testImportRowsInsertWhitelist)
+	t.Run("InboundMessages", testInboundMessagesInsert)
+	t.Run("InboundMessages", testInboundMessagesInsertWhitelist)
+	t.Run("InterestAccruals", testInterestAccrualsInsert)
+	t.Run("InterestAccruals", testInterestAccrualsInsertWhitelist)
 	t.Run("Inventories", testInventoriesInsert)
 	t.Run("Inventories", testInventoriesInsertWhitelist)
+	t.Run("JournalEntries", testJournalEntriesInsert)
+	t.Run("JournalEntries", testJournalEntriesInsertWhitelist)
+	t.Run("LedgerAccounts", testLedgerAccountsInsert)
+	t.Run("LedgerAccounts", testLedgerAccountsInsertWhitelist)
+	t.Run("Loans", testLoansInsert)
+	t.Run("Loans", testLoansInsertWhitelist)
+	t.Run("LoanInstalments", testLoanInstalmentsInsert)
+	t.Run("LoanInstalments", testLoanInstalmentsInsertWhitelist)
+	t.Run("LoanRepayments", testLoanRepaymentsInsert)
+	t.Run("LoanRepayments", testLoanRepaymentsInsertWhitelist)
 	t.Run("Payments", testPaymentsInsert)
 	t.Run("Payments", testPaymentsInsertWhitelist)
+	t.Run("Postings", testPostingsInsert)
+	t.Run("Postings", testPostingsInsertWhitelist)
 	t.Run("Products", testProductsInsert)
 	t.Run("Products", testProductsInsertWhitelist)
 	t.Run("ProductCategories", testProductCategoriesInsert)
@@ -278,10 +558,18 @@ func TestInsert(t *testing.T) {
 	t.Run("Sales", testSalesInsertWhitelist)
 	t.Run("SaleItems", testSaleItemsInsert)
 	t.Run("SaleItems", testSaleItemsInsertWhitelist)
+	t.Run("TillCounts", testTillCountsInsert)
+	t.Run("TillCounts", testTillCountsInsertWhitelist)
+	t.Run("TillSessions", testTillSessionsInsert)
+	t.Run("TillSessions", testTillSessionsInsertWhitelist)
 	t.Run("Transactions", testTransactionsInsert)
 	t.Run("Transactions", testTransactionsInsertWhitelist)
+	t.Run("Transfers", testTransfersInsert)
+	t.Run("Transfers", testTransfersInsertWhitelist)
 	t.Run("Users", testUsersInsert)
 	t.Run("Users", testUsersInsertWhitelist)
+	t.Run("WithdrawalRequests", testWithdrawalRequestsInsert)
+	t.Run("WithdrawalRequests", testWithdrawalRequestsInsertWhitelist)
 }
 
 // TestToOne tests cannot be run in parallel
@@ -289,17 +577,68 @@ func TestInsert(t *testing.T) {
 func TestToOne(t *testing.T) {
 	t.Run("AccountToBranchUsingBranch", testAccountToOneBranchUsingBranch)
 	t.Run("AccountToCustomerUsingCustomer", testAccountToOneCustomerUsingCustomer)
+	t.Run("AccountToAccountProductUsingProduct", testAccountToOneAccountProductUsingProduct)
 	t.Run("AccountToUserUsingSalesRep", testAccountToOneUserUsingSalesRep)
+	t.Run("AccountFollowUpToAccountUsingAccount", testAccountFollowUpToOneAccountUsingAccount)
+	t.Run("AccountFollowUpToUserUsingSalesRep", testAccountFollowUpToOneUserUsingSalesRep)
+	t.Run("AccountHoldToAccountUsingAccount", testAccountHoldToOneAccountUsingAccount)
+	t.Run("AccountHoldToUserUsingCreatedBy", testAccountHoldToOneUserUsingCreatedBy)
+	t.Run("AccountHoldToUserUsingReleasedBy", testAccountHoldToOneUserUsingReleasedBy)
+	t.Run("AccountStatusChangeToAccountUsingAccount", testAccountStatusChangeToOneAccountUsingAccount)
+	t.Run("AccountStatusChangeToUserUsingChangedBy", testAccountStatusChangeToOneUserUsingChangedBy)
+	t.Run("ApprovalToAccountUsingAccount", testApprovalToOneAccountUsingAccount)
+	t.Run("ApprovalToBranchUsingBranch", testApprovalToOneBranchUsingBranch)
+	t.Run("ApprovalToUserUsingDecidedBy", testApprovalToOneUserUsingDecidedBy)
+	t.Run("ApprovalToUserUsingRequestedBy", testApprovalToOneUserUsingRequestedBy)
+	t.Run("ApprovalToAccountUsingToAccount", testApprovalToOneAccountUsingToAccount)
+	t.Run("ApprovalToTransactionUsingTransaction", testApprovalToOneTransactionUsingTransaction)
+	t.Run("ApprovalToTransferUsingTransfer", testApprovalToOneTransferUsingTransfer)
 	t.Run("BankDepositToBankAccountUsingBankAccount", testBankDepositToOneBankAccountUsingBankAccount)
 	t.Run("CustomerToBranchUsingBranch", testCustomerToOneBranchUsingBranch)
+	t.Run("CustomerToUserUsingKycVerifiedBy", testCustomerToOneUserUsingKycVerifiedBy)
+	t.Run("CustomerToCustomerUsingMergedInto", testCustomerToOneCustomerUsingMergedInto)
 	t.Run("CustomerToUserUsingSalesRep", testCustomerToOneUserUsingSalesRep)
+	t.Run("CustomerDocumentToCustomerUsingCustomer", testCustomerDocumentToOneCustomerUsingCustomer)
+	t.Run("CustomerDocumentToUserUsingUploadedBy", testCustomerDocumentToOneUserUsingUploadedBy)
+	t.Run("CustomerLoginCodeToCustomerUsingCustomer", testCustomerLoginCodeToOneCustomerUsingCustomer)
+	t.Run("CustomerMergeToCustomerUsingDuplicate", testCustomerMergeToOneCustomerUsingDuplicate)
+	t.Run("CustomerMergeToUserUsingMergedBy", testCustomerMergeToOneUserUsingMergedBy)
+	t.Run("CustomerMergeToCustomerUsingSurvivor", testCustomerMergeToOneCustomerUsingSurvivor)
 	t.Run("DSCommissionToAccountUsingAccount", testDSCommissionToOneAccountUsingAccount)
 	t.Run("DSCommissionToCustomerUsingCustomer", testDSCommissionToOneCustomerUsingCustomer)
+	t.Run("DSCycleToAccountUsingAccount", testDSCycleToOneAccountUsingAccount)
+	t.Run("DSCycleToTransactionUsingPayoutTransaction", testDSCycleToOneTransactionUsingPayoutTransaction)
+	t.Run("DSCycleToUserUsingSettledBy", testDSCycleToOneUserUsingSettledBy)
+	t.Run("ImportJobToBranchUsingBranch", testImportJobToOneBranchUsingBranch)
+	t.Run("ImportJobToUserUsingCreatedBy", testImportJobToOneUserUsingCreatedBy)
+	t.Run("ImportJobToUserUsingSalesRep", testImportJobToOneUserUsingSalesRep)
+	t.Run("ImportRowToAccountUsingAccount", testImportRowToOneAccountUsingAccount)
+	t.Run("ImportRowToCustomerUsingCustomer", testImportRowToOneCustomerUsingCustomer)
+	t.Run("ImportRowToImportJobUsingJob", testImportRowToOneImportJobUsingJob)
+	t.Run("ImportRowToTransactionUsingTransaction", testImportRowToOneTransactionUsingTransaction)
+	t.Run("InboundMessageToAccountUsingAccount", testInboundMessageToOneAccountUsingAccount)
+	t.Run("InterestAccrualToAccountUsingAccount", testInterestAccrualToOneAccountUsingAccount)
 	t.Run("InventoryToBranchUsingBranch", testInventoryToOneBranchUsingBranch)
 	t.Run("InventoryToProductUsingProduct", testInventoryToOneProductUsingProduct)
 	t.Run("InventoryToUserUsingSalesRep", testInventoryToOneUserUsingSalesRep)
+	t.Run("JournalEntryToUserUsingCreatedBy", testJournalEntryToOneUserUsingCreatedBy)
+	t.Run("JournalEntryToJournalEntryUsingReversalOf", testJournalEntryToOneJournalEntryUsingReversalOf)
+	t.Run("LoanToAccountUsingAccount", testLoanToOneAccountUsingAccount)
+	t.Run("LoanToUserUsingAppliedBy", testLoanToOneUserUsingAppliedBy)
+	t.Run("LoanToUserUsingApprovedBy", testLoanToOneUserUsingApprovedBy)
+	t.Run("LoanToBranchUsingBranch", testLoanToOneBranchUsingBranch)
+	t.Run("LoanToCustomerUsingCustomer", testLoanToOneCustomerUsingCustomer)
+	t.Run("LoanToUserUsingDisbursedBy", testLoanToOneUserUsingDisbursedBy)
+	t.Run("LoanToTransactionUsingDisbursementTransaction", testLoanToOneTransactionUsingDisbursementTransaction)
+	t.Run("LoanInstalmentToLoanUsingLoan", testLoanInstalmentToOneLoanUsingLoan)
+	t.Run("LoanRepaymentToLoanUsingLoan", testLoanRepaymentToOneLoanUsingLoan)
+	t.Run("LoanRepaymentToUserUsingSalesRep", testLoanRepaymentToOneUserUsingSalesRep)
+	t.Run("LoanRepaymentToTransactionUsingTransaction", testLoanRepaymentToOneTransactionUsingTransaction)
 	t.Run("PaymentToSaleUsingSale", testPaymentToOneSaleUsingSale)
 	t.Run("PaymentToUserUsingSalesRep", testPaymentToOneUserUsingSalesRep)
+	t.Run("PostingToAccountUsingAccount", testPostingToOneAccountUsingAccount)
+	t.Run("PostingToJournalEntryUsingJournalEntry", testPostingToOneJournalEntryUsingJournalEntry)
+	t.Run("PostingToLedgerAccountUsingLedgerAccountCodeLedgerAccount", testPostingToOneLedgerAccountUsingLedgerAccountCodeLedgerAccount)
 	t.Run("ProductToUserUsingArchivedBy", testProductToOneUserUsingArchivedBy)
 	t.Run("ProductToBrandUsingBrand", testProductToOneBrandUsingBrand)
 	t.Run("ProductToCategoryUsingCategory", testProductToOneCategoryUsingCategory)
@@ -311,12 +650,30 @@ func TestToOne(t *testing.T) {
 	t.Run("SaleToUserUsingArchivedBy", testSaleToOneUserUsingArchivedBy)
 	t.Run("SaleToBranchUsingBranch", testSaleToOneBranchUsingBranch)
 	t.Run("SaleToUserUsingCreatedBy", testSaleToOneUserUsingCreatedBy)
+	t.Run("SaleToAccountUsingCreditAccount", testSaleToOneAccountUsingCreditAccount)
 	t.Run("SaleToUserUsingUpdatedBy", testSaleToOneUserUsingUpdatedBy)
 	t.Run("SaleItemToProductUsingProduct", testSaleItemToOneProductUsingProduct)
 	t.Run("SaleItemToSaleUsingSale", testSaleItemToOneSaleUsingSale)
+	t.Run("TillCountToTillSessionUsingTillSession", testTillCountToOneTillSessionUsingTillSession)
+	t.Run("TillSessionToBranchUsingBranch", testTillSessionToOneBranchUsingBranch)
+	t.Run("TillSessionToUserUsingSalesRep", testTillSessionToOneUserUsingSalesRep)
+	t.Run("TillSessionToUserUsingSignedOffBy", testTillSessionToOneUserUsingSignedOffBy)
+	t.Run("TillSessionToUserUsingSubmittedBy", testTillSessionToOneUserUsingSubmittedBy)
 	t.Run("TransactionToAccountUsingAccount", testTransactionToOneAccountUsingAccount)
+	t.Run("TransactionToUserUsingApprovedBy", testTransactionToOneUserUsingApprovedBy)
+	t.Run("TransactionToTransactionUsingCorrectionOf", testTransactionToOneTransactionUsingCorrectionOf)
+	t.Run("TransactionToDSCycleUsingDSCycle", testTransactionToOneDSCycleUsingDSCycle)
+	t.Run("TransactionToTransactionUsingReversalOf", testTransactionToOneTransactionUsingReversalOf)
 	t.Run("TransactionToUserUsingSalesRep", testTransactionToOneUserUsingSalesRep)
+	t.Run("TransactionToTransferUsingTransfer", testTransactionToOneTransferUsingTransfer)
+	t.Run("TransferToAccountUsingFromAccount", testTransferToOneAccountUsingFromAccount)
+	t.Run("TransferToUserUsingSalesRep", testTransferToOneUserUsingSalesRep)
+	t.Run("TransferToAccountUsingToAccount", testTransferToOneAccountUsingToAccount)
 	t.Run("UserToBranchUsingBranch", testUserToOneBranchUsingBranch)
+	t.Run("WithdrawalRequestToAccountUsingAccount", testWithdrawalRequestToOneAccountUsingAccount)
+	t.Run("WithdrawalRequestToCustomerUsingCustomer", testWithdrawalRequestToOneCustomerUsingCustomer)
+	t.Run("WithdrawalRequestToUserUsingDecidedBy", testWithdrawalRequestToOneUserUsingDecidedBy)
+	t.Run("WithdrawalRequestToTransactionUsingTransaction", testWithdrawalRequestToOneTransactionUsingTransaction)
 }
 
 // TestOneToOne tests cannot be run in parallel
@@ -326,27 +683,90 @@ func TestOneToOne(t *testing.T) {}
 // TestToMany tests cannot be run in parallel
 // or deadlocks can occur.
 func TestToMany(t *testing.T) {
+	t.Run("AccountToAccountFollowUps", testAccountToManyAccountFollowUps)
+	t.Run("AccountToAccountHolds", testAccountToManyAccountHolds)
+	t.Run("AccountToAccountStatusChanges", testAccountToManyAccountStatusChanges)
+	t.Run("AccountToApprovals", testAccountToManyApprovals)
+	t.Run("AccountToToAccountApprovals", testAccountToManyToAccountApprovals)
 	t.Run("AccountToDSCommissions", testAccountToManyDSCommissions)
+	t.Run("AccountToDSCycles", testAccountToManyDSCycles)
+	t.Run("AccountToImportRows", testAccountToManyImportRows)
+	t.Run("AccountToInboundMessages", testAccountToManyInboundMessages)
+	t.Run("AccountToInterestAccruals", testAccountToManyInterestAccruals)
+	t.Run("AccountToLoans", testAccountToManyLoans)
+	t.Run("AccountToPostings", testAccountToManyPostings)
+	t.Run("AccountToCreditAccountSales", testAccountToManyCreditAccountSales)
 	t.Run("AccountToTransactions", testAccountToManyTransactions)
+	t.Run("AccountToFromAccountTransfers", testAccountToManyFromAccountTransfers)
+	t.Run("AccountToToAccountTransfers", testAccountToManyToAccountTransfers)
+	t.Run("AccountToWithdrawalRequests", testAccountToManyWithdrawalRequests)
+	t.Run("AccountProductToProductAccounts", testAccountProductToManyProductAccounts)
 	t.Run("BankAccountToBankDeposits", testBankAccountToManyBankDeposits)
 	t.Run("BranchToAccounts", testBranchToManyAccounts)
+	t.Run("BranchToApprovals", testBranchToManyApprovals)
 	t.Run("BranchToCustomers", testBranchToManyCustomers)
+	t.Run("BranchToImportJobs", testBranchToManyImportJobs)
 	t.Run("BranchToInventories", testBranchToManyInventories)
+	t.Run("BranchToLoans", testBranchToManyLoans)
 	t.Run("BranchToSales", testBranchToManySales)
+	t.Run("BranchToTillSessions", testBranchToManyTillSessions)
 	t.Run("BranchToUsers", testBranchToManyUsers)
 	t.Run("BrandToProducts", testBrandToManyProducts)
 	t.Run("CategoryToProducts", testCategoryToManyProducts)
 	t.Run("CategoryToProductCategories", testCategoryToManyProductCategories)
 	t.Run("CustomerToAccounts", testCustomerToManyAccounts)
+	t.Run("CustomerToMergedIntoCustomers", testCustomerToManyMergedIntoCustomers)
+	t.Run("CustomerToCustomerDocuments", testCustomerToManyCustomerDocuments)
+	t.Run("CustomerToCustomerLoginCodes", testCustomerToManyCustomerLoginCodes)
+	t.Run("CustomerToDuplicateCustomerMerges", testCustomerToManyDuplicateCustomerMerges)
+	t.Run("CustomerToSurvivorCustomerMerges", testCustomerToManySurvivorCustomerMerges)
 	t.Run("CustomerToDSCommissions", testCustomerToManyDSCommissions)
+	t.Run("CustomerToImportRows", testCustomerToManyImportRows)
+	t.Run("CustomerToLoans", testCustomerToManyLoans)
+	t.Run("CustomerToWithdrawalRequests", testCustomerToManyWithdrawalRequests)
+	t.Run("DSCycleToTransactions", testDSCycleToManyTransactions)
+	t.Run("ImportJobToJobImportRows", testImportJobToManyJobImportRows)
+	t.Run("JournalEntryToReversalOfJournalEntries", testJournalEntryToManyReversalOfJournalEntries)
+	t.Run("JournalEntryToPostings", testJournalEntryToManyPostings)
+	t.Run("LedgerAccountToLedgerAccountCodePostings", testLedgerAccountToManyLedgerAccountCodePostings)
+	t.Run("LoanToLoanInstalments", testLoanToManyLoanInstalments)
+	t.Run("LoanToLoanRepayments", testLoanToManyLoanRepayments)
 	t.Run("ProductToInventories", testProductToManyInventories)
 	t.Run("ProductToProductCategories", testProductToManyProductCategories)
 	t.Run("ProductToSaleItems", testProductToManySaleItems)
 	t.Run("SaleToPayments", testSaleToManyPayments)
 	t.Run("SaleToSaleItems", testSaleToManySaleItems)
+	t.Run("TillSessionToTillCounts", testTillSessionToManyTillCounts)
+	t.Run("TransactionToApprovals", testTransactionToManyApprovals)
+	t.Run("TransactionToPayoutTransactionDSCycles", testTransactionToManyPayoutTransactionDSCycles)
+	t.Run("TransactionToImportRows", testTransactionToManyImportRows)
+	t.Run("TransactionToDisbursementTransactionLoans", testTransactionToManyDisbursementTransactionLoans)
+	t.Run("TransactionToLoanRepayments", testTransactionToManyLoanRepayments)
+	t.Run("TransactionToCorrectionOfTransactions", testTransactionToManyCorrectionOfTransactions)
+	t.Run("TransactionToReversalOfTransactions", testTransactionToManyReversalOfTransactions)
+	t.Run("TransactionToWithdrawalRequests", testTransactionToManyWithdrawalRequests)
+	t.Run("TransferToApprovals", testTransferToManyApprovals)
+	t.Run("TransferToTransactions", testTransferToManyTransactions)
 	t.Run("UserToSalesRepAccounts", testUserToManySalesRepAccounts)
+	t.Run("UserToSalesRepAccountFollowUps", testUserToManySalesRepAccountFollowUps)
+	t.Run("UserToCreatedByAccountHolds", testUserToManyCreatedByAccountHolds)
+	t.Run("UserToReleasedByAccountHolds", testUserToManyReleasedByAccountHolds)
+	t.Run("UserToChangedByAccountStatusChanges", testUserToManyChangedByAccountStatusChanges)
+	t.Run("UserToDecidedByApprovals", testUserToManyDecidedByApprovals)
+	t.Run("UserToRequestedByApprovals", testUserToManyRequestedByApprovals)
+	t.Run("UserToKycVerifiedByCustomers", testUserToManyKycVerifiedByCustomers)
 	t.Run("UserToSalesRepCustomers", testUserToManySalesRepCustomers)
+	t.Run("UserToUploadedByCustomerDocuments", testUserToManyUploadedByCustomerDocuments)
+	t.Run("UserToMergedByCustomerMerges", testUserToManyMergedByCustomerMerges)
+	t.Run("UserToSettledByDSCycles", testUserToManySettledByDSCycles)
+	t.Run("UserToCreatedByImportJobs", testUserToManyCreatedByImportJobs)
+	t.Run("UserToSalesRepImportJobs", testUserToManySalesRepImportJobs)
 	t.Run("UserToSalesRepInventories", testUserToManySalesRepInventories)
+	t.Run("UserToCreatedByJournalEntries", testUserToManyCreatedByJournalEntries)
+	t.Run("UserToAppliedByLoans", testUserToManyAppliedByLoans)
+	t.Run("UserToApprovedByLoans", testUserToManyApprovedByLoans)
+	t.Run("UserToDisbursedByLoans", testUserToManyDisbursedByLoans)
+	t.Run("UserToSalesRepLoanRepayments", testUserToManySalesRepLoanRepayments)
 	t.Run("UserToSalesRepPayments", testUserToManySalesRepPayments)
 	t.Run("UserToArchivedByProducts", testUserToManyArchivedByProducts)
 	t.Run("UserToCreatedByProducts", testUserToManyCreatedByProducts)
@@ -355,7 +775,13 @@ func TestToMany(t *testing.T) {
 	t.Run("UserToArchivedBySales", testUserToManyArchivedBySales)
 	t.Run("UserToCreatedBySales", testUserToManyCreatedBySales)
 	t.Run("UserToUpdatedBySales", testUserToManyUpdatedBySales)
+	t.Run("UserToSalesRepTillSessions", testUserToManySalesRepTillSessions)
+	t.Run("UserToSignedOffByTillSessions", testUserToManySignedOffByTillSessions)
+	t.Run("UserToSubmittedByTillSessions", testUserToManySubmittedByTillSessions)
+	t.Run("UserToApprovedByTransactions", testUserToManyApprovedByTransactions)
 	t.Run("UserToSalesRepTransactions", testUserToManySalesRepTransactions)
+	t.Run("UserToSalesRepTransfers", testUserToManySalesRepTransfers)
+	t.Run("UserToDecidedByWithdrawalRequests", testUserToManyDecidedByWithdrawalRequests)
 }
 
 // TestToOneSet tests cannot be run in parallel
@@ -363,17 +789,68 @@ func TestToMany(t *testing.T) {
 func TestToOneSet(t *testing.T) {
 	t.Run("AccountToBranchUsingAccounts", testAccountToOneSetOpBranchUsingBranch)
 	t.Run("AccountToCustomerUsingAccounts", testAccountToOneSetOpCustomerUsingCustomer)
+	t.Run("AccountToAccountProductUsingProductAccounts", testAccountToOneSetOpAccountProductUsingProduct)
 	t.Run("AccountToUserUsingSalesRepAccounts", testAccountToOneSetOpUserUsingSalesRep)
+	t.Run("AccountFollowUpToAccountUsingAccountFollowUps", testAccountFollowUpToOneSetOpAccountUsingAccount)
+	t.Run("AccountFollowUpToUserUsingSalesRepAccountFollowUps", testAccountFollowUpToOneSetOpUserUsingSalesRep)
+	t.Run("AccountHoldToAccountUsingAccountHolds", testAccountHoldToOneSetOpAccountUsingAccount)
+	t.Run("AccountHoldToUserUsingCreatedByAccountHolds", testAccountHoldToOneSetOpUserUsingCreatedBy)
+	t.Run("AccountHoldToUserUsingReleasedByAccountHolds", testAccountHoldToOneSetOpUserUsingReleasedBy)
+	t.Run("AccountStatusChangeToAccountUsingAccountStatusChanges", testAccountStatusChangeToOneSetOpAccountUsingAccount)
+	t.Run("AccountStatusChangeToUserUsingChangedByAccountStatusChanges", testAccountStatusChangeToOneSetOpUserUsingChangedBy)
+	t.Run("ApprovalToAccountUsingApprovals", testApprovalToOneSetOpAccountUsingAccount)
+	t.Run("ApprovalToBranchUsingApprovals", testApprovalToOneSetOpBranchUsingBranch)
+	t.Run("ApprovalToUserUsingDecidedByApprovals", testApprovalToOneSetOpUserUsingDecidedBy)
+	t.Run("ApprovalToUserUsingRequestedByApprovals", testApprovalToOneSetOpUserUsingRequestedBy)
+	t.Run("ApprovalToAccountUsingToAccountApprovals", testApprovalToOneSetOpAccountUsingToAccount)
+	t.Run("ApprovalToTransactionUsingApprovals", testApprovalToOneSetOpTransactionUsingTransaction)
+	t.Run("ApprovalToTransferUsingApprovals", testApprovalToOneSetOpTransferUsingTransfer)
 	t.Run("BankDepositToBankAccountUsingBankDeposits", testBankDepositToOneSetOpBankAccountUsingBankAccount)
 	t.Run("CustomerToBranchUsingCustomers", testCustomerToOneSetOpBranchUsingBranch)
+	t.Run("CustomerToUserUsingKycVerifiedByCustomers", testCustomerToOneSetOpUserUsingKycVerifiedBy)
+	t.Run("CustomerToCustomerUsingMergedIntoCustomers", testCustomerToOneSetOpCustomerUsingMergedInto)
 	t.Run("CustomerToUserUsingSalesRepCustomers", testCustomerToOneSetOpUserUsingSalesRep)
+	t.Run("CustomerDocumentToCustomerUsingCustomerDocuments", testCustomerDocumentToOneSetOpCustomerUsingCustomer)
+	t.Run("CustomerDocumentToUserUsingUploadedByCustomerDocuments", testCustomerDocumentToOneSetOpUserUsingUploadedBy)
+	t.Run("CustomerLoginCodeToCustomerUsingCustomerLoginCodes", testCustomerLoginCodeToOneSetOpCustomerUsingCustomer)
+	t.Run("CustomerMergeToCustomerUsingDuplicateCustomerMerges", testCustomerMergeToOneSetOpCustomerUsingDuplicate)
+	t.Run("CustomerMergeToUserUsingMergedByCustomerMerges", testCustomerMergeToOneSetOpUserUsingMergedBy)
+	t.Run("CustomerMergeToCustomerUsingSurvivorCustomerMerges", testCustomerMergeToOneSetOpCustomerUsingSurvivor)
 	t.Run("DSCommissionToAccountUsingDSCommissions", testDSCommissionToOneSetOpAccountUsingAccount)
 	t.Run("DSCommissionToCustomerUsingDSCommissions", testDSCommissionToOneSetOpCustomerUsingCustomer)
+	t.Run("DSCycleToAccountUsingDSCycles", testDSCycleToOneSetOpAccountUsingAccount)
+	t.Run("DSCycleToTransactionUsingPayoutTransactionDSCycles", testDSCycleToOneSetOpTransactionUsingPayoutTransaction)
+	t.Run("DSCycleToUserUsingSettledByDSCycles", testDSCycleToOneSetOpUserUsingSettledBy)
+	t.Run("ImportJobToBranchUsingImportJobs", testImportJobToOneSetOpBranchUsingBranch)
+	t.Run("ImportJobToUserUsingCreatedByImportJobs", testImportJobToOneSetOpUserUsingCreatedBy)
+	t.Run("ImportJobToUserUsingSalesRepImportJobs", testImportJobToOneSetOpUserUsingSalesRep)
+	t.Run("ImportRowToAccountUsingImportRows", testImportRowToOneSetOpAccountUsingAccount)
+	t.Run("ImportRowToCustomerUsingImportRows", testImportRowToOneSetOpCustomerUsingCustomer)
+	t.Run("ImportRowToImportJobUsingJobImportRows", testImportRowToOneSetOpImportJobUsingJob)
+	t.Run("ImportRowToTransactionUsingImportRows", testImportRowToOneSetOpTransactionUsingTransaction)
+	t.Run("InboundMessageToAccountUsingInboundMessages", testInboundMessageToOneSetOpAccountUsingAccount)
+	t.Run("InterestAccrualToAccountUsingInterestAccruals", testInterestAccrualToOneSetOpAccountUsingAccount)
 	t.Run("InventoryToBranchUsingInventories", testInventoryToOneSetOpBranchUsingBranch)
 	t.Run("InventoryToProductUsingInventories", testInventoryToOneSetOpProductUsingProduct)
 	t.Run("InventoryToUserUsingSalesRepInventories", testInventoryToOneSetOpUserUsingSalesRep)
+	t.Run("JournalEntryToUserUsingCreatedByJournalEntries", testJournalEntryToOneSetOpUserUsingCreatedBy)
+	t.Run("JournalEntryToJournalEntryUsingReversalOfJournalEntries", testJournalEntryToOneSetOpJournalEntryUsingReversalOf)
+	t.Run("LoanToAccountUsingLoans", testLoanToOneSetOpAccountUsingAccount)
+	t.Run("LoanToUserUsingAppliedByLoans", testLoanToOneSetOpUserUsingAppliedBy)
+	t.Run("LoanToUserUsingApprovedByLoans", testLoanToOneSetOpUserUsingApprovedBy)
+	t.Run("LoanToBranchUsingLoans", testLoanToOneSetOpBranchUsingBranch)
+	t.Run("LoanToCustomerUsingLoans", testLoanToOneSetOpCustomerUsingCustomer)
+	t.Run("LoanToUserUsingDisbursedByLoans", testLoanToOneSetOpUserUsingDisbursedBy)
+	t.Run("LoanToTransactionUsingDisbursementTransactionLoans", testLoanToOneSetOpTransactionUsingDisbursementTransaction)
+	t.Run("LoanInstalmentToLoanUsingLoanInstalments", testLoanInstalmentToOneSetOpLoanUsingLoan)
+	t.Run("LoanRepaymentToLoanUsingLoanRepayments", testLoanRepaymentToOneSetOpLoanUsingLoan)
+	t.Run("LoanRepaymentToUserUsingSalesRepLoanRepayments", testLoanRepaymentToOneSetOpUserUsingSalesRep)
+	t.Run("LoanRepaymentToTransactionUsingLoanRepayments", testLoanRepaymentToOneSetOpTransactionUsingTransaction)
 	t.Run("PaymentToSaleUsingPayments", testPaymentToOneSetOpSaleUsingSale)
 	t.Run("PaymentToUserUsingSalesRepPayments", testPaymentToOneSetOpUserUsingSalesRep)
+	t.Run("PostingToAccountUsingPostings", testPostingToOneSetOpAccountUsingAccount)
+	t.Run("PostingToJournalEntryUsingPostings", testPostingToOneSetOpJournalEntryUsingJournalEntry)
+	t.Run("PostingToLedgerAccountUsingLedgerAccountCodePostings", testPostingToOneSetOpLedgerAccountUsingLedgerAccountCodeLedgerAccount)
 	t.Run("ProductToUserUsingArchivedByProducts", testProductToOneSetOpUserUsingArchivedBy)
 	t.Run("ProductToBrandUsingProducts", testProductToOneSetOpBrandUsingBrand)
 	t.Run("ProductToCategoryUsingProducts", testProductToOneSetOpCategoryUsingCategory)
@@ -385,21 +862,69 @@ func TestToOneSet(t *testing.T) {
 	t.Run("SaleToUserUsingArchivedBySales", testSaleToOneSetOpUserUsingArchivedBy)
 	t.Run("SaleToBranchUsingSales", testSaleToOneSetOpBranchUsingBranch)
 	t.Run("SaleToUserUsingCreatedBySales", testSaleToOneSetOpUserUsingCreatedBy)
+	t.Run("SaleToAccountUsingCreditAccountSales", testSaleToOneSetOpAccountUsingCreditAccount)
 	t.Run("SaleToUserUsingUpdatedBySales", testSaleToOneSetOpUserUsingUpdatedBy)
 	t.Run("SaleItemToProductUsingSaleItems", testSaleItemToOneSetOpProductUsingProduct)
 	t.Run("SaleItemToSaleUsingSaleItems", testSaleItemToOneSetOpSaleUsingSale)
+	t.Run("TillCountToTillSessionUsingTillCounts", testTillCountToOneSetOpTillSessionUsingTillSession)
+	t.Run("TillSessionToBranchUsingTillSessions", testTillSessionToOneSetOpBranchUsingBranch)
+	t.Run("TillSessionToUserUsingSalesRepTillSessions", testTillSessionToOneSetOpUserUsingSalesRep)
+	t.Run("TillSessionToUserUsingSignedOffByTillSessions", testTillSessionToOneSetOpUserUsingSignedOffBy)
+	t.Run("TillSessionToUserUsingSubmittedByTillSessions", testTillSessionToOneSetOpUserUsingSubmittedBy)
 	t.Run("TransactionToAccountUsingTransactions", testTransactionToOneSetOpAccountUsingAccount)
+	t.Run("TransactionToUserUsingApprovedByTransactions", testTransactionToOneSetOpUserUsingApprovedBy)
+	t.Run("TransactionToTransactionUsingCorrectionOfTransactions", testTransactionToOneSetOpTransactionUsingCorrectionOf)
+	t.Run("TransactionToDSCycleUsingTransactions", testTransactionToOneSetOpDSCycleUsingDSCycle)
+	t.Run("TransactionToTransactionUsingReversalOfTransactions", testTransactionToOneSetOpTransactionUsingReversalOf)
 	t.Run("TransactionToUserUsingSalesRepTransactions", testTransactionToOneSetOpUserUsingSalesRep)
+	t.Run("TransactionToTransferUsingTransactions", testTransactionToOneSetOpTransferUsingTransfer)
+	t.Run("TransferToAccountUsingFromAccountTransfers", testTransferToOneSetOpAccountUsingFromAccount)
+	t.Run("TransferToUserUsingSalesRepTransfers", testTransferToOneSetOpUserUsingSalesRep)
+	t.Run("TransferToAccountUsingToAccountTransfers", testTransferToOneSetOpAccountUsingToAccount)
 	t.Run("UserToBranchUsingUsers", testUserToOneSetOpBranchUsingBranch)
+	t.Run("WithdrawalRequestToAccountUsingWithdrawalRequests", testWithdrawalRequestToOneSetOpAccountUsingAccount)
+	t.Run("WithdrawalRequestToCustomerUsingWithdrawalRequests", testWithdrawalRequestToOneSetOpCustomerUsingCustomer)
+	t.Run("WithdrawalRequestToUserUsingDecidedByWithdrawalRequests", testWithdrawalRequestToOneSetOpUserUsingDecidedBy)
+	t.Run("WithdrawalRequestToTransactionUsingWithdrawalRequests", testWithdrawalRequestToOneSetOpTransactionUsingTransaction)
 }
 
 // TestToOneRemove tests cannot be run in parallel
 // or deadlocks can occur.
 func TestToOneRemove(t *testing.T) {
+	t.Run("AccountHoldToUserUsingReleasedByAccountHolds", testAccountHoldToOneRemoveOpUserUsingReleasedBy)
+	t.Run("AccountStatusChangeToUserUsingChangedByAccountStatusChanges", testAccountStatusChangeToOneRemoveOpUserUsingChangedBy)
+	t.Run("ApprovalToUserUsingDecidedByApprovals", testApprovalToOneRemoveOpUserUsingDecidedBy)
+	t.Run("ApprovalToAccountUsingToAccountApprovals", testApprovalToOneRemoveOpAccountUsingToAccount)
+	t.Run("ApprovalToTransactionUsingApprovals", testApprovalToOneRemoveOpTransactionUsingTransaction)
+	t.Run("ApprovalToTransferUsingApprovals", testApprovalToOneRemoveOpTransferUsingTransfer)
+	t.Run("CustomerToUserUsingKycVerifiedByCustomers", testCustomerToOneRemoveOpUserUsingKycVerifiedBy)
+	t.Run("CustomerToCustomerUsingMergedIntoCustomers", testCustomerToOneRemoveOpCustomerUsingMergedInto)
+	t.Run("DSCycleToTransactionUsingPayoutTransactionDSCycles", testDSCycleToOneRemoveOpTransactionUsingPayoutTransaction)
+	t.Run("DSCycleToUserUsingSettledByDSCycles", testDSCycleToOneRemoveOpUserUsingSettledBy)
+	t.Run("ImportRowToAccountUsingImportRows", testImportRowToOneRemoveOpAccountUsingAccount)
+	t.Run("ImportRowToCustomerUsingImportRows", testImportRowToOneRemoveOpCustomerUsingCustomer)
+	t.Run("ImportRowToTransactionUsingImportRows", testImportRowToOneRemoveOpTransactionUsingTransaction)
+	t.Run("InboundMessageToAccountUsingInboundMessages", testInboundMessageToOneRemoveOpAccountUsingAccount)
+	t.Run("JournalEntryToUserUsingCreatedByJournalEntries", testJournalEntryToOneRemoveOpUserUsingCreatedBy)
+	t.Run("JournalEntryToJournalEntryUsingReversalOfJournalEntries", testJournalEntryToOneRemoveOpJournalEntryUsingReversalOf)
+	t.Run("LoanToUserUsingApprovedByLoans", testLoanToOneRemoveOpUserUsingApprovedBy)
+	t.Run("LoanToUserUsingDisbursedByLoans", testLoanToOneRemoveOpUserUsingDisbursedBy)
+	t.Run("LoanToTransactionUsingDisbursementTransactionLoans", testLoanToOneRemoveOpTransactionUsingDisbursementTransaction)
+	t.Run("LoanRepaymentToTransactionUsingLoanRepayments", testLoanRepaymentToOneRemoveOpTransactionUsingTransaction)
+	t.Run("PostingToAccountUsingPostings", testPostingToOneRemoveOpAccountUsingAccount)
 	t.Run("ProductToUserUsingArchivedByProducts", testProductToOneRemoveOpUserUsingArchivedBy)
 	t.Run("ProductToBrandUsingProducts", testProductToOneRemoveOpBrandUsingBrand)
 	t.Run("SaleToUserUsingArchivedBySales", testSaleToOneRemoveOpUserUsingArchivedBy)
+	t.Run("SaleToAccountUsingCreditAccountSales", testSaleToOneRemoveOpAccountUsingCreditAccount)
 	t.Run("SaleToUserUsingUpdatedBySales", testSaleToOneRemoveOpUserUsingUpdatedBy)
+	t.Run("TillSessionToUserUsingSignedOffByTillSessions", testTillSessionToOneRemoveOpUserUsingSignedOffBy)
+	t.Run("TransactionToUserUsingApprovedByTransactions", testTransactionToOneRemoveOpUserUsingApprovedBy)
+	t.Run("TransactionToTransactionUsingCorrectionOfTransactions", testTransactionToOneRemoveOpTransactionUsingCorrectionOf)
+	t.Run("TransactionToDSCycleUsingTransactions", testTransactionToOneRemoveOpDSCycleUsingDSCycle)
+	t.Run("TransactionToTransactionUsingReversalOfTransactions", testTransactionToOneRemoveOpTransactionUsingReversalOf)
+	t.Run("TransactionToTransferUsingTransactions", testTransactionToOneRemoveOpTransferUsingTransfer)
+	t.Run("WithdrawalRequestToUserUsingDecidedByWithdrawalRequests", testWithdrawalRequestToOneRemoveOpUserUsingDecidedBy)
+	t.Run("WithdrawalRequestToTransactionUsingWithdrawalRequests", testWithdrawalRequestToOneRemoveOpTransactionUsingTransaction)
 }
 
 // TestOneToOneSet tests cannot be run in parallel
@@ -413,27 +938,90 @@ func TestOneToOneRemove(t *testing.T) {}
 // TestToManyAdd tests cannot be run in parallel
 // or deadlocks can occur.
 func TestToManyAdd(t *testing.T) {
+	t.Run("AccountToAccountFollowUps", testAccountToManyAddOpAccountFollowUps)
+	t.Run("AccountToAccountHolds", testAccountToManyAddOpAccountHolds)
+	t.Run("AccountToAccountStatusChanges", testAccountToManyAddOpAccountStatusChanges)
+	t.Run("AccountToApprovals", testAccountToManyAddOpApprovals)
+	t.Run("AccountToToAccountApprovals", testAccountToManyAddOpToAccountApprovals)
 	t.Run("AccountToDSCommissions", testAccountToManyAddOpDSCommissions)
+	t.Run("AccountToDSCycles", testAccountToManyAddOpDSCycles)
+	t.Run("AccountToImportRows", testAccountToManyAddOpImportRows)
+	t.Run("AccountToInboundMessages", testAccountToManyAddOpInboundMessages)
+	t.Run("AccountToInterestAccruals", testAccountToManyAddOpInterestAccruals)
+	t.Run("AccountToLoans", testAccountToManyAddOpLoans)
+	t.Run("AccountToPostings", testAccountToManyAddOpPostings)
+	t.Run("AccountToCreditAccountSales", testAccountToManyAddOpCreditAccountSales)
 	t.Run("AccountToTransactions", testAccountToManyAddOpTransactions)
+	t.Run("AccountToFromAccountTransfers", testAccountToManyAddOpFromAccountTransfers)
+	t.Run("AccountToToAccountTransfers", testAccountToManyAddOpToAccountTransfers)
+	t.Run("AccountToWithdrawalRequests", testAccountToManyAddOpWithdrawalRequests)
+	t.Run("AccountProductToProductAccounts", testAccountProductToManyAddOpProductAccounts)
 	t.Run("BankAccountToBankDeposits", testBankAccountToManyAddOpBankDeposits)
 	t.Run("BranchToAccounts", testBranchToManyAddOpAccounts)
+	t.Run("BranchToApprovals", testBranchToManyAddOpApprovals)
 	t.Run("BranchToCustomers", testBranchToManyAddOpCustomers)
+	t.Run("BranchToImportJobs", testBranchToManyAddOpImportJobs)
 	t.Run("BranchToInventories", testBranchToManyAddOpInventories)
+	t.Run("BranchToLoans", testBranchToManyAddOpLoans)
 	t.Run("BranchToSales", testBranchToManyAddOpSales)
+	t.Run("BranchToTillSessions", testBranchToManyAddOpTillSessions)
 	t.Run("BranchToUsers", testBranchToManyAddOpUsers)
 	t.Run("BrandToProducts", testBrandToManyAddOpProducts)
 	t.Run("CategoryToProducts", testCategoryToManyAddOpProducts)
 	t.Run("CategoryToProductCategories", testCategoryToManyAddOpProductCategories)
 	t.Run("CustomerToAccounts", testCustomerToManyAddOpAccounts)
+	t.Run("CustomerToMergedIntoCustomers", testCustomerToManyAddOpMergedIntoCustomers)
+	t.Run("CustomerToCustomerDocuments", testCustomerToManyAddOpCustomerDocuments)
+	t.Run("CustomerToCustomerLoginCodes", testCustomerToManyAddOpCustomerLoginCodes)
+	t.Run("CustomerToDuplicateCustomerMerges", testCustomerToManyAddOpDuplicateCustomerMerges)
+	t.Run("CustomerToSurvivorCustomerMerges", testCustomerToManyAddOpSurvivorCustomerMerges)
 	t.Run("CustomerToDSCommissions", testCustomerToManyAddOpDSCommissions)
+	t.Run("CustomerToImportRows", testCustomerToManyAddOpImportRows)
+	t.Run("CustomerToLoans", testCustomerToManyAddOpLoans)
+	t.Run("CustomerToWithdrawalRequests", testCustomerToManyAddOpWithdrawalRequests)
+	t.Run("DSCycleToTransactions", testDSCycleToManyAddOpTransactions)
+	t.Run("ImportJobToJobImportRows", testImportJobToManyAddOpJobImportRows)
+	t.Run("JournalEntryToReversalOfJournalEntries", testJournalEntryToManyAddOpReversalOfJournalEntries)
+	t.Run("JournalEntryToPostings", testJournalEntryToManyAddOpPostings)
+	t.Run("LedgerAccountToLedgerAccountCodePostings", testLedgerAccountToManyAddOpLedgerAccountCodePostings)
+	t.Run("LoanToLoanInstalments", testLoanToManyAddOpLoanInstalments)
+	t.Run("LoanToLoanRepayments", testLoanToManyAddOpLoanRepayments)
 	t.Run("ProductToInventories", testProductToManyAddOpInventories)
 	t.Run("ProductToProductCategories", testProductToManyAddOpProductCategories)
 	t.Run("ProductToSaleItems", testProductToManyAddOpSaleItems)
 	t.Run("SaleToPayments", testSaleToManyAddOpPayments)
 	t.Run("SaleToSaleItems", testSaleToManyAddOpSaleItems)
+	t.Run("TillSessionToTillCounts", testTillSessionToManyAddOpTillCounts)
+	t.Run("TransactionToApprovals", testTransactionToManyAddOpApprovals)
+	t.Run("TransactionToPayoutTransactionDSCycles", testTransactionToManyAddOpPayoutTransactionDSCycles)
+	t.Run("TransactionToImportRows", testTransactionToManyAddOpImportRows)
+	t.Run("TransactionToDisbursementTransactionLoans", testTransactionToManyAddOpDisbursementTransactionLoans)
+	t.Run("TransactionToLoanRepayments", testTransactionToManyAddOpLoanRepayments)
+	t.Run("TransactionToCorrectionOfTransactions", testTransactionToManyAddOpCorrectionOfTransactions)
+	t.Run("TransactionToReversalOfTransactions", testTransactionToManyAddOpReversalOfTransactions)
+	t.Run("TransactionToWithdrawalRequests", testTransactionToManyAddOpWithdrawalRequests)
+	t.Run("TransferToApprovals", testTransferToManyAddOpApprovals)
+	t.Run("TransferToTransactions", testTransferToManyAddOpTransactions)
 	t.Run("UserToSalesRepAccounts", testUserToManyAddOpSalesRepAccounts)
+	t.Run("UserToSalesRepAccountFollowUps", testUserToManyAddOpSalesRepAccountFollowUps)
+	t.Run("UserToCreatedByAccountHolds", testUserToManyAddOpCreatedByAccountHolds)
+	t.Run("UserToReleasedByAccountHolds", testUserToManyAddOpReleasedByAccountHolds)
+	t.Run("UserToChangedByAccountStatusChanges", testUserToManyAddOpChangedByAccountStatusChanges)
+	t.Run("UserToDecidedByApprovals", testUserToManyAddOpDecidedByApprovals)
+	t.Run("UserToRequestedByApprovals", testUserToManyAddOpRequestedByApprovals)
+	t.Run("UserToKycVerifiedByCustomers", testUserToManyAddOpKycVerifiedByCustomers)
 	t.Run("UserToSalesRepCustomers", testUserToManyAddOpSalesRepCustomers)
+	t.Run("UserToUploadedByCustomerDocuments", testUserToManyAddOpUploadedByCustomerDocuments)
+	t.Run("UserToMergedByCustomerMerges", testUserToManyAddOpMergedByCustomerMerges)
+	t.Run("UserToSettledByDSCycles", testUserToManyAddOpSettledByDSCycles)
+	t.Run("UserToCreatedByImportJobs", testUserToManyAddOpCreatedByImportJobs)
+	t.Run("UserToSalesRepImportJobs", testUserToManyAddOpSalesRepImportJobs)
 	t.Run("UserToSalesRepInventories", testUserToManyAddOpSalesRepInventories)
+	t.Run("UserToCreatedByJournalEntries", testUserToManyAddOpCreatedByJournalEntries)
+	t.Run("UserToAppliedByLoans", testUserToManyAddOpAppliedByLoans)
+	t.Run("UserToApprovedByLoans", testUserToManyAddOpApprovedByLoans)
+	t.Run("UserToDisbursedByLoans", testUserToManyAddOpDisbursedByLoans)
+	t.Run("UserToSalesRepLoanRepayments", testUserToManyAddOpSalesRepLoanRepayments)
 	t.Run("UserToSalesRepPayments", testUserToManyAddOpSalesRepPayments)
 	t.Run("UserToArchivedByProducts", testUserToManyAddOpArchivedByProducts)
 	t.Run("UserToCreatedByProducts", testUserToManyAddOpCreatedByProducts)
@@ -442,138 +1030,324 @@ func TestToManyAdd(t *testing.T) {
 	t.Run("UserToArchivedBySales", testUserToManyAddOpArchivedBySales)
 	t.Run("UserToCreatedBySales", testUserToManyAddOpCreatedBySales)
 	t.Run("UserToUpdatedBySales", testUserToManyAddOpUpdatedBySales)
+	t.Run("UserToSalesRepTillSessions", testUserToManyAddOpSalesRepTillSessions)
+	t.Run("UserToSignedOffByTillSessions", testUserToManyAddOpSignedOffByTillSessions)
+	t.Run("UserToSubmittedByTillSessions", testUserToManyAddOpSubmittedByTillSessions)
+	t.Run("UserToApprovedByTransactions", testUserToManyAddOpApprovedByTransactions)
 	t.Run("UserToSalesRepTransactions", testUserToManyAddOpSalesRepTransactions)
+	t.Run("UserToSalesRepTransfers", testUserToManyAddOpSalesRepTransfers)
+	t.Run("UserToDecidedByWithdrawalRequests", testUserToManyAddOpDecidedByWithdrawalRequests)
 }
 
 // TestToManySet tests cannot be run in parallel
 // or deadlocks can occur.
 func TestToManySet(t *testing.T) {
+	t.Run("AccountToToAccountApprovals", testAccountToManySetOpToAccountApprovals)
+	t.Run("AccountToImportRows", testAccountToManySetOpImportRows)
+	t.Run("AccountToInboundMessages", testAccountToManySetOpInboundMessages)
+	t.Run("AccountToPostings", testAccountToManySetOpPostings)
+	t.Run("AccountToCreditAccountSales", testAccountToManySetOpCreditAccountSales)
 	t.Run("BrandToProducts", testBrandToManySetOpProducts)
+	t.Run("CustomerToMergedIntoCustomers", testCustomerToManySetOpMergedIntoCustomers)
+	t.Run("CustomerToImportRows", testCustomerToManySetOpImportRows)
+	t.Run("DSCycleToTransactions", testDSCycleToManySetOpTransactions)
+	t.Run("JournalEntryToReversalOfJournalEntries", testJournalEntryToManySetOpReversalOfJournalEntries)
+	t.Run("TransactionToApprovals", testTransactionToManySetOpApprovals)
+	t.Run("TransactionToPayoutTransactionDSCycles", testTransactionToManySetOpPayoutTransactionDSCycles)
+	t.Run("TransactionToImportRows", testTransactionToManySetOpImportRows)
+	t.Run("TransactionToDisbursementTransactionLoans", testTransactionToManySetOpDisbursementTransactionLoans)
+	t.Run("TransactionToLoanRepayments", testTransactionToManySetOpLoanRepayments)
+	t.Run("TransactionToCorrectionOfTransactions", testTransactionToManySetOpCorrectionOfTransactions)
+	t.Run("TransactionToReversalOfTransactions", testTransactionToManySetOpReversalOfTransactions)
+	t.Run("TransactionToWithdrawalRequests", testTransactionToManySetOpWithdrawalRequests)
+	t.Run("TransferToApprovals", testTransferToManySetOpApprovals)
+	t.Run("TransferToTransactions", testTransferToManySetOpTransactions)
+	t.Run("UserToReleasedByAccountHolds", testUserToManySetOpReleasedByAccountHolds)
+	t.Run("UserToChangedByAccountStatusChanges", testUserToManySetOpChangedByAccountStatusChanges)
+	t.Run("UserToDecidedByApprovals", testUserToManySetOpDecidedByApprovals)
+	t.Run("UserToKycVerifiedByCustomers", testUserToManySetOpKycVerifiedByCustomers)
+	t.Run("UserToSettledByDSCycles", testUserToManySetOpSettledByDSCycles)
+	t.Run("UserToCreatedByJournalEntries", testUserToManySetOpCreatedByJournalEntries)
+	t.Run("UserToApprovedByLoans", testUserToManySetOpApprovedByLoans)
+	t.Run("UserToDisbursedByLoans", testUserToManySetOpDisbursedByLoans)
 	t.Run("UserToArchivedByProducts", testUserToManySetOpArchivedByProducts)
 	t.Run("UserToArchivedBySales", testUserToManySetOpArchivedBySales)
 	t.Run("UserToUpdatedBySales", testUserToManySetOpUpdatedBySales)
+	t.Run("UserToSignedOffByTillSessions", testUserToManySetOpSignedOffByTillSessions)
+	t.Run("UserToApprovedByTransactions", testUserToManySetOpApprovedByTransactions)
+	t.Run("UserToDecidedByWithdrawalRequests", testUserToManySetOpDecidedByWithdrawalRequests)
 }
 
 // TestToManyRemove tests cannot be run in parallel
 // or deadlocks can occur.
 func TestToManyRemove(t *testing.T) {
+	t.Run("AccountToToAccountApprovals", testAccountToManyRemoveOpToAccountApprovals)
+	t.Run("AccountToImportRows", testAccountToManyRemoveOpImportRows)
+	t.Run("AccountToInboundMessages", testAccountToManyRemoveOpInboundMessages)
+	t.Run("AccountToPostings", testAccountToManyRemoveOpPostings)
+	t.Run("AccountToCreditAccountSales", testAccountToManyRemoveOpCreditAccountSales)
 	t.Run("BrandToProducts", testBrandToManyRemoveOpProducts)
+	t.Run("CustomerToMergedIntoCustomers", testCustomerToManyRemoveOpMergedIntoCustomers)
+	t.Run("CustomerToImportRows", testCustomerToManyRemoveOpImportRows)
+	t.Run("DSCycleToTransactions", testDSCycleToManyRemoveOpTransactions)
+	t.Run("JournalEntryToReversalOfJournalEntries", testJournalEntryToManyRemoveOpReversalOfJournalEntries)
+	t.Run("TransactionToApprovals", testTransactionToManyRemoveOpApprovals)
+	t.Run("TransactionToPayoutTransactionDSCycles", testTransactionToManyRemoveOpPayoutTransactionDSCycles)
+	t.Run("TransactionToImportRows", testTransactionToManyRemoveOpImportRows)
+	t.Run("TransactionToDisbursementTransactionLoans", testTransactionToManyRemoveOpDisbursementTransactionLoans)
+	t.Run("TransactionToLoanRepayments", testTransactionToManyRemoveOpLoanRepayments)
+	t.Run("TransactionToCorrectionOfTransactions", testTransactionToManyRemoveOpCorrectionOfTransactions)
+	t.Run("TransactionToReversalOfTransactions", testTransactionToManyRemoveOpReversalOfTransactions)
+	t.Run("TransactionToWithdrawalRequests", testTransactionToManyRemoveOpWithdrawalRequests)
+	t.Run("TransferToApprovals", testTransferToManyRemoveOpApprovals)
+	t.Run("TransferToTransactions", testTransferToManyRemoveOpTransactions)
+	t.Run("UserToReleasedByAccountHolds", testUserToManyRemoveOpReleasedByAccountHolds)
+	t.Run("UserToChangedByAccountStatusChanges", testUserToManyRemoveOpChangedByAccountStatusChanges)
+	t.Run("UserToDecidedByApprovals", testUserToManyRemoveOpDecidedByApprovals)
+	t.Run("UserToKycVerifiedByCustomers", testUserToManyRemoveOpKycVerifiedByCustomers)
+	t.Run("UserToSettledByDSCycles", testUserToManyRemoveOpSettledByDSCycles)
+	t.Run("UserToCreatedByJournalEntries", testUserToManyRemoveOpCreatedByJournalEntries)
+	t.Run("UserToApprovedByLoans", testUserToManyRemoveOpApprovedByLoans)
+	t.Run("UserToDisbursedByLoans", testUserToManyRemoveOpDisbursedByLoans)
 	t.Run("UserToArchivedByProducts", testUserToManyRemoveOpArchivedByProducts)
 	t.Run("UserToArchivedBySales", testUserToManyRemoveOpArchivedBySales)
 	t.Run("UserToUpdatedBySales", testUserToManyRemoveOpUpdatedBySales)
+	t.Run("UserToSignedOffByTillSessions", testUserToManyRemoveOpSignedOffByTillSessions)
+	t.Run("UserToApprovedByTransactions", testUserToManyRemoveOpApprovedByTransactions)
+	t.Run("UserToDecidedByWithdrawalRequests", testUserToManyRemoveOpDecidedByWithdrawalRequests)
 }
 
 func TestReload(t *testing.T) {
 	t.Run("Accounts", testAccountsReload)
+	t.Run("AccountFollowUps", testAccountFollowUpsReload)
+	t.Run("AccountHolds", testAccountHoldsReload)
+	t.Run("AccountProducts", testAccountProductsReload)
+	t.Run("AccountStatusChanges", testAccountStatusChangesReload)
+	t.Run("Approvals", testApprovalsReload)
 	t.Run("BankAccounts", testBankAccountsReload)
 	t.Run("BankDeposits", testBankDepositsReload)
 	t.Run("Branches", testBranchesReload)
 	t.Run("Brands", testBrandsReload)
 	t.Run("Categories", testCategoriesReload)
 	t.Run("Customers", testCustomersReload)
+	t.Run("CustomerDocuments", testCustomerDocumentsReload)
+	t.Run("CustomerLoginCodes", testCustomerLoginCodesReload)
+	t.Run("CustomerMerges", testCustomerMergesReload)
 	t.Run("DailySummaries", testDailySummariesReload)
 	t.Run("DSCommissions", testDSCommissionsReload)
+	t.Run("DSCycles", testDSCyclesReload)
 	t.Run("Expenditures", testExpendituresReload)
+	t.Run("IdempotencyKeys", testIdempotencyKeysReload)
+	t.Run("ImportJobs", testImportJobsReload)
+	t.Run("ImportRows", testImportRowsReload)
+	t.Run("InboundMessages", testInboundMessagesReload)
+	t.Run("InterestAccruals", testInterestAccrualsReload)
 	t.Run("Inventories", testInventoriesReload)
+	t.Run("JournalEntries", testJournalEntriesReload)
+	t.Run("LedgerAccounts", testLedgerAccountsReload)
+	t.Run("Loans", testLoansReload)
+	t.Run("LoanInstalments", testLoanInstalmentsReload)
+	t.Run("LoanRepayments", testLoanRepaymentsReload)
 	t.Run("Payments", testPaymentsReload)
+	t.Run("Postings", testPostingsReload)
 	t.Run("Products", testProductsReload)
 	t.Run("ProductCategories", testProductCategoriesReload)
 	t.Run("Profits", testProfitsReload)
 	t.Run("RepsExpenses", testRepsExpensesReload)
 	t.Run("Sales", testSalesReload)
 	t.Run("SaleItems", testSaleItemsReload)
+	t.Run("TillCounts", testTillCountsReload)
+	t.Run("TillSessions", testTillSessionsReload)
 	t.Run("Transactions", testTransactionsReload)
+	t.Run("Transfers", testTransfersReload)
 	t.Run("Users", testUsersReload)
+	t.Run("WithdrawalRequests", testWithdrawalRequestsReload)
 }
 
 func TestReloadAll(t *testing.T) {
 	t.Run("Accounts", testAccountsReloadAll)
+	t.Run("AccountFollowUps", testAccountFollowUpsReloadAll)
+	t.Run("AccountHolds", testAccountHoldsReloadAll)
+	t.Run("AccountProducts", testAccountProductsReloadAll)
+	t.Run("AccountStatusChanges", testAccountStatusChangesReloadAll)
+	t.Run("Approvals", testApprovalsReloadAll)
 	t.Run("BankAccounts", testBankAccountsReloadAll)
 	t.Run("BankDeposits", testBankDepositsReloadAll)
 	t.Run("Branches", testBranchesReloadAll)
 	t.Run("Brands", testBrandsReloadAll)
 	t.Run("Categories", testCategoriesReloadAll)
 	t.Run("Customers", testCustomersReloadAll)
+	t.Run("CustomerDocuments", testCustomerDocumentsReloadAll)
+	t.Run("CustomerLoginCodes", testCustomerLoginCodesReloadAll)
+	t.Run("CustomerMerges", testCustomerMergesReloadAll)
 	t.Run("DailySummaries", testDailySummariesReloadAll)
 	t.Run("DSCommissions", testDSCommissionsReloadAll)
+	t.Run("DSCycles", testDSCyclesReloadAll)
 	t.Run("Expenditures", testExpendituresReloadAll)
+	t.Run("IdempotencyKeys", testIdempotencyKeysReloadAll)
+	t.Run("ImportJobs", testImportJobsReloadAll)
+	t.Run("ImportRows", testImportRowsReloadAll)
+	t.Run("InboundMessages", testInboundMessagesReloadAll)
+	t.Run("InterestAccruals", testInterestAccrualsReloadAll)
 	t.Run("Inventories", testInventoriesReloadAll)
+	t.Run("JournalEntries", testJournalEntriesReloadAll)
+	t.Run("LedgerAccounts", testLedgerAccountsReloadAll)
+	t.Run("Loans", testLoansReloadAll)
+	t.Run("LoanInstalments", testLoanInstalmentsReloadAll)
+	t.Run("LoanRepayments", testLoanRepaymentsReloadAll)
 	t.Run("Payments", testPaymentsReloadAll)
+	t.Run("Postings", testPostingsReloadAll)
 	t.Run("Products", testProductsReloadAll)
 	t.Run("ProductCategories", testProductCategoriesReloadAll)
 	t.Run("Profits", testProfitsReloadAll)
 	t.Run("RepsExpenses", testRepsExpensesReloadAll)
 	t.Run("Sales", testSalesReloadAll)
 	t.Run("SaleItems", testSaleItemsReloadAll)
+	t.Run("TillCounts", testTillCountsReloadAll)
+	t.Run("TillSessions", testTillSessionsReloadAll)
 	t.Run("Transactions", testTransactionsReloadAll)
+	t.Run("Transfers", testTransfersReloadAll)
 	t.Run("Users", testUsersReloadAll)
+	t.Run("WithdrawalRequests", testWithdrawalRequestsReloadAll)
 }
 
 func TestSelect(t *testing.T) {
 	t.Run("Accounts", testAccountsSelect)
+	t.Run("AccountFollowUps", testAccountFollowUpsSelect)
+	t.Run("AccountHolds", testAccountHoldsSelect)
+	t.Run("AccountProducts", testAccountProductsSelect)
+	t.Run("AccountStatusChanges", testAccountStatusChangesSelect)
+	t.Run("Approvals", testApprovalsSelect)
 	t.Run("BankAccounts", testBankAccountsSelect)
 	t.Run("BankDeposits", testBankDepositsSelect)
 	t.Run("Branches", testBranchesSelect)
 	t.Run("Brands", testBrandsSelect)
 	t.Run("Categories", testCategoriesSelect)
 	t.Run("Customers", testCustomersSelect)
+	t.Run("CustomerDocuments", testCustomerDocumentsSelect)
+	t.Run("CustomerLoginCodes", testCustomerLoginCodesSelect)
+	t.Run("CustomerMerges", testCustomerMergesSelect)
 	t.Run("DailySummaries", testDailySummariesSelect)
 	t.Run("DSCommissions", testDSCommissionsSelect)
+	t.Run("DSCycles", testDSCyclesSelect)
 	t.Run("Expenditures", testExpendituresSelect)
+	t.Run("IdempotencyKeys", testIdempotencyKeysSelect)
+	t.Run("ImportJobs", testImportJobsSelect)
+	t.Run("ImportRows", testImportRowsSelect)
+	t.Run("InboundMessages", testInboundMessagesSelect)
+	t.Run("InterestAccruals", testInterestAccrualsSelect)
 	t.Run("Inventories", testInventoriesSelect)
+	t.Run("JournalEntries", testJournalEntriesSelect)
+	t.Run("LedgerAccounts", testLedgerAccountsSelect)
+	t.Run("Loans", testLoansSelect)
+	t.Run("LoanInstalments", testLoanInstalmentsSelect)
+	t.Run("LoanRepayments", testLoanRepaymentsSelect)
 	t.Run("Payments", testPaymentsSelect)
+	t.Run("Postings", testPostingsSelect)
 	t.Run("Products", testProductsSelect)
 	t.Run("ProductCategories", testProductCategoriesSelect)
 	t.Run("Profits", testProfitsSelect)
 	t.Run("RepsExpenses", testRepsExpensesSelect)
 	t.Run("Sales", testSalesSelect)
 	t.Run("SaleItems", testSaleItemsSelect)
+	t.Run("TillCounts", testTillCountsSelect)
+	t.Run("TillSessions", testTillSessionsSelect)
 	t.Run("Transactions", testTransactionsSelect)
+	t.Run("Transfers", testTransfersSelect)
 	t.Run("Users", testUsersSelect)
+	t.Run("WithdrawalRequests", testWithdrawalRequestsSelect)
 }
 
 func TestUpdate(t *testing.T) {
 	t.Run("Accounts", testAccountsUpdate)
+	t.Run("AccountFollowUps", testAccountFollowUpsUpdate)
+	t.Run("AccountHolds", testAccountHoldsUpdate)
+	t.Run("AccountProducts", testAccountProductsUpdate)
+	t.Run("AccountStatusChanges", testAccountStatusChangesUpdate)
+	t.Run("Approvals", testApprovalsUpdate)
 	t.Run("BankAccounts", testBankAccountsUpdate)
 	t.Run("BankDeposits", testBankDepositsUpdate)
 	t.Run("Branches", testBranchesUpdate)
 	t.Run("Brands", testBrandsUpdate)
 	t.Run("Categories", testCategoriesUpdate)
 	t.Run("Customers", testCustomersUpdate)
+	t.Run("CustomerDocuments", testCustomerDocumentsUpdate)
+	t.Run("CustomerLoginCodes", testCustomerLoginCodesUpdate)
+	t.Run("CustomerMerges", testCustomerMergesUpdate)
 	t.Run("DailySummaries", testDailySummariesUpdate)
 	t.Run("DSCommissions", testDSCommissionsUpdate)
+	t.Run("DSCycles", testDSCyclesUpdate)
 	t.Run("Expenditures", testExpendituresUpdate)
+	t.Run("IdempotencyKeys", testIdempotencyKeysUpdate)
+	t.Run("ImportJobs", testImportJobsUpdate)
+	t.Run("ImportRows", testImportRowsUpdate)
+	t.Run("InboundMessages", testInboundMessagesUpdate)
+	t.Run("InterestAccruals", testInterestAccrualsUpdate)
 	t.Run("Inventories", testInventoriesUpdate)
+	t.Run("JournalEntries", testJournalEntriesUpdate)
+	t.Run("LedgerAccounts", testLedgerAccountsUpdate)
+	t.Run("Loans", testLoansUpdate)
+	t.Run("LoanInstalments", testLoanInstalmentsUpdate)
+	t.Run("LoanRepayments", testLoanRepaymentsUpdate)
 	t.Run("Payments", testPaymentsUpdate)
+	t.Run("Postings", testPostingsUpdate)
 	t.Run("Products", testProductsUpdate)
 	t.Run("ProductCategories", testProductCategoriesUpdate)
 	t.Run("Profits", testProfitsUpdate)
 	t.Run("RepsExpenses", testRepsExpensesUpdate)
 	t.Run("Sales", testSalesUpdate)
 	t.Run("SaleItems", testSaleItemsUpdate)
+	t.Run("TillCounts", testTillCountsUpdate)
+	t.Run("TillSessions", testTillSessionsUpdate)
 	t.Run("Transactions", testTransactionsUpdate)
+	t.Run("Transfers", testTransfersUpdate)
 	t.Run("Users", testUsersUpdate)
+	t.Run("WithdrawalRequests", testWithdrawalRequestsUpdate)
 }
 
 func TestSliceUpdateAll(t *testing.T) {
 	t.Run("Accounts", testAccountsSliceUpdateAll)
+	t.Run("AccountFollowUps", testAccountFollowUpsSliceUpdateAll)
+	t.Run("AccountHolds", testAccountHoldsSliceUpdateAll)
+	t.Run("AccountProducts", testAccountProductsSliceUpdateAll)
+	t.Run("AccountStatusChanges", testAccountStatusChangesSliceUpdateAll)
+	t.Run("Approvals", testApprovalsSliceUpdateAll)
 	t.Run("BankAccounts", testBankAccountsSliceUpdateAll)
 	t.Run("BankDeposits", testBankDepositsSliceUpdateAll)
 	t.Run("Branches", testBranchesSliceUpdateAll)
 	t.Run("Brands", testBrandsSliceUpdateAll)
 	t.Run("Categories", testCategoriesSliceUpdateAll)
 	t.Run("Customers", testCustomersSliceUpdateAll)
+	t.Run("CustomerDocuments", testCustomerDocumentsSliceUpdateAll)
+	t.Run("CustomerLoginCodes", testCustomerLoginCodesSliceUpdateAll)
+	t.Run("CustomerMerges", testCustomerMergesSliceUpdateAll)
 	t.Run("DailySummaries", testDailySummariesSliceUpdateAll)
 	t.Run("DSCommissions", testDSCommissionsSliceUpdateAll)
+	t.Run("DSCycles", testDSCyclesSliceUpdateAll)
 	t.Run("Expenditures", testExpendituresSliceUpdateAll)
+	t.Run("IdempotencyKeys", testIdempotencyKeysSliceUpdateAll)
+	t.Run("ImportJobs", testImportJobsSliceUpdateAll)
+	t.Run("ImportRows", testImportRowsSliceUpdateAll)
+	t.Run("InboundMessages", testInboundMessagesSliceUpdateAll)
+	t.Run("InterestAccruals", testInterestAccrualsSliceUpdateAll)
 	t.Run("Inventories", testInventoriesSliceUpdateAll)
+	t.Run("JournalEntries", testJournalEntriesSliceUpdateAll)
+	t.Run("LedgerAccounts", testLedgerAccountsSliceUpdateAll)
+	t.Run("Loans", testLoansSliceUpdateAll)
+	t.Run("LoanInstalments", testLoanInstalmentsSliceUpdateAll)
+	t.Run("LoanRepayments", testLoanRepaymentsSliceUpdateAll)
 	t.Run("Payments", testPaymentsSliceUpdateAll)
+	t.Run("Postings", testPostingsSliceUpdateAll)
 	t.Run("Products", testProductsSliceUpdateAll)
 	t.Run("ProductCategories", testProductCategoriesSliceUpdateAll)
 	t.Run("Profits", testProfitsSliceUpdateAll)
 	t.Run("RepsExpenses", testRepsExpensesSliceUpdateAll)
 	t.Run("Sales", testSalesSliceUpdateAll)
 	t.Run("SaleItems", testSaleItemsSliceUpdateAll)
+	t.Run("TillCounts", testTillCountsSliceUpdateAll)
+	t.Run("TillSessions", testTillSessionsSliceUpdateAll)
 	t.Run("Transactions", testTransactionsSliceUpdateAll)
+	t.Run("Transfers", testTransfersSliceUpdateAll)
 	t.Run("Users", testUsersSliceUpdateAll)
+	t.Run("WithdrawalRequests", testWithdrawalRequestsSliceUpdateAll)
 }
@@ -4,45 +4,93 @@
 package models
 
 var TableNames = struct {
-	Account         string
-	BankAccount     string
-	BankDeposit     string
-	Branch          string
-	Brand           string
-	Category        string
-	Customer        string
-	DailySummary    string
-	DSCommission    string
-	Expenditure     string
-	Inventory       string
-	Payment         string
-	Product         string
-	ProductCategory string
-	Profit          string
-	RepsExpense     string
-	Sale            string
-	SaleItem        string
-	Transaction     string
-	Users           string
+	Account             string
+	AccountFollowUp     string
+	AccountHold         string
+	AccountProduct      string
+	AccountStatusChange string
+	Approval            string
+	BankAccount         string
+	BankDeposit         string
+	Branch              string
+	Brand               string
+	Category            string
+	Customer            string
+	CustomerDocument    string
+	CustomerLoginCode   string
+	CustomerMerge       string
+	DailySummary        string
+	DSCommission        string
+	DSCycle             string
+	Expenditure         string
+	IdempotencyKey      string
+	ImportJob           string
+	ImportRow           string
+	InboundMessage      string
+	InterestAccrual     string
+	Inventory           string
+	JournalEntry        string
+	LedgerAccount       string
+	Loan                string
+	LoanInstalment      string
+	LoanRepayment       string
+	Payment             string
+	Posting             string
+	Product             string
+	ProductCategory     string
+	Profit              string
+	RepsExpense         string
+	Sale                string
+	SaleItem            string
+	TillCount           string
+	TillSession         string
+	Transaction         string
+	Transfer            string
+	Users               string
+	WithdrawalRequest   string
 }{
-	Account:         "account",
-	BankAccount:     "bank_account",
-	BankDeposit:     "bank_deposit",
-	Branch:          "branch",
-	Brand:           "brand",
-	Category:        "category",
-	Customer:        "customer",
-	DailySummary:    "daily_summary",
-	DSCommission:    "ds_commission",
-	Expenditure:     "expenditure",
-	Inventory:       "inventory",
-	Payment:         "payment",
-	Product:         "product",
-	ProductCategory: "product_category",
-	Profit:          "profit",
-	RepsExpense:     "reps_expense",
-	Sale:            "sale",
-	SaleItem:        "sale_item",
-	Transaction:     "transaction",
-	Users:           "users",
+	Account:             "account",
+	AccountFollowUp:     "account_follow_up",
+	AccountHold:         "account_hold",
+	AccountProduct:      "account_product",
+	AccountStatusChange: "account_status_change",
+	Approval:            "approval",
+	BankAccount:         "bank_account",
+	BankDeposit:         "bank_deposit",
+	Branch:              "branch",
+	Brand:               "brand",
+	Category:            "category",
+	Customer:            "customer",
+	CustomerDocument:    "customer_document",
+	CustomerLoginCode:   "customer_login_code",
+	CustomerMerge:       "customer_merge",
+	DailySummary:        "daily_summary",
+	DSCommission:        "ds_commission",
+	DSCycle:             "ds_cycle",
+	Expenditure:         "expenditure",
+	IdempotencyKey:      "idempotency_key",
+	ImportJob:           "import_job",
+	ImportRow:           "import_row",
+	InboundMessage:      "inbound_message",
+	InterestAccrual:     "interest_accrual",
+	Inventory:           "inventory",
+	JournalEntry:        "journal_entry",
+	LedgerAccount:       "ledger_account",
+	Loan:                "loan",
+	LoanInstalment:      "loan_instalment",
+	LoanRepayment:       "loan_repayment",
+	Payment:             "payment",
+	Posting:             "posting",
+	Product:             "product",
+	ProductCategory:     "product_category",
+	Profit:              "profit",
+	RepsExpense:         "reps_expense",
+	Sale:                "sale",
+	SaleItem:            "sale_item",
+	TillCount:           "till_count",
+	TillSession:         "till_session",
+	Transaction:         "transaction",
+	Transfer:            "transfer",
+	Users:               "users",
+	WithdrawalRequest:   "withdrawal_request",
 }
@@ -24,82 +24,108 @@ import (
 
 // Branch is an object representing the database table.
 type Branch struct {
-	ID         string     `boil:"id" json:"id" toml:"id" yaml:"id"`
-	Name       string     `boil:"name" json:"name" toml:"name" yaml:"name"`
-	CreatedAt  int64      `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
-	UpdatedAt  int64      `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
-	ArchivedAt null.Int64 `boil:"archived_at" json:"archived_at,omitempty" toml:"archived_at" yaml:"archived_at,omitempty"`
+	ID                string     `boil:"id" json:"id" toml:"id" yaml:"id"`
+	Name              string     `boil:"name" json:"name" toml:"name" yaml:"name"`
+	CreatedAt         int64      `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedAt         int64      `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+	ArchivedAt        null.Int64 `boil:"archived_at" json:"archived_at,omitempty" toml:"archived_at" yaml:"archived_at,omitempty"`
+	ApprovalThreshold int64      `boil:"approval_threshold" json:"approval_threshold" toml:"approval_threshold" yaml:"approval_threshold"`
+	CommissionPolicy  string     `boil:"commission_policy" json:"commission_policy" toml:"commission_policy" yaml:"commission_policy"`
 
 	R *branchR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L branchL  `boil:"-" json:"-" toml:"-" yaml:"-"`
 }
 
 var BranchColumns = struct {
-	ID         string
-	Name       string
-	CreatedAt  string
-	UpdatedAt  string
-	ArchivedAt string
+	ID                string
+	Name              string
+	CreatedAt         string
+	UpdatedAt         string
+	ArchivedAt        string
+	ApprovalThreshold string
+	CommissionPolicy  string
 }{
-	ID:         "id",
-	Name:       "name",
-	CreatedAt:  "created_at",
-	UpdatedAt:  "updated_at",
-	ArchivedAt: "archived_at",
+	ID:                "id",
+	Name:              "name",
+	CreatedAt:         "created_at",
+	UpdatedAt:         "updated_at",
+	ArchivedAt:        "archived_at",
+	ApprovalThreshold: "approval_threshold",
+	CommissionPolicy:  "commission_policy",
 }
 
 var BranchTableColumns = struct {
-	ID         string
-	Name       string
-	CreatedAt  string
-	UpdatedAt  string
-	ArchivedAt string
+	ID                string
+	Name              string
+	CreatedAt         string
+	UpdatedAt         string
+	ArchivedAt        string
+	ApprovalThreshold string
+	CommissionPolicy  string
 }{
-	ID:         "branch.id",
-	Name:       "branch.name",
-	CreatedAt:  "branch.created_at",
-	UpdatedAt:  "branch.updated_at",
-	ArchivedAt: "branch.archived_at",
+	ID:                "branch.id",
+	Name:              "branch.name",
+	CreatedAt:         "branch.created_at",
+	UpdatedAt:         "branch.updated_at",
+	ArchivedAt:        "branch.archived_at",
+	ApprovalThreshold: "branch.approval_threshold",
+	CommissionPolicy:  "branch.commission_policy",
 }
 
 // Generated where
 
 var BranchWhere = struct {
-	ID         whereHelperstring
-	Name       whereHelperstring
-	CreatedAt  whereHelperint64
-	UpdatedAt  whereHelperint64
-	ArchivedAt whereHelpernull_Int64
+	ID                whereHelperstring
+	Name              whereHelperstring
+	CreatedAt         whereHelperint64
+	UpdatedAt         whereHelperint64
+	ArchivedAt        whereHelpernull_Int64
+	ApprovalThreshold whereHelperint64
+	CommissionPolicy  whereHelperstring
 }{
-	ID:         whereHelperstring{field: "\"branch\".\"id\""},
-	Name:       whereHelperstring{field: "\"branch\".\"name\""},
-	CreatedAt:  whereHelperint64{field: "\"branch\".\"created_at\""},
-	UpdatedAt:  whereHelperint64{field: "\"branch\".\"updated_at\""},
-	ArchivedAt: whereHelpernull_Int64{field: "\"branch\".\"archived_at\""},
+	ID:                whereHelperstring{field: "\"branch\".\"id\""},
+	Name:              whereHelperstring{field: "\"branch\".\"name\""},
+	CreatedAt:         whereHelperint64{field: "\"branch\".\"created_at\""},
+	UpdatedAt:         whereHelperint64{field: "\"branch\".\"updated_at\""},
+	ArchivedAt:        whereHelpernull_Int64{field: "\"branch\".\"archived_at\""},
+	ApprovalThreshold: whereHelperint64{field: "\"branch\".\"approval_threshold\""},
+	CommissionPolicy:  whereHelperstring{field: "\"branch\".\"commission_policy\""},
 }
 
 // BranchRels is where relationship names are stored.
 var BranchRels = struct {
-	Accounts    string
-	Customers   string
-	Inventories string
-	Sales       string
-	Users       string
+	Accounts     string
+	Approvals    string
+	Customers    string
+	ImportJobs   string
+	Inventories  string
+	Loans        string
+	Sales        string
+	TillSessions string
+	Users        string
 }{
-	Accounts:    "Accounts",
-	Customers:   "Customers",
-	Inventories: "Inventories",
-	Sales:       "Sales",
-	Users:       "Users",
+	Accounts:     "Accounts",
+	Approvals:    "Approvals",
+	Customers:    "Customers",
+	ImportJobs:   "ImportJobs",
+	Inventories:  "Inventories",
+	Loans:        "Loans",
+	Sales:        "Sales",
+	TillSessions: "TillSessions",
+	Users:        "Users",
 }
 
 // branchR is where relationships are stored.
 type branchR struct {
-	Accounts    AccountSlice   `boil:"Accounts" json:"Accounts" toml:"Accounts" yaml:"Accounts"`
-	Customers   CustomerSlice  `boil:"Customers" json:"Customers" toml:"Customers" yaml:"Customers"`
-	Inventories InventorySlice `boil:"Inventories" json:"Inventories" toml:"Inventories" yaml:"Inventories"`
-	Sales       SaleSlice      `boil:"Sales" json:"Sales" toml:"Sales" yaml:"Sales"`
-	Users       UserSlice      `boil:"Users" json:"Users" toml:"Users" yaml:"Users"`
+	Accounts     AccountSlice     `boil:"Accounts" json:"Accounts" toml:"Accounts" yaml:"Accounts"`
+	Approvals    ApprovalSlice    `boil:"Approvals" json:"Approvals" toml:"Approvals" yaml:"Approvals"`
+	Customers    CustomerSlice    `boil:"Customers" json:"Customers" toml:"Customers" yaml:"Customers"`
+	ImportJobs   ImportJobSlice   `boil:"ImportJobs" json:"ImportJobs" toml:"ImportJobs" yaml:"ImportJobs"`
+	Inventories  InventorySlice   `boil:"Inventories" json:"Inventories" toml:"Inventories" yaml:"Inventories"`
+	Loans        LoanSlice        `boil:"Loans" json:"Loans" toml:"Loans" yaml:"Loans"`
+	Sales        SaleSlice        `boil:"Sales" json:"Sales" toml:"Sales" yaml:"Sales"`
+	TillSessions TillSessionSlice `boil:"TillSessions" json:"TillSessions" toml:"TillSessions" yaml:"TillSessions"`
+	Users        UserSlice        `boil:"Users" json:"Users" toml:"Users" yaml:"Users"`
 }
 
 // NewStruct creates a new relationship struct
@@ -111,9 +137,9 @@ func (*branchR) NewStruct() *branchR {
 type branchL struct{}
 
 var (
-	branchAllColumns            = []string{"id", "name", "created_at", "updated_at", "archived_at"}
+	branchAllColumns            = []string{"id", "name", "created_at", "updated_at", "archived_at", "approval_threshold", "commission_policy"}
 	branchColumnsWithoutDefault = []string{"id", "created_at", "updated_at", "archived_at"}
-	branchColumnsWithDefault    = []string{"name"}
+	branchColumnsWithDefault    = []string{"name", "approval_threshold", "commission_policy"}
 	branchPrimaryKeyColumns     = []string{"id"}
 )
 
@@ -229,6 +255,27 @@ func (o *Branch) Accounts(mods ...qm.QueryMod) accountQuery {
 	return query
 }
 
+// Approvals retrieves all the approval's Approvals with an executor.
+func (o *Branch) Approvals(mods ...qm.QueryMod) approvalQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"approval\".\"branch_id\"=?", o.ID),
+	)
+
+	query := Approvals(queryMods...)
+	queries.SetFrom(query.Query, "\"approval\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"approval\".*"})
+	}
+
+	return query
+}
+
 // Customers retrieves all the customer's Customers with an executor.
 func (o *Branch) Customers(mods ...qm.QueryMod) customerQuery {
 	var queryMods []qm.QueryMod
@@ -250,6 +297,27 @@ func (o *Branch) Customers(mods ...qm.QueryMod) customerQuery {
 	return query
 }
 
+// ImportJobs retrieves all the import_job's ImportJobs with an executor.
+func (o *Branch) ImportJobs(mods ...qm.QueryMod) importJobQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"import_job\".\"branch_id\"=?", o.ID),
+	)
+
+	query := ImportJobs(queryMods...)
+	queries.SetFrom(query.Query, "\"import_job\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"import_job\".*"})
+	}
+
+	return query
+}
+
 // Inventories retrieves all the inventory's Inventories with an executor.
 func (o *Branch) Inventories(mods ...qm.QueryMod) inventoryQuery {
 	var queryMods []qm.QueryMod
@@ -271,6 +339,27 @@ func (o *Branch) Inventories(mods ...qm.QueryMod) inventoryQuery {
 	return query
 }
 
+// Loans retrieves all the loan's Loans with an executor.
+func (o *Branch) Loans(mods ...qm.QueryMod) loanQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"loan\".\"branch_id\"=?", o.ID),
+	)
+
+	query := Loans(queryMods...)
+	queries.SetFrom(query.Query, "\"loan\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"loan\".*"})
+	}
+
+	return query
+}
+
 // Sales retrieves all the sale's Sales with an executor.
 func (o *Branch) Sales(mods ...qm.QueryMod) saleQuery {
 	var queryMods []qm.QueryMod
@@ -292,6 +381,27 @@ func (o *Branch) Sales(mods ...qm.QueryMod) saleQuery {
 	return query
 }
 
+// TillSessions retrieves all the till_session's TillSessions with an executor.
+func (o *Branch) TillSessions(mods ...qm.QueryMod) tillSessionQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"till_session\".\"branch_id\"=?", o.ID),
+	)
+
+	query := TillSessions(queryMods...)
+	queries.SetFrom(query.Query, "\"till_session\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"till_session\".*"})
+	}
+
+	return query
+}
+
 // Users retrieves all the user's Users with an executor.
 func (o *Branch) Users(mods ...qm.QueryMod) userQuery {
 	var queryMods []qm.QueryMod
@@ -315,7 +425,371 @@ func (o *Branch) Users(mods ...qm.QueryMod) userQuery {
 
 // LoadAccounts allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (branchL) LoadAccounts(ctx context.Context, e boil.ContextExecutor, singular bool, maybeBranch interface{}, mods queries.Applicator) error {
+func (branchL) LoadAccounts(ctx context.Context, e boil.ContextExecutor, singular bool, maybeBranch interface{}, mods queries.Applicator) error {
+	var slice []*Branch
+	var object *Branch
+
+	if singular {
+		object = maybeBranch.(*Branch)
+	} else {
+		slice = *maybeBranch.(*[]*Branch)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &branchR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &branchR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`account`),
+		qm.WhereIn(`account.branch_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load account")
+	}
+
+	var resultSlice []*Account
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice account")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on account")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for account")
+	}
+
+	if singular {
+		object.R.Accounts = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &accountR{}
+			}
+			foreign.R.Branch = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.BranchID {
+				local.R.Accounts = append(local.R.Accounts, foreign)
+				if foreign.R == nil {
+					foreign.R = &accountR{}
+				}
+				foreign.R.Branch = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadApprovals allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (branchL) LoadApprovals(ctx context.Context, e boil.ContextExecutor, singular bool, maybeBranch interface{}, mods queries.Applicator) error {
+	var slice []*Branch
+	var object *Branch
+
+	if singular {
+		object = maybeBranch.(*Branch)
+	} else {
+		slice = *maybeBranch.(*[]*Branch)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &branchR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &branchR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`approval`),
+		qm.WhereIn(`approval.branch_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load approval")
+	}
+
+	var resultSlice []*Approval
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice approval")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on approval")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for approval")
+	}
+
+	if singular {
+		object.R.Approvals = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &approvalR{}
+			}
+			foreign.R.Branch = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.BranchID {
+				local.R.Approvals = append(local.R.Approvals, foreign)
+				if foreign.R == nil {
+					foreign.R = &approvalR{}
+				}
+				foreign.R.Branch = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadCustomers allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (branchL) LoadCustomers(ctx context.Context, e boil.ContextExecutor, singular bool, maybeBranch interface{}, mods queries.Applicator) error {
+	var slice []*Branch
+	var object *Branch
+
+	if singular {
+		object = maybeBranch.(*Branch)
+	} else {
+		slice = *maybeBranch.(*[]*Branch)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &branchR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &branchR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`customer`),
+		qm.WhereIn(`customer.branch_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load customer")
+	}
+
+	var resultSlice []*Customer
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice customer")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on customer")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for customer")
+	}
+
+	if singular {
+		object.R.Customers = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &customerR{}
+			}
+			foreign.R.Branch = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.BranchID {
+				local.R.Customers = append(local.R.Customers, foreign)
+				if foreign.R == nil {
+					foreign.R = &customerR{}
+				}
+				foreign.R.Branch = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadImportJobs allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (branchL) LoadImportJobs(ctx context.Context, e boil.ContextExecutor, singular bool, maybeBranch interface{}, mods queries.Applicator) error {
+	var slice []*Branch
+	var object *Branch
+
+	if singular {
+		object = maybeBranch.(*Branch)
+	} else {
+		slice = *maybeBranch.(*[]*Branch)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &branchR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &branchR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`import_job`),
+		qm.WhereIn(`import_job.branch_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load import_job")
+	}
+
+	var resultSlice []*ImportJob
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice import_job")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on import_job")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for import_job")
+	}
+
+	if singular {
+		object.R.ImportJobs = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &importJobR{}
+			}
+			foreign.R.Branch = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.BranchID {
+				local.R.ImportJobs = append(local.R.ImportJobs, foreign)
+				if foreign.R == nil {
+					foreign.R = &importJobR{}
+				}
+				foreign.R.Branch = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadInventories allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (branchL) LoadInventories(ctx context.Context, e boil.ContextExecutor, singular bool, maybeBranch interface{}, mods queries.Applicator) error {
 	var slice []*Branch
 	var object *Branch
 
@@ -353,8 +827,8 @@ func (branchL) LoadAccounts(ctx context.Context, e boil.ContextExecutor, singula
 	}
 
 	query := NewQuery(
-		qm.From(`account`),
-		qm.WhereIn(`account.branch_id in ?`, args...),
+		qm.From(`inventory`),
+		qm.WhereIn(`inventory.branch_id in ?`, args...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -362,26 +836,26 @@ func (branchL) LoadAccounts(ctx context.Context, e boil.ContextExecutor, singula
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load account")
+		return errors.Wrap(err, "failed to eager load inventory")
 	}
 
-	var resultSlice []*Account
+	var resultSlice []*Inventory
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice account")
+		return errors.Wrap(err, "failed to bind eager loaded slice inventory")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on account")
+		return errors.Wrap(err, "failed to close results in eager load on inventory")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for account")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for inventory")
 	}
 
 	if singular {
-		object.R.Accounts = resultSlice
+		object.R.Inventories = resultSlice
 		for _, foreign := range resultSlice {
 			if foreign.R == nil {
-				foreign.R = &accountR{}
+				foreign.R = &inventoryR{}
 			}
 			foreign.R.Branch = object
 		}
@@ -391,9 +865,9 @@ func (branchL) LoadAccounts(ctx context.Context, e boil.ContextExecutor, singula
 	for _, foreign := range resultSlice {
 		for _, local := range slice {
 			if local.ID == foreign.BranchID {
-				local.R.Accounts = append(local.R.Accounts, foreign)
+				local.R.Inventories = append(local.R.Inventories, foreign)
 				if foreign.R == nil {
-					foreign.R = &accountR{}
+					foreign.R = &inventoryR{}
 				}
 				foreign.R.Branch = local
 				break
@@ -404,9 +878,9 @@ func (branchL) LoadAccounts(ctx context.Context, e boil.ContextExecutor, singula
 	return nil
 }
 
-// LoadCustomers allows an eager lookup of values, cached into the
+// LoadLoans allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (branchL) LoadCustomers(ctx context.Context, e boil.ContextExecutor, singular bool, maybeBranch interface{}, mods queries.Applicator) error {
+func (branchL) LoadLoans(ctx context.Context, e boil.ContextExecutor, singular bool, maybeBranch interface{}, mods queries.Applicator) error {
 	var slice []*Branch
 	var object *Branch
 
@@ -444,8 +918,8 @@ func (branchL) LoadCustomers(ctx context.Context, e boil.ContextExecutor, singul
 	}
 
 	query := NewQuery(
-		qm.From(`customer`),
-		qm.WhereIn(`customer.branch_id in ?`, args...),
+		qm.From(`loan`),
+		qm.WhereIn(`loan.branch_id in ?`, args...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -453,26 +927,26 @@ func (branchL) LoadCustomers(ctx context.Context, e boil.ContextExecutor, singul
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load customer")
+		return errors.Wrap(err, "failed to eager load loan")
 	}
 
-	var resultSlice []*Customer
+	var resultSlice []*Loan
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice customer")
+		return errors.Wrap(err, "failed to bind eager loaded slice loan")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on customer")
+		return errors.Wrap(err, "failed to close results in eager load on loan")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for customer")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for loan")
 	}
 
 	if singular {
-		object.R.Customers = resultSlice
+		object.R.Loans = resultSlice
 		for _, foreign := range resultSlice {
 			if foreign.R == nil {
-				foreign.R = &customerR{}
+				foreign.R = &loanR{}
 			}
 			foreign.R.Branch = object
 		}
@@ -482,9 +956,9 @@ func (branchL) LoadCustomers(ctx context.Context, e boil.ContextExecutor, singul
 	for _, foreign := range resultSlice {
 		for _, local := range slice {
 			if local.ID == foreign.BranchID {
-				local.R.Customers = append(local.R.Customers, foreign)
+				local.R.Loans = append(local.R.Loans, foreign)
 				if foreign.R == nil {
-					foreign.R = &customerR{}
+					foreign.R = &loanR{}
 				}
 				foreign.R.Branch = local
 				break
@@ -495,9 +969,9 @@ func (branchL) LoadCustomers(ctx context.Context, e boil.ContextExecutor, singul
 	return nil
 }
 
-// LoadInventories allows an eager lookup of values, cached into the
+// LoadSales allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (branchL) LoadInventories(ctx context.Context, e boil.ContextExecutor, singular bool, maybeBranch interface{}, mods queries.Applicator) error {
+func (branchL) LoadSales(ctx context.Context, e boil.ContextExecutor, singular bool, maybeBranch interface{}, mods queries.Applicator) error {
 	var slice []*Branch
 	var object *Branch
 
@@ -535,8 +1009,8 @@ func (branchL) LoadInventories(ctx context.Context, e boil.ContextExecutor, sing
 	}
 
 	query := NewQuery(
-		qm.From(`inventory`),
-		qm.WhereIn(`inventory.branch_id in ?`, args...),
+		qm.From(`sale`),
+		qm.WhereIn(`sale.branch_id in ?`, args...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -544,26 +1018,26 @@ func (branchL) LoadInventories(ctx context.Context, e boil.ContextExecutor, sing
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load inventory")
+		return errors.Wrap(err, "failed to eager load sale")
 	}
 
-	var resultSlice []*Inventory
+	var resultSlice []*Sale
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice inventory")
+		return errors.Wrap(err, "failed to bind eager loaded slice sale")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on inventory")
+		return errors.Wrap(err, "failed to close results in eager load on sale")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for inventory")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for sale")
 	}
 
 	if singular {
-		object.R.Inventories = resultSlice
+		object.R.Sales = resultSlice
 		for _, foreign := range resultSlice {
 			if foreign.R == nil {
-				foreign.R = &inventoryR{}
+				foreign.R = &saleR{}
 			}
 			foreign.R.Branch = object
 		}
@@ -573,9 +1047,9 @@ func (branchL) LoadInventories(ctx context.Context, e boil.ContextExecutor, sing
 	for _, foreign := range resultSlice {
 		for _, local := range slice {
 			if local.ID == foreign.BranchID {
-				local.R.Inventories = append(local.R.Inventories, foreign)
+				local.R.Sales = append(local.R.Sales, foreign)
 				if foreign.R == nil {
-					foreign.R = &inventoryR{}
+					foreign.R = &saleR{}
 				}
 				foreign.R.Branch = local
 				break
@@ -586,9 +1060,9 @@ func (branchL) LoadInventories(ctx context.Context, e boil.ContextExecutor, sing
 	return nil
 }
 
-// LoadSales allows an eager lookup of values, cached into the
+// LoadTillSessions allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (branchL) LoadSales(ctx context.Context, e boil.ContextExecutor, singular bool, maybeBranch interface{}, mods queries.Applicator) error {
+func (branchL) LoadTillSessions(ctx context.Context, e boil.ContextExecutor, singular bool, maybeBranch interface{}, mods queries.Applicator) error {
 	var slice []*Branch
 	var object *Branch
 
@@ -626,8 +1100,8 @@ func (branchL) LoadSales(ctx context.Context, e boil.ContextExecutor, singular b
 	}
 
 	query := NewQuery(
-		qm.From(`sale`),
-		qm.WhereIn(`sale.branch_id in ?`, args...),
+		qm.From(`till_session`),
+		qm.WhereIn(`till_session.branch_id in ?`, args...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -635,26 +1109,26 @@ func (branchL) LoadSales(ctx context.Context, e boil.ContextExecutor, singular b
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load sale")
+		return errors.Wrap(err, "failed to eager load till_session")
 	}
 
-	var resultSlice []*Sale
+	var resultSlice []*TillSession
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice sale")
+		return errors.Wrap(err, "failed to bind eager loaded slice till_session")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on sale")
+		return errors.Wrap(err, "failed to close results in eager load on till_session")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for sale")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for till_session")
 	}
 
 	if singular {
-		object.R.Sales = resultSlice
+		object.R.TillSessions = resultSlice
 		for _, foreign := range resultSlice {
 			if foreign.R == nil {
-				foreign.R = &saleR{}
+				foreign.R = &tillSessionR{}
 			}
 			foreign.R.Branch = object
 		}
@@ -664,9 +1138,9 @@ func (branchL) LoadSales(ctx context.Context, e boil.ContextExecutor, singular b
 	for _, foreign := range resultSlice {
 		for _, local := range slice {
 			if local.ID == foreign.BranchID {
-				local.R.Sales = append(local.R.Sales, foreign)
+				local.R.TillSessions = append(local.R.TillSessions, foreign)
 				if foreign.R == nil {
-					foreign.R = &saleR{}
+					foreign.R = &tillSessionR{}
 				}
 				foreign.R.Branch = local
 				break
@@ -821,6 +1295,59 @@ func (o *Branch) AddAccounts(ctx context.Context, exec boil.ContextExecutor, ins
 	return nil
 }
 
+// AddApprovals adds the given related objects to the existing relationships
+// of the branch, optionally inserting them as new records.
+// Appends related to o.R.Approvals.
+// Sets related.R.Branch appropriately.
+func (o *Branch) AddApprovals(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Approval) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.BranchID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"approval\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"branch_id"}),
+				strmangle.WhereClause("\"", "\"", 2, approvalPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.BranchID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &branchR{
+			Approvals: related,
+		}
+	} else {
+		o.R.Approvals = append(o.R.Approvals, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &approvalR{
+				Branch: o,
+			}
+		} else {
+			rel.R.Branch = o
+		}
+	}
+	return nil
+}
+
 // AddCustomers adds the given related objects to the existing relationships
 // of the branch, optionally inserting them as new records.
 // Appends related to o.R.Customers.
@@ -874,6 +1401,59 @@ func (o *Branch) AddCustomers(ctx context.Context, exec boil.ContextExecutor, in
 	return nil
 }
 
+// AddImportJobs adds the given related objects to the existing relationships
+// of the branch, optionally inserting them as new records.
+// Appends related to o.R.ImportJobs.
+// Sets related.R.Branch appropriately.
+func (o *Branch) AddImportJobs(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*ImportJob) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.BranchID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"import_job\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"branch_id"}),
+				strmangle.WhereClause("\"", "\"", 2, importJobPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.BranchID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &branchR{
+			ImportJobs: related,
+		}
+	} else {
+		o.R.ImportJobs = append(o.R.ImportJobs, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &importJobR{
+				Branch: o,
+			}
+		} else {
+			rel.R.Branch = o
+		}
+	}
+	return nil
+}
+
 // AddInventories adds the given related objects to the existing relationships
 // of the branch, optionally inserting them as new records.
 // Appends related to o.R.Inventories.
@@ -927,6 +1507,59 @@ func (o *Branch) AddInventories(ctx context.Context, exec boil.ContextExecutor,
 	return nil
 }
 
+// AddLoans adds the given related objects to the existing relationships
+// of the branch, optionally inserting them as new records.
+// Appends related to o.R.Loans.
+// Sets related.R.Branch appropriately.
+func (o *Branch) AddLoans(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Loan) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.BranchID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"loan\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"branch_id"}),
+				strmangle.WhereClause("\"", "\"", 2, loanPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.BranchID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &branchR{
+			Loans: related,
+		}
+	} else {
+		o.R.Loans = append(o.R.Loans, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &loanR{
+				Branch: o,
+			}
+		} else {
+			rel.R.Branch = o
+		}
+	}
+	return nil
+}
+
 // AddSales adds the given related objects to the existing relationships
 // of the branch, optionally inserting them as new records.
 // Appends related to o.R.Sales.
@@ -980,6 +1613,59 @@ func (o *Branch) AddSales(ctx context.Context, exec boil.ContextExecutor, insert
 	return nil
 }
 
+// AddTillSessions adds the given related objects to the existing relationships
+// of the branch, optionally inserting them as new records.
+// Appends related to o.R.TillSessions.
+// Sets related.R.Branch appropriately.
+func (o *Branch) AddTillSessions(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*TillSession) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.BranchID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"till_session\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"branch_id"}),
+				strmangle.WhereClause("\"", "\"", 2, tillSessionPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.BranchID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &branchR{
+			TillSessions: related,
+		}
+	} else {
+		o.R.TillSessions = append(o.R.TillSessions, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &tillSessionR{
+				Branch: o,
+			}
+		} else {
+			rel.R.Branch = o
+		}
+	}
+	return nil
+}
+
 // AddUsers adds the given related objects to the existing relationships
 // of the branch, optionally inserting them as new records.
 // Appends related to o.R.Users.
@@ -431,14 +431,14 @@ func testBranchToManyAccounts(t *testing.T) {
 	}
 }
 
-func testBranchToManyCustomers(t *testing.T) {
+func testBranchToManyApprovals(t *testing.T) {
 	var err error
 	ctx := context.Background()
 	tx := MustTx(boil.BeginTx(ctx, nil))
 	defer func() { _ = tx.Rollback() }()
 
 	var a Branch
-	var b, c Customer
+	var b, c Approval
 
 	seed := randomize.NewSeed()
 	if err = randomize.Struct(seed, &a, branchDBTypes, true, branchColumnsWithDefault...); err != nil {
@@ -449,10 +449,10 @@ func testBranchToManyCustomers(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	if err = randomize.Struct(seed, &b, customerDBTypes, false, customerColumnsWithDefault...); err != nil {
+	if err = randomize.Struct(seed, &b, approvalDBTypes, false, approvalColumnsWithDefault...); err != nil {
 		t.Fatal(err)
 	}
-	if err = randomize.Struct(seed, &c, customerDBTypes, false, customerColumnsWithDefault...); err != nil {
+	if err = randomize.Struct(seed, &c, approvalDBTypes, false, approvalColumnsWithDefault...); err != nil {
 		t.Fatal(err)
 	}
 
@@ -466,7 +466,7 @@ func testBranchToManyCustomers(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	check, err := a.Customers().All(ctx, tx)
+	check, err := a.Approvals().All(ctx, tx)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -489,18 +489,18 @@ func testBranchToManyCustomers(t *testing.T) {
 	}
 
 	slice := BranchSlice{&a}
-	if err = a.L.LoadCustomers(ctx, tx, false, (*[]*Branch)(&slice), nil); err != nil {
+	if err = a.L.LoadApprovals(ctx, tx, false, (*[]*Branch)(&slice), nil); err != nil {
 		t.Fatal(err)
 	}
-	if got := len(a.R.Customers); got != 2 {
+	if got := len(a.R.Approvals); got != 2 {
 		t.Error("number of eager loaded records wrong, got:", got)
 	}
 
-	a.R.Customers = nil
-	if err = a.L.LoadCustomers(ctx, tx, true, &a, nil); err != nil {
+	a.R.Approvals = nil
+	if err = a.L.LoadApprovals(ctx, tx, true, &a, nil); err != nil {
 		t.Fatal(err)
 	}
-	if got := len(a.R.Customers); got != 2 {
+	if got := len(a.R.Approvals); got != 2 {
 		t.Error("number of eager loaded records wrong, got:", got)
 	}
 
@@ -509,14 +509,14 @@ func testBranchToManyCustomers(t *testing.T) {
 	}
 }
 
-func testBranchToManyInventories(t *testing.T) {
+func testBranchToManyCustomers(t *testing.T) {
 	var err error
 	ctx := context.Background()
 	tx := MustTx(boil.BeginTx(ctx, nil))
 	defer func() { _ = tx.Rollback() }()
 
 	var a Branch
-	var b, c Inventory
+	var b, c Customer
 
 	seed := randomize.NewSeed()
 	if err = randomize.Struct(seed, &a, branchDBTypes, true, branchColumnsWithDefault...); err != nil {
@@ -527,10 +527,10 @@ func testBranchToManyInventories(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	if err = randomize.Struct(seed, &b, inventoryDBTypes, false, inventoryColumnsWithDefault...); err != nil {
+	if err = randomize.Struct(seed, &b, customerDBTypes, false, customerColumnsWithDefault...); err != nil {
 		t.Fatal(err)
 	}
-	if err = randomize.Struct(seed, &c, inventoryDBTypes, false, inventoryColumnsWithDefault...); err != nil {
+	if err = randomize.Struct(seed, &c, customerDBTypes, false, customerColumnsWithDefault...); err != nil {
 		t.Fatal(err)
 	}
 
@@ -544,7 +544,7 @@ func testBranchToManyInventories(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	check, err := a.Inventories().All(ctx, tx)
+	check, err := a.Customers().All(ctx, tx)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -567,18 +567,18 @@ func testBranchToManyInventories(t *testing.T) {
 	}
 
 	slice := BranchSlice{&a}
-	if err = a.L.LoadInventories(ctx, tx, false, (*[]*Branch)(&slice), nil); err != nil {
+	if err = a.L.LoadCustomers(ctx, tx, false, (*[]*Branch)(&slice), nil); err != nil {
 		t.Fatal(err)
 	}
-	if got := len(a.R.Inventories); got != 2 {
+	if got := len(a.R.Customers); got != 2 {
 		t.Error("number of eager loaded records wrong, got:", got)
 	}
 
-	a.R.Inventories = nil
-	if err = a.L.LoadInventories(ctx, tx, true, &a, nil); err != nil {
+	a.R.Customers = nil
+	if err = a.L.LoadCustomers(ctx, tx, true, &a, nil); err != nil {
 		t.Fatal(err)
 	}
-	if got := len(a.R.Inventories); got != 2 {
+	if got := len(a.R.Customers); got != 2 {
 		t.Error("number of eager loaded records wrong, got:", got)
 	}
 
@@ -587,14 +587,14 @@ func testBranchToManyInventories(t *testing.T) {
 	}
 }
 
-func testBranchToManySales(t *testing.T) {
+func testBranchToManyImportJobs(t *testing.T) {
 	var err error
 	ctx := context.Background()
 	tx := MustTx(boil.BeginTx(ctx, nil))
 	defer func() { _ = tx.Rollback() }()
 
 	var a Branch
-	var b, c Sale
+	var b, c ImportJob
 
 	seed := randomize.NewSeed()
 	if err = randomize.Struct(seed, &a, branchDBTypes, true, branchColumnsWithDefault...); err != nil {
@@ -605,10 +605,10 @@ func testBranchToManySales(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	if err = randomize.Struct(seed, &b, saleDBTypes, false, saleColumnsWithDefault...); err != nil {
+	if err = randomize.Struct(seed, &b, importJobDBTypes, false, importJobColumnsWithDefault...); err != nil {
 		t.Fatal(err)
 	}
-	if err = randomize.Struct(seed, &c, saleDBTypes, false, saleColumnsWithDefault...); err != nil {
+	if err = randomize.Struct(seed, &c, importJobDBTypes, false, importJobColumnsWithDefault...); err != nil {
 		t.Fatal(err)
 	}
 
@@ -622,7 +622,7 @@ func testBranchToManySales(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	check, err := a.Sales().All(ctx, tx)
+	check, err := a.ImportJobs().All(ctx, tx)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -645,18 +645,18 @@ func testBranchToManySales(t *testing.T) {
 	}
 
 	slice := BranchSlice{&a}
-	if err = a.L.LoadSales(ctx, tx, false, (*[]*Branch)(&slice), nil); err != nil {
+	if err = a.L.LoadImportJobs(ctx, tx, false, (*[]*Branch)(&slice), nil); err != nil {
 		t.Fatal(err)
 	}
-	if got := len(a.R.Sales); got != 2 {
+	if got := len(a.R.ImportJobs); got != 2 {
 		t.Error("number of eager loaded records wrong, got:", got)
 	}
 
-	a.R.Sales = nil
-	if err = a.L.LoadSales(ctx, tx, true, &a, nil); err != nil {
+	a.R.ImportJobs = nil
+	if err = a.L.LoadImportJobs(ctx, tx, true, &a, nil); err != nil {
 		t.Fatal(err)
 	}
-	if got := len(a.R.Sales); got != 2 {
+	if got := len(a.R.ImportJobs); got != 2 {
 		t.Error("number of eager loaded records wrong, got:", got)
 	}
 
@@ -665,14 +665,14 @@ func testBranchToManySales(t *testing.T) {
 	}
 }
 
-func testBranchToManyUsers(t *testing.T) {
+func testBranchToManyInventories(t *testing.T) {
 	var err error
 	ctx := context.Background()
 	tx := MustTx(boil.BeginTx(ctx, nil))
 	defer func() { _ = tx.Rollback() }()
 
 	var a Branch
-	var b, c User
+	var b, c Inventory
 
 	seed := randomize.NewSeed()
 	if err = randomize.Struct(seed, &a, branchDBTypes, true, branchColumnsWithDefault...); err != nil {
@@ -683,10 +683,10 @@ func testBranchToManyUsers(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	if err = randomize.Struct(seed, &b, userDBTypes, false, userColumnsWithDefault...); err != nil {
+	if err = randomize.Struct(seed, &b, inventoryDBTypes, false, inventoryColumnsWithDefault...); err != nil {
 		t.Fatal(err)
 	}
-	if err = randomize.Struct(seed, &c, userDBTypes, false, userColumnsWithDefault...); err != nil {
+	if err = randomize.Struct(seed, &c, inventoryDBTypes, false, inventoryColumnsWithDefault...); err != nil {
 		t.Fatal(err)
 	}
 
@@ -700,7 +700,7 @@ func testBranchToManyUsers(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	check, err := a.Users().All(ctx, tx)
+	check, err := a.Inventories().All(ctx, tx)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -723,18 +723,18 @@ func testBranchToManyUsers(t *testing.T) {
 	}
 
 	slice := BranchSlice{&a}
-	if err = a.L.LoadUsers(ctx, tx, false, (*[]*Branch)(&slice), nil); err != nil {
+	if err = a.L.LoadInventories(ctx, tx, false, (*[]*Branch)(&slice), nil); err != nil {
 		t.Fatal(err)
 	}
-	if got := len(a.R.Users); got != 2 {
+	if got := len(a.R.Inventories); got != 2 {
 		t.Error("number of eager loaded records wrong, got:", got)
 	}
 
-	a.R.Users = nil
-	if err = a.L.LoadUsers(ctx, tx, true, &a, nil); err != nil {
+	a.R.Inventories = nil
+	if err = a.L.LoadInventories(ctx, tx, true, &a, nil); err != nil {
 		t.Fatal(err)
 	}
-	if got := len(a.R.Users); got != 2 {
+	if got := len(a.R.Inventories); got != 2 {
 		t.Error("number of eager loaded records wrong, got:", got)
 	}
 
@@ -743,30 +743,34 @@ func testBranchToManyUsers(t *testing.T) {
 	}
 }
 
-func testBranchToManyAddOpAccounts(t *testing.T) {
+func testBranchToManyLoans(t *testing.T) {
 	var err error
-
 	ctx := context.Background()
 	tx := MustTx(boil.BeginTx(ctx, nil))
 	defer func() { _ = tx.Rollback() }()
 
 	var a Branch
-	var b, c, d, e Account
+	var b, c Loan
 
 	seed := randomize.NewSeed()
-	if err = randomize.Struct(seed, &a, branchDBTypes, false, strmangle.SetComplement(branchPrimaryKeyColumns, branchColumnsWithoutDefault)...); err != nil {
-		t.Fatal(err)
-	}
-	foreigners := []*Account{&b, &c, &d, &e}
-	for _, x := range foreigners {
-		if err = randomize.Struct(seed, x, accountDBTypes, false, strmangle.SetComplement(accountPrimaryKeyColumns, accountColumnsWithoutDefault)...); err != nil {
-			t.Fatal(err)
-		}
+	if err = randomize.Struct(seed, &a, branchDBTypes, true, branchColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Branch struct: %s", err)
 	}
 
 	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
 		t.Fatal(err)
 	}
+
+	if err = randomize.Struct(seed, &b, loanDBTypes, false, loanColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, loanDBTypes, false, loanColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+
+	b.BranchID = a.ID
+	c.BranchID = a.ID
+
 	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
 		t.Fatal(err)
 	}
@@ -774,74 +778,77 @@ func testBranchToManyAddOpAccounts(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	foreignersSplitByInsertion := [][]*Account{
-		{&b, &c},
-		{&d, &e},
+	check, err := a.Loans().All(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
 	}
 
-	for i, x := range foreignersSplitByInsertion {
-		err = a.AddAccounts(ctx, tx, i != 0, x...)
-		if err != nil {
-			t.Fatal(err)
-		}
-
-		first := x[0]
-		second := x[1]
-
-		if a.ID != first.BranchID {
-			t.Error("foreign key was wrong value", a.ID, first.BranchID)
+	bFound, cFound := false, false
+	for _, v := range check {
+		if v.BranchID == b.BranchID {
+			bFound = true
 		}
-		if a.ID != second.BranchID {
-			t.Error("foreign key was wrong value", a.ID, second.BranchID)
+		if v.BranchID == c.BranchID {
+			cFound = true
 		}
+	}
 
-		if first.R.Branch != &a {
-			t.Error("relationship was not added properly to the foreign slice")
-		}
-		if second.R.Branch != &a {
-			t.Error("relationship was not added properly to the foreign slice")
-		}
+	if !bFound {
+		t.Error("expected to find b")
+	}
+	if !cFound {
+		t.Error("expected to find c")
+	}
 
-		if a.R.Accounts[i*2] != first {
-			t.Error("relationship struct slice not set to correct value")
-		}
-		if a.R.Accounts[i*2+1] != second {
-			t.Error("relationship struct slice not set to correct value")
-		}
+	slice := BranchSlice{&a}
+	if err = a.L.LoadLoans(ctx, tx, false, (*[]*Branch)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.Loans); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
 
-		count, err := a.Accounts().Count(ctx, tx)
-		if err != nil {
-			t.Fatal(err)
-		}
-		if want := int64((i + 1) * 2); count != want {
-			t.Error("want", want, "got", count)
-		}
+	a.R.Loans = nil
+	if err = a.L.LoadLoans(ctx, tx, true, &a, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.Loans); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	if t.Failed() {
+		t.Logf("%#v", check)
 	}
 }
-func testBranchToManyAddOpCustomers(t *testing.T) {
-	var err error
 
+func testBranchToManySales(t *testing.T) {
+	var err error
 	ctx := context.Background()
 	tx := MustTx(boil.BeginTx(ctx, nil))
 	defer func() { _ = tx.Rollback() }()
 
 	var a Branch
-	var b, c, d, e Customer
+	var b, c Sale
 
 	seed := randomize.NewSeed()
-	if err = randomize.Struct(seed, &a, branchDBTypes, false, strmangle.SetComplement(branchPrimaryKeyColumns, branchColumnsWithoutDefault)...); err != nil {
-		t.Fatal(err)
-	}
-	foreigners := []*Customer{&b, &c, &d, &e}
-	for _, x := range foreigners {
-		if err = randomize.Struct(seed, x, customerDBTypes, false, strmangle.SetComplement(customerPrimaryKeyColumns, customerColumnsWithoutDefault)...); err != nil {
-			t.Fatal(err)
-		}
+	if err = randomize.Struct(seed, &a, branchDBTypes, true, branchColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Branch struct: %s", err)
 	}
 
 	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
 		t.Fatal(err)
 	}
+
+	if err = randomize.Struct(seed, &b, saleDBTypes, false, saleColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, saleDBTypes, false, saleColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+
+	b.BranchID = a.ID
+	c.BranchID = a.ID
+
 	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
 		t.Fatal(err)
 	}
@@ -849,78 +856,533 @@ func testBranchToManyAddOpCustomers(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	foreignersSplitByInsertion := [][]*Customer{
-		{&b, &c},
-		{&d, &e},
+	check, err := a.Sales().All(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
 	}
 
-	for i, x := range foreignersSplitByInsertion {
-		err = a.AddCustomers(ctx, tx, i != 0, x...)
-		if err != nil {
-			t.Fatal(err)
-		}
-
-		first := x[0]
-		second := x[1]
-
-		if a.ID != first.BranchID {
-			t.Error("foreign key was wrong value", a.ID, first.BranchID)
+	bFound, cFound := false, false
+	for _, v := range check {
+		if v.BranchID == b.BranchID {
+			bFound = true
 		}
-		if a.ID != second.BranchID {
-			t.Error("foreign key was wrong value", a.ID, second.BranchID)
+		if v.BranchID == c.BranchID {
+			cFound = true
 		}
+	}
 
-		if first.R.Branch != &a {
-			t.Error("relationship was not added properly to the foreign slice")
-		}
-		if second.R.Branch != &a {
-			t.Error("relationship was not added properly to the foreign slice")
-		}
+	if !bFound {
+		t.Error("expected to find b")
+	}
+	if !cFound {
+		t.Error("expected to find c")
+	}
 
-		if a.R.Customers[i*2] != first {
-			t.Error("relationship struct slice not set to correct value")
-		}
-		if a.R.Customers[i*2+1] != second {
-			t.Error("relationship struct slice not set to correct value")
-		}
+	slice := BranchSlice{&a}
+	if err = a.L.LoadSales(ctx, tx, false, (*[]*Branch)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.Sales); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
 
-		count, err := a.Customers().Count(ctx, tx)
-		if err != nil {
-			t.Fatal(err)
-		}
-		if want := int64((i + 1) * 2); count != want {
-			t.Error("want", want, "got", count)
-		}
+	a.R.Sales = nil
+	if err = a.L.LoadSales(ctx, tx, true, &a, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.Sales); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	if t.Failed() {
+		t.Logf("%#v", check)
 	}
 }
-func testBranchToManyAddOpInventories(t *testing.T) {
-	var err error
 
+func testBranchToManyTillSessions(t *testing.T) {
+	var err error
 	ctx := context.Background()
 	tx := MustTx(boil.BeginTx(ctx, nil))
 	defer func() { _ = tx.Rollback() }()
 
 	var a Branch
-	var b, c, d, e Inventory
+	var b, c TillSession
 
 	seed := randomize.NewSeed()
-	if err = randomize.Struct(seed, &a, branchDBTypes, false, strmangle.SetComplement(branchPrimaryKeyColumns, branchColumnsWithoutDefault)...); err != nil {
-		t.Fatal(err)
-	}
-	foreigners := []*Inventory{&b, &c, &d, &e}
-	for _, x := range foreigners {
-		if err = randomize.Struct(seed, x, inventoryDBTypes, false, strmangle.SetComplement(inventoryPrimaryKeyColumns, inventoryColumnsWithoutDefault)...); err != nil {
-			t.Fatal(err)
-		}
+	if err = randomize.Struct(seed, &a, branchDBTypes, true, branchColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Branch struct: %s", err)
 	}
 
 	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
 		t.Fatal(err)
 	}
-	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+
+	if err = randomize.Struct(seed, &b, tillSessionDBTypes, false, tillSessionColumnsWithDefault...); err != nil {
 		t.Fatal(err)
 	}
-	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+	if err = randomize.Struct(seed, &c, tillSessionDBTypes, false, tillSessionColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+
+	b.BranchID = a.ID
+	c.BranchID = a.ID
+
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := a.TillSessions().All(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bFound, cFound := false, false
+	for _, v := range check {
+		if v.BranchID == b.BranchID {
+			bFound = true
+		}
+		if v.BranchID == c.BranchID {
+			cFound = true
+		}
+	}
+
+	if !bFound {
+		t.Error("expected to find b")
+	}
+	if !cFound {
+		t.Error("expected to find c")
+	}
+
+	slice := BranchSlice{&a}
+	if err = a.L.LoadTillSessions(ctx, tx, false, (*[]*Branch)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.TillSessions); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	a.R.TillSessions = nil
+	if err = a.L.LoadTillSessions(ctx, tx, true, &a, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.TillSessions); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	if t.Failed() {
+		t.Logf("%#v", check)
+	}
+}
+
+func testBranchToManyUsers(t *testing.T) {
+	var err error
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Branch
+	var b, c User
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, branchDBTypes, true, branchColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Branch struct: %s", err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = randomize.Struct(seed, &b, userDBTypes, false, userColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, userDBTypes, false, userColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+
+	b.BranchID = a.ID
+	c.BranchID = a.ID
+
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := a.Users().All(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bFound, cFound := false, false
+	for _, v := range check {
+		if v.BranchID == b.BranchID {
+			bFound = true
+		}
+		if v.BranchID == c.BranchID {
+			cFound = true
+		}
+	}
+
+	if !bFound {
+		t.Error("expected to find b")
+	}
+	if !cFound {
+		t.Error("expected to find c")
+	}
+
+	slice := BranchSlice{&a}
+	if err = a.L.LoadUsers(ctx, tx, false, (*[]*Branch)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.Users); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	a.R.Users = nil
+	if err = a.L.LoadUsers(ctx, tx, true, &a, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.Users); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	if t.Failed() {
+		t.Logf("%#v", check)
+	}
+}
+
+func testBranchToManyAddOpAccounts(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Branch
+	var b, c, d, e Account
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, branchDBTypes, false, strmangle.SetComplement(branchPrimaryKeyColumns, branchColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*Account{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, accountDBTypes, false, strmangle.SetComplement(accountPrimaryKeyColumns, accountColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	foreignersSplitByInsertion := [][]*Account{
+		{&b, &c},
+		{&d, &e},
+	}
+
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddAccounts(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		first := x[0]
+		second := x[1]
+
+		if a.ID != first.BranchID {
+			t.Error("foreign key was wrong value", a.ID, first.BranchID)
+		}
+		if a.ID != second.BranchID {
+			t.Error("foreign key was wrong value", a.ID, second.BranchID)
+		}
+
+		if first.R.Branch != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.Branch != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+
+		if a.R.Accounts[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.Accounts[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
+
+		count, err := a.Accounts().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
+	}
+}
+func testBranchToManyAddOpApprovals(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Branch
+	var b, c, d, e Approval
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, branchDBTypes, false, strmangle.SetComplement(branchPrimaryKeyColumns, branchColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*Approval{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, approvalDBTypes, false, strmangle.SetComplement(approvalPrimaryKeyColumns, approvalColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	foreignersSplitByInsertion := [][]*Approval{
+		{&b, &c},
+		{&d, &e},
+	}
+
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddApprovals(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		first := x[0]
+		second := x[1]
+
+		if a.ID != first.BranchID {
+			t.Error("foreign key was wrong value", a.ID, first.BranchID)
+		}
+		if a.ID != second.BranchID {
+			t.Error("foreign key was wrong value", a.ID, second.BranchID)
+		}
+
+		if first.R.Branch != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.Branch != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+
+		if a.R.Approvals[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.Approvals[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
+
+		count, err := a.Approvals().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
+	}
+}
+func testBranchToManyAddOpCustomers(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Branch
+	var b, c, d, e Customer
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, branchDBTypes, false, strmangle.SetComplement(branchPrimaryKeyColumns, branchColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*Customer{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, customerDBTypes, false, strmangle.SetComplement(customerPrimaryKeyColumns, customerColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	foreignersSplitByInsertion := [][]*Customer{
+		{&b, &c},
+		{&d, &e},
+	}
+
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddCustomers(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		first := x[0]
+		second := x[1]
+
+		if a.ID != first.BranchID {
+			t.Error("foreign key was wrong value", a.ID, first.BranchID)
+		}
+		if a.ID != second.BranchID {
+			t.Error("foreign key was wrong value", a.ID, second.BranchID)
+		}
+
+		if first.R.Branch != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.Branch != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+
+		if a.R.Customers[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.Customers[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
+
+		count, err := a.Customers().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
+	}
+}
+func testBranchToManyAddOpImportJobs(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Branch
+	var b, c, d, e ImportJob
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, branchDBTypes, false, strmangle.SetComplement(branchPrimaryKeyColumns, branchColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*ImportJob{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, importJobDBTypes, false, strmangle.SetComplement(importJobPrimaryKeyColumns, importJobColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	foreignersSplitByInsertion := [][]*ImportJob{
+		{&b, &c},
+		{&d, &e},
+	}
+
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddImportJobs(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		first := x[0]
+		second := x[1]
+
+		if a.ID != first.BranchID {
+			t.Error("foreign key was wrong value", a.ID, first.BranchID)
+		}
+		if a.ID != second.BranchID {
+			t.Error("foreign key was wrong value", a.ID, second.BranchID)
+		}
+
+		if first.R.Branch != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.Branch != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+
+		if a.R.ImportJobs[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.ImportJobs[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
+
+		count, err := a.ImportJobs().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
+	}
+}
+func testBranchToManyAddOpInventories(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Branch
+	var b, c, d, e Inventory
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, branchDBTypes, false, strmangle.SetComplement(branchPrimaryKeyColumns, branchColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*Inventory{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, inventoryDBTypes, false, strmangle.SetComplement(inventoryPrimaryKeyColumns, inventoryColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
 		t.Fatal(err)
 	}
 
@@ -968,6 +1430,81 @@ func testBranchToManyAddOpInventories(t *testing.T) {
 		}
 	}
 }
+func testBranchToManyAddOpLoans(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Branch
+	var b, c, d, e Loan
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, branchDBTypes, false, strmangle.SetComplement(branchPrimaryKeyColumns, branchColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*Loan{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, loanDBTypes, false, strmangle.SetComplement(loanPrimaryKeyColumns, loanColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	foreignersSplitByInsertion := [][]*Loan{
+		{&b, &c},
+		{&d, &e},
+	}
+
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddLoans(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		first := x[0]
+		second := x[1]
+
+		if a.ID != first.BranchID {
+			t.Error("foreign key was wrong value", a.ID, first.BranchID)
+		}
+		if a.ID != second.BranchID {
+			t.Error("foreign key was wrong value", a.ID, second.BranchID)
+		}
+
+		if first.R.Branch != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.Branch != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+
+		if a.R.Loans[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.Loans[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
+
+		count, err := a.Loans().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
+	}
+}
 func testBranchToManyAddOpSales(t *testing.T) {
 	var err error
 
@@ -1043,6 +1580,81 @@ func testBranchToManyAddOpSales(t *testing.T) {
 		}
 	}
 }
+func testBranchToManyAddOpTillSessions(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Branch
+	var b, c, d, e TillSession
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, branchDBTypes, false, strmangle.SetComplement(branchPrimaryKeyColumns, branchColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*TillSession{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, tillSessionDBTypes, false, strmangle.SetComplement(tillSessionPrimaryKeyColumns, tillSessionColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	foreignersSplitByInsertion := [][]*TillSession{
+		{&b, &c},
+		{&d, &e},
+	}
+
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddTillSessions(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		first := x[0]
+		second := x[1]
+
+		if a.ID != first.BranchID {
+			t.Error("foreign key was wrong value", a.ID, first.BranchID)
+		}
+		if a.ID != second.BranchID {
+			t.Error("foreign key was wrong value", a.ID, second.BranchID)
+		}
+
+		if first.R.Branch != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.Branch != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+
+		if a.R.TillSessions[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.TillSessions[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
+
+		count, err := a.TillSessions().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
+	}
+}
 func testBranchToManyAddOpUsers(t *testing.T) {
 	var err error
 
@@ -1193,7 +1805,7 @@ func testBranchesSelect(t *testing.T) {
 }
 
 var (
-	branchDBTypes = map[string]string{`ID`: `character`, `Name`: `character varying`, `CreatedAt`: `bigint`, `UpdatedAt`: `bigint`, `ArchivedAt`: `bigint`}
+	branchDBTypes = map[string]string{`ID`: `character`, `Name`: `character varying`, `CreatedAt`: `bigint`, `UpdatedAt`: `bigint`, `ArchivedAt`: `bigint`, `ApprovalThreshold`: `bigint`, `CommissionPolicy`: `character varying`}
 	_             = bytes.MinRead
 )
 
@@ -24,114 +24,256 @@ import (
 
 // Customer is an object representing the database table.
 type Customer struct {
-	ID          string     `boil:"id" json:"id" toml:"id" yaml:"id"`
-	BranchID    string     `boil:"branch_id" json:"branch_id" toml:"branch_id" yaml:"branch_id"`
-	Email       string     `boil:"email" json:"email" toml:"email" yaml:"email"`
-	Name        string     `boil:"name" json:"name" toml:"name" yaml:"name"`
-	PhoneNumber string     `boil:"phone_number" json:"phone_number" toml:"phone_number" yaml:"phone_number"`
-	Address     string     `boil:"address" json:"address" toml:"address" yaml:"address"`
-	SalesRepID  string     `boil:"sales_rep_id" json:"sales_rep_id" toml:"sales_rep_id" yaml:"sales_rep_id"`
-	CreatedAt   int64      `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
-	UpdatedAt   int64      `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
-	ArchivedAt  null.Int64 `boil:"archived_at" json:"archived_at,omitempty" toml:"archived_at" yaml:"archived_at,omitempty"`
+	ID                    string      `boil:"id" json:"id" toml:"id" yaml:"id"`
+	BranchID              string      `boil:"branch_id" json:"branch_id" toml:"branch_id" yaml:"branch_id"`
+	Email                 string      `boil:"email" json:"email" toml:"email" yaml:"email"`
+	Name                  string      `boil:"name" json:"name" toml:"name" yaml:"name"`
+	PhoneNumber           string      `boil:"phone_number" json:"phone_number" toml:"phone_number" yaml:"phone_number"`
+	Address               string      `boil:"address" json:"address" toml:"address" yaml:"address"`
+	SalesRepID            string      `boil:"sales_rep_id" json:"sales_rep_id" toml:"sales_rep_id" yaml:"sales_rep_id"`
+	CreatedAt             int64       `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedAt             int64       `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+	ArchivedAt            null.Int64  `boil:"archived_at" json:"archived_at,omitempty" toml:"archived_at" yaml:"archived_at,omitempty"`
+	DateOfBirth           null.Int64  `boil:"date_of_birth" json:"date_of_birth,omitempty" toml:"date_of_birth" yaml:"date_of_birth,omitempty"`
+	Gender                string      `boil:"gender" json:"gender" toml:"gender" yaml:"gender"`
+	IDType                string      `boil:"id_type" json:"id_type" toml:"id_type" yaml:"id_type"`
+	IDNumber              string      `boil:"id_number" json:"id_number" toml:"id_number" yaml:"id_number"`
+	BVN                   string      `boil:"bvn" json:"bvn" toml:"bvn" yaml:"bvn"`
+	Nin                   string      `boil:"nin" json:"nin" toml:"nin" yaml:"nin"`
+	Occupation            string      `boil:"occupation" json:"occupation" toml:"occupation" yaml:"occupation"`
+	NextOfKinName         string      `boil:"next_of_kin_name" json:"next_of_kin_name" toml:"next_of_kin_name" yaml:"next_of_kin_name"`
+	NextOfKinPhone        string      `boil:"next_of_kin_phone" json:"next_of_kin_phone" toml:"next_of_kin_phone" yaml:"next_of_kin_phone"`
+	NextOfKinRelationship string      `boil:"next_of_kin_relationship" json:"next_of_kin_relationship" toml:"next_of_kin_relationship" yaml:"next_of_kin_relationship"`
+	KycTier               int         `boil:"kyc_tier" json:"kyc_tier" toml:"kyc_tier" yaml:"kyc_tier"`
+	KycStatus             string      `boil:"kyc_status" json:"kyc_status" toml:"kyc_status" yaml:"kyc_status"`
+	KycNote               string      `boil:"kyc_note" json:"kyc_note" toml:"kyc_note" yaml:"kyc_note"`
+	KycVerifiedAt         null.Int64  `boil:"kyc_verified_at" json:"kyc_verified_at,omitempty" toml:"kyc_verified_at" yaml:"kyc_verified_at,omitempty"`
+	KycVerifiedByID       null.String `boil:"kyc_verified_by_id" json:"kyc_verified_by_id,omitempty" toml:"kyc_verified_by_id" yaml:"kyc_verified_by_id,omitempty"`
+	MergedIntoID          null.String `boil:"merged_into_id" json:"merged_into_id,omitempty" toml:"merged_into_id" yaml:"merged_into_id,omitempty"`
 
 	R *customerR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L customerL  `boil:"-" json:"-" toml:"-" yaml:"-"`
 }
 
 var CustomerColumns = struct {
-	ID          string
-	BranchID    string
-	Email       string
-	Name        string
-	PhoneNumber string
-	Address     string
-	SalesRepID  string
-	CreatedAt   string
-	UpdatedAt   string
-	ArchivedAt  string
+	ID                    string
+	BranchID              string
+	Email                 string
+	Name                  string
+	PhoneNumber           string
+	Address               string
+	SalesRepID            string
+	CreatedAt             string
+	UpdatedAt             string
+	ArchivedAt            string
+	DateOfBirth           string
+	Gender                string
+	IDType                string
+	IDNumber              string
+	BVN                   string
+	Nin                   string
+	Occupation            string
+	NextOfKinName         string
+	NextOfKinPhone        string
+	NextOfKinRelationship string
+	KycTier               string
+	KycStatus             string
+	KycNote               string
+	KycVerifiedAt         string
+	KycVerifiedByID       string
+	MergedIntoID          string
 }{
-	ID:          "id",
-	BranchID:    "branch_id",
-	Email:       "email",
-	Name:        "name",
-	PhoneNumber: "phone_number",
-	Address:     "address",
-	SalesRepID:  "sales_rep_id",
-	CreatedAt:   "created_at",
-	UpdatedAt:   "updated_at",
-	ArchivedAt:  "archived_at",
+	ID:                    "id",
+	BranchID:              "branch_id",
+	Email:                 "email",
+	Name:                  "name",
+	PhoneNumber:           "phone_number",
+	Address:               "address",
+	SalesRepID:            "sales_rep_id",
+	CreatedAt:             "created_at",
+	UpdatedAt:             "updated_at",
+	ArchivedAt:            "archived_at",
+	DateOfBirth:           "date_of_birth",
+	Gender:                "gender",
+	IDType:                "id_type",
+	IDNumber:              "id_number",
+	BVN:                   "bvn",
+	Nin:                   "nin",
+	Occupation:            "occupation",
+	NextOfKinName:         "next_of_kin_name",
+	NextOfKinPhone:        "next_of_kin_phone",
+	NextOfKinRelationship: "next_of_kin_relationship",
+	KycTier:               "kyc_tier",
+	KycStatus:             "kyc_status",
+	KycNote:               "kyc_note",
+	KycVerifiedAt:         "kyc_verified_at",
+	KycVerifiedByID:       "kyc_verified_by_id",
+	MergedIntoID:          "merged_into_id",
 }
 
 var CustomerTableColumns = struct {
-	ID          string
-	BranchID    string
-	Email       string
-	Name        string
-	PhoneNumber string
-	Address     string
-	SalesRepID  string
-	CreatedAt   string
-	UpdatedAt   string
-	ArchivedAt  string
+	ID                    string
+	BranchID              string
+	Email                 string
+	Name                  string
+	PhoneNumber           string
+	Address               string
+	SalesRepID            string
+	CreatedAt             string
+	UpdatedAt             string
+	ArchivedAt            string
+	DateOfBirth           string
+	Gender                string
+	IDType                string
+	IDNumber              string
+	BVN                   string
+	Nin                   string
+	Occupation            string
+	NextOfKinName         string
+	NextOfKinPhone        string
+	NextOfKinRelationship string
+	KycTier               string
+	KycStatus             string
+	KycNote               string
+	KycVerifiedAt         string
+	KycVerifiedByID       string
+	MergedIntoID          string
 }{
-	ID:          "customer.id",
-	BranchID:    "customer.branch_id",
-	Email:       "customer.email",
-	Name:        "customer.name",
-	PhoneNumber: "customer.phone_number",
-	Address:     "customer.address",
-	SalesRepID:  "customer.sales_rep_id",
-	CreatedAt:   "customer.created_at",
-	UpdatedAt:   "customer.updated_at",
-	ArchivedAt:  "customer.archived_at",
+	ID:                    "customer.id",
+	BranchID:              "customer.branch_id",
+	Email:                 "customer.email",
+	Name:                  "customer.name",
+	PhoneNumber:           "customer.phone_number",
+	Address:               "customer.address",
+	SalesRepID:            "customer.sales_rep_id",
+	CreatedAt:             "customer.created_at",
+	UpdatedAt:             "customer.updated_at",
+	ArchivedAt:            "customer.archived_at",
+	DateOfBirth:           "customer.date_of_birth",
+	Gender:                "customer.gender",
+	IDType:                "customer.id_type",
+	IDNumber:              "customer.id_number",
+	BVN:                   "customer.bvn",
+	Nin:                   "customer.nin",
+	Occupation:            "customer.occupation",
+	NextOfKinName:         "customer.next_of_kin_name",
+	NextOfKinPhone:        "customer.next_of_kin_phone",
+	NextOfKinRelationship: "customer.next_of_kin_relationship",
+	KycTier:               "customer.kyc_tier",
+	KycStatus:             "customer.kyc_status",
+	KycNote:               "customer.kyc_note",
+	KycVerifiedAt:         "customer.kyc_verified_at",
+	KycVerifiedByID:       "customer.kyc_verified_by_id",
+	MergedIntoID:          "customer.merged_into_id",
 }
 
 // Generated where
 
 var CustomerWhere = struct {
-	ID          whereHelperstring
-	BranchID    whereHelperstring
-	Email       whereHelperstring
-	Name        whereHelperstring
-	PhoneNumber whereHelperstring
-	Address     whereHelperstring
-	SalesRepID  whereHelperstring
-	CreatedAt   whereHelperint64
-	UpdatedAt   whereHelperint64
-	ArchivedAt  whereHelpernull_Int64
+	ID                    whereHelperstring
+	BranchID              whereHelperstring
+	Email                 whereHelperstring
+	Name                  whereHelperstring
+	PhoneNumber           whereHelperstring
+	Address               whereHelperstring
+	SalesRepID            whereHelperstring
+	CreatedAt             whereHelperint64
+	UpdatedAt             whereHelperint64
+	ArchivedAt            whereHelpernull_Int64
+	DateOfBirth           whereHelpernull_Int64
+	Gender                whereHelperstring
+	IDType                whereHelperstring
+	IDNumber              whereHelperstring
+	BVN                   whereHelperstring
+	Nin                   whereHelperstring
+	Occupation            whereHelperstring
+	NextOfKinName         whereHelperstring
+	NextOfKinPhone        whereHelperstring
+	NextOfKinRelationship whereHelperstring
+	KycTier               whereHelperint
+	KycStatus             whereHelperstring
+	KycNote               whereHelperstring
+	KycVerifiedAt         whereHelpernull_Int64
+	KycVerifiedByID       whereHelpernull_String
+	MergedIntoID          whereHelpernull_String
 }{
-	ID:          whereHelperstring{field: "\"customer\".\"id\""},
-	BranchID:    whereHelperstring{field: "\"customer\".\"branch_id\""},
-	Email:       whereHelperstring{field: "\"customer\".\"email\""},
-	Name:        whereHelperstring{field: "\"customer\".\"name\""},
-	PhoneNumber: whereHelperstring{field: "\"customer\".\"phone_number\""},
-	Address:     whereHelperstring{field: "\"customer\".\"address\""},
-	SalesRepID:  whereHelperstring{field: "\"customer\".\"sales_rep_id\""},
-	CreatedAt:   whereHelperint64{field: "\"customer\".\"created_at\""},
-	UpdatedAt:   whereHelperint64{field: "\"customer\".\"updated_at\""},
-	ArchivedAt:  whereHelpernull_Int64{field: "\"customer\".\"archived_at\""},
+	ID:                    whereHelperstring{field: "\"customer\".\"id\""},
+	BranchID:              whereHelperstring{field: "\"customer\".\"branch_id\""},
+	Email:                 whereHelperstring{field: "\"customer\".\"email\""},
+	Name:                  whereHelperstring{field: "\"customer\".\"name\""},
+	PhoneNumber:           whereHelperstring{field: "\"customer\".\"phone_number\""},
+	Address:               whereHelperstring{field: "\"customer\".\"address\""},
+	SalesRepID:            whereHelperstring{field: "\"customer\".\"sales_rep_id\""},
+	CreatedAt:             whereHelperint64{field: "\"customer\".\"created_at\""},
+	UpdatedAt:             whereHelperint64{field: "\"customer\".\"updated_at\""},
+	ArchivedAt:            whereHelpernull_Int64{field: "\"customer\".\"archived_at\""},
+	DateOfBirth:           whereHelpernull_Int64{field: "\"customer\".\"date_of_birth\""},
+	Gender:                whereHelperstring{field: "\"customer\".\"gender\""},
+	IDType:                whereHelperstring{field: "\"customer\".\"id_type\""},
+	IDNumber:              whereHelperstring{field: "\"customer\".\"id_number\""},
+	BVN:                   whereHelperstring{field: "\"customer\".\"bvn\""},
+	Nin:                   whereHelperstring{field: "\"customer\".\"nin\""},
+	Occupation:            whereHelperstring{field: "\"customer\".\"occupation\""},
+	NextOfKinName:         whereHelperstring{field: "\"customer\".\"next_of_kin_name\""},
+	NextOfKinPhone:        whereHelperstring{field: "\"customer\".\"next_of_kin_phone\""},
+	NextOfKinRelationship: whereHelperstring{field: "\"customer\".\"next_of_kin_relationship\""},
+	KycTier:               whereHelperint{field: "\"customer\".\"kyc_tier\""},
+	KycStatus:             whereHelperstring{field: "\"customer\".\"kyc_status\""},
+	KycNote:               whereHelperstring{field: "\"customer\".\"kyc_note\""},
+	KycVerifiedAt:         whereHelpernull_Int64{field: "\"customer\".\"kyc_verified_at\""},
+	KycVerifiedByID:       whereHelpernull_String{field: "\"customer\".\"kyc_verified_by_id\""},
+	MergedIntoID:          whereHelpernull_String{field: "\"customer\".\"merged_into_id\""},
 }
 
 // CustomerRels is where relationship names are stored.
 var CustomerRels = struct {
-	Branch        string
-	SalesRep      string
-	Accounts      string
-	DSCommissions string
+	Branch                  string
+	KycVerifiedBy           string
+	MergedInto              string
+	SalesRep                string
+	Accounts                string
+	MergedIntoCustomers     string
+	CustomerDocuments       string
+	CustomerLoginCodes      string
+	DuplicateCustomerMerges string
+	SurvivorCustomerMerges  string
+	DSCommissions           string
+	ImportRows              string
+	Loans                   string
+	WithdrawalRequests      string
 }{
-	Branch:        "Branch",
-	SalesRep:      "SalesRep",
-	Accounts:      "Accounts",
-	DSCommissions: "DSCommissions",
+	Branch:                  "Branch",
+	KycVerifiedBy:           "KycVerifiedBy",
+	MergedInto:              "MergedInto",
+	SalesRep:                "SalesRep",
+	Accounts:                "Accounts",
+	MergedIntoCustomers:     "MergedIntoCustomers",
+	CustomerDocuments:       "CustomerDocuments",
+	CustomerLoginCodes:      "CustomerLoginCodes",
+	DuplicateCustomerMerges: "DuplicateCustomerMerges",
+	SurvivorCustomerMerges:  "SurvivorCustomerMerges",
+	DSCommissions:           "DSCommissions",
+	ImportRows:              "ImportRows",
+	Loans:                   "Loans",
+	WithdrawalRequests:      "WithdrawalRequests",
 }
 
 // customerR is where relationships are stored.
 type customerR struct {
-	Branch        *Branch           `boil:"Branch" json:"Branch" toml:"Branch" yaml:"Branch"`
-	SalesRep      *User             `boil:"SalesRep" json:"SalesRep" toml:"SalesRep" yaml:"SalesRep"`
-	Accounts      AccountSlice      `boil:"Accounts" json:"Accounts" toml:"Accounts" yaml:"Accounts"`
-	DSCommissions DSCommissionSlice `boil:"DSCommissions" json:"DSCommissions" toml:"DSCommissions" yaml:"DSCommissions"`
+	Branch                  *Branch                `boil:"Branch" json:"Branch" toml:"Branch" yaml:"Branch"`
+	KycVerifiedBy           *User                  `boil:"KycVerifiedBy" json:"KycVerifiedBy" toml:"KycVerifiedBy" yaml:"KycVerifiedBy"`
+	MergedInto              *Customer              `boil:"MergedInto" json:"MergedInto" toml:"MergedInto" yaml:"MergedInto"`
+	SalesRep                *User                  `boil:"SalesRep" json:"SalesRep" toml:"SalesRep" yaml:"SalesRep"`
+	Accounts                AccountSlice           `boil:"Accounts" json:"Accounts" toml:"Accounts" yaml:"Accounts"`
+	MergedIntoCustomers     CustomerSlice          `boil:"MergedIntoCustomers" json:"MergedIntoCustomers" toml:"MergedIntoCustomers" yaml:"MergedIntoCustomers"`
+	CustomerDocuments       CustomerDocumentSlice  `boil:"CustomerDocuments" json:"CustomerDocuments" toml:"CustomerDocuments" yaml:"CustomerDocuments"`
+	CustomerLoginCodes      CustomerLoginCodeSlice `boil:"CustomerLoginCodes" json:"CustomerLoginCodes" toml:"CustomerLoginCodes" yaml:"CustomerLoginCodes"`
+	DuplicateCustomerMerges CustomerMergeSlice     `boil:"DuplicateCustomerMerges" json:"DuplicateCustomerMerges" toml:"DuplicateCustomerMerges" yaml:"DuplicateCustomerMerges"`
+	SurvivorCustomerMerges  CustomerMergeSlice     `boil:"SurvivorCustomerMerges" json:"SurvivorCustomerMerges" toml:"SurvivorCustomerMerges" yaml:"SurvivorCustomerMerges"`
+	DSCommissions           DSCommissionSlice      `boil:"DSCommissions" json:"DSCommissions" toml:"DSCommissions" yaml:"DSCommissions"`
+	ImportRows              ImportRowSlice         `boil:"ImportRows" json:"ImportRows" toml:"ImportRows" yaml:"ImportRows"`
+	Loans                   LoanSlice              `boil:"Loans" json:"Loans" toml:"Loans" yaml:"Loans"`
+	WithdrawalRequests      WithdrawalRequestSlice `boil:"WithdrawalRequests" json:"WithdrawalRequests" toml:"WithdrawalRequests" yaml:"WithdrawalRequests"`
 }
 
 // NewStruct creates a new relationship struct
@@ -143,9 +285,9 @@ func (*customerR) NewStruct() *customerR {
 type customerL struct{}
 
 var (
-	customerAllColumns            = []string{"id", "branch_id", "email", "name", "phone_number", "address", "sales_rep_id", "created_at", "updated_at", "archived_at"}
+	customerAllColumns            = []string{"id", "branch_id", "email", "name", "phone_number", "address", "sales_rep_id", "created_at", "updated_at", "archived_at", "date_of_birth", "gender", "id_type", "id_number", "bvn", "nin", "occupation", "next_of_kin_name", "next_of_kin_phone", "next_of_kin_relationship", "kyc_tier", "kyc_status", "kyc_note", "kyc_verified_at", "kyc_verified_by_id", "merged_into_id"}
 	customerColumnsWithoutDefault = []string{"id", "email", "phone_number", "address", "sales_rep_id", "created_at", "updated_at", "archived_at"}
-	customerColumnsWithDefault    = []string{"branch_id", "name"}
+	customerColumnsWithDefault    = []string{"branch_id", "name", "date_of_birth", "gender", "id_type", "id_number", "bvn", "nin", "occupation", "next_of_kin_name", "next_of_kin_phone", "next_of_kin_relationship", "kyc_tier", "kyc_status", "kyc_note", "kyc_verified_at", "kyc_verified_by_id", "merged_into_id"}
 	customerPrimaryKeyColumns     = []string{"id"}
 )
 
@@ -254,6 +396,34 @@ func (o *Customer) Branch(mods ...qm.QueryMod) branchQuery {
 	return query
 }
 
+// KycVerifiedBy pointed to by the foreign key.
+func (o *Customer) KycVerifiedBy(mods ...qm.QueryMod) userQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.KycVerifiedByID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	query := Users(queryMods...)
+	queries.SetFrom(query.Query, "\"users\"")
+
+	return query
+}
+
+// MergedInto pointed to by the foreign key.
+func (o *Customer) MergedInto(mods ...qm.QueryMod) customerQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.MergedIntoID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	query := Customers(queryMods...)
+	queries.SetFrom(query.Query, "\"customer\"")
+
+	return query
+}
+
 // SalesRep pointed to by the foreign key.
 func (o *Customer) SalesRep(mods ...qm.QueryMod) userQuery {
 	queryMods := []qm.QueryMod{
@@ -289,6 +459,111 @@ func (o *Customer) Accounts(mods ...qm.QueryMod) accountQuery {
 	return query
 }
 
+// MergedIntoCustomers retrieves all the customer's Customers with an executor via merged_into_id column.
+func (o *Customer) MergedIntoCustomers(mods ...qm.QueryMod) customerQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"customer\".\"merged_into_id\"=?", o.ID),
+	)
+
+	query := Customers(queryMods...)
+	queries.SetFrom(query.Query, "\"customer\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"customer\".*"})
+	}
+
+	return query
+}
+
+// CustomerDocuments retrieves all the customer_document's CustomerDocuments with an executor.
+func (o *Customer) CustomerDocuments(mods ...qm.QueryMod) customerDocumentQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"customer_document\".\"customer_id\"=?", o.ID),
+	)
+
+	query := CustomerDocuments(queryMods...)
+	queries.SetFrom(query.Query, "\"customer_document\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"customer_document\".*"})
+	}
+
+	return query
+}
+
+// CustomerLoginCodes retrieves all the customer_login_code's CustomerLoginCodes with an executor.
+func (o *Customer) CustomerLoginCodes(mods ...qm.QueryMod) customerLoginCodeQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"customer_login_code\".\"customer_id\"=?", o.ID),
+	)
+
+	query := CustomerLoginCodes(queryMods...)
+	queries.SetFrom(query.Query, "\"customer_login_code\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"customer_login_code\".*"})
+	}
+
+	return query
+}
+
+// DuplicateCustomerMerges retrieves all the customer_merge's CustomerMerges with an executor via duplicate_id column.
+func (o *Customer) DuplicateCustomerMerges(mods ...qm.QueryMod) customerMergeQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"customer_merge\".\"duplicate_id\"=?", o.ID),
+	)
+
+	query := CustomerMerges(queryMods...)
+	queries.SetFrom(query.Query, "\"customer_merge\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"customer_merge\".*"})
+	}
+
+	return query
+}
+
+// SurvivorCustomerMerges retrieves all the customer_merge's CustomerMerges with an executor via survivor_id column.
+func (o *Customer) SurvivorCustomerMerges(mods ...qm.QueryMod) customerMergeQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"customer_merge\".\"survivor_id\"=?", o.ID),
+	)
+
+	query := CustomerMerges(queryMods...)
+	queries.SetFrom(query.Query, "\"customer_merge\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"customer_merge\".*"})
+	}
+
+	return query
+}
+
 // DSCommissions retrieves all the ds_commission's DSCommissions with an executor.
 func (o *Customer) DSCommissions(mods ...qm.QueryMod) dsCommissionQuery {
 	var queryMods []qm.QueryMod
@@ -310,6 +585,69 @@ func (o *Customer) DSCommissions(mods ...qm.QueryMod) dsCommissionQuery {
 	return query
 }
 
+// ImportRows retrieves all the import_row's ImportRows with an executor.
+func (o *Customer) ImportRows(mods ...qm.QueryMod) importRowQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"import_row\".\"customer_id\"=?", o.ID),
+	)
+
+	query := ImportRows(queryMods...)
+	queries.SetFrom(query.Query, "\"import_row\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"import_row\".*"})
+	}
+
+	return query
+}
+
+// Loans retrieves all the loan's Loans with an executor.
+func (o *Customer) Loans(mods ...qm.QueryMod) loanQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"loan\".\"customer_id\"=?", o.ID),
+	)
+
+	query := Loans(queryMods...)
+	queries.SetFrom(query.Query, "\"loan\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"loan\".*"})
+	}
+
+	return query
+}
+
+// WithdrawalRequests retrieves all the withdrawal_request's WithdrawalRequests with an executor.
+func (o *Customer) WithdrawalRequests(mods ...qm.QueryMod) withdrawalRequestQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"withdrawal_request\".\"customer_id\"=?", o.ID),
+	)
+
+	query := WithdrawalRequests(queryMods...)
+	queries.SetFrom(query.Query, "\"withdrawal_request\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"withdrawal_request\".*"})
+	}
+
+	return query
+}
+
 // LoadBranch allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for an N-1 relationship.
 func (customerL) LoadBranch(ctx context.Context, e boil.ContextExecutor, singular bool, maybeCustomer interface{}, mods queries.Applicator) error {
@@ -406,9 +744,9 @@ func (customerL) LoadBranch(ctx context.Context, e boil.ContextExecutor, singula
 	return nil
 }
 
-// LoadSalesRep allows an eager lookup of values, cached into the
+// LoadKycVerifiedBy allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for an N-1 relationship.
-func (customerL) LoadSalesRep(ctx context.Context, e boil.ContextExecutor, singular bool, maybeCustomer interface{}, mods queries.Applicator) error {
+func (customerL) LoadKycVerifiedBy(ctx context.Context, e boil.ContextExecutor, singular bool, maybeCustomer interface{}, mods queries.Applicator) error {
 	var slice []*Customer
 	var object *Customer
 
@@ -423,7 +761,9 @@ func (customerL) LoadSalesRep(ctx context.Context, e boil.ContextExecutor, singu
 		if object.R == nil {
 			object.R = &customerR{}
 		}
-		args = append(args, object.SalesRepID)
+		if !queries.IsNil(object.KycVerifiedByID) {
+			args = append(args, object.KycVerifiedByID)
+		}
 
 	} else {
 	Outer:
@@ -433,12 +773,14 @@ func (customerL) LoadSalesRep(ctx context.Context, e boil.ContextExecutor, singu
 			}
 
 			for _, a := range args {
-				if a == obj.SalesRepID {
+				if queries.Equal(a, obj.KycVerifiedByID) {
 					continue Outer
 				}
 			}
 
-			args = append(args, obj.SalesRepID)
+			if !queries.IsNil(obj.KycVerifiedByID) {
+				args = append(args, obj.KycVerifiedByID)
+			}
 
 		}
 	}
@@ -478,22 +820,22 @@ func (customerL) LoadSalesRep(ctx context.Context, e boil.ContextExecutor, singu
 
 	if singular {
 		foreign := resultSlice[0]
-		object.R.SalesRep = foreign
+		object.R.KycVerifiedBy = foreign
 		if foreign.R == nil {
 			foreign.R = &userR{}
 		}
-		foreign.R.SalesRepCustomers = append(foreign.R.SalesRepCustomers, object)
+		foreign.R.KycVerifiedByCustomers = append(foreign.R.KycVerifiedByCustomers, object)
 		return nil
 	}
 
 	for _, local := range slice {
 		for _, foreign := range resultSlice {
-			if local.SalesRepID == foreign.ID {
-				local.R.SalesRep = foreign
+			if queries.Equal(local.KycVerifiedByID, foreign.ID) {
+				local.R.KycVerifiedBy = foreign
 				if foreign.R == nil {
 					foreign.R = &userR{}
 				}
-				foreign.R.SalesRepCustomers = append(foreign.R.SalesRepCustomers, local)
+				foreign.R.KycVerifiedByCustomers = append(foreign.R.KycVerifiedByCustomers, local)
 				break
 			}
 		}
@@ -502,9 +844,9 @@ func (customerL) LoadSalesRep(ctx context.Context, e boil.ContextExecutor, singu
 	return nil
 }
 
-// LoadAccounts allows an eager lookup of values, cached into the
-// loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (customerL) LoadAccounts(ctx context.Context, e boil.ContextExecutor, singular bool, maybeCustomer interface{}, mods queries.Applicator) error {
+// LoadMergedInto allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (customerL) LoadMergedInto(ctx context.Context, e boil.ContextExecutor, singular bool, maybeCustomer interface{}, mods queries.Applicator) error {
 	var slice []*Customer
 	var object *Customer
 
@@ -519,7 +861,10 @@ func (customerL) LoadAccounts(ctx context.Context, e boil.ContextExecutor, singu
 		if object.R == nil {
 			object.R = &customerR{}
 		}
-		args = append(args, object.ID)
+		if !queries.IsNil(object.MergedIntoID) {
+			args = append(args, object.MergedIntoID)
+		}
+
 	} else {
 	Outer:
 		for _, obj := range slice {
@@ -528,12 +873,15 @@ func (customerL) LoadAccounts(ctx context.Context, e boil.ContextExecutor, singu
 			}
 
 			for _, a := range args {
-				if a == obj.ID {
+				if queries.Equal(a, obj.MergedIntoID) {
 					continue Outer
 				}
 			}
 
-			args = append(args, obj.ID)
+			if !queries.IsNil(obj.MergedIntoID) {
+				args = append(args, obj.MergedIntoID)
+			}
+
 		}
 	}
 
@@ -542,8 +890,8 @@ func (customerL) LoadAccounts(ctx context.Context, e boil.ContextExecutor, singu
 	}
 
 	query := NewQuery(
-		qm.From(`account`),
-		qm.WhereIn(`account.customer_id in ?`, args...),
+		qm.From(`customer`),
+		qm.WhereIn(`customer.id in ?`, args...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -551,40 +899,43 @@ func (customerL) LoadAccounts(ctx context.Context, e boil.ContextExecutor, singu
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load account")
+		return errors.Wrap(err, "failed to eager load Customer")
 	}
 
-	var resultSlice []*Account
+	var resultSlice []*Customer
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice account")
+		return errors.Wrap(err, "failed to bind eager loaded slice Customer")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on account")
+		return errors.Wrap(err, "failed to close results of eager load for customer")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for account")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for customer")
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
 	}
 
 	if singular {
-		object.R.Accounts = resultSlice
-		for _, foreign := range resultSlice {
-			if foreign.R == nil {
-				foreign.R = &accountR{}
-			}
-			foreign.R.Customer = object
+		foreign := resultSlice[0]
+		object.R.MergedInto = foreign
+		if foreign.R == nil {
+			foreign.R = &customerR{}
 		}
+		foreign.R.MergedIntoCustomers = append(foreign.R.MergedIntoCustomers, object)
 		return nil
 	}
 
-	for _, foreign := range resultSlice {
-		for _, local := range slice {
-			if local.ID == foreign.CustomerID {
-				local.R.Accounts = append(local.R.Accounts, foreign)
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if queries.Equal(local.MergedIntoID, foreign.ID) {
+				local.R.MergedInto = foreign
 				if foreign.R == nil {
-					foreign.R = &accountR{}
+					foreign.R = &customerR{}
 				}
-				foreign.R.Customer = local
+				foreign.R.MergedIntoCustomers = append(foreign.R.MergedIntoCustomers, local)
 				break
 			}
 		}
@@ -593,9 +944,9 @@ func (customerL) LoadAccounts(ctx context.Context, e boil.ContextExecutor, singu
 	return nil
 }
 
-// LoadDSCommissions allows an eager lookup of values, cached into the
-// loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (customerL) LoadDSCommissions(ctx context.Context, e boil.ContextExecutor, singular bool, maybeCustomer interface{}, mods queries.Applicator) error {
+// LoadSalesRep allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (customerL) LoadSalesRep(ctx context.Context, e boil.ContextExecutor, singular bool, maybeCustomer interface{}, mods queries.Applicator) error {
 	var slice []*Customer
 	var object *Customer
 
@@ -610,7 +961,8 @@ func (customerL) LoadDSCommissions(ctx context.Context, e boil.ContextExecutor,
 		if object.R == nil {
 			object.R = &customerR{}
 		}
-		args = append(args, object.ID)
+		args = append(args, object.SalesRepID)
+
 	} else {
 	Outer:
 		for _, obj := range slice {
@@ -619,12 +971,13 @@ func (customerL) LoadDSCommissions(ctx context.Context, e boil.ContextExecutor,
 			}
 
 			for _, a := range args {
-				if a == obj.ID {
+				if a == obj.SalesRepID {
 					continue Outer
 				}
 			}
 
-			args = append(args, obj.ID)
+			args = append(args, obj.SalesRepID)
+
 		}
 	}
 
@@ -633,8 +986,8 @@ func (customerL) LoadDSCommissions(ctx context.Context, e boil.ContextExecutor,
 	}
 
 	query := NewQuery(
-		qm.From(`ds_commission`),
-		qm.WhereIn(`ds_commission.customer_id in ?`, args...),
+		qm.From(`users`),
+		qm.WhereIn(`users.id in ?`, args...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -642,40 +995,43 @@ func (customerL) LoadDSCommissions(ctx context.Context, e boil.ContextExecutor,
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load ds_commission")
+		return errors.Wrap(err, "failed to eager load User")
 	}
 
-	var resultSlice []*DSCommission
+	var resultSlice []*User
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice ds_commission")
+		return errors.Wrap(err, "failed to bind eager loaded slice User")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on ds_commission")
+		return errors.Wrap(err, "failed to close results of eager load for users")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for ds_commission")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for users")
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
 	}
 
 	if singular {
-		object.R.DSCommissions = resultSlice
-		for _, foreign := range resultSlice {
-			if foreign.R == nil {
-				foreign.R = &dsCommissionR{}
-			}
-			foreign.R.Customer = object
+		foreign := resultSlice[0]
+		object.R.SalesRep = foreign
+		if foreign.R == nil {
+			foreign.R = &userR{}
 		}
+		foreign.R.SalesRepCustomers = append(foreign.R.SalesRepCustomers, object)
 		return nil
 	}
 
-	for _, foreign := range resultSlice {
-		for _, local := range slice {
-			if local.ID == foreign.CustomerID {
-				local.R.DSCommissions = append(local.R.DSCommissions, foreign)
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.SalesRepID == foreign.ID {
+				local.R.SalesRep = foreign
 				if foreign.R == nil {
-					foreign.R = &dsCommissionR{}
+					foreign.R = &userR{}
 				}
-				foreign.R.Customer = local
+				foreign.R.SalesRepCustomers = append(foreign.R.SalesRepCustomers, local)
 				break
 			}
 		}
@@ -684,105 +1040,1747 @@ func (customerL) LoadDSCommissions(ctx context.Context, e boil.ContextExecutor,
 	return nil
 }
 
-// SetBranch of the customer to the related item.
-// Sets o.R.Branch to related.
-// Adds o to related.R.Customers.
-func (o *Customer) SetBranch(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Branch) error {
-	var err error
-	if insert {
-		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
-			return errors.Wrap(err, "failed to insert into foreign table")
-		}
-	}
-
+// LoadAccounts allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (customerL) LoadAccounts(ctx context.Context, e boil.ContextExecutor, singular bool, maybeCustomer interface{}, mods queries.Applicator) error {
+	var slice []*Customer
+	var object *Customer
+
+	if singular {
+		object = maybeCustomer.(*Customer)
+	} else {
+		slice = *maybeCustomer.(*[]*Customer)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &customerR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &customerR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`account`),
+		qm.WhereIn(`account.customer_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load account")
+	}
+
+	var resultSlice []*Account
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice account")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on account")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for account")
+	}
+
+	if singular {
+		object.R.Accounts = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &accountR{}
+			}
+			foreign.R.Customer = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.CustomerID {
+				local.R.Accounts = append(local.R.Accounts, foreign)
+				if foreign.R == nil {
+					foreign.R = &accountR{}
+				}
+				foreign.R.Customer = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadMergedIntoCustomers allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (customerL) LoadMergedIntoCustomers(ctx context.Context, e boil.ContextExecutor, singular bool, maybeCustomer interface{}, mods queries.Applicator) error {
+	var slice []*Customer
+	var object *Customer
+
+	if singular {
+		object = maybeCustomer.(*Customer)
+	} else {
+		slice = *maybeCustomer.(*[]*Customer)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &customerR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &customerR{}
+			}
+
+			for _, a := range args {
+				if queries.Equal(a, obj.ID) {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`customer`),
+		qm.WhereIn(`customer.merged_into_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load customer")
+	}
+
+	var resultSlice []*Customer
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice customer")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on customer")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for customer")
+	}
+
+	if singular {
+		object.R.MergedIntoCustomers = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &customerR{}
+			}
+			foreign.R.MergedInto = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.MergedIntoID) {
+				local.R.MergedIntoCustomers = append(local.R.MergedIntoCustomers, foreign)
+				if foreign.R == nil {
+					foreign.R = &customerR{}
+				}
+				foreign.R.MergedInto = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadCustomerDocuments allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (customerL) LoadCustomerDocuments(ctx context.Context, e boil.ContextExecutor, singular bool, maybeCustomer interface{}, mods queries.Applicator) error {
+	var slice []*Customer
+	var object *Customer
+
+	if singular {
+		object = maybeCustomer.(*Customer)
+	} else {
+		slice = *maybeCustomer.(*[]*Customer)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &customerR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &customerR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`customer_document`),
+		qm.WhereIn(`customer_document.customer_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load customer_document")
+	}
+
+	var resultSlice []*CustomerDocument
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice customer_document")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on customer_document")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for customer_document")
+	}
+
+	if singular {
+		object.R.CustomerDocuments = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &customerDocumentR{}
+			}
+			foreign.R.Customer = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.CustomerID {
+				local.R.CustomerDocuments = append(local.R.CustomerDocuments, foreign)
+				if foreign.R == nil {
+					foreign.R = &customerDocumentR{}
+				}
+				foreign.R.Customer = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadCustomerLoginCodes allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (customerL) LoadCustomerLoginCodes(ctx context.Context, e boil.ContextExecutor, singular bool, maybeCustomer interface{}, mods queries.Applicator) error {
+	var slice []*Customer
+	var object *Customer
+
+	if singular {
+		object = maybeCustomer.(*Customer)
+	} else {
+		slice = *maybeCustomer.(*[]*Customer)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &customerR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &customerR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`customer_login_code`),
+		qm.WhereIn(`customer_login_code.customer_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load customer_login_code")
+	}
+
+	var resultSlice []*CustomerLoginCode
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice customer_login_code")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on customer_login_code")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for customer_login_code")
+	}
+
+	if singular {
+		object.R.CustomerLoginCodes = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &customerLoginCodeR{}
+			}
+			foreign.R.Customer = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.CustomerID {
+				local.R.CustomerLoginCodes = append(local.R.CustomerLoginCodes, foreign)
+				if foreign.R == nil {
+					foreign.R = &customerLoginCodeR{}
+				}
+				foreign.R.Customer = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadDuplicateCustomerMerges allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (customerL) LoadDuplicateCustomerMerges(ctx context.Context, e boil.ContextExecutor, singular bool, maybeCustomer interface{}, mods queries.Applicator) error {
+	var slice []*Customer
+	var object *Customer
+
+	if singular {
+		object = maybeCustomer.(*Customer)
+	} else {
+		slice = *maybeCustomer.(*[]*Customer)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &customerR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &customerR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`customer_merge`),
+		qm.WhereIn(`customer_merge.duplicate_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load customer_merge")
+	}
+
+	var resultSlice []*CustomerMerge
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice customer_merge")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on customer_merge")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for customer_merge")
+	}
+
+	if singular {
+		object.R.DuplicateCustomerMerges = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &customerMergeR{}
+			}
+			foreign.R.Duplicate = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.DuplicateID {
+				local.R.DuplicateCustomerMerges = append(local.R.DuplicateCustomerMerges, foreign)
+				if foreign.R == nil {
+					foreign.R = &customerMergeR{}
+				}
+				foreign.R.Duplicate = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadSurvivorCustomerMerges allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (customerL) LoadSurvivorCustomerMerges(ctx context.Context, e boil.ContextExecutor, singular bool, maybeCustomer interface{}, mods queries.Applicator) error {
+	var slice []*Customer
+	var object *Customer
+
+	if singular {
+		object = maybeCustomer.(*Customer)
+	} else {
+		slice = *maybeCustomer.(*[]*Customer)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &customerR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &customerR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`customer_merge`),
+		qm.WhereIn(`customer_merge.survivor_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load customer_merge")
+	}
+
+	var resultSlice []*CustomerMerge
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice customer_merge")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on customer_merge")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for customer_merge")
+	}
+
+	if singular {
+		object.R.SurvivorCustomerMerges = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &customerMergeR{}
+			}
+			foreign.R.Survivor = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.SurvivorID {
+				local.R.SurvivorCustomerMerges = append(local.R.SurvivorCustomerMerges, foreign)
+				if foreign.R == nil {
+					foreign.R = &customerMergeR{}
+				}
+				foreign.R.Survivor = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadDSCommissions allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (customerL) LoadDSCommissions(ctx context.Context, e boil.ContextExecutor, singular bool, maybeCustomer interface{}, mods queries.Applicator) error {
+	var slice []*Customer
+	var object *Customer
+
+	if singular {
+		object = maybeCustomer.(*Customer)
+	} else {
+		slice = *maybeCustomer.(*[]*Customer)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &customerR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &customerR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`ds_commission`),
+		qm.WhereIn(`ds_commission.customer_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load ds_commission")
+	}
+
+	var resultSlice []*DSCommission
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice ds_commission")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on ds_commission")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for ds_commission")
+	}
+
+	if singular {
+		object.R.DSCommissions = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &dsCommissionR{}
+			}
+			foreign.R.Customer = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.CustomerID {
+				local.R.DSCommissions = append(local.R.DSCommissions, foreign)
+				if foreign.R == nil {
+					foreign.R = &dsCommissionR{}
+				}
+				foreign.R.Customer = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadImportRows allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (customerL) LoadImportRows(ctx context.Context, e boil.ContextExecutor, singular bool, maybeCustomer interface{}, mods queries.Applicator) error {
+	var slice []*Customer
+	var object *Customer
+
+	if singular {
+		object = maybeCustomer.(*Customer)
+	} else {
+		slice = *maybeCustomer.(*[]*Customer)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &customerR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &customerR{}
+			}
+
+			for _, a := range args {
+				if queries.Equal(a, obj.ID) {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`import_row`),
+		qm.WhereIn(`import_row.customer_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load import_row")
+	}
+
+	var resultSlice []*ImportRow
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice import_row")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on import_row")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for import_row")
+	}
+
+	if singular {
+		object.R.ImportRows = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &importRowR{}
+			}
+			foreign.R.Customer = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.CustomerID) {
+				local.R.ImportRows = append(local.R.ImportRows, foreign)
+				if foreign.R == nil {
+					foreign.R = &importRowR{}
+				}
+				foreign.R.Customer = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadLoans allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (customerL) LoadLoans(ctx context.Context, e boil.ContextExecutor, singular bool, maybeCustomer interface{}, mods queries.Applicator) error {
+	var slice []*Customer
+	var object *Customer
+
+	if singular {
+		object = maybeCustomer.(*Customer)
+	} else {
+		slice = *maybeCustomer.(*[]*Customer)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &customerR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &customerR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`loan`),
+		qm.WhereIn(`loan.customer_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load loan")
+	}
+
+	var resultSlice []*Loan
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice loan")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on loan")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for loan")
+	}
+
+	if singular {
+		object.R.Loans = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &loanR{}
+			}
+			foreign.R.Customer = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.CustomerID {
+				local.R.Loans = append(local.R.Loans, foreign)
+				if foreign.R == nil {
+					foreign.R = &loanR{}
+				}
+				foreign.R.Customer = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadWithdrawalRequests allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (customerL) LoadWithdrawalRequests(ctx context.Context, e boil.ContextExecutor, singular bool, maybeCustomer interface{}, mods queries.Applicator) error {
+	var slice []*Customer
+	var object *Customer
+
+	if singular {
+		object = maybeCustomer.(*Customer)
+	} else {
+		slice = *maybeCustomer.(*[]*Customer)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &customerR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &customerR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`withdrawal_request`),
+		qm.WhereIn(`withdrawal_request.customer_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load withdrawal_request")
+	}
+
+	var resultSlice []*WithdrawalRequest
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice withdrawal_request")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on withdrawal_request")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for withdrawal_request")
+	}
+
+	if singular {
+		object.R.WithdrawalRequests = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &withdrawalRequestR{}
+			}
+			foreign.R.Customer = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.CustomerID {
+				local.R.WithdrawalRequests = append(local.R.WithdrawalRequests, foreign)
+				if foreign.R == nil {
+					foreign.R = &withdrawalRequestR{}
+				}
+				foreign.R.Customer = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetBranch of the customer to the related item.
+// Sets o.R.Branch to related.
+// Adds o to related.R.Customers.
+func (o *Customer) SetBranch(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Branch) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"customer\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"branch_id"}),
+		strmangle.WhereClause("\"", "\"", 2, customerPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.BranchID = related.ID
+	if o.R == nil {
+		o.R = &customerR{
+			Branch: related,
+		}
+	} else {
+		o.R.Branch = related
+	}
+
+	if related.R == nil {
+		related.R = &branchR{
+			Customers: CustomerSlice{o},
+		}
+	} else {
+		related.R.Customers = append(related.R.Customers, o)
+	}
+
+	return nil
+}
+
+// SetKycVerifiedBy of the customer to the related item.
+// Sets o.R.KycVerifiedBy to related.
+// Adds o to related.R.KycVerifiedByCustomers.
+func (o *Customer) SetKycVerifiedBy(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"customer\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"kyc_verified_by_id"}),
+		strmangle.WhereClause("\"", "\"", 2, customerPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.KycVerifiedByID, related.ID)
+	if o.R == nil {
+		o.R = &customerR{
+			KycVerifiedBy: related,
+		}
+	} else {
+		o.R.KycVerifiedBy = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			KycVerifiedByCustomers: CustomerSlice{o},
+		}
+	} else {
+		related.R.KycVerifiedByCustomers = append(related.R.KycVerifiedByCustomers, o)
+	}
+
+	return nil
+}
+
+// RemoveKycVerifiedBy relationship.
+// Sets o.R.KycVerifiedBy to nil.
+// Removes o from all passed in related items' relationships struct (Optional).
+func (o *Customer) RemoveKycVerifiedBy(ctx context.Context, exec boil.ContextExecutor, related *User) error {
+	var err error
+
+	queries.SetScanner(&o.KycVerifiedByID, nil)
+	if _, err = o.Update(ctx, exec, boil.Whitelist("kyc_verified_by_id")); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	if o.R != nil {
+		o.R.KycVerifiedBy = nil
+	}
+	if related == nil || related.R == nil {
+		return nil
+	}
+
+	for i, ri := range related.R.KycVerifiedByCustomers {
+		if queries.Equal(o.KycVerifiedByID, ri.KycVerifiedByID) {
+			continue
+		}
+
+		ln := len(related.R.KycVerifiedByCustomers)
+		if ln > 1 && i < ln-1 {
+			related.R.KycVerifiedByCustomers[i] = related.R.KycVerifiedByCustomers[ln-1]
+		}
+		related.R.KycVerifiedByCustomers = related.R.KycVerifiedByCustomers[:ln-1]
+		break
+	}
+	return nil
+}
+
+// SetMergedInto of the customer to the related item.
+// Sets o.R.MergedInto to related.
+// Adds o to related.R.MergedIntoCustomers.
+func (o *Customer) SetMergedInto(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Customer) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"customer\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"merged_into_id"}),
+		strmangle.WhereClause("\"", "\"", 2, customerPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.MergedIntoID, related.ID)
+	if o.R == nil {
+		o.R = &customerR{
+			MergedInto: related,
+		}
+	} else {
+		o.R.MergedInto = related
+	}
+
+	if related.R == nil {
+		related.R = &customerR{
+			MergedIntoCustomers: CustomerSlice{o},
+		}
+	} else {
+		related.R.MergedIntoCustomers = append(related.R.MergedIntoCustomers, o)
+	}
+
+	return nil
+}
+
+// RemoveMergedInto relationship.
+// Sets o.R.MergedInto to nil.
+// Removes o from all passed in related items' relationships struct (Optional).
+func (o *Customer) RemoveMergedInto(ctx context.Context, exec boil.ContextExecutor, related *Customer) error {
+	var err error
+
+	queries.SetScanner(&o.MergedIntoID, nil)
+	if _, err = o.Update(ctx, exec, boil.Whitelist("merged_into_id")); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	if o.R != nil {
+		o.R.MergedInto = nil
+	}
+	if related == nil || related.R == nil {
+		return nil
+	}
+
+	for i, ri := range related.R.MergedIntoCustomers {
+		if queries.Equal(o.MergedIntoID, ri.MergedIntoID) {
+			continue
+		}
+
+		ln := len(related.R.MergedIntoCustomers)
+		if ln > 1 && i < ln-1 {
+			related.R.MergedIntoCustomers[i] = related.R.MergedIntoCustomers[ln-1]
+		}
+		related.R.MergedIntoCustomers = related.R.MergedIntoCustomers[:ln-1]
+		break
+	}
+	return nil
+}
+
+// SetSalesRep of the customer to the related item.
+// Sets o.R.SalesRep to related.
+// Adds o to related.R.SalesRepCustomers.
+func (o *Customer) SetSalesRep(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
 	updateQuery := fmt.Sprintf(
 		"UPDATE \"customer\" SET %s WHERE %s",
-		strmangle.SetParamNames("\"", "\"", 1, []string{"branch_id"}),
+		strmangle.SetParamNames("\"", "\"", 1, []string{"sales_rep_id"}),
 		strmangle.WhereClause("\"", "\"", 2, customerPrimaryKeyColumns),
 	)
 	values := []interface{}{related.ID, o.ID}
 
-	if boil.IsDebug(ctx) {
-		writer := boil.DebugWriterFrom(ctx)
-		fmt.Fprintln(writer, updateQuery)
-		fmt.Fprintln(writer, values)
-	}
-	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
-		return errors.Wrap(err, "failed to update local table")
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.SalesRepID = related.ID
+	if o.R == nil {
+		o.R = &customerR{
+			SalesRep: related,
+		}
+	} else {
+		o.R.SalesRep = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			SalesRepCustomers: CustomerSlice{o},
+		}
+	} else {
+		related.R.SalesRepCustomers = append(related.R.SalesRepCustomers, o)
+	}
+
+	return nil
+}
+
+// AddAccounts adds the given related objects to the existing relationships
+// of the customer, optionally inserting them as new records.
+// Appends related to o.R.Accounts.
+// Sets related.R.Customer appropriately.
+func (o *Customer) AddAccounts(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Account) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.CustomerID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"account\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"customer_id"}),
+				strmangle.WhereClause("\"", "\"", 2, accountPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.CustomerID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &customerR{
+			Accounts: related,
+		}
+	} else {
+		o.R.Accounts = append(o.R.Accounts, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &accountR{
+				Customer: o,
+			}
+		} else {
+			rel.R.Customer = o
+		}
+	}
+	return nil
+}
+
+// AddMergedIntoCustomers adds the given related objects to the existing relationships
+// of the customer, optionally inserting them as new records.
+// Appends related to o.R.MergedIntoCustomers.
+// Sets related.R.MergedInto appropriately.
+func (o *Customer) AddMergedIntoCustomers(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Customer) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.MergedIntoID, o.ID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"customer\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"merged_into_id"}),
+				strmangle.WhereClause("\"", "\"", 2, customerPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.MergedIntoID, o.ID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &customerR{
+			MergedIntoCustomers: related,
+		}
+	} else {
+		o.R.MergedIntoCustomers = append(o.R.MergedIntoCustomers, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &customerR{
+				MergedInto: o,
+			}
+		} else {
+			rel.R.MergedInto = o
+		}
+	}
+	return nil
+}
+
+// SetMergedIntoCustomers removes all previously related items of the
+// customer replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.MergedInto's MergedIntoCustomers accordingly.
+// Replaces o.R.MergedIntoCustomers with related.
+// Sets related.R.MergedInto's MergedIntoCustomers accordingly.
+func (o *Customer) SetMergedIntoCustomers(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Customer) error {
+	query := "update \"customer\" set \"merged_into_id\" = null where \"merged_into_id\" = $1"
+	values := []interface{}{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.MergedIntoCustomers {
+			queries.SetScanner(&rel.MergedIntoID, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.MergedInto = nil
+		}
+
+		o.R.MergedIntoCustomers = nil
+	}
+	return o.AddMergedIntoCustomers(ctx, exec, insert, related...)
+}
+
+// RemoveMergedIntoCustomers relationships from objects passed in.
+// Removes related items from R.MergedIntoCustomers (uses pointer comparison, removal does not keep order)
+// Sets related.R.MergedInto.
+func (o *Customer) RemoveMergedIntoCustomers(ctx context.Context, exec boil.ContextExecutor, related ...*Customer) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.MergedIntoID, nil)
+		if rel.R != nil {
+			rel.R.MergedInto = nil
+		}
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("merged_into_id")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.MergedIntoCustomers {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.MergedIntoCustomers)
+			if ln > 1 && i < ln-1 {
+				o.R.MergedIntoCustomers[i] = o.R.MergedIntoCustomers[ln-1]
+			}
+			o.R.MergedIntoCustomers = o.R.MergedIntoCustomers[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
+// AddCustomerDocuments adds the given related objects to the existing relationships
+// of the customer, optionally inserting them as new records.
+// Appends related to o.R.CustomerDocuments.
+// Sets related.R.Customer appropriately.
+func (o *Customer) AddCustomerDocuments(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*CustomerDocument) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.CustomerID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"customer_document\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"customer_id"}),
+				strmangle.WhereClause("\"", "\"", 2, customerDocumentPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.CustomerID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &customerR{
+			CustomerDocuments: related,
+		}
+	} else {
+		o.R.CustomerDocuments = append(o.R.CustomerDocuments, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &customerDocumentR{
+				Customer: o,
+			}
+		} else {
+			rel.R.Customer = o
+		}
+	}
+	return nil
+}
+
+// AddCustomerLoginCodes adds the given related objects to the existing relationships
+// of the customer, optionally inserting them as new records.
+// Appends related to o.R.CustomerLoginCodes.
+// Sets related.R.Customer appropriately.
+func (o *Customer) AddCustomerLoginCodes(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*CustomerLoginCode) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.CustomerID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"customer_login_code\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"customer_id"}),
+				strmangle.WhereClause("\"", "\"", 2, customerLoginCodePrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.CustomerID = o.ID
+		}
 	}
 
-	o.BranchID = related.ID
 	if o.R == nil {
 		o.R = &customerR{
-			Branch: related,
+			CustomerLoginCodes: related,
 		}
 	} else {
-		o.R.Branch = related
+		o.R.CustomerLoginCodes = append(o.R.CustomerLoginCodes, related...)
 	}
 
-	if related.R == nil {
-		related.R = &branchR{
-			Customers: CustomerSlice{o},
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &customerLoginCodeR{
+				Customer: o,
+			}
+		} else {
+			rel.R.Customer = o
+		}
+	}
+	return nil
+}
+
+// AddDuplicateCustomerMerges adds the given related objects to the existing relationships
+// of the customer, optionally inserting them as new records.
+// Appends related to o.R.DuplicateCustomerMerges.
+// Sets related.R.Duplicate appropriately.
+func (o *Customer) AddDuplicateCustomerMerges(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*CustomerMerge) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.DuplicateID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"customer_merge\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"duplicate_id"}),
+				strmangle.WhereClause("\"", "\"", 2, customerMergePrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.DuplicateID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &customerR{
+			DuplicateCustomerMerges: related,
 		}
 	} else {
-		related.R.Customers = append(related.R.Customers, o)
+		o.R.DuplicateCustomerMerges = append(o.R.DuplicateCustomerMerges, related...)
 	}
 
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &customerMergeR{
+				Duplicate: o,
+			}
+		} else {
+			rel.R.Duplicate = o
+		}
+	}
 	return nil
 }
 
-// SetSalesRep of the customer to the related item.
-// Sets o.R.SalesRep to related.
-// Adds o to related.R.SalesRepCustomers.
-func (o *Customer) SetSalesRep(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
+// AddSurvivorCustomerMerges adds the given related objects to the existing relationships
+// of the customer, optionally inserting them as new records.
+// Appends related to o.R.SurvivorCustomerMerges.
+// Sets related.R.Survivor appropriately.
+func (o *Customer) AddSurvivorCustomerMerges(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*CustomerMerge) error {
 	var err error
-	if insert {
-		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
-			return errors.Wrap(err, "failed to insert into foreign table")
+	for _, rel := range related {
+		if insert {
+			rel.SurvivorID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"customer_merge\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"survivor_id"}),
+				strmangle.WhereClause("\"", "\"", 2, customerMergePrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.SurvivorID = o.ID
 		}
 	}
 
-	updateQuery := fmt.Sprintf(
-		"UPDATE \"customer\" SET %s WHERE %s",
-		strmangle.SetParamNames("\"", "\"", 1, []string{"sales_rep_id"}),
-		strmangle.WhereClause("\"", "\"", 2, customerPrimaryKeyColumns),
-	)
-	values := []interface{}{related.ID, o.ID}
+	if o.R == nil {
+		o.R = &customerR{
+			SurvivorCustomerMerges: related,
+		}
+	} else {
+		o.R.SurvivorCustomerMerges = append(o.R.SurvivorCustomerMerges, related...)
+	}
 
-	if boil.IsDebug(ctx) {
-		writer := boil.DebugWriterFrom(ctx)
-		fmt.Fprintln(writer, updateQuery)
-		fmt.Fprintln(writer, values)
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &customerMergeR{
+				Survivor: o,
+			}
+		} else {
+			rel.R.Survivor = o
+		}
 	}
-	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
-		return errors.Wrap(err, "failed to update local table")
+	return nil
+}
+
+// AddDSCommissions adds the given related objects to the existing relationships
+// of the customer, optionally inserting them as new records.
+// Appends related to o.R.DSCommissions.
+// Sets related.R.Customer appropriately.
+func (o *Customer) AddDSCommissions(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*DSCommission) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.CustomerID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"ds_commission\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"customer_id"}),
+				strmangle.WhereClause("\"", "\"", 2, dsCommissionPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.CustomerID = o.ID
+		}
 	}
 
-	o.SalesRepID = related.ID
 	if o.R == nil {
 		o.R = &customerR{
-			SalesRep: related,
+			DSCommissions: related,
 		}
 	} else {
-		o.R.SalesRep = related
+		o.R.DSCommissions = append(o.R.DSCommissions, related...)
 	}
 
-	if related.R == nil {
-		related.R = &userR{
-			SalesRepCustomers: CustomerSlice{o},
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &dsCommissionR{
+				Customer: o,
+			}
+		} else {
+			rel.R.Customer = o
+		}
+	}
+	return nil
+}
+
+// AddImportRows adds the given related objects to the existing relationships
+// of the customer, optionally inserting them as new records.
+// Appends related to o.R.ImportRows.
+// Sets related.R.Customer appropriately.
+func (o *Customer) AddImportRows(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*ImportRow) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.CustomerID, o.ID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"import_row\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"customer_id"}),
+				strmangle.WhereClause("\"", "\"", 2, importRowPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.CustomerID, o.ID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &customerR{
+			ImportRows: related,
 		}
 	} else {
-		related.R.SalesRepCustomers = append(related.R.SalesRepCustomers, o)
+		o.R.ImportRows = append(o.R.ImportRows, related...)
 	}
 
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &importRowR{
+				Customer: o,
+			}
+		} else {
+			rel.R.Customer = o
+		}
+	}
 	return nil
 }
 
-// AddAccounts adds the given related objects to the existing relationships
+// SetImportRows removes all previously related items of the
+// customer replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.Customer's ImportRows accordingly.
+// Replaces o.R.ImportRows with related.
+// Sets related.R.Customer's ImportRows accordingly.
+func (o *Customer) SetImportRows(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*ImportRow) error {
+	query := "update \"import_row\" set \"customer_id\" = null where \"customer_id\" = $1"
+	values := []interface{}{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.ImportRows {
+			queries.SetScanner(&rel.CustomerID, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.Customer = nil
+		}
+
+		o.R.ImportRows = nil
+	}
+	return o.AddImportRows(ctx, exec, insert, related...)
+}
+
+// RemoveImportRows relationships from objects passed in.
+// Removes related items from R.ImportRows (uses pointer comparison, removal does not keep order)
+// Sets related.R.Customer.
+func (o *Customer) RemoveImportRows(ctx context.Context, exec boil.ContextExecutor, related ...*ImportRow) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.CustomerID, nil)
+		if rel.R != nil {
+			rel.R.Customer = nil
+		}
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("customer_id")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.ImportRows {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.ImportRows)
+			if ln > 1 && i < ln-1 {
+				o.R.ImportRows[i] = o.R.ImportRows[ln-1]
+			}
+			o.R.ImportRows = o.R.ImportRows[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
+// AddLoans adds the given related objects to the existing relationships
 // of the customer, optionally inserting them as new records.
-// Appends related to o.R.Accounts.
+// Appends related to o.R.Loans.
 // Sets related.R.Customer appropriately.
-func (o *Customer) AddAccounts(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Account) error {
+func (o *Customer) AddLoans(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Loan) error {
 	var err error
 	for _, rel := range related {
 		if insert {
@@ -792,9 +2790,9 @@ func (o *Customer) AddAccounts(ctx context.Context, exec boil.ContextExecutor, i
 			}
 		} else {
 			updateQuery := fmt.Sprintf(
-				"UPDATE \"account\" SET %s WHERE %s",
+				"UPDATE \"loan\" SET %s WHERE %s",
 				strmangle.SetParamNames("\"", "\"", 1, []string{"customer_id"}),
-				strmangle.WhereClause("\"", "\"", 2, accountPrimaryKeyColumns),
+				strmangle.WhereClause("\"", "\"", 2, loanPrimaryKeyColumns),
 			)
 			values := []interface{}{o.ID, rel.ID}
 
@@ -813,15 +2811,15 @@ func (o *Customer) AddAccounts(ctx context.Context, exec boil.ContextExecutor, i
 
 	if o.R == nil {
 		o.R = &customerR{
-			Accounts: related,
+			Loans: related,
 		}
 	} else {
-		o.R.Accounts = append(o.R.Accounts, related...)
+		o.R.Loans = append(o.R.Loans, related...)
 	}
 
 	for _, rel := range related {
 		if rel.R == nil {
-			rel.R = &accountR{
+			rel.R = &loanR{
 				Customer: o,
 			}
 		} else {
@@ -831,11 +2829,11 @@ func (o *Customer) AddAccounts(ctx context.Context, exec boil.ContextExecutor, i
 	return nil
 }
 
-// AddDSCommissions adds the given related objects to the existing relationships
+// AddWithdrawalRequests adds the given related objects to the existing relationships
 // of the customer, optionally inserting them as new records.
-// Appends related to o.R.DSCommissions.
+// Appends related to o.R.WithdrawalRequests.
 // Sets related.R.Customer appropriately.
-func (o *Customer) AddDSCommissions(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*DSCommission) error {
+func (o *Customer) AddWithdrawalRequests(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*WithdrawalRequest) error {
 	var err error
 	for _, rel := range related {
 		if insert {
@@ -845,9 +2843,9 @@ func (o *Customer) AddDSCommissions(ctx context.Context, exec boil.ContextExecut
 			}
 		} else {
 			updateQuery := fmt.Sprintf(
-				"UPDATE \"ds_commission\" SET %s WHERE %s",
+				"UPDATE \"withdrawal_request\" SET %s WHERE %s",
 				strmangle.SetParamNames("\"", "\"", 1, []string{"customer_id"}),
-				strmangle.WhereClause("\"", "\"", 2, dsCommissionPrimaryKeyColumns),
+				strmangle.WhereClause("\"", "\"", 2, withdrawalRequestPrimaryKeyColumns),
 			)
 			values := []interface{}{o.ID, rel.ID}
 
@@ -866,15 +2864,15 @@ func (o *Customer) AddDSCommissions(ctx context.Context, exec boil.ContextExecut
 
 	if o.R == nil {
 		o.R = &customerR{
-			DSCommissions: related,
+			WithdrawalRequests: related,
 		}
 	} else {
-		o.R.DSCommissions = append(o.R.DSCommissions, related...)
+		o.R.WithdrawalRequests = append(o.R.WithdrawalRequests, related...)
 	}
 
 	for _, rel := range related {
 		if rel.R == nil {
-			rel.R = &dsCommissionR{
+			rel.R = &withdrawalRequestR{
 				Customer: o,
 			}
 		} else {
@@ -431,6 +431,395 @@ func testCustomerToManyAccounts(t *testing.T) {
 	}
 }
 
+func testCustomerToManyMergedIntoCustomers(t *testing.T) {
+	var err error
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Customer
+	var b, c Customer
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, customerDBTypes, true, customerColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Customer struct: %s", err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = randomize.Struct(seed, &b, customerDBTypes, false, customerColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, customerDBTypes, false, customerColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+
+	queries.Assign(&b.MergedIntoID, a.ID)
+	queries.Assign(&c.MergedIntoID, a.ID)
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := a.MergedIntoCustomers().All(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bFound, cFound := false, false
+	for _, v := range check {
+		if queries.Equal(v.MergedIntoID, b.MergedIntoID) {
+			bFound = true
+		}
+		if queries.Equal(v.MergedIntoID, c.MergedIntoID) {
+			cFound = true
+		}
+	}
+
+	if !bFound {
+		t.Error("expected to find b")
+	}
+	if !cFound {
+		t.Error("expected to find c")
+	}
+
+	slice := CustomerSlice{&a}
+	if err = a.L.LoadMergedIntoCustomers(ctx, tx, false, (*[]*Customer)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.MergedIntoCustomers); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	a.R.MergedIntoCustomers = nil
+	if err = a.L.LoadMergedIntoCustomers(ctx, tx, true, &a, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.MergedIntoCustomers); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	if t.Failed() {
+		t.Logf("%#v", check)
+	}
+}
+
+func testCustomerToManyCustomerDocuments(t *testing.T) {
+	var err error
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Customer
+	var b, c CustomerDocument
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, customerDBTypes, true, customerColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Customer struct: %s", err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = randomize.Struct(seed, &b, customerDocumentDBTypes, false, customerDocumentColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, customerDocumentDBTypes, false, customerDocumentColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+
+	b.CustomerID = a.ID
+	c.CustomerID = a.ID
+
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := a.CustomerDocuments().All(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bFound, cFound := false, false
+	for _, v := range check {
+		if v.CustomerID == b.CustomerID {
+			bFound = true
+		}
+		if v.CustomerID == c.CustomerID {
+			cFound = true
+		}
+	}
+
+	if !bFound {
+		t.Error("expected to find b")
+	}
+	if !cFound {
+		t.Error("expected to find c")
+	}
+
+	slice := CustomerSlice{&a}
+	if err = a.L.LoadCustomerDocuments(ctx, tx, false, (*[]*Customer)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.CustomerDocuments); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	a.R.CustomerDocuments = nil
+	if err = a.L.LoadCustomerDocuments(ctx, tx, true, &a, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.CustomerDocuments); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	if t.Failed() {
+		t.Logf("%#v", check)
+	}
+}
+
+func testCustomerToManyCustomerLoginCodes(t *testing.T) {
+	var err error
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Customer
+	var b, c CustomerLoginCode
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, customerDBTypes, true, customerColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Customer struct: %s", err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = randomize.Struct(seed, &b, customerLoginCodeDBTypes, false, customerLoginCodeColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, customerLoginCodeDBTypes, false, customerLoginCodeColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+
+	b.CustomerID = a.ID
+	c.CustomerID = a.ID
+
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := a.CustomerLoginCodes().All(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bFound, cFound := false, false
+	for _, v := range check {
+		if v.CustomerID == b.CustomerID {
+			bFound = true
+		}
+		if v.CustomerID == c.CustomerID {
+			cFound = true
+		}
+	}
+
+	if !bFound {
+		t.Error("expected to find b")
+	}
+	if !cFound {
+		t.Error("expected to find c")
+	}
+
+	slice := CustomerSlice{&a}
+	if err = a.L.LoadCustomerLoginCodes(ctx, tx, false, (*[]*Customer)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.CustomerLoginCodes); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	a.R.CustomerLoginCodes = nil
+	if err = a.L.LoadCustomerLoginCodes(ctx, tx, true, &a, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.CustomerLoginCodes); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	if t.Failed() {
+		t.Logf("%#v", check)
+	}
+}
+
+func testCustomerToManyDuplicateCustomerMerges(t *testing.T) {
+	var err error
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Customer
+	var b, c CustomerMerge
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, customerDBTypes, true, customerColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Customer struct: %s", err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = randomize.Struct(seed, &b, customerMergeDBTypes, false, customerMergeColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, customerMergeDBTypes, false, customerMergeColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+
+	b.DuplicateID = a.ID
+	c.DuplicateID = a.ID
+
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := a.DuplicateCustomerMerges().All(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bFound, cFound := false, false
+	for _, v := range check {
+		if v.DuplicateID == b.DuplicateID {
+			bFound = true
+		}
+		if v.DuplicateID == c.DuplicateID {
+			cFound = true
+		}
+	}
+
+	if !bFound {
+		t.Error("expected to find b")
+	}
+	if !cFound {
+		t.Error("expected to find c")
+	}
+
+	slice := CustomerSlice{&a}
+	if err = a.L.LoadDuplicateCustomerMerges(ctx, tx, false, (*[]*Customer)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.DuplicateCustomerMerges); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	a.R.DuplicateCustomerMerges = nil
+	if err = a.L.LoadDuplicateCustomerMerges(ctx, tx, true, &a, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.DuplicateCustomerMerges); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	if t.Failed() {
+		t.Logf("%#v", check)
+	}
+}
+
+func testCustomerToManySurvivorCustomerMerges(t *testing.T) {
+	var err error
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Customer
+	var b, c CustomerMerge
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, customerDBTypes, true, customerColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Customer struct: %s", err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = randomize.Struct(seed, &b, customerMergeDBTypes, false, customerMergeColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, customerMergeDBTypes, false, customerMergeColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+
+	b.SurvivorID = a.ID
+	c.SurvivorID = a.ID
+
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := a.SurvivorCustomerMerges().All(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bFound, cFound := false, false
+	for _, v := range check {
+		if v.SurvivorID == b.SurvivorID {
+			bFound = true
+		}
+		if v.SurvivorID == c.SurvivorID {
+			cFound = true
+		}
+	}
+
+	if !bFound {
+		t.Error("expected to find b")
+	}
+	if !cFound {
+		t.Error("expected to find c")
+	}
+
+	slice := CustomerSlice{&a}
+	if err = a.L.LoadSurvivorCustomerMerges(ctx, tx, false, (*[]*Customer)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.SurvivorCustomerMerges); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	a.R.SurvivorCustomerMerges = nil
+	if err = a.L.LoadSurvivorCustomerMerges(ctx, tx, true, &a, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.SurvivorCustomerMerges); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	if t.Failed() {
+		t.Logf("%#v", check)
+	}
+}
+
 func testCustomerToManyDSCommissions(t *testing.T) {
 	var err error
 	ctx := context.Background()
@@ -438,27 +827,1111 @@ func testCustomerToManyDSCommissions(t *testing.T) {
 	defer func() { _ = tx.Rollback() }()
 
 	var a Customer
-	var b, c DSCommission
+	var b, c DSCommission
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, customerDBTypes, true, customerColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Customer struct: %s", err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = randomize.Struct(seed, &b, dsCommissionDBTypes, false, dsCommissionColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, dsCommissionDBTypes, false, dsCommissionColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+
+	b.CustomerID = a.ID
+	c.CustomerID = a.ID
+
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := a.DSCommissions().All(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bFound, cFound := false, false
+	for _, v := range check {
+		if v.CustomerID == b.CustomerID {
+			bFound = true
+		}
+		if v.CustomerID == c.CustomerID {
+			cFound = true
+		}
+	}
+
+	if !bFound {
+		t.Error("expected to find b")
+	}
+	if !cFound {
+		t.Error("expected to find c")
+	}
+
+	slice := CustomerSlice{&a}
+	if err = a.L.LoadDSCommissions(ctx, tx, false, (*[]*Customer)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.DSCommissions); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	a.R.DSCommissions = nil
+	if err = a.L.LoadDSCommissions(ctx, tx, true, &a, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.DSCommissions); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	if t.Failed() {
+		t.Logf("%#v", check)
+	}
+}
+
+func testCustomerToManyImportRows(t *testing.T) {
+	var err error
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Customer
+	var b, c ImportRow
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, customerDBTypes, true, customerColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Customer struct: %s", err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = randomize.Struct(seed, &b, importRowDBTypes, false, importRowColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, importRowDBTypes, false, importRowColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+
+	queries.Assign(&b.CustomerID, a.ID)
+	queries.Assign(&c.CustomerID, a.ID)
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := a.ImportRows().All(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bFound, cFound := false, false
+	for _, v := range check {
+		if queries.Equal(v.CustomerID, b.CustomerID) {
+			bFound = true
+		}
+		if queries.Equal(v.CustomerID, c.CustomerID) {
+			cFound = true
+		}
+	}
+
+	if !bFound {
+		t.Error("expected to find b")
+	}
+	if !cFound {
+		t.Error("expected to find c")
+	}
+
+	slice := CustomerSlice{&a}
+	if err = a.L.LoadImportRows(ctx, tx, false, (*[]*Customer)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.ImportRows); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	a.R.ImportRows = nil
+	if err = a.L.LoadImportRows(ctx, tx, true, &a, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.ImportRows); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	if t.Failed() {
+		t.Logf("%#v", check)
+	}
+}
+
+func testCustomerToManyLoans(t *testing.T) {
+	var err error
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Customer
+	var b, c Loan
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, customerDBTypes, true, customerColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Customer struct: %s", err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = randomize.Struct(seed, &b, loanDBTypes, false, loanColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, loanDBTypes, false, loanColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+
+	b.CustomerID = a.ID
+	c.CustomerID = a.ID
+
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := a.Loans().All(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bFound, cFound := false, false
+	for _, v := range check {
+		if v.CustomerID == b.CustomerID {
+			bFound = true
+		}
+		if v.CustomerID == c.CustomerID {
+			cFound = true
+		}
+	}
+
+	if !bFound {
+		t.Error("expected to find b")
+	}
+	if !cFound {
+		t.Error("expected to find c")
+	}
+
+	slice := CustomerSlice{&a}
+	if err = a.L.LoadLoans(ctx, tx, false, (*[]*Customer)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.Loans); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	a.R.Loans = nil
+	if err = a.L.LoadLoans(ctx, tx, true, &a, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.Loans); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	if t.Failed() {
+		t.Logf("%#v", check)
+	}
+}
+
+func testCustomerToManyWithdrawalRequests(t *testing.T) {
+	var err error
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Customer
+	var b, c WithdrawalRequest
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, customerDBTypes, true, customerColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Customer struct: %s", err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = randomize.Struct(seed, &b, withdrawalRequestDBTypes, false, withdrawalRequestColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, withdrawalRequestDBTypes, false, withdrawalRequestColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+
+	b.CustomerID = a.ID
+	c.CustomerID = a.ID
+
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := a.WithdrawalRequests().All(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bFound, cFound := false, false
+	for _, v := range check {
+		if v.CustomerID == b.CustomerID {
+			bFound = true
+		}
+		if v.CustomerID == c.CustomerID {
+			cFound = true
+		}
+	}
+
+	if !bFound {
+		t.Error("expected to find b")
+	}
+	if !cFound {
+		t.Error("expected to find c")
+	}
+
+	slice := CustomerSlice{&a}
+	if err = a.L.LoadWithdrawalRequests(ctx, tx, false, (*[]*Customer)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.WithdrawalRequests); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	a.R.WithdrawalRequests = nil
+	if err = a.L.LoadWithdrawalRequests(ctx, tx, true, &a, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.WithdrawalRequests); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	if t.Failed() {
+		t.Logf("%#v", check)
+	}
+}
+
+func testCustomerToManyAddOpAccounts(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Customer
+	var b, c, d, e Account
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, customerDBTypes, false, strmangle.SetComplement(customerPrimaryKeyColumns, customerColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*Account{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, accountDBTypes, false, strmangle.SetComplement(accountPrimaryKeyColumns, accountColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	foreignersSplitByInsertion := [][]*Account{
+		{&b, &c},
+		{&d, &e},
+	}
+
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddAccounts(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		first := x[0]
+		second := x[1]
+
+		if a.ID != first.CustomerID {
+			t.Error("foreign key was wrong value", a.ID, first.CustomerID)
+		}
+		if a.ID != second.CustomerID {
+			t.Error("foreign key was wrong value", a.ID, second.CustomerID)
+		}
+
+		if first.R.Customer != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.Customer != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+
+		if a.R.Accounts[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.Accounts[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
+
+		count, err := a.Accounts().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
+	}
+}
+func testCustomerToManyAddOpMergedIntoCustomers(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Customer
+	var b, c, d, e Customer
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, customerDBTypes, false, strmangle.SetComplement(customerPrimaryKeyColumns, customerColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*Customer{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, customerDBTypes, false, strmangle.SetComplement(customerPrimaryKeyColumns, customerColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	foreignersSplitByInsertion := [][]*Customer{
+		{&b, &c},
+		{&d, &e},
+	}
+
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddMergedIntoCustomers(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		first := x[0]
+		second := x[1]
+
+		if !queries.Equal(a.ID, first.MergedIntoID) {
+			t.Error("foreign key was wrong value", a.ID, first.MergedIntoID)
+		}
+		if !queries.Equal(a.ID, second.MergedIntoID) {
+			t.Error("foreign key was wrong value", a.ID, second.MergedIntoID)
+		}
+
+		if first.R.MergedInto != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.MergedInto != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+
+		if a.R.MergedIntoCustomers[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.MergedIntoCustomers[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
+
+		count, err := a.MergedIntoCustomers().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
+	}
+}
+
+func testCustomerToManySetOpMergedIntoCustomers(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Customer
+	var b, c, d, e Customer
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, customerDBTypes, false, strmangle.SetComplement(customerPrimaryKeyColumns, customerColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*Customer{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, customerDBTypes, false, strmangle.SetComplement(customerPrimaryKeyColumns, customerColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err = a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	err = a.SetMergedIntoCustomers(ctx, tx, false, &b, &c)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err := a.MergedIntoCustomers().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	err = a.SetMergedIntoCustomers(ctx, tx, true, &d, &e)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err = a.MergedIntoCustomers().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	if !queries.IsValuerNil(b.MergedIntoID) {
+		t.Error("want b's foreign key value to be nil")
+	}
+	if !queries.IsValuerNil(c.MergedIntoID) {
+		t.Error("want c's foreign key value to be nil")
+	}
+	if !queries.Equal(a.ID, d.MergedIntoID) {
+		t.Error("foreign key was wrong value", a.ID, d.MergedIntoID)
+	}
+	if !queries.Equal(a.ID, e.MergedIntoID) {
+		t.Error("foreign key was wrong value", a.ID, e.MergedIntoID)
+	}
+
+	if b.R.MergedInto != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if c.R.MergedInto != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if d.R.MergedInto != &a {
+		t.Error("relationship was not added properly to the foreign struct")
+	}
+	if e.R.MergedInto != &a {
+		t.Error("relationship was not added properly to the foreign struct")
+	}
+
+	if a.R.MergedIntoCustomers[0] != &d {
+		t.Error("relationship struct slice not set to correct value")
+	}
+	if a.R.MergedIntoCustomers[1] != &e {
+		t.Error("relationship struct slice not set to correct value")
+	}
+}
+
+func testCustomerToManyRemoveOpMergedIntoCustomers(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Customer
+	var b, c, d, e Customer
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, customerDBTypes, false, strmangle.SetComplement(customerPrimaryKeyColumns, customerColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*Customer{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, customerDBTypes, false, strmangle.SetComplement(customerPrimaryKeyColumns, customerColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	err = a.AddMergedIntoCustomers(ctx, tx, true, foreigners...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err := a.MergedIntoCustomers().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 4 {
+		t.Error("count was wrong:", count)
+	}
+
+	err = a.RemoveMergedIntoCustomers(ctx, tx, foreigners[:2]...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err = a.MergedIntoCustomers().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	if !queries.IsValuerNil(b.MergedIntoID) {
+		t.Error("want b's foreign key value to be nil")
+	}
+	if !queries.IsValuerNil(c.MergedIntoID) {
+		t.Error("want c's foreign key value to be nil")
+	}
+
+	if b.R.MergedInto != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if c.R.MergedInto != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if d.R.MergedInto != &a {
+		t.Error("relationship to a should have been preserved")
+	}
+	if e.R.MergedInto != &a {
+		t.Error("relationship to a should have been preserved")
+	}
+
+	if len(a.R.MergedIntoCustomers) != 2 {
+		t.Error("should have preserved two relationships")
+	}
+
+	// Removal doesn't do a stable deletion for performance so we have to flip the order
+	if a.R.MergedIntoCustomers[1] != &d {
+		t.Error("relationship to d should have been preserved")
+	}
+	if a.R.MergedIntoCustomers[0] != &e {
+		t.Error("relationship to e should have been preserved")
+	}
+}
+
+func testCustomerToManyAddOpCustomerDocuments(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Customer
+	var b, c, d, e CustomerDocument
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, customerDBTypes, false, strmangle.SetComplement(customerPrimaryKeyColumns, customerColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*CustomerDocument{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, customerDocumentDBTypes, false, strmangle.SetComplement(customerDocumentPrimaryKeyColumns, customerDocumentColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	foreignersSplitByInsertion := [][]*CustomerDocument{
+		{&b, &c},
+		{&d, &e},
+	}
+
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddCustomerDocuments(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		first := x[0]
+		second := x[1]
+
+		if a.ID != first.CustomerID {
+			t.Error("foreign key was wrong value", a.ID, first.CustomerID)
+		}
+		if a.ID != second.CustomerID {
+			t.Error("foreign key was wrong value", a.ID, second.CustomerID)
+		}
+
+		if first.R.Customer != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.Customer != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+
+		if a.R.CustomerDocuments[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.CustomerDocuments[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
+
+		count, err := a.CustomerDocuments().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
+	}
+}
+func testCustomerToManyAddOpCustomerLoginCodes(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Customer
+	var b, c, d, e CustomerLoginCode
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, customerDBTypes, false, strmangle.SetComplement(customerPrimaryKeyColumns, customerColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*CustomerLoginCode{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, customerLoginCodeDBTypes, false, strmangle.SetComplement(customerLoginCodePrimaryKeyColumns, customerLoginCodeColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	foreignersSplitByInsertion := [][]*CustomerLoginCode{
+		{&b, &c},
+		{&d, &e},
+	}
+
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddCustomerLoginCodes(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		first := x[0]
+		second := x[1]
+
+		if a.ID != first.CustomerID {
+			t.Error("foreign key was wrong value", a.ID, first.CustomerID)
+		}
+		if a.ID != second.CustomerID {
+			t.Error("foreign key was wrong value", a.ID, second.CustomerID)
+		}
+
+		if first.R.Customer != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.Customer != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+
+		if a.R.CustomerLoginCodes[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.CustomerLoginCodes[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
+
+		count, err := a.CustomerLoginCodes().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
+	}
+}
+func testCustomerToManyAddOpDuplicateCustomerMerges(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Customer
+	var b, c, d, e CustomerMerge
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, customerDBTypes, false, strmangle.SetComplement(customerPrimaryKeyColumns, customerColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*CustomerMerge{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, customerMergeDBTypes, false, strmangle.SetComplement(customerMergePrimaryKeyColumns, customerMergeColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	foreignersSplitByInsertion := [][]*CustomerMerge{
+		{&b, &c},
+		{&d, &e},
+	}
+
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddDuplicateCustomerMerges(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		first := x[0]
+		second := x[1]
+
+		if a.ID != first.DuplicateID {
+			t.Error("foreign key was wrong value", a.ID, first.DuplicateID)
+		}
+		if a.ID != second.DuplicateID {
+			t.Error("foreign key was wrong value", a.ID, second.DuplicateID)
+		}
+
+		if first.R.Duplicate != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.Duplicate != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+
+		if a.R.DuplicateCustomerMerges[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.DuplicateCustomerMerges[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
+
+		count, err := a.DuplicateCustomerMerges().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
+	}
+}
+func testCustomerToManyAddOpSurvivorCustomerMerges(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Customer
+	var b, c, d, e CustomerMerge
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, customerDBTypes, false, strmangle.SetComplement(customerPrimaryKeyColumns, customerColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*CustomerMerge{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, customerMergeDBTypes, false, strmangle.SetComplement(customerMergePrimaryKeyColumns, customerMergeColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	foreignersSplitByInsertion := [][]*CustomerMerge{
+		{&b, &c},
+		{&d, &e},
+	}
+
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddSurvivorCustomerMerges(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		first := x[0]
+		second := x[1]
+
+		if a.ID != first.SurvivorID {
+			t.Error("foreign key was wrong value", a.ID, first.SurvivorID)
+		}
+		if a.ID != second.SurvivorID {
+			t.Error("foreign key was wrong value", a.ID, second.SurvivorID)
+		}
+
+		if first.R.Survivor != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.Survivor != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+
+		if a.R.SurvivorCustomerMerges[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.SurvivorCustomerMerges[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
+
+		count, err := a.SurvivorCustomerMerges().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
+	}
+}
+func testCustomerToManyAddOpDSCommissions(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Customer
+	var b, c, d, e DSCommission
 
 	seed := randomize.NewSeed()
-	if err = randomize.Struct(seed, &a, customerDBTypes, true, customerColumnsWithDefault...); err != nil {
-		t.Errorf("Unable to randomize Customer struct: %s", err)
+	if err = randomize.Struct(seed, &a, customerDBTypes, false, strmangle.SetComplement(customerPrimaryKeyColumns, customerColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*DSCommission{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, dsCommissionDBTypes, false, strmangle.SetComplement(dsCommissionPrimaryKeyColumns, dsCommissionColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
 	}
 
 	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
 		t.Fatal(err)
 	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
 
-	if err = randomize.Struct(seed, &b, dsCommissionDBTypes, false, dsCommissionColumnsWithDefault...); err != nil {
+	foreignersSplitByInsertion := [][]*DSCommission{
+		{&b, &c},
+		{&d, &e},
+	}
+
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddDSCommissions(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		first := x[0]
+		second := x[1]
+
+		if a.ID != first.CustomerID {
+			t.Error("foreign key was wrong value", a.ID, first.CustomerID)
+		}
+		if a.ID != second.CustomerID {
+			t.Error("foreign key was wrong value", a.ID, second.CustomerID)
+		}
+
+		if first.R.Customer != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.Customer != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+
+		if a.R.DSCommissions[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.DSCommissions[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
+
+		count, err := a.DSCommissions().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
+	}
+}
+func testCustomerToManyAddOpImportRows(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Customer
+	var b, c, d, e ImportRow
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, customerDBTypes, false, strmangle.SetComplement(customerPrimaryKeyColumns, customerColumnsWithoutDefault)...); err != nil {
 		t.Fatal(err)
 	}
-	if err = randomize.Struct(seed, &c, dsCommissionDBTypes, false, dsCommissionColumnsWithDefault...); err != nil {
+	foreigners := []*ImportRow{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, importRowDBTypes, false, strmangle.SetComplement(importRowPrimaryKeyColumns, importRowColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
 		t.Fatal(err)
 	}
 
-	b.CustomerID = a.ID
-	c.CustomerID = a.ID
+	foreignersSplitByInsertion := [][]*ImportRow{
+		{&b, &c},
+		{&d, &e},
+	}
+
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddImportRows(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		first := x[0]
+		second := x[1]
+
+		if !queries.Equal(a.ID, first.CustomerID) {
+			t.Error("foreign key was wrong value", a.ID, first.CustomerID)
+		}
+		if !queries.Equal(a.ID, second.CustomerID) {
+			t.Error("foreign key was wrong value", a.ID, second.CustomerID)
+		}
+
+		if first.R.Customer != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.Customer != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+
+		if a.R.ImportRows[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.ImportRows[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
+
+		count, err := a.ImportRows().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
+	}
+}
+
+func testCustomerToManySetOpImportRows(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Customer
+	var b, c, d, e ImportRow
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, customerDBTypes, false, strmangle.SetComplement(customerPrimaryKeyColumns, customerColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*ImportRow{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, importRowDBTypes, false, strmangle.SetComplement(importRowPrimaryKeyColumns, importRowColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
 
+	if err = a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
 	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
 		t.Fatal(err)
 	}
@@ -466,50 +1939,151 @@ func testCustomerToManyDSCommissions(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	check, err := a.DSCommissions().All(ctx, tx)
+	err = a.SetImportRows(ctx, tx, false, &b, &c)
 	if err != nil {
 		t.Fatal(err)
 	}
 
-	bFound, cFound := false, false
-	for _, v := range check {
-		if v.CustomerID == b.CustomerID {
-			bFound = true
-		}
-		if v.CustomerID == c.CustomerID {
-			cFound = true
+	count, err := a.ImportRows().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	err = a.SetImportRows(ctx, tx, true, &d, &e)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err = a.ImportRows().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	if !queries.IsValuerNil(b.CustomerID) {
+		t.Error("want b's foreign key value to be nil")
+	}
+	if !queries.IsValuerNil(c.CustomerID) {
+		t.Error("want c's foreign key value to be nil")
+	}
+	if !queries.Equal(a.ID, d.CustomerID) {
+		t.Error("foreign key was wrong value", a.ID, d.CustomerID)
+	}
+	if !queries.Equal(a.ID, e.CustomerID) {
+		t.Error("foreign key was wrong value", a.ID, e.CustomerID)
+	}
+
+	if b.R.Customer != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if c.R.Customer != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if d.R.Customer != &a {
+		t.Error("relationship was not added properly to the foreign struct")
+	}
+	if e.R.Customer != &a {
+		t.Error("relationship was not added properly to the foreign struct")
+	}
+
+	if a.R.ImportRows[0] != &d {
+		t.Error("relationship struct slice not set to correct value")
+	}
+	if a.R.ImportRows[1] != &e {
+		t.Error("relationship struct slice not set to correct value")
+	}
+}
+
+func testCustomerToManyRemoveOpImportRows(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Customer
+	var b, c, d, e ImportRow
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, customerDBTypes, false, strmangle.SetComplement(customerPrimaryKeyColumns, customerColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*ImportRow{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, importRowDBTypes, false, strmangle.SetComplement(importRowPrimaryKeyColumns, importRowColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
 		}
 	}
 
-	if !bFound {
-		t.Error("expected to find b")
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
 	}
-	if !cFound {
-		t.Error("expected to find c")
+
+	err = a.AddImportRows(ctx, tx, true, foreigners...)
+	if err != nil {
+		t.Fatal(err)
 	}
 
-	slice := CustomerSlice{&a}
-	if err = a.L.LoadDSCommissions(ctx, tx, false, (*[]*Customer)(&slice), nil); err != nil {
+	count, err := a.ImportRows().Count(ctx, tx)
+	if err != nil {
 		t.Fatal(err)
 	}
-	if got := len(a.R.DSCommissions); got != 2 {
-		t.Error("number of eager loaded records wrong, got:", got)
+	if count != 4 {
+		t.Error("count was wrong:", count)
 	}
 
-	a.R.DSCommissions = nil
-	if err = a.L.LoadDSCommissions(ctx, tx, true, &a, nil); err != nil {
+	err = a.RemoveImportRows(ctx, tx, foreigners[:2]...)
+	if err != nil {
 		t.Fatal(err)
 	}
-	if got := len(a.R.DSCommissions); got != 2 {
-		t.Error("number of eager loaded records wrong, got:", got)
+
+	count, err = a.ImportRows().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
 	}
 
-	if t.Failed() {
-		t.Logf("%#v", check)
+	if !queries.IsValuerNil(b.CustomerID) {
+		t.Error("want b's foreign key value to be nil")
+	}
+	if !queries.IsValuerNil(c.CustomerID) {
+		t.Error("want c's foreign key value to be nil")
+	}
+
+	if b.R.Customer != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if c.R.Customer != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if d.R.Customer != &a {
+		t.Error("relationship to a should have been preserved")
+	}
+	if e.R.Customer != &a {
+		t.Error("relationship to a should have been preserved")
+	}
+
+	if len(a.R.ImportRows) != 2 {
+		t.Error("should have preserved two relationships")
+	}
+
+	// Removal doesn't do a stable deletion for performance so we have to flip the order
+	if a.R.ImportRows[1] != &d {
+		t.Error("relationship to d should have been preserved")
+	}
+	if a.R.ImportRows[0] != &e {
+		t.Error("relationship to e should have been preserved")
 	}
 }
 
-func testCustomerToManyAddOpAccounts(t *testing.T) {
+func testCustomerToManyAddOpLoans(t *testing.T) {
 	var err error
 
 	ctx := context.Background()
@@ -517,15 +2091,15 @@ func testCustomerToManyAddOpAccounts(t *testing.T) {
 	defer func() { _ = tx.Rollback() }()
 
 	var a Customer
-	var b, c, d, e Account
+	var b, c, d, e Loan
 
 	seed := randomize.NewSeed()
 	if err = randomize.Struct(seed, &a, customerDBTypes, false, strmangle.SetComplement(customerPrimaryKeyColumns, customerColumnsWithoutDefault)...); err != nil {
 		t.Fatal(err)
 	}
-	foreigners := []*Account{&b, &c, &d, &e}
+	foreigners := []*Loan{&b, &c, &d, &e}
 	for _, x := range foreigners {
-		if err = randomize.Struct(seed, x, accountDBTypes, false, strmangle.SetComplement(accountPrimaryKeyColumns, accountColumnsWithoutDefault)...); err != nil {
+		if err = randomize.Struct(seed, x, loanDBTypes, false, strmangle.SetComplement(loanPrimaryKeyColumns, loanColumnsWithoutDefault)...); err != nil {
 			t.Fatal(err)
 		}
 	}
@@ -540,13 +2114,13 @@ func testCustomerToManyAddOpAccounts(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	foreignersSplitByInsertion := [][]*Account{
+	foreignersSplitByInsertion := [][]*Loan{
 		{&b, &c},
 		{&d, &e},
 	}
 
 	for i, x := range foreignersSplitByInsertion {
-		err = a.AddAccounts(ctx, tx, i != 0, x...)
+		err = a.AddLoans(ctx, tx, i != 0, x...)
 		if err != nil {
 			t.Fatal(err)
 		}
@@ -568,14 +2142,14 @@ func testCustomerToManyAddOpAccounts(t *testing.T) {
 			t.Error("relationship was not added properly to the foreign slice")
 		}
 
-		if a.R.Accounts[i*2] != first {
+		if a.R.Loans[i*2] != first {
 			t.Error("relationship struct slice not set to correct value")
 		}
-		if a.R.Accounts[i*2+1] != second {
+		if a.R.Loans[i*2+1] != second {
 			t.Error("relationship struct slice not set to correct value")
 		}
 
-		count, err := a.Accounts().Count(ctx, tx)
+		count, err := a.Loans().Count(ctx, tx)
 		if err != nil {
 			t.Fatal(err)
 		}
@@ -584,7 +2158,7 @@ func testCustomerToManyAddOpAccounts(t *testing.T) {
 		}
 	}
 }
-func testCustomerToManyAddOpDSCommissions(t *testing.T) {
+func testCustomerToManyAddOpWithdrawalRequests(t *testing.T) {
 	var err error
 
 	ctx := context.Background()
@@ -592,15 +2166,15 @@ func testCustomerToManyAddOpDSCommissions(t *testing.T) {
 	defer func() { _ = tx.Rollback() }()
 
 	var a Customer
-	var b, c, d, e DSCommission
+	var b, c, d, e WithdrawalRequest
 
 	seed := randomize.NewSeed()
 	if err = randomize.Struct(seed, &a, customerDBTypes, false, strmangle.SetComplement(customerPrimaryKeyColumns, customerColumnsWithoutDefault)...); err != nil {
 		t.Fatal(err)
 	}
-	foreigners := []*DSCommission{&b, &c, &d, &e}
+	foreigners := []*WithdrawalRequest{&b, &c, &d, &e}
 	for _, x := range foreigners {
-		if err = randomize.Struct(seed, x, dsCommissionDBTypes, false, strmangle.SetComplement(dsCommissionPrimaryKeyColumns, dsCommissionColumnsWithoutDefault)...); err != nil {
+		if err = randomize.Struct(seed, x, withdrawalRequestDBTypes, false, strmangle.SetComplement(withdrawalRequestPrimaryKeyColumns, withdrawalRequestColumnsWithoutDefault)...); err != nil {
 			t.Fatal(err)
 		}
 	}
@@ -615,13 +2189,13 @@ func testCustomerToManyAddOpDSCommissions(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	foreignersSplitByInsertion := [][]*DSCommission{
+	foreignersSplitByInsertion := [][]*WithdrawalRequest{
 		{&b, &c},
 		{&d, &e},
 	}
 
 	for i, x := range foreignersSplitByInsertion {
-		err = a.AddDSCommissions(ctx, tx, i != 0, x...)
+		err = a.AddWithdrawalRequests(ctx, tx, i != 0, x...)
 		if err != nil {
 			t.Fatal(err)
 		}
@@ -643,14 +2217,14 @@ func testCustomerToManyAddOpDSCommissions(t *testing.T) {
 			t.Error("relationship was not added properly to the foreign slice")
 		}
 
-		if a.R.DSCommissions[i*2] != first {
+		if a.R.WithdrawalRequests[i*2] != first {
 			t.Error("relationship struct slice not set to correct value")
 		}
-		if a.R.DSCommissions[i*2+1] != second {
+		if a.R.WithdrawalRequests[i*2+1] != second {
 			t.Error("relationship struct slice not set to correct value")
 		}
 
-		count, err := a.DSCommissions().Count(ctx, tx)
+		count, err := a.WithdrawalRequests().Count(ctx, tx)
 		if err != nil {
 			t.Fatal(err)
 		}
@@ -710,6 +2284,108 @@ func testCustomerToOneBranchUsingBranch(t *testing.T) {
 	}
 }
 
+func testCustomerToOneUserUsingKycVerifiedBy(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local Customer
+	var foreign User
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, customerDBTypes, true, customerColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Customer struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, userDBTypes, false, userColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize User struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	queries.Assign(&local.KycVerifiedByID, foreign.ID)
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.KycVerifiedBy().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !queries.Equal(check.ID, foreign.ID) {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	slice := CustomerSlice{&local}
+	if err = local.L.LoadKycVerifiedBy(ctx, tx, false, (*[]*Customer)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.KycVerifiedBy == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.KycVerifiedBy = nil
+	if err = local.L.LoadKycVerifiedBy(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.KycVerifiedBy == nil {
+		t.Error("struct should have been eager loaded")
+	}
+}
+
+func testCustomerToOneCustomerUsingMergedInto(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local Customer
+	var foreign Customer
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, customerDBTypes, true, customerColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Customer struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, customerDBTypes, false, customerColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Customer struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	queries.Assign(&local.MergedIntoID, foreign.ID)
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.MergedInto().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !queries.Equal(check.ID, foreign.ID) {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	slice := CustomerSlice{&local}
+	if err = local.L.LoadMergedInto(ctx, tx, false, (*[]*Customer)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.MergedInto == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.MergedInto = nil
+	if err = local.L.LoadMergedInto(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.MergedInto == nil {
+		t.Error("struct should have been eager loaded")
+	}
+}
+
 func testCustomerToOneUserUsingSalesRep(t *testing.T) {
 	ctx := context.Background()
 	tx := MustTx(boil.BeginTx(ctx, nil))
@@ -818,6 +2494,224 @@ func testCustomerToOneSetOpBranchUsingBranch(t *testing.T) {
 		}
 	}
 }
+func testCustomerToOneSetOpUserUsingKycVerifiedBy(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Customer
+	var b, c User
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, customerDBTypes, false, strmangle.SetComplement(customerPrimaryKeyColumns, customerColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*User{&b, &c} {
+		err = a.SetKycVerifiedBy(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.KycVerifiedBy != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.KycVerifiedByCustomers[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if !queries.Equal(a.KycVerifiedByID, x.ID) {
+			t.Error("foreign key was wrong value", a.KycVerifiedByID)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(a.KycVerifiedByID))
+		reflect.Indirect(reflect.ValueOf(&a.KycVerifiedByID)).Set(zero)
+
+		if err = a.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if !queries.Equal(a.KycVerifiedByID, x.ID) {
+			t.Error("foreign key was wrong value", a.KycVerifiedByID, x.ID)
+		}
+	}
+}
+
+func testCustomerToOneRemoveOpUserUsingKycVerifiedBy(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Customer
+	var b User
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, customerDBTypes, false, strmangle.SetComplement(customerPrimaryKeyColumns, customerColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.SetKycVerifiedBy(ctx, tx, true, &b); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.RemoveKycVerifiedBy(ctx, tx, &b); err != nil {
+		t.Error("failed to remove relationship")
+	}
+
+	count, err := a.KycVerifiedBy().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 0 {
+		t.Error("want no relationships remaining")
+	}
+
+	if a.R.KycVerifiedBy != nil {
+		t.Error("R struct entry should be nil")
+	}
+
+	if !queries.IsValuerNil(a.KycVerifiedByID) {
+		t.Error("foreign key value should be nil")
+	}
+
+	if len(b.R.KycVerifiedByCustomers) != 0 {
+		t.Error("failed to remove a from b's relationships")
+	}
+}
+
+func testCustomerToOneSetOpCustomerUsingMergedInto(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Customer
+	var b, c Customer
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, customerDBTypes, false, strmangle.SetComplement(customerPrimaryKeyColumns, customerColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, customerDBTypes, false, strmangle.SetComplement(customerPrimaryKeyColumns, customerColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, customerDBTypes, false, strmangle.SetComplement(customerPrimaryKeyColumns, customerColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*Customer{&b, &c} {
+		err = a.SetMergedInto(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.MergedInto != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.MergedIntoCustomers[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if !queries.Equal(a.MergedIntoID, x.ID) {
+			t.Error("foreign key was wrong value", a.MergedIntoID)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(a.MergedIntoID))
+		reflect.Indirect(reflect.ValueOf(&a.MergedIntoID)).Set(zero)
+
+		if err = a.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if !queries.Equal(a.MergedIntoID, x.ID) {
+			t.Error("foreign key was wrong value", a.MergedIntoID, x.ID)
+		}
+	}
+}
+
+func testCustomerToOneRemoveOpCustomerUsingMergedInto(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Customer
+	var b Customer
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, customerDBTypes, false, strmangle.SetComplement(customerPrimaryKeyColumns, customerColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, customerDBTypes, false, strmangle.SetComplement(customerPrimaryKeyColumns, customerColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.SetMergedInto(ctx, tx, true, &b); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.RemoveMergedInto(ctx, tx, &b); err != nil {
+		t.Error("failed to remove relationship")
+	}
+
+	count, err := a.MergedInto().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 0 {
+		t.Error("want no relationships remaining")
+	}
+
+	if a.R.MergedInto != nil {
+		t.Error("R struct entry should be nil")
+	}
+
+	if !queries.IsValuerNil(a.MergedIntoID) {
+		t.Error("foreign key value should be nil")
+	}
+
+	if len(b.R.MergedIntoCustomers) != 0 {
+		t.Error("failed to remove a from b's relationships")
+	}
+}
+
 func testCustomerToOneSetOpUserUsingSalesRep(t *testing.T) {
 	var err error
 
@@ -950,7 +2844,7 @@ func testCustomersSelect(t *testing.T) {
 }
 
 var (
-	customerDBTypes = map[string]string{`ID`: `character`, `BranchID`: `character`, `Email`: `character varying`, `Name`: `character varying`, `PhoneNumber`: `character varying`, `Address`: `character varying`, `SalesRepID`: `character`, `CreatedAt`: `bigint`, `UpdatedAt`: `bigint`, `ArchivedAt`: `bigint`}
+	customerDBTypes = map[string]string{`ID`: `character`, `BranchID`: `character`, `Email`: `character varying`, `Name`: `character varying`, `PhoneNumber`: `character varying`, `Address`: `character varying`, `SalesRepID`: `character`, `CreatedAt`: `bigint`, `UpdatedAt`: `bigint`, `ArchivedAt`: `bigint`, `DateOfBirth`: `bigint`, `Gender`: `character varying`, `IDType`: `character varying`, `IDNumber`: `character varying`, `BVN`: `character varying`, `Nin`: `character varying`, `Occupation`: `character varying`, `NextOfKinName`: `character varying`, `NextOfKinPhone`: `character varying`, `NextOfKinRelationship`: `character varying`, `KycTier`: `integer`, `KycStatus`: `character varying`, `KycNote`: `character varying`, `KycVerifiedAt`: `bigint`, `KycVerifiedByID`: `character`, `MergedIntoID`: `character`}
 	_               = bytes.MinRead
 )
 
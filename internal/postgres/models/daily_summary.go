@@ -23,10 +23,10 @@ import (
 
 // DailySummary is an object representing the database table.
 type DailySummary struct {
-	Income      float64 `boil:"income" json:"income" toml:"income" yaml:"income"`
-	Expenditure float64 `boil:"expenditure" json:"expenditure" toml:"expenditure" yaml:"expenditure"`
-	BankDeposit float64 `boil:"bank_deposit" json:"bank_deposit" toml:"bank_deposit" yaml:"bank_deposit"`
-	Date        int64   `boil:"date" json:"date" toml:"date" yaml:"date"`
+	Income      int64 `boil:"income" json:"income" toml:"income" yaml:"income"`
+	Expenditure int64 `boil:"expenditure" json:"expenditure" toml:"expenditure" yaml:"expenditure"`
+	BankDeposit int64 `boil:"bank_deposit" json:"bank_deposit" toml:"bank_deposit" yaml:"bank_deposit"`
+	Date        int64 `boil:"date" json:"date" toml:"date" yaml:"date"`
 
 	R *dailySummaryR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L dailySummaryL  `boil:"-" json:"-" toml:"-" yaml:"-"`
@@ -59,14 +59,14 @@ var DailySummaryTableColumns = struct {
 // Generated where
 
 var DailySummaryWhere = struct {
-	Income      whereHelperfloat64
-	Expenditure whereHelperfloat64
-	BankDeposit whereHelperfloat64
+	Income      whereHelperint64
+	Expenditure whereHelperint64
+	BankDeposit whereHelperint64
 	Date        whereHelperint64
 }{
-	Income:      whereHelperfloat64{field: "\"daily_summary\".\"income\""},
-	Expenditure: whereHelperfloat64{field: "\"daily_summary\".\"expenditure\""},
-	BankDeposit: whereHelperfloat64{field: "\"daily_summary\".\"bank_deposit\""},
+	Income:      whereHelperint64{field: "\"daily_summary\".\"income\""},
+	Expenditure: whereHelperint64{field: "\"daily_summary\".\"expenditure\""},
+	BankDeposit: whereHelperint64{field: "\"daily_summary\".\"bank_deposit\""},
 	Date:        whereHelperint64{field: "\"daily_summary\".\"date\""},
 }
 
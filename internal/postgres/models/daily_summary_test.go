@@ -427,7 +427,7 @@ func testDailySummariesSelect(t *testing.T) {
 }
 
 var (
-	dailySummaryDBTypes = map[string]string{`Income`: `double precision`, `Expenditure`: `double precision`, `BankDeposit`: `double precision`, `Date`: `bigint`}
+	dailySummaryDBTypes = map[string]string{`Income`: `bigint`, `Expenditure`: `bigint`, `BankDeposit`: `bigint`, `Date`: `bigint`}
 	_                   = bytes.MinRead
 )
 
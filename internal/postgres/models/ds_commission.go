@@ -23,12 +23,12 @@ import (
 
 // DSCommission is an object representing the database table.
 type DSCommission struct {
-	ID            string  `boil:"id" json:"id" toml:"id" yaml:"id"`
-	AccountID     string  `boil:"account_id" json:"account_id" toml:"account_id" yaml:"account_id"`
-	CustomerID    string  `boil:"customer_id" json:"customer_id" toml:"customer_id" yaml:"customer_id"`
-	Amount        float64 `boil:"amount" json:"amount" toml:"amount" yaml:"amount"`
-	Date          int64   `boil:"date" json:"date" toml:"date" yaml:"date"`
-	EffectiveDate int64   `boil:"effective_date" json:"effective_date" toml:"effective_date" yaml:"effective_date"`
+	ID            string `boil:"id" json:"id" toml:"id" yaml:"id"`
+	AccountID     string `boil:"account_id" json:"account_id" toml:"account_id" yaml:"account_id"`
+	CustomerID    string `boil:"customer_id" json:"customer_id" toml:"customer_id" yaml:"customer_id"`
+	Amount        int64  `boil:"amount" json:"amount" toml:"amount" yaml:"amount"`
+	Date          int64  `boil:"date" json:"date" toml:"date" yaml:"date"`
+	EffectiveDate int64  `boil:"effective_date" json:"effective_date" toml:"effective_date" yaml:"effective_date"`
 
 	R *dsCommissionR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L dsCommissionL  `boil:"-" json:"-" toml:"-" yaml:"-"`
@@ -72,14 +72,14 @@ var DSCommissionWhere = struct {
 	ID            whereHelperstring
 	AccountID     whereHelperstring
 	CustomerID    whereHelperstring
-	Amount        whereHelperfloat64
+	Amount        whereHelperint64
 	Date          whereHelperint64
 	EffectiveDate whereHelperint64
 }{
 	ID:            whereHelperstring{field: "\"ds_commission\".\"id\""},
 	AccountID:     whereHelperstring{field: "\"ds_commission\".\"account_id\""},
 	CustomerID:    whereHelperstring{field: "\"ds_commission\".\"customer_id\""},
-	Amount:        whereHelperfloat64{field: "\"ds_commission\".\"amount\""},
+	Amount:        whereHelperint64{field: "\"ds_commission\".\"amount\""},
 	Date:          whereHelperint64{field: "\"ds_commission\".\"date\""},
 	EffectiveDate: whereHelperint64{field: "\"ds_commission\".\"effective_date\""},
 }
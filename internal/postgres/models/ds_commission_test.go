@@ -644,7 +644,7 @@ func testDSCommissionsSelect(t *testing.T) {
 }
 
 var (
-	dsCommissionDBTypes = map[string]string{`ID`: `character`, `AccountID`: `character`, `CustomerID`: `character`, `Amount`: `double precision`, `Date`: `bigint`, `EffectiveDate`: `bigint`}
+	dsCommissionDBTypes = map[string]string{`ID`: `character`, `AccountID`: `character`, `CustomerID`: `character`, `Amount`: `bigint`, `Date`: `bigint`, `EffectiveDate`: `bigint`}
 	_                   = bytes.MinRead
 )
 
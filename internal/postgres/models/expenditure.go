@@ -23,10 +23,10 @@ import (
 
 // Expenditure is an object representing the database table.
 type Expenditure struct {
-	ID     string  `boil:"id" json:"id" toml:"id" yaml:"id"`
-	Amount float64 `boil:"amount" json:"amount" toml:"amount" yaml:"amount"`
-	Date   int64   `boil:"date" json:"date" toml:"date" yaml:"date"`
-	Reason string  `boil:"reason" json:"reason" toml:"reason" yaml:"reason"`
+	ID     string `boil:"id" json:"id" toml:"id" yaml:"id"`
+	Amount int64  `boil:"amount" json:"amount" toml:"amount" yaml:"amount"`
+	Date   int64  `boil:"date" json:"date" toml:"date" yaml:"date"`
+	Reason string `boil:"reason" json:"reason" toml:"reason" yaml:"reason"`
 
 	R *expenditureR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L expenditureL  `boil:"-" json:"-" toml:"-" yaml:"-"`
@@ -60,12 +60,12 @@ var ExpenditureTableColumns = struct {
 
 var ExpenditureWhere = struct {
 	ID     whereHelperstring
-	Amount whereHelperfloat64
+	Amount whereHelperint64
 	Date   whereHelperint64
 	Reason whereHelperstring
 }{
 	ID:     whereHelperstring{field: "\"expenditure\".\"id\""},
-	Amount: whereHelperfloat64{field: "\"expenditure\".\"amount\""},
+	Amount: whereHelperint64{field: "\"expenditure\".\"amount\""},
 	Date:   whereHelperint64{field: "\"expenditure\".\"date\""},
 	Reason: whereHelperstring{field: "\"expenditure\".\"reason\""},
 }
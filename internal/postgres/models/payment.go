@@ -26,7 +26,7 @@ import (
 type Payment struct {
 	ID            string     `boil:"id" json:"id" toml:"id" yaml:"id"`
 	SaleID        string     `boil:"sale_id" json:"sale_id" toml:"sale_id" yaml:"sale_id"`
-	Amount        float64    `boil:"amount" json:"amount" toml:"amount" yaml:"amount"`
+	Amount        int64      `boil:"amount" json:"amount" toml:"amount" yaml:"amount"`
 	PaymentMethod string     `boil:"payment_method" json:"payment_method" toml:"payment_method" yaml:"payment_method"`
 	SalesRepID    string     `boil:"sales_rep_id" json:"sales_rep_id" toml:"sales_rep_id" yaml:"sales_rep_id"`
 	CreatedAt     int64      `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
@@ -82,7 +82,7 @@ var PaymentTableColumns = struct {
 var PaymentWhere = struct {
 	ID            whereHelperstring
 	SaleID        whereHelperstring
-	Amount        whereHelperfloat64
+	Amount        whereHelperint64
 	PaymentMethod whereHelperstring
 	SalesRepID    whereHelperstring
 	CreatedAt     whereHelperint64
@@ -91,7 +91,7 @@ var PaymentWhere = struct {
 }{
 	ID:            whereHelperstring{field: "\"payment\".\"id\""},
 	SaleID:        whereHelperstring{field: "\"payment\".\"sale_id\""},
-	Amount:        whereHelperfloat64{field: "\"payment\".\"amount\""},
+	Amount:        whereHelperint64{field: "\"payment\".\"amount\""},
 	PaymentMethod: whereHelperstring{field: "\"payment\".\"payment_method\""},
 	SalesRepID:    whereHelperstring{field: "\"payment\".\"sales_rep_id\""},
 	CreatedAt:     whereHelperint64{field: "\"payment\".\"created_at\""},
@@ -31,7 +31,7 @@ type Product struct {
 	Description  string      `boil:"description" json:"description" toml:"description" yaml:"description"`
 	Sku          string      `boil:"sku" json:"sku" toml:"sku" yaml:"sku"`
 	Barcode      string      `boil:"barcode" json:"barcode" toml:"barcode" yaml:"barcode"`
-	Price        float64     `boil:"price" json:"price" toml:"price" yaml:"price"`
+	Price        int64       `boil:"price" json:"price" toml:"price" yaml:"price"`
 	ReorderLevel int         `boil:"reorder_level" json:"reorder_level" toml:"reorder_level" yaml:"reorder_level"`
 	Image        null.String `boil:"image" json:"image,omitempty" toml:"image" yaml:"image,omitempty"`
 	CreatedAt    time.Time   `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
@@ -41,7 +41,7 @@ type Product struct {
 	UpdatedByID  string      `boil:"updated_by_id" json:"updated_by_id" toml:"updated_by_id" yaml:"updated_by_id"`
 	ArchivedByID null.String `boil:"archived_by_id" json:"archived_by_id,omitempty" toml:"archived_by_id" yaml:"archived_by_id,omitempty"`
 	StockBalance int         `boil:"stock_balance" json:"stock_balance" toml:"stock_balance" yaml:"stock_balance"`
-	CostPrice    float64     `boil:"cost_price" json:"cost_price" toml:"cost_price" yaml:"cost_price"`
+	CostPrice    int64       `boil:"cost_price" json:"cost_price" toml:"cost_price" yaml:"cost_price"`
 
 	R *productR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L productL  `boil:"-" json:"-" toml:"-" yaml:"-"`
@@ -129,52 +129,6 @@ var ProductTableColumns = struct {
 
 // Generated where
 
-type whereHelpernull_String struct{ field string }
-
-func (w whereHelpernull_String) EQ(x null.String) qm.QueryMod {
-	return qmhelper.WhereNullEQ(w.field, false, x)
-}
-func (w whereHelpernull_String) NEQ(x null.String) qm.QueryMod {
-	return qmhelper.WhereNullEQ(w.field, true, x)
-}
-func (w whereHelpernull_String) IsNull() qm.QueryMod    { return qmhelper.WhereIsNull(w.field) }
-func (w whereHelpernull_String) IsNotNull() qm.QueryMod { return qmhelper.WhereIsNotNull(w.field) }
-func (w whereHelpernull_String) LT(x null.String) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.LT, x)
-}
-func (w whereHelpernull_String) LTE(x null.String) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.LTE, x)
-}
-func (w whereHelpernull_String) GT(x null.String) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.GT, x)
-}
-func (w whereHelpernull_String) GTE(x null.String) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.GTE, x)
-}
-
-type whereHelperint struct{ field string }
-
-func (w whereHelperint) EQ(x int) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.EQ, x) }
-func (w whereHelperint) NEQ(x int) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.NEQ, x) }
-func (w whereHelperint) LT(x int) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.LT, x) }
-func (w whereHelperint) LTE(x int) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.LTE, x) }
-func (w whereHelperint) GT(x int) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.GT, x) }
-func (w whereHelperint) GTE(x int) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.GTE, x) }
-func (w whereHelperint) IN(slice []int) qm.QueryMod {
-	values := make([]interface{}, 0, len(slice))
-	for _, value := range slice {
-		values = append(values, value)
-	}
-	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
-}
-func (w whereHelperint) NIN(slice []int) qm.QueryMod {
-	values := make([]interface{}, 0, len(slice))
-	for _, value := range slice {
-		values = append(values, value)
-	}
-	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
-}
-
 type whereHelpertime_Time struct{ field string }
 
 func (w whereHelpertime_Time) EQ(x time.Time) qm.QueryMod {
@@ -227,7 +181,7 @@ var ProductWhere = struct {
 	Description  whereHelperstring
 	Sku          whereHelperstring
 	Barcode      whereHelperstring
-	Price        whereHelperfloat64
+	Price        whereHelperint64
 	ReorderLevel whereHelperint
 	Image        whereHelpernull_String
 	CreatedAt    whereHelpertime_Time
@@ -237,7 +191,7 @@ var ProductWhere = struct {
 	UpdatedByID  whereHelperstring
 	ArchivedByID whereHelpernull_String
 	StockBalance whereHelperint
-	CostPrice    whereHelperfloat64
+	CostPrice    whereHelperint64
 }{
 	ID:           whereHelperstring{field: "\"product\".\"id\""},
 	BrandID:      whereHelpernull_String{field: "\"product\".\"brand_id\""},
@@ -246,7 +200,7 @@ var ProductWhere = struct {
 	Description:  whereHelperstring{field: "\"product\".\"description\""},
 	Sku:          whereHelperstring{field: "\"product\".\"sku\""},
 	Barcode:      whereHelperstring{field: "\"product\".\"barcode\""},
-	Price:        whereHelperfloat64{field: "\"product\".\"price\""},
+	Price:        whereHelperint64{field: "\"product\".\"price\""},
 	ReorderLevel: whereHelperint{field: "\"product\".\"reorder_level\""},
 	Image:        whereHelpernull_String{field: "\"product\".\"image\""},
 	CreatedAt:    whereHelpertime_Time{field: "\"product\".\"created_at\""},
@@ -256,7 +210,7 @@ var ProductWhere = struct {
 	UpdatedByID:  whereHelperstring{field: "\"product\".\"updated_by_id\""},
 	ArchivedByID: whereHelpernull_String{field: "\"product\".\"archived_by_id\""},
 	StockBalance: whereHelperint{field: "\"product\".\"stock_balance\""},
-	CostPrice:    whereHelperfloat64{field: "\"product\".\"cost_price\""},
+	CostPrice:    whereHelperint64{field: "\"product\".\"cost_price\""},
 }
 
 // ProductRels is where relationship names are stored.
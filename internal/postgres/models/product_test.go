@@ -1531,7 +1531,7 @@ func testProductsSelect(t *testing.T) {
 }
 
 var (
-	productDBTypes = map[string]string{`ID`: `character`, `BrandID`: `character`, `CategoryID`: `character`, `Name`: `character varying`, `Description`: `character varying`, `Sku`: `character varying`, `Barcode`: `character varying`, `Price`: `double precision`, `ReorderLevel`: `integer`, `Image`: `character varying`, `CreatedAt`: `timestamp with time zone`, `UpdatedAt`: `timestamp with time zone`, `ArchivedAt`: `timestamp with time zone`, `CreatedByID`: `character`, `UpdatedByID`: `character`, `ArchivedByID`: `character`, `StockBalance`: `integer`, `CostPrice`: `double precision`}
+	productDBTypes = map[string]string{`ID`: `character`, `BrandID`: `character`, `CategoryID`: `character`, `Name`: `character varying`, `Description`: `character varying`, `Sku`: `character varying`, `Barcode`: `character varying`, `Price`: `bigint`, `ReorderLevel`: `integer`, `Image`: `character varying`, `CreatedAt`: `timestamp with time zone`, `UpdatedAt`: `timestamp with time zone`, `ArchivedAt`: `timestamp with time zone`, `CreatedByID`: `character`, `UpdatedByID`: `character`, `ArchivedByID`: `character`, `StockBalance`: `integer`, `CostPrice`: `bigint`}
 	_              = bytes.MinRead
 )
 
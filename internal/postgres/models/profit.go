@@ -25,7 +25,7 @@ import (
 // Profit is an object representing the database table.
 type Profit struct {
 	ID         string     `boil:"id" json:"id" toml:"id" yaml:"id"`
-	Amount     float64    `boil:"amount" json:"amount" toml:"amount" yaml:"amount"`
+	Amount     int64      `boil:"amount" json:"amount" toml:"amount" yaml:"amount"`
 	Narration  string     `boil:"narration" json:"narration" toml:"narration" yaml:"narration"`
 	CreatedAt  int64      `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
 	UpdatedAt  int64      `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
@@ -71,14 +71,14 @@ var ProfitTableColumns = struct {
 
 var ProfitWhere = struct {
 	ID         whereHelperstring
-	Amount     whereHelperfloat64
+	Amount     whereHelperint64
 	Narration  whereHelperstring
 	CreatedAt  whereHelperint64
 	UpdatedAt  whereHelperint64
 	ArchivedAt whereHelpernull_Int64
 }{
 	ID:         whereHelperstring{field: "\"profit\".\"id\""},
-	Amount:     whereHelperfloat64{field: "\"profit\".\"amount\""},
+	Amount:     whereHelperint64{field: "\"profit\".\"amount\""},
 	Narration:  whereHelperstring{field: "\"profit\".\"narration\""},
 	CreatedAt:  whereHelperint64{field: "\"profit\".\"created_at\""},
 	UpdatedAt:  whereHelperint64{field: "\"profit\".\"updated_at\""},
@@ -427,7 +427,7 @@ func testProfitsSelect(t *testing.T) {
 }
 
 var (
-	profitDBTypes = map[string]string{`ID`: `character`, `Amount`: `double precision`, `Narration`: `character varying`, `CreatedAt`: `bigint`, `UpdatedAt`: `bigint`, `ArchivedAt`: `bigint`}
+	profitDBTypes = map[string]string{`ID`: `character`, `Amount`: `bigint`, `Narration`: `character varying`, `CreatedAt`: `bigint`, `UpdatedAt`: `bigint`, `ArchivedAt`: `bigint`}
 	_             = bytes.MinRead
 )
 
@@ -8,6 +8,16 @@ import "testing"
 func TestUpsert(t *testing.T) {
 	t.Run("Accounts", testAccountsUpsert)
 
+	t.Run("AccountFollowUps", testAccountFollowUpsUpsert)
+
+	t.Run("AccountHolds", testAccountHoldsUpsert)
+
+	t.Run("AccountProducts", testAccountProductsUpsert)
+
+	t.Run("AccountStatusChanges", testAccountStatusChangesUpsert)
+
+	t.Run("Approvals", testApprovalsUpsert)
+
 	t.Run("BankAccounts", testBankAccountsUpsert)
 
 	t.Run("BankDeposits", testBankDepositsUpsert)
@@ -20,16 +30,46 @@ func TestUpsert(t *testing.T) {
 
 	t.Run("Customers", testCustomersUpsert)
 
+	t.Run("CustomerDocuments", testCustomerDocumentsUpsert)
+
+	t.Run("CustomerLoginCodes", testCustomerLoginCodesUpsert)
+
+	t.Run("CustomerMerges", testCustomerMergesUpsert)
+
 	t.Run("DailySummaries", testDailySummariesUpsert)
 
 	t.Run("DSCommissions", testDSCommissionsUpsert)
 
+	t.Run("DSCycles", testDSCyclesUpsert)
+
 	t.Run("Expenditures", testExpendituresUpsert)
 
+	t.Run("IdempotencyKeys", testIdempotencyKeysUpsert)
+
+	t.Run("ImportJobs", testImportJobsUpsert)
+
+	t.Run("ImportRows", testImportRowsUpsert)
+
+	t.Run("InboundMessages", testInboundMessagesUpsert)
+
+	t.Run("InterestAccruals", testInterestAccrualsUpsert)
+
 	t.Run("Inventories", testInventoriesUpsert)
 
+	t.Run("JournalEntries", testJournalEntriesUpsert)
+
+	t.Run("LedgerAccounts", testLedgerAccountsUpsert)
+
+	t.Run("Loans", testLoansUpsert)
+
+	t.Run("LoanInstalments", testLoanInstalmentsUpsert)
+
+	t.Run("LoanRepayments", testLoanRepaymentsUpsert)
+
 	t.Run("Payments", testPaymentsUpsert)
 
+	t.Run("Postings", testPostingsUpsert)
+
 	t.Run("Products", testProductsUpsert)
 
 	t.Run("ProductCategories", testProductCategoriesUpsert)
@@ -42,7 +82,15 @@ func TestUpsert(t *testing.T) {
 
 	t.Run("SaleItems", testSaleItemsUpsert)
 
+	t.Run("TillCounts", testTillCountsUpsert)
+
+	t.Run("TillSessions", testTillSessionsUpsert)
+
 	t.Run("Transactions", testTransactionsUpsert)
 
+	t.Run("Transfers", testTransfersUpsert)
+
 	t.Run("Users", testUsersUpsert)
+
+	t.Run("WithdrawalRequests", testWithdrawalRequestsUpsert)
 }
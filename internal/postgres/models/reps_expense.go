@@ -23,11 +23,11 @@ import (
 
 // RepsExpense is an object representing the database table.
 type RepsExpense struct {
-	ID         string  `boil:"id" json:"id" toml:"id" yaml:"id"`
-	SalesRepID string  `boil:"sales_rep_id" json:"sales_rep_id" toml:"sales_rep_id" yaml:"sales_rep_id"`
-	Amount     float64 `boil:"amount" json:"amount" toml:"amount" yaml:"amount"`
-	Reason     string  `boil:"reason" json:"reason" toml:"reason" yaml:"reason"`
-	Date       int64   `boil:"date" json:"date" toml:"date" yaml:"date"`
+	ID         string `boil:"id" json:"id" toml:"id" yaml:"id"`
+	SalesRepID string `boil:"sales_rep_id" json:"sales_rep_id" toml:"sales_rep_id" yaml:"sales_rep_id"`
+	Amount     int64  `boil:"amount" json:"amount" toml:"amount" yaml:"amount"`
+	Reason     string `boil:"reason" json:"reason" toml:"reason" yaml:"reason"`
+	Date       int64  `boil:"date" json:"date" toml:"date" yaml:"date"`
 
 	R *repsExpenseR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L repsExpenseL  `boil:"-" json:"-" toml:"-" yaml:"-"`
@@ -66,13 +66,13 @@ var RepsExpenseTableColumns = struct {
 var RepsExpenseWhere = struct {
 	ID         whereHelperstring
 	SalesRepID whereHelperstring
-	Amount     whereHelperfloat64
+	Amount     whereHelperint64
 	Reason     whereHelperstring
 	Date       whereHelperint64
 }{
 	ID:         whereHelperstring{field: "\"reps_expense\".\"id\""},
 	SalesRepID: whereHelperstring{field: "\"reps_expense\".\"sales_rep_id\""},
-	Amount:     whereHelperfloat64{field: "\"reps_expense\".\"amount\""},
+	Amount:     whereHelperint64{field: "\"reps_expense\".\"amount\""},
 	Reason:     whereHelperstring{field: "\"reps_expense\".\"reason\""},
 	Date:       whereHelperint64{field: "\"reps_expense\".\"date\""},
 }
@@ -536,7 +536,7 @@ func testRepsExpensesSelect(t *testing.T) {
 }
 
 var (
-	repsExpenseDBTypes = map[string]string{`ID`: `character`, `SalesRepID`: `character`, `Amount`: `double precision`, `Reason`: `character varying`, `Date`: `bigint`}
+	repsExpenseDBTypes = map[string]string{`ID`: `character`, `SalesRepID`: `character`, `Amount`: `bigint`, `Reason`: `character varying`, `Date`: `bigint`}
 	_                  = bytes.MinRead
 )
 
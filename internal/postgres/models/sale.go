@@ -24,148 +24,193 @@ import (
 
 // Sale is an object representing the database table.
 type Sale struct {
-	ID            string      `boil:"id" json:"id" toml:"id" yaml:"id"`
-	BranchID      string      `boil:"branch_id" json:"branch_id" toml:"branch_id" yaml:"branch_id"`
-	ReceiptNumber string      `boil:"receipt_number" json:"receipt_number" toml:"receipt_number" yaml:"receipt_number"`
-	Amount        float64     `boil:"amount" json:"amount" toml:"amount" yaml:"amount"`
-	AmountTender  float64     `boil:"amount_tender" json:"amount_tender" toml:"amount_tender" yaml:"amount_tender"`
-	Balance       float64     `boil:"balance" json:"balance" toml:"balance" yaml:"balance"`
-	CustomerName  null.String `boil:"customer_name" json:"customer_name,omitempty" toml:"customer_name" yaml:"customer_name,omitempty"`
-	PhoneNumber   null.String `boil:"phone_number" json:"phone_number,omitempty" toml:"phone_number" yaml:"phone_number,omitempty"`
-	CreatedAt     int64       `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
-	UpdatedAt     int64       `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
-	ArchivedAt    null.Int64  `boil:"archived_at" json:"archived_at,omitempty" toml:"archived_at" yaml:"archived_at,omitempty"`
-	CreatedByID   string      `boil:"created_by_id" json:"created_by_id" toml:"created_by_id" yaml:"created_by_id"`
-	UpdatedByID   null.String `boil:"updated_by_id" json:"updated_by_id,omitempty" toml:"updated_by_id" yaml:"updated_by_id,omitempty"`
-	ArchivedByID  null.String `boil:"archived_by_id" json:"archived_by_id,omitempty" toml:"archived_by_id" yaml:"archived_by_id,omitempty"`
+	ID              string      `boil:"id" json:"id" toml:"id" yaml:"id"`
+	BranchID        string      `boil:"branch_id" json:"branch_id" toml:"branch_id" yaml:"branch_id"`
+	ReceiptNumber   string      `boil:"receipt_number" json:"receipt_number" toml:"receipt_number" yaml:"receipt_number"`
+	Amount          int64       `boil:"amount" json:"amount" toml:"amount" yaml:"amount"`
+	AmountTender    int64       `boil:"amount_tender" json:"amount_tender" toml:"amount_tender" yaml:"amount_tender"`
+	Balance         int64       `boil:"balance" json:"balance" toml:"balance" yaml:"balance"`
+	CustomerName    null.String `boil:"customer_name" json:"customer_name,omitempty" toml:"customer_name" yaml:"customer_name,omitempty"`
+	PhoneNumber     null.String `boil:"phone_number" json:"phone_number,omitempty" toml:"phone_number" yaml:"phone_number,omitempty"`
+	CreatedAt       int64       `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedAt       int64       `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+	ArchivedAt      null.Int64  `boil:"archived_at" json:"archived_at,omitempty" toml:"archived_at" yaml:"archived_at,omitempty"`
+	CreatedByID     string      `boil:"created_by_id" json:"created_by_id" toml:"created_by_id" yaml:"created_by_id"`
+	UpdatedByID     null.String `boil:"updated_by_id" json:"updated_by_id,omitempty" toml:"updated_by_id" yaml:"updated_by_id,omitempty"`
+	ArchivedByID    null.String `boil:"archived_by_id" json:"archived_by_id,omitempty" toml:"archived_by_id" yaml:"archived_by_id,omitempty"`
+	PaymentMethod   string      `boil:"payment_method" json:"payment_method" toml:"payment_method" yaml:"payment_method"`
+	Status          string      `boil:"status" json:"status" toml:"status" yaml:"status"`
+	AmountPaid      int64       `boil:"amount_paid" json:"amount_paid" toml:"amount_paid" yaml:"amount_paid"`
+	CreditAccountID null.String `boil:"credit_account_id" json:"credit_account_id,omitempty" toml:"credit_account_id" yaml:"credit_account_id,omitempty"`
+	CreditTermDays  int         `boil:"credit_term_days" json:"credit_term_days" toml:"credit_term_days" yaml:"credit_term_days"`
+	ClearedAt       null.Int64  `boil:"cleared_at" json:"cleared_at,omitempty" toml:"cleared_at" yaml:"cleared_at,omitempty"`
 
 	R *saleR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L saleL  `boil:"-" json:"-" toml:"-" yaml:"-"`
 }
 
 var SaleColumns = struct {
-	ID            string
-	BranchID      string
-	ReceiptNumber string
-	Amount        string
-	AmountTender  string
-	Balance       string
-	CustomerName  string
-	PhoneNumber   string
-	CreatedAt     string
-	UpdatedAt     string
-	ArchivedAt    string
-	CreatedByID   string
-	UpdatedByID   string
-	ArchivedByID  string
+	ID              string
+	BranchID        string
+	ReceiptNumber   string
+	Amount          string
+	AmountTender    string
+	Balance         string
+	CustomerName    string
+	PhoneNumber     string
+	CreatedAt       string
+	UpdatedAt       string
+	ArchivedAt      string
+	CreatedByID     string
+	UpdatedByID     string
+	ArchivedByID    string
+	PaymentMethod   string
+	Status          string
+	AmountPaid      string
+	CreditAccountID string
+	CreditTermDays  string
+	ClearedAt       string
 }{
-	ID:            "id",
-	BranchID:      "branch_id",
-	ReceiptNumber: "receipt_number",
-	Amount:        "amount",
-	AmountTender:  "amount_tender",
-	Balance:       "balance",
-	CustomerName:  "customer_name",
-	PhoneNumber:   "phone_number",
-	CreatedAt:     "created_at",
-	UpdatedAt:     "updated_at",
-	ArchivedAt:    "archived_at",
-	CreatedByID:   "created_by_id",
-	UpdatedByID:   "updated_by_id",
-	ArchivedByID:  "archived_by_id",
+	ID:              "id",
+	BranchID:        "branch_id",
+	ReceiptNumber:   "receipt_number",
+	Amount:          "amount",
+	AmountTender:    "amount_tender",
+	Balance:         "balance",
+	CustomerName:    "customer_name",
+	PhoneNumber:     "phone_number",
+	CreatedAt:       "created_at",
+	UpdatedAt:       "updated_at",
+	ArchivedAt:      "archived_at",
+	CreatedByID:     "created_by_id",
+	UpdatedByID:     "updated_by_id",
+	ArchivedByID:    "archived_by_id",
+	PaymentMethod:   "payment_method",
+	Status:          "status",
+	AmountPaid:      "amount_paid",
+	CreditAccountID: "credit_account_id",
+	CreditTermDays:  "credit_term_days",
+	ClearedAt:       "cleared_at",
 }
 
 var SaleTableColumns = struct {
-	ID            string
-	BranchID      string
-	ReceiptNumber string
-	Amount        string
-	AmountTender  string
-	Balance       string
-	CustomerName  string
-	PhoneNumber   string
-	CreatedAt     string
-	UpdatedAt     string
-	ArchivedAt    string
-	CreatedByID   string
-	UpdatedByID   string
-	ArchivedByID  string
+	ID              string
+	BranchID        string
+	ReceiptNumber   string
+	Amount          string
+	AmountTender    string
+	Balance         string
+	CustomerName    string
+	PhoneNumber     string
+	CreatedAt       string
+	UpdatedAt       string
+	ArchivedAt      string
+	CreatedByID     string
+	UpdatedByID     string
+	ArchivedByID    string
+	PaymentMethod   string
+	Status          string
+	AmountPaid      string
+	CreditAccountID string
+	CreditTermDays  string
+	ClearedAt       string
 }{
-	ID:            "sale.id",
-	BranchID:      "sale.branch_id",
-	ReceiptNumber: "sale.receipt_number",
-	Amount:        "sale.amount",
-	AmountTender:  "sale.amount_tender",
-	Balance:       "sale.balance",
-	CustomerName:  "sale.customer_name",
-	PhoneNumber:   "sale.phone_number",
-	CreatedAt:     "sale.created_at",
-	UpdatedAt:     "sale.updated_at",
-	ArchivedAt:    "sale.archived_at",
-	CreatedByID:   "sale.created_by_id",
-	UpdatedByID:   "sale.updated_by_id",
-	ArchivedByID:  "sale.archived_by_id",
+	ID:              "sale.id",
+	BranchID:        "sale.branch_id",
+	ReceiptNumber:   "sale.receipt_number",
+	Amount:          "sale.amount",
+	AmountTender:    "sale.amount_tender",
+	Balance:         "sale.balance",
+	CustomerName:    "sale.customer_name",
+	PhoneNumber:     "sale.phone_number",
+	CreatedAt:       "sale.created_at",
+	UpdatedAt:       "sale.updated_at",
+	ArchivedAt:      "sale.archived_at",
+	CreatedByID:     "sale.created_by_id",
+	UpdatedByID:     "sale.updated_by_id",
+	ArchivedByID:    "sale.archived_by_id",
+	PaymentMethod:   "sale.payment_method",
+	Status:          "sale.status",
+	AmountPaid:      "sale.amount_paid",
+	CreditAccountID: "sale.credit_account_id",
+	CreditTermDays:  "sale.credit_term_days",
+	ClearedAt:       "sale.cleared_at",
 }
 
 // Generated where
 
 var SaleWhere = struct {
-	ID            whereHelperstring
-	BranchID      whereHelperstring
-	ReceiptNumber whereHelperstring
-	Amount        whereHelperfloat64
-	AmountTender  whereHelperfloat64
-	Balance       whereHelperfloat64
-	CustomerName  whereHelpernull_String
-	PhoneNumber   whereHelpernull_String
-	CreatedAt     whereHelperint64
-	UpdatedAt     whereHelperint64
-	ArchivedAt    whereHelpernull_Int64
-	CreatedByID   whereHelperstring
-	UpdatedByID   whereHelpernull_String
-	ArchivedByID  whereHelpernull_String
+	ID              whereHelperstring
+	BranchID        whereHelperstring
+	ReceiptNumber   whereHelperstring
+	Amount          whereHelperint64
+	AmountTender    whereHelperint64
+	Balance         whereHelperint64
+	CustomerName    whereHelpernull_String
+	PhoneNumber     whereHelpernull_String
+	CreatedAt       whereHelperint64
+	UpdatedAt       whereHelperint64
+	ArchivedAt      whereHelpernull_Int64
+	CreatedByID     whereHelperstring
+	UpdatedByID     whereHelpernull_String
+	ArchivedByID    whereHelpernull_String
+	PaymentMethod   whereHelperstring
+	Status          whereHelperstring
+	AmountPaid      whereHelperint64
+	CreditAccountID whereHelpernull_String
+	CreditTermDays  whereHelperint
+	ClearedAt       whereHelpernull_Int64
 }{
-	ID:            whereHelperstring{field: "\"sale\".\"id\""},
-	BranchID:      whereHelperstring{field: "\"sale\".\"branch_id\""},
-	ReceiptNumber: whereHelperstring{field: "\"sale\".\"receipt_number\""},
-	Amount:        whereHelperfloat64{field: "\"sale\".\"amount\""},
-	AmountTender:  whereHelperfloat64{field: "\"sale\".\"amount_tender\""},
-	Balance:       whereHelperfloat64{field: "\"sale\".\"balance\""},
-	CustomerName:  whereHelpernull_String{field: "\"sale\".\"customer_name\""},
-	PhoneNumber:   whereHelpernull_String{field: "\"sale\".\"phone_number\""},
-	CreatedAt:     whereHelperint64{field: "\"sale\".\"created_at\""},
-	UpdatedAt:     whereHelperint64{field: "\"sale\".\"updated_at\""},
-	ArchivedAt:    whereHelpernull_Int64{field: "\"sale\".\"archived_at\""},
-	CreatedByID:   whereHelperstring{field: "\"sale\".\"created_by_id\""},
-	UpdatedByID:   whereHelpernull_String{field: "\"sale\".\"updated_by_id\""},
-	ArchivedByID:  whereHelpernull_String{field: "\"sale\".\"archived_by_id\""},
+	ID:              whereHelperstring{field: "\"sale\".\"id\""},
+	BranchID:        whereHelperstring{field: "\"sale\".\"branch_id\""},
+	ReceiptNumber:   whereHelperstring{field: "\"sale\".\"receipt_number\""},
+	Amount:          whereHelperint64{field: "\"sale\".\"amount\""},
+	AmountTender:    whereHelperint64{field: "\"sale\".\"amount_tender\""},
+	Balance:         whereHelperint64{field: "\"sale\".\"balance\""},
+	CustomerName:    whereHelpernull_String{field: "\"sale\".\"customer_name\""},
+	PhoneNumber:     whereHelpernull_String{field: "\"sale\".\"phone_number\""},
+	CreatedAt:       whereHelperint64{field: "\"sale\".\"created_at\""},
+	UpdatedAt:       whereHelperint64{field: "\"sale\".\"updated_at\""},
+	ArchivedAt:      whereHelpernull_Int64{field: "\"sale\".\"archived_at\""},
+	CreatedByID:     whereHelperstring{field: "\"sale\".\"created_by_id\""},
+	UpdatedByID:     whereHelpernull_String{field: "\"sale\".\"updated_by_id\""},
+	ArchivedByID:    whereHelpernull_String{field: "\"sale\".\"archived_by_id\""},
+	PaymentMethod:   whereHelperstring{field: "\"sale\".\"payment_method\""},
+	Status:          whereHelperstring{field: "\"sale\".\"status\""},
+	AmountPaid:      whereHelperint64{field: "\"sale\".\"amount_paid\""},
+	CreditAccountID: whereHelpernull_String{field: "\"sale\".\"credit_account_id\""},
+	CreditTermDays:  whereHelperint{field: "\"sale\".\"credit_term_days\""},
+	ClearedAt:       whereHelpernull_Int64{field: "\"sale\".\"cleared_at\""},
 }
 
 // SaleRels is where relationship names are stored.
 var SaleRels = struct {
-	ArchivedBy string
-	Branch     string
-	CreatedBy  string
-	UpdatedBy  string
-	Payments   string
-	SaleItems  string
+	ArchivedBy    string
+	Branch        string
+	CreatedBy     string
+	CreditAccount string
+	UpdatedBy     string
+	Payments      string
+	SaleItems     string
 }{
-	ArchivedBy: "ArchivedBy",
-	Branch:     "Branch",
-	CreatedBy:  "CreatedBy",
-	UpdatedBy:  "UpdatedBy",
-	Payments:   "Payments",
-	SaleItems:  "SaleItems",
+	ArchivedBy:    "ArchivedBy",
+	Branch:        "Branch",
+	CreatedBy:     "CreatedBy",
+	CreditAccount: "CreditAccount",
+	UpdatedBy:     "UpdatedBy",
+	Payments:      "Payments",
+	SaleItems:     "SaleItems",
 }
 
 // saleR is where relationships are stored.
 type saleR struct {
-	ArchivedBy *User         `boil:"ArchivedBy" json:"ArchivedBy" toml:"ArchivedBy" yaml:"ArchivedBy"`
-	Branch     *Branch       `boil:"Branch" json:"Branch" toml:"Branch" yaml:"Branch"`
-	CreatedBy  *User         `boil:"CreatedBy" json:"CreatedBy" toml:"CreatedBy" yaml:"CreatedBy"`
-	UpdatedBy  *User         `boil:"UpdatedBy" json:"UpdatedBy" toml:"UpdatedBy" yaml:"UpdatedBy"`
-	Payments   PaymentSlice  `boil:"Payments" json:"Payments" toml:"Payments" yaml:"Payments"`
-	SaleItems  SaleItemSlice `boil:"SaleItems" json:"SaleItems" toml:"SaleItems" yaml:"SaleItems"`
+	ArchivedBy    *User         `boil:"ArchivedBy" json:"ArchivedBy" toml:"ArchivedBy" yaml:"ArchivedBy"`
+	Branch        *Branch       `boil:"Branch" json:"Branch" toml:"Branch" yaml:"Branch"`
+	CreatedBy     *User         `boil:"CreatedBy" json:"CreatedBy" toml:"CreatedBy" yaml:"CreatedBy"`
+	CreditAccount *Account      `boil:"CreditAccount" json:"CreditAccount" toml:"CreditAccount" yaml:"CreditAccount"`
+	UpdatedBy     *User         `boil:"UpdatedBy" json:"UpdatedBy" toml:"UpdatedBy" yaml:"UpdatedBy"`
+	Payments      PaymentSlice  `boil:"Payments" json:"Payments" toml:"Payments" yaml:"Payments"`
+	SaleItems     SaleItemSlice `boil:"SaleItems" json:"SaleItems" toml:"SaleItems" yaml:"SaleItems"`
 }
 
 // NewStruct creates a new relationship struct
@@ -177,9 +222,9 @@ func (*saleR) NewStruct() *saleR {
 type saleL struct{}
 
 var (
-	saleAllColumns            = []string{"id", "branch_id", "receipt_number", "amount", "amount_tender", "balance", "customer_name", "phone_number", "created_at", "updated_at", "archived_at", "created_by_id", "updated_by_id", "archived_by_id"}
+	saleAllColumns            = []string{"id", "branch_id", "receipt_number", "amount", "amount_tender", "balance", "customer_name", "phone_number", "created_at", "updated_at", "archived_at", "created_by_id", "updated_by_id", "archived_by_id", "payment_method", "status", "amount_paid", "credit_account_id", "credit_term_days", "cleared_at"}
 	saleColumnsWithoutDefault = []string{"id", "receipt_number", "amount", "amount_tender", "balance", "customer_name", "phone_number", "created_at", "updated_at", "archived_at", "created_by_id", "updated_by_id", "archived_by_id"}
-	saleColumnsWithDefault    = []string{"branch_id"}
+	saleColumnsWithDefault    = []string{"branch_id", "payment_method", "status", "amount_paid", "credit_account_id", "credit_term_days", "cleared_at"}
 	salePrimaryKeyColumns     = []string{"id"}
 )
 
@@ -316,6 +361,20 @@ func (o *Sale) CreatedBy(mods ...qm.QueryMod) userQuery {
 	return query
 }
 
+// CreditAccount pointed to by the foreign key.
+func (o *Sale) CreditAccount(mods ...qm.QueryMod) accountQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.CreditAccountID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	query := Accounts(queryMods...)
+	queries.SetFrom(query.Query, "\"account\"")
+
+	return query
+}
+
 // UpdatedBy pointed to by the foreign key.
 func (o *Sale) UpdatedBy(mods ...qm.QueryMod) userQuery {
 	queryMods := []qm.QueryMod{
@@ -664,6 +723,106 @@ func (saleL) LoadCreatedBy(ctx context.Context, e boil.ContextExecutor, singular
 	return nil
 }
 
+// LoadCreditAccount allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (saleL) LoadCreditAccount(ctx context.Context, e boil.ContextExecutor, singular bool, maybeSale interface{}, mods queries.Applicator) error {
+	var slice []*Sale
+	var object *Sale
+
+	if singular {
+		object = maybeSale.(*Sale)
+	} else {
+		slice = *maybeSale.(*[]*Sale)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &saleR{}
+		}
+		if !queries.IsNil(object.CreditAccountID) {
+			args = append(args, object.CreditAccountID)
+		}
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &saleR{}
+			}
+
+			for _, a := range args {
+				if queries.Equal(a, obj.CreditAccountID) {
+					continue Outer
+				}
+			}
+
+			if !queries.IsNil(obj.CreditAccountID) {
+				args = append(args, obj.CreditAccountID)
+			}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`account`),
+		qm.WhereIn(`account.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Account")
+	}
+
+	var resultSlice []*Account
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Account")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for account")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for account")
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.CreditAccount = foreign
+		if foreign.R == nil {
+			foreign.R = &accountR{}
+		}
+		foreign.R.CreditAccountSales = append(foreign.R.CreditAccountSales, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if queries.Equal(local.CreditAccountID, foreign.ID) {
+				local.R.CreditAccount = foreign
+				if foreign.R == nil {
+					foreign.R = &accountR{}
+				}
+				foreign.R.CreditAccountSales = append(foreign.R.CreditAccountSales, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
 // LoadUpdatedBy allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for an N-1 relationship.
 func (saleL) LoadUpdatedBy(ctx context.Context, e boil.ContextExecutor, singular bool, maybeSale interface{}, mods queries.Applicator) error {
@@ -1120,6 +1279,86 @@ func (o *Sale) SetCreatedBy(ctx context.Context, exec boil.ContextExecutor, inse
 	return nil
 }
 
+// SetCreditAccount of the sale to the related item.
+// Sets o.R.CreditAccount to related.
+// Adds o to related.R.CreditAccountSales.
+func (o *Sale) SetCreditAccount(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Account) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"sale\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"credit_account_id"}),
+		strmangle.WhereClause("\"", "\"", 2, salePrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.CreditAccountID, related.ID)
+	if o.R == nil {
+		o.R = &saleR{
+			CreditAccount: related,
+		}
+	} else {
+		o.R.CreditAccount = related
+	}
+
+	if related.R == nil {
+		related.R = &accountR{
+			CreditAccountSales: SaleSlice{o},
+		}
+	} else {
+		related.R.CreditAccountSales = append(related.R.CreditAccountSales, o)
+	}
+
+	return nil
+}
+
+// RemoveCreditAccount relationship.
+// Sets o.R.CreditAccount to nil.
+// Removes o from all passed in related items' relationships struct (Optional).
+func (o *Sale) RemoveCreditAccount(ctx context.Context, exec boil.ContextExecutor, related *Account) error {
+	var err error
+
+	queries.SetScanner(&o.CreditAccountID, nil)
+	if _, err = o.Update(ctx, exec, boil.Whitelist("credit_account_id")); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	if o.R != nil {
+		o.R.CreditAccount = nil
+	}
+	if related == nil || related.R == nil {
+		return nil
+	}
+
+	for i, ri := range related.R.CreditAccountSales {
+		if queries.Equal(o.CreditAccountID, ri.CreditAccountID) {
+			continue
+		}
+
+		ln := len(related.R.CreditAccountSales)
+		if ln > 1 && i < ln-1 {
+			related.R.CreditAccountSales[i] = related.R.CreditAccountSales[ln-1]
+		}
+		related.R.CreditAccountSales = related.R.CreditAccountSales[:ln-1]
+		break
+	}
+	return nil
+}
+
 // SetUpdatedBy of the sale to the related item.
 // Sets o.R.UpdatedBy to related.
 // Adds o to related.R.UpdatedBySales.
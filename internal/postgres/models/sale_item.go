@@ -23,13 +23,13 @@ import (
 
 // SaleItem is an object representing the database table.
 type SaleItem struct {
-	ID            string  `boil:"id" json:"id" toml:"id" yaml:"id"`
-	SaleID        string  `boil:"sale_id" json:"sale_id" toml:"sale_id" yaml:"sale_id"`
-	ProductID     string  `boil:"product_id" json:"product_id" toml:"product_id" yaml:"product_id"`
-	Quantity      int     `boil:"quantity" json:"quantity" toml:"quantity" yaml:"quantity"`
-	UnitPrice     float64 `boil:"unit_price" json:"unit_price" toml:"unit_price" yaml:"unit_price"`
-	UnitCostPrice float64 `boil:"unit_cost_price" json:"unit_cost_price" toml:"unit_cost_price" yaml:"unit_cost_price"`
-	StockIds      string  `boil:"stock_ids" json:"stock_ids" toml:"stock_ids" yaml:"stock_ids"`
+	ID            string `boil:"id" json:"id" toml:"id" yaml:"id"`
+	SaleID        string `boil:"sale_id" json:"sale_id" toml:"sale_id" yaml:"sale_id"`
+	ProductID     string `boil:"product_id" json:"product_id" toml:"product_id" yaml:"product_id"`
+	Quantity      int    `boil:"quantity" json:"quantity" toml:"quantity" yaml:"quantity"`
+	UnitPrice     int64  `boil:"unit_price" json:"unit_price" toml:"unit_price" yaml:"unit_price"`
+	UnitCostPrice int64  `boil:"unit_cost_price" json:"unit_cost_price" toml:"unit_cost_price" yaml:"unit_cost_price"`
+	StockIds      string `boil:"stock_ids" json:"stock_ids" toml:"stock_ids" yaml:"stock_ids"`
 
 	R *saleItemR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L saleItemL  `boil:"-" json:"-" toml:"-" yaml:"-"`
@@ -78,16 +78,16 @@ var SaleItemWhere = struct {
 	SaleID        whereHelperstring
 	ProductID     whereHelperstring
 	Quantity      whereHelperint
-	UnitPrice     whereHelperfloat64
-	UnitCostPrice whereHelperfloat64
+	UnitPrice     whereHelperint64
+	UnitCostPrice whereHelperint64
 	StockIds      whereHelperstring
 }{
 	ID:            whereHelperstring{field: "\"sale_item\".\"id\""},
 	SaleID:        whereHelperstring{field: "\"sale_item\".\"sale_id\""},
 	ProductID:     whereHelperstring{field: "\"sale_item\".\"product_id\""},
 	Quantity:      whereHelperint{field: "\"sale_item\".\"quantity\""},
-	UnitPrice:     whereHelperfloat64{field: "\"sale_item\".\"unit_price\""},
-	UnitCostPrice: whereHelperfloat64{field: "\"sale_item\".\"unit_cost_price\""},
+	UnitPrice:     whereHelperint64{field: "\"sale_item\".\"unit_price\""},
+	UnitCostPrice: whereHelperint64{field: "\"sale_item\".\"unit_cost_price\""},
 	StockIds:      whereHelperstring{field: "\"sale_item\".\"stock_ids\""},
 }
 
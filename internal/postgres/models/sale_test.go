@@ -812,6 +812,57 @@ func testSaleToOneUserUsingCreatedBy(t *testing.T) {
 	}
 }
 
+func testSaleToOneAccountUsingCreditAccount(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local Sale
+	var foreign Account
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, saleDBTypes, true, saleColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Sale struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, accountDBTypes, false, accountColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Account struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	queries.Assign(&local.CreditAccountID, foreign.ID)
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.CreditAccount().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !queries.Equal(check.ID, foreign.ID) {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	slice := SaleSlice{&local}
+	if err = local.L.LoadCreditAccount(ctx, tx, false, (*[]*Sale)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.CreditAccount == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.CreditAccount = nil
+	if err = local.L.LoadCreditAccount(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.CreditAccount == nil {
+		t.Error("struct should have been eager loaded")
+	}
+}
+
 func testSaleToOneUserUsingUpdatedBy(t *testing.T) {
 	ctx := context.Background()
 	tx := MustTx(boil.BeginTx(ctx, nil))
@@ -1086,6 +1137,115 @@ func testSaleToOneSetOpUserUsingCreatedBy(t *testing.T) {
 		}
 	}
 }
+func testSaleToOneSetOpAccountUsingCreditAccount(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Sale
+	var b, c Account
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, saleDBTypes, false, strmangle.SetComplement(salePrimaryKeyColumns, saleColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, accountDBTypes, false, strmangle.SetComplement(accountPrimaryKeyColumns, accountColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, accountDBTypes, false, strmangle.SetComplement(accountPrimaryKeyColumns, accountColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*Account{&b, &c} {
+		err = a.SetCreditAccount(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.CreditAccount != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.CreditAccountSales[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if !queries.Equal(a.CreditAccountID, x.ID) {
+			t.Error("foreign key was wrong value", a.CreditAccountID)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(a.CreditAccountID))
+		reflect.Indirect(reflect.ValueOf(&a.CreditAccountID)).Set(zero)
+
+		if err = a.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if !queries.Equal(a.CreditAccountID, x.ID) {
+			t.Error("foreign key was wrong value", a.CreditAccountID, x.ID)
+		}
+	}
+}
+
+func testSaleToOneRemoveOpAccountUsingCreditAccount(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Sale
+	var b Account
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, saleDBTypes, false, strmangle.SetComplement(salePrimaryKeyColumns, saleColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, accountDBTypes, false, strmangle.SetComplement(accountPrimaryKeyColumns, accountColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.SetCreditAccount(ctx, tx, true, &b); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.RemoveCreditAccount(ctx, tx, &b); err != nil {
+		t.Error("failed to remove relationship")
+	}
+
+	count, err := a.CreditAccount().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 0 {
+		t.Error("want no relationships remaining")
+	}
+
+	if a.R.CreditAccount != nil {
+		t.Error("R struct entry should be nil")
+	}
+
+	if !queries.IsValuerNil(a.CreditAccountID) {
+		t.Error("foreign key value should be nil")
+	}
+
+	if len(b.R.CreditAccountSales) != 0 {
+		t.Error("failed to remove a from b's relationships")
+	}
+}
+
 func testSaleToOneSetOpUserUsingUpdatedBy(t *testing.T) {
 	var err error
 
@@ -1269,7 +1429,7 @@ func testSalesSelect(t *testing.T) {
 }
 
 var (
-	saleDBTypes = map[string]string{`ID`: `character`, `BranchID`: `character`, `ReceiptNumber`: `character varying`, `Amount`: `double precision`, `AmountTender`: `double precision`, `Balance`: `double precision`, `CustomerName`: `character varying`, `PhoneNumber`: `character varying`, `CreatedAt`: `bigint`, `UpdatedAt`: `bigint`, `ArchivedAt`: `bigint`, `CreatedByID`: `character`, `UpdatedByID`: `character`, `ArchivedByID`: `character`}
+	saleDBTypes = map[string]string{`ID`: `character`, `BranchID`: `character`, `ReceiptNumber`: `character varying`, `Amount`: `bigint`, `AmountTender`: `bigint`, `Balance`: `bigint`, `CustomerName`: `character varying`, `PhoneNumber`: `character varying`, `CreatedAt`: `bigint`, `UpdatedAt`: `bigint`, `ArchivedAt`: `bigint`, `CreatedByID`: `character`, `UpdatedByID`: `character`, `ArchivedByID`: `character`, `PaymentMethod`: `character varying`, `Status`: `character varying`, `AmountPaid`: `bigint`, `CreditAccountID`: `character`, `CreditTermDays`: `integer`, `ClearedAt`: `bigint`}
 	_           = bytes.MinRead
 )
 
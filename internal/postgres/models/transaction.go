@@ -24,19 +24,25 @@ import (
 
 // Transaction is an object representing the database table.
 type Transaction struct {
-	ID             string     `boil:"id" json:"id" toml:"id" yaml:"id"`
-	AccountID      string     `boil:"account_id" json:"account_id" toml:"account_id" yaml:"account_id"`
-	TXType         string     `boil:"tx_type" json:"tx_type" toml:"tx_type" yaml:"tx_type"`
-	OpeningBalance float64    `boil:"opening_balance" json:"opening_balance" toml:"opening_balance" yaml:"opening_balance"`
-	Amount         float64    `boil:"amount" json:"amount" toml:"amount" yaml:"amount"`
-	Narration      string     `boil:"narration" json:"narration" toml:"narration" yaml:"narration"`
-	SalesRepID     string     `boil:"sales_rep_id" json:"sales_rep_id" toml:"sales_rep_id" yaml:"sales_rep_id"`
-	CreatedAt      int64      `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
-	UpdatedAt      int64      `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
-	ArchivedAt     null.Int64 `boil:"archived_at" json:"archived_at,omitempty" toml:"archived_at" yaml:"archived_at,omitempty"`
-	ReceiptNo      string     `boil:"receipt_no" json:"receipt_no" toml:"receipt_no" yaml:"receipt_no"`
-	EffectiveDate  int64      `boil:"effective_date" json:"effective_date" toml:"effective_date" yaml:"effective_date"`
-	PaymentMethod  string     `boil:"payment_method" json:"payment_method" toml:"payment_method" yaml:"payment_method"`
+	ID             string      `boil:"id" json:"id" toml:"id" yaml:"id"`
+	AccountID      string      `boil:"account_id" json:"account_id" toml:"account_id" yaml:"account_id"`
+	TXType         string      `boil:"tx_type" json:"tx_type" toml:"tx_type" yaml:"tx_type"`
+	OpeningBalance int64       `boil:"opening_balance" json:"opening_balance" toml:"opening_balance" yaml:"opening_balance"`
+	Amount         int64       `boil:"amount" json:"amount" toml:"amount" yaml:"amount"`
+	Narration      string      `boil:"narration" json:"narration" toml:"narration" yaml:"narration"`
+	SalesRepID     string      `boil:"sales_rep_id" json:"sales_rep_id" toml:"sales_rep_id" yaml:"sales_rep_id"`
+	CreatedAt      int64       `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedAt      int64       `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+	ArchivedAt     null.Int64  `boil:"archived_at" json:"archived_at,omitempty" toml:"archived_at" yaml:"archived_at,omitempty"`
+	ReceiptNo      string      `boil:"receipt_no" json:"receipt_no" toml:"receipt_no" yaml:"receipt_no"`
+	EffectiveDate  int64       `boil:"effective_date" json:"effective_date" toml:"effective_date" yaml:"effective_date"`
+	PaymentMethod  string      `boil:"payment_method" json:"payment_method" toml:"payment_method" yaml:"payment_method"`
+	ReversalOfID   null.String `boil:"reversal_of_id" json:"reversal_of_id,omitempty" toml:"reversal_of_id" yaml:"reversal_of_id,omitempty"`
+	CorrectionOfID null.String `boil:"correction_of_id" json:"correction_of_id,omitempty" toml:"correction_of_id" yaml:"correction_of_id,omitempty"`
+	Reason         string      `boil:"reason" json:"reason" toml:"reason" yaml:"reason"`
+	ApprovedByID   null.String `boil:"approved_by_id" json:"approved_by_id,omitempty" toml:"approved_by_id" yaml:"approved_by_id,omitempty"`
+	TransferID     null.String `boil:"transfer_id" json:"transfer_id,omitempty" toml:"transfer_id" yaml:"transfer_id,omitempty"`
+	DSCycleID      null.String `boil:"ds_cycle_id" json:"ds_cycle_id,omitempty" toml:"ds_cycle_id" yaml:"ds_cycle_id,omitempty"`
 
 	R *transactionR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L transactionL  `boil:"-" json:"-" toml:"-" yaml:"-"`
@@ -56,6 +62,12 @@ var TransactionColumns = struct {
 	ReceiptNo      string
 	EffectiveDate  string
 	PaymentMethod  string
+	ReversalOfID   string
+	CorrectionOfID string
+	Reason         string
+	ApprovedByID   string
+	TransferID     string
+	DSCycleID      string
 }{
 	ID:             "id",
 	AccountID:      "account_id",
@@ -70,6 +82,12 @@ var TransactionColumns = struct {
 	ReceiptNo:      "receipt_no",
 	EffectiveDate:  "effective_date",
 	PaymentMethod:  "payment_method",
+	ReversalOfID:   "reversal_of_id",
+	CorrectionOfID: "correction_of_id",
+	Reason:         "reason",
+	ApprovedByID:   "approved_by_id",
+	TransferID:     "transfer_id",
+	DSCycleID:      "ds_cycle_id",
 }
 
 var TransactionTableColumns = struct {
@@ -86,6 +104,12 @@ var TransactionTableColumns = struct {
 	ReceiptNo      string
 	EffectiveDate  string
 	PaymentMethod  string
+	ReversalOfID   string
+	CorrectionOfID string
+	Reason         string
+	ApprovedByID   string
+	TransferID     string
+	DSCycleID      string
 }{
 	ID:             "transaction.id",
 	AccountID:      "transaction.account_id",
@@ -100,6 +124,12 @@ var TransactionTableColumns = struct {
 	ReceiptNo:      "transaction.receipt_no",
 	EffectiveDate:  "transaction.effective_date",
 	PaymentMethod:  "transaction.payment_method",
+	ReversalOfID:   "transaction.reversal_of_id",
+	CorrectionOfID: "transaction.correction_of_id",
+	Reason:         "transaction.reason",
+	ApprovedByID:   "transaction.approved_by_id",
+	TransferID:     "transaction.transfer_id",
+	DSCycleID:      "transaction.ds_cycle_id",
 }
 
 // Generated where
@@ -108,8 +138,8 @@ var TransactionWhere = struct {
 	ID             whereHelperstring
 	AccountID      whereHelperstring
 	TXType         whereHelperstring
-	OpeningBalance whereHelperfloat64
-	Amount         whereHelperfloat64
+	OpeningBalance whereHelperint64
+	Amount         whereHelperint64
 	Narration      whereHelperstring
 	SalesRepID     whereHelperstring
 	CreatedAt      whereHelperint64
@@ -118,12 +148,18 @@ var TransactionWhere = struct {
 	ReceiptNo      whereHelperstring
 	EffectiveDate  whereHelperint64
 	PaymentMethod  whereHelperstring
+	ReversalOfID   whereHelpernull_String
+	CorrectionOfID whereHelpernull_String
+	Reason         whereHelperstring
+	ApprovedByID   whereHelpernull_String
+	TransferID     whereHelpernull_String
+	DSCycleID      whereHelpernull_String
 }{
 	ID:             whereHelperstring{field: "\"transaction\".\"id\""},
 	AccountID:      whereHelperstring{field: "\"transaction\".\"account_id\""},
 	TXType:         whereHelperstring{field: "\"transaction\".\"tx_type\""},
-	OpeningBalance: whereHelperfloat64{field: "\"transaction\".\"opening_balance\""},
-	Amount:         whereHelperfloat64{field: "\"transaction\".\"amount\""},
+	OpeningBalance: whereHelperint64{field: "\"transaction\".\"opening_balance\""},
+	Amount:         whereHelperint64{field: "\"transaction\".\"amount\""},
 	Narration:      whereHelperstring{field: "\"transaction\".\"narration\""},
 	SalesRepID:     whereHelperstring{field: "\"transaction\".\"sales_rep_id\""},
 	CreatedAt:      whereHelperint64{field: "\"transaction\".\"created_at\""},
@@ -132,21 +168,66 @@ var TransactionWhere = struct {
 	ReceiptNo:      whereHelperstring{field: "\"transaction\".\"receipt_no\""},
 	EffectiveDate:  whereHelperint64{field: "\"transaction\".\"effective_date\""},
 	PaymentMethod:  whereHelperstring{field: "\"transaction\".\"payment_method\""},
+	ReversalOfID:   whereHelpernull_String{field: "\"transaction\".\"reversal_of_id\""},
+	CorrectionOfID: whereHelpernull_String{field: "\"transaction\".\"correction_of_id\""},
+	Reason:         whereHelperstring{field: "\"transaction\".\"reason\""},
+	ApprovedByID:   whereHelpernull_String{field: "\"transaction\".\"approved_by_id\""},
+	TransferID:     whereHelpernull_String{field: "\"transaction\".\"transfer_id\""},
+	DSCycleID:      whereHelpernull_String{field: "\"transaction\".\"ds_cycle_id\""},
 }
 
 // TransactionRels is where relationship names are stored.
 var TransactionRels = struct {
-	Account  string
-	SalesRep string
+	Account                      string
+	ApprovedBy                   string
+	CorrectionOf                 string
+	DSCycle                      string
+	ReversalOf                   string
+	SalesRep                     string
+	Transfer                     string
+	Approvals                    string
+	PayoutTransactionDSCycles    string
+	ImportRows                   string
+	DisbursementTransactionLoans string
+	LoanRepayments               string
+	CorrectionOfTransactions     string
+	ReversalOfTransactions       string
+	WithdrawalRequests           string
 }{
-	Account:  "Account",
-	SalesRep: "SalesRep",
+	Account:                      "Account",
+	ApprovedBy:                   "ApprovedBy",
+	CorrectionOf:                 "CorrectionOf",
+	DSCycle:                      "DSCycle",
+	ReversalOf:                   "ReversalOf",
+	SalesRep:                     "SalesRep",
+	Transfer:                     "Transfer",
+	Approvals:                    "Approvals",
+	PayoutTransactionDSCycles:    "PayoutTransactionDSCycles",
+	ImportRows:                   "ImportRows",
+	DisbursementTransactionLoans: "DisbursementTransactionLoans",
+	LoanRepayments:               "LoanRepayments",
+	CorrectionOfTransactions:     "CorrectionOfTransactions",
+	ReversalOfTransactions:       "ReversalOfTransactions",
+	WithdrawalRequests:           "WithdrawalRequests",
 }
 
 // transactionR is where relationships are stored.
 type transactionR struct {
-	Account  *Account `boil:"Account" json:"Account" toml:"Account" yaml:"Account"`
-	SalesRep *User    `boil:"SalesRep" json:"SalesRep" toml:"SalesRep" yaml:"SalesRep"`
+	Account                      *Account               `boil:"Account" json:"Account" toml:"Account" yaml:"Account"`
+	ApprovedBy                   *User                  `boil:"ApprovedBy" json:"ApprovedBy" toml:"ApprovedBy" yaml:"ApprovedBy"`
+	CorrectionOf                 *Transaction           `boil:"CorrectionOf" json:"CorrectionOf" toml:"CorrectionOf" yaml:"CorrectionOf"`
+	DSCycle                      *DSCycle               `boil:"DSCycle" json:"DSCycle" toml:"DSCycle" yaml:"DSCycle"`
+	ReversalOf                   *Transaction           `boil:"ReversalOf" json:"ReversalOf" toml:"ReversalOf" yaml:"ReversalOf"`
+	SalesRep                     *User                  `boil:"SalesRep" json:"SalesRep" toml:"SalesRep" yaml:"SalesRep"`
+	Transfer                     *Transfer              `boil:"Transfer" json:"Transfer" toml:"Transfer" yaml:"Transfer"`
+	Approvals                    ApprovalSlice          `boil:"Approvals" json:"Approvals" toml:"Approvals" yaml:"Approvals"`
+	PayoutTransactionDSCycles    DSCycleSlice           `boil:"PayoutTransactionDSCycles" json:"PayoutTransactionDSCycles" toml:"PayoutTransactionDSCycles" yaml:"PayoutTransactionDSCycles"`
+	ImportRows                   ImportRowSlice         `boil:"ImportRows" json:"ImportRows" toml:"ImportRows" yaml:"ImportRows"`
+	DisbursementTransactionLoans LoanSlice              `boil:"DisbursementTransactionLoans" json:"DisbursementTransactionLoans" toml:"DisbursementTransactionLoans" yaml:"DisbursementTransactionLoans"`
+	LoanRepayments               LoanRepaymentSlice     `boil:"LoanRepayments" json:"LoanRepayments" toml:"LoanRepayments" yaml:"LoanRepayments"`
+	CorrectionOfTransactions     TransactionSlice       `boil:"CorrectionOfTransactions" json:"CorrectionOfTransactions" toml:"CorrectionOfTransactions" yaml:"CorrectionOfTransactions"`
+	ReversalOfTransactions       TransactionSlice       `boil:"ReversalOfTransactions" json:"ReversalOfTransactions" toml:"ReversalOfTransactions" yaml:"ReversalOfTransactions"`
+	WithdrawalRequests           WithdrawalRequestSlice `boil:"WithdrawalRequests" json:"WithdrawalRequests" toml:"WithdrawalRequests" yaml:"WithdrawalRequests"`
 }
 
 // NewStruct creates a new relationship struct
@@ -158,9 +239,9 @@ func (*transactionR) NewStruct() *transactionR {
 type transactionL struct{}
 
 var (
-	transactionAllColumns            = []string{"id", "account_id", "tx_type", "opening_balance", "amount", "narration", "sales_rep_id", "created_at", "updated_at", "archived_at", "receipt_no", "effective_date", "payment_method"}
+	transactionAllColumns            = []string{"id", "account_id", "tx_type", "opening_balance", "amount", "narration", "sales_rep_id", "created_at", "updated_at", "archived_at", "receipt_no", "effective_date", "payment_method", "reversal_of_id", "correction_of_id", "reason", "approved_by_id", "transfer_id", "ds_cycle_id"}
 	transactionColumnsWithoutDefault = []string{"id", "tx_type", "opening_balance", "sales_rep_id", "created_at", "updated_at", "archived_at"}
-	transactionColumnsWithDefault    = []string{"account_id", "amount", "narration", "receipt_no", "effective_date", "payment_method"}
+	transactionColumnsWithDefault    = []string{"account_id", "amount", "narration", "receipt_no", "effective_date", "payment_method", "reversal_of_id", "correction_of_id", "reason", "approved_by_id", "transfer_id", "ds_cycle_id"}
 	transactionPrimaryKeyColumns     = []string{"id"}
 )
 
@@ -269,6 +350,62 @@ func (o *Transaction) Account(mods ...qm.QueryMod) accountQuery {
 	return query
 }
 
+// ApprovedBy pointed to by the foreign key.
+func (o *Transaction) ApprovedBy(mods ...qm.QueryMod) userQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.ApprovedByID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	query := Users(queryMods...)
+	queries.SetFrom(query.Query, "\"users\"")
+
+	return query
+}
+
+// CorrectionOf pointed to by the foreign key.
+func (o *Transaction) CorrectionOf(mods ...qm.QueryMod) transactionQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.CorrectionOfID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	query := Transactions(queryMods...)
+	queries.SetFrom(query.Query, "\"transaction\"")
+
+	return query
+}
+
+// DSCycle pointed to by the foreign key.
+func (o *Transaction) DSCycle(mods ...qm.QueryMod) dsCycleQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.DSCycleID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	query := DSCycles(queryMods...)
+	queries.SetFrom(query.Query, "\"ds_cycle\"")
+
+	return query
+}
+
+// ReversalOf pointed to by the foreign key.
+func (o *Transaction) ReversalOf(mods ...qm.QueryMod) transactionQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.ReversalOfID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	query := Transactions(queryMods...)
+	queries.SetFrom(query.Query, "\"transaction\"")
+
+	return query
+}
+
 // SalesRep pointed to by the foreign key.
 func (o *Transaction) SalesRep(mods ...qm.QueryMod) userQuery {
 	queryMods := []qm.QueryMod{
@@ -283,6 +420,188 @@ func (o *Transaction) SalesRep(mods ...qm.QueryMod) userQuery {
 	return query
 }
 
+// Transfer pointed to by the foreign key.
+func (o *Transaction) Transfer(mods ...qm.QueryMod) transferQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.TransferID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	query := Transfers(queryMods...)
+	queries.SetFrom(query.Query, "\"transfer\"")
+
+	return query
+}
+
+// Approvals retrieves all the approval's Approvals with an executor.
+func (o *Transaction) Approvals(mods ...qm.QueryMod) approvalQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"approval\".\"transaction_id\"=?", o.ID),
+	)
+
+	query := Approvals(queryMods...)
+	queries.SetFrom(query.Query, "\"approval\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"approval\".*"})
+	}
+
+	return query
+}
+
+// PayoutTransactionDSCycles retrieves all the ds_cycle's DSCycles with an executor via payout_transaction_id column.
+func (o *Transaction) PayoutTransactionDSCycles(mods ...qm.QueryMod) dsCycleQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"ds_cycle\".\"payout_transaction_id\"=?", o.ID),
+	)
+
+	query := DSCycles(queryMods...)
+	queries.SetFrom(query.Query, "\"ds_cycle\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"ds_cycle\".*"})
+	}
+
+	return query
+}
+
+// ImportRows retrieves all the import_row's ImportRows with an executor.
+func (o *Transaction) ImportRows(mods ...qm.QueryMod) importRowQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"import_row\".\"transaction_id\"=?", o.ID),
+	)
+
+	query := ImportRows(queryMods...)
+	queries.SetFrom(query.Query, "\"import_row\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"import_row\".*"})
+	}
+
+	return query
+}
+
+// DisbursementTransactionLoans retrieves all the loan's Loans with an executor via disbursement_transaction_id column.
+func (o *Transaction) DisbursementTransactionLoans(mods ...qm.QueryMod) loanQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"loan\".\"disbursement_transaction_id\"=?", o.ID),
+	)
+
+	query := Loans(queryMods...)
+	queries.SetFrom(query.Query, "\"loan\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"loan\".*"})
+	}
+
+	return query
+}
+
+// LoanRepayments retrieves all the loan_repayment's LoanRepayments with an executor.
+func (o *Transaction) LoanRepayments(mods ...qm.QueryMod) loanRepaymentQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"loan_repayment\".\"transaction_id\"=?", o.ID),
+	)
+
+	query := LoanRepayments(queryMods...)
+	queries.SetFrom(query.Query, "\"loan_repayment\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"loan_repayment\".*"})
+	}
+
+	return query
+}
+
+// CorrectionOfTransactions retrieves all the transaction's Transactions with an executor via correction_of_id column.
+func (o *Transaction) CorrectionOfTransactions(mods ...qm.QueryMod) transactionQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"transaction\".\"correction_of_id\"=?", o.ID),
+	)
+
+	query := Transactions(queryMods...)
+	queries.SetFrom(query.Query, "\"transaction\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"transaction\".*"})
+	}
+
+	return query
+}
+
+// ReversalOfTransactions retrieves all the transaction's Transactions with an executor via reversal_of_id column.
+func (o *Transaction) ReversalOfTransactions(mods ...qm.QueryMod) transactionQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"transaction\".\"reversal_of_id\"=?", o.ID),
+	)
+
+	query := Transactions(queryMods...)
+	queries.SetFrom(query.Query, "\"transaction\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"transaction\".*"})
+	}
+
+	return query
+}
+
+// WithdrawalRequests retrieves all the withdrawal_request's WithdrawalRequests with an executor.
+func (o *Transaction) WithdrawalRequests(mods ...qm.QueryMod) withdrawalRequestQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"withdrawal_request\".\"transaction_id\"=?", o.ID),
+	)
+
+	query := WithdrawalRequests(queryMods...)
+	queries.SetFrom(query.Query, "\"withdrawal_request\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"withdrawal_request\".*"})
+	}
+
+	return query
+}
+
 // LoadAccount allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for an N-1 relationship.
 func (transactionL) LoadAccount(ctx context.Context, e boil.ContextExecutor, singular bool, maybeTransaction interface{}, mods queries.Applicator) error {
@@ -379,9 +698,9 @@ func (transactionL) LoadAccount(ctx context.Context, e boil.ContextExecutor, sin
 	return nil
 }
 
-// LoadSalesRep allows an eager lookup of values, cached into the
+// LoadApprovedBy allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for an N-1 relationship.
-func (transactionL) LoadSalesRep(ctx context.Context, e boil.ContextExecutor, singular bool, maybeTransaction interface{}, mods queries.Applicator) error {
+func (transactionL) LoadApprovedBy(ctx context.Context, e boil.ContextExecutor, singular bool, maybeTransaction interface{}, mods queries.Applicator) error {
 	var slice []*Transaction
 	var object *Transaction
 
@@ -396,7 +715,9 @@ func (transactionL) LoadSalesRep(ctx context.Context, e boil.ContextExecutor, si
 		if object.R == nil {
 			object.R = &transactionR{}
 		}
-		args = append(args, object.SalesRepID)
+		if !queries.IsNil(object.ApprovedByID) {
+			args = append(args, object.ApprovedByID)
+		}
 
 	} else {
 	Outer:
@@ -406,12 +727,14 @@ func (transactionL) LoadSalesRep(ctx context.Context, e boil.ContextExecutor, si
 			}
 
 			for _, a := range args {
-				if a == obj.SalesRepID {
+				if queries.Equal(a, obj.ApprovedByID) {
 					continue Outer
 				}
 			}
 
-			args = append(args, obj.SalesRepID)
+			if !queries.IsNil(obj.ApprovedByID) {
+				args = append(args, obj.ApprovedByID)
+			}
 
 		}
 	}
@@ -451,22 +774,22 @@ func (transactionL) LoadSalesRep(ctx context.Context, e boil.ContextExecutor, si
 
 	if singular {
 		foreign := resultSlice[0]
-		object.R.SalesRep = foreign
+		object.R.ApprovedBy = foreign
 		if foreign.R == nil {
 			foreign.R = &userR{}
 		}
-		foreign.R.SalesRepTransactions = append(foreign.R.SalesRepTransactions, object)
+		foreign.R.ApprovedByTransactions = append(foreign.R.ApprovedByTransactions, object)
 		return nil
 	}
 
 	for _, local := range slice {
 		for _, foreign := range resultSlice {
-			if local.SalesRepID == foreign.ID {
-				local.R.SalesRep = foreign
+			if queries.Equal(local.ApprovedByID, foreign.ID) {
+				local.R.ApprovedBy = foreign
 				if foreign.R == nil {
 					foreign.R = &userR{}
 				}
-				foreign.R.SalesRepTransactions = append(foreign.R.SalesRepTransactions, local)
+				foreign.R.ApprovedByTransactions = append(foreign.R.ApprovedByTransactions, local)
 				break
 			}
 		}
@@ -475,95 +798,2735 @@ func (transactionL) LoadSalesRep(ctx context.Context, e boil.ContextExecutor, si
 	return nil
 }
 
-// SetAccount of the transaction to the related item.
-// Sets o.R.Account to related.
-// Adds o to related.R.Transactions.
-func (o *Transaction) SetAccount(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Account) error {
-	var err error
-	if insert {
-		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
-			return errors.Wrap(err, "failed to insert into foreign table")
-		}
-	}
-
-	updateQuery := fmt.Sprintf(
-		"UPDATE \"transaction\" SET %s WHERE %s",
-		strmangle.SetParamNames("\"", "\"", 1, []string{"account_id"}),
-		strmangle.WhereClause("\"", "\"", 2, transactionPrimaryKeyColumns),
-	)
-	values := []interface{}{related.ID, o.ID}
-
-	if boil.IsDebug(ctx) {
-		writer := boil.DebugWriterFrom(ctx)
-		fmt.Fprintln(writer, updateQuery)
-		fmt.Fprintln(writer, values)
-	}
-	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
-		return errors.Wrap(err, "failed to update local table")
-	}
+// LoadCorrectionOf allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (transactionL) LoadCorrectionOf(ctx context.Context, e boil.ContextExecutor, singular bool, maybeTransaction interface{}, mods queries.Applicator) error {
+	var slice []*Transaction
+	var object *Transaction
 
-	o.AccountID = related.ID
-	if o.R == nil {
-		o.R = &transactionR{
-			Account: related,
-		}
+	if singular {
+		object = maybeTransaction.(*Transaction)
 	} else {
-		o.R.Account = related
+		slice = *maybeTransaction.(*[]*Transaction)
 	}
 
-	if related.R == nil {
-		related.R = &accountR{
-			Transactions: TransactionSlice{o},
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &transactionR{}
 		}
+		if !queries.IsNil(object.CorrectionOfID) {
+			args = append(args, object.CorrectionOfID)
+		}
+
 	} else {
-		related.R.Transactions = append(related.R.Transactions, o)
-	}
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &transactionR{}
+			}
 
-	return nil
-}
+			for _, a := range args {
+				if queries.Equal(a, obj.CorrectionOfID) {
+					continue Outer
+				}
+			}
+
+			if !queries.IsNil(obj.CorrectionOfID) {
+				args = append(args, obj.CorrectionOfID)
+			}
 
-// SetSalesRep of the transaction to the related item.
-// Sets o.R.SalesRep to related.
-// Adds o to related.R.SalesRepTransactions.
-func (o *Transaction) SetSalesRep(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
-	var err error
-	if insert {
-		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
-			return errors.Wrap(err, "failed to insert into foreign table")
 		}
 	}
 
-	updateQuery := fmt.Sprintf(
-		"UPDATE \"transaction\" SET %s WHERE %s",
-		strmangle.SetParamNames("\"", "\"", 1, []string{"sales_rep_id"}),
-		strmangle.WhereClause("\"", "\"", 2, transactionPrimaryKeyColumns),
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`transaction`),
+		qm.WhereIn(`transaction.id in ?`, args...),
 	)
-	values := []interface{}{related.ID, o.ID}
+	if mods != nil {
+		mods.Apply(query)
+	}
 
-	if boil.IsDebug(ctx) {
-		writer := boil.DebugWriterFrom(ctx)
-		fmt.Fprintln(writer, updateQuery)
-		fmt.Fprintln(writer, values)
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Transaction")
 	}
-	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
-		return errors.Wrap(err, "failed to update local table")
+
+	var resultSlice []*Transaction
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Transaction")
 	}
 
-	o.SalesRepID = related.ID
-	if o.R == nil {
-		o.R = &transactionR{
-			SalesRep: related,
-		}
-	} else {
-		o.R.SalesRep = related
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for transaction")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for transaction")
 	}
 
-	if related.R == nil {
-		related.R = &userR{
-			SalesRepTransactions: TransactionSlice{o},
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.CorrectionOf = foreign
+		if foreign.R == nil {
+			foreign.R = &transactionR{}
+		}
+		foreign.R.CorrectionOfTransactions = append(foreign.R.CorrectionOfTransactions, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if queries.Equal(local.CorrectionOfID, foreign.ID) {
+				local.R.CorrectionOf = foreign
+				if foreign.R == nil {
+					foreign.R = &transactionR{}
+				}
+				foreign.R.CorrectionOfTransactions = append(foreign.R.CorrectionOfTransactions, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadDSCycle allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (transactionL) LoadDSCycle(ctx context.Context, e boil.ContextExecutor, singular bool, maybeTransaction interface{}, mods queries.Applicator) error {
+	var slice []*Transaction
+	var object *Transaction
+
+	if singular {
+		object = maybeTransaction.(*Transaction)
+	} else {
+		slice = *maybeTransaction.(*[]*Transaction)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &transactionR{}
+		}
+		if !queries.IsNil(object.DSCycleID) {
+			args = append(args, object.DSCycleID)
+		}
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &transactionR{}
+			}
+
+			for _, a := range args {
+				if queries.Equal(a, obj.DSCycleID) {
+					continue Outer
+				}
+			}
+
+			if !queries.IsNil(obj.DSCycleID) {
+				args = append(args, obj.DSCycleID)
+			}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`ds_cycle`),
+		qm.WhereIn(`ds_cycle.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load DSCycle")
+	}
+
+	var resultSlice []*DSCycle
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice DSCycle")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for ds_cycle")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for ds_cycle")
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.DSCycle = foreign
+		if foreign.R == nil {
+			foreign.R = &dsCycleR{}
+		}
+		foreign.R.Transactions = append(foreign.R.Transactions, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if queries.Equal(local.DSCycleID, foreign.ID) {
+				local.R.DSCycle = foreign
+				if foreign.R == nil {
+					foreign.R = &dsCycleR{}
+				}
+				foreign.R.Transactions = append(foreign.R.Transactions, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadReversalOf allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (transactionL) LoadReversalOf(ctx context.Context, e boil.ContextExecutor, singular bool, maybeTransaction interface{}, mods queries.Applicator) error {
+	var slice []*Transaction
+	var object *Transaction
+
+	if singular {
+		object = maybeTransaction.(*Transaction)
+	} else {
+		slice = *maybeTransaction.(*[]*Transaction)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &transactionR{}
+		}
+		if !queries.IsNil(object.ReversalOfID) {
+			args = append(args, object.ReversalOfID)
+		}
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &transactionR{}
+			}
+
+			for _, a := range args {
+				if queries.Equal(a, obj.ReversalOfID) {
+					continue Outer
+				}
+			}
+
+			if !queries.IsNil(obj.ReversalOfID) {
+				args = append(args, obj.ReversalOfID)
+			}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`transaction`),
+		qm.WhereIn(`transaction.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Transaction")
+	}
+
+	var resultSlice []*Transaction
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Transaction")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for transaction")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for transaction")
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.ReversalOf = foreign
+		if foreign.R == nil {
+			foreign.R = &transactionR{}
+		}
+		foreign.R.ReversalOfTransactions = append(foreign.R.ReversalOfTransactions, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if queries.Equal(local.ReversalOfID, foreign.ID) {
+				local.R.ReversalOf = foreign
+				if foreign.R == nil {
+					foreign.R = &transactionR{}
+				}
+				foreign.R.ReversalOfTransactions = append(foreign.R.ReversalOfTransactions, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadSalesRep allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (transactionL) LoadSalesRep(ctx context.Context, e boil.ContextExecutor, singular bool, maybeTransaction interface{}, mods queries.Applicator) error {
+	var slice []*Transaction
+	var object *Transaction
+
+	if singular {
+		object = maybeTransaction.(*Transaction)
+	} else {
+		slice = *maybeTransaction.(*[]*Transaction)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &transactionR{}
+		}
+		args = append(args, object.SalesRepID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &transactionR{}
+			}
+
+			for _, a := range args {
+				if a == obj.SalesRepID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.SalesRepID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`users`),
+		qm.WhereIn(`users.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load User")
+	}
+
+	var resultSlice []*User
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice User")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for users")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for users")
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.SalesRep = foreign
+		if foreign.R == nil {
+			foreign.R = &userR{}
+		}
+		foreign.R.SalesRepTransactions = append(foreign.R.SalesRepTransactions, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.SalesRepID == foreign.ID {
+				local.R.SalesRep = foreign
+				if foreign.R == nil {
+					foreign.R = &userR{}
+				}
+				foreign.R.SalesRepTransactions = append(foreign.R.SalesRepTransactions, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadTransfer allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (transactionL) LoadTransfer(ctx context.Context, e boil.ContextExecutor, singular bool, maybeTransaction interface{}, mods queries.Applicator) error {
+	var slice []*Transaction
+	var object *Transaction
+
+	if singular {
+		object = maybeTransaction.(*Transaction)
+	} else {
+		slice = *maybeTransaction.(*[]*Transaction)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &transactionR{}
+		}
+		if !queries.IsNil(object.TransferID) {
+			args = append(args, object.TransferID)
+		}
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &transactionR{}
+			}
+
+			for _, a := range args {
+				if queries.Equal(a, obj.TransferID) {
+					continue Outer
+				}
+			}
+
+			if !queries.IsNil(obj.TransferID) {
+				args = append(args, obj.TransferID)
+			}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`transfer`),
+		qm.WhereIn(`transfer.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Transfer")
+	}
+
+	var resultSlice []*Transfer
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Transfer")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for transfer")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for transfer")
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Transfer = foreign
+		if foreign.R == nil {
+			foreign.R = &transferR{}
+		}
+		foreign.R.Transactions = append(foreign.R.Transactions, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if queries.Equal(local.TransferID, foreign.ID) {
+				local.R.Transfer = foreign
+				if foreign.R == nil {
+					foreign.R = &transferR{}
+				}
+				foreign.R.Transactions = append(foreign.R.Transactions, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadApprovals allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (transactionL) LoadApprovals(ctx context.Context, e boil.ContextExecutor, singular bool, maybeTransaction interface{}, mods queries.Applicator) error {
+	var slice []*Transaction
+	var object *Transaction
+
+	if singular {
+		object = maybeTransaction.(*Transaction)
+	} else {
+		slice = *maybeTransaction.(*[]*Transaction)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &transactionR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &transactionR{}
+			}
+
+			for _, a := range args {
+				if queries.Equal(a, obj.ID) {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`approval`),
+		qm.WhereIn(`approval.transaction_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load approval")
+	}
+
+	var resultSlice []*Approval
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice approval")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on approval")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for approval")
+	}
+
+	if singular {
+		object.R.Approvals = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &approvalR{}
+			}
+			foreign.R.Transaction = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.TransactionID) {
+				local.R.Approvals = append(local.R.Approvals, foreign)
+				if foreign.R == nil {
+					foreign.R = &approvalR{}
+				}
+				foreign.R.Transaction = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadPayoutTransactionDSCycles allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (transactionL) LoadPayoutTransactionDSCycles(ctx context.Context, e boil.ContextExecutor, singular bool, maybeTransaction interface{}, mods queries.Applicator) error {
+	var slice []*Transaction
+	var object *Transaction
+
+	if singular {
+		object = maybeTransaction.(*Transaction)
+	} else {
+		slice = *maybeTransaction.(*[]*Transaction)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &transactionR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &transactionR{}
+			}
+
+			for _, a := range args {
+				if queries.Equal(a, obj.ID) {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`ds_cycle`),
+		qm.WhereIn(`ds_cycle.payout_transaction_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load ds_cycle")
+	}
+
+	var resultSlice []*DSCycle
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice ds_cycle")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on ds_cycle")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for ds_cycle")
+	}
+
+	if singular {
+		object.R.PayoutTransactionDSCycles = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &dsCycleR{}
+			}
+			foreign.R.PayoutTransaction = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.PayoutTransactionID) {
+				local.R.PayoutTransactionDSCycles = append(local.R.PayoutTransactionDSCycles, foreign)
+				if foreign.R == nil {
+					foreign.R = &dsCycleR{}
+				}
+				foreign.R.PayoutTransaction = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadImportRows allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (transactionL) LoadImportRows(ctx context.Context, e boil.ContextExecutor, singular bool, maybeTransaction interface{}, mods queries.Applicator) error {
+	var slice []*Transaction
+	var object *Transaction
+
+	if singular {
+		object = maybeTransaction.(*Transaction)
+	} else {
+		slice = *maybeTransaction.(*[]*Transaction)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &transactionR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &transactionR{}
+			}
+
+			for _, a := range args {
+				if queries.Equal(a, obj.ID) {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`import_row`),
+		qm.WhereIn(`import_row.transaction_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load import_row")
+	}
+
+	var resultSlice []*ImportRow
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice import_row")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on import_row")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for import_row")
+	}
+
+	if singular {
+		object.R.ImportRows = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &importRowR{}
+			}
+			foreign.R.Transaction = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.TransactionID) {
+				local.R.ImportRows = append(local.R.ImportRows, foreign)
+				if foreign.R == nil {
+					foreign.R = &importRowR{}
+				}
+				foreign.R.Transaction = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadDisbursementTransactionLoans allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (transactionL) LoadDisbursementTransactionLoans(ctx context.Context, e boil.ContextExecutor, singular bool, maybeTransaction interface{}, mods queries.Applicator) error {
+	var slice []*Transaction
+	var object *Transaction
+
+	if singular {
+		object = maybeTransaction.(*Transaction)
+	} else {
+		slice = *maybeTransaction.(*[]*Transaction)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &transactionR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &transactionR{}
+			}
+
+			for _, a := range args {
+				if queries.Equal(a, obj.ID) {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`loan`),
+		qm.WhereIn(`loan.disbursement_transaction_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load loan")
+	}
+
+	var resultSlice []*Loan
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice loan")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on loan")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for loan")
+	}
+
+	if singular {
+		object.R.DisbursementTransactionLoans = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &loanR{}
+			}
+			foreign.R.DisbursementTransaction = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.DisbursementTransactionID) {
+				local.R.DisbursementTransactionLoans = append(local.R.DisbursementTransactionLoans, foreign)
+				if foreign.R == nil {
+					foreign.R = &loanR{}
+				}
+				foreign.R.DisbursementTransaction = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadLoanRepayments allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (transactionL) LoadLoanRepayments(ctx context.Context, e boil.ContextExecutor, singular bool, maybeTransaction interface{}, mods queries.Applicator) error {
+	var slice []*Transaction
+	var object *Transaction
+
+	if singular {
+		object = maybeTransaction.(*Transaction)
+	} else {
+		slice = *maybeTransaction.(*[]*Transaction)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &transactionR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &transactionR{}
+			}
+
+			for _, a := range args {
+				if queries.Equal(a, obj.ID) {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`loan_repayment`),
+		qm.WhereIn(`loan_repayment.transaction_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load loan_repayment")
+	}
+
+	var resultSlice []*LoanRepayment
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice loan_repayment")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on loan_repayment")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for loan_repayment")
+	}
+
+	if singular {
+		object.R.LoanRepayments = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &loanRepaymentR{}
+			}
+			foreign.R.Transaction = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.TransactionID) {
+				local.R.LoanRepayments = append(local.R.LoanRepayments, foreign)
+				if foreign.R == nil {
+					foreign.R = &loanRepaymentR{}
+				}
+				foreign.R.Transaction = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadCorrectionOfTransactions allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (transactionL) LoadCorrectionOfTransactions(ctx context.Context, e boil.ContextExecutor, singular bool, maybeTransaction interface{}, mods queries.Applicator) error {
+	var slice []*Transaction
+	var object *Transaction
+
+	if singular {
+		object = maybeTransaction.(*Transaction)
+	} else {
+		slice = *maybeTransaction.(*[]*Transaction)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &transactionR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &transactionR{}
+			}
+
+			for _, a := range args {
+				if queries.Equal(a, obj.ID) {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`transaction`),
+		qm.WhereIn(`transaction.correction_of_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load transaction")
+	}
+
+	var resultSlice []*Transaction
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice transaction")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on transaction")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for transaction")
+	}
+
+	if singular {
+		object.R.CorrectionOfTransactions = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &transactionR{}
+			}
+			foreign.R.CorrectionOf = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.CorrectionOfID) {
+				local.R.CorrectionOfTransactions = append(local.R.CorrectionOfTransactions, foreign)
+				if foreign.R == nil {
+					foreign.R = &transactionR{}
+				}
+				foreign.R.CorrectionOf = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadReversalOfTransactions allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (transactionL) LoadReversalOfTransactions(ctx context.Context, e boil.ContextExecutor, singular bool, maybeTransaction interface{}, mods queries.Applicator) error {
+	var slice []*Transaction
+	var object *Transaction
+
+	if singular {
+		object = maybeTransaction.(*Transaction)
+	} else {
+		slice = *maybeTransaction.(*[]*Transaction)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &transactionR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &transactionR{}
+			}
+
+			for _, a := range args {
+				if queries.Equal(a, obj.ID) {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`transaction`),
+		qm.WhereIn(`transaction.reversal_of_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load transaction")
+	}
+
+	var resultSlice []*Transaction
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice transaction")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on transaction")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for transaction")
+	}
+
+	if singular {
+		object.R.ReversalOfTransactions = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &transactionR{}
+			}
+			foreign.R.ReversalOf = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.ReversalOfID) {
+				local.R.ReversalOfTransactions = append(local.R.ReversalOfTransactions, foreign)
+				if foreign.R == nil {
+					foreign.R = &transactionR{}
+				}
+				foreign.R.ReversalOf = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadWithdrawalRequests allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (transactionL) LoadWithdrawalRequests(ctx context.Context, e boil.ContextExecutor, singular bool, maybeTransaction interface{}, mods queries.Applicator) error {
+	var slice []*Transaction
+	var object *Transaction
+
+	if singular {
+		object = maybeTransaction.(*Transaction)
+	} else {
+		slice = *maybeTransaction.(*[]*Transaction)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &transactionR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &transactionR{}
+			}
+
+			for _, a := range args {
+				if queries.Equal(a, obj.ID) {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`withdrawal_request`),
+		qm.WhereIn(`withdrawal_request.transaction_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load withdrawal_request")
+	}
+
+	var resultSlice []*WithdrawalRequest
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice withdrawal_request")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on withdrawal_request")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for withdrawal_request")
+	}
+
+	if singular {
+		object.R.WithdrawalRequests = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &withdrawalRequestR{}
+			}
+			foreign.R.Transaction = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.TransactionID) {
+				local.R.WithdrawalRequests = append(local.R.WithdrawalRequests, foreign)
+				if foreign.R == nil {
+					foreign.R = &withdrawalRequestR{}
+				}
+				foreign.R.Transaction = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetAccount of the transaction to the related item.
+// Sets o.R.Account to related.
+// Adds o to related.R.Transactions.
+func (o *Transaction) SetAccount(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Account) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"transaction\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"account_id"}),
+		strmangle.WhereClause("\"", "\"", 2, transactionPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.AccountID = related.ID
+	if o.R == nil {
+		o.R = &transactionR{
+			Account: related,
+		}
+	} else {
+		o.R.Account = related
+	}
+
+	if related.R == nil {
+		related.R = &accountR{
+			Transactions: TransactionSlice{o},
+		}
+	} else {
+		related.R.Transactions = append(related.R.Transactions, o)
+	}
+
+	return nil
+}
+
+// SetApprovedBy of the transaction to the related item.
+// Sets o.R.ApprovedBy to related.
+// Adds o to related.R.ApprovedByTransactions.
+func (o *Transaction) SetApprovedBy(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"transaction\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"approved_by_id"}),
+		strmangle.WhereClause("\"", "\"", 2, transactionPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.ApprovedByID, related.ID)
+	if o.R == nil {
+		o.R = &transactionR{
+			ApprovedBy: related,
+		}
+	} else {
+		o.R.ApprovedBy = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			ApprovedByTransactions: TransactionSlice{o},
+		}
+	} else {
+		related.R.ApprovedByTransactions = append(related.R.ApprovedByTransactions, o)
+	}
+
+	return nil
+}
+
+// RemoveApprovedBy relationship.
+// Sets o.R.ApprovedBy to nil.
+// Removes o from all passed in related items' relationships struct (Optional).
+func (o *Transaction) RemoveApprovedBy(ctx context.Context, exec boil.ContextExecutor, related *User) error {
+	var err error
+
+	queries.SetScanner(&o.ApprovedByID, nil)
+	if _, err = o.Update(ctx, exec, boil.Whitelist("approved_by_id")); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	if o.R != nil {
+		o.R.ApprovedBy = nil
+	}
+	if related == nil || related.R == nil {
+		return nil
+	}
+
+	for i, ri := range related.R.ApprovedByTransactions {
+		if queries.Equal(o.ApprovedByID, ri.ApprovedByID) {
+			continue
+		}
+
+		ln := len(related.R.ApprovedByTransactions)
+		if ln > 1 && i < ln-1 {
+			related.R.ApprovedByTransactions[i] = related.R.ApprovedByTransactions[ln-1]
+		}
+		related.R.ApprovedByTransactions = related.R.ApprovedByTransactions[:ln-1]
+		break
+	}
+	return nil
+}
+
+// SetCorrectionOf of the transaction to the related item.
+// Sets o.R.CorrectionOf to related.
+// Adds o to related.R.CorrectionOfTransactions.
+func (o *Transaction) SetCorrectionOf(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Transaction) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"transaction\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"correction_of_id"}),
+		strmangle.WhereClause("\"", "\"", 2, transactionPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.CorrectionOfID, related.ID)
+	if o.R == nil {
+		o.R = &transactionR{
+			CorrectionOf: related,
+		}
+	} else {
+		o.R.CorrectionOf = related
+	}
+
+	if related.R == nil {
+		related.R = &transactionR{
+			CorrectionOfTransactions: TransactionSlice{o},
+		}
+	} else {
+		related.R.CorrectionOfTransactions = append(related.R.CorrectionOfTransactions, o)
+	}
+
+	return nil
+}
+
+// RemoveCorrectionOf relationship.
+// Sets o.R.CorrectionOf to nil.
+// Removes o from all passed in related items' relationships struct (Optional).
+func (o *Transaction) RemoveCorrectionOf(ctx context.Context, exec boil.ContextExecutor, related *Transaction) error {
+	var err error
+
+	queries.SetScanner(&o.CorrectionOfID, nil)
+	if _, err = o.Update(ctx, exec, boil.Whitelist("correction_of_id")); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	if o.R != nil {
+		o.R.CorrectionOf = nil
+	}
+	if related == nil || related.R == nil {
+		return nil
+	}
+
+	for i, ri := range related.R.CorrectionOfTransactions {
+		if queries.Equal(o.CorrectionOfID, ri.CorrectionOfID) {
+			continue
+		}
+
+		ln := len(related.R.CorrectionOfTransactions)
+		if ln > 1 && i < ln-1 {
+			related.R.CorrectionOfTransactions[i] = related.R.CorrectionOfTransactions[ln-1]
+		}
+		related.R.CorrectionOfTransactions = related.R.CorrectionOfTransactions[:ln-1]
+		break
+	}
+	return nil
+}
+
+// SetDSCycle of the transaction to the related item.
+// Sets o.R.DSCycle to related.
+// Adds o to related.R.Transactions.
+func (o *Transaction) SetDSCycle(ctx context.Context, exec boil.ContextExecutor, insert bool, related *DSCycle) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"transaction\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"ds_cycle_id"}),
+		strmangle.WhereClause("\"", "\"", 2, transactionPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.DSCycleID, related.ID)
+	if o.R == nil {
+		o.R = &transactionR{
+			DSCycle: related,
+		}
+	} else {
+		o.R.DSCycle = related
+	}
+
+	if related.R == nil {
+		related.R = &dsCycleR{
+			Transactions: TransactionSlice{o},
+		}
+	} else {
+		related.R.Transactions = append(related.R.Transactions, o)
+	}
+
+	return nil
+}
+
+// RemoveDSCycle relationship.
+// Sets o.R.DSCycle to nil.
+// Removes o from all passed in related items' relationships struct (Optional).
+func (o *Transaction) RemoveDSCycle(ctx context.Context, exec boil.ContextExecutor, related *DSCycle) error {
+	var err error
+
+	queries.SetScanner(&o.DSCycleID, nil)
+	if _, err = o.Update(ctx, exec, boil.Whitelist("ds_cycle_id")); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	if o.R != nil {
+		o.R.DSCycle = nil
+	}
+	if related == nil || related.R == nil {
+		return nil
+	}
+
+	for i, ri := range related.R.Transactions {
+		if queries.Equal(o.DSCycleID, ri.DSCycleID) {
+			continue
+		}
+
+		ln := len(related.R.Transactions)
+		if ln > 1 && i < ln-1 {
+			related.R.Transactions[i] = related.R.Transactions[ln-1]
+		}
+		related.R.Transactions = related.R.Transactions[:ln-1]
+		break
+	}
+	return nil
+}
+
+// SetReversalOf of the transaction to the related item.
+// Sets o.R.ReversalOf to related.
+// Adds o to related.R.ReversalOfTransactions.
+func (o *Transaction) SetReversalOf(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Transaction) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"transaction\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"reversal_of_id"}),
+		strmangle.WhereClause("\"", "\"", 2, transactionPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.ReversalOfID, related.ID)
+	if o.R == nil {
+		o.R = &transactionR{
+			ReversalOf: related,
+		}
+	} else {
+		o.R.ReversalOf = related
+	}
+
+	if related.R == nil {
+		related.R = &transactionR{
+			ReversalOfTransactions: TransactionSlice{o},
+		}
+	} else {
+		related.R.ReversalOfTransactions = append(related.R.ReversalOfTransactions, o)
+	}
+
+	return nil
+}
+
+// RemoveReversalOf relationship.
+// Sets o.R.ReversalOf to nil.
+// Removes o from all passed in related items' relationships struct (Optional).
+func (o *Transaction) RemoveReversalOf(ctx context.Context, exec boil.ContextExecutor, related *Transaction) error {
+	var err error
+
+	queries.SetScanner(&o.ReversalOfID, nil)
+	if _, err = o.Update(ctx, exec, boil.Whitelist("reversal_of_id")); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	if o.R != nil {
+		o.R.ReversalOf = nil
+	}
+	if related == nil || related.R == nil {
+		return nil
+	}
+
+	for i, ri := range related.R.ReversalOfTransactions {
+		if queries.Equal(o.ReversalOfID, ri.ReversalOfID) {
+			continue
+		}
+
+		ln := len(related.R.ReversalOfTransactions)
+		if ln > 1 && i < ln-1 {
+			related.R.ReversalOfTransactions[i] = related.R.ReversalOfTransactions[ln-1]
+		}
+		related.R.ReversalOfTransactions = related.R.ReversalOfTransactions[:ln-1]
+		break
+	}
+	return nil
+}
+
+// SetSalesRep of the transaction to the related item.
+// Sets o.R.SalesRep to related.
+// Adds o to related.R.SalesRepTransactions.
+func (o *Transaction) SetSalesRep(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"transaction\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"sales_rep_id"}),
+		strmangle.WhereClause("\"", "\"", 2, transactionPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.SalesRepID = related.ID
+	if o.R == nil {
+		o.R = &transactionR{
+			SalesRep: related,
+		}
+	} else {
+		o.R.SalesRep = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			SalesRepTransactions: TransactionSlice{o},
+		}
+	} else {
+		related.R.SalesRepTransactions = append(related.R.SalesRepTransactions, o)
+	}
+
+	return nil
+}
+
+// SetTransfer of the transaction to the related item.
+// Sets o.R.Transfer to related.
+// Adds o to related.R.Transactions.
+func (o *Transaction) SetTransfer(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Transfer) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"transaction\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"transfer_id"}),
+		strmangle.WhereClause("\"", "\"", 2, transactionPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.TransferID, related.ID)
+	if o.R == nil {
+		o.R = &transactionR{
+			Transfer: related,
+		}
+	} else {
+		o.R.Transfer = related
+	}
+
+	if related.R == nil {
+		related.R = &transferR{
+			Transactions: TransactionSlice{o},
+		}
+	} else {
+		related.R.Transactions = append(related.R.Transactions, o)
+	}
+
+	return nil
+}
+
+// RemoveTransfer relationship.
+// Sets o.R.Transfer to nil.
+// Removes o from all passed in related items' relationships struct (Optional).
+func (o *Transaction) RemoveTransfer(ctx context.Context, exec boil.ContextExecutor, related *Transfer) error {
+	var err error
+
+	queries.SetScanner(&o.TransferID, nil)
+	if _, err = o.Update(ctx, exec, boil.Whitelist("transfer_id")); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	if o.R != nil {
+		o.R.Transfer = nil
+	}
+	if related == nil || related.R == nil {
+		return nil
+	}
+
+	for i, ri := range related.R.Transactions {
+		if queries.Equal(o.TransferID, ri.TransferID) {
+			continue
+		}
+
+		ln := len(related.R.Transactions)
+		if ln > 1 && i < ln-1 {
+			related.R.Transactions[i] = related.R.Transactions[ln-1]
+		}
+		related.R.Transactions = related.R.Transactions[:ln-1]
+		break
+	}
+	return nil
+}
+
+// AddApprovals adds the given related objects to the existing relationships
+// of the transaction, optionally inserting them as new records.
+// Appends related to o.R.Approvals.
+// Sets related.R.Transaction appropriately.
+func (o *Transaction) AddApprovals(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Approval) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.TransactionID, o.ID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"approval\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"transaction_id"}),
+				strmangle.WhereClause("\"", "\"", 2, approvalPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.TransactionID, o.ID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &transactionR{
+			Approvals: related,
+		}
+	} else {
+		o.R.Approvals = append(o.R.Approvals, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &approvalR{
+				Transaction: o,
+			}
+		} else {
+			rel.R.Transaction = o
+		}
+	}
+	return nil
+}
+
+// SetApprovals removes all previously related items of the
+// transaction replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.Transaction's Approvals accordingly.
+// Replaces o.R.Approvals with related.
+// Sets related.R.Transaction's Approvals accordingly.
+func (o *Transaction) SetApprovals(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Approval) error {
+	query := "update \"approval\" set \"transaction_id\" = null where \"transaction_id\" = $1"
+	values := []interface{}{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.Approvals {
+			queries.SetScanner(&rel.TransactionID, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.Transaction = nil
+		}
+
+		o.R.Approvals = nil
+	}
+	return o.AddApprovals(ctx, exec, insert, related...)
+}
+
+// RemoveApprovals relationships from objects passed in.
+// Removes related items from R.Approvals (uses pointer comparison, removal does not keep order)
+// Sets related.R.Transaction.
+func (o *Transaction) RemoveApprovals(ctx context.Context, exec boil.ContextExecutor, related ...*Approval) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.TransactionID, nil)
+		if rel.R != nil {
+			rel.R.Transaction = nil
+		}
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("transaction_id")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.Approvals {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.Approvals)
+			if ln > 1 && i < ln-1 {
+				o.R.Approvals[i] = o.R.Approvals[ln-1]
+			}
+			o.R.Approvals = o.R.Approvals[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
+// AddPayoutTransactionDSCycles adds the given related objects to the existing relationships
+// of the transaction, optionally inserting them as new records.
+// Appends related to o.R.PayoutTransactionDSCycles.
+// Sets related.R.PayoutTransaction appropriately.
+func (o *Transaction) AddPayoutTransactionDSCycles(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*DSCycle) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.PayoutTransactionID, o.ID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"ds_cycle\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"payout_transaction_id"}),
+				strmangle.WhereClause("\"", "\"", 2, dsCyclePrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.PayoutTransactionID, o.ID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &transactionR{
+			PayoutTransactionDSCycles: related,
+		}
+	} else {
+		o.R.PayoutTransactionDSCycles = append(o.R.PayoutTransactionDSCycles, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &dsCycleR{
+				PayoutTransaction: o,
+			}
+		} else {
+			rel.R.PayoutTransaction = o
+		}
+	}
+	return nil
+}
+
+// SetPayoutTransactionDSCycles removes all previously related items of the
+// transaction replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.PayoutTransaction's PayoutTransactionDSCycles accordingly.
+// Replaces o.R.PayoutTransactionDSCycles with related.
+// Sets related.R.PayoutTransaction's PayoutTransactionDSCycles accordingly.
+func (o *Transaction) SetPayoutTransactionDSCycles(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*DSCycle) error {
+	query := "update \"ds_cycle\" set \"payout_transaction_id\" = null where \"payout_transaction_id\" = $1"
+	values := []interface{}{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.PayoutTransactionDSCycles {
+			queries.SetScanner(&rel.PayoutTransactionID, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.PayoutTransaction = nil
+		}
+
+		o.R.PayoutTransactionDSCycles = nil
+	}
+	return o.AddPayoutTransactionDSCycles(ctx, exec, insert, related...)
+}
+
+// RemovePayoutTransactionDSCycles relationships from objects passed in.
+// Removes related items from R.PayoutTransactionDSCycles (uses pointer comparison, removal does not keep order)
+// Sets related.R.PayoutTransaction.
+func (o *Transaction) RemovePayoutTransactionDSCycles(ctx context.Context, exec boil.ContextExecutor, related ...*DSCycle) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.PayoutTransactionID, nil)
+		if rel.R != nil {
+			rel.R.PayoutTransaction = nil
+		}
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("payout_transaction_id")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.PayoutTransactionDSCycles {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.PayoutTransactionDSCycles)
+			if ln > 1 && i < ln-1 {
+				o.R.PayoutTransactionDSCycles[i] = o.R.PayoutTransactionDSCycles[ln-1]
+			}
+			o.R.PayoutTransactionDSCycles = o.R.PayoutTransactionDSCycles[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
+// AddImportRows adds the given related objects to the existing relationships
+// of the transaction, optionally inserting them as new records.
+// Appends related to o.R.ImportRows.
+// Sets related.R.Transaction appropriately.
+func (o *Transaction) AddImportRows(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*ImportRow) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.TransactionID, o.ID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"import_row\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"transaction_id"}),
+				strmangle.WhereClause("\"", "\"", 2, importRowPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.TransactionID, o.ID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &transactionR{
+			ImportRows: related,
+		}
+	} else {
+		o.R.ImportRows = append(o.R.ImportRows, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &importRowR{
+				Transaction: o,
+			}
+		} else {
+			rel.R.Transaction = o
+		}
+	}
+	return nil
+}
+
+// SetImportRows removes all previously related items of the
+// transaction replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.Transaction's ImportRows accordingly.
+// Replaces o.R.ImportRows with related.
+// Sets related.R.Transaction's ImportRows accordingly.
+func (o *Transaction) SetImportRows(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*ImportRow) error {
+	query := "update \"import_row\" set \"transaction_id\" = null where \"transaction_id\" = $1"
+	values := []interface{}{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.ImportRows {
+			queries.SetScanner(&rel.TransactionID, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.Transaction = nil
+		}
+
+		o.R.ImportRows = nil
+	}
+	return o.AddImportRows(ctx, exec, insert, related...)
+}
+
+// RemoveImportRows relationships from objects passed in.
+// Removes related items from R.ImportRows (uses pointer comparison, removal does not keep order)
+// Sets related.R.Transaction.
+func (o *Transaction) RemoveImportRows(ctx context.Context, exec boil.ContextExecutor, related ...*ImportRow) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.TransactionID, nil)
+		if rel.R != nil {
+			rel.R.Transaction = nil
+		}
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("transaction_id")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.ImportRows {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.ImportRows)
+			if ln > 1 && i < ln-1 {
+				o.R.ImportRows[i] = o.R.ImportRows[ln-1]
+			}
+			o.R.ImportRows = o.R.ImportRows[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
+// AddDisbursementTransactionLoans adds the given related objects to the existing relationships
+// of the transaction, optionally inserting them as new records.
+// Appends related to o.R.DisbursementTransactionLoans.
+// Sets related.R.DisbursementTransaction appropriately.
+func (o *Transaction) AddDisbursementTransactionLoans(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Loan) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.DisbursementTransactionID, o.ID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"loan\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"disbursement_transaction_id"}),
+				strmangle.WhereClause("\"", "\"", 2, loanPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.DisbursementTransactionID, o.ID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &transactionR{
+			DisbursementTransactionLoans: related,
+		}
+	} else {
+		o.R.DisbursementTransactionLoans = append(o.R.DisbursementTransactionLoans, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &loanR{
+				DisbursementTransaction: o,
+			}
+		} else {
+			rel.R.DisbursementTransaction = o
+		}
+	}
+	return nil
+}
+
+// SetDisbursementTransactionLoans removes all previously related items of the
+// transaction replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.DisbursementTransaction's DisbursementTransactionLoans accordingly.
+// Replaces o.R.DisbursementTransactionLoans with related.
+// Sets related.R.DisbursementTransaction's DisbursementTransactionLoans accordingly.
+func (o *Transaction) SetDisbursementTransactionLoans(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Loan) error {
+	query := "update \"loan\" set \"disbursement_transaction_id\" = null where \"disbursement_transaction_id\" = $1"
+	values := []interface{}{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.DisbursementTransactionLoans {
+			queries.SetScanner(&rel.DisbursementTransactionID, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.DisbursementTransaction = nil
+		}
+
+		o.R.DisbursementTransactionLoans = nil
+	}
+	return o.AddDisbursementTransactionLoans(ctx, exec, insert, related...)
+}
+
+// RemoveDisbursementTransactionLoans relationships from objects passed in.
+// Removes related items from R.DisbursementTransactionLoans (uses pointer comparison, removal does not keep order)
+// Sets related.R.DisbursementTransaction.
+func (o *Transaction) RemoveDisbursementTransactionLoans(ctx context.Context, exec boil.ContextExecutor, related ...*Loan) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.DisbursementTransactionID, nil)
+		if rel.R != nil {
+			rel.R.DisbursementTransaction = nil
+		}
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("disbursement_transaction_id")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.DisbursementTransactionLoans {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.DisbursementTransactionLoans)
+			if ln > 1 && i < ln-1 {
+				o.R.DisbursementTransactionLoans[i] = o.R.DisbursementTransactionLoans[ln-1]
+			}
+			o.R.DisbursementTransactionLoans = o.R.DisbursementTransactionLoans[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
+// AddLoanRepayments adds the given related objects to the existing relationships
+// of the transaction, optionally inserting them as new records.
+// Appends related to o.R.LoanRepayments.
+// Sets related.R.Transaction appropriately.
+func (o *Transaction) AddLoanRepayments(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*LoanRepayment) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.TransactionID, o.ID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"loan_repayment\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"transaction_id"}),
+				strmangle.WhereClause("\"", "\"", 2, loanRepaymentPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.TransactionID, o.ID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &transactionR{
+			LoanRepayments: related,
+		}
+	} else {
+		o.R.LoanRepayments = append(o.R.LoanRepayments, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &loanRepaymentR{
+				Transaction: o,
+			}
+		} else {
+			rel.R.Transaction = o
+		}
+	}
+	return nil
+}
+
+// SetLoanRepayments removes all previously related items of the
+// transaction replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.Transaction's LoanRepayments accordingly.
+// Replaces o.R.LoanRepayments with related.
+// Sets related.R.Transaction's LoanRepayments accordingly.
+func (o *Transaction) SetLoanRepayments(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*LoanRepayment) error {
+	query := "update \"loan_repayment\" set \"transaction_id\" = null where \"transaction_id\" = $1"
+	values := []interface{}{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.LoanRepayments {
+			queries.SetScanner(&rel.TransactionID, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.Transaction = nil
+		}
+
+		o.R.LoanRepayments = nil
+	}
+	return o.AddLoanRepayments(ctx, exec, insert, related...)
+}
+
+// RemoveLoanRepayments relationships from objects passed in.
+// Removes related items from R.LoanRepayments (uses pointer comparison, removal does not keep order)
+// Sets related.R.Transaction.
+func (o *Transaction) RemoveLoanRepayments(ctx context.Context, exec boil.ContextExecutor, related ...*LoanRepayment) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.TransactionID, nil)
+		if rel.R != nil {
+			rel.R.Transaction = nil
+		}
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("transaction_id")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.LoanRepayments {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.LoanRepayments)
+			if ln > 1 && i < ln-1 {
+				o.R.LoanRepayments[i] = o.R.LoanRepayments[ln-1]
+			}
+			o.R.LoanRepayments = o.R.LoanRepayments[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
+// AddCorrectionOfTransactions adds the given related objects to the existing relationships
+// of the transaction, optionally inserting them as new records.
+// Appends related to o.R.CorrectionOfTransactions.
+// Sets related.R.CorrectionOf appropriately.
+func (o *Transaction) AddCorrectionOfTransactions(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Transaction) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.CorrectionOfID, o.ID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"transaction\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"correction_of_id"}),
+				strmangle.WhereClause("\"", "\"", 2, transactionPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.CorrectionOfID, o.ID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &transactionR{
+			CorrectionOfTransactions: related,
+		}
+	} else {
+		o.R.CorrectionOfTransactions = append(o.R.CorrectionOfTransactions, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &transactionR{
+				CorrectionOf: o,
+			}
+		} else {
+			rel.R.CorrectionOf = o
+		}
+	}
+	return nil
+}
+
+// SetCorrectionOfTransactions removes all previously related items of the
+// transaction replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.CorrectionOf's CorrectionOfTransactions accordingly.
+// Replaces o.R.CorrectionOfTransactions with related.
+// Sets related.R.CorrectionOf's CorrectionOfTransactions accordingly.
+func (o *Transaction) SetCorrectionOfTransactions(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Transaction) error {
+	query := "update \"transaction\" set \"correction_of_id\" = null where \"correction_of_id\" = $1"
+	values := []interface{}{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.CorrectionOfTransactions {
+			queries.SetScanner(&rel.CorrectionOfID, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.CorrectionOf = nil
+		}
+
+		o.R.CorrectionOfTransactions = nil
+	}
+	return o.AddCorrectionOfTransactions(ctx, exec, insert, related...)
+}
+
+// RemoveCorrectionOfTransactions relationships from objects passed in.
+// Removes related items from R.CorrectionOfTransactions (uses pointer comparison, removal does not keep order)
+// Sets related.R.CorrectionOf.
+func (o *Transaction) RemoveCorrectionOfTransactions(ctx context.Context, exec boil.ContextExecutor, related ...*Transaction) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.CorrectionOfID, nil)
+		if rel.R != nil {
+			rel.R.CorrectionOf = nil
+		}
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("correction_of_id")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.CorrectionOfTransactions {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.CorrectionOfTransactions)
+			if ln > 1 && i < ln-1 {
+				o.R.CorrectionOfTransactions[i] = o.R.CorrectionOfTransactions[ln-1]
+			}
+			o.R.CorrectionOfTransactions = o.R.CorrectionOfTransactions[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
+// AddReversalOfTransactions adds the given related objects to the existing relationships
+// of the transaction, optionally inserting them as new records.
+// Appends related to o.R.ReversalOfTransactions.
+// Sets related.R.ReversalOf appropriately.
+func (o *Transaction) AddReversalOfTransactions(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Transaction) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.ReversalOfID, o.ID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"transaction\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"reversal_of_id"}),
+				strmangle.WhereClause("\"", "\"", 2, transactionPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.ReversalOfID, o.ID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &transactionR{
+			ReversalOfTransactions: related,
+		}
+	} else {
+		o.R.ReversalOfTransactions = append(o.R.ReversalOfTransactions, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &transactionR{
+				ReversalOf: o,
+			}
+		} else {
+			rel.R.ReversalOf = o
+		}
+	}
+	return nil
+}
+
+// SetReversalOfTransactions removes all previously related items of the
+// transaction replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.ReversalOf's ReversalOfTransactions accordingly.
+// Replaces o.R.ReversalOfTransactions with related.
+// Sets related.R.ReversalOf's ReversalOfTransactions accordingly.
+func (o *Transaction) SetReversalOfTransactions(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Transaction) error {
+	query := "update \"transaction\" set \"reversal_of_id\" = null where \"reversal_of_id\" = $1"
+	values := []interface{}{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.ReversalOfTransactions {
+			queries.SetScanner(&rel.ReversalOfID, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.ReversalOf = nil
+		}
+
+		o.R.ReversalOfTransactions = nil
+	}
+	return o.AddReversalOfTransactions(ctx, exec, insert, related...)
+}
+
+// RemoveReversalOfTransactions relationships from objects passed in.
+// Removes related items from R.ReversalOfTransactions (uses pointer comparison, removal does not keep order)
+// Sets related.R.ReversalOf.
+func (o *Transaction) RemoveReversalOfTransactions(ctx context.Context, exec boil.ContextExecutor, related ...*Transaction) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.ReversalOfID, nil)
+		if rel.R != nil {
+			rel.R.ReversalOf = nil
+		}
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("reversal_of_id")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.ReversalOfTransactions {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.ReversalOfTransactions)
+			if ln > 1 && i < ln-1 {
+				o.R.ReversalOfTransactions[i] = o.R.ReversalOfTransactions[ln-1]
+			}
+			o.R.ReversalOfTransactions = o.R.ReversalOfTransactions[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
+// AddWithdrawalRequests adds the given related objects to the existing relationships
+// of the transaction, optionally inserting them as new records.
+// Appends related to o.R.WithdrawalRequests.
+// Sets related.R.Transaction appropriately.
+func (o *Transaction) AddWithdrawalRequests(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*WithdrawalRequest) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.TransactionID, o.ID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"withdrawal_request\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"transaction_id"}),
+				strmangle.WhereClause("\"", "\"", 2, withdrawalRequestPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.TransactionID, o.ID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &transactionR{
+			WithdrawalRequests: related,
+		}
+	} else {
+		o.R.WithdrawalRequests = append(o.R.WithdrawalRequests, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &withdrawalRequestR{
+				Transaction: o,
+			}
+		} else {
+			rel.R.Transaction = o
+		}
+	}
+	return nil
+}
+
+// SetWithdrawalRequests removes all previously related items of the
+// transaction replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.Transaction's WithdrawalRequests accordingly.
+// Replaces o.R.WithdrawalRequests with related.
+// Sets related.R.Transaction's WithdrawalRequests accordingly.
+func (o *Transaction) SetWithdrawalRequests(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*WithdrawalRequest) error {
+	query := "update \"withdrawal_request\" set \"transaction_id\" = null where \"transaction_id\" = $1"
+	values := []interface{}{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.WithdrawalRequests {
+			queries.SetScanner(&rel.TransactionID, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.Transaction = nil
+		}
+
+		o.R.WithdrawalRequests = nil
+	}
+	return o.AddWithdrawalRequests(ctx, exec, insert, related...)
+}
+
+// RemoveWithdrawalRequests relationships from objects passed in.
+// Removes related items from R.WithdrawalRequests (uses pointer comparison, removal does not keep order)
+// Sets related.R.Transaction.
+func (o *Transaction) RemoveWithdrawalRequests(ctx context.Context, exec boil.ContextExecutor, related ...*WithdrawalRequest) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.TransactionID, nil)
+		if rel.R != nil {
+			rel.R.Transaction = nil
+		}
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("transaction_id")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.WithdrawalRequests {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.WithdrawalRequests)
+			if ln > 1 && i < ln-1 {
+				o.R.WithdrawalRequests[i] = o.R.WithdrawalRequests[ln-1]
+			}
+			o.R.WithdrawalRequests = o.R.WithdrawalRequests[:ln-1]
+			break
 		}
-	} else {
-		related.R.SalesRepTransactions = append(related.R.SalesRepTransactions, o)
 	}
 
 	return nil
@@ -353,109 +353,3372 @@ func testTransactionsInsertWhitelist(t *testing.T) {
 	}
 }
 
+func testTransactionToManyApprovals(t *testing.T) {
+	var err error
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Transaction
+	var b, c Approval
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, transactionDBTypes, true, transactionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Transaction struct: %s", err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = randomize.Struct(seed, &b, approvalDBTypes, false, approvalColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, approvalDBTypes, false, approvalColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+
+	queries.Assign(&b.TransactionID, a.ID)
+	queries.Assign(&c.TransactionID, a.ID)
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := a.Approvals().All(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bFound, cFound := false, false
+	for _, v := range check {
+		if queries.Equal(v.TransactionID, b.TransactionID) {
+			bFound = true
+		}
+		if queries.Equal(v.TransactionID, c.TransactionID) {
+			cFound = true
+		}
+	}
+
+	if !bFound {
+		t.Error("expected to find b")
+	}
+	if !cFound {
+		t.Error("expected to find c")
+	}
+
+	slice := TransactionSlice{&a}
+	if err = a.L.LoadApprovals(ctx, tx, false, (*[]*Transaction)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.Approvals); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	a.R.Approvals = nil
+	if err = a.L.LoadApprovals(ctx, tx, true, &a, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.Approvals); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	if t.Failed() {
+		t.Logf("%#v", check)
+	}
+}
+
+func testTransactionToManyPayoutTransactionDSCycles(t *testing.T) {
+	var err error
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Transaction
+	var b, c DSCycle
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, transactionDBTypes, true, transactionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Transaction struct: %s", err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = randomize.Struct(seed, &b, dsCycleDBTypes, false, dsCycleColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, dsCycleDBTypes, false, dsCycleColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+
+	queries.Assign(&b.PayoutTransactionID, a.ID)
+	queries.Assign(&c.PayoutTransactionID, a.ID)
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := a.PayoutTransactionDSCycles().All(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bFound, cFound := false, false
+	for _, v := range check {
+		if queries.Equal(v.PayoutTransactionID, b.PayoutTransactionID) {
+			bFound = true
+		}
+		if queries.Equal(v.PayoutTransactionID, c.PayoutTransactionID) {
+			cFound = true
+		}
+	}
+
+	if !bFound {
+		t.Error("expected to find b")
+	}
+	if !cFound {
+		t.Error("expected to find c")
+	}
+
+	slice := TransactionSlice{&a}
+	if err = a.L.LoadPayoutTransactionDSCycles(ctx, tx, false, (*[]*Transaction)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.PayoutTransactionDSCycles); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	a.R.PayoutTransactionDSCycles = nil
+	if err = a.L.LoadPayoutTransactionDSCycles(ctx, tx, true, &a, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.PayoutTransactionDSCycles); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	if t.Failed() {
+		t.Logf("%#v", check)
+	}
+}
+
+func testTransactionToManyImportRows(t *testing.T) {
+	var err error
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Transaction
+	var b, c ImportRow
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, transactionDBTypes, true, transactionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Transaction struct: %s", err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = randomize.Struct(seed, &b, importRowDBTypes, false, importRowColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, importRowDBTypes, false, importRowColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+
+	queries.Assign(&b.TransactionID, a.ID)
+	queries.Assign(&c.TransactionID, a.ID)
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := a.ImportRows().All(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bFound, cFound := false, false
+	for _, v := range check {
+		if queries.Equal(v.TransactionID, b.TransactionID) {
+			bFound = true
+		}
+		if queries.Equal(v.TransactionID, c.TransactionID) {
+			cFound = true
+		}
+	}
+
+	if !bFound {
+		t.Error("expected to find b")
+	}
+	if !cFound {
+		t.Error("expected to find c")
+	}
+
+	slice := TransactionSlice{&a}
+	if err = a.L.LoadImportRows(ctx, tx, false, (*[]*Transaction)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.ImportRows); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	a.R.ImportRows = nil
+	if err = a.L.LoadImportRows(ctx, tx, true, &a, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.ImportRows); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	if t.Failed() {
+		t.Logf("%#v", check)
+	}
+}
+
+func testTransactionToManyDisbursementTransactionLoans(t *testing.T) {
+	var err error
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Transaction
+	var b, c Loan
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, transactionDBTypes, true, transactionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Transaction struct: %s", err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = randomize.Struct(seed, &b, loanDBTypes, false, loanColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, loanDBTypes, false, loanColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+
+	queries.Assign(&b.DisbursementTransactionID, a.ID)
+	queries.Assign(&c.DisbursementTransactionID, a.ID)
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := a.DisbursementTransactionLoans().All(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bFound, cFound := false, false
+	for _, v := range check {
+		if queries.Equal(v.DisbursementTransactionID, b.DisbursementTransactionID) {
+			bFound = true
+		}
+		if queries.Equal(v.DisbursementTransactionID, c.DisbursementTransactionID) {
+			cFound = true
+		}
+	}
+
+	if !bFound {
+		t.Error("expected to find b")
+	}
+	if !cFound {
+		t.Error("expected to find c")
+	}
+
+	slice := TransactionSlice{&a}
+	if err = a.L.LoadDisbursementTransactionLoans(ctx, tx, false, (*[]*Transaction)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.DisbursementTransactionLoans); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	a.R.DisbursementTransactionLoans = nil
+	if err = a.L.LoadDisbursementTransactionLoans(ctx, tx, true, &a, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.DisbursementTransactionLoans); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	if t.Failed() {
+		t.Logf("%#v", check)
+	}
+}
+
+func testTransactionToManyLoanRepayments(t *testing.T) {
+	var err error
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Transaction
+	var b, c LoanRepayment
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, transactionDBTypes, true, transactionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Transaction struct: %s", err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = randomize.Struct(seed, &b, loanRepaymentDBTypes, false, loanRepaymentColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, loanRepaymentDBTypes, false, loanRepaymentColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+
+	queries.Assign(&b.TransactionID, a.ID)
+	queries.Assign(&c.TransactionID, a.ID)
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := a.LoanRepayments().All(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bFound, cFound := false, false
+	for _, v := range check {
+		if queries.Equal(v.TransactionID, b.TransactionID) {
+			bFound = true
+		}
+		if queries.Equal(v.TransactionID, c.TransactionID) {
+			cFound = true
+		}
+	}
+
+	if !bFound {
+		t.Error("expected to find b")
+	}
+	if !cFound {
+		t.Error("expected to find c")
+	}
+
+	slice := TransactionSlice{&a}
+	if err = a.L.LoadLoanRepayments(ctx, tx, false, (*[]*Transaction)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.LoanRepayments); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	a.R.LoanRepayments = nil
+	if err = a.L.LoadLoanRepayments(ctx, tx, true, &a, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.LoanRepayments); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	if t.Failed() {
+		t.Logf("%#v", check)
+	}
+}
+
+func testTransactionToManyCorrectionOfTransactions(t *testing.T) {
+	var err error
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Transaction
+	var b, c Transaction
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, transactionDBTypes, true, transactionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Transaction struct: %s", err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = randomize.Struct(seed, &b, transactionDBTypes, false, transactionColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, transactionDBTypes, false, transactionColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+
+	queries.Assign(&b.CorrectionOfID, a.ID)
+	queries.Assign(&c.CorrectionOfID, a.ID)
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := a.CorrectionOfTransactions().All(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bFound, cFound := false, false
+	for _, v := range check {
+		if queries.Equal(v.CorrectionOfID, b.CorrectionOfID) {
+			bFound = true
+		}
+		if queries.Equal(v.CorrectionOfID, c.CorrectionOfID) {
+			cFound = true
+		}
+	}
+
+	if !bFound {
+		t.Error("expected to find b")
+	}
+	if !cFound {
+		t.Error("expected to find c")
+	}
+
+	slice := TransactionSlice{&a}
+	if err = a.L.LoadCorrectionOfTransactions(ctx, tx, false, (*[]*Transaction)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.CorrectionOfTransactions); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	a.R.CorrectionOfTransactions = nil
+	if err = a.L.LoadCorrectionOfTransactions(ctx, tx, true, &a, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.CorrectionOfTransactions); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	if t.Failed() {
+		t.Logf("%#v", check)
+	}
+}
+
+func testTransactionToManyReversalOfTransactions(t *testing.T) {
+	var err error
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Transaction
+	var b, c Transaction
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, transactionDBTypes, true, transactionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Transaction struct: %s", err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = randomize.Struct(seed, &b, transactionDBTypes, false, transactionColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, transactionDBTypes, false, transactionColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+
+	queries.Assign(&b.ReversalOfID, a.ID)
+	queries.Assign(&c.ReversalOfID, a.ID)
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := a.ReversalOfTransactions().All(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bFound, cFound := false, false
+	for _, v := range check {
+		if queries.Equal(v.ReversalOfID, b.ReversalOfID) {
+			bFound = true
+		}
+		if queries.Equal(v.ReversalOfID, c.ReversalOfID) {
+			cFound = true
+		}
+	}
+
+	if !bFound {
+		t.Error("expected to find b")
+	}
+	if !cFound {
+		t.Error("expected to find c")
+	}
+
+	slice := TransactionSlice{&a}
+	if err = a.L.LoadReversalOfTransactions(ctx, tx, false, (*[]*Transaction)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.ReversalOfTransactions); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	a.R.ReversalOfTransactions = nil
+	if err = a.L.LoadReversalOfTransactions(ctx, tx, true, &a, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.ReversalOfTransactions); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	if t.Failed() {
+		t.Logf("%#v", check)
+	}
+}
+
+func testTransactionToManyWithdrawalRequests(t *testing.T) {
+	var err error
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Transaction
+	var b, c WithdrawalRequest
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, transactionDBTypes, true, transactionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Transaction struct: %s", err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = randomize.Struct(seed, &b, withdrawalRequestDBTypes, false, withdrawalRequestColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, withdrawalRequestDBTypes, false, withdrawalRequestColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+
+	queries.Assign(&b.TransactionID, a.ID)
+	queries.Assign(&c.TransactionID, a.ID)
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := a.WithdrawalRequests().All(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bFound, cFound := false, false
+	for _, v := range check {
+		if queries.Equal(v.TransactionID, b.TransactionID) {
+			bFound = true
+		}
+		if queries.Equal(v.TransactionID, c.TransactionID) {
+			cFound = true
+		}
+	}
+
+	if !bFound {
+		t.Error("expected to find b")
+	}
+	if !cFound {
+		t.Error("expected to find c")
+	}
+
+	slice := TransactionSlice{&a}
+	if err = a.L.LoadWithdrawalRequests(ctx, tx, false, (*[]*Transaction)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.WithdrawalRequests); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	a.R.WithdrawalRequests = nil
+	if err = a.L.LoadWithdrawalRequests(ctx, tx, true, &a, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.WithdrawalRequests); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	if t.Failed() {
+		t.Logf("%#v", check)
+	}
+}
+
+func testTransactionToManyAddOpApprovals(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Transaction
+	var b, c, d, e Approval
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, transactionDBTypes, false, strmangle.SetComplement(transactionPrimaryKeyColumns, transactionColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*Approval{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, approvalDBTypes, false, strmangle.SetComplement(approvalPrimaryKeyColumns, approvalColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	foreignersSplitByInsertion := [][]*Approval{
+		{&b, &c},
+		{&d, &e},
+	}
+
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddApprovals(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		first := x[0]
+		second := x[1]
+
+		if !queries.Equal(a.ID, first.TransactionID) {
+			t.Error("foreign key was wrong value", a.ID, first.TransactionID)
+		}
+		if !queries.Equal(a.ID, second.TransactionID) {
+			t.Error("foreign key was wrong value", a.ID, second.TransactionID)
+		}
+
+		if first.R.Transaction != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.Transaction != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+
+		if a.R.Approvals[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.Approvals[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
+
+		count, err := a.Approvals().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
+	}
+}
+
+func testTransactionToManySetOpApprovals(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Transaction
+	var b, c, d, e Approval
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, transactionDBTypes, false, strmangle.SetComplement(transactionPrimaryKeyColumns, transactionColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*Approval{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, approvalDBTypes, false, strmangle.SetComplement(approvalPrimaryKeyColumns, approvalColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err = a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	err = a.SetApprovals(ctx, tx, false, &b, &c)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err := a.Approvals().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	err = a.SetApprovals(ctx, tx, true, &d, &e)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err = a.Approvals().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	if !queries.IsValuerNil(b.TransactionID) {
+		t.Error("want b's foreign key value to be nil")
+	}
+	if !queries.IsValuerNil(c.TransactionID) {
+		t.Error("want c's foreign key value to be nil")
+	}
+	if !queries.Equal(a.ID, d.TransactionID) {
+		t.Error("foreign key was wrong value", a.ID, d.TransactionID)
+	}
+	if !queries.Equal(a.ID, e.TransactionID) {
+		t.Error("foreign key was wrong value", a.ID, e.TransactionID)
+	}
+
+	if b.R.Transaction != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if c.R.Transaction != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if d.R.Transaction != &a {
+		t.Error("relationship was not added properly to the foreign struct")
+	}
+	if e.R.Transaction != &a {
+		t.Error("relationship was not added properly to the foreign struct")
+	}
+
+	if a.R.Approvals[0] != &d {
+		t.Error("relationship struct slice not set to correct value")
+	}
+	if a.R.Approvals[1] != &e {
+		t.Error("relationship struct slice not set to correct value")
+	}
+}
+
+func testTransactionToManyRemoveOpApprovals(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Transaction
+	var b, c, d, e Approval
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, transactionDBTypes, false, strmangle.SetComplement(transactionPrimaryKeyColumns, transactionColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*Approval{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, approvalDBTypes, false, strmangle.SetComplement(approvalPrimaryKeyColumns, approvalColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	err = a.AddApprovals(ctx, tx, true, foreigners...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err := a.Approvals().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 4 {
+		t.Error("count was wrong:", count)
+	}
+
+	err = a.RemoveApprovals(ctx, tx, foreigners[:2]...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err = a.Approvals().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	if !queries.IsValuerNil(b.TransactionID) {
+		t.Error("want b's foreign key value to be nil")
+	}
+	if !queries.IsValuerNil(c.TransactionID) {
+		t.Error("want c's foreign key value to be nil")
+	}
+
+	if b.R.Transaction != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if c.R.Transaction != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if d.R.Transaction != &a {
+		t.Error("relationship to a should have been preserved")
+	}
+	if e.R.Transaction != &a {
+		t.Error("relationship to a should have been preserved")
+	}
+
+	if len(a.R.Approvals) != 2 {
+		t.Error("should have preserved two relationships")
+	}
+
+	// Removal doesn't do a stable deletion for performance so we have to flip the order
+	if a.R.Approvals[1] != &d {
+		t.Error("relationship to d should have been preserved")
+	}
+	if a.R.Approvals[0] != &e {
+		t.Error("relationship to e should have been preserved")
+	}
+}
+
+func testTransactionToManyAddOpPayoutTransactionDSCycles(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Transaction
+	var b, c, d, e DSCycle
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, transactionDBTypes, false, strmangle.SetComplement(transactionPrimaryKeyColumns, transactionColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*DSCycle{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, dsCycleDBTypes, false, strmangle.SetComplement(dsCyclePrimaryKeyColumns, dsCycleColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	foreignersSplitByInsertion := [][]*DSCycle{
+		{&b, &c},
+		{&d, &e},
+	}
+
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddPayoutTransactionDSCycles(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		first := x[0]
+		second := x[1]
+
+		if !queries.Equal(a.ID, first.PayoutTransactionID) {
+			t.Error("foreign key was wrong value", a.ID, first.PayoutTransactionID)
+		}
+		if !queries.Equal(a.ID, second.PayoutTransactionID) {
+			t.Error("foreign key was wrong value", a.ID, second.PayoutTransactionID)
+		}
+
+		if first.R.PayoutTransaction != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.PayoutTransaction != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+
+		if a.R.PayoutTransactionDSCycles[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.PayoutTransactionDSCycles[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
+
+		count, err := a.PayoutTransactionDSCycles().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
+	}
+}
+
+func testTransactionToManySetOpPayoutTransactionDSCycles(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Transaction
+	var b, c, d, e DSCycle
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, transactionDBTypes, false, strmangle.SetComplement(transactionPrimaryKeyColumns, transactionColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*DSCycle{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, dsCycleDBTypes, false, strmangle.SetComplement(dsCyclePrimaryKeyColumns, dsCycleColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err = a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	err = a.SetPayoutTransactionDSCycles(ctx, tx, false, &b, &c)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err := a.PayoutTransactionDSCycles().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	err = a.SetPayoutTransactionDSCycles(ctx, tx, true, &d, &e)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err = a.PayoutTransactionDSCycles().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	if !queries.IsValuerNil(b.PayoutTransactionID) {
+		t.Error("want b's foreign key value to be nil")
+	}
+	if !queries.IsValuerNil(c.PayoutTransactionID) {
+		t.Error("want c's foreign key value to be nil")
+	}
+	if !queries.Equal(a.ID, d.PayoutTransactionID) {
+		t.Error("foreign key was wrong value", a.ID, d.PayoutTransactionID)
+	}
+	if !queries.Equal(a.ID, e.PayoutTransactionID) {
+		t.Error("foreign key was wrong value", a.ID, e.PayoutTransactionID)
+	}
+
+	if b.R.PayoutTransaction != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if c.R.PayoutTransaction != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if d.R.PayoutTransaction != &a {
+		t.Error("relationship was not added properly to the foreign struct")
+	}
+	if e.R.PayoutTransaction != &a {
+		t.Error("relationship was not added properly to the foreign struct")
+	}
+
+	if a.R.PayoutTransactionDSCycles[0] != &d {
+		t.Error("relationship struct slice not set to correct value")
+	}
+	if a.R.PayoutTransactionDSCycles[1] != &e {
+		t.Error("relationship struct slice not set to correct value")
+	}
+}
+
+func testTransactionToManyRemoveOpPayoutTransactionDSCycles(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Transaction
+	var b, c, d, e DSCycle
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, transactionDBTypes, false, strmangle.SetComplement(transactionPrimaryKeyColumns, transactionColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*DSCycle{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, dsCycleDBTypes, false, strmangle.SetComplement(dsCyclePrimaryKeyColumns, dsCycleColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	err = a.AddPayoutTransactionDSCycles(ctx, tx, true, foreigners...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err := a.PayoutTransactionDSCycles().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 4 {
+		t.Error("count was wrong:", count)
+	}
+
+	err = a.RemovePayoutTransactionDSCycles(ctx, tx, foreigners[:2]...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err = a.PayoutTransactionDSCycles().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	if !queries.IsValuerNil(b.PayoutTransactionID) {
+		t.Error("want b's foreign key value to be nil")
+	}
+	if !queries.IsValuerNil(c.PayoutTransactionID) {
+		t.Error("want c's foreign key value to be nil")
+	}
+
+	if b.R.PayoutTransaction != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if c.R.PayoutTransaction != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if d.R.PayoutTransaction != &a {
+		t.Error("relationship to a should have been preserved")
+	}
+	if e.R.PayoutTransaction != &a {
+		t.Error("relationship to a should have been preserved")
+	}
+
+	if len(a.R.PayoutTransactionDSCycles) != 2 {
+		t.Error("should have preserved two relationships")
+	}
+
+	// Removal doesn't do a stable deletion for performance so we have to flip the order
+	if a.R.PayoutTransactionDSCycles[1] != &d {
+		t.Error("relationship to d should have been preserved")
+	}
+	if a.R.PayoutTransactionDSCycles[0] != &e {
+		t.Error("relationship to e should have been preserved")
+	}
+}
+
+func testTransactionToManyAddOpImportRows(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Transaction
+	var b, c, d, e ImportRow
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, transactionDBTypes, false, strmangle.SetComplement(transactionPrimaryKeyColumns, transactionColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*ImportRow{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, importRowDBTypes, false, strmangle.SetComplement(importRowPrimaryKeyColumns, importRowColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	foreignersSplitByInsertion := [][]*ImportRow{
+		{&b, &c},
+		{&d, &e},
+	}
+
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddImportRows(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		first := x[0]
+		second := x[1]
+
+		if !queries.Equal(a.ID, first.TransactionID) {
+			t.Error("foreign key was wrong value", a.ID, first.TransactionID)
+		}
+		if !queries.Equal(a.ID, second.TransactionID) {
+			t.Error("foreign key was wrong value", a.ID, second.TransactionID)
+		}
+
+		if first.R.Transaction != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.Transaction != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+
+		if a.R.ImportRows[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.ImportRows[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
+
+		count, err := a.ImportRows().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
+	}
+}
+
+func testTransactionToManySetOpImportRows(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Transaction
+	var b, c, d, e ImportRow
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, transactionDBTypes, false, strmangle.SetComplement(transactionPrimaryKeyColumns, transactionColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*ImportRow{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, importRowDBTypes, false, strmangle.SetComplement(importRowPrimaryKeyColumns, importRowColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err = a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	err = a.SetImportRows(ctx, tx, false, &b, &c)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err := a.ImportRows().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	err = a.SetImportRows(ctx, tx, true, &d, &e)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err = a.ImportRows().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	if !queries.IsValuerNil(b.TransactionID) {
+		t.Error("want b's foreign key value to be nil")
+	}
+	if !queries.IsValuerNil(c.TransactionID) {
+		t.Error("want c's foreign key value to be nil")
+	}
+	if !queries.Equal(a.ID, d.TransactionID) {
+		t.Error("foreign key was wrong value", a.ID, d.TransactionID)
+	}
+	if !queries.Equal(a.ID, e.TransactionID) {
+		t.Error("foreign key was wrong value", a.ID, e.TransactionID)
+	}
+
+	if b.R.Transaction != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if c.R.Transaction != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if d.R.Transaction != &a {
+		t.Error("relationship was not added properly to the foreign struct")
+	}
+	if e.R.Transaction != &a {
+		t.Error("relationship was not added properly to the foreign struct")
+	}
+
+	if a.R.ImportRows[0] != &d {
+		t.Error("relationship struct slice not set to correct value")
+	}
+	if a.R.ImportRows[1] != &e {
+		t.Error("relationship struct slice not set to correct value")
+	}
+}
+
+func testTransactionToManyRemoveOpImportRows(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Transaction
+	var b, c, d, e ImportRow
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, transactionDBTypes, false, strmangle.SetComplement(transactionPrimaryKeyColumns, transactionColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*ImportRow{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, importRowDBTypes, false, strmangle.SetComplement(importRowPrimaryKeyColumns, importRowColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	err = a.AddImportRows(ctx, tx, true, foreigners...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err := a.ImportRows().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 4 {
+		t.Error("count was wrong:", count)
+	}
+
+	err = a.RemoveImportRows(ctx, tx, foreigners[:2]...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err = a.ImportRows().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	if !queries.IsValuerNil(b.TransactionID) {
+		t.Error("want b's foreign key value to be nil")
+	}
+	if !queries.IsValuerNil(c.TransactionID) {
+		t.Error("want c's foreign key value to be nil")
+	}
+
+	if b.R.Transaction != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if c.R.Transaction != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if d.R.Transaction != &a {
+		t.Error("relationship to a should have been preserved")
+	}
+	if e.R.Transaction != &a {
+		t.Error("relationship to a should have been preserved")
+	}
+
+	if len(a.R.ImportRows) != 2 {
+		t.Error("should have preserved two relationships")
+	}
+
+	// Removal doesn't do a stable deletion for performance so we have to flip the order
+	if a.R.ImportRows[1] != &d {
+		t.Error("relationship to d should have been preserved")
+	}
+	if a.R.ImportRows[0] != &e {
+		t.Error("relationship to e should have been preserved")
+	}
+}
+
+func testTransactionToManyAddOpDisbursementTransactionLoans(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Transaction
+	var b, c, d, e Loan
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, transactionDBTypes, false, strmangle.SetComplement(transactionPrimaryKeyColumns, transactionColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*Loan{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, loanDBTypes, false, strmangle.SetComplement(loanPrimaryKeyColumns, loanColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	foreignersSplitByInsertion := [][]*Loan{
+		{&b, &c},
+		{&d, &e},
+	}
+
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddDisbursementTransactionLoans(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		first := x[0]
+		second := x[1]
+
+		if !queries.Equal(a.ID, first.DisbursementTransactionID) {
+			t.Error("foreign key was wrong value", a.ID, first.DisbursementTransactionID)
+		}
+		if !queries.Equal(a.ID, second.DisbursementTransactionID) {
+			t.Error("foreign key was wrong value", a.ID, second.DisbursementTransactionID)
+		}
+
+		if first.R.DisbursementTransaction != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.DisbursementTransaction != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+
+		if a.R.DisbursementTransactionLoans[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.DisbursementTransactionLoans[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
+
+		count, err := a.DisbursementTransactionLoans().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
+	}
+}
+
+func testTransactionToManySetOpDisbursementTransactionLoans(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Transaction
+	var b, c, d, e Loan
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, transactionDBTypes, false, strmangle.SetComplement(transactionPrimaryKeyColumns, transactionColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*Loan{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, loanDBTypes, false, strmangle.SetComplement(loanPrimaryKeyColumns, loanColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err = a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	err = a.SetDisbursementTransactionLoans(ctx, tx, false, &b, &c)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err := a.DisbursementTransactionLoans().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	err = a.SetDisbursementTransactionLoans(ctx, tx, true, &d, &e)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err = a.DisbursementTransactionLoans().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	if !queries.IsValuerNil(b.DisbursementTransactionID) {
+		t.Error("want b's foreign key value to be nil")
+	}
+	if !queries.IsValuerNil(c.DisbursementTransactionID) {
+		t.Error("want c's foreign key value to be nil")
+	}
+	if !queries.Equal(a.ID, d.DisbursementTransactionID) {
+		t.Error("foreign key was wrong value", a.ID, d.DisbursementTransactionID)
+	}
+	if !queries.Equal(a.ID, e.DisbursementTransactionID) {
+		t.Error("foreign key was wrong value", a.ID, e.DisbursementTransactionID)
+	}
+
+	if b.R.DisbursementTransaction != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if c.R.DisbursementTransaction != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if d.R.DisbursementTransaction != &a {
+		t.Error("relationship was not added properly to the foreign struct")
+	}
+	if e.R.DisbursementTransaction != &a {
+		t.Error("relationship was not added properly to the foreign struct")
+	}
+
+	if a.R.DisbursementTransactionLoans[0] != &d {
+		t.Error("relationship struct slice not set to correct value")
+	}
+	if a.R.DisbursementTransactionLoans[1] != &e {
+		t.Error("relationship struct slice not set to correct value")
+	}
+}
+
+func testTransactionToManyRemoveOpDisbursementTransactionLoans(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Transaction
+	var b, c, d, e Loan
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, transactionDBTypes, false, strmangle.SetComplement(transactionPrimaryKeyColumns, transactionColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*Loan{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, loanDBTypes, false, strmangle.SetComplement(loanPrimaryKeyColumns, loanColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	err = a.AddDisbursementTransactionLoans(ctx, tx, true, foreigners...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err := a.DisbursementTransactionLoans().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 4 {
+		t.Error("count was wrong:", count)
+	}
+
+	err = a.RemoveDisbursementTransactionLoans(ctx, tx, foreigners[:2]...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err = a.DisbursementTransactionLoans().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	if !queries.IsValuerNil(b.DisbursementTransactionID) {
+		t.Error("want b's foreign key value to be nil")
+	}
+	if !queries.IsValuerNil(c.DisbursementTransactionID) {
+		t.Error("want c's foreign key value to be nil")
+	}
+
+	if b.R.DisbursementTransaction != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if c.R.DisbursementTransaction != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if d.R.DisbursementTransaction != &a {
+		t.Error("relationship to a should have been preserved")
+	}
+	if e.R.DisbursementTransaction != &a {
+		t.Error("relationship to a should have been preserved")
+	}
+
+	if len(a.R.DisbursementTransactionLoans) != 2 {
+		t.Error("should have preserved two relationships")
+	}
+
+	// Removal doesn't do a stable deletion for performance so we have to flip the order
+	if a.R.DisbursementTransactionLoans[1] != &d {
+		t.Error("relationship to d should have been preserved")
+	}
+	if a.R.DisbursementTransactionLoans[0] != &e {
+		t.Error("relationship to e should have been preserved")
+	}
+}
+
+func testTransactionToManyAddOpLoanRepayments(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Transaction
+	var b, c, d, e LoanRepayment
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, transactionDBTypes, false, strmangle.SetComplement(transactionPrimaryKeyColumns, transactionColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*LoanRepayment{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, loanRepaymentDBTypes, false, strmangle.SetComplement(loanRepaymentPrimaryKeyColumns, loanRepaymentColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	foreignersSplitByInsertion := [][]*LoanRepayment{
+		{&b, &c},
+		{&d, &e},
+	}
+
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddLoanRepayments(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		first := x[0]
+		second := x[1]
+
+		if !queries.Equal(a.ID, first.TransactionID) {
+			t.Error("foreign key was wrong value", a.ID, first.TransactionID)
+		}
+		if !queries.Equal(a.ID, second.TransactionID) {
+			t.Error("foreign key was wrong value", a.ID, second.TransactionID)
+		}
+
+		if first.R.Transaction != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.Transaction != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+
+		if a.R.LoanRepayments[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.LoanRepayments[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
+
+		count, err := a.LoanRepayments().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
+	}
+}
+
+func testTransactionToManySetOpLoanRepayments(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Transaction
+	var b, c, d, e LoanRepayment
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, transactionDBTypes, false, strmangle.SetComplement(transactionPrimaryKeyColumns, transactionColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*LoanRepayment{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, loanRepaymentDBTypes, false, strmangle.SetComplement(loanRepaymentPrimaryKeyColumns, loanRepaymentColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err = a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	err = a.SetLoanRepayments(ctx, tx, false, &b, &c)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err := a.LoanRepayments().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	err = a.SetLoanRepayments(ctx, tx, true, &d, &e)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err = a.LoanRepayments().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	if !queries.IsValuerNil(b.TransactionID) {
+		t.Error("want b's foreign key value to be nil")
+	}
+	if !queries.IsValuerNil(c.TransactionID) {
+		t.Error("want c's foreign key value to be nil")
+	}
+	if !queries.Equal(a.ID, d.TransactionID) {
+		t.Error("foreign key was wrong value", a.ID, d.TransactionID)
+	}
+	if !queries.Equal(a.ID, e.TransactionID) {
+		t.Error("foreign key was wrong value", a.ID, e.TransactionID)
+	}
+
+	if b.R.Transaction != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if c.R.Transaction != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if d.R.Transaction != &a {
+		t.Error("relationship was not added properly to the foreign struct")
+	}
+	if e.R.Transaction != &a {
+		t.Error("relationship was not added properly to the foreign struct")
+	}
+
+	if a.R.LoanRepayments[0] != &d {
+		t.Error("relationship struct slice not set to correct value")
+	}
+	if a.R.LoanRepayments[1] != &e {
+		t.Error("relationship struct slice not set to correct value")
+	}
+}
+
+func testTransactionToManyRemoveOpLoanRepayments(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Transaction
+	var b, c, d, e LoanRepayment
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, transactionDBTypes, false, strmangle.SetComplement(transactionPrimaryKeyColumns, transactionColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*LoanRepayment{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, loanRepaymentDBTypes, false, strmangle.SetComplement(loanRepaymentPrimaryKeyColumns, loanRepaymentColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	err = a.AddLoanRepayments(ctx, tx, true, foreigners...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err := a.LoanRepayments().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 4 {
+		t.Error("count was wrong:", count)
+	}
+
+	err = a.RemoveLoanRepayments(ctx, tx, foreigners[:2]...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err = a.LoanRepayments().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	if !queries.IsValuerNil(b.TransactionID) {
+		t.Error("want b's foreign key value to be nil")
+	}
+	if !queries.IsValuerNil(c.TransactionID) {
+		t.Error("want c's foreign key value to be nil")
+	}
+
+	if b.R.Transaction != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if c.R.Transaction != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if d.R.Transaction != &a {
+		t.Error("relationship to a should have been preserved")
+	}
+	if e.R.Transaction != &a {
+		t.Error("relationship to a should have been preserved")
+	}
+
+	if len(a.R.LoanRepayments) != 2 {
+		t.Error("should have preserved two relationships")
+	}
+
+	// Removal doesn't do a stable deletion for performance so we have to flip the order
+	if a.R.LoanRepayments[1] != &d {
+		t.Error("relationship to d should have been preserved")
+	}
+	if a.R.LoanRepayments[0] != &e {
+		t.Error("relationship to e should have been preserved")
+	}
+}
+
+func testTransactionToManyAddOpCorrectionOfTransactions(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Transaction
+	var b, c, d, e Transaction
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, transactionDBTypes, false, strmangle.SetComplement(transactionPrimaryKeyColumns, transactionColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*Transaction{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, transactionDBTypes, false, strmangle.SetComplement(transactionPrimaryKeyColumns, transactionColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	foreignersSplitByInsertion := [][]*Transaction{
+		{&b, &c},
+		{&d, &e},
+	}
+
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddCorrectionOfTransactions(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		first := x[0]
+		second := x[1]
+
+		if !queries.Equal(a.ID, first.CorrectionOfID) {
+			t.Error("foreign key was wrong value", a.ID, first.CorrectionOfID)
+		}
+		if !queries.Equal(a.ID, second.CorrectionOfID) {
+			t.Error("foreign key was wrong value", a.ID, second.CorrectionOfID)
+		}
+
+		if first.R.CorrectionOf != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.CorrectionOf != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+
+		if a.R.CorrectionOfTransactions[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.CorrectionOfTransactions[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
+
+		count, err := a.CorrectionOfTransactions().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
+	}
+}
+
+func testTransactionToManySetOpCorrectionOfTransactions(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Transaction
+	var b, c, d, e Transaction
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, transactionDBTypes, false, strmangle.SetComplement(transactionPrimaryKeyColumns, transactionColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*Transaction{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, transactionDBTypes, false, strmangle.SetComplement(transactionPrimaryKeyColumns, transactionColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err = a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	err = a.SetCorrectionOfTransactions(ctx, tx, false, &b, &c)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err := a.CorrectionOfTransactions().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	err = a.SetCorrectionOfTransactions(ctx, tx, true, &d, &e)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err = a.CorrectionOfTransactions().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	if !queries.IsValuerNil(b.CorrectionOfID) {
+		t.Error("want b's foreign key value to be nil")
+	}
+	if !queries.IsValuerNil(c.CorrectionOfID) {
+		t.Error("want c's foreign key value to be nil")
+	}
+	if !queries.Equal(a.ID, d.CorrectionOfID) {
+		t.Error("foreign key was wrong value", a.ID, d.CorrectionOfID)
+	}
+	if !queries.Equal(a.ID, e.CorrectionOfID) {
+		t.Error("foreign key was wrong value", a.ID, e.CorrectionOfID)
+	}
+
+	if b.R.CorrectionOf != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if c.R.CorrectionOf != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if d.R.CorrectionOf != &a {
+		t.Error("relationship was not added properly to the foreign struct")
+	}
+	if e.R.CorrectionOf != &a {
+		t.Error("relationship was not added properly to the foreign struct")
+	}
+
+	if a.R.CorrectionOfTransactions[0] != &d {
+		t.Error("relationship struct slice not set to correct value")
+	}
+	if a.R.CorrectionOfTransactions[1] != &e {
+		t.Error("relationship struct slice not set to correct value")
+	}
+}
+
+func testTransactionToManyRemoveOpCorrectionOfTransactions(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Transaction
+	var b, c, d, e Transaction
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, transactionDBTypes, false, strmangle.SetComplement(transactionPrimaryKeyColumns, transactionColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*Transaction{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, transactionDBTypes, false, strmangle.SetComplement(transactionPrimaryKeyColumns, transactionColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	err = a.AddCorrectionOfTransactions(ctx, tx, true, foreigners...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err := a.CorrectionOfTransactions().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 4 {
+		t.Error("count was wrong:", count)
+	}
+
+	err = a.RemoveCorrectionOfTransactions(ctx, tx, foreigners[:2]...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err = a.CorrectionOfTransactions().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	if !queries.IsValuerNil(b.CorrectionOfID) {
+		t.Error("want b's foreign key value to be nil")
+	}
+	if !queries.IsValuerNil(c.CorrectionOfID) {
+		t.Error("want c's foreign key value to be nil")
+	}
+
+	if b.R.CorrectionOf != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if c.R.CorrectionOf != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if d.R.CorrectionOf != &a {
+		t.Error("relationship to a should have been preserved")
+	}
+	if e.R.CorrectionOf != &a {
+		t.Error("relationship to a should have been preserved")
+	}
+
+	if len(a.R.CorrectionOfTransactions) != 2 {
+		t.Error("should have preserved two relationships")
+	}
+
+	// Removal doesn't do a stable deletion for performance so we have to flip the order
+	if a.R.CorrectionOfTransactions[1] != &d {
+		t.Error("relationship to d should have been preserved")
+	}
+	if a.R.CorrectionOfTransactions[0] != &e {
+		t.Error("relationship to e should have been preserved")
+	}
+}
+
+func testTransactionToManyAddOpReversalOfTransactions(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Transaction
+	var b, c, d, e Transaction
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, transactionDBTypes, false, strmangle.SetComplement(transactionPrimaryKeyColumns, transactionColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*Transaction{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, transactionDBTypes, false, strmangle.SetComplement(transactionPrimaryKeyColumns, transactionColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	foreignersSplitByInsertion := [][]*Transaction{
+		{&b, &c},
+		{&d, &e},
+	}
+
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddReversalOfTransactions(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		first := x[0]
+		second := x[1]
+
+		if !queries.Equal(a.ID, first.ReversalOfID) {
+			t.Error("foreign key was wrong value", a.ID, first.ReversalOfID)
+		}
+		if !queries.Equal(a.ID, second.ReversalOfID) {
+			t.Error("foreign key was wrong value", a.ID, second.ReversalOfID)
+		}
+
+		if first.R.ReversalOf != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.ReversalOf != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+
+		if a.R.ReversalOfTransactions[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.ReversalOfTransactions[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
+
+		count, err := a.ReversalOfTransactions().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
+	}
+}
+
+func testTransactionToManySetOpReversalOfTransactions(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Transaction
+	var b, c, d, e Transaction
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, transactionDBTypes, false, strmangle.SetComplement(transactionPrimaryKeyColumns, transactionColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*Transaction{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, transactionDBTypes, false, strmangle.SetComplement(transactionPrimaryKeyColumns, transactionColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err = a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	err = a.SetReversalOfTransactions(ctx, tx, false, &b, &c)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err := a.ReversalOfTransactions().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	err = a.SetReversalOfTransactions(ctx, tx, true, &d, &e)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err = a.ReversalOfTransactions().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	if !queries.IsValuerNil(b.ReversalOfID) {
+		t.Error("want b's foreign key value to be nil")
+	}
+	if !queries.IsValuerNil(c.ReversalOfID) {
+		t.Error("want c's foreign key value to be nil")
+	}
+	if !queries.Equal(a.ID, d.ReversalOfID) {
+		t.Error("foreign key was wrong value", a.ID, d.ReversalOfID)
+	}
+	if !queries.Equal(a.ID, e.ReversalOfID) {
+		t.Error("foreign key was wrong value", a.ID, e.ReversalOfID)
+	}
+
+	if b.R.ReversalOf != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if c.R.ReversalOf != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if d.R.ReversalOf != &a {
+		t.Error("relationship was not added properly to the foreign struct")
+	}
+	if e.R.ReversalOf != &a {
+		t.Error("relationship was not added properly to the foreign struct")
+	}
+
+	if a.R.ReversalOfTransactions[0] != &d {
+		t.Error("relationship struct slice not set to correct value")
+	}
+	if a.R.ReversalOfTransactions[1] != &e {
+		t.Error("relationship struct slice not set to correct value")
+	}
+}
+
+func testTransactionToManyRemoveOpReversalOfTransactions(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Transaction
+	var b, c, d, e Transaction
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, transactionDBTypes, false, strmangle.SetComplement(transactionPrimaryKeyColumns, transactionColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*Transaction{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, transactionDBTypes, false, strmangle.SetComplement(transactionPrimaryKeyColumns, transactionColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	err = a.AddReversalOfTransactions(ctx, tx, true, foreigners...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err := a.ReversalOfTransactions().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 4 {
+		t.Error("count was wrong:", count)
+	}
+
+	err = a.RemoveReversalOfTransactions(ctx, tx, foreigners[:2]...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err = a.ReversalOfTransactions().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	if !queries.IsValuerNil(b.ReversalOfID) {
+		t.Error("want b's foreign key value to be nil")
+	}
+	if !queries.IsValuerNil(c.ReversalOfID) {
+		t.Error("want c's foreign key value to be nil")
+	}
+
+	if b.R.ReversalOf != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if c.R.ReversalOf != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if d.R.ReversalOf != &a {
+		t.Error("relationship to a should have been preserved")
+	}
+	if e.R.ReversalOf != &a {
+		t.Error("relationship to a should have been preserved")
+	}
+
+	if len(a.R.ReversalOfTransactions) != 2 {
+		t.Error("should have preserved two relationships")
+	}
+
+	// Removal doesn't do a stable deletion for performance so we have to flip the order
+	if a.R.ReversalOfTransactions[1] != &d {
+		t.Error("relationship to d should have been preserved")
+	}
+	if a.R.ReversalOfTransactions[0] != &e {
+		t.Error("relationship to e should have been preserved")
+	}
+}
+
+func testTransactionToManyAddOpWithdrawalRequests(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Transaction
+	var b, c, d, e WithdrawalRequest
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, transactionDBTypes, false, strmangle.SetComplement(transactionPrimaryKeyColumns, transactionColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*WithdrawalRequest{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, withdrawalRequestDBTypes, false, strmangle.SetComplement(withdrawalRequestPrimaryKeyColumns, withdrawalRequestColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	foreignersSplitByInsertion := [][]*WithdrawalRequest{
+		{&b, &c},
+		{&d, &e},
+	}
+
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddWithdrawalRequests(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		first := x[0]
+		second := x[1]
+
+		if !queries.Equal(a.ID, first.TransactionID) {
+			t.Error("foreign key was wrong value", a.ID, first.TransactionID)
+		}
+		if !queries.Equal(a.ID, second.TransactionID) {
+			t.Error("foreign key was wrong value", a.ID, second.TransactionID)
+		}
+
+		if first.R.Transaction != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.Transaction != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+
+		if a.R.WithdrawalRequests[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.WithdrawalRequests[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
+
+		count, err := a.WithdrawalRequests().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
+	}
+}
+
+func testTransactionToManySetOpWithdrawalRequests(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Transaction
+	var b, c, d, e WithdrawalRequest
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, transactionDBTypes, false, strmangle.SetComplement(transactionPrimaryKeyColumns, transactionColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*WithdrawalRequest{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, withdrawalRequestDBTypes, false, strmangle.SetComplement(withdrawalRequestPrimaryKeyColumns, withdrawalRequestColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err = a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	err = a.SetWithdrawalRequests(ctx, tx, false, &b, &c)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err := a.WithdrawalRequests().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	err = a.SetWithdrawalRequests(ctx, tx, true, &d, &e)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err = a.WithdrawalRequests().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	if !queries.IsValuerNil(b.TransactionID) {
+		t.Error("want b's foreign key value to be nil")
+	}
+	if !queries.IsValuerNil(c.TransactionID) {
+		t.Error("want c's foreign key value to be nil")
+	}
+	if !queries.Equal(a.ID, d.TransactionID) {
+		t.Error("foreign key was wrong value", a.ID, d.TransactionID)
+	}
+	if !queries.Equal(a.ID, e.TransactionID) {
+		t.Error("foreign key was wrong value", a.ID, e.TransactionID)
+	}
+
+	if b.R.Transaction != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if c.R.Transaction != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if d.R.Transaction != &a {
+		t.Error("relationship was not added properly to the foreign struct")
+	}
+	if e.R.Transaction != &a {
+		t.Error("relationship was not added properly to the foreign struct")
+	}
+
+	if a.R.WithdrawalRequests[0] != &d {
+		t.Error("relationship struct slice not set to correct value")
+	}
+	if a.R.WithdrawalRequests[1] != &e {
+		t.Error("relationship struct slice not set to correct value")
+	}
+}
+
+func testTransactionToManyRemoveOpWithdrawalRequests(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Transaction
+	var b, c, d, e WithdrawalRequest
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, transactionDBTypes, false, strmangle.SetComplement(transactionPrimaryKeyColumns, transactionColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*WithdrawalRequest{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, withdrawalRequestDBTypes, false, strmangle.SetComplement(withdrawalRequestPrimaryKeyColumns, withdrawalRequestColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	err = a.AddWithdrawalRequests(ctx, tx, true, foreigners...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err := a.WithdrawalRequests().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 4 {
+		t.Error("count was wrong:", count)
+	}
+
+	err = a.RemoveWithdrawalRequests(ctx, tx, foreigners[:2]...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err = a.WithdrawalRequests().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	if !queries.IsValuerNil(b.TransactionID) {
+		t.Error("want b's foreign key value to be nil")
+	}
+	if !queries.IsValuerNil(c.TransactionID) {
+		t.Error("want c's foreign key value to be nil")
+	}
+
+	if b.R.Transaction != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if c.R.Transaction != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if d.R.Transaction != &a {
+		t.Error("relationship to a should have been preserved")
+	}
+	if e.R.Transaction != &a {
+		t.Error("relationship to a should have been preserved")
+	}
+
+	if len(a.R.WithdrawalRequests) != 2 {
+		t.Error("should have preserved two relationships")
+	}
+
+	// Removal doesn't do a stable deletion for performance so we have to flip the order
+	if a.R.WithdrawalRequests[1] != &d {
+		t.Error("relationship to d should have been preserved")
+	}
+	if a.R.WithdrawalRequests[0] != &e {
+		t.Error("relationship to e should have been preserved")
+	}
+}
+
 func testTransactionToOneAccountUsingAccount(t *testing.T) {
 	ctx := context.Background()
 	tx := MustTx(boil.BeginTx(ctx, nil))
 	defer func() { _ = tx.Rollback() }()
 
-	var local Transaction
-	var foreign Account
+	var local Transaction
+	var foreign Account
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, transactionDBTypes, false, transactionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Transaction struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, accountDBTypes, false, accountColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Account struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	local.AccountID = foreign.ID
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.Account().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if check.ID != foreign.ID {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	slice := TransactionSlice{&local}
+	if err = local.L.LoadAccount(ctx, tx, false, (*[]*Transaction)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Account == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.Account = nil
+	if err = local.L.LoadAccount(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Account == nil {
+		t.Error("struct should have been eager loaded")
+	}
+}
+
+func testTransactionToOneUserUsingApprovedBy(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local Transaction
+	var foreign User
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, transactionDBTypes, true, transactionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Transaction struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, userDBTypes, false, userColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize User struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	queries.Assign(&local.ApprovedByID, foreign.ID)
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.ApprovedBy().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !queries.Equal(check.ID, foreign.ID) {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	slice := TransactionSlice{&local}
+	if err = local.L.LoadApprovedBy(ctx, tx, false, (*[]*Transaction)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.ApprovedBy == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.ApprovedBy = nil
+	if err = local.L.LoadApprovedBy(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.ApprovedBy == nil {
+		t.Error("struct should have been eager loaded")
+	}
+}
+
+func testTransactionToOneTransactionUsingCorrectionOf(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local Transaction
+	var foreign Transaction
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, transactionDBTypes, true, transactionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Transaction struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, transactionDBTypes, false, transactionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Transaction struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	queries.Assign(&local.CorrectionOfID, foreign.ID)
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.CorrectionOf().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !queries.Equal(check.ID, foreign.ID) {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	slice := TransactionSlice{&local}
+	if err = local.L.LoadCorrectionOf(ctx, tx, false, (*[]*Transaction)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.CorrectionOf == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.CorrectionOf = nil
+	if err = local.L.LoadCorrectionOf(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.CorrectionOf == nil {
+		t.Error("struct should have been eager loaded")
+	}
+}
+
+func testTransactionToOneDSCycleUsingDSCycle(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local Transaction
+	var foreign DSCycle
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, transactionDBTypes, true, transactionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Transaction struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, dsCycleDBTypes, false, dsCycleColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize DSCycle struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	queries.Assign(&local.DSCycleID, foreign.ID)
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.DSCycle().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !queries.Equal(check.ID, foreign.ID) {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	slice := TransactionSlice{&local}
+	if err = local.L.LoadDSCycle(ctx, tx, false, (*[]*Transaction)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.DSCycle == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.DSCycle = nil
+	if err = local.L.LoadDSCycle(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.DSCycle == nil {
+		t.Error("struct should have been eager loaded")
+	}
+}
+
+func testTransactionToOneTransactionUsingReversalOf(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local Transaction
+	var foreign Transaction
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, transactionDBTypes, true, transactionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Transaction struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, transactionDBTypes, false, transactionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Transaction struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	queries.Assign(&local.ReversalOfID, foreign.ID)
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.ReversalOf().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !queries.Equal(check.ID, foreign.ID) {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	slice := TransactionSlice{&local}
+	if err = local.L.LoadReversalOf(ctx, tx, false, (*[]*Transaction)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.ReversalOf == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.ReversalOf = nil
+	if err = local.L.LoadReversalOf(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.ReversalOf == nil {
+		t.Error("struct should have been eager loaded")
+	}
+}
+
+func testTransactionToOneUserUsingSalesRep(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local Transaction
+	var foreign User
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, transactionDBTypes, false, transactionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Transaction struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, userDBTypes, false, userColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize User struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	local.SalesRepID = foreign.ID
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.SalesRep().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if check.ID != foreign.ID {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	slice := TransactionSlice{&local}
+	if err = local.L.LoadSalesRep(ctx, tx, false, (*[]*Transaction)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.SalesRep == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.SalesRep = nil
+	if err = local.L.LoadSalesRep(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.SalesRep == nil {
+		t.Error("struct should have been eager loaded")
+	}
+}
+
+func testTransactionToOneTransferUsingTransfer(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local Transaction
+	var foreign Transfer
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, transactionDBTypes, true, transactionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Transaction struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, transferDBTypes, false, transferColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Transfer struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	queries.Assign(&local.TransferID, foreign.ID)
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.Transfer().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !queries.Equal(check.ID, foreign.ID) {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	slice := TransactionSlice{&local}
+	if err = local.L.LoadTransfer(ctx, tx, false, (*[]*Transaction)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Transfer == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.Transfer = nil
+	if err = local.L.LoadTransfer(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Transfer == nil {
+		t.Error("struct should have been eager loaded")
+	}
+}
+
+func testTransactionToOneSetOpAccountUsingAccount(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Transaction
+	var b, c Account
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, transactionDBTypes, false, strmangle.SetComplement(transactionPrimaryKeyColumns, transactionColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, accountDBTypes, false, strmangle.SetComplement(accountPrimaryKeyColumns, accountColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, accountDBTypes, false, strmangle.SetComplement(accountPrimaryKeyColumns, accountColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*Account{&b, &c} {
+		err = a.SetAccount(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.Account != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.Transactions[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if a.AccountID != x.ID {
+			t.Error("foreign key was wrong value", a.AccountID)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(a.AccountID))
+		reflect.Indirect(reflect.ValueOf(&a.AccountID)).Set(zero)
+
+		if err = a.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if a.AccountID != x.ID {
+			t.Error("foreign key was wrong value", a.AccountID, x.ID)
+		}
+	}
+}
+func testTransactionToOneSetOpUserUsingApprovedBy(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Transaction
+	var b, c User
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, transactionDBTypes, false, strmangle.SetComplement(transactionPrimaryKeyColumns, transactionColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*User{&b, &c} {
+		err = a.SetApprovedBy(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.ApprovedBy != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.ApprovedByTransactions[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if !queries.Equal(a.ApprovedByID, x.ID) {
+			t.Error("foreign key was wrong value", a.ApprovedByID)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(a.ApprovedByID))
+		reflect.Indirect(reflect.ValueOf(&a.ApprovedByID)).Set(zero)
+
+		if err = a.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if !queries.Equal(a.ApprovedByID, x.ID) {
+			t.Error("foreign key was wrong value", a.ApprovedByID, x.ID)
+		}
+	}
+}
+
+func testTransactionToOneRemoveOpUserUsingApprovedBy(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Transaction
+	var b User
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, transactionDBTypes, false, strmangle.SetComplement(transactionPrimaryKeyColumns, transactionColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.SetApprovedBy(ctx, tx, true, &b); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.RemoveApprovedBy(ctx, tx, &b); err != nil {
+		t.Error("failed to remove relationship")
+	}
+
+	count, err := a.ApprovedBy().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 0 {
+		t.Error("want no relationships remaining")
+	}
+
+	if a.R.ApprovedBy != nil {
+		t.Error("R struct entry should be nil")
+	}
+
+	if !queries.IsValuerNil(a.ApprovedByID) {
+		t.Error("foreign key value should be nil")
+	}
+
+	if len(b.R.ApprovedByTransactions) != 0 {
+		t.Error("failed to remove a from b's relationships")
+	}
+}
+
+func testTransactionToOneSetOpTransactionUsingCorrectionOf(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Transaction
+	var b, c Transaction
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, transactionDBTypes, false, strmangle.SetComplement(transactionPrimaryKeyColumns, transactionColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, transactionDBTypes, false, strmangle.SetComplement(transactionPrimaryKeyColumns, transactionColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, transactionDBTypes, false, strmangle.SetComplement(transactionPrimaryKeyColumns, transactionColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*Transaction{&b, &c} {
+		err = a.SetCorrectionOf(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.CorrectionOf != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.CorrectionOfTransactions[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if !queries.Equal(a.CorrectionOfID, x.ID) {
+			t.Error("foreign key was wrong value", a.CorrectionOfID)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(a.CorrectionOfID))
+		reflect.Indirect(reflect.ValueOf(&a.CorrectionOfID)).Set(zero)
+
+		if err = a.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if !queries.Equal(a.CorrectionOfID, x.ID) {
+			t.Error("foreign key was wrong value", a.CorrectionOfID, x.ID)
+		}
+	}
+}
+
+func testTransactionToOneRemoveOpTransactionUsingCorrectionOf(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Transaction
+	var b Transaction
 
 	seed := randomize.NewSeed()
-	if err := randomize.Struct(seed, &local, transactionDBTypes, false, transactionColumnsWithDefault...); err != nil {
-		t.Errorf("Unable to randomize Transaction struct: %s", err)
+	if err = randomize.Struct(seed, &a, transactionDBTypes, false, strmangle.SetComplement(transactionPrimaryKeyColumns, transactionColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
 	}
-	if err := randomize.Struct(seed, &foreign, accountDBTypes, false, accountColumnsWithDefault...); err != nil {
-		t.Errorf("Unable to randomize Account struct: %s", err)
+	if err = randomize.Struct(seed, &b, transactionDBTypes, false, strmangle.SetComplement(transactionPrimaryKeyColumns, transactionColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
 	}
 
-	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+	if err = a.Insert(ctx, tx, boil.Infer()); err != nil {
 		t.Fatal(err)
 	}
 
-	local.AccountID = foreign.ID
-	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+	if err = a.SetCorrectionOf(ctx, tx, true, &b); err != nil {
 		t.Fatal(err)
 	}
 
-	check, err := local.Account().One(ctx, tx)
+	if err = a.RemoveCorrectionOf(ctx, tx, &b); err != nil {
+		t.Error("failed to remove relationship")
+	}
+
+	count, err := a.CorrectionOf().Count(ctx, tx)
 	if err != nil {
-		t.Fatal(err)
+		t.Error(err)
+	}
+	if count != 0 {
+		t.Error("want no relationships remaining")
 	}
 
-	if check.ID != foreign.ID {
-		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	if a.R.CorrectionOf != nil {
+		t.Error("R struct entry should be nil")
 	}
 
-	slice := TransactionSlice{&local}
-	if err = local.L.LoadAccount(ctx, tx, false, (*[]*Transaction)(&slice), nil); err != nil {
+	if !queries.IsValuerNil(a.CorrectionOfID) {
+		t.Error("foreign key value should be nil")
+	}
+
+	if len(b.R.CorrectionOfTransactions) != 0 {
+		t.Error("failed to remove a from b's relationships")
+	}
+}
+
+func testTransactionToOneSetOpDSCycleUsingDSCycle(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Transaction
+	var b, c DSCycle
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, transactionDBTypes, false, strmangle.SetComplement(transactionPrimaryKeyColumns, transactionColumnsWithoutDefault)...); err != nil {
 		t.Fatal(err)
 	}
-	if local.R.Account == nil {
-		t.Error("struct should have been eager loaded")
+	if err = randomize.Struct(seed, &b, dsCycleDBTypes, false, strmangle.SetComplement(dsCyclePrimaryKeyColumns, dsCycleColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, dsCycleDBTypes, false, strmangle.SetComplement(dsCyclePrimaryKeyColumns, dsCycleColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
 	}
 
-	local.R.Account = nil
-	if err = local.L.LoadAccount(ctx, tx, true, &local, nil); err != nil {
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
 		t.Fatal(err)
 	}
-	if local.R.Account == nil {
-		t.Error("struct should have been eager loaded")
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*DSCycle{&b, &c} {
+		err = a.SetDSCycle(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.DSCycle != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.Transactions[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if !queries.Equal(a.DSCycleID, x.ID) {
+			t.Error("foreign key was wrong value", a.DSCycleID)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(a.DSCycleID))
+		reflect.Indirect(reflect.ValueOf(&a.DSCycleID)).Set(zero)
+
+		if err = a.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if !queries.Equal(a.DSCycleID, x.ID) {
+			t.Error("foreign key was wrong value", a.DSCycleID, x.ID)
+		}
 	}
 }
 
-func testTransactionToOneUserUsingSalesRep(t *testing.T) {
+func testTransactionToOneRemoveOpDSCycleUsingDSCycle(t *testing.T) {
+	var err error
+
 	ctx := context.Background()
 	tx := MustTx(boil.BeginTx(ctx, nil))
 	defer func() { _ = tx.Rollback() }()
 
-	var local Transaction
-	var foreign User
+	var a Transaction
+	var b DSCycle
 
 	seed := randomize.NewSeed()
-	if err := randomize.Struct(seed, &local, transactionDBTypes, false, transactionColumnsWithDefault...); err != nil {
-		t.Errorf("Unable to randomize Transaction struct: %s", err)
-	}
-	if err := randomize.Struct(seed, &foreign, userDBTypes, false, userColumnsWithDefault...); err != nil {
-		t.Errorf("Unable to randomize User struct: %s", err)
+	if err = randomize.Struct(seed, &a, transactionDBTypes, false, strmangle.SetComplement(transactionPrimaryKeyColumns, transactionColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
 	}
-
-	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+	if err = randomize.Struct(seed, &b, dsCycleDBTypes, false, strmangle.SetComplement(dsCyclePrimaryKeyColumns, dsCycleColumnsWithoutDefault)...); err != nil {
 		t.Fatal(err)
 	}
 
-	local.SalesRepID = foreign.ID
-	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+	if err = a.Insert(ctx, tx, boil.Infer()); err != nil {
 		t.Fatal(err)
 	}
 
-	check, err := local.SalesRep().One(ctx, tx)
-	if err != nil {
+	if err = a.SetDSCycle(ctx, tx, true, &b); err != nil {
 		t.Fatal(err)
 	}
 
-	if check.ID != foreign.ID {
-		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	if err = a.RemoveDSCycle(ctx, tx, &b); err != nil {
+		t.Error("failed to remove relationship")
 	}
 
-	slice := TransactionSlice{&local}
-	if err = local.L.LoadSalesRep(ctx, tx, false, (*[]*Transaction)(&slice), nil); err != nil {
-		t.Fatal(err)
+	count, err := a.DSCycle().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
 	}
-	if local.R.SalesRep == nil {
-		t.Error("struct should have been eager loaded")
+	if count != 0 {
+		t.Error("want no relationships remaining")
 	}
 
-	local.R.SalesRep = nil
-	if err = local.L.LoadSalesRep(ctx, tx, true, &local, nil); err != nil {
-		t.Fatal(err)
+	if a.R.DSCycle != nil {
+		t.Error("R struct entry should be nil")
 	}
-	if local.R.SalesRep == nil {
-		t.Error("struct should have been eager loaded")
+
+	if !queries.IsValuerNil(a.DSCycleID) {
+		t.Error("foreign key value should be nil")
+	}
+
+	if len(b.R.Transactions) != 0 {
+		t.Error("failed to remove a from b's relationships")
 	}
 }
 
-func testTransactionToOneSetOpAccountUsingAccount(t *testing.T) {
+func testTransactionToOneSetOpTransactionUsingReversalOf(t *testing.T) {
 	var err error
 
 	ctx := context.Background()
@@ -463,16 +3726,16 @@ func testTransactionToOneSetOpAccountUsingAccount(t *testing.T) {
 	defer func() { _ = tx.Rollback() }()
 
 	var a Transaction
-	var b, c Account
+	var b, c Transaction
 
 	seed := randomize.NewSeed()
 	if err = randomize.Struct(seed, &a, transactionDBTypes, false, strmangle.SetComplement(transactionPrimaryKeyColumns, transactionColumnsWithoutDefault)...); err != nil {
 		t.Fatal(err)
 	}
-	if err = randomize.Struct(seed, &b, accountDBTypes, false, strmangle.SetComplement(accountPrimaryKeyColumns, accountColumnsWithoutDefault)...); err != nil {
+	if err = randomize.Struct(seed, &b, transactionDBTypes, false, strmangle.SetComplement(transactionPrimaryKeyColumns, transactionColumnsWithoutDefault)...); err != nil {
 		t.Fatal(err)
 	}
-	if err = randomize.Struct(seed, &c, accountDBTypes, false, strmangle.SetComplement(accountPrimaryKeyColumns, accountColumnsWithoutDefault)...); err != nil {
+	if err = randomize.Struct(seed, &c, transactionDBTypes, false, strmangle.SetComplement(transactionPrimaryKeyColumns, transactionColumnsWithoutDefault)...); err != nil {
 		t.Fatal(err)
 	}
 
@@ -483,35 +3746,87 @@ func testTransactionToOneSetOpAccountUsingAccount(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	for i, x := range []*Account{&b, &c} {
-		err = a.SetAccount(ctx, tx, i != 0, x)
+	for i, x := range []*Transaction{&b, &c} {
+		err = a.SetReversalOf(ctx, tx, i != 0, x)
 		if err != nil {
 			t.Fatal(err)
 		}
 
-		if a.R.Account != x {
+		if a.R.ReversalOf != x {
 			t.Error("relationship struct not set to correct value")
 		}
 
-		if x.R.Transactions[0] != &a {
+		if x.R.ReversalOfTransactions[0] != &a {
 			t.Error("failed to append to foreign relationship struct")
 		}
-		if a.AccountID != x.ID {
-			t.Error("foreign key was wrong value", a.AccountID)
+		if !queries.Equal(a.ReversalOfID, x.ID) {
+			t.Error("foreign key was wrong value", a.ReversalOfID)
 		}
 
-		zero := reflect.Zero(reflect.TypeOf(a.AccountID))
-		reflect.Indirect(reflect.ValueOf(&a.AccountID)).Set(zero)
+		zero := reflect.Zero(reflect.TypeOf(a.ReversalOfID))
+		reflect.Indirect(reflect.ValueOf(&a.ReversalOfID)).Set(zero)
 
 		if err = a.Reload(ctx, tx); err != nil {
 			t.Fatal("failed to reload", err)
 		}
 
-		if a.AccountID != x.ID {
-			t.Error("foreign key was wrong value", a.AccountID, x.ID)
+		if !queries.Equal(a.ReversalOfID, x.ID) {
+			t.Error("foreign key was wrong value", a.ReversalOfID, x.ID)
 		}
 	}
 }
+
+func testTransactionToOneRemoveOpTransactionUsingReversalOf(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Transaction
+	var b Transaction
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, transactionDBTypes, false, strmangle.SetComplement(transactionPrimaryKeyColumns, transactionColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, transactionDBTypes, false, strmangle.SetComplement(transactionPrimaryKeyColumns, transactionColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.SetReversalOf(ctx, tx, true, &b); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.RemoveReversalOf(ctx, tx, &b); err != nil {
+		t.Error("failed to remove relationship")
+	}
+
+	count, err := a.ReversalOf().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 0 {
+		t.Error("want no relationships remaining")
+	}
+
+	if a.R.ReversalOf != nil {
+		t.Error("R struct entry should be nil")
+	}
+
+	if !queries.IsValuerNil(a.ReversalOfID) {
+		t.Error("foreign key value should be nil")
+	}
+
+	if len(b.R.ReversalOfTransactions) != 0 {
+		t.Error("failed to remove a from b's relationships")
+	}
+}
+
 func testTransactionToOneSetOpUserUsingSalesRep(t *testing.T) {
 	var err error
 
@@ -569,6 +3884,114 @@ func testTransactionToOneSetOpUserUsingSalesRep(t *testing.T) {
 		}
 	}
 }
+func testTransactionToOneSetOpTransferUsingTransfer(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Transaction
+	var b, c Transfer
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, transactionDBTypes, false, strmangle.SetComplement(transactionPrimaryKeyColumns, transactionColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, transferDBTypes, false, strmangle.SetComplement(transferPrimaryKeyColumns, transferColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, transferDBTypes, false, strmangle.SetComplement(transferPrimaryKeyColumns, transferColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*Transfer{&b, &c} {
+		err = a.SetTransfer(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.Transfer != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.Transactions[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if !queries.Equal(a.TransferID, x.ID) {
+			t.Error("foreign key was wrong value", a.TransferID)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(a.TransferID))
+		reflect.Indirect(reflect.ValueOf(&a.TransferID)).Set(zero)
+
+		if err = a.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if !queries.Equal(a.TransferID, x.ID) {
+			t.Error("foreign key was wrong value", a.TransferID, x.ID)
+		}
+	}
+}
+
+func testTransactionToOneRemoveOpTransferUsingTransfer(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Transaction
+	var b Transfer
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, transactionDBTypes, false, strmangle.SetComplement(transactionPrimaryKeyColumns, transactionColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, transferDBTypes, false, strmangle.SetComplement(transferPrimaryKeyColumns, transferColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.SetTransfer(ctx, tx, true, &b); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.RemoveTransfer(ctx, tx, &b); err != nil {
+		t.Error("failed to remove relationship")
+	}
+
+	count, err := a.Transfer().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 0 {
+		t.Error("want no relationships remaining")
+	}
+
+	if a.R.Transfer != nil {
+		t.Error("R struct entry should be nil")
+	}
+
+	if !queries.IsValuerNil(a.TransferID) {
+		t.Error("foreign key value should be nil")
+	}
+
+	if len(b.R.Transactions) != 0 {
+		t.Error("failed to remove a from b's relationships")
+	}
+}
 
 func testTransactionsReload(t *testing.T) {
 	t.Parallel()
@@ -644,7 +4067,7 @@ func testTransactionsSelect(t *testing.T) {
 }
 
 var (
-	transactionDBTypes = map[string]string{`ID`: `character`, `AccountID`: `character`, `TXType`: `character varying`, `OpeningBalance`: `double precision`, `Amount`: `double precision`, `Narration`: `character varying`, `SalesRepID`: `character`, `CreatedAt`: `bigint`, `UpdatedAt`: `bigint`, `ArchivedAt`: `bigint`, `ReceiptNo`: `character varying`, `EffectiveDate`: `bigint`, `PaymentMethod`: `character`}
+	transactionDBTypes = map[string]string{`ID`: `character`, `AccountID`: `character`, `TXType`: `character varying`, `OpeningBalance`: `bigint`, `Amount`: `bigint`, `Narration`: `character varying`, `SalesRepID`: `character`, `CreatedAt`: `bigint`, `UpdatedAt`: `bigint`, `ArchivedAt`: `bigint`, `ReceiptNo`: `character varying`, `EffectiveDate`: `bigint`, `PaymentMethod`: `character`, `ReversalOfID`: `character`, `CorrectionOfID`: `character`, `Reason`: `character varying`, `ApprovedByID`: `character`, `TransferID`: `character`, `DSCycleID`: `character`}
 	_                  = bytes.MinRead
 )
 
@@ -136,50 +136,119 @@ var UserWhere = struct {
 
 // UserRels is where relationship names are stored.
 var UserRels = struct {
-	Branch               string
-	SalesRepAccounts     string
-	SalesRepCustomers    string
-	SalesRepInventories  string
-	SalesRepPayments     string
-	ArchivedByProducts   string
-	CreatedByProducts    string
-	UpdatedByProducts    string
-	SalesRepRepsExpenses string
-	ArchivedBySales      string
-	CreatedBySales       string
-	UpdatedBySales       string
-	SalesRepTransactions string
+	Branch                        string
+	SalesRepAccounts              string
+	SalesRepAccountFollowUps      string
+	CreatedByAccountHolds         string
+	ReleasedByAccountHolds        string
+	ChangedByAccountStatusChanges string
+	DecidedByApprovals            string
+	RequestedByApprovals          string
+	KycVerifiedByCustomers        string
+	SalesRepCustomers             string
+	UploadedByCustomerDocuments   string
+	MergedByCustomerMerges        string
+	SettledByDSCycles             string
+	CreatedByImportJobs           string
+	SalesRepImportJobs            string
+	SalesRepInventories           string
+	CreatedByJournalEntries       string
+	AppliedByLoans                string
+	ApprovedByLoans               string
+	DisbursedByLoans              string
+	SalesRepLoanRepayments        string
+	SalesRepPayments              string
+	ArchivedByProducts            string
+	CreatedByProducts             string
+	UpdatedByProducts             string
+	SalesRepRepsExpenses          string
+	ArchivedBySales               string
+	CreatedBySales                string
+	UpdatedBySales                string
+	SalesRepTillSessions          string
+	SignedOffByTillSessions       string
+	SubmittedByTillSessions       string
+	ApprovedByTransactions        string
+	SalesRepTransactions          string
+	SalesRepTransfers             string
+	DecidedByWithdrawalRequests   string
 }{
-	Branch:               "Branch",
-	SalesRepAccounts:     "SalesRepAccounts",
-	SalesRepCustomers:    "SalesRepCustomers",
-	SalesRepInventories:  "SalesRepInventories",
-	SalesRepPayments:     "SalesRepPayments",
-	ArchivedByProducts:   "ArchivedByProducts",
-	CreatedByProducts:    "CreatedByProducts",
-	UpdatedByProducts:    "UpdatedByProducts",
-	SalesRepRepsExpenses: "SalesRepRepsExpenses",
-	ArchivedBySales:      "ArchivedBySales",
-	CreatedBySales:       "CreatedBySales",
-	UpdatedBySales:       "UpdatedBySales",
-	SalesRepTransactions: "SalesRepTransactions",
+	Branch:                        "Branch",
+	SalesRepAccounts:              "SalesRepAccounts",
+	SalesRepAccountFollowUps:      "SalesRepAccountFollowUps",
+	CreatedByAccountHolds:         "CreatedByAccountHolds",
+	ReleasedByAccountHolds:        "ReleasedByAccountHolds",
+	ChangedByAccountStatusChanges: "ChangedByAccountStatusChanges",
+	DecidedByApprovals:            "DecidedByApprovals",
+	RequestedByApprovals:          "RequestedByApprovals",
+	KycVerifiedByCustomers:        "KycVerifiedByCustomers",
+	SalesRepCustomers:             "SalesRepCustomers",
+	UploadedByCustomerDocuments:   "UploadedByCustomerDocuments",
+	MergedByCustomerMerges:        "MergedByCustomerMerges",
+	SettledByDSCycles:             "SettledByDSCycles",
+	CreatedByImportJobs:           "CreatedByImportJobs",
+	SalesRepImportJobs:            "SalesRepImportJobs",
+	SalesRepInventories:           "SalesRepInventories",
+	CreatedByJournalEntries:       "CreatedByJournalEntries",
+	AppliedByLoans:                "AppliedByLoans",
+	ApprovedByLoans:               "ApprovedByLoans",
+	DisbursedByLoans:              "DisbursedByLoans",
+	SalesRepLoanRepayments:        "SalesRepLoanRepayments",
+	SalesRepPayments:              "SalesRepPayments",
+	ArchivedByProducts:            "ArchivedByProducts",
+	CreatedByProducts:             "CreatedByProducts",
+	UpdatedByProducts:             "UpdatedByProducts",
+	SalesRepRepsExpenses:          "SalesRepRepsExpenses",
+	ArchivedBySales:               "ArchivedBySales",
+	CreatedBySales:                "CreatedBySales",
+	UpdatedBySales:                "UpdatedBySales",
+	SalesRepTillSessions:          "SalesRepTillSessions",
+	SignedOffByTillSessions:       "SignedOffByTillSessions",
+	SubmittedByTillSessions:       "SubmittedByTillSessions",
+	ApprovedByTransactions:        "ApprovedByTransactions",
+	SalesRepTransactions:          "SalesRepTransactions",
+	SalesRepTransfers:             "SalesRepTransfers",
+	DecidedByWithdrawalRequests:   "DecidedByWithdrawalRequests",
 }
 
 // userR is where relationships are stored.
 type userR struct {
-	Branch               *Branch          `boil:"Branch" json:"Branch" toml:"Branch" yaml:"Branch"`
-	SalesRepAccounts     AccountSlice     `boil:"SalesRepAccounts" json:"SalesRepAccounts" toml:"SalesRepAccounts" yaml:"SalesRepAccounts"`
-	SalesRepCustomers    CustomerSlice    `boil:"SalesRepCustomers" json:"SalesRepCustomers" toml:"SalesRepCustomers" yaml:"SalesRepCustomers"`
-	SalesRepInventories  InventorySlice   `boil:"SalesRepInventories" json:"SalesRepInventories" toml:"SalesRepInventories" yaml:"SalesRepInventories"`
-	SalesRepPayments     PaymentSlice     `boil:"SalesRepPayments" json:"SalesRepPayments" toml:"SalesRepPayments" yaml:"SalesRepPayments"`
-	ArchivedByProducts   ProductSlice     `boil:"ArchivedByProducts" json:"ArchivedByProducts" toml:"ArchivedByProducts" yaml:"ArchivedByProducts"`
-	CreatedByProducts    ProductSlice     `boil:"CreatedByProducts" json:"CreatedByProducts" toml:"CreatedByProducts" yaml:"CreatedByProducts"`
-	UpdatedByProducts    ProductSlice     `boil:"UpdatedByProducts" json:"UpdatedByProducts" toml:"UpdatedByProducts" yaml:"UpdatedByProducts"`
-	SalesRepRepsExpenses RepsExpenseSlice `boil:"SalesRepRepsExpenses" json:"SalesRepRepsExpenses" toml:"SalesRepRepsExpenses" yaml:"SalesRepRepsExpenses"`
-	ArchivedBySales      SaleSlice        `boil:"ArchivedBySales" json:"ArchivedBySales" toml:"ArchivedBySales" yaml:"ArchivedBySales"`
-	CreatedBySales       SaleSlice        `boil:"CreatedBySales" json:"CreatedBySales" toml:"CreatedBySales" yaml:"CreatedBySales"`
-	UpdatedBySales       SaleSlice        `boil:"UpdatedBySales" json:"UpdatedBySales" toml:"UpdatedBySales" yaml:"UpdatedBySales"`
-	SalesRepTransactions TransactionSlice `boil:"SalesRepTransactions" json:"SalesRepTransactions" toml:"SalesRepTransactions" yaml:"SalesRepTransactions"`
+	Branch                        *Branch                  `boil:"Branch" json:"Branch" toml:"Branch" yaml:"Branch"`
+	SalesRepAccounts              AccountSlice             `boil:"SalesRepAccounts" json:"SalesRepAccounts" toml:"SalesRepAccounts" yaml:"SalesRepAccounts"`
+	SalesRepAccountFollowUps      AccountFollowUpSlice     `boil:"SalesRepAccountFollowUps" json:"SalesRepAccountFollowUps" toml:"SalesRepAccountFollowUps" yaml:"SalesRepAccountFollowUps"`
+	CreatedByAccountHolds         AccountHoldSlice         `boil:"CreatedByAccountHolds" json:"CreatedByAccountHolds" toml:"CreatedByAccountHolds" yaml:"CreatedByAccountHolds"`
+	ReleasedByAccountHolds        AccountHoldSlice         `boil:"ReleasedByAccountHolds" json:"ReleasedByAccountHolds" toml:"ReleasedByAccountHolds" yaml:"ReleasedByAccountHolds"`
+	ChangedByAccountStatusChanges AccountStatusChangeSlice `boil:"ChangedByAccountStatusChanges" json:"ChangedByAccountStatusChanges" toml:"ChangedByAccountStatusChanges" yaml:"ChangedByAccountStatusChanges"`
+	DecidedByApprovals            ApprovalSlice            `boil:"DecidedByApprovals" json:"DecidedByApprovals" toml:"DecidedByApprovals" yaml:"DecidedByApprovals"`
+	RequestedByApprovals          ApprovalSlice            `boil:"RequestedByApprovals" json:"RequestedByApprovals" toml:"RequestedByApprovals" yaml:"RequestedByApprovals"`
+	KycVerifiedByCustomers        CustomerSlice            `boil:"KycVerifiedByCustomers" json:"KycVerifiedByCustomers" toml:"KycVerifiedByCustomers" yaml:"KycVerifiedByCustomers"`
+	SalesRepCustomers             CustomerSlice            `boil:"SalesRepCustomers" json:"SalesRepCustomers" toml:"SalesRepCustomers" yaml:"SalesRepCustomers"`
+	UploadedByCustomerDocuments   CustomerDocumentSlice    `boil:"UploadedByCustomerDocuments" json:"UploadedByCustomerDocuments" toml:"UploadedByCustomerDocuments" yaml:"UploadedByCustomerDocuments"`
+	MergedByCustomerMerges        CustomerMergeSlice       `boil:"MergedByCustomerMerges" json:"MergedByCustomerMerges" toml:"MergedByCustomerMerges" yaml:"MergedByCustomerMerges"`
+	SettledByDSCycles             DSCycleSlice             `boil:"SettledByDSCycles" json:"SettledByDSCycles" toml:"SettledByDSCycles" yaml:"SettledByDSCycles"`
+	CreatedByImportJobs           ImportJobSlice           `boil:"CreatedByImportJobs" json:"CreatedByImportJobs" toml:"CreatedByImportJobs" yaml:"CreatedByImportJobs"`
+	SalesRepImportJobs            ImportJobSlice           `boil:"SalesRepImportJobs" json:"SalesRepImportJobs" toml:"SalesRepImportJobs" yaml:"SalesRepImportJobs"`
+	SalesRepInventories           InventorySlice           `boil:"SalesRepInventories" json:"SalesRepInventories" toml:"SalesRepInventories" yaml:"SalesRepInventories"`
+	CreatedByJournalEntries       JournalEntrySlice        `boil:"CreatedByJournalEntries" json:"CreatedByJournalEntries" toml:"CreatedByJournalEntries" yaml:"CreatedByJournalEntries"`
+	AppliedByLoans                LoanSlice                `boil:"AppliedByLoans" json:"AppliedByLoans" toml:"AppliedByLoans" yaml:"AppliedByLoans"`
+	ApprovedByLoans               LoanSlice                `boil:"ApprovedByLoans" json:"ApprovedByLoans" toml:"ApprovedByLoans" yaml:"ApprovedByLoans"`
+	DisbursedByLoans              LoanSlice                `boil:"DisbursedByLoans" json:"DisbursedByLoans" toml:"DisbursedByLoans" yaml:"DisbursedByLoans"`
+	SalesRepLoanRepayments        LoanRepaymentSlice       `boil:"SalesRepLoanRepayments" json:"SalesRepLoanRepayments" toml:"SalesRepLoanRepayments" yaml:"SalesRepLoanRepayments"`
+	SalesRepPayments              PaymentSlice             `boil:"SalesRepPayments" json:"SalesRepPayments" toml:"SalesRepPayments" yaml:"SalesRepPayments"`
+	ArchivedByProducts            ProductSlice             `boil:"ArchivedByProducts" json:"ArchivedByProducts" toml:"ArchivedByProducts" yaml:"ArchivedByProducts"`
+	CreatedByProducts             ProductSlice             `boil:"CreatedByProducts" json:"CreatedByProducts" toml:"CreatedByProducts" yaml:"CreatedByProducts"`
+	UpdatedByProducts             ProductSlice             `boil:"UpdatedByProducts" json:"UpdatedByProducts" toml:"UpdatedByProducts" yaml:"UpdatedByProducts"`
+	SalesRepRepsExpenses          RepsExpenseSlice         `boil:"SalesRepRepsExpenses" json:"SalesRepRepsExpenses" toml:"SalesRepRepsExpenses" yaml:"SalesRepRepsExpenses"`
+	ArchivedBySales               SaleSlice                `boil:"ArchivedBySales" json:"ArchivedBySales" toml:"ArchivedBySales" yaml:"ArchivedBySales"`
+	CreatedBySales                SaleSlice                `boil:"CreatedBySales" json:"CreatedBySales" toml:"CreatedBySales" yaml:"CreatedBySales"`
+	UpdatedBySales                SaleSlice                `boil:"UpdatedBySales" json:"UpdatedBySales" toml:"UpdatedBySales" yaml:"UpdatedBySales"`
+	SalesRepTillSessions          TillSessionSlice         `boil:"SalesRepTillSessions" json:"SalesRepTillSessions" toml:"SalesRepTillSessions" yaml:"SalesRepTillSessions"`
+	SignedOffByTillSessions       TillSessionSlice         `boil:"SignedOffByTillSessions" json:"SignedOffByTillSessions" toml:"SignedOffByTillSessions" yaml:"SignedOffByTillSessions"`
+	SubmittedByTillSessions       TillSessionSlice         `boil:"SubmittedByTillSessions" json:"SubmittedByTillSessions" toml:"SubmittedByTillSessions" yaml:"SubmittedByTillSessions"`
+	ApprovedByTransactions        TransactionSlice         `boil:"ApprovedByTransactions" json:"ApprovedByTransactions" toml:"ApprovedByTransactions" yaml:"ApprovedByTransactions"`
+	SalesRepTransactions          TransactionSlice         `boil:"SalesRepTransactions" json:"SalesRepTransactions" toml:"SalesRepTransactions" yaml:"SalesRepTransactions"`
+	SalesRepTransfers             TransferSlice            `boil:"SalesRepTransfers" json:"SalesRepTransfers" toml:"SalesRepTransfers" yaml:"SalesRepTransfers"`
+	DecidedByWithdrawalRequests   WithdrawalRequestSlice   `boil:"DecidedByWithdrawalRequests" json:"DecidedByWithdrawalRequests" toml:"DecidedByWithdrawalRequests" yaml:"DecidedByWithdrawalRequests"`
 }
 
 // NewStruct creates a new relationship struct
@@ -323,1489 +392,5770 @@ func (o *User) SalesRepAccounts(mods ...qm.QueryMod) accountQuery {
 	return query
 }
 
-// SalesRepCustomers retrieves all the customer's Customers with an executor via sales_rep_id column.
-func (o *User) SalesRepCustomers(mods ...qm.QueryMod) customerQuery {
+// SalesRepAccountFollowUps retrieves all the account_follow_up's AccountFollowUps with an executor via sales_rep_id column.
+func (o *User) SalesRepAccountFollowUps(mods ...qm.QueryMod) accountFollowUpQuery {
 	var queryMods []qm.QueryMod
 	if len(mods) != 0 {
 		queryMods = append(queryMods, mods...)
 	}
 
 	queryMods = append(queryMods,
-		qm.Where("\"customer\".\"sales_rep_id\"=?", o.ID),
+		qm.Where("\"account_follow_up\".\"sales_rep_id\"=?", o.ID),
 	)
 
-	query := Customers(queryMods...)
-	queries.SetFrom(query.Query, "\"customer\"")
+	query := AccountFollowUps(queryMods...)
+	queries.SetFrom(query.Query, "\"account_follow_up\"")
 
 	if len(queries.GetSelect(query.Query)) == 0 {
-		queries.SetSelect(query.Query, []string{"\"customer\".*"})
+		queries.SetSelect(query.Query, []string{"\"account_follow_up\".*"})
 	}
 
 	return query
 }
 
-// SalesRepInventories retrieves all the inventory's Inventories with an executor via sales_rep_id column.
-func (o *User) SalesRepInventories(mods ...qm.QueryMod) inventoryQuery {
+// CreatedByAccountHolds retrieves all the account_hold's AccountHolds with an executor via created_by_id column.
+func (o *User) CreatedByAccountHolds(mods ...qm.QueryMod) accountHoldQuery {
 	var queryMods []qm.QueryMod
 	if len(mods) != 0 {
 		queryMods = append(queryMods, mods...)
 	}
 
 	queryMods = append(queryMods,
-		qm.Where("\"inventory\".\"sales_rep_id\"=?", o.ID),
+		qm.Where("\"account_hold\".\"created_by_id\"=?", o.ID),
 	)
 
-	query := Inventories(queryMods...)
-	queries.SetFrom(query.Query, "\"inventory\"")
+	query := AccountHolds(queryMods...)
+	queries.SetFrom(query.Query, "\"account_hold\"")
 
 	if len(queries.GetSelect(query.Query)) == 0 {
-		queries.SetSelect(query.Query, []string{"\"inventory\".*"})
+		queries.SetSelect(query.Query, []string{"\"account_hold\".*"})
 	}
 
 	return query
 }
 
-// SalesRepPayments retrieves all the payment's Payments with an executor via sales_rep_id column.
-func (o *User) SalesRepPayments(mods ...qm.QueryMod) paymentQuery {
+// ReleasedByAccountHolds retrieves all the account_hold's AccountHolds with an executor via released_by_id column.
+func (o *User) ReleasedByAccountHolds(mods ...qm.QueryMod) accountHoldQuery {
 	var queryMods []qm.QueryMod
 	if len(mods) != 0 {
 		queryMods = append(queryMods, mods...)
 	}
 
 	queryMods = append(queryMods,
-		qm.Where("\"payment\".\"sales_rep_id\"=?", o.ID),
+		qm.Where("\"account_hold\".\"released_by_id\"=?", o.ID),
 	)
 
-	query := Payments(queryMods...)
-	queries.SetFrom(query.Query, "\"payment\"")
+	query := AccountHolds(queryMods...)
+	queries.SetFrom(query.Query, "\"account_hold\"")
 
 	if len(queries.GetSelect(query.Query)) == 0 {
-		queries.SetSelect(query.Query, []string{"\"payment\".*"})
+		queries.SetSelect(query.Query, []string{"\"account_hold\".*"})
 	}
 
 	return query
 }
 
-// ArchivedByProducts retrieves all the product's Products with an executor via archived_by_id column.
-func (o *User) ArchivedByProducts(mods ...qm.QueryMod) productQuery {
+// ChangedByAccountStatusChanges retrieves all the account_status_change's AccountStatusChanges with an executor via changed_by_id column.
+func (o *User) ChangedByAccountStatusChanges(mods ...qm.QueryMod) accountStatusChangeQuery {
 	var queryMods []qm.QueryMod
 	if len(mods) != 0 {
 		queryMods = append(queryMods, mods...)
 	}
 
 	queryMods = append(queryMods,
-		qm.Where("\"product\".\"archived_by_id\"=?", o.ID),
+		qm.Where("\"account_status_change\".\"changed_by_id\"=?", o.ID),
 	)
 
-	query := Products(queryMods...)
-	queries.SetFrom(query.Query, "\"product\"")
+	query := AccountStatusChanges(queryMods...)
+	queries.SetFrom(query.Query, "\"account_status_change\"")
 
 	if len(queries.GetSelect(query.Query)) == 0 {
-		queries.SetSelect(query.Query, []string{"\"product\".*"})
+		queries.SetSelect(query.Query, []string{"\"account_status_change\".*"})
 	}
 
 	return query
 }
 
-// CreatedByProducts retrieves all the product's Products with an executor via created_by_id column.
-func (o *User) CreatedByProducts(mods ...qm.QueryMod) productQuery {
+// DecidedByApprovals retrieves all the approval's Approvals with an executor via decided_by_id column.
+func (o *User) DecidedByApprovals(mods ...qm.QueryMod) approvalQuery {
 	var queryMods []qm.QueryMod
 	if len(mods) != 0 {
 		queryMods = append(queryMods, mods...)
 	}
 
 	queryMods = append(queryMods,
-		qm.Where("\"product\".\"created_by_id\"=?", o.ID),
+		qm.Where("\"approval\".\"decided_by_id\"=?", o.ID),
 	)
 
-	query := Products(queryMods...)
-	queries.SetFrom(query.Query, "\"product\"")
+	query := Approvals(queryMods...)
+	queries.SetFrom(query.Query, "\"approval\"")
 
 	if len(queries.GetSelect(query.Query)) == 0 {
-		queries.SetSelect(query.Query, []string{"\"product\".*"})
+		queries.SetSelect(query.Query, []string{"\"approval\".*"})
 	}
 
 	return query
 }
 
-// UpdatedByProducts retrieves all the product's Products with an executor via updated_by_id column.
-func (o *User) UpdatedByProducts(mods ...qm.QueryMod) productQuery {
+// RequestedByApprovals retrieves all the approval's Approvals with an executor via requested_by_id column.
+func (o *User) RequestedByApprovals(mods ...qm.QueryMod) approvalQuery {
 	var queryMods []qm.QueryMod
 	if len(mods) != 0 {
 		queryMods = append(queryMods, mods...)
 	}
 
 	queryMods = append(queryMods,
-		qm.Where("\"product\".\"updated_by_id\"=?", o.ID),
+		qm.Where("\"approval\".\"requested_by_id\"=?", o.ID),
 	)
 
-	query := Products(queryMods...)
-	queries.SetFrom(query.Query, "\"product\"")
+	query := Approvals(queryMods...)
+	queries.SetFrom(query.Query, "\"approval\"")
 
 	if len(queries.GetSelect(query.Query)) == 0 {
-		queries.SetSelect(query.Query, []string{"\"product\".*"})
+		queries.SetSelect(query.Query, []string{"\"approval\".*"})
 	}
 
 	return query
 }
 
-// SalesRepRepsExpenses retrieves all the reps_expense's RepsExpenses with an executor via sales_rep_id column.
-func (o *User) SalesRepRepsExpenses(mods ...qm.QueryMod) repsExpenseQuery {
+// KycVerifiedByCustomers retrieves all the customer's Customers with an executor via kyc_verified_by_id column.
+func (o *User) KycVerifiedByCustomers(mods ...qm.QueryMod) customerQuery {
 	var queryMods []qm.QueryMod
 	if len(mods) != 0 {
 		queryMods = append(queryMods, mods...)
 	}
 
 	queryMods = append(queryMods,
-		qm.Where("\"reps_expense\".\"sales_rep_id\"=?", o.ID),
+		qm.Where("\"customer\".\"kyc_verified_by_id\"=?", o.ID),
 	)
 
-	query := RepsExpenses(queryMods...)
-	queries.SetFrom(query.Query, "\"reps_expense\"")
+	query := Customers(queryMods...)
+	queries.SetFrom(query.Query, "\"customer\"")
 
 	if len(queries.GetSelect(query.Query)) == 0 {
-		queries.SetSelect(query.Query, []string{"\"reps_expense\".*"})
+		queries.SetSelect(query.Query, []string{"\"customer\".*"})
 	}
 
 	return query
 }
 
-// ArchivedBySales retrieves all the sale's Sales with an executor via archived_by_id column.
-func (o *User) ArchivedBySales(mods ...qm.QueryMod) saleQuery {
+// SalesRepCustomers retrieves all the customer's Customers with an executor via sales_rep_id column.
+func (o *User) SalesRepCustomers(mods ...qm.QueryMod) customerQuery {
 	var queryMods []qm.QueryMod
 	if len(mods) != 0 {
 		queryMods = append(queryMods, mods...)
 	}
 
 	queryMods = append(queryMods,
-		qm.Where("\"sale\".\"archived_by_id\"=?", o.ID),
+		qm.Where("\"customer\".\"sales_rep_id\"=?", o.ID),
 	)
 
-	query := Sales(queryMods...)
-	queries.SetFrom(query.Query, "\"sale\"")
+	query := Customers(queryMods...)
+	queries.SetFrom(query.Query, "\"customer\"")
 
 	if len(queries.GetSelect(query.Query)) == 0 {
-		queries.SetSelect(query.Query, []string{"\"sale\".*"})
+		queries.SetSelect(query.Query, []string{"\"customer\".*"})
 	}
 
 	return query
 }
 
-// CreatedBySales retrieves all the sale's Sales with an executor via created_by_id column.
-func (o *User) CreatedBySales(mods ...qm.QueryMod) saleQuery {
+// UploadedByCustomerDocuments retrieves all the customer_document's CustomerDocuments with an executor via uploaded_by_id column.
+func (o *User) UploadedByCustomerDocuments(mods ...qm.QueryMod) customerDocumentQuery {
 	var queryMods []qm.QueryMod
 	if len(mods) != 0 {
 		queryMods = append(queryMods, mods...)
 	}
 
 	queryMods = append(queryMods,
-		qm.Where("\"sale\".\"created_by_id\"=?", o.ID),
+		qm.Where("\"customer_document\".\"uploaded_by_id\"=?", o.ID),
 	)
 
-	query := Sales(queryMods...)
-	queries.SetFrom(query.Query, "\"sale\"")
+	query := CustomerDocuments(queryMods...)
+	queries.SetFrom(query.Query, "\"customer_document\"")
 
 	if len(queries.GetSelect(query.Query)) == 0 {
-		queries.SetSelect(query.Query, []string{"\"sale\".*"})
+		queries.SetSelect(query.Query, []string{"\"customer_document\".*"})
 	}
 
 	return query
 }
 
-// UpdatedBySales retrieves all the sale's Sales with an executor via updated_by_id column.
-func (o *User) UpdatedBySales(mods ...qm.QueryMod) saleQuery {
+// MergedByCustomerMerges retrieves all the customer_merge's CustomerMerges with an executor via merged_by_id column.
+func (o *User) MergedByCustomerMerges(mods ...qm.QueryMod) customerMergeQuery {
 	var queryMods []qm.QueryMod
 	if len(mods) != 0 {
 		queryMods = append(queryMods, mods...)
 	}
 
 	queryMods = append(queryMods,
-		qm.Where("\"sale\".\"updated_by_id\"=?", o.ID),
+		qm.Where("\"customer_merge\".\"merged_by_id\"=?", o.ID),
 	)
 
-	query := Sales(queryMods...)
-	queries.SetFrom(query.Query, "\"sale\"")
+	query := CustomerMerges(queryMods...)
+	queries.SetFrom(query.Query, "\"customer_merge\"")
 
 	if len(queries.GetSelect(query.Query)) == 0 {
-		queries.SetSelect(query.Query, []string{"\"sale\".*"})
+		queries.SetSelect(query.Query, []string{"\"customer_merge\".*"})
 	}
 
 	return query
 }
 
-// SalesRepTransactions retrieves all the transaction's Transactions with an executor via sales_rep_id column.
-func (o *User) SalesRepTransactions(mods ...qm.QueryMod) transactionQuery {
+// SettledByDSCycles retrieves all the ds_cycle's DSCycles with an executor via settled_by_id column.
+func (o *User) SettledByDSCycles(mods ...qm.QueryMod) dsCycleQuery {
 	var queryMods []qm.QueryMod
 	if len(mods) != 0 {
 		queryMods = append(queryMods, mods...)
 	}
 
 	queryMods = append(queryMods,
-		qm.Where("\"transaction\".\"sales_rep_id\"=?", o.ID),
+		qm.Where("\"ds_cycle\".\"settled_by_id\"=?", o.ID),
 	)
 
-	query := Transactions(queryMods...)
-	queries.SetFrom(query.Query, "\"transaction\"")
+	query := DSCycles(queryMods...)
+	queries.SetFrom(query.Query, "\"ds_cycle\"")
 
 	if len(queries.GetSelect(query.Query)) == 0 {
-		queries.SetSelect(query.Query, []string{"\"transaction\".*"})
+		queries.SetSelect(query.Query, []string{"\"ds_cycle\".*"})
 	}
 
 	return query
 }
 
-// LoadBranch allows an eager lookup of values, cached into the
-// loaded structs of the objects. This is for an N-1 relationship.
-func (userL) LoadBranch(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
-	var slice []*User
-	var object *User
+// CreatedByImportJobs retrieves all the import_job's ImportJobs with an executor via created_by_id column.
+func (o *User) CreatedByImportJobs(mods ...qm.QueryMod) importJobQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
 
-	if singular {
-		object = maybeUser.(*User)
-	} else {
-		slice = *maybeUser.(*[]*User)
+	queryMods = append(queryMods,
+		qm.Where("\"import_job\".\"created_by_id\"=?", o.ID),
+	)
+
+	query := ImportJobs(queryMods...)
+	queries.SetFrom(query.Query, "\"import_job\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"import_job\".*"})
 	}
 
-	args := make([]interface{}, 0, 1)
-	if singular {
-		if object.R == nil {
-			object.R = &userR{}
-		}
-		args = append(args, object.BranchID)
+	return query
+}
 
-	} else {
-	Outer:
-		for _, obj := range slice {
-			if obj.R == nil {
-				obj.R = &userR{}
-			}
+// SalesRepImportJobs retrieves all the import_job's ImportJobs with an executor via sales_rep_id column.
+func (o *User) SalesRepImportJobs(mods ...qm.QueryMod) importJobQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
 
-			for _, a := range args {
-				if a == obj.BranchID {
-					continue Outer
-				}
-			}
+	queryMods = append(queryMods,
+		qm.Where("\"import_job\".\"sales_rep_id\"=?", o.ID),
+	)
 
-			args = append(args, obj.BranchID)
+	query := ImportJobs(queryMods...)
+	queries.SetFrom(query.Query, "\"import_job\"")
 
-		}
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"import_job\".*"})
 	}
 
-	if len(args) == 0 {
-		return nil
+	return query
+}
+
+// SalesRepInventories retrieves all the inventory's Inventories with an executor via sales_rep_id column.
+func (o *User) SalesRepInventories(mods ...qm.QueryMod) inventoryQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
 	}
 
-	query := NewQuery(
-		qm.From(`branch`),
-		qm.WhereIn(`branch.id in ?`, args...),
+	queryMods = append(queryMods,
+		qm.Where("\"inventory\".\"sales_rep_id\"=?", o.ID),
 	)
-	if mods != nil {
-		mods.Apply(query)
-	}
 
-	results, err := query.QueryContext(ctx, e)
-	if err != nil {
-		return errors.Wrap(err, "failed to eager load Branch")
-	}
+	query := Inventories(queryMods...)
+	queries.SetFrom(query.Query, "\"inventory\"")
 
-	var resultSlice []*Branch
-	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice Branch")
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"inventory\".*"})
 	}
 
-	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results of eager load for branch")
-	}
-	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for branch")
-	}
+	return query
+}
 
-	if len(resultSlice) == 0 {
-		return nil
+// CreatedByJournalEntries retrieves all the journal_entry's JournalEntries with an executor via created_by_id column.
+func (o *User) CreatedByJournalEntries(mods ...qm.QueryMod) journalEntryQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
 	}
 
-	if singular {
-		foreign := resultSlice[0]
-		object.R.Branch = foreign
-		if foreign.R == nil {
-			foreign.R = &branchR{}
-		}
-		foreign.R.Users = append(foreign.R.Users, object)
-		return nil
-	}
+	queryMods = append(queryMods,
+		qm.Where("\"journal_entry\".\"created_by_id\"=?", o.ID),
+	)
 
-	for _, local := range slice {
-		for _, foreign := range resultSlice {
-			if local.BranchID == foreign.ID {
-				local.R.Branch = foreign
-				if foreign.R == nil {
-					foreign.R = &branchR{}
-				}
-				foreign.R.Users = append(foreign.R.Users, local)
-				break
-			}
-		}
+	query := JournalEntries(queryMods...)
+	queries.SetFrom(query.Query, "\"journal_entry\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"journal_entry\".*"})
 	}
 
-	return nil
+	return query
 }
 
-// LoadSalesRepAccounts allows an eager lookup of values, cached into the
-// loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (userL) LoadSalesRepAccounts(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
-	var slice []*User
-	var object *User
-
-	if singular {
-		object = maybeUser.(*User)
-	} else {
-		slice = *maybeUser.(*[]*User)
+// AppliedByLoans retrieves all the loan's Loans with an executor via applied_by_id column.
+func (o *User) AppliedByLoans(mods ...qm.QueryMod) loanQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
 	}
 
-	args := make([]interface{}, 0, 1)
-	if singular {
-		if object.R == nil {
-			object.R = &userR{}
-		}
-		args = append(args, object.ID)
-	} else {
-	Outer:
-		for _, obj := range slice {
-			if obj.R == nil {
-				obj.R = &userR{}
-			}
+	queryMods = append(queryMods,
+		qm.Where("\"loan\".\"applied_by_id\"=?", o.ID),
+	)
 
-			for _, a := range args {
-				if a == obj.ID {
-					continue Outer
-				}
-			}
+	query := Loans(queryMods...)
+	queries.SetFrom(query.Query, "\"loan\"")
 
-			args = append(args, obj.ID)
-		}
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"loan\".*"})
 	}
 
-	if len(args) == 0 {
-		return nil
+	return query
+}
+
+// ApprovedByLoans retrieves all the loan's Loans with an executor via approved_by_id column.
+func (o *User) ApprovedByLoans(mods ...qm.QueryMod) loanQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
 	}
 
-	query := NewQuery(
-		qm.From(`account`),
-		qm.WhereIn(`account.sales_rep_id in ?`, args...),
+	queryMods = append(queryMods,
+		qm.Where("\"loan\".\"approved_by_id\"=?", o.ID),
 	)
-	if mods != nil {
-		mods.Apply(query)
-	}
 
-	results, err := query.QueryContext(ctx, e)
-	if err != nil {
-		return errors.Wrap(err, "failed to eager load account")
-	}
+	query := Loans(queryMods...)
+	queries.SetFrom(query.Query, "\"loan\"")
 
-	var resultSlice []*Account
-	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice account")
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"loan\".*"})
 	}
 
-	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on account")
-	}
-	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for account")
-	}
+	return query
+}
 
-	if singular {
+// DisbursedByLoans retrieves all the loan's Loans with an executor via disbursed_by_id column.
+func (o *User) DisbursedByLoans(mods ...qm.QueryMod) loanQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"loan\".\"disbursed_by_id\"=?", o.ID),
+	)
+
+	query := Loans(queryMods...)
+	queries.SetFrom(query.Query, "\"loan\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"loan\".*"})
+	}
+
+	return query
+}
+
+// SalesRepLoanRepayments retrieves all the loan_repayment's LoanRepayments with an executor via sales_rep_id column.
+func (o *User) SalesRepLoanRepayments(mods ...qm.QueryMod) loanRepaymentQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"loan_repayment\".\"sales_rep_id\"=?", o.ID),
+	)
+
+	query := LoanRepayments(queryMods...)
+	queries.SetFrom(query.Query, "\"loan_repayment\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"loan_repayment\".*"})
+	}
+
+	return query
+}
+
+// SalesRepPayments retrieves all the payment's Payments with an executor via sales_rep_id column.
+func (o *User) SalesRepPayments(mods ...qm.QueryMod) paymentQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"payment\".\"sales_rep_id\"=?", o.ID),
+	)
+
+	query := Payments(queryMods...)
+	queries.SetFrom(query.Query, "\"payment\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"payment\".*"})
+	}
+
+	return query
+}
+
+// ArchivedByProducts retrieves all the product's Products with an executor via archived_by_id column.
+func (o *User) ArchivedByProducts(mods ...qm.QueryMod) productQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"product\".\"archived_by_id\"=?", o.ID),
+	)
+
+	query := Products(queryMods...)
+	queries.SetFrom(query.Query, "\"product\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"product\".*"})
+	}
+
+	return query
+}
+
+// CreatedByProducts retrieves all the product's Products with an executor via created_by_id column.
+func (o *User) CreatedByProducts(mods ...qm.QueryMod) productQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"product\".\"created_by_id\"=?", o.ID),
+	)
+
+	query := Products(queryMods...)
+	queries.SetFrom(query.Query, "\"product\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"product\".*"})
+	}
+
+	return query
+}
+
+// UpdatedByProducts retrieves all the product's Products with an executor via updated_by_id column.
+func (o *User) UpdatedByProducts(mods ...qm.QueryMod) productQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"product\".\"updated_by_id\"=?", o.ID),
+	)
+
+	query := Products(queryMods...)
+	queries.SetFrom(query.Query, "\"product\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"product\".*"})
+	}
+
+	return query
+}
+
+// SalesRepRepsExpenses retrieves all the reps_expense's RepsExpenses with an executor via sales_rep_id column.
+func (o *User) SalesRepRepsExpenses(mods ...qm.QueryMod) repsExpenseQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"reps_expense\".\"sales_rep_id\"=?", o.ID),
+	)
+
+	query := RepsExpenses(queryMods...)
+	queries.SetFrom(query.Query, "\"reps_expense\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"reps_expense\".*"})
+	}
+
+	return query
+}
+
+// ArchivedBySales retrieves all the sale's Sales with an executor via archived_by_id column.
+func (o *User) ArchivedBySales(mods ...qm.QueryMod) saleQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"sale\".\"archived_by_id\"=?", o.ID),
+	)
+
+	query := Sales(queryMods...)
+	queries.SetFrom(query.Query, "\"sale\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"sale\".*"})
+	}
+
+	return query
+}
+
+// CreatedBySales retrieves all the sale's Sales with an executor via created_by_id column.
+func (o *User) CreatedBySales(mods ...qm.QueryMod) saleQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"sale\".\"created_by_id\"=?", o.ID),
+	)
+
+	query := Sales(queryMods...)
+	queries.SetFrom(query.Query, "\"sale\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"sale\".*"})
+	}
+
+	return query
+}
+
+// UpdatedBySales retrieves all the sale's Sales with an executor via updated_by_id column.
+func (o *User) UpdatedBySales(mods ...qm.QueryMod) saleQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"sale\".\"updated_by_id\"=?", o.ID),
+	)
+
+	query := Sales(queryMods...)
+	queries.SetFrom(query.Query, "\"sale\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"sale\".*"})
+	}
+
+	return query
+}
+
+// SalesRepTillSessions retrieves all the till_session's TillSessions with an executor via sales_rep_id column.
+func (o *User) SalesRepTillSessions(mods ...qm.QueryMod) tillSessionQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"till_session\".\"sales_rep_id\"=?", o.ID),
+	)
+
+	query := TillSessions(queryMods...)
+	queries.SetFrom(query.Query, "\"till_session\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"till_session\".*"})
+	}
+
+	return query
+}
+
+// SignedOffByTillSessions retrieves all the till_session's TillSessions with an executor via signed_off_by_id column.
+func (o *User) SignedOffByTillSessions(mods ...qm.QueryMod) tillSessionQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"till_session\".\"signed_off_by_id\"=?", o.ID),
+	)
+
+	query := TillSessions(queryMods...)
+	queries.SetFrom(query.Query, "\"till_session\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"till_session\".*"})
+	}
+
+	return query
+}
+
+// SubmittedByTillSessions retrieves all the till_session's TillSessions with an executor via submitted_by_id column.
+func (o *User) SubmittedByTillSessions(mods ...qm.QueryMod) tillSessionQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"till_session\".\"submitted_by_id\"=?", o.ID),
+	)
+
+	query := TillSessions(queryMods...)
+	queries.SetFrom(query.Query, "\"till_session\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"till_session\".*"})
+	}
+
+	return query
+}
+
+// ApprovedByTransactions retrieves all the transaction's Transactions with an executor via approved_by_id column.
+func (o *User) ApprovedByTransactions(mods ...qm.QueryMod) transactionQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"transaction\".\"approved_by_id\"=?", o.ID),
+	)
+
+	query := Transactions(queryMods...)
+	queries.SetFrom(query.Query, "\"transaction\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"transaction\".*"})
+	}
+
+	return query
+}
+
+// SalesRepTransactions retrieves all the transaction's Transactions with an executor via sales_rep_id column.
+func (o *User) SalesRepTransactions(mods ...qm.QueryMod) transactionQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"transaction\".\"sales_rep_id\"=?", o.ID),
+	)
+
+	query := Transactions(queryMods...)
+	queries.SetFrom(query.Query, "\"transaction\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"transaction\".*"})
+	}
+
+	return query
+}
+
+// SalesRepTransfers retrieves all the transfer's Transfers with an executor via sales_rep_id column.
+func (o *User) SalesRepTransfers(mods ...qm.QueryMod) transferQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"transfer\".\"sales_rep_id\"=?", o.ID),
+	)
+
+	query := Transfers(queryMods...)
+	queries.SetFrom(query.Query, "\"transfer\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"transfer\".*"})
+	}
+
+	return query
+}
+
+// DecidedByWithdrawalRequests retrieves all the withdrawal_request's WithdrawalRequests with an executor via decided_by_id column.
+func (o *User) DecidedByWithdrawalRequests(mods ...qm.QueryMod) withdrawalRequestQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"withdrawal_request\".\"decided_by_id\"=?", o.ID),
+	)
+
+	query := WithdrawalRequests(queryMods...)
+	queries.SetFrom(query.Query, "\"withdrawal_request\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"withdrawal_request\".*"})
+	}
+
+	return query
+}
+
+// LoadBranch allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (userL) LoadBranch(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		object = maybeUser.(*User)
+	} else {
+		slice = *maybeUser.(*[]*User)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args = append(args, object.BranchID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+
+			for _, a := range args {
+				if a == obj.BranchID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.BranchID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`branch`),
+		qm.WhereIn(`branch.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Branch")
+	}
+
+	var resultSlice []*Branch
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Branch")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for branch")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for branch")
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Branch = foreign
+		if foreign.R == nil {
+			foreign.R = &branchR{}
+		}
+		foreign.R.Users = append(foreign.R.Users, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.BranchID == foreign.ID {
+				local.R.Branch = foreign
+				if foreign.R == nil {
+					foreign.R = &branchR{}
+				}
+				foreign.R.Users = append(foreign.R.Users, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadSalesRepAccounts allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadSalesRepAccounts(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		object = maybeUser.(*User)
+	} else {
+		slice = *maybeUser.(*[]*User)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`account`),
+		qm.WhereIn(`account.sales_rep_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load account")
+	}
+
+	var resultSlice []*Account
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice account")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on account")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for account")
+	}
+
+	if singular {
 		object.R.SalesRepAccounts = resultSlice
 		for _, foreign := range resultSlice {
 			if foreign.R == nil {
-				foreign.R = &accountR{}
+				foreign.R = &accountR{}
+			}
+			foreign.R.SalesRep = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.SalesRepID {
+				local.R.SalesRepAccounts = append(local.R.SalesRepAccounts, foreign)
+				if foreign.R == nil {
+					foreign.R = &accountR{}
+				}
+				foreign.R.SalesRep = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadSalesRepAccountFollowUps allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadSalesRepAccountFollowUps(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		object = maybeUser.(*User)
+	} else {
+		slice = *maybeUser.(*[]*User)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`account_follow_up`),
+		qm.WhereIn(`account_follow_up.sales_rep_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load account_follow_up")
+	}
+
+	var resultSlice []*AccountFollowUp
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice account_follow_up")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on account_follow_up")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for account_follow_up")
+	}
+
+	if singular {
+		object.R.SalesRepAccountFollowUps = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &accountFollowUpR{}
+			}
+			foreign.R.SalesRep = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.SalesRepID {
+				local.R.SalesRepAccountFollowUps = append(local.R.SalesRepAccountFollowUps, foreign)
+				if foreign.R == nil {
+					foreign.R = &accountFollowUpR{}
+				}
+				foreign.R.SalesRep = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadCreatedByAccountHolds allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadCreatedByAccountHolds(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		object = maybeUser.(*User)
+	} else {
+		slice = *maybeUser.(*[]*User)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`account_hold`),
+		qm.WhereIn(`account_hold.created_by_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load account_hold")
+	}
+
+	var resultSlice []*AccountHold
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice account_hold")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on account_hold")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for account_hold")
+	}
+
+	if singular {
+		object.R.CreatedByAccountHolds = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &accountHoldR{}
+			}
+			foreign.R.CreatedBy = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.CreatedByID {
+				local.R.CreatedByAccountHolds = append(local.R.CreatedByAccountHolds, foreign)
+				if foreign.R == nil {
+					foreign.R = &accountHoldR{}
+				}
+				foreign.R.CreatedBy = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadReleasedByAccountHolds allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadReleasedByAccountHolds(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		object = maybeUser.(*User)
+	} else {
+		slice = *maybeUser.(*[]*User)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+
+			for _, a := range args {
+				if queries.Equal(a, obj.ID) {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`account_hold`),
+		qm.WhereIn(`account_hold.released_by_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load account_hold")
+	}
+
+	var resultSlice []*AccountHold
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice account_hold")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on account_hold")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for account_hold")
+	}
+
+	if singular {
+		object.R.ReleasedByAccountHolds = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &accountHoldR{}
+			}
+			foreign.R.ReleasedBy = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.ReleasedByID) {
+				local.R.ReleasedByAccountHolds = append(local.R.ReleasedByAccountHolds, foreign)
+				if foreign.R == nil {
+					foreign.R = &accountHoldR{}
+				}
+				foreign.R.ReleasedBy = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadChangedByAccountStatusChanges allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadChangedByAccountStatusChanges(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		object = maybeUser.(*User)
+	} else {
+		slice = *maybeUser.(*[]*User)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+
+			for _, a := range args {
+				if queries.Equal(a, obj.ID) {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`account_status_change`),
+		qm.WhereIn(`account_status_change.changed_by_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load account_status_change")
+	}
+
+	var resultSlice []*AccountStatusChange
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice account_status_change")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on account_status_change")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for account_status_change")
+	}
+
+	if singular {
+		object.R.ChangedByAccountStatusChanges = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &accountStatusChangeR{}
+			}
+			foreign.R.ChangedBy = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.ChangedByID) {
+				local.R.ChangedByAccountStatusChanges = append(local.R.ChangedByAccountStatusChanges, foreign)
+				if foreign.R == nil {
+					foreign.R = &accountStatusChangeR{}
+				}
+				foreign.R.ChangedBy = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadDecidedByApprovals allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadDecidedByApprovals(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		object = maybeUser.(*User)
+	} else {
+		slice = *maybeUser.(*[]*User)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+
+			for _, a := range args {
+				if queries.Equal(a, obj.ID) {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`approval`),
+		qm.WhereIn(`approval.decided_by_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load approval")
+	}
+
+	var resultSlice []*Approval
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice approval")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on approval")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for approval")
+	}
+
+	if singular {
+		object.R.DecidedByApprovals = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &approvalR{}
+			}
+			foreign.R.DecidedBy = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.DecidedByID) {
+				local.R.DecidedByApprovals = append(local.R.DecidedByApprovals, foreign)
+				if foreign.R == nil {
+					foreign.R = &approvalR{}
+				}
+				foreign.R.DecidedBy = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadRequestedByApprovals allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadRequestedByApprovals(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		object = maybeUser.(*User)
+	} else {
+		slice = *maybeUser.(*[]*User)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`approval`),
+		qm.WhereIn(`approval.requested_by_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load approval")
+	}
+
+	var resultSlice []*Approval
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice approval")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on approval")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for approval")
+	}
+
+	if singular {
+		object.R.RequestedByApprovals = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &approvalR{}
+			}
+			foreign.R.RequestedBy = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.RequestedByID {
+				local.R.RequestedByApprovals = append(local.R.RequestedByApprovals, foreign)
+				if foreign.R == nil {
+					foreign.R = &approvalR{}
+				}
+				foreign.R.RequestedBy = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadKycVerifiedByCustomers allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadKycVerifiedByCustomers(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		object = maybeUser.(*User)
+	} else {
+		slice = *maybeUser.(*[]*User)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+
+			for _, a := range args {
+				if queries.Equal(a, obj.ID) {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`customer`),
+		qm.WhereIn(`customer.kyc_verified_by_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load customer")
+	}
+
+	var resultSlice []*Customer
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice customer")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on customer")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for customer")
+	}
+
+	if singular {
+		object.R.KycVerifiedByCustomers = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &customerR{}
+			}
+			foreign.R.KycVerifiedBy = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.KycVerifiedByID) {
+				local.R.KycVerifiedByCustomers = append(local.R.KycVerifiedByCustomers, foreign)
+				if foreign.R == nil {
+					foreign.R = &customerR{}
+				}
+				foreign.R.KycVerifiedBy = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadSalesRepCustomers allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadSalesRepCustomers(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		object = maybeUser.(*User)
+	} else {
+		slice = *maybeUser.(*[]*User)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`customer`),
+		qm.WhereIn(`customer.sales_rep_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load customer")
+	}
+
+	var resultSlice []*Customer
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice customer")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on customer")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for customer")
+	}
+
+	if singular {
+		object.R.SalesRepCustomers = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &customerR{}
+			}
+			foreign.R.SalesRep = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.SalesRepID {
+				local.R.SalesRepCustomers = append(local.R.SalesRepCustomers, foreign)
+				if foreign.R == nil {
+					foreign.R = &customerR{}
+				}
+				foreign.R.SalesRep = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadUploadedByCustomerDocuments allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadUploadedByCustomerDocuments(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		object = maybeUser.(*User)
+	} else {
+		slice = *maybeUser.(*[]*User)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`customer_document`),
+		qm.WhereIn(`customer_document.uploaded_by_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load customer_document")
+	}
+
+	var resultSlice []*CustomerDocument
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice customer_document")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on customer_document")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for customer_document")
+	}
+
+	if singular {
+		object.R.UploadedByCustomerDocuments = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &customerDocumentR{}
+			}
+			foreign.R.UploadedBy = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.UploadedByID {
+				local.R.UploadedByCustomerDocuments = append(local.R.UploadedByCustomerDocuments, foreign)
+				if foreign.R == nil {
+					foreign.R = &customerDocumentR{}
+				}
+				foreign.R.UploadedBy = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadMergedByCustomerMerges allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadMergedByCustomerMerges(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		object = maybeUser.(*User)
+	} else {
+		slice = *maybeUser.(*[]*User)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`customer_merge`),
+		qm.WhereIn(`customer_merge.merged_by_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load customer_merge")
+	}
+
+	var resultSlice []*CustomerMerge
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice customer_merge")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on customer_merge")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for customer_merge")
+	}
+
+	if singular {
+		object.R.MergedByCustomerMerges = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &customerMergeR{}
+			}
+			foreign.R.MergedBy = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.MergedByID {
+				local.R.MergedByCustomerMerges = append(local.R.MergedByCustomerMerges, foreign)
+				if foreign.R == nil {
+					foreign.R = &customerMergeR{}
+				}
+				foreign.R.MergedBy = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadSettledByDSCycles allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadSettledByDSCycles(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		object = maybeUser.(*User)
+	} else {
+		slice = *maybeUser.(*[]*User)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+
+			for _, a := range args {
+				if queries.Equal(a, obj.ID) {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`ds_cycle`),
+		qm.WhereIn(`ds_cycle.settled_by_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load ds_cycle")
+	}
+
+	var resultSlice []*DSCycle
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice ds_cycle")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on ds_cycle")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for ds_cycle")
+	}
+
+	if singular {
+		object.R.SettledByDSCycles = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &dsCycleR{}
+			}
+			foreign.R.SettledBy = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.SettledByID) {
+				local.R.SettledByDSCycles = append(local.R.SettledByDSCycles, foreign)
+				if foreign.R == nil {
+					foreign.R = &dsCycleR{}
+				}
+				foreign.R.SettledBy = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadCreatedByImportJobs allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadCreatedByImportJobs(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		object = maybeUser.(*User)
+	} else {
+		slice = *maybeUser.(*[]*User)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`import_job`),
+		qm.WhereIn(`import_job.created_by_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load import_job")
+	}
+
+	var resultSlice []*ImportJob
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice import_job")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on import_job")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for import_job")
+	}
+
+	if singular {
+		object.R.CreatedByImportJobs = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &importJobR{}
+			}
+			foreign.R.CreatedBy = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.CreatedByID {
+				local.R.CreatedByImportJobs = append(local.R.CreatedByImportJobs, foreign)
+				if foreign.R == nil {
+					foreign.R = &importJobR{}
+				}
+				foreign.R.CreatedBy = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadSalesRepImportJobs allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadSalesRepImportJobs(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		object = maybeUser.(*User)
+	} else {
+		slice = *maybeUser.(*[]*User)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`import_job`),
+		qm.WhereIn(`import_job.sales_rep_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load import_job")
+	}
+
+	var resultSlice []*ImportJob
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice import_job")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on import_job")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for import_job")
+	}
+
+	if singular {
+		object.R.SalesRepImportJobs = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &importJobR{}
+			}
+			foreign.R.SalesRep = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.SalesRepID {
+				local.R.SalesRepImportJobs = append(local.R.SalesRepImportJobs, foreign)
+				if foreign.R == nil {
+					foreign.R = &importJobR{}
+				}
+				foreign.R.SalesRep = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadSalesRepInventories allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadSalesRepInventories(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		object = maybeUser.(*User)
+	} else {
+		slice = *maybeUser.(*[]*User)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`inventory`),
+		qm.WhereIn(`inventory.sales_rep_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load inventory")
+	}
+
+	var resultSlice []*Inventory
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice inventory")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on inventory")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for inventory")
+	}
+
+	if singular {
+		object.R.SalesRepInventories = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &inventoryR{}
+			}
+			foreign.R.SalesRep = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.SalesRepID {
+				local.R.SalesRepInventories = append(local.R.SalesRepInventories, foreign)
+				if foreign.R == nil {
+					foreign.R = &inventoryR{}
+				}
+				foreign.R.SalesRep = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadCreatedByJournalEntries allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadCreatedByJournalEntries(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		object = maybeUser.(*User)
+	} else {
+		slice = *maybeUser.(*[]*User)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+
+			for _, a := range args {
+				if queries.Equal(a, obj.ID) {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`journal_entry`),
+		qm.WhereIn(`journal_entry.created_by_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load journal_entry")
+	}
+
+	var resultSlice []*JournalEntry
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice journal_entry")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on journal_entry")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for journal_entry")
+	}
+
+	if singular {
+		object.R.CreatedByJournalEntries = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &journalEntryR{}
+			}
+			foreign.R.CreatedBy = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.CreatedByID) {
+				local.R.CreatedByJournalEntries = append(local.R.CreatedByJournalEntries, foreign)
+				if foreign.R == nil {
+					foreign.R = &journalEntryR{}
+				}
+				foreign.R.CreatedBy = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadAppliedByLoans allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadAppliedByLoans(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		object = maybeUser.(*User)
+	} else {
+		slice = *maybeUser.(*[]*User)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`loan`),
+		qm.WhereIn(`loan.applied_by_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load loan")
+	}
+
+	var resultSlice []*Loan
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice loan")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on loan")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for loan")
+	}
+
+	if singular {
+		object.R.AppliedByLoans = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &loanR{}
+			}
+			foreign.R.AppliedBy = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.AppliedByID {
+				local.R.AppliedByLoans = append(local.R.AppliedByLoans, foreign)
+				if foreign.R == nil {
+					foreign.R = &loanR{}
+				}
+				foreign.R.AppliedBy = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadApprovedByLoans allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadApprovedByLoans(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		object = maybeUser.(*User)
+	} else {
+		slice = *maybeUser.(*[]*User)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+
+			for _, a := range args {
+				if queries.Equal(a, obj.ID) {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`loan`),
+		qm.WhereIn(`loan.approved_by_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load loan")
+	}
+
+	var resultSlice []*Loan
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice loan")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on loan")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for loan")
+	}
+
+	if singular {
+		object.R.ApprovedByLoans = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &loanR{}
+			}
+			foreign.R.ApprovedBy = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.ApprovedByID) {
+				local.R.ApprovedByLoans = append(local.R.ApprovedByLoans, foreign)
+				if foreign.R == nil {
+					foreign.R = &loanR{}
+				}
+				foreign.R.ApprovedBy = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadDisbursedByLoans allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadDisbursedByLoans(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		object = maybeUser.(*User)
+	} else {
+		slice = *maybeUser.(*[]*User)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+
+			for _, a := range args {
+				if queries.Equal(a, obj.ID) {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`loan`),
+		qm.WhereIn(`loan.disbursed_by_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load loan")
+	}
+
+	var resultSlice []*Loan
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice loan")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on loan")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for loan")
+	}
+
+	if singular {
+		object.R.DisbursedByLoans = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &loanR{}
+			}
+			foreign.R.DisbursedBy = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.DisbursedByID) {
+				local.R.DisbursedByLoans = append(local.R.DisbursedByLoans, foreign)
+				if foreign.R == nil {
+					foreign.R = &loanR{}
+				}
+				foreign.R.DisbursedBy = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadSalesRepLoanRepayments allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadSalesRepLoanRepayments(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		object = maybeUser.(*User)
+	} else {
+		slice = *maybeUser.(*[]*User)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`loan_repayment`),
+		qm.WhereIn(`loan_repayment.sales_rep_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load loan_repayment")
+	}
+
+	var resultSlice []*LoanRepayment
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice loan_repayment")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on loan_repayment")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for loan_repayment")
+	}
+
+	if singular {
+		object.R.SalesRepLoanRepayments = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &loanRepaymentR{}
+			}
+			foreign.R.SalesRep = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.SalesRepID {
+				local.R.SalesRepLoanRepayments = append(local.R.SalesRepLoanRepayments, foreign)
+				if foreign.R == nil {
+					foreign.R = &loanRepaymentR{}
+				}
+				foreign.R.SalesRep = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadSalesRepPayments allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadSalesRepPayments(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		object = maybeUser.(*User)
+	} else {
+		slice = *maybeUser.(*[]*User)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`payment`),
+		qm.WhereIn(`payment.sales_rep_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load payment")
+	}
+
+	var resultSlice []*Payment
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice payment")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on payment")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for payment")
+	}
+
+	if singular {
+		object.R.SalesRepPayments = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &paymentR{}
+			}
+			foreign.R.SalesRep = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.SalesRepID {
+				local.R.SalesRepPayments = append(local.R.SalesRepPayments, foreign)
+				if foreign.R == nil {
+					foreign.R = &paymentR{}
+				}
+				foreign.R.SalesRep = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadArchivedByProducts allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadArchivedByProducts(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		object = maybeUser.(*User)
+	} else {
+		slice = *maybeUser.(*[]*User)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+
+			for _, a := range args {
+				if queries.Equal(a, obj.ID) {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`product`),
+		qm.WhereIn(`product.archived_by_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load product")
+	}
+
+	var resultSlice []*Product
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice product")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on product")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for product")
+	}
+
+	if singular {
+		object.R.ArchivedByProducts = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &productR{}
+			}
+			foreign.R.ArchivedBy = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.ArchivedByID) {
+				local.R.ArchivedByProducts = append(local.R.ArchivedByProducts, foreign)
+				if foreign.R == nil {
+					foreign.R = &productR{}
+				}
+				foreign.R.ArchivedBy = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadCreatedByProducts allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadCreatedByProducts(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		object = maybeUser.(*User)
+	} else {
+		slice = *maybeUser.(*[]*User)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`product`),
+		qm.WhereIn(`product.created_by_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load product")
+	}
+
+	var resultSlice []*Product
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice product")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on product")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for product")
+	}
+
+	if singular {
+		object.R.CreatedByProducts = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &productR{}
+			}
+			foreign.R.CreatedBy = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.CreatedByID {
+				local.R.CreatedByProducts = append(local.R.CreatedByProducts, foreign)
+				if foreign.R == nil {
+					foreign.R = &productR{}
+				}
+				foreign.R.CreatedBy = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadUpdatedByProducts allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadUpdatedByProducts(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		object = maybeUser.(*User)
+	} else {
+		slice = *maybeUser.(*[]*User)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`product`),
+		qm.WhereIn(`product.updated_by_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load product")
+	}
+
+	var resultSlice []*Product
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice product")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on product")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for product")
+	}
+
+	if singular {
+		object.R.UpdatedByProducts = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &productR{}
+			}
+			foreign.R.UpdatedBy = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.UpdatedByID {
+				local.R.UpdatedByProducts = append(local.R.UpdatedByProducts, foreign)
+				if foreign.R == nil {
+					foreign.R = &productR{}
+				}
+				foreign.R.UpdatedBy = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadSalesRepRepsExpenses allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadSalesRepRepsExpenses(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		object = maybeUser.(*User)
+	} else {
+		slice = *maybeUser.(*[]*User)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`reps_expense`),
+		qm.WhereIn(`reps_expense.sales_rep_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load reps_expense")
+	}
+
+	var resultSlice []*RepsExpense
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice reps_expense")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on reps_expense")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for reps_expense")
+	}
+
+	if singular {
+		object.R.SalesRepRepsExpenses = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &repsExpenseR{}
+			}
+			foreign.R.SalesRep = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.SalesRepID {
+				local.R.SalesRepRepsExpenses = append(local.R.SalesRepRepsExpenses, foreign)
+				if foreign.R == nil {
+					foreign.R = &repsExpenseR{}
+				}
+				foreign.R.SalesRep = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadArchivedBySales allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadArchivedBySales(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		object = maybeUser.(*User)
+	} else {
+		slice = *maybeUser.(*[]*User)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+
+			for _, a := range args {
+				if queries.Equal(a, obj.ID) {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`sale`),
+		qm.WhereIn(`sale.archived_by_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load sale")
+	}
+
+	var resultSlice []*Sale
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice sale")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on sale")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for sale")
+	}
+
+	if singular {
+		object.R.ArchivedBySales = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &saleR{}
+			}
+			foreign.R.ArchivedBy = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.ArchivedByID) {
+				local.R.ArchivedBySales = append(local.R.ArchivedBySales, foreign)
+				if foreign.R == nil {
+					foreign.R = &saleR{}
+				}
+				foreign.R.ArchivedBy = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadCreatedBySales allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadCreatedBySales(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		object = maybeUser.(*User)
+	} else {
+		slice = *maybeUser.(*[]*User)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`sale`),
+		qm.WhereIn(`sale.created_by_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load sale")
+	}
+
+	var resultSlice []*Sale
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice sale")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on sale")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for sale")
+	}
+
+	if singular {
+		object.R.CreatedBySales = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &saleR{}
+			}
+			foreign.R.CreatedBy = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.CreatedByID {
+				local.R.CreatedBySales = append(local.R.CreatedBySales, foreign)
+				if foreign.R == nil {
+					foreign.R = &saleR{}
+				}
+				foreign.R.CreatedBy = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadUpdatedBySales allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadUpdatedBySales(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		object = maybeUser.(*User)
+	} else {
+		slice = *maybeUser.(*[]*User)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+
+			for _, a := range args {
+				if queries.Equal(a, obj.ID) {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`sale`),
+		qm.WhereIn(`sale.updated_by_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load sale")
+	}
+
+	var resultSlice []*Sale
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice sale")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on sale")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for sale")
+	}
+
+	if singular {
+		object.R.UpdatedBySales = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &saleR{}
+			}
+			foreign.R.UpdatedBy = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.UpdatedByID) {
+				local.R.UpdatedBySales = append(local.R.UpdatedBySales, foreign)
+				if foreign.R == nil {
+					foreign.R = &saleR{}
+				}
+				foreign.R.UpdatedBy = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadSalesRepTillSessions allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadSalesRepTillSessions(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		object = maybeUser.(*User)
+	} else {
+		slice = *maybeUser.(*[]*User)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`till_session`),
+		qm.WhereIn(`till_session.sales_rep_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load till_session")
+	}
+
+	var resultSlice []*TillSession
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice till_session")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on till_session")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for till_session")
+	}
+
+	if singular {
+		object.R.SalesRepTillSessions = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &tillSessionR{}
+			}
+			foreign.R.SalesRep = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.SalesRepID {
+				local.R.SalesRepTillSessions = append(local.R.SalesRepTillSessions, foreign)
+				if foreign.R == nil {
+					foreign.R = &tillSessionR{}
+				}
+				foreign.R.SalesRep = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadSignedOffByTillSessions allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadSignedOffByTillSessions(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		object = maybeUser.(*User)
+	} else {
+		slice = *maybeUser.(*[]*User)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+
+			for _, a := range args {
+				if queries.Equal(a, obj.ID) {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`till_session`),
+		qm.WhereIn(`till_session.signed_off_by_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load till_session")
+	}
+
+	var resultSlice []*TillSession
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice till_session")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on till_session")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for till_session")
+	}
+
+	if singular {
+		object.R.SignedOffByTillSessions = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &tillSessionR{}
+			}
+			foreign.R.SignedOffBy = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.SignedOffByID) {
+				local.R.SignedOffByTillSessions = append(local.R.SignedOffByTillSessions, foreign)
+				if foreign.R == nil {
+					foreign.R = &tillSessionR{}
+				}
+				foreign.R.SignedOffBy = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadSubmittedByTillSessions allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadSubmittedByTillSessions(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		object = maybeUser.(*User)
+	} else {
+		slice = *maybeUser.(*[]*User)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`till_session`),
+		qm.WhereIn(`till_session.submitted_by_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load till_session")
+	}
+
+	var resultSlice []*TillSession
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice till_session")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on till_session")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for till_session")
+	}
+
+	if singular {
+		object.R.SubmittedByTillSessions = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &tillSessionR{}
+			}
+			foreign.R.SubmittedBy = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.SubmittedByID {
+				local.R.SubmittedByTillSessions = append(local.R.SubmittedByTillSessions, foreign)
+				if foreign.R == nil {
+					foreign.R = &tillSessionR{}
+				}
+				foreign.R.SubmittedBy = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadApprovedByTransactions allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadApprovedByTransactions(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		object = maybeUser.(*User)
+	} else {
+		slice = *maybeUser.(*[]*User)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+
+			for _, a := range args {
+				if queries.Equal(a, obj.ID) {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`transaction`),
+		qm.WhereIn(`transaction.approved_by_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load transaction")
+	}
+
+	var resultSlice []*Transaction
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice transaction")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on transaction")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for transaction")
+	}
+
+	if singular {
+		object.R.ApprovedByTransactions = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &transactionR{}
+			}
+			foreign.R.ApprovedBy = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.ApprovedByID) {
+				local.R.ApprovedByTransactions = append(local.R.ApprovedByTransactions, foreign)
+				if foreign.R == nil {
+					foreign.R = &transactionR{}
+				}
+				foreign.R.ApprovedBy = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadSalesRepTransactions allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadSalesRepTransactions(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		object = maybeUser.(*User)
+	} else {
+		slice = *maybeUser.(*[]*User)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`transaction`),
+		qm.WhereIn(`transaction.sales_rep_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load transaction")
+	}
+
+	var resultSlice []*Transaction
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice transaction")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on transaction")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for transaction")
+	}
+
+	if singular {
+		object.R.SalesRepTransactions = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &transactionR{}
+			}
+			foreign.R.SalesRep = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.SalesRepID {
+				local.R.SalesRepTransactions = append(local.R.SalesRepTransactions, foreign)
+				if foreign.R == nil {
+					foreign.R = &transactionR{}
+				}
+				foreign.R.SalesRep = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadSalesRepTransfers allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadSalesRepTransfers(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		object = maybeUser.(*User)
+	} else {
+		slice = *maybeUser.(*[]*User)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`transfer`),
+		qm.WhereIn(`transfer.sales_rep_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load transfer")
+	}
+
+	var resultSlice []*Transfer
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice transfer")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on transfer")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for transfer")
+	}
+
+	if singular {
+		object.R.SalesRepTransfers = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &transferR{}
+			}
+			foreign.R.SalesRep = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.SalesRepID {
+				local.R.SalesRepTransfers = append(local.R.SalesRepTransfers, foreign)
+				if foreign.R == nil {
+					foreign.R = &transferR{}
+				}
+				foreign.R.SalesRep = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadDecidedByWithdrawalRequests allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadDecidedByWithdrawalRequests(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		object = maybeUser.(*User)
+	} else {
+		slice = *maybeUser.(*[]*User)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+
+			for _, a := range args {
+				if queries.Equal(a, obj.ID) {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`withdrawal_request`),
+		qm.WhereIn(`withdrawal_request.decided_by_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load withdrawal_request")
+	}
+
+	var resultSlice []*WithdrawalRequest
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice withdrawal_request")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on withdrawal_request")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for withdrawal_request")
+	}
+
+	if singular {
+		object.R.DecidedByWithdrawalRequests = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &withdrawalRequestR{}
+			}
+			foreign.R.DecidedBy = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.DecidedByID) {
+				local.R.DecidedByWithdrawalRequests = append(local.R.DecidedByWithdrawalRequests, foreign)
+				if foreign.R == nil {
+					foreign.R = &withdrawalRequestR{}
+				}
+				foreign.R.DecidedBy = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetBranch of the user to the related item.
+// Sets o.R.Branch to related.
+// Adds o to related.R.Users.
+func (o *User) SetBranch(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Branch) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"users\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"branch_id"}),
+		strmangle.WhereClause("\"", "\"", 2, userPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.BranchID = related.ID
+	if o.R == nil {
+		o.R = &userR{
+			Branch: related,
+		}
+	} else {
+		o.R.Branch = related
+	}
+
+	if related.R == nil {
+		related.R = &branchR{
+			Users: UserSlice{o},
+		}
+	} else {
+		related.R.Users = append(related.R.Users, o)
+	}
+
+	return nil
+}
+
+// AddSalesRepAccounts adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.SalesRepAccounts.
+// Sets related.R.SalesRep appropriately.
+func (o *User) AddSalesRepAccounts(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Account) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.SalesRepID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"account\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"sales_rep_id"}),
+				strmangle.WhereClause("\"", "\"", 2, accountPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
 			}
-			foreign.R.SalesRep = object
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.SalesRepID = o.ID
 		}
-		return nil
 	}
 
-	for _, foreign := range resultSlice {
-		for _, local := range slice {
-			if local.ID == foreign.SalesRepID {
-				local.R.SalesRepAccounts = append(local.R.SalesRepAccounts, foreign)
-				if foreign.R == nil {
-					foreign.R = &accountR{}
-				}
-				foreign.R.SalesRep = local
-				break
-			}
+	if o.R == nil {
+		o.R = &userR{
+			SalesRepAccounts: related,
 		}
+	} else {
+		o.R.SalesRepAccounts = append(o.R.SalesRepAccounts, related...)
 	}
 
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &accountR{
+				SalesRep: o,
+			}
+		} else {
+			rel.R.SalesRep = o
+		}
+	}
 	return nil
 }
 
-// LoadSalesRepCustomers allows an eager lookup of values, cached into the
-// loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (userL) LoadSalesRepCustomers(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
-	var slice []*User
-	var object *User
+// AddSalesRepAccountFollowUps adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.SalesRepAccountFollowUps.
+// Sets related.R.SalesRep appropriately.
+func (o *User) AddSalesRepAccountFollowUps(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*AccountFollowUp) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.SalesRepID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"account_follow_up\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"sales_rep_id"}),
+				strmangle.WhereClause("\"", "\"", 2, accountFollowUpPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
 
-	if singular {
-		object = maybeUser.(*User)
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.SalesRepID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			SalesRepAccountFollowUps: related,
+		}
 	} else {
-		slice = *maybeUser.(*[]*User)
+		o.R.SalesRepAccountFollowUps = append(o.R.SalesRepAccountFollowUps, related...)
 	}
 
-	args := make([]interface{}, 0, 1)
-	if singular {
-		if object.R == nil {
-			object.R = &userR{}
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &accountFollowUpR{
+				SalesRep: o,
+			}
+		} else {
+			rel.R.SalesRep = o
+		}
+	}
+	return nil
+}
+
+// AddCreatedByAccountHolds adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.CreatedByAccountHolds.
+// Sets related.R.CreatedBy appropriately.
+func (o *User) AddCreatedByAccountHolds(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*AccountHold) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.CreatedByID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"account_hold\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"created_by_id"}),
+				strmangle.WhereClause("\"", "\"", 2, accountHoldPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.CreatedByID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			CreatedByAccountHolds: related,
 		}
-		args = append(args, object.ID)
 	} else {
-	Outer:
-		for _, obj := range slice {
-			if obj.R == nil {
-				obj.R = &userR{}
+		o.R.CreatedByAccountHolds = append(o.R.CreatedByAccountHolds, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &accountHoldR{
+				CreatedBy: o,
 			}
+		} else {
+			rel.R.CreatedBy = o
+		}
+	}
+	return nil
+}
 
-			for _, a := range args {
-				if a == obj.ID {
-					continue Outer
-				}
+// AddReleasedByAccountHolds adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.ReleasedByAccountHolds.
+// Sets related.R.ReleasedBy appropriately.
+func (o *User) AddReleasedByAccountHolds(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*AccountHold) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.ReleasedByID, o.ID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
 			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"account_hold\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"released_by_id"}),
+				strmangle.WhereClause("\"", "\"", 2, accountHoldPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
 
-			args = append(args, obj.ID)
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.ReleasedByID, o.ID)
 		}
 	}
 
-	if len(args) == 0 {
-		return nil
+	if o.R == nil {
+		o.R = &userR{
+			ReleasedByAccountHolds: related,
+		}
+	} else {
+		o.R.ReleasedByAccountHolds = append(o.R.ReleasedByAccountHolds, related...)
 	}
 
-	query := NewQuery(
-		qm.From(`customer`),
-		qm.WhereIn(`customer.sales_rep_id in ?`, args...),
-	)
-	if mods != nil {
-		mods.Apply(query)
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &accountHoldR{
+				ReleasedBy: o,
+			}
+		} else {
+			rel.R.ReleasedBy = o
+		}
 	}
+	return nil
+}
 
-	results, err := query.QueryContext(ctx, e)
+// SetReleasedByAccountHolds removes all previously related items of the
+// user replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.ReleasedBy's ReleasedByAccountHolds accordingly.
+// Replaces o.R.ReleasedByAccountHolds with related.
+// Sets related.R.ReleasedBy's ReleasedByAccountHolds accordingly.
+func (o *User) SetReleasedByAccountHolds(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*AccountHold) error {
+	query := "update \"account_hold\" set \"released_by_id\" = null where \"released_by_id\" = $1"
+	values := []interface{}{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load customer")
+		return errors.Wrap(err, "failed to remove relationships before set")
 	}
 
-	var resultSlice []*Customer
-	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice customer")
+	if o.R != nil {
+		for _, rel := range o.R.ReleasedByAccountHolds {
+			queries.SetScanner(&rel.ReleasedByID, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.ReleasedBy = nil
+		}
+
+		o.R.ReleasedByAccountHolds = nil
 	}
+	return o.AddReleasedByAccountHolds(ctx, exec, insert, related...)
+}
 
-	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on customer")
+// RemoveReleasedByAccountHolds relationships from objects passed in.
+// Removes related items from R.ReleasedByAccountHolds (uses pointer comparison, removal does not keep order)
+// Sets related.R.ReleasedBy.
+func (o *User) RemoveReleasedByAccountHolds(ctx context.Context, exec boil.ContextExecutor, related ...*AccountHold) error {
+	if len(related) == 0 {
+		return nil
 	}
-	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for customer")
+
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.ReleasedByID, nil)
+		if rel.R != nil {
+			rel.R.ReleasedBy = nil
+		}
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("released_by_id")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
 	}
 
-	if singular {
-		object.R.SalesRepCustomers = resultSlice
-		for _, foreign := range resultSlice {
-			if foreign.R == nil {
-				foreign.R = &customerR{}
+	for _, rel := range related {
+		for i, ri := range o.R.ReleasedByAccountHolds {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.ReleasedByAccountHolds)
+			if ln > 1 && i < ln-1 {
+				o.R.ReleasedByAccountHolds[i] = o.R.ReleasedByAccountHolds[ln-1]
+			}
+			o.R.ReleasedByAccountHolds = o.R.ReleasedByAccountHolds[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
+// AddChangedByAccountStatusChanges adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.ChangedByAccountStatusChanges.
+// Sets related.R.ChangedBy appropriately.
+func (o *User) AddChangedByAccountStatusChanges(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*AccountStatusChange) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.ChangedByID, o.ID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"account_status_change\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"changed_by_id"}),
+				strmangle.WhereClause("\"", "\"", 2, accountStatusChangePrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
 			}
-			foreign.R.SalesRep = object
+
+			queries.Assign(&rel.ChangedByID, o.ID)
 		}
-		return nil
 	}
 
-	for _, foreign := range resultSlice {
-		for _, local := range slice {
-			if local.ID == foreign.SalesRepID {
-				local.R.SalesRepCustomers = append(local.R.SalesRepCustomers, foreign)
-				if foreign.R == nil {
-					foreign.R = &customerR{}
-				}
-				foreign.R.SalesRep = local
-				break
-			}
+	if o.R == nil {
+		o.R = &userR{
+			ChangedByAccountStatusChanges: related,
 		}
+	} else {
+		o.R.ChangedByAccountStatusChanges = append(o.R.ChangedByAccountStatusChanges, related...)
 	}
 
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &accountStatusChangeR{
+				ChangedBy: o,
+			}
+		} else {
+			rel.R.ChangedBy = o
+		}
+	}
 	return nil
 }
 
-// LoadSalesRepInventories allows an eager lookup of values, cached into the
-// loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (userL) LoadSalesRepInventories(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
-	var slice []*User
-	var object *User
-
-	if singular {
-		object = maybeUser.(*User)
-	} else {
-		slice = *maybeUser.(*[]*User)
+// SetChangedByAccountStatusChanges removes all previously related items of the
+// user replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.ChangedBy's ChangedByAccountStatusChanges accordingly.
+// Replaces o.R.ChangedByAccountStatusChanges with related.
+// Sets related.R.ChangedBy's ChangedByAccountStatusChanges accordingly.
+func (o *User) SetChangedByAccountStatusChanges(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*AccountStatusChange) error {
+	query := "update \"account_status_change\" set \"changed_by_id\" = null where \"changed_by_id\" = $1"
+	values := []interface{}{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
 	}
 
-	args := make([]interface{}, 0, 1)
-	if singular {
-		if object.R == nil {
-			object.R = &userR{}
-		}
-		args = append(args, object.ID)
-	} else {
-	Outer:
-		for _, obj := range slice {
-			if obj.R == nil {
-				obj.R = &userR{}
-			}
-
-			for _, a := range args {
-				if a == obj.ID {
-					continue Outer
-				}
+	if o.R != nil {
+		for _, rel := range o.R.ChangedByAccountStatusChanges {
+			queries.SetScanner(&rel.ChangedByID, nil)
+			if rel.R == nil {
+				continue
 			}
 
-			args = append(args, obj.ID)
+			rel.R.ChangedBy = nil
 		}
-	}
-
-	if len(args) == 0 {
-		return nil
-	}
-
-	query := NewQuery(
-		qm.From(`inventory`),
-		qm.WhereIn(`inventory.sales_rep_id in ?`, args...),
-	)
-	if mods != nil {
-		mods.Apply(query)
-	}
 
-	results, err := query.QueryContext(ctx, e)
-	if err != nil {
-		return errors.Wrap(err, "failed to eager load inventory")
+		o.R.ChangedByAccountStatusChanges = nil
 	}
+	return o.AddChangedByAccountStatusChanges(ctx, exec, insert, related...)
+}
 
-	var resultSlice []*Inventory
-	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice inventory")
+// RemoveChangedByAccountStatusChanges relationships from objects passed in.
+// Removes related items from R.ChangedByAccountStatusChanges (uses pointer comparison, removal does not keep order)
+// Sets related.R.ChangedBy.
+func (o *User) RemoveChangedByAccountStatusChanges(ctx context.Context, exec boil.ContextExecutor, related ...*AccountStatusChange) error {
+	if len(related) == 0 {
+		return nil
 	}
 
-	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on inventory")
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.ChangedByID, nil)
+		if rel.R != nil {
+			rel.R.ChangedBy = nil
+		}
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("changed_by_id")); err != nil {
+			return err
+		}
 	}
-	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for inventory")
+	if o.R == nil {
+		return nil
 	}
 
-	if singular {
-		object.R.SalesRepInventories = resultSlice
-		for _, foreign := range resultSlice {
-			if foreign.R == nil {
-				foreign.R = &inventoryR{}
+	for _, rel := range related {
+		for i, ri := range o.R.ChangedByAccountStatusChanges {
+			if rel != ri {
+				continue
 			}
-			foreign.R.SalesRep = object
-		}
-		return nil
-	}
 
-	for _, foreign := range resultSlice {
-		for _, local := range slice {
-			if local.ID == foreign.SalesRepID {
-				local.R.SalesRepInventories = append(local.R.SalesRepInventories, foreign)
-				if foreign.R == nil {
-					foreign.R = &inventoryR{}
-				}
-				foreign.R.SalesRep = local
-				break
+			ln := len(o.R.ChangedByAccountStatusChanges)
+			if ln > 1 && i < ln-1 {
+				o.R.ChangedByAccountStatusChanges[i] = o.R.ChangedByAccountStatusChanges[ln-1]
 			}
+			o.R.ChangedByAccountStatusChanges = o.R.ChangedByAccountStatusChanges[:ln-1]
+			break
 		}
 	}
 
 	return nil
 }
 
-// LoadSalesRepPayments allows an eager lookup of values, cached into the
-// loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (userL) LoadSalesRepPayments(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
-	var slice []*User
-	var object *User
+// AddDecidedByApprovals adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.DecidedByApprovals.
+// Sets related.R.DecidedBy appropriately.
+func (o *User) AddDecidedByApprovals(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Approval) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.DecidedByID, o.ID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"approval\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"decided_by_id"}),
+				strmangle.WhereClause("\"", "\"", 2, approvalPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
 
-	if singular {
-		object = maybeUser.(*User)
-	} else {
-		slice = *maybeUser.(*[]*User)
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.DecidedByID, o.ID)
+		}
 	}
 
-	args := make([]interface{}, 0, 1)
-	if singular {
-		if object.R == nil {
-			object.R = &userR{}
+	if o.R == nil {
+		o.R = &userR{
+			DecidedByApprovals: related,
 		}
-		args = append(args, object.ID)
 	} else {
-	Outer:
-		for _, obj := range slice {
-			if obj.R == nil {
-				obj.R = &userR{}
+		o.R.DecidedByApprovals = append(o.R.DecidedByApprovals, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &approvalR{
+				DecidedBy: o,
 			}
+		} else {
+			rel.R.DecidedBy = o
+		}
+	}
+	return nil
+}
 
-			for _, a := range args {
-				if a == obj.ID {
-					continue Outer
-				}
+// SetDecidedByApprovals removes all previously related items of the
+// user replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.DecidedBy's DecidedByApprovals accordingly.
+// Replaces o.R.DecidedByApprovals with related.
+// Sets related.R.DecidedBy's DecidedByApprovals accordingly.
+func (o *User) SetDecidedByApprovals(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Approval) error {
+	query := "update \"approval\" set \"decided_by_id\" = null where \"decided_by_id\" = $1"
+	values := []interface{}{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.DecidedByApprovals {
+			queries.SetScanner(&rel.DecidedByID, nil)
+			if rel.R == nil {
+				continue
 			}
 
-			args = append(args, obj.ID)
+			rel.R.DecidedBy = nil
 		}
+
+		o.R.DecidedByApprovals = nil
 	}
+	return o.AddDecidedByApprovals(ctx, exec, insert, related...)
+}
 
-	if len(args) == 0 {
+// RemoveDecidedByApprovals relationships from objects passed in.
+// Removes related items from R.DecidedByApprovals (uses pointer comparison, removal does not keep order)
+// Sets related.R.DecidedBy.
+func (o *User) RemoveDecidedByApprovals(ctx context.Context, exec boil.ContextExecutor, related ...*Approval) error {
+	if len(related) == 0 {
 		return nil
 	}
 
-	query := NewQuery(
-		qm.From(`payment`),
-		qm.WhereIn(`payment.sales_rep_id in ?`, args...),
-	)
-	if mods != nil {
-		mods.Apply(query)
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.DecidedByID, nil)
+		if rel.R != nil {
+			rel.R.DecidedBy = nil
+		}
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("decided_by_id")); err != nil {
+			return err
+		}
 	}
-
-	results, err := query.QueryContext(ctx, e)
-	if err != nil {
-		return errors.Wrap(err, "failed to eager load payment")
+	if o.R == nil {
+		return nil
 	}
 
-	var resultSlice []*Payment
-	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice payment")
-	}
+	for _, rel := range related {
+		for i, ri := range o.R.DecidedByApprovals {
+			if rel != ri {
+				continue
+			}
 
-	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on payment")
+			ln := len(o.R.DecidedByApprovals)
+			if ln > 1 && i < ln-1 {
+				o.R.DecidedByApprovals[i] = o.R.DecidedByApprovals[ln-1]
+			}
+			o.R.DecidedByApprovals = o.R.DecidedByApprovals[:ln-1]
+			break
+		}
 	}
-	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for payment")
+
+	return nil
+}
+
+// AddRequestedByApprovals adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.RequestedByApprovals.
+// Sets related.R.RequestedBy appropriately.
+func (o *User) AddRequestedByApprovals(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Approval) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.RequestedByID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"approval\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"requested_by_id"}),
+				strmangle.WhereClause("\"", "\"", 2, approvalPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.RequestedByID = o.ID
+		}
 	}
 
-	if singular {
-		object.R.SalesRepPayments = resultSlice
-		for _, foreign := range resultSlice {
-			if foreign.R == nil {
-				foreign.R = &paymentR{}
-			}
-			foreign.R.SalesRep = object
+	if o.R == nil {
+		o.R = &userR{
+			RequestedByApprovals: related,
 		}
-		return nil
+	} else {
+		o.R.RequestedByApprovals = append(o.R.RequestedByApprovals, related...)
 	}
 
-	for _, foreign := range resultSlice {
-		for _, local := range slice {
-			if local.ID == foreign.SalesRepID {
-				local.R.SalesRepPayments = append(local.R.SalesRepPayments, foreign)
-				if foreign.R == nil {
-					foreign.R = &paymentR{}
-				}
-				foreign.R.SalesRep = local
-				break
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &approvalR{
+				RequestedBy: o,
 			}
+		} else {
+			rel.R.RequestedBy = o
 		}
 	}
-
 	return nil
 }
 
-// LoadArchivedByProducts allows an eager lookup of values, cached into the
-// loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (userL) LoadArchivedByProducts(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
-	var slice []*User
-	var object *User
-
-	if singular {
-		object = maybeUser.(*User)
-	} else {
-		slice = *maybeUser.(*[]*User)
-	}
-
-	args := make([]interface{}, 0, 1)
-	if singular {
-		if object.R == nil {
-			object.R = &userR{}
-		}
-		args = append(args, object.ID)
-	} else {
-	Outer:
-		for _, obj := range slice {
-			if obj.R == nil {
-				obj.R = &userR{}
+// AddKycVerifiedByCustomers adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.KycVerifiedByCustomers.
+// Sets related.R.KycVerifiedBy appropriately.
+func (o *User) AddKycVerifiedByCustomers(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Customer) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.KycVerifiedByID, o.ID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
 			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"customer\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"kyc_verified_by_id"}),
+				strmangle.WhereClause("\"", "\"", 2, customerPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
 
-			for _, a := range args {
-				if queries.Equal(a, obj.ID) {
-					continue Outer
-				}
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
 			}
 
-			args = append(args, obj.ID)
+			queries.Assign(&rel.KycVerifiedByID, o.ID)
 		}
 	}
 
-	if len(args) == 0 {
-		return nil
+	if o.R == nil {
+		o.R = &userR{
+			KycVerifiedByCustomers: related,
+		}
+	} else {
+		o.R.KycVerifiedByCustomers = append(o.R.KycVerifiedByCustomers, related...)
 	}
 
-	query := NewQuery(
-		qm.From(`product`),
-		qm.WhereIn(`product.archived_by_id in ?`, args...),
-	)
-	if mods != nil {
-		mods.Apply(query)
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &customerR{
+				KycVerifiedBy: o,
+			}
+		} else {
+			rel.R.KycVerifiedBy = o
+		}
 	}
+	return nil
+}
 
-	results, err := query.QueryContext(ctx, e)
+// SetKycVerifiedByCustomers removes all previously related items of the
+// user replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.KycVerifiedBy's KycVerifiedByCustomers accordingly.
+// Replaces o.R.KycVerifiedByCustomers with related.
+// Sets related.R.KycVerifiedBy's KycVerifiedByCustomers accordingly.
+func (o *User) SetKycVerifiedByCustomers(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Customer) error {
+	query := "update \"customer\" set \"kyc_verified_by_id\" = null where \"kyc_verified_by_id\" = $1"
+	values := []interface{}{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load product")
+		return errors.Wrap(err, "failed to remove relationships before set")
 	}
 
-	var resultSlice []*Product
-	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice product")
-	}
+	if o.R != nil {
+		for _, rel := range o.R.KycVerifiedByCustomers {
+			queries.SetScanner(&rel.KycVerifiedByID, nil)
+			if rel.R == nil {
+				continue
+			}
 
-	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on product")
+			rel.R.KycVerifiedBy = nil
+		}
+
+		o.R.KycVerifiedByCustomers = nil
 	}
-	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for product")
+	return o.AddKycVerifiedByCustomers(ctx, exec, insert, related...)
+}
+
+// RemoveKycVerifiedByCustomers relationships from objects passed in.
+// Removes related items from R.KycVerifiedByCustomers (uses pointer comparison, removal does not keep order)
+// Sets related.R.KycVerifiedBy.
+func (o *User) RemoveKycVerifiedByCustomers(ctx context.Context, exec boil.ContextExecutor, related ...*Customer) error {
+	if len(related) == 0 {
+		return nil
 	}
 
-	if singular {
-		object.R.ArchivedByProducts = resultSlice
-		for _, foreign := range resultSlice {
-			if foreign.R == nil {
-				foreign.R = &productR{}
-			}
-			foreign.R.ArchivedBy = object
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.KycVerifiedByID, nil)
+		if rel.R != nil {
+			rel.R.KycVerifiedBy = nil
 		}
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("kyc_verified_by_id")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
 		return nil
 	}
 
-	for _, foreign := range resultSlice {
-		for _, local := range slice {
-			if queries.Equal(local.ID, foreign.ArchivedByID) {
-				local.R.ArchivedByProducts = append(local.R.ArchivedByProducts, foreign)
-				if foreign.R == nil {
-					foreign.R = &productR{}
-				}
-				foreign.R.ArchivedBy = local
-				break
+	for _, rel := range related {
+		for i, ri := range o.R.KycVerifiedByCustomers {
+			if rel != ri {
+				continue
 			}
+
+			ln := len(o.R.KycVerifiedByCustomers)
+			if ln > 1 && i < ln-1 {
+				o.R.KycVerifiedByCustomers[i] = o.R.KycVerifiedByCustomers[ln-1]
+			}
+			o.R.KycVerifiedByCustomers = o.R.KycVerifiedByCustomers[:ln-1]
+			break
 		}
 	}
 
 	return nil
 }
 
-// LoadCreatedByProducts allows an eager lookup of values, cached into the
-// loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (userL) LoadCreatedByProducts(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
-	var slice []*User
-	var object *User
-
-	if singular {
-		object = maybeUser.(*User)
-	} else {
-		slice = *maybeUser.(*[]*User)
-	}
-
-	args := make([]interface{}, 0, 1)
-	if singular {
-		if object.R == nil {
-			object.R = &userR{}
-		}
-		args = append(args, object.ID)
-	} else {
-	Outer:
-		for _, obj := range slice {
-			if obj.R == nil {
-				obj.R = &userR{}
+// AddSalesRepCustomers adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.SalesRepCustomers.
+// Sets related.R.SalesRep appropriately.
+func (o *User) AddSalesRepCustomers(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Customer) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.SalesRepID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
 			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"customer\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"sales_rep_id"}),
+				strmangle.WhereClause("\"", "\"", 2, customerPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
 
-			for _, a := range args {
-				if a == obj.ID {
-					continue Outer
-				}
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
 			}
 
-			args = append(args, obj.ID)
+			rel.SalesRepID = o.ID
 		}
 	}
 
-	if len(args) == 0 {
-		return nil
+	if o.R == nil {
+		o.R = &userR{
+			SalesRepCustomers: related,
+		}
+	} else {
+		o.R.SalesRepCustomers = append(o.R.SalesRepCustomers, related...)
 	}
 
-	query := NewQuery(
-		qm.From(`product`),
-		qm.WhereIn(`product.created_by_id in ?`, args...),
-	)
-	if mods != nil {
-		mods.Apply(query)
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &customerR{
+				SalesRep: o,
+			}
+		} else {
+			rel.R.SalesRep = o
+		}
 	}
+	return nil
+}
 
-	results, err := query.QueryContext(ctx, e)
-	if err != nil {
-		return errors.Wrap(err, "failed to eager load product")
-	}
+// AddUploadedByCustomerDocuments adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.UploadedByCustomerDocuments.
+// Sets related.R.UploadedBy appropriately.
+func (o *User) AddUploadedByCustomerDocuments(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*CustomerDocument) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.UploadedByID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"customer_document\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"uploaded_by_id"}),
+				strmangle.WhereClause("\"", "\"", 2, customerDocumentPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
 
-	var resultSlice []*Product
-	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice product")
-	}
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
 
-	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on product")
-	}
-	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for product")
+			rel.UploadedByID = o.ID
+		}
 	}
 
-	if singular {
-		object.R.CreatedByProducts = resultSlice
-		for _, foreign := range resultSlice {
-			if foreign.R == nil {
-				foreign.R = &productR{}
-			}
-			foreign.R.CreatedBy = object
+	if o.R == nil {
+		o.R = &userR{
+			UploadedByCustomerDocuments: related,
 		}
-		return nil
+	} else {
+		o.R.UploadedByCustomerDocuments = append(o.R.UploadedByCustomerDocuments, related...)
 	}
 
-	for _, foreign := range resultSlice {
-		for _, local := range slice {
-			if local.ID == foreign.CreatedByID {
-				local.R.CreatedByProducts = append(local.R.CreatedByProducts, foreign)
-				if foreign.R == nil {
-					foreign.R = &productR{}
-				}
-				foreign.R.CreatedBy = local
-				break
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &customerDocumentR{
+				UploadedBy: o,
 			}
+		} else {
+			rel.R.UploadedBy = o
 		}
 	}
-
 	return nil
 }
 
-// LoadUpdatedByProducts allows an eager lookup of values, cached into the
-// loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (userL) LoadUpdatedByProducts(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
-	var slice []*User
-	var object *User
-
-	if singular {
-		object = maybeUser.(*User)
-	} else {
-		slice = *maybeUser.(*[]*User)
-	}
-
-	args := make([]interface{}, 0, 1)
-	if singular {
-		if object.R == nil {
-			object.R = &userR{}
-		}
-		args = append(args, object.ID)
-	} else {
-	Outer:
-		for _, obj := range slice {
-			if obj.R == nil {
-				obj.R = &userR{}
+// AddMergedByCustomerMerges adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.MergedByCustomerMerges.
+// Sets related.R.MergedBy appropriately.
+func (o *User) AddMergedByCustomerMerges(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*CustomerMerge) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.MergedByID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
 			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"customer_merge\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"merged_by_id"}),
+				strmangle.WhereClause("\"", "\"", 2, customerMergePrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
 
-			for _, a := range args {
-				if a == obj.ID {
-					continue Outer
-				}
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
 			}
 
-			args = append(args, obj.ID)
+			rel.MergedByID = o.ID
 		}
 	}
 
-	if len(args) == 0 {
-		return nil
+	if o.R == nil {
+		o.R = &userR{
+			MergedByCustomerMerges: related,
+		}
+	} else {
+		o.R.MergedByCustomerMerges = append(o.R.MergedByCustomerMerges, related...)
 	}
 
-	query := NewQuery(
-		qm.From(`product`),
-		qm.WhereIn(`product.updated_by_id in ?`, args...),
-	)
-	if mods != nil {
-		mods.Apply(query)
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &customerMergeR{
+				MergedBy: o,
+			}
+		} else {
+			rel.R.MergedBy = o
+		}
 	}
+	return nil
+}
 
-	results, err := query.QueryContext(ctx, e)
-	if err != nil {
-		return errors.Wrap(err, "failed to eager load product")
-	}
+// AddSettledByDSCycles adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.SettledByDSCycles.
+// Sets related.R.SettledBy appropriately.
+func (o *User) AddSettledByDSCycles(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*DSCycle) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.SettledByID, o.ID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"ds_cycle\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"settled_by_id"}),
+				strmangle.WhereClause("\"", "\"", 2, dsCyclePrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
 
-	var resultSlice []*Product
-	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice product")
-	}
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
 
-	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on product")
-	}
-	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for product")
+			queries.Assign(&rel.SettledByID, o.ID)
+		}
 	}
 
-	if singular {
-		object.R.UpdatedByProducts = resultSlice
-		for _, foreign := range resultSlice {
-			if foreign.R == nil {
-				foreign.R = &productR{}
-			}
-			foreign.R.UpdatedBy = object
+	if o.R == nil {
+		o.R = &userR{
+			SettledByDSCycles: related,
 		}
-		return nil
+	} else {
+		o.R.SettledByDSCycles = append(o.R.SettledByDSCycles, related...)
 	}
 
-	for _, foreign := range resultSlice {
-		for _, local := range slice {
-			if local.ID == foreign.UpdatedByID {
-				local.R.UpdatedByProducts = append(local.R.UpdatedByProducts, foreign)
-				if foreign.R == nil {
-					foreign.R = &productR{}
-				}
-				foreign.R.UpdatedBy = local
-				break
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &dsCycleR{
+				SettledBy: o,
 			}
+		} else {
+			rel.R.SettledBy = o
 		}
 	}
-
 	return nil
 }
 
-// LoadSalesRepRepsExpenses allows an eager lookup of values, cached into the
-// loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (userL) LoadSalesRepRepsExpenses(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
-	var slice []*User
-	var object *User
-
-	if singular {
-		object = maybeUser.(*User)
-	} else {
-		slice = *maybeUser.(*[]*User)
+// SetSettledByDSCycles removes all previously related items of the
+// user replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.SettledBy's SettledByDSCycles accordingly.
+// Replaces o.R.SettledByDSCycles with related.
+// Sets related.R.SettledBy's SettledByDSCycles accordingly.
+func (o *User) SetSettledByDSCycles(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*DSCycle) error {
+	query := "update \"ds_cycle\" set \"settled_by_id\" = null where \"settled_by_id\" = $1"
+	values := []interface{}{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
 	}
 
-	args := make([]interface{}, 0, 1)
-	if singular {
-		if object.R == nil {
-			object.R = &userR{}
-		}
-		args = append(args, object.ID)
-	} else {
-	Outer:
-		for _, obj := range slice {
-			if obj.R == nil {
-				obj.R = &userR{}
-			}
-
-			for _, a := range args {
-				if a == obj.ID {
-					continue Outer
-				}
+	if o.R != nil {
+		for _, rel := range o.R.SettledByDSCycles {
+			queries.SetScanner(&rel.SettledByID, nil)
+			if rel.R == nil {
+				continue
 			}
 
-			args = append(args, obj.ID)
+			rel.R.SettledBy = nil
 		}
+
+		o.R.SettledByDSCycles = nil
 	}
+	return o.AddSettledByDSCycles(ctx, exec, insert, related...)
+}
 
-	if len(args) == 0 {
+// RemoveSettledByDSCycles relationships from objects passed in.
+// Removes related items from R.SettledByDSCycles (uses pointer comparison, removal does not keep order)
+// Sets related.R.SettledBy.
+func (o *User) RemoveSettledByDSCycles(ctx context.Context, exec boil.ContextExecutor, related ...*DSCycle) error {
+	if len(related) == 0 {
 		return nil
 	}
 
-	query := NewQuery(
-		qm.From(`reps_expense`),
-		qm.WhereIn(`reps_expense.sales_rep_id in ?`, args...),
-	)
-	if mods != nil {
-		mods.Apply(query)
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.SettledByID, nil)
+		if rel.R != nil {
+			rel.R.SettledBy = nil
+		}
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("settled_by_id")); err != nil {
+			return err
+		}
 	}
-
-	results, err := query.QueryContext(ctx, e)
-	if err != nil {
-		return errors.Wrap(err, "failed to eager load reps_expense")
+	if o.R == nil {
+		return nil
 	}
 
-	var resultSlice []*RepsExpense
-	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice reps_expense")
-	}
+	for _, rel := range related {
+		for i, ri := range o.R.SettledByDSCycles {
+			if rel != ri {
+				continue
+			}
 
-	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on reps_expense")
+			ln := len(o.R.SettledByDSCycles)
+			if ln > 1 && i < ln-1 {
+				o.R.SettledByDSCycles[i] = o.R.SettledByDSCycles[ln-1]
+			}
+			o.R.SettledByDSCycles = o.R.SettledByDSCycles[:ln-1]
+			break
+		}
 	}
-	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for reps_expense")
+
+	return nil
+}
+
+// AddCreatedByImportJobs adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.CreatedByImportJobs.
+// Sets related.R.CreatedBy appropriately.
+func (o *User) AddCreatedByImportJobs(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*ImportJob) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.CreatedByID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"import_job\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"created_by_id"}),
+				strmangle.WhereClause("\"", "\"", 2, importJobPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.CreatedByID = o.ID
+		}
 	}
 
-	if singular {
-		object.R.SalesRepRepsExpenses = resultSlice
-		for _, foreign := range resultSlice {
-			if foreign.R == nil {
-				foreign.R = &repsExpenseR{}
-			}
-			foreign.R.SalesRep = object
+	if o.R == nil {
+		o.R = &userR{
+			CreatedByImportJobs: related,
 		}
-		return nil
+	} else {
+		o.R.CreatedByImportJobs = append(o.R.CreatedByImportJobs, related...)
 	}
 
-	for _, foreign := range resultSlice {
-		for _, local := range slice {
-			if local.ID == foreign.SalesRepID {
-				local.R.SalesRepRepsExpenses = append(local.R.SalesRepRepsExpenses, foreign)
-				if foreign.R == nil {
-					foreign.R = &repsExpenseR{}
-				}
-				foreign.R.SalesRep = local
-				break
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &importJobR{
+				CreatedBy: o,
 			}
+		} else {
+			rel.R.CreatedBy = o
 		}
 	}
-
 	return nil
 }
 
-// LoadArchivedBySales allows an eager lookup of values, cached into the
-// loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (userL) LoadArchivedBySales(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
-	var slice []*User
-	var object *User
-
-	if singular {
-		object = maybeUser.(*User)
-	} else {
-		slice = *maybeUser.(*[]*User)
-	}
-
-	args := make([]interface{}, 0, 1)
-	if singular {
-		if object.R == nil {
-			object.R = &userR{}
-		}
-		args = append(args, object.ID)
-	} else {
-	Outer:
-		for _, obj := range slice {
-			if obj.R == nil {
-				obj.R = &userR{}
+// AddSalesRepImportJobs adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.SalesRepImportJobs.
+// Sets related.R.SalesRep appropriately.
+func (o *User) AddSalesRepImportJobs(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*ImportJob) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.SalesRepID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
 			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"import_job\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"sales_rep_id"}),
+				strmangle.WhereClause("\"", "\"", 2, importJobPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
 
-			for _, a := range args {
-				if queries.Equal(a, obj.ID) {
-					continue Outer
-				}
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
 			}
 
-			args = append(args, obj.ID)
+			rel.SalesRepID = o.ID
 		}
 	}
 
-	if len(args) == 0 {
-		return nil
+	if o.R == nil {
+		o.R = &userR{
+			SalesRepImportJobs: related,
+		}
+	} else {
+		o.R.SalesRepImportJobs = append(o.R.SalesRepImportJobs, related...)
 	}
 
-	query := NewQuery(
-		qm.From(`sale`),
-		qm.WhereIn(`sale.archived_by_id in ?`, args...),
-	)
-	if mods != nil {
-		mods.Apply(query)
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &importJobR{
+				SalesRep: o,
+			}
+		} else {
+			rel.R.SalesRep = o
+		}
 	}
+	return nil
+}
 
-	results, err := query.QueryContext(ctx, e)
-	if err != nil {
-		return errors.Wrap(err, "failed to eager load sale")
-	}
+// AddSalesRepInventories adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.SalesRepInventories.
+// Sets related.R.SalesRep appropriately.
+func (o *User) AddSalesRepInventories(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Inventory) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.SalesRepID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"inventory\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"sales_rep_id"}),
+				strmangle.WhereClause("\"", "\"", 2, inventoryPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
 
-	var resultSlice []*Sale
-	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice sale")
-	}
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
 
-	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on sale")
-	}
-	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for sale")
+			rel.SalesRepID = o.ID
+		}
 	}
 
-	if singular {
-		object.R.ArchivedBySales = resultSlice
-		for _, foreign := range resultSlice {
-			if foreign.R == nil {
-				foreign.R = &saleR{}
-			}
-			foreign.R.ArchivedBy = object
+	if o.R == nil {
+		o.R = &userR{
+			SalesRepInventories: related,
 		}
-		return nil
+	} else {
+		o.R.SalesRepInventories = append(o.R.SalesRepInventories, related...)
 	}
 
-	for _, foreign := range resultSlice {
-		for _, local := range slice {
-			if queries.Equal(local.ID, foreign.ArchivedByID) {
-				local.R.ArchivedBySales = append(local.R.ArchivedBySales, foreign)
-				if foreign.R == nil {
-					foreign.R = &saleR{}
-				}
-				foreign.R.ArchivedBy = local
-				break
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &inventoryR{
+				SalesRep: o,
 			}
+		} else {
+			rel.R.SalesRep = o
 		}
 	}
-
 	return nil
 }
 
-// LoadCreatedBySales allows an eager lookup of values, cached into the
-// loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (userL) LoadCreatedBySales(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
-	var slice []*User
-	var object *User
+// AddCreatedByJournalEntries adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.CreatedByJournalEntries.
+// Sets related.R.CreatedBy appropriately.
+func (o *User) AddCreatedByJournalEntries(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*JournalEntry) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.CreatedByID, o.ID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"journal_entry\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"created_by_id"}),
+				strmangle.WhereClause("\"", "\"", 2, journalEntryPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
 
-	if singular {
-		object = maybeUser.(*User)
-	} else {
-		slice = *maybeUser.(*[]*User)
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.CreatedByID, o.ID)
+		}
 	}
 
-	args := make([]interface{}, 0, 1)
-	if singular {
-		if object.R == nil {
-			object.R = &userR{}
+	if o.R == nil {
+		o.R = &userR{
+			CreatedByJournalEntries: related,
 		}
-		args = append(args, object.ID)
 	} else {
-	Outer:
-		for _, obj := range slice {
-			if obj.R == nil {
-				obj.R = &userR{}
-			}
+		o.R.CreatedByJournalEntries = append(o.R.CreatedByJournalEntries, related...)
+	}
 
-			for _, a := range args {
-				if a == obj.ID {
-					continue Outer
-				}
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &journalEntryR{
+				CreatedBy: o,
 			}
-
-			args = append(args, obj.ID)
+		} else {
+			rel.R.CreatedBy = o
 		}
 	}
+	return nil
+}
 
-	if len(args) == 0 {
-		return nil
+// SetCreatedByJournalEntries removes all previously related items of the
+// user replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.CreatedBy's CreatedByJournalEntries accordingly.
+// Replaces o.R.CreatedByJournalEntries with related.
+// Sets related.R.CreatedBy's CreatedByJournalEntries accordingly.
+func (o *User) SetCreatedByJournalEntries(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*JournalEntry) error {
+	query := "update \"journal_entry\" set \"created_by_id\" = null where \"created_by_id\" = $1"
+	values := []interface{}{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
 	}
-
-	query := NewQuery(
-		qm.From(`sale`),
-		qm.WhereIn(`sale.created_by_id in ?`, args...),
-	)
-	if mods != nil {
-		mods.Apply(query)
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
 	}
 
-	results, err := query.QueryContext(ctx, e)
-	if err != nil {
-		return errors.Wrap(err, "failed to eager load sale")
+	if o.R != nil {
+		for _, rel := range o.R.CreatedByJournalEntries {
+			queries.SetScanner(&rel.CreatedByID, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.CreatedBy = nil
+		}
+
+		o.R.CreatedByJournalEntries = nil
 	}
+	return o.AddCreatedByJournalEntries(ctx, exec, insert, related...)
+}
 
-	var resultSlice []*Sale
-	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice sale")
+// RemoveCreatedByJournalEntries relationships from objects passed in.
+// Removes related items from R.CreatedByJournalEntries (uses pointer comparison, removal does not keep order)
+// Sets related.R.CreatedBy.
+func (o *User) RemoveCreatedByJournalEntries(ctx context.Context, exec boil.ContextExecutor, related ...*JournalEntry) error {
+	if len(related) == 0 {
+		return nil
 	}
 
-	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on sale")
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.CreatedByID, nil)
+		if rel.R != nil {
+			rel.R.CreatedBy = nil
+		}
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("created_by_id")); err != nil {
+			return err
+		}
 	}
-	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for sale")
+	if o.R == nil {
+		return nil
 	}
 
-	if singular {
-		object.R.CreatedBySales = resultSlice
-		for _, foreign := range resultSlice {
-			if foreign.R == nil {
-				foreign.R = &saleR{}
+	for _, rel := range related {
+		for i, ri := range o.R.CreatedByJournalEntries {
+			if rel != ri {
+				continue
 			}
-			foreign.R.CreatedBy = object
-		}
-		return nil
-	}
 
-	for _, foreign := range resultSlice {
-		for _, local := range slice {
-			if local.ID == foreign.CreatedByID {
-				local.R.CreatedBySales = append(local.R.CreatedBySales, foreign)
-				if foreign.R == nil {
-					foreign.R = &saleR{}
-				}
-				foreign.R.CreatedBy = local
-				break
+			ln := len(o.R.CreatedByJournalEntries)
+			if ln > 1 && i < ln-1 {
+				o.R.CreatedByJournalEntries[i] = o.R.CreatedByJournalEntries[ln-1]
 			}
+			o.R.CreatedByJournalEntries = o.R.CreatedByJournalEntries[:ln-1]
+			break
 		}
 	}
 
 	return nil
 }
 
-// LoadUpdatedBySales allows an eager lookup of values, cached into the
-// loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (userL) LoadUpdatedBySales(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
-	var slice []*User
-	var object *User
+// AddAppliedByLoans adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.AppliedByLoans.
+// Sets related.R.AppliedBy appropriately.
+func (o *User) AddAppliedByLoans(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Loan) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.AppliedByID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"loan\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"applied_by_id"}),
+				strmangle.WhereClause("\"", "\"", 2, loanPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
 
-	if singular {
-		object = maybeUser.(*User)
-	} else {
-		slice = *maybeUser.(*[]*User)
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.AppliedByID = o.ID
+		}
 	}
 
-	args := make([]interface{}, 0, 1)
-	if singular {
-		if object.R == nil {
-			object.R = &userR{}
+	if o.R == nil {
+		o.R = &userR{
+			AppliedByLoans: related,
 		}
-		args = append(args, object.ID)
 	} else {
-	Outer:
-		for _, obj := range slice {
-			if obj.R == nil {
-				obj.R = &userR{}
+		o.R.AppliedByLoans = append(o.R.AppliedByLoans, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &loanR{
+				AppliedBy: o,
 			}
+		} else {
+			rel.R.AppliedBy = o
+		}
+	}
+	return nil
+}
 
-			for _, a := range args {
-				if queries.Equal(a, obj.ID) {
-					continue Outer
-				}
+// AddApprovedByLoans adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.ApprovedByLoans.
+// Sets related.R.ApprovedBy appropriately.
+func (o *User) AddApprovedByLoans(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Loan) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.ApprovedByID, o.ID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
 			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"loan\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"approved_by_id"}),
+				strmangle.WhereClause("\"", "\"", 2, loanPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
 
-			args = append(args, obj.ID)
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.ApprovedByID, o.ID)
 		}
 	}
 
-	if len(args) == 0 {
-		return nil
+	if o.R == nil {
+		o.R = &userR{
+			ApprovedByLoans: related,
+		}
+	} else {
+		o.R.ApprovedByLoans = append(o.R.ApprovedByLoans, related...)
 	}
 
-	query := NewQuery(
-		qm.From(`sale`),
-		qm.WhereIn(`sale.updated_by_id in ?`, args...),
-	)
-	if mods != nil {
-		mods.Apply(query)
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &loanR{
+				ApprovedBy: o,
+			}
+		} else {
+			rel.R.ApprovedBy = o
+		}
 	}
+	return nil
+}
 
-	results, err := query.QueryContext(ctx, e)
+// SetApprovedByLoans removes all previously related items of the
+// user replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.ApprovedBy's ApprovedByLoans accordingly.
+// Replaces o.R.ApprovedByLoans with related.
+// Sets related.R.ApprovedBy's ApprovedByLoans accordingly.
+func (o *User) SetApprovedByLoans(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Loan) error {
+	query := "update \"loan\" set \"approved_by_id\" = null where \"approved_by_id\" = $1"
+	values := []interface{}{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load sale")
+		return errors.Wrap(err, "failed to remove relationships before set")
 	}
 
-	var resultSlice []*Sale
-	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice sale")
-	}
+	if o.R != nil {
+		for _, rel := range o.R.ApprovedByLoans {
+			queries.SetScanner(&rel.ApprovedByID, nil)
+			if rel.R == nil {
+				continue
+			}
 
-	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on sale")
+			rel.R.ApprovedBy = nil
+		}
+
+		o.R.ApprovedByLoans = nil
 	}
-	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for sale")
+	return o.AddApprovedByLoans(ctx, exec, insert, related...)
+}
+
+// RemoveApprovedByLoans relationships from objects passed in.
+// Removes related items from R.ApprovedByLoans (uses pointer comparison, removal does not keep order)
+// Sets related.R.ApprovedBy.
+func (o *User) RemoveApprovedByLoans(ctx context.Context, exec boil.ContextExecutor, related ...*Loan) error {
+	if len(related) == 0 {
+		return nil
 	}
 
-	if singular {
-		object.R.UpdatedBySales = resultSlice
-		for _, foreign := range resultSlice {
-			if foreign.R == nil {
-				foreign.R = &saleR{}
-			}
-			foreign.R.UpdatedBy = object
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.ApprovedByID, nil)
+		if rel.R != nil {
+			rel.R.ApprovedBy = nil
+		}
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("approved_by_id")); err != nil {
+			return err
 		}
+	}
+	if o.R == nil {
 		return nil
 	}
 
-	for _, foreign := range resultSlice {
-		for _, local := range slice {
-			if queries.Equal(local.ID, foreign.UpdatedByID) {
-				local.R.UpdatedBySales = append(local.R.UpdatedBySales, foreign)
-				if foreign.R == nil {
-					foreign.R = &saleR{}
-				}
-				foreign.R.UpdatedBy = local
-				break
+	for _, rel := range related {
+		for i, ri := range o.R.ApprovedByLoans {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.ApprovedByLoans)
+			if ln > 1 && i < ln-1 {
+				o.R.ApprovedByLoans[i] = o.R.ApprovedByLoans[ln-1]
 			}
+			o.R.ApprovedByLoans = o.R.ApprovedByLoans[:ln-1]
+			break
 		}
 	}
 
 	return nil
 }
 
-// LoadSalesRepTransactions allows an eager lookup of values, cached into the
-// loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (userL) LoadSalesRepTransactions(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
-	var slice []*User
-	var object *User
+// AddDisbursedByLoans adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.DisbursedByLoans.
+// Sets related.R.DisbursedBy appropriately.
+func (o *User) AddDisbursedByLoans(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Loan) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.DisbursedByID, o.ID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"loan\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"disbursed_by_id"}),
+				strmangle.WhereClause("\"", "\"", 2, loanPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
 
-	if singular {
-		object = maybeUser.(*User)
-	} else {
-		slice = *maybeUser.(*[]*User)
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.DisbursedByID, o.ID)
+		}
 	}
 
-	args := make([]interface{}, 0, 1)
-	if singular {
-		if object.R == nil {
-			object.R = &userR{}
+	if o.R == nil {
+		o.R = &userR{
+			DisbursedByLoans: related,
 		}
-		args = append(args, object.ID)
 	} else {
-	Outer:
-		for _, obj := range slice {
-			if obj.R == nil {
-				obj.R = &userR{}
+		o.R.DisbursedByLoans = append(o.R.DisbursedByLoans, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &loanR{
+				DisbursedBy: o,
 			}
+		} else {
+			rel.R.DisbursedBy = o
+		}
+	}
+	return nil
+}
+
+// SetDisbursedByLoans removes all previously related items of the
+// user replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.DisbursedBy's DisbursedByLoans accordingly.
+// Replaces o.R.DisbursedByLoans with related.
+// Sets related.R.DisbursedBy's DisbursedByLoans accordingly.
+func (o *User) SetDisbursedByLoans(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Loan) error {
+	query := "update \"loan\" set \"disbursed_by_id\" = null where \"disbursed_by_id\" = $1"
+	values := []interface{}{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
 
-			for _, a := range args {
-				if a == obj.ID {
-					continue Outer
-				}
+	if o.R != nil {
+		for _, rel := range o.R.DisbursedByLoans {
+			queries.SetScanner(&rel.DisbursedByID, nil)
+			if rel.R == nil {
+				continue
 			}
 
-			args = append(args, obj.ID)
+			rel.R.DisbursedBy = nil
 		}
+
+		o.R.DisbursedByLoans = nil
 	}
+	return o.AddDisbursedByLoans(ctx, exec, insert, related...)
+}
 
-	if len(args) == 0 {
+// RemoveDisbursedByLoans relationships from objects passed in.
+// Removes related items from R.DisbursedByLoans (uses pointer comparison, removal does not keep order)
+// Sets related.R.DisbursedBy.
+func (o *User) RemoveDisbursedByLoans(ctx context.Context, exec boil.ContextExecutor, related ...*Loan) error {
+	if len(related) == 0 {
 		return nil
 	}
 
-	query := NewQuery(
-		qm.From(`transaction`),
-		qm.WhereIn(`transaction.sales_rep_id in ?`, args...),
-	)
-	if mods != nil {
-		mods.Apply(query)
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.DisbursedByID, nil)
+		if rel.R != nil {
+			rel.R.DisbursedBy = nil
+		}
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("disbursed_by_id")); err != nil {
+			return err
+		}
 	}
-
-	results, err := query.QueryContext(ctx, e)
-	if err != nil {
-		return errors.Wrap(err, "failed to eager load transaction")
+	if o.R == nil {
+		return nil
 	}
 
-	var resultSlice []*Transaction
-	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice transaction")
-	}
+	for _, rel := range related {
+		for i, ri := range o.R.DisbursedByLoans {
+			if rel != ri {
+				continue
+			}
 
-	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on transaction")
-	}
-	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for transaction")
+			ln := len(o.R.DisbursedByLoans)
+			if ln > 1 && i < ln-1 {
+				o.R.DisbursedByLoans[i] = o.R.DisbursedByLoans[ln-1]
+			}
+			o.R.DisbursedByLoans = o.R.DisbursedByLoans[:ln-1]
+			break
+		}
 	}
 
-	if singular {
-		object.R.SalesRepTransactions = resultSlice
-		for _, foreign := range resultSlice {
-			if foreign.R == nil {
-				foreign.R = &transactionR{}
+	return nil
+}
+
+// AddSalesRepLoanRepayments adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.SalesRepLoanRepayments.
+// Sets related.R.SalesRep appropriately.
+func (o *User) AddSalesRepLoanRepayments(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*LoanRepayment) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.SalesRepID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
 			}
-			foreign.R.SalesRep = object
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"loan_repayment\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"sales_rep_id"}),
+				strmangle.WhereClause("\"", "\"", 2, loanRepaymentPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.SalesRepID = o.ID
 		}
-		return nil
 	}
 
-	for _, foreign := range resultSlice {
-		for _, local := range slice {
-			if local.ID == foreign.SalesRepID {
-				local.R.SalesRepTransactions = append(local.R.SalesRepTransactions, foreign)
-				if foreign.R == nil {
-					foreign.R = &transactionR{}
-				}
-				foreign.R.SalesRep = local
-				break
-			}
+	if o.R == nil {
+		o.R = &userR{
+			SalesRepLoanRepayments: related,
 		}
+	} else {
+		o.R.SalesRepLoanRepayments = append(o.R.SalesRepLoanRepayments, related...)
 	}
 
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &loanRepaymentR{
+				SalesRep: o,
+			}
+		} else {
+			rel.R.SalesRep = o
+		}
+	}
 	return nil
 }
 
-// SetBranch of the user to the related item.
-// Sets o.R.Branch to related.
-// Adds o to related.R.Users.
-func (o *User) SetBranch(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Branch) error {
+// AddSalesRepPayments adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.SalesRepPayments.
+// Sets related.R.SalesRep appropriately.
+func (o *User) AddSalesRepPayments(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Payment) error {
 	var err error
-	if insert {
-		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
-			return errors.Wrap(err, "failed to insert into foreign table")
-		}
-	}
+	for _, rel := range related {
+		if insert {
+			rel.SalesRepID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"payment\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"sales_rep_id"}),
+				strmangle.WhereClause("\"", "\"", 2, paymentPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
 
-	updateQuery := fmt.Sprintf(
-		"UPDATE \"users\" SET %s WHERE %s",
-		strmangle.SetParamNames("\"", "\"", 1, []string{"branch_id"}),
-		strmangle.WhereClause("\"", "\"", 2, userPrimaryKeyColumns),
-	)
-	values := []interface{}{related.ID, o.ID}
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
 
-	if boil.IsDebug(ctx) {
-		writer := boil.DebugWriterFrom(ctx)
-		fmt.Fprintln(writer, updateQuery)
-		fmt.Fprintln(writer, values)
-	}
-	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
-		return errors.Wrap(err, "failed to update local table")
+			rel.SalesRepID = o.ID
+		}
 	}
 
-	o.BranchID = related.ID
 	if o.R == nil {
 		o.R = &userR{
-			Branch: related,
+			SalesRepPayments: related,
 		}
 	} else {
-		o.R.Branch = related
+		o.R.SalesRepPayments = append(o.R.SalesRepPayments, related...)
 	}
 
-	if related.R == nil {
-		related.R = &branchR{
-			Users: UserSlice{o},
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &paymentR{
+				SalesRep: o,
+			}
+		} else {
+			rel.R.SalesRep = o
 		}
-	} else {
-		related.R.Users = append(related.R.Users, o)
 	}
-
 	return nil
 }
 
-// AddSalesRepAccounts adds the given related objects to the existing relationships
+// AddArchivedByProducts adds the given related objects to the existing relationships
 // of the user, optionally inserting them as new records.
-// Appends related to o.R.SalesRepAccounts.
-// Sets related.R.SalesRep appropriately.
-func (o *User) AddSalesRepAccounts(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Account) error {
+// Appends related to o.R.ArchivedByProducts.
+// Sets related.R.ArchivedBy appropriately.
+func (o *User) AddArchivedByProducts(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Product) error {
 	var err error
 	for _, rel := range related {
 		if insert {
-			rel.SalesRepID = o.ID
+			queries.Assign(&rel.ArchivedByID, o.ID)
 			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
 				return errors.Wrap(err, "failed to insert into foreign table")
 			}
 		} else {
 			updateQuery := fmt.Sprintf(
-				"UPDATE \"account\" SET %s WHERE %s",
-				strmangle.SetParamNames("\"", "\"", 1, []string{"sales_rep_id"}),
-				strmangle.WhereClause("\"", "\"", 2, accountPrimaryKeyColumns),
+				"UPDATE \"product\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"archived_by_id"}),
+				strmangle.WhereClause("\"", "\"", 2, productPrimaryKeyColumns),
 			)
 			values := []interface{}{o.ID, rel.ID}
 
@@ -1818,47 +6168,121 @@ func (o *User) AddSalesRepAccounts(ctx context.Context, exec boil.ContextExecuto
 				return errors.Wrap(err, "failed to update foreign table")
 			}
 
-			rel.SalesRepID = o.ID
+			queries.Assign(&rel.ArchivedByID, o.ID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			ArchivedByProducts: related,
+		}
+	} else {
+		o.R.ArchivedByProducts = append(o.R.ArchivedByProducts, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &productR{
+				ArchivedBy: o,
+			}
+		} else {
+			rel.R.ArchivedBy = o
+		}
+	}
+	return nil
+}
+
+// SetArchivedByProducts removes all previously related items of the
+// user replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.ArchivedBy's ArchivedByProducts accordingly.
+// Replaces o.R.ArchivedByProducts with related.
+// Sets related.R.ArchivedBy's ArchivedByProducts accordingly.
+func (o *User) SetArchivedByProducts(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Product) error {
+	query := "update \"product\" set \"archived_by_id\" = null where \"archived_by_id\" = $1"
+	values := []interface{}{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.ArchivedByProducts {
+			queries.SetScanner(&rel.ArchivedByID, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.ArchivedBy = nil
+		}
+
+		o.R.ArchivedByProducts = nil
+	}
+	return o.AddArchivedByProducts(ctx, exec, insert, related...)
+}
+
+// RemoveArchivedByProducts relationships from objects passed in.
+// Removes related items from R.ArchivedByProducts (uses pointer comparison, removal does not keep order)
+// Sets related.R.ArchivedBy.
+func (o *User) RemoveArchivedByProducts(ctx context.Context, exec boil.ContextExecutor, related ...*Product) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.ArchivedByID, nil)
+		if rel.R != nil {
+			rel.R.ArchivedBy = nil
+		}
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("archived_by_id")); err != nil {
+			return err
 		}
 	}
-
 	if o.R == nil {
-		o.R = &userR{
-			SalesRepAccounts: related,
-		}
-	} else {
-		o.R.SalesRepAccounts = append(o.R.SalesRepAccounts, related...)
+		return nil
 	}
 
 	for _, rel := range related {
-		if rel.R == nil {
-			rel.R = &accountR{
-				SalesRep: o,
+		for i, ri := range o.R.ArchivedByProducts {
+			if rel != ri {
+				continue
 			}
-		} else {
-			rel.R.SalesRep = o
+
+			ln := len(o.R.ArchivedByProducts)
+			if ln > 1 && i < ln-1 {
+				o.R.ArchivedByProducts[i] = o.R.ArchivedByProducts[ln-1]
+			}
+			o.R.ArchivedByProducts = o.R.ArchivedByProducts[:ln-1]
+			break
 		}
 	}
+
 	return nil
 }
 
-// AddSalesRepCustomers adds the given related objects to the existing relationships
+// AddCreatedByProducts adds the given related objects to the existing relationships
 // of the user, optionally inserting them as new records.
-// Appends related to o.R.SalesRepCustomers.
-// Sets related.R.SalesRep appropriately.
-func (o *User) AddSalesRepCustomers(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Customer) error {
+// Appends related to o.R.CreatedByProducts.
+// Sets related.R.CreatedBy appropriately.
+func (o *User) AddCreatedByProducts(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Product) error {
 	var err error
 	for _, rel := range related {
 		if insert {
-			rel.SalesRepID = o.ID
+			rel.CreatedByID = o.ID
 			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
 				return errors.Wrap(err, "failed to insert into foreign table")
 			}
 		} else {
 			updateQuery := fmt.Sprintf(
-				"UPDATE \"customer\" SET %s WHERE %s",
-				strmangle.SetParamNames("\"", "\"", 1, []string{"sales_rep_id"}),
-				strmangle.WhereClause("\"", "\"", 2, customerPrimaryKeyColumns),
+				"UPDATE \"product\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"created_by_id"}),
+				strmangle.WhereClause("\"", "\"", 2, productPrimaryKeyColumns),
 			)
 			values := []interface{}{o.ID, rel.ID}
 
@@ -1871,47 +6295,47 @@ func (o *User) AddSalesRepCustomers(ctx context.Context, exec boil.ContextExecut
 				return errors.Wrap(err, "failed to update foreign table")
 			}
 
-			rel.SalesRepID = o.ID
+			rel.CreatedByID = o.ID
 		}
 	}
 
 	if o.R == nil {
 		o.R = &userR{
-			SalesRepCustomers: related,
+			CreatedByProducts: related,
 		}
 	} else {
-		o.R.SalesRepCustomers = append(o.R.SalesRepCustomers, related...)
+		o.R.CreatedByProducts = append(o.R.CreatedByProducts, related...)
 	}
 
 	for _, rel := range related {
 		if rel.R == nil {
-			rel.R = &customerR{
-				SalesRep: o,
+			rel.R = &productR{
+				CreatedBy: o,
 			}
 		} else {
-			rel.R.SalesRep = o
+			rel.R.CreatedBy = o
 		}
 	}
 	return nil
 }
 
-// AddSalesRepInventories adds the given related objects to the existing relationships
+// AddUpdatedByProducts adds the given related objects to the existing relationships
 // of the user, optionally inserting them as new records.
-// Appends related to o.R.SalesRepInventories.
-// Sets related.R.SalesRep appropriately.
-func (o *User) AddSalesRepInventories(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Inventory) error {
+// Appends related to o.R.UpdatedByProducts.
+// Sets related.R.UpdatedBy appropriately.
+func (o *User) AddUpdatedByProducts(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Product) error {
 	var err error
 	for _, rel := range related {
 		if insert {
-			rel.SalesRepID = o.ID
+			rel.UpdatedByID = o.ID
 			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
 				return errors.Wrap(err, "failed to insert into foreign table")
 			}
 		} else {
 			updateQuery := fmt.Sprintf(
-				"UPDATE \"inventory\" SET %s WHERE %s",
-				strmangle.SetParamNames("\"", "\"", 1, []string{"sales_rep_id"}),
-				strmangle.WhereClause("\"", "\"", 2, inventoryPrimaryKeyColumns),
+				"UPDATE \"product\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"updated_by_id"}),
+				strmangle.WhereClause("\"", "\"", 2, productPrimaryKeyColumns),
 			)
 			values := []interface{}{o.ID, rel.ID}
 
@@ -1924,35 +6348,35 @@ func (o *User) AddSalesRepInventories(ctx context.Context, exec boil.ContextExec
 				return errors.Wrap(err, "failed to update foreign table")
 			}
 
-			rel.SalesRepID = o.ID
+			rel.UpdatedByID = o.ID
 		}
 	}
 
 	if o.R == nil {
 		o.R = &userR{
-			SalesRepInventories: related,
+			UpdatedByProducts: related,
 		}
 	} else {
-		o.R.SalesRepInventories = append(o.R.SalesRepInventories, related...)
+		o.R.UpdatedByProducts = append(o.R.UpdatedByProducts, related...)
 	}
 
 	for _, rel := range related {
 		if rel.R == nil {
-			rel.R = &inventoryR{
-				SalesRep: o,
+			rel.R = &productR{
+				UpdatedBy: o,
 			}
 		} else {
-			rel.R.SalesRep = o
+			rel.R.UpdatedBy = o
 		}
 	}
 	return nil
 }
 
-// AddSalesRepPayments adds the given related objects to the existing relationships
+// AddSalesRepRepsExpenses adds the given related objects to the existing relationships
 // of the user, optionally inserting them as new records.
-// Appends related to o.R.SalesRepPayments.
+// Appends related to o.R.SalesRepRepsExpenses.
 // Sets related.R.SalesRep appropriately.
-func (o *User) AddSalesRepPayments(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Payment) error {
+func (o *User) AddSalesRepRepsExpenses(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*RepsExpense) error {
 	var err error
 	for _, rel := range related {
 		if insert {
@@ -1962,9 +6386,9 @@ func (o *User) AddSalesRepPayments(ctx context.Context, exec boil.ContextExecuto
 			}
 		} else {
 			updateQuery := fmt.Sprintf(
-				"UPDATE \"payment\" SET %s WHERE %s",
+				"UPDATE \"reps_expense\" SET %s WHERE %s",
 				strmangle.SetParamNames("\"", "\"", 1, []string{"sales_rep_id"}),
-				strmangle.WhereClause("\"", "\"", 2, paymentPrimaryKeyColumns),
+				strmangle.WhereClause("\"", "\"", 2, repsExpensePrimaryKeyColumns),
 			)
 			values := []interface{}{o.ID, rel.ID}
 
@@ -1983,15 +6407,15 @@ func (o *User) AddSalesRepPayments(ctx context.Context, exec boil.ContextExecuto
 
 	if o.R == nil {
 		o.R = &userR{
-			SalesRepPayments: related,
+			SalesRepRepsExpenses: related,
 		}
 	} else {
-		o.R.SalesRepPayments = append(o.R.SalesRepPayments, related...)
+		o.R.SalesRepRepsExpenses = append(o.R.SalesRepRepsExpenses, related...)
 	}
 
 	for _, rel := range related {
 		if rel.R == nil {
-			rel.R = &paymentR{
+			rel.R = &repsExpenseR{
 				SalesRep: o,
 			}
 		} else {
@@ -2001,11 +6425,11 @@ func (o *User) AddSalesRepPayments(ctx context.Context, exec boil.ContextExecuto
 	return nil
 }
 
-// AddArchivedByProducts adds the given related objects to the existing relationships
+// AddArchivedBySales adds the given related objects to the existing relationships
 // of the user, optionally inserting them as new records.
-// Appends related to o.R.ArchivedByProducts.
+// Appends related to o.R.ArchivedBySales.
 // Sets related.R.ArchivedBy appropriately.
-func (o *User) AddArchivedByProducts(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Product) error {
+func (o *User) AddArchivedBySales(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Sale) error {
 	var err error
 	for _, rel := range related {
 		if insert {
@@ -2015,9 +6439,9 @@ func (o *User) AddArchivedByProducts(ctx context.Context, exec boil.ContextExecu
 			}
 		} else {
 			updateQuery := fmt.Sprintf(
-				"UPDATE \"product\" SET %s WHERE %s",
+				"UPDATE \"sale\" SET %s WHERE %s",
 				strmangle.SetParamNames("\"", "\"", 1, []string{"archived_by_id"}),
-				strmangle.WhereClause("\"", "\"", 2, productPrimaryKeyColumns),
+				strmangle.WhereClause("\"", "\"", 2, salePrimaryKeyColumns),
 			)
 			values := []interface{}{o.ID, rel.ID}
 
@@ -2036,15 +6460,15 @@ func (o *User) AddArchivedByProducts(ctx context.Context, exec boil.ContextExecu
 
 	if o.R == nil {
 		o.R = &userR{
-			ArchivedByProducts: related,
+			ArchivedBySales: related,
 		}
 	} else {
-		o.R.ArchivedByProducts = append(o.R.ArchivedByProducts, related...)
+		o.R.ArchivedBySales = append(o.R.ArchivedBySales, related...)
 	}
 
 	for _, rel := range related {
 		if rel.R == nil {
-			rel.R = &productR{
+			rel.R = &saleR{
 				ArchivedBy: o,
 			}
 		} else {
@@ -2054,14 +6478,14 @@ func (o *User) AddArchivedByProducts(ctx context.Context, exec boil.ContextExecu
 	return nil
 }
 
-// SetArchivedByProducts removes all previously related items of the
+// SetArchivedBySales removes all previously related items of the
 // user replacing them completely with the passed
 // in related items, optionally inserting them as new records.
-// Sets o.R.ArchivedBy's ArchivedByProducts accordingly.
-// Replaces o.R.ArchivedByProducts with related.
-// Sets related.R.ArchivedBy's ArchivedByProducts accordingly.
-func (o *User) SetArchivedByProducts(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Product) error {
-	query := "update \"product\" set \"archived_by_id\" = null where \"archived_by_id\" = $1"
+// Sets o.R.ArchivedBy's ArchivedBySales accordingly.
+// Replaces o.R.ArchivedBySales with related.
+// Sets related.R.ArchivedBy's ArchivedBySales accordingly.
+func (o *User) SetArchivedBySales(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Sale) error {
+	query := "update \"sale\" set \"archived_by_id\" = null where \"archived_by_id\" = $1"
 	values := []interface{}{o.ID}
 	if boil.IsDebug(ctx) {
 		writer := boil.DebugWriterFrom(ctx)
@@ -2074,7 +6498,7 @@ func (o *User) SetArchivedByProducts(ctx context.Context, exec boil.ContextExecu
 	}
 
 	if o.R != nil {
-		for _, rel := range o.R.ArchivedByProducts {
+		for _, rel := range o.R.ArchivedBySales {
 			queries.SetScanner(&rel.ArchivedByID, nil)
 			if rel.R == nil {
 				continue
@@ -2083,15 +6507,15 @@ func (o *User) SetArchivedByProducts(ctx context.Context, exec boil.ContextExecu
 			rel.R.ArchivedBy = nil
 		}
 
-		o.R.ArchivedByProducts = nil
+		o.R.ArchivedBySales = nil
 	}
-	return o.AddArchivedByProducts(ctx, exec, insert, related...)
+	return o.AddArchivedBySales(ctx, exec, insert, related...)
 }
 
-// RemoveArchivedByProducts relationships from objects passed in.
-// Removes related items from R.ArchivedByProducts (uses pointer comparison, removal does not keep order)
+// RemoveArchivedBySales relationships from objects passed in.
+// Removes related items from R.ArchivedBySales (uses pointer comparison, removal does not keep order)
 // Sets related.R.ArchivedBy.
-func (o *User) RemoveArchivedByProducts(ctx context.Context, exec boil.ContextExecutor, related ...*Product) error {
+func (o *User) RemoveArchivedBySales(ctx context.Context, exec boil.ContextExecutor, related ...*Sale) error {
 	if len(related) == 0 {
 		return nil
 	}
@@ -2111,16 +6535,16 @@ func (o *User) RemoveArchivedByProducts(ctx context.Context, exec boil.ContextEx
 	}
 
 	for _, rel := range related {
-		for i, ri := range o.R.ArchivedByProducts {
+		for i, ri := range o.R.ArchivedBySales {
 			if rel != ri {
 				continue
 			}
 
-			ln := len(o.R.ArchivedByProducts)
+			ln := len(o.R.ArchivedBySales)
 			if ln > 1 && i < ln-1 {
-				o.R.ArchivedByProducts[i] = o.R.ArchivedByProducts[ln-1]
+				o.R.ArchivedBySales[i] = o.R.ArchivedBySales[ln-1]
 			}
-			o.R.ArchivedByProducts = o.R.ArchivedByProducts[:ln-1]
+			o.R.ArchivedBySales = o.R.ArchivedBySales[:ln-1]
 			break
 		}
 	}
@@ -2128,11 +6552,11 @@ func (o *User) RemoveArchivedByProducts(ctx context.Context, exec boil.ContextEx
 	return nil
 }
 
-// AddCreatedByProducts adds the given related objects to the existing relationships
+// AddCreatedBySales adds the given related objects to the existing relationships
 // of the user, optionally inserting them as new records.
-// Appends related to o.R.CreatedByProducts.
+// Appends related to o.R.CreatedBySales.
 // Sets related.R.CreatedBy appropriately.
-func (o *User) AddCreatedByProducts(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Product) error {
+func (o *User) AddCreatedBySales(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Sale) error {
 	var err error
 	for _, rel := range related {
 		if insert {
@@ -2142,9 +6566,9 @@ func (o *User) AddCreatedByProducts(ctx context.Context, exec boil.ContextExecut
 			}
 		} else {
 			updateQuery := fmt.Sprintf(
-				"UPDATE \"product\" SET %s WHERE %s",
+				"UPDATE \"sale\" SET %s WHERE %s",
 				strmangle.SetParamNames("\"", "\"", 1, []string{"created_by_id"}),
-				strmangle.WhereClause("\"", "\"", 2, productPrimaryKeyColumns),
+				strmangle.WhereClause("\"", "\"", 2, salePrimaryKeyColumns),
 			)
 			values := []interface{}{o.ID, rel.ID}
 
@@ -2163,15 +6587,15 @@ func (o *User) AddCreatedByProducts(ctx context.Context, exec boil.ContextExecut
 
 	if o.R == nil {
 		o.R = &userR{
-			CreatedByProducts: related,
+			CreatedBySales: related,
 		}
 	} else {
-		o.R.CreatedByProducts = append(o.R.CreatedByProducts, related...)
+		o.R.CreatedBySales = append(o.R.CreatedBySales, related...)
 	}
 
 	for _, rel := range related {
 		if rel.R == nil {
-			rel.R = &productR{
+			rel.R = &saleR{
 				CreatedBy: o,
 			}
 		} else {
@@ -2181,23 +6605,23 @@ func (o *User) AddCreatedByProducts(ctx context.Context, exec boil.ContextExecut
 	return nil
 }
 
-// AddUpdatedByProducts adds the given related objects to the existing relationships
+// AddUpdatedBySales adds the given related objects to the existing relationships
 // of the user, optionally inserting them as new records.
-// Appends related to o.R.UpdatedByProducts.
+// Appends related to o.R.UpdatedBySales.
 // Sets related.R.UpdatedBy appropriately.
-func (o *User) AddUpdatedByProducts(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Product) error {
+func (o *User) AddUpdatedBySales(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Sale) error {
 	var err error
 	for _, rel := range related {
 		if insert {
-			rel.UpdatedByID = o.ID
+			queries.Assign(&rel.UpdatedByID, o.ID)
 			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
 				return errors.Wrap(err, "failed to insert into foreign table")
 			}
 		} else {
 			updateQuery := fmt.Sprintf(
-				"UPDATE \"product\" SET %s WHERE %s",
+				"UPDATE \"sale\" SET %s WHERE %s",
 				strmangle.SetParamNames("\"", "\"", 1, []string{"updated_by_id"}),
-				strmangle.WhereClause("\"", "\"", 2, productPrimaryKeyColumns),
+				strmangle.WhereClause("\"", "\"", 2, salePrimaryKeyColumns),
 			)
 			values := []interface{}{o.ID, rel.ID}
 
@@ -2210,35 +6634,109 @@ func (o *User) AddUpdatedByProducts(ctx context.Context, exec boil.ContextExecut
 				return errors.Wrap(err, "failed to update foreign table")
 			}
 
-			rel.UpdatedByID = o.ID
+			queries.Assign(&rel.UpdatedByID, o.ID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			UpdatedBySales: related,
+		}
+	} else {
+		o.R.UpdatedBySales = append(o.R.UpdatedBySales, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &saleR{
+				UpdatedBy: o,
+			}
+		} else {
+			rel.R.UpdatedBy = o
+		}
+	}
+	return nil
+}
+
+// SetUpdatedBySales removes all previously related items of the
+// user replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.UpdatedBy's UpdatedBySales accordingly.
+// Replaces o.R.UpdatedBySales with related.
+// Sets related.R.UpdatedBy's UpdatedBySales accordingly.
+func (o *User) SetUpdatedBySales(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Sale) error {
+	query := "update \"sale\" set \"updated_by_id\" = null where \"updated_by_id\" = $1"
+	values := []interface{}{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.UpdatedBySales {
+			queries.SetScanner(&rel.UpdatedByID, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.UpdatedBy = nil
+		}
+
+		o.R.UpdatedBySales = nil
+	}
+	return o.AddUpdatedBySales(ctx, exec, insert, related...)
+}
+
+// RemoveUpdatedBySales relationships from objects passed in.
+// Removes related items from R.UpdatedBySales (uses pointer comparison, removal does not keep order)
+// Sets related.R.UpdatedBy.
+func (o *User) RemoveUpdatedBySales(ctx context.Context, exec boil.ContextExecutor, related ...*Sale) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.UpdatedByID, nil)
+		if rel.R != nil {
+			rel.R.UpdatedBy = nil
+		}
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("updated_by_id")); err != nil {
+			return err
 		}
 	}
-
 	if o.R == nil {
-		o.R = &userR{
-			UpdatedByProducts: related,
-		}
-	} else {
-		o.R.UpdatedByProducts = append(o.R.UpdatedByProducts, related...)
+		return nil
 	}
 
 	for _, rel := range related {
-		if rel.R == nil {
-			rel.R = &productR{
-				UpdatedBy: o,
+		for i, ri := range o.R.UpdatedBySales {
+			if rel != ri {
+				continue
 			}
-		} else {
-			rel.R.UpdatedBy = o
+
+			ln := len(o.R.UpdatedBySales)
+			if ln > 1 && i < ln-1 {
+				o.R.UpdatedBySales[i] = o.R.UpdatedBySales[ln-1]
+			}
+			o.R.UpdatedBySales = o.R.UpdatedBySales[:ln-1]
+			break
 		}
 	}
+
 	return nil
 }
 
-// AddSalesRepRepsExpenses adds the given related objects to the existing relationships
+// AddSalesRepTillSessions adds the given related objects to the existing relationships
 // of the user, optionally inserting them as new records.
-// Appends related to o.R.SalesRepRepsExpenses.
+// Appends related to o.R.SalesRepTillSessions.
 // Sets related.R.SalesRep appropriately.
-func (o *User) AddSalesRepRepsExpenses(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*RepsExpense) error {
+func (o *User) AddSalesRepTillSessions(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*TillSession) error {
 	var err error
 	for _, rel := range related {
 		if insert {
@@ -2248,9 +6746,9 @@ func (o *User) AddSalesRepRepsExpenses(ctx context.Context, exec boil.ContextExe
 			}
 		} else {
 			updateQuery := fmt.Sprintf(
-				"UPDATE \"reps_expense\" SET %s WHERE %s",
+				"UPDATE \"till_session\" SET %s WHERE %s",
 				strmangle.SetParamNames("\"", "\"", 1, []string{"sales_rep_id"}),
-				strmangle.WhereClause("\"", "\"", 2, repsExpensePrimaryKeyColumns),
+				strmangle.WhereClause("\"", "\"", 2, tillSessionPrimaryKeyColumns),
 			)
 			values := []interface{}{o.ID, rel.ID}
 
@@ -2269,15 +6767,15 @@ func (o *User) AddSalesRepRepsExpenses(ctx context.Context, exec boil.ContextExe
 
 	if o.R == nil {
 		o.R = &userR{
-			SalesRepRepsExpenses: related,
+			SalesRepTillSessions: related,
 		}
 	} else {
-		o.R.SalesRepRepsExpenses = append(o.R.SalesRepRepsExpenses, related...)
+		o.R.SalesRepTillSessions = append(o.R.SalesRepTillSessions, related...)
 	}
 
 	for _, rel := range related {
 		if rel.R == nil {
-			rel.R = &repsExpenseR{
+			rel.R = &tillSessionR{
 				SalesRep: o,
 			}
 		} else {
@@ -2287,23 +6785,23 @@ func (o *User) AddSalesRepRepsExpenses(ctx context.Context, exec boil.ContextExe
 	return nil
 }
 
-// AddArchivedBySales adds the given related objects to the existing relationships
+// AddSignedOffByTillSessions adds the given related objects to the existing relationships
 // of the user, optionally inserting them as new records.
-// Appends related to o.R.ArchivedBySales.
-// Sets related.R.ArchivedBy appropriately.
-func (o *User) AddArchivedBySales(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Sale) error {
+// Appends related to o.R.SignedOffByTillSessions.
+// Sets related.R.SignedOffBy appropriately.
+func (o *User) AddSignedOffByTillSessions(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*TillSession) error {
 	var err error
 	for _, rel := range related {
 		if insert {
-			queries.Assign(&rel.ArchivedByID, o.ID)
+			queries.Assign(&rel.SignedOffByID, o.ID)
 			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
 				return errors.Wrap(err, "failed to insert into foreign table")
 			}
 		} else {
 			updateQuery := fmt.Sprintf(
-				"UPDATE \"sale\" SET %s WHERE %s",
-				strmangle.SetParamNames("\"", "\"", 1, []string{"archived_by_id"}),
-				strmangle.WhereClause("\"", "\"", 2, salePrimaryKeyColumns),
+				"UPDATE \"till_session\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"signed_off_by_id"}),
+				strmangle.WhereClause("\"", "\"", 2, tillSessionPrimaryKeyColumns),
 			)
 			values := []interface{}{o.ID, rel.ID}
 
@@ -2316,38 +6814,38 @@ func (o *User) AddArchivedBySales(ctx context.Context, exec boil.ContextExecutor
 				return errors.Wrap(err, "failed to update foreign table")
 			}
 
-			queries.Assign(&rel.ArchivedByID, o.ID)
+			queries.Assign(&rel.SignedOffByID, o.ID)
 		}
 	}
 
 	if o.R == nil {
 		o.R = &userR{
-			ArchivedBySales: related,
+			SignedOffByTillSessions: related,
 		}
 	} else {
-		o.R.ArchivedBySales = append(o.R.ArchivedBySales, related...)
+		o.R.SignedOffByTillSessions = append(o.R.SignedOffByTillSessions, related...)
 	}
 
 	for _, rel := range related {
 		if rel.R == nil {
-			rel.R = &saleR{
-				ArchivedBy: o,
+			rel.R = &tillSessionR{
+				SignedOffBy: o,
 			}
 		} else {
-			rel.R.ArchivedBy = o
+			rel.R.SignedOffBy = o
 		}
 	}
 	return nil
 }
 
-// SetArchivedBySales removes all previously related items of the
+// SetSignedOffByTillSessions removes all previously related items of the
 // user replacing them completely with the passed
 // in related items, optionally inserting them as new records.
-// Sets o.R.ArchivedBy's ArchivedBySales accordingly.
-// Replaces o.R.ArchivedBySales with related.
-// Sets related.R.ArchivedBy's ArchivedBySales accordingly.
-func (o *User) SetArchivedBySales(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Sale) error {
-	query := "update \"sale\" set \"archived_by_id\" = null where \"archived_by_id\" = $1"
+// Sets o.R.SignedOffBy's SignedOffByTillSessions accordingly.
+// Replaces o.R.SignedOffByTillSessions with related.
+// Sets related.R.SignedOffBy's SignedOffByTillSessions accordingly.
+func (o *User) SetSignedOffByTillSessions(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*TillSession) error {
+	query := "update \"till_session\" set \"signed_off_by_id\" = null where \"signed_off_by_id\" = $1"
 	values := []interface{}{o.ID}
 	if boil.IsDebug(ctx) {
 		writer := boil.DebugWriterFrom(ctx)
@@ -2360,35 +6858,35 @@ func (o *User) SetArchivedBySales(ctx context.Context, exec boil.ContextExecutor
 	}
 
 	if o.R != nil {
-		for _, rel := range o.R.ArchivedBySales {
-			queries.SetScanner(&rel.ArchivedByID, nil)
+		for _, rel := range o.R.SignedOffByTillSessions {
+			queries.SetScanner(&rel.SignedOffByID, nil)
 			if rel.R == nil {
 				continue
 			}
 
-			rel.R.ArchivedBy = nil
+			rel.R.SignedOffBy = nil
 		}
 
-		o.R.ArchivedBySales = nil
+		o.R.SignedOffByTillSessions = nil
 	}
-	return o.AddArchivedBySales(ctx, exec, insert, related...)
+	return o.AddSignedOffByTillSessions(ctx, exec, insert, related...)
 }
 
-// RemoveArchivedBySales relationships from objects passed in.
-// Removes related items from R.ArchivedBySales (uses pointer comparison, removal does not keep order)
-// Sets related.R.ArchivedBy.
-func (o *User) RemoveArchivedBySales(ctx context.Context, exec boil.ContextExecutor, related ...*Sale) error {
+// RemoveSignedOffByTillSessions relationships from objects passed in.
+// Removes related items from R.SignedOffByTillSessions (uses pointer comparison, removal does not keep order)
+// Sets related.R.SignedOffBy.
+func (o *User) RemoveSignedOffByTillSessions(ctx context.Context, exec boil.ContextExecutor, related ...*TillSession) error {
 	if len(related) == 0 {
 		return nil
 	}
 
 	var err error
 	for _, rel := range related {
-		queries.SetScanner(&rel.ArchivedByID, nil)
+		queries.SetScanner(&rel.SignedOffByID, nil)
 		if rel.R != nil {
-			rel.R.ArchivedBy = nil
+			rel.R.SignedOffBy = nil
 		}
-		if _, err = rel.Update(ctx, exec, boil.Whitelist("archived_by_id")); err != nil {
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("signed_off_by_id")); err != nil {
 			return err
 		}
 	}
@@ -2397,16 +6895,16 @@ func (o *User) RemoveArchivedBySales(ctx context.Context, exec boil.ContextExecu
 	}
 
 	for _, rel := range related {
-		for i, ri := range o.R.ArchivedBySales {
+		for i, ri := range o.R.SignedOffByTillSessions {
 			if rel != ri {
 				continue
 			}
 
-			ln := len(o.R.ArchivedBySales)
+			ln := len(o.R.SignedOffByTillSessions)
 			if ln > 1 && i < ln-1 {
-				o.R.ArchivedBySales[i] = o.R.ArchivedBySales[ln-1]
+				o.R.SignedOffByTillSessions[i] = o.R.SignedOffByTillSessions[ln-1]
 			}
-			o.R.ArchivedBySales = o.R.ArchivedBySales[:ln-1]
+			o.R.SignedOffByTillSessions = o.R.SignedOffByTillSessions[:ln-1]
 			break
 		}
 	}
@@ -2414,23 +6912,23 @@ func (o *User) RemoveArchivedBySales(ctx context.Context, exec boil.ContextExecu
 	return nil
 }
 
-// AddCreatedBySales adds the given related objects to the existing relationships
+// AddSubmittedByTillSessions adds the given related objects to the existing relationships
 // of the user, optionally inserting them as new records.
-// Appends related to o.R.CreatedBySales.
-// Sets related.R.CreatedBy appropriately.
-func (o *User) AddCreatedBySales(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Sale) error {
+// Appends related to o.R.SubmittedByTillSessions.
+// Sets related.R.SubmittedBy appropriately.
+func (o *User) AddSubmittedByTillSessions(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*TillSession) error {
 	var err error
 	for _, rel := range related {
 		if insert {
-			rel.CreatedByID = o.ID
+			rel.SubmittedByID = o.ID
 			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
 				return errors.Wrap(err, "failed to insert into foreign table")
 			}
 		} else {
 			updateQuery := fmt.Sprintf(
-				"UPDATE \"sale\" SET %s WHERE %s",
-				strmangle.SetParamNames("\"", "\"", 1, []string{"created_by_id"}),
-				strmangle.WhereClause("\"", "\"", 2, salePrimaryKeyColumns),
+				"UPDATE \"till_session\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"submitted_by_id"}),
+				strmangle.WhereClause("\"", "\"", 2, tillSessionPrimaryKeyColumns),
 			)
 			values := []interface{}{o.ID, rel.ID}
 
@@ -2443,47 +6941,47 @@ func (o *User) AddCreatedBySales(ctx context.Context, exec boil.ContextExecutor,
 				return errors.Wrap(err, "failed to update foreign table")
 			}
 
-			rel.CreatedByID = o.ID
+			rel.SubmittedByID = o.ID
 		}
 	}
 
 	if o.R == nil {
 		o.R = &userR{
-			CreatedBySales: related,
+			SubmittedByTillSessions: related,
 		}
 	} else {
-		o.R.CreatedBySales = append(o.R.CreatedBySales, related...)
+		o.R.SubmittedByTillSessions = append(o.R.SubmittedByTillSessions, related...)
 	}
 
 	for _, rel := range related {
 		if rel.R == nil {
-			rel.R = &saleR{
-				CreatedBy: o,
+			rel.R = &tillSessionR{
+				SubmittedBy: o,
 			}
 		} else {
-			rel.R.CreatedBy = o
+			rel.R.SubmittedBy = o
 		}
 	}
 	return nil
 }
 
-// AddUpdatedBySales adds the given related objects to the existing relationships
+// AddApprovedByTransactions adds the given related objects to the existing relationships
 // of the user, optionally inserting them as new records.
-// Appends related to o.R.UpdatedBySales.
-// Sets related.R.UpdatedBy appropriately.
-func (o *User) AddUpdatedBySales(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Sale) error {
+// Appends related to o.R.ApprovedByTransactions.
+// Sets related.R.ApprovedBy appropriately.
+func (o *User) AddApprovedByTransactions(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Transaction) error {
 	var err error
 	for _, rel := range related {
 		if insert {
-			queries.Assign(&rel.UpdatedByID, o.ID)
+			queries.Assign(&rel.ApprovedByID, o.ID)
 			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
 				return errors.Wrap(err, "failed to insert into foreign table")
 			}
 		} else {
 			updateQuery := fmt.Sprintf(
-				"UPDATE \"sale\" SET %s WHERE %s",
-				strmangle.SetParamNames("\"", "\"", 1, []string{"updated_by_id"}),
-				strmangle.WhereClause("\"", "\"", 2, salePrimaryKeyColumns),
+				"UPDATE \"transaction\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"approved_by_id"}),
+				strmangle.WhereClause("\"", "\"", 2, transactionPrimaryKeyColumns),
 			)
 			values := []interface{}{o.ID, rel.ID}
 
@@ -2496,38 +6994,38 @@ func (o *User) AddUpdatedBySales(ctx context.Context, exec boil.ContextExecutor,
 				return errors.Wrap(err, "failed to update foreign table")
 			}
 
-			queries.Assign(&rel.UpdatedByID, o.ID)
+			queries.Assign(&rel.ApprovedByID, o.ID)
 		}
 	}
 
 	if o.R == nil {
 		o.R = &userR{
-			UpdatedBySales: related,
+			ApprovedByTransactions: related,
 		}
 	} else {
-		o.R.UpdatedBySales = append(o.R.UpdatedBySales, related...)
+		o.R.ApprovedByTransactions = append(o.R.ApprovedByTransactions, related...)
 	}
 
 	for _, rel := range related {
 		if rel.R == nil {
-			rel.R = &saleR{
-				UpdatedBy: o,
+			rel.R = &transactionR{
+				ApprovedBy: o,
 			}
 		} else {
-			rel.R.UpdatedBy = o
+			rel.R.ApprovedBy = o
 		}
 	}
 	return nil
 }
 
-// SetUpdatedBySales removes all previously related items of the
+// SetApprovedByTransactions removes all previously related items of the
 // user replacing them completely with the passed
 // in related items, optionally inserting them as new records.
-// Sets o.R.UpdatedBy's UpdatedBySales accordingly.
-// Replaces o.R.UpdatedBySales with related.
-// Sets related.R.UpdatedBy's UpdatedBySales accordingly.
-func (o *User) SetUpdatedBySales(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Sale) error {
-	query := "update \"sale\" set \"updated_by_id\" = null where \"updated_by_id\" = $1"
+// Sets o.R.ApprovedBy's ApprovedByTransactions accordingly.
+// Replaces o.R.ApprovedByTransactions with related.
+// Sets related.R.ApprovedBy's ApprovedByTransactions accordingly.
+func (o *User) SetApprovedByTransactions(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Transaction) error {
+	query := "update \"transaction\" set \"approved_by_id\" = null where \"approved_by_id\" = $1"
 	values := []interface{}{o.ID}
 	if boil.IsDebug(ctx) {
 		writer := boil.DebugWriterFrom(ctx)
@@ -2540,35 +7038,35 @@ func (o *User) SetUpdatedBySales(ctx context.Context, exec boil.ContextExecutor,
 	}
 
 	if o.R != nil {
-		for _, rel := range o.R.UpdatedBySales {
-			queries.SetScanner(&rel.UpdatedByID, nil)
+		for _, rel := range o.R.ApprovedByTransactions {
+			queries.SetScanner(&rel.ApprovedByID, nil)
 			if rel.R == nil {
 				continue
 			}
 
-			rel.R.UpdatedBy = nil
+			rel.R.ApprovedBy = nil
 		}
 
-		o.R.UpdatedBySales = nil
+		o.R.ApprovedByTransactions = nil
 	}
-	return o.AddUpdatedBySales(ctx, exec, insert, related...)
+	return o.AddApprovedByTransactions(ctx, exec, insert, related...)
 }
 
-// RemoveUpdatedBySales relationships from objects passed in.
-// Removes related items from R.UpdatedBySales (uses pointer comparison, removal does not keep order)
-// Sets related.R.UpdatedBy.
-func (o *User) RemoveUpdatedBySales(ctx context.Context, exec boil.ContextExecutor, related ...*Sale) error {
+// RemoveApprovedByTransactions relationships from objects passed in.
+// Removes related items from R.ApprovedByTransactions (uses pointer comparison, removal does not keep order)
+// Sets related.R.ApprovedBy.
+func (o *User) RemoveApprovedByTransactions(ctx context.Context, exec boil.ContextExecutor, related ...*Transaction) error {
 	if len(related) == 0 {
 		return nil
 	}
 
 	var err error
 	for _, rel := range related {
-		queries.SetScanner(&rel.UpdatedByID, nil)
+		queries.SetScanner(&rel.ApprovedByID, nil)
 		if rel.R != nil {
-			rel.R.UpdatedBy = nil
+			rel.R.ApprovedBy = nil
 		}
-		if _, err = rel.Update(ctx, exec, boil.Whitelist("updated_by_id")); err != nil {
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("approved_by_id")); err != nil {
 			return err
 		}
 	}
@@ -2577,16 +7075,16 @@ func (o *User) RemoveUpdatedBySales(ctx context.Context, exec boil.ContextExecut
 	}
 
 	for _, rel := range related {
-		for i, ri := range o.R.UpdatedBySales {
+		for i, ri := range o.R.ApprovedByTransactions {
 			if rel != ri {
 				continue
 			}
 
-			ln := len(o.R.UpdatedBySales)
+			ln := len(o.R.ApprovedByTransactions)
 			if ln > 1 && i < ln-1 {
-				o.R.UpdatedBySales[i] = o.R.UpdatedBySales[ln-1]
+				o.R.ApprovedByTransactions[i] = o.R.ApprovedByTransactions[ln-1]
 			}
-			o.R.UpdatedBySales = o.R.UpdatedBySales[:ln-1]
+			o.R.ApprovedByTransactions = o.R.ApprovedByTransactions[:ln-1]
 			break
 		}
 	}
@@ -2647,6 +7145,186 @@ func (o *User) AddSalesRepTransactions(ctx context.Context, exec boil.ContextExe
 	return nil
 }
 
+// AddSalesRepTransfers adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.SalesRepTransfers.
+// Sets related.R.SalesRep appropriately.
+func (o *User) AddSalesRepTransfers(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Transfer) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.SalesRepID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"transfer\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"sales_rep_id"}),
+				strmangle.WhereClause("\"", "\"", 2, transferPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.SalesRepID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			SalesRepTransfers: related,
+		}
+	} else {
+		o.R.SalesRepTransfers = append(o.R.SalesRepTransfers, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &transferR{
+				SalesRep: o,
+			}
+		} else {
+			rel.R.SalesRep = o
+		}
+	}
+	return nil
+}
+
+// AddDecidedByWithdrawalRequests adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.DecidedByWithdrawalRequests.
+// Sets related.R.DecidedBy appropriately.
+func (o *User) AddDecidedByWithdrawalRequests(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*WithdrawalRequest) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.DecidedByID, o.ID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"withdrawal_request\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"decided_by_id"}),
+				strmangle.WhereClause("\"", "\"", 2, withdrawalRequestPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.DecidedByID, o.ID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			DecidedByWithdrawalRequests: related,
+		}
+	} else {
+		o.R.DecidedByWithdrawalRequests = append(o.R.DecidedByWithdrawalRequests, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &withdrawalRequestR{
+				DecidedBy: o,
+			}
+		} else {
+			rel.R.DecidedBy = o
+		}
+	}
+	return nil
+}
+
+// SetDecidedByWithdrawalRequests removes all previously related items of the
+// user replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.DecidedBy's DecidedByWithdrawalRequests accordingly.
+// Replaces o.R.DecidedByWithdrawalRequests with related.
+// Sets related.R.DecidedBy's DecidedByWithdrawalRequests accordingly.
+func (o *User) SetDecidedByWithdrawalRequests(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*WithdrawalRequest) error {
+	query := "update \"withdrawal_request\" set \"decided_by_id\" = null where \"decided_by_id\" = $1"
+	values := []interface{}{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.DecidedByWithdrawalRequests {
+			queries.SetScanner(&rel.DecidedByID, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.DecidedBy = nil
+		}
+
+		o.R.DecidedByWithdrawalRequests = nil
+	}
+	return o.AddDecidedByWithdrawalRequests(ctx, exec, insert, related...)
+}
+
+// RemoveDecidedByWithdrawalRequests relationships from objects passed in.
+// Removes related items from R.DecidedByWithdrawalRequests (uses pointer comparison, removal does not keep order)
+// Sets related.R.DecidedBy.
+func (o *User) RemoveDecidedByWithdrawalRequests(ctx context.Context, exec boil.ContextExecutor, related ...*WithdrawalRequest) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.DecidedByID, nil)
+		if rel.R != nil {
+			rel.R.DecidedBy = nil
+		}
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("decided_by_id")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.DecidedByWithdrawalRequests {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.DecidedByWithdrawalRequests)
+			if ln > 1 && i < ln-1 {
+				o.R.DecidedByWithdrawalRequests[i] = o.R.DecidedByWithdrawalRequests[ln-1]
+			}
+			o.R.DecidedByWithdrawalRequests = o.R.DecidedByWithdrawalRequests[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
 // Users retrieves all the records using an executor.
 func Users(mods ...qm.QueryMod) userQuery {
 	mods = append(mods, qm.From("\"users\""))
@@ -431,14 +431,14 @@ func testUserToManySalesRepAccounts(t *testing.T) {
 	}
 }
 
-func testUserToManySalesRepCustomers(t *testing.T) {
+func testUserToManySalesRepAccountFollowUps(t *testing.T) {
 	var err error
 	ctx := context.Background()
 	tx := MustTx(boil.BeginTx(ctx, nil))
 	defer func() { _ = tx.Rollback() }()
 
 	var a User
-	var b, c Customer
+	var b, c AccountFollowUp
 
 	seed := randomize.NewSeed()
 	if err = randomize.Struct(seed, &a, userDBTypes, true, userColumnsWithDefault...); err != nil {
@@ -449,10 +449,10 @@ func testUserToManySalesRepCustomers(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	if err = randomize.Struct(seed, &b, customerDBTypes, false, customerColumnsWithDefault...); err != nil {
+	if err = randomize.Struct(seed, &b, accountFollowUpDBTypes, false, accountFollowUpColumnsWithDefault...); err != nil {
 		t.Fatal(err)
 	}
-	if err = randomize.Struct(seed, &c, customerDBTypes, false, customerColumnsWithDefault...); err != nil {
+	if err = randomize.Struct(seed, &c, accountFollowUpDBTypes, false, accountFollowUpColumnsWithDefault...); err != nil {
 		t.Fatal(err)
 	}
 
@@ -466,7 +466,7 @@ func testUserToManySalesRepCustomers(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	check, err := a.SalesRepCustomers().All(ctx, tx)
+	check, err := a.SalesRepAccountFollowUps().All(ctx, tx)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -489,18 +489,18 @@ func testUserToManySalesRepCustomers(t *testing.T) {
 	}
 
 	slice := UserSlice{&a}
-	if err = a.L.LoadSalesRepCustomers(ctx, tx, false, (*[]*User)(&slice), nil); err != nil {
+	if err = a.L.LoadSalesRepAccountFollowUps(ctx, tx, false, (*[]*User)(&slice), nil); err != nil {
 		t.Fatal(err)
 	}
-	if got := len(a.R.SalesRepCustomers); got != 2 {
+	if got := len(a.R.SalesRepAccountFollowUps); got != 2 {
 		t.Error("number of eager loaded records wrong, got:", got)
 	}
 
-	a.R.SalesRepCustomers = nil
-	if err = a.L.LoadSalesRepCustomers(ctx, tx, true, &a, nil); err != nil {
+	a.R.SalesRepAccountFollowUps = nil
+	if err = a.L.LoadSalesRepAccountFollowUps(ctx, tx, true, &a, nil); err != nil {
 		t.Fatal(err)
 	}
-	if got := len(a.R.SalesRepCustomers); got != 2 {
+	if got := len(a.R.SalesRepAccountFollowUps); got != 2 {
 		t.Error("number of eager loaded records wrong, got:", got)
 	}
 
@@ -509,14 +509,14 @@ func testUserToManySalesRepCustomers(t *testing.T) {
 	}
 }
 
-func testUserToManySalesRepInventories(t *testing.T) {
+func testUserToManyCreatedByAccountHolds(t *testing.T) {
 	var err error
 	ctx := context.Background()
 	tx := MustTx(boil.BeginTx(ctx, nil))
 	defer func() { _ = tx.Rollback() }()
 
 	var a User
-	var b, c Inventory
+	var b, c AccountHold
 
 	seed := randomize.NewSeed()
 	if err = randomize.Struct(seed, &a, userDBTypes, true, userColumnsWithDefault...); err != nil {
@@ -527,15 +527,15 @@ func testUserToManySalesRepInventories(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	if err = randomize.Struct(seed, &b, inventoryDBTypes, false, inventoryColumnsWithDefault...); err != nil {
+	if err = randomize.Struct(seed, &b, accountHoldDBTypes, false, accountHoldColumnsWithDefault...); err != nil {
 		t.Fatal(err)
 	}
-	if err = randomize.Struct(seed, &c, inventoryDBTypes, false, inventoryColumnsWithDefault...); err != nil {
+	if err = randomize.Struct(seed, &c, accountHoldDBTypes, false, accountHoldColumnsWithDefault...); err != nil {
 		t.Fatal(err)
 	}
 
-	b.SalesRepID = a.ID
-	c.SalesRepID = a.ID
+	b.CreatedByID = a.ID
+	c.CreatedByID = a.ID
 
 	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
 		t.Fatal(err)
@@ -544,17 +544,17 @@ func testUserToManySalesRepInventories(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	check, err := a.SalesRepInventories().All(ctx, tx)
+	check, err := a.CreatedByAccountHolds().All(ctx, tx)
 	if err != nil {
 		t.Fatal(err)
 	}
 
 	bFound, cFound := false, false
 	for _, v := range check {
-		if v.SalesRepID == b.SalesRepID {
+		if v.CreatedByID == b.CreatedByID {
 			bFound = true
 		}
-		if v.SalesRepID == c.SalesRepID {
+		if v.CreatedByID == c.CreatedByID {
 			cFound = true
 		}
 	}
@@ -567,18 +567,18 @@ func testUserToManySalesRepInventories(t *testing.T) {
 	}
 
 	slice := UserSlice{&a}
-	if err = a.L.LoadSalesRepInventories(ctx, tx, false, (*[]*User)(&slice), nil); err != nil {
+	if err = a.L.LoadCreatedByAccountHolds(ctx, tx, false, (*[]*User)(&slice), nil); err != nil {
 		t.Fatal(err)
 	}
-	if got := len(a.R.SalesRepInventories); got != 2 {
+	if got := len(a.R.CreatedByAccountHolds); got != 2 {
 		t.Error("number of eager loaded records wrong, got:", got)
 	}
 
-	a.R.SalesRepInventories = nil
-	if err = a.L.LoadSalesRepInventories(ctx, tx, true, &a, nil); err != nil {
+	a.R.CreatedByAccountHolds = nil
+	if err = a.L.LoadCreatedByAccountHolds(ctx, tx, true, &a, nil); err != nil {
 		t.Fatal(err)
 	}
-	if got := len(a.R.SalesRepInventories); got != 2 {
+	if got := len(a.R.CreatedByAccountHolds); got != 2 {
 		t.Error("number of eager loaded records wrong, got:", got)
 	}
 
@@ -587,14 +587,14 @@ func testUserToManySalesRepInventories(t *testing.T) {
 	}
 }
 
-func testUserToManySalesRepPayments(t *testing.T) {
+func testUserToManyReleasedByAccountHolds(t *testing.T) {
 	var err error
 	ctx := context.Background()
 	tx := MustTx(boil.BeginTx(ctx, nil))
 	defer func() { _ = tx.Rollback() }()
 
 	var a User
-	var b, c Payment
+	var b, c AccountHold
 
 	seed := randomize.NewSeed()
 	if err = randomize.Struct(seed, &a, userDBTypes, true, userColumnsWithDefault...); err != nil {
@@ -605,16 +605,15 @@ func testUserToManySalesRepPayments(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	if err = randomize.Struct(seed, &b, paymentDBTypes, false, paymentColumnsWithDefault...); err != nil {
+	if err = randomize.Struct(seed, &b, accountHoldDBTypes, false, accountHoldColumnsWithDefault...); err != nil {
 		t.Fatal(err)
 	}
-	if err = randomize.Struct(seed, &c, paymentDBTypes, false, paymentColumnsWithDefault...); err != nil {
+	if err = randomize.Struct(seed, &c, accountHoldDBTypes, false, accountHoldColumnsWithDefault...); err != nil {
 		t.Fatal(err)
 	}
 
-	b.SalesRepID = a.ID
-	c.SalesRepID = a.ID
-
+	queries.Assign(&b.ReleasedByID, a.ID)
+	queries.Assign(&c.ReleasedByID, a.ID)
 	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
 		t.Fatal(err)
 	}
@@ -622,17 +621,17 @@ func testUserToManySalesRepPayments(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	check, err := a.SalesRepPayments().All(ctx, tx)
+	check, err := a.ReleasedByAccountHolds().All(ctx, tx)
 	if err != nil {
 		t.Fatal(err)
 	}
 
 	bFound, cFound := false, false
 	for _, v := range check {
-		if v.SalesRepID == b.SalesRepID {
+		if queries.Equal(v.ReleasedByID, b.ReleasedByID) {
 			bFound = true
 		}
-		if v.SalesRepID == c.SalesRepID {
+		if queries.Equal(v.ReleasedByID, c.ReleasedByID) {
 			cFound = true
 		}
 	}
@@ -645,18 +644,18 @@ func testUserToManySalesRepPayments(t *testing.T) {
 	}
 
 	slice := UserSlice{&a}
-	if err = a.L.LoadSalesRepPayments(ctx, tx, false, (*[]*User)(&slice), nil); err != nil {
+	if err = a.L.LoadReleasedByAccountHolds(ctx, tx, false, (*[]*User)(&slice), nil); err != nil {
 		t.Fatal(err)
 	}
-	if got := len(a.R.SalesRepPayments); got != 2 {
+	if got := len(a.R.ReleasedByAccountHolds); got != 2 {
 		t.Error("number of eager loaded records wrong, got:", got)
 	}
 
-	a.R.SalesRepPayments = nil
-	if err = a.L.LoadSalesRepPayments(ctx, tx, true, &a, nil); err != nil {
+	a.R.ReleasedByAccountHolds = nil
+	if err = a.L.LoadReleasedByAccountHolds(ctx, tx, true, &a, nil); err != nil {
 		t.Fatal(err)
 	}
-	if got := len(a.R.SalesRepPayments); got != 2 {
+	if got := len(a.R.ReleasedByAccountHolds); got != 2 {
 		t.Error("number of eager loaded records wrong, got:", got)
 	}
 
@@ -665,14 +664,14 @@ func testUserToManySalesRepPayments(t *testing.T) {
 	}
 }
 
-func testUserToManyArchivedByProducts(t *testing.T) {
+func testUserToManyChangedByAccountStatusChanges(t *testing.T) {
 	var err error
 	ctx := context.Background()
 	tx := MustTx(boil.BeginTx(ctx, nil))
 	defer func() { _ = tx.Rollback() }()
 
 	var a User
-	var b, c Product
+	var b, c AccountStatusChange
 
 	seed := randomize.NewSeed()
 	if err = randomize.Struct(seed, &a, userDBTypes, true, userColumnsWithDefault...); err != nil {
@@ -683,15 +682,15 @@ func testUserToManyArchivedByProducts(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	if err = randomize.Struct(seed, &b, productDBTypes, false, productColumnsWithDefault...); err != nil {
+	if err = randomize.Struct(seed, &b, accountStatusChangeDBTypes, false, accountStatusChangeColumnsWithDefault...); err != nil {
 		t.Fatal(err)
 	}
-	if err = randomize.Struct(seed, &c, productDBTypes, false, productColumnsWithDefault...); err != nil {
+	if err = randomize.Struct(seed, &c, accountStatusChangeDBTypes, false, accountStatusChangeColumnsWithDefault...); err != nil {
 		t.Fatal(err)
 	}
 
-	queries.Assign(&b.ArchivedByID, a.ID)
-	queries.Assign(&c.ArchivedByID, a.ID)
+	queries.Assign(&b.ChangedByID, a.ID)
+	queries.Assign(&c.ChangedByID, a.ID)
 	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
 		t.Fatal(err)
 	}
@@ -699,17 +698,17 @@ func testUserToManyArchivedByProducts(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	check, err := a.ArchivedByProducts().All(ctx, tx)
+	check, err := a.ChangedByAccountStatusChanges().All(ctx, tx)
 	if err != nil {
 		t.Fatal(err)
 	}
 
 	bFound, cFound := false, false
 	for _, v := range check {
-		if queries.Equal(v.ArchivedByID, b.ArchivedByID) {
+		if queries.Equal(v.ChangedByID, b.ChangedByID) {
 			bFound = true
 		}
-		if queries.Equal(v.ArchivedByID, c.ArchivedByID) {
+		if queries.Equal(v.ChangedByID, c.ChangedByID) {
 			cFound = true
 		}
 	}
@@ -722,18 +721,18 @@ func testUserToManyArchivedByProducts(t *testing.T) {
 	}
 
 	slice := UserSlice{&a}
-	if err = a.L.LoadArchivedByProducts(ctx, tx, false, (*[]*User)(&slice), nil); err != nil {
+	if err = a.L.LoadChangedByAccountStatusChanges(ctx, tx, false, (*[]*User)(&slice), nil); err != nil {
 		t.Fatal(err)
 	}
-	if got := len(a.R.ArchivedByProducts); got != 2 {
+	if got := len(a.R.ChangedByAccountStatusChanges); got != 2 {
 		t.Error("number of eager loaded records wrong, got:", got)
 	}
 
-	a.R.ArchivedByProducts = nil
-	if err = a.L.LoadArchivedByProducts(ctx, tx, true, &a, nil); err != nil {
+	a.R.ChangedByAccountStatusChanges = nil
+	if err = a.L.LoadChangedByAccountStatusChanges(ctx, tx, true, &a, nil); err != nil {
 		t.Fatal(err)
 	}
-	if got := len(a.R.ArchivedByProducts); got != 2 {
+	if got := len(a.R.ChangedByAccountStatusChanges); got != 2 {
 		t.Error("number of eager loaded records wrong, got:", got)
 	}
 
@@ -742,14 +741,14 @@ func testUserToManyArchivedByProducts(t *testing.T) {
 	}
 }
 
-func testUserToManyCreatedByProducts(t *testing.T) {
+func testUserToManyDecidedByApprovals(t *testing.T) {
 	var err error
 	ctx := context.Background()
 	tx := MustTx(boil.BeginTx(ctx, nil))
 	defer func() { _ = tx.Rollback() }()
 
 	var a User
-	var b, c Product
+	var b, c Approval
 
 	seed := randomize.NewSeed()
 	if err = randomize.Struct(seed, &a, userDBTypes, true, userColumnsWithDefault...); err != nil {
@@ -760,16 +759,15 @@ func testUserToManyCreatedByProducts(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	if err = randomize.Struct(seed, &b, productDBTypes, false, productColumnsWithDefault...); err != nil {
+	if err = randomize.Struct(seed, &b, approvalDBTypes, false, approvalColumnsWithDefault...); err != nil {
 		t.Fatal(err)
 	}
-	if err = randomize.Struct(seed, &c, productDBTypes, false, productColumnsWithDefault...); err != nil {
+	if err = randomize.Struct(seed, &c, approvalDBTypes, false, approvalColumnsWithDefault...); err != nil {
 		t.Fatal(err)
 	}
 
-	b.CreatedByID = a.ID
-	c.CreatedByID = a.ID
-
+	queries.Assign(&b.DecidedByID, a.ID)
+	queries.Assign(&c.DecidedByID, a.ID)
 	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
 		t.Fatal(err)
 	}
@@ -777,17 +775,17 @@ func testUserToManyCreatedByProducts(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	check, err := a.CreatedByProducts().All(ctx, tx)
+	check, err := a.DecidedByApprovals().All(ctx, tx)
 	if err != nil {
 		t.Fatal(err)
 	}
 
 	bFound, cFound := false, false
 	for _, v := range check {
-		if v.CreatedByID == b.CreatedByID {
+		if queries.Equal(v.DecidedByID, b.DecidedByID) {
 			bFound = true
 		}
-		if v.CreatedByID == c.CreatedByID {
+		if queries.Equal(v.DecidedByID, c.DecidedByID) {
 			cFound = true
 		}
 	}
@@ -800,18 +798,18 @@ func testUserToManyCreatedByProducts(t *testing.T) {
 	}
 
 	slice := UserSlice{&a}
-	if err = a.L.LoadCreatedByProducts(ctx, tx, false, (*[]*User)(&slice), nil); err != nil {
+	if err = a.L.LoadDecidedByApprovals(ctx, tx, false, (*[]*User)(&slice), nil); err != nil {
 		t.Fatal(err)
 	}
-	if got := len(a.R.CreatedByProducts); got != 2 {
+	if got := len(a.R.DecidedByApprovals); got != 2 {
 		t.Error("number of eager loaded records wrong, got:", got)
 	}
 
-	a.R.CreatedByProducts = nil
-	if err = a.L.LoadCreatedByProducts(ctx, tx, true, &a, nil); err != nil {
+	a.R.DecidedByApprovals = nil
+	if err = a.L.LoadDecidedByApprovals(ctx, tx, true, &a, nil); err != nil {
 		t.Fatal(err)
 	}
-	if got := len(a.R.CreatedByProducts); got != 2 {
+	if got := len(a.R.DecidedByApprovals); got != 2 {
 		t.Error("number of eager loaded records wrong, got:", got)
 	}
 
@@ -820,14 +818,14 @@ func testUserToManyCreatedByProducts(t *testing.T) {
 	}
 }
 
-func testUserToManyUpdatedByProducts(t *testing.T) {
+func testUserToManyRequestedByApprovals(t *testing.T) {
 	var err error
 	ctx := context.Background()
 	tx := MustTx(boil.BeginTx(ctx, nil))
 	defer func() { _ = tx.Rollback() }()
 
 	var a User
-	var b, c Product
+	var b, c Approval
 
 	seed := randomize.NewSeed()
 	if err = randomize.Struct(seed, &a, userDBTypes, true, userColumnsWithDefault...); err != nil {
@@ -838,15 +836,15 @@ func testUserToManyUpdatedByProducts(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	if err = randomize.Struct(seed, &b, productDBTypes, false, productColumnsWithDefault...); err != nil {
+	if err = randomize.Struct(seed, &b, approvalDBTypes, false, approvalColumnsWithDefault...); err != nil {
 		t.Fatal(err)
 	}
-	if err = randomize.Struct(seed, &c, productDBTypes, false, productColumnsWithDefault...); err != nil {
+	if err = randomize.Struct(seed, &c, approvalDBTypes, false, approvalColumnsWithDefault...); err != nil {
 		t.Fatal(err)
 	}
 
-	b.UpdatedByID = a.ID
-	c.UpdatedByID = a.ID
+	b.RequestedByID = a.ID
+	c.RequestedByID = a.ID
 
 	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
 		t.Fatal(err)
@@ -855,17 +853,17 @@ func testUserToManyUpdatedByProducts(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	check, err := a.UpdatedByProducts().All(ctx, tx)
+	check, err := a.RequestedByApprovals().All(ctx, tx)
 	if err != nil {
 		t.Fatal(err)
 	}
 
 	bFound, cFound := false, false
 	for _, v := range check {
-		if v.UpdatedByID == b.UpdatedByID {
+		if v.RequestedByID == b.RequestedByID {
 			bFound = true
 		}
-		if v.UpdatedByID == c.UpdatedByID {
+		if v.RequestedByID == c.RequestedByID {
 			cFound = true
 		}
 	}
@@ -878,18 +876,18 @@ func testUserToManyUpdatedByProducts(t *testing.T) {
 	}
 
 	slice := UserSlice{&a}
-	if err = a.L.LoadUpdatedByProducts(ctx, tx, false, (*[]*User)(&slice), nil); err != nil {
+	if err = a.L.LoadRequestedByApprovals(ctx, tx, false, (*[]*User)(&slice), nil); err != nil {
 		t.Fatal(err)
 	}
-	if got := len(a.R.UpdatedByProducts); got != 2 {
+	if got := len(a.R.RequestedByApprovals); got != 2 {
 		t.Error("number of eager loaded records wrong, got:", got)
 	}
 
-	a.R.UpdatedByProducts = nil
-	if err = a.L.LoadUpdatedByProducts(ctx, tx, true, &a, nil); err != nil {
+	a.R.RequestedByApprovals = nil
+	if err = a.L.LoadRequestedByApprovals(ctx, tx, true, &a, nil); err != nil {
 		t.Fatal(err)
 	}
-	if got := len(a.R.UpdatedByProducts); got != 2 {
+	if got := len(a.R.RequestedByApprovals); got != 2 {
 		t.Error("number of eager loaded records wrong, got:", got)
 	}
 
@@ -898,14 +896,14 @@ func testUserToManyUpdatedByProducts(t *testing.T) {
 	}
 }
 
-func testUserToManySalesRepRepsExpenses(t *testing.T) {
+func testUserToManyKycVerifiedByCustomers(t *testing.T) {
 	var err error
 	ctx := context.Background()
 	tx := MustTx(boil.BeginTx(ctx, nil))
 	defer func() { _ = tx.Rollback() }()
 
 	var a User
-	var b, c RepsExpense
+	var b, c Customer
 
 	seed := randomize.NewSeed()
 	if err = randomize.Struct(seed, &a, userDBTypes, true, userColumnsWithDefault...); err != nil {
@@ -916,16 +914,15 @@ func testUserToManySalesRepRepsExpenses(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	if err = randomize.Struct(seed, &b, repsExpenseDBTypes, false, repsExpenseColumnsWithDefault...); err != nil {
+	if err = randomize.Struct(seed, &b, customerDBTypes, false, customerColumnsWithDefault...); err != nil {
 		t.Fatal(err)
 	}
-	if err = randomize.Struct(seed, &c, repsExpenseDBTypes, false, repsExpenseColumnsWithDefault...); err != nil {
+	if err = randomize.Struct(seed, &c, customerDBTypes, false, customerColumnsWithDefault...); err != nil {
 		t.Fatal(err)
 	}
 
-	b.SalesRepID = a.ID
-	c.SalesRepID = a.ID
-
+	queries.Assign(&b.KycVerifiedByID, a.ID)
+	queries.Assign(&c.KycVerifiedByID, a.ID)
 	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
 		t.Fatal(err)
 	}
@@ -933,17 +930,17 @@ func testUserToManySalesRepRepsExpenses(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	check, err := a.SalesRepRepsExpenses().All(ctx, tx)
+	check, err := a.KycVerifiedByCustomers().All(ctx, tx)
 	if err != nil {
 		t.Fatal(err)
 	}
 
 	bFound, cFound := false, false
 	for _, v := range check {
-		if v.SalesRepID == b.SalesRepID {
+		if queries.Equal(v.KycVerifiedByID, b.KycVerifiedByID) {
 			bFound = true
 		}
-		if v.SalesRepID == c.SalesRepID {
+		if queries.Equal(v.KycVerifiedByID, c.KycVerifiedByID) {
 			cFound = true
 		}
 	}
@@ -956,18 +953,18 @@ func testUserToManySalesRepRepsExpenses(t *testing.T) {
 	}
 
 	slice := UserSlice{&a}
-	if err = a.L.LoadSalesRepRepsExpenses(ctx, tx, false, (*[]*User)(&slice), nil); err != nil {
+	if err = a.L.LoadKycVerifiedByCustomers(ctx, tx, false, (*[]*User)(&slice), nil); err != nil {
 		t.Fatal(err)
 	}
-	if got := len(a.R.SalesRepRepsExpenses); got != 2 {
+	if got := len(a.R.KycVerifiedByCustomers); got != 2 {
 		t.Error("number of eager loaded records wrong, got:", got)
 	}
 
-	a.R.SalesRepRepsExpenses = nil
-	if err = a.L.LoadSalesRepRepsExpenses(ctx, tx, true, &a, nil); err != nil {
+	a.R.KycVerifiedByCustomers = nil
+	if err = a.L.LoadKycVerifiedByCustomers(ctx, tx, true, &a, nil); err != nil {
 		t.Fatal(err)
 	}
-	if got := len(a.R.SalesRepRepsExpenses); got != 2 {
+	if got := len(a.R.KycVerifiedByCustomers); got != 2 {
 		t.Error("number of eager loaded records wrong, got:", got)
 	}
 
@@ -976,14 +973,14 @@ func testUserToManySalesRepRepsExpenses(t *testing.T) {
 	}
 }
 
-func testUserToManyArchivedBySales(t *testing.T) {
+func testUserToManySalesRepCustomers(t *testing.T) {
 	var err error
 	ctx := context.Background()
 	tx := MustTx(boil.BeginTx(ctx, nil))
 	defer func() { _ = tx.Rollback() }()
 
 	var a User
-	var b, c Sale
+	var b, c Customer
 
 	seed := randomize.NewSeed()
 	if err = randomize.Struct(seed, &a, userDBTypes, true, userColumnsWithDefault...); err != nil {
@@ -994,15 +991,16 @@ func testUserToManyArchivedBySales(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	if err = randomize.Struct(seed, &b, saleDBTypes, false, saleColumnsWithDefault...); err != nil {
+	if err = randomize.Struct(seed, &b, customerDBTypes, false, customerColumnsWithDefault...); err != nil {
 		t.Fatal(err)
 	}
-	if err = randomize.Struct(seed, &c, saleDBTypes, false, saleColumnsWithDefault...); err != nil {
+	if err = randomize.Struct(seed, &c, customerDBTypes, false, customerColumnsWithDefault...); err != nil {
 		t.Fatal(err)
 	}
 
-	queries.Assign(&b.ArchivedByID, a.ID)
-	queries.Assign(&c.ArchivedByID, a.ID)
+	b.SalesRepID = a.ID
+	c.SalesRepID = a.ID
+
 	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
 		t.Fatal(err)
 	}
@@ -1010,17 +1008,17 @@ func testUserToManyArchivedBySales(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	check, err := a.ArchivedBySales().All(ctx, tx)
+	check, err := a.SalesRepCustomers().All(ctx, tx)
 	if err != nil {
 		t.Fatal(err)
 	}
 
 	bFound, cFound := false, false
 	for _, v := range check {
-		if queries.Equal(v.ArchivedByID, b.ArchivedByID) {
+		if v.SalesRepID == b.SalesRepID {
 			bFound = true
 		}
-		if queries.Equal(v.ArchivedByID, c.ArchivedByID) {
+		if v.SalesRepID == c.SalesRepID {
 			cFound = true
 		}
 	}
@@ -1033,18 +1031,18 @@ func testUserToManyArchivedBySales(t *testing.T) {
 	}
 
 	slice := UserSlice{&a}
-	if err = a.L.LoadArchivedBySales(ctx, tx, false, (*[]*User)(&slice), nil); err != nil {
+	if err = a.L.LoadSalesRepCustomers(ctx, tx, false, (*[]*User)(&slice), nil); err != nil {
 		t.Fatal(err)
 	}
-	if got := len(a.R.ArchivedBySales); got != 2 {
+	if got := len(a.R.SalesRepCustomers); got != 2 {
 		t.Error("number of eager loaded records wrong, got:", got)
 	}
 
-	a.R.ArchivedBySales = nil
-	if err = a.L.LoadArchivedBySales(ctx, tx, true, &a, nil); err != nil {
+	a.R.SalesRepCustomers = nil
+	if err = a.L.LoadSalesRepCustomers(ctx, tx, true, &a, nil); err != nil {
 		t.Fatal(err)
 	}
-	if got := len(a.R.ArchivedBySales); got != 2 {
+	if got := len(a.R.SalesRepCustomers); got != 2 {
 		t.Error("number of eager loaded records wrong, got:", got)
 	}
 
@@ -1053,14 +1051,14 @@ func testUserToManyArchivedBySales(t *testing.T) {
 	}
 }
 
-func testUserToManyCreatedBySales(t *testing.T) {
+func testUserToManyUploadedByCustomerDocuments(t *testing.T) {
 	var err error
 	ctx := context.Background()
 	tx := MustTx(boil.BeginTx(ctx, nil))
 	defer func() { _ = tx.Rollback() }()
 
 	var a User
-	var b, c Sale
+	var b, c CustomerDocument
 
 	seed := randomize.NewSeed()
 	if err = randomize.Struct(seed, &a, userDBTypes, true, userColumnsWithDefault...); err != nil {
@@ -1071,15 +1069,15 @@ func testUserToManyCreatedBySales(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	if err = randomize.Struct(seed, &b, saleDBTypes, false, saleColumnsWithDefault...); err != nil {
+	if err = randomize.Struct(seed, &b, customerDocumentDBTypes, false, customerDocumentColumnsWithDefault...); err != nil {
 		t.Fatal(err)
 	}
-	if err = randomize.Struct(seed, &c, saleDBTypes, false, saleColumnsWithDefault...); err != nil {
+	if err = randomize.Struct(seed, &c, customerDocumentDBTypes, false, customerDocumentColumnsWithDefault...); err != nil {
 		t.Fatal(err)
 	}
 
-	b.CreatedByID = a.ID
-	c.CreatedByID = a.ID
+	b.UploadedByID = a.ID
+	c.UploadedByID = a.ID
 
 	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
 		t.Fatal(err)
@@ -1088,17 +1086,17 @@ func testUserToManyCreatedBySales(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	check, err := a.CreatedBySales().All(ctx, tx)
+	check, err := a.UploadedByCustomerDocuments().All(ctx, tx)
 	if err != nil {
 		t.Fatal(err)
 	}
 
 	bFound, cFound := false, false
 	for _, v := range check {
-		if v.CreatedByID == b.CreatedByID {
+		if v.UploadedByID == b.UploadedByID {
 			bFound = true
 		}
-		if v.CreatedByID == c.CreatedByID {
+		if v.UploadedByID == c.UploadedByID {
 			cFound = true
 		}
 	}
@@ -1111,18 +1109,18 @@ func testUserToManyCreatedBySales(t *testing.T) {
 	}
 
 	slice := UserSlice{&a}
-	if err = a.L.LoadCreatedBySales(ctx, tx, false, (*[]*User)(&slice), nil); err != nil {
+	if err = a.L.LoadUploadedByCustomerDocuments(ctx, tx, false, (*[]*User)(&slice), nil); err != nil {
 		t.Fatal(err)
 	}
-	if got := len(a.R.CreatedBySales); got != 2 {
+	if got := len(a.R.UploadedByCustomerDocuments); got != 2 {
 		t.Error("number of eager loaded records wrong, got:", got)
 	}
 
-	a.R.CreatedBySales = nil
-	if err = a.L.LoadCreatedBySales(ctx, tx, true, &a, nil); err != nil {
+	a.R.UploadedByCustomerDocuments = nil
+	if err = a.L.LoadUploadedByCustomerDocuments(ctx, tx, true, &a, nil); err != nil {
 		t.Fatal(err)
 	}
-	if got := len(a.R.CreatedBySales); got != 2 {
+	if got := len(a.R.UploadedByCustomerDocuments); got != 2 {
 		t.Error("number of eager loaded records wrong, got:", got)
 	}
 
@@ -1131,14 +1129,14 @@ func testUserToManyCreatedBySales(t *testing.T) {
 	}
 }
 
-func testUserToManyUpdatedBySales(t *testing.T) {
+func testUserToManyMergedByCustomerMerges(t *testing.T) {
 	var err error
 	ctx := context.Background()
 	tx := MustTx(boil.BeginTx(ctx, nil))
 	defer func() { _ = tx.Rollback() }()
 
 	var a User
-	var b, c Sale
+	var b, c CustomerMerge
 
 	seed := randomize.NewSeed()
 	if err = randomize.Struct(seed, &a, userDBTypes, true, userColumnsWithDefault...); err != nil {
@@ -1149,15 +1147,16 @@ func testUserToManyUpdatedBySales(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	if err = randomize.Struct(seed, &b, saleDBTypes, false, saleColumnsWithDefault...); err != nil {
+	if err = randomize.Struct(seed, &b, customerMergeDBTypes, false, customerMergeColumnsWithDefault...); err != nil {
 		t.Fatal(err)
 	}
-	if err = randomize.Struct(seed, &c, saleDBTypes, false, saleColumnsWithDefault...); err != nil {
+	if err = randomize.Struct(seed, &c, customerMergeDBTypes, false, customerMergeColumnsWithDefault...); err != nil {
 		t.Fatal(err)
 	}
 
-	queries.Assign(&b.UpdatedByID, a.ID)
-	queries.Assign(&c.UpdatedByID, a.ID)
+	b.MergedByID = a.ID
+	c.MergedByID = a.ID
+
 	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
 		t.Fatal(err)
 	}
@@ -1165,17 +1164,17 @@ func testUserToManyUpdatedBySales(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	check, err := a.UpdatedBySales().All(ctx, tx)
+	check, err := a.MergedByCustomerMerges().All(ctx, tx)
 	if err != nil {
 		t.Fatal(err)
 	}
 
 	bFound, cFound := false, false
 	for _, v := range check {
-		if queries.Equal(v.UpdatedByID, b.UpdatedByID) {
+		if v.MergedByID == b.MergedByID {
 			bFound = true
 		}
-		if queries.Equal(v.UpdatedByID, c.UpdatedByID) {
+		if v.MergedByID == c.MergedByID {
 			cFound = true
 		}
 	}
@@ -1188,18 +1187,18 @@ func testUserToManyUpdatedBySales(t *testing.T) {
 	}
 
 	slice := UserSlice{&a}
-	if err = a.L.LoadUpdatedBySales(ctx, tx, false, (*[]*User)(&slice), nil); err != nil {
+	if err = a.L.LoadMergedByCustomerMerges(ctx, tx, false, (*[]*User)(&slice), nil); err != nil {
 		t.Fatal(err)
 	}
-	if got := len(a.R.UpdatedBySales); got != 2 {
+	if got := len(a.R.MergedByCustomerMerges); got != 2 {
 		t.Error("number of eager loaded records wrong, got:", got)
 	}
 
-	a.R.UpdatedBySales = nil
-	if err = a.L.LoadUpdatedBySales(ctx, tx, true, &a, nil); err != nil {
+	a.R.MergedByCustomerMerges = nil
+	if err = a.L.LoadMergedByCustomerMerges(ctx, tx, true, &a, nil); err != nil {
 		t.Fatal(err)
 	}
-	if got := len(a.R.UpdatedBySales); got != 2 {
+	if got := len(a.R.MergedByCustomerMerges); got != 2 {
 		t.Error("number of eager loaded records wrong, got:", got)
 	}
 
@@ -1208,14 +1207,14 @@ func testUserToManyUpdatedBySales(t *testing.T) {
 	}
 }
 
-func testUserToManySalesRepTransactions(t *testing.T) {
+func testUserToManySettledByDSCycles(t *testing.T) {
 	var err error
 	ctx := context.Background()
 	tx := MustTx(boil.BeginTx(ctx, nil))
 	defer func() { _ = tx.Rollback() }()
 
 	var a User
-	var b, c Transaction
+	var b, c DSCycle
 
 	seed := randomize.NewSeed()
 	if err = randomize.Struct(seed, &a, userDBTypes, true, userColumnsWithDefault...); err != nil {
@@ -1226,16 +1225,15 @@ func testUserToManySalesRepTransactions(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	if err = randomize.Struct(seed, &b, transactionDBTypes, false, transactionColumnsWithDefault...); err != nil {
+	if err = randomize.Struct(seed, &b, dsCycleDBTypes, false, dsCycleColumnsWithDefault...); err != nil {
 		t.Fatal(err)
 	}
-	if err = randomize.Struct(seed, &c, transactionDBTypes, false, transactionColumnsWithDefault...); err != nil {
+	if err = randomize.Struct(seed, &c, dsCycleDBTypes, false, dsCycleColumnsWithDefault...); err != nil {
 		t.Fatal(err)
 	}
 
-	b.SalesRepID = a.ID
-	c.SalesRepID = a.ID
-
+	queries.Assign(&b.SettledByID, a.ID)
+	queries.Assign(&c.SettledByID, a.ID)
 	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
 		t.Fatal(err)
 	}
@@ -1243,17 +1241,17 @@ func testUserToManySalesRepTransactions(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	check, err := a.SalesRepTransactions().All(ctx, tx)
+	check, err := a.SettledByDSCycles().All(ctx, tx)
 	if err != nil {
 		t.Fatal(err)
 	}
 
 	bFound, cFound := false, false
 	for _, v := range check {
-		if v.SalesRepID == b.SalesRepID {
+		if queries.Equal(v.SettledByID, b.SettledByID) {
 			bFound = true
 		}
-		if v.SalesRepID == c.SalesRepID {
+		if queries.Equal(v.SettledByID, c.SettledByID) {
 			cFound = true
 		}
 	}
@@ -1266,18 +1264,18 @@ func testUserToManySalesRepTransactions(t *testing.T) {
 	}
 
 	slice := UserSlice{&a}
-	if err = a.L.LoadSalesRepTransactions(ctx, tx, false, (*[]*User)(&slice), nil); err != nil {
+	if err = a.L.LoadSettledByDSCycles(ctx, tx, false, (*[]*User)(&slice), nil); err != nil {
 		t.Fatal(err)
 	}
-	if got := len(a.R.SalesRepTransactions); got != 2 {
+	if got := len(a.R.SettledByDSCycles); got != 2 {
 		t.Error("number of eager loaded records wrong, got:", got)
 	}
 
-	a.R.SalesRepTransactions = nil
-	if err = a.L.LoadSalesRepTransactions(ctx, tx, true, &a, nil); err != nil {
+	a.R.SettledByDSCycles = nil
+	if err = a.L.LoadSettledByDSCycles(ctx, tx, true, &a, nil); err != nil {
 		t.Fatal(err)
 	}
-	if got := len(a.R.SalesRepTransactions); got != 2 {
+	if got := len(a.R.SettledByDSCycles); got != 2 {
 		t.Error("number of eager loaded records wrong, got:", got)
 	}
 
@@ -1286,30 +1284,34 @@ func testUserToManySalesRepTransactions(t *testing.T) {
 	}
 }
 
-func testUserToManyAddOpSalesRepAccounts(t *testing.T) {
+func testUserToManyCreatedByImportJobs(t *testing.T) {
 	var err error
-
 	ctx := context.Background()
 	tx := MustTx(boil.BeginTx(ctx, nil))
 	defer func() { _ = tx.Rollback() }()
 
 	var a User
-	var b, c, d, e Account
+	var b, c ImportJob
 
 	seed := randomize.NewSeed()
-	if err = randomize.Struct(seed, &a, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
-		t.Fatal(err)
-	}
-	foreigners := []*Account{&b, &c, &d, &e}
-	for _, x := range foreigners {
-		if err = randomize.Struct(seed, x, accountDBTypes, false, strmangle.SetComplement(accountPrimaryKeyColumns, accountColumnsWithoutDefault)...); err != nil {
-			t.Fatal(err)
-		}
+	if err = randomize.Struct(seed, &a, userDBTypes, true, userColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize User struct: %s", err)
 	}
 
 	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
 		t.Fatal(err)
 	}
+
+	if err = randomize.Struct(seed, &b, importJobDBTypes, false, importJobColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, importJobDBTypes, false, importJobColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+
+	b.CreatedByID = a.ID
+	c.CreatedByID = a.ID
+
 	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
 		t.Fatal(err)
 	}
@@ -1317,50 +1319,3060 @@ func testUserToManyAddOpSalesRepAccounts(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	foreignersSplitByInsertion := [][]*Account{
-		{&b, &c},
-		{&d, &e},
+	check, err := a.CreatedByImportJobs().All(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
 	}
 
-	for i, x := range foreignersSplitByInsertion {
-		err = a.AddSalesRepAccounts(ctx, tx, i != 0, x...)
-		if err != nil {
-			t.Fatal(err)
-		}
-
-		first := x[0]
-		second := x[1]
-
-		if a.ID != first.SalesRepID {
-			t.Error("foreign key was wrong value", a.ID, first.SalesRepID)
+	bFound, cFound := false, false
+	for _, v := range check {
+		if v.CreatedByID == b.CreatedByID {
+			bFound = true
 		}
-		if a.ID != second.SalesRepID {
-			t.Error("foreign key was wrong value", a.ID, second.SalesRepID)
+		if v.CreatedByID == c.CreatedByID {
+			cFound = true
 		}
+	}
 
-		if first.R.SalesRep != &a {
-			t.Error("relationship was not added properly to the foreign slice")
-		}
-		if second.R.SalesRep != &a {
-			t.Error("relationship was not added properly to the foreign slice")
-		}
+	if !bFound {
+		t.Error("expected to find b")
+	}
+	if !cFound {
+		t.Error("expected to find c")
+	}
 
-		if a.R.SalesRepAccounts[i*2] != first {
-			t.Error("relationship struct slice not set to correct value")
-		}
-		if a.R.SalesRepAccounts[i*2+1] != second {
-			t.Error("relationship struct slice not set to correct value")
-		}
+	slice := UserSlice{&a}
+	if err = a.L.LoadCreatedByImportJobs(ctx, tx, false, (*[]*User)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.CreatedByImportJobs); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
 
-		count, err := a.SalesRepAccounts().Count(ctx, tx)
-		if err != nil {
-			t.Fatal(err)
-		}
-		if want := int64((i + 1) * 2); count != want {
-			t.Error("want", want, "got", count)
-		}
+	a.R.CreatedByImportJobs = nil
+	if err = a.L.LoadCreatedByImportJobs(ctx, tx, true, &a, nil); err != nil {
+		t.Fatal(err)
 	}
-}
+	if got := len(a.R.CreatedByImportJobs); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	if t.Failed() {
+		t.Logf("%#v", check)
+	}
+}
+
+func testUserToManySalesRepImportJobs(t *testing.T) {
+	var err error
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a User
+	var b, c ImportJob
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, userDBTypes, true, userColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize User struct: %s", err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = randomize.Struct(seed, &b, importJobDBTypes, false, importJobColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, importJobDBTypes, false, importJobColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+
+	b.SalesRepID = a.ID
+	c.SalesRepID = a.ID
+
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := a.SalesRepImportJobs().All(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bFound, cFound := false, false
+	for _, v := range check {
+		if v.SalesRepID == b.SalesRepID {
+			bFound = true
+		}
+		if v.SalesRepID == c.SalesRepID {
+			cFound = true
+		}
+	}
+
+	if !bFound {
+		t.Error("expected to find b")
+	}
+	if !cFound {
+		t.Error("expected to find c")
+	}
+
+	slice := UserSlice{&a}
+	if err = a.L.LoadSalesRepImportJobs(ctx, tx, false, (*[]*User)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.SalesRepImportJobs); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	a.R.SalesRepImportJobs = nil
+	if err = a.L.LoadSalesRepImportJobs(ctx, tx, true, &a, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.SalesRepImportJobs); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	if t.Failed() {
+		t.Logf("%#v", check)
+	}
+}
+
+func testUserToManySalesRepInventories(t *testing.T) {
+	var err error
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a User
+	var b, c Inventory
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, userDBTypes, true, userColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize User struct: %s", err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = randomize.Struct(seed, &b, inventoryDBTypes, false, inventoryColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, inventoryDBTypes, false, inventoryColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+
+	b.SalesRepID = a.ID
+	c.SalesRepID = a.ID
+
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := a.SalesRepInventories().All(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bFound, cFound := false, false
+	for _, v := range check {
+		if v.SalesRepID == b.SalesRepID {
+			bFound = true
+		}
+		if v.SalesRepID == c.SalesRepID {
+			cFound = true
+		}
+	}
+
+	if !bFound {
+		t.Error("expected to find b")
+	}
+	if !cFound {
+		t.Error("expected to find c")
+	}
+
+	slice := UserSlice{&a}
+	if err = a.L.LoadSalesRepInventories(ctx, tx, false, (*[]*User)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.SalesRepInventories); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	a.R.SalesRepInventories = nil
+	if err = a.L.LoadSalesRepInventories(ctx, tx, true, &a, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.SalesRepInventories); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	if t.Failed() {
+		t.Logf("%#v", check)
+	}
+}
+
+func testUserToManyCreatedByJournalEntries(t *testing.T) {
+	var err error
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a User
+	var b, c JournalEntry
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, userDBTypes, true, userColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize User struct: %s", err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = randomize.Struct(seed, &b, journalEntryDBTypes, false, journalEntryColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, journalEntryDBTypes, false, journalEntryColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+
+	queries.Assign(&b.CreatedByID, a.ID)
+	queries.Assign(&c.CreatedByID, a.ID)
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := a.CreatedByJournalEntries().All(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bFound, cFound := false, false
+	for _, v := range check {
+		if queries.Equal(v.CreatedByID, b.CreatedByID) {
+			bFound = true
+		}
+		if queries.Equal(v.CreatedByID, c.CreatedByID) {
+			cFound = true
+		}
+	}
+
+	if !bFound {
+		t.Error("expected to find b")
+	}
+	if !cFound {
+		t.Error("expected to find c")
+	}
+
+	slice := UserSlice{&a}
+	if err = a.L.LoadCreatedByJournalEntries(ctx, tx, false, (*[]*User)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.CreatedByJournalEntries); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	a.R.CreatedByJournalEntries = nil
+	if err = a.L.LoadCreatedByJournalEntries(ctx, tx, true, &a, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.CreatedByJournalEntries); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	if t.Failed() {
+		t.Logf("%#v", check)
+	}
+}
+
+func testUserToManyAppliedByLoans(t *testing.T) {
+	var err error
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a User
+	var b, c Loan
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, userDBTypes, true, userColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize User struct: %s", err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = randomize.Struct(seed, &b, loanDBTypes, false, loanColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, loanDBTypes, false, loanColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+
+	b.AppliedByID = a.ID
+	c.AppliedByID = a.ID
+
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := a.AppliedByLoans().All(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bFound, cFound := false, false
+	for _, v := range check {
+		if v.AppliedByID == b.AppliedByID {
+			bFound = true
+		}
+		if v.AppliedByID == c.AppliedByID {
+			cFound = true
+		}
+	}
+
+	if !bFound {
+		t.Error("expected to find b")
+	}
+	if !cFound {
+		t.Error("expected to find c")
+	}
+
+	slice := UserSlice{&a}
+	if err = a.L.LoadAppliedByLoans(ctx, tx, false, (*[]*User)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.AppliedByLoans); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	a.R.AppliedByLoans = nil
+	if err = a.L.LoadAppliedByLoans(ctx, tx, true, &a, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.AppliedByLoans); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	if t.Failed() {
+		t.Logf("%#v", check)
+	}
+}
+
+func testUserToManyApprovedByLoans(t *testing.T) {
+	var err error
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a User
+	var b, c Loan
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, userDBTypes, true, userColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize User struct: %s", err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = randomize.Struct(seed, &b, loanDBTypes, false, loanColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, loanDBTypes, false, loanColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+
+	queries.Assign(&b.ApprovedByID, a.ID)
+	queries.Assign(&c.ApprovedByID, a.ID)
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := a.ApprovedByLoans().All(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bFound, cFound := false, false
+	for _, v := range check {
+		if queries.Equal(v.ApprovedByID, b.ApprovedByID) {
+			bFound = true
+		}
+		if queries.Equal(v.ApprovedByID, c.ApprovedByID) {
+			cFound = true
+		}
+	}
+
+	if !bFound {
+		t.Error("expected to find b")
+	}
+	if !cFound {
+		t.Error("expected to find c")
+	}
+
+	slice := UserSlice{&a}
+	if err = a.L.LoadApprovedByLoans(ctx, tx, false, (*[]*User)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.ApprovedByLoans); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	a.R.ApprovedByLoans = nil
+	if err = a.L.LoadApprovedByLoans(ctx, tx, true, &a, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.ApprovedByLoans); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	if t.Failed() {
+		t.Logf("%#v", check)
+	}
+}
+
+func testUserToManyDisbursedByLoans(t *testing.T) {
+	var err error
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a User
+	var b, c Loan
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, userDBTypes, true, userColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize User struct: %s", err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = randomize.Struct(seed, &b, loanDBTypes, false, loanColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, loanDBTypes, false, loanColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+
+	queries.Assign(&b.DisbursedByID, a.ID)
+	queries.Assign(&c.DisbursedByID, a.ID)
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := a.DisbursedByLoans().All(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bFound, cFound := false, false
+	for _, v := range check {
+		if queries.Equal(v.DisbursedByID, b.DisbursedByID) {
+			bFound = true
+		}
+		if queries.Equal(v.DisbursedByID, c.DisbursedByID) {
+			cFound = true
+		}
+	}
+
+	if !bFound {
+		t.Error("expected to find b")
+	}
+	if !cFound {
+		t.Error("expected to find c")
+	}
+
+	slice := UserSlice{&a}
+	if err = a.L.LoadDisbursedByLoans(ctx, tx, false, (*[]*User)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.DisbursedByLoans); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	a.R.DisbursedByLoans = nil
+	if err = a.L.LoadDisbursedByLoans(ctx, tx, true, &a, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.DisbursedByLoans); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	if t.Failed() {
+		t.Logf("%#v", check)
+	}
+}
+
+func testUserToManySalesRepLoanRepayments(t *testing.T) {
+	var err error
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a User
+	var b, c LoanRepayment
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, userDBTypes, true, userColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize User struct: %s", err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = randomize.Struct(seed, &b, loanRepaymentDBTypes, false, loanRepaymentColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, loanRepaymentDBTypes, false, loanRepaymentColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+
+	b.SalesRepID = a.ID
+	c.SalesRepID = a.ID
+
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := a.SalesRepLoanRepayments().All(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bFound, cFound := false, false
+	for _, v := range check {
+		if v.SalesRepID == b.SalesRepID {
+			bFound = true
+		}
+		if v.SalesRepID == c.SalesRepID {
+			cFound = true
+		}
+	}
+
+	if !bFound {
+		t.Error("expected to find b")
+	}
+	if !cFound {
+		t.Error("expected to find c")
+	}
+
+	slice := UserSlice{&a}
+	if err = a.L.LoadSalesRepLoanRepayments(ctx, tx, false, (*[]*User)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.SalesRepLoanRepayments); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	a.R.SalesRepLoanRepayments = nil
+	if err = a.L.LoadSalesRepLoanRepayments(ctx, tx, true, &a, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.SalesRepLoanRepayments); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	if t.Failed() {
+		t.Logf("%#v", check)
+	}
+}
+
+func testUserToManySalesRepPayments(t *testing.T) {
+	var err error
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a User
+	var b, c Payment
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, userDBTypes, true, userColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize User struct: %s", err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = randomize.Struct(seed, &b, paymentDBTypes, false, paymentColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, paymentDBTypes, false, paymentColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+
+	b.SalesRepID = a.ID
+	c.SalesRepID = a.ID
+
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := a.SalesRepPayments().All(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bFound, cFound := false, false
+	for _, v := range check {
+		if v.SalesRepID == b.SalesRepID {
+			bFound = true
+		}
+		if v.SalesRepID == c.SalesRepID {
+			cFound = true
+		}
+	}
+
+	if !bFound {
+		t.Error("expected to find b")
+	}
+	if !cFound {
+		t.Error("expected to find c")
+	}
+
+	slice := UserSlice{&a}
+	if err = a.L.LoadSalesRepPayments(ctx, tx, false, (*[]*User)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.SalesRepPayments); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	a.R.SalesRepPayments = nil
+	if err = a.L.LoadSalesRepPayments(ctx, tx, true, &a, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.SalesRepPayments); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	if t.Failed() {
+		t.Logf("%#v", check)
+	}
+}
+
+func testUserToManyArchivedByProducts(t *testing.T) {
+	var err error
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a User
+	var b, c Product
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, userDBTypes, true, userColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize User struct: %s", err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = randomize.Struct(seed, &b, productDBTypes, false, productColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, productDBTypes, false, productColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+
+	queries.Assign(&b.ArchivedByID, a.ID)
+	queries.Assign(&c.ArchivedByID, a.ID)
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := a.ArchivedByProducts().All(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bFound, cFound := false, false
+	for _, v := range check {
+		if queries.Equal(v.ArchivedByID, b.ArchivedByID) {
+			bFound = true
+		}
+		if queries.Equal(v.ArchivedByID, c.ArchivedByID) {
+			cFound = true
+		}
+	}
+
+	if !bFound {
+		t.Error("expected to find b")
+	}
+	if !cFound {
+		t.Error("expected to find c")
+	}
+
+	slice := UserSlice{&a}
+	if err = a.L.LoadArchivedByProducts(ctx, tx, false, (*[]*User)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.ArchivedByProducts); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	a.R.ArchivedByProducts = nil
+	if err = a.L.LoadArchivedByProducts(ctx, tx, true, &a, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.ArchivedByProducts); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	if t.Failed() {
+		t.Logf("%#v", check)
+	}
+}
+
+func testUserToManyCreatedByProducts(t *testing.T) {
+	var err error
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a User
+	var b, c Product
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, userDBTypes, true, userColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize User struct: %s", err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = randomize.Struct(seed, &b, productDBTypes, false, productColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, productDBTypes, false, productColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+
+	b.CreatedByID = a.ID
+	c.CreatedByID = a.ID
+
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := a.CreatedByProducts().All(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bFound, cFound := false, false
+	for _, v := range check {
+		if v.CreatedByID == b.CreatedByID {
+			bFound = true
+		}
+		if v.CreatedByID == c.CreatedByID {
+			cFound = true
+		}
+	}
+
+	if !bFound {
+		t.Error("expected to find b")
+	}
+	if !cFound {
+		t.Error("expected to find c")
+	}
+
+	slice := UserSlice{&a}
+	if err = a.L.LoadCreatedByProducts(ctx, tx, false, (*[]*User)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.CreatedByProducts); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	a.R.CreatedByProducts = nil
+	if err = a.L.LoadCreatedByProducts(ctx, tx, true, &a, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.CreatedByProducts); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	if t.Failed() {
+		t.Logf("%#v", check)
+	}
+}
+
+func testUserToManyUpdatedByProducts(t *testing.T) {
+	var err error
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a User
+	var b, c Product
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, userDBTypes, true, userColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize User struct: %s", err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = randomize.Struct(seed, &b, productDBTypes, false, productColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, productDBTypes, false, productColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+
+	b.UpdatedByID = a.ID
+	c.UpdatedByID = a.ID
+
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := a.UpdatedByProducts().All(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bFound, cFound := false, false
+	for _, v := range check {
+		if v.UpdatedByID == b.UpdatedByID {
+			bFound = true
+		}
+		if v.UpdatedByID == c.UpdatedByID {
+			cFound = true
+		}
+	}
+
+	if !bFound {
+		t.Error("expected to find b")
+	}
+	if !cFound {
+		t.Error("expected to find c")
+	}
+
+	slice := UserSlice{&a}
+	if err = a.L.LoadUpdatedByProducts(ctx, tx, false, (*[]*User)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.UpdatedByProducts); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	a.R.UpdatedByProducts = nil
+	if err = a.L.LoadUpdatedByProducts(ctx, tx, true, &a, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.UpdatedByProducts); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	if t.Failed() {
+		t.Logf("%#v", check)
+	}
+}
+
+func testUserToManySalesRepRepsExpenses(t *testing.T) {
+	var err error
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a User
+	var b, c RepsExpense
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, userDBTypes, true, userColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize User struct: %s", err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = randomize.Struct(seed, &b, repsExpenseDBTypes, false, repsExpenseColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, repsExpenseDBTypes, false, repsExpenseColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+
+	b.SalesRepID = a.ID
+	c.SalesRepID = a.ID
+
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := a.SalesRepRepsExpenses().All(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bFound, cFound := false, false
+	for _, v := range check {
+		if v.SalesRepID == b.SalesRepID {
+			bFound = true
+		}
+		if v.SalesRepID == c.SalesRepID {
+			cFound = true
+		}
+	}
+
+	if !bFound {
+		t.Error("expected to find b")
+	}
+	if !cFound {
+		t.Error("expected to find c")
+	}
+
+	slice := UserSlice{&a}
+	if err = a.L.LoadSalesRepRepsExpenses(ctx, tx, false, (*[]*User)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.SalesRepRepsExpenses); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	a.R.SalesRepRepsExpenses = nil
+	if err = a.L.LoadSalesRepRepsExpenses(ctx, tx, true, &a, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.SalesRepRepsExpenses); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	if t.Failed() {
+		t.Logf("%#v", check)
+	}
+}
+
+func testUserToManyArchivedBySales(t *testing.T) {
+	var err error
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a User
+	var b, c Sale
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, userDBTypes, true, userColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize User struct: %s", err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = randomize.Struct(seed, &b, saleDBTypes, false, saleColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, saleDBTypes, false, saleColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+
+	queries.Assign(&b.ArchivedByID, a.ID)
+	queries.Assign(&c.ArchivedByID, a.ID)
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := a.ArchivedBySales().All(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bFound, cFound := false, false
+	for _, v := range check {
+		if queries.Equal(v.ArchivedByID, b.ArchivedByID) {
+			bFound = true
+		}
+		if queries.Equal(v.ArchivedByID, c.ArchivedByID) {
+			cFound = true
+		}
+	}
+
+	if !bFound {
+		t.Error("expected to find b")
+	}
+	if !cFound {
+		t.Error("expected to find c")
+	}
+
+	slice := UserSlice{&a}
+	if err = a.L.LoadArchivedBySales(ctx, tx, false, (*[]*User)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.ArchivedBySales); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	a.R.ArchivedBySales = nil
+	if err = a.L.LoadArchivedBySales(ctx, tx, true, &a, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.ArchivedBySales); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	if t.Failed() {
+		t.Logf("%#v", check)
+	}
+}
+
+func testUserToManyCreatedBySales(t *testing.T) {
+	var err error
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a User
+	var b, c Sale
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, userDBTypes, true, userColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize User struct: %s", err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = randomize.Struct(seed, &b, saleDBTypes, false, saleColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, saleDBTypes, false, saleColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+
+	b.CreatedByID = a.ID
+	c.CreatedByID = a.ID
+
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := a.CreatedBySales().All(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bFound, cFound := false, false
+	for _, v := range check {
+		if v.CreatedByID == b.CreatedByID {
+			bFound = true
+		}
+		if v.CreatedByID == c.CreatedByID {
+			cFound = true
+		}
+	}
+
+	if !bFound {
+		t.Error("expected to find b")
+	}
+	if !cFound {
+		t.Error("expected to find c")
+	}
+
+	slice := UserSlice{&a}
+	if err = a.L.LoadCreatedBySales(ctx, tx, false, (*[]*User)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.CreatedBySales); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	a.R.CreatedBySales = nil
+	if err = a.L.LoadCreatedBySales(ctx, tx, true, &a, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.CreatedBySales); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	if t.Failed() {
+		t.Logf("%#v", check)
+	}
+}
+
+func testUserToManyUpdatedBySales(t *testing.T) {
+	var err error
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a User
+	var b, c Sale
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, userDBTypes, true, userColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize User struct: %s", err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = randomize.Struct(seed, &b, saleDBTypes, false, saleColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, saleDBTypes, false, saleColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+
+	queries.Assign(&b.UpdatedByID, a.ID)
+	queries.Assign(&c.UpdatedByID, a.ID)
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := a.UpdatedBySales().All(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bFound, cFound := false, false
+	for _, v := range check {
+		if queries.Equal(v.UpdatedByID, b.UpdatedByID) {
+			bFound = true
+		}
+		if queries.Equal(v.UpdatedByID, c.UpdatedByID) {
+			cFound = true
+		}
+	}
+
+	if !bFound {
+		t.Error("expected to find b")
+	}
+	if !cFound {
+		t.Error("expected to find c")
+	}
+
+	slice := UserSlice{&a}
+	if err = a.L.LoadUpdatedBySales(ctx, tx, false, (*[]*User)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.UpdatedBySales); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	a.R.UpdatedBySales = nil
+	if err = a.L.LoadUpdatedBySales(ctx, tx, true, &a, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.UpdatedBySales); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	if t.Failed() {
+		t.Logf("%#v", check)
+	}
+}
+
+func testUserToManySalesRepTillSessions(t *testing.T) {
+	var err error
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a User
+	var b, c TillSession
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, userDBTypes, true, userColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize User struct: %s", err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = randomize.Struct(seed, &b, tillSessionDBTypes, false, tillSessionColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, tillSessionDBTypes, false, tillSessionColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+
+	b.SalesRepID = a.ID
+	c.SalesRepID = a.ID
+
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := a.SalesRepTillSessions().All(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bFound, cFound := false, false
+	for _, v := range check {
+		if v.SalesRepID == b.SalesRepID {
+			bFound = true
+		}
+		if v.SalesRepID == c.SalesRepID {
+			cFound = true
+		}
+	}
+
+	if !bFound {
+		t.Error("expected to find b")
+	}
+	if !cFound {
+		t.Error("expected to find c")
+	}
+
+	slice := UserSlice{&a}
+	if err = a.L.LoadSalesRepTillSessions(ctx, tx, false, (*[]*User)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.SalesRepTillSessions); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	a.R.SalesRepTillSessions = nil
+	if err = a.L.LoadSalesRepTillSessions(ctx, tx, true, &a, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.SalesRepTillSessions); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	if t.Failed() {
+		t.Logf("%#v", check)
+	}
+}
+
+func testUserToManySignedOffByTillSessions(t *testing.T) {
+	var err error
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a User
+	var b, c TillSession
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, userDBTypes, true, userColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize User struct: %s", err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = randomize.Struct(seed, &b, tillSessionDBTypes, false, tillSessionColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, tillSessionDBTypes, false, tillSessionColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+
+	queries.Assign(&b.SignedOffByID, a.ID)
+	queries.Assign(&c.SignedOffByID, a.ID)
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := a.SignedOffByTillSessions().All(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bFound, cFound := false, false
+	for _, v := range check {
+		if queries.Equal(v.SignedOffByID, b.SignedOffByID) {
+			bFound = true
+		}
+		if queries.Equal(v.SignedOffByID, c.SignedOffByID) {
+			cFound = true
+		}
+	}
+
+	if !bFound {
+		t.Error("expected to find b")
+	}
+	if !cFound {
+		t.Error("expected to find c")
+	}
+
+	slice := UserSlice{&a}
+	if err = a.L.LoadSignedOffByTillSessions(ctx, tx, false, (*[]*User)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.SignedOffByTillSessions); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	a.R.SignedOffByTillSessions = nil
+	if err = a.L.LoadSignedOffByTillSessions(ctx, tx, true, &a, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.SignedOffByTillSessions); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	if t.Failed() {
+		t.Logf("%#v", check)
+	}
+}
+
+func testUserToManySubmittedByTillSessions(t *testing.T) {
+	var err error
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a User
+	var b, c TillSession
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, userDBTypes, true, userColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize User struct: %s", err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = randomize.Struct(seed, &b, tillSessionDBTypes, false, tillSessionColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, tillSessionDBTypes, false, tillSessionColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+
+	b.SubmittedByID = a.ID
+	c.SubmittedByID = a.ID
+
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := a.SubmittedByTillSessions().All(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bFound, cFound := false, false
+	for _, v := range check {
+		if v.SubmittedByID == b.SubmittedByID {
+			bFound = true
+		}
+		if v.SubmittedByID == c.SubmittedByID {
+			cFound = true
+		}
+	}
+
+	if !bFound {
+		t.Error("expected to find b")
+	}
+	if !cFound {
+		t.Error("expected to find c")
+	}
+
+	slice := UserSlice{&a}
+	if err = a.L.LoadSubmittedByTillSessions(ctx, tx, false, (*[]*User)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.SubmittedByTillSessions); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	a.R.SubmittedByTillSessions = nil
+	if err = a.L.LoadSubmittedByTillSessions(ctx, tx, true, &a, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.SubmittedByTillSessions); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	if t.Failed() {
+		t.Logf("%#v", check)
+	}
+}
+
+func testUserToManyApprovedByTransactions(t *testing.T) {
+	var err error
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a User
+	var b, c Transaction
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, userDBTypes, true, userColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize User struct: %s", err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = randomize.Struct(seed, &b, transactionDBTypes, false, transactionColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, transactionDBTypes, false, transactionColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+
+	queries.Assign(&b.ApprovedByID, a.ID)
+	queries.Assign(&c.ApprovedByID, a.ID)
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := a.ApprovedByTransactions().All(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bFound, cFound := false, false
+	for _, v := range check {
+		if queries.Equal(v.ApprovedByID, b.ApprovedByID) {
+			bFound = true
+		}
+		if queries.Equal(v.ApprovedByID, c.ApprovedByID) {
+			cFound = true
+		}
+	}
+
+	if !bFound {
+		t.Error("expected to find b")
+	}
+	if !cFound {
+		t.Error("expected to find c")
+	}
+
+	slice := UserSlice{&a}
+	if err = a.L.LoadApprovedByTransactions(ctx, tx, false, (*[]*User)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.ApprovedByTransactions); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	a.R.ApprovedByTransactions = nil
+	if err = a.L.LoadApprovedByTransactions(ctx, tx, true, &a, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.ApprovedByTransactions); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	if t.Failed() {
+		t.Logf("%#v", check)
+	}
+}
+
+func testUserToManySalesRepTransactions(t *testing.T) {
+	var err error
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a User
+	var b, c Transaction
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, userDBTypes, true, userColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize User struct: %s", err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = randomize.Struct(seed, &b, transactionDBTypes, false, transactionColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, transactionDBTypes, false, transactionColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+
+	b.SalesRepID = a.ID
+	c.SalesRepID = a.ID
+
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := a.SalesRepTransactions().All(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bFound, cFound := false, false
+	for _, v := range check {
+		if v.SalesRepID == b.SalesRepID {
+			bFound = true
+		}
+		if v.SalesRepID == c.SalesRepID {
+			cFound = true
+		}
+	}
+
+	if !bFound {
+		t.Error("expected to find b")
+	}
+	if !cFound {
+		t.Error("expected to find c")
+	}
+
+	slice := UserSlice{&a}
+	if err = a.L.LoadSalesRepTransactions(ctx, tx, false, (*[]*User)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.SalesRepTransactions); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	a.R.SalesRepTransactions = nil
+	if err = a.L.LoadSalesRepTransactions(ctx, tx, true, &a, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.SalesRepTransactions); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	if t.Failed() {
+		t.Logf("%#v", check)
+	}
+}
+
+func testUserToManySalesRepTransfers(t *testing.T) {
+	var err error
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a User
+	var b, c Transfer
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, userDBTypes, true, userColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize User struct: %s", err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = randomize.Struct(seed, &b, transferDBTypes, false, transferColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, transferDBTypes, false, transferColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+
+	b.SalesRepID = a.ID
+	c.SalesRepID = a.ID
+
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := a.SalesRepTransfers().All(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bFound, cFound := false, false
+	for _, v := range check {
+		if v.SalesRepID == b.SalesRepID {
+			bFound = true
+		}
+		if v.SalesRepID == c.SalesRepID {
+			cFound = true
+		}
+	}
+
+	if !bFound {
+		t.Error("expected to find b")
+	}
+	if !cFound {
+		t.Error("expected to find c")
+	}
+
+	slice := UserSlice{&a}
+	if err = a.L.LoadSalesRepTransfers(ctx, tx, false, (*[]*User)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.SalesRepTransfers); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	a.R.SalesRepTransfers = nil
+	if err = a.L.LoadSalesRepTransfers(ctx, tx, true, &a, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.SalesRepTransfers); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	if t.Failed() {
+		t.Logf("%#v", check)
+	}
+}
+
+func testUserToManyDecidedByWithdrawalRequests(t *testing.T) {
+	var err error
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a User
+	var b, c WithdrawalRequest
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, userDBTypes, true, userColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize User struct: %s", err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = randomize.Struct(seed, &b, withdrawalRequestDBTypes, false, withdrawalRequestColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, withdrawalRequestDBTypes, false, withdrawalRequestColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+
+	queries.Assign(&b.DecidedByID, a.ID)
+	queries.Assign(&c.DecidedByID, a.ID)
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := a.DecidedByWithdrawalRequests().All(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bFound, cFound := false, false
+	for _, v := range check {
+		if queries.Equal(v.DecidedByID, b.DecidedByID) {
+			bFound = true
+		}
+		if queries.Equal(v.DecidedByID, c.DecidedByID) {
+			cFound = true
+		}
+	}
+
+	if !bFound {
+		t.Error("expected to find b")
+	}
+	if !cFound {
+		t.Error("expected to find c")
+	}
+
+	slice := UserSlice{&a}
+	if err = a.L.LoadDecidedByWithdrawalRequests(ctx, tx, false, (*[]*User)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.DecidedByWithdrawalRequests); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	a.R.DecidedByWithdrawalRequests = nil
+	if err = a.L.LoadDecidedByWithdrawalRequests(ctx, tx, true, &a, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.DecidedByWithdrawalRequests); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	if t.Failed() {
+		t.Logf("%#v", check)
+	}
+}
+
+func testUserToManyAddOpSalesRepAccounts(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a User
+	var b, c, d, e Account
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*Account{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, accountDBTypes, false, strmangle.SetComplement(accountPrimaryKeyColumns, accountColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	foreignersSplitByInsertion := [][]*Account{
+		{&b, &c},
+		{&d, &e},
+	}
+
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddSalesRepAccounts(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		first := x[0]
+		second := x[1]
+
+		if a.ID != first.SalesRepID {
+			t.Error("foreign key was wrong value", a.ID, first.SalesRepID)
+		}
+		if a.ID != second.SalesRepID {
+			t.Error("foreign key was wrong value", a.ID, second.SalesRepID)
+		}
+
+		if first.R.SalesRep != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.SalesRep != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+
+		if a.R.SalesRepAccounts[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.SalesRepAccounts[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
+
+		count, err := a.SalesRepAccounts().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
+	}
+}
+func testUserToManyAddOpSalesRepAccountFollowUps(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a User
+	var b, c, d, e AccountFollowUp
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*AccountFollowUp{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, accountFollowUpDBTypes, false, strmangle.SetComplement(accountFollowUpPrimaryKeyColumns, accountFollowUpColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	foreignersSplitByInsertion := [][]*AccountFollowUp{
+		{&b, &c},
+		{&d, &e},
+	}
+
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddSalesRepAccountFollowUps(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		first := x[0]
+		second := x[1]
+
+		if a.ID != first.SalesRepID {
+			t.Error("foreign key was wrong value", a.ID, first.SalesRepID)
+		}
+		if a.ID != second.SalesRepID {
+			t.Error("foreign key was wrong value", a.ID, second.SalesRepID)
+		}
+
+		if first.R.SalesRep != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.SalesRep != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+
+		if a.R.SalesRepAccountFollowUps[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.SalesRepAccountFollowUps[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
+
+		count, err := a.SalesRepAccountFollowUps().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
+	}
+}
+func testUserToManyAddOpCreatedByAccountHolds(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a User
+	var b, c, d, e AccountHold
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*AccountHold{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, accountHoldDBTypes, false, strmangle.SetComplement(accountHoldPrimaryKeyColumns, accountHoldColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	foreignersSplitByInsertion := [][]*AccountHold{
+		{&b, &c},
+		{&d, &e},
+	}
+
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddCreatedByAccountHolds(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		first := x[0]
+		second := x[1]
+
+		if a.ID != first.CreatedByID {
+			t.Error("foreign key was wrong value", a.ID, first.CreatedByID)
+		}
+		if a.ID != second.CreatedByID {
+			t.Error("foreign key was wrong value", a.ID, second.CreatedByID)
+		}
+
+		if first.R.CreatedBy != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.CreatedBy != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+
+		if a.R.CreatedByAccountHolds[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.CreatedByAccountHolds[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
+
+		count, err := a.CreatedByAccountHolds().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
+	}
+}
+func testUserToManyAddOpReleasedByAccountHolds(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a User
+	var b, c, d, e AccountHold
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*AccountHold{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, accountHoldDBTypes, false, strmangle.SetComplement(accountHoldPrimaryKeyColumns, accountHoldColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	foreignersSplitByInsertion := [][]*AccountHold{
+		{&b, &c},
+		{&d, &e},
+	}
+
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddReleasedByAccountHolds(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		first := x[0]
+		second := x[1]
+
+		if !queries.Equal(a.ID, first.ReleasedByID) {
+			t.Error("foreign key was wrong value", a.ID, first.ReleasedByID)
+		}
+		if !queries.Equal(a.ID, second.ReleasedByID) {
+			t.Error("foreign key was wrong value", a.ID, second.ReleasedByID)
+		}
+
+		if first.R.ReleasedBy != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.ReleasedBy != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+
+		if a.R.ReleasedByAccountHolds[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.ReleasedByAccountHolds[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
+
+		count, err := a.ReleasedByAccountHolds().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
+	}
+}
+
+func testUserToManySetOpReleasedByAccountHolds(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a User
+	var b, c, d, e AccountHold
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*AccountHold{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, accountHoldDBTypes, false, strmangle.SetComplement(accountHoldPrimaryKeyColumns, accountHoldColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err = a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	err = a.SetReleasedByAccountHolds(ctx, tx, false, &b, &c)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err := a.ReleasedByAccountHolds().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	err = a.SetReleasedByAccountHolds(ctx, tx, true, &d, &e)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err = a.ReleasedByAccountHolds().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	if !queries.IsValuerNil(b.ReleasedByID) {
+		t.Error("want b's foreign key value to be nil")
+	}
+	if !queries.IsValuerNil(c.ReleasedByID) {
+		t.Error("want c's foreign key value to be nil")
+	}
+	if !queries.Equal(a.ID, d.ReleasedByID) {
+		t.Error("foreign key was wrong value", a.ID, d.ReleasedByID)
+	}
+	if !queries.Equal(a.ID, e.ReleasedByID) {
+		t.Error("foreign key was wrong value", a.ID, e.ReleasedByID)
+	}
+
+	if b.R.ReleasedBy != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if c.R.ReleasedBy != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if d.R.ReleasedBy != &a {
+		t.Error("relationship was not added properly to the foreign struct")
+	}
+	if e.R.ReleasedBy != &a {
+		t.Error("relationship was not added properly to the foreign struct")
+	}
+
+	if a.R.ReleasedByAccountHolds[0] != &d {
+		t.Error("relationship struct slice not set to correct value")
+	}
+	if a.R.ReleasedByAccountHolds[1] != &e {
+		t.Error("relationship struct slice not set to correct value")
+	}
+}
+
+func testUserToManyRemoveOpReleasedByAccountHolds(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a User
+	var b, c, d, e AccountHold
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*AccountHold{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, accountHoldDBTypes, false, strmangle.SetComplement(accountHoldPrimaryKeyColumns, accountHoldColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	err = a.AddReleasedByAccountHolds(ctx, tx, true, foreigners...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err := a.ReleasedByAccountHolds().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 4 {
+		t.Error("count was wrong:", count)
+	}
+
+	err = a.RemoveReleasedByAccountHolds(ctx, tx, foreigners[:2]...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err = a.ReleasedByAccountHolds().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	if !queries.IsValuerNil(b.ReleasedByID) {
+		t.Error("want b's foreign key value to be nil")
+	}
+	if !queries.IsValuerNil(c.ReleasedByID) {
+		t.Error("want c's foreign key value to be nil")
+	}
+
+	if b.R.ReleasedBy != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if c.R.ReleasedBy != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if d.R.ReleasedBy != &a {
+		t.Error("relationship to a should have been preserved")
+	}
+	if e.R.ReleasedBy != &a {
+		t.Error("relationship to a should have been preserved")
+	}
+
+	if len(a.R.ReleasedByAccountHolds) != 2 {
+		t.Error("should have preserved two relationships")
+	}
+
+	// Removal doesn't do a stable deletion for performance so we have to flip the order
+	if a.R.ReleasedByAccountHolds[1] != &d {
+		t.Error("relationship to d should have been preserved")
+	}
+	if a.R.ReleasedByAccountHolds[0] != &e {
+		t.Error("relationship to e should have been preserved")
+	}
+}
+
+func testUserToManyAddOpChangedByAccountStatusChanges(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a User
+	var b, c, d, e AccountStatusChange
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*AccountStatusChange{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, accountStatusChangeDBTypes, false, strmangle.SetComplement(accountStatusChangePrimaryKeyColumns, accountStatusChangeColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	foreignersSplitByInsertion := [][]*AccountStatusChange{
+		{&b, &c},
+		{&d, &e},
+	}
+
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddChangedByAccountStatusChanges(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		first := x[0]
+		second := x[1]
+
+		if !queries.Equal(a.ID, first.ChangedByID) {
+			t.Error("foreign key was wrong value", a.ID, first.ChangedByID)
+		}
+		if !queries.Equal(a.ID, second.ChangedByID) {
+			t.Error("foreign key was wrong value", a.ID, second.ChangedByID)
+		}
+
+		if first.R.ChangedBy != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.ChangedBy != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+
+		if a.R.ChangedByAccountStatusChanges[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.ChangedByAccountStatusChanges[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
+
+		count, err := a.ChangedByAccountStatusChanges().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
+	}
+}
+
+func testUserToManySetOpChangedByAccountStatusChanges(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a User
+	var b, c, d, e AccountStatusChange
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*AccountStatusChange{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, accountStatusChangeDBTypes, false, strmangle.SetComplement(accountStatusChangePrimaryKeyColumns, accountStatusChangeColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err = a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	err = a.SetChangedByAccountStatusChanges(ctx, tx, false, &b, &c)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err := a.ChangedByAccountStatusChanges().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	err = a.SetChangedByAccountStatusChanges(ctx, tx, true, &d, &e)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err = a.ChangedByAccountStatusChanges().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	if !queries.IsValuerNil(b.ChangedByID) {
+		t.Error("want b's foreign key value to be nil")
+	}
+	if !queries.IsValuerNil(c.ChangedByID) {
+		t.Error("want c's foreign key value to be nil")
+	}
+	if !queries.Equal(a.ID, d.ChangedByID) {
+		t.Error("foreign key was wrong value", a.ID, d.ChangedByID)
+	}
+	if !queries.Equal(a.ID, e.ChangedByID) {
+		t.Error("foreign key was wrong value", a.ID, e.ChangedByID)
+	}
+
+	if b.R.ChangedBy != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if c.R.ChangedBy != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if d.R.ChangedBy != &a {
+		t.Error("relationship was not added properly to the foreign struct")
+	}
+	if e.R.ChangedBy != &a {
+		t.Error("relationship was not added properly to the foreign struct")
+	}
+
+	if a.R.ChangedByAccountStatusChanges[0] != &d {
+		t.Error("relationship struct slice not set to correct value")
+	}
+	if a.R.ChangedByAccountStatusChanges[1] != &e {
+		t.Error("relationship struct slice not set to correct value")
+	}
+}
+
+func testUserToManyRemoveOpChangedByAccountStatusChanges(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a User
+	var b, c, d, e AccountStatusChange
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*AccountStatusChange{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, accountStatusChangeDBTypes, false, strmangle.SetComplement(accountStatusChangePrimaryKeyColumns, accountStatusChangeColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	err = a.AddChangedByAccountStatusChanges(ctx, tx, true, foreigners...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err := a.ChangedByAccountStatusChanges().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 4 {
+		t.Error("count was wrong:", count)
+	}
+
+	err = a.RemoveChangedByAccountStatusChanges(ctx, tx, foreigners[:2]...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err = a.ChangedByAccountStatusChanges().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	if !queries.IsValuerNil(b.ChangedByID) {
+		t.Error("want b's foreign key value to be nil")
+	}
+	if !queries.IsValuerNil(c.ChangedByID) {
+		t.Error("want c's foreign key value to be nil")
+	}
+
+	if b.R.ChangedBy != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if c.R.ChangedBy != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if d.R.ChangedBy != &a {
+		t.Error("relationship to a should have been preserved")
+	}
+	if e.R.ChangedBy != &a {
+		t.Error("relationship to a should have been preserved")
+	}
+
+	if len(a.R.ChangedByAccountStatusChanges) != 2 {
+		t.Error("should have preserved two relationships")
+	}
+
+	// Removal doesn't do a stable deletion for performance so we have to flip the order
+	if a.R.ChangedByAccountStatusChanges[1] != &d {
+		t.Error("relationship to d should have been preserved")
+	}
+	if a.R.ChangedByAccountStatusChanges[0] != &e {
+		t.Error("relationship to e should have been preserved")
+	}
+}
+
+func testUserToManyAddOpDecidedByApprovals(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a User
+	var b, c, d, e Approval
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*Approval{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, approvalDBTypes, false, strmangle.SetComplement(approvalPrimaryKeyColumns, approvalColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	foreignersSplitByInsertion := [][]*Approval{
+		{&b, &c},
+		{&d, &e},
+	}
+
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddDecidedByApprovals(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		first := x[0]
+		second := x[1]
+
+		if !queries.Equal(a.ID, first.DecidedByID) {
+			t.Error("foreign key was wrong value", a.ID, first.DecidedByID)
+		}
+		if !queries.Equal(a.ID, second.DecidedByID) {
+			t.Error("foreign key was wrong value", a.ID, second.DecidedByID)
+		}
+
+		if first.R.DecidedBy != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.DecidedBy != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+
+		if a.R.DecidedByApprovals[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.DecidedByApprovals[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
+
+		count, err := a.DecidedByApprovals().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
+	}
+}
+
+func testUserToManySetOpDecidedByApprovals(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a User
+	var b, c, d, e Approval
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*Approval{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, approvalDBTypes, false, strmangle.SetComplement(approvalPrimaryKeyColumns, approvalColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err = a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	err = a.SetDecidedByApprovals(ctx, tx, false, &b, &c)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err := a.DecidedByApprovals().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	err = a.SetDecidedByApprovals(ctx, tx, true, &d, &e)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err = a.DecidedByApprovals().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	if !queries.IsValuerNil(b.DecidedByID) {
+		t.Error("want b's foreign key value to be nil")
+	}
+	if !queries.IsValuerNil(c.DecidedByID) {
+		t.Error("want c's foreign key value to be nil")
+	}
+	if !queries.Equal(a.ID, d.DecidedByID) {
+		t.Error("foreign key was wrong value", a.ID, d.DecidedByID)
+	}
+	if !queries.Equal(a.ID, e.DecidedByID) {
+		t.Error("foreign key was wrong value", a.ID, e.DecidedByID)
+	}
+
+	if b.R.DecidedBy != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if c.R.DecidedBy != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if d.R.DecidedBy != &a {
+		t.Error("relationship was not added properly to the foreign struct")
+	}
+	if e.R.DecidedBy != &a {
+		t.Error("relationship was not added properly to the foreign struct")
+	}
+
+	if a.R.DecidedByApprovals[0] != &d {
+		t.Error("relationship struct slice not set to correct value")
+	}
+	if a.R.DecidedByApprovals[1] != &e {
+		t.Error("relationship struct slice not set to correct value")
+	}
+}
+
+func testUserToManyRemoveOpDecidedByApprovals(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a User
+	var b, c, d, e Approval
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*Approval{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, approvalDBTypes, false, strmangle.SetComplement(approvalPrimaryKeyColumns, approvalColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	err = a.AddDecidedByApprovals(ctx, tx, true, foreigners...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err := a.DecidedByApprovals().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 4 {
+		t.Error("count was wrong:", count)
+	}
+
+	err = a.RemoveDecidedByApprovals(ctx, tx, foreigners[:2]...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err = a.DecidedByApprovals().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	if !queries.IsValuerNil(b.DecidedByID) {
+		t.Error("want b's foreign key value to be nil")
+	}
+	if !queries.IsValuerNil(c.DecidedByID) {
+		t.Error("want c's foreign key value to be nil")
+	}
+
+	if b.R.DecidedBy != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if c.R.DecidedBy != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if d.R.DecidedBy != &a {
+		t.Error("relationship to a should have been preserved")
+	}
+	if e.R.DecidedBy != &a {
+		t.Error("relationship to a should have been preserved")
+	}
+
+	if len(a.R.DecidedByApprovals) != 2 {
+		t.Error("should have preserved two relationships")
+	}
+
+	// Removal doesn't do a stable deletion for performance so we have to flip the order
+	if a.R.DecidedByApprovals[1] != &d {
+		t.Error("relationship to d should have been preserved")
+	}
+	if a.R.DecidedByApprovals[0] != &e {
+		t.Error("relationship to e should have been preserved")
+	}
+}
+
+func testUserToManyAddOpRequestedByApprovals(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a User
+	var b, c, d, e Approval
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*Approval{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, approvalDBTypes, false, strmangle.SetComplement(approvalPrimaryKeyColumns, approvalColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	foreignersSplitByInsertion := [][]*Approval{
+		{&b, &c},
+		{&d, &e},
+	}
+
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddRequestedByApprovals(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		first := x[0]
+		second := x[1]
+
+		if a.ID != first.RequestedByID {
+			t.Error("foreign key was wrong value", a.ID, first.RequestedByID)
+		}
+		if a.ID != second.RequestedByID {
+			t.Error("foreign key was wrong value", a.ID, second.RequestedByID)
+		}
+
+		if first.R.RequestedBy != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.RequestedBy != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+
+		if a.R.RequestedByApprovals[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.RequestedByApprovals[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
+
+		count, err := a.RequestedByApprovals().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
+	}
+}
+func testUserToManyAddOpKycVerifiedByCustomers(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a User
+	var b, c, d, e Customer
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*Customer{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, customerDBTypes, false, strmangle.SetComplement(customerPrimaryKeyColumns, customerColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	foreignersSplitByInsertion := [][]*Customer{
+		{&b, &c},
+		{&d, &e},
+	}
+
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddKycVerifiedByCustomers(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		first := x[0]
+		second := x[1]
+
+		if !queries.Equal(a.ID, first.KycVerifiedByID) {
+			t.Error("foreign key was wrong value", a.ID, first.KycVerifiedByID)
+		}
+		if !queries.Equal(a.ID, second.KycVerifiedByID) {
+			t.Error("foreign key was wrong value", a.ID, second.KycVerifiedByID)
+		}
+
+		if first.R.KycVerifiedBy != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.KycVerifiedBy != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+
+		if a.R.KycVerifiedByCustomers[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.KycVerifiedByCustomers[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
+
+		count, err := a.KycVerifiedByCustomers().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
+	}
+}
+
+func testUserToManySetOpKycVerifiedByCustomers(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a User
+	var b, c, d, e Customer
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*Customer{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, customerDBTypes, false, strmangle.SetComplement(customerPrimaryKeyColumns, customerColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err = a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	err = a.SetKycVerifiedByCustomers(ctx, tx, false, &b, &c)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err := a.KycVerifiedByCustomers().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	err = a.SetKycVerifiedByCustomers(ctx, tx, true, &d, &e)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err = a.KycVerifiedByCustomers().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	if !queries.IsValuerNil(b.KycVerifiedByID) {
+		t.Error("want b's foreign key value to be nil")
+	}
+	if !queries.IsValuerNil(c.KycVerifiedByID) {
+		t.Error("want c's foreign key value to be nil")
+	}
+	if !queries.Equal(a.ID, d.KycVerifiedByID) {
+		t.Error("foreign key was wrong value", a.ID, d.KycVerifiedByID)
+	}
+	if !queries.Equal(a.ID, e.KycVerifiedByID) {
+		t.Error("foreign key was wrong value", a.ID, e.KycVerifiedByID)
+	}
+
+	if b.R.KycVerifiedBy != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if c.R.KycVerifiedBy != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if d.R.KycVerifiedBy != &a {
+		t.Error("relationship was not added properly to the foreign struct")
+	}
+	if e.R.KycVerifiedBy != &a {
+		t.Error("relationship was not added properly to the foreign struct")
+	}
+
+	if a.R.KycVerifiedByCustomers[0] != &d {
+		t.Error("relationship struct slice not set to correct value")
+	}
+	if a.R.KycVerifiedByCustomers[1] != &e {
+		t.Error("relationship struct slice not set to correct value")
+	}
+}
+
+func testUserToManyRemoveOpKycVerifiedByCustomers(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a User
+	var b, c, d, e Customer
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*Customer{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, customerDBTypes, false, strmangle.SetComplement(customerPrimaryKeyColumns, customerColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	err = a.AddKycVerifiedByCustomers(ctx, tx, true, foreigners...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err := a.KycVerifiedByCustomers().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 4 {
+		t.Error("count was wrong:", count)
+	}
+
+	err = a.RemoveKycVerifiedByCustomers(ctx, tx, foreigners[:2]...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err = a.KycVerifiedByCustomers().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	if !queries.IsValuerNil(b.KycVerifiedByID) {
+		t.Error("want b's foreign key value to be nil")
+	}
+	if !queries.IsValuerNil(c.KycVerifiedByID) {
+		t.Error("want c's foreign key value to be nil")
+	}
+
+	if b.R.KycVerifiedBy != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if c.R.KycVerifiedBy != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if d.R.KycVerifiedBy != &a {
+		t.Error("relationship to a should have been preserved")
+	}
+	if e.R.KycVerifiedBy != &a {
+		t.Error("relationship to a should have been preserved")
+	}
+
+	if len(a.R.KycVerifiedByCustomers) != 2 {
+		t.Error("should have preserved two relationships")
+	}
+
+	// Removal doesn't do a stable deletion for performance so we have to flip the order
+	if a.R.KycVerifiedByCustomers[1] != &d {
+		t.Error("relationship to d should have been preserved")
+	}
+	if a.R.KycVerifiedByCustomers[0] != &e {
+		t.Error("relationship to e should have been preserved")
+	}
+}
+
 func testUserToManyAddOpSalesRepCustomers(t *testing.T) {
 	var err error
 
@@ -1369,15 +4381,2421 @@ func testUserToManyAddOpSalesRepCustomers(t *testing.T) {
 	defer func() { _ = tx.Rollback() }()
 
 	var a User
-	var b, c, d, e Customer
+	var b, c, d, e Customer
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*Customer{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, customerDBTypes, false, strmangle.SetComplement(customerPrimaryKeyColumns, customerColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	foreignersSplitByInsertion := [][]*Customer{
+		{&b, &c},
+		{&d, &e},
+	}
+
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddSalesRepCustomers(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		first := x[0]
+		second := x[1]
+
+		if a.ID != first.SalesRepID {
+			t.Error("foreign key was wrong value", a.ID, first.SalesRepID)
+		}
+		if a.ID != second.SalesRepID {
+			t.Error("foreign key was wrong value", a.ID, second.SalesRepID)
+		}
+
+		if first.R.SalesRep != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.SalesRep != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+
+		if a.R.SalesRepCustomers[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.SalesRepCustomers[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
+
+		count, err := a.SalesRepCustomers().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
+	}
+}
+func testUserToManyAddOpUploadedByCustomerDocuments(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a User
+	var b, c, d, e CustomerDocument
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*CustomerDocument{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, customerDocumentDBTypes, false, strmangle.SetComplement(customerDocumentPrimaryKeyColumns, customerDocumentColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	foreignersSplitByInsertion := [][]*CustomerDocument{
+		{&b, &c},
+		{&d, &e},
+	}
+
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddUploadedByCustomerDocuments(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		first := x[0]
+		second := x[1]
+
+		if a.ID != first.UploadedByID {
+			t.Error("foreign key was wrong value", a.ID, first.UploadedByID)
+		}
+		if a.ID != second.UploadedByID {
+			t.Error("foreign key was wrong value", a.ID, second.UploadedByID)
+		}
+
+		if first.R.UploadedBy != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.UploadedBy != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+
+		if a.R.UploadedByCustomerDocuments[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.UploadedByCustomerDocuments[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
+
+		count, err := a.UploadedByCustomerDocuments().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
+	}
+}
+func testUserToManyAddOpMergedByCustomerMerges(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a User
+	var b, c, d, e CustomerMerge
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*CustomerMerge{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, customerMergeDBTypes, false, strmangle.SetComplement(customerMergePrimaryKeyColumns, customerMergeColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	foreignersSplitByInsertion := [][]*CustomerMerge{
+		{&b, &c},
+		{&d, &e},
+	}
+
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddMergedByCustomerMerges(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		first := x[0]
+		second := x[1]
+
+		if a.ID != first.MergedByID {
+			t.Error("foreign key was wrong value", a.ID, first.MergedByID)
+		}
+		if a.ID != second.MergedByID {
+			t.Error("foreign key was wrong value", a.ID, second.MergedByID)
+		}
+
+		if first.R.MergedBy != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.MergedBy != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+
+		if a.R.MergedByCustomerMerges[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.MergedByCustomerMerges[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
+
+		count, err := a.MergedByCustomerMerges().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
+	}
+}
+func testUserToManyAddOpSettledByDSCycles(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a User
+	var b, c, d, e DSCycle
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*DSCycle{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, dsCycleDBTypes, false, strmangle.SetComplement(dsCyclePrimaryKeyColumns, dsCycleColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	foreignersSplitByInsertion := [][]*DSCycle{
+		{&b, &c},
+		{&d, &e},
+	}
+
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddSettledByDSCycles(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		first := x[0]
+		second := x[1]
+
+		if !queries.Equal(a.ID, first.SettledByID) {
+			t.Error("foreign key was wrong value", a.ID, first.SettledByID)
+		}
+		if !queries.Equal(a.ID, second.SettledByID) {
+			t.Error("foreign key was wrong value", a.ID, second.SettledByID)
+		}
+
+		if first.R.SettledBy != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.SettledBy != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+
+		if a.R.SettledByDSCycles[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.SettledByDSCycles[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
+
+		count, err := a.SettledByDSCycles().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
+	}
+}
+
+func testUserToManySetOpSettledByDSCycles(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a User
+	var b, c, d, e DSCycle
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*DSCycle{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, dsCycleDBTypes, false, strmangle.SetComplement(dsCyclePrimaryKeyColumns, dsCycleColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err = a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	err = a.SetSettledByDSCycles(ctx, tx, false, &b, &c)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err := a.SettledByDSCycles().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	err = a.SetSettledByDSCycles(ctx, tx, true, &d, &e)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err = a.SettledByDSCycles().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	if !queries.IsValuerNil(b.SettledByID) {
+		t.Error("want b's foreign key value to be nil")
+	}
+	if !queries.IsValuerNil(c.SettledByID) {
+		t.Error("want c's foreign key value to be nil")
+	}
+	if !queries.Equal(a.ID, d.SettledByID) {
+		t.Error("foreign key was wrong value", a.ID, d.SettledByID)
+	}
+	if !queries.Equal(a.ID, e.SettledByID) {
+		t.Error("foreign key was wrong value", a.ID, e.SettledByID)
+	}
+
+	if b.R.SettledBy != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if c.R.SettledBy != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if d.R.SettledBy != &a {
+		t.Error("relationship was not added properly to the foreign struct")
+	}
+	if e.R.SettledBy != &a {
+		t.Error("relationship was not added properly to the foreign struct")
+	}
+
+	if a.R.SettledByDSCycles[0] != &d {
+		t.Error("relationship struct slice not set to correct value")
+	}
+	if a.R.SettledByDSCycles[1] != &e {
+		t.Error("relationship struct slice not set to correct value")
+	}
+}
+
+func testUserToManyRemoveOpSettledByDSCycles(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a User
+	var b, c, d, e DSCycle
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*DSCycle{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, dsCycleDBTypes, false, strmangle.SetComplement(dsCyclePrimaryKeyColumns, dsCycleColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	err = a.AddSettledByDSCycles(ctx, tx, true, foreigners...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err := a.SettledByDSCycles().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 4 {
+		t.Error("count was wrong:", count)
+	}
+
+	err = a.RemoveSettledByDSCycles(ctx, tx, foreigners[:2]...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err = a.SettledByDSCycles().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	if !queries.IsValuerNil(b.SettledByID) {
+		t.Error("want b's foreign key value to be nil")
+	}
+	if !queries.IsValuerNil(c.SettledByID) {
+		t.Error("want c's foreign key value to be nil")
+	}
+
+	if b.R.SettledBy != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if c.R.SettledBy != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if d.R.SettledBy != &a {
+		t.Error("relationship to a should have been preserved")
+	}
+	if e.R.SettledBy != &a {
+		t.Error("relationship to a should have been preserved")
+	}
+
+	if len(a.R.SettledByDSCycles) != 2 {
+		t.Error("should have preserved two relationships")
+	}
+
+	// Removal doesn't do a stable deletion for performance so we have to flip the order
+	if a.R.SettledByDSCycles[1] != &d {
+		t.Error("relationship to d should have been preserved")
+	}
+	if a.R.SettledByDSCycles[0] != &e {
+		t.Error("relationship to e should have been preserved")
+	}
+}
+
+func testUserToManyAddOpCreatedByImportJobs(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a User
+	var b, c, d, e ImportJob
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*ImportJob{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, importJobDBTypes, false, strmangle.SetComplement(importJobPrimaryKeyColumns, importJobColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	foreignersSplitByInsertion := [][]*ImportJob{
+		{&b, &c},
+		{&d, &e},
+	}
+
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddCreatedByImportJobs(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		first := x[0]
+		second := x[1]
+
+		if a.ID != first.CreatedByID {
+			t.Error("foreign key was wrong value", a.ID, first.CreatedByID)
+		}
+		if a.ID != second.CreatedByID {
+			t.Error("foreign key was wrong value", a.ID, second.CreatedByID)
+		}
+
+		if first.R.CreatedBy != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.CreatedBy != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+
+		if a.R.CreatedByImportJobs[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.CreatedByImportJobs[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
+
+		count, err := a.CreatedByImportJobs().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
+	}
+}
+func testUserToManyAddOpSalesRepImportJobs(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a User
+	var b, c, d, e ImportJob
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*ImportJob{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, importJobDBTypes, false, strmangle.SetComplement(importJobPrimaryKeyColumns, importJobColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	foreignersSplitByInsertion := [][]*ImportJob{
+		{&b, &c},
+		{&d, &e},
+	}
+
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddSalesRepImportJobs(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		first := x[0]
+		second := x[1]
+
+		if a.ID != first.SalesRepID {
+			t.Error("foreign key was wrong value", a.ID, first.SalesRepID)
+		}
+		if a.ID != second.SalesRepID {
+			t.Error("foreign key was wrong value", a.ID, second.SalesRepID)
+		}
+
+		if first.R.SalesRep != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.SalesRep != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+
+		if a.R.SalesRepImportJobs[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.SalesRepImportJobs[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
+
+		count, err := a.SalesRepImportJobs().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
+	}
+}
+func testUserToManyAddOpSalesRepInventories(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a User
+	var b, c, d, e Inventory
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*Inventory{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, inventoryDBTypes, false, strmangle.SetComplement(inventoryPrimaryKeyColumns, inventoryColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	foreignersSplitByInsertion := [][]*Inventory{
+		{&b, &c},
+		{&d, &e},
+	}
+
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddSalesRepInventories(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		first := x[0]
+		second := x[1]
+
+		if a.ID != first.SalesRepID {
+			t.Error("foreign key was wrong value", a.ID, first.SalesRepID)
+		}
+		if a.ID != second.SalesRepID {
+			t.Error("foreign key was wrong value", a.ID, second.SalesRepID)
+		}
+
+		if first.R.SalesRep != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.SalesRep != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+
+		if a.R.SalesRepInventories[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.SalesRepInventories[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
+
+		count, err := a.SalesRepInventories().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
+	}
+}
+func testUserToManyAddOpCreatedByJournalEntries(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a User
+	var b, c, d, e JournalEntry
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*JournalEntry{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, journalEntryDBTypes, false, strmangle.SetComplement(journalEntryPrimaryKeyColumns, journalEntryColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	foreignersSplitByInsertion := [][]*JournalEntry{
+		{&b, &c},
+		{&d, &e},
+	}
+
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddCreatedByJournalEntries(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		first := x[0]
+		second := x[1]
+
+		if !queries.Equal(a.ID, first.CreatedByID) {
+			t.Error("foreign key was wrong value", a.ID, first.CreatedByID)
+		}
+		if !queries.Equal(a.ID, second.CreatedByID) {
+			t.Error("foreign key was wrong value", a.ID, second.CreatedByID)
+		}
+
+		if first.R.CreatedBy != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.CreatedBy != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+
+		if a.R.CreatedByJournalEntries[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.CreatedByJournalEntries[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
+
+		count, err := a.CreatedByJournalEntries().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
+	}
+}
+
+func testUserToManySetOpCreatedByJournalEntries(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a User
+	var b, c, d, e JournalEntry
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*JournalEntry{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, journalEntryDBTypes, false, strmangle.SetComplement(journalEntryPrimaryKeyColumns, journalEntryColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err = a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	err = a.SetCreatedByJournalEntries(ctx, tx, false, &b, &c)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err := a.CreatedByJournalEntries().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	err = a.SetCreatedByJournalEntries(ctx, tx, true, &d, &e)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err = a.CreatedByJournalEntries().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	if !queries.IsValuerNil(b.CreatedByID) {
+		t.Error("want b's foreign key value to be nil")
+	}
+	if !queries.IsValuerNil(c.CreatedByID) {
+		t.Error("want c's foreign key value to be nil")
+	}
+	if !queries.Equal(a.ID, d.CreatedByID) {
+		t.Error("foreign key was wrong value", a.ID, d.CreatedByID)
+	}
+	if !queries.Equal(a.ID, e.CreatedByID) {
+		t.Error("foreign key was wrong value", a.ID, e.CreatedByID)
+	}
+
+	if b.R.CreatedBy != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if c.R.CreatedBy != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if d.R.CreatedBy != &a {
+		t.Error("relationship was not added properly to the foreign struct")
+	}
+	if e.R.CreatedBy != &a {
+		t.Error("relationship was not added properly to the foreign struct")
+	}
+
+	if a.R.CreatedByJournalEntries[0] != &d {
+		t.Error("relationship struct slice not set to correct value")
+	}
+	if a.R.CreatedByJournalEntries[1] != &e {
+		t.Error("relationship struct slice not set to correct value")
+	}
+}
+
+func testUserToManyRemoveOpCreatedByJournalEntries(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a User
+	var b, c, d, e JournalEntry
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*JournalEntry{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, journalEntryDBTypes, false, strmangle.SetComplement(journalEntryPrimaryKeyColumns, journalEntryColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	err = a.AddCreatedByJournalEntries(ctx, tx, true, foreigners...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err := a.CreatedByJournalEntries().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 4 {
+		t.Error("count was wrong:", count)
+	}
+
+	err = a.RemoveCreatedByJournalEntries(ctx, tx, foreigners[:2]...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err = a.CreatedByJournalEntries().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	if !queries.IsValuerNil(b.CreatedByID) {
+		t.Error("want b's foreign key value to be nil")
+	}
+	if !queries.IsValuerNil(c.CreatedByID) {
+		t.Error("want c's foreign key value to be nil")
+	}
+
+	if b.R.CreatedBy != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if c.R.CreatedBy != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if d.R.CreatedBy != &a {
+		t.Error("relationship to a should have been preserved")
+	}
+	if e.R.CreatedBy != &a {
+		t.Error("relationship to a should have been preserved")
+	}
+
+	if len(a.R.CreatedByJournalEntries) != 2 {
+		t.Error("should have preserved two relationships")
+	}
+
+	// Removal doesn't do a stable deletion for performance so we have to flip the order
+	if a.R.CreatedByJournalEntries[1] != &d {
+		t.Error("relationship to d should have been preserved")
+	}
+	if a.R.CreatedByJournalEntries[0] != &e {
+		t.Error("relationship to e should have been preserved")
+	}
+}
+
+func testUserToManyAddOpAppliedByLoans(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a User
+	var b, c, d, e Loan
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*Loan{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, loanDBTypes, false, strmangle.SetComplement(loanPrimaryKeyColumns, loanColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	foreignersSplitByInsertion := [][]*Loan{
+		{&b, &c},
+		{&d, &e},
+	}
+
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddAppliedByLoans(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		first := x[0]
+		second := x[1]
+
+		if a.ID != first.AppliedByID {
+			t.Error("foreign key was wrong value", a.ID, first.AppliedByID)
+		}
+		if a.ID != second.AppliedByID {
+			t.Error("foreign key was wrong value", a.ID, second.AppliedByID)
+		}
+
+		if first.R.AppliedBy != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.AppliedBy != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+
+		if a.R.AppliedByLoans[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.AppliedByLoans[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
+
+		count, err := a.AppliedByLoans().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
+	}
+}
+func testUserToManyAddOpApprovedByLoans(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a User
+	var b, c, d, e Loan
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*Loan{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, loanDBTypes, false, strmangle.SetComplement(loanPrimaryKeyColumns, loanColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	foreignersSplitByInsertion := [][]*Loan{
+		{&b, &c},
+		{&d, &e},
+	}
+
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddApprovedByLoans(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		first := x[0]
+		second := x[1]
+
+		if !queries.Equal(a.ID, first.ApprovedByID) {
+			t.Error("foreign key was wrong value", a.ID, first.ApprovedByID)
+		}
+		if !queries.Equal(a.ID, second.ApprovedByID) {
+			t.Error("foreign key was wrong value", a.ID, second.ApprovedByID)
+		}
+
+		if first.R.ApprovedBy != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.ApprovedBy != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+
+		if a.R.ApprovedByLoans[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.ApprovedByLoans[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
+
+		count, err := a.ApprovedByLoans().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
+	}
+}
+
+func testUserToManySetOpApprovedByLoans(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a User
+	var b, c, d, e Loan
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*Loan{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, loanDBTypes, false, strmangle.SetComplement(loanPrimaryKeyColumns, loanColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err = a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	err = a.SetApprovedByLoans(ctx, tx, false, &b, &c)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err := a.ApprovedByLoans().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	err = a.SetApprovedByLoans(ctx, tx, true, &d, &e)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err = a.ApprovedByLoans().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	if !queries.IsValuerNil(b.ApprovedByID) {
+		t.Error("want b's foreign key value to be nil")
+	}
+	if !queries.IsValuerNil(c.ApprovedByID) {
+		t.Error("want c's foreign key value to be nil")
+	}
+	if !queries.Equal(a.ID, d.ApprovedByID) {
+		t.Error("foreign key was wrong value", a.ID, d.ApprovedByID)
+	}
+	if !queries.Equal(a.ID, e.ApprovedByID) {
+		t.Error("foreign key was wrong value", a.ID, e.ApprovedByID)
+	}
+
+	if b.R.ApprovedBy != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if c.R.ApprovedBy != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if d.R.ApprovedBy != &a {
+		t.Error("relationship was not added properly to the foreign struct")
+	}
+	if e.R.ApprovedBy != &a {
+		t.Error("relationship was not added properly to the foreign struct")
+	}
+
+	if a.R.ApprovedByLoans[0] != &d {
+		t.Error("relationship struct slice not set to correct value")
+	}
+	if a.R.ApprovedByLoans[1] != &e {
+		t.Error("relationship struct slice not set to correct value")
+	}
+}
+
+func testUserToManyRemoveOpApprovedByLoans(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a User
+	var b, c, d, e Loan
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*Loan{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, loanDBTypes, false, strmangle.SetComplement(loanPrimaryKeyColumns, loanColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	err = a.AddApprovedByLoans(ctx, tx, true, foreigners...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err := a.ApprovedByLoans().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 4 {
+		t.Error("count was wrong:", count)
+	}
+
+	err = a.RemoveApprovedByLoans(ctx, tx, foreigners[:2]...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err = a.ApprovedByLoans().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	if !queries.IsValuerNil(b.ApprovedByID) {
+		t.Error("want b's foreign key value to be nil")
+	}
+	if !queries.IsValuerNil(c.ApprovedByID) {
+		t.Error("want c's foreign key value to be nil")
+	}
+
+	if b.R.ApprovedBy != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if c.R.ApprovedBy != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if d.R.ApprovedBy != &a {
+		t.Error("relationship to a should have been preserved")
+	}
+	if e.R.ApprovedBy != &a {
+		t.Error("relationship to a should have been preserved")
+	}
+
+	if len(a.R.ApprovedByLoans) != 2 {
+		t.Error("should have preserved two relationships")
+	}
+
+	// Removal doesn't do a stable deletion for performance so we have to flip the order
+	if a.R.ApprovedByLoans[1] != &d {
+		t.Error("relationship to d should have been preserved")
+	}
+	if a.R.ApprovedByLoans[0] != &e {
+		t.Error("relationship to e should have been preserved")
+	}
+}
+
+func testUserToManyAddOpDisbursedByLoans(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a User
+	var b, c, d, e Loan
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*Loan{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, loanDBTypes, false, strmangle.SetComplement(loanPrimaryKeyColumns, loanColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	foreignersSplitByInsertion := [][]*Loan{
+		{&b, &c},
+		{&d, &e},
+	}
+
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddDisbursedByLoans(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		first := x[0]
+		second := x[1]
+
+		if !queries.Equal(a.ID, first.DisbursedByID) {
+			t.Error("foreign key was wrong value", a.ID, first.DisbursedByID)
+		}
+		if !queries.Equal(a.ID, second.DisbursedByID) {
+			t.Error("foreign key was wrong value", a.ID, second.DisbursedByID)
+		}
+
+		if first.R.DisbursedBy != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.DisbursedBy != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+
+		if a.R.DisbursedByLoans[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.DisbursedByLoans[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
+
+		count, err := a.DisbursedByLoans().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
+	}
+}
+
+func testUserToManySetOpDisbursedByLoans(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a User
+	var b, c, d, e Loan
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*Loan{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, loanDBTypes, false, strmangle.SetComplement(loanPrimaryKeyColumns, loanColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err = a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	err = a.SetDisbursedByLoans(ctx, tx, false, &b, &c)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err := a.DisbursedByLoans().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	err = a.SetDisbursedByLoans(ctx, tx, true, &d, &e)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err = a.DisbursedByLoans().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	if !queries.IsValuerNil(b.DisbursedByID) {
+		t.Error("want b's foreign key value to be nil")
+	}
+	if !queries.IsValuerNil(c.DisbursedByID) {
+		t.Error("want c's foreign key value to be nil")
+	}
+	if !queries.Equal(a.ID, d.DisbursedByID) {
+		t.Error("foreign key was wrong value", a.ID, d.DisbursedByID)
+	}
+	if !queries.Equal(a.ID, e.DisbursedByID) {
+		t.Error("foreign key was wrong value", a.ID, e.DisbursedByID)
+	}
+
+	if b.R.DisbursedBy != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if c.R.DisbursedBy != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if d.R.DisbursedBy != &a {
+		t.Error("relationship was not added properly to the foreign struct")
+	}
+	if e.R.DisbursedBy != &a {
+		t.Error("relationship was not added properly to the foreign struct")
+	}
+
+	if a.R.DisbursedByLoans[0] != &d {
+		t.Error("relationship struct slice not set to correct value")
+	}
+	if a.R.DisbursedByLoans[1] != &e {
+		t.Error("relationship struct slice not set to correct value")
+	}
+}
+
+func testUserToManyRemoveOpDisbursedByLoans(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a User
+	var b, c, d, e Loan
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*Loan{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, loanDBTypes, false, strmangle.SetComplement(loanPrimaryKeyColumns, loanColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	err = a.AddDisbursedByLoans(ctx, tx, true, foreigners...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err := a.DisbursedByLoans().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 4 {
+		t.Error("count was wrong:", count)
+	}
+
+	err = a.RemoveDisbursedByLoans(ctx, tx, foreigners[:2]...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err = a.DisbursedByLoans().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	if !queries.IsValuerNil(b.DisbursedByID) {
+		t.Error("want b's foreign key value to be nil")
+	}
+	if !queries.IsValuerNil(c.DisbursedByID) {
+		t.Error("want c's foreign key value to be nil")
+	}
+
+	if b.R.DisbursedBy != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if c.R.DisbursedBy != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if d.R.DisbursedBy != &a {
+		t.Error("relationship to a should have been preserved")
+	}
+	if e.R.DisbursedBy != &a {
+		t.Error("relationship to a should have been preserved")
+	}
+
+	if len(a.R.DisbursedByLoans) != 2 {
+		t.Error("should have preserved two relationships")
+	}
+
+	// Removal doesn't do a stable deletion for performance so we have to flip the order
+	if a.R.DisbursedByLoans[1] != &d {
+		t.Error("relationship to d should have been preserved")
+	}
+	if a.R.DisbursedByLoans[0] != &e {
+		t.Error("relationship to e should have been preserved")
+	}
+}
+
+func testUserToManyAddOpSalesRepLoanRepayments(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a User
+	var b, c, d, e LoanRepayment
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*LoanRepayment{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, loanRepaymentDBTypes, false, strmangle.SetComplement(loanRepaymentPrimaryKeyColumns, loanRepaymentColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	foreignersSplitByInsertion := [][]*LoanRepayment{
+		{&b, &c},
+		{&d, &e},
+	}
+
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddSalesRepLoanRepayments(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		first := x[0]
+		second := x[1]
+
+		if a.ID != first.SalesRepID {
+			t.Error("foreign key was wrong value", a.ID, first.SalesRepID)
+		}
+		if a.ID != second.SalesRepID {
+			t.Error("foreign key was wrong value", a.ID, second.SalesRepID)
+		}
+
+		if first.R.SalesRep != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.SalesRep != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+
+		if a.R.SalesRepLoanRepayments[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.SalesRepLoanRepayments[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
+
+		count, err := a.SalesRepLoanRepayments().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
+	}
+}
+func testUserToManyAddOpSalesRepPayments(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a User
+	var b, c, d, e Payment
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*Payment{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, paymentDBTypes, false, strmangle.SetComplement(paymentPrimaryKeyColumns, paymentColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	foreignersSplitByInsertion := [][]*Payment{
+		{&b, &c},
+		{&d, &e},
+	}
+
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddSalesRepPayments(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		first := x[0]
+		second := x[1]
+
+		if a.ID != first.SalesRepID {
+			t.Error("foreign key was wrong value", a.ID, first.SalesRepID)
+		}
+		if a.ID != second.SalesRepID {
+			t.Error("foreign key was wrong value", a.ID, second.SalesRepID)
+		}
+
+		if first.R.SalesRep != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.SalesRep != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+
+		if a.R.SalesRepPayments[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.SalesRepPayments[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
+
+		count, err := a.SalesRepPayments().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
+	}
+}
+func testUserToManyAddOpArchivedByProducts(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a User
+	var b, c, d, e Product
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*Product{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, productDBTypes, false, strmangle.SetComplement(productPrimaryKeyColumns, productColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	foreignersSplitByInsertion := [][]*Product{
+		{&b, &c},
+		{&d, &e},
+	}
+
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddArchivedByProducts(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		first := x[0]
+		second := x[1]
+
+		if !queries.Equal(a.ID, first.ArchivedByID) {
+			t.Error("foreign key was wrong value", a.ID, first.ArchivedByID)
+		}
+		if !queries.Equal(a.ID, second.ArchivedByID) {
+			t.Error("foreign key was wrong value", a.ID, second.ArchivedByID)
+		}
+
+		if first.R.ArchivedBy != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.ArchivedBy != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+
+		if a.R.ArchivedByProducts[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.ArchivedByProducts[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
+
+		count, err := a.ArchivedByProducts().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
+	}
+}
+
+func testUserToManySetOpArchivedByProducts(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a User
+	var b, c, d, e Product
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*Product{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, productDBTypes, false, strmangle.SetComplement(productPrimaryKeyColumns, productColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err = a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	err = a.SetArchivedByProducts(ctx, tx, false, &b, &c)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err := a.ArchivedByProducts().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	err = a.SetArchivedByProducts(ctx, tx, true, &d, &e)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err = a.ArchivedByProducts().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	if !queries.IsValuerNil(b.ArchivedByID) {
+		t.Error("want b's foreign key value to be nil")
+	}
+	if !queries.IsValuerNil(c.ArchivedByID) {
+		t.Error("want c's foreign key value to be nil")
+	}
+	if !queries.Equal(a.ID, d.ArchivedByID) {
+		t.Error("foreign key was wrong value", a.ID, d.ArchivedByID)
+	}
+	if !queries.Equal(a.ID, e.ArchivedByID) {
+		t.Error("foreign key was wrong value", a.ID, e.ArchivedByID)
+	}
+
+	if b.R.ArchivedBy != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if c.R.ArchivedBy != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if d.R.ArchivedBy != &a {
+		t.Error("relationship was not added properly to the foreign struct")
+	}
+	if e.R.ArchivedBy != &a {
+		t.Error("relationship was not added properly to the foreign struct")
+	}
+
+	if a.R.ArchivedByProducts[0] != &d {
+		t.Error("relationship struct slice not set to correct value")
+	}
+	if a.R.ArchivedByProducts[1] != &e {
+		t.Error("relationship struct slice not set to correct value")
+	}
+}
+
+func testUserToManyRemoveOpArchivedByProducts(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a User
+	var b, c, d, e Product
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*Product{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, productDBTypes, false, strmangle.SetComplement(productPrimaryKeyColumns, productColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	err = a.AddArchivedByProducts(ctx, tx, true, foreigners...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err := a.ArchivedByProducts().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 4 {
+		t.Error("count was wrong:", count)
+	}
+
+	err = a.RemoveArchivedByProducts(ctx, tx, foreigners[:2]...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err = a.ArchivedByProducts().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	if !queries.IsValuerNil(b.ArchivedByID) {
+		t.Error("want b's foreign key value to be nil")
+	}
+	if !queries.IsValuerNil(c.ArchivedByID) {
+		t.Error("want c's foreign key value to be nil")
+	}
+
+	if b.R.ArchivedBy != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if c.R.ArchivedBy != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if d.R.ArchivedBy != &a {
+		t.Error("relationship to a should have been preserved")
+	}
+	if e.R.ArchivedBy != &a {
+		t.Error("relationship to a should have been preserved")
+	}
+
+	if len(a.R.ArchivedByProducts) != 2 {
+		t.Error("should have preserved two relationships")
+	}
+
+	// Removal doesn't do a stable deletion for performance so we have to flip the order
+	if a.R.ArchivedByProducts[1] != &d {
+		t.Error("relationship to d should have been preserved")
+	}
+	if a.R.ArchivedByProducts[0] != &e {
+		t.Error("relationship to e should have been preserved")
+	}
+}
+
+func testUserToManyAddOpCreatedByProducts(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a User
+	var b, c, d, e Product
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*Product{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, productDBTypes, false, strmangle.SetComplement(productPrimaryKeyColumns, productColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	foreignersSplitByInsertion := [][]*Product{
+		{&b, &c},
+		{&d, &e},
+	}
+
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddCreatedByProducts(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		first := x[0]
+		second := x[1]
+
+		if a.ID != first.CreatedByID {
+			t.Error("foreign key was wrong value", a.ID, first.CreatedByID)
+		}
+		if a.ID != second.CreatedByID {
+			t.Error("foreign key was wrong value", a.ID, second.CreatedByID)
+		}
+
+		if first.R.CreatedBy != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.CreatedBy != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+
+		if a.R.CreatedByProducts[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.CreatedByProducts[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
+
+		count, err := a.CreatedByProducts().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
+	}
+}
+func testUserToManyAddOpUpdatedByProducts(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a User
+	var b, c, d, e Product
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*Product{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, productDBTypes, false, strmangle.SetComplement(productPrimaryKeyColumns, productColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	foreignersSplitByInsertion := [][]*Product{
+		{&b, &c},
+		{&d, &e},
+	}
+
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddUpdatedByProducts(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		first := x[0]
+		second := x[1]
+
+		if a.ID != first.UpdatedByID {
+			t.Error("foreign key was wrong value", a.ID, first.UpdatedByID)
+		}
+		if a.ID != second.UpdatedByID {
+			t.Error("foreign key was wrong value", a.ID, second.UpdatedByID)
+		}
+
+		if first.R.UpdatedBy != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.UpdatedBy != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+
+		if a.R.UpdatedByProducts[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.UpdatedByProducts[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
+
+		count, err := a.UpdatedByProducts().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
+	}
+}
+func testUserToManyAddOpSalesRepRepsExpenses(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a User
+	var b, c, d, e RepsExpense
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*RepsExpense{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, repsExpenseDBTypes, false, strmangle.SetComplement(repsExpensePrimaryKeyColumns, repsExpenseColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	foreignersSplitByInsertion := [][]*RepsExpense{
+		{&b, &c},
+		{&d, &e},
+	}
+
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddSalesRepRepsExpenses(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		first := x[0]
+		second := x[1]
+
+		if a.ID != first.SalesRepID {
+			t.Error("foreign key was wrong value", a.ID, first.SalesRepID)
+		}
+		if a.ID != second.SalesRepID {
+			t.Error("foreign key was wrong value", a.ID, second.SalesRepID)
+		}
+
+		if first.R.SalesRep != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.SalesRep != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+
+		if a.R.SalesRepRepsExpenses[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.SalesRepRepsExpenses[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
+
+		count, err := a.SalesRepRepsExpenses().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
+	}
+}
+func testUserToManyAddOpArchivedBySales(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a User
+	var b, c, d, e Sale
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*Sale{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, saleDBTypes, false, strmangle.SetComplement(salePrimaryKeyColumns, saleColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	foreignersSplitByInsertion := [][]*Sale{
+		{&b, &c},
+		{&d, &e},
+	}
+
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddArchivedBySales(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		first := x[0]
+		second := x[1]
+
+		if !queries.Equal(a.ID, first.ArchivedByID) {
+			t.Error("foreign key was wrong value", a.ID, first.ArchivedByID)
+		}
+		if !queries.Equal(a.ID, second.ArchivedByID) {
+			t.Error("foreign key was wrong value", a.ID, second.ArchivedByID)
+		}
+
+		if first.R.ArchivedBy != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.ArchivedBy != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+
+		if a.R.ArchivedBySales[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.ArchivedBySales[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
+
+		count, err := a.ArchivedBySales().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
+	}
+}
+
+func testUserToManySetOpArchivedBySales(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a User
+	var b, c, d, e Sale
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*Sale{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, saleDBTypes, false, strmangle.SetComplement(salePrimaryKeyColumns, saleColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err = a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	err = a.SetArchivedBySales(ctx, tx, false, &b, &c)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err := a.ArchivedBySales().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	err = a.SetArchivedBySales(ctx, tx, true, &d, &e)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err = a.ArchivedBySales().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	if !queries.IsValuerNil(b.ArchivedByID) {
+		t.Error("want b's foreign key value to be nil")
+	}
+	if !queries.IsValuerNil(c.ArchivedByID) {
+		t.Error("want c's foreign key value to be nil")
+	}
+	if !queries.Equal(a.ID, d.ArchivedByID) {
+		t.Error("foreign key was wrong value", a.ID, d.ArchivedByID)
+	}
+	if !queries.Equal(a.ID, e.ArchivedByID) {
+		t.Error("foreign key was wrong value", a.ID, e.ArchivedByID)
+	}
+
+	if b.R.ArchivedBy != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if c.R.ArchivedBy != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if d.R.ArchivedBy != &a {
+		t.Error("relationship was not added properly to the foreign struct")
+	}
+	if e.R.ArchivedBy != &a {
+		t.Error("relationship was not added properly to the foreign struct")
+	}
+
+	if a.R.ArchivedBySales[0] != &d {
+		t.Error("relationship struct slice not set to correct value")
+	}
+	if a.R.ArchivedBySales[1] != &e {
+		t.Error("relationship struct slice not set to correct value")
+	}
+}
+
+func testUserToManyRemoveOpArchivedBySales(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a User
+	var b, c, d, e Sale
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*Sale{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, saleDBTypes, false, strmangle.SetComplement(salePrimaryKeyColumns, saleColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	err = a.AddArchivedBySales(ctx, tx, true, foreigners...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err := a.ArchivedBySales().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 4 {
+		t.Error("count was wrong:", count)
+	}
+
+	err = a.RemoveArchivedBySales(ctx, tx, foreigners[:2]...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err = a.ArchivedBySales().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	if !queries.IsValuerNil(b.ArchivedByID) {
+		t.Error("want b's foreign key value to be nil")
+	}
+	if !queries.IsValuerNil(c.ArchivedByID) {
+		t.Error("want c's foreign key value to be nil")
+	}
+
+	if b.R.ArchivedBy != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if c.R.ArchivedBy != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if d.R.ArchivedBy != &a {
+		t.Error("relationship to a should have been preserved")
+	}
+	if e.R.ArchivedBy != &a {
+		t.Error("relationship to a should have been preserved")
+	}
+
+	if len(a.R.ArchivedBySales) != 2 {
+		t.Error("should have preserved two relationships")
+	}
+
+	// Removal doesn't do a stable deletion for performance so we have to flip the order
+	if a.R.ArchivedBySales[1] != &d {
+		t.Error("relationship to d should have been preserved")
+	}
+	if a.R.ArchivedBySales[0] != &e {
+		t.Error("relationship to e should have been preserved")
+	}
+}
+
+func testUserToManyAddOpCreatedBySales(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a User
+	var b, c, d, e Sale
 
 	seed := randomize.NewSeed()
 	if err = randomize.Struct(seed, &a, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
 		t.Fatal(err)
 	}
-	foreigners := []*Customer{&b, &c, &d, &e}
+	foreigners := []*Sale{&b, &c, &d, &e}
 	for _, x := range foreigners {
-		if err = randomize.Struct(seed, x, customerDBTypes, false, strmangle.SetComplement(customerPrimaryKeyColumns, customerColumnsWithoutDefault)...); err != nil {
+		if err = randomize.Struct(seed, x, saleDBTypes, false, strmangle.SetComplement(salePrimaryKeyColumns, saleColumnsWithoutDefault)...); err != nil {
 			t.Fatal(err)
 		}
 	}
@@ -1392,13 +6810,13 @@ func testUserToManyAddOpSalesRepCustomers(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	foreignersSplitByInsertion := [][]*Customer{
+	foreignersSplitByInsertion := [][]*Sale{
 		{&b, &c},
 		{&d, &e},
 	}
 
 	for i, x := range foreignersSplitByInsertion {
-		err = a.AddSalesRepCustomers(ctx, tx, i != 0, x...)
+		err = a.AddCreatedBySales(ctx, tx, i != 0, x...)
 		if err != nil {
 			t.Fatal(err)
 		}
@@ -1406,28 +6824,28 @@ func testUserToManyAddOpSalesRepCustomers(t *testing.T) {
 		first := x[0]
 		second := x[1]
 
-		if a.ID != first.SalesRepID {
-			t.Error("foreign key was wrong value", a.ID, first.SalesRepID)
+		if a.ID != first.CreatedByID {
+			t.Error("foreign key was wrong value", a.ID, first.CreatedByID)
 		}
-		if a.ID != second.SalesRepID {
-			t.Error("foreign key was wrong value", a.ID, second.SalesRepID)
+		if a.ID != second.CreatedByID {
+			t.Error("foreign key was wrong value", a.ID, second.CreatedByID)
 		}
 
-		if first.R.SalesRep != &a {
+		if first.R.CreatedBy != &a {
 			t.Error("relationship was not added properly to the foreign slice")
 		}
-		if second.R.SalesRep != &a {
+		if second.R.CreatedBy != &a {
 			t.Error("relationship was not added properly to the foreign slice")
 		}
 
-		if a.R.SalesRepCustomers[i*2] != first {
+		if a.R.CreatedBySales[i*2] != first {
 			t.Error("relationship struct slice not set to correct value")
 		}
-		if a.R.SalesRepCustomers[i*2+1] != second {
+		if a.R.CreatedBySales[i*2+1] != second {
 			t.Error("relationship struct slice not set to correct value")
 		}
 
-		count, err := a.SalesRepCustomers().Count(ctx, tx)
+		count, err := a.CreatedBySales().Count(ctx, tx)
 		if err != nil {
 			t.Fatal(err)
 		}
@@ -1436,7 +6854,7 @@ func testUserToManyAddOpSalesRepCustomers(t *testing.T) {
 		}
 	}
 }
-func testUserToManyAddOpSalesRepInventories(t *testing.T) {
+func testUserToManyAddOpUpdatedBySales(t *testing.T) {
 	var err error
 
 	ctx := context.Background()
@@ -1444,15 +6862,15 @@ func testUserToManyAddOpSalesRepInventories(t *testing.T) {
 	defer func() { _ = tx.Rollback() }()
 
 	var a User
-	var b, c, d, e Inventory
+	var b, c, d, e Sale
 
 	seed := randomize.NewSeed()
 	if err = randomize.Struct(seed, &a, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
 		t.Fatal(err)
 	}
-	foreigners := []*Inventory{&b, &c, &d, &e}
+	foreigners := []*Sale{&b, &c, &d, &e}
 	for _, x := range foreigners {
-		if err = randomize.Struct(seed, x, inventoryDBTypes, false, strmangle.SetComplement(inventoryPrimaryKeyColumns, inventoryColumnsWithoutDefault)...); err != nil {
+		if err = randomize.Struct(seed, x, saleDBTypes, false, strmangle.SetComplement(salePrimaryKeyColumns, saleColumnsWithoutDefault)...); err != nil {
 			t.Fatal(err)
 		}
 	}
@@ -1467,13 +6885,13 @@ func testUserToManyAddOpSalesRepInventories(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	foreignersSplitByInsertion := [][]*Inventory{
+	foreignersSplitByInsertion := [][]*Sale{
 		{&b, &c},
 		{&d, &e},
 	}
 
 	for i, x := range foreignersSplitByInsertion {
-		err = a.AddSalesRepInventories(ctx, tx, i != 0, x...)
+		err = a.AddUpdatedBySales(ctx, tx, i != 0, x...)
 		if err != nil {
 			t.Fatal(err)
 		}
@@ -1481,28 +6899,28 @@ func testUserToManyAddOpSalesRepInventories(t *testing.T) {
 		first := x[0]
 		second := x[1]
 
-		if a.ID != first.SalesRepID {
-			t.Error("foreign key was wrong value", a.ID, first.SalesRepID)
+		if !queries.Equal(a.ID, first.UpdatedByID) {
+			t.Error("foreign key was wrong value", a.ID, first.UpdatedByID)
 		}
-		if a.ID != second.SalesRepID {
-			t.Error("foreign key was wrong value", a.ID, second.SalesRepID)
+		if !queries.Equal(a.ID, second.UpdatedByID) {
+			t.Error("foreign key was wrong value", a.ID, second.UpdatedByID)
 		}
 
-		if first.R.SalesRep != &a {
+		if first.R.UpdatedBy != &a {
 			t.Error("relationship was not added properly to the foreign slice")
 		}
-		if second.R.SalesRep != &a {
+		if second.R.UpdatedBy != &a {
 			t.Error("relationship was not added properly to the foreign slice")
 		}
 
-		if a.R.SalesRepInventories[i*2] != first {
+		if a.R.UpdatedBySales[i*2] != first {
 			t.Error("relationship struct slice not set to correct value")
 		}
-		if a.R.SalesRepInventories[i*2+1] != second {
+		if a.R.UpdatedBySales[i*2+1] != second {
 			t.Error("relationship struct slice not set to correct value")
 		}
 
-		count, err := a.SalesRepInventories().Count(ctx, tx)
+		count, err := a.UpdatedBySales().Count(ctx, tx)
 		if err != nil {
 			t.Fatal(err)
 		}
@@ -1511,7 +6929,183 @@ func testUserToManyAddOpSalesRepInventories(t *testing.T) {
 		}
 	}
 }
-func testUserToManyAddOpSalesRepPayments(t *testing.T) {
+
+func testUserToManySetOpUpdatedBySales(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a User
+	var b, c, d, e Sale
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*Sale{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, saleDBTypes, false, strmangle.SetComplement(salePrimaryKeyColumns, saleColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err = a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	err = a.SetUpdatedBySales(ctx, tx, false, &b, &c)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err := a.UpdatedBySales().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	err = a.SetUpdatedBySales(ctx, tx, true, &d, &e)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err = a.UpdatedBySales().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	if !queries.IsValuerNil(b.UpdatedByID) {
+		t.Error("want b's foreign key value to be nil")
+	}
+	if !queries.IsValuerNil(c.UpdatedByID) {
+		t.Error("want c's foreign key value to be nil")
+	}
+	if !queries.Equal(a.ID, d.UpdatedByID) {
+		t.Error("foreign key was wrong value", a.ID, d.UpdatedByID)
+	}
+	if !queries.Equal(a.ID, e.UpdatedByID) {
+		t.Error("foreign key was wrong value", a.ID, e.UpdatedByID)
+	}
+
+	if b.R.UpdatedBy != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if c.R.UpdatedBy != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if d.R.UpdatedBy != &a {
+		t.Error("relationship was not added properly to the foreign struct")
+	}
+	if e.R.UpdatedBy != &a {
+		t.Error("relationship was not added properly to the foreign struct")
+	}
+
+	if a.R.UpdatedBySales[0] != &d {
+		t.Error("relationship struct slice not set to correct value")
+	}
+	if a.R.UpdatedBySales[1] != &e {
+		t.Error("relationship struct slice not set to correct value")
+	}
+}
+
+func testUserToManyRemoveOpUpdatedBySales(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a User
+	var b, c, d, e Sale
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*Sale{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, saleDBTypes, false, strmangle.SetComplement(salePrimaryKeyColumns, saleColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	err = a.AddUpdatedBySales(ctx, tx, true, foreigners...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err := a.UpdatedBySales().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 4 {
+		t.Error("count was wrong:", count)
+	}
+
+	err = a.RemoveUpdatedBySales(ctx, tx, foreigners[:2]...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err = a.UpdatedBySales().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	if !queries.IsValuerNil(b.UpdatedByID) {
+		t.Error("want b's foreign key value to be nil")
+	}
+	if !queries.IsValuerNil(c.UpdatedByID) {
+		t.Error("want c's foreign key value to be nil")
+	}
+
+	if b.R.UpdatedBy != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if c.R.UpdatedBy != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if d.R.UpdatedBy != &a {
+		t.Error("relationship to a should have been preserved")
+	}
+	if e.R.UpdatedBy != &a {
+		t.Error("relationship to a should have been preserved")
+	}
+
+	if len(a.R.UpdatedBySales) != 2 {
+		t.Error("should have preserved two relationships")
+	}
+
+	// Removal doesn't do a stable deletion for performance so we have to flip the order
+	if a.R.UpdatedBySales[1] != &d {
+		t.Error("relationship to d should have been preserved")
+	}
+	if a.R.UpdatedBySales[0] != &e {
+		t.Error("relationship to e should have been preserved")
+	}
+}
+
+func testUserToManyAddOpSalesRepTillSessions(t *testing.T) {
 	var err error
 
 	ctx := context.Background()
@@ -1519,15 +7113,15 @@ func testUserToManyAddOpSalesRepPayments(t *testing.T) {
 	defer func() { _ = tx.Rollback() }()
 
 	var a User
-	var b, c, d, e Payment
+	var b, c, d, e TillSession
 
 	seed := randomize.NewSeed()
 	if err = randomize.Struct(seed, &a, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
 		t.Fatal(err)
 	}
-	foreigners := []*Payment{&b, &c, &d, &e}
+	foreigners := []*TillSession{&b, &c, &d, &e}
 	for _, x := range foreigners {
-		if err = randomize.Struct(seed, x, paymentDBTypes, false, strmangle.SetComplement(paymentPrimaryKeyColumns, paymentColumnsWithoutDefault)...); err != nil {
+		if err = randomize.Struct(seed, x, tillSessionDBTypes, false, strmangle.SetComplement(tillSessionPrimaryKeyColumns, tillSessionColumnsWithoutDefault)...); err != nil {
 			t.Fatal(err)
 		}
 	}
@@ -1542,13 +7136,13 @@ func testUserToManyAddOpSalesRepPayments(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	foreignersSplitByInsertion := [][]*Payment{
+	foreignersSplitByInsertion := [][]*TillSession{
 		{&b, &c},
 		{&d, &e},
 	}
 
 	for i, x := range foreignersSplitByInsertion {
-		err = a.AddSalesRepPayments(ctx, tx, i != 0, x...)
+		err = a.AddSalesRepTillSessions(ctx, tx, i != 0, x...)
 		if err != nil {
 			t.Fatal(err)
 		}
@@ -1570,14 +7164,14 @@ func testUserToManyAddOpSalesRepPayments(t *testing.T) {
 			t.Error("relationship was not added properly to the foreign slice")
 		}
 
-		if a.R.SalesRepPayments[i*2] != first {
+		if a.R.SalesRepTillSessions[i*2] != first {
 			t.Error("relationship struct slice not set to correct value")
 		}
-		if a.R.SalesRepPayments[i*2+1] != second {
+		if a.R.SalesRepTillSessions[i*2+1] != second {
 			t.Error("relationship struct slice not set to correct value")
 		}
 
-		count, err := a.SalesRepPayments().Count(ctx, tx)
+		count, err := a.SalesRepTillSessions().Count(ctx, tx)
 		if err != nil {
 			t.Fatal(err)
 		}
@@ -1586,7 +7180,7 @@ func testUserToManyAddOpSalesRepPayments(t *testing.T) {
 		}
 	}
 }
-func testUserToManyAddOpArchivedByProducts(t *testing.T) {
+func testUserToManyAddOpSignedOffByTillSessions(t *testing.T) {
 	var err error
 
 	ctx := context.Background()
@@ -1594,15 +7188,15 @@ func testUserToManyAddOpArchivedByProducts(t *testing.T) {
 	defer func() { _ = tx.Rollback() }()
 
 	var a User
-	var b, c, d, e Product
+	var b, c, d, e TillSession
 
 	seed := randomize.NewSeed()
 	if err = randomize.Struct(seed, &a, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
 		t.Fatal(err)
 	}
-	foreigners := []*Product{&b, &c, &d, &e}
+	foreigners := []*TillSession{&b, &c, &d, &e}
 	for _, x := range foreigners {
-		if err = randomize.Struct(seed, x, productDBTypes, false, strmangle.SetComplement(productPrimaryKeyColumns, productColumnsWithoutDefault)...); err != nil {
+		if err = randomize.Struct(seed, x, tillSessionDBTypes, false, strmangle.SetComplement(tillSessionPrimaryKeyColumns, tillSessionColumnsWithoutDefault)...); err != nil {
 			t.Fatal(err)
 		}
 	}
@@ -1617,13 +7211,13 @@ func testUserToManyAddOpArchivedByProducts(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	foreignersSplitByInsertion := [][]*Product{
+	foreignersSplitByInsertion := [][]*TillSession{
 		{&b, &c},
 		{&d, &e},
 	}
 
 	for i, x := range foreignersSplitByInsertion {
-		err = a.AddArchivedByProducts(ctx, tx, i != 0, x...)
+		err = a.AddSignedOffByTillSessions(ctx, tx, i != 0, x...)
 		if err != nil {
 			t.Fatal(err)
 		}
@@ -1631,28 +7225,28 @@ func testUserToManyAddOpArchivedByProducts(t *testing.T) {
 		first := x[0]
 		second := x[1]
 
-		if !queries.Equal(a.ID, first.ArchivedByID) {
-			t.Error("foreign key was wrong value", a.ID, first.ArchivedByID)
+		if !queries.Equal(a.ID, first.SignedOffByID) {
+			t.Error("foreign key was wrong value", a.ID, first.SignedOffByID)
 		}
-		if !queries.Equal(a.ID, second.ArchivedByID) {
-			t.Error("foreign key was wrong value", a.ID, second.ArchivedByID)
+		if !queries.Equal(a.ID, second.SignedOffByID) {
+			t.Error("foreign key was wrong value", a.ID, second.SignedOffByID)
 		}
 
-		if first.R.ArchivedBy != &a {
+		if first.R.SignedOffBy != &a {
 			t.Error("relationship was not added properly to the foreign slice")
 		}
-		if second.R.ArchivedBy != &a {
+		if second.R.SignedOffBy != &a {
 			t.Error("relationship was not added properly to the foreign slice")
 		}
 
-		if a.R.ArchivedByProducts[i*2] != first {
+		if a.R.SignedOffByTillSessions[i*2] != first {
 			t.Error("relationship struct slice not set to correct value")
 		}
-		if a.R.ArchivedByProducts[i*2+1] != second {
+		if a.R.SignedOffByTillSessions[i*2+1] != second {
 			t.Error("relationship struct slice not set to correct value")
 		}
 
-		count, err := a.ArchivedByProducts().Count(ctx, tx)
+		count, err := a.SignedOffByTillSessions().Count(ctx, tx)
 		if err != nil {
 			t.Fatal(err)
 		}
@@ -1662,7 +7256,7 @@ func testUserToManyAddOpArchivedByProducts(t *testing.T) {
 	}
 }
 
-func testUserToManySetOpArchivedByProducts(t *testing.T) {
+func testUserToManySetOpSignedOffByTillSessions(t *testing.T) {
 	var err error
 
 	ctx := context.Background()
@@ -1670,15 +7264,15 @@ func testUserToManySetOpArchivedByProducts(t *testing.T) {
 	defer func() { _ = tx.Rollback() }()
 
 	var a User
-	var b, c, d, e Product
+	var b, c, d, e TillSession
 
 	seed := randomize.NewSeed()
 	if err = randomize.Struct(seed, &a, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
 		t.Fatal(err)
 	}
-	foreigners := []*Product{&b, &c, &d, &e}
+	foreigners := []*TillSession{&b, &c, &d, &e}
 	for _, x := range foreigners {
-		if err = randomize.Struct(seed, x, productDBTypes, false, strmangle.SetComplement(productPrimaryKeyColumns, productColumnsWithoutDefault)...); err != nil {
+		if err = randomize.Struct(seed, x, tillSessionDBTypes, false, strmangle.SetComplement(tillSessionPrimaryKeyColumns, tillSessionColumnsWithoutDefault)...); err != nil {
 			t.Fatal(err)
 		}
 	}
@@ -1693,12 +7287,12 @@ func testUserToManySetOpArchivedByProducts(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	err = a.SetArchivedByProducts(ctx, tx, false, &b, &c)
+	err = a.SetSignedOffByTillSessions(ctx, tx, false, &b, &c)
 	if err != nil {
 		t.Fatal(err)
 	}
 
-	count, err := a.ArchivedByProducts().Count(ctx, tx)
+	count, err := a.SignedOffByTillSessions().Count(ctx, tx)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -1706,12 +7300,12 @@ func testUserToManySetOpArchivedByProducts(t *testing.T) {
 		t.Error("count was wrong:", count)
 	}
 
-	err = a.SetArchivedByProducts(ctx, tx, true, &d, &e)
+	err = a.SetSignedOffByTillSessions(ctx, tx, true, &d, &e)
 	if err != nil {
 		t.Fatal(err)
 	}
 
-	count, err = a.ArchivedByProducts().Count(ctx, tx)
+	count, err = a.SignedOffByTillSessions().Count(ctx, tx)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -1719,41 +7313,41 @@ func testUserToManySetOpArchivedByProducts(t *testing.T) {
 		t.Error("count was wrong:", count)
 	}
 
-	if !queries.IsValuerNil(b.ArchivedByID) {
+	if !queries.IsValuerNil(b.SignedOffByID) {
 		t.Error("want b's foreign key value to be nil")
 	}
-	if !queries.IsValuerNil(c.ArchivedByID) {
+	if !queries.IsValuerNil(c.SignedOffByID) {
 		t.Error("want c's foreign key value to be nil")
 	}
-	if !queries.Equal(a.ID, d.ArchivedByID) {
-		t.Error("foreign key was wrong value", a.ID, d.ArchivedByID)
+	if !queries.Equal(a.ID, d.SignedOffByID) {
+		t.Error("foreign key was wrong value", a.ID, d.SignedOffByID)
 	}
-	if !queries.Equal(a.ID, e.ArchivedByID) {
-		t.Error("foreign key was wrong value", a.ID, e.ArchivedByID)
+	if !queries.Equal(a.ID, e.SignedOffByID) {
+		t.Error("foreign key was wrong value", a.ID, e.SignedOffByID)
 	}
 
-	if b.R.ArchivedBy != nil {
+	if b.R.SignedOffBy != nil {
 		t.Error("relationship was not removed properly from the foreign struct")
 	}
-	if c.R.ArchivedBy != nil {
+	if c.R.SignedOffBy != nil {
 		t.Error("relationship was not removed properly from the foreign struct")
 	}
-	if d.R.ArchivedBy != &a {
+	if d.R.SignedOffBy != &a {
 		t.Error("relationship was not added properly to the foreign struct")
 	}
-	if e.R.ArchivedBy != &a {
+	if e.R.SignedOffBy != &a {
 		t.Error("relationship was not added properly to the foreign struct")
 	}
 
-	if a.R.ArchivedByProducts[0] != &d {
+	if a.R.SignedOffByTillSessions[0] != &d {
 		t.Error("relationship struct slice not set to correct value")
 	}
-	if a.R.ArchivedByProducts[1] != &e {
+	if a.R.SignedOffByTillSessions[1] != &e {
 		t.Error("relationship struct slice not set to correct value")
 	}
 }
 
-func testUserToManyRemoveOpArchivedByProducts(t *testing.T) {
+func testUserToManyRemoveOpSignedOffByTillSessions(t *testing.T) {
 	var err error
 
 	ctx := context.Background()
@@ -1761,15 +7355,15 @@ func testUserToManyRemoveOpArchivedByProducts(t *testing.T) {
 	defer func() { _ = tx.Rollback() }()
 
 	var a User
-	var b, c, d, e Product
+	var b, c, d, e TillSession
 
 	seed := randomize.NewSeed()
 	if err = randomize.Struct(seed, &a, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
 		t.Fatal(err)
 	}
-	foreigners := []*Product{&b, &c, &d, &e}
+	foreigners := []*TillSession{&b, &c, &d, &e}
 	for _, x := range foreigners {
-		if err = randomize.Struct(seed, x, productDBTypes, false, strmangle.SetComplement(productPrimaryKeyColumns, productColumnsWithoutDefault)...); err != nil {
+		if err = randomize.Struct(seed, x, tillSessionDBTypes, false, strmangle.SetComplement(tillSessionPrimaryKeyColumns, tillSessionColumnsWithoutDefault)...); err != nil {
 			t.Fatal(err)
 		}
 	}
@@ -1778,12 +7372,12 @@ func testUserToManyRemoveOpArchivedByProducts(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	err = a.AddArchivedByProducts(ctx, tx, true, foreigners...)
+	err = a.AddSignedOffByTillSessions(ctx, tx, true, foreigners...)
 	if err != nil {
 		t.Fatal(err)
 	}
 
-	count, err := a.ArchivedByProducts().Count(ctx, tx)
+	count, err := a.SignedOffByTillSessions().Count(ctx, tx)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -1791,12 +7385,12 @@ func testUserToManyRemoveOpArchivedByProducts(t *testing.T) {
 		t.Error("count was wrong:", count)
 	}
 
-	err = a.RemoveArchivedByProducts(ctx, tx, foreigners[:2]...)
+	err = a.RemoveSignedOffByTillSessions(ctx, tx, foreigners[:2]...)
 	if err != nil {
 		t.Fatal(err)
 	}
 
-	count, err = a.ArchivedByProducts().Count(ctx, tx)
+	count, err = a.SignedOffByTillSessions().Count(ctx, tx)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -1804,190 +7398,40 @@ func testUserToManyRemoveOpArchivedByProducts(t *testing.T) {
 		t.Error("count was wrong:", count)
 	}
 
-	if !queries.IsValuerNil(b.ArchivedByID) {
+	if !queries.IsValuerNil(b.SignedOffByID) {
 		t.Error("want b's foreign key value to be nil")
 	}
-	if !queries.IsValuerNil(c.ArchivedByID) {
+	if !queries.IsValuerNil(c.SignedOffByID) {
 		t.Error("want c's foreign key value to be nil")
 	}
 
-	if b.R.ArchivedBy != nil {
+	if b.R.SignedOffBy != nil {
 		t.Error("relationship was not removed properly from the foreign struct")
 	}
-	if c.R.ArchivedBy != nil {
+	if c.R.SignedOffBy != nil {
 		t.Error("relationship was not removed properly from the foreign struct")
 	}
-	if d.R.ArchivedBy != &a {
+	if d.R.SignedOffBy != &a {
 		t.Error("relationship to a should have been preserved")
 	}
-	if e.R.ArchivedBy != &a {
+	if e.R.SignedOffBy != &a {
 		t.Error("relationship to a should have been preserved")
 	}
 
-	if len(a.R.ArchivedByProducts) != 2 {
+	if len(a.R.SignedOffByTillSessions) != 2 {
 		t.Error("should have preserved two relationships")
 	}
 
 	// Removal doesn't do a stable deletion for performance so we have to flip the order
-	if a.R.ArchivedByProducts[1] != &d {
+	if a.R.SignedOffByTillSessions[1] != &d {
 		t.Error("relationship to d should have been preserved")
 	}
-	if a.R.ArchivedByProducts[0] != &e {
+	if a.R.SignedOffByTillSessions[0] != &e {
 		t.Error("relationship to e should have been preserved")
 	}
 }
 
-func testUserToManyAddOpCreatedByProducts(t *testing.T) {
-	var err error
-
-	ctx := context.Background()
-	tx := MustTx(boil.BeginTx(ctx, nil))
-	defer func() { _ = tx.Rollback() }()
-
-	var a User
-	var b, c, d, e Product
-
-	seed := randomize.NewSeed()
-	if err = randomize.Struct(seed, &a, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
-		t.Fatal(err)
-	}
-	foreigners := []*Product{&b, &c, &d, &e}
-	for _, x := range foreigners {
-		if err = randomize.Struct(seed, x, productDBTypes, false, strmangle.SetComplement(productPrimaryKeyColumns, productColumnsWithoutDefault)...); err != nil {
-			t.Fatal(err)
-		}
-	}
-
-	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
-		t.Fatal(err)
-	}
-	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
-		t.Fatal(err)
-	}
-	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
-		t.Fatal(err)
-	}
-
-	foreignersSplitByInsertion := [][]*Product{
-		{&b, &c},
-		{&d, &e},
-	}
-
-	for i, x := range foreignersSplitByInsertion {
-		err = a.AddCreatedByProducts(ctx, tx, i != 0, x...)
-		if err != nil {
-			t.Fatal(err)
-		}
-
-		first := x[0]
-		second := x[1]
-
-		if a.ID != first.CreatedByID {
-			t.Error("foreign key was wrong value", a.ID, first.CreatedByID)
-		}
-		if a.ID != second.CreatedByID {
-			t.Error("foreign key was wrong value", a.ID, second.CreatedByID)
-		}
-
-		if first.R.CreatedBy != &a {
-			t.Error("relationship was not added properly to the foreign slice")
-		}
-		if second.R.CreatedBy != &a {
-			t.Error("relationship was not added properly to the foreign slice")
-		}
-
-		if a.R.CreatedByProducts[i*2] != first {
-			t.Error("relationship struct slice not set to correct value")
-		}
-		if a.R.CreatedByProducts[i*2+1] != second {
-			t.Error("relationship struct slice not set to correct value")
-		}
-
-		count, err := a.CreatedByProducts().Count(ctx, tx)
-		if err != nil {
-			t.Fatal(err)
-		}
-		if want := int64((i + 1) * 2); count != want {
-			t.Error("want", want, "got", count)
-		}
-	}
-}
-func testUserToManyAddOpUpdatedByProducts(t *testing.T) {
-	var err error
-
-	ctx := context.Background()
-	tx := MustTx(boil.BeginTx(ctx, nil))
-	defer func() { _ = tx.Rollback() }()
-
-	var a User
-	var b, c, d, e Product
-
-	seed := randomize.NewSeed()
-	if err = randomize.Struct(seed, &a, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
-		t.Fatal(err)
-	}
-	foreigners := []*Product{&b, &c, &d, &e}
-	for _, x := range foreigners {
-		if err = randomize.Struct(seed, x, productDBTypes, false, strmangle.SetComplement(productPrimaryKeyColumns, productColumnsWithoutDefault)...); err != nil {
-			t.Fatal(err)
-		}
-	}
-
-	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
-		t.Fatal(err)
-	}
-	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
-		t.Fatal(err)
-	}
-	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
-		t.Fatal(err)
-	}
-
-	foreignersSplitByInsertion := [][]*Product{
-		{&b, &c},
-		{&d, &e},
-	}
-
-	for i, x := range foreignersSplitByInsertion {
-		err = a.AddUpdatedByProducts(ctx, tx, i != 0, x...)
-		if err != nil {
-			t.Fatal(err)
-		}
-
-		first := x[0]
-		second := x[1]
-
-		if a.ID != first.UpdatedByID {
-			t.Error("foreign key was wrong value", a.ID, first.UpdatedByID)
-		}
-		if a.ID != second.UpdatedByID {
-			t.Error("foreign key was wrong value", a.ID, second.UpdatedByID)
-		}
-
-		if first.R.UpdatedBy != &a {
-			t.Error("relationship was not added properly to the foreign slice")
-		}
-		if second.R.UpdatedBy != &a {
-			t.Error("relationship was not added properly to the foreign slice")
-		}
-
-		if a.R.UpdatedByProducts[i*2] != first {
-			t.Error("relationship struct slice not set to correct value")
-		}
-		if a.R.UpdatedByProducts[i*2+1] != second {
-			t.Error("relationship struct slice not set to correct value")
-		}
-
-		count, err := a.UpdatedByProducts().Count(ctx, tx)
-		if err != nil {
-			t.Fatal(err)
-		}
-		if want := int64((i + 1) * 2); count != want {
-			t.Error("want", want, "got", count)
-		}
-	}
-}
-func testUserToManyAddOpSalesRepRepsExpenses(t *testing.T) {
+func testUserToManyAddOpSubmittedByTillSessions(t *testing.T) {
 	var err error
 
 	ctx := context.Background()
@@ -1995,15 +7439,15 @@ func testUserToManyAddOpSalesRepRepsExpenses(t *testing.T) {
 	defer func() { _ = tx.Rollback() }()
 
 	var a User
-	var b, c, d, e RepsExpense
+	var b, c, d, e TillSession
 
 	seed := randomize.NewSeed()
 	if err = randomize.Struct(seed, &a, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
 		t.Fatal(err)
 	}
-	foreigners := []*RepsExpense{&b, &c, &d, &e}
+	foreigners := []*TillSession{&b, &c, &d, &e}
 	for _, x := range foreigners {
-		if err = randomize.Struct(seed, x, repsExpenseDBTypes, false, strmangle.SetComplement(repsExpensePrimaryKeyColumns, repsExpenseColumnsWithoutDefault)...); err != nil {
+		if err = randomize.Struct(seed, x, tillSessionDBTypes, false, strmangle.SetComplement(tillSessionPrimaryKeyColumns, tillSessionColumnsWithoutDefault)...); err != nil {
 			t.Fatal(err)
 		}
 	}
@@ -2018,13 +7462,13 @@ func testUserToManyAddOpSalesRepRepsExpenses(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	foreignersSplitByInsertion := [][]*RepsExpense{
+	foreignersSplitByInsertion := [][]*TillSession{
 		{&b, &c},
 		{&d, &e},
 	}
 
 	for i, x := range foreignersSplitByInsertion {
-		err = a.AddSalesRepRepsExpenses(ctx, tx, i != 0, x...)
+		err = a.AddSubmittedByTillSessions(ctx, tx, i != 0, x...)
 		if err != nil {
 			t.Fatal(err)
 		}
@@ -2032,28 +7476,28 @@ func testUserToManyAddOpSalesRepRepsExpenses(t *testing.T) {
 		first := x[0]
 		second := x[1]
 
-		if a.ID != first.SalesRepID {
-			t.Error("foreign key was wrong value", a.ID, first.SalesRepID)
+		if a.ID != first.SubmittedByID {
+			t.Error("foreign key was wrong value", a.ID, first.SubmittedByID)
 		}
-		if a.ID != second.SalesRepID {
-			t.Error("foreign key was wrong value", a.ID, second.SalesRepID)
+		if a.ID != second.SubmittedByID {
+			t.Error("foreign key was wrong value", a.ID, second.SubmittedByID)
 		}
 
-		if first.R.SalesRep != &a {
+		if first.R.SubmittedBy != &a {
 			t.Error("relationship was not added properly to the foreign slice")
 		}
-		if second.R.SalesRep != &a {
+		if second.R.SubmittedBy != &a {
 			t.Error("relationship was not added properly to the foreign slice")
 		}
 
-		if a.R.SalesRepRepsExpenses[i*2] != first {
+		if a.R.SubmittedByTillSessions[i*2] != first {
 			t.Error("relationship struct slice not set to correct value")
 		}
-		if a.R.SalesRepRepsExpenses[i*2+1] != second {
+		if a.R.SubmittedByTillSessions[i*2+1] != second {
 			t.Error("relationship struct slice not set to correct value")
 		}
 
-		count, err := a.SalesRepRepsExpenses().Count(ctx, tx)
+		count, err := a.SubmittedByTillSessions().Count(ctx, tx)
 		if err != nil {
 			t.Fatal(err)
 		}
@@ -2062,7 +7506,7 @@ func testUserToManyAddOpSalesRepRepsExpenses(t *testing.T) {
 		}
 	}
 }
-func testUserToManyAddOpArchivedBySales(t *testing.T) {
+func testUserToManyAddOpApprovedByTransactions(t *testing.T) {
 	var err error
 
 	ctx := context.Background()
@@ -2070,15 +7514,15 @@ func testUserToManyAddOpArchivedBySales(t *testing.T) {
 	defer func() { _ = tx.Rollback() }()
 
 	var a User
-	var b, c, d, e Sale
+	var b, c, d, e Transaction
 
 	seed := randomize.NewSeed()
 	if err = randomize.Struct(seed, &a, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
 		t.Fatal(err)
 	}
-	foreigners := []*Sale{&b, &c, &d, &e}
+	foreigners := []*Transaction{&b, &c, &d, &e}
 	for _, x := range foreigners {
-		if err = randomize.Struct(seed, x, saleDBTypes, false, strmangle.SetComplement(salePrimaryKeyColumns, saleColumnsWithoutDefault)...); err != nil {
+		if err = randomize.Struct(seed, x, transactionDBTypes, false, strmangle.SetComplement(transactionPrimaryKeyColumns, transactionColumnsWithoutDefault)...); err != nil {
 			t.Fatal(err)
 		}
 	}
@@ -2093,13 +7537,13 @@ func testUserToManyAddOpArchivedBySales(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	foreignersSplitByInsertion := [][]*Sale{
+	foreignersSplitByInsertion := [][]*Transaction{
 		{&b, &c},
 		{&d, &e},
 	}
 
 	for i, x := range foreignersSplitByInsertion {
-		err = a.AddArchivedBySales(ctx, tx, i != 0, x...)
+		err = a.AddApprovedByTransactions(ctx, tx, i != 0, x...)
 		if err != nil {
 			t.Fatal(err)
 		}
@@ -2107,28 +7551,28 @@ func testUserToManyAddOpArchivedBySales(t *testing.T) {
 		first := x[0]
 		second := x[1]
 
-		if !queries.Equal(a.ID, first.ArchivedByID) {
-			t.Error("foreign key was wrong value", a.ID, first.ArchivedByID)
+		if !queries.Equal(a.ID, first.ApprovedByID) {
+			t.Error("foreign key was wrong value", a.ID, first.ApprovedByID)
 		}
-		if !queries.Equal(a.ID, second.ArchivedByID) {
-			t.Error("foreign key was wrong value", a.ID, second.ArchivedByID)
+		if !queries.Equal(a.ID, second.ApprovedByID) {
+			t.Error("foreign key was wrong value", a.ID, second.ApprovedByID)
 		}
 
-		if first.R.ArchivedBy != &a {
+		if first.R.ApprovedBy != &a {
 			t.Error("relationship was not added properly to the foreign slice")
 		}
-		if second.R.ArchivedBy != &a {
+		if second.R.ApprovedBy != &a {
 			t.Error("relationship was not added properly to the foreign slice")
 		}
 
-		if a.R.ArchivedBySales[i*2] != first {
+		if a.R.ApprovedByTransactions[i*2] != first {
 			t.Error("relationship struct slice not set to correct value")
 		}
-		if a.R.ArchivedBySales[i*2+1] != second {
+		if a.R.ApprovedByTransactions[i*2+1] != second {
 			t.Error("relationship struct slice not set to correct value")
 		}
 
-		count, err := a.ArchivedBySales().Count(ctx, tx)
+		count, err := a.ApprovedByTransactions().Count(ctx, tx)
 		if err != nil {
 			t.Fatal(err)
 		}
@@ -2138,7 +7582,7 @@ func testUserToManyAddOpArchivedBySales(t *testing.T) {
 	}
 }
 
-func testUserToManySetOpArchivedBySales(t *testing.T) {
+func testUserToManySetOpApprovedByTransactions(t *testing.T) {
 	var err error
 
 	ctx := context.Background()
@@ -2146,15 +7590,15 @@ func testUserToManySetOpArchivedBySales(t *testing.T) {
 	defer func() { _ = tx.Rollback() }()
 
 	var a User
-	var b, c, d, e Sale
+	var b, c, d, e Transaction
 
 	seed := randomize.NewSeed()
 	if err = randomize.Struct(seed, &a, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
 		t.Fatal(err)
 	}
-	foreigners := []*Sale{&b, &c, &d, &e}
+	foreigners := []*Transaction{&b, &c, &d, &e}
 	for _, x := range foreigners {
-		if err = randomize.Struct(seed, x, saleDBTypes, false, strmangle.SetComplement(salePrimaryKeyColumns, saleColumnsWithoutDefault)...); err != nil {
+		if err = randomize.Struct(seed, x, transactionDBTypes, false, strmangle.SetComplement(transactionPrimaryKeyColumns, transactionColumnsWithoutDefault)...); err != nil {
 			t.Fatal(err)
 		}
 	}
@@ -2169,12 +7613,12 @@ func testUserToManySetOpArchivedBySales(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	err = a.SetArchivedBySales(ctx, tx, false, &b, &c)
+	err = a.SetApprovedByTransactions(ctx, tx, false, &b, &c)
 	if err != nil {
 		t.Fatal(err)
 	}
 
-	count, err := a.ArchivedBySales().Count(ctx, tx)
+	count, err := a.ApprovedByTransactions().Count(ctx, tx)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -2182,12 +7626,12 @@ func testUserToManySetOpArchivedBySales(t *testing.T) {
 		t.Error("count was wrong:", count)
 	}
 
-	err = a.SetArchivedBySales(ctx, tx, true, &d, &e)
+	err = a.SetApprovedByTransactions(ctx, tx, true, &d, &e)
 	if err != nil {
 		t.Fatal(err)
 	}
 
-	count, err = a.ArchivedBySales().Count(ctx, tx)
+	count, err = a.ApprovedByTransactions().Count(ctx, tx)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -2195,41 +7639,41 @@ func testUserToManySetOpArchivedBySales(t *testing.T) {
 		t.Error("count was wrong:", count)
 	}
 
-	if !queries.IsValuerNil(b.ArchivedByID) {
+	if !queries.IsValuerNil(b.ApprovedByID) {
 		t.Error("want b's foreign key value to be nil")
 	}
-	if !queries.IsValuerNil(c.ArchivedByID) {
+	if !queries.IsValuerNil(c.ApprovedByID) {
 		t.Error("want c's foreign key value to be nil")
 	}
-	if !queries.Equal(a.ID, d.ArchivedByID) {
-		t.Error("foreign key was wrong value", a.ID, d.ArchivedByID)
+	if !queries.Equal(a.ID, d.ApprovedByID) {
+		t.Error("foreign key was wrong value", a.ID, d.ApprovedByID)
 	}
-	if !queries.Equal(a.ID, e.ArchivedByID) {
-		t.Error("foreign key was wrong value", a.ID, e.ArchivedByID)
+	if !queries.Equal(a.ID, e.ApprovedByID) {
+		t.Error("foreign key was wrong value", a.ID, e.ApprovedByID)
 	}
 
-	if b.R.ArchivedBy != nil {
+	if b.R.ApprovedBy != nil {
 		t.Error("relationship was not removed properly from the foreign struct")
 	}
-	if c.R.ArchivedBy != nil {
+	if c.R.ApprovedBy != nil {
 		t.Error("relationship was not removed properly from the foreign struct")
 	}
-	if d.R.ArchivedBy != &a {
+	if d.R.ApprovedBy != &a {
 		t.Error("relationship was not added properly to the foreign struct")
 	}
-	if e.R.ArchivedBy != &a {
+	if e.R.ApprovedBy != &a {
 		t.Error("relationship was not added properly to the foreign struct")
 	}
 
-	if a.R.ArchivedBySales[0] != &d {
+	if a.R.ApprovedByTransactions[0] != &d {
 		t.Error("relationship struct slice not set to correct value")
 	}
-	if a.R.ArchivedBySales[1] != &e {
+	if a.R.ApprovedByTransactions[1] != &e {
 		t.Error("relationship struct slice not set to correct value")
 	}
 }
 
-func testUserToManyRemoveOpArchivedBySales(t *testing.T) {
+func testUserToManyRemoveOpApprovedByTransactions(t *testing.T) {
 	var err error
 
 	ctx := context.Background()
@@ -2237,15 +7681,15 @@ func testUserToManyRemoveOpArchivedBySales(t *testing.T) {
 	defer func() { _ = tx.Rollback() }()
 
 	var a User
-	var b, c, d, e Sale
+	var b, c, d, e Transaction
 
 	seed := randomize.NewSeed()
 	if err = randomize.Struct(seed, &a, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
 		t.Fatal(err)
 	}
-	foreigners := []*Sale{&b, &c, &d, &e}
+	foreigners := []*Transaction{&b, &c, &d, &e}
 	for _, x := range foreigners {
-		if err = randomize.Struct(seed, x, saleDBTypes, false, strmangle.SetComplement(salePrimaryKeyColumns, saleColumnsWithoutDefault)...); err != nil {
+		if err = randomize.Struct(seed, x, transactionDBTypes, false, strmangle.SetComplement(transactionPrimaryKeyColumns, transactionColumnsWithoutDefault)...); err != nil {
 			t.Fatal(err)
 		}
 	}
@@ -2254,12 +7698,12 @@ func testUserToManyRemoveOpArchivedBySales(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	err = a.AddArchivedBySales(ctx, tx, true, foreigners...)
+	err = a.AddApprovedByTransactions(ctx, tx, true, foreigners...)
 	if err != nil {
 		t.Fatal(err)
 	}
 
-	count, err := a.ArchivedBySales().Count(ctx, tx)
+	count, err := a.ApprovedByTransactions().Count(ctx, tx)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -2267,12 +7711,12 @@ func testUserToManyRemoveOpArchivedBySales(t *testing.T) {
 		t.Error("count was wrong:", count)
 	}
 
-	err = a.RemoveArchivedBySales(ctx, tx, foreigners[:2]...)
+	err = a.RemoveApprovedByTransactions(ctx, tx, foreigners[:2]...)
 	if err != nil {
 		t.Fatal(err)
 	}
 
-	count, err = a.ArchivedBySales().Count(ctx, tx)
+	count, err = a.ApprovedByTransactions().Count(ctx, tx)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -2280,40 +7724,40 @@ func testUserToManyRemoveOpArchivedBySales(t *testing.T) {
 		t.Error("count was wrong:", count)
 	}
 
-	if !queries.IsValuerNil(b.ArchivedByID) {
+	if !queries.IsValuerNil(b.ApprovedByID) {
 		t.Error("want b's foreign key value to be nil")
 	}
-	if !queries.IsValuerNil(c.ArchivedByID) {
+	if !queries.IsValuerNil(c.ApprovedByID) {
 		t.Error("want c's foreign key value to be nil")
 	}
 
-	if b.R.ArchivedBy != nil {
+	if b.R.ApprovedBy != nil {
 		t.Error("relationship was not removed properly from the foreign struct")
 	}
-	if c.R.ArchivedBy != nil {
+	if c.R.ApprovedBy != nil {
 		t.Error("relationship was not removed properly from the foreign struct")
 	}
-	if d.R.ArchivedBy != &a {
+	if d.R.ApprovedBy != &a {
 		t.Error("relationship to a should have been preserved")
 	}
-	if e.R.ArchivedBy != &a {
+	if e.R.ApprovedBy != &a {
 		t.Error("relationship to a should have been preserved")
 	}
 
-	if len(a.R.ArchivedBySales) != 2 {
+	if len(a.R.ApprovedByTransactions) != 2 {
 		t.Error("should have preserved two relationships")
 	}
 
 	// Removal doesn't do a stable deletion for performance so we have to flip the order
-	if a.R.ArchivedBySales[1] != &d {
+	if a.R.ApprovedByTransactions[1] != &d {
 		t.Error("relationship to d should have been preserved")
 	}
-	if a.R.ArchivedBySales[0] != &e {
+	if a.R.ApprovedByTransactions[0] != &e {
 		t.Error("relationship to e should have been preserved")
 	}
 }
 
-func testUserToManyAddOpCreatedBySales(t *testing.T) {
+func testUserToManyAddOpSalesRepTransactions(t *testing.T) {
 	var err error
 
 	ctx := context.Background()
@@ -2321,15 +7765,15 @@ func testUserToManyAddOpCreatedBySales(t *testing.T) {
 	defer func() { _ = tx.Rollback() }()
 
 	var a User
-	var b, c, d, e Sale
+	var b, c, d, e Transaction
 
 	seed := randomize.NewSeed()
 	if err = randomize.Struct(seed, &a, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
 		t.Fatal(err)
 	}
-	foreigners := []*Sale{&b, &c, &d, &e}
+	foreigners := []*Transaction{&b, &c, &d, &e}
 	for _, x := range foreigners {
-		if err = randomize.Struct(seed, x, saleDBTypes, false, strmangle.SetComplement(salePrimaryKeyColumns, saleColumnsWithoutDefault)...); err != nil {
+		if err = randomize.Struct(seed, x, transactionDBTypes, false, strmangle.SetComplement(transactionPrimaryKeyColumns, transactionColumnsWithoutDefault)...); err != nil {
 			t.Fatal(err)
 		}
 	}
@@ -2344,13 +7788,13 @@ func testUserToManyAddOpCreatedBySales(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	foreignersSplitByInsertion := [][]*Sale{
+	foreignersSplitByInsertion := [][]*Transaction{
 		{&b, &c},
 		{&d, &e},
 	}
 
 	for i, x := range foreignersSplitByInsertion {
-		err = a.AddCreatedBySales(ctx, tx, i != 0, x...)
+		err = a.AddSalesRepTransactions(ctx, tx, i != 0, x...)
 		if err != nil {
 			t.Fatal(err)
 		}
@@ -2358,28 +7802,28 @@ func testUserToManyAddOpCreatedBySales(t *testing.T) {
 		first := x[0]
 		second := x[1]
 
-		if a.ID != first.CreatedByID {
-			t.Error("foreign key was wrong value", a.ID, first.CreatedByID)
+		if a.ID != first.SalesRepID {
+			t.Error("foreign key was wrong value", a.ID, first.SalesRepID)
 		}
-		if a.ID != second.CreatedByID {
-			t.Error("foreign key was wrong value", a.ID, second.CreatedByID)
+		if a.ID != second.SalesRepID {
+			t.Error("foreign key was wrong value", a.ID, second.SalesRepID)
 		}
 
-		if first.R.CreatedBy != &a {
+		if first.R.SalesRep != &a {
 			t.Error("relationship was not added properly to the foreign slice")
 		}
-		if second.R.CreatedBy != &a {
+		if second.R.SalesRep != &a {
 			t.Error("relationship was not added properly to the foreign slice")
 		}
 
-		if a.R.CreatedBySales[i*2] != first {
+		if a.R.SalesRepTransactions[i*2] != first {
 			t.Error("relationship struct slice not set to correct value")
 		}
-		if a.R.CreatedBySales[i*2+1] != second {
+		if a.R.SalesRepTransactions[i*2+1] != second {
 			t.Error("relationship struct slice not set to correct value")
 		}
 
-		count, err := a.CreatedBySales().Count(ctx, tx)
+		count, err := a.SalesRepTransactions().Count(ctx, tx)
 		if err != nil {
 			t.Fatal(err)
 		}
@@ -2388,7 +7832,7 @@ func testUserToManyAddOpCreatedBySales(t *testing.T) {
 		}
 	}
 }
-func testUserToManyAddOpUpdatedBySales(t *testing.T) {
+func testUserToManyAddOpSalesRepTransfers(t *testing.T) {
 	var err error
 
 	ctx := context.Background()
@@ -2396,15 +7840,15 @@ func testUserToManyAddOpUpdatedBySales(t *testing.T) {
 	defer func() { _ = tx.Rollback() }()
 
 	var a User
-	var b, c, d, e Sale
+	var b, c, d, e Transfer
 
 	seed := randomize.NewSeed()
 	if err = randomize.Struct(seed, &a, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
 		t.Fatal(err)
 	}
-	foreigners := []*Sale{&b, &c, &d, &e}
+	foreigners := []*Transfer{&b, &c, &d, &e}
 	for _, x := range foreigners {
-		if err = randomize.Struct(seed, x, saleDBTypes, false, strmangle.SetComplement(salePrimaryKeyColumns, saleColumnsWithoutDefault)...); err != nil {
+		if err = randomize.Struct(seed, x, transferDBTypes, false, strmangle.SetComplement(transferPrimaryKeyColumns, transferColumnsWithoutDefault)...); err != nil {
 			t.Fatal(err)
 		}
 	}
@@ -2419,13 +7863,13 @@ func testUserToManyAddOpUpdatedBySales(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	foreignersSplitByInsertion := [][]*Sale{
+	foreignersSplitByInsertion := [][]*Transfer{
 		{&b, &c},
 		{&d, &e},
 	}
 
 	for i, x := range foreignersSplitByInsertion {
-		err = a.AddUpdatedBySales(ctx, tx, i != 0, x...)
+		err = a.AddSalesRepTransfers(ctx, tx, i != 0, x...)
 		if err != nil {
 			t.Fatal(err)
 		}
@@ -2433,28 +7877,28 @@ func testUserToManyAddOpUpdatedBySales(t *testing.T) {
 		first := x[0]
 		second := x[1]
 
-		if !queries.Equal(a.ID, first.UpdatedByID) {
-			t.Error("foreign key was wrong value", a.ID, first.UpdatedByID)
+		if a.ID != first.SalesRepID {
+			t.Error("foreign key was wrong value", a.ID, first.SalesRepID)
 		}
-		if !queries.Equal(a.ID, second.UpdatedByID) {
-			t.Error("foreign key was wrong value", a.ID, second.UpdatedByID)
+		if a.ID != second.SalesRepID {
+			t.Error("foreign key was wrong value", a.ID, second.SalesRepID)
 		}
 
-		if first.R.UpdatedBy != &a {
+		if first.R.SalesRep != &a {
 			t.Error("relationship was not added properly to the foreign slice")
 		}
-		if second.R.UpdatedBy != &a {
+		if second.R.SalesRep != &a {
 			t.Error("relationship was not added properly to the foreign slice")
 		}
 
-		if a.R.UpdatedBySales[i*2] != first {
+		if a.R.SalesRepTransfers[i*2] != first {
 			t.Error("relationship struct slice not set to correct value")
 		}
-		if a.R.UpdatedBySales[i*2+1] != second {
+		if a.R.SalesRepTransfers[i*2+1] != second {
 			t.Error("relationship struct slice not set to correct value")
 		}
 
-		count, err := a.UpdatedBySales().Count(ctx, tx)
+		count, err := a.SalesRepTransfers().Count(ctx, tx)
 		if err != nil {
 			t.Fatal(err)
 		}
@@ -2463,8 +7907,83 @@ func testUserToManyAddOpUpdatedBySales(t *testing.T) {
 		}
 	}
 }
+func testUserToManyAddOpDecidedByWithdrawalRequests(t *testing.T) {
+	var err error
 
-func testUserToManySetOpUpdatedBySales(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a User
+	var b, c, d, e WithdrawalRequest
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*WithdrawalRequest{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, withdrawalRequestDBTypes, false, strmangle.SetComplement(withdrawalRequestPrimaryKeyColumns, withdrawalRequestColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	foreignersSplitByInsertion := [][]*WithdrawalRequest{
+		{&b, &c},
+		{&d, &e},
+	}
+
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddDecidedByWithdrawalRequests(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		first := x[0]
+		second := x[1]
+
+		if !queries.Equal(a.ID, first.DecidedByID) {
+			t.Error("foreign key was wrong value", a.ID, first.DecidedByID)
+		}
+		if !queries.Equal(a.ID, second.DecidedByID) {
+			t.Error("foreign key was wrong value", a.ID, second.DecidedByID)
+		}
+
+		if first.R.DecidedBy != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.DecidedBy != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+
+		if a.R.DecidedByWithdrawalRequests[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.DecidedByWithdrawalRequests[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
+
+		count, err := a.DecidedByWithdrawalRequests().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
+	}
+}
+
+func testUserToManySetOpDecidedByWithdrawalRequests(t *testing.T) {
 	var err error
 
 	ctx := context.Background()
@@ -2472,15 +7991,15 @@ func testUserToManySetOpUpdatedBySales(t *testing.T) {
 	defer func() { _ = tx.Rollback() }()
 
 	var a User
-	var b, c, d, e Sale
+	var b, c, d, e WithdrawalRequest
 
 	seed := randomize.NewSeed()
 	if err = randomize.Struct(seed, &a, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
 		t.Fatal(err)
 	}
-	foreigners := []*Sale{&b, &c, &d, &e}
+	foreigners := []*WithdrawalRequest{&b, &c, &d, &e}
 	for _, x := range foreigners {
-		if err = randomize.Struct(seed, x, saleDBTypes, false, strmangle.SetComplement(salePrimaryKeyColumns, saleColumnsWithoutDefault)...); err != nil {
+		if err = randomize.Struct(seed, x, withdrawalRequestDBTypes, false, strmangle.SetComplement(withdrawalRequestPrimaryKeyColumns, withdrawalRequestColumnsWithoutDefault)...); err != nil {
 			t.Fatal(err)
 		}
 	}
@@ -2495,12 +8014,12 @@ func testUserToManySetOpUpdatedBySales(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	err = a.SetUpdatedBySales(ctx, tx, false, &b, &c)
+	err = a.SetDecidedByWithdrawalRequests(ctx, tx, false, &b, &c)
 	if err != nil {
 		t.Fatal(err)
 	}
 
-	count, err := a.UpdatedBySales().Count(ctx, tx)
+	count, err := a.DecidedByWithdrawalRequests().Count(ctx, tx)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -2508,12 +8027,12 @@ func testUserToManySetOpUpdatedBySales(t *testing.T) {
 		t.Error("count was wrong:", count)
 	}
 
-	err = a.SetUpdatedBySales(ctx, tx, true, &d, &e)
+	err = a.SetDecidedByWithdrawalRequests(ctx, tx, true, &d, &e)
 	if err != nil {
 		t.Fatal(err)
 	}
 
-	count, err = a.UpdatedBySales().Count(ctx, tx)
+	count, err = a.DecidedByWithdrawalRequests().Count(ctx, tx)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -2521,41 +8040,41 @@ func testUserToManySetOpUpdatedBySales(t *testing.T) {
 		t.Error("count was wrong:", count)
 	}
 
-	if !queries.IsValuerNil(b.UpdatedByID) {
+	if !queries.IsValuerNil(b.DecidedByID) {
 		t.Error("want b's foreign key value to be nil")
 	}
-	if !queries.IsValuerNil(c.UpdatedByID) {
+	if !queries.IsValuerNil(c.DecidedByID) {
 		t.Error("want c's foreign key value to be nil")
 	}
-	if !queries.Equal(a.ID, d.UpdatedByID) {
-		t.Error("foreign key was wrong value", a.ID, d.UpdatedByID)
+	if !queries.Equal(a.ID, d.DecidedByID) {
+		t.Error("foreign key was wrong value", a.ID, d.DecidedByID)
 	}
-	if !queries.Equal(a.ID, e.UpdatedByID) {
-		t.Error("foreign key was wrong value", a.ID, e.UpdatedByID)
+	if !queries.Equal(a.ID, e.DecidedByID) {
+		t.Error("foreign key was wrong value", a.ID, e.DecidedByID)
 	}
 
-	if b.R.UpdatedBy != nil {
+	if b.R.DecidedBy != nil {
 		t.Error("relationship was not removed properly from the foreign struct")
 	}
-	if c.R.UpdatedBy != nil {
+	if c.R.DecidedBy != nil {
 		t.Error("relationship was not removed properly from the foreign struct")
 	}
-	if d.R.UpdatedBy != &a {
+	if d.R.DecidedBy != &a {
 		t.Error("relationship was not added properly to the foreign struct")
 	}
-	if e.R.UpdatedBy != &a {
+	if e.R.DecidedBy != &a {
 		t.Error("relationship was not added properly to the foreign struct")
 	}
 
-	if a.R.UpdatedBySales[0] != &d {
+	if a.R.DecidedByWithdrawalRequests[0] != &d {
 		t.Error("relationship struct slice not set to correct value")
 	}
-	if a.R.UpdatedBySales[1] != &e {
+	if a.R.DecidedByWithdrawalRequests[1] != &e {
 		t.Error("relationship struct slice not set to correct value")
 	}
 }
 
-func testUserToManyRemoveOpUpdatedBySales(t *testing.T) {
+func testUserToManyRemoveOpDecidedByWithdrawalRequests(t *testing.T) {
 	var err error
 
 	ctx := context.Background()
@@ -2563,15 +8082,15 @@ func testUserToManyRemoveOpUpdatedBySales(t *testing.T) {
 	defer func() { _ = tx.Rollback() }()
 
 	var a User
-	var b, c, d, e Sale
+	var b, c, d, e WithdrawalRequest
 
 	seed := randomize.NewSeed()
 	if err = randomize.Struct(seed, &a, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
 		t.Fatal(err)
 	}
-	foreigners := []*Sale{&b, &c, &d, &e}
+	foreigners := []*WithdrawalRequest{&b, &c, &d, &e}
 	for _, x := range foreigners {
-		if err = randomize.Struct(seed, x, saleDBTypes, false, strmangle.SetComplement(salePrimaryKeyColumns, saleColumnsWithoutDefault)...); err != nil {
+		if err = randomize.Struct(seed, x, withdrawalRequestDBTypes, false, strmangle.SetComplement(withdrawalRequestPrimaryKeyColumns, withdrawalRequestColumnsWithoutDefault)...); err != nil {
 			t.Fatal(err)
 		}
 	}
@@ -2580,12 +8099,12 @@ func testUserToManyRemoveOpUpdatedBySales(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	err = a.AddUpdatedBySales(ctx, tx, true, foreigners...)
+	err = a.AddDecidedByWithdrawalRequests(ctx, tx, true, foreigners...)
 	if err != nil {
 		t.Fatal(err)
 	}
 
-	count, err := a.UpdatedBySales().Count(ctx, tx)
+	count, err := a.DecidedByWithdrawalRequests().Count(ctx, tx)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -2593,12 +8112,12 @@ func testUserToManyRemoveOpUpdatedBySales(t *testing.T) {
 		t.Error("count was wrong:", count)
 	}
 
-	err = a.RemoveUpdatedBySales(ctx, tx, foreigners[:2]...)
+	err = a.RemoveDecidedByWithdrawalRequests(ctx, tx, foreigners[:2]...)
 	if err != nil {
 		t.Fatal(err)
 	}
 
-	count, err = a.UpdatedBySales().Count(ctx, tx)
+	count, err = a.DecidedByWithdrawalRequests().Count(ctx, tx)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -2606,114 +8125,39 @@ func testUserToManyRemoveOpUpdatedBySales(t *testing.T) {
 		t.Error("count was wrong:", count)
 	}
 
-	if !queries.IsValuerNil(b.UpdatedByID) {
+	if !queries.IsValuerNil(b.DecidedByID) {
 		t.Error("want b's foreign key value to be nil")
 	}
-	if !queries.IsValuerNil(c.UpdatedByID) {
+	if !queries.IsValuerNil(c.DecidedByID) {
 		t.Error("want c's foreign key value to be nil")
 	}
 
-	if b.R.UpdatedBy != nil {
+	if b.R.DecidedBy != nil {
 		t.Error("relationship was not removed properly from the foreign struct")
 	}
-	if c.R.UpdatedBy != nil {
+	if c.R.DecidedBy != nil {
 		t.Error("relationship was not removed properly from the foreign struct")
 	}
-	if d.R.UpdatedBy != &a {
+	if d.R.DecidedBy != &a {
 		t.Error("relationship to a should have been preserved")
 	}
-	if e.R.UpdatedBy != &a {
+	if e.R.DecidedBy != &a {
 		t.Error("relationship to a should have been preserved")
 	}
 
-	if len(a.R.UpdatedBySales) != 2 {
+	if len(a.R.DecidedByWithdrawalRequests) != 2 {
 		t.Error("should have preserved two relationships")
 	}
 
 	// Removal doesn't do a stable deletion for performance so we have to flip the order
-	if a.R.UpdatedBySales[1] != &d {
+	if a.R.DecidedByWithdrawalRequests[1] != &d {
 		t.Error("relationship to d should have been preserved")
 	}
-	if a.R.UpdatedBySales[0] != &e {
+	if a.R.DecidedByWithdrawalRequests[0] != &e {
 		t.Error("relationship to e should have been preserved")
 	}
 }
 
-func testUserToManyAddOpSalesRepTransactions(t *testing.T) {
-	var err error
-
-	ctx := context.Background()
-	tx := MustTx(boil.BeginTx(ctx, nil))
-	defer func() { _ = tx.Rollback() }()
-
-	var a User
-	var b, c, d, e Transaction
-
-	seed := randomize.NewSeed()
-	if err = randomize.Struct(seed, &a, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
-		t.Fatal(err)
-	}
-	foreigners := []*Transaction{&b, &c, &d, &e}
-	for _, x := range foreigners {
-		if err = randomize.Struct(seed, x, transactionDBTypes, false, strmangle.SetComplement(transactionPrimaryKeyColumns, transactionColumnsWithoutDefault)...); err != nil {
-			t.Fatal(err)
-		}
-	}
-
-	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
-		t.Fatal(err)
-	}
-	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
-		t.Fatal(err)
-	}
-	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
-		t.Fatal(err)
-	}
-
-	foreignersSplitByInsertion := [][]*Transaction{
-		{&b, &c},
-		{&d, &e},
-	}
-
-	for i, x := range foreignersSplitByInsertion {
-		err = a.AddSalesRepTransactions(ctx, tx, i != 0, x...)
-		if err != nil {
-			t.Fatal(err)
-		}
-
-		first := x[0]
-		second := x[1]
-
-		if a.ID != first.SalesRepID {
-			t.Error("foreign key was wrong value", a.ID, first.SalesRepID)
-		}
-		if a.ID != second.SalesRepID {
-			t.Error("foreign key was wrong value", a.ID, second.SalesRepID)
-		}
-
-		if first.R.SalesRep != &a {
-			t.Error("relationship was not added properly to the foreign slice")
-		}
-		if second.R.SalesRep != &a {
-			t.Error("relationship was not added properly to the foreign slice")
-		}
-
-		if a.R.SalesRepTransactions[i*2] != first {
-			t.Error("relationship struct slice not set to correct value")
-		}
-		if a.R.SalesRepTransactions[i*2+1] != second {
-			t.Error("relationship struct slice not set to correct value")
-		}
-
-		count, err := a.SalesRepTransactions().Count(ctx, tx)
-		if err != nil {
-			t.Fatal(err)
-		}
-		if want := int64((i + 1) * 2); count != want {
-			t.Error("want", want, "got", count)
-		}
-	}
-}
 func testUserToOneBranchUsingBranch(t *testing.T) {
 	ctx := context.Background()
 	tx := MustTx(boil.BeginTx(ctx, nil))
@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"merryworld/surebank/internal/platform/auth"
+	"merryworld/surebank/internal/platform/money"
 	"merryworld/surebank/internal/platform/web"
 	"merryworld/surebank/internal/platform/web/webcontext"
 	"merryworld/surebank/internal/postgres/models"
@@ -41,19 +42,19 @@ func NewRepository(db *sqlx.DB) *Repository {
 
 // Profit
 type Profit struct {
-	ID         string    `json:"id"`
-	Amount     float64   `json:"price"`
-	Narration  string    `json:"image"`
-	CreatedAt  time.Time `json:"created_at"`
-	UpdatedAt  time.Time `json:"updated_at"`
-	ArchivedAt null.Time `json:"archived_at"`
+	ID         string       `json:"id"`
+	Amount     money.Amount `json:"price"`
+	Narration  string       `json:"image"`
+	CreatedAt  time.Time    `json:"created_at"`
+	UpdatedAt  time.Time    `json:"updated_at"`
+	ArchivedAt null.Time    `json:"archived_at"`
 }
 
 func (m Profit) ToModel() models.Profit {
 	return models.Profit{
 		ID:         m.ID,
 		Narration:  m.Narration,
-		Amount:     m.Amount,
+		Amount:     m.Amount.Kobo(),
 		CreatedAt:  m.CreatedAt.Unix(),
 		UpdatedAt:  m.UpdatedAt.Unix(),
 		ArchivedAt: null.Int64From(m.ArchivedAt.Time.Unix()),
@@ -63,7 +64,7 @@ func (m Profit) ToModel() models.Profit {
 func ProfitFromModel(profit *models.Profit) *Profit {
 	p := &Profit{
 		ID:        profit.ID,
-		Amount:    profit.Amount,
+		Amount:    money.Amount(profit.Amount),
 		Narration: profit.Narration,
 		CreatedAt: time.Unix(profit.CreatedAt, 0),
 		UpdatedAt: time.Unix(profit.UpdatedAt, 0),
@@ -77,9 +78,9 @@ func ProfitFromModel(profit *models.Profit) *Profit {
 
 // ProfitResponse represent a Profit that is returned for display
 type ProfitResponse struct {
-	ID        string  `json:"id"`
-	Narration string  `json:"narration"`
-	Amount    float64 `json:"amount"`
+	ID        string       `json:"id"`
+	Narration string       `json:"narration"`
+	Amount    money.Amount `json:"amount"`
 
 	CreatedAt web.TimeResponse `json:"created_at"`
 	UpdatedAt web.TimeResponse `json:"updated_at"`
@@ -119,14 +120,14 @@ func (m *Profits) Response(ctx context.Context) []*ProfitResponse {
 
 // ProfitCreateRequest contains information needed to create a new Profit.
 type ProfitCreateRequest struct {
-	Narration string  `json:"narration" validate:"required,unique"  example:"Bread"`
-	Amount    float64 `json:"amount" validate:"required"`
+	Narration string       `json:"narration" validate:"required,unique"  example:"Bread"`
+	Amount    money.Amount `json:"amount" validate:"required"`
 }
 
 type ProfitUpdateRequest struct {
-	ID        string   `json:"id"`
-	Amount    *float64 `json:"amount"`
-	Narration *string  `json:"narration"`
+	ID        string        `json:"id"`
+	Amount    *money.Amount `json:"amount"`
+	Narration *string       `json:"narration"`
 }
 
 // ProfitReadRequest defines the information needed to read a Profit.
@@ -221,6 +222,13 @@ func (repo *Repository) ReadProfitByIDTx(ctx context.Context, _ auth.Claims, id
 }
 
 func (repo *Repository) CreateProfit(ctx context.Context, claims auth.Claims, req ProfitCreateRequest, now time.Time) (*Profit, error) {
+	if claims.Audience == "" {
+		return nil, errors.WithStack(ErrForbidden)
+	}
+
+	if !claims.HasRole(auth.RoleAdmin) {
+		return nil, errors.WithStack(ErrForbidden)
+	}
 
 	tx, err := repo.DbConn.Begin()
 	if err != nil {
@@ -240,16 +248,11 @@ func (repo *Repository) CreateProfit(ctx context.Context, claims auth.Claims, re
 	return s, nil
 }
 
+// CreateProfitTx records the profit made by a posting within its db transaction. The posting
+// checks the claims, so reps and the daily jobs can record the profit of what they post.
 func (repo *Repository) CreateProfitTx(ctx context.Context, tx *sql.Tx, claims auth.Claims, req ProfitCreateRequest, now time.Time) (*Profit, error) {
 	span, ctx := tracer.StartSpanFromContext(ctx, "internal.shop.CreateProfit")
 	defer span.Finish()
-	if claims.Audience == "" {
-		return nil, errors.WithStack(ErrForbidden)
-	}
-
-	if !claims.HasRole(auth.RoleAdmin) {
-		return nil, errors.WithStack(ErrForbidden)
-	}
 
 	// If now empty set it to the current time.
 	if now.IsZero() {
@@ -304,7 +307,7 @@ func (repo *Repository) UpdateProfit(ctx context.Context, claims auth.Claims, re
 	}
 
 	if req.Amount != nil {
-		cols[models.ProfitColumns.Amount] = *req.Amount
+		cols[models.ProfitColumns.Amount] = req.Amount.Kobo()
 	}
 
 	// If now empty set it to the current time.
@@ -0,0 +1,451 @@
+package sale
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/jinzhu/now"
+	"github.com/pkg/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	. "github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"gopkg.in/DataDog/dd-trace-go.v1/ddtrace/tracer"
+
+	"merryworld/surebank/internal/account"
+	"merryworld/surebank/internal/account_product"
+	"merryworld/surebank/internal/customer"
+	"merryworld/surebank/internal/ledger"
+	"merryworld/surebank/internal/platform/auth"
+	"merryworld/surebank/internal/platform/money"
+	"merryworld/surebank/internal/platform/web"
+	"merryworld/surebank/internal/platform/web/webcontext"
+	"merryworld/surebank/internal/platform/web/weberror"
+	"merryworld/surebank/internal/postgres/models"
+	"merryworld/surebank/internal/transaction"
+)
+
+var (
+	// ErrCreditTerm occurs when a credit sale leaves a balance without the term to collect it over.
+	ErrCreditTerm = errors.New("A term in days is required to collect the balance of a credit sale")
+
+	// ErrCreditAccount occurs when the balance of a credit sale cannot be collected on the account given.
+	ErrCreditAccount = errors.New("The balance can only be collected on a daily contribution account of the buyer that is not collecting another sale")
+
+	// ErrNothingToCollect occurs when a sale has no balance or its credit account has no funds to collect.
+	ErrNothingToCollect = errors.New("There is nothing to collect on the sale")
+)
+
+// AvailableFunds returns what can be taken from an account of the product with the balance. Fixed
+// savings accounts that have not matured and accounts that do not allow withdrawals have none.
+func AvailableFunds(balance money.Amount, product *account_product.Product, maturity *time.Time, currentDate time.Time) money.Amount {
+	if product == nil || !product.WithdrawalsAllowed {
+		return 0
+	}
+	if maturity != nil && currentDate.Before(*maturity) {
+		return 0
+	}
+	if available := balance - product.MinBalance; available > 0 {
+		return available
+	}
+	return 0
+}
+
+// CreditDailyTarget returns the daily contribution that collects the balance of a credit sale over
+// the term, rounded up to the naira so the contributions cover it.
+func CreditDailyTarget(balance money.Amount, termDays int) money.Amount {
+	if balance <= 0 || termDays <= 0 {
+		return 0
+	}
+	days := int64(termDays)
+	perDay := (balance.Kobo() + days - 1) / days
+	return money.Amount((perDay + 99) / 100 * 100)
+}
+
+// CreditDueDate returns the day the balance of a credit sale made at createdAt should be collected by.
+func CreditDueDate(createdAt time.Time, termDays int) time.Time {
+	return now.New(createdAt).BeginningOfDay().AddDate(0, 0, termDays)
+}
+
+// DaysOverdue returns the number of whole days the current date is past the due date.
+func DaysOverdue(dueDate, currentDate time.Time) int {
+	days := int(now.New(currentDate).BeginningOfDay().Sub(now.New(dueDate).BeginningOfDay()).Hours() / 24)
+	if days < 0 {
+		return 0
+	}
+	return days
+}
+
+// payOnCredit takes what it can of the sale amount from the wallet account of the buyer and sets
+// up a daily contribution account to collect the rest on. It returns the amount paid and the
+// account the balance is collected on, empty when the wallet paid in full.
+func (repo *Repository) payOnCredit(ctx context.Context, claims auth.Claims, req MakeSalesRequest, amount money.Amount,
+	receiptNumber, saleID string, currentDate time.Time, tx *sql.Tx) (money.Amount, string, error) {
+
+	if req.AccountNumber == "" {
+		return 0, "", weberror.NewError(ctx, errors.New("You must specify the buyer's account number to sell on credit"), http.StatusBadRequest)
+	}
+
+	wallet, err := models.Accounts(
+		models.AccountWhere.Number.EQ(req.AccountNumber),
+		models.AccountWhere.ArchivedAt.IsNull(),
+		Load(models.AccountRels.Product),
+	).One(ctx, tx)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return 0, "", weberror.NewErrorMessage(ctx, err, http.StatusBadRequest, "invalid account number")
+		}
+		return 0, "", err
+	}
+
+	balance, err := repo.TransactionRepo.AccountBalanceTx(ctx, wallet.ID, tx)
+	if err != nil {
+		return 0, "", err
+	}
+	var maturity *time.Time
+	if wallet.MaturityDate.Valid {
+		m := time.Unix(wallet.MaturityDate.Int64, 0)
+		maturity = &m
+	}
+
+	held, err := account.HeldAmount(ctx, tx, wallet.ID, currentDate)
+	if err != nil {
+		return 0, "", err
+	}
+
+	paid := AvailableFunds(account.AvailableBalance(balance, held), account_product.FromModel(wallet.R.Product), maturity, currentDate)
+	if paid > amount {
+		paid = amount
+	}
+	if paid > 0 {
+		if _, err = repo.TransactionRepo.MakeDeduction(ctx, claims, transaction.MakeDeductionRequest{
+			AccountNumber: wallet.Number,
+			Amount:        paid,
+			Narration:     fmt.Sprintf("sale:%s:%s", receiptNumber, saleID),
+			LedgerAccount: ledger.AccountSalesRevenue,
+		}, currentDate, tx); err != nil {
+			return 0, "", err
+		}
+	}
+
+	remainder := amount - paid
+	if remainder <= 0 {
+		return paid, "", nil
+	}
+	if req.CreditTermDays <= 0 {
+		return 0, "", weberror.NewError(ctx, ErrCreditTerm, http.StatusBadRequest)
+	}
+
+	target := CreditDailyTarget(remainder, req.CreditTermDays)
+	targetInfo := fmt.Sprintf("Balance of sale %s", receiptNumber)
+
+	var creditAccountID string
+	if req.CreditAccountNumber != "" {
+		acc, err := models.Accounts(
+			models.AccountWhere.Number.EQ(req.CreditAccountNumber),
+			models.AccountWhere.ArchivedAt.IsNull(),
+			Load(models.AccountRels.Product),
+			For("UPDATE"),
+		).One(ctx, tx)
+		if err != nil {
+			if errors.Cause(err) == sql.ErrNoRows {
+				return 0, "", weberror.NewError(ctx, ErrCreditAccount, http.StatusBadRequest)
+			}
+			return 0, "", err
+		}
+		if acc.CustomerID != wallet.CustomerID || !acc.R.Product.DailyContribution {
+			return 0, "", weberror.NewError(ctx, ErrCreditAccount, http.StatusBadRequest)
+		}
+
+		collecting, err := models.Sales(
+			models.SaleWhere.CreditAccountID.EQ(null.StringFrom(acc.ID)),
+			models.SaleWhere.Status.EQ(Status_PartiallyPaid),
+		).Exists(ctx, tx)
+		if err != nil {
+			return 0, "", err
+		}
+		if collecting {
+			return 0, "", weberror.NewError(ctx, ErrCreditAccount, http.StatusBadRequest)
+		}
+
+		acc.Target = target.Kobo()
+		acc.TargetInfo = targetInfo
+		acc.UpdatedAt = currentDate.Unix()
+		if _, err = acc.Update(ctx, tx, boil.Whitelist(models.AccountColumns.Target,
+			models.AccountColumns.TargetInfo, models.AccountColumns.UpdatedAt)); err != nil {
+			return 0, "", errors.WithMessage(err, "Cannot update the target of the credit account")
+		}
+		creditAccountID = acc.ID
+	} else {
+		acc, err := repo.AccountRepo.CreateTx(ctx, claims, account.CreateRequest{
+			CustomerID: wallet.CustomerID,
+			Type:       customer.AccountTypeDS,
+			Target:     target,
+			TargetInfo: targetInfo,
+		}, currentDate, tx)
+		if err != nil {
+			return 0, "", err
+		}
+		creditAccountID = acc.ID
+	}
+
+	if _, err = repo.LedgerRepo.PostTx(ctx, claims, ledger.PostRequest{
+		Reference:  receiptNumber,
+		Narration:  fmt.Sprintf("Credit sale %s", receiptNumber),
+		SourceType: ledger.SourceSale,
+		SourceID:   saleID,
+		Lines: []ledger.Line{
+			ledger.Debit(ledger.AccountSalesReceivables, remainder),
+			ledger.Credit(ledger.AccountSalesRevenue, remainder),
+		},
+	}, currentDate, tx); err != nil {
+		return 0, "", weberror.WithMessage(ctx, err, "Cannot post credit sale to the ledger")
+	}
+
+	return paid, creditAccountID, nil
+}
+
+// Collect takes what the credit account of a sale holds towards the balance of the sale. The sale
+// is marked paid once the balance has been collected in full.
+func (repo *Repository) Collect(ctx context.Context, claims auth.Claims, req CollectRequest, currentDate time.Time) (*Sale, error) {
+	span, ctx := tracer.StartSpanFromContext(ctx, "internal.sale.Collect")
+	defer span.Finish()
+
+	if claims.Audience == "" {
+		return nil, errors.WithStack(ErrForbidden)
+	}
+
+	// Validate the request.
+	v := webcontext.Validator()
+	err := v.Struct(req)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := repo.DbConn.Begin()
+	if err != nil {
+		return nil, err
+	}
+
+	collected, _, err := repo.collect(ctx, claims, req.ID, currentDate, tx)
+	if err != nil {
+		_ = tx.Rollback()
+		return nil, err
+	}
+	if collected <= 0 {
+		_ = tx.Rollback()
+		return nil, weberror.NewError(ctx, ErrNothingToCollect, http.StatusBadRequest)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return repo.ReadByID(ctx, claims, req.ID)
+}
+
+// collect deducts what can be taken from the credit account of the sale up to its balance within
+// the db transaction. It returns the amount collected and whether the sale is now paid.
+func (repo *Repository) collect(ctx context.Context, claims auth.Claims, saleID string, currentDate time.Time,
+	tx *sql.Tx) (money.Amount, bool, error) {
+
+	// If now empty set it to the current time.
+	if currentDate.IsZero() {
+		currentDate = time.Now()
+	}
+
+	// Always store the time as UTC.
+	currentDate = currentDate.UTC()
+	// Postgres truncates times to milliseconds when storing. We and do the same
+	// here so the value we return is consistent with what we store.
+	currentDate = currentDate.Truncate(time.Millisecond)
+
+	m, err := models.Sales(
+		models.SaleWhere.ID.EQ(saleID),
+		For("UPDATE"),
+	).One(ctx, tx)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return 0, false, weberror.NewError(ctx, ErrNotFound, http.StatusNotFound)
+		}
+		return 0, false, err
+	}
+	if m.Status != Status_PartiallyPaid || !m.CreditAccountID.Valid {
+		return 0, false, nil
+	}
+
+	acc, err := models.Accounts(
+		models.AccountWhere.ID.EQ(m.CreditAccountID.String),
+		Load(models.AccountRels.Product),
+	).One(ctx, tx)
+	if err != nil {
+		return 0, false, err
+	}
+
+	balance, err := repo.TransactionRepo.AccountBalanceTx(ctx, acc.ID, tx)
+	if err != nil {
+		return 0, false, err
+	}
+
+	held, err := account.HeldAmount(ctx, tx, acc.ID, currentDate)
+	if err != nil {
+		return 0, false, err
+	}
+
+	outstanding := money.Amount(m.Amount - m.AmountPaid)
+	amount := AvailableFunds(account.AvailableBalance(balance, held), account_product.FromModel(acc.R.Product), nil, currentDate)
+	if amount > outstanding {
+		amount = outstanding
+	}
+	if amount <= 0 {
+		return 0, false, nil
+	}
+
+	if _, err = repo.TransactionRepo.MakeDeduction(ctx, claims, transaction.MakeDeductionRequest{
+		AccountNumber: acc.Number,
+		Amount:        amount,
+		Narration:     fmt.Sprintf("sale:%s:%s", m.ReceiptNumber, m.ID),
+		LedgerAccount: ledger.AccountSalesReceivables,
+	}, currentDate, tx); err != nil {
+		return 0, false, err
+	}
+
+	if err = transaction.SaveDailySummary(ctx, amount, 0, 0, currentDate, tx); err != nil {
+		return 0, false, err
+	}
+
+	m.AmountPaid += amount.Kobo()
+	m.UpdatedAt = currentDate.Unix()
+	m.UpdatedByID = null.StringFrom(claims.Subject)
+	cleared := m.AmountPaid >= m.Amount
+	if cleared {
+		m.Status = Status_Paid
+		m.ClearedAt = null.Int64From(currentDate.Unix())
+	}
+	if _, err = m.Update(ctx, tx, boil.Whitelist(models.SaleColumns.AmountPaid, models.SaleColumns.Status,
+		models.SaleColumns.ClearedAt, models.SaleColumns.UpdatedAt, models.SaleColumns.UpdatedByID)); err != nil {
+		return 0, false, errors.WithMessage(err, "Cannot update sale")
+	}
+
+	return amount, cleared, nil
+}
+
+// CollectDue is the daily credit sales job. The credit account of every sale that has not been
+// paid in full is emptied towards its balance. Each sale is collected in its own db transaction
+// so the job can safely be run again for the same date.
+func (repo *Repository) CollectDue(ctx context.Context, req CollectDueRequest, currentDate time.Time) (*CollectReport, error) {
+	span, ctx := tracer.StartSpanFromContext(ctx, "internal.sale.CollectDue")
+	defer span.Finish()
+
+	// Validate the request.
+	v := webcontext.Validator()
+	if err := v.Struct(req); err != nil {
+		return nil, err
+	}
+
+	report := &CollectReport{Date: now.New(req.Date).BeginningOfDay()}
+
+	sales, err := models.Sales(
+		Select(models.SaleColumns.ID, models.SaleColumns.CreditAccountID),
+		Load(models.SaleRels.CreditAccount),
+		models.SaleWhere.Status.EQ(Status_PartiallyPaid),
+		models.SaleWhere.ArchivedAt.IsNull(),
+		OrderBy(models.SaleColumns.CreatedAt),
+	).All(ctx, repo.DbConn)
+	if err != nil {
+		return nil, errors.WithMessage(err, "Cannot list credit sales")
+	}
+
+	for _, s := range sales {
+		if s.R == nil || s.R.CreditAccount == nil {
+			continue
+		}
+
+		// Collections are made on behalf of the account manager.
+		var claims auth.Claims
+		claims.Subject = s.R.CreditAccount.SalesRepID
+
+		tx, err := repo.DbConn.Begin()
+		if err != nil {
+			return report, err
+		}
+		amount, cleared, err := repo.collect(ctx, claims, s.ID, currentDate, tx)
+		if err != nil {
+			_ = tx.Rollback()
+			report.Failed++
+			continue
+		}
+		if err = tx.Commit(); err != nil {
+			report.Failed++
+			continue
+		}
+
+		if amount > 0 {
+			report.Collected++
+			report.Amount += amount
+		}
+		if cleared {
+			report.Cleared++
+		}
+	}
+
+	return report, nil
+}
+
+// Receivables lists the credit sales that have not been paid in full with what their credit
+// accounts hold and how long they are past the end of their term.
+func (repo *Repository) Receivables(ctx context.Context, claims auth.Claims, req ReceivablesRequest, currentDate time.Time) (*Receivables, error) {
+	span, ctx := tracer.StartSpanFromContext(ctx, "internal.sale.Receivables")
+	defer span.Finish()
+
+	if claims.Audience == "" {
+		return nil, errors.WithStack(ErrForbidden)
+	}
+
+	queries := []QueryMod{
+		Load(models.SaleRels.CreditAccount),
+		Load(models.SaleRels.CreditAccount + "." + models.AccountRels.Customer),
+		Load(models.SaleRels.CreatedBy),
+		models.SaleWhere.Status.EQ(Status_PartiallyPaid),
+		models.SaleWhere.ArchivedAt.IsNull(),
+		OrderBy(models.SaleColumns.CreatedAt),
+	}
+	if req.BranchID != "" {
+		queries = append(queries, models.SaleWhere.BranchID.EQ(req.BranchID))
+	}
+
+	sales, err := models.Sales(queries...).All(ctx, repo.DbConn)
+	if err != nil {
+		return nil, weberror.NewError(ctx, err, http.StatusInternalServerError)
+	}
+
+	res := &Receivables{}
+	for _, rec := range sales {
+		s := FromModel(rec)
+		dueDate := CreditDueDate(s.CreatedAt, s.CreditTermDays)
+		r := &Receivable{
+			Response:    s.Response(ctx),
+			DueDate:     web.NewTimeResponse(ctx, dueDate),
+			DaysOverdue: DaysOverdue(dueDate, currentDate),
+		}
+		if acc := rec.R.CreditAccount; acc != nil {
+			r.AccountBalance = money.Amount(acc.Balance)
+			r.CustomerID = acc.CustomerID
+			if acc.R != nil && acc.R.Customer != nil {
+				r.Customer = acc.R.Customer.Name
+			}
+		}
+
+		res.Sales = append(res.Sales, r)
+		res.Amount += s.Amount
+		res.AmountPaid += s.AmountPaid
+		res.Outstanding += s.Outstanding()
+		if r.DaysOverdue > 0 {
+			res.Overdue += s.Outstanding()
+		}
+	}
+
+	return res, nil
+}
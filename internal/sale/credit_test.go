@@ -0,0 +1,294 @@
+package sale
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	"github.com/dgrijalva/jwt-go"
+	"github.com/pborman/uuid"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+
+	"merryworld/surebank/internal/account"
+	"merryworld/surebank/internal/account_product"
+	"merryworld/surebank/internal/customer"
+	"merryworld/surebank/internal/dscommission"
+	"merryworld/surebank/internal/inventory"
+	"merryworld/surebank/internal/ledger"
+	"merryworld/surebank/internal/platform/auth"
+	"merryworld/surebank/internal/platform/money"
+	"merryworld/surebank/internal/platform/notify"
+	"merryworld/surebank/internal/platform/tests"
+	"merryworld/surebank/internal/postgres/models"
+	"merryworld/surebank/internal/profit"
+	"merryworld/surebank/internal/shop"
+	"merryworld/surebank/internal/transaction"
+)
+
+var (
+	test *tests.Test
+	repo *Repository
+)
+
+// TestMain is the entry point for testing.
+func TestMain(m *testing.M) {
+	os.Exit(testMain(m))
+}
+
+func testMain(m *testing.M) int {
+	test = tests.New()
+	defer test.TearDown()
+
+	txRepo := transaction.NewRepository(test.MasterDB, dscommission.NewRepository(test.MasterDB), profit.NewRepository(test.MasterDB),
+		ledger.NewRepository(test.MasterDB), notify.NewSMSDisabled(), notify.NewEmailDisabled(), nil)
+	repo = NewRepository(test.MasterDB, shop.NewRepository(test.MasterDB), inventory.NewRepository(test.MasterDB), txRepo,
+		profit.NewRepository(test.MasterDB), ledger.NewRepository(test.MasterDB), account.NewRepository(test.MasterDB))
+
+	return m.Run()
+}
+
+// TestCreditDailyTarget validates the daily contribution set to collect credit sales.
+func TestCreditDailyTarget(t *testing.T) {
+
+	var targetTests = []struct {
+		balance  money.Amount
+		termDays int
+		want     money.Amount
+	}{
+		{money.Naira(3000), 30, money.Naira(100)},
+		{money.Naira(1000), 30, money.Naira(34)},
+		{money.Amount(150), 1, money.Naira(2)},
+		{money.Naira(1000), 0, 0},
+		{0, 30, 0},
+	}
+
+	t.Log("Given the need to collect the balance of credit sales over their term.")
+	{
+		for i, tt := range targetTests {
+			t.Logf("\tTest: %d\tWhen %s is collected over %d days.", i, tt.balance, tt.termDays)
+			{
+				got := CreditDailyTarget(tt.balance, tt.termDays)
+				if got != tt.want {
+					t.Fatalf("\t%s\tExpected a daily target of %s, got %s.", tests.Failed, tt.want, got)
+				}
+				if tt.termDays > 0 && got*money.Amount(tt.termDays) < tt.balance {
+					t.Fatalf("\t%s\tExpected %s a day to cover %s.", tests.Failed, got, tt.balance)
+				}
+				t.Logf("\t%s\tCreditDailyTarget ok.", tests.Success)
+			}
+		}
+	}
+}
+
+// TestAvailableFunds validates what can be taken from a wallet towards a credit sale.
+func TestAvailableFunds(t *testing.T) {
+
+	currentDate := time.Date(2026, time.June, 30, 10, 0, 0, 0, time.UTC)
+	before, after := currentDate.AddDate(0, 0, -1), currentDate.AddDate(0, 0, 1)
+
+	var fundsTests = []struct {
+		name     string
+		balance  money.Amount
+		product  *account_product.Product
+		maturity *time.Time
+		want     money.Amount
+	}{
+		{"without a product", money.Naira(500), nil, nil, 0},
+		{"without withdrawals", money.Naira(500), &account_product.Product{}, nil, 0},
+		{"with withdrawals", money.Naira(500), &account_product.Product{WithdrawalsAllowed: true}, nil, money.Naira(500)},
+		{"above the minimum balance", money.Naira(500),
+			&account_product.Product{WithdrawalsAllowed: true, MinBalance: money.Naira(100)}, nil, money.Naira(400)},
+		{"below the minimum balance", money.Naira(50),
+			&account_product.Product{WithdrawalsAllowed: true, MinBalance: money.Naira(100)}, nil, 0},
+		{"before maturity", money.Naira(500), &account_product.Product{WithdrawalsAllowed: true}, &after, 0},
+		{"after maturity", money.Naira(500), &account_product.Product{WithdrawalsAllowed: true}, &before, money.Naira(500)},
+	}
+
+	t.Log("Given the need to pay for credit sales from the funds of a wallet.")
+	{
+		for i, tt := range fundsTests {
+			t.Logf("\tTest: %d\tWhen the account is %s.", i, tt.name)
+			{
+				if got := AvailableFunds(tt.balance, tt.product, tt.maturity, currentDate); got != tt.want {
+					t.Fatalf("\t%s\tExpected %s to be available, got %s.", tests.Failed, tt.want, got)
+				}
+				t.Logf("\t%s\tAvailableFunds ok.", tests.Success)
+			}
+		}
+	}
+}
+
+// TestDaysOverdue validates how long credit sales are past the end of their term.
+func TestDaysOverdue(t *testing.T) {
+
+	created := time.Date(2026, time.June, 1, 15, 30, 0, 0, time.UTC)
+
+	var overdueTests = []struct {
+		termDays    int
+		currentDate time.Time
+		want        int
+	}{
+		{30, time.Date(2026, time.June, 20, 9, 0, 0, 0, time.UTC), 0},
+		{30, time.Date(2026, time.July, 1, 23, 0, 0, 0, time.UTC), 0},
+		{30, time.Date(2026, time.July, 2, 8, 0, 0, 0, time.UTC), 1},
+		{7, time.Date(2026, time.July, 1, 8, 0, 0, 0, time.UTC), 23},
+	}
+
+	t.Log("Given the need to follow up credit sales past the end of their term.")
+	{
+		for i, tt := range overdueTests {
+			t.Logf("\tTest: %d\tWhen a %d day sale is checked on %s.", i, tt.termDays, tt.currentDate.Format("2006-01-02"))
+			{
+				due := CreditDueDate(created, tt.termDays)
+				if got := DaysOverdue(due, tt.currentDate); got != tt.want {
+					t.Fatalf("\t%s\tExpected %d days overdue, got %d.", tests.Failed, tt.want, got)
+				}
+				t.Logf("\t%s\tDaysOverdue ok.", tests.Success)
+			}
+		}
+	}
+}
+
+// newTestCreditSale creates a sales rep and a sale of amount that is collected from a savings
+// account of the buyer holding deposit.
+func newTestCreditSale(t *testing.T, amount, deposit money.Amount, now time.Time) (auth.Claims, *models.Sale) {
+	ctx := tests.Context()
+
+	branch := models.Branch{
+		ID:        uuid.NewRandom().String(),
+		Name:      "Branch " + uuid.NewRandom().String(),
+		CreatedAt: now.Unix(),
+		UpdatedAt: now.Unix(),
+	}
+	if err := branch.Insert(ctx, test.MasterDB, boil.Infer()); err != nil {
+		t.Fatalf("\t%s\tInsert branch failed: %v", tests.Failed, err)
+	}
+
+	salesRep := models.User{
+		ID:          uuid.NewRandom().String(),
+		BranchID:    branch.ID,
+		Email:       uuid.NewRandom().String() + "@example.com",
+		FirstName:   "Sales",
+		LastName:    "Rep",
+		PhoneNumber: "08000000000",
+		CreatedAt:   now,
+	}
+	if err := salesRep.Insert(ctx, test.MasterDB, boil.Infer()); err != nil {
+		t.Fatalf("\t%s\tInsert sales rep failed: %v", tests.Failed, err)
+	}
+
+	cust := models.Customer{
+		ID:          uuid.NewRandom().String(),
+		BranchID:    branch.ID,
+		Email:       uuid.NewRandom().String() + "@example.com",
+		Name:        "Test Customer",
+		PhoneNumber: "08000000001",
+		SalesRepID:  salesRep.ID,
+		CreatedAt:   now.Unix(),
+		UpdatedAt:   now.Unix(),
+	}
+	if err := cust.Insert(ctx, test.MasterDB, boil.Infer()); err != nil {
+		t.Fatalf("\t%s\tInsert customer failed: %v", tests.Failed, err)
+	}
+
+	// The SB product is seeded by the schema migrations.
+	product, err := models.AccountProducts(models.AccountProductWhere.Code.EQ(customer.AccountTypeSB)).One(ctx, test.MasterDB)
+	if err != nil {
+		t.Fatalf("\t%s\tRead account product failed: %v", tests.Failed, err)
+	}
+
+	acc := models.Account{
+		ID:          uuid.NewRandom().String(),
+		BranchID:    branch.ID,
+		Number:      uuid.NewRandom().String()[:8],
+		CustomerID:  cust.ID,
+		AccountType: product.Code,
+		ProductID:   product.ID,
+		SalesRepID:  salesRep.ID,
+		CreatedAt:   now.Unix(),
+		UpdatedAt:   now.Unix(),
+	}
+	if err := acc.Insert(ctx, test.MasterDB, boil.Infer()); err != nil {
+		t.Fatalf("\t%s\tInsert account failed: %v", tests.Failed, err)
+	}
+
+	claims := auth.Claims{
+		Roles: []string{auth.RoleAdmin},
+		StandardClaims: jwt.StandardClaims{
+			Subject:  salesRep.ID,
+			Audience: uuid.NewRandom().String(),
+		},
+	}
+
+	if _, err := repo.TransactionRepo.Deposit(ctx, claims, transaction.CreateRequest{
+		Type:          transaction.TransactionType_Deposit,
+		AccountNumber: acc.Number,
+		Amount:        deposit,
+		PaymentMethod: transaction.PaymentMethod_Cash,
+	}, now); err != nil {
+		t.Fatalf("\t%s\tDeposit failed: %v", tests.Failed, err)
+	}
+
+	sale := models.Sale{
+		ID:              uuid.NewRandom().String(),
+		BranchID:        branch.ID,
+		ReceiptNumber:   uuid.NewRandom().String()[:8],
+		Amount:          amount.Kobo(),
+		CreatedAt:       now.Unix(),
+		UpdatedAt:       now.Unix(),
+		CreatedByID:     salesRep.ID,
+		PaymentMethod:   PaymentMethod_Credit,
+		Status:          Status_PartiallyPaid,
+		CreditAccountID: null.StringFrom(acc.ID),
+		CreditTermDays:  30,
+	}
+	if err := sale.Insert(ctx, test.MasterDB, boil.Infer()); err != nil {
+		t.Fatalf("\t%s\tInsert sale failed: %v", tests.Failed, err)
+	}
+
+	return claims, &sale
+}
+
+// TestCollectDailySummary validates what is collected towards a credit sale is added to the
+// income of the day it is collected.
+func TestCollectDailySummary(t *testing.T) {
+	defer tests.Recover(t)
+
+	// No other test posts on this day so the summary only holds the collection made here.
+	now := time.Date(2026, time.March, 15, 10, 0, 0, 0, time.UTC)
+	claims, sale := newTestCreditSale(t, money.Naira(300), money.Naira(1000), now.AddDate(0, 0, -1))
+
+	t.Log("Given the need to keep the income of the day in step with the postings.")
+	{
+		t.Log("\tTest: 0\tWhen a credit sale is collected.")
+		{
+			ctx := tests.Context()
+
+			day := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC).Unix()
+			income := func() money.Amount {
+				m, err := models.DailySummaries(models.DailySummaryWhere.Date.EQ(day)).One(ctx, test.MasterDB)
+				if err != nil {
+					return 0
+				}
+				return money.Amount(m.Income)
+			}
+
+			before := income()
+			collected, err := repo.Collect(ctx, claims, CollectRequest{ID: sale.ID}, now)
+			if err != nil {
+				t.Log("\t\tGot :", err)
+				t.Fatalf("\t%s\tCollect failed.", tests.Failed)
+			}
+			if collected.AmountPaid <= 0 {
+				t.Fatalf("\t%s\tExpected part of the sale to be collected.", tests.Failed)
+			}
+			if got := income() - before; got != collected.AmountPaid {
+				t.Logf("\t\tGot : %s", got)
+				t.Logf("\t\tWant: %s", collected.AmountPaid)
+				t.Fatalf("\t%s\tShould add the collection to the income of the day.", tests.Failed)
+			}
+			t.Logf("\t%s\tShould add the collection to the income of the day.", tests.Success)
+		}
+	}
+}
@@ -0,0 +1,58 @@
+package sale
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/pkg/errors"
+	"gopkg.in/DataDog/dd-trace-go.v1/ddtrace/tracer"
+
+	"merryworld/surebank/internal/account"
+	"merryworld/surebank/internal/platform/auth"
+	"merryworld/surebank/internal/platform/web/webcontext"
+	"merryworld/surebank/internal/platform/web/weberror"
+)
+
+// ErrGoalNotReached occurs when an account that has not reached its savings goal is redeemed.
+var ErrGoalNotReached = errors.New("Only SB accounts that have reached their savings target can be redeemed")
+
+// RedeemGoal sells the product to the customer of an SB account that reached its savings goal,
+// paid for from the account.
+func (repo *Repository) RedeemGoal(ctx context.Context, claims auth.Claims, req RedeemGoalRequest, now time.Time) (*Sale, error) {
+	span, ctx := tracer.StartSpanFromContext(ctx, "internal.sale.RedeemGoal")
+	defer span.Finish()
+
+	if claims.Audience == "" {
+		return nil, errors.WithStack(ErrForbidden)
+	}
+
+	// Validate the request.
+	v := webcontext.Validator()
+	if err := v.Struct(req); err != nil {
+		return nil, err
+	}
+
+	acc, err := repo.AccountRepo.ReadByID(ctx, claims, req.AccountID)
+	if err != nil {
+		return nil, err
+	}
+	if !account.HasGoal(acc.Type, acc.Target) || acc.Balance < acc.Target {
+		return nil, weberror.NewError(ctx, ErrGoalNotReached, http.StatusBadRequest)
+	}
+
+	saleReq := MakeSalesRequest{
+		PaymentMethod: PaymentMethod_Wallet,
+		AccountNumber: acc.Number,
+	}
+	if acc.Customer != nil {
+		saleReq.CustomerName = acc.Customer.Name
+		saleReq.PhoneNumber = acc.Customer.PhoneNumber
+	}
+	saleReq.Items = append(saleReq.Items, struct {
+		ProductID string `json:"product_id"`
+		Quantity  int    `json:"quantity"`
+	}{ProductID: req.ProductID, Quantity: req.Quantity})
+
+	return repo.MakeSale(ctx, claims, saleReq, now)
+}
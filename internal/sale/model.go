@@ -2,14 +2,16 @@ package sale
 
 import (
 	"context"
-	"sync"
 	"time"
 
 	"github.com/jmoiron/sqlx"
 	"github.com/volatiletech/null/v8"
 
+	"merryworld/surebank/internal/account"
 	"merryworld/surebank/internal/branch"
 	"merryworld/surebank/internal/inventory"
+	"merryworld/surebank/internal/ledger"
+	"merryworld/surebank/internal/platform/money"
 	"merryworld/surebank/internal/platform/web"
 	"merryworld/surebank/internal/postgres/models"
 	"merryworld/surebank/internal/profit"
@@ -25,52 +27,85 @@ type Repository struct {
 	InventoryRepo   *inventory.Repository
 	TransactionRepo *transaction.Repository
 	ProfitRepo      *profit.Repository
-	mutex           sync.Mutex
+	LedgerRepo      *ledger.Repository
+	AccountRepo     *account.Repository
 }
 
 // NewRepository creates a new Repository that defines dependencies for Branch.
 func NewRepository(db *sqlx.DB, shopRepo *shop.Repository, inventoryRepo *inventory.Repository,
-	transactionRepo *transaction.Repository, profitRepo *profit.Repository) *Repository {
+	transactionRepo *transaction.Repository, profitRepo *profit.Repository, ledgerRepo *ledger.Repository,
+	accountRepo *account.Repository) *Repository {
 	return &Repository{
 		DbConn:          db,
 		ShopRepo:        shopRepo,
 		InventoryRepo:   inventoryRepo,
 		TransactionRepo: transactionRepo,
 		ProfitRepo:      profitRepo,
+		LedgerRepo:      ledgerRepo,
+		AccountRepo:     accountRepo,
 	}
 }
 
+// The ways a sale can be paid for.
+const (
+	PaymentMethod_Cash   = "cash"
+	PaymentMethod_Wallet = "wallet"
+	// PaymentMethod_Credit pays what the wallet of the buyer holds and collects the rest from a
+	// daily contribution account.
+	PaymentMethod_Credit = "credit"
+)
+
+// The statuses of a sale.
+const (
+	Status_Paid          = "paid"
+	Status_PartiallyPaid = "partially_paid"
+)
+
 // Sale
 type Sale struct {
-	ID            string     `json:"id"`
-	ReceiptNumber string     `json:"receipt_number"`
-	Amount        float64    `json:"amount"`
-	AmountTender  float64    `json:"amount_tender"`
-	Balance       float64    `json:"balance"`
-	CustomerName  string     `json:"customer_name"`
-	PhoneNumber   string     `json:"phone_number"`
-	CreatedAt     time.Time  `json:"created_at"`
-	UpdatedAt     time.Time  `json:"updated_at"`
-	ArchivedAt    *time.Time `json:"archived_at"`
-	CreatedByID   string     `json:"created_by_id"`
-	UpdatedByID   string     `json:"updated_by_id"`
-	ArchivedByID  *string    `json:"archived_by_id"`
-	BranchID      string     `json:"branch_id"`
+	ID            string       `json:"id"`
+	ReceiptNumber string       `json:"receipt_number"`
+	Amount        money.Amount `json:"amount"`
+	AmountTender  money.Amount `json:"amount_tender"`
+	Balance       money.Amount `json:"balance"`
+	CustomerName  string       `json:"customer_name"`
+	PhoneNumber   string       `json:"phone_number"`
+	CreatedAt     time.Time    `json:"created_at"`
+	UpdatedAt     time.Time    `json:"updated_at"`
+	ArchivedAt    *time.Time   `json:"archived_at"`
+	CreatedByID   string       `json:"created_by_id"`
+	UpdatedByID   string       `json:"updated_by_id"`
+	ArchivedByID  *string      `json:"archived_by_id"`
+	BranchID      string       `json:"branch_id"`
+
+	PaymentMethod   string       `json:"payment_method"`
+	Status          string       `json:"status"`
+	AmountPaid      money.Amount `json:"amount_paid"`
+	CreditAccountID *string      `json:"credit_account_id,omitempty"`
+	CreditTermDays  int          `json:"credit_term_days"`
+	ClearedAt       *time.Time   `json:"cleared_at,omitempty"`
 
 	Items      []*Item        `json:"items"`
 	Branch     *branch.Branch `json:"branch"`
 	CreatedBy  *user.User     `json:"created_by"`
 	UpdatedBy  *user.User     `json:"updated_by"`
 	ArchivedBy *user.User     `json:"archived_by"`
+
+	CreditAccountNumber string `json:"credit_account_number,omitempty"`
+}
+
+// Outstanding returns what is left to collect on the sale.
+func (s *Sale) Outstanding() money.Amount {
+	return s.Amount - s.AmountPaid
 }
 
 func (s Sale) model() models.Sale {
 	m := models.Sale{
 		ID:            s.ID,
 		ReceiptNumber: s.ReceiptNumber,
-		Amount:        s.Amount,
-		AmountTender:  s.AmountTender,
-		Balance:       s.Balance,
+		Amount:        s.Amount.Kobo(),
+		AmountTender:  s.AmountTender.Kobo(),
+		Balance:       s.Balance.Kobo(),
 		CustomerName:  null.StringFrom(s.CustomerName),
 		PhoneNumber:   null.StringFrom(s.PhoneNumber),
 		CreatedAt:     s.CreatedAt.Unix(),
@@ -78,12 +113,22 @@ func (s Sale) model() models.Sale {
 		CreatedByID:   s.CreatedByID,
 		UpdatedByID:   null.StringFrom(s.UpdatedByID),
 		BranchID:      s.BranchID,
+
+		PaymentMethod:   s.PaymentMethod,
+		Status:          s.Status,
+		AmountPaid:      s.AmountPaid.Kobo(),
+		CreditAccountID: null.StringFromPtr(s.CreditAccountID),
+		CreditTermDays:  s.CreditTermDays,
 	}
 
 	if s.ArchivedAt != nil {
 		m.ArchivedAt = null.Int64From(s.ArchivedAt.Unix())
 	}
 
+	if s.ClearedAt != nil {
+		m.ClearedAt = null.Int64From(s.ClearedAt.Unix())
+	}
+
 	if s.ArchivedByID != nil {
 		m.ArchivedByID = null.StringFrom(*s.ArchivedByID)
 	}
@@ -96,9 +141,9 @@ func FromModel(m *models.Sale) *Sale {
 	s := &Sale{
 		ID:            m.ID,
 		ReceiptNumber: m.ReceiptNumber,
-		Amount:        m.Amount,
-		AmountTender:  m.AmountTender,
-		Balance:       m.Balance,
+		Amount:        money.Amount(m.Amount),
+		AmountTender:  money.Amount(m.AmountTender),
+		Balance:       money.Amount(m.Balance),
 		CustomerName:  m.CustomerName.String,
 		PhoneNumber:   m.PhoneNumber.String,
 		CreatedAt:     time.Unix(m.CreatedAt, 0),
@@ -106,6 +151,17 @@ func FromModel(m *models.Sale) *Sale {
 		CreatedByID:   m.CreatedByID,
 		UpdatedByID:   m.UpdatedByID.String,
 		BranchID:      m.BranchID,
+
+		PaymentMethod:   m.PaymentMethod,
+		Status:          m.Status,
+		AmountPaid:      money.Amount(m.AmountPaid),
+		CreditAccountID: m.CreditAccountID.Ptr(),
+		CreditTermDays:  m.CreditTermDays,
+	}
+
+	if m.ClearedAt.Valid {
+		clearedAt := time.Unix(m.ClearedAt.Int64, 0)
+		s.ClearedAt = &clearedAt
 	}
 
 	if m.ArchivedByID.Valid {
@@ -139,6 +195,10 @@ func FromModel(m *models.Sale) *Sale {
 		if m.R.ArchivedBy != nil {
 			s.ArchivedBy = user.FromModel(m.R.ArchivedBy)
 		}
+
+		if m.R.CreditAccount != nil {
+			s.CreditAccountNumber = m.R.CreditAccount.Number
+		}
 	}
 
 	return s
@@ -148,9 +208,9 @@ func FromModel(m *models.Sale) *Sale {
 type Response struct {
 	ID            string            `json:"id"`
 	ReceiptNumber string            `json:"receipt_number"`
-	Amount        float64           `json:"amount"`
-	AmountTender  float64           `json:"amount_tender"`
-	Balance       float64           `json:"balance"`
+	Amount        money.Amount      `json:"amount"`
+	AmountTender  money.Amount      `json:"amount_tender"`
+	Balance       money.Amount      `json:"balance"`
 	CustomerName  string            `json:"customer_name"`
 	PhoneNumber   string            `json:"phone_number"`
 	CreatedAt     web.TimeResponse  `json:"created_at"`
@@ -161,6 +221,15 @@ type Response struct {
 	ArchivedByID  *string           `json:"archived_by_id,omitempty"`
 	BranchID      string            `json:"branch_id"`
 
+	PaymentMethod       string            `json:"payment_method"`
+	Status              string            `json:"status"`
+	AmountPaid          money.Amount      `json:"amount_paid"`
+	Outstanding         money.Amount      `json:"outstanding"`
+	CreditAccountID     *string           `json:"credit_account_id,omitempty"`
+	CreditAccountNumber string            `json:"credit_account_number,omitempty"`
+	CreditTermDays      int               `json:"credit_term_days"`
+	ClearedAt           *web.TimeResponse `json:"cleared_at,omitempty"`
+
 	Items      []*ItemResponse `json:"items,omitempty"`
 	Branch     *string         `json:"branch,omitempty"`
 	CreatedBy  *string         `json:"created_by,omitempty"`
@@ -173,7 +242,7 @@ func (s *Sale) Response(ctx context.Context) *Response {
 	r := &Response{
 		ID:            s.ID,
 		ReceiptNumber: s.ReceiptNumber,
-		Amount:        s.AmountTender,
+		Amount:        s.Amount,
 		AmountTender:  s.AmountTender,
 		Balance:       s.Balance,
 		CustomerName:  s.CustomerName,
@@ -183,6 +252,14 @@ func (s *Sale) Response(ctx context.Context) *Response {
 		CreatedByID:   s.CreatedByID,
 		UpdatedByID:   s.UpdatedByID,
 		BranchID:      s.BranchID,
+
+		PaymentMethod:       s.PaymentMethod,
+		Status:              s.Status,
+		AmountPaid:          s.AmountPaid,
+		Outstanding:         s.Outstanding(),
+		CreditAccountID:     s.CreditAccountID,
+		CreditAccountNumber: s.CreditAccountNumber,
+		CreditTermDays:      s.CreditTermDays,
 	}
 
 	if s.ArchivedAt != nil {
@@ -190,6 +267,11 @@ func (s *Sale) Response(ctx context.Context) *Response {
 		r.ArchivedAt = &t
 	}
 
+	if s.ClearedAt != nil {
+		t := web.NewTimeResponse(ctx, *s.ClearedAt)
+		r.ClearedAt = &t
+	}
+
 	if s.ArchivedByID != nil {
 		r.ArchivedByID = s.ArchivedByID
 	}
@@ -239,13 +321,13 @@ func (m *Sales) Response(ctx context.Context) []*Response {
 
 // Item represents sales Item
 type Item struct {
-	ID            string  `json:"id"`
-	SaleID        string  `json:"sale_id"`
-	ProductID     string  `json:"product_id"`
-	Quantity      int     `json:"quantity"`
-	UnitPrice     float64 `json:"unit_price"`
-	UnitCostPrice float64 `json:"unit_cost_price"`
-	StockIds      string  `json:"stock_ids"`
+	ID            string       `json:"id"`
+	SaleID        string       `json:"sale_id"`
+	ProductID     string       `json:"product_id"`
+	Quantity      int          `json:"quantity"`
+	UnitPrice     money.Amount `json:"unit_price"`
+	UnitCostPrice money.Amount `json:"unit_cost_price"`
+	StockIds      string       `json:"stock_ids"`
 
 	Product *shop.Product `json:"product,omitempty"`
 }
@@ -255,8 +337,8 @@ func (item *Item) model() *models.SaleItem {
 		ID:            item.ID,
 		SaleID:        item.SaleID,
 		ProductID:     item.ProductID,
-		UnitPrice:     item.UnitPrice,
-		UnitCostPrice: item.UnitCostPrice,
+		UnitPrice:     item.UnitPrice.Kobo(),
+		UnitCostPrice: item.UnitCostPrice.Kobo(),
 		StockIds:      item.StockIds,
 	}
 }
@@ -267,8 +349,8 @@ func ItemFromModel(m *models.SaleItem) *Item {
 		SaleID:        m.SaleID,
 		ProductID:     m.ProductID,
 		Quantity:      m.Quantity,
-		UnitPrice:     m.UnitPrice,
-		UnitCostPrice: m.UnitCostPrice,
+		UnitPrice:     money.Amount(m.UnitPrice),
+		UnitCostPrice: money.Amount(m.UnitCostPrice),
 		StockIds:      m.StockIds,
 	}
 
@@ -282,14 +364,14 @@ func ItemFromModel(m *models.SaleItem) *Item {
 }
 
 type ItemResponse struct {
-	ID            string  `json:"id"`
-	SaleID        string  `json:"sale_id"`
-	ProductID     string  `json:"product_id"`
-	Quantity      int     `json:"quantity"`
-	UnitPrice     float64 `json:"unit_price"`
-	UnitCostPrice float64 `json:"unit_cost_price"`
-	SubTotal      float64 `json:"sub_total"`
-	StockIds      string  `json:"stock_ids"`
+	ID            string       `json:"id"`
+	SaleID        string       `json:"sale_id"`
+	ProductID     string       `json:"product_id"`
+	Quantity      int          `json:"quantity"`
+	UnitPrice     money.Amount `json:"unit_price"`
+	UnitCostPrice money.Amount `json:"unit_cost_price"`
+	SubTotal      money.Amount `json:"sub_total"`
+	StockIds      string       `json:"stock_ids"`
 
 	Product *string `json:"product,omitempty"`
 }
@@ -302,7 +384,7 @@ func (item Item) Response(ctx context.Context) *ItemResponse {
 		Quantity:      item.Quantity,
 		UnitPrice:     item.UnitPrice,
 		UnitCostPrice: item.UnitCostPrice,
-		SubTotal:      float64(item.Quantity) * item.UnitPrice,
+		SubTotal:      item.UnitPrice.Mul(int64(item.Quantity)),
 		StockIds:      item.StockIds,
 	}
 
@@ -321,16 +403,23 @@ type PagedResponseList struct {
 
 // MakeSalesRequest contains the payload for capturing a new sale
 type MakeSalesRequest struct {
-	PaymentMethod string  `json:"payment_method" validate:"required"`
-	AccountNumber string  `json:"account_number"`
-	AmountTender  float64 `json:"amount_tender"`
-	CustomerName  string  `json:"customer_name"`
-	PhoneNumber   string  `json:"phone_number"`
+	PaymentMethod string       `json:"payment_method" validate:"required"`
+	AccountNumber string       `json:"account_number"`
+	AmountTender  money.Amount `json:"amount_tender"`
+	CustomerName  string       `json:"customer_name"`
+	PhoneNumber   string       `json:"phone_number"`
+	// CreditTermDays is the number of days the balance of a credit sale is collected over.
+	CreditTermDays int `json:"credit_term_days" validate:"omitempty,gt=0,lte=365"`
+	// CreditAccountNumber is a daily contribution account of the buyer to collect the balance of a
+	// credit sale on, a new one is opened when it is empty.
+	CreditAccountNumber string `json:"credit_account_number"`
 
 	Items []struct {
 		ProductID string `json:"product_id"`
 		Quantity  int    `json:"quantity"`
 	} `json:"items"`
+	// IdempotencyKey is provided by the client in the Idempotency-Key header to make retries safe.
+	IdempotencyKey string `json:"-"`
 }
 
 // ReadRequest defines the information needed to read a sale.
@@ -365,3 +454,54 @@ type FindRequest struct {
 	IncludeUpdatedBy  bool          `json:"include-updated-by"`
 	IncludeArchivedBy bool          `json:"include-archived-by"`
 }
+
+// CollectRequest defines the sale to collect the balance of from its credit account.
+type CollectRequest struct {
+	ID string `json:"id" validate:"required,uuid" example:"985f1746-1d9f-459f-a2d9-fc53ece5ae86"`
+}
+
+// CollectDueRequest defines the day the credit sales job runs for.
+type CollectDueRequest struct {
+	Date time.Time `json:"date" validate:"required"`
+}
+
+// CollectReport summarises a run of the credit sales job.
+type CollectReport struct {
+	Date      time.Time    `json:"date"`
+	Collected int          `json:"collected"`
+	Amount    money.Amount `json:"amount"`
+	Cleared   int          `json:"cleared"`
+	Failed    int          `json:"failed"`
+}
+
+// ReceivablesRequest defines the credit sales to report on.
+type ReceivablesRequest struct {
+	BranchID string `json:"branch_id"`
+}
+
+// Receivable is a credit sale that has not been paid for in full.
+type Receivable struct {
+	*Response
+	CustomerID     string           `json:"customer_id"`
+	Customer       string           `json:"customer"`
+	AccountBalance money.Amount     `json:"account_balance"`
+	DueDate        web.TimeResponse `json:"due_date"`
+	DaysOverdue    int              `json:"days_overdue"`
+}
+
+// Receivables is the receivables report, the credit sales still being collected and their totals.
+type Receivables struct {
+	Sales       []*Receivable `json:"sales"`
+	Amount      money.Amount  `json:"amount"`
+	AmountPaid  money.Amount  `json:"amount_paid"`
+	Outstanding money.Amount  `json:"outstanding"`
+	Overdue     money.Amount  `json:"overdue"`
+}
+
+// RedeemGoalRequest defines the product an SB account that reached its savings goal is redeemed
+// against.
+type RedeemGoalRequest struct {
+	AccountID string `json:"account_id" validate:"required,uuid" example:"985f1746-1d9f-459f-a2d9-fc53ece5ae86"`
+	ProductID string `json:"product_id" validate:"required,uuid" example:"985f1746-1d9f-459f-a2d9-fc53ece5ae86"`
+	Quantity  int    `json:"quantity" validate:"required,gt=0" example:"1"`
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"math/rand"
+	"merryworld/surebank/internal/platform/money"
 	"merryworld/surebank/internal/profit"
 	"merryworld/surebank/internal/transaction"
 	"strconv"
@@ -15,7 +16,9 @@ import (
 	. "github.com/volatiletech/sqlboiler/v4/queries/qm"
 	"gopkg.in/DataDog/dd-trace-go.v1/ddtrace/tracer"
 
+	"merryworld/surebank/internal/idempotency"
 	"merryworld/surebank/internal/inventory"
+	"merryworld/surebank/internal/ledger"
 	"merryworld/surebank/internal/platform/auth"
 	"merryworld/surebank/internal/platform/web/webcontext"
 	"merryworld/surebank/internal/platform/web/weberror"
@@ -106,6 +109,7 @@ func (repo *Repository) ReadByID(ctx context.Context, claims auth.Claims, id str
 		Load(models.SaleRels.CreatedBy),
 		Load(models.SaleRels.ArchivedBy),
 		Load(models.SaleRels.Branch),
+		Load(models.SaleRels.CreditAccount),
 	}
 	sale, err := models.Sales(queries...).One(ctx, repo.DbConn)
 	if err != nil {
@@ -139,8 +143,27 @@ func (repo *Repository) MakeSale(ctx context.Context, claims auth.Claims, req Ma
 		return nil, weberror.WithMessage(ctx, err, "Cannot start a db transaction")
 	}
 
-	repo.mutex.Lock()
-	defer repo.mutex.Unlock()
+	if req.IdempotencyKey != "" {
+		saleID, err := idempotency.Claim(ctx, tx, claims.Audience, idempotency.ScopeSale, req.IdempotencyKey, req, now)
+		if err != nil {
+			_ = tx.Rollback()
+			return nil, err
+		}
+		if saleID != "" {
+			_ = tx.Rollback()
+			return repo.ReadByID(ctx, claims, saleID)
+		}
+	}
+
+	// Lock every product up front so the stock of the whole sale is checked and deducted atomically.
+	var productIDs []string
+	for _, item := range req.Items {
+		productIDs = append(productIDs, item.ProductID)
+	}
+	if err = repo.InventoryRepo.LockProducts(ctx, tx, productIDs...); err != nil {
+		_ = tx.Rollback()
+		return nil, err
+	}
 
 	salesRep, err := models.Users(models.UserWhere.ID.EQ(claims.Subject)).One(ctx, tx)
 	if err != nil {
@@ -150,7 +173,7 @@ func (repo *Repository) MakeSale(ctx context.Context, claims auth.Claims, req Ma
 
 	saleID := uuid.NewRandom().String()
 	var itemSlice models.SaleItemSlice
-	var amount float64
+	var amount money.Amount
 	for _, item := range req.Items {
 		prod, err := repo.ShopRepo.ReadProductByIDTx(ctx, claims, item.ProductID, tx)
 		if err != nil {
@@ -183,8 +206,8 @@ func (repo *Repository) MakeSale(ctx context.Context, claims auth.Claims, req Ma
 			SaleID:        saleID,
 			ProductID:     item.ProductID,
 			Quantity:      item.Quantity,
-			UnitPrice:     prod.Price,
-			UnitCostPrice: prod.Price,
+			UnitPrice:     prod.Price.Kobo(),
+			UnitCostPrice: prod.Price.Kobo(),
 		})
 
 		// TODO: save profit from this sale
@@ -192,8 +215,8 @@ func (repo *Repository) MakeSale(ctx context.Context, claims auth.Claims, req Ma
 		if customerName == "" {
 			customerName = "customer"
 		}
-		profReq := profit.ProfitCreateRequest {
-			Amount: float64(item.Quantity) * (prod.Price - prod.CostPrice),
+		profReq := profit.ProfitCreateRequest{
+			Amount:    (prod.Price - prod.CostPrice).Mul(int64(item.Quantity)),
 			Narration: fmt.Sprintf("Sale of %s to %s", prod.Name, customerName),
 		}
 		if _, err := repo.ProfitRepo.CreateProfitTx(ctx, tx, claims, profReq, now); err != nil {
@@ -201,17 +224,31 @@ func (repo *Repository) MakeSale(ctx context.Context, claims auth.Claims, req Ma
 			return nil, weberror.NewError(ctx, weberror.WithMessagef(ctx, err, "Cannot create profit for %s", prod.Name), 400)
 		}
 
-		amount += float64(item.Quantity) * prod.Price
+		amount += prod.Price.Mul(int64(item.Quantity))
 	}
 
-	if req.PaymentMethod == "cash" && amount > req.AmountTender {
+	if req.PaymentMethod == PaymentMethod_Cash && amount > req.AmountTender {
 		_ = tx.Rollback()
-		return nil, weberror.NewError(ctx, fmt.Errorf("you must collect %f from the customer to make this sale", amount), 400)
+		return nil, weberror.NewError(ctx, fmt.Errorf("you must collect %s from the customer to make this sale", amount), 400)
 	}
 
+	// If now empty set it to the current time.
+	if now.IsZero() {
+		now = time.Now()
+	}
+
+	// Always store the time as UTC.
+	now = now.UTC()
+	// Postgres truncates times to milliseconds when storing. We and do the same
+	// here so the value we return is consistent with what we store.
+	now = now.Truncate(time.Millisecond)
+
 	receiptNumber := repo.generateReceiptNumber(ctx)
 
-	if req.PaymentMethod == "wallet" {
+	amountPaid := amount
+	var creditAccountID string
+	switch req.PaymentMethod {
+	case PaymentMethod_Wallet:
 		if req.AccountNumber == "" {
 			_ = tx.Rollback()
 			return nil, weberror.NewError(ctx, errors.New("You must specify the buyer's account number to use wallet for payment"), 400)
@@ -220,24 +257,21 @@ func (repo *Repository) MakeSale(ctx context.Context, claims auth.Claims, req Ma
 			AccountNumber: req.AccountNumber,
 			Amount:        amount,
 			Narration:     fmt.Sprintf("sale:%s:%s", receiptNumber, saleID),
+			LedgerAccount: ledger.AccountSalesRevenue,
 		}, now, tx)
 		if err != nil {
 			_ = tx.Rollback()
 			return nil, weberror.NewErrorMessage(ctx, err, 500, "cannot make deduction")
 		}
+	case PaymentMethod_Credit:
+		amountPaid, creditAccountID, err = repo.payOnCredit(ctx, claims, req, amount, receiptNumber, saleID, now, tx)
+		if err != nil {
+			_ = tx.Rollback()
+			return nil, err
+		}
+		req.AmountTender = amountPaid
 	}
 
-	// If now empty set it to the current time.
-	if now.IsZero() {
-		now = time.Now()
-	}
-
-	// Always store the time as UTC.
-	now = now.UTC()
-	// Postgres truncates times to milliseconds when storing. We and do the same
-	// here so the value we return is consistent with what we store.
-	now = now.Truncate(time.Millisecond)
-
 	sale := Sale{
 		ID:            saleID,
 		ReceiptNumber: receiptNumber,
@@ -251,6 +285,18 @@ func (repo *Repository) MakeSale(ctx context.Context, claims auth.Claims, req Ma
 		CreatedByID:   claims.Subject,
 		UpdatedByID:   claims.Subject,
 		BranchID:      salesRep.BranchID,
+
+		PaymentMethod: req.PaymentMethod,
+		Status:        Status_Paid,
+		AmountPaid:    amountPaid,
+	}
+	if creditAccountID != "" {
+		sale.Status = Status_PartiallyPaid
+		sale.CreditAccountID = &creditAccountID
+		sale.CreditTermDays = req.CreditTermDays
+		sale.Balance = 0
+	} else {
+		sale.ClearedAt = &now
 	}
 
 	saleModel := sale.model()
@@ -266,6 +312,31 @@ func (repo *Repository) MakeSale(ctx context.Context, claims auth.Claims, req Ma
 		}
 	}
 
+	// Wallet sales are posted to the ledger by the deduction from the customer's account and
+	// credit sales when they are paid for.
+	if req.PaymentMethod != PaymentMethod_Wallet && req.PaymentMethod != PaymentMethod_Credit {
+		if _, err = repo.LedgerRepo.PostTx(ctx, claims, ledger.PostRequest{
+			Reference:  receiptNumber,
+			Narration:  fmt.Sprintf("Sale %s", receiptNumber),
+			SourceType: ledger.SourceSale,
+			SourceID:   saleID,
+			Lines: []ledger.Line{
+				ledger.Debit(ledger.AccountCash, amount),
+				ledger.Credit(ledger.AccountSalesRevenue, amount),
+			},
+		}, now, tx); err != nil {
+			_ = tx.Rollback()
+			return nil, weberror.WithMessage(ctx, err, "Cannot post sale to the ledger")
+		}
+	}
+
+	if req.IdempotencyKey != "" {
+		if err = idempotency.Complete(ctx, tx, claims.Audience, req.IdempotencyKey, saleID); err != nil {
+			_ = tx.Rollback()
+			return nil, err
+		}
+	}
+
 	if err = tx.Commit(); err != nil {
 		return nil, weberror.WithMessage(ctx, err, "Unable to commit DB transaction")
 	}
@@ -320,7 +391,24 @@ func (repo *Repository) Archive(ctx context.Context, claims auth.Claims, req Arc
 	// here so the value we return is consistent with what we store.
 	now = now.Truncate(time.Millisecond)
 
-	_, err = models.Sales(models.SaleWhere.ID.EQ(req.ID)).UpdateAll(ctx, repo.DbConn, models.M{models.SaleColumns.ArchivedAt: now})
+	tx, err := repo.DbConn.Begin()
+	if err != nil {
+		return err
+	}
+
+	if _, err = models.Sales(models.SaleWhere.ID.EQ(req.ID)).UpdateAll(ctx, tx, models.M{models.SaleColumns.ArchivedAt: now.Unix()}); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+
+	if _, err = repo.LedgerRepo.ReverseTx(ctx, claims, ledger.ReverseRequest{
+		SourceType: ledger.SourceSale,
+		SourceID:   req.ID,
+		Narration:  "Archived sale",
+	}, now, tx); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
 
-	return nil
+	return tx.Commit()
 }
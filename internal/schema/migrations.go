@@ -1466,10 +1466,1159 @@ func migrationList(ctx context.Context, db *sqlx.DB, log *log.Logger, isUnittest
 				return nil
 			},
 		},
+		// Create the general ledger tables and seed the chart of accounts
+		{
+			ID: "20261018-01",
+			Migrate: func(tx *sql.Tx) error {
+				statements := []string{
+					`CREATE TABLE IF NOT EXISTS ledger_account (
+					  code varchar(10) NOT NULL,
+					  name varchar(100) NOT NULL,
+					  account_type varchar(20) NOT NULL,
+					  created_at INT8 NOT NULL,
+					  PRIMARY KEY (code)
+					) ;`,
+					`CREATE TABLE IF NOT EXISTS journal_entry (
+					  id char(36) NOT NULL,
+					  reference varchar(64) NOT NULL DEFAULT '',
+					  narration varchar(256) NOT NULL DEFAULT '',
+					  source_type varchar(32) NOT NULL,
+					  source_id varchar(36) NOT NULL,
+					  reversal_of_id char(36) DEFAULT NULL REFERENCES journal_entry(id),
+					  created_by_id char(36) DEFAULT NULL REFERENCES users(id) ON DELETE SET NULL,
+					  created_at INT8 NOT NULL,
+					  PRIMARY KEY (id)
+					) ;`,
+					`CREATE INDEX IF NOT EXISTS idx_journal_entry_source ON journal_entry(source_type, source_id)`,
+					`CREATE TABLE IF NOT EXISTS posting (
+					  id char(36) NOT NULL,
+					  journal_entry_id char(36) NOT NULL REFERENCES journal_entry(id),
+					  ledger_account_code varchar(10) NOT NULL REFERENCES ledger_account(code),
+					  account_id char(36) DEFAULT NULL REFERENCES account(id) ON DELETE SET NULL,
+					  debit FLOAT8 NOT NULL DEFAULT 0,
+					  credit FLOAT8 NOT NULL DEFAULT 0,
+					  created_at INT8 NOT NULL,
+					  PRIMARY KEY (id),
+					  CONSTRAINT posting_one_side CHECK ((debit >= 0 AND credit >= 0) AND (debit = 0 OR credit = 0))
+					) ;`,
+					`CREATE INDEX IF NOT EXISTS idx_posting_journal_entry ON posting(journal_entry_id)`,
+					`CREATE INDEX IF NOT EXISTS idx_posting_ledger_account ON posting(ledger_account_code)`,
+					`INSERT INTO ledger_account (code, name, account_type, created_at) VALUES
+						('1000', 'Cash on Hand', 'asset', extract(epoch from now())::INT8),
+						('1010', 'Bank', 'asset', extract(epoch from now())::INT8),
+						('2000', 'Customer Deposits', 'liability', extract(epoch from now())::INT8),
+						('3000', 'Owner''s Equity', 'equity', extract(epoch from now())::INT8),
+						('4000', 'DS Fee Income', 'income', extract(epoch from now())::INT8),
+						('4100', 'Sales Revenue', 'income', extract(epoch from now())::INT8),
+						('5000', 'Operating Expenses', 'expense', extract(epoch from now())::INT8),
+						('5100', 'Sales Rep Expenses', 'expense', extract(epoch from now())::INT8)
+					ON CONFLICT (code) DO NOTHING`,
+				}
+
+				for _, q1 := range statements {
+					if _, err := tx.Exec(q1); err != nil {
+						return errors.Wrapf(err, "Query failed %s", q1)
+					}
+				}
+
+				return nil
+			},
+			Rollback: func(tx *sql.Tx) error {
+				statements := []string{
+					`DROP TABLE IF EXISTS posting`,
+					`DROP TABLE IF EXISTS journal_entry`,
+					`DROP TABLE IF EXISTS ledger_account`,
+				}
+
+				for _, q1 := range statements {
+					if _, err := tx.Exec(q1); err != nil {
+						return errors.Wrapf(err, "Query failed %s", q1)
+					}
+				}
+
+				return nil
+			},
+		},
+		// Create table idempotency_key
+		{
+			ID: "20261018-02",
+			Migrate: func(tx *sql.Tx) error {
+				q1 := `CREATE TABLE IF NOT EXISTS idempotency_key (
+					  tenant_id char(36) NOT NULL,
+					  idempotency_key varchar(255) NOT NULL,
+					  scope varchar(32) NOT NULL,
+					  request_hash char(64) NOT NULL,
+					  resource_id char(36) DEFAULT NULL,
+					  created_at INT8 NOT NULL,
+					  PRIMARY KEY (tenant_id, idempotency_key)
+					) ;`
+				if _, err := tx.Exec(q1); err != nil {
+					return errors.Wrapf(err, "Query failed %s", q1)
+				}
+				return nil
+			},
+			Rollback: func(tx *sql.Tx) error {
+				q1 := `DROP TABLE IF EXISTS idempotency_key`
+				if _, err := tx.Exec(q1); err != nil {
+					return errors.Wrapf(err, "Query failed %s", q1)
+				}
+				return nil
+			},
+		},
+		// Store money as whole kobo instead of FLOAT8 naira.
+		{
+			ID: "20261018-03",
+			Migrate: func(tx *sql.Tx) error {
+				for _, c := range moneyColumns {
+					q1 := fmt.Sprintf(`ALTER TABLE %s ALTER COLUMN %s DROP DEFAULT,
+						ALTER COLUMN %s TYPE INT8 USING ROUND(%s * 100)::INT8`, c.table, c.column, c.column, c.column)
+					if c.hasDefault {
+						q1 += fmt.Sprintf(`, ALTER COLUMN %s SET DEFAULT 0`, c.column)
+					}
+					if _, err := tx.Exec(q1); err != nil {
+						return errors.Wrapf(err, "Query failed %s", q1)
+					}
+				}
+				return nil
+			},
+			Rollback: func(tx *sql.Tx) error {
+				for _, c := range moneyColumns {
+					q1 := fmt.Sprintf(`ALTER TABLE %s ALTER COLUMN %s DROP DEFAULT,
+						ALTER COLUMN %s TYPE FLOAT8 USING %s / 100.0`, c.table, c.column, c.column, c.column)
+					if c.hasDefault {
+						q1 += fmt.Sprintf(`, ALTER COLUMN %s SET DEFAULT 0`, c.column)
+					}
+					if _, err := tx.Exec(q1); err != nil {
+						return errors.Wrapf(err, "Query failed %s", q1)
+					}
+				}
+				return nil
+			},
+		},
+		// Link corrections to the transactions they reverse or replace
+		{
+			ID: "20261018-04",
+			Migrate: func(tx *sql.Tx) error {
+				statements := []string{
+					`ALTER TABLE transaction
+						ADD COLUMN reversal_of_id char(36) DEFAULT NULL REFERENCES transaction(id) ON DELETE RESTRICT,
+						ADD COLUMN correction_of_id char(36) DEFAULT NULL REFERENCES transaction(id) ON DELETE RESTRICT,
+						ADD COLUMN reason varchar(200) NOT NULL DEFAULT '',
+						ADD COLUMN approved_by_id char(36) DEFAULT NULL REFERENCES users(id) ON DELETE RESTRICT`,
+					`CREATE UNIQUE INDEX IF NOT EXISTS idx_transaction_reversal_of ON transaction (reversal_of_id)`,
+				}
+
+				for _, q1 := range statements {
+					if _, err := tx.Exec(q1); err != nil {
+						return errors.Wrapf(err, "Query failed %s", q1)
+					}
+				}
+
+				return nil
+			},
+			Rollback: func(tx *sql.Tx) error {
+				q1 := `ALTER TABLE transaction DROP COLUMN IF EXISTS reversal_of_id, DROP COLUMN IF EXISTS correction_of_id,
+					DROP COLUMN IF EXISTS reason, DROP COLUMN IF EXISTS approved_by_id`
+				if _, err := tx.Exec(q1); err != nil {
+					return errors.Wrapf(err, "Query failed %s", q1)
+				}
+				return nil
+			},
+		},
+		// Create table account_product and link every account to the product of its type
+		{
+			ID: "20261018-05",
+			Migrate: func(tx *sql.Tx) error {
+				statements := []string{
+					`CREATE TABLE IF NOT EXISTS account_product (
+					  id char(36) NOT NULL,
+					  code varchar(28) NOT NULL,
+					  name varchar(100) NOT NULL,
+					  description varchar(500) NOT NULL DEFAULT '',
+					  target_required boolean NOT NULL DEFAULT false,
+					  daily_contribution boolean NOT NULL DEFAULT false,
+					  max_days_per_payment INT NOT NULL DEFAULT 0,
+					  min_deposit INT8 NOT NULL DEFAULT 0,
+					  cycle_days INT NOT NULL DEFAULT 0,
+					  first_contribution_fee boolean NOT NULL DEFAULT false,
+					  deposit_sms boolean NOT NULL DEFAULT true,
+					  withdrawals_allowed boolean NOT NULL DEFAULT true,
+					  min_balance INT8 NOT NULL DEFAULT 0,
+					  interest_rate_bps INT NOT NULL DEFAULT 0,
+					  tenor_days INT NOT NULL DEFAULT 0,
+					  early_withdrawal_penalty_bps INT NOT NULL DEFAULT 0,
+					  created_at INT8 NOT NULL,
+					  updated_at INT8 NOT NULL,
+					  archived_at INT8 DEFAULT NULL,
+					  PRIMARY KEY (id),
+					  CONSTRAINT account_product_code UNIQUE (code)
+					) ;`,
+					// The products reproduce the rules that were hard-coded for each account type.
+					`INSERT INTO account_product (id, code, name, description, target_required, daily_contribution,
+						max_days_per_payment, cycle_days, first_contribution_fee, deposit_sms, created_at, updated_at) VALUES
+						(md5('SB')::uuid::text, 'SB', 'Savings', 'Flexible savings.', false, false, 0, 0, false, true,
+							extract(epoch from now())::INT8, extract(epoch from now())::INT8),
+						(md5('DS')::uuid::text, 'DS', 'Daily Savings', 'Daily contributions of a fixed amount, the first contribution of each cycle is the fee.',
+							true, true, 50, 31, true, true, extract(epoch from now())::INT8, extract(epoch from now())::INT8),
+						(md5('SF')::uuid::text, 'SF', 'Fixed Savings', 'Savings kept for a fixed period.', false, false, 0, 0, false, false,
+							extract(epoch from now())::INT8, extract(epoch from now())::INT8)
+					ON CONFLICT (code) DO NOTHING`,
+					// Accounts of any other type get a product without rules so no account is left behind.
+					`INSERT INTO account_product (id, code, name, created_at, updated_at)
+						SELECT DISTINCT md5(a.account_type)::uuid::text, a.account_type, a.account_type,
+							extract(epoch from now())::INT8, extract(epoch from now())::INT8
+						FROM account a
+						WHERE NOT EXISTS (SELECT 1 FROM account_product p WHERE p.code = a.account_type)`,
+					`ALTER TABLE account ADD COLUMN IF NOT EXISTS product_id char(36) DEFAULT NULL REFERENCES account_product(id) ON DELETE RESTRICT`,
+					`UPDATE account SET product_id = p.id FROM account_product p WHERE p.code = account.account_type`,
+					`ALTER TABLE account ALTER COLUMN product_id SET NOT NULL`,
+					`CREATE INDEX IF NOT EXISTS idx_account_product ON account(product_id)`,
+				}
+
+				for _, q1 := range statements {
+					if _, err := tx.Exec(q1); err != nil {
+						return errors.Wrapf(err, "Query failed %s", q1)
+					}
+				}
+
+				return nil
+			},
+			Rollback: func(tx *sql.Tx) error {
+				statements := []string{
+					`ALTER TABLE account DROP COLUMN IF EXISTS product_id`,
+					`DROP TABLE IF EXISTS account_product`,
+				}
+
+				for _, q1 := range statements {
+					if _, err := tx.Exec(q1); err != nil {
+						return errors.Wrapf(err, "Query failed %s", q1)
+					}
+				}
+
+				return nil
+			},
+		},
+		// Add the interest terms of fixed savings accounts and record their daily interest accruals
+		{
+			ID: "20261018-06",
+			Migrate: func(tx *sql.Tx) error {
+				statements := []string{
+					`ALTER TABLE account
+						ADD COLUMN IF NOT EXISTS tenor_days INT NOT NULL DEFAULT 0,
+						ADD COLUMN IF NOT EXISTS interest_rate_bps INT NOT NULL DEFAULT 0,
+						ADD COLUMN IF NOT EXISTS maturity_date INT8 DEFAULT NULL,
+						ADD COLUMN IF NOT EXISTS roll_over boolean NOT NULL DEFAULT false,
+						ADD COLUMN IF NOT EXISTS accrued_interest INT8 NOT NULL DEFAULT 0,
+						ADD COLUMN IF NOT EXISTS interest_accrued_to INT8 DEFAULT NULL,
+						ADD COLUMN IF NOT EXISTS matured_at INT8 DEFAULT NULL`,
+					// Existing accounts take the terms of their product as of their opening date.
+					`UPDATE account SET tenor_days = p.tenor_days, interest_rate_bps = p.interest_rate_bps,
+						maturity_date = CASE WHEN p.tenor_days > 0 THEN account.created_at + p.tenor_days * 86400 END
+						FROM account_product p WHERE p.id = account.product_id`,
+					`CREATE TABLE IF NOT EXISTS interest_accrual (
+					  id char(36) NOT NULL,
+					  account_id char(36) NOT NULL REFERENCES account(id) ON DELETE CASCADE,
+					  date INT8 NOT NULL,
+					  balance INT8 NOT NULL,
+					  rate_bps INT NOT NULL,
+					  amount INT8 NOT NULL,
+					  created_at INT8 NOT NULL,
+					  PRIMARY KEY (id),
+					  CONSTRAINT interest_accrual_account_date UNIQUE (account_id, date)
+					) ;`,
+					`INSERT INTO ledger_account (code, name, account_type, created_at) VALUES
+						('2100', 'Interest Payable', 'liability', extract(epoch from now())::INT8),
+						('4200', 'Penalty Income', 'income', extract(epoch from now())::INT8),
+						('5200', 'Interest Expense', 'expense', extract(epoch from now())::INT8)
+					ON CONFLICT (code) DO NOTHING`,
+				}
+
+				for _, q1 := range statements {
+					if _, err := tx.Exec(q1); err != nil {
+						return errors.Wrapf(err, "Query failed %s", q1)
+					}
+				}
+
+				return nil
+			},
+			Rollback: func(tx *sql.Tx) error {
+				statements := []string{
+					`DROP TABLE IF EXISTS interest_accrual`,
+					`ALTER TABLE account
+						DROP COLUMN IF EXISTS tenor_days,
+						DROP COLUMN IF EXISTS interest_rate_bps,
+						DROP COLUMN IF EXISTS maturity_date,
+						DROP COLUMN IF EXISTS roll_over,
+						DROP COLUMN IF EXISTS accrued_interest,
+						DROP COLUMN IF EXISTS interest_accrued_to,
+						DROP COLUMN IF EXISTS matured_at`,
+				}
+
+				for _, q1 := range statements {
+					if _, err := tx.Exec(q1); err != nil {
+						return errors.Wrapf(err, "Query failed %s", q1)
+					}
+				}
+
+				return nil
+			},
+		},
+		// Add transfers between the accounts of a customer and link both of their legs to them
+		{
+			ID: "20261018-07",
+			Migrate: func(tx *sql.Tx) error {
+				statements := []string{
+					`CREATE TABLE IF NOT EXISTS transfer (
+					  id char(36) NOT NULL,
+					  reference varchar(36) NOT NULL,
+					  from_account_id char(36) NOT NULL REFERENCES account(id) ON DELETE RESTRICT,
+					  to_account_id char(36) NOT NULL REFERENCES account(id) ON DELETE RESTRICT,
+					  amount INT8 NOT NULL,
+					  narration varchar(256) NOT NULL DEFAULT '',
+					  sales_rep_id char(36) NOT NULL REFERENCES users(id) ON DELETE RESTRICT,
+					  created_at INT8 NOT NULL,
+					  PRIMARY KEY (id),
+					  CONSTRAINT transfer_reference UNIQUE (reference)
+					) ;`,
+					`ALTER TABLE transaction
+						ADD COLUMN IF NOT EXISTS transfer_id char(36) DEFAULT NULL REFERENCES transfer(id) ON DELETE RESTRICT`,
+					`CREATE INDEX IF NOT EXISTS idx_transaction_transfer ON transaction (transfer_id)`,
+				}
+
+				for _, q1 := range statements {
+					if _, err := tx.Exec(q1); err != nil {
+						return errors.Wrapf(err, "Query failed %s", q1)
+					}
+				}
+
+				return nil
+			},
+			Rollback: func(tx *sql.Tx) error {
+				statements := []string{
+					`ALTER TABLE transaction DROP COLUMN IF EXISTS transfer_id`,
+					`DROP TABLE IF EXISTS transfer`,
+				}
+
+				for _, q1 := range statements {
+					if _, err := tx.Exec(q1); err != nil {
+						return errors.Wrapf(err, "Query failed %s", q1)
+					}
+				}
+
+				return nil
+			},
+		},
+		// Hold withdrawals and transfers above the approval threshold of the branch or account
+		// product until a second user approves them
+		{
+			ID: "20261018-08",
+			Migrate: func(tx *sql.Tx) error {
+				statements := []string{
+					`ALTER TABLE branch ADD COLUMN IF NOT EXISTS approval_threshold INT8 NOT NULL DEFAULT 0`,
+					`ALTER TABLE account_product ADD COLUMN IF NOT EXISTS approval_threshold INT8 NOT NULL DEFAULT 0`,
+					`CREATE TABLE IF NOT EXISTS approval (
+					  id char(36) NOT NULL,
+					  kind varchar(20) NOT NULL,
+					  account_id char(36) NOT NULL REFERENCES account(id) ON DELETE RESTRICT,
+					  to_account_id char(36) DEFAULT NULL REFERENCES account(id) ON DELETE RESTRICT,
+					  branch_id char(36) NOT NULL REFERENCES branch(id) ON DELETE RESTRICT,
+					  amount INT8 NOT NULL,
+					  narration varchar(256) NOT NULL DEFAULT '',
+					  ledger_account varchar(36) NOT NULL DEFAULT '',
+					  status varchar(20) NOT NULL DEFAULT 'pending',
+					  requested_by_id char(36) NOT NULL REFERENCES users(id) ON DELETE RESTRICT,
+					  decided_by_id char(36) DEFAULT NULL REFERENCES users(id) ON DELETE RESTRICT,
+					  decided_at INT8 DEFAULT NULL,
+					  reason varchar(256) NOT NULL DEFAULT '',
+					  transaction_id char(36) DEFAULT NULL REFERENCES transaction(id) ON DELETE RESTRICT,
+					  transfer_id char(36) DEFAULT NULL REFERENCES transfer(id) ON DELETE RESTRICT,
+					  created_at INT8 NOT NULL,
+					  updated_at INT8 NOT NULL,
+					  PRIMARY KEY (id)
+					) ;`,
+					`CREATE INDEX IF NOT EXISTS idx_approval_branch_status ON approval (branch_id, status)`,
+				}
+
+				for _, q1 := range statements {
+					if _, err := tx.Exec(q1); err != nil {
+						return errors.Wrapf(err, "Query failed %s", q1)
+					}
+				}
+
+				return nil
+			},
+			Rollback: func(tx *sql.Tx) error {
+				statements := []string{
+					`DROP TABLE IF EXISTS approval`,
+					`ALTER TABLE account_product DROP COLUMN IF EXISTS approval_threshold`,
+					`ALTER TABLE branch DROP COLUMN IF EXISTS approval_threshold`,
+				}
+
+				for _, q1 := range statements {
+					if _, err := tx.Exec(q1); err != nil {
+						return errors.Wrapf(err, "Query failed %s", q1)
+					}
+				}
+
+				return nil
+			},
+		},
+		// Record the end of day cash-up of each sales rep against the cash expected from the ledger
+		{
+			ID: "20261018-09",
+			Migrate: func(tx *sql.Tx) error {
+				statements := []string{
+					`CREATE TABLE IF NOT EXISTS till_session (
+					  id char(36) NOT NULL,
+					  branch_id char(36) NOT NULL REFERENCES branch(id) ON DELETE RESTRICT,
+					  sales_rep_id char(36) NOT NULL REFERENCES users(id) ON DELETE RESTRICT,
+					  date INT8 NOT NULL,
+					  cash_deposits INT8 NOT NULL DEFAULT 0,
+					  cash_sales INT8 NOT NULL DEFAULT 0,
+					  withdrawals_paid INT8 NOT NULL DEFAULT 0,
+					  rep_expenses INT8 NOT NULL DEFAULT 0,
+					  expected INT8 NOT NULL DEFAULT 0,
+					  counted INT8 NOT NULL DEFAULT 0,
+					  variance INT8 NOT NULL DEFAULT 0,
+					  status varchar(20) NOT NULL DEFAULT 'submitted',
+					  notes varchar(256) NOT NULL DEFAULT '',
+					  submitted_by_id char(36) NOT NULL REFERENCES users(id) ON DELETE RESTRICT,
+					  signed_off_by_id char(36) DEFAULT NULL REFERENCES users(id) ON DELETE RESTRICT,
+					  signed_off_at INT8 DEFAULT NULL,
+					  created_at INT8 NOT NULL,
+					  updated_at INT8 NOT NULL,
+					  PRIMARY KEY (id),
+					  CONSTRAINT till_session_rep_date UNIQUE (sales_rep_id, date)
+					) ;`,
+					`CREATE INDEX IF NOT EXISTS idx_till_session_branch_date ON till_session (branch_id, date)`,
+					`CREATE TABLE IF NOT EXISTS till_count (
+					  id char(36) NOT NULL,
+					  till_session_id char(36) NOT NULL REFERENCES till_session(id) ON DELETE CASCADE,
+					  denomination INT8 NOT NULL,
+					  count INT8 NOT NULL DEFAULT 0,
+					  PRIMARY KEY (id),
+					  CONSTRAINT till_count_denomination UNIQUE (till_session_id, denomination)
+					) ;`,
+				}
+
+				for _, q1 := range statements {
+					if _, err := tx.Exec(q1); err != nil {
+						return errors.Wrapf(err, "Query failed %s", q1)
+					}
+				}
+
+				return nil
+			},
+			Rollback: func(tx *sql.Tx) error {
+				statements := []string{
+					`DROP TABLE IF EXISTS till_count`,
+					`DROP TABLE IF EXISTS till_session`,
+				}
+
+				for _, q1 := range statements {
+					if _, err := tx.Exec(q1); err != nil {
+						return errors.Wrapf(err, "Query failed %s", q1)
+					}
+				}
+
+				return nil
+			},
+		},
+		// Track the contribution cycles of daily savings accounts instead of inferring them from the
+		// last commission
+		{
+			ID: "20261018-10",
+			Migrate: func(tx *sql.Tx) error {
+				statements := []string{
+					`CREATE TABLE IF NOT EXISTS ds_cycle (
+					  id char(36) NOT NULL,
+					  account_id char(36) NOT NULL REFERENCES account(id) ON DELETE RESTRICT,
+					  number INT NOT NULL,
+					  start_date INT8 NOT NULL,
+					  end_date INT8 NOT NULL,
+					  target INT8 NOT NULL DEFAULT 0,
+					  days_paid INT NOT NULL DEFAULT 0,
+					  amount_paid INT8 NOT NULL DEFAULT 0,
+					  fee INT8 NOT NULL DEFAULT 0,
+					  brought_forward INT8 NOT NULL DEFAULT 0,
+					  status varchar(20) NOT NULL DEFAULT 'open',
+					  settlement varchar(20) NOT NULL DEFAULT '',
+					  payout INT8 NOT NULL DEFAULT 0,
+					  carried_forward INT8 NOT NULL DEFAULT 0,
+					  payout_transaction_id char(36) DEFAULT NULL REFERENCES transaction(id) ON DELETE RESTRICT,
+					  settled_by_id char(36) DEFAULT NULL REFERENCES users(id) ON DELETE RESTRICT,
+					  settled_at INT8 DEFAULT NULL,
+					  created_at INT8 NOT NULL,
+					  updated_at INT8 NOT NULL,
+					  PRIMARY KEY (id),
+					  CONSTRAINT ds_cycle_account_number UNIQUE (account_id, number)
+					) ;`,
+					`CREATE INDEX IF NOT EXISTS idx_ds_cycle_status_end_date ON ds_cycle (status, end_date)`,
+					`ALTER TABLE transaction ADD COLUMN IF NOT EXISTS ds_cycle_id char(36) DEFAULT NULL REFERENCES ds_cycle(id) ON DELETE RESTRICT`,
+					`CREATE INDEX IF NOT EXISTS idx_transaction_ds_cycle ON transaction (ds_cycle_id)`,
+					// Every commission charged so far started a cycle. The balance of the earlier cycles
+					// stayed on the account so they are recorded as rolled over.
+					`INSERT INTO ds_cycle (id, account_id, number, start_date, end_date, target, fee, status, settlement, created_at, updated_at)
+						SELECT c.id, c.account_id,
+							row_number() OVER (PARTITION BY c.account_id ORDER BY c.effective_date, c.date),
+							c.effective_date, c.effective_date + (GREATEST(p.cycle_days, 1) - 1) * 86400,
+							a.target, c.amount, 'settled', 'rollover', c.date, c.date
+						FROM ds_commission c
+						INNER JOIN account a ON a.id = c.account_id
+						INNER JOIN account_product p ON p.id = a.product_id
+						WHERE NOT EXISTS (SELECT 1 FROM ds_cycle d WHERE d.account_id = c.account_id)`,
+					`UPDATE ds_cycle SET status = 'open', settlement = ''
+						WHERE (account_id, number) IN (SELECT account_id, max(number) FROM ds_cycle GROUP BY account_id)`,
+					`UPDATE transaction t SET ds_cycle_id = c.id FROM ds_cycle c
+						WHERE t.account_id = c.account_id AND t.tx_type = 'deposit' AND t.ds_cycle_id IS NULL
+							AND t.effective_date BETWEEN c.start_date AND c.end_date`,
+					// The fee was deducted two seconds after the commission was recorded.
+					`UPDATE transaction t SET ds_cycle_id = c.id FROM ds_commission c
+						WHERE t.account_id = c.account_id AND t.tx_type = 'withdrawal' AND t.ds_cycle_id IS NULL
+							AND t.narration = 'DS fee deduction' AND t.created_at BETWEEN c.date AND c.date + 2
+							AND EXISTS (SELECT 1 FROM ds_cycle d WHERE d.id = c.id)`,
+					`UPDATE transaction t SET ds_cycle_id = o.ds_cycle_id FROM transaction o
+						WHERE t.reversal_of_id = o.id AND t.ds_cycle_id IS NULL AND o.ds_cycle_id IS NOT NULL`,
+					`UPDATE ds_cycle c SET days_paid = s.days_paid, amount_paid = s.amount_paid
+						FROM (SELECT t.ds_cycle_id, count(*) AS days_paid, sum(t.amount) AS amount_paid
+							FROM transaction t
+							WHERE t.ds_cycle_id IS NOT NULL AND t.tx_type = 'deposit' AND t.reversal_of_id IS NULL
+								AND t.archived_at IS NULL
+								AND NOT EXISTS (SELECT 1 FROM transaction r WHERE r.reversal_of_id = t.id)
+							GROUP BY t.ds_cycle_id) s
+						WHERE s.ds_cycle_id = c.id`,
+				}
+
+				for _, q1 := range statements {
+					if _, err := tx.Exec(q1); err != nil {
+						return errors.Wrapf(err, "Query failed %s", q1)
+					}
+				}
+
+				return nil
+			},
+			Rollback: func(tx *sql.Tx) error {
+				statements := []string{
+					`ALTER TABLE transaction DROP COLUMN IF EXISTS ds_cycle_id`,
+					`DROP TABLE IF EXISTS ds_cycle`,
+				}
+
+				for _, q1 := range statements {
+					if _, err := tx.Exec(q1); err != nil {
+						return errors.Wrapf(err, "Query failed %s", q1)
+					}
+				}
+
+				return nil
+			},
+		},
+		// Let account products and branches choose how the DS commission is charged
+		{
+			ID: "20261018-11",
+			Migrate: func(tx *sql.Tx) error {
+				statements := []string{
+					`ALTER TABLE account_product ADD COLUMN IF NOT EXISTS commission_policy varchar(200) NOT NULL DEFAULT ''`,
+					`ALTER TABLE branch ADD COLUMN IF NOT EXISTS commission_policy varchar(200) NOT NULL DEFAULT ''`,
+				}
+
+				for _, q1 := range statements {
+					if _, err := tx.Exec(q1); err != nil {
+						return errors.Wrapf(err, "Query failed %s", q1)
+					}
+				}
+
+				return nil
+			},
+			Rollback: func(tx *sql.Tx) error {
+				statements := []string{
+					`ALTER TABLE account_product DROP COLUMN IF EXISTS commission_policy`,
+					`ALTER TABLE branch DROP COLUMN IF EXISTS commission_policy`,
+				}
+
+				for _, q1 := range statements {
+					if _, err := tx.Exec(q1); err != nil {
+						return errors.Wrapf(err, "Query failed %s", q1)
+					}
+				}
+
+				return nil
+			},
+		},
+		// Record the calls made to DS debtors and the payments they promised
+		{
+			ID: "20261018-12",
+			Migrate: func(tx *sql.Tx) error {
+				statements := []string{
+					`CREATE TABLE IF NOT EXISTS account_follow_up (
+					  id char(36) NOT NULL,
+					  account_id char(36) NOT NULL REFERENCES account(id) ON DELETE RESTRICT,
+					  sales_rep_id char(36) NOT NULL REFERENCES users(id) ON DELETE RESTRICT,
+					  called_at INT8 NOT NULL,
+					  outcome varchar(20) NOT NULL,
+					  promised_date INT8 DEFAULT NULL,
+					  note varchar(500) NOT NULL DEFAULT '',
+					  created_at INT8 NOT NULL,
+					  PRIMARY KEY (id)
+					) ;`,
+					`CREATE INDEX IF NOT EXISTS idx_account_follow_up_account ON account_follow_up (account_id, called_at)`,
+				}
+
+				for _, q1 := range statements {
+					if _, err := tx.Exec(q1); err != nil {
+						return errors.Wrapf(err, "Query failed %s", q1)
+					}
+				}
+
+				return nil
+			},
+			Rollback: func(tx *sql.Tx) error {
+				q1 := `DROP TABLE IF EXISTS account_follow_up`
+				if _, err := tx.Exec(q1); err != nil {
+					return errors.Wrapf(err, "Query failed %s", q1)
+				}
+				return nil
+			},
+		},
+		// Create tables for customer loans, their repayment schedules and repayments
+		{
+			ID: "20261018-13",
+			Migrate: func(tx *sql.Tx) error {
+				statements := []string{
+					`CREATE TABLE IF NOT EXISTS loan (
+					  id char(36) NOT NULL,
+					  customer_id char(36) NOT NULL REFERENCES customer(id) ON DELETE RESTRICT,
+					  account_id char(36) NOT NULL REFERENCES account(id) ON DELETE RESTRICT,
+					  branch_id char(36) NOT NULL REFERENCES branch(id) ON DELETE RESTRICT,
+					  principal INT8 NOT NULL,
+					  interest_rate_bps INT NOT NULL DEFAULT 0,
+					  method varchar(20) NOT NULL,
+					  frequency varchar(20) NOT NULL,
+					  tenor INT NOT NULL,
+					  penalty_rate_bps INT NOT NULL DEFAULT 0,
+					  auto_deduct BOOLEAN NOT NULL DEFAULT FALSE,
+					  purpose varchar(200) NOT NULL DEFAULT '',
+					  status varchar(20) NOT NULL DEFAULT 'pending',
+					  total_interest INT8 NOT NULL DEFAULT 0,
+					  amount_repaid INT8 NOT NULL DEFAULT 0,
+					  penalties INT8 NOT NULL DEFAULT 0,
+					  disbursement_method varchar(20) NOT NULL DEFAULT '',
+					  disbursement_transaction_id char(36) DEFAULT NULL REFERENCES transaction(id) ON DELETE RESTRICT,
+					  applied_by_id char(36) NOT NULL REFERENCES users(id) ON DELETE RESTRICT,
+					  approved_by_id char(36) DEFAULT NULL REFERENCES users(id) ON DELETE RESTRICT,
+					  approved_at INT8 DEFAULT NULL,
+					  rejected_reason varchar(200) NOT NULL DEFAULT '',
+					  disbursed_by_id char(36) DEFAULT NULL REFERENCES users(id) ON DELETE RESTRICT,
+					  disbursed_at INT8 DEFAULT NULL,
+					  closed_at INT8 DEFAULT NULL,
+					  created_at INT8 NOT NULL,
+					  updated_at INT8 NOT NULL,
+					  PRIMARY KEY (id)
+					) ;`,
+					`CREATE INDEX IF NOT EXISTS idx_loan_customer ON loan (customer_id)`,
+					`CREATE INDEX IF NOT EXISTS idx_loan_status ON loan (status)`,
+					`CREATE TABLE IF NOT EXISTS loan_instalment (
+					  id char(36) NOT NULL,
+					  loan_id char(36) NOT NULL REFERENCES loan(id) ON DELETE RESTRICT,
+					  number INT NOT NULL,
+					  due_date INT8 NOT NULL,
+					  principal INT8 NOT NULL,
+					  interest INT8 NOT NULL,
+					  penalty INT8 NOT NULL DEFAULT 0,
+					  principal_paid INT8 NOT NULL DEFAULT 0,
+					  interest_paid INT8 NOT NULL DEFAULT 0,
+					  penalty_paid INT8 NOT NULL DEFAULT 0,
+					  paid_at INT8 DEFAULT NULL,
+					  penalized_at INT8 DEFAULT NULL,
+					  created_at INT8 NOT NULL,
+					  updated_at INT8 NOT NULL,
+					  PRIMARY KEY (id),
+					  CONSTRAINT loan_instalment_loan_number UNIQUE (loan_id, number)
+					) ;`,
+					`CREATE TABLE IF NOT EXISTS loan_repayment (
+					  id char(36) NOT NULL,
+					  loan_id char(36) NOT NULL REFERENCES loan(id) ON DELETE RESTRICT,
+					  amount INT8 NOT NULL,
+					  principal INT8 NOT NULL DEFAULT 0,
+					  interest INT8 NOT NULL DEFAULT 0,
+					  penalty INT8 NOT NULL DEFAULT 0,
+					  method varchar(20) NOT NULL,
+					  transaction_id char(36) DEFAULT NULL REFERENCES transaction(id) ON DELETE RESTRICT,
+					  sales_rep_id char(36) NOT NULL REFERENCES users(id) ON DELETE RESTRICT,
+					  created_at INT8 NOT NULL,
+					  PRIMARY KEY (id)
+					) ;`,
+					`CREATE INDEX IF NOT EXISTS idx_loan_repayment_loan ON loan_repayment (loan_id)`,
+					`INSERT INTO ledger_account (code, name, account_type, created_at) VALUES
+						('1100', 'Loans to Customers', 'asset', extract(epoch from now())::INT8),
+						('4300', 'Loan Interest Income', 'income', extract(epoch from now())::INT8)
+					ON CONFLICT (code) DO NOTHING`,
+				}
+
+				for _, q1 := range statements {
+					if _, err := tx.Exec(q1); err != nil {
+						return errors.Wrapf(err, "Query failed %s", q1)
+					}
+				}
+
+				return nil
+			},
+			Rollback: func(tx *sql.Tx) error {
+				statements := []string{
+					`DROP TABLE IF EXISTS loan_repayment`,
+					`DROP TABLE IF EXISTS loan_instalment`,
+					`DROP TABLE IF EXISTS loan`,
+					`DELETE FROM ledger_account WHERE code IN ('1100', '4300')`,
+				}
+
+				for _, q1 := range statements {
+					if _, err := tx.Exec(q1); err != nil {
+						return errors.Wrapf(err, "Query failed %s", q1)
+					}
+				}
+
+				return nil
+			},
+		},
+		// Credit sales are paid in part from the wallet of the buyer and the rest is collected from a
+		// daily contribution account.
+		{
+			ID: "20261018-14",
+			Migrate: func(tx *sql.Tx) error {
+				statements := []string{
+					`ALTER TABLE sale ADD COLUMN IF NOT EXISTS payment_method varchar(20) NOT NULL DEFAULT ''`,
+					`ALTER TABLE sale ADD COLUMN IF NOT EXISTS status varchar(20) NOT NULL DEFAULT 'paid'`,
+					`ALTER TABLE sale ADD COLUMN IF NOT EXISTS amount_paid INT8 NOT NULL DEFAULT 0`,
+					`ALTER TABLE sale ADD COLUMN IF NOT EXISTS credit_account_id char(36) DEFAULT NULL REFERENCES account(id) ON DELETE RESTRICT`,
+					`ALTER TABLE sale ADD COLUMN IF NOT EXISTS credit_term_days INT NOT NULL DEFAULT 0`,
+					`ALTER TABLE sale ADD COLUMN IF NOT EXISTS cleared_at INT8 DEFAULT NULL`,
+					`UPDATE sale SET amount_paid = amount, cleared_at = created_at WHERE amount_paid = 0`,
+					`CREATE INDEX IF NOT EXISTS idx_sale_status ON sale (status)`,
+					`INSERT INTO ledger_account (code, name, account_type, created_at) VALUES
+						('1200', 'Sales Receivables', 'asset', extract(epoch from now())::INT8)
+					ON CONFLICT (code) DO NOTHING`,
+				}
+
+				for _, q1 := range statements {
+					if _, err := tx.Exec(q1); err != nil {
+						return errors.Wrapf(err, "Query failed %s", q1)
+					}
+				}
+
+				return nil
+			},
+			Rollback: func(tx *sql.Tx) error {
+				statements := []string{
+					`DROP INDEX IF EXISTS idx_sale_status`,
+					`ALTER TABLE sale DROP COLUMN IF EXISTS cleared_at`,
+					`ALTER TABLE sale DROP COLUMN IF EXISTS credit_term_days`,
+					`ALTER TABLE sale DROP COLUMN IF EXISTS credit_account_id`,
+					`ALTER TABLE sale DROP COLUMN IF EXISTS amount_paid`,
+					`ALTER TABLE sale DROP COLUMN IF EXISTS status`,
+					`ALTER TABLE sale DROP COLUMN IF EXISTS payment_method`,
+					`DELETE FROM ledger_account WHERE code = '1200'`,
+				}
+
+				for _, q1 := range statements {
+					if _, err := tx.Exec(q1); err != nil {
+						return errors.Wrapf(err, "Query failed %s", q1)
+					}
+				}
+
+				return nil
+			},
+		},
+		// Tracks the highest milestone of the savings goal of an SB account the customer was told about.
+		{
+			ID: "20261018-15",
+			Migrate: func(tx *sql.Tx) error {
+				q1 := `ALTER TABLE account ADD COLUMN IF NOT EXISTS goal_milestone INT NOT NULL DEFAULT 0`
+				if _, err := tx.Exec(q1); err != nil {
+					return errors.Wrapf(err, "Query failed %s", q1)
+				}
+				return nil
+			},
+			Rollback: func(tx *sql.Tx) error {
+				q1 := `ALTER TABLE account DROP COLUMN IF EXISTS goal_milestone`
+				if _, err := tx.Exec(q1); err != nil {
+					return errors.Wrapf(err, "Query failed %s", q1)
+				}
+				return nil
+			},
+		},
+		// Accounts move through the active, dormant, frozen and closed statuses and every change is logged.
+		{
+			ID: "20261018-16",
+			Migrate: func(tx *sql.Tx) error {
+				statements := []string{
+					`ALTER TABLE account ADD COLUMN IF NOT EXISTS status varchar(20) NOT NULL DEFAULT 'active'`,
+					`CREATE INDEX IF NOT EXISTS idx_account_status ON account (status)`,
+					`CREATE TABLE IF NOT EXISTS account_status_change (
+					  id char(36) NOT NULL,
+					  account_id char(36) NOT NULL REFERENCES account(id) ON DELETE RESTRICT,
+					  from_status varchar(20) NOT NULL,
+					  to_status varchar(20) NOT NULL,
+					  reason varchar(500) NOT NULL DEFAULT '',
+					  changed_by_id char(36) DEFAULT NULL REFERENCES users(id) ON DELETE RESTRICT,
+					  created_at INT8 NOT NULL,
+					  PRIMARY KEY (id)
+					) ;`,
+					`CREATE INDEX IF NOT EXISTS idx_account_status_change_account ON account_status_change (account_id, created_at)`,
+				}
+
+				for _, q1 := range statements {
+					if _, err := tx.Exec(q1); err != nil {
+						return errors.Wrapf(err, "Query failed %s", q1)
+					}
+				}
+
+				return nil
+			},
+			Rollback: func(tx *sql.Tx) error {
+				statements := []string{
+					`DROP TABLE IF EXISTS account_status_change`,
+					`DROP INDEX IF EXISTS idx_account_status`,
+					`ALTER TABLE account DROP COLUMN IF EXISTS status`,
+				}
+
+				for _, q1 := range statements {
+					if _, err := tx.Exec(q1); err != nil {
+						return errors.Wrapf(err, "Query failed %s", q1)
+					}
+				}
+
+				return nil
+			},
+		},
+		{
+			ID: "20261018-17",
+			Migrate: func(tx *sql.Tx) error {
+				statements := []string{
+					`CREATE TABLE IF NOT EXISTS account_hold (
+					  id char(36) NOT NULL,
+					  account_id char(36) NOT NULL REFERENCES account(id) ON DELETE RESTRICT,
+					  amount INT8 NOT NULL,
+					  reason varchar(500) NOT NULL,
+					  expires_at INT8 DEFAULT NULL,
+					  created_by_id char(36) NOT NULL REFERENCES users(id) ON DELETE RESTRICT,
+					  created_at INT8 NOT NULL,
+					  released_at INT8 DEFAULT NULL,
+					  released_by_id char(36) DEFAULT NULL REFERENCES users(id) ON DELETE RESTRICT,
+					  PRIMARY KEY (id)
+					) ;`,
+					`CREATE INDEX IF NOT EXISTS idx_account_hold_account ON account_hold (account_id) WHERE released_at IS NULL`,
+				}
+
+				for _, q1 := range statements {
+					if _, err := tx.Exec(q1); err != nil {
+						return errors.Wrapf(err, "Query failed %s", q1)
+					}
+				}
+
+				return nil
+			},
+			Rollback: func(tx *sql.Tx) error {
+				q1 := `DROP TABLE IF EXISTS account_hold`
+				if _, err := tx.Exec(q1); err != nil {
+					return errors.Wrapf(err, "Query failed %s", q1)
+				}
+				return nil
+			},
+		},
+		{
+			ID: "20261018-18",
+			Migrate: func(tx *sql.Tx) error {
+				statements := []string{
+					`ALTER TABLE customer ADD COLUMN IF NOT EXISTS date_of_birth INT8 DEFAULT NULL`,
+					`ALTER TABLE customer ADD COLUMN IF NOT EXISTS gender varchar(10) NOT NULL DEFAULT ''`,
+					`ALTER TABLE customer ADD COLUMN IF NOT EXISTS id_type varchar(30) NOT NULL DEFAULT ''`,
+					`ALTER TABLE customer ADD COLUMN IF NOT EXISTS id_number varchar(50) NOT NULL DEFAULT ''`,
+					`ALTER TABLE customer ADD COLUMN IF NOT EXISTS bvn varchar(11) NOT NULL DEFAULT ''`,
+					`ALTER TABLE customer ADD COLUMN IF NOT EXISTS nin varchar(11) NOT NULL DEFAULT ''`,
+					`ALTER TABLE customer ADD COLUMN IF NOT EXISTS occupation varchar(100) NOT NULL DEFAULT ''`,
+					`ALTER TABLE customer ADD COLUMN IF NOT EXISTS next_of_kin_name varchar(200) NOT NULL DEFAULT ''`,
+					`ALTER TABLE customer ADD COLUMN IF NOT EXISTS next_of_kin_phone varchar(20) NOT NULL DEFAULT ''`,
+					`ALTER TABLE customer ADD COLUMN IF NOT EXISTS next_of_kin_relationship varchar(50) NOT NULL DEFAULT ''`,
+					`ALTER TABLE customer ADD COLUMN IF NOT EXISTS kyc_tier INT NOT NULL DEFAULT 1`,
+					`ALTER TABLE customer ADD COLUMN IF NOT EXISTS kyc_status varchar(20) NOT NULL DEFAULT 'unverified'`,
+					`ALTER TABLE customer ADD COLUMN IF NOT EXISTS kyc_note varchar(500) NOT NULL DEFAULT ''`,
+					`ALTER TABLE customer ADD COLUMN IF NOT EXISTS kyc_verified_at INT8 DEFAULT NULL`,
+					`ALTER TABLE customer ADD COLUMN IF NOT EXISTS kyc_verified_by_id char(36) DEFAULT NULL REFERENCES users(id) ON DELETE RESTRICT`,
+					`CREATE TABLE IF NOT EXISTS customer_document (
+					  id char(36) NOT NULL,
+					  customer_id char(36) NOT NULL REFERENCES customer(id) ON DELETE RESTRICT,
+					  kind varchar(20) NOT NULL,
+					  blob_key varchar(255) NOT NULL,
+					  content_type varchar(50) NOT NULL,
+					  uploaded_by_id char(36) NOT NULL REFERENCES users(id) ON DELETE RESTRICT,
+					  created_at INT8 NOT NULL,
+					  PRIMARY KEY (id),
+					  CONSTRAINT customer_document_kind UNIQUE (customer_id, kind)
+					) ;`,
+				}
+
+				for _, q1 := range statements {
+					if _, err := tx.Exec(q1); err != nil {
+						return errors.Wrapf(err, "Query failed %s", q1)
+					}
+				}
+
+				return nil
+			},
+			Rollback: func(tx *sql.Tx) error {
+				statements := []string{
+					`DROP TABLE IF EXISTS customer_document`,
+					`ALTER TABLE customer DROP COLUMN IF EXISTS date_of_birth, DROP COLUMN IF EXISTS gender,
+						DROP COLUMN IF EXISTS id_type, DROP COLUMN IF EXISTS id_number, DROP COLUMN IF EXISTS bvn,
+						DROP COLUMN IF EXISTS nin, DROP COLUMN IF EXISTS occupation, DROP COLUMN IF EXISTS next_of_kin_name,
+						DROP COLUMN IF EXISTS next_of_kin_phone, DROP COLUMN IF EXISTS next_of_kin_relationship,
+						DROP COLUMN IF EXISTS kyc_tier, DROP COLUMN IF EXISTS kyc_status, DROP COLUMN IF EXISTS kyc_note,
+						DROP COLUMN IF EXISTS kyc_verified_at, DROP COLUMN IF EXISTS kyc_verified_by_id`,
+				}
+
+				for _, q1 := range statements {
+					if _, err := tx.Exec(q1); err != nil {
+						return errors.Wrapf(err, "Query failed %s", q1)
+					}
+				}
+
+				return nil
+			},
+		},
+		// Audit the merges of duplicate customers and link merged customers to the one they were merged into.
+		{
+			ID: "20261018-19",
+			Migrate: func(tx *sql.Tx) error {
+				statements := []string{
+					`ALTER TABLE customer ADD COLUMN IF NOT EXISTS merged_into_id char(36) DEFAULT NULL REFERENCES customer(id) ON DELETE RESTRICT`,
+					`CREATE TABLE IF NOT EXISTS customer_merge (
+					  id char(36) NOT NULL,
+					  survivor_id char(36) NOT NULL REFERENCES customer(id) ON DELETE RESTRICT,
+					  duplicate_id char(36) NOT NULL REFERENCES customer(id) ON DELETE RESTRICT,
+					  reason varchar(500) NOT NULL DEFAULT '',
+					  accounts_moved INT NOT NULL DEFAULT 0,
+					  loans_moved INT NOT NULL DEFAULT 0,
+					  documents_moved INT NOT NULL DEFAULT 0,
+					  sales_moved INT NOT NULL DEFAULT 0,
+					  duplicate_snapshot TEXT NOT NULL DEFAULT '',
+					  merged_by_id char(36) NOT NULL REFERENCES users(id) ON DELETE RESTRICT,
+					  created_at INT8 NOT NULL,
+					  PRIMARY KEY (id)
+					) ;`,
+					`CREATE INDEX IF NOT EXISTS idx_customer_merge_survivor ON customer_merge (survivor_id)`,
+				}
+
+				for _, q1 := range statements {
+					if _, err := tx.Exec(q1); err != nil {
+						return errors.Wrapf(err, "Query failed %s", q1)
+					}
+				}
+
+				return nil
+			},
+			Rollback: func(tx *sql.Tx) error {
+				statements := []string{
+					`DROP TABLE IF EXISTS customer_merge`,
+					`ALTER TABLE customer DROP COLUMN IF EXISTS merged_into_id`,
+				}
+
+				for _, q1 := range statements {
+					if _, err := tx.Exec(q1); err != nil {
+						return errors.Wrapf(err, "Query failed %s", q1)
+					}
+				}
+
+				return nil
+			},
+		},
+		// Create tables for importing customers and their accounts in bulk from spreadsheets.
+		{
+			ID: "20261018-20",
+			Migrate: func(tx *sql.Tx) error {
+				statements := []string{
+					`CREATE TABLE IF NOT EXISTS import_job (
+					  id char(36) NOT NULL,
+					  branch_id char(36) NOT NULL REFERENCES branch(id) ON DELETE RESTRICT,
+					  sales_rep_id char(36) NOT NULL REFERENCES users(id) ON DELETE RESTRICT,
+					  file_name varchar(200) NOT NULL DEFAULT '',
+					  headers TEXT NOT NULL DEFAULT '',
+					  mapping TEXT NOT NULL DEFAULT '',
+					  account_type varchar(20) NOT NULL DEFAULT '',
+					  ignore_duplicates BOOL NOT NULL DEFAULT FALSE,
+					  status varchar(20) NOT NULL DEFAULT 'uploaded',
+					  total_rows INT NOT NULL DEFAULT 0,
+					  valid_rows INT NOT NULL DEFAULT 0,
+					  invalid_rows INT NOT NULL DEFAULT 0,
+					  imported_rows INT NOT NULL DEFAULT 0,
+					  failed_rows INT NOT NULL DEFAULT 0,
+					  created_by_id char(36) NOT NULL REFERENCES users(id) ON DELETE RESTRICT,
+					  created_at INT8 NOT NULL,
+					  updated_at INT8 NOT NULL,
+					  completed_at INT8 DEFAULT NULL,
+					  PRIMARY KEY (id)
+					) ;`,
+					`CREATE TABLE IF NOT EXISTS import_row (
+					  id char(36) NOT NULL,
+					  job_id char(36) NOT NULL REFERENCES import_job(id) ON DELETE CASCADE,
+					  row_number INT NOT NULL,
+					  cells TEXT NOT NULL DEFAULT '',
+					  status varchar(20) NOT NULL DEFAULT 'pending',
+					  error TEXT NOT NULL DEFAULT '',
+					  customer_id char(36) DEFAULT NULL REFERENCES customer(id) ON DELETE SET NULL,
+					  account_id char(36) DEFAULT NULL REFERENCES account(id) ON DELETE SET NULL,
+					  transaction_id char(36) DEFAULT NULL REFERENCES transaction(id) ON DELETE SET NULL,
+					  PRIMARY KEY (id),
+					  UNIQUE (job_id, row_number)
+					) ;`,
+				}
+
+				for _, q1 := range statements {
+					if _, err := tx.Exec(q1); err != nil {
+						return errors.Wrapf(err, "Query failed %s", q1)
+					}
+				}
+
+				return nil
+			},
+			Rollback: func(tx *sql.Tx) error {
+				statements := []string{
+					`DROP TABLE IF EXISTS import_row`,
+					`DROP TABLE IF EXISTS import_job`,
+				}
+
+				for _, q1 := range statements {
+					if _, err := tx.Exec(q1); err != nil {
+						return errors.Wrapf(err, "Query failed %s", q1)
+					}
+				}
+
+				return nil
+			},
+		},
+		// Create tables for customer portal login codes and the withdrawals customers request from it.
+		{
+			ID: "20261018-21",
+			Migrate: func(tx *sql.Tx) error {
+				statements := []string{
+					`CREATE TABLE IF NOT EXISTS customer_login_code (
+					  id char(36) NOT NULL,
+					  customer_id char(36) NOT NULL REFERENCES customer(id) ON DELETE CASCADE,
+					  phone_number varchar(20) NOT NULL,
+					  code_hash varchar(256) NOT NULL,
+					  attempts INT NOT NULL DEFAULT 0,
+					  expires_at INT8 NOT NULL,
+					  used_at INT8 DEFAULT NULL,
+					  created_at INT8 NOT NULL,
+					  PRIMARY KEY (id)
+					) ;`,
+					`CREATE INDEX IF NOT EXISTS idx_customer_login_code_customer ON customer_login_code (customer_id, created_at)`,
+					`CREATE TABLE IF NOT EXISTS withdrawal_request (
+					  id char(36) NOT NULL,
+					  customer_id char(36) NOT NULL REFERENCES customer(id) ON DELETE CASCADE,
+					  account_id char(36) NOT NULL REFERENCES account(id) ON DELETE CASCADE,
+					  amount INT8 NOT NULL,
+					  note varchar(500) NOT NULL DEFAULT '',
+					  status varchar(20) NOT NULL DEFAULT 'pending',
+					  reason varchar(500) NOT NULL DEFAULT '',
+					  decided_by_id char(36) DEFAULT NULL REFERENCES users(id) ON DELETE SET NULL,
+					  decided_at INT8 DEFAULT NULL,
+					  transaction_id char(36) DEFAULT NULL REFERENCES transaction(id) ON DELETE SET NULL,
+					  created_at INT8 NOT NULL,
+					  updated_at INT8 NOT NULL,
+					  PRIMARY KEY (id)
+					) ;`,
+					`CREATE INDEX IF NOT EXISTS idx_withdrawal_request_account ON withdrawal_request (account_id, status)`,
+				}
+
+				for _, q1 := range statements {
+					if _, err := tx.Exec(q1); err != nil {
+						return errors.Wrapf(err, "Query failed %s", q1)
+					}
+				}
+
+				return nil
+			},
+			Rollback: func(tx *sql.Tx) error {
+				statements := []string{
+					`DROP TABLE IF EXISTS withdrawal_request`,
+					`DROP TABLE IF EXISTS customer_login_code`,
+				}
+
+				for _, q1 := range statements {
+					if _, err := tx.Exec(q1); err != nil {
+						return errors.Wrapf(err, "Query failed %s", q1)
+					}
+				}
+
+				return nil
+			},
+		},
+		// Create table for the commands customers send by SMS, also used to rate limit them per phone.
+		{
+			ID: "20261018-22",
+			Migrate: func(tx *sql.Tx) error {
+				statements := []string{
+					`CREATE TABLE IF NOT EXISTS inbound_message (
+					  id char(36) NOT NULL,
+					  phone_number varchar(20) NOT NULL,
+					  message varchar(500) NOT NULL DEFAULT '',
+					  command varchar(20) NOT NULL DEFAULT '',
+					  account_id char(36) DEFAULT NULL REFERENCES account(id) ON DELETE SET NULL,
+					  status varchar(20) NOT NULL,
+					  created_at INT8 NOT NULL,
+					  PRIMARY KEY (id)
+					) ;`,
+					`CREATE INDEX IF NOT EXISTS idx_inbound_message_phone ON inbound_message (phone_number, created_at)`,
+				}
+
+				for _, q1 := range statements {
+					if _, err := tx.Exec(q1); err != nil {
+						return errors.Wrapf(err, "Query failed %s", q1)
+					}
+				}
+
+				return nil
+			},
+			Rollback: func(tx *sql.Tx) error {
+				q1 := `DROP TABLE IF EXISTS inbound_message`
+				if _, err := tx.Exec(q1); err != nil {
+					return errors.Wrapf(err, "Query failed %s", q1)
+				}
+				return nil
+			},
+		},
 		// TODO: store dates in unix
 	}
 }
 
+// moneyColumns lists the columns that hold amounts of money, stored as kobo.
+var moneyColumns = []struct {
+	table, column string
+	hasDefault    bool
+}{
+	{"account", "target", true},
+	{"account", "balance", true},
+	{"transaction", "opening_balance", false},
+	{"transaction", "amount", true},
+	{"product", "price", false},
+	{"product", "cost_price", true},
+	{"sale", "amount", false},
+	{"sale", "amount_tender", false},
+	{"sale", "balance", false},
+	{"sale_item", "unit_price", false},
+	{"sale_item", "unit_cost_price", false},
+	{"payment", "amount", false},
+	{"profit", "amount", false},
+	{"ds_commission", "amount", false},
+	{"expenditure", "amount", false},
+	{"reps_expense", "amount", false},
+	{"bank_deposit", "amount", false},
+	{"daily_summary", "income", false},
+	{"daily_summary", "expenditure", false},
+	{"daily_summary", "bank_deposit", false},
+	{"posting", "debit", true},
+	{"posting", "credit", true},
+}
+
 // dropTypeIfExists executes drop type.
 func dropTypeIfExists(tx *sql.Tx, name string) error {
 	q := "DROP TYPE IF EXISTS " + name
@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"merryworld/surebank/internal/platform/auth"
+	"merryworld/surebank/internal/platform/money"
 	"merryworld/surebank/internal/platform/web"
 	"merryworld/surebank/internal/platform/web/webcontext"
 	"merryworld/surebank/internal/postgres/models"
@@ -20,28 +21,28 @@ import (
 
 // Product
 type Product struct {
-	ID           string      `json:"id"`
-	BrandID      string      `json:"brand_id"`
-	CategoryID   string      `json:"category_id"`
-	Brand        string      `json:"brand"`
-	Category     string      `json:"category"`
-	Name         string      `json:"name"`
-	Description  string      `json:"description"`
-	Sku          string      `json:"sku"`
-	Barcode      string      `json:"barcode"`
-	Price        float64     `json:"price"`
-	CostPrice    float64     `json:"cost_price"`
-	StockBalance int         `json:"stock_balance"`
-	ReorderLevel int         `json:"reorder_level"`
-	Image        string      `json:"image"`
-	CreatedAt    time.Time   `json:"created_at"`
-	UpdatedAt    time.Time   `json:"updated_at"`
-	ArchivedAt   null.Time   `json:"archived_at"`
-	CreatedByID  string      `json:"created_by_id"`
-	CreatedBy    string      `json:"created_by"`
-	UpdatedByID  string      `json:"updated_by_id"`
-	UpdatedBy    string      `json:"updated_by"`
-	ArchivedByID null.String `json:"archived_by_id"`
+	ID           string       `json:"id"`
+	BrandID      string       `json:"brand_id"`
+	CategoryID   string       `json:"category_id"`
+	Brand        string       `json:"brand"`
+	Category     string       `json:"category"`
+	Name         string       `json:"name"`
+	Description  string       `json:"description"`
+	Sku          string       `json:"sku"`
+	Barcode      string       `json:"barcode"`
+	Price        money.Amount `json:"price"`
+	CostPrice    money.Amount `json:"cost_price"`
+	StockBalance int          `json:"stock_balance"`
+	ReorderLevel int          `json:"reorder_level"`
+	Image        string       `json:"image"`
+	CreatedAt    time.Time    `json:"created_at"`
+	UpdatedAt    time.Time    `json:"updated_at"`
+	ArchivedAt   null.Time    `json:"archived_at"`
+	CreatedByID  string       `json:"created_by_id"`
+	CreatedBy    string       `json:"created_by"`
+	UpdatedByID  string       `json:"updated_by_id"`
+	UpdatedBy    string       `json:"updated_by"`
+	ArchivedByID null.String  `json:"archived_by_id"`
 }
 
 func (m Product) ToModel() models.Product {
@@ -53,8 +54,8 @@ func (m Product) ToModel() models.Product {
 		Description: m.Description,
 		Sku:         m.Sku,
 		Barcode:     m.Barcode,
-		Price:       m.Price,
-		CostPrice:   m.CostPrice,
+		Price:       m.Price.Kobo(),
+		CostPrice:   m.CostPrice.Kobo(),
 
 		ReorderLevel: m.ReorderLevel,
 		Image:        null.StringFrom(m.Image),
@@ -76,8 +77,8 @@ func ProductFromModel(product *models.Product) *Product {
 		Description:  product.Description,
 		Sku:          product.Sku,
 		Barcode:      product.Barcode,
-		Price:        product.Price,
-		CostPrice:    product.CostPrice,
+		Price:        money.Amount(product.Price),
+		CostPrice:    money.Amount(product.CostPrice),
 		StockBalance: product.StockBalance,
 		ReorderLevel: product.ReorderLevel,
 		Image:        product.Image.String,
@@ -103,20 +104,20 @@ func ProductFromModel(product *models.Product) *Product {
 
 // ProductResponse represent a Product that is returned for display
 type ProductResponse struct {
-	ID           string  `json:"id"`
-	BrandID      string  `json:"brand_id"`
-	Brand        string  `json:"brand"`
-	CategoryID   string  `json:"category_id"`
-	Category     string  `json:"category"`
-	Name         string  `json:"name"`
-	Description  string  `json:"description"`
-	Sku          string  `json:"sku"`
-	Barcode      string  `json:"barcode"`
-	Price        float64 `json:"price"`
-	CostPrice    float64 `json:"cost_price"`
-	StockBalance int     `json:"stock_balance"`
-	ReorderLevel int     `json:"reorder_level"`
-	Image        string  `json:"image"`
+	ID           string       `json:"id"`
+	BrandID      string       `json:"brand_id"`
+	Brand        string       `json:"brand"`
+	CategoryID   string       `json:"category_id"`
+	Category     string       `json:"category"`
+	Name         string       `json:"name"`
+	Description  string       `json:"description"`
+	Sku          string       `json:"sku"`
+	Barcode      string       `json:"barcode"`
+	Price        money.Amount `json:"price"`
+	CostPrice    money.Amount `json:"cost_price"`
+	StockBalance int          `json:"stock_balance"`
+	ReorderLevel int          `json:"reorder_level"`
+	Image        string       `json:"image"`
 
 	CreatedAt   web.TimeResponse `json:"created_at"`
 	UpdatedAt   web.TimeResponse `json:"updated_at"`
@@ -171,17 +172,17 @@ func (m *Products) Response(ctx context.Context) []*ProductResponse {
 
 // ProductCreateRequest contains information needed to create a new Product.
 type ProductCreateRequest struct {
-	Name         string   `json:"name" validate:"required,unique"  example:"Bread"`
-	BrandID      string   `json:"brand_id" validate:"required"`
-	CategoryID   string   `json:"category_id" validate:"required"`
-	Description  string   `json:"description"`
-	Sku          string   `json:"sku" validate:"required,unique"`
-	Barcode      string   `json:"barcode" validate:"required,unique"`
-	Price        float64  `json:"price" validate:"required"`
-	CostPrice    float64  `json:"cost_price" validate:"required"`
-	ReorderLevel int      `json:"reorder_level"`
-	Image        string   `json:"image"`
-	Categories   []string `json:"categories"`
+	Name         string       `json:"name" validate:"required,unique"  example:"Bread"`
+	BrandID      string       `json:"brand_id" validate:"required"`
+	CategoryID   string       `json:"category_id" validate:"required"`
+	Description  string       `json:"description"`
+	Sku          string       `json:"sku" validate:"required,unique"`
+	Barcode      string       `json:"barcode" validate:"required,unique"`
+	Price        money.Amount `json:"price" validate:"required"`
+	CostPrice    money.Amount `json:"cost_price" validate:"required"`
+	ReorderLevel int          `json:"reorder_level"`
+	Image        string       `json:"image"`
+	Categories   []string     `json:"categories"`
 }
 
 // ProductReadRequest defines the information needed to read a Product.
@@ -195,18 +196,18 @@ type ProductReadRequest struct {
 // changed. It uses pointer fields so we can differentiate between a field that
 // was not provided and a field that was provided as explicitly blank.
 type ProductUpdateRequest struct {
-	ID           string   `json:"id" example:"985f1746-1d9f-459f-a2d9-fc53ece5ae86"`
-	Name         *string  `json:"name" example:"Bread"`
-	BrandID      *string  `json:"brand_id"`
-	CategoryID   *string  `json:"category_id"`
-	Description  *string  `json:"description"`
-	Sku          *string  `json:"sku"`
-	Barcode      *string  `json:"barcode"`
-	Price        *float64 `json:"price"`
-	CostPrice    *float64 `json:"cost_price"`
-	ReorderLevel *int     `json:"reorder_level"`
-	Image        *string  `json:"image"`
-	Categories   []string `json:"categories"`
+	ID           string        `json:"id" example:"985f1746-1d9f-459f-a2d9-fc53ece5ae86"`
+	Name         *string       `json:"name" example:"Bread"`
+	BrandID      *string       `json:"brand_id"`
+	CategoryID   *string       `json:"category_id"`
+	Description  *string       `json:"description"`
+	Sku          *string       `json:"sku"`
+	Barcode      *string       `json:"barcode"`
+	Price        *money.Amount `json:"price"`
+	CostPrice    *money.Amount `json:"cost_price"`
+	ReorderLevel *int          `json:"reorder_level"`
+	Image        *string       `json:"image"`
+	Categories   []string      `json:"categories"`
 }
 
 // AddProductToCategoryRequest contains the information needed to link a product to a category
@@ -466,11 +467,11 @@ func (repo *Repository) UpdateProduct(ctx context.Context, claims auth.Claims, r
 	}
 
 	if req.Price != nil {
-		cols[models.ProductColumns.Price] = *req.Price
+		cols[models.ProductColumns.Price] = req.Price.Kobo()
 	}
 
 	if req.CostPrice != nil {
-		cols[models.ProductColumns.CostPrice] = *req.CostPrice
+		cols[models.ProductColumns.CostPrice] = req.CostPrice.Kobo()
 	}
 
 	if req.ReorderLevel != nil {
@@ -0,0 +1,118 @@
+package sms_command
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
+
+	"merryworld/surebank/internal/customer"
+	"merryworld/surebank/internal/platform/money"
+	"merryworld/surebank/internal/platform/notify"
+	"merryworld/surebank/internal/postgres/models"
+	"merryworld/surebank/internal/transaction"
+)
+
+// Repository defines the required dependencies for the SMS commands.
+type Repository struct {
+	DbConn       *sqlx.DB
+	CustomerRepo *customer.Repository
+	notifySMS    notify.SMS
+}
+
+// NewRepository creates a new Repository that defines dependencies for the SMS commands.
+func NewRepository(db *sqlx.DB, customerRepo *customer.Repository, notifySMS notify.SMS) *Repository {
+	return &Repository{
+		DbConn:       db,
+		CustomerRepo: customerRepo,
+		notifySMS:    notifySMS,
+	}
+}
+
+// Commands customers send by SMS, each followed by the number of one of their accounts.
+const (
+	Command_Balance   = "BAL"
+	Command_Statement = "STMT"
+)
+
+// Statuses of the messages customers send. Messages are replied to unless they are not a
+// command, are for an account of another customer or the phone sent too many of them.
+const (
+	Status_Replied  = "replied"
+	Status_Invalid  = "invalid"
+	Status_Rejected = "rejected"
+	Status_Limited  = "limited"
+)
+
+const (
+	// MaxMessagesPerHour is the most messages a phone is replied to in an hour.
+	MaxMessagesPerHour = 10
+	// StatementSize is the number of transactions in a mini statement.
+	StatementSize = 5
+)
+
+// ErrUnknownCommand occurs when a message is not one of the commands.
+var ErrUnknownCommand = errors.New("Unknown command")
+
+// Command is a request for the balance or statement of an account sent by SMS.
+type Command struct {
+	Name          string
+	AccountNumber string
+}
+
+// ParseCommand reads a command like "BAL SB12345" from a message, ignoring case and spaces.
+func ParseCommand(message string) (Command, error) {
+	fields := strings.Fields(strings.ToUpper(message))
+	if len(fields) != 2 {
+		return Command{}, errors.WithStack(ErrUnknownCommand)
+	}
+
+	switch fields[0] {
+	case Command_Balance, Command_Statement:
+	default:
+		return Command{}, errors.WithStack(ErrUnknownCommand)
+	}
+
+	return Command{
+		Name:          fields[0],
+		AccountNumber: fields[1],
+	}, nil
+}
+
+// helpMessage is the reply to a message that is not a command.
+func helpMessage() string {
+	return fmt.Sprintf("SURE-BANK\nSend %s <account number> for your balance or %s <account number> for your last %d transactions",
+		Command_Balance, Command_Statement, StatementSize)
+}
+
+// rejectedMessage is the reply when the account is not found or belongs to another customer,
+// the two are not told apart so account numbers cannot be probed.
+func rejectedMessage(accountNumber string) string {
+	return fmt.Sprintf("SURE-BANK\nAcc %s is not registered to this phone number", accountNumber)
+}
+
+// balanceMessage is the reply to a balance command.
+func balanceMessage(accountNumber string, balance, available money.Amount) string {
+	return fmt.Sprintf("SURE-BANK\nBalance\nAcc: %s\nBal: %s NGN\nAvail Bal: %s NGN", accountNumber, balance, available)
+}
+
+// statementMessage is the reply to a statement command, txs are the most recent transactions
+// of the account, newest first.
+func statementMessage(accountNumber string, txs models.TransactionSlice, balance money.Amount) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "SURE-BANK\nMini Statement\nAcc: %s", accountNumber)
+	if len(txs) == 0 {
+		b.WriteString("\nNo transactions")
+	}
+	for _, tx := range txs {
+		dir := "DR"
+		if tx.TXType == transaction.TransactionType_Deposit.String() {
+			dir = "CR"
+		}
+		fmt.Fprintf(&b, "\n%s %s %s", time.Unix(tx.EffectiveDate, 0).UTC().Format("02/01"), dir, money.Amount(tx.Amount))
+	}
+	fmt.Fprintf(&b, "\nBal: %s NGN", balance)
+	return b.String()
+}
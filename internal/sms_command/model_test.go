@@ -0,0 +1,89 @@
+package sms_command
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"merryworld/surebank/internal/platform/money"
+	"merryworld/surebank/internal/platform/tests"
+	"merryworld/surebank/internal/postgres/models"
+	"merryworld/surebank/internal/transaction"
+)
+
+// TestParseCommand validates commands are read from the messages customers send.
+func TestParseCommand(t *testing.T) {
+	type commandTest struct {
+		Message string
+		Command Command
+		Err     error
+	}
+
+	var commandTests = []commandTest{
+		{"BAL SB12345", Command{Command_Balance, "SB12345"}, nil},
+		{"stmt ds54321", Command{Command_Statement, "DS54321"}, nil},
+		{"  Bal   SB12345 \n", Command{Command_Balance, "SB12345"}, nil},
+		{"BAL", Command{}, ErrUnknownCommand},
+		{"BAL SB12345 SB54321", Command{}, ErrUnknownCommand},
+		{"BALANCE SB12345", Command{}, ErrUnknownCommand},
+		{"", Command{}, ErrUnknownCommand},
+	}
+
+	t.Log("Given the need to read the commands customers send by SMS.")
+	{
+		for i, tt := range commandTests {
+			t.Logf("\tTest: %d\tWhen the message is %q.", i, tt.Message)
+			{
+				cmd, err := ParseCommand(tt.Message)
+				if errors.Cause(err) != tt.Err {
+					t.Logf("\t\tGot : %v", err)
+					t.Logf("\t\tWant: %v", tt.Err)
+					t.Fatalf("\t%s\tShould get the expected error.", tests.Failed)
+				}
+				if cmd != tt.Command {
+					t.Logf("\t\tGot : %+v", cmd)
+					t.Logf("\t\tWant: %+v", tt.Command)
+					t.Fatalf("\t%s\tShould read the command.", tests.Failed)
+				}
+				t.Logf("\t%s\tShould read the command.", tests.Success)
+			}
+		}
+	}
+}
+
+// TestStatementMessage validates the mini statement lists the transactions with their direction.
+func TestStatementMessage(t *testing.T) {
+	day := time.Date(2026, 10, 18, 9, 0, 0, 0, time.UTC)
+
+	txs := models.TransactionSlice{
+		{TXType: transaction.TransactionType_Withdrawal.String(), Amount: 20000, EffectiveDate: day.Unix()},
+		{TXType: transaction.TransactionType_Deposit.String(), Amount: 50050, EffectiveDate: day.AddDate(0, 0, -1).Unix()},
+	}
+
+	t.Log("Given the need to send customers a mini statement by SMS.")
+	{
+		t.Log("\tTest: 0\tWhen the account has transactions.")
+		{
+			msg := statementMessage("DS54321", txs, money.Amount(150000))
+			want := "SURE-BANK\nMini Statement\nAcc: DS54321\n18/10 DR 200.00\n17/10 CR 500.50\nBal: 1500.00 NGN"
+			if msg != want {
+				t.Logf("\t\tGot : %q", msg)
+				t.Logf("\t\tWant: %q", want)
+				t.Fatalf("\t%s\tShould list the transactions newest first.", tests.Failed)
+			}
+			t.Logf("\t%s\tShould list the transactions newest first.", tests.Success)
+		}
+
+		t.Log("\tTest: 1\tWhen the account has no transactions.")
+		{
+			msg := statementMessage("DS54321", nil, 0)
+			if !strings.Contains(msg, "No transactions") {
+				t.Logf("\t\tGot : %q", msg)
+				t.Fatalf("\t%s\tShould say there are no transactions.", tests.Failed)
+			}
+			t.Logf("\t%s\tShould say there are no transactions.", tests.Success)
+		}
+	}
+}
@@ -0,0 +1,188 @@
+package sms_command
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/pborman/uuid"
+	"github.com/pkg/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	. "github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"gopkg.in/DataDog/dd-trace-go.v1/ddtrace/tracer"
+
+	"merryworld/surebank/internal/account"
+	"merryworld/surebank/internal/customer"
+	"merryworld/surebank/internal/platform/auth"
+	"merryworld/surebank/internal/platform/money"
+	"merryworld/surebank/internal/platform/notify"
+	"merryworld/surebank/internal/postgres/models"
+)
+
+// Handle replies to a message a customer sent by SMS with the balance or the mini statement of
+// the account in the command. Only the customer of the account is replied to with its details
+// and a phone that sent MaxMessagesPerHour messages in the last hour, with or without the country
+// code, is not replied to at all. Every message is logged with the outcome.
+func (repo *Repository) Handle(ctx context.Context, sms notify.InboundSMS, now time.Time) error {
+	span, ctx := tracer.StartSpanFromContext(ctx, "internal.sms_command.Handle")
+	defer span.Finish()
+
+	// If now empty set it to the current time.
+	if now.IsZero() {
+		now = time.Now()
+	}
+
+	// Always store the time as UTC.
+	now = now.UTC()
+
+	// Postgres truncates times to milliseconds when storing. We and do the same
+	// here so the value we return is consistent with what we store.
+	now = now.Truncate(time.Millisecond)
+
+	// Messages are counted by the normalized number so a phone is limited the same whether the
+	// provider sends it with the country code or not.
+	phoneNumber := customer.NormalizePhone(sms.From)
+	if phoneNumber == "" {
+		phoneNumber = sms.From
+	}
+
+	m := models.InboundMessage{
+		ID:          uuid.NewRandom().String(),
+		PhoneNumber: truncate(phoneNumber, 20),
+		Message:     truncate(sms.Message, 500),
+		CreatedAt:   now.Unix(),
+	}
+
+	tx, err := repo.DbConn.Begin()
+	if err != nil {
+		return err
+	}
+
+	// Messages from the same phone are counted and logged one at a time so concurrent messages
+	// cannot all pass the limit.
+	if _, err := tx.ExecContext(ctx, "SELECT pg_advisory_xact_lock(hashtext($1))", m.PhoneNumber); err != nil {
+		_ = tx.Rollback()
+		return errors.Wrap(err, "Cannot lock phone number")
+	}
+
+	sent, err := models.InboundMessages(
+		models.InboundMessageWhere.PhoneNumber.EQ(m.PhoneNumber),
+		models.InboundMessageWhere.CreatedAt.GT(now.Add(-time.Hour).Unix()),
+	).Count(ctx, tx)
+	if err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+
+	var reply string
+	if sent >= MaxMessagesPerHour {
+		m.Status = Status_Limited
+	} else {
+		reply, err = repo.reply(ctx, &m, sms, now)
+		if err != nil {
+			_ = tx.Rollback()
+			return err
+		}
+	}
+
+	if err := m.Insert(ctx, tx, boil.Infer()); err != nil {
+		_ = tx.Rollback()
+		return errors.WithMessage(err, "Insert inbound message failed")
+	}
+
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+
+	if reply == "" {
+		return nil
+	}
+
+	if err := repo.notifySMS.SendStr(ctx, sms.From, reply); err != nil {
+		return errors.WithMessage(err, "Send SMS reply failed")
+	}
+
+	return nil
+}
+
+// reply runs the command in the message and returns the reply, setting the outcome on m.
+func (repo *Repository) reply(ctx context.Context, m *models.InboundMessage, sms notify.InboundSMS, now time.Time) (string, error) {
+	cmd, err := ParseCommand(sms.Message)
+	if err != nil {
+		m.Status = Status_Invalid
+		return helpMessage(), nil
+	}
+	m.Command = cmd.Name
+
+	acc, err := models.Accounts(
+		models.AccountWhere.Number.EQ(cmd.AccountNumber),
+		models.AccountWhere.ArchivedAt.IsNull(),
+	).One(ctx, repo.DbConn)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			m.Status = Status_Rejected
+			return rejectedMessage(cmd.AccountNumber), nil
+		}
+		return "", err
+	}
+
+	customers, err := repo.CustomerRepo.FindByPhone(ctx, auth.Claims{}, sms.From)
+	if err != nil {
+		return "", err
+	}
+
+	var isOwner bool
+	for _, c := range customers {
+		if c.ID == acc.CustomerID {
+			isOwner = true
+			break
+		}
+	}
+	if !isOwner {
+		m.Status = Status_Rejected
+		return rejectedMessage(cmd.AccountNumber), nil
+	}
+	m.AccountID = null.StringFrom(acc.ID)
+
+	balance := money.Amount(acc.Balance)
+
+	var reply string
+	switch cmd.Name {
+	case Command_Balance:
+		held, err := account.HeldAmount(ctx, repo.DbConn, acc.ID, now)
+		if err != nil {
+			return "", err
+		}
+		reply = balanceMessage(acc.Number, balance, account.AvailableBalance(balance, held))
+	case Command_Statement:
+		// Transactions that were reversed cancel out with their reversals and are left out as on
+		// the portal.
+		txs, err := models.Transactions(
+			models.TransactionWhere.AccountID.EQ(acc.ID),
+			models.TransactionWhere.ArchivedAt.IsNull(),
+			models.TransactionWhere.ReversalOfID.IsNull(),
+			Where(fmt.Sprintf("NOT EXISTS (SELECT 1 FROM %s r WHERE r.%s = %s.%s)",
+				models.TableNames.Transaction, models.TransactionColumns.ReversalOfID,
+				models.TableNames.Transaction, models.TransactionColumns.ID)),
+			OrderBy(models.TransactionColumns.CreatedAt+" desc"),
+			Limit(StatementSize),
+		).All(ctx, repo.DbConn)
+		if err != nil {
+			return "", err
+		}
+		reply = statementMessage(acc.Number, txs, balance)
+	}
+
+	m.Status = Status_Replied
+	return reply, nil
+}
+
+// truncate shortens s to at most n bytes so it fits in its column.
+func truncate(s string, n int) string {
+	if len(s) > n {
+		return s[:n]
+	}
+	return s
+}
@@ -0,0 +1,318 @@
+package till
+
+import (
+	"context"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+
+	"merryworld/surebank/internal/platform/money"
+	"merryworld/surebank/internal/platform/web"
+	"merryworld/surebank/internal/postgres/models"
+	"merryworld/surebank/internal/user"
+)
+
+// Repository defines the required dependencies for Till.
+type Repository struct {
+	DbConn *sqlx.DB
+}
+
+// NewRepository creates a new Repository that defines dependencies for Till.
+func NewRepository(db *sqlx.DB) *Repository {
+	return &Repository{
+		DbConn: db,
+	}
+}
+
+const (
+	// Status_Submitted is a cash-up that is waiting for a supervisor, the rep can still recount.
+	Status_Submitted string = "submitted"
+	// Status_SignedOff is a cash-up that a supervisor has accepted, it can no longer be changed.
+	Status_SignedOff string = "signed_off"
+)
+
+// DateFormat is the layout of the business day of a cash-up in responses and links.
+const DateFormat = "2006-01-02"
+
+// RepeatedShortages is the number of short cash-ups in a period from which a rep is highlighted
+// in the shortages report.
+const RepeatedShortages = 2
+
+// Denominations lists the naira notes and coins a till is counted in, largest first.
+var Denominations = []money.Amount{
+	money.Naira(1000),
+	money.Naira(500),
+	money.Naira(200),
+	money.Naira(100),
+	money.Naira(50),
+	money.Naira(20),
+	money.Naira(10),
+	money.Naira(5),
+	money.Naira(2),
+	money.Naira(1),
+}
+
+// Expected is the cash a rep should hand over for a day, worked out from the postings to cash on
+// hand of the deposits, sales, withdrawals and expenses recorded for the rep.
+type Expected struct {
+	CashDeposits    money.Amount `json:"cash_deposits"`
+	CashSales       money.Amount `json:"cash_sales"`
+	WithdrawalsPaid money.Amount `json:"withdrawals_paid"`
+	RepExpenses     money.Amount `json:"rep_expenses"`
+}
+
+// Total returns the cash received less the cash paid out.
+func (e Expected) Total() money.Amount {
+	return e.CashDeposits + e.CashSales - e.WithdrawalsPaid - e.RepExpenses
+}
+
+// Count is the number of notes or coins of one denomination in the till.
+type Count struct {
+	Denomination money.Amount `json:"denomination" validate:"required,gt=0"`
+	Count        int64        `json:"count" validate:"gte=0"`
+}
+
+// CountedTotal returns the cash in the till from the counts of each denomination.
+func CountedTotal(counts []Count) money.Amount {
+	var total money.Amount
+	for _, c := range counts {
+		total += c.Denomination.Mul(c.Count)
+	}
+	return total
+}
+
+// DayRange returns the first and last second of the business day of the date. Dates are picked
+// as UTC and the business day is in WAT, an hour ahead.
+func DayRange(date time.Time) (time.Time, time.Time) {
+	date = time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, time.UTC)
+	start := date.Add(-1 * time.Hour)
+	end := date.Add(23*time.Hour - time.Second)
+	return start, end
+}
+
+// BusinessDate returns the date of the business day the time falls in, as picked in UTC.
+func BusinessDate(t time.Time) time.Time {
+	t = t.UTC().Add(time.Hour)
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+// Session is the end of day cash-up of a sales rep.
+type Session struct {
+	ID         string `json:"id" example:"985f1746-1d9f-459f-a2d9-fc53ece5ae86"`
+	BranchID   string `json:"branch_id" example:"985f1746-1d9f-459f-a2d9-fc53ece5ae86"`
+	SalesRepID string `json:"sales_rep_id" example:"985f1746-1d9f-459f-a2d9-fc53ece5ae86"`
+	// Date is the business day of the cash-up, as picked in UTC.
+	Date time.Time `json:"date"`
+	// Expected is the cash worked out from the ledger when the till was last counted.
+	Expected      Expected     `json:"expected"`
+	Counted       money.Amount `json:"counted"`
+	Variance      money.Amount `json:"variance"`
+	Status        string       `json:"status" example:"submitted"`
+	Notes         string       `json:"notes"`
+	SubmittedByID string       `json:"submitted_by_id"`
+	SignedOffByID *string      `json:"signed_off_by_id,omitempty"`
+	SignedOffAt   *time.Time   `json:"signed_off_at,omitempty"`
+	CreatedAt     time.Time    `json:"created_at"`
+	UpdatedAt     time.Time    `json:"updated_at"`
+
+	Counts      []Count    `json:"counts,omitempty"`
+	SalesRep    *user.User `json:"sales_rep,omitempty"`
+	SubmittedBy *user.User `json:"submitted_by,omitempty"`
+	SignedOffBy *user.User `json:"signed_off_by,omitempty"`
+	BranchName  string     `json:"branch_name,omitempty"`
+}
+
+// FromModel converts the till session model and its loaded relations to a Session.
+func FromModel(rec *models.TillSession) *Session {
+	s := &Session{
+		ID:         rec.ID,
+		BranchID:   rec.BranchID,
+		SalesRepID: rec.SalesRepID,
+		Date:       BusinessDate(time.Unix(rec.Date, 0)),
+		Expected: Expected{
+			CashDeposits:    money.Amount(rec.CashDeposits),
+			CashSales:       money.Amount(rec.CashSales),
+			WithdrawalsPaid: money.Amount(rec.WithdrawalsPaid),
+			RepExpenses:     money.Amount(rec.RepExpenses),
+		},
+		Counted:       money.Amount(rec.Counted),
+		Variance:      money.Amount(rec.Variance),
+		Status:        rec.Status,
+		Notes:         rec.Notes,
+		SubmittedByID: rec.SubmittedByID,
+		SignedOffByID: rec.SignedOffByID.Ptr(),
+		CreatedAt:     time.Unix(rec.CreatedAt, 0).UTC(),
+		UpdatedAt:     time.Unix(rec.UpdatedAt, 0).UTC(),
+	}
+
+	if rec.SignedOffAt.Valid {
+		signedOffAt := time.Unix(rec.SignedOffAt.Int64, 0).UTC()
+		s.SignedOffAt = &signedOffAt
+	}
+
+	if rec.R != nil {
+		for _, c := range rec.R.TillCounts {
+			s.Counts = append(s.Counts, Count{
+				Denomination: money.Amount(c.Denomination),
+				Count:        c.Count,
+			})
+		}
+		if rec.R.SalesRep != nil {
+			s.SalesRep = user.FromModel(rec.R.SalesRep)
+		}
+		if rec.R.SubmittedBy != nil {
+			s.SubmittedBy = user.FromModel(rec.R.SubmittedBy)
+		}
+		if rec.R.SignedOffBy != nil {
+			s.SignedOffBy = user.FromModel(rec.R.SignedOffBy)
+		}
+		if rec.R.Branch != nil {
+			s.BranchName = rec.R.Branch.Name
+		}
+	}
+
+	return s
+}
+
+// Short reports whether less cash was counted than expected.
+func (m *Session) Short() bool {
+	return m.Variance < 0
+}
+
+// Response represents a till session that is returned for display.
+type Response struct {
+	ID              string            `json:"id" example:"985f1746-1d9f-459f-a2d9-fc53ece5ae86"`
+	BranchID        string            `json:"branch_id" example:"985f1746-1d9f-459f-a2d9-fc53ece5ae86"`
+	Branch          string            `json:"branch,omitempty"`
+	SalesRepID      string            `json:"sales_rep_id" example:"985f1746-1d9f-459f-a2d9-fc53ece5ae86"`
+	SalesRep        string            `json:"sales_rep,omitempty"`
+	Date            string            `json:"date" example:"2026-03-03"`
+	CashDeposits    money.Amount      `json:"cash_deposits"`
+	CashSales       money.Amount      `json:"cash_sales"`
+	WithdrawalsPaid money.Amount      `json:"withdrawals_paid"`
+	RepExpenses     money.Amount      `json:"rep_expenses"`
+	Expected        money.Amount      `json:"expected"`
+	Counted         money.Amount      `json:"counted"`
+	Variance        money.Amount      `json:"variance"`
+	Short           bool              `json:"short"`
+	Status          string            `json:"status" example:"submitted"`
+	Notes           string            `json:"notes"`
+	Counts          []Count           `json:"counts,omitempty"`
+	SubmittedByID   string            `json:"submitted_by_id"`
+	SubmittedBy     string            `json:"submitted_by,omitempty"`
+	SignedOffByID   *string           `json:"signed_off_by_id,omitempty"`
+	SignedOffBy     string            `json:"signed_off_by,omitempty"`
+	SignedOffAt     *web.TimeResponse `json:"signed_off_at,omitempty"`
+	CreatedAt       web.TimeResponse  `json:"created_at"`
+	UpdatedAt       web.TimeResponse  `json:"updated_at"`
+}
+
+// Response transforms Session to the Response that is used for display.
+func (m *Session) Response(ctx context.Context) *Response {
+	if m == nil {
+		return nil
+	}
+
+	r := &Response{
+		ID:              m.ID,
+		BranchID:        m.BranchID,
+		Branch:          m.BranchName,
+		SalesRepID:      m.SalesRepID,
+		Date:            m.Date.Format(DateFormat),
+		CashDeposits:    m.Expected.CashDeposits,
+		CashSales:       m.Expected.CashSales,
+		WithdrawalsPaid: m.Expected.WithdrawalsPaid,
+		RepExpenses:     m.Expected.RepExpenses,
+		Expected:        m.Expected.Total(),
+		Counted:         m.Counted,
+		Variance:        m.Variance,
+		Short:           m.Short(),
+		Status:          m.Status,
+		Notes:           m.Notes,
+		Counts:          m.Counts,
+		SubmittedByID:   m.SubmittedByID,
+		SignedOffByID:   m.SignedOffByID,
+		CreatedAt:       web.NewTimeResponse(ctx, m.CreatedAt),
+		UpdatedAt:       web.NewTimeResponse(ctx, m.UpdatedAt),
+	}
+
+	if m.SignedOffAt != nil {
+		at := web.NewTimeResponse(ctx, *m.SignedOffAt)
+		r.SignedOffAt = &at
+	}
+
+	if m.SalesRep != nil {
+		r.SalesRep = m.SalesRep.LastName + " " + m.SalesRep.FirstName
+	}
+	if m.SubmittedBy != nil {
+		r.SubmittedBy = m.SubmittedBy.LastName + " " + m.SubmittedBy.FirstName
+	}
+	if m.SignedOffBy != nil {
+		r.SignedOffBy = m.SignedOffBy.LastName + " " + m.SignedOffBy.FirstName
+	}
+
+	return r
+}
+
+// Sessions a list of Sessions.
+type Sessions []*Session
+
+// Response transforms a list of Sessions to a list of Responses.
+func (m *Sessions) Response(ctx context.Context) []*Response {
+	var l = make([]*Response, 0)
+	if m != nil && len(*m) > 0 {
+		for _, n := range *m {
+			l = append(l, n.Response(ctx))
+		}
+	}
+
+	return l
+}
+
+// Shortage sums up the short cash-ups of a rep over a period.
+type Shortage struct {
+	SalesRepID string       `json:"sales_rep_id" boil:"sales_rep_id"`
+	SalesRep   string       `json:"sales_rep" boil:"sales_rep"`
+	Sessions   int64        `json:"sessions" boil:"sessions"`
+	Shortages  int64        `json:"shortages" boil:"shortages"`
+	Total      money.Amount `json:"total" boil:"total"`
+}
+
+// Repeated reports whether the rep was short often enough in the period to be followed up.
+func (m *Shortage) Repeated() bool {
+	return m.Shortages >= RepeatedShortages
+}
+
+// SubmitRequest contains the cash counted by a rep at the end of the day.
+type SubmitRequest struct {
+	SalesRepID string    `json:"sales_rep_id" validate:"required,uuid" example:"985f1746-1d9f-459f-a2d9-fc53ece5ae86"`
+	Date       time.Time `json:"date" validate:"required"`
+	Counts     []Count   `json:"counts" validate:"required,dive"`
+	Notes      string    `json:"notes" validate:"max=256"`
+}
+
+// SignOffRequest defines the information needed for a supervisor to accept a cash-up.
+type SignOffRequest struct {
+	ID string `json:"id" validate:"required,uuid" example:"985f1746-1d9f-459f-a2d9-fc53ece5ae86"`
+}
+
+// ReadRequest defines the information needed to read a till session.
+type ReadRequest struct {
+	ID string `json:"id" validate:"required,uuid" example:"985f1746-1d9f-459f-a2d9-fc53ece5ae86"`
+}
+
+// FindRequest defines the possible options to search for till sessions.
+type FindRequest struct {
+	Where  string        `json:"where" example:"sales_rep_id = ? and status = ?"`
+	Args   []interface{} `json:"args" swaggertype:"array,string" example:"985f1746-1d9f-459f-a2d9-fc53ece5ae86,submitted"`
+	Order  []string      `json:"order" example:"date desc"`
+	Limit  *uint         `json:"limit" example:"10"`
+	Offset *uint         `json:"offset" example:"20"`
+}
+
+// ShortagesRequest defines the period and branch of the shortages report.
+type ShortagesRequest struct {
+	BranchID  string    `json:"branch_id" validate:"omitempty,uuid"`
+	StartDate time.Time `json:"start_date" validate:"required"`
+	EndDate   time.Time `json:"end_date" validate:"required"`
+}
@@ -0,0 +1,471 @@
+package till
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/pborman/uuid"
+	"github.com/pkg/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	. "github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"gopkg.in/DataDog/dd-trace-go.v1/ddtrace/tracer"
+
+	"merryworld/surebank/internal/ledger"
+	"merryworld/surebank/internal/platform/auth"
+	"merryworld/surebank/internal/platform/money"
+	"merryworld/surebank/internal/platform/web/webcontext"
+	"merryworld/surebank/internal/platform/web/weberror"
+	"merryworld/surebank/internal/postgres/models"
+)
+
+var (
+	// ErrNotFound abstracts the postgres not found error.
+	ErrNotFound = errors.New("Entity not found")
+
+	// ErrForbidden occurs when a user tries to do something that is forbidden to them according to our access control policies.
+	ErrForbidden = errors.New("Attempted action is not allowed")
+
+	// ErrLocked occurs when a cash-up that has been signed off is changed.
+	ErrLocked = errors.New("The cash-up has been signed off and can no longer be changed")
+
+	// ErrFutureDate occurs when a cash-up is submitted for a day that has not started.
+	ErrFutureDate = errors.New("Cash cannot be counted for a future date")
+
+	// ErrSignOffSelf occurs when a supervisor signs off their own till or a count they entered.
+	ErrSignOffSelf = errors.New("A cash-up must be signed off by someone other than the rep and the user that entered the count")
+
+	// ErrSignOffBranch occurs when a supervisor signs off the till of a rep at another branch.
+	ErrSignOffBranch = errors.New("Only a supervisor of the branch of the rep can sign off the cash-up")
+)
+
+// expectedCashQuery sums the postings to cash on hand of the deposits, sales, withdrawals and
+// expenses of a rep in a period. A reversal is a transaction of its own, posted by the admin that
+// approved it, so reversed transactions and their reversals are both left out. A replacement
+// posted by a correction counts for the rep and the time of the transaction it corrects.
+const expectedCashQuery = `select
+	coalesce((select sum(p.debit - p.credit) from posting p
+		inner join journal_entry j on j.id = p.journal_entry_id and j.source_type = $1
+		inner join transaction t on t.id = j.source_id
+		left join transaction o on o.id = t.correction_of_id
+		where p.ledger_account_code = $5 and t.tx_type = 'deposit' and t.reversal_of_id is null
+			and not exists (select 1 from transaction r where r.reversal_of_id = t.id)
+			and coalesce(o.sales_rep_id, t.sales_rep_id) = $6
+			and coalesce(o.created_at, t.created_at) >= $7 and coalesce(o.created_at, t.created_at) <= $8), 0) as cash_deposits,
+	coalesce((select sum(p.debit - p.credit) from posting p
+		inner join journal_entry j on j.id = p.journal_entry_id and j.source_type = $2
+		inner join sale s on s.id = j.source_id
+		where p.ledger_account_code = $5 and s.created_by_id = $6
+			and s.created_at >= $7 and s.created_at <= $8), 0) as cash_sales,
+	coalesce((select sum(p.credit - p.debit) from posting p
+		inner join journal_entry j on j.id = p.journal_entry_id and j.source_type = $3
+		inner join transaction t on t.id = j.source_id
+		left join transaction o on o.id = t.correction_of_id
+		where p.ledger_account_code = $5 and t.tx_type = 'withdrawal' and t.reversal_of_id is null
+			and not exists (select 1 from transaction r where r.reversal_of_id = t.id)
+			and coalesce(o.sales_rep_id, t.sales_rep_id) = $6
+			and coalesce(o.created_at, t.created_at) >= $7 and coalesce(o.created_at, t.created_at) <= $8), 0) as withdrawals_paid,
+	coalesce((select sum(p.credit - p.debit) from posting p
+		inner join journal_entry j on j.id = p.journal_entry_id and j.source_type = $4
+		inner join reps_expense e on e.id = j.source_id
+		where p.ledger_account_code = $5 and e.sales_rep_id = $6
+			and e.date >= $7 and e.date <= $8), 0) as rep_expenses`
+
+// ExpectedCash works out the cash the rep should hold at the end of the day from the ledger.
+func (repo *Repository) ExpectedCash(ctx context.Context, claims auth.Claims, salesRepID string, date time.Time) (*Expected, error) {
+	span, ctx := tracer.StartSpanFromContext(ctx, "internal.till.ExpectedCash")
+	defer span.Finish()
+
+	if claims.Audience == "" {
+		return nil, errors.WithStack(ErrForbidden)
+	}
+
+	return repo.expectedCash(ctx, salesRepID, date, repo.DbConn)
+}
+
+// expectedCash runs the expected cash query for the business day of the date.
+func (repo *Repository) expectedCash(ctx context.Context, salesRepID string, date time.Time, exec boil.ContextExecutor) (*Expected, error) {
+	start, end := DayRange(date)
+
+	var row struct {
+		CashDeposits    int64 `boil:"cash_deposits"`
+		CashSales       int64 `boil:"cash_sales"`
+		WithdrawalsPaid int64 `boil:"withdrawals_paid"`
+		RepExpenses     int64 `boil:"rep_expenses"`
+	}
+	err := models.NewQuery(SQL(expectedCashQuery,
+		ledger.SourceTransaction, ledger.SourceSale, ledger.SourceTransaction, ledger.SourceRepsExpense,
+		ledger.AccountCash, salesRepID, start.Unix(), end.Unix(),
+	)).Bind(ctx, exec, &row)
+	if err != nil {
+		return nil, errors.WithMessage(err, "Cannot work out the expected cash")
+	}
+
+	return &Expected{
+		CashDeposits:    money.Amount(row.CashDeposits),
+		CashSales:       money.Amount(row.CashSales),
+		WithdrawalsPaid: money.Amount(row.WithdrawalsPaid),
+		RepExpenses:     money.Amount(row.RepExpenses),
+	}, nil
+}
+
+// Find gets all the till sessions from the database based on the request params. Users that
+// are not admins only see their own cash-ups.
+func (repo *Repository) Find(ctx context.Context, claims auth.Claims, req FindRequest) (Sessions, error) {
+	span, ctx := tracer.StartSpanFromContext(ctx, "internal.till.Find")
+	defer span.Finish()
+
+	if claims.Audience == "" {
+		return nil, errors.WithStack(ErrForbidden)
+	}
+
+	var queries = []QueryMod{
+		Load(models.TillSessionRels.Branch),
+		Load(models.TillSessionRels.SalesRep),
+		Load(models.TillSessionRels.SubmittedBy),
+		Load(models.TillSessionRels.SignedOffBy),
+	}
+
+	if req.Where != "" {
+		queries = append(queries, Where(req.Where, req.Args...))
+	}
+
+	if !claims.HasRole(auth.RoleAdmin, auth.RoleSuperAdmin) {
+		queries = append(queries, models.TillSessionWhere.SalesRepID.EQ(claims.Subject))
+	}
+
+	if len(req.Order) > 0 {
+		for _, s := range req.Order {
+			queries = append(queries, OrderBy(s))
+		}
+	} else {
+		queries = append(queries, OrderBy(models.TillSessionColumns.Date+" desc"))
+	}
+
+	if req.Limit != nil {
+		queries = append(queries, Limit(int(*req.Limit)))
+	}
+
+	if req.Offset != nil {
+		queries = append(queries, Offset(int(*req.Offset)))
+	}
+
+	slice, err := models.TillSessions(queries...).All(ctx, repo.DbConn)
+	if err != nil {
+		return nil, err
+	}
+
+	var result Sessions
+	for _, rec := range slice {
+		result = append(result, FromModel(rec))
+	}
+
+	return result, nil
+}
+
+// Read gets the specified till session with its counts from the database.
+func (repo *Repository) Read(ctx context.Context, claims auth.Claims, req ReadRequest) (*Session, error) {
+	span, ctx := tracer.StartSpanFromContext(ctx, "internal.till.Read")
+	defer span.Finish()
+
+	if claims.Audience == "" {
+		return nil, errors.WithStack(ErrForbidden)
+	}
+
+	// Validate the request.
+	v := webcontext.Validator()
+	err := v.Struct(req)
+	if err != nil {
+		return nil, err
+	}
+
+	rec, err := models.TillSessions(
+		models.TillSessionWhere.ID.EQ(req.ID),
+		Load(models.TillSessionRels.TillCounts, OrderBy(models.TillCountColumns.Denomination+" desc")),
+		Load(models.TillSessionRels.Branch),
+		Load(models.TillSessionRels.SalesRep),
+		Load(models.TillSessionRels.SubmittedBy),
+		Load(models.TillSessionRels.SignedOffBy),
+	).One(ctx, repo.DbConn)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, weberror.NewError(ctx, ErrNotFound, http.StatusNotFound)
+		}
+		return nil, err
+	}
+
+	if rec.SalesRepID != claims.Subject && !claims.HasRole(auth.RoleAdmin, auth.RoleSuperAdmin) {
+		return nil, weberror.NewError(ctx, ErrForbidden, http.StatusForbidden)
+	}
+
+	return FromModel(rec), nil
+}
+
+// Submit records the cash counted by denomination against the cash expected for the day of the
+// rep. Admins can enter the count for any rep. Counting again before the supervisor signs off
+// replaces the previous count and works out the expected cash again.
+func (repo *Repository) Submit(ctx context.Context, claims auth.Claims, req SubmitRequest, now time.Time) (*Session, error) {
+	span, ctx := tracer.StartSpanFromContext(ctx, "internal.till.Submit")
+	defer span.Finish()
+
+	if claims.Audience == "" {
+		return nil, errors.WithStack(ErrForbidden)
+	}
+
+	if req.SalesRepID != claims.Subject && !claims.HasRole(auth.RoleAdmin, auth.RoleSuperAdmin) {
+		return nil, errors.WithStack(ErrForbidden)
+	}
+
+	// Validate the request.
+	v := webcontext.Validator()
+	err := v.Struct(req)
+	if err != nil {
+		return nil, err
+	}
+
+	// If now empty set it to the current time.
+	if now.IsZero() {
+		now = time.Now()
+	}
+
+	// Always store the time as UTC.
+	now = now.UTC()
+	// Postgres truncates times to milliseconds when storing. We and do the same
+	// here so the value we return is consistent with what we store.
+	now = now.Truncate(time.Millisecond)
+
+	start, _ := DayRange(req.Date)
+	if start.After(now) {
+		return nil, weberror.NewError(ctx, ErrFutureDate, http.StatusBadRequest)
+	}
+
+	tx, err := repo.DbConn.Begin()
+	if err != nil {
+		return nil, err
+	}
+
+	salesRep, err := models.FindUser(ctx, tx, req.SalesRepID)
+	if err != nil {
+		_ = tx.Rollback()
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, weberror.NewError(ctx, ErrNotFound, http.StatusNotFound)
+		}
+		return nil, err
+	}
+
+	m, err := models.TillSessions(
+		models.TillSessionWhere.SalesRepID.EQ(req.SalesRepID),
+		models.TillSessionWhere.Date.EQ(start.Unix()),
+		For("UPDATE"),
+	).One(ctx, tx)
+	if err != nil && errors.Cause(err) != sql.ErrNoRows {
+		_ = tx.Rollback()
+		return nil, err
+	}
+	if m != nil && m.Status != Status_Submitted {
+		_ = tx.Rollback()
+		return nil, weberror.NewError(ctx, ErrLocked, http.StatusBadRequest)
+	}
+
+	expected, err := repo.expectedCash(ctx, req.SalesRepID, req.Date, tx)
+	if err != nil {
+		_ = tx.Rollback()
+		return nil, err
+	}
+	counted := CountedTotal(req.Counts)
+
+	recount := m != nil
+	if recount {
+		if _, err = m.TillCounts().DeleteAll(ctx, tx); err != nil {
+			_ = tx.Rollback()
+			return nil, errors.WithMessage(err, "Cannot remove the previous count")
+		}
+	} else {
+		m = &models.TillSession{
+			ID:         uuid.NewRandom().String(),
+			SalesRepID: req.SalesRepID,
+			Date:       start.Unix(),
+			CreatedAt:  now.Unix(),
+		}
+	}
+
+	m.BranchID = salesRep.BranchID
+	m.CashDeposits = expected.CashDeposits.Kobo()
+	m.CashSales = expected.CashSales.Kobo()
+	m.WithdrawalsPaid = expected.WithdrawalsPaid.Kobo()
+	m.RepExpenses = expected.RepExpenses.Kobo()
+	m.Expected = expected.Total().Kobo()
+	m.Counted = counted.Kobo()
+	m.Variance = (counted - expected.Total()).Kobo()
+	m.Status = Status_Submitted
+	m.Notes = req.Notes
+	m.SubmittedByID = claims.Subject
+	m.UpdatedAt = now.Unix()
+
+	if recount {
+		_, err = m.Update(ctx, tx, boil.Infer())
+	} else {
+		err = m.Insert(ctx, tx, boil.Infer())
+	}
+	if err != nil {
+		_ = tx.Rollback()
+		return nil, errors.WithMessage(err, "Cannot save the cash-up")
+	}
+
+	for _, c := range req.Counts {
+		if c.Count == 0 {
+			continue
+		}
+		count := models.TillCount{
+			ID:            uuid.NewRandom().String(),
+			TillSessionID: m.ID,
+			Denomination:  c.Denomination.Kobo(),
+			Count:         c.Count,
+		}
+		if err = count.Insert(ctx, tx, boil.Infer()); err != nil {
+			_ = tx.Rollback()
+			return nil, errors.WithMessagef(err, "Cannot save the count of %s", c.Denomination)
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return repo.Read(ctx, claims, ReadRequest{ID: m.ID})
+}
+
+// SignOff locks a cash-up once a supervisor has checked it. The supervisor must be an admin of
+// the branch of the rep and cannot be the rep or the user that entered the count.
+func (repo *Repository) SignOff(ctx context.Context, claims auth.Claims, req SignOffRequest, now time.Time) (*Session, error) {
+	span, ctx := tracer.StartSpanFromContext(ctx, "internal.till.SignOff")
+	defer span.Finish()
+
+	if claims.Audience == "" || !claims.HasRole(auth.RoleAdmin, auth.RoleSuperAdmin) {
+		return nil, errors.WithStack(ErrForbidden)
+	}
+
+	// Validate the request.
+	v := webcontext.Validator()
+	err := v.Struct(req)
+	if err != nil {
+		return nil, err
+	}
+
+	// If now empty set it to the current time.
+	if now.IsZero() {
+		now = time.Now()
+	}
+
+	// Always store the time as UTC.
+	now = now.UTC()
+	// Postgres truncates times to milliseconds when storing. We and do the same
+	// here so the value we return is consistent with what we store.
+	now = now.Truncate(time.Millisecond)
+
+	tx, err := repo.DbConn.Begin()
+	if err != nil {
+		return nil, err
+	}
+
+	m, err := models.TillSessions(models.TillSessionWhere.ID.EQ(req.ID), For("UPDATE")).One(ctx, tx)
+	if err != nil {
+		_ = tx.Rollback()
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, weberror.NewError(ctx, ErrNotFound, http.StatusNotFound)
+		}
+		return nil, err
+	}
+
+	if m.Status != Status_Submitted {
+		_ = tx.Rollback()
+		return nil, weberror.NewError(ctx, ErrLocked, http.StatusBadRequest)
+	}
+
+	if m.SalesRepID == claims.Subject || m.SubmittedByID == claims.Subject {
+		_ = tx.Rollback()
+		return nil, weberror.NewError(ctx, ErrSignOffSelf, http.StatusForbidden)
+	}
+
+	supervisor, err := models.FindUser(ctx, tx, claims.Subject)
+	if err != nil {
+		_ = tx.Rollback()
+		return nil, err
+	}
+	if supervisor.BranchID != m.BranchID {
+		_ = tx.Rollback()
+		return nil, weberror.NewError(ctx, ErrSignOffBranch, http.StatusForbidden)
+	}
+
+	m.Status = Status_SignedOff
+	m.SignedOffByID = null.StringFrom(claims.Subject)
+	m.SignedOffAt = null.Int64From(now.Unix())
+	m.UpdatedAt = now.Unix()
+
+	if _, err = m.Update(ctx, tx, boil.Whitelist(
+		models.TillSessionColumns.Status,
+		models.TillSessionColumns.SignedOffByID,
+		models.TillSessionColumns.SignedOffAt,
+		models.TillSessionColumns.UpdatedAt,
+	)); err != nil {
+		_ = tx.Rollback()
+		return nil, errors.WithMessage(err, "Cannot sign off the cash-up")
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return repo.Read(ctx, claims, ReadRequest{ID: m.ID})
+}
+
+// Shortages sums up the cash-ups of each rep in the period, counting the ones that were short.
+// Reps with the most shortages are listed first.
+func (repo *Repository) Shortages(ctx context.Context, claims auth.Claims, req ShortagesRequest) ([]*Shortage, error) {
+	span, ctx := tracer.StartSpanFromContext(ctx, "internal.till.Shortages")
+	defer span.Finish()
+
+	if claims.Audience == "" || !claims.HasRole(auth.RoleAdmin, auth.RoleSuperAdmin) {
+		return nil, errors.WithStack(ErrForbidden)
+	}
+
+	// Validate the request.
+	v := webcontext.Validator()
+	err := v.Struct(req)
+	if err != nil {
+		return nil, err
+	}
+
+	start, _ := DayRange(req.StartDate)
+	end, _ := DayRange(req.EndDate)
+
+	statement := `select
+		s.sales_rep_id,
+		concat(u.first_name, ' ', u.last_name) as sales_rep,
+		count(*) as sessions,
+		count(*) filter (where s.variance < 0) as shortages,
+		coalesce(sum(-s.variance) filter (where s.variance < 0), 0) as total
+	from till_session s
+	inner join users u on u.id = s.sales_rep_id
+	where s.date >= $1 and s.date <= $2 %s
+	group by s.sales_rep_id, u.first_name, u.last_name
+	order by shortages desc, total desc, sales_rep`
+
+	args := []interface{}{start.Unix(), end.Unix()}
+	var branchWhere string
+	if req.BranchID != "" {
+		branchWhere = "and s.branch_id = $3"
+		args = append(args, req.BranchID)
+	}
+
+	var result []*Shortage
+	err = models.NewQuery(SQL(fmt.Sprintf(statement, branchWhere), args...)).Bind(ctx, repo.DbConn, &result)
+	if err != nil {
+		return nil, errors.WithMessage(err, "Cannot read the till shortages")
+	}
+
+	return result, nil
+}
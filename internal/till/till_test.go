@@ -0,0 +1,265 @@
+package till
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	"github.com/dgrijalva/jwt-go"
+	"github.com/pborman/uuid"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+
+	"merryworld/surebank/internal/customer"
+	"merryworld/surebank/internal/dscommission"
+	"merryworld/surebank/internal/ledger"
+	"merryworld/surebank/internal/platform/auth"
+	"merryworld/surebank/internal/platform/money"
+	"merryworld/surebank/internal/platform/notify"
+	"merryworld/surebank/internal/platform/tests"
+	"merryworld/surebank/internal/postgres/models"
+	"merryworld/surebank/internal/profit"
+	"merryworld/surebank/internal/transaction"
+)
+
+var (
+	test   *tests.Test
+	repo   *Repository
+	txRepo *transaction.Repository
+)
+
+// TestMain is the entry point for testing.
+func TestMain(m *testing.M) {
+	os.Exit(testMain(m))
+}
+
+func testMain(m *testing.M) int {
+	test = tests.New()
+	defer test.TearDown()
+
+	repo = NewRepository(test.MasterDB)
+	txRepo = transaction.NewRepository(test.MasterDB, dscommission.NewRepository(test.MasterDB), profit.NewRepository(test.MasterDB),
+		ledger.NewRepository(test.MasterDB), notify.NewSMSDisabled(), notify.NewEmailDisabled(), nil)
+
+	return m.Run()
+}
+
+// TestExpectedTotal validates the cash expected from a rep for a day.
+func TestExpectedTotal(t *testing.T) {
+
+	var expectedTests = []struct {
+		name     string
+		expected Expected
+		want     money.Amount
+	}{
+		{"nothing recorded", Expected{}, 0},
+		{"deposits and sales", Expected{CashDeposits: money.Naira(5000), CashSales: money.Naira(1200)}, money.Naira(6200)},
+		{"withdrawals and expenses paid out",
+			Expected{CashDeposits: money.Naira(5000), CashSales: money.Naira(1200), WithdrawalsPaid: money.Naira(3000), RepExpenses: money.Naira(500)},
+			money.Naira(2700)},
+		{"more paid out than received",
+			Expected{CashDeposits: money.Naira(1000), WithdrawalsPaid: money.Naira(2500)}, money.Naira(-1500)},
+	}
+
+	t.Log("Given the need to work out the cash expected from a rep.")
+	{
+		for i, tt := range expectedTests {
+			t.Logf("\tTest: %d\tWhen there are %s.", i, tt.name)
+			{
+				if got := tt.expected.Total(); got != tt.want {
+					t.Fatalf("\t%s\tExpected %s, got %s.", tests.Failed, tt.want, got)
+				}
+				t.Logf("\t%s\tTotal ok.", tests.Success)
+			}
+		}
+	}
+}
+
+// TestCountedTotal validates the cash counted by denomination.
+func TestCountedTotal(t *testing.T) {
+
+	var countedTests = []struct {
+		name   string
+		counts []Count
+		want   money.Amount
+	}{
+		{"no counts", nil, 0},
+		{"notes", []Count{{money.Naira(1000), 4}, {money.Naira(500), 3}, {money.Naira(50), 1}}, money.Naira(5550)},
+		{"notes and coins", []Count{{money.Naira(200), 2}, {money.Naira(2), 3}, {money.Naira(1), 5}}, money.Naira(411)},
+		{"empty denominations", []Count{{money.Naira(1000), 0}, {money.Naira(100), 7}}, money.Naira(700)},
+	}
+
+	t.Log("Given the need to total the cash counted in a till.")
+	{
+		for i, tt := range countedTests {
+			t.Logf("\tTest: %d\tWhen counting %s.", i, tt.name)
+			{
+				if got := CountedTotal(tt.counts); got != tt.want {
+					t.Fatalf("\t%s\tExpected %s, got %s.", tests.Failed, tt.want, got)
+				}
+				t.Logf("\t%s\tCountedTotal ok.", tests.Success)
+			}
+		}
+	}
+}
+
+// TestDayRange validates the business day a cash-up covers.
+func TestDayRange(t *testing.T) {
+
+	t.Log("Given the need to cover the business day in WAT.")
+	{
+		start, end := DayRange(time.Date(2026, time.March, 3, 15, 30, 0, 0, time.UTC))
+
+		wantStart := time.Date(2026, time.March, 2, 23, 0, 0, 0, time.UTC)
+		if !start.Equal(wantStart) {
+			t.Fatalf("\t%s\tExpected the day to start at %s, got %s.", tests.Failed, wantStart, start)
+		}
+
+		wantEnd := time.Date(2026, time.March, 3, 22, 59, 59, 0, time.UTC)
+		if !end.Equal(wantEnd) {
+			t.Fatalf("\t%s\tExpected the day to end at %s, got %s.", tests.Failed, wantEnd, end)
+		}
+		t.Logf("\t%s\tDayRange ok.", tests.Success)
+
+		late := time.Date(2026, time.March, 3, 23, 30, 0, 0, time.UTC)
+		wantDate := time.Date(2026, time.March, 4, 0, 0, 0, 0, time.UTC)
+		if got := BusinessDate(late); !got.Equal(wantDate) {
+			t.Fatalf("\t%s\tExpected %s to be on the business day of %s, got %s.", tests.Failed, late, wantDate, got)
+		}
+		if start, end := DayRange(BusinessDate(late)); late.Before(start) || late.After(end) {
+			t.Fatalf("\t%s\tExpected %s to be within %s and %s.", tests.Failed, late, start, end)
+		}
+		t.Logf("\t%s\tBusinessDate ok.", tests.Success)
+	}
+}
+
+// newTestUser creates a user of the branch and returns claims for it with the role.
+func newTestUser(t *testing.T, branchID, audience, role string, now time.Time) auth.Claims {
+	user := models.User{
+		ID:          uuid.NewRandom().String(),
+		BranchID:    branchID,
+		Email:       uuid.NewRandom().String() + "@example.com",
+		FirstName:   "Test",
+		LastName:    "User",
+		PhoneNumber: "08000000000",
+		CreatedAt:   now,
+	}
+	if err := user.Insert(tests.Context(), test.MasterDB, boil.Infer()); err != nil {
+		t.Fatalf("\t%s\tInsert user failed: %v", tests.Failed, err)
+	}
+
+	return auth.Claims{
+		Roles: []string{role},
+		StandardClaims: jwt.StandardClaims{
+			Subject:  user.ID,
+			Audience: audience,
+		},
+	}
+}
+
+// TestExpectedCashArchived validates a deposit that was archived is neither expected from the rep
+// that took it nor from the admin that reversed it.
+func TestExpectedCashArchived(t *testing.T) {
+	defer tests.Recover(t)
+
+	now := time.Date(2026, time.October, 18, 9, 0, 0, 0, time.UTC)
+
+	t.Log("Given the need to work out the cash a rep should hold after a deposit was archived.")
+	{
+		ctx := tests.Context()
+
+		branch := models.Branch{
+			ID:        uuid.NewRandom().String(),
+			Name:      "Branch " + uuid.NewRandom().String(),
+			CreatedAt: now.Unix(),
+			UpdatedAt: now.Unix(),
+		}
+		if err := branch.Insert(ctx, test.MasterDB, boil.Infer()); err != nil {
+			t.Fatalf("\t%s\tInsert branch failed: %v", tests.Failed, err)
+		}
+
+		audience := uuid.NewRandom().String()
+		rep := newTestUser(t, branch.ID, audience, auth.RoleUser, now)
+		admin := newTestUser(t, branch.ID, audience, auth.RoleAdmin, now)
+
+		cust := models.Customer{
+			ID:          uuid.NewRandom().String(),
+			BranchID:    branch.ID,
+			Email:       uuid.NewRandom().String() + "@example.com",
+			Name:        "Test Customer",
+			PhoneNumber: "08000000001",
+			SalesRepID:  rep.Subject,
+			CreatedAt:   now.Unix(),
+			UpdatedAt:   now.Unix(),
+		}
+		if err := cust.Insert(ctx, test.MasterDB, boil.Infer()); err != nil {
+			t.Fatalf("\t%s\tInsert customer failed: %v", tests.Failed, err)
+		}
+
+		// The SB product is seeded by the schema migrations.
+		product, err := models.AccountProducts(models.AccountProductWhere.Code.EQ(customer.AccountTypeSB)).One(ctx, test.MasterDB)
+		if err != nil {
+			t.Fatalf("\t%s\tRead account product failed: %v", tests.Failed, err)
+		}
+
+		account := models.Account{
+			ID:          uuid.NewRandom().String(),
+			BranchID:    branch.ID,
+			Number:      uuid.NewRandom().String()[:8],
+			CustomerID:  cust.ID,
+			AccountType: product.Code,
+			ProductID:   product.ID,
+			SalesRepID:  rep.Subject,
+			CreatedAt:   now.Unix(),
+			UpdatedAt:   now.Unix(),
+		}
+		if err := account.Insert(ctx, test.MasterDB, boil.Infer()); err != nil {
+			t.Fatalf("\t%s\tInsert account failed: %v", tests.Failed, err)
+		}
+
+		var depositIDs []string
+		for i, amount := range []money.Amount{money.Naira(500), money.Naira(200)} {
+			tx, err := txRepo.Deposit(ctx, rep, transaction.CreateRequest{
+				Type:          transaction.TransactionType_Deposit,
+				AccountNumber: account.Number,
+				Amount:        amount,
+				PaymentMethod: transaction.PaymentMethod_Cash,
+			}, now.Add(time.Duration(i)*time.Minute))
+			if err != nil {
+				t.Log("\t\tGot :", err)
+				t.Fatalf("\t%s\tDeposit failed.", tests.Failed)
+			}
+			depositIDs = append(depositIDs, tx.ID)
+		}
+
+		err = txRepo.Archive(ctx, admin, transaction.ArchiveRequest{ID: depositIDs[0], Reason: "Posted twice"}, now.Add(time.Hour))
+		if err != nil {
+			t.Log("\t\tGot :", err)
+			t.Fatalf("\t%s\tArchive failed.", tests.Failed)
+		}
+
+		t.Log("\tTest: 0\tWhen a deposit of the rep was archived by an admin.")
+		{
+			expected, err := repo.ExpectedCash(ctx, admin, rep.Subject, now)
+			if err != nil {
+				t.Log("\t\tGot :", err)
+				t.Fatalf("\t%s\tShould work out the expected cash.", tests.Failed)
+			}
+			if expected.Total() != money.Naira(200) {
+				t.Logf("\t\tGot : %+v", expected)
+				t.Fatalf("\t%s\tShould only expect the deposit that stands from the rep.", tests.Failed)
+			}
+			t.Logf("\t%s\tShould only expect the deposit that stands from the rep.", tests.Success)
+
+			expected, err = repo.ExpectedCash(ctx, admin, admin.Subject, now)
+			if err != nil {
+				t.Log("\t\tGot :", err)
+				t.Fatalf("\t%s\tShould work out the expected cash.", tests.Failed)
+			}
+			if expected.Total() != 0 {
+				t.Logf("\t\tGot : %+v", expected)
+				t.Fatalf("\t%s\tShould not expect the reversal from the admin.", tests.Failed)
+			}
+			t.Logf("\t%s\tShould not expect the reversal from the admin.", tests.Success)
+		}
+	}
+}
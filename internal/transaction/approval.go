@@ -0,0 +1,521 @@
+package transaction
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/pborman/uuid"
+	"github.com/pkg/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	. "github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"gopkg.in/DataDog/dd-trace-go.v1/ddtrace/tracer"
+
+	"merryworld/surebank/internal/account_product"
+	"merryworld/surebank/internal/platform/auth"
+	"merryworld/surebank/internal/platform/money"
+	"merryworld/surebank/internal/platform/web/webcontext"
+	"merryworld/surebank/internal/platform/web/weberror"
+	"merryworld/surebank/internal/postgres/models"
+)
+
+var (
+	// ErrApprovalDecided occurs when an approval that has already been approved or rejected is
+	// decided again.
+	ErrApprovalDecided = errors.New("The request has already been decided")
+
+	// ErrApprovalSelf occurs when a user tries to approve or reject their own request.
+	ErrApprovalSelf = errors.New("A request must be approved by a different user from the one that made it")
+
+	// ErrApprovalBranch occurs when an admin tries to decide a request made at another branch.
+	ErrApprovalBranch = errors.New("Only an admin of the branch the request was made at can decide it")
+
+	// ErrApprovalRejected occurs when a request that was rejected is replayed.
+	ErrApprovalRejected = errors.New("The request was rejected")
+)
+
+// PendingApprovalError is returned by Withdraw and Transfer when the amount is at or above the
+// approval threshold. Nothing has been posted, the request is held as the approval until it is
+// decided.
+type PendingApprovalError struct {
+	Approval *Approval
+}
+
+// Error implements the error interface.
+func (e *PendingApprovalError) Error() string {
+	return fmt.Sprintf("The %s of %s is above the approval threshold and has been sent for approval",
+		e.Approval.Kind, e.Approval.Amount)
+}
+
+// IsPendingApproval returns the approval a request was held as when err is a PendingApprovalError.
+func IsPendingApproval(err error) (*Approval, bool) {
+	if e, ok := errors.Cause(err).(*PendingApprovalError); ok {
+		return e.Approval, true
+	}
+	return nil, false
+}
+
+// ApprovalThreshold returns the amount from which a withdrawal or transfer must be approved given
+// the thresholds of the branch and of the account product. Zero means no approval is required
+// by that setting, otherwise the lower of the two applies.
+func ApprovalThreshold(branch, product money.Amount) money.Amount {
+	if branch <= 0 {
+		return product
+	}
+	if product <= 0 || branch < product {
+		return branch
+	}
+	return product
+}
+
+// FindApprovals gets the approvals matching the request. Users that are not admins only see the
+// requests they made.
+func (repo *Repository) FindApprovals(ctx context.Context, claims auth.Claims, req ApprovalFindRequest) (Approvals, error) {
+	span, ctx := tracer.StartSpanFromContext(ctx, "internal.transaction.FindApprovals")
+	defer span.Finish()
+
+	if claims.Audience == "" {
+		return nil, errors.WithStack(ErrForbidden)
+	}
+
+	var queries = []QueryMod{
+		Load(models.ApprovalRels.Account),
+		Load(models.ApprovalRels.ToAccount),
+		Load(models.ApprovalRels.Branch),
+		Load(models.ApprovalRels.RequestedBy),
+		Load(models.ApprovalRels.DecidedBy),
+	}
+
+	if req.Where != "" {
+		queries = append(queries, Where(req.Where, req.Args...))
+	}
+
+	if !claims.HasRole(auth.RoleAdmin, auth.RoleSuperAdmin) {
+		queries = append(queries, models.ApprovalWhere.RequestedByID.EQ(claims.Subject))
+	}
+
+	if len(req.Order) > 0 {
+		for _, s := range req.Order {
+			queries = append(queries, OrderBy(s))
+		}
+	} else {
+		queries = append(queries, OrderBy(models.ApprovalColumns.CreatedAt))
+	}
+
+	if req.Limit != nil {
+		queries = append(queries, Limit(int(*req.Limit)))
+	}
+
+	if req.Offset != nil {
+		queries = append(queries, Offset(int(*req.Offset)))
+	}
+
+	slice, err := models.Approvals(queries...).All(ctx, repo.DbConn)
+	if err != nil {
+		return nil, err
+	}
+
+	var result Approvals
+	for _, rec := range slice {
+		result = append(result, ApprovalFromModel(rec))
+	}
+
+	return result, nil
+}
+
+// ReadApproval gets the specified approval from the database. Users that are not admins can only
+// read the requests they made and admins those made at their branch.
+func (repo *Repository) ReadApproval(ctx context.Context, claims auth.Claims, id string) (*Approval, error) {
+	span, ctx := tracer.StartSpanFromContext(ctx, "internal.transaction.ReadApproval")
+	defer span.Finish()
+
+	if claims.Audience == "" {
+		return nil, errors.WithStack(ErrForbidden)
+	}
+
+	rec, err := models.Approvals(
+		models.ApprovalWhere.ID.EQ(id),
+		Load(models.ApprovalRels.Account),
+		Load(models.ApprovalRels.ToAccount),
+		Load(models.ApprovalRels.Branch),
+		Load(models.ApprovalRels.RequestedBy),
+		Load(models.ApprovalRels.DecidedBy),
+	).One(ctx, repo.DbConn)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, weberror.NewError(ctx, ErrNotFound, http.StatusNotFound)
+		}
+		return nil, err
+	}
+
+	if rec.RequestedByID != claims.Subject {
+		if !claims.HasRole(auth.RoleAdmin, auth.RoleSuperAdmin) {
+			return nil, weberror.NewError(ctx, ErrForbidden, http.StatusForbidden)
+		}
+
+		user, err := models.FindUser(ctx, repo.DbConn, claims.Subject)
+		if err != nil {
+			return nil, err
+		}
+		if user.BranchID != rec.BranchID {
+			return nil, weberror.NewError(ctx, ErrForbidden, http.StatusForbidden)
+		}
+	}
+
+	return ApprovalFromModel(rec), nil
+}
+
+// Approve posts a held withdrawal or transfer on behalf of the rep that requested it. The approver
+// must be an admin of the branch the request was made at and cannot be the rep. The posted
+// transactions record the approver.
+func (repo *Repository) Approve(ctx context.Context, claims auth.Claims, req ApproveRequest, now time.Time) (*Approval, error) {
+	span, ctx := tracer.StartSpanFromContext(ctx, "internal.transaction.Approve")
+	defer span.Finish()
+
+	if claims.Audience == "" || !claims.HasRole(auth.RoleAdmin, auth.RoleSuperAdmin) {
+		return nil, errors.WithStack(ErrForbidden)
+	}
+
+	// Validate the request.
+	v := webcontext.Validator()
+	err := v.Struct(req)
+	if err != nil {
+		return nil, err
+	}
+
+	// If now empty set it to the current time.
+	if now.IsZero() {
+		now = time.Now()
+	}
+
+	// Always store the time as UTC.
+	now = now.UTC()
+	// Postgres truncates times to milliseconds when storing. We and do the same
+	// here so the value we return is consistent with what we store.
+	now = now.Truncate(time.Millisecond)
+
+	tx, err := repo.DbConn.Begin()
+	if err != nil {
+		return nil, err
+	}
+
+	rec, err := repo.lockApproval(ctx, claims, req.ID, tx)
+	if err != nil {
+		_ = tx.Rollback()
+		return nil, err
+	}
+
+	// The money is paid out by the rep that took the request, the approver is recorded on the
+	// transactions.
+	makerClaims := claims
+	makerClaims.Subject = rec.RequestedByID
+
+	var transfer *models.Transfer
+	var from, to *models.Account
+	switch rec.Kind {
+	case ApprovalKind_Withdrawal:
+		account, err := repo.lockAccount(ctx, models.AccountWhere.ID.EQ(rec.AccountID), tx)
+		if err != nil {
+			_ = tx.Rollback()
+			return nil, err
+		}
+
+		// The customer may have withdrawn more since the request was held.
+		if err = checkKYCLimit(ctx, account, TransactionType_Withdrawal, money.Amount(rec.Amount), now, tx); err != nil {
+			_ = tx.Rollback()
+			return nil, err
+		}
+
+		txn, err := repo.MakeDeduction(ctx, makerClaims, MakeDeductionRequest{
+			AccountNumber: account.Number,
+			Amount:        money.Amount(rec.Amount),
+			Narration:     rec.Narration,
+			LedgerAccount: rec.LedgerAccount,
+		}, now, tx)
+		if err != nil {
+			_ = tx.Rollback()
+			return nil, err
+		}
+
+		if _, err = models.Transactions(models.TransactionWhere.ID.EQ(txn.ID)).UpdateAll(ctx, tx, models.M{
+			models.TransactionColumns.ApprovedByID: claims.Subject,
+		}); err != nil {
+			_ = tx.Rollback()
+			return nil, err
+		}
+		rec.TransactionID = null.StringFrom(txn.ID)
+
+	case ApprovalKind_Transfer:
+		fromAccount, err := models.FindAccount(ctx, tx, rec.AccountID)
+		if err != nil {
+			_ = tx.Rollback()
+			return nil, err
+		}
+		toAccount, err := models.FindAccount(ctx, tx, rec.ToAccountID.String)
+		if err != nil {
+			_ = tx.Rollback()
+			return nil, err
+		}
+
+		from, to, err = repo.lockTransferAccounts(ctx, fromAccount.Number, toAccount.Number, tx)
+		if err != nil {
+			_ = tx.Rollback()
+			return nil, err
+		}
+
+		transfer, err = repo.postTransfer(ctx, makerClaims, from, to, money.Amount(rec.Amount), rec.Narration, now, tx)
+		if err != nil {
+			_ = tx.Rollback()
+			return nil, err
+		}
+
+		if _, err = models.Transactions(models.TransactionWhere.TransferID.EQ(null.StringFrom(transfer.ID))).UpdateAll(ctx, tx, models.M{
+			models.TransactionColumns.ApprovedByID: claims.Subject,
+		}); err != nil {
+			_ = tx.Rollback()
+			return nil, err
+		}
+		rec.TransferID = null.StringFrom(transfer.ID)
+	}
+
+	rec.Status = ApprovalStatus_Approved
+	rec.DecidedByID = null.StringFrom(claims.Subject)
+	rec.DecidedAt = null.Int64From(now.Unix())
+	rec.UpdatedAt = now.Unix()
+	if _, err = rec.Update(ctx, tx, boil.Infer()); err != nil {
+		_ = tx.Rollback()
+		return nil, errors.WithMessage(err, "Update approval failed")
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	if transfer != nil {
+		repo.notifyTransfer(ctx, transfer, from, to)
+	}
+
+	return repo.ReadApproval(ctx, claims, rec.ID)
+}
+
+// Reject declines a held withdrawal or transfer with the reason given. Nothing is posted.
+func (repo *Repository) Reject(ctx context.Context, claims auth.Claims, req RejectRequest, now time.Time) error {
+	span, ctx := tracer.StartSpanFromContext(ctx, "internal.transaction.Reject")
+	defer span.Finish()
+
+	if claims.Audience == "" || !claims.HasRole(auth.RoleAdmin, auth.RoleSuperAdmin) {
+		return errors.WithStack(ErrForbidden)
+	}
+
+	// Validate the request.
+	v := webcontext.Validator()
+	err := v.Struct(req)
+	if err != nil {
+		return err
+	}
+
+	// If now empty set it to the current time.
+	if now.IsZero() {
+		now = time.Now()
+	}
+
+	// Always store the time as UTC.
+	now = now.UTC()
+	// Postgres truncates times to milliseconds when storing. We and do the same
+	// here so the value we return is consistent with what we store.
+	now = now.Truncate(time.Millisecond)
+
+	tx, err := repo.DbConn.Begin()
+	if err != nil {
+		return err
+	}
+
+	rec, err := repo.lockApproval(ctx, claims, req.ID, tx)
+	if err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+
+	rec.Status = ApprovalStatus_Rejected
+	rec.Reason = req.Reason
+	rec.DecidedByID = null.StringFrom(claims.Subject)
+	rec.DecidedAt = null.Int64From(now.Unix())
+	rec.UpdatedAt = now.Unix()
+	if _, err = rec.Update(ctx, tx, boil.Infer()); err != nil {
+		_ = tx.Rollback()
+		return errors.WithMessage(err, "Update approval failed")
+	}
+
+	return tx.Commit()
+}
+
+// lockApproval reads the approval for update and checks that it is pending and that the user can
+// decide it.
+func (repo *Repository) lockApproval(ctx context.Context, claims auth.Claims, id string, tx *sql.Tx) (*models.Approval, error) {
+	rec, err := models.Approvals(models.ApprovalWhere.ID.EQ(id), For("UPDATE")).One(ctx, tx)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, weberror.NewError(ctx, ErrNotFound, http.StatusNotFound)
+		}
+		return nil, err
+	}
+
+	if rec.Status != ApprovalStatus_Pending {
+		return nil, weberror.NewError(ctx, ErrApprovalDecided, http.StatusBadRequest)
+	}
+
+	if rec.RequestedByID == claims.Subject {
+		return nil, weberror.NewError(ctx, ErrApprovalSelf, http.StatusForbidden)
+	}
+
+	approver, err := models.FindUser(ctx, tx, claims.Subject)
+	if err != nil {
+		return nil, err
+	}
+	if approver.BranchID != rec.BranchID {
+		return nil, weberror.NewError(ctx, ErrApprovalBranch, http.StatusForbidden)
+	}
+
+	return rec, nil
+}
+
+// holdForApproval records the withdrawal or transfer out of the locked account as a pending
+// approval when the amount is at or above the approval threshold of the branch of the rep or of
+// the account product, and returns nil otherwise. The withdrawal rules and holds are checked first so
+// that requests that cannot be posted are not sent for approval.
+func (repo *Repository) holdForApproval(ctx context.Context, claims auth.Claims, kind string, account, to *models.Account,
+	amount money.Amount, narration, ledgerAccount string, now time.Time, tx *sql.Tx) (*models.Approval, error) {
+
+	balance, err := repo.AccountBalanceTx(ctx, account.ID, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	penalty := earlyWithdrawalPenalty(account, amount, now)
+	if err = account_product.FromModel(account.R.Product).CheckWithdrawal(balance, amount+penalty); err != nil {
+		return nil, weberror.NewError(ctx, err, http.StatusBadRequest)
+	}
+	if err = checkHeld(ctx, account.ID, balance, amount+penalty, now, tx); err != nil {
+		return nil, err
+	}
+
+	requester, err := models.Users(
+		models.UserWhere.ID.EQ(claims.Subject),
+		Load(models.UserRels.Branch),
+	).One(ctx, tx)
+	if err != nil {
+		return nil, errors.WithMessage(err, "Cannot read the branch of the user")
+	}
+
+	threshold := ApprovalThreshold(money.Amount(requester.R.Branch.ApprovalThreshold), money.Amount(account.R.Product.ApprovalThreshold))
+	if threshold <= 0 || amount < threshold {
+		return nil, nil
+	}
+
+	m := models.Approval{
+		ID:            uuid.NewRandom().String(),
+		Kind:          kind,
+		AccountID:     account.ID,
+		BranchID:      requester.BranchID,
+		Amount:        amount.Kobo(),
+		Narration:     narration,
+		LedgerAccount: ledgerAccount,
+		Status:        ApprovalStatus_Pending,
+		RequestedByID: claims.Subject,
+		CreatedAt:     now.Unix(),
+		UpdatedAt:     now.Unix(),
+	}
+	if to != nil {
+		m.ToAccountID = null.StringFrom(to.ID)
+	}
+	if err := m.Insert(ctx, tx, boil.Infer()); err != nil {
+		return nil, errors.WithMessage(err, "Insert approval failed")
+	}
+
+	return &m, nil
+}
+
+// approvalReplay resolves the resource ID stored for a replayed idempotency key. Requests that
+// were held for approval store the ID of the approval: while it is pending a PendingApprovalError
+// is returned, once approved the ID of what it posted. Other IDs are returned as they are.
+func (repo *Repository) approvalReplay(ctx context.Context, id string) (string, error) {
+	rec, err := models.FindApproval(ctx, repo.DbConn, id)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return id, nil
+		}
+		return "", err
+	}
+
+	switch rec.Status {
+	case ApprovalStatus_Approved:
+		if rec.Kind == ApprovalKind_Transfer {
+			return rec.TransferID.String, nil
+		}
+		return rec.TransactionID.String, nil
+	case ApprovalStatus_Rejected:
+		return "", weberror.NewErrorMessage(ctx, ErrApprovalRejected, http.StatusBadRequest,
+			"The request was rejected: "+rec.Reason)
+	}
+
+	return "", &PendingApprovalError{Approval: ApprovalFromModel(rec)}
+}
+
+// notifyApprovers emails the admins of the branch an approval was requested at, other than the
+// rep that requested it.
+func (repo *Repository) notifyApprovers(ctx context.Context, claims auth.Claims, id string) {
+	rec, err := models.Approvals(
+		models.ApprovalWhere.ID.EQ(id),
+		Load(models.ApprovalRels.Account),
+		Load(models.ApprovalRels.ToAccount),
+		Load(models.ApprovalRels.Branch),
+		Load(models.ApprovalRels.RequestedBy),
+	).One(ctx, repo.DbConn)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	approvers, err := models.Users(
+		models.UserWhere.BranchID.EQ(rec.BranchID),
+		models.UserWhere.ID.NEQ(rec.RequestedByID),
+		models.UserWhere.ArchivedAt.IsNull(),
+		Where(`exists (select 1 from users_accounts ua where ua.user_id = users.id and ua.account_id = ?
+			and ua.archived_at is null and ('`+auth.RoleAdmin+`' = ANY (ua.roles) or '`+auth.RoleSuperAdmin+`' = ANY (ua.roles)))`,
+			claims.Audience),
+	).All(ctx, repo.DbConn)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	var toAccountNumber string
+	if rec.R.ToAccount != nil {
+		toAccountNumber = rec.R.ToAccount.Number
+	}
+
+	subject := fmt.Sprintf("Approval required for a %s of %s", rec.Kind, money.Amount(rec.Amount))
+	for _, u := range approvers {
+		if strings.TrimSpace(u.Email) == "" {
+			continue
+		}
+
+		data := map[string]interface{}{
+			"Name":            u.FirstName,
+			"RequestedBy":     rec.R.RequestedBy.FirstName + " " + rec.R.RequestedBy.LastName,
+			"Kind":            rec.Kind,
+			"Amount":          money.Amount(rec.Amount).String(),
+			"AccountNumber":   rec.R.Account.Number,
+			"ToAccountNumber": toAccountNumber,
+			"Branch":          rec.R.Branch.Name,
+		}
+		if err := repo.notifyEmail.Send(ctx, u.Email, subject, "approval_requested", data); err != nil {
+			// TODO: log critical error. Send message to monitoring account
+			fmt.Println(err)
+		}
+	}
+}
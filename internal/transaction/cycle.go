@@ -0,0 +1,377 @@
+package transaction
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/pborman/uuid"
+	"github.com/pkg/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	. "github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"gopkg.in/DataDog/dd-trace-go.v1/ddtrace/tracer"
+
+	"merryworld/surebank/internal/account_product"
+	"merryworld/surebank/internal/platform/auth"
+	"merryworld/surebank/internal/platform/money"
+	"merryworld/surebank/internal/platform/web/webcontext"
+	"merryworld/surebank/internal/platform/web/weberror"
+	"merryworld/surebank/internal/postgres/models"
+)
+
+var (
+	// ErrCycleSettled occurs when a DS cycle that has already been settled is settled again.
+	ErrCycleSettled = errors.New("The cycle has already been settled")
+
+	// ErrCycleNotDue occurs when a DS cycle is settled before it has ended.
+	ErrCycleNotDue = errors.New("A cycle can only be settled once it has ended or every day of it has been paid")
+
+	// ErrNothingToPayOut occurs when the balance of the account does not exceed the minimum balance
+	// of its product when a cycle is paid out.
+	ErrNothingToPayOut = errors.New("There is nothing to pay out of the account")
+)
+
+// CycleEnd returns the effective date of the last day of a cycle of the given number of days that
+// starts on the effective date.
+func CycleEnd(start time.Time, days int) time.Time {
+	if days < 1 {
+		days = 1
+	}
+	return start.Add(time.Duration(days-1) * 24 * time.Hour)
+}
+
+// Days returns the number of days in the cycle.
+func (m *Cycle) Days() int {
+	return int(m.EndDate.Sub(m.StartDate).Hours()/24) + 1
+}
+
+// DaysMissed returns the days of the cycle up to asOf that have not been paid for. Days paid in
+// advance make up for the ones missed.
+func (m *Cycle) DaysMissed(asOf time.Time) int {
+	if asOf.Before(m.StartDate) {
+		return 0
+	}
+	if asOf.After(m.EndDate) {
+		asOf = m.EndDate
+	}
+
+	elapsed := int(asOf.Sub(m.StartDate).Hours()/24) + 1
+	if missed := elapsed - m.DaysPaid; missed > 0 {
+		return missed
+	}
+	return 0
+}
+
+// Due reports whether the cycle is open and can be settled as of asOf, once its last day has
+// passed or every day of it has been paid.
+func (m *Cycle) Due(asOf time.Time) bool {
+	if m.Status != CycleStatus_Open {
+		return false
+	}
+	return m.DaysPaid >= m.Days() || !asOf.Before(m.EndDate.Add(24*time.Hour))
+}
+
+// FindCycles gets the DS cycles matching the request.
+func (repo *Repository) FindCycles(ctx context.Context, claims auth.Claims, req CycleFindRequest) (Cycles, error) {
+	span, ctx := tracer.StartSpanFromContext(ctx, "internal.transaction.FindCycles")
+	defer span.Finish()
+
+	if claims.Audience == "" {
+		return nil, errors.WithStack(ErrForbidden)
+	}
+
+	var queries []QueryMod
+
+	if req.Where != "" {
+		queries = append(queries, Where(req.Where, req.Args...))
+	}
+
+	if req.IncludeAccount {
+		queries = append(queries, Load(models.DSCycleRels.Account))
+	}
+
+	if len(req.Order) > 0 {
+		for _, s := range req.Order {
+			queries = append(queries, OrderBy(s))
+		}
+	} else {
+		queries = append(queries, OrderBy(fmt.Sprintf("%s desc", models.DSCycleColumns.Number)))
+	}
+
+	if req.Limit != nil {
+		queries = append(queries, Limit(int(*req.Limit)))
+	}
+
+	if req.Offset != nil {
+		queries = append(queries, Offset(int(*req.Offset)))
+	}
+
+	slice, err := models.DSCycles(queries...).All(ctx, repo.DbConn)
+	if err != nil {
+		return nil, err
+	}
+
+	var result Cycles
+	for _, rec := range slice {
+		result = append(result, CycleFromModel(rec))
+	}
+
+	return result, nil
+}
+
+// ReadCycle gets the specified DS cycle from the database.
+func (repo *Repository) ReadCycle(ctx context.Context, _ auth.Claims, id string) (*Cycle, error) {
+	span, ctx := tracer.StartSpanFromContext(ctx, "internal.transaction.ReadCycle")
+	defer span.Finish()
+
+	rec, err := models.DSCycles(
+		models.DSCycleWhere.ID.EQ(id),
+		Load(models.DSCycleRels.Account),
+		Load(models.DSCycleRels.SettledBy),
+	).One(ctx, repo.DbConn)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, weberror.NewError(ctx, ErrNotFound, http.StatusNotFound)
+		}
+		return nil, err
+	}
+
+	return CycleFromModel(rec), nil
+}
+
+// CycleStatement gets the specified DS cycle and the transactions recorded in it, including the
+// fee, any corrections and the payout.
+func (repo *Repository) CycleStatement(ctx context.Context, claims auth.Claims, id string) (*CycleStatement, error) {
+	span, ctx := tracer.StartSpanFromContext(ctx, "internal.transaction.CycleStatement")
+	defer span.Finish()
+
+	if claims.Audience == "" {
+		return nil, errors.WithStack(ErrForbidden)
+	}
+
+	cycle, err := repo.ReadCycle(ctx, claims, id)
+	if err != nil {
+		return nil, err
+	}
+
+	slice, err := models.Transactions(
+		models.TransactionWhere.DSCycleID.EQ(null.StringFrom(id)),
+		Load(models.TransactionRels.SalesRep),
+		OrderBy(fmt.Sprintf("%s, %s", models.TransactionColumns.EffectiveDate, models.TransactionColumns.CreatedAt)),
+	).All(ctx, repo.DbConn)
+	if err != nil {
+		return nil, err
+	}
+
+	statement := &CycleStatement{Cycle: cycle}
+	for _, rec := range slice {
+		statement.Transactions = append(statement.Transactions, FromModel(rec))
+	}
+
+	return statement, nil
+}
+
+// SettleCycle settles a DS cycle that is due, either paying the balance of the account above the
+// minimum balance of its product out to the customer or rolling it over into the next cycle. Only
+// admins can settle a cycle so the payout is not held for approval.
+func (repo *Repository) SettleCycle(ctx context.Context, claims auth.Claims, req SettleCycleRequest, now time.Time) (*Cycle, error) {
+	span, ctx := tracer.StartSpanFromContext(ctx, "internal.transaction.SettleCycle")
+	defer span.Finish()
+
+	if claims.Audience == "" || !claims.HasRole(auth.RoleAdmin, auth.RoleSuperAdmin) {
+		return nil, errors.WithStack(ErrForbidden)
+	}
+
+	// Validate the request.
+	v := webcontext.Validator()
+	err := v.Struct(req)
+	if err != nil {
+		return nil, err
+	}
+
+	// If now empty set it to the current time.
+	if now.IsZero() {
+		now = time.Now()
+	}
+
+	// Always store the time as UTC.
+	now = now.UTC()
+	// Postgres truncates times to milliseconds when storing. We and do the same
+	// here so the value we return is consistent with what we store.
+	now = now.Truncate(time.Millisecond)
+
+	tx, err := repo.DbConn.Begin()
+	if err != nil {
+		return nil, err
+	}
+
+	cycle, err := models.FindDSCycle(ctx, tx, req.ID)
+	if err != nil {
+		_ = tx.Rollback()
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, weberror.NewError(ctx, ErrNotFound, http.StatusNotFound)
+		}
+		return nil, err
+	}
+
+	// The account is locked before the cycle, in the same order as deposits lock them.
+	account, err := repo.lockAccount(ctx, models.AccountWhere.ID.EQ(cycle.AccountID), tx)
+	if err != nil {
+		_ = tx.Rollback()
+		return nil, err
+	}
+
+	cycle, err = models.DSCycles(models.DSCycleWhere.ID.EQ(req.ID), For("UPDATE")).One(ctx, tx)
+	if err != nil {
+		_ = tx.Rollback()
+		return nil, err
+	}
+
+	if cycle.Status != CycleStatus_Open {
+		_ = tx.Rollback()
+		return nil, weberror.NewError(ctx, ErrCycleSettled, http.StatusBadRequest)
+	}
+	if !CycleFromModel(cycle).Due(now) {
+		_ = tx.Rollback()
+		return nil, weberror.NewError(ctx, ErrCycleNotDue, http.StatusBadRequest)
+	}
+
+	balance, err := repo.AccountBalanceTx(ctx, account.ID, tx)
+	if err != nil {
+		_ = tx.Rollback()
+		return nil, err
+	}
+
+	cycle.Status = CycleStatus_Settled
+	cycle.Settlement = req.Action
+	cycle.CarriedForward = balance.Kobo()
+	cycle.SettledByID = null.StringFrom(claims.Subject)
+	cycle.SettledAt = null.Int64From(now.Unix())
+	cycle.UpdatedAt = now.Unix()
+
+	if req.Action == CycleSettlement_Payout {
+		amount := balance - account_product.FromModel(account.R.Product).MinBalance
+		if amount <= 0 {
+			_ = tx.Rollback()
+			return nil, weberror.NewError(ctx, ErrNothingToPayOut, http.StatusBadRequest)
+		}
+
+		paymentMethod := req.PaymentMethod
+		if paymentMethod == "" {
+			paymentMethod = PaymentMethod_Cash
+		}
+
+		txn, err := repo.MakeDeduction(ctx, claims, MakeDeductionRequest{
+			AccountNumber: account.Number,
+			Amount:        amount,
+			Narration:     fmt.Sprintf("%s - DS cycle %d payout", paymentMethod, cycle.Number),
+			LedgerAccount: LedgerAccountForPaymentMethod(paymentMethod),
+		}, now, tx)
+		if err != nil {
+			_ = tx.Rollback()
+			return nil, err
+		}
+
+		if _, err = models.Transactions(models.TransactionWhere.ID.EQ(txn.ID)).UpdateAll(ctx, tx, models.M{
+			models.TransactionColumns.DSCycleID:     cycle.ID,
+			models.TransactionColumns.PaymentMethod: paymentMethod,
+		}); err != nil {
+			_ = tx.Rollback()
+			return nil, err
+		}
+
+		cycle.Payout = amount.Kobo()
+		cycle.CarriedForward = (balance - amount).Kobo()
+		cycle.PayoutTransactionID = null.StringFrom(txn.ID)
+	}
+
+	if _, err = cycle.Update(ctx, tx, boil.Infer()); err != nil {
+		_ = tx.Rollback()
+		return nil, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return repo.ReadCycle(ctx, claims, cycle.ID)
+}
+
+// cycleFor returns the cycle of the account that a deposit with the effective date is a day of.
+// A new cycle is opened when there is none, when the date is past the end of the latest one or
+// when it has been settled. A cycle that is still open when the next one is opened is rolled over
+// into it with the balance of the account. It reports whether the cycle was opened.
+func (repo *Repository) cycleFor(ctx context.Context, account *models.Account, cycleDays int, balance money.Amount,
+	effectiveDate, now time.Time, tx *sql.Tx) (*models.DSCycle, bool, error) {
+
+	latest, err := models.DSCycles(
+		models.DSCycleWhere.AccountID.EQ(account.ID),
+		OrderBy(fmt.Sprintf("%s desc", models.DSCycleColumns.Number)),
+		Limit(1),
+		For("UPDATE"),
+	).One(ctx, tx)
+	if err != nil && errors.Cause(err) != sql.ErrNoRows {
+		return nil, false, err
+	}
+
+	number := 1
+	if latest != nil {
+		if latest.Status == CycleStatus_Open && effectiveDate.Unix() <= latest.EndDate {
+			return latest, false, nil
+		}
+
+		number = latest.Number + 1
+		if latest.Status == CycleStatus_Open {
+			latest.Status = CycleStatus_Settled
+			latest.Settlement = CycleSettlement_Rollover
+			latest.CarriedForward = balance.Kobo()
+			latest.SettledAt = null.Int64From(now.Unix())
+			latest.UpdatedAt = now.Unix()
+			if _, err := latest.Update(ctx, tx, boil.Infer()); err != nil {
+				return nil, false, err
+			}
+		}
+	}
+
+	m := &models.DSCycle{
+		ID:             uuid.NewRandom().String(),
+		AccountID:      account.ID,
+		Number:         number,
+		StartDate:      effectiveDate.Unix(),
+		EndDate:        CycleEnd(effectiveDate, cycleDays).Unix(),
+		Target:         account.Target,
+		BroughtForward: balance.Kobo(),
+		Status:         CycleStatus_Open,
+		CreatedAt:      now.Unix(),
+		UpdatedAt:      now.Unix(),
+	}
+	if err := m.Insert(ctx, tx, boil.Infer()); err != nil {
+		return nil, false, errors.WithMessage(err, "Insert DS cycle failed")
+	}
+
+	return m, true, nil
+}
+
+// refreshCycle recounts the days and amount paid in the cycle and the fee taken from it. Entries
+// that were reversed and the reversals themselves are left out so corrections are accounted for.
+func (repo *Repository) refreshCycle(ctx context.Context, id string, now time.Time, tx *sql.Tx) error {
+	const q = `UPDATE ds_cycle SET days_paid = s.days_paid, amount_paid = s.amount_paid, fee = s.fee, updated_at = $2
+		FROM (SELECT
+				count(*) FILTER (WHERE t.tx_type = 'deposit') AS days_paid,
+				COALESCE(sum(t.amount) FILTER (WHERE t.tx_type = 'deposit'), 0) AS amount_paid,
+				COALESCE(sum(t.amount) FILTER (WHERE t.tx_type = 'withdrawal'
+					AND t.id IS DISTINCT FROM (SELECT payout_transaction_id FROM ds_cycle WHERE id = $1)), 0) AS fee
+			FROM transaction t
+			WHERE t.ds_cycle_id = $1 AND t.reversal_of_id IS NULL AND t.archived_at IS NULL
+				AND NOT EXISTS (SELECT 1 FROM transaction r WHERE r.reversal_of_id = t.id)) s
+		WHERE ds_cycle.id = $1`
+
+	if _, err := tx.ExecContext(ctx, q, id, now.Unix()); err != nil {
+		return errors.WithMessage(err, "Refresh DS cycle failed")
+	}
+
+	return nil
+}
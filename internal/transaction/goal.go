@@ -0,0 +1,54 @@
+package transaction
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"merryworld/surebank/internal/account"
+	"merryworld/surebank/internal/platform/money"
+	"merryworld/surebank/internal/postgres/models"
+)
+
+// trackGoal keeps the goal milestone of an SB account in step with its new balance. The customer
+// is sent an SMS when the balance reaches a milestone of the target that was not notified before.
+// Milestones the balance falls back below are cleared so they are notified again when reached.
+func (repo *Repository) trackGoal(ctx context.Context, acc *models.Account, balance money.Amount, dbTx *sql.Tx) error {
+	target := money.Amount(acc.Target)
+	if !account.HasGoal(acc.AccountType, target) {
+		return nil
+	}
+
+	milestone := account.GoalMilestone(balance, target)
+	if milestone == acc.GoalMilestone {
+		return nil
+	}
+
+	if _, err := models.Accounts(models.AccountWhere.ID.EQ(acc.ID)).UpdateAll(ctx, dbTx, models.M{
+		models.AccountColumns.GoalMilestone: milestone,
+	}); err != nil {
+		return err
+	}
+
+	notified := acc.GoalMilestone
+	acc.GoalMilestone = milestone
+	if milestone < notified || acc.R == nil || acc.R.Customer == nil {
+		return nil
+	}
+
+	if err := repo.notifySMS.Send(ctx, acc.R.Customer.PhoneNumber, "sms/goal_progress",
+		map[string]interface{}{
+			"Name":          acc.R.Customer.Name,
+			"Percent":       milestone,
+			"Reached":       milestone >= 100,
+			"Target":        target,
+			"TargetInfo":    acc.TargetInfo,
+			"Balance":       balance,
+			"AccountNumber": acc.Number,
+		}); err != nil {
+		// TODO: log critical error. Send message to monitoring account
+		fmt.Println(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,25 @@
+package transaction
+
+import (
+	"context"
+	"database/sql"
+	"net/http"
+	"time"
+
+	"merryworld/surebank/internal/account"
+	"merryworld/surebank/internal/platform/money"
+	"merryworld/surebank/internal/platform/web/weberror"
+)
+
+// checkHeld validates taking amount out of the locked account with the balance against the holds
+// on it that are active at the time, so that only the available balance can be taken out.
+func checkHeld(ctx context.Context, accountID string, balance, amount money.Amount, now time.Time, tx *sql.Tx) error {
+	held, err := account.HeldAmount(ctx, tx, accountID, now)
+	if err != nil {
+		return err
+	}
+	if err = account.CheckAvailable(balance, held, amount); err != nil {
+		return weberror.NewError(ctx, err, http.StatusBadRequest)
+	}
+	return nil
+}
@@ -0,0 +1,317 @@
+package transaction
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/jinzhu/now"
+	"github.com/pborman/uuid"
+	"github.com/pkg/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	. "github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"gopkg.in/DataDog/dd-trace-go.v1/ddtrace/tracer"
+
+	"merryworld/surebank/internal/account_product"
+	"merryworld/surebank/internal/ledger"
+	"merryworld/surebank/internal/platform/auth"
+	"merryworld/surebank/internal/platform/money"
+	"merryworld/surebank/internal/platform/web/webcontext"
+	"merryworld/surebank/internal/postgres/models"
+)
+
+// AccrueInterest is the daily interest job. For every account that earns interest it records the
+// interest earned on each day since the last run, up to the day before the requested date or the
+// maturity date, and moves it from interest expense to interest payable. Accounts that reach
+// their maturity date are credited with the accrued interest and either roll over into a new
+// tenor or stop earning. Each account is handled in its own db transaction and days already
+// accrued are skipped, so the job can safely be run again for the same date.
+func (repo *Repository) AccrueInterest(ctx context.Context, req InterestRequest, currentDate time.Time) (*InterestReport, error) {
+	span, ctx := tracer.StartSpanFromContext(ctx, "internal.transaction.AccrueInterest")
+	defer span.Finish()
+
+	// Validate the request.
+	v := webcontext.Validator()
+	if err := v.Struct(req); err != nil {
+		return nil, err
+	}
+
+	// If now empty set it to the current time.
+	if currentDate.IsZero() {
+		currentDate = time.Now()
+	}
+
+	// Always store the time as UTC.
+	currentDate = currentDate.UTC()
+	// Postgres truncates times to milliseconds when storing. We and do the same
+	// here so the value we return is consistent with what we store.
+	currentDate = currentDate.Truncate(time.Millisecond)
+
+	report := &InterestReport{Date: now.New(req.Date).BeginningOfDay()}
+
+	accounts, err := models.Accounts(
+		Select(models.AccountColumns.ID),
+		models.AccountWhere.InterestRateBPS.GT(0),
+		models.AccountWhere.MaturedAt.IsNull(),
+		models.AccountWhere.ArchivedAt.IsNull(),
+		OrderBy(models.AccountColumns.ID),
+	).All(ctx, repo.DbConn)
+	if err != nil {
+		return nil, errors.WithMessage(err, "Cannot list interest earning accounts")
+	}
+
+	for _, a := range accounts {
+		if err := repo.accrueAccountInterest(ctx, a.ID, report, currentDate); err != nil {
+			return report, errors.WithMessagef(err, "Cannot accrue interest of account %s", a.ID)
+		}
+	}
+
+	return report, nil
+}
+
+// accrueAccountInterest accrues and matures the interest of one account.
+func (repo *Repository) accrueAccountInterest(ctx context.Context, accountID string, report *InterestReport,
+	currentDate time.Time) error {
+
+	tx, err := repo.DbConn.Begin()
+	if err != nil {
+		return err
+	}
+
+	account, err := repo.lockAccount(ctx, models.AccountWhere.ID.EQ(accountID), tx)
+	if err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+
+	// The account may have matured since it was listed.
+	if account.MaturedAt.Valid || account.InterestRateBPS <= 0 {
+		return tx.Rollback()
+	}
+
+	start := now.New(time.Unix(account.CreatedAt, 0)).BeginningOfDay()
+	if account.InterestAccruedTo.Valid {
+		start = time.Unix(account.InterestAccruedTo.Int64, 0).AddDate(0, 0, 1)
+	}
+
+	var accrued money.Amount
+	var days int
+	var lastAccrualID string
+	for {
+		// Interest is earned for every day the money is held before maturity.
+		end := report.Date
+		var maturity time.Time
+		if account.MaturityDate.Valid {
+			maturity = now.New(time.Unix(account.MaturityDate.Int64, 0)).BeginningOfDay()
+			if maturity.Before(end) {
+				end = maturity
+			}
+		}
+
+		for day := start; day.Before(end); day = day.AddDate(0, 0, 1) {
+			var balance null.Int64
+			if err = tx.QueryRowContext(ctx, statementOpeningStatement, account.ID, day.AddDate(0, 0, 1).Unix()).Scan(&balance); err != nil {
+				_ = tx.Rollback()
+				return errors.WithMessage(err, "Cannot read balance")
+			}
+
+			m := models.InterestAccrual{
+				ID:        uuid.NewRandom().String(),
+				AccountID: account.ID,
+				Date:      day.Unix(),
+				Balance:   balance.Int64,
+				RateBPS:   account.InterestRateBPS,
+				Amount:    account_product.DailyInterest(money.Amount(balance.Int64), account.InterestRateBPS).Kobo(),
+				CreatedAt: currentDate.Unix(),
+			}
+			if err := m.Insert(ctx, tx, boil.Infer()); err != nil {
+				_ = tx.Rollback()
+				return errors.WithMessage(err, "Insert interest accrual failed")
+			}
+
+			accrued += money.Amount(m.Amount)
+			account.AccruedInterest += m.Amount
+			account.InterestAccruedTo = null.Int64From(day.Unix())
+			lastAccrualID = m.ID
+			days++
+			start = day.AddDate(0, 0, 1)
+		}
+
+		if maturity.IsZero() || report.Date.Before(maturity) {
+			break
+		}
+
+		if err = repo.matureAccount(ctx, account, maturity, report, currentDate, tx); err != nil {
+			_ = tx.Rollback()
+			return err
+		}
+		if account.MaturedAt.Valid {
+			break
+		}
+	}
+
+	if days == 0 && !account.MaturedAt.Valid && accrued == 0 {
+		return tx.Rollback()
+	}
+
+	if accrued > 0 {
+		_, err = repo.LedgerRepo.PostTx(ctx, auth.Claims{}, ledger.PostRequest{
+			Reference:  account.Number,
+			Narration:  fmt.Sprintf("interest accrued on %s for %d days", account.Number, days),
+			SourceType: ledger.SourceInterest,
+			SourceID:   lastAccrualID,
+			Lines: []ledger.Line{
+				ledger.Debit(ledger.AccountInterestExpense, accrued),
+				ledger.Credit(ledger.AccountInterestPayable, accrued).ForAccount(account.ID),
+			},
+		}, currentDate, tx)
+		if err != nil {
+			_ = tx.Rollback()
+			return errors.WithMessage(err, "Cannot post interest accrual to the ledger")
+		}
+	}
+
+	if _, err = models.Accounts(models.AccountWhere.ID.EQ(account.ID)).UpdateAll(ctx, tx, models.M{
+		models.AccountColumns.AccruedInterest:   account.AccruedInterest,
+		models.AccountColumns.InterestAccruedTo: account.InterestAccruedTo,
+		models.AccountColumns.MaturityDate:      account.MaturityDate,
+		models.AccountColumns.MaturedAt:         account.MaturedAt,
+	}); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return err
+	}
+
+	if days > 0 {
+		report.Accounts++
+		report.Days += days
+		report.Accrued += accrued
+	}
+
+	return nil
+}
+
+// matureAccount credits the interest accrued on the account to its balance as an interest
+// transaction and either starts a new tenor or stops the account from earning interest. The
+// interest paid out is recorded against the profit of the business.
+func (repo *Repository) matureAccount(ctx context.Context, account *models.Account, maturity time.Time,
+	report *InterestReport, currentDate time.Time, tx *sql.Tx) error {
+
+	interest := money.Amount(account.AccruedInterest)
+	if interest > 0 {
+		balance, err := repo.AccountBalanceTx(ctx, account.ID, tx)
+		if err != nil {
+			return err
+		}
+
+		m := models.Transaction{
+			ID:             uuid.NewRandom().String(),
+			AccountID:      account.ID,
+			OpeningBalance: balance.Kobo(),
+			Amount:         interest.Kobo(),
+			Narration:      fmt.Sprintf("Interest at maturity on %s", maturity.Format("02 Jan 2006")),
+			TXType:         TransactionType_Deposit.String(),
+			SalesRepID:     account.SalesRepID,
+			ReceiptNo:      repo.generateReceiptNumber(ctx),
+			// The interest opens with the current balance so it is listed after the transactions
+			// posted since maturity when the job runs late, it is effective from maturity.
+			CreatedAt:     currentDate.Unix(),
+			UpdatedAt:     currentDate.Unix(),
+			EffectiveDate: maturity.Unix(),
+		}
+		if err := m.Insert(ctx, tx, boil.Infer()); err != nil {
+			return errors.WithMessage(err, "Insert interest failed")
+		}
+
+		if err := repo.postToLedger(ctx, auth.Claims{}, &m, account.Number, ledger.AccountInterestPayable, currentDate, tx); err != nil {
+			return err
+		}
+
+		balance += interest
+		if _, err := models.Accounts(models.AccountWhere.ID.EQ(account.ID)).UpdateAll(ctx, tx, models.M{
+			models.AccountColumns.Balance: balance.Kobo(),
+		}); err != nil {
+			return err
+		}
+
+		// Interest paid reduces the profit of the business.
+		p := models.Profit{
+			ID:        uuid.NewRandom().String(),
+			Amount:    -interest.Kobo(),
+			Narration: fmt.Sprintf("Interest on %s", account.Number),
+			CreatedAt: currentDate.Unix(),
+			UpdatedAt: currentDate.Unix(),
+		}
+		if err := p.Insert(ctx, tx, boil.Infer()); err != nil {
+			return errors.WithMessage(err, "Insert interest expense failed")
+		}
+
+		account.AccruedInterest = 0
+		report.Credited += interest
+	}
+	report.Matured++
+
+	if account.RollOver && account.TenorDays > 0 {
+		account.MaturityDate = null.Int64From(maturity.AddDate(0, 0, account.TenorDays).Unix())
+		report.RolledOver++
+		return nil
+	}
+
+	account.MaturedAt = null.Int64From(currentDate.Unix())
+	return nil
+}
+
+// earlyWithdrawalPenalty returns the penalty for taking amount out of the account before it
+// matures. Accounts without a maturity date, or past it, are not charged.
+func earlyWithdrawalPenalty(account *models.Account, amount money.Amount, currentDate time.Time) money.Amount {
+	if !account.MaturityDate.Valid || account.MaturedAt.Valid || currentDate.Unix() >= account.MaturityDate.Int64 {
+		return 0
+	}
+	return account_product.FromModel(account.R.Product).EarlyWithdrawalPenalty(amount)
+}
+
+// chargeEarlyWithdrawalPenalty deducts the penalty from the account as a withdrawal of its own,
+// effective on the same day as the withdrawal that caused it, and records it as income.
+func (repo *Repository) chargeEarlyWithdrawalPenalty(ctx context.Context, claims auth.Claims, account *models.Account,
+	withdrawal *models.Transaction, balance, penalty money.Amount, currentDate time.Time, tx *sql.Tx) error {
+
+	m := models.Transaction{
+		ID:             uuid.NewRandom().String(),
+		AccountID:      account.ID,
+		TXType:         TransactionType_Withdrawal.String(),
+		OpeningBalance: balance.Kobo(),
+		Amount:         penalty.Kobo(),
+		Narration:      "Early withdrawal penalty",
+		SalesRepID:     claims.Subject,
+		ReceiptNo:      repo.generateReceiptNumber(ctx),
+		// Listed after the withdrawal that caused it.
+		CreatedAt:     currentDate.Add(time.Second).Unix(),
+		UpdatedAt:     currentDate.Unix(),
+		EffectiveDate: withdrawal.EffectiveDate,
+	}
+	if err := m.Insert(ctx, tx, boil.Infer()); err != nil {
+		return errors.WithMessage(err, "Insert early withdrawal penalty failed")
+	}
+
+	if err := repo.postToLedger(ctx, claims, &m, account.Number, ledger.AccountPenaltyIncome, currentDate, tx); err != nil {
+		return err
+	}
+
+	p := models.Profit{
+		ID:        uuid.NewRandom().String(),
+		Amount:    penalty.Kobo(),
+		Narration: fmt.Sprintf("Early withdrawal penalty on %s", account.Number),
+		CreatedAt: currentDate.Unix(),
+		UpdatedAt: currentDate.Unix(),
+	}
+	if err := p.Insert(ctx, tx, boil.Infer()); err != nil {
+		return errors.WithMessage(err, "Insert early withdrawal penalty income failed")
+	}
+
+	return nil
+}
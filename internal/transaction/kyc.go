@@ -0,0 +1,38 @@
+package transaction
+
+import (
+	"context"
+	"database/sql"
+	"net/http"
+	"time"
+
+	"merryworld/surebank/internal/customer"
+	"merryworld/surebank/internal/platform/money"
+	"merryworld/surebank/internal/platform/web/weberror"
+	"merryworld/surebank/internal/postgres/models"
+)
+
+// checkKYCLimit stops a deposit or withdrawal on the locked account that takes its customer over
+// the daily limit of their KYC tier. The customer of the account must be loaded.
+func checkKYCLimit(ctx context.Context, acc *models.Account, txType TransactionType, amount money.Amount,
+	now time.Time, tx *sql.Tx) error {
+	if acc.R == nil || acc.R.Customer == nil {
+		return nil
+	}
+
+	limits := customer.Limits(customer.FromModel(acc.R.Customer).EffectiveTier())
+	used, err := customer.DailyUsed(ctx, tx, acc.CustomerID, txType.String(), now)
+	if err != nil {
+		return err
+	}
+
+	if txType == TransactionType_Withdrawal {
+		err = limits.CheckWithdrawal(used, amount)
+	} else {
+		err = limits.CheckDeposit(used, amount)
+	}
+	if err != nil {
+		return weberror.NewError(ctx, err, http.StatusBadRequest)
+	}
+	return nil
+}
@@ -5,9 +5,10 @@ import (
 	"database/sql/driver"
 	"errors"
 	"merryworld/surebank/internal/dscommission"
+	"merryworld/surebank/internal/ledger"
+	"merryworld/surebank/internal/platform/money"
 	"merryworld/surebank/internal/platform/notify"
 	"merryworld/surebank/internal/profit"
-	"sync"
 	"time"
 
 	"gopkg.in/go-playground/validator.v9"
@@ -26,18 +27,22 @@ type Repository struct {
 	DbConn         *sqlx.DB
 	CommissionRepo *dscommission.Repository
 	ProfitRepo     *profit.Repository
+	LedgerRepo     *ledger.Repository
 	notifySMS      notify.SMS
-	accNumMtx      sync.Mutex
+	notifyEmail    notify.Email
 	creatDB        func() (*sqlx.DB, error)
 }
 
 // NewRepository creates a new Repository that defines dependencies for Transaction.
-func NewRepository(db *sqlx.DB, commissionRepo *dscommission.Repository, profitRepo *profit.Repository, notifySMS notify.SMS, creatDB func() (*sqlx.DB, error)) *Repository {
+func NewRepository(db *sqlx.DB, commissionRepo *dscommission.Repository, profitRepo *profit.Repository,
+	ledgerRepo *ledger.Repository, notifySMS notify.SMS, notifyEmail notify.Email, creatDB func() (*sqlx.DB, error)) *Repository {
 	return &Repository{
 		DbConn:         db,
 		CommissionRepo: commissionRepo,
 		ProfitRepo:     profitRepo,
+		LedgerRepo:     ledgerRepo,
 		notifySMS:      notifySMS,
+		notifyEmail:    notifyEmail,
 		creatDB:        creatDB,
 	}
 }
@@ -47,8 +52,8 @@ type Transaction struct {
 	ID             string          `json:"id" example:"985f1746-1d9f-459f-a2d9-fc53ece5ae86"`
 	AccountID      string          `json:"account_id" example:"985f1746-1d9f-459f-a2d9-fc53ece5ae86"`
 	Type           TransactionType `json:"type" example:"deposit"`
-	OpeningBalance float64         `json:"opening_balance" exmaple:"34500.01"`
-	Amount         float64         `json:"amount" truss:"api-read"`
+	OpeningBalance money.Amount    `json:"opening_balance" exmaple:"34500.01"`
+	Amount         money.Amount    `json:"amount" truss:"api-read"`
 	Narration      string          `json:"narration" truss:"api-read"`
 	PaymentMethod  string          `json:"payment_method" truss:"api-read"`
 	SalesRepID     string          `json:"sales_rep_id" truss:"api-read"`
@@ -57,6 +62,12 @@ type Transaction struct {
 	CreatedAt      time.Time       `json:"created_at" truss:"api-read"`
 	UpdatedAt      time.Time       `json:"updated_at" truss:"api-read"`
 	ArchivedAt     *time.Time      `json:"archived_at,omitempty" truss:"api-hide"`
+	ReversalOfID   *string         `json:"reversal_of_id,omitempty" truss:"api-read"`
+	CorrectionOfID *string         `json:"correction_of_id,omitempty" truss:"api-read"`
+	Reason         string          `json:"reason,omitempty" truss:"api-read"`
+	ApprovedByID   *string         `json:"approved_by_id,omitempty" truss:"api-read"`
+	TransferID     *string         `json:"transfer_id,omitempty" truss:"api-read"`
+	DSCycleID      *string         `json:"ds_cycle_id,omitempty" truss:"api-read"`
 
 	SalesRep *user.User       `json:"sales_rep" truss:"api-read"`
 	Account  *account.Account `json:"account" truss:"api-read"`
@@ -67,8 +78,8 @@ func FromModel(rec *models.Transaction) *Transaction {
 		ID:             rec.ID,
 		AccountID:      rec.AccountID,
 		Type:           TransactionType(rec.TXType),
-		OpeningBalance: rec.OpeningBalance,
-		Amount:         rec.Amount,
+		OpeningBalance: money.Amount(rec.OpeningBalance),
+		Amount:         money.Amount(rec.Amount),
 		Narration:      rec.Narration,
 		PaymentMethod:  rec.PaymentMethod,
 		SalesRepID:     rec.SalesRepID,
@@ -76,6 +87,12 @@ func FromModel(rec *models.Transaction) *Transaction {
 		EffectiveDate:  time.Unix(rec.EffectiveDate, 0).UTC(),
 		CreatedAt:      time.Unix(rec.CreatedAt, 0).UTC(),
 		UpdatedAt:      time.Unix(rec.UpdatedAt, 0).UTC(),
+		ReversalOfID:   rec.ReversalOfID.Ptr(),
+		CorrectionOfID: rec.CorrectionOfID.Ptr(),
+		Reason:         rec.Reason,
+		ApprovedByID:   rec.ApprovedByID.Ptr(),
+		TransferID:     rec.TransferID.Ptr(),
+		DSCycleID:      rec.DSCycleID.Ptr(),
 	}
 
 	if rec.R != nil {
@@ -104,8 +121,8 @@ type Response struct {
 	AccountNumber  string            `json:"account_number" example:"SB10003001" truss:"api-read"`
 	CustomerID     string            `json:"customer_id" truss:"api-read"`
 	Customer       string            `json:"customer"`
-	OpeningBalance float64           `json:"opening_balance" truss:"api-read"`
-	Amount         float64           `json:"amount" truss:"api-read"`
+	OpeningBalance money.Amount      `json:"opening_balance" truss:"api-read"`
+	Amount         money.Amount      `json:"amount" truss:"api-read"`
 	Narration      string            `json:"narration" truss:"api-read"`
 	PaymentMethod  string            `json:"payment_method" truss:"api-read"`
 	SalesRepID     string            `json:"sales_rep_id" truss:"api-read"`
@@ -115,6 +132,12 @@ type Response struct {
 	CreatedAt      web.TimeResponse  `json:"created_at" truss:"api-read"`            // CreatedAt contains multiple format options for display.
 	UpdatedAt      web.TimeResponse  `json:"updated_at" truss:"api-read"`            // UpdatedAt contains multiple format options for display.
 	ArchivedAt     *web.TimeResponse `json:"archived_at,omitempty" truss:"api-read"` // ArchivedAt contains multiple format options for display.
+	ReversalOfID   *string           `json:"reversal_of_id,omitempty" truss:"api-read"`
+	CorrectionOfID *string           `json:"correction_of_id,omitempty" truss:"api-read"`
+	Reason         string            `json:"reason,omitempty" truss:"api-read"`
+	ApprovedByID   *string           `json:"approved_by_id,omitempty" truss:"api-read"`
+	TransferID     *string           `json:"transfer_id,omitempty" truss:"api-read"`
+	DSCycleID      *string           `json:"ds_cycle_id,omitempty" truss:"api-read"`
 }
 
 // Response transforms Transaction to the Response that is used for display.
@@ -137,6 +160,12 @@ func (m *Transaction) Response(ctx context.Context) *Response {
 		EffectiveDate:  web.NewTimeResponse(ctx, m.EffectiveDate),
 		CreatedAt:      web.NewTimeResponse(ctx, m.CreatedAt),
 		UpdatedAt:      web.NewTimeResponse(ctx, m.UpdatedAt),
+		ReversalOfID:   m.ReversalOfID,
+		CorrectionOfID: m.CorrectionOfID,
+		Reason:         m.Reason,
+		ApprovedByID:   m.ApprovedByID,
+		TransferID:     m.TransferID,
+		DSCycleID:      m.DSCycleID,
 	}
 
 	if m.ArchivedAt != nil && !m.ArchivedAt.IsZero() {
@@ -163,7 +192,7 @@ type TxReportResponse struct {
 	AccountNumber string          `json:"account_number" example:"SB10003001" truss:"api-read"`
 	CustomerID    string          `json:"customer_id" truss:"api-read"`
 	CustomerName  string          `json:"customer_name" truss:"api-read"`
-	Amount        float64         `json:"amount" truss:"api-read"`
+	Amount        money.Amount    `json:"amount" truss:"api-read"`
 	Narration     string          `json:"narration" truss:"api-read"`
 	PaymentMethod string          `json:"payment_method" truss:"api-read"`
 	SalesRepID    string          `json:"sales_rep_id" truss:"api-read"`
@@ -200,34 +229,58 @@ type PagedResponseList struct {
 type CreateRequest struct {
 	Type          TransactionType `json:"type" validate:"required,oneof=deposit withdrawal"`
 	AccountNumber string          `json:"account_number" validate:"required"`
-	Amount        float64         `json:"amount" validate:"required,gt=0"`
+	Amount        money.Amount    `json:"amount" validate:"required,gt=0"`
 	Narration     string          `json:"narration"`
 	PaymentMethod string          `json:"payment_method"`
+	// IdempotencyKey is provided by the client in the Idempotency-Key header to make retries safe.
+	IdempotencyKey string `json:"-"`
 }
 
 // WithdrawRequest contains information needed to make a new Transaction.
 type WithdrawRequest struct {
 	Type              TransactionType `json:"type" validate:"required,oneof=deposit withdrawal"`
 	AccountNumber     string          `json:"account_number" validate:"required"`
-	Amount            float64         `json:"amount" validate:"required,gt=0"`
+	Amount            money.Amount    `json:"amount" validate:"required,gt=0"`
 	PaymentMethod     string          `json:"payment_method" validate:"required"`
 	Bank              string          `json:"bank"`
 	BankAccountNumber string          `json:"bank_account_number"`
 	Narration         string          `json:"narration"`
+	// IdempotencyKey is provided by the client in the Idempotency-Key header to make retries safe.
+	IdempotencyKey string `json:"-"`
 }
 
 type MakeDeductionRequest struct {
-	AccountNumber string  `json:"account_number" validate:"required"`
-	Amount        float64 `json:"amount" validate:"required,gt=0"`
-	Narration     string  `json:"narration"`
+	AccountNumber string       `json:"account_number" validate:"required"`
+	Amount        money.Amount `json:"amount" validate:"required,gt=0"`
+	Narration     string       `json:"narration"`
+	// LedgerAccount is the ledger account credited with the deduction, defaults to cash.
+	LedgerAccount string `json:"ledger_account"`
+}
+
+// MakeCreditRequest contains information needed to pay money into an account from a ledger
+// account other than cash, such as a loan disbursement.
+type MakeCreditRequest struct {
+	AccountNumber string       `json:"account_number" validate:"required"`
+	Amount        money.Amount `json:"amount" validate:"required,gt=0"`
+	Narration     string       `json:"narration"`
+	// LedgerAccount is the ledger account debited with the credit.
+	LedgerAccount string `json:"ledger_account" validate:"required"`
+}
+
+// OpeningBalanceRequest defines the information needed to bring the balance of an account over
+// from the records it was kept in before.
+type OpeningBalanceRequest struct {
+	AccountID string       `json:"account_id" validate:"required,uuid"`
+	Amount    money.Amount `json:"amount" validate:"required,gt=0"`
+	Narration string       `json:"narration"`
 }
 
 // CreateDepositRequest contains information needed to add a new Transaction of type, deposit.
 type CreateDepositRequest struct {
-	AccountNumber string  `json:"account_number" validate:"required"`
-	Amount        float64 `json:"amount" validate:"required,gt=0"`
-	PaymentMethod string  `json:"payment_method" validate:"required" truss:"api-read"`
-	Narration     string  `json:"narration"`
+	AccountNumber string       `json:"account_number" validate:"required"`
+	Amount        money.Amount `json:"amount" validate:"required,gt=0"`
+	PaymentMethod string       `json:"payment_method" validate:"required" truss:"api-read"`
+	Narration     string       `json:"narration"`
 }
 
 // ReadRequest defines the information needed to read a deposit from the system.
@@ -236,20 +289,22 @@ type ReadRequest struct {
 	IncludeArchived bool   `json:"include-archived" example:"false"`
 }
 
-// UpdateRequest defines what information may be provided to modify an existing
-// Transaction. All fields are optional so clients can send just the fields they want
+// UpdateRequest defines what information may be provided to correct an existing
+// Transaction. Amount and Narration are optional so clients can send just the fields they want
 // changed. It uses pointer fields so we can differentiate between a field that
 // was not provided and a field that was provided as explicitly blank.
 type UpdateRequest struct {
-	ID        string   `json:"id" validate:"required,uuid" example:"985f1746-1d9f-459f-a2d9-fc53ece5ae86"`
-	Amount    *float64 `json:"amount" validate:"omitempty,gt=0"`
-	Narration *string  `json:"narration"`
+	ID        string        `json:"id" validate:"required,uuid" example:"985f1746-1d9f-459f-a2d9-fc53ece5ae86"`
+	Amount    *money.Amount `json:"amount" validate:"omitempty,gt=0"`
+	Narration *string       `json:"narration"`
+	Reason    string        `json:"reason" validate:"required,max=200" example:"Amount entered wrongly"`
 }
 
-// ArchiveRequest defines the information needed to archive a deposit. This will archive (soft-delete) the
-// existing database entry.
+// ArchiveRequest defines the information needed to reverse a transaction. The existing database
+// entry is kept and a reversal linked to it is posted.
 type ArchiveRequest struct {
-	ID string `json:"id" validate:"required,uuid" example:"985f1746-1d9f-459f-a2d9-fc53ece5ae86"`
+	ID     string `json:"id" validate:"required,uuid" example:"985f1746-1d9f-459f-a2d9-fc53ece5ae86"`
+	Reason string `json:"reason" validate:"required,max=200" example:"Posted to the wrong account"`
 }
 
 // DeleteRequest defines the information needed to delete a customer account.
@@ -272,6 +327,573 @@ type FindRequest struct {
 	IncludeSalesRep  bool          `json:"include_sales_rep" example:"false"`
 }
 
+// StatementRequest defines the information needed to produce the statement of an account for a
+// period. Transactions created from the start date up to and including the end date are listed.
+type StatementRequest struct {
+	AccountID string    `json:"account_id" validate:"required,uuid" example:"985f1746-1d9f-459f-a2d9-fc53ece5ae86"`
+	StartDate time.Time `json:"start_date" validate:"required"`
+	EndDate   time.Time `json:"end_date" validate:"required,gtefield=StartDate"`
+}
+
+// EmailStatementRequest defines the information needed to email the statement of an account.
+type EmailStatementRequest struct {
+	StatementRequest
+	// Email is where the statement is sent, it defaults to the email of the customer.
+	Email string `json:"email" validate:"omitempty,email" example:"gabi@geeksinthewoods.com"`
+}
+
+// Statement represents the transactions of an account over a period with the running balance.
+type Statement struct {
+	AccountID        string          `json:"account_id"`
+	AccountNumber    string          `json:"account_number"`
+	AccountType      string          `json:"account_type"`
+	CustomerName     string          `json:"customer_name"`
+	CustomerEmail    string          `json:"customer_email"`
+	CustomerPhone    string          `json:"customer_phone"`
+	CustomerAddress  string          `json:"customer_address"`
+	Branch           string          `json:"branch"`
+	StartDate        time.Time       `json:"start_date"`
+	EndDate          time.Time       `json:"end_date"`
+	OpeningBalance   money.Amount    `json:"opening_balance"`
+	TotalDeposits    money.Amount    `json:"total_deposits"`
+	TotalWithdrawals money.Amount    `json:"total_withdrawals"`
+	ClosingBalance   money.Amount    `json:"closing_balance"`
+	Lines            []StatementLine `json:"lines"`
+	GeneratedAt      time.Time       `json:"generated_at"`
+}
+
+// StatementLine represents a transaction on a statement along with the balance after it.
+type StatementLine struct {
+	TransactionID string          `json:"transaction_id"`
+	Date          time.Time       `json:"date"`
+	ReceiptNo     string          `json:"receipt_no"`
+	Type          TransactionType `json:"type"`
+	Narration     string          `json:"narration"`
+	Amount        money.Amount    `json:"amount"`
+	Balance       money.Amount    `json:"balance"`
+}
+
+// Transfer represents a movement of money between two accounts of the same customer. It is
+// recorded as a withdrawal from one account and a deposit to the other, both linked to it.
+type Transfer struct {
+	ID            string       `json:"id" example:"985f1746-1d9f-459f-a2d9-fc53ece5ae86"`
+	Reference     string       `json:"reference" example:"TF123456"`
+	FromAccountID string       `json:"from_account_id" example:"985f1746-1d9f-459f-a2d9-fc53ece5ae86"`
+	ToAccountID   string       `json:"to_account_id" example:"985f1746-1d9f-459f-a2d9-fc53ece5ae86"`
+	Amount        money.Amount `json:"amount"`
+	Narration     string       `json:"narration"`
+	SalesRepID    string       `json:"sales_rep_id"`
+	CreatedAt     time.Time    `json:"created_at"`
+
+	FromAccount  *account.Account `json:"from_account,omitempty"`
+	ToAccount    *account.Account `json:"to_account,omitempty"`
+	Transactions Transactions     `json:"transactions,omitempty"`
+}
+
+// TransferFromModel converts the transfer model and its loaded relations to a Transfer.
+func TransferFromModel(rec *models.Transfer) *Transfer {
+	t := &Transfer{
+		ID:            rec.ID,
+		Reference:     rec.Reference,
+		FromAccountID: rec.FromAccountID,
+		ToAccountID:   rec.ToAccountID,
+		Amount:        money.Amount(rec.Amount),
+		Narration:     rec.Narration,
+		SalesRepID:    rec.SalesRepID,
+		CreatedAt:     time.Unix(rec.CreatedAt, 0).UTC(),
+	}
+
+	if rec.R != nil {
+		if rec.R.FromAccount != nil {
+			t.FromAccount = account.FromModel(rec.R.FromAccount)
+		}
+		if rec.R.ToAccount != nil {
+			t.ToAccount = account.FromModel(rec.R.ToAccount)
+		}
+		for _, tx := range rec.R.Transactions {
+			t.Transactions = append(t.Transactions, FromModel(tx))
+		}
+	}
+
+	return t
+}
+
+// TransferResponse represents a transfer that is returned for display.
+type TransferResponse struct {
+	ID                string           `json:"id" example:"985f1746-1d9f-459f-a2d9-fc53ece5ae86"`
+	Reference         string           `json:"reference" example:"TF123456"`
+	FromAccountID     string           `json:"from_account_id" example:"985f1746-1d9f-459f-a2d9-fc53ece5ae86"`
+	FromAccountNumber string           `json:"from_account_number,omitempty" example:"SB10003001"`
+	ToAccountID       string           `json:"to_account_id" example:"985f1746-1d9f-459f-a2d9-fc53ece5ae86"`
+	ToAccountNumber   string           `json:"to_account_number,omitempty" example:"DS10003002"`
+	Amount            money.Amount     `json:"amount"`
+	Narration         string           `json:"narration"`
+	SalesRepID        string           `json:"sales_rep_id"`
+	CreatedAt         web.TimeResponse `json:"created_at"`
+	Transactions      []*Response      `json:"transactions"`
+}
+
+// Response transforms Transfer to the TransferResponse that is used for display.
+func (m *Transfer) Response(ctx context.Context) *TransferResponse {
+	if m == nil {
+		return nil
+	}
+
+	r := &TransferResponse{
+		ID:            m.ID,
+		Reference:     m.Reference,
+		FromAccountID: m.FromAccountID,
+		ToAccountID:   m.ToAccountID,
+		Amount:        m.Amount,
+		Narration:     m.Narration,
+		SalesRepID:    m.SalesRepID,
+		CreatedAt:     web.NewTimeResponse(ctx, m.CreatedAt),
+		Transactions:  m.Transactions.Response(ctx),
+	}
+
+	if m.FromAccount != nil {
+		r.FromAccountNumber = m.FromAccount.Number
+	}
+	if m.ToAccount != nil {
+		r.ToAccountNumber = m.ToAccount.Number
+	}
+
+	return r
+}
+
+// TransferRequest contains the information needed to move money between two accounts of a
+// customer.
+type TransferRequest struct {
+	FromAccountNumber string       `json:"from_account_number" validate:"required" example:"SB10003001"`
+	ToAccountNumber   string       `json:"to_account_number" validate:"required,nefield=FromAccountNumber" example:"DS10003002"`
+	Amount            money.Amount `json:"amount" validate:"required,gt=0"`
+	Narration         string       `json:"narration"`
+	// IdempotencyKey is provided by the client in the Idempotency-Key header to make retries safe.
+	IdempotencyKey string `json:"-"`
+}
+
+// Approval kinds name the operation an approval holds.
+const (
+	ApprovalKind_Withdrawal = "withdrawal"
+	ApprovalKind_Transfer   = "transfer"
+)
+
+// Approval statuses.
+const (
+	ApprovalStatus_Pending  = "pending"
+	ApprovalStatus_Approved = "approved"
+	ApprovalStatus_Rejected = "rejected"
+)
+
+// Approval is a withdrawal or transfer at or above the approval threshold that is held until a
+// branch admin other than the requesting rep approves or rejects it. Nothing is posted until it
+// is approved.
+type Approval struct {
+	ID            string       `json:"id" example:"985f1746-1d9f-459f-a2d9-fc53ece5ae86"`
+	Kind          string       `json:"kind" example:"withdrawal"`
+	AccountID     string       `json:"account_id" example:"985f1746-1d9f-459f-a2d9-fc53ece5ae86"`
+	ToAccountID   *string      `json:"to_account_id,omitempty" example:"985f1746-1d9f-459f-a2d9-fc53ece5ae86"`
+	BranchID      string       `json:"branch_id" example:"985f1746-1d9f-459f-a2d9-fc53ece5ae86"`
+	Amount        money.Amount `json:"amount"`
+	Narration     string       `json:"narration"`
+	LedgerAccount string       `json:"ledger_account"`
+	Status        string       `json:"status" example:"pending"`
+	RequestedByID string       `json:"requested_by_id"`
+	DecidedByID   *string      `json:"decided_by_id,omitempty"`
+	DecidedAt     *time.Time   `json:"decided_at,omitempty"`
+	Reason        string       `json:"reason,omitempty"`
+	TransactionID *string      `json:"transaction_id,omitempty"`
+	TransferID    *string      `json:"transfer_id,omitempty"`
+	CreatedAt     time.Time    `json:"created_at"`
+	UpdatedAt     time.Time    `json:"updated_at"`
+
+	Account     *account.Account `json:"account,omitempty"`
+	ToAccount   *account.Account `json:"to_account,omitempty"`
+	RequestedBy *user.User       `json:"requested_by,omitempty"`
+	DecidedBy   *user.User       `json:"decided_by,omitempty"`
+	BranchName  string           `json:"branch_name,omitempty"`
+}
+
+// ApprovalFromModel converts the approval model and its loaded relations to an Approval.
+func ApprovalFromModel(rec *models.Approval) *Approval {
+	a := &Approval{
+		ID:            rec.ID,
+		Kind:          rec.Kind,
+		AccountID:     rec.AccountID,
+		ToAccountID:   rec.ToAccountID.Ptr(),
+		BranchID:      rec.BranchID,
+		Amount:        money.Amount(rec.Amount),
+		Narration:     rec.Narration,
+		LedgerAccount: rec.LedgerAccount,
+		Status:        rec.Status,
+		RequestedByID: rec.RequestedByID,
+		DecidedByID:   rec.DecidedByID.Ptr(),
+		Reason:        rec.Reason,
+		TransactionID: rec.TransactionID.Ptr(),
+		TransferID:    rec.TransferID.Ptr(),
+		CreatedAt:     time.Unix(rec.CreatedAt, 0).UTC(),
+		UpdatedAt:     time.Unix(rec.UpdatedAt, 0).UTC(),
+	}
+
+	if rec.DecidedAt.Valid {
+		decidedAt := time.Unix(rec.DecidedAt.Int64, 0).UTC()
+		a.DecidedAt = &decidedAt
+	}
+
+	if rec.R != nil {
+		if rec.R.Account != nil {
+			a.Account = account.FromModel(rec.R.Account)
+		}
+		if rec.R.ToAccount != nil {
+			a.ToAccount = account.FromModel(rec.R.ToAccount)
+		}
+		if rec.R.RequestedBy != nil {
+			a.RequestedBy = user.FromModel(rec.R.RequestedBy)
+		}
+		if rec.R.DecidedBy != nil {
+			a.DecidedBy = user.FromModel(rec.R.DecidedBy)
+		}
+		if rec.R.Branch != nil {
+			a.BranchName = rec.R.Branch.Name
+		}
+	}
+
+	return a
+}
+
+// ApprovalResponse represents an approval that is returned for display.
+type ApprovalResponse struct {
+	ID              string            `json:"id" example:"985f1746-1d9f-459f-a2d9-fc53ece5ae86"`
+	Kind            string            `json:"kind" example:"withdrawal"`
+	AccountID       string            `json:"account_id" example:"985f1746-1d9f-459f-a2d9-fc53ece5ae86"`
+	AccountNumber   string            `json:"account_number,omitempty" example:"SB10003001"`
+	CustomerID      string            `json:"customer_id,omitempty"`
+	ToAccountID     *string           `json:"to_account_id,omitempty" example:"985f1746-1d9f-459f-a2d9-fc53ece5ae86"`
+	ToAccountNumber string            `json:"to_account_number,omitempty" example:"DS10003002"`
+	BranchID        string            `json:"branch_id" example:"985f1746-1d9f-459f-a2d9-fc53ece5ae86"`
+	Branch          string            `json:"branch,omitempty"`
+	Amount          money.Amount      `json:"amount"`
+	Narration       string            `json:"narration"`
+	Status          string            `json:"status" example:"pending"`
+	RequestedByID   string            `json:"requested_by_id"`
+	RequestedBy     string            `json:"requested_by,omitempty"`
+	DecidedByID     *string           `json:"decided_by_id,omitempty"`
+	DecidedBy       string            `json:"decided_by,omitempty"`
+	DecidedAt       *web.TimeResponse `json:"decided_at,omitempty"`
+	Reason          string            `json:"reason,omitempty"`
+	TransactionID   *string           `json:"transaction_id,omitempty"`
+	TransferID      *string           `json:"transfer_id,omitempty"`
+	CreatedAt       web.TimeResponse  `json:"created_at"`
+	UpdatedAt       web.TimeResponse  `json:"updated_at"`
+}
+
+// Response transforms Approval to the ApprovalResponse that is used for display.
+func (m *Approval) Response(ctx context.Context) *ApprovalResponse {
+	if m == nil {
+		return nil
+	}
+
+	r := &ApprovalResponse{
+		ID:            m.ID,
+		Kind:          m.Kind,
+		AccountID:     m.AccountID,
+		ToAccountID:   m.ToAccountID,
+		BranchID:      m.BranchID,
+		Branch:        m.BranchName,
+		Amount:        m.Amount,
+		Narration:     m.Narration,
+		Status:        m.Status,
+		RequestedByID: m.RequestedByID,
+		DecidedByID:   m.DecidedByID,
+		Reason:        m.Reason,
+		TransactionID: m.TransactionID,
+		TransferID:    m.TransferID,
+		CreatedAt:     web.NewTimeResponse(ctx, m.CreatedAt),
+		UpdatedAt:     web.NewTimeResponse(ctx, m.UpdatedAt),
+	}
+
+	if m.DecidedAt != nil {
+		at := web.NewTimeResponse(ctx, *m.DecidedAt)
+		r.DecidedAt = &at
+	}
+
+	if m.Account != nil {
+		r.AccountNumber = m.Account.Number
+		r.CustomerID = m.Account.CustomerID
+	}
+	if m.ToAccount != nil {
+		r.ToAccountNumber = m.ToAccount.Number
+	}
+	if m.RequestedBy != nil {
+		r.RequestedBy = m.RequestedBy.LastName + " " + m.RequestedBy.FirstName
+	}
+	if m.DecidedBy != nil {
+		r.DecidedBy = m.DecidedBy.LastName + " " + m.DecidedBy.FirstName
+	}
+
+	return r
+}
+
+// Approvals a list of Approvals.
+type Approvals []*Approval
+
+// Response transforms a list of Approvals to a list of ApprovalResponses.
+func (m *Approvals) Response(ctx context.Context) []*ApprovalResponse {
+	var l = make([]*ApprovalResponse, 0)
+	if m != nil && len(*m) > 0 {
+		for _, n := range *m {
+			l = append(l, n.Response(ctx))
+		}
+	}
+
+	return l
+}
+
+// ApprovalFindRequest defines the possible options to search for approvals.
+type ApprovalFindRequest struct {
+	Where  string        `json:"where" example:"status = ?"`
+	Args   []interface{} `json:"args" swaggertype:"array,string" example:"pending"`
+	Order  []string      `json:"order" example:"created_at desc"`
+	Limit  *uint         `json:"limit" example:"10"`
+	Offset *uint         `json:"offset" example:"20"`
+}
+
+// ApproveRequest defines the information needed to approve a pending approval.
+type ApproveRequest struct {
+	ID string `json:"id" validate:"required,uuid" example:"985f1746-1d9f-459f-a2d9-fc53ece5ae86"`
+}
+
+// RejectRequest defines the information needed to reject a pending approval.
+type RejectRequest struct {
+	ID     string `json:"id" validate:"required,uuid" example:"985f1746-1d9f-459f-a2d9-fc53ece5ae86"`
+	Reason string `json:"reason" validate:"required,max=200" example:"Customer could not be reached to confirm"`
+}
+
+// SavingsHistory summarises the deposits and withdrawals made on an account since a date.
+// Reversed transactions and money paid in from loans are left out.
+type SavingsHistory struct {
+	AccountID   string       `json:"account_id"`
+	Balance     money.Amount `json:"balance"`
+	OpenedAt    time.Time    `json:"opened_at"`
+	Since       time.Time    `json:"since"`
+	Deposits    money.Amount `json:"deposits"`
+	DepositDays int          `json:"deposit_days"`
+	Withdrawals money.Amount `json:"withdrawals"`
+}
+
+// InterestRequest defines the day the interest job runs for. Interest is accrued for every day
+// before it and accounts that mature on or before it are matured.
+type InterestRequest struct {
+	Date time.Time `json:"date" validate:"required"`
+}
+
+// InterestReport summarises a run of the interest job.
+type InterestReport struct {
+	Date       time.Time    `json:"date"`
+	Accounts   int          `json:"accounts"`
+	Days       int          `json:"days"`
+	Accrued    money.Amount `json:"accrued"`
+	Matured    int          `json:"matured"`
+	RolledOver int          `json:"rolled_over"`
+	Credited   money.Amount `json:"credited"`
+}
+
+// DS cycle statuses.
+const (
+	CycleStatus_Open    = "open"
+	CycleStatus_Settled = "settled"
+)
+
+// Ways a DS cycle is settled.
+const (
+	CycleSettlement_Payout   = "payout"
+	CycleSettlement_Rollover = "rollover"
+)
+
+// Cycle is a contribution cycle of a daily savings account. Each deposit is a day of the cycle
+// whose effective date it falls in and the first one may be kept as the fee of the product. At
+// the end of the cycle the balance is paid out or rolled into the next cycle.
+type Cycle struct {
+	ID                  string       `json:"id" example:"985f1746-1d9f-459f-a2d9-fc53ece5ae86"`
+	AccountID           string       `json:"account_id" example:"985f1746-1d9f-459f-a2d9-fc53ece5ae86"`
+	Number              int          `json:"number" example:"3"`
+	StartDate           time.Time    `json:"start_date"`
+	EndDate             time.Time    `json:"end_date"`
+	Target              money.Amount `json:"target"`
+	DaysPaid            int          `json:"days_paid"`
+	AmountPaid          money.Amount `json:"amount_paid"`
+	Fee                 money.Amount `json:"fee"`
+	BroughtForward      money.Amount `json:"brought_forward"`
+	Status              string       `json:"status" example:"open"`
+	Settlement          string       `json:"settlement,omitempty" example:"payout"`
+	Payout              money.Amount `json:"payout"`
+	CarriedForward      money.Amount `json:"carried_forward"`
+	PayoutTransactionID *string      `json:"payout_transaction_id,omitempty"`
+	SettledByID         *string      `json:"settled_by_id,omitempty"`
+	SettledAt           *time.Time   `json:"settled_at,omitempty"`
+	CreatedAt           time.Time    `json:"created_at"`
+	UpdatedAt           time.Time    `json:"updated_at"`
+
+	Account   *account.Account `json:"account,omitempty"`
+	SettledBy *user.User       `json:"settled_by,omitempty"`
+}
+
+// CycleFromModel converts the DS cycle model and its loaded relations to a Cycle.
+func CycleFromModel(rec *models.DSCycle) *Cycle {
+	c := &Cycle{
+		ID:                  rec.ID,
+		AccountID:           rec.AccountID,
+		Number:              rec.Number,
+		StartDate:           time.Unix(rec.StartDate, 0).UTC(),
+		EndDate:             time.Unix(rec.EndDate, 0).UTC(),
+		Target:              money.Amount(rec.Target),
+		DaysPaid:            rec.DaysPaid,
+		AmountPaid:          money.Amount(rec.AmountPaid),
+		Fee:                 money.Amount(rec.Fee),
+		BroughtForward:      money.Amount(rec.BroughtForward),
+		Status:              rec.Status,
+		Settlement:          rec.Settlement,
+		Payout:              money.Amount(rec.Payout),
+		CarriedForward:      money.Amount(rec.CarriedForward),
+		PayoutTransactionID: rec.PayoutTransactionID.Ptr(),
+		SettledByID:         rec.SettledByID.Ptr(),
+		CreatedAt:           time.Unix(rec.CreatedAt, 0).UTC(),
+		UpdatedAt:           time.Unix(rec.UpdatedAt, 0).UTC(),
+	}
+
+	if rec.SettledAt.Valid {
+		settledAt := time.Unix(rec.SettledAt.Int64, 0).UTC()
+		c.SettledAt = &settledAt
+	}
+
+	if rec.R != nil {
+		if rec.R.Account != nil {
+			c.Account = account.FromModel(rec.R.Account)
+		}
+		if rec.R.SettledBy != nil {
+			c.SettledBy = user.FromModel(rec.R.SettledBy)
+		}
+	}
+
+	return c
+}
+
+// CycleResponse represents a DS cycle that is returned for display.
+type CycleResponse struct {
+	ID                  string            `json:"id" example:"985f1746-1d9f-459f-a2d9-fc53ece5ae86"`
+	AccountID           string            `json:"account_id" example:"985f1746-1d9f-459f-a2d9-fc53ece5ae86"`
+	AccountNumber       string            `json:"account_number,omitempty" example:"DS10003002"`
+	CustomerID          string            `json:"customer_id,omitempty"`
+	Number              int               `json:"number" example:"3"`
+	StartDate           web.TimeResponse  `json:"start_date"`
+	EndDate             web.TimeResponse  `json:"end_date"`
+	Days                int               `json:"days" example:"31"`
+	Target              money.Amount      `json:"target"`
+	DaysPaid            int               `json:"days_paid"`
+	DaysMissed          int               `json:"days_missed"`
+	AmountPaid          money.Amount      `json:"amount_paid"`
+	Fee                 money.Amount      `json:"fee"`
+	BroughtForward      money.Amount      `json:"brought_forward"`
+	Status              string            `json:"status" example:"open"`
+	Settlement          string            `json:"settlement,omitempty" example:"payout"`
+	Payout              money.Amount      `json:"payout"`
+	CarriedForward      money.Amount      `json:"carried_forward"`
+	PayoutTransactionID *string           `json:"payout_transaction_id,omitempty"`
+	SettledByID         *string           `json:"settled_by_id,omitempty"`
+	SettledBy           string            `json:"settled_by,omitempty"`
+	SettledAt           *web.TimeResponse `json:"settled_at,omitempty"`
+	Due                 bool              `json:"due"`
+	CreatedAt           web.TimeResponse  `json:"created_at"`
+	UpdatedAt           web.TimeResponse  `json:"updated_at"`
+}
+
+// Response transforms Cycle to the CycleResponse that is used for display. Days missed and
+// whether the cycle is due for settlement are as of now.
+func (m *Cycle) Response(ctx context.Context) *CycleResponse {
+	if m == nil {
+		return nil
+	}
+
+	now := time.Now()
+	r := &CycleResponse{
+		ID:                  m.ID,
+		AccountID:           m.AccountID,
+		Number:              m.Number,
+		StartDate:           web.NewTimeResponse(ctx, m.StartDate),
+		EndDate:             web.NewTimeResponse(ctx, m.EndDate),
+		Days:                m.Days(),
+		Target:              m.Target,
+		DaysPaid:            m.DaysPaid,
+		DaysMissed:          m.DaysMissed(now),
+		AmountPaid:          m.AmountPaid,
+		Fee:                 m.Fee,
+		BroughtForward:      m.BroughtForward,
+		Status:              m.Status,
+		Settlement:          m.Settlement,
+		Payout:              m.Payout,
+		CarriedForward:      m.CarriedForward,
+		PayoutTransactionID: m.PayoutTransactionID,
+		SettledByID:         m.SettledByID,
+		Due:                 m.Due(now),
+		CreatedAt:           web.NewTimeResponse(ctx, m.CreatedAt),
+		UpdatedAt:           web.NewTimeResponse(ctx, m.UpdatedAt),
+	}
+
+	if m.SettledAt != nil {
+		at := web.NewTimeResponse(ctx, *m.SettledAt)
+		r.SettledAt = &at
+	}
+
+	if m.Account != nil {
+		r.AccountNumber = m.Account.Number
+		r.CustomerID = m.Account.CustomerID
+	}
+	if m.SettledBy != nil {
+		r.SettledBy = m.SettledBy.LastName + " " + m.SettledBy.FirstName
+	}
+
+	return r
+}
+
+// Cycles a list of Cycles.
+type Cycles []*Cycle
+
+// Response transforms a list of Cycles to a list of CycleResponses.
+func (m *Cycles) Response(ctx context.Context) []*CycleResponse {
+	var l = make([]*CycleResponse, 0)
+	if m != nil && len(*m) > 0 {
+		for _, n := range *m {
+			l = append(l, n.Response(ctx))
+		}
+	}
+
+	return l
+}
+
+// CycleStatement is a DS cycle with the transactions recorded in it.
+type CycleStatement struct {
+	Cycle        *Cycle       `json:"cycle"`
+	Transactions Transactions `json:"transactions"`
+}
+
+// CycleFindRequest defines the possible options to search for DS cycles.
+type CycleFindRequest struct {
+	Where          string        `json:"where" example:"status = ?"`
+	Args           []interface{} `json:"args" swaggertype:"array,string" example:"open"`
+	Order          []string      `json:"order" example:"number desc"`
+	Limit          *uint         `json:"limit" example:"10"`
+	Offset         *uint         `json:"offset" example:"20"`
+	IncludeAccount bool          `json:"include-account" example:"false"`
+}
+
+// SettleCycleRequest defines the information needed to settle a DS cycle that has ended. The
+// payment method is how a payout is made and defaults to cash.
+type SettleCycleRequest struct {
+	ID            string `json:"id" validate:"required,uuid" example:"985f1746-1d9f-459f-a2d9-fc53ece5ae86"`
+	Action        string `json:"action" validate:"required,oneof=payout rollover" example:"payout"`
+	PaymentMethod string `json:"payment_method" validate:"omitempty,oneof=cash bank_deposit" example:"cash"`
+}
+
 // ChecklistStatus represents the status of checklist.
 type TransactionType string
 
@@ -284,6 +906,12 @@ const (
 
 	PaymentMethod_Cash string = "cash"
 	PaymentMethod_Bank string = "bank_deposit"
+	// PaymentMethod_Transfer marks the legs of a transfer between accounts, no money is received
+	// or paid out for them.
+	PaymentMethod_Transfer string = "transfer"
+	// PaymentMethod_OpeningBalance marks balances brought over from records kept before the
+	// account was opened, no money is received for them.
+	PaymentMethod_OpeningBalance string = "opening_balance"
 )
 
 // TransactionType_Values provides list of valid TransactionType values.
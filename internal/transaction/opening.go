@@ -0,0 +1,113 @@
+package transaction
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/pborman/uuid"
+	"github.com/pkg/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"gopkg.in/DataDog/dd-trace-go.v1/ddtrace/tracer"
+
+	"merryworld/surebank/internal/ledger"
+	"merryworld/surebank/internal/platform/auth"
+	"merryworld/surebank/internal/platform/web/webcontext"
+	"merryworld/surebank/internal/platform/web/weberror"
+	"merryworld/surebank/internal/postgres/models"
+)
+
+// OpeningBalance posts the balance an account had in the records it was kept in before it was
+// opened here, such as the paper ledgers of a branch that is being onboarded. The balance is a
+// deposit that was not received today, so no KYC limit, daily contribution fee or SMS applies
+// and it is funded from equity rather than the cash on hand. The account must not have any
+// transaction yet.
+func (repo *Repository) OpeningBalance(ctx context.Context, claims auth.Claims, req OpeningBalanceRequest,
+	now time.Time, tx *sql.Tx) (*Transaction, error) {
+
+	span, ctx := tracer.StartSpanFromContext(ctx, "internal.transaction.OpeningBalance")
+	defer span.Finish()
+	if claims.Subject == "" {
+		return nil, errors.WithStack(ErrForbidden)
+	}
+
+	// Validate the request.
+	v := webcontext.Validator()
+	err := v.Struct(req)
+	if err != nil {
+		return nil, err
+	}
+
+	acc, err := repo.lockAccount(ctx, models.AccountWhere.ID.EQ(req.AccountID), tx)
+	if err != nil {
+		return nil, weberror.NewErrorMessage(ctx, err, 400, "invalid account")
+	}
+
+	if err = checkStatus(ctx, acc, false); err != nil {
+		return nil, err
+	}
+
+	exists, err := models.Transactions(models.TransactionWhere.AccountID.EQ(acc.ID)).Exists(ctx, tx)
+	if err != nil {
+		return nil, err
+	}
+	if exists {
+		return nil, weberror.NewErrorMessage(ctx, ErrForbidden, 400,
+			"the opening balance can only be posted before any other transaction")
+	}
+
+	// If now empty set it to the current time.
+	if now.IsZero() {
+		now = time.Now()
+	}
+
+	// Always store the time as UTC.
+	now = now.UTC()
+	// Postgres truncates times to milliseconds when storing. We and do the same
+	// here so the value we return is consistent with what we store.
+	now = now.Truncate(time.Millisecond)
+
+	narration := req.Narration
+	if narration == "" {
+		narration = "Opening balance"
+	}
+
+	m := models.Transaction{
+		ID:             uuid.NewRandom().String(),
+		AccountID:      acc.ID,
+		TXType:         TransactionType_Deposit.String(),
+		OpeningBalance: 0,
+		Amount:         req.Amount.Kobo(),
+		Narration:      narration,
+		SalesRepID:     claims.Subject,
+		ReceiptNo:      repo.generateReceiptNumber(ctx),
+		CreatedAt:      now.Unix(),
+		UpdatedAt:      now.Unix(),
+		EffectiveDate:  now.Unix(),
+		PaymentMethod:  PaymentMethod_OpeningBalance,
+	}
+
+	if err := m.Insert(ctx, tx, boil.Infer()); err != nil {
+		return nil, errors.WithMessage(err, "Insert opening balance failed")
+	}
+
+	if err := repo.postToLedger(ctx, claims, &m, acc.Number, ledger.AccountEquity, now, tx); err != nil {
+		return nil, err
+	}
+
+	if _, err := models.Accounts(models.AccountWhere.ID.EQ(acc.ID)).UpdateAll(ctx, tx, models.M{
+		models.AccountColumns.Balance: req.Amount.Kobo(),
+	}); err != nil {
+		return nil, err
+	}
+
+	if err = repo.trackGoal(ctx, acc, req.Amount, tx); err != nil {
+		return nil, err
+	}
+
+	if err = SaveDailySummary(ctx, req.Amount, 0, 0, now, tx); err != nil {
+		return nil, err
+	}
+
+	return FromModel(&m), nil
+}
@@ -0,0 +1,149 @@
+package transaction
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/jung-kurt/gofpdf"
+)
+
+const (
+	statementDateFormat     = "02 Jan 2006"
+	statementDateTimeFormat = "02 Jan 2006 15:04"
+)
+
+// statementColumn is a column of the transaction table on a statement.
+type statementColumn struct {
+	title string
+	width float64
+	align string
+}
+
+var statementColumns = []statementColumn{
+	{"Date", 22, "L"},
+	{"Receipt", 24, "L"},
+	{"Narration", 64, "L"},
+	{"Debit", 23, "R"},
+	{"Credit", 23, "R"},
+	{"Balance", 24, "R"},
+}
+
+// Filename returns the name the statement is downloaded or attached as.
+func (s *Statement) Filename() string {
+	return fmt.Sprintf("statement-%s-%s-%s.pdf", s.AccountNumber,
+		s.StartDate.Format("20060102"), s.EndDate.Format("20060102"))
+}
+
+// WritePDF renders the statement as an A4 PDF document. Amounts are in naira.
+func (s *Statement) WritePDF(w io.Writer) error {
+	pdf := gofpdf.New("P", "mm", "A4", "")
+	pdf.SetMargins(15, 15, 15)
+	pdf.SetAutoPageBreak(false, 15)
+	pdf.SetTitle(fmt.Sprintf("Statement of account %s", s.AccountNumber), true)
+
+	// The core fonts are cp1252, anything else a customer typed in a narration is translated.
+	tr := pdf.UnicodeTranslatorFromDescriptor("")
+
+	_, pageHeight := pdf.GetPageSize()
+	pdf.SetFooterFunc(func() {
+		pdf.SetY(-12)
+		pdf.SetFont("Helvetica", "", 8)
+		pdf.CellFormat(0, 5, fmt.Sprintf("%s - generated %s - page %d of {nb}", s.AccountNumber,
+			s.GeneratedAt.Format(statementDateTimeFormat), pdf.PageNo()), "", 0, "C", false, 0, "")
+	})
+	pdf.AliasNbPages("")
+
+	tableHeader := func() {
+		pdf.SetFont("Helvetica", "B", 9)
+		pdf.SetFillColor(230, 230, 230)
+		for _, c := range statementColumns {
+			pdf.CellFormat(c.width, 7, c.title, "1", 0, c.align, true, 0, "")
+		}
+		pdf.Ln(-1)
+		pdf.SetFont("Helvetica", "", 9)
+	}
+
+	pdf.AddPage()
+
+	// Branch header.
+	pdf.SetFont("Helvetica", "B", 16)
+	pdf.CellFormat(0, 8, tr(s.Branch), "", 1, "L", false, 0, "")
+	pdf.SetFont("Helvetica", "", 11)
+	pdf.CellFormat(0, 6, fmt.Sprintf("Statement of account for %s to %s",
+		s.StartDate.Format(statementDateFormat), s.EndDate.Format(statementDateFormat)), "", 1, "L", false, 0, "")
+	pdf.Ln(4)
+
+	// Customer and account details side by side.
+	top := pdf.GetY()
+	pdf.SetFont("Helvetica", "B", 10)
+	pdf.CellFormat(100, 5, tr(s.CustomerName), "", 2, "L", false, 0, "")
+	pdf.SetFont("Helvetica", "", 9)
+	for _, l := range []string{s.CustomerAddress, s.CustomerPhone, s.CustomerEmail} {
+		if l != "" {
+			pdf.CellFormat(100, 5, tr(l), "", 2, "L", false, 0, "")
+		}
+	}
+	bottom := pdf.GetY()
+
+	pdf.SetXY(115, top)
+	for _, l := range [][2]string{
+		{"Account Number", s.AccountNumber},
+		{"Account Type", strings.ToUpper(s.AccountType)},
+		{"Opening Balance", s.OpeningBalance.String()},
+		{"Total Credit", s.TotalDeposits.String()},
+		{"Total Debit", s.TotalWithdrawals.String()},
+		{"Closing Balance", s.ClosingBalance.String()},
+	} {
+		pdf.SetX(115)
+		pdf.SetFont("Helvetica", "", 9)
+		pdf.CellFormat(40, 5, l[0], "", 0, "L", false, 0, "")
+		pdf.SetFont("Helvetica", "B", 9)
+		pdf.CellFormat(40, 5, l[1], "", 1, "R", false, 0, "")
+	}
+	if pdf.GetY() < bottom {
+		pdf.SetY(bottom)
+	}
+	pdf.Ln(6)
+
+	tableHeader()
+
+	row := func(cells ...string) {
+		if pdf.GetY()+6 > pageHeight-20 {
+			pdf.AddPage()
+			tableHeader()
+		}
+		for i, c := range statementColumns {
+			text := tr(cells[i])
+			// Long narrations are cut to the width of the column rather than wrapped.
+			for pdf.GetStringWidth(text) > c.width-2 && len(text) > 0 {
+				text = text[:len(text)-1]
+			}
+			pdf.CellFormat(c.width, 6, text, "LR", 0, c.align, false, 0, "")
+		}
+		pdf.Ln(-1)
+	}
+
+	row(s.StartDate.Format(statementDateFormat), "", "Opening balance", "", "", s.OpeningBalance.String())
+	for _, l := range s.Lines {
+		var debit, credit string
+		if l.Type == TransactionType_Deposit {
+			credit = l.Amount.String()
+		} else {
+			debit = l.Amount.String()
+		}
+		row(l.Date.Format(statementDateFormat), l.ReceiptNo, l.Narration, debit, credit, l.Balance.String())
+	}
+
+	pdf.SetFont("Helvetica", "B", 9)
+	var width float64
+	for _, c := range statementColumns[:3] {
+		width += c.width
+	}
+	pdf.CellFormat(width, 7, "Closing balance", "1", 0, "L", false, 0, "")
+	pdf.CellFormat(statementColumns[3].width, 7, s.TotalWithdrawals.String(), "1", 0, "R", false, 0, "")
+	pdf.CellFormat(statementColumns[4].width, 7, s.TotalDeposits.String(), "1", 0, "R", false, 0, "")
+	pdf.CellFormat(statementColumns[5].width, 7, s.ClosingBalance.String(), "1", 1, "R", false, 0, "")
+
+	return pdf.Output(w)
+}
@@ -0,0 +1,133 @@
+package transaction
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/pborman/uuid"
+	"github.com/pkg/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"gopkg.in/DataDog/dd-trace-go.v1/ddtrace/tracer"
+
+	"merryworld/surebank/internal/account"
+	"merryworld/surebank/internal/ledger"
+	"merryworld/surebank/internal/platform/auth"
+	"merryworld/surebank/internal/platform/web/webcontext"
+	"merryworld/surebank/internal/platform/web/weberror"
+	"merryworld/surebank/internal/postgres/models"
+)
+
+// checkStatus stops money being paid into or taken out of the locked account when its status
+// does not allow it.
+func checkStatus(ctx context.Context, acc *models.Account, withdrawal bool) error {
+	if err := account.CheckStatus(acc.Status, withdrawal); err != nil {
+		return weberror.NewError(ctx, err, http.StatusBadRequest)
+	}
+	return nil
+}
+
+// CloseAccount settles and closes an account. The balance left on the account is paid out in cash
+// as a final withdrawal before it is closed, the settlement transaction is returned or nil when
+// there was nothing to pay out. Only supervisors can close an account.
+func (repo *Repository) CloseAccount(ctx context.Context, claims auth.Claims, req account.CloseRequest, now time.Time) (*Transaction, error) {
+	span, ctx := tracer.StartSpanFromContext(ctx, "internal.transaction.CloseAccount")
+	defer span.Finish()
+
+	if claims.Audience == "" || !claims.HasRole(auth.RoleAdmin, auth.RoleSuperAdmin) {
+		return nil, errors.WithStack(ErrForbidden)
+	}
+
+	// Validate the request.
+	v := webcontext.Validator()
+	if err := v.Struct(req); err != nil {
+		return nil, err
+	}
+
+	// If now empty set it to the current time.
+	if now.IsZero() {
+		now = time.Now()
+	}
+
+	// Always store the time as UTC.
+	now = now.UTC()
+	// Postgres truncates times to milliseconds when storing. We and do the same
+	// here so the value we return is consistent with what we store.
+	now = now.Truncate(time.Millisecond)
+
+	tx, err := repo.DbConn.Begin()
+	if err != nil {
+		return nil, err
+	}
+
+	acc, err := repo.lockAccount(ctx, models.AccountWhere.ID.EQ(req.ID), tx)
+	if err != nil {
+		_ = tx.Rollback()
+		return nil, weberror.NewErrorMessage(ctx, err, http.StatusBadRequest, "Invalid account id")
+	}
+
+	if !account.CanChangeStatus(acc.Status, account.Status_Closed) {
+		_ = tx.Rollback()
+		return nil, weberror.NewError(ctx, account.ErrStatusChange, http.StatusBadRequest)
+	}
+
+	balance, err := repo.AccountBalanceTx(ctx, acc.ID, tx)
+	if err != nil {
+		_ = tx.Rollback()
+		return nil, err
+	}
+
+	// Holds must be released before the balance is paid out.
+	if err = checkHeld(ctx, acc.ID, balance, balance, now, tx); err != nil {
+		_ = tx.Rollback()
+		return nil, err
+	}
+
+	var settlement *models.Transaction
+	if balance > 0 {
+		settlement = &models.Transaction{
+			ID:             uuid.NewRandom().String(),
+			AccountID:      acc.ID,
+			TXType:         TransactionType_Withdrawal.String(),
+			OpeningBalance: balance.Kobo(),
+			Amount:         balance.Kobo(),
+			Narration:      "Account closure settlement",
+			PaymentMethod:  PaymentMethod_Cash,
+			SalesRepID:     claims.Subject,
+			ReceiptNo:      repo.generateReceiptNumber(ctx),
+			CreatedAt:      now.Unix(),
+			UpdatedAt:      now.Unix(),
+			EffectiveDate:  now.Unix(),
+		}
+		if err = settlement.Insert(ctx, tx, boil.Infer()); err != nil {
+			_ = tx.Rollback()
+			return nil, errors.WithMessage(err, "Insert settlement failed")
+		}
+
+		if err = repo.postToLedger(ctx, claims, settlement, acc.Number, ledger.AccountCash, now, tx); err != nil {
+			_ = tx.Rollback()
+			return nil, err
+		}
+
+		if _, err = models.Accounts(models.AccountWhere.ID.EQ(acc.ID)).UpdateAll(ctx, tx, models.M{
+			models.AccountColumns.Balance: 0,
+		}); err != nil {
+			_ = tx.Rollback()
+			return nil, err
+		}
+	}
+
+	if err = account.RecordStatusChange(ctx, tx, acc, account.Status_Closed, req.Reason, claims.Subject, now); err != nil {
+		_ = tx.Rollback()
+		return nil, weberror.NewError(ctx, err, http.StatusBadRequest)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	if settlement == nil {
+		return nil, nil
+	}
+	return FromModel(settlement), nil
+}
@@ -1,15 +1,14 @@
 package transaction
 
 import (
+	"bytes"
 	"context"
 	"database/sql"
 	"fmt"
-	"math"
 	"math/rand"
 	"net/http"
 	"strconv"
 	"strings"
-	"sync"
 	"time"
 
 	"github.com/jinzhu/now"
@@ -21,8 +20,13 @@ import (
 	. "github.com/volatiletech/sqlboiler/v4/queries/qm"
 	"gopkg.in/DataDog/dd-trace-go.v1/ddtrace/tracer"
 
-	"merryworld/surebank/internal/customer"
+	"merryworld/surebank/internal/account_product"
+	"merryworld/surebank/internal/dscommission"
+	"merryworld/surebank/internal/idempotency"
+	"merryworld/surebank/internal/ledger"
 	"merryworld/surebank/internal/platform/auth"
+	"merryworld/surebank/internal/platform/money"
+	"merryworld/surebank/internal/platform/notify"
 	"merryworld/surebank/internal/platform/web"
 	"merryworld/surebank/internal/platform/web/webcontext"
 	"merryworld/surebank/internal/platform/web/weberror"
@@ -191,31 +195,31 @@ func (repo *Repository) ReadByID(ctx context.Context, _ auth.Claims, id string)
 	return FromModel(model), nil
 }
 
-func (repo *Repository) TodayDepositAmount(ctx context.Context, claims auth.Claims) (float64, error) {
+func (repo *Repository) TodayDepositAmount(ctx context.Context, claims auth.Claims) (money.Amount, error) {
 	span, ctx := tracer.StartSpanFromContext(ctx, "internal.transaction.TodayDepositAmount")
 	defer span.Finish()
 	startDate := now.BeginningOfDay()
 	return repo.TotalDepositAmount(ctx, claims, startDate.UTC().Unix(), time.Now().UTC().Unix())
 }
 
-func (repo *Repository) ThisWeekDepositAmount(ctx context.Context, claims auth.Claims) (float64, error) {
+func (repo *Repository) ThisWeekDepositAmount(ctx context.Context, claims auth.Claims) (money.Amount, error) {
 	span, ctx := tracer.StartSpanFromContext(ctx, "internal.transaction.ThisWeekDepositAmount")
 	defer span.Finish()
 	startDate := now.BeginningOfWeek()
 	return repo.TotalDepositAmount(ctx, claims, startDate.UTC().Unix(), time.Now().UTC().Unix())
 }
 
-func (repo *Repository) ThisMonthDepositAmount(ctx context.Context, claims auth.Claims) (float64, error) {
+func (repo *Repository) ThisMonthDepositAmount(ctx context.Context, claims auth.Claims) (money.Amount, error) {
 	span, ctx := tracer.StartSpanFromContext(ctx, "internal.transaction.ThisMonthDepositAmount")
 	defer span.Finish()
 	startDate := now.BeginningOfMonth()
 	return repo.TotalDepositAmount(ctx, claims, startDate.UTC().Unix(), time.Now().UTC().Unix())
 }
 
-func (repo *Repository) TotalDepositAmount(ctx context.Context, claims auth.Claims, startDate, endDate int64) (float64, error) {
+func (repo *Repository) TotalDepositAmount(ctx context.Context, claims auth.Claims, startDate, endDate int64) (money.Amount, error) {
 	span, ctx := tracer.StartSpanFromContext(ctx, "internal.transaction.TotalDepositAmount")
 	defer span.Finish()
-	statement := `select sum(amount) total from transaction where tx_type = 'deposit' and created_at > $1 and created_at < $2`
+	statement := `select sum(amount) total from transaction where tx_type = 'deposit' and transfer_id is null and created_at > $1 and created_at < $2`
 	args := []interface{}{startDate, endDate}
 	if !claims.HasRole(auth.RoleAdmin) {
 		statement += " and sales_rep_id = $3"
@@ -223,13 +227,13 @@ func (repo *Repository) TotalDepositAmount(ctx context.Context, claims auth.Clai
 	}
 
 	var result struct {
-		Total sql.NullFloat64
+		Total sql.NullInt64
 	}
 	err := models.NewQuery(SQL(statement, args...)).Bind(ctx, repo.DbConn, &result)
-	return result.Total.Float64, err
+	return money.Amount(result.Total.Int64), err
 }
 
-func (repo *Repository) DepositAmountByWhere(ctx context.Context, where string, args []interface{}) (float64, error) {
+func (repo *Repository) DepositAmountByWhere(ctx context.Context, where string, args []interface{}) (money.Amount, error) {
 	span, ctx := tracer.StartSpanFromContext(ctx, "internal.transaction.DepositAmountByWhere")
 	defer span.Finish()
 	statement := `select sum(amount) total from transaction where `
@@ -239,10 +243,10 @@ func (repo *Repository) DepositAmountByWhere(ctx context.Context, where string,
 		statement += fmt.Sprintf(" %s = '%s' ", models.TransactionColumns.TXType, TransactionType_Deposit)
 	}
 	var result struct {
-		Total sql.NullFloat64
+		Total sql.NullInt64
 	}
 	err := models.NewQuery(SQL(statement, args...)).Bind(ctx, repo.DbConn, &result)
-	return result.Total.Float64, err
+	return money.Amount(result.Total.Int64), err
 }
 
 const accountBalanceStatement = `SELECT 
@@ -254,106 +258,305 @@ const accountBalanceStatement = `SELECT
 	) res`
 
 // AccountBalance gets the balance of the specified account from the database.
-func (repo *Repository) AccountBalance(ctx context.Context, accountID string) (float64, error) {
+func (repo *Repository) AccountBalance(ctx context.Context, accountID string) (money.Amount, error) {
 	span, ctx := tracer.StartSpanFromContext(ctx, "internal.transaction.AccountBalance")
 	defer span.Finish()
-	var result null.Float64
+	var result null.Int64
 	err := repo.DbConn.QueryRow(accountBalanceStatement, accountID).Scan(&result)
 	if err != nil && err.Error() == sql.ErrNoRows.Error() {
 		return 0, nil
 	}
-	return result.Float64, err
+	return money.Amount(result.Int64), err
 }
 
 // AccountBalanceTx gets the balance of the specified account from the database within a DB tx.
-func (repo *Repository) AccountBalanceTx(ctx context.Context, accountID string, tx *sql.Tx) (float64, error) {
+func (repo *Repository) AccountBalanceTx(ctx context.Context, accountID string, tx *sql.Tx) (money.Amount, error) {
 	span, ctx := tracer.StartSpanFromContext(ctx, "internal.transaction.AccountBalanceTx")
 	defer span.Finish()
-	var result null.Float64
+	var result null.Int64
 	err := tx.QueryRow(accountBalanceStatement, accountID).Scan(&result)
-	return result.Float64, err
+	return money.Amount(result.Int64), err
 }
 
-var depoLock sync.Mutex
+const savingsHistoryStatement = `SELECT
+	COALESCE(SUM(CASE WHEN t.tx_type = 'deposit' THEN t.amount ELSE 0 END), 0) AS deposits,
+	COUNT(DISTINCT CASE WHEN t.tx_type = 'deposit' THEN t.created_at / 86400 END) AS deposit_days,
+	COALESCE(SUM(CASE WHEN t.tx_type = 'withdrawal' THEN t.amount ELSE 0 END), 0) AS withdrawals
+	FROM transaction t
+	WHERE t.account_id = $1 AND t.created_at >= $2
+		AND t.reversal_of_id IS NULL AND t.archived_at IS NULL
+		AND NOT EXISTS (SELECT 1 FROM transaction r WHERE r.reversal_of_id = t.id)
+		AND NOT EXISTS (SELECT 1 FROM loan l WHERE l.disbursement_transaction_id = t.id)`
+
+// SavingsHistory gets the deposits and withdrawals made on an account since the provided date,
+// with the number of days deposits were made on, to judge how well the customer saves.
+func (repo *Repository) SavingsHistory(ctx context.Context, claims auth.Claims, accountID string, since time.Time) (*SavingsHistory, error) {
+	span, ctx := tracer.StartSpanFromContext(ctx, "internal.transaction.SavingsHistory")
+	defer span.Finish()
 
-func (repo *Repository) Deposit(ctx context.Context, claims auth.Claims, req CreateRequest, currentDate time.Time) (*Transaction, error) {
-	span, ctx := tracer.StartSpanFromContext(ctx, "internal.transaction.Deposit")
+	if claims.Audience == "" {
+		return nil, errors.WithStack(ErrForbidden)
+	}
+
+	account, err := models.FindAccount(ctx, repo.DbConn, accountID)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, weberror.NewError(ctx, ErrNotFound, 404)
+		}
+		return nil, err
+	}
+
+	res := &SavingsHistory{
+		AccountID: account.ID,
+		Balance:   money.Amount(account.Balance),
+		OpenedAt:  time.Unix(account.CreatedAt, 0).UTC(),
+		Since:     since.UTC(),
+	}
+
+	var deposits, withdrawals int64
+	if err := repo.DbConn.QueryRowContext(ctx, savingsHistoryStatement, account.ID, since.Unix()).Scan(
+		&deposits, &res.DepositDays, &withdrawals); err != nil {
+		return nil, errors.WithMessage(err, "Cannot read savings history")
+	}
+	res.Deposits = money.Amount(deposits)
+	res.Withdrawals = money.Amount(withdrawals)
+
+	return res, nil
+}
+
+const statementOpeningStatement = `SELECT 
+	SUM(CASE WHEN tx.tx_type = 'deposit' THEN tx.amount ELSE -1 * tx.amount END) AS balance
+	FROM transaction tx
+	WHERE tx.account_id = $1 AND tx.archived_at IS NULL AND tx.created_at < $2`
+
+// Statement gets the transactions of an account created within the requested period along with
+// the balance before the period and the running balance after each transaction. Reversals are
+// listed as transactions of their own so the statement agrees with the balance of the account.
+// Dates on the statement are in the location of the requested start date.
+func (repo *Repository) Statement(ctx context.Context, claims auth.Claims, req StatementRequest, now time.Time) (*Statement, error) {
+	span, ctx := tracer.StartSpanFromContext(ctx, "internal.transaction.Statement")
 	defer span.Finish()
-	depoLock.Lock()
-	defer depoLock.Unlock()
 
-	//open a new db
-	dbTx, err := repo.DbConn.Begin()
+	if claims.Audience == "" {
+		return nil, errors.WithStack(ErrForbidden)
+	}
+
+	// Validate the request.
+	v := webcontext.Validator()
+	err := v.Struct(req)
 	if err != nil {
 		return nil, err
 	}
-	account, err := models.Accounts(models.AccountWhere.Number.EQ(req.AccountNumber),
-		Load(models.AccountRels.Customer)).One(ctx, dbTx)
+
+	// If now empty set it to the current time.
+	if now.IsZero() {
+		now = time.Now()
+	}
+
+	loc := req.StartDate.Location()
+
+	// The opening balance and the transactions are read from the same snapshot so a posting made
+	// while the statement is produced cannot make them disagree.
+	tx, err := repo.DbConn.BeginTx(ctx, &sql.TxOptions{Isolation: sql.LevelRepeatableRead, ReadOnly: true})
 	if err != nil {
-		dbTx.Rollback()
-		return nil, weberror.NewErrorMessage(ctx, err, http.StatusBadRequest, "Invalid account number")
+		return nil, err
 	}
+	defer tx.Rollback()
 
-	effectiveDate := now.New(currentDate).BeginningOfDay()
-	if account.AccountType == customer.AccountTypeDS {
-		lastDeposit, err := repo.lastDeposit(ctx, account.ID, dbTx)
-		if err == nil {
-			effectiveDate = now.New(time.Unix(lastDeposit.EffectiveDate, 0)).Time.Add(24 * time.Hour)
+	account, err := models.Accounts(
+		models.AccountWhere.ID.EQ(req.AccountID),
+		Load(models.AccountRels.Customer),
+		Load(models.AccountRels.Branch),
+	).One(ctx, tx)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, weberror.NewError(ctx, ErrNotFound, http.StatusNotFound)
 		}
+		return nil, err
 	}
 
-	effectiveDate = effectiveDate.UTC()
+	var opening null.Int64
+	if err = tx.QueryRowContext(ctx, statementOpeningStatement, account.ID, req.StartDate.Unix()).Scan(&opening); err != nil {
+		return nil, errors.WithMessage(err, "Cannot read opening balance")
+	}
+
+	txns, err := models.Transactions(
+		models.TransactionWhere.AccountID.EQ(account.ID),
+		models.TransactionWhere.ArchivedAt.IsNull(),
+		models.TransactionWhere.CreatedAt.GTE(req.StartDate.Unix()),
+		models.TransactionWhere.CreatedAt.LTE(req.EndDate.Unix()),
+		OrderBy(fmt.Sprintf("%s, %s", models.TransactionColumns.CreatedAt, models.TransactionColumns.ID)),
+	).All(ctx, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	st := &Statement{
+		AccountID:      account.ID,
+		AccountNumber:  account.Number,
+		AccountType:    account.AccountType,
+		StartDate:      req.StartDate,
+		EndDate:        req.EndDate,
+		OpeningBalance: money.Amount(opening.Int64),
+		GeneratedAt:    now.In(loc),
+	}
+	if account.R.Customer != nil {
+		st.CustomerName = account.R.Customer.Name
+		st.CustomerEmail = account.R.Customer.Email
+		st.CustomerPhone = account.R.Customer.PhoneNumber
+		st.CustomerAddress = account.R.Customer.Address
+	}
+	if account.R.Branch != nil {
+		st.Branch = account.R.Branch.Name
+	}
+
+	balance := st.OpeningBalance
+	for _, t := range txns {
+		amount := money.Amount(t.Amount)
+		if t.TXType == TransactionType_Deposit.String() {
+			balance += amount
+			st.TotalDeposits += amount
+		} else {
+			balance -= amount
+			st.TotalWithdrawals += amount
+		}
+
+		st.Lines = append(st.Lines, StatementLine{
+			TransactionID: t.ID,
+			Date:          time.Unix(t.CreatedAt, 0).In(loc),
+			ReceiptNo:     t.ReceiptNo,
+			Type:          TransactionType(t.TXType),
+			Narration:     t.Narration,
+			Amount:        amount,
+			Balance:       balance,
+		})
+	}
+	st.ClosingBalance = balance
+
+	return st, nil
+}
+
+// EmailStatement sends the statement of an account for the requested period as a PDF attachment.
+func (repo *Repository) EmailStatement(ctx context.Context, claims auth.Claims, req EmailStatementRequest, now time.Time) error {
+	span, ctx := tracer.StartSpanFromContext(ctx, "internal.transaction.EmailStatement")
+	defer span.Finish()
 
-	if account.AccountType != customer.AccountTypeDS {
-		m, err := repo.create(ctx, claims, req, currentDate, effectiveDate, dbTx)
+	// Validate the request.
+	v := webcontext.Validator()
+	err := v.Struct(req)
+	if err != nil {
+		return err
+	}
+
+	st, err := repo.Statement(ctx, claims, req.StatementRequest, now)
+	if err != nil {
+		return err
+	}
+
+	toEmail := req.Email
+	if toEmail == "" {
+		toEmail = st.CustomerEmail
+	}
+	if toEmail == "" {
+		return weberror.NewErrorMessage(ctx, errors.New("customer has no email"), http.StatusBadRequest,
+			"The customer does not have an email address, provide one to send the statement to")
+	}
+
+	var pdf bytes.Buffer
+	if err = st.WritePDF(&pdf); err != nil {
+		return errors.WithMessage(err, "Cannot render statement")
+	}
+
+	data := map[string]interface{}{
+		"Name":           st.CustomerName,
+		"AccountNumber":  st.AccountNumber,
+		"StartDate":      st.StartDate.Format(statementDateFormat),
+		"EndDate":        st.EndDate.Format(statementDateFormat),
+		"ClosingBalance": st.ClosingBalance.String(),
+	}
+
+	subject := fmt.Sprintf("Statement of account %s", st.AccountNumber)
+	err = repo.notifyEmail.Send(ctx, toEmail, subject, "account_statement", data, notify.EmailAttachment{
+		Filename:    st.Filename(),
+		ContentType: web.MIMEApplicationPDF,
+		Data:        pdf.Bytes(),
+	})
+	if err != nil {
+		return errors.WithMessage(err, "Cannot send statement")
+	}
+
+	return nil
+}
+
+func (repo *Repository) Deposit(ctx context.Context, claims auth.Claims, req CreateRequest, currentDate time.Time) (*Transaction, error) {
+	span, ctx := tracer.StartSpanFromContext(ctx, "internal.transaction.Deposit")
+	defer span.Finish()
+
+	//open a new db
+	dbTx, err := repo.DbConn.Begin()
+	if err != nil {
+		return nil, err
+	}
+
+	if req.IdempotencyKey != "" {
+		txID, err := idempotency.Claim(ctx, dbTx, claims.Audience, idempotency.ScopeDeposit, req.IdempotencyKey, req, currentDate)
 		if err != nil {
 			dbTx.Rollback()
 			return nil, err
 		}
-		if err := dbTx.Commit(); err != nil {
-			return nil, err
+		if txID != "" {
+			dbTx.Rollback()
+			return repo.ReadByID(ctx, claims, txID)
 		}
-		return m, nil
 	}
-
-	if math.Mod(req.Amount, account.Target) != 0 {
+	account, err := repo.lockAccount(ctx, models.AccountWhere.Number.EQ(req.AccountNumber), dbTx)
+	if err != nil {
 		dbTx.Rollback()
-		return nil, weberror.NewError(ctx, fmt.Errorf("Amount must be a multiple of %f", account.Target), 400)
+		return nil, weberror.NewErrorMessage(ctx, err, http.StatusBadRequest, "Invalid account number")
 	}
 
-	if req.Amount/account.Target > 50 {
+	limitDate := currentDate
+	if limitDate.IsZero() {
+		limitDate = time.Now()
+	}
+	if err = checkKYCLimit(ctx, account, TransactionType_Deposit, req.Amount, limitDate.UTC(), dbTx); err != nil {
 		dbTx.Rollback()
-		return nil, weberror.NewError(ctx, fmt.Errorf("Please pay for max of 50 days at a time, one day is %.2f", account.Target), 400)
+		return nil, err
 	}
 
-	if req.PaymentMethod != "bank_deposit" {
-		req.PaymentMethod = "cash"
+	product := account_product.FromModel(account.R.Product)
+
+	reqAmount := req.Amount
+	tx, err := repo.postDeposit(ctx, claims, req, account, currentDate, "", dbTx)
+	if err != nil {
+		dbTx.Rollback()
+		return nil, err
 	}
 
-	var tx *Transaction
-	amount, reqAmount := req.Amount, req.Amount
-	req.Amount = account.Target
-	for amount > 0 {
-		tx, err = repo.create(ctx, claims, req, currentDate, effectiveDate, dbTx)
-		if err != nil {
-			dbTx.Rollback()
-			return nil, err
-		}
-		amount -= account.Target
-		currentDate = currentDate.Add(4 * time.Second)
-		effectiveDate = effectiveDate.Add(24 * time.Hour)
+	if err = repo.completeIdempotency(ctx, claims, req.IdempotencyKey, tx.ID, dbTx); err != nil {
+		dbTx.Rollback()
+		return nil, err
 	}
 
 	if err = dbTx.Commit(); err != nil {
 		return nil, err
 	}
 
+	// Other deposits are notified by create.
+	if !product.DailyContribution {
+		return tx, nil
+	}
+
 	var salesRepName string
 	salesRep, err := models.FindUser(ctx, repo.DbConn, claims.Subject)
 	if err == nil {
 		salesRepName = salesRep.FirstName + " " + salesRep.LastName
 	}
 
+	if !product.DepositSMS {
+		return tx, nil
+	}
+
 	if serr := repo.notifySMS.Send(ctx, account.R.Customer.PhoneNumber, "sms/ds_received",
 		map[string]interface{}{
 			"Name":          account.R.Customer.Name,
@@ -369,9 +572,56 @@ func (repo *Repository) Deposit(ctx context.Context, claims auth.Claims, req Cre
 	return tx, err
 }
 
-// create inserts a new transaction into the database.
+// postDeposit applies the deposit rules of the product of the account to the deposit and records
+// it, as one entry per day for daily contributions. It returns the last entry recorded. A non
+// empty transferID records the deposit as the receiving leg of that transfer.
+func (repo *Repository) postDeposit(ctx context.Context, claims auth.Claims, req CreateRequest, account *models.Account,
+	currentDate time.Time, transferID string, dbTx *sql.Tx) (*Transaction, error) {
+
+	product := account_product.FromModel(account.R.Product)
+
+	effectiveDate := now.New(currentDate).BeginningOfDay()
+	if product.DailyContribution {
+		lastDeposit, err := repo.lastDeposit(ctx, account.ID, dbTx)
+		if err == nil {
+			effectiveDate = now.New(time.Unix(lastDeposit.EffectiveDate, 0)).Time.Add(24 * time.Hour)
+		}
+	}
+
+	effectiveDate = effectiveDate.UTC()
+
+	target := money.Amount(account.Target)
+	days, err := product.CheckDeposit(req.Amount, target)
+	if err != nil {
+		return nil, weberror.NewError(ctx, err, 400)
+	}
+
+	if !product.DailyContribution {
+		return repo.create(ctx, claims, req, currentDate, effectiveDate, transferID, dbTx)
+	}
+
+	if req.PaymentMethod != "bank_deposit" {
+		req.PaymentMethod = "cash"
+	}
+
+	var tx *Transaction
+	req.Amount = target
+	for ; days > 0; days-- {
+		tx, err = repo.create(ctx, claims, req, currentDate, effectiveDate, transferID, dbTx)
+		if err != nil {
+			return nil, err
+		}
+		currentDate = currentDate.Add(4 * time.Second)
+		effectiveDate = effectiveDate.Add(24 * time.Hour)
+	}
+
+	return tx, nil
+}
+
+// create inserts a new transaction into the database. The legs of a transfer are posted to the
+// ledger, notified and left out of the daily income by Transfer rather than here.
 func (repo *Repository) create(ctx context.Context, claims auth.Claims, req CreateRequest,
-	currentDate, effectiveDate time.Time, dbTx *sql.Tx) (*Transaction, error) {
+	currentDate, effectiveDate time.Time, transferID string, dbTx *sql.Tx) (*Transaction, error) {
 
 	span, ctx := tracer.StartSpanFromContext(ctx, "internal.transaction.Create")
 	defer span.Finish()
@@ -386,12 +636,15 @@ func (repo *Repository) create(ctx context.Context, claims auth.Claims, req Crea
 		return nil, err
 	}
 
-	account, err := models.Accounts(models.AccountWhere.Number.EQ(req.AccountNumber),
-		Load(models.AccountRels.Customer)).One(ctx, dbTx)
+	account, err := repo.lockAccount(ctx, models.AccountWhere.Number.EQ(req.AccountNumber), dbTx)
 	if err != nil {
 		return nil, weberror.NewErrorMessage(ctx, err, http.StatusBadRequest, "Invalid account number")
 	}
 
+	if err = checkStatus(ctx, account, req.Type == TransactionType_Withdrawal); err != nil {
+		return nil, err
+	}
+
 	// If now empty set it to the current time.
 	if currentDate.IsZero() {
 		currentDate = time.Now()
@@ -403,9 +656,6 @@ func (repo *Repository) create(ctx context.Context, claims auth.Claims, req Crea
 	// here so the value we return is consistent with what we store.
 	currentDate = currentDate.Truncate(time.Millisecond)
 
-	repo.accNumMtx.Lock()
-	defer repo.accNumMtx.Unlock()
-
 	accountBalance, err := repo.AccountBalanceTx(ctx, account.ID, dbTx)
 	if err != nil {
 		if err.Error() != sql.ErrNoRows.Error() {
@@ -417,8 +667,8 @@ func (repo *Repository) create(ctx context.Context, claims auth.Claims, req Crea
 	m := models.Transaction{
 		ID:             uuid.NewRandom().String(),
 		AccountID:      account.ID,
-		OpeningBalance: accountBalance,
-		Amount:         req.Amount,
+		OpeningBalance: accountBalance.Kobo(),
+		Amount:         req.Amount.Kobo(),
 		Narration:      req.Narration,
 		PaymentMethod:  req.PaymentMethod,
 		TXType:         req.Type.String(),
@@ -428,26 +678,62 @@ func (repo *Repository) create(ctx context.Context, claims auth.Claims, req Crea
 		UpdatedAt:      currentDate.Unix(),
 		EffectiveDate:  effectiveDate.Unix(),
 	}
+	if transferID != "" {
+		m.TransferID = null.StringFrom(transferID)
+		m.PaymentMethod = PaymentMethod_Transfer
+	}
 
-	isFirstContribution, err := repo.CommissionRepo.StartingNewCircle(ctx, account.ID, effectiveDate, dbTx)
-	if err != nil {
-		return nil, err
+	product := account_product.FromModel(account.R.Product)
+
+	// Deposits on products with cycles are days of the cycle their effective date falls in, the
+	// commission policy of the product or branch decides the fee taken from them.
+	var cycle *models.DSCycle
+	var fee money.Amount
+	var feeDate time.Time
+	if req.Type == TransactionType_Deposit && product.CycleDays > 0 {
+		var opened bool
+		cycle, opened, err = repo.cycleFor(ctx, account, product.CycleDays, accountBalance, effectiveDate, currentDate, dbTx)
+		if err != nil {
+			return nil, err
+		}
+		m.DSCycleID = null.StringFrom(cycle.ID)
+
+		branch, err := models.FindBranch(ctx, dbTx, account.BranchID)
+		if err != nil {
+			return nil, errors.WithMessage(err, "Cannot read account branch")
+		}
+		policy, err := dscommission.SelectPolicy(product.CommissionPolicy, product.FirstContributionFee, branch.CommissionPolicy)
+		if err != nil {
+			return nil, weberror.NewError(ctx, err, http.StatusBadRequest)
+		}
+		fee, feeDate = policy.Fee(dscommission.Contribution{
+			Amount:        req.Amount,
+			EffectiveDate: effectiveDate,
+			CycleStart:    time.Unix(cycle.StartDate, 0),
+			FirstOfCycle:  opened,
+		})
 	}
 
 	if err := m.Insert(ctx, dbTx, boil.Infer()); err != nil {
 		return nil, errors.WithMessage(err, "Insert deposit failed")
 	}
 
+	if transferID == "" {
+		if err := repo.postToLedger(ctx, claims, &m, account.Number, LedgerAccountForPaymentMethod(req.PaymentMethod), currentDate, dbTx); err != nil {
+			return nil, err
+		}
+	}
+
 	var lastDepositDate int64
 	if req.Type == TransactionType_Deposit {
 		lastDepositDate = m.EffectiveDate
-		accountBalance += m.Amount
+		accountBalance += req.Amount
 	} else {
-		accountBalance -= m.Amount
+		accountBalance -= req.Amount
 	}
 
 	if _, err := models.Accounts(models.AccountWhere.ID.EQ(account.ID)).UpdateAll(ctx, dbTx, models.M{
-		models.AccountColumns.Balance:         accountBalance,
+		models.AccountColumns.Balance:         accountBalance.Kobo(),
 		models.AccountColumns.LastPaymentDate: lastDepositDate,
 		models.AccountColumns.SalesRepID:      claims.Subject,
 	}); err != nil {
@@ -466,10 +752,6 @@ func (repo *Repository) create(ctx context.Context, claims auth.Claims, req Crea
 		return nil, err
 	}
 
-	if err = SaveDailySummary(ctx, req.Amount, 0, 0, currentDate, dbTx); err != nil {
-		return nil, err
-	}
-
 	// send SMS notification
 	var salesRepName string
 	salesRep, err := models.FindUser(ctx, repo.DbConn, claims.Subject)
@@ -478,7 +760,8 @@ func (repo *Repository) create(ctx context.Context, claims auth.Claims, req Crea
 	}
 
 	if req.Type == TransactionType_Deposit {
-		if account.AccountType == customer.AccountTypeSB {
+		// Daily contributions are notified once per deposit by Deposit rather than for every day.
+		if product.DepositSMS && !product.DailyContribution && transferID == "" {
 			if err = repo.notifySMS.Send(ctx, account.R.Customer.PhoneNumber, "sms/payment_received",
 				map[string]interface{}{
 					"Name":          account.R.Customer.Name,
@@ -505,28 +788,33 @@ func (repo *Repository) create(ctx context.Context, claims auth.Claims, req Crea
 		}
 	}
 
-	// Deduct fee for the first contribution for DS customers
-	if req.Type == TransactionType_Deposit && account.AccountType == customer.AccountTypeDS && isFirstContribution {
+	// Deduct the DS fee taken by the commission policy
+	if fee > 0 {
 		wm := models.Transaction{
 			ID:             uuid.NewRandom().String(),
 			AccountID:      account.ID,
-			OpeningBalance: accountBalance,
-			Amount:         req.Amount,
+			OpeningBalance: accountBalance.Kobo(),
+			Amount:         fee.Kobo(),
 			Narration:      "DS fee deduction",
 			TXType:         TransactionType_Withdrawal.String(),
 			SalesRepID:     claims.Subject,
 			ReceiptNo:      repo.generateReceiptNumber(ctx),
 			CreatedAt:      currentDate.Add(2 * time.Second).Unix(),
 			UpdatedAt:      currentDate.Unix(),
+			DSCycleID:      m.DSCycleID,
 		}
 
 		if err := wm.Insert(ctx, dbTx, boil.Infer()); err != nil {
 			return nil, errors.WithMessage(err, "Insert DS fee failed")
 		}
 
-		accountBalance -= req.Amount
+		if err := repo.postToLedger(ctx, claims, &wm, account.Number, ledger.AccountDSFeeIncome, currentDate, dbTx); err != nil {
+			return nil, err
+		}
+
+		accountBalance -= fee
 		if _, err := models.Accounts(models.AccountWhere.ID.EQ(account.ID)).UpdateAll(ctx, dbTx, models.M{
-			models.AccountColumns.Balance: accountBalance,
+			models.AccountColumns.Balance: accountBalance.Kobo(),
 		}); err != nil {
 			return nil, err
 		}
@@ -535,9 +823,9 @@ func (repo *Repository) create(ctx context.Context, claims auth.Claims, req Crea
 			ID:            uuid.NewRandom().String(),
 			AccountID:     account.ID,
 			CustomerID:    account.CustomerID,
-			Amount:        req.Amount,
+			Amount:        fee.Kobo(),
 			Date:          currentDate.Unix(),
-			EffectiveDate: effectiveDate.Unix(),
+			EffectiveDate: feeDate.Unix(),
 		}
 		if err := commission.Insert(ctx, dbTx, boil.Infer()); err != nil {
 			return nil, err
@@ -546,7 +834,7 @@ func (repo *Repository) create(ctx context.Context, claims auth.Claims, req Crea
 		// capture profit
 
 		profReq := profit.ProfitCreateRequest{
-			Amount:    req.Amount,
+			Amount:    fee,
 			Narration: fmt.Sprintf("DS commission of %s", account.R.Customer.Name),
 		}
 		if _, err := repo.ProfitRepo.CreateProfitTx(ctx, dbTx, claims, profReq, time.Unix(m.CreatedAt, 0)); err != nil {
@@ -554,21 +842,41 @@ func (repo *Repository) create(ctx context.Context, claims auth.Claims, req Crea
 		}
 	}
 
+	if err = repo.trackGoal(ctx, account, accountBalance, dbTx); err != nil {
+		return nil, err
+	}
+
+	if cycle != nil {
+		if err = repo.refreshCycle(ctx, cycle.ID, currentDate, dbTx); err != nil {
+			return nil, err
+		}
+	}
+
+	// The daily summary row is shared by every deposit of the day so it is updated last to hold
+	// its lock for as short as possible. Transfers move money the business already holds.
+	if transferID == "" {
+		if err = SaveDailySummary(ctx, req.Amount, 0, 0, currentDate, dbTx); err != nil {
+			return nil, err
+		}
+	}
+
 	return &Transaction{
 		ID:             m.ID,
 		AccountID:      m.AccountID,
-		Amount:         m.Amount,
-		OpeningBalance: m.OpeningBalance,
+		Amount:         money.Amount(m.Amount),
+		OpeningBalance: money.Amount(m.OpeningBalance),
 		Narration:      m.Narration,
 		Type:           TransactionType(m.TXType),
 		SalesRepID:     m.SalesRepID,
 		CreatedAt:      time.Unix(m.CreatedAt, 0),
 		UpdatedAt:      time.Unix(m.UpdatedAt, 0),
 		EffectiveDate:  time.Unix(m.EffectiveDate, 0),
+		TransferID:     m.TransferID.Ptr(),
 	}, nil
 }
 
-// Withdraw inserts a new withdrawal transaction into the database.
+// Withdraw inserts a new withdrawal transaction into the database. Withdrawals at or above the
+// approval threshold are held for approval and a PendingApprovalError is returned instead.
 func (repo *Repository) Withdraw(ctx context.Context, claims auth.Claims, req WithdrawRequest, now time.Time) (*Transaction, error) {
 	span, ctx := tracer.StartSpanFromContext(ctx, "internal.transaction.Withdraw")
 	defer span.Finish()
@@ -577,10 +885,38 @@ func (repo *Repository) Withdraw(ctx context.Context, claims auth.Claims, req Wi
 		Amount:        req.Amount,
 		Narration:     fmt.Sprintf("%s - %s", req.PaymentMethod, req.Narration),
 	}
+
+	// If now empty set it to the current time.
+	if now.IsZero() {
+		now = time.Now()
+	}
+
+	// Always store the time as UTC.
+	now = now.UTC()
+	// Postgres truncates times to milliseconds when storing. We and do the same
+	// here so the value we return is consistent with what we store.
+	now = now.Truncate(time.Millisecond)
+
 	tx, err := repo.DbConn.Begin()
 	if err != nil {
 		return nil, err
 	}
+
+	if req.IdempotencyKey != "" {
+		txID, err := idempotency.Claim(ctx, tx, claims.Audience, idempotency.ScopeWithdraw, req.IdempotencyKey, req, now)
+		if err != nil {
+			_ = tx.Rollback()
+			return nil, err
+		}
+		if txID != "" {
+			_ = tx.Rollback()
+			if txID, err = repo.approvalReplay(ctx, txID); err != nil {
+				return nil, err
+			}
+			return repo.ReadByID(ctx, claims, txID)
+		}
+	}
+
 	if req.PaymentMethod == "Transfer" {
 		if len(req.Narration) > 0 {
 			createReq.Narration += " -"
@@ -588,18 +924,95 @@ func (repo *Repository) Withdraw(ctx context.Context, claims auth.Claims, req Wi
 		if len(req.Bank) > 0 && len(req.BankAccountNumber) > 0 {
 			createReq.Narration += fmt.Sprintf("%s - %s", req.Bank, req.BankAccountNumber)
 		}
+		createReq.LedgerAccount = ledger.AccountBank
 
 	}
 
+	account, err := repo.lockAccount(ctx, models.AccountWhere.Number.EQ(req.AccountNumber), tx)
+	if err != nil {
+		_ = tx.Rollback()
+		return nil, weberror.NewErrorMessage(ctx, err, 400, "invalid account number")
+	}
+
+	if err = checkStatus(ctx, account, true); err != nil {
+		_ = tx.Rollback()
+		return nil, err
+	}
+
+	if err = checkKYCLimit(ctx, account, TransactionType_Withdrawal, createReq.Amount, now, tx); err != nil {
+		_ = tx.Rollback()
+		return nil, err
+	}
+
+	approval, err := repo.holdForApproval(ctx, claims, ApprovalKind_Withdrawal, account, nil, createReq.Amount,
+		createReq.Narration, createReq.LedgerAccount, now, tx)
+	if err != nil {
+		_ = tx.Rollback()
+		return nil, err
+	}
+	if approval != nil {
+		if err = repo.completeIdempotency(ctx, claims, req.IdempotencyKey, approval.ID, tx); err != nil {
+			_ = tx.Rollback()
+			return nil, err
+		}
+		if err = tx.Commit(); err != nil {
+			return nil, err
+		}
+		repo.notifyApprovers(ctx, claims, approval.ID)
+		return nil, &PendingApprovalError{Approval: ApprovalFromModel(approval)}
+	}
+
 	txn, err := repo.MakeDeduction(ctx, claims, createReq, now, tx)
 	if err != nil {
 		_ = tx.Rollback()
 		return nil, err
 	}
-	_ = tx.Commit()
+
+	if err = repo.completeIdempotency(ctx, claims, req.IdempotencyKey, txn.ID, tx); err != nil {
+		_ = tx.Rollback()
+		return nil, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, err
+	}
 	return txn, nil
 }
 
+// completeIdempotency links the claimed idempotency key, if any, to the transaction it produced.
+func (repo *Repository) completeIdempotency(ctx context.Context, claims auth.Claims, key, txID string, tx *sql.Tx) error {
+	if key == "" {
+		return nil
+	}
+	return idempotency.Complete(ctx, tx, claims.Audience, key, txID)
+}
+
+// lockAccount reads the account matching the query and locks it until the db transaction ends, so
+// balances are computed and written by one request at a time per account, including across
+// replicas of the app. The sibling accounts of the customer are locked with it, in a fixed order,
+// because a deposit also updates them; two postings to different accounts of the same customer
+// would otherwise deadlock.
+func (repo *Repository) lockAccount(ctx context.Context, where QueryMod, tx *sql.Tx) (*models.Account, error) {
+	account, err := models.Accounts(where).One(ctx, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err = models.Accounts(
+		models.AccountWhere.CustomerID.EQ(account.CustomerID),
+		OrderBy(models.AccountColumns.ID),
+		For("UPDATE"),
+	).All(ctx, tx); err != nil {
+		return nil, errors.WithMessage(err, "Cannot lock account")
+	}
+
+	return models.Accounts(
+		models.AccountWhere.ID.EQ(account.ID),
+		Load(models.AccountRels.Customer),
+		Load(models.AccountRels.Product),
+	).One(ctx, tx)
+}
+
 func (repo *Repository) generateReceiptNumber(ctx context.Context) string {
 	var receipt string
 	for receipt == "" || repo.receiptExists(ctx, receipt) {
@@ -617,16 +1030,21 @@ func (repo *Repository) receiptExists(ctx context.Context, receipt string) bool
 	return exists
 }
 
+// lastDeposit returns the latest deposit to the account that has not been reversed.
 func (repo *Repository) lastDeposit(ctx context.Context, accountID string, dbTx *sql.Tx) (*models.Transaction, error) {
 	return models.Transactions(
 		models.TransactionWhere.AccountID.EQ(accountID),
 		models.TransactionWhere.TXType.EQ(TransactionType_Deposit.String()),
+		models.TransactionWhere.ReversalOfID.IsNull(),
+		Where(`not exists (select 1 from transaction r where r.reversal_of_id = "transaction".id)`),
 		OrderBy(fmt.Sprintf("%s desc", models.TransactionColumns.CreatedAt)),
 		Limit(1),
 	).One(ctx, dbTx)
 }
 
-// Update replaces an exiting transaction in the database.
+// Update corrects an existing transaction. The transaction itself is never changed, instead a
+// reversal of it is posted followed by a replacement with the corrected amount and narration.
+// Both are linked to the original and record the reason and the admin that approved them.
 func (repo *Repository) Update(ctx context.Context, claims auth.Claims, req UpdateRequest, now time.Time) error {
 	span, ctx := tracer.StartSpanFromContext(ctx, "internal.transaction.Update")
 	defer span.Finish()
@@ -646,16 +1064,7 @@ func (repo *Repository) Update(ctx context.Context, claims auth.Claims, req Upda
 		return err
 	}
 
-	cols := models.M{}
-	if req.Narration != nil {
-		cols[models.TransactionColumns.Narration] = req.Narration
-	}
-
-	if req.Amount != nil {
-		cols[models.TransactionColumns.Amount] = req.Amount
-	}
-
-	if len(cols) == 0 {
+	if req.Amount == nil && req.Narration == nil {
 		return nil
 	}
 
@@ -670,49 +1079,66 @@ func (repo *Repository) Update(ctx context.Context, claims auth.Claims, req Upda
 	// here so the value we return is consistent with what we store.
 	now = now.Truncate(time.Millisecond)
 
-	cols[models.CustomerColumns.UpdatedAt] = now
-
 	tx, err := repo.DbConn.Begin()
 	if err != nil {
 		return err
 	}
 
-	_, err = models.Transactions(models.CustomerWhere.ID.EQ(req.ID)).UpdateAll(ctx, tx, cols)
+	original, account, contraAccount, err := repo.reverse(ctx, claims, req.ID, req.Reason, now, tx)
 	if err != nil {
-		tx.Rollback()
+		_ = tx.Rollback()
 		return err
 	}
 
+	replacement := models.Transaction{
+		AccountID:      original.AccountID,
+		TXType:         original.TXType,
+		Amount:         original.Amount,
+		Narration:      original.Narration,
+		PaymentMethod:  original.PaymentMethod,
+		EffectiveDate:  original.EffectiveDate,
+		CorrectionOfID: null.StringFrom(original.ID),
+		Reason:         req.Reason,
+		DSCycleID:      original.DSCycleID,
+	}
 	if req.Amount != nil {
-		tranx, err := models.FindTransaction(ctx, tx, req.ID)
-		if err != nil {
-			_ = tx.Rollback()
-			return err
-		}
-
-		diff := *req.Amount - tranx.Amount
-		if tranx.TXType == TransactionType_Withdrawal.String() {
-			diff *= -1
-		}
-
-		if diff != 0 {
-			if _, err := models.Accounts(models.AccountWhere.ID.EQ(tranx.AccountID)).UpdateAll(ctx, tx, models.M{
-				models.AccountColumns.Balance: fmt.Sprintf("%s + (%f)", models.AccountColumns.Balance, diff),
-			}); err != nil {
+		replacement.Amount = req.Amount.Kobo()
+	}
+	if req.Narration != nil {
+		replacement.Narration = *req.Narration
+	}
 
-				_ = tx.Rollback()
-				return err
+	// A corrected amount must pass the same checks as a new posting. The original was reversed
+	// above so it no longer counts towards the KYC limit of the day.
+	if req.Amount != nil {
+		if replacement.TXType == TransactionType_Deposit.String() {
+			product := account_product.FromModel(account.R.Product)
+			target := money.Amount(account.Target)
+			days, err := product.CheckDeposit(*req.Amount, target)
+			if err == nil && days != 1 {
+				err = fmt.Errorf("A daily contribution entry must be %s", target)
 			}
-
-			_, err = models.Transactions(
-				models.TransactionWhere.CreatedAt.GT(tranx.CreatedAt)).
-				UpdateAll(ctx, tx, models.M{models.TransactionColumns.Amount: fmt.Sprintf("amount + (%f)", diff)})
-
 			if err != nil {
 				_ = tx.Rollback()
-				return err
+				return weberror.NewError(ctx, err, http.StatusBadRequest)
 			}
 		}
+
+		if err = checkKYCLimit(ctx, account, TransactionType(replacement.TXType), *req.Amount, now, tx); err != nil {
+			_ = tx.Rollback()
+			return err
+		}
+	}
+
+	var income money.Amount
+	if replacement.TXType == TransactionType_Deposit.String() {
+		income = money.Amount(replacement.Amount)
+	}
+
+	// The replacement is dated just after the reversal so the history reads in order.
+	if err = repo.postCorrection(ctx, claims, account, &replacement, contraAccount, income, now.Add(time.Second), tx); err != nil {
+		_ = tx.Rollback()
+		return err
 	}
 
 	if err = tx.Commit(); err != nil {
@@ -722,7 +1148,8 @@ func (repo *Repository) Update(ctx context.Context, claims auth.Claims, req Upda
 	return nil
 }
 
-// Archive soft deleted the transaction from the database.
+// Archive reverses the transaction. The transaction is kept as it is and a reversal entry that
+// is linked to it and records the reason and the approving admin is posted to the account.
 func (repo *Repository) Archive(ctx context.Context, claims auth.Claims, req ArchiveRequest, now time.Time) error {
 	span, ctx := tracer.StartSpanFromContext(ctx, "internal.transaction.Archive")
 	defer span.Finish()
@@ -757,76 +1184,168 @@ func (repo *Repository) Archive(ctx context.Context, claims auth.Claims, req Arc
 		return err
 	}
 
-	tranx, err := models.Transactions(models.TransactionWhere.ID.EQ(req.ID)).One(ctx, tx)
-	if err != nil {
+	if _, _, _, err = repo.reverse(ctx, claims, req.ID, req.Reason, now, tx); err != nil {
 		_ = tx.Rollback()
 		return err
 	}
 
-	if tranx.ArchivedAt.Valid {
-		_ = tx.Rollback()
-		return errors.New("This transaction has been archived")
+	if err = tx.Commit(); err != nil {
+		return errors.Wrap(err, "commintin transaction")
 	}
 
-	_, err = models.Transactions(models.TransactionWhere.ID.EQ(req.ID)).
-		UpdateAll(ctx, tx, models.M{models.TransactionColumns.ArchivedAt: now.Unix()})
+	return nil
+}
+
+// reverse posts an entry in the opposite direction of the transaction for the same amount. It
+// returns the reversed transaction, its account and the ledger account the money originally moved
+// through so that a replacement can be posted the same way.
+func (repo *Repository) reverse(ctx context.Context, claims auth.Claims, id, reason string, now time.Time,
+	tx *sql.Tx) (*models.Transaction, *models.Account, string, error) {
+
+	original, err := models.FindTransaction(ctx, tx, id)
 	if err != nil {
-		tx.Rollback()
-		return err
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, nil, "", weberror.NewError(ctx, ErrNotFound, http.StatusNotFound)
+		}
+		return nil, nil, "", err
 	}
 
-	var txAmount = tranx.Amount
-	if tranx.TXType == TransactionType_Deposit.String() {
-		txAmount *= -1
+	account, err := repo.lockAccount(ctx, models.AccountWhere.ID.EQ(original.AccountID), tx)
+	if err != nil {
+		return nil, nil, "", err
 	}
 
-	// updated all trans after it
-	statement := fmt.Sprintf("UPDATE %s SET %s = %s + $1 WHERE %s = $2 AND %s > $3",
-		models.TableNames.Transaction,
-		models.TransactionColumns.OpeningBalance, models.TransactionColumns.OpeningBalance,
-		models.TransactionColumns.AccountID, models.TransactionColumns.CreatedAt)
-	_, err = models.NewQuery(qm.SQL(statement, txAmount, tranx.AccountID, tranx.CreatedAt)).Exec(tx)
+	if original.ReversalOfID.Valid {
+		return nil, nil, "", weberror.NewError(ctx,
+			errors.New("A reversal cannot be reversed, post a new transaction instead"), http.StatusBadRequest)
+	}
+
+	// Reversing one leg of a transfer would leave the money on neither account.
+	if original.TransferID.Valid {
+		return nil, nil, "", weberror.NewError(ctx,
+			errors.New("A transfer cannot be corrected, post a transfer back instead"), http.StatusBadRequest)
+	}
 
+	// Checked after the account is locked so two admins cannot reverse the same transaction.
+	reversed, err := models.Transactions(models.TransactionWhere.ReversalOfID.EQ(null.StringFrom(original.ID))).Exists(ctx, tx)
 	if err != nil {
-		_ = tx.Rollback()
-		return err
+		return nil, nil, "", err
+	}
+	if reversed {
+		return nil, nil, "", weberror.NewError(ctx, errors.New("This transaction has already been reversed"), http.StatusBadRequest)
 	}
 
-	// update the account balance
-	statement = fmt.Sprintf("UPDATE %s SET %s = %s + $1 WHERE %s = $2",
-		models.TableNames.Account,
-		models.AccountColumns.Balance,
-		models.AccountColumns.Balance,
-		models.AccountColumns.ID,
-	)
-	_, err = models.NewQuery(qm.SQL(statement, txAmount, tranx.AccountID)).Exec(tx)
+	contraAccount, err := repo.contraAccount(ctx, original, tx)
+	if err != nil {
+		return nil, nil, "", err
+	}
+
+	reversal := models.Transaction{
+		AccountID:     original.AccountID,
+		TXType:        TransactionType_Deposit.String(),
+		Amount:        original.Amount,
+		Narration:     fmt.Sprintf("Reversal of %s", original.ReceiptNo),
+		PaymentMethod: original.PaymentMethod,
+		EffectiveDate: original.EffectiveDate,
+		ReversalOfID:  null.StringFrom(original.ID),
+		Reason:        reason,
+		DSCycleID:     original.DSCycleID,
+	}
+
+	// Reversing a deposit takes the money back out of the account and out of the day's income.
+	var income money.Amount
+	if original.TXType == TransactionType_Deposit.String() {
+		reversal.TXType = TransactionType_Withdrawal.String()
+		income = -money.Amount(original.Amount)
+	}
+
+	if err = repo.postCorrection(ctx, claims, account, &reversal, contraAccount, income, now, tx); err != nil {
+		return nil, nil, "", err
+	}
 
+	return original, account, contraAccount, nil
+}
+
+// postCorrection inserts a reversal or replacement entry, posts it to the ledger against the
+// provided contra account and applies it to the account balance and the daily summary.
+func (repo *Repository) postCorrection(ctx context.Context, claims auth.Claims, account *models.Account,
+	m *models.Transaction, contraAccount string, income money.Amount, now time.Time, tx *sql.Tx) error {
+
+	accountBalance, err := repo.AccountBalanceTx(ctx, account.ID, tx)
 	if err != nil {
-		_ = tx.Rollback()
 		return err
 	}
 
-	//update daily saving
-	dailySum, err := models.FindDailySummary(ctx, tx, tranx.CreatedAt)
-	if err != nil && err != sql.ErrNoRows {
-		_ = tx.Rollback()
+	amount := money.Amount(m.Amount)
+	if m.TXType == TransactionType_Withdrawal.String() && accountBalance < amount {
+		return weberror.NewError(ctx, errors.New("insufficient fund"), http.StatusBadRequest)
+	}
+
+	m.ID = uuid.NewRandom().String()
+	m.OpeningBalance = accountBalance.Kobo()
+	m.SalesRepID = claims.Subject
+	m.ApprovedByID = null.StringFrom(claims.Subject)
+	m.ReceiptNo = repo.generateReceiptNumber(ctx)
+	m.CreatedAt = now.Unix()
+	m.UpdatedAt = now.Unix()
+
+	if err := m.Insert(ctx, tx, boil.Infer()); err != nil {
+		return errors.WithMessage(err, "Insert correction failed")
+	}
+
+	if err := repo.postToLedger(ctx, claims, m, account.Number, contraAccount, now, tx); err != nil {
 		return err
 	}
-	if dailySum != nil {
-		dailySum.Income += txAmount
-		if _, err = dailySum.Update(ctx, tx, boil.Infer()); err != nil {
-			_ = tx.Rollback()
+
+	if m.TXType == TransactionType_Deposit.String() {
+		accountBalance += amount
+	} else {
+		accountBalance -= amount
+	}
+
+	if _, err := models.Accounts(models.AccountWhere.ID.EQ(account.ID)).UpdateAll(ctx, tx, models.M{
+		models.AccountColumns.Balance: accountBalance.Kobo(),
+	}); err != nil {
+		return err
+	}
+
+	if m.DSCycleID.Valid {
+		if err := repo.refreshCycle(ctx, m.DSCycleID.String, now, tx); err != nil {
 			return err
 		}
 	}
 
-	if err = tx.Commit(); err != nil {
-		return errors.Wrap(err, "commintin transaction")
+	if income != 0 {
+		if err := SaveDailySummary(ctx, income, 0, 0, now, tx); err != nil {
+			return err
+		}
 	}
 
 	return nil
 }
 
+// contraAccount returns the ledger account, other than customer deposits, that the transaction
+// was posted against. Transactions recorded before the ledger fall back to the account of their
+// payment method.
+func (repo *Repository) contraAccount(ctx context.Context, m *models.Transaction, tx *sql.Tx) (string, error) {
+	posting, err := models.Postings(
+		InnerJoin(fmt.Sprintf("%s je on je.%s = %s.%s", models.TableNames.JournalEntry, models.JournalEntryColumns.ID,
+			models.TableNames.Posting, models.PostingColumns.JournalEntryID)),
+		Where(fmt.Sprintf("je.%s = ? and je.%s = ?", models.JournalEntryColumns.SourceType, models.JournalEntryColumns.SourceID),
+			ledger.SourceTransaction, m.ID),
+		models.PostingWhere.LedgerAccountCode.NEQ(ledger.AccountCustomerDeposits),
+		OrderBy(fmt.Sprintf("je.%s", models.JournalEntryColumns.CreatedAt)),
+	).One(ctx, tx)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return LedgerAccountForPaymentMethod(m.PaymentMethod), nil
+		}
+		return "", err
+	}
+
+	return posting.LedgerAccountCode, nil
+}
+
 // MakeDeduction inserts a new transaction of type withdrawal into the database.
 func (repo *Repository) MakeDeduction(ctx context.Context, claims auth.Claims, req MakeDeductionRequest,
 	now time.Time, tx *sql.Tx) (*Transaction, error) {
@@ -837,13 +1356,15 @@ func (repo *Repository) MakeDeduction(ctx context.Context, claims auth.Claims, r
 		return nil, errors.WithStack(ErrForbidden)
 	}
 
-	account, err := models.Accounts(
-		qm.Load(models.AccountRels.Customer),
-		models.AccountWhere.Number.EQ(req.AccountNumber)).One(ctx, tx)
+	account, err := repo.lockAccount(ctx, models.AccountWhere.Number.EQ(req.AccountNumber), tx)
 	if err != nil {
 		return nil, weberror.NewErrorMessage(ctx, err, 400, "invalid account number")
 	}
 
+	if err = checkStatus(ctx, account, true); err != nil {
+		return nil, err
+	}
+
 	// Validate the request.
 	v := webcontext.Validator()
 	err = v.Struct(req)
@@ -862,43 +1383,66 @@ func (repo *Repository) MakeDeduction(ctx context.Context, claims auth.Claims, r
 	// here so the value we return is consistent with what we store.
 	now = now.Truncate(time.Millisecond)
 
-	repo.accNumMtx.Lock()
-	defer repo.accNumMtx.Unlock()
-
 	accountBalance, err := repo.AccountBalanceTx(ctx, account.ID, tx)
 	if err != nil {
 		return nil, err
 	}
 
-	if accountBalance < req.Amount {
-		return nil, weberror.NewError(ctx, errors.New("insufficient fund"), 400)
+	// Taking money out of a fixed savings account before it matures attracts a penalty that
+	// must also be covered by the balance.
+	penalty := earlyWithdrawalPenalty(account, req.Amount, now)
+	if err = account_product.FromModel(account.R.Product).CheckWithdrawal(accountBalance, req.Amount+penalty); err != nil {
+		return nil, weberror.NewError(ctx, err, 400)
+	}
+	if err = checkHeld(ctx, account.ID, accountBalance, req.Amount+penalty, now, tx); err != nil {
+		return nil, err
 	}
 
 	m := models.Transaction{
 		ID:             uuid.NewRandom().String(),
 		AccountID:      account.ID,
 		TXType:         TransactionType_Withdrawal.String(),
-		OpeningBalance: accountBalance,
-		Amount:         req.Amount,
+		OpeningBalance: accountBalance.Kobo(),
+		Amount:         req.Amount.Kobo(),
 		Narration:      req.Narration,
 		SalesRepID:     claims.Subject,
 		CreatedAt:      now.Unix(),
 		UpdatedAt:      now.Unix(),
+		EffectiveDate:  now.Unix(),
 	}
 
 	if err := m.Insert(ctx, tx, boil.Infer()); err != nil {
 		return nil, errors.WithMessage(err, "Insert deduction failed")
 	}
 
+	contraAccount := req.LedgerAccount
+	if contraAccount == "" {
+		contraAccount = ledger.AccountCash
+	}
+	if err := repo.postToLedger(ctx, claims, &m, account.Number, contraAccount, now, tx); err != nil {
+		return nil, err
+	}
+
 	accountBalance -= req.Amount
+	if penalty > 0 {
+		if err := repo.chargeEarlyWithdrawalPenalty(ctx, claims, account, &m, accountBalance, penalty, now, tx); err != nil {
+			return nil, err
+		}
+		accountBalance -= penalty
+	}
+
 	if _, err := models.Accounts(models.AccountWhere.ID.EQ(account.ID)).UpdateAll(ctx, tx, models.M{
-		models.AccountColumns.Balance: accountBalance,
+		models.AccountColumns.Balance: accountBalance.Kobo(),
 	}); err != nil {
 
 		_ = tx.Rollback()
 		return nil, err
 	}
 
+	if err = repo.trackGoal(ctx, account, accountBalance, tx); err != nil {
+		return nil, err
+	}
+
 	var salesRepName string
 	salesRep, err := models.FindUser(ctx, repo.DbConn, claims.Subject)
 	if err == nil {
@@ -920,28 +1464,175 @@ func (repo *Repository) MakeDeduction(ctx context.Context, claims auth.Claims, r
 	return FromModel(&m), nil
 }
 
-// SaveDailySummary saves the provided daily summary info to the db
-func SaveDailySummary(ctx context.Context, income, expenditure, bankDeposit float64, date time.Time, tx *sql.Tx) error {
-	span, ctx := tracer.StartSpanFromContext(ctx, "internal.transaction.SaveDailySummary")
+// MakeCredit inserts a new transaction of type deposit into the database for money paid into the
+// account from the requested ledger account rather than received from the customer. Daily
+// contribution accounts cannot be credited this way as deposits on them pay for days.
+func (repo *Repository) MakeCredit(ctx context.Context, claims auth.Claims, req MakeCreditRequest,
+	now time.Time, tx *sql.Tx) (*Transaction, error) {
+
+	span, ctx := tracer.StartSpanFromContext(ctx, "internal.transaction.MakeCredit")
 	defer span.Finish()
+	if claims.Subject == "" {
+		return nil, errors.WithStack(ErrForbidden)
+	}
 
-	today := now.New(date).BeginningOfDay().Unix()
-	existingSummary, err := models.FindDailySummary(ctx, tx, today)
+	// Validate the request.
+	v := webcontext.Validator()
+	err := v.Struct(req)
+	if err != nil {
+		return nil, err
+	}
+
+	account, err := repo.lockAccount(ctx, models.AccountWhere.Number.EQ(req.AccountNumber), tx)
+	if err != nil {
+		return nil, weberror.NewErrorMessage(ctx, err, 400, "invalid account number")
+	}
+
+	if account.R.Product.DailyContribution {
+		return nil, weberror.NewErrorMessage(ctx, ErrForbidden, 400, "daily contribution accounts cannot be credited")
+	}
+
+	if err = checkStatus(ctx, account, false); err != nil {
+		return nil, err
+	}
+
+	// If now empty set it to the current time.
+	if now.IsZero() {
+		now = time.Now()
+	}
+
+	// Always store the time as UTC.
+	now = now.UTC()
+	// Postgres truncates times to milliseconds when storing. We and do the same
+	// here so the value we return is consistent with what we store.
+	now = now.Truncate(time.Millisecond)
+
+	accountBalance, err := repo.AccountBalanceTx(ctx, account.ID, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	m := models.Transaction{
+		ID:             uuid.NewRandom().String(),
+		AccountID:      account.ID,
+		TXType:         TransactionType_Deposit.String(),
+		OpeningBalance: accountBalance.Kobo(),
+		Amount:         req.Amount.Kobo(),
+		Narration:      req.Narration,
+		SalesRepID:     claims.Subject,
+		ReceiptNo:      repo.generateReceiptNumber(ctx),
+		CreatedAt:      now.Unix(),
+		UpdatedAt:      now.Unix(),
+		EffectiveDate:  now.Unix(),
+	}
+
+	if err := m.Insert(ctx, tx, boil.Infer()); err != nil {
+		return nil, errors.WithMessage(err, "Insert credit failed")
+	}
+
+	if err := repo.postToLedger(ctx, claims, &m, account.Number, req.LedgerAccount, now, tx); err != nil {
+		return nil, err
+	}
+
+	accountBalance += req.Amount
+	if _, err := models.Accounts(models.AccountWhere.ID.EQ(account.ID)).UpdateAll(ctx, tx, models.M{
+		models.AccountColumns.Balance: accountBalance.Kobo(),
+	}); err != nil {
+		return nil, err
+	}
+
+	if err = repo.trackGoal(ctx, account, accountBalance, tx); err != nil {
+		return nil, err
+	}
+
+	if err = SaveDailySummary(ctx, req.Amount, 0, 0, now, tx); err != nil {
+		return nil, err
+	}
+
+	var salesRepName string
+	salesRep, err := models.FindUser(ctx, repo.DbConn, claims.Subject)
 	if err == nil {
-		existingSummary.BankDeposit += bankDeposit
-		existingSummary.Income += income
-		existingSummary.Expenditure += expenditure
+		salesRepName = salesRep.FirstName + " " + salesRep.LastName
+	}
 
-		_, err = existingSummary.Update(ctx, tx, boil.Infer())
-		return err
+	if err = repo.notifySMS.Send(ctx, account.R.Customer.PhoneNumber, "sms/payment_received",
+		map[string]interface{}{
+			"Name":          account.R.Customer.Name,
+			"Amount":        req.Amount,
+			"Balance":       accountBalance,
+			"AccountNumber": account.Number,
+			"Cashier":       salesRepName,
+		}); err != nil {
+		// TODO: log critical error. Send message to monitoring account
+		fmt.Println(err)
+	}
+
+	return FromModel(&m), nil
+}
+
+// LedgerAccountForPaymentMethod returns the ledger account that receives or pays out money for
+// the payment method.
+func LedgerAccountForPaymentMethod(paymentMethod string) string {
+	if paymentMethod == PaymentMethod_Bank {
+		return ledger.AccountBank
+	}
+	return ledger.AccountCash
+}
+
+// postToLedger records the transaction in the general ledger against the customer deposit
+// account and the provided contra account.
+func (repo *Repository) postToLedger(ctx context.Context, claims auth.Claims, m *models.Transaction,
+	accountNumber, contraAccount string, now time.Time, tx *sql.Tx) error {
+
+	deposits := ledger.AccountCustomerDeposits
+	amount := money.Amount(m.Amount)
+	lines := []ledger.Line{
+		ledger.Debit(contraAccount, amount),
+		ledger.Credit(deposits, amount).ForAccount(m.AccountID),
+	}
+	if m.TXType == TransactionType_Withdrawal.String() {
+		lines = []ledger.Line{
+			ledger.Debit(deposits, amount).ForAccount(m.AccountID),
+			ledger.Credit(contraAccount, amount),
+		}
 	}
 
-	model := models.DailySummary{
-		Date:        today,
-		BankDeposit: bankDeposit,
-		Income:      income,
-		Expenditure: expenditure,
+	narration := fmt.Sprintf("%s on %s", m.TXType, accountNumber)
+	if m.Narration != "" {
+		narration += " - " + m.Narration
 	}
 
-	return model.Insert(ctx, tx, boil.Infer())
+	_, err := repo.LedgerRepo.PostTx(ctx, claims, ledger.PostRequest{
+		Reference:  m.ReceiptNo,
+		Narration:  narration,
+		SourceType: ledger.SourceTransaction,
+		SourceID:   m.ID,
+		Lines:      lines,
+	}, now, tx)
+	if err != nil {
+		return errors.WithMessage(err, "Cannot post transaction to the ledger")
+	}
+
+	return nil
+}
+
+const saveDailySummaryStatement = `INSERT INTO daily_summary (date, income, expenditure, bank_deposit)
+	VALUES ($1, $2, $3, $4)
+	ON CONFLICT (date) DO UPDATE SET
+		income = daily_summary.income + EXCLUDED.income,
+		expenditure = daily_summary.expenditure + EXCLUDED.expenditure,
+		bank_deposit = daily_summary.bank_deposit + EXCLUDED.bank_deposit`
+
+// SaveDailySummary adds the provided amounts to the summary of the day in a single statement so
+// that concurrent postings do not overwrite each other's totals.
+func SaveDailySummary(ctx context.Context, income, expenditure, bankDeposit money.Amount, date time.Time, tx *sql.Tx) error {
+	span, ctx := tracer.StartSpanFromContext(ctx, "internal.transaction.SaveDailySummary")
+	defer span.Finish()
+
+	today := now.New(date).BeginningOfDay().Unix()
+	if _, err := tx.ExecContext(ctx, saveDailySummaryStatement, today, income, expenditure, bankDeposit); err != nil {
+		return errors.Wrap(err, "Cannot save daily summary")
+	}
+
+	return nil
 }
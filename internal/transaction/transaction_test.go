@@ -0,0 +1,1226 @@
+package transaction
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"os"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/dgrijalva/jwt-go"
+	"github.com/pborman/uuid"
+	"github.com/pkg/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	. "github.com/volatiletech/sqlboiler/v4/queries/qm"
+
+	"merryworld/surebank/internal/customer"
+	"merryworld/surebank/internal/dscommission"
+	"merryworld/surebank/internal/ledger"
+	"merryworld/surebank/internal/platform/auth"
+	"merryworld/surebank/internal/platform/money"
+	"merryworld/surebank/internal/platform/notify"
+	"merryworld/surebank/internal/platform/tests"
+	"merryworld/surebank/internal/postgres/models"
+	"merryworld/surebank/internal/profit"
+)
+
+var (
+	test *tests.Test
+	repo *Repository
+)
+
+// TestMain is the entry point for testing.
+func TestMain(m *testing.M) {
+	os.Exit(testMain(m))
+}
+
+func testMain(m *testing.M) int {
+	test = tests.New()
+	defer test.TearDown()
+
+	repo = NewRepository(test.MasterDB, dscommission.NewRepository(test.MasterDB), profit.NewRepository(test.MasterDB),
+		ledger.NewRepository(test.MasterDB), notify.NewSMSDisabled(), notify.NewEmailDisabled(), nil)
+
+	return m.Run()
+}
+
+// newTestAccount creates a branch, sales rep, customer and savings account to post transactions to.
+func newTestAccount(t *testing.T, now time.Time) (auth.Claims, *models.Account) {
+	ctx := tests.Context()
+
+	branch := models.Branch{
+		ID:        uuid.NewRandom().String(),
+		Name:      "Branch " + uuid.NewRandom().String(),
+		CreatedAt: now.Unix(),
+		UpdatedAt: now.Unix(),
+	}
+	if err := branch.Insert(ctx, test.MasterDB, boil.Infer()); err != nil {
+		t.Fatalf("\t%s\tInsert branch failed: %v", tests.Failed, err)
+	}
+
+	salesRep := models.User{
+		ID:          uuid.NewRandom().String(),
+		BranchID:    branch.ID,
+		Email:       uuid.NewRandom().String() + "@example.com",
+		FirstName:   "Sales",
+		LastName:    "Rep",
+		PhoneNumber: "08000000000",
+		CreatedAt:   now,
+	}
+	if err := salesRep.Insert(ctx, test.MasterDB, boil.Infer()); err != nil {
+		t.Fatalf("\t%s\tInsert sales rep failed: %v", tests.Failed, err)
+	}
+
+	cust := models.Customer{
+		ID:          uuid.NewRandom().String(),
+		BranchID:    branch.ID,
+		Email:       uuid.NewRandom().String() + "@example.com",
+		Name:        "Test Customer",
+		PhoneNumber: "08000000001",
+		SalesRepID:  salesRep.ID,
+		CreatedAt:   now.Unix(),
+		UpdatedAt:   now.Unix(),
+	}
+	if err := cust.Insert(ctx, test.MasterDB, boil.Infer()); err != nil {
+		t.Fatalf("\t%s\tInsert customer failed: %v", tests.Failed, err)
+	}
+
+	// The SB product is seeded by the schema migrations.
+	product, err := models.AccountProducts(models.AccountProductWhere.Code.EQ(customer.AccountTypeSB)).One(ctx, test.MasterDB)
+	if err != nil {
+		t.Fatalf("\t%s\tRead account product failed: %v", tests.Failed, err)
+	}
+
+	account := models.Account{
+		ID:          uuid.NewRandom().String(),
+		BranchID:    branch.ID,
+		Number:      uuid.NewRandom().String()[:8],
+		CustomerID:  cust.ID,
+		AccountType: product.Code,
+		ProductID:   product.ID,
+		SalesRepID:  salesRep.ID,
+		CreatedAt:   now.Unix(),
+		UpdatedAt:   now.Unix(),
+	}
+	if err := account.Insert(ctx, test.MasterDB, boil.Infer()); err != nil {
+		t.Fatalf("\t%s\tInsert account failed: %v", tests.Failed, err)
+	}
+
+	claims := auth.Claims{
+		Roles: []string{auth.RoleAdmin},
+		StandardClaims: jwt.StandardClaims{
+			Subject:  salesRep.ID,
+			Audience: uuid.NewRandom().String(),
+		},
+	}
+
+	return claims, &account
+}
+
+// TestConcurrentPostings hammers a single account with deposits and withdrawals from many
+// goroutines and ensures every posting saw the balance left by the one before it.
+func TestConcurrentPostings(t *testing.T) {
+	defer tests.Recover(t)
+
+	const (
+		workers = 40
+		amount  = money.Amount(100 * money.KoboPerNaira)
+	)
+
+	now := time.Date(2026, time.October, 18, 9, 0, 0, 0, time.UTC)
+	claims, account := newTestAccount(t, now)
+
+	t.Log("Given the need to post to one account from many requests at the same time.")
+	{
+		ctx := tests.Context()
+
+		var wg sync.WaitGroup
+		errs := make(chan error, workers)
+		for i := 0; i < workers; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				_, err := repo.Deposit(ctx, claims, CreateRequest{
+					Type:          TransactionType_Deposit,
+					AccountNumber: account.Number,
+					Amount:        amount,
+					PaymentMethod: PaymentMethod_Cash,
+				}, now)
+				errs <- err
+			}()
+		}
+		wg.Wait()
+		close(errs)
+
+		for err := range errs {
+			if err != nil {
+				t.Log("\t\tGot :", err)
+				t.Fatalf("\t%s\tDeposit failed.", tests.Failed)
+			}
+		}
+		t.Logf("\t%s\tDeposit ok.", tests.Success)
+
+		deposits, err := models.Transactions(models.TransactionWhere.AccountID.EQ(account.ID)).All(ctx, test.MasterDB)
+		if err != nil {
+			t.Log("\t\tGot :", err)
+			t.Fatalf("\t%s\tFind deposits failed.", tests.Failed)
+		}
+		if len(deposits) != workers {
+			t.Fatalf("\t%s\tExpected %d deposits, got %d.", tests.Failed, workers, len(deposits))
+		}
+
+		// Serialized deposits each open with the balance of all the deposits before it.
+		var openingBalances []money.Amount
+		for _, d := range deposits {
+			openingBalances = append(openingBalances, money.Amount(d.OpeningBalance))
+		}
+		sort.Slice(openingBalances, func(i, j int) bool { return openingBalances[i] < openingBalances[j] })
+		for i, b := range openingBalances {
+			if b != amount.Mul(int64(i)) {
+				t.Fatalf("\t%s\tExpected deposit %d to open at %s, got %s.", tests.Failed, i+1, amount.Mul(int64(i)), b)
+			}
+		}
+		assertBalance(t, account.ID, workers*amount)
+		t.Logf("\t%s\tDeposits serialized ok.", tests.Success)
+
+		// Ask for more than the balance covers, only enough withdrawals to empty the account may pass.
+		var (
+			mu        sync.Mutex
+			withdrawn int
+		)
+		for i := 0; i < workers+5; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				_, err := repo.Withdraw(ctx, claims, WithdrawRequest{
+					Type:          TransactionType_Withdrawal,
+					AccountNumber: account.Number,
+					Amount:        amount,
+					PaymentMethod: PaymentMethod_Cash,
+				}, now)
+				if err == nil {
+					mu.Lock()
+					withdrawn++
+					mu.Unlock()
+				}
+			}()
+		}
+		wg.Wait()
+
+		if withdrawn != workers {
+			t.Fatalf("\t%s\tExpected %d withdrawals to pass, got %d.", tests.Failed, workers, withdrawn)
+		}
+		assertBalance(t, account.ID, 0)
+		t.Logf("\t%s\tWithdraw ok.", tests.Success)
+	}
+}
+
+// assertBalance ensures the stored account balance, the sum of its transactions and its customer
+// deposit sub-ledger all equal the expected amount.
+func assertBalance(t *testing.T, accountID string, expected money.Amount) {
+	ctx := tests.Context()
+
+	account, err := models.FindAccount(ctx, test.MasterDB, accountID)
+	if err != nil {
+		t.Log("\t\tGot :", err)
+		t.Fatalf("\t%s\tFind account failed.", tests.Failed)
+	}
+	if money.Amount(account.Balance) != expected {
+		t.Fatalf("\t%s\tExpected account balance %s, got %s.", tests.Failed, expected, money.Amount(account.Balance))
+	}
+
+	balance, err := repo.AccountBalance(ctx, accountID)
+	if err != nil {
+		t.Log("\t\tGot :", err)
+		t.Fatalf("\t%s\tAccountBalance failed.", tests.Failed)
+	}
+	if balance != expected {
+		t.Fatalf("\t%s\tExpected transactions to sum to %s, got %s.", tests.Failed, expected, balance)
+	}
+
+	postings, err := models.Postings(
+		models.PostingWhere.AccountID.EQ(null.StringFrom(accountID)),
+		models.PostingWhere.LedgerAccountCode.EQ(ledger.AccountCustomerDeposits),
+	).All(ctx, test.MasterDB)
+	if err != nil {
+		t.Log("\t\tGot :", err)
+		t.Fatalf("\t%s\tFind postings failed.", tests.Failed)
+	}
+	var ledgerBalance money.Amount
+	for _, p := range postings {
+		ledgerBalance += money.Amount(p.Credit - p.Debit)
+	}
+	if ledgerBalance != expected {
+		t.Fatalf("\t%s\tExpected ledger balance %s, got %s.", tests.Failed, expected, ledgerBalance)
+	}
+}
+
+// TestCorrections ensures corrections are posted as linked reversals and replacements and never
+// change the transaction they correct.
+func TestCorrections(t *testing.T) {
+	defer tests.Recover(t)
+
+	now := time.Date(2026, time.October, 18, 10, 0, 0, 0, time.UTC)
+	claims, account := newTestAccount(t, now)
+
+	t.Log("Given the need to correct a posted transaction without rewriting history.")
+	{
+		ctx := tests.Context()
+
+		deposit, err := repo.Deposit(ctx, claims, CreateRequest{
+			Type:          TransactionType_Deposit,
+			AccountNumber: account.Number,
+			Amount:        money.Naira(500),
+			PaymentMethod: PaymentMethod_Cash,
+		}, now)
+		if err != nil {
+			t.Log("\t\tGot :", err)
+			t.Fatalf("\t%s\tDeposit failed.", tests.Failed)
+		}
+		t.Logf("\t%s\tDeposit ok.", tests.Success)
+
+		amount := money.Naira(300)
+		err = repo.Update(ctx, claims, UpdateRequest{
+			ID:     deposit.ID,
+			Amount: &amount,
+			Reason: "Amount entered wrongly",
+		}, now.Add(time.Minute))
+		if err != nil {
+			t.Log("\t\tGot :", err)
+			t.Fatalf("\t%s\tUpdate failed.", tests.Failed)
+		}
+
+		original, err := models.FindTransaction(ctx, test.MasterDB, deposit.ID)
+		if err != nil {
+			t.Log("\t\tGot :", err)
+			t.Fatalf("\t%s\tFind original failed.", tests.Failed)
+		}
+		if money.Amount(original.Amount) != deposit.Amount || original.UpdatedAt != deposit.UpdatedAt.Unix() {
+			t.Fatalf("\t%s\tExpected the original transaction to be unchanged.", tests.Failed)
+		}
+
+		reversal, err := models.Transactions(models.TransactionWhere.ReversalOfID.EQ(null.StringFrom(deposit.ID))).One(ctx, test.MasterDB)
+		if err != nil {
+			t.Log("\t\tGot :", err)
+			t.Fatalf("\t%s\tFind reversal failed.", tests.Failed)
+		}
+		if reversal.TXType != TransactionType_Withdrawal.String() || money.Amount(reversal.Amount) != deposit.Amount ||
+			reversal.Reason == "" || reversal.ApprovedByID.String != claims.Subject {
+			t.Fatalf("\t%s\tExpected a withdrawal of %s approved by %s, got %+v.", tests.Failed, deposit.Amount, claims.Subject, reversal)
+		}
+
+		replacement, err := models.Transactions(models.TransactionWhere.CorrectionOfID.EQ(null.StringFrom(deposit.ID))).One(ctx, test.MasterDB)
+		if err != nil {
+			t.Log("\t\tGot :", err)
+			t.Fatalf("\t%s\tFind replacement failed.", tests.Failed)
+		}
+		if replacement.TXType != TransactionType_Deposit.String() || money.Amount(replacement.Amount) != amount {
+			t.Fatalf("\t%s\tExpected a deposit of %s, got %+v.", tests.Failed, amount, replacement)
+		}
+		assertBalance(t, account.ID, amount)
+		t.Logf("\t%s\tUpdate ok.", tests.Success)
+
+		err = repo.Archive(ctx, claims, ArchiveRequest{ID: deposit.ID, Reason: "Posted twice"}, now.Add(2*time.Minute))
+		if err == nil {
+			t.Fatalf("\t%s\tExpected reversing the original again to fail.", tests.Failed)
+		}
+
+		err = repo.Archive(ctx, claims, ArchiveRequest{ID: replacement.ID, Reason: "Posted to the wrong account"}, now.Add(2*time.Minute))
+		if err != nil {
+			t.Log("\t\tGot :", err)
+			t.Fatalf("\t%s\tArchive failed.", tests.Failed)
+		}
+		assertBalance(t, account.ID, 0)
+		t.Logf("\t%s\tArchive ok.", tests.Success)
+	}
+}
+
+// TestTransfer ensures a transfer moves money between accounts of a customer atomically and is
+// not counted as income.
+func TestTransfer(t *testing.T) {
+	defer tests.Recover(t)
+
+	now := time.Date(2026, time.March, 2, 11, 0, 0, 0, time.UTC)
+	claims, from := newTestAccount(t, now)
+	_, other := newTestAccount(t, now)
+
+	ctx := tests.Context()
+
+	to := models.Account{
+		ID:          uuid.NewRandom().String(),
+		BranchID:    from.BranchID,
+		Number:      uuid.NewRandom().String()[:8],
+		CustomerID:  from.CustomerID,
+		AccountType: from.AccountType,
+		ProductID:   from.ProductID,
+		SalesRepID:  from.SalesRepID,
+		CreatedAt:   now.Unix(),
+		UpdatedAt:   now.Unix(),
+	}
+	if err := to.Insert(ctx, test.MasterDB, boil.Infer()); err != nil {
+		t.Fatalf("\t%s\tInsert account failed: %v", tests.Failed, err)
+	}
+
+	t.Log("Given the need to move money between the accounts of a customer.")
+	{
+		_, err := repo.Deposit(ctx, claims, CreateRequest{
+			Type:          TransactionType_Deposit,
+			AccountNumber: from.Number,
+			Amount:        money.Naira(1000),
+			PaymentMethod: PaymentMethod_Cash,
+		}, now)
+		if err != nil {
+			t.Log("\t\tGot :", err)
+			t.Fatalf("\t%s\tDeposit failed.", tests.Failed)
+		}
+
+		transfer, err := repo.Transfer(ctx, claims, TransferRequest{
+			FromAccountNumber: from.Number,
+			ToAccountNumber:   to.Number,
+			Amount:            money.Naira(400),
+		}, now.Add(time.Minute))
+		if err != nil {
+			t.Log("\t\tGot :", err)
+			t.Fatalf("\t%s\tTransfer failed.", tests.Failed)
+		}
+		if len(transfer.Transactions) != 2 {
+			t.Fatalf("\t%s\tExpected a withdrawal and a deposit, got %d transactions.", tests.Failed, len(transfer.Transactions))
+		}
+		for _, tx := range transfer.Transactions {
+			if tx.TransferID == nil || *tx.TransferID != transfer.ID || tx.PaymentMethod != PaymentMethod_Transfer {
+				t.Fatalf("\t%s\tExpected the transaction to be linked to the transfer, got %+v.", tests.Failed, tx)
+			}
+		}
+		assertBalance(t, from.ID, money.Naira(600))
+		assertBalance(t, to.ID, money.Naira(400))
+		t.Logf("\t%s\tTransfer ok.", tests.Success)
+
+		income, err := repo.TotalDepositAmount(ctx, claims, now.Add(-time.Second).Unix(), now.Add(time.Hour).Unix())
+		if err != nil {
+			t.Log("\t\tGot :", err)
+			t.Fatalf("\t%s\tTotalDepositAmount failed.", tests.Failed)
+		}
+		if income != money.Naira(1000) {
+			t.Fatalf("\t%s\tExpected income of %s, got %s.", tests.Failed, money.Naira(1000), income)
+		}
+		t.Logf("\t%s\tTransfer excluded from income.", tests.Success)
+
+		_, err = repo.Transfer(ctx, claims, TransferRequest{
+			FromAccountNumber: from.Number,
+			ToAccountNumber:   to.Number,
+			Amount:            money.Naira(601),
+		}, now.Add(2*time.Minute))
+		if err == nil {
+			t.Fatalf("\t%s\tExpected a transfer above the balance to fail.", tests.Failed)
+		}
+
+		_, err = repo.Transfer(ctx, claims, TransferRequest{
+			FromAccountNumber: from.Number,
+			ToAccountNumber:   other.Number,
+			Amount:            money.Naira(100),
+		}, now.Add(2*time.Minute))
+		if err == nil {
+			t.Fatalf("\t%s\tExpected a transfer to another customer to fail.", tests.Failed)
+		}
+		assertBalance(t, from.ID, money.Naira(600))
+		assertBalance(t, to.ID, money.Naira(400))
+		t.Logf("\t%s\tInvalid transfers rejected.", tests.Success)
+	}
+}
+
+// TestApprovalThreshold validates the threshold applied when both the branch and the product may
+// require approval.
+func TestApprovalThreshold(t *testing.T) {
+	var thresholdTests = []struct {
+		name            string
+		branch, product money.Amount
+		expected        money.Amount
+	}{
+		{"none", 0, 0, 0},
+		{"branch only", money.Naira(500), 0, money.Naira(500)},
+		{"product only", 0, money.Naira(200), money.Naira(200)},
+		{"branch lower", money.Naira(100), money.Naira(200), money.Naira(100)},
+		{"product lower", money.Naira(500), money.Naira(200), money.Naira(200)},
+	}
+
+	for _, tt := range thresholdTests {
+		if got := ApprovalThreshold(tt.branch, tt.product); got != tt.expected {
+			t.Errorf("%s: expected %s, got %s", tt.name, tt.expected, got)
+		}
+	}
+}
+
+// TestApproval validates that withdrawals above the threshold of the branch are only posted once
+// another admin of the branch approves them.
+func TestApproval(t *testing.T) {
+	defer tests.Recover(t)
+
+	now := time.Date(2026, time.March, 3, 11, 0, 0, 0, time.UTC)
+	claims, account := newTestAccount(t, now)
+
+	ctx := tests.Context()
+
+	if _, err := models.Branches(models.BranchWhere.ID.EQ(account.BranchID)).UpdateAll(ctx, test.MasterDB, models.M{
+		models.BranchColumns.ApprovalThreshold: money.Naira(500).Kobo(),
+	}); err != nil {
+		t.Fatalf("\t%s\tUpdate branch failed: %v", tests.Failed, err)
+	}
+
+	approver := models.User{
+		ID:          uuid.NewRandom().String(),
+		BranchID:    account.BranchID,
+		Email:       uuid.NewRandom().String() + "@example.com",
+		FirstName:   "Branch",
+		LastName:    "Admin",
+		PhoneNumber: "08000000002",
+		CreatedAt:   now,
+	}
+	if err := approver.Insert(ctx, test.MasterDB, boil.Infer()); err != nil {
+		t.Fatalf("\t%s\tInsert approver failed: %v", tests.Failed, err)
+	}
+	approverClaims := claims
+	approverClaims.Subject = approver.ID
+
+	t.Log("Given the need to approve large withdrawals.")
+	{
+		_, err := repo.Deposit(ctx, claims, CreateRequest{
+			Type:          TransactionType_Deposit,
+			AccountNumber: account.Number,
+			Amount:        money.Naira(1000),
+			PaymentMethod: PaymentMethod_Cash,
+		}, now)
+		if err != nil {
+			t.Log("\t\tGot :", err)
+			t.Fatalf("\t%s\tDeposit failed.", tests.Failed)
+		}
+
+		_, err = repo.Withdraw(ctx, claims, WithdrawRequest{
+			Type:          TransactionType_Withdrawal,
+			AccountNumber: account.Number,
+			Amount:        money.Naira(100),
+			PaymentMethod: PaymentMethod_Cash,
+		}, now.Add(time.Minute))
+		if err != nil {
+			t.Log("\t\tGot :", err)
+			t.Fatalf("\t%s\tWithdrawal below the threshold failed.", tests.Failed)
+		}
+		assertBalance(t, account.ID, money.Naira(900))
+		t.Logf("\t%s\tWithdrawal below the threshold posted.", tests.Success)
+
+		_, err = repo.Withdraw(ctx, claims, WithdrawRequest{
+			Type:          TransactionType_Withdrawal,
+			AccountNumber: account.Number,
+			Amount:        money.Naira(600),
+			PaymentMethod: PaymentMethod_Cash,
+		}, now.Add(2*time.Minute))
+		approval, ok := IsPendingApproval(err)
+		if !ok {
+			t.Log("\t\tGot :", err)
+			t.Fatalf("\t%s\tExpected the withdrawal to be held for approval.", tests.Failed)
+		}
+		assertBalance(t, account.ID, money.Naira(900))
+		t.Logf("\t%s\tWithdrawal above the threshold held.", tests.Success)
+
+		if _, err = repo.Approve(ctx, claims, ApproveRequest{ID: approval.ID}, now.Add(3*time.Minute)); err == nil {
+			t.Fatalf("\t%s\tExpected the rep to be unable to approve their own request.", tests.Failed)
+		}
+
+		approved, err := repo.Approve(ctx, approverClaims, ApproveRequest{ID: approval.ID}, now.Add(3*time.Minute))
+		if err != nil {
+			t.Log("\t\tGot :", err)
+			t.Fatalf("\t%s\tApprove failed.", tests.Failed)
+		}
+		if approved.Status != ApprovalStatus_Approved || approved.TransactionID == nil {
+			t.Fatalf("\t%s\tExpected the approval to be approved with its transaction, got %+v.", tests.Failed, approved)
+		}
+		txn, err := repo.ReadByID(ctx, claims, *approved.TransactionID)
+		if err != nil {
+			t.Log("\t\tGot :", err)
+			t.Fatalf("\t%s\tRead withdrawal failed.", tests.Failed)
+		}
+		if txn.SalesRepID != claims.Subject || txn.ApprovedByID == nil || *txn.ApprovedByID != approver.ID {
+			t.Fatalf("\t%s\tExpected the withdrawal to record the rep and the approver, got %+v.", tests.Failed, txn)
+		}
+		assertBalance(t, account.ID, money.Naira(300))
+		t.Logf("\t%s\tApproved withdrawal posted.", tests.Success)
+
+		if _, err = repo.Approve(ctx, approverClaims, ApproveRequest{ID: approval.ID}, now.Add(4*time.Minute)); err == nil {
+			t.Fatalf("\t%s\tExpected a decided approval to not be approved again.", tests.Failed)
+		}
+		assertBalance(t, account.ID, money.Naira(300))
+
+		_, err = repo.Deposit(ctx, claims, CreateRequest{
+			Type:          TransactionType_Deposit,
+			AccountNumber: account.Number,
+			Amount:        money.Naira(500),
+			PaymentMethod: PaymentMethod_Cash,
+		}, now.Add(5*time.Minute))
+		if err != nil {
+			t.Log("\t\tGot :", err)
+			t.Fatalf("\t%s\tDeposit failed.", tests.Failed)
+		}
+
+		_, err = repo.Withdraw(ctx, claims, WithdrawRequest{
+			Type:          TransactionType_Withdrawal,
+			AccountNumber: account.Number,
+			Amount:        money.Naira(500),
+			PaymentMethod: PaymentMethod_Cash,
+		}, now.Add(6*time.Minute))
+		approval, ok = IsPendingApproval(err)
+		if !ok {
+			t.Log("\t\tGot :", err)
+			t.Fatalf("\t%s\tExpected the withdrawal to be held for approval.", tests.Failed)
+		}
+
+		err = repo.Reject(ctx, approverClaims, RejectRequest{ID: approval.ID, Reason: "Customer not present"}, now.Add(7*time.Minute))
+		if err != nil {
+			t.Log("\t\tGot :", err)
+			t.Fatalf("\t%s\tReject failed.", tests.Failed)
+		}
+		assertBalance(t, account.ID, money.Naira(800))
+		t.Logf("\t%s\tRejected withdrawal not posted.", tests.Success)
+	}
+}
+
+// TestStatement validates the balances on the statement of an account and that it renders to PDF.
+func TestStatement(t *testing.T) {
+	defer tests.Recover(t)
+
+	now := time.Date(2026, time.October, 1, 9, 0, 0, 0, time.UTC)
+	claims, account := newTestAccount(t, now)
+
+	t.Log("Given the need to give a customer the statement of an account for a period.")
+	{
+		ctx := tests.Context()
+
+		// One deposit before the period and two transactions within it.
+		for i, p := range []struct {
+			typ    TransactionType
+			amount money.Amount
+			date   time.Time
+		}{
+			{TransactionType_Deposit, money.Naira(1000), now},
+			{TransactionType_Deposit, money.Naira(500), now.AddDate(0, 0, 10)},
+			{TransactionType_Withdrawal, money.Naira(300), now.AddDate(0, 0, 12)},
+		} {
+			var err error
+			if p.typ == TransactionType_Deposit {
+				_, err = repo.Deposit(ctx, claims, CreateRequest{
+					Type:          p.typ,
+					AccountNumber: account.Number,
+					Amount:        p.amount,
+					PaymentMethod: PaymentMethod_Cash,
+				}, p.date)
+			} else {
+				_, err = repo.Withdraw(ctx, claims, WithdrawRequest{
+					Type:          p.typ,
+					AccountNumber: account.Number,
+					Amount:        p.amount,
+					PaymentMethod: PaymentMethod_Cash,
+				}, p.date)
+			}
+			if err != nil {
+				t.Log("\t\tGot :", err)
+				t.Fatalf("\t%s\tPosting %d failed.", tests.Failed, i)
+			}
+		}
+
+		st, err := repo.Statement(ctx, claims, StatementRequest{
+			AccountID: account.ID,
+			StartDate: now.AddDate(0, 0, 5),
+			EndDate:   now.AddDate(0, 0, 20),
+		}, now.AddDate(0, 0, 20))
+		if err != nil {
+			t.Log("\t\tGot :", err)
+			t.Fatalf("\t%s\tStatement failed.", tests.Failed)
+		}
+
+		if st.OpeningBalance != money.Naira(1000) || st.ClosingBalance != money.Naira(1200) {
+			t.Fatalf("\t%s\tExpected opening balance 1000.00 and closing balance 1200.00, got %s and %s.",
+				tests.Failed, st.OpeningBalance, st.ClosingBalance)
+		}
+		if len(st.Lines) != 2 || st.Lines[0].Balance != money.Naira(1500) || st.Lines[1].Balance != money.Naira(1200) {
+			t.Fatalf("\t%s\tExpected running balances 1500.00 and 1200.00, got %+v.", tests.Failed, st.Lines)
+		}
+		if st.TotalDeposits != money.Naira(500) || st.TotalWithdrawals != money.Naira(300) {
+			t.Fatalf("\t%s\tExpected totals 500.00 and 300.00, got %s and %s.", tests.Failed, st.TotalDeposits, st.TotalWithdrawals)
+		}
+		t.Logf("\t%s\tStatement ok.", tests.Success)
+
+		var pdf bytes.Buffer
+		if err := st.WritePDF(&pdf); err != nil {
+			t.Log("\t\tGot :", err)
+			t.Fatalf("\t%s\tWritePDF failed.", tests.Failed)
+		}
+		if !bytes.HasPrefix(pdf.Bytes(), []byte("%PDF-")) {
+			t.Fatalf("\t%s\tExpected a PDF document.", tests.Failed)
+		}
+		t.Logf("\t%s\tWritePDF ok.", tests.Success)
+	}
+}
+
+// TestCycleDaysMissed validates the days of a DS cycle that have not been paid for.
+func TestCycleDaysMissed(t *testing.T) {
+
+	start := time.Date(2026, time.October, 1, 0, 0, 0, 0, time.UTC)
+	cycle := func(daysPaid int) *Cycle {
+		return &Cycle{StartDate: start, EndDate: CycleEnd(start, 31), DaysPaid: daysPaid, Status: CycleStatus_Open}
+	}
+
+	var missedTests = []struct {
+		name    string
+		cycle   *Cycle
+		asOf    time.Time
+		missed  int
+		willDue bool
+	}{
+		{"before the cycle starts", cycle(0), start.Add(-time.Hour), 0, false},
+		{"on the first day unpaid", cycle(0), start.Add(10 * time.Hour), 1, false},
+		{"paid up to date", cycle(5), start.AddDate(0, 0, 4).Add(10 * time.Hour), 0, false},
+		{"behind by two days", cycle(3), start.AddDate(0, 0, 4).Add(10 * time.Hour), 2, false},
+		{"paid in advance", cycle(20), start.AddDate(0, 0, 4), 0, false},
+		{"every day paid", cycle(31), start.AddDate(0, 0, 20), 0, true},
+		{"after the last day", cycle(25), start.AddDate(0, 0, 40), 6, true},
+	}
+
+	t.Log("Given the need to follow up on missed DS contributions.")
+	{
+		if days := cycle(0).Days(); days != 31 {
+			t.Fatalf("\t%s\tExpected a cycle of 31 days, got %d.", tests.Failed, days)
+		}
+
+		for i, tt := range missedTests {
+			t.Logf("\tTest: %d\tWhen %s.", i, tt.name)
+			{
+				if got := tt.cycle.DaysMissed(tt.asOf); got != tt.missed {
+					t.Fatalf("\t%s\tExpected %d days missed, got %d.", tests.Failed, tt.missed, got)
+				}
+				t.Logf("\t%s\tDaysMissed ok.", tests.Success)
+
+				if got := tt.cycle.Due(tt.asOf); got != tt.willDue {
+					t.Fatalf("\t%s\tExpected due to be %v, got %v.", tests.Failed, tt.willDue, got)
+				}
+				t.Logf("\t%s\tDue ok.", tests.Success)
+			}
+		}
+	}
+}
+
+// TestCycles ensures DS deposits are recorded against their cycle, the fee is taken once per
+// cycle and a settled cycle can be paid out before the next one opens.
+func TestCycles(t *testing.T) {
+	defer tests.Recover(t)
+
+	now := time.Date(2026, time.October, 18, 10, 0, 0, 0, time.UTC)
+	claims, account := newTestAccount(t, now)
+
+	t.Log("Given the need to follow daily savings from cycle to cycle.")
+	{
+		ctx := tests.Context()
+
+		product, err := models.AccountProducts(models.AccountProductWhere.Code.EQ(customer.AccountTypeDS)).One(ctx, test.MasterDB)
+		if err != nil {
+			t.Fatalf("\t%s\tRead account product failed: %v", tests.Failed, err)
+		}
+		if _, err := models.Accounts(models.AccountWhere.ID.EQ(account.ID)).UpdateAll(ctx, test.MasterDB, models.M{
+			models.AccountColumns.ProductID:   product.ID,
+			models.AccountColumns.AccountType: product.Code,
+			models.AccountColumns.Target:      money.Naira(1000).Kobo(),
+		}); err != nil {
+			t.Fatalf("\t%s\tUpdate account failed: %v", tests.Failed, err)
+		}
+
+		for i, amount := range []money.Amount{money.Naira(3000), money.Naira(28000)} {
+			if _, err := repo.Deposit(ctx, claims, CreateRequest{
+				Type:          TransactionType_Deposit,
+				AccountNumber: account.Number,
+				Amount:        amount,
+				PaymentMethod: PaymentMethod_Cash,
+			}, now.Add(time.Duration(i)*time.Minute)); err != nil {
+				t.Log("\t\tGot :", err)
+				t.Fatalf("\t%s\tDeposit failed.", tests.Failed)
+			}
+		}
+
+		cycles, err := repo.FindCycles(ctx, claims, CycleFindRequest{Where: "account_id = ?", Args: []interface{}{account.ID}})
+		if err != nil {
+			t.Log("\t\tGot :", err)
+			t.Fatalf("\t%s\tFindCycles failed.", tests.Failed)
+		}
+		if len(cycles) != 1 {
+			t.Fatalf("\t%s\tExpected one cycle, got %d.", tests.Failed, len(cycles))
+		}
+		first := cycles[0]
+		if first.DaysPaid != 31 || first.AmountPaid != money.Naira(31000) || first.Fee != money.Naira(1000) {
+			t.Fatalf("\t%s\tExpected 31 days paid, 31000.00 paid and a fee of 1000.00, got %+v.", tests.Failed, first)
+		}
+		assertBalance(t, account.ID, money.Naira(30000))
+		t.Logf("\t%s\tDeposits ok.", tests.Success)
+
+		settled, err := repo.SettleCycle(ctx, claims, SettleCycleRequest{
+			ID:     first.ID,
+			Action: CycleSettlement_Payout,
+		}, now.Add(time.Hour))
+		if err != nil {
+			t.Log("\t\tGot :", err)
+			t.Fatalf("\t%s\tSettleCycle failed.", tests.Failed)
+		}
+		if settled.Status != CycleStatus_Settled || settled.Payout != money.Naira(30000) || settled.PayoutTransactionID == nil {
+			t.Fatalf("\t%s\tExpected a payout of 30000.00, got %+v.", tests.Failed, settled)
+		}
+		assertBalance(t, account.ID, 0)
+
+		if _, err := repo.SettleCycle(ctx, claims, SettleCycleRequest{ID: first.ID, Action: CycleSettlement_Rollover},
+			now.Add(time.Hour)); err == nil {
+			t.Fatalf("\t%s\tExpected settling the cycle again to fail.", tests.Failed)
+		}
+		t.Logf("\t%s\tSettleCycle ok.", tests.Success)
+
+		if _, err := repo.Deposit(ctx, claims, CreateRequest{
+			Type:          TransactionType_Deposit,
+			AccountNumber: account.Number,
+			Amount:        money.Naira(2000),
+			PaymentMethod: PaymentMethod_Cash,
+		}, now.Add(2*time.Hour)); err != nil {
+			t.Log("\t\tGot :", err)
+			t.Fatalf("\t%s\tDeposit failed.", tests.Failed)
+		}
+
+		cycles, err = repo.FindCycles(ctx, claims, CycleFindRequest{Where: "account_id = ?", Args: []interface{}{account.ID}})
+		if err != nil {
+			t.Log("\t\tGot :", err)
+			t.Fatalf("\t%s\tFindCycles failed.", tests.Failed)
+		}
+		if len(cycles) != 2 || cycles[0].Number != 2 || cycles[0].Status != CycleStatus_Open || cycles[0].Fee != money.Naira(1000) {
+			t.Fatalf("\t%s\tExpected a second open cycle with a fee of 1000.00, got %+v.", tests.Failed, cycles[0])
+		}
+
+		statement, err := repo.CycleStatement(ctx, claims, first.ID)
+		if err != nil {
+			t.Log("\t\tGot :", err)
+			t.Fatalf("\t%s\tCycleStatement failed.", tests.Failed)
+		}
+		// 31 daily contributions, the fee and the payout.
+		if len(statement.Transactions) != 33 {
+			t.Fatalf("\t%s\tExpected 33 transactions in the first cycle, got %d.", tests.Failed, len(statement.Transactions))
+		}
+		t.Logf("\t%s\tNext cycle ok.", tests.Success)
+	}
+}
+
+// TestInterestMaturedLate ensures interest credited by a late run of the interest job is listed
+// after the transactions posted since maturity and opens with the balance they left.
+func TestInterestMaturedLate(t *testing.T) {
+	defer tests.Recover(t)
+
+	now := time.Date(2026, time.January, 1, 9, 0, 0, 0, time.UTC)
+	claims, account := newTestAccount(t, now)
+
+	t.Log("Given the need to credit interest when the interest job runs after maturity.")
+	{
+		ctx := tests.Context()
+
+		maturity := now.AddDate(0, 0, 10)
+		if _, err := models.Accounts(models.AccountWhere.ID.EQ(account.ID)).UpdateAll(ctx, test.MasterDB, models.M{
+			models.AccountColumns.InterestRateBPS: 1000,
+			models.AccountColumns.MaturityDate:    maturity.Unix(),
+		}); err != nil {
+			t.Log("\t\tGot :", err)
+			t.Fatalf("\t%s\tUpdate account failed.", tests.Failed)
+		}
+
+		// One deposit before maturity and one after it, before the job runs.
+		for i, date := range []time.Time{now, maturity.AddDate(0, 0, 2)} {
+			_, err := repo.Deposit(ctx, claims, CreateRequest{
+				Type:          TransactionType_Deposit,
+				AccountNumber: account.Number,
+				Amount:        money.Naira(1000),
+				PaymentMethod: PaymentMethod_Cash,
+			}, date)
+			if err != nil {
+				t.Log("\t\tGot :", err)
+				t.Fatalf("\t%s\tDeposit %d failed.", tests.Failed, i)
+			}
+		}
+
+		runDate := maturity.AddDate(0, 0, 5)
+		report, err := repo.AccrueInterest(ctx, InterestRequest{Date: runDate}, runDate)
+		if err != nil {
+			t.Log("\t\tGot :", err)
+			t.Fatalf("\t%s\tAccrueInterest failed.", tests.Failed)
+		}
+		if report.Credited <= 0 {
+			t.Fatalf("\t%s\tExpected interest to be credited, got %+v.", tests.Failed, report)
+		}
+		t.Logf("\t%s\tAccrueInterest ok.", tests.Success)
+
+		txs, err := models.Transactions(
+			models.TransactionWhere.AccountID.EQ(account.ID),
+			OrderBy(models.TransactionColumns.CreatedAt+", "+models.TransactionColumns.ID),
+		).All(ctx, test.MasterDB)
+		if err != nil {
+			t.Log("\t\tGot :", err)
+			t.Fatalf("\t%s\tFind transactions failed.", tests.Failed)
+		}
+		if len(txs) != 3 || txs[2].ReceiptNo == "" || txs[2].EffectiveDate != maturity.Unix() {
+			t.Fatalf("\t%s\tExpected the interest after the deposits, effective at maturity, got %+v.", tests.Failed, txs)
+		}
+
+		var balance money.Amount
+		for i, tx := range txs {
+			if money.Amount(tx.OpeningBalance) != balance {
+				t.Fatalf("\t%s\tExpected transaction %d to open at %s, got %s.", tests.Failed, i, balance, money.Amount(tx.OpeningBalance))
+			}
+			balance += money.Amount(tx.Amount)
+		}
+		assertBalance(t, account.ID, money.Naira(2000)+report.Credited)
+		t.Logf("\t%s\tOpening balances ok.", tests.Success)
+	}
+}
+
+// TestDepositReplay ensures a deposit retried with the same idempotency key returns the deposit
+// already posted instead of posting it again.
+func TestDepositReplay(t *testing.T) {
+	defer tests.Recover(t)
+
+	now := time.Date(2026, time.October, 18, 11, 0, 0, 0, time.UTC)
+	claims, account := newTestAccount(t, now)
+
+	t.Log("Given the need to retry a deposit safely.")
+	{
+		ctx := tests.Context()
+
+		req := CreateRequest{
+			Type:           TransactionType_Deposit,
+			AccountNumber:  account.Number,
+			Amount:         money.Naira(500),
+			PaymentMethod:  PaymentMethod_Cash,
+			IdempotencyKey: uuid.NewRandom().String(),
+		}
+
+		first, err := repo.Deposit(ctx, claims, req, now)
+		if err != nil {
+			t.Log("\t\tGot :", err)
+			t.Fatalf("\t%s\tDeposit failed.", tests.Failed)
+		}
+
+		replay, err := repo.Deposit(ctx, claims, req, now.Add(time.Minute))
+		if err != nil {
+			t.Log("\t\tGot :", err)
+			t.Fatalf("\t%s\tReplay failed.", tests.Failed)
+		}
+		if replay.ID != first.ID {
+			t.Fatalf("\t%s\tExpected the replay to return deposit %s, got %s.", tests.Failed, first.ID, replay.ID)
+		}
+
+		count, err := models.Transactions(models.TransactionWhere.AccountID.EQ(account.ID)).Count(ctx, test.MasterDB)
+		if err != nil {
+			t.Log("\t\tGot :", err)
+			t.Fatalf("\t%s\tCount transactions failed.", tests.Failed)
+		}
+		if count != 1 {
+			t.Fatalf("\t%s\tExpected 1 transaction, got %d.", tests.Failed, count)
+		}
+		assertBalance(t, account.ID, req.Amount)
+		t.Logf("\t%s\tReplay ok.", tests.Success)
+	}
+}
+
+// dailyIncome returns the income recorded in the daily summary of the day of date.
+func dailyIncome(t *testing.T, date time.Time) money.Amount {
+	ctx := tests.Context()
+
+	day := time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, time.UTC)
+	m, err := models.DailySummaries(models.DailySummaryWhere.Date.EQ(day.Unix())).One(ctx, test.MasterDB)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return 0
+		}
+		t.Log("\t\tGot :", err)
+		t.Fatalf("\t%s\tFind daily summary failed.", tests.Failed)
+	}
+	return money.Amount(m.Income)
+}
+
+// TestDailySummary ensures every posting that brings money in or takes it back out updates the
+// income of the day with the posting.
+func TestDailySummary(t *testing.T) {
+	defer tests.Recover(t)
+
+	// No other test posts on this day so the summary only holds the postings made here.
+	now := time.Date(2026, time.March, 14, 10, 0, 0, 0, time.UTC)
+	claims, account := newTestAccount(t, now)
+	_, opened := newTestAccount(t, now)
+
+	inTx := func(f func(tx *sql.Tx) error) error {
+		tx, err := test.MasterDB.Begin()
+		if err != nil {
+			return err
+		}
+		if err := f(tx); err != nil {
+			_ = tx.Rollback()
+			return err
+		}
+		return tx.Commit()
+	}
+
+	var deposit *Transaction
+	var replacementID string
+
+	var summaryTests = []struct {
+		Name string
+		Post func(ctx context.Context, now time.Time) error
+		Want money.Amount
+	}{
+		{"a deposit is made", func(ctx context.Context, now time.Time) (err error) {
+			deposit, err = repo.Deposit(ctx, claims, CreateRequest{
+				Type:          TransactionType_Deposit,
+				AccountNumber: account.Number,
+				Amount:        money.Naira(500),
+				PaymentMethod: PaymentMethod_Cash,
+			}, now)
+			return err
+		}, money.Naira(500)},
+		{"the deposit is corrected", func(ctx context.Context, now time.Time) error {
+			amount := money.Naira(300)
+			if err := repo.Update(ctx, claims, UpdateRequest{
+				ID:     deposit.ID,
+				Amount: &amount,
+				Reason: "Amount entered wrongly",
+			}, now); err != nil {
+				return err
+			}
+			replacement, err := models.Transactions(models.TransactionWhere.CorrectionOfID.EQ(null.StringFrom(deposit.ID))).One(ctx, test.MasterDB)
+			if err != nil {
+				return err
+			}
+			replacementID = replacement.ID
+			return nil
+		}, money.Naira(-200)},
+		{"the correction is archived", func(ctx context.Context, now time.Time) error {
+			return repo.Archive(ctx, claims, ArchiveRequest{ID: replacementID, Reason: "Posted to the wrong account"}, now)
+		}, money.Naira(-300)},
+		{"an opening balance is posted", func(ctx context.Context, now time.Time) error {
+			return inTx(func(tx *sql.Tx) error {
+				_, err := repo.OpeningBalance(ctx, claims, OpeningBalanceRequest{
+					AccountID: opened.ID,
+					Amount:    money.Naira(1000),
+				}, now, tx)
+				return err
+			})
+		}, money.Naira(1000)},
+		{"an account is credited", func(ctx context.Context, now time.Time) error {
+			return inTx(func(tx *sql.Tx) error {
+				_, err := repo.MakeCredit(ctx, claims, MakeCreditRequest{
+					AccountNumber: account.Number,
+					Amount:        money.Naira(200),
+					Narration:     "Loan disbursement",
+					LedgerAccount: ledger.AccountLoans,
+				}, now, tx)
+				return err
+			})
+		}, money.Naira(200)},
+	}
+
+	t.Log("Given the need to keep the income of the day in step with the postings.")
+	{
+		for i, tt := range summaryTests {
+			t.Logf("\tTest: %d\tWhen %s.", i, tt.Name)
+			{
+				ctx := tests.Context()
+				postedAt := now.Add(time.Duration(i) * time.Minute)
+
+				before := dailyIncome(t, postedAt)
+				if err := tt.Post(ctx, postedAt); err != nil {
+					t.Log("\t\tGot :", err)
+					t.Fatalf("\t%s\tPosting failed.", tests.Failed)
+				}
+				if got := dailyIncome(t, postedAt) - before; got != tt.Want {
+					t.Logf("\t\tGot : %s", got)
+					t.Logf("\t\tWant: %s", tt.Want)
+					t.Fatalf("\t%s\tShould update the income of the day.", tests.Failed)
+				}
+				t.Logf("\t%s\tShould update the income of the day.", tests.Success)
+			}
+		}
+	}
+}
+
+// TestUpdateLimits ensures a correction is held to the KYC limit of the customer the same as a
+// new deposit and leaves the transaction untouched when it is rejected.
+func TestUpdateLimits(t *testing.T) {
+	defer tests.Recover(t)
+
+	now := time.Date(2026, time.October, 18, 13, 0, 0, 0, time.UTC)
+	claims, account := newTestAccount(t, now)
+
+	t.Log("Given the need to keep corrections within the limits deposits are held to.")
+	{
+		ctx := tests.Context()
+
+		deposit, err := repo.Deposit(ctx, claims, CreateRequest{
+			Type:          TransactionType_Deposit,
+			AccountNumber: account.Number,
+			Amount:        money.Naira(40000),
+			PaymentMethod: PaymentMethod_Cash,
+		}, now)
+		if err != nil {
+			t.Log("\t\tGot :", err)
+			t.Fatalf("\t%s\tDeposit failed.", tests.Failed)
+		}
+
+		t.Log("\tTest: 0\tWhen the corrected amount is over the daily limit of the customer.")
+		{
+			amount := money.Naira(60000)
+			err := repo.Update(ctx, claims, UpdateRequest{
+				ID:     deposit.ID,
+				Amount: &amount,
+				Reason: "Amount entered wrongly",
+			}, now.Add(time.Minute))
+			if errors.Cause(err) != customer.ErrDepositLimit {
+				t.Logf("\t\tGot : %v", err)
+				t.Logf("\t\tWant: %v", customer.ErrDepositLimit)
+				t.Fatalf("\t%s\tShould reject the correction.", tests.Failed)
+			}
+
+			reversed, err := models.Transactions(models.TransactionWhere.ReversalOfID.EQ(null.StringFrom(deposit.ID))).Exists(ctx, test.MasterDB)
+			if err != nil {
+				t.Log("\t\tGot :", err)
+				t.Fatalf("\t%s\tFind reversal failed.", tests.Failed)
+			}
+			if reversed {
+				t.Fatalf("\t%s\tExpected the deposit not to be reversed.", tests.Failed)
+			}
+			assertBalance(t, account.ID, deposit.Amount)
+			t.Logf("\t%s\tShould reject the correction.", tests.Success)
+		}
+
+		t.Log("\tTest: 1\tWhen the corrected amount is within the daily limit without the original.")
+		{
+			amount := money.Naira(45000)
+			if err := repo.Update(ctx, claims, UpdateRequest{
+				ID:     deposit.ID,
+				Amount: &amount,
+				Reason: "Amount entered wrongly",
+			}, now.Add(2*time.Minute)); err != nil {
+				t.Log("\t\tGot :", err)
+				t.Fatalf("\t%s\tShould accept the correction.", tests.Failed)
+			}
+			assertBalance(t, account.ID, amount)
+			t.Logf("\t%s\tShould accept the correction.", tests.Success)
+		}
+	}
+}
+
+// TestApprovalLimits validates a held withdrawal is held to the KYC limit of the customer again
+// when it is approved and that approvals are only read by the rep and admins of the branch.
+func TestApprovalLimits(t *testing.T) {
+	defer tests.Recover(t)
+
+	now := time.Date(2026, time.March, 4, 11, 0, 0, 0, time.UTC)
+	claims, account := newTestAccount(t, now)
+
+	ctx := tests.Context()
+
+	if _, err := models.Branches(models.BranchWhere.ID.EQ(account.BranchID)).UpdateAll(ctx, test.MasterDB, models.M{
+		models.BranchColumns.ApprovalThreshold: money.Naira(10000).Kobo(),
+	}); err != nil {
+		t.Fatalf("\t%s\tUpdate branch failed: %v", tests.Failed, err)
+	}
+
+	approverClaims, otherAccount := newTestAccount(t, now)
+	approverClaims.Subject = uuid.NewRandom().String()
+	approver := models.User{
+		ID:          approverClaims.Subject,
+		BranchID:    account.BranchID,
+		Email:       uuid.NewRandom().String() + "@example.com",
+		FirstName:   "Branch",
+		LastName:    "Admin",
+		PhoneNumber: "08000000002",
+		CreatedAt:   now,
+	}
+	if err := approver.Insert(ctx, test.MasterDB, boil.Infer()); err != nil {
+		t.Fatalf("\t%s\tInsert approver failed: %v", tests.Failed, err)
+	}
+
+	// The admin of another branch.
+	otherClaims := claims
+	otherClaims.Subject = otherAccount.SalesRepID
+
+	t.Log("Given the need to keep approved withdrawals within the KYC limit of the customer.")
+	{
+		_, err := repo.Deposit(ctx, claims, CreateRequest{
+			Type:          TransactionType_Deposit,
+			AccountNumber: account.Number,
+			Amount:        money.Naira(40000),
+			PaymentMethod: PaymentMethod_Cash,
+		}, now)
+		if err != nil {
+			t.Log("\t\tGot :", err)
+			t.Fatalf("\t%s\tDeposit failed.", tests.Failed)
+		}
+
+		_, err = repo.Withdraw(ctx, claims, WithdrawRequest{
+			Type:          TransactionType_Withdrawal,
+			AccountNumber: account.Number,
+			Amount:        money.Naira(15000),
+			PaymentMethod: PaymentMethod_Cash,
+		}, now.Add(time.Minute))
+		approval, ok := IsPendingApproval(err)
+		if !ok {
+			t.Log("\t\tGot :", err)
+			t.Fatalf("\t%s\tExpected the withdrawal to be held for approval.", tests.Failed)
+		}
+
+		var readTests = []struct {
+			Name   string
+			Claims auth.Claims
+			Err    error
+		}{
+			{"the rep that made the request", claims, nil},
+			{"an admin of the branch", approverClaims, nil},
+			{"an admin of another branch", otherClaims, ErrForbidden},
+			{"a user without an account", auth.Claims{StandardClaims: jwt.StandardClaims{Subject: claims.Subject}}, ErrForbidden},
+		}
+
+		for i, tt := range readTests {
+			t.Logf("\tTest: %d\tWhen the approval is read by %s.", i, tt.Name)
+			{
+				_, err := repo.ReadApproval(ctx, tt.Claims, approval.ID)
+				if errors.Cause(err) != tt.Err {
+					t.Logf("\t\tGot : %v", err)
+					t.Logf("\t\tWant: %v", tt.Err)
+					t.Fatalf("\t%s\tShould get the expected error.", tests.Failed)
+				}
+				t.Logf("\t%s\tShould get the expected error.", tests.Success)
+			}
+		}
+
+		t.Logf("\tTest: %d\tWhen the customer withdrew more before the request was approved.", len(readTests))
+		{
+			_, err = repo.Withdraw(ctx, claims, WithdrawRequest{
+				Type:          TransactionType_Withdrawal,
+				AccountNumber: account.Number,
+				Amount:        money.Naira(9000),
+				PaymentMethod: PaymentMethod_Cash,
+			}, now.Add(2*time.Minute))
+			if err != nil {
+				t.Log("\t\tGot :", err)
+				t.Fatalf("\t%s\tWithdrawal below the threshold failed.", tests.Failed)
+			}
+
+			_, err = repo.Approve(ctx, approverClaims, ApproveRequest{ID: approval.ID}, now.Add(3*time.Minute))
+			if errors.Cause(err) != customer.ErrWithdrawalLimit {
+				t.Logf("\t\tGot : %v", err)
+				t.Logf("\t\tWant: %v", customer.ErrWithdrawalLimit)
+				t.Fatalf("\t%s\tShould not post the withdrawal.", tests.Failed)
+			}
+			assertBalance(t, account.ID, money.Naira(31000))
+			t.Logf("\t%s\tShould not post the withdrawal.", tests.Success)
+		}
+	}
+}
@@ -0,0 +1,332 @@
+package transaction
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/pborman/uuid"
+	"github.com/pkg/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	. "github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"gopkg.in/DataDog/dd-trace-go.v1/ddtrace/tracer"
+
+	"merryworld/surebank/internal/account_product"
+	"merryworld/surebank/internal/idempotency"
+	"merryworld/surebank/internal/ledger"
+	"merryworld/surebank/internal/platform/auth"
+	"merryworld/surebank/internal/platform/money"
+	"merryworld/surebank/internal/platform/web/webcontext"
+	"merryworld/surebank/internal/platform/web/weberror"
+	"merryworld/surebank/internal/postgres/models"
+)
+
+var (
+	// ErrTransferCustomer occurs when a transfer is requested between accounts of different customers.
+	ErrTransferCustomer = errors.New("Transfers can only be made between accounts of the same customer")
+)
+
+// ReadTransfer gets the specified transfer with its accounts and transactions from the database.
+func (repo *Repository) ReadTransfer(ctx context.Context, _ auth.Claims, id string) (*Transfer, error) {
+	span, ctx := tracer.StartSpanFromContext(ctx, "internal.transaction.ReadTransfer")
+	defer span.Finish()
+
+	rec, err := models.Transfers(
+		models.TransferWhere.ID.EQ(id),
+		Load(models.TransferRels.FromAccount),
+		Load(models.TransferRels.ToAccount),
+		Load(models.TransferRels.Transactions, OrderBy(models.TransactionColumns.CreatedAt)),
+	).One(ctx, repo.DbConn)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, weberror.NewError(ctx, ErrNotFound, http.StatusNotFound)
+		}
+		return nil, err
+	}
+
+	return TransferFromModel(rec), nil
+}
+
+// Transfer moves money from one account of a customer to another in a single db transaction. It
+// is recorded as a withdrawal from the source account and a deposit to the destination, both
+// linked to the transfer. The withdrawal rules of the source and the deposit rules of the
+// destination apply as they would for cash, but the transfer is not counted as income and the
+// customer gets one SMS for both legs. Transfers at or above the approval threshold are held for
+// approval and a PendingApprovalError is returned instead.
+func (repo *Repository) Transfer(ctx context.Context, claims auth.Claims, req TransferRequest, currentDate time.Time) (*Transfer, error) {
+	span, ctx := tracer.StartSpanFromContext(ctx, "internal.transaction.Transfer")
+	defer span.Finish()
+	if claims.Audience == "" {
+		return nil, errors.WithStack(ErrForbidden)
+	}
+
+	// Validate the request.
+	v := webcontext.Validator()
+	err := v.Struct(req)
+	if err != nil {
+		return nil, err
+	}
+
+	// If now empty set it to the current time.
+	if currentDate.IsZero() {
+		currentDate = time.Now()
+	}
+
+	// Always store the time as UTC.
+	currentDate = currentDate.UTC()
+	// Postgres truncates times to milliseconds when storing. We and do the same
+	// here so the value we return is consistent with what we store.
+	currentDate = currentDate.Truncate(time.Millisecond)
+
+	dbTx, err := repo.DbConn.Begin()
+	if err != nil {
+		return nil, err
+	}
+
+	if req.IdempotencyKey != "" {
+		transferID, err := idempotency.Claim(ctx, dbTx, claims.Audience, idempotency.ScopeTransfer, req.IdempotencyKey, req, currentDate)
+		if err != nil {
+			_ = dbTx.Rollback()
+			return nil, err
+		}
+		if transferID != "" {
+			_ = dbTx.Rollback()
+			if transferID, err = repo.approvalReplay(ctx, transferID); err != nil {
+				return nil, err
+			}
+			return repo.ReadTransfer(ctx, claims, transferID)
+		}
+	}
+
+	from, to, err := repo.lockTransferAccounts(ctx, req.FromAccountNumber, req.ToAccountNumber, dbTx)
+	if err != nil {
+		_ = dbTx.Rollback()
+		return nil, err
+	}
+
+	approval, err := repo.holdForApproval(ctx, claims, ApprovalKind_Transfer, from, to, req.Amount, req.Narration, "", currentDate, dbTx)
+	if err != nil {
+		_ = dbTx.Rollback()
+		return nil, err
+	}
+	if approval != nil {
+		if err = repo.completeIdempotency(ctx, claims, req.IdempotencyKey, approval.ID, dbTx); err != nil {
+			_ = dbTx.Rollback()
+			return nil, err
+		}
+		if err = dbTx.Commit(); err != nil {
+			return nil, err
+		}
+		repo.notifyApprovers(ctx, claims, approval.ID)
+		return nil, &PendingApprovalError{Approval: ApprovalFromModel(approval)}
+	}
+
+	t, err := repo.postTransfer(ctx, claims, from, to, req.Amount, req.Narration, currentDate, dbTx)
+	if err != nil {
+		_ = dbTx.Rollback()
+		return nil, err
+	}
+
+	if err = repo.completeIdempotency(ctx, claims, req.IdempotencyKey, t.ID, dbTx); err != nil {
+		_ = dbTx.Rollback()
+		return nil, err
+	}
+
+	if err = dbTx.Commit(); err != nil {
+		return nil, err
+	}
+
+	repo.notifyTransfer(ctx, t, from, to)
+
+	return repo.ReadTransfer(ctx, claims, t.ID)
+}
+
+// lockTransferAccounts locks the accounts a transfer is made between and checks that they belong
+// to the same customer.
+func (repo *Repository) lockTransferAccounts(ctx context.Context, fromNumber, toNumber string, dbTx *sql.Tx) (*models.Account, *models.Account, error) {
+	// Both accounts belong to the same customer so locking one locks the other.
+	from, err := repo.lockAccount(ctx, models.AccountWhere.Number.EQ(fromNumber), dbTx)
+	if err != nil {
+		return nil, nil, weberror.NewErrorMessage(ctx, err, http.StatusBadRequest, "Invalid account number "+fromNumber)
+	}
+
+	to, err := repo.lockAccount(ctx, models.AccountWhere.Number.EQ(toNumber), dbTx)
+	if err != nil {
+		return nil, nil, weberror.NewErrorMessage(ctx, err, http.StatusBadRequest, "Invalid account number "+toNumber)
+	}
+
+	if from.CustomerID != to.CustomerID {
+		return nil, nil, weberror.NewError(ctx, ErrTransferCustomer, http.StatusBadRequest)
+	}
+
+	if err = checkStatus(ctx, from, true); err != nil {
+		return nil, nil, err
+	}
+	if err = checkStatus(ctx, to, false); err != nil {
+		return nil, nil, err
+	}
+
+	return from, to, nil
+}
+
+// postTransfer records the transfer and its two legs, charges any early withdrawal penalty on the
+// source account and posts the transfer to the ledger within the provided db transaction. The
+// accounts must already be locked.
+func (repo *Repository) postTransfer(ctx context.Context, claims auth.Claims, from, to *models.Account,
+	amount money.Amount, narration string, currentDate time.Time, dbTx *sql.Tx) (*models.Transfer, error) {
+
+	fromBalance, err := repo.AccountBalanceTx(ctx, from.ID, dbTx)
+	if err != nil {
+		return nil, err
+	}
+
+	penalty := earlyWithdrawalPenalty(from, amount, currentDate)
+	if err = account_product.FromModel(from.R.Product).CheckWithdrawal(fromBalance, amount+penalty); err != nil {
+		return nil, weberror.NewError(ctx, err, http.StatusBadRequest)
+	}
+	if err = checkHeld(ctx, from.ID, fromBalance, amount+penalty, currentDate, dbTx); err != nil {
+		return nil, err
+	}
+
+	if narration == "" {
+		narration = fmt.Sprintf("Transfer from %s to %s", from.Number, to.Number)
+	}
+
+	t := models.Transfer{
+		ID:            uuid.NewRandom().String(),
+		Reference:     repo.generateTransferReference(ctx),
+		FromAccountID: from.ID,
+		ToAccountID:   to.ID,
+		Amount:        amount.Kobo(),
+		Narration:     narration,
+		SalesRepID:    claims.Subject,
+		CreatedAt:     currentDate.Unix(),
+	}
+	if err := t.Insert(ctx, dbTx, boil.Infer()); err != nil {
+		return nil, errors.WithMessage(err, "Insert transfer failed")
+	}
+
+	withdrawal := models.Transaction{
+		ID:             uuid.NewRandom().String(),
+		AccountID:      from.ID,
+		TXType:         TransactionType_Withdrawal.String(),
+		OpeningBalance: fromBalance.Kobo(),
+		Amount:         amount.Kobo(),
+		Narration:      fmt.Sprintf("Transfer %s to %s", t.Reference, to.Number),
+		PaymentMethod:  PaymentMethod_Transfer,
+		SalesRepID:     claims.Subject,
+		ReceiptNo:      repo.generateReceiptNumber(ctx),
+		CreatedAt:      currentDate.Unix(),
+		UpdatedAt:      currentDate.Unix(),
+		EffectiveDate:  currentDate.Unix(),
+		TransferID:     null.StringFrom(t.ID),
+	}
+	if err := withdrawal.Insert(ctx, dbTx, boil.Infer()); err != nil {
+		return nil, errors.WithMessage(err, "Insert transfer withdrawal failed")
+	}
+
+	fromBalance -= amount
+	if penalty > 0 {
+		if err := repo.chargeEarlyWithdrawalPenalty(ctx, claims, from, &withdrawal, fromBalance, penalty, currentDate, dbTx); err != nil {
+			return nil, err
+		}
+		fromBalance -= penalty
+	}
+
+	if _, err := models.Accounts(models.AccountWhere.ID.EQ(from.ID)).UpdateAll(ctx, dbTx, models.M{
+		models.AccountColumns.Balance: fromBalance.Kobo(),
+	}); err != nil {
+		return nil, err
+	}
+
+	if err = repo.trackGoal(ctx, from, fromBalance, dbTx); err != nil {
+		return nil, err
+	}
+
+	// The deposit is recorded after the withdrawal so statements list them in order.
+	if _, err = repo.postDeposit(ctx, claims, CreateRequest{
+		Type:          TransactionType_Deposit,
+		AccountNumber: to.Number,
+		Amount:        amount,
+		Narration:     fmt.Sprintf("Transfer %s from %s", t.Reference, from.Number),
+	}, to, currentDate.Add(time.Second), t.ID, dbTx); err != nil {
+		return nil, err
+	}
+
+	// The money stays with the business, it only moves between the deposits of the customer.
+	_, err = repo.LedgerRepo.PostTx(ctx, claims, ledger.PostRequest{
+		Reference:  t.Reference,
+		Narration:  fmt.Sprintf("transfer from %s to %s - %s", from.Number, to.Number, narration),
+		SourceType: ledger.SourceTransfer,
+		SourceID:   t.ID,
+		Lines: []ledger.Line{
+			ledger.Debit(ledger.AccountCustomerDeposits, amount).ForAccount(from.ID),
+			ledger.Credit(ledger.AccountCustomerDeposits, amount).ForAccount(to.ID),
+		},
+	}, currentDate, dbTx)
+	if err != nil {
+		return nil, errors.WithMessage(err, "Cannot post transfer to the ledger")
+	}
+
+	return &t, nil
+}
+
+// notifyTransfer sends the customer one SMS with the new balances of both accounts of a posted
+// transfer.
+func (repo *Repository) notifyTransfer(ctx context.Context, t *models.Transfer, from, to *models.Account) {
+	fromBalance, err := repo.AccountBalance(ctx, from.ID)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	toBalance, err := repo.AccountBalance(ctx, to.ID)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	var salesRepName string
+	salesRep, err := models.FindUser(ctx, repo.DbConn, t.SalesRepID)
+	if err == nil {
+		salesRepName = salesRep.FirstName + " " + salesRep.LastName
+	}
+
+	if err = repo.notifySMS.Send(ctx, from.R.Customer.PhoneNumber, "sms/transfer_made",
+		map[string]interface{}{
+			"Name":              from.R.Customer.Name,
+			"Amount":            money.Amount(t.Amount),
+			"Reference":         t.Reference,
+			"FromAccountNumber": from.Number,
+			"FromBalance":       fromBalance,
+			"ToAccountNumber":   to.Number,
+			"ToBalance":         toBalance,
+			"Cashier":           salesRepName,
+		}); err != nil {
+		// TODO: log critical error. Send message to monitoring account
+		fmt.Println(err)
+	}
+}
+
+// generateTransferReference returns a new reference that no other transfer has.
+func (repo *Repository) generateTransferReference(ctx context.Context) string {
+	var reference string
+	for reference == "" || repo.transferReferenceExists(ctx, reference) {
+		reference = "TF"
+		rand.Seed(time.Now().UTC().UnixNano())
+		for i := 0; i < 6; i++ {
+			reference += strconv.Itoa(rand.Intn(10))
+		}
+	}
+	return reference
+}
+
+func (repo *Repository) transferReferenceExists(ctx context.Context, reference string) bool {
+	exists, _ := models.Transfers(models.TransferWhere.Reference.EQ(reference)).Exists(ctx, repo.DbConn)
+	return exists
+}
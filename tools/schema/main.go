@@ -7,14 +7,27 @@ import (
 	"net/url"
 	"os"
 	"strings"
+	"time"
 
-	"merryworld/surebank/internal/platform/web/webcontext"
-	"merryworld/surebank/internal/schema"
+	"github.com/jmoiron/sqlx"
 	"github.com/lib/pq"
 	_ "github.com/lib/pq"
 	"github.com/urfave/cli"
 	sqltrace "gopkg.in/DataDog/dd-trace-go.v1/contrib/database/sql"
 	sqlxtrace "gopkg.in/DataDog/dd-trace-go.v1/contrib/jmoiron/sqlx"
+	"merryworld/surebank/internal/account"
+	"merryworld/surebank/internal/dscommission"
+	"merryworld/surebank/internal/integrity"
+	"merryworld/surebank/internal/inventory"
+	"merryworld/surebank/internal/ledger"
+	"merryworld/surebank/internal/loan"
+	"merryworld/surebank/internal/platform/notify"
+	"merryworld/surebank/internal/platform/web/webcontext"
+	"merryworld/surebank/internal/profit"
+	"merryworld/surebank/internal/sale"
+	"merryworld/surebank/internal/schema"
+	"merryworld/surebank/internal/shop"
+	"merryworld/surebank/internal/transaction"
 )
 
 // service is the name of the program used for logging, tracing and the
@@ -53,7 +66,7 @@ func main() {
 			Name:    "migrate",
 			Aliases: []string{"m"},
 			Usage:   "run schema migration",
-			Flags: []cli.Flag{
+			Flags: append(dbFlags(),
 				cli.StringFlag{
 					Name: "env",
 					Usage: fmt.Sprintf("target environment, one of [%s]",
@@ -61,44 +74,157 @@ func main() {
 					Value:  "dev",
 					EnvVar: "ENV",
 				},
-				cli.StringFlag{
-					Name:   "host",
-					Usage:  "host",
-					Value:  "127.0.0.1:5433",
-					EnvVar: "SCHEMA_DB_HOST",
+			),
+			Action: func(c *cli.Context) error {
+				targetEnv := c.String("env")
+				var dbInfo = DB{
+					Host:     c.String("host"),
+					User:     c.String("user"),
+					Pass:     c.String("pass"),
+					Database: c.String("database"),
+
+					Driver:     c.String("driver"),
+					DisableTLS: c.Bool("disable-tls"),
+				}
+
+				return runMigrate(log, targetEnv, dbInfo)
+			},
+		},
+		{
+			Name:    "integrity",
+			Aliases: []string{"i"},
+			Usage:   "check cached balances against the transactions and stock movements they are built from",
+			Flags: append(dbFlags(),
+				cli.BoolFlag{
+					Name:  "repair",
+					Usage: "rebuild the opening balances and cached balances that drifted",
 				},
+			),
+			Action: func(c *cli.Context) error {
+				var dbInfo = DB{
+					Host:     c.String("host"),
+					User:     c.String("user"),
+					Pass:     c.String("pass"),
+					Database: c.String("database"),
+
+					Driver:     c.String("driver"),
+					DisableTLS: c.Bool("disable-tls"),
+				}
+
+				return runIntegrity(log, dbInfo, c.Bool("repair"))
+			},
+		},
+		{
+			Name:  "interest",
+			Usage: "accrue the daily interest of fixed savings and mature the accounts that are due",
+			Flags: append(dbFlags(),
 				cli.StringFlag{
-					Name:   "user",
-					Usage:  "username",
-					Value:  "postgres",
-					EnvVar: "SCHEMA_DB_USER",
+					Name:  "date",
+					Usage: "the day to run for as YYYY-MM-DD, interest is accrued for every day before it (default: today)",
 				},
+			),
+			Action: func(c *cli.Context) error {
+				var dbInfo = DB{
+					Host:     c.String("host"),
+					User:     c.String("user"),
+					Pass:     c.String("pass"),
+					Database: c.String("database"),
+
+					Driver:     c.String("driver"),
+					DisableTLS: c.Bool("disable-tls"),
+				}
+
+				date := time.Now()
+				if v := c.String("date"); v != "" {
+					var err error
+					date, err = time.ParseInLocation("2006-01-02", v, time.Local)
+					if err != nil {
+						return cli.NewExitError(fmt.Sprintf("invalid date %q, expected YYYY-MM-DD", v), 1)
+					}
+				}
+
+				return runInterest(log, dbInfo, date)
+			},
+		},
+		{
+			Name:  "loans",
+			Usage: "deduct the loan instalments that are due and charge penalties on overdue instalments",
+			Flags: append(dbFlags(),
 				cli.StringFlag{
-					Name:   "pass",
-					Usage:  "password",
-					Value:  "postgres",
-					EnvVar: "SCHEMA_DB_PASS",
+					Name:  "date",
+					Usage: "the day to run for as YYYY-MM-DD, instalments due on or before it are deducted (default: today)",
 				},
+			),
+			Action: func(c *cli.Context) error {
+				var dbInfo = DB{
+					Host:     c.String("host"),
+					User:     c.String("user"),
+					Pass:     c.String("pass"),
+					Database: c.String("database"),
+
+					Driver:     c.String("driver"),
+					DisableTLS: c.Bool("disable-tls"),
+				}
+
+				date := time.Now()
+				if v := c.String("date"); v != "" {
+					var err error
+					date, err = time.ParseInLocation("2006-01-02", v, time.Local)
+					if err != nil {
+						return cli.NewExitError(fmt.Sprintf("invalid date %q, expected YYYY-MM-DD", v), 1)
+					}
+				}
+
+				return runLoans(log, dbInfo, date)
+			},
+		},
+		{
+			Name:  "credit-sales",
+			Usage: "collect the balance of credit sales from the accounts they are paid into",
+			Flags: append(dbFlags(),
 				cli.StringFlag{
-					Name:   "database",
-					Usage:  "name of the default",
-					Value:  "shared",
-					EnvVar: "SCHEMA_DB_DATABASE",
+					Name:  "date",
+					Usage: "the day to run for as YYYY-MM-DD (default: today)",
 				},
-				cli.StringFlag{
-					Name:   "driver",
-					Usage:  "database drive to use for connection",
-					Value:  "postgres",
-					EnvVar: "SCHEMA_DB_DRIVER",
+			),
+			Action: func(c *cli.Context) error {
+				var dbInfo = DB{
+					Host:     c.String("host"),
+					User:     c.String("user"),
+					Pass:     c.String("pass"),
+					Database: c.String("database"),
+
+					Driver:     c.String("driver"),
+					DisableTLS: c.Bool("disable-tls"),
+				}
+
+				date := time.Now()
+				if v := c.String("date"); v != "" {
+					var err error
+					date, err = time.ParseInLocation("2006-01-02", v, time.Local)
+					if err != nil {
+						return cli.NewExitError(fmt.Sprintf("invalid date %q, expected YYYY-MM-DD", v), 1)
+					}
+				}
+
+				return runCreditSales(log, dbInfo, date)
+			},
+		},
+		{
+			Name:  "dormant-accounts",
+			Usage: "mark active accounts without transactions for a number of days as dormant",
+			Flags: append(dbFlags(),
+				cli.IntFlag{
+					Name:  "days",
+					Usage: "the number of days without transactions that make an account dormant",
+					Value: account.DormantDays,
 				},
-				cli.BoolTFlag{
-					Name:   "disable-tls",
-					Usage:  "disable TLS for the database connection",
-					EnvVar: "SCHEMA_DB_DISABLE_TLS",
+				cli.StringFlag{
+					Name:  "date",
+					Usage: "the day to run for as YYYY-MM-DD (default: today)",
 				},
-			},
+			),
 			Action: func(c *cli.Context) error {
-				targetEnv := c.String("env")
 				var dbInfo = DB{
 					Host:     c.String("host"),
 					User:     c.String("user"),
@@ -109,7 +235,16 @@ func main() {
 					DisableTLS: c.Bool("disable-tls"),
 				}
 
-				return runMigrate(log, targetEnv, dbInfo)
+				date := time.Now()
+				if v := c.String("date"); v != "" {
+					var err error
+					date, err = time.ParseInLocation("2006-01-02", v, time.Local)
+					if err != nil {
+						return cli.NewExitError(fmt.Sprintf("invalid date %q, expected YYYY-MM-DD", v), 1)
+					}
+				}
+
+				return runDormantAccounts(log, dbInfo, c.Int("days"), date)
 			},
 		},
 	}
@@ -122,6 +257,136 @@ func main() {
 
 // runMigrate executes the schema migration against the provided database connection details.
 func runMigrate(log *log.Logger, targetEnv string, dbInfo DB) error {
+	masterDb := openDB(log, dbInfo)
+	defer masterDb.Close()
+
+	// =========================================================================
+	// Start Migrations
+
+	ctx := context.Background()
+
+	// Execute the migrations
+	if err := schema.Migrate(ctx, targetEnv, masterDb, log, false); err != nil {
+		return err
+	}
+
+	log.Printf("main : Migrate : Completed")
+	return nil
+}
+
+// runIntegrity checks every account and product for balances that drifted from their records and
+// optionally repairs them. It fails when drift is found and was not repaired.
+func runIntegrity(log *log.Logger, dbInfo DB, repair bool) error {
+	masterDb := openDB(log, dbInfo)
+	defer masterDb.Close()
+
+	report, err := integrity.NewRepository(masterDb).Check(context.Background(), integrity.CheckRequest{Repair: repair}, time.Now())
+	if err != nil {
+		return err
+	}
+
+	for _, d := range report.Response(context.Background()).Drifts {
+		log.Printf("main : Integrity : %s : %s (%s) : expected %s, got %s", d.Kind, d.Reference, d.RecordID, d.Expected, d.Actual)
+	}
+
+	log.Printf("main : Integrity : Checked %d accounts, %d transactions, %d products and %d stock movements",
+		report.Accounts, report.Transactions, report.Products, report.StockMovements)
+
+	if len(report.Drifts) == 0 {
+		log.Printf("main : Integrity : No drift found")
+		return nil
+	}
+	if repair {
+		log.Printf("main : Integrity : Repaired %d records", len(report.Drifts))
+		return nil
+	}
+
+	return cli.NewExitError(fmt.Sprintf("%d records drifted, run with --repair to rebuild them", len(report.Drifts)), 1)
+}
+
+// runInterest accrues the interest of every account that earns it up to the provided date and
+// matures the accounts that are due. It is meant to be run once a day.
+func runInterest(log *log.Logger, dbInfo DB, date time.Time) error {
+	masterDb := openDB(log, dbInfo)
+	defer masterDb.Close()
+
+	transactionRepo := transaction.NewRepository(masterDb, dscommission.NewRepository(masterDb), profit.NewRepository(masterDb),
+		ledger.NewRepository(masterDb), notify.NewSMSDisabled(), notify.NewEmailDisabled(), nil)
+
+	report, err := transactionRepo.AccrueInterest(context.Background(), transaction.InterestRequest{Date: date}, time.Now())
+	if report != nil {
+		log.Printf("main : Interest : %s : Accrued %s over %d days on %d accounts",
+			report.Date.Format("2006-01-02"), report.Accrued, report.Days, report.Accounts)
+		log.Printf("main : Interest : Matured %d accounts, credited %s and rolled over %d",
+			report.Matured, report.Credited, report.RolledOver)
+	}
+
+	return err
+}
+
+// runLoans deducts the loan instalments that are due from the savings accounts of loans set to
+// auto deduct and charges penalties on overdue instalments. It is meant to be run once a day.
+func runLoans(log *log.Logger, dbInfo DB, date time.Time) error {
+	masterDb := openDB(log, dbInfo)
+	defer masterDb.Close()
+
+	ledgerRepo := ledger.NewRepository(masterDb)
+	profitRepo := profit.NewRepository(masterDb)
+	transactionRepo := transaction.NewRepository(masterDb, dscommission.NewRepository(masterDb), profitRepo,
+		ledgerRepo, notify.NewSMSDisabled(), notify.NewEmailDisabled(), nil)
+	loanRepo := loan.NewRepository(masterDb, transactionRepo, ledgerRepo, profitRepo)
+
+	report, err := loanRepo.ProcessDue(context.Background(), loan.ProcessRequest{Date: date}, time.Now())
+	if report != nil {
+		log.Printf("main : Loans : %s : Deducted %s from %d loans, %d could not be deducted",
+			report.Date.Format("2006-01-02"), report.Collected, report.Deducted, report.Failed)
+		log.Printf("main : Loans : Charged %s in penalties on %d instalments", report.Penalties, report.Penalized)
+	}
+
+	return err
+}
+
+// runCreditSales collects what the credit accounts of the sales that have not been paid in full hold
+// towards their balance.
+func runCreditSales(log *log.Logger, dbInfo DB, date time.Time) error {
+	masterDb := openDB(log, dbInfo)
+	defer masterDb.Close()
+
+	ledgerRepo := ledger.NewRepository(masterDb)
+	profitRepo := profit.NewRepository(masterDb)
+	transactionRepo := transaction.NewRepository(masterDb, dscommission.NewRepository(masterDb), profitRepo,
+		ledgerRepo, notify.NewSMSDisabled(), notify.NewEmailDisabled(), nil)
+	saleRepo := sale.NewRepository(masterDb, shop.NewRepository(masterDb), inventory.NewRepository(masterDb),
+		transactionRepo, profitRepo, ledgerRepo, account.NewRepository(masterDb))
+
+	report, err := saleRepo.CollectDue(context.Background(), sale.CollectDueRequest{Date: date}, time.Now())
+	if report != nil {
+		log.Printf("main : Credit Sales : %s : Collected %s on %d sales, %d cleared, %d failed",
+			report.Date.Format("2006-01-02"), report.Amount, report.Collected, report.Cleared, report.Failed)
+	}
+
+	return err
+}
+
+// runDormantAccounts marks the active accounts without transactions for the number of days before
+// the date as dormant.
+func runDormantAccounts(log *log.Logger, dbInfo DB, days int, date time.Time) error {
+	masterDb := openDB(log, dbInfo)
+	defer masterDb.Close()
+
+	accountRepo := account.NewRepository(masterDb)
+
+	report, err := accountRepo.MarkDormant(context.Background(), account.DormantRequest{Date: date, Days: days}, time.Now())
+	if report != nil {
+		log.Printf("main : Dormant Accounts : %s : Marked %d accounts without transactions for %d days as dormant, %d failed",
+			report.Date.Format("2006-01-02"), report.Marked, report.Days, report.Failed)
+	}
+
+	return err
+}
+
+// openDB opens a connection to the database with the provided connection details.
+func openDB(log *log.Logger, dbInfo DB) *sqlx.DB {
 	// =========================================================================
 	// Start Database
 	var dbUrl url.URL
@@ -154,18 +419,47 @@ func runMigrate(log *log.Logger, targetEnv string, dbInfo DB) error {
 	if err != nil {
 		log.Fatalf("main : Register DB : %s : %v", dbInfo.Driver, err)
 	}
-	defer masterDb.Close()
-
-	// =========================================================================
-	// Start Migrations
 
-	ctx := context.Background()
+	return masterDb
+}
 
-	// Execute the migrations
-	if err = schema.Migrate(ctx, targetEnv, masterDb, log, false); err != nil {
-		return err
+// dbFlags returns the options shared by the commands for connecting to the database.
+func dbFlags() []cli.Flag {
+	return []cli.Flag{
+		cli.StringFlag{
+			Name:   "host",
+			Usage:  "host",
+			Value:  "127.0.0.1:5433",
+			EnvVar: "SCHEMA_DB_HOST",
+		},
+		cli.StringFlag{
+			Name:   "user",
+			Usage:  "username",
+			Value:  "postgres",
+			EnvVar: "SCHEMA_DB_USER",
+		},
+		cli.StringFlag{
+			Name:   "pass",
+			Usage:  "password",
+			Value:  "postgres",
+			EnvVar: "SCHEMA_DB_PASS",
+		},
+		cli.StringFlag{
+			Name:   "database",
+			Usage:  "name of the default",
+			Value:  "shared",
+			EnvVar: "SCHEMA_DB_DATABASE",
+		},
+		cli.StringFlag{
+			Name:   "driver",
+			Usage:  "database drive to use for connection",
+			Value:  "postgres",
+			EnvVar: "SCHEMA_DB_DRIVER",
+		},
+		cli.BoolTFlag{
+			Name:   "disable-tls",
+			Usage:  "disable TLS for the database connection",
+			EnvVar: "SCHEMA_DB_DISABLE_TLS",
+		},
 	}
-
-	log.Printf("main : Migrate : Completed")
-	return nil
 }